@@ -0,0 +1,49 @@
+// SPDX-License-Identifier: MIT
+pragma solidity ^0.8.0;
+
+/** ****************************************************************************
+ * @notice Interface for contracts using VRF randomness through a
+ * @notice VRFCoordinatorV2
+ * *****************************************************************************
+ * @dev USAGE
+ *
+ * @dev Consumers inherit from VRFConsumerBaseV2, pass the address of the
+ * @dev VRFCoordinatorV2 to its constructor and implement fulfillRandomWords.
+ *
+ * @dev Unlike with the VRFCoordinator, requests are not paid for by the
+ * @dev consumer but by a subscription it was added to. Call
+ * @dev requestRandomWords on the VRFCoordinatorV2 with the keyHash of the
+ * @dev oracle's proving key and the id of the subscription. Once the oracle
+ * @dev responds and the coordinator has verified its proof, the coordinator
+ * @dev calls fulfillRandomWords with the id the request returned and the
+ * @dev random words.
+ *
+ * @dev fulfillRandomWords is given the callbackGasLimit of the request, and
+ * @dev must not revert: the request is fulfilled and paid for even if it does.
+ */
+abstract contract VRFConsumerBaseV2 {
+  address private immutable vrfCoordinator;
+
+  /**
+   * @param _vrfCoordinator address of the VRFCoordinatorV2 contract
+   */
+  constructor(address _vrfCoordinator) {
+    vrfCoordinator = _vrfCoordinator;
+  }
+
+  /**
+   * @notice fulfillRandomWords handles the VRF response. Your contract must
+   * @notice implement it.
+   *
+   * @param requestId The id returned by requestRandomWords
+   * @param randomWords The VRF output expanded to the requested number of words
+   */
+  function fulfillRandomWords(uint256 requestId, uint256[] memory randomWords) internal virtual;
+
+  // rawFulfillRandomWords is called by the VRFCoordinatorV2 when it receives a
+  // valid VRF proof. It checks the caller before calling fulfillRandomWords.
+  function rawFulfillRandomWords(uint256 requestId, uint256[] memory randomWords) external {
+    require(msg.sender == vrfCoordinator, "Only VRFCoordinatorV2 can fulfill");
+    fulfillRandomWords(requestId, randomWords);
+  }
+}
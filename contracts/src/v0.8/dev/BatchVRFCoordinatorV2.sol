@@ -0,0 +1,47 @@
+// SPDX-License-Identifier: MIT
+pragma solidity ^0.8.0;
+
+import "../VRF.sol";
+import "./VRFCoordinatorV2.sol";
+
+/**
+ * @notice Fulfills several requests of a VRFCoordinatorV2 in one transaction
+ * @dev A fulfillment which reverts does not revert the others, its error being
+ * @dev logged in ErrorReturned or RawErrorReturned instead.
+ */
+contract BatchVRFCoordinatorV2 {
+  VRFCoordinatorV2 public immutable COORDINATOR;
+
+  event ErrorReturned(uint256 indexed requestId, string reason);
+  event RawErrorReturned(uint256 indexed requestId, bytes lowLevelData);
+
+  constructor(address coordinatorAddr) {
+    COORDINATOR = VRFCoordinatorV2(coordinatorAddr);
+  }
+
+  /**
+   * @notice Fulfills the requests of the given proofs and commitments, the
+   * @notice arguments of VRFCoordinatorV2.fulfillRandomWords for each request
+   */
+  function fulfillRandomWords(VRF.Proof[] memory proofs, VRFCoordinatorV2.RequestCommitment[] memory rcs) external {
+    require(proofs.length == rcs.length, "input array arg lengths mismatch");
+    for (uint256 i = 0; i < proofs.length; i++) {
+      try COORDINATOR.fulfillRandomWords(proofs[i], rcs[i]) returns (
+        uint96 /* payment */
+      ) {
+        continue;
+      } catch Error(string memory reason) {
+        emit ErrorReturned(getRequestIdFromProof(proofs[i]), reason);
+      } catch (bytes memory lowLevelData) {
+        emit RawErrorReturned(getRequestIdFromProof(proofs[i]), lowLevelData);
+      }
+    }
+  }
+
+  // getRequestIdFromProof returns the request id of a proof, as computed by
+  // the VRFCoordinatorV2
+  function getRequestIdFromProof(VRF.Proof memory proof) internal pure returns (uint256) {
+    bytes32 keyHash = keccak256(abi.encode(proof.pk));
+    return uint256(keccak256(abi.encode(keyHash, proof.seed)));
+  }
+}
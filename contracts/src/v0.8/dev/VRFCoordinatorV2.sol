@@ -0,0 +1,474 @@
+// SPDX-License-Identifier: MIT
+pragma solidity ^0.8.0;
+
+import "../interfaces/LinkTokenInterface.sol";
+import "../interfaces/BlockhashStoreInterface.sol";
+import "../interfaces/AggregatorV3Interface.sol";
+import "../interfaces/VRFCoordinatorV2Interface.sol";
+import "../ConfirmedOwner.sol";
+import "../VRF.sol";
+import "../VRFConsumerBaseV2.sol";
+
+/** ****************************************************************************
+ * @notice Coordinates VRF requests paid for by subscriptions
+ * *****************************************************************************
+ * @dev Consumers request random words with requestRandomWords, against a
+ * @dev subscription they were added to by its owner. The request is committed
+ * @dev to and logged in RandomWordsRequested, from which the oracle of the
+ * @dev requested proving key generates a proof for the final seed
+ * @dev keccak256(preSeed, blockhash). Anyone may then submit the proof with
+ * @dev fulfillRandomWords, along with the RequestCommitment it is checked
+ * @dev against. The random words are passed to the consumer's callback, and
+ * @dev the subscription is charged for the gas of the fulfillment at the
+ * @dev LINK/ETH price of linkEthFeed, plus a flat fee, which is credited to the
+ * @dev oracle.
+ */
+contract VRFCoordinatorV2 is VRF, ConfirmedOwner, VRFCoordinatorV2Interface {
+  LinkTokenInterface public immutable LINK;
+  AggregatorV3Interface public immutable LINK_ETH_FEED;
+  BlockhashStoreInterface public immutable BLOCKHASH_STORE;
+
+  // The most consumers a subscription may have, bounding the gas of its
+  // cancellation
+  uint16 public constant MAX_CONSUMERS = 100;
+  // The most words a request may ask for
+  uint32 public constant MAX_NUM_WORDS = 500;
+  // The most confirmations a request may ask for, past which the block hash of
+  // the request may only be available from the BLOCKHASH_STORE
+  uint16 public constant MAX_REQUEST_CONFIRMATIONS = 200;
+  // Gas reserved for the checks of callWithExactGas
+  uint256 private constant GAS_FOR_CALL_EXACT_CHECK = 5_000;
+
+  struct Subscription {
+    uint96 balance; // Juels
+    uint64 reqCount; // Fulfilled requests
+    address owner;
+    address[] consumers;
+  }
+  // The current nonce of every consumer of a subscription, 0 if it is not a
+  // consumer of the subscription
+  mapping(address => mapping(uint64 => uint64)) private s_consumers;
+  mapping(uint64 => Subscription) private s_subscriptions;
+  uint64 private s_currentSubId;
+
+  // The oracle of every registered proving key, by key hash
+  mapping(bytes32 => address) private s_provingKeys;
+  bytes32[] private s_provingKeyHashes;
+  mapping(address => uint96) private s_withdrawableTokens;
+  // The hash of the RequestCommitment of every pending request
+  mapping(uint256 => bytes32) private s_requestCommitments;
+
+  struct Config {
+    uint16 minimumRequestConfirmations;
+    uint32 maxGasLimit;
+    // Gas used by fulfillRandomWords after the payment is calculated, which
+    // the payment accounts for
+    uint32 gasAfterPaymentCalculation;
+    // Flat fee of a fulfillment, in millionths of LINK
+    uint32 fulfillmentFlatFeeLinkPPM;
+    // Set while the callback of a fulfillment runs, so that it cannot make
+    // requests or change subscriptions
+    bool reentrancyLock;
+  }
+  Config private s_config;
+
+  // RequestCommitment is what a request is checked against when it is
+  // fulfilled, its hash being stored when it is made
+  struct RequestCommitment {
+    uint64 blockNum;
+    uint64 subId;
+    uint32 callbackGasLimit;
+    uint32 numWords;
+    address sender;
+  }
+
+  event ConfigSet(
+    uint16 minimumRequestConfirmations,
+    uint32 maxGasLimit,
+    uint32 gasAfterPaymentCalculation,
+    uint32 fulfillmentFlatFeeLinkPPM
+  );
+  event ProvingKeyRegistered(bytes32 keyHash, address indexed oracle);
+  event RandomWordsRequested(
+    bytes32 indexed keyHash,
+    uint256 requestId,
+    uint256 preSeed,
+    uint64 indexed subId,
+    uint16 minimumRequestConfirmations,
+    uint32 callbackGasLimit,
+    uint32 numWords,
+    address indexed sender
+  );
+  event RandomWordsFulfilled(uint256 indexed requestId, uint256 outputSeed, uint96 payment, bool success);
+  event SubscriptionCreated(uint64 indexed subId, address owner);
+  event SubscriptionFunded(uint64 indexed subId, uint256 oldBalance, uint256 newBalance);
+  event SubscriptionConsumerAdded(uint64 indexed subId, address consumer);
+  event SubscriptionCanceled(uint64 indexed subId, address to, uint256 amount);
+
+  constructor(
+    address link,
+    address blockhashStore,
+    address linkEthFeed
+  ) ConfirmedOwner(msg.sender) {
+    LINK = LinkTokenInterface(link);
+    LINK_ETH_FEED = AggregatorV3Interface(linkEthFeed);
+    BLOCKHASH_STORE = BlockhashStoreInterface(blockhashStore);
+  }
+
+  modifier nonReentrant() {
+    require(!s_config.reentrancyLock, "reentrant call");
+    _;
+  }
+
+  modifier onlySubOwner(uint64 subId) {
+    address owner = s_subscriptions[subId].owner;
+    require(owner != address(0), "invalid subscription");
+    require(msg.sender == owner, "must be subscription owner");
+    _;
+  }
+
+  /**
+   * @notice Sets the configuration of the coordinator
+   * @param minimumRequestConfirmations the least number of confirmations a
+   * request may ask for
+   * @param maxGasLimit the largest callback gas limit a request may ask for
+   * @param gasAfterPaymentCalculation the gas used after the payment of a
+   * fulfillment is calculated
+   * @param fulfillmentFlatFeeLinkPPM the flat fee of a fulfillment, in
+   * millionths of LINK
+   */
+  function setConfig(
+    uint16 minimumRequestConfirmations,
+    uint32 maxGasLimit,
+    uint32 gasAfterPaymentCalculation,
+    uint32 fulfillmentFlatFeeLinkPPM
+  ) external onlyOwner {
+    require(minimumRequestConfirmations <= MAX_REQUEST_CONFIRMATIONS, "too many confirmations");
+    s_config = Config({
+      minimumRequestConfirmations: minimumRequestConfirmations,
+      maxGasLimit: maxGasLimit,
+      gasAfterPaymentCalculation: gasAfterPaymentCalculation,
+      fulfillmentFlatFeeLinkPPM: fulfillmentFlatFeeLinkPPM,
+      reentrancyLock: false
+    });
+    emit ConfigSet(minimumRequestConfirmations, maxGasLimit, gasAfterPaymentCalculation, fulfillmentFlatFeeLinkPPM);
+  }
+
+  function getConfig()
+    external
+    view
+    returns (
+      uint16 minimumRequestConfirmations,
+      uint32 maxGasLimit,
+      uint32 gasAfterPaymentCalculation,
+      uint32 fulfillmentFlatFeeLinkPPM
+    )
+  {
+    return (
+      s_config.minimumRequestConfirmations,
+      s_config.maxGasLimit,
+      s_config.gasAfterPaymentCalculation,
+      s_config.fulfillmentFlatFeeLinkPPM
+    );
+  }
+
+  /**
+   * @notice Registers a proving key, whose fulfillments are paid to oracle
+   * @param oracle the address credited with the payments of the key
+   * @param publicProvingKey the key's public key, as an uncompressed point
+   */
+  function registerProvingKey(address oracle, uint256[2] calldata publicProvingKey) external onlyOwner {
+    bytes32 kh = hashOfKey(publicProvingKey);
+    require(s_provingKeys[kh] == address(0), "proving key already registered");
+    s_provingKeys[kh] = oracle;
+    s_provingKeyHashes.push(kh);
+    emit ProvingKeyRegistered(kh, oracle);
+  }
+
+  /**
+   * @notice Returns the hash of a public proving key, as used in requests
+   * @param publicKey the key as an uncompressed point
+   */
+  function hashOfKey(uint256[2] memory publicKey) public pure returns (bytes32) {
+    return keccak256(abi.encode(publicKey));
+  }
+
+  /**
+   * @inheritdoc VRFCoordinatorV2Interface
+   */
+  function getRequestConfig()
+    external
+    view
+    override
+    returns (
+      uint16,
+      uint32,
+      bytes32[] memory
+    )
+  {
+    return (s_config.minimumRequestConfirmations, s_config.maxGasLimit, s_provingKeyHashes);
+  }
+
+  /**
+   * @inheritdoc VRFCoordinatorV2Interface
+   */
+  function requestRandomWords(
+    bytes32 keyHash,
+    uint64 subId,
+    uint16 requestConfirmations,
+    uint32 callbackGasLimit,
+    uint32 numWords
+  ) external override nonReentrant returns (uint256) {
+    require(s_subscriptions[subId].owner != address(0), "invalid subscription");
+    uint64 currentNonce = s_consumers[msg.sender][subId];
+    require(currentNonce != 0, "invalid consumer");
+    require(
+      requestConfirmations >= s_config.minimumRequestConfirmations &&
+        requestConfirmations <= MAX_REQUEST_CONFIRMATIONS,
+      "invalid request confirmations"
+    );
+    require(callbackGasLimit <= s_config.maxGasLimit, "callback gas limit too big");
+    require(numWords <= MAX_NUM_WORDS, "too many words");
+
+    // The pre-seed is unique to the consumer and subscription, so that the
+    // request id it determines along with the key hash is too
+    uint64 nonce = currentNonce + 1;
+    uint256 preSeed = uint256(keccak256(abi.encode(keyHash, msg.sender, subId, nonce)));
+    uint256 requestId = uint256(keccak256(abi.encode(keyHash, preSeed)));
+
+    s_requestCommitments[requestId] = keccak256(
+      abi.encode(requestId, block.number, subId, callbackGasLimit, numWords, msg.sender)
+    );
+    emit RandomWordsRequested(
+      keyHash,
+      requestId,
+      preSeed,
+      subId,
+      requestConfirmations,
+      callbackGasLimit,
+      numWords,
+      msg.sender
+    );
+    s_consumers[msg.sender][subId] = nonce;
+    return requestId;
+  }
+
+  /**
+   * @notice Returns the hash of the RequestCommitment of a pending request, or
+   * zero if it was fulfilled or never made
+   */
+  function getCommitment(uint256 requestId) external view returns (bytes32) {
+    return s_requestCommitments[requestId];
+  }
+
+  /**
+   * @notice Fulfills a request with a proof for its final seed
+   * @param proof the proof, whose seed is the pre-seed of the request
+   * @param rc the commitment of the request, from its RandomWordsRequested log
+   * @return payment the LINK the subscription was charged, in juels
+   * @dev The fulfillment succeeds even if the callback of the consumer reverts,
+   * @dev but reverts if the subscription cannot pay for it.
+   */
+  function fulfillRandomWords(VRF.Proof memory proof, RequestCommitment memory rc)
+    external
+    nonReentrant
+    returns (uint96)
+  {
+    uint256 startGas = gasleft();
+    (bytes32 keyHash, uint256 requestId, uint256 randomness) = getRandomnessFromProof(proof, rc);
+
+    uint256[] memory randomWords = new uint256[](rc.numWords);
+    for (uint256 i = 0; i < rc.numWords; i++) {
+      randomWords[i] = uint256(keccak256(abi.encode(randomness, i)));
+    }
+
+    delete s_requestCommitments[requestId];
+    bytes memory resp = abi.encodeWithSelector(
+      VRFConsumerBaseV2.rawFulfillRandomWords.selector,
+      requestId,
+      randomWords
+    );
+    s_config.reentrancyLock = true;
+    bool success = callWithExactGas(rc.callbackGasLimit, rc.sender, resp);
+    s_config.reentrancyLock = false;
+
+    s_subscriptions[rc.subId].reqCount += 1;
+    uint96 payment = calculatePaymentAmount(
+      startGas,
+      s_config.gasAfterPaymentCalculation,
+      s_config.fulfillmentFlatFeeLinkPPM,
+      tx.gasprice
+    );
+    require(s_subscriptions[rc.subId].balance >= payment, "insufficient balance");
+    s_subscriptions[rc.subId].balance -= payment;
+    s_withdrawableTokens[s_provingKeys[keyHash]] += payment;
+    emit RandomWordsFulfilled(requestId, randomness, payment, success);
+    return payment;
+  }
+
+  // getRandomnessFromProof checks the proof against the commitment of its
+  // request and returns the VRF output for the final seed of the request
+  function getRandomnessFromProof(VRF.Proof memory proof, RequestCommitment memory rc)
+    private
+    view
+    returns (
+      bytes32 keyHash,
+      uint256 requestId,
+      uint256 randomness
+    )
+  {
+    keyHash = hashOfKey(proof.pk);
+    require(s_provingKeys[keyHash] != address(0), "no such proving key");
+    requestId = uint256(keccak256(abi.encode(keyHash, proof.seed)));
+    bytes32 commitment = s_requestCommitments[requestId];
+    require(commitment != 0, "no corresponding request");
+    require(
+      commitment ==
+        keccak256(abi.encode(requestId, rc.blockNum, rc.subId, rc.callbackGasLimit, rc.numWords, rc.sender)),
+      "incorrect commitment"
+    );
+
+    bytes32 blockHash = blockhash(rc.blockNum);
+    if (blockHash == bytes32(0)) {
+      blockHash = BLOCKHASH_STORE.getBlockhash(rc.blockNum);
+      require(blockHash != bytes32(0), "blockhash not in store");
+    }
+    // The final seed of the request, as computed by FinalSeed on the node
+    uint256 actualSeed = uint256(keccak256(abi.encodePacked(proof.seed, blockHash)));
+    randomness = VRF.randomValueFromVRFProof(proof, actualSeed);
+  }
+
+  // calculatePaymentAmount returns the LINK for the gas used since startGas
+  // plus gasAfterPaymentCalculation at weiPerUnitGas, plus the flat fee
+  function calculatePaymentAmount(
+    uint256 startGas,
+    uint256 gasAfterPaymentCalculation,
+    uint32 fulfillmentFlatFeeLinkPPM,
+    uint256 weiPerUnitGas
+  ) internal view returns (uint96) {
+    (, int256 weiPerUnitLink, , , ) = LINK_ETH_FEED.latestRoundData();
+    require(weiPerUnitLink > 0, "invalid LINK wei price");
+    uint256 paymentNoFee = (1e18 * weiPerUnitGas * (gasAfterPaymentCalculation + startGas - gasleft())) /
+      uint256(weiPerUnitLink);
+    uint256 fee = 1e12 * uint256(fulfillmentFlatFeeLinkPPM);
+    require(paymentNoFee <= 1e27 - fee, "payment too large"); // The total LINK supply
+    return uint96(paymentNoFee + fee);
+  }
+
+  // callWithExactGas calls target with exactly gasAmount gas, reverting if
+  // there is not enough gas left to do so
+  function callWithExactGas(
+    uint256 gasAmount,
+    address target,
+    bytes memory data
+  ) private returns (bool success) {
+    // solhint-disable-next-line no-inline-assembly
+    assembly {
+      let g := gas()
+      // Compute g -= GAS_FOR_CALL_EXACT_CHECK and check for underflow
+      if lt(g, GAS_FOR_CALL_EXACT_CHECK) {
+        revert(0, 0)
+      }
+      g := sub(g, GAS_FOR_CALL_EXACT_CHECK)
+      // If g - g//64 <= gasAmount, the call would be given less than gasAmount
+      if iszero(gt(sub(g, div(g, 64)), gasAmount)) {
+        revert(0, 0)
+      }
+      // Calls to accounts without code succeed, which would hide a wrong sender
+      if iszero(extcodesize(target)) {
+        revert(0, 0)
+      }
+      success := call(gasAmount, target, 0, add(data, 0x20), mload(data), 0, 0)
+    }
+    return success;
+  }
+
+  /**
+   * @notice Withdraws the LINK credited to the caller for fulfillments
+   * @param recipient where to send the LINK
+   * @param amount how much LINK to send, in juels
+   */
+  function oracleWithdraw(address recipient, uint96 amount) external nonReentrant {
+    require(s_withdrawableTokens[msg.sender] >= amount, "insufficient balance");
+    s_withdrawableTokens[msg.sender] -= amount;
+    require(LINK.transfer(recipient, amount), "LINK transfer failed");
+  }
+
+  /**
+   * @notice Funds the subscription abi-encoded in data, called by the LINK
+   * token on transferAndCall
+   */
+  function onTokenTransfer(
+    address, /* sender */
+    uint256 amount,
+    bytes calldata data
+  ) external nonReentrant {
+    require(msg.sender == address(LINK), "only callable from LINK");
+    require(data.length == 32, "invalid calldata");
+    uint64 subId = abi.decode(data, (uint64));
+    require(s_subscriptions[subId].owner != address(0), "invalid subscription");
+    uint256 oldBalance = s_subscriptions[subId].balance;
+    // The total LINK supply fits in a uint96
+    s_subscriptions[subId].balance += uint96(amount);
+    emit SubscriptionFunded(subId, oldBalance, oldBalance + amount);
+  }
+
+  /**
+   * @inheritdoc VRFCoordinatorV2Interface
+   */
+  function getSubscription(uint64 subId)
+    external
+    view
+    override
+    returns (
+      uint96 balance,
+      uint64 reqCount,
+      address owner,
+      address[] memory consumers
+    )
+  {
+    Subscription storage sub = s_subscriptions[subId];
+    require(sub.owner != address(0), "invalid subscription");
+    return (sub.balance, sub.reqCount, sub.owner, sub.consumers);
+  }
+
+  /**
+   * @inheritdoc VRFCoordinatorV2Interface
+   */
+  function createSubscription() external override nonReentrant returns (uint64) {
+    s_currentSubId++;
+    uint64 subId = s_currentSubId;
+    s_subscriptions[subId].owner = msg.sender;
+    emit SubscriptionCreated(subId, msg.sender);
+    return subId;
+  }
+
+  /**
+   * @inheritdoc VRFCoordinatorV2Interface
+   */
+  function addConsumer(uint64 subId, address consumer) external override onlySubOwner(subId) nonReentrant {
+    if (s_consumers[consumer][subId] != 0) {
+      // Idempotent
+      return;
+    }
+    require(s_subscriptions[subId].consumers.length < MAX_CONSUMERS, "too many consumers");
+    // Nonces start at 1 so that 0 marks consumers which were not added
+    s_consumers[consumer][subId] = 1;
+    s_subscriptions[subId].consumers.push(consumer);
+    emit SubscriptionConsumerAdded(subId, consumer);
+  }
+
+  /**
+   * @inheritdoc VRFCoordinatorV2Interface
+   */
+  function cancelSubscription(uint64 subId, address to) external override onlySubOwner(subId) nonReentrant {
+    Subscription storage sub = s_subscriptions[subId];
+    uint96 balance = sub.balance;
+    for (uint256 i = 0; i < sub.consumers.length; i++) {
+      delete s_consumers[sub.consumers[i]][subId];
+    }
+    delete s_subscriptions[subId];
+    require(LINK.transfer(to, balance), "LINK transfer failed");
+    emit SubscriptionCanceled(subId, to, balance);
+  }
+}
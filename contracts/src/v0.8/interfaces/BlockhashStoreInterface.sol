@@ -0,0 +1,6 @@
+// SPDX-License-Identifier: MIT
+pragma solidity ^0.8.0;
+
+interface BlockhashStoreInterface {
+  function getBlockhash(uint256 number) external view returns (bytes32);
+}
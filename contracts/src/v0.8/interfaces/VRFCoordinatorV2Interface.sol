@@ -0,0 +1,82 @@
+// SPDX-License-Identifier: MIT
+pragma solidity ^0.8.0;
+
+interface VRFCoordinatorV2Interface {
+  /**
+   * @notice Returns the global config that applies to all VRF requests.
+   * @return minimumRequestConfirmations - the least number of confirmations a
+   * request may ask for
+   * @return maxGasLimit - the largest callback gas limit a request may ask for
+   * @return keyHashes - the hashes of the registered proving keys
+   */
+  function getRequestConfig()
+    external
+    view
+    returns (
+      uint16 minimumRequestConfirmations,
+      uint32 maxGasLimit,
+      bytes32[] memory keyHashes
+    );
+
+  /**
+   * @notice Request a set of random words.
+   * @param keyHash - the hash of the proving key to fulfill the request with
+   * @param subId - the subscription paying for the request, to which the
+   * caller must have been added as a consumer
+   * @param requestConfirmations - how many blocks the oracle waits before
+   * responding to the request
+   * @param callbackGasLimit - how much gas the callback to
+   * rawFulfillRandomWords is given
+   * @param numWords - the number of random words requested
+   * @return requestId - a unique identifier of the request, passed back to the
+   * callback along with the random words
+   */
+  function requestRandomWords(
+    bytes32 keyHash,
+    uint64 subId,
+    uint16 requestConfirmations,
+    uint32 callbackGasLimit,
+    uint32 numWords
+  ) external returns (uint256 requestId);
+
+  /**
+   * @notice Create a VRF subscription, owned by the caller.
+   * @return subId - the id of the subscription
+   * @dev The subscription is funded by sending LINK to the coordinator with
+   * @dev transferAndCall, abi-encoding the subId as the data.
+   */
+  function createSubscription() external returns (uint64 subId);
+
+  /**
+   * @notice Get a VRF subscription.
+   * @param subId - the id of the subscription
+   * @return balance - the LINK balance of the subscription in juels
+   * @return reqCount - the number of requests of the subscription fulfilled
+   * @return owner - the owner of the subscription
+   * @return consumers - the consumers allowed to make requests paid for by the
+   * subscription
+   */
+  function getSubscription(uint64 subId)
+    external
+    view
+    returns (
+      uint96 balance,
+      uint64 reqCount,
+      address owner,
+      address[] memory consumers
+    );
+
+  /**
+   * @notice Allow a consumer to make requests paid for by a subscription.
+   * @param subId - the id of the subscription
+   * @param consumer - the consumer to add
+   */
+  function addConsumer(uint64 subId, address consumer) external;
+
+  /**
+   * @notice Cancel a subscription, sending its remaining balance.
+   * @param subId - the id of the subscription
+   * @param to - where to send the remaining LINK
+   */
+  function cancelSubscription(uint64 subId, address to) external;
+}
@@ -0,0 +1,49 @@
+// SPDX-License-Identifier: MIT
+pragma solidity ^0.8.0;
+
+import "../interfaces/LinkTokenInterface.sol";
+import "../interfaces/VRFCoordinatorV2Interface.sol";
+import "../VRFConsumerBaseV2.sol";
+
+contract VRFConsumerV2 is VRFConsumerBaseV2 {
+  VRFCoordinatorV2Interface internal immutable COORDINATOR;
+  LinkTokenInterface internal immutable LINKTOKEN;
+
+  uint64 public s_subId;
+  uint256 public s_requestId;
+  uint256[] public s_randomWords;
+  uint256 public s_fulfillments;
+
+  constructor(address vrfCoordinator, address link) VRFConsumerBaseV2(vrfCoordinator) {
+    COORDINATOR = VRFCoordinatorV2Interface(vrfCoordinator);
+    LINKTOKEN = LinkTokenInterface(link);
+  }
+
+  function fulfillRandomWords(uint256 requestId, uint256[] memory randomWords) internal override {
+    s_requestId = requestId;
+    s_randomWords = randomWords;
+    s_fulfillments++;
+  }
+
+  // testCreateSubscription creates a subscription owned by this contract,
+  // with this contract as its only consumer
+  function testCreateSubscription() external returns (uint64) {
+    s_subId = COORDINATOR.createSubscription();
+    COORDINATOR.addConsumer(s_subId, address(this));
+    return s_subId;
+  }
+
+  // testFundSubscription funds the subscription with LINK held by this contract
+  function testFundSubscription(uint96 amount) external {
+    LINKTOKEN.transferAndCall(address(COORDINATOR), amount, abi.encode(s_subId));
+  }
+
+  function testRequestRandomness(
+    bytes32 keyHash,
+    uint16 minReqConfs,
+    uint32 callbackGasLimit,
+    uint32 numWords
+  ) external returns (uint256) {
+    return COORDINATOR.requestRandomWords(keyHash, s_subId, minReqConfs, callbackGasLimit, numWords);
+  }
+}
@@ -175,7 +175,7 @@ func (e *EthTx) insertEthTx(
 		gasLimit = e.GasLimit
 	}
 
-	if err := bulletprooftxmanager.CheckEthTxQueueCapacity(store.DB, fromAddress, store.Config.EthMaxQueuedTransactions()); err != nil {
+	if err := bulletprooftxmanager.CheckEthTxQueueCapacity(store.DB, nil, fromAddress, store.Config.EthMaxQueuedTransactions()); err != nil {
 		err = errors.Wrapf(err, "number of unconfirmed transactions exceeds ETH_MAX_QUEUED_TRANSACTIONS. %s", static.EthMaxQueuedTransactionsLabel)
 		logger.Error(err)
 		return models.NewRunOutputError(err)
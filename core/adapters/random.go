@@ -1,6 +1,7 @@
 package adapters
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/smartcontractkit/chainlink/core/services/vrf/proof"
@@ -87,7 +88,11 @@ func (ra *Random) Perform(input models.RunInput, store *store.Store, keyStore *k
 	if err != nil {
 		return models.NewRunOutputError(err)
 	}
-	solidityProof, err := proof.GenerateProofResponse(keyStore.VRF(), key, i)
+	ctx := keystore.WithRequester(context.Background(), keystore.Requester{
+		Service: "RandomAdapter",
+		Purpose: fmt.Sprintf("job run %s", input.JobRunID()),
+	})
+	solidityProof, err := proof.GenerateProofResponse(ctx, keyStore.VRF(), key, i)
 	if err != nil {
 		return models.NewRunOutputError(err)
 	}
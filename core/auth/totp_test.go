@@ -0,0 +1,63 @@
+package auth_test
+
+import (
+	"encoding/base32"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/auth"
+)
+
+func TestTOTPCode(t *testing.T) {
+	t.Parallel()
+
+	// The SHA1 test vectors of RFC 6238, truncated to 6 digits
+	secret := base32.StdEncoding.EncodeToString([]byte("12345678901234567890"))
+	tests := []struct {
+		unix int64
+		code string
+	}{
+		{59, "287082"},
+		{1111111109, "081804"},
+		{1111111111, "050471"},
+		{1234567890, "005924"},
+		{2000000000, "279037"},
+	}
+	for _, test := range tests {
+		code, err := auth.TOTPCode(secret, time.Unix(test.unix, 0))
+		require.NoError(t, err)
+		assert.Equal(t, test.code, code)
+	}
+
+	_, err := auth.TOTPCode("not base32!", time.Now())
+	assert.Error(t, err)
+}
+
+func TestValidateTOTP(t *testing.T) {
+	t.Parallel()
+
+	secret, err := auth.NewTOTPSecret()
+	require.NoError(t, err)
+	now := time.Now()
+	code, err := auth.TOTPCode(secret, now)
+	require.NoError(t, err)
+
+	assert.True(t, auth.ValidateTOTP(secret, code, now))
+	assert.True(t, auth.ValidateTOTP(secret, code, now.Add(30*time.Second)))
+	assert.False(t, auth.ValidateTOTP(secret, code, now.Add(2*time.Minute)))
+	assert.False(t, auth.ValidateTOTP(secret, "", now))
+	assert.False(t, auth.ValidateTOTP(secret, "12345", now))
+}
+
+func TestTOTPURI(t *testing.T) {
+	t.Parallel()
+
+	uri := auth.TOTPURI("SECRET", "user@chainlink.test")
+	assert.True(t, strings.HasPrefix(uri, "otpauth://totp/Chainlink:user@chainlink.test?"))
+	assert.Contains(t, uri, "secret=SECRET")
+	assert.Contains(t, uri, "issuer=Chainlink")
+}
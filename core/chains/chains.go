@@ -12,11 +12,30 @@ import (
 // 1. Add the global var in the vars list
 // 2. Add the chain ID in the map in the init() function
 // 3. Add a config set in configs.go
+//
+// Chains can also be added or configured at runtime, without a release, by
+// adding them to the chain registry, see registry.go
+
+const (
+	// L2TypeArbitrum is the L2Type of Arbitrum chains
+	L2TypeArbitrum = "arbitrum"
+	// L2TypeOptimism is the L2Type of Optimism chains
+	L2TypeOptimism = "optimism"
+)
 
 // Chain represents a blockchain with a unique Chain ID
 type Chain struct {
-	id      *big.Int
-	config  ChainSpecificConfig
+	id *big.Int
+
+	mu     sync.RWMutex
+	config ChainSpecificConfig
+	// builtin is the config of the chain in configs.go, which the overrides
+	// of the chain registry apply to
+	builtin ChainSpecificConfig
+	// overrides are the settings of the chain in the chain registry, which
+	// take precedence over the environment
+	overrides ChainOverrides
+
 	logOnce sync.Once
 }
 
@@ -29,6 +48,8 @@ func (c *Chain) ID() *big.Int {
 }
 
 func (c *Chain) Config() ChainSpecificConfig {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
 	if !c.config.set {
 		c.logOnce.Do(func() {
 			logger.Warnf("chain with ID %s does not have a chain-specific config, using fallback config instead", c.ID())
@@ -38,14 +59,24 @@ func (c *Chain) Config() ChainSpecificConfig {
 	return c.config
 }
 
-// IsArbitrum returns true if the chain is arbitrum mainnet or testnet
+// Overrides returns the settings of the chain in the chain registry, which
+// take precedence over the global environment variables
+func (c *Chain) Overrides() ChainOverrides {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.overrides
+}
+
+// IsArbitrum returns true if the chain is an arbitrum chain, e.g. arbitrum
+// mainnet or testnet
 func (c *Chain) IsArbitrum() bool {
-	return c.ID().Cmp(ArbitrumMainnet.ID()) == 0 || c.ID().Cmp(ArbitrumRinkeby.ID()) == 0
+	return c.Config().L2Type == L2TypeArbitrum
 }
 
-// IsOptimism returns true if the chain is optimism mainnet or testnet
+// IsOptimism returns true if the chain is an optimism chain, e.g. optimism
+// mainnet or testnet
 func (c *Chain) IsOptimism() bool {
-	return c.ID().Cmp(OptimismMainnet.ID()) == 0 || c.ID().Cmp(OptimismKovan.ID()) == 0
+	return c.Config().L2Type == L2TypeOptimism
 }
 
 // IsL2 returns true if this chain is an L2 chain, notably that the block
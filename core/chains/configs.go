@@ -11,32 +11,39 @@ type (
 
 	// ChainSpecificConfig lists the config defaults specific to a particular chain ID
 	ChainSpecificConfig struct {
-		BlockHistoryEstimatorBatchSize        uint32
-		BlockHistoryEstimatorBlockDelay       uint16
-		BlockHistoryEstimatorBlockHistorySize uint16
-		EnableLegacyJobPipeline               bool
-		EthBalanceMonitorBlockDelay           uint16
-		EthFinalityDepth                      uint
-		EthGasBumpThreshold                   uint64
-		EthGasBumpWei                         big.Int
-		EthGasLimitDefault                    uint64
-		EthGasLimitTransfer                   uint64
-		EthGasPriceDefault                    big.Int
-		EthHeadTrackerHistoryDepth            uint
-		EthHeadTrackerSamplingInterval        time.Duration
-		BlockEmissionIdleWarningThreshold     time.Duration
-		EthMaxGasPriceWei                     big.Int
-		EthMaxInFlightTransactions            uint32
-		EthMaxQueuedTransactions              uint64
-		EthMinGasPriceWei                     big.Int
-		EthTxResendAfterThreshold             time.Duration
-		GasEstimatorMode                      string
-		LinkContractAddress                   string
-		MinIncomingConfirmations              uint32
-		MinRequiredOutgoingConfirmations      uint64
-		MinimumContractPayment                *assets.Link
-		OCRContractConfirmations              uint16
-		set                                   bool
+		BlockHistoryEstimatorBatchSize             uint32
+		BlockHistoryEstimatorBlockDelay            uint16
+		BlockHistoryEstimatorBlockHistorySize      uint16
+		BlockHistoryEstimatorTransactionPercentile uint16
+		// BlockTime is the average time between blocks of the chain
+		BlockTime                         time.Duration
+		EnableLegacyJobPipeline           bool
+		EthBalanceMonitorBlockDelay       uint16
+		EthFinalityDepth                  uint
+		EthGasBumpThreshold               uint64
+		EthGasBumpWei                     big.Int
+		EthGasLimitDefault                uint64
+		EthGasLimitTransfer               uint64
+		EthGasPriceDefault                big.Int
+		EthHeadTrackerHistoryDepth        uint
+		EthHeadTrackerSamplingBlocks      uint
+		EthHeadTrackerSamplingInterval    time.Duration
+		BlockEmissionIdleWarningThreshold time.Duration
+		EthMaxGasPriceWei                 big.Int
+		EthMaxInFlightTransactions        uint32
+		EthMaxQueuedTransactions          uint64
+		EthMinGasPriceWei                 big.Int
+		EthTxResendAfterThreshold         time.Duration
+		EthUseFinalityTag                 bool
+		GasEstimatorMode                  string
+		// L2Type is the kind of L2 the chain is, or empty for an L1
+		L2Type                           string
+		LinkContractAddress              string
+		MinIncomingConfirmations         uint32
+		MinRequiredOutgoingConfirmations uint64
+		MinimumContractPayment           *assets.Link
+		OCRContractConfirmations         uint16
+		set                              bool
 	}
 )
 
@@ -57,47 +64,55 @@ func setConfigs() {
 	// See: https://app.clubhouse.io/chainlinklabs/story/11091/chain-configs-should-move-to-toml-json-files
 
 	FallbackConfig = ChainSpecificConfig{
-		BlockHistoryEstimatorBatchSize:        4, // FIXME: Workaround `websocket: read limit exceeded` until https://app.clubhouse.io/chainlinklabs/story/6717/geth-websockets-can-sometimes-go-bad-under-heavy-load-proposal-for-eth-node-balancer
-		BlockHistoryEstimatorBlockDelay:       1,
-		BlockHistoryEstimatorBlockHistorySize: 24,
-		EnableLegacyJobPipeline:               false,
-		EthBalanceMonitorBlockDelay:           1,
-		EthFinalityDepth:                      50,
-		EthGasBumpThreshold:                   3,
-		EthGasBumpWei:                         *assets.GWei(5),
-		EthGasLimitDefault:                    500000,
-		EthGasLimitTransfer:                   21000,
-		EthGasPriceDefault:                    *assets.GWei(20),
-		EthHeadTrackerHistoryDepth:            100,
-		EthHeadTrackerSamplingInterval:        1 * time.Second,
-		BlockEmissionIdleWarningThreshold:     1 * time.Minute,
-		EthMaxGasPriceWei:                     *assets.GWei(5000),
-		EthMaxInFlightTransactions:            16,
-		EthMaxQueuedTransactions:              250,
-		EthMinGasPriceWei:                     *assets.GWei(1),
-		EthTxResendAfterThreshold:             1 * time.Minute,
-		GasEstimatorMode:                      "BlockHistory",
-		LinkContractAddress:                   "",
-		MinIncomingConfirmations:              3,
-		MinRequiredOutgoingConfirmations:      12,
-		MinimumContractPayment:                assets.NewLink(100000000000000), // 0.0001 LINK
-		OCRContractConfirmations:              4,
-		set:                                   true,
+		BlockHistoryEstimatorBatchSize:             4, // FIXME: Workaround `websocket: read limit exceeded` until https://app.clubhouse.io/chainlinklabs/story/6717/geth-websockets-can-sometimes-go-bad-under-heavy-load-proposal-for-eth-node-balancer
+		BlockHistoryEstimatorBlockDelay:            1,
+		BlockHistoryEstimatorBlockHistorySize:      24,
+		BlockHistoryEstimatorTransactionPercentile: 60,
+		BlockTime:                         15 * time.Second,
+		EnableLegacyJobPipeline:           false,
+		EthBalanceMonitorBlockDelay:       1,
+		EthFinalityDepth:                  50,
+		EthGasBumpThreshold:               3,
+		EthGasBumpWei:                     *assets.GWei(5),
+		EthGasLimitDefault:                500000,
+		EthGasLimitTransfer:               21000,
+		EthGasPriceDefault:                *assets.GWei(20),
+		EthHeadTrackerHistoryDepth:        100,
+		EthHeadTrackerSamplingBlocks:      0,
+		EthHeadTrackerSamplingInterval:    1 * time.Second,
+		BlockEmissionIdleWarningThreshold: 1 * time.Minute,
+		EthMaxGasPriceWei:                 *assets.GWei(5000),
+		EthMaxInFlightTransactions:        16,
+		EthMaxQueuedTransactions:          250,
+		EthMinGasPriceWei:                 *assets.GWei(1),
+		EthTxResendAfterThreshold:         1 * time.Minute,
+		EthUseFinalityTag:                 false,
+		GasEstimatorMode:                  "BlockHistory",
+		L2Type:                            "",
+		LinkContractAddress:               "",
+		MinIncomingConfirmations:          3,
+		MinRequiredOutgoingConfirmations:  12,
+		MinimumContractPayment:            assets.NewLink(100000000000000), // 0.0001 LINK
+		OCRContractConfirmations:          4,
+		set:                               true,
 	}
 
 	mainnet := FallbackConfig
 	mainnet.EnableLegacyJobPipeline = true
 	mainnet.LinkContractAddress = "0x514910771AF9Ca656af840dff83E8264EcF986CA"
 	mainnet.MinimumContractPayment = assets.NewLink(1000000000000000000) // 1 LINK
+	mainnet.EthUseFinalityTag = true                                     // Post-merge Ethereum exposes the `finalized` and `safe` block tags
 	// NOTE: There are probably other variables we can tweak for Kovan and other
 	// test chains, but the defaults have been working fine and if it ain't
 	// broke, don't fix it.
 	kovan := mainnet
 	kovan.LinkContractAddress = "0xa36085F69e2889c224210F603D836748e7dC0088"
+	kovan.EthUseFinalityTag = false // Kovan never merged
 	goerli := mainnet
 	goerli.LinkContractAddress = "0x326c977e6efc84e512bb9c30f76e30c160ed06fb"
 	rinkeby := mainnet
 	rinkeby.LinkContractAddress = "0x01BE23585060835E02B77ef475b0Cc51aA1e0709"
+	rinkeby.EthUseFinalityTag = false // Rinkeby never merged
 
 	// xDai currently uses AuRa (like Parity) consensus so finality rules will be similar to parity
 	// See: https://www.poa.network/for-users/whitepaper/poadao-v1/proof-of-authority
@@ -107,17 +122,23 @@ func setConfigs() {
 	// With xDai's current maximum of 19 validators then 40 blocks is the maximum possible re-org)
 	// The mainnet default of 50 blocks is ok here
 	xDaiMainnet := FallbackConfig
+	xDaiMainnet.BlockTime = 5 * time.Second
 	xDaiMainnet.EnableLegacyJobPipeline = true
 	xDaiMainnet.EthGasBumpThreshold = 3 // 15s delay since feeds update every minute in volatile situations
 	xDaiMainnet.EthGasPriceDefault = *assets.GWei(1)
 	xDaiMainnet.EthMinGasPriceWei = *assets.GWei(1) // 1 Gwei is the minimum accepted by the validators (unless whitelisted)
 	xDaiMainnet.EthMaxGasPriceWei = *assets.GWei(500)
 	xDaiMainnet.LinkContractAddress = "0xE2e73A1c69ecF83F464EFCE6A5be353a37cA09b2"
+	// Ordinary transactions on xDai are almost always priced at the 1 Gwei
+	// minimum, so sampling blocks only risks picking up the 0-priced bridge
+	// transactions. Use the constant price instead.
+	xDaiMainnet.GasEstimatorMode = "FixedPrice"
 
 	// BSC uses Clique consensus with ~3s block times
 	// Clique offers finality within (N/2)+1 blocks where N is number of signers
 	// There are 21 BSC validators so theoretically finality should occur after 21/2+1 = 11 blocks
 	bscMainnet := FallbackConfig
+	bscMainnet.BlockTime = 3 * time.Second
 	bscMainnet.EnableLegacyJobPipeline = true
 	bscMainnet.EthBalanceMonitorBlockDelay = 2
 	bscMainnet.EthFinalityDepth = 50   // Keeping this >> 11 because it's not expensive and gives us a safety margin
@@ -140,6 +161,7 @@ func setConfigs() {
 	// Polygon has a 1s block time and looser finality guarantees than Ethereum.
 	// Re-orgs have been observed at 64 blocks or even deeper
 	polygonMainnet := FallbackConfig
+	polygonMainnet.BlockTime = 2 * time.Second
 	polygonMainnet.EnableLegacyJobPipeline = true
 	polygonMainnet.EthBalanceMonitorBlockDelay = 13 // equivalent of 1 eth block seems reasonable
 	polygonMainnet.EthFinalityDepth = 200           // A sprint is 64 blocks long and doesn't guarantee finality. To be safe we take three sprints (192 blocks) plus a safety margin
@@ -154,6 +176,7 @@ func setConfigs() {
 	polygonMainnet.EthTxResendAfterThreshold = 5 * time.Minute // 5 minutes is roughly 300 blocks on Polygon. Since re-orgs occur often and can be deep we want to avoid overloading the node with a ton of re-sent unconfirmed transactions.
 	polygonMainnet.BlockHistoryEstimatorBlockDelay = 10
 	polygonMainnet.BlockHistoryEstimatorBlockHistorySize = 24
+	polygonMainnet.BlockHistoryEstimatorTransactionPercentile = 70 // Polygon blocks are often full during spikes, so price above the median to get included promptly
 	polygonMainnet.LinkContractAddress = "0xb0897686c545045afc77cf20ec7a532e3120e0f1"
 	polygonMainnet.MinIncomingConfirmations = 5
 	polygonMainnet.MinRequiredOutgoingConfirmations = 12
@@ -162,6 +185,7 @@ func setConfigs() {
 
 	// Arbitrum is an L2 chain. Pending proper L2 support, for now we rely on their sequencer
 	arbitrumMainnet := FallbackConfig
+	arbitrumMainnet.BlockTime = 1 * time.Second
 	arbitrumMainnet.EthGasBumpThreshold = 0 // Disable gas bumping on arbitrum
 	arbitrumMainnet.EthGasLimitDefault = 7000000
 	arbitrumMainnet.EthGasLimitTransfer = 800000            // estimating gas returns 695,344 so 800,000 should be safe with some buffer
@@ -169,6 +193,7 @@ func setConfigs() {
 	arbitrumMainnet.EthMaxGasPriceWei = *assets.GWei(1000)  // Fix the gas price
 	arbitrumMainnet.EthMinGasPriceWei = *assets.GWei(1000)  // Fix the gas price
 	arbitrumMainnet.GasEstimatorMode = "FixedPrice"
+	arbitrumMainnet.L2Type = L2TypeArbitrum
 	arbitrumMainnet.BlockHistoryEstimatorBlockHistorySize = 0 // Force an error if someone set GAS_UPDATER_ENABLED=true by accident; we never want to run the block history estimator on arbitrum
 	arbitrumMainnet.LinkContractAddress = "0xf97f4df75117a78c1A5a0DBb814Af92458539FB4"
 	arbitrumMainnet.OCRContractConfirmations = 1
@@ -177,6 +202,7 @@ func setConfigs() {
 
 	// Optimism is an L2 chain. Pending proper L2 support, for now we rely on their sequencer
 	optimismMainnet := FallbackConfig
+	optimismMainnet.BlockTime = 1 * time.Second
 	optimismMainnet.EthBalanceMonitorBlockDelay = 0
 	optimismMainnet.EthFinalityDepth = 1    // Sequencer offers absolute finality as long as no re-org longer than 20 blocks occurs on main chain this event would require special handling (new txm)
 	optimismMainnet.EthGasBumpThreshold = 0 // Never bump gas on optimism
@@ -186,6 +212,7 @@ func setConfigs() {
 	optimismMainnet.EthTxResendAfterThreshold = 15 * time.Second
 	optimismMainnet.BlockHistoryEstimatorBlockHistorySize = 0 // Force an error if someone set GAS_UPDATER_ENABLED=true by accident; we never want to run the block history estimator on optimism
 	optimismMainnet.GasEstimatorMode = "Optimism"
+	optimismMainnet.L2Type = L2TypeOptimism
 	optimismMainnet.LinkContractAddress = "" // TBD
 	optimismMainnet.MinIncomingConfirmations = 1
 	optimismMainnet.MinRequiredOutgoingConfirmations = 0
@@ -217,6 +244,7 @@ func setConfigs() {
 
 	// Avalanche
 	avalancheMainnet := FallbackConfig
+	avalancheMainnet.BlockTime = 2 * time.Second
 	avalancheMainnet.LinkContractAddress = "0x350a791Bfc2C21F9Ed5d10980Dad2e2638ffa7f6" // TBD
 	avalancheMainnet.EthFinalityDepth = 1
 	avalancheMainnet.GasEstimatorMode = "FixedPrice"
@@ -249,4 +277,10 @@ func setConfigs() {
 	RSKTestnet.config = rskTestnet
 	AvalancheFuji.config = avalancheFuji
 	AvalancheMainnet.config = avalancheMainnet
+
+	// The built-in configs are kept to restore them once the overrides of a
+	// chain are removed from the registry
+	for _, chain := range chains {
+		chain.builtin = chain.config
+	}
 }
@@ -0,0 +1,246 @@
+package chains
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/store/models"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+// ChainOverrides are the settings of a chain in the chain registry. They
+// override the built-in config of the chain, or FallbackConfig for chains
+// without one, so that new chains can be supported without a release. Unset
+// fields keep their built-in value.
+//
+// The fields named after a config variable also take precedence over its
+// environment variable for the chain, so the settings are resolved chain >
+// env > default.
+type ChainOverrides struct {
+	BlockBackfillDepth               *uint64          `json:"blockBackfillDepth,omitempty"`
+	BlockTime                        *models.Duration `json:"blockTime,omitempty"`
+	EthFinalityDepth                 *uint32          `json:"ethFinalityDepth,omitempty"`
+	EthGasBumpPercent                *uint16          `json:"ethGasBumpPercent,omitempty"`
+	EthGasBumpThreshold              *uint64          `json:"ethGasBumpThreshold,omitempty"`
+	EthGasBumpTxDepth                *uint16          `json:"ethGasBumpTxDepth,omitempty"`
+	EthGasBumpWei                    *utils.Big       `json:"ethGasBumpWei,omitempty"`
+	EthGasLimitDefault               *uint64          `json:"ethGasLimitDefault,omitempty"`
+	EthGasPriceDefault               *utils.Big       `json:"ethGasPriceDefault,omitempty"`
+	EthHeadTrackerHistoryDepth       *uint32          `json:"ethHeadTrackerHistoryDepth,omitempty"`
+	EthMaxGasPriceWei                *utils.Big       `json:"ethMaxGasPriceWei,omitempty"`
+	EthMaxInFlightTransactions       *uint32          `json:"ethMaxInFlightTransactions,omitempty"`
+	EthMaxQueuedTransactions         *uint64          `json:"ethMaxQueuedTransactions,omitempty"`
+	EthMinGasPriceWei                *utils.Big       `json:"ethMinGasPriceWei,omitempty"`
+	EthTxResendAfterThreshold        *models.Duration `json:"ethTxResendAfterThreshold,omitempty"`
+	GasEstimatorMode                 *string          `json:"gasEstimatorMode,omitempty"`
+	L2Type                           *string          `json:"l2Type,omitempty"`
+	LinkContractAddress              *string          `json:"linkContractAddress,omitempty"`
+	MinIncomingConfirmations         *uint32          `json:"minIncomingConfirmations,omitempty"`
+	MinRequiredOutgoingConfirmations *uint64          `json:"minRequiredOutgoingConfirmations,omitempty"`
+}
+
+// Validate checks that the overrides are consistent
+func (o ChainOverrides) Validate() error {
+	if o.L2Type != nil {
+		switch *o.L2Type {
+		case "", L2TypeArbitrum, L2TypeOptimism:
+		default:
+			return errors.Errorf("invalid l2Type '%s', must be empty, %s or %s", *o.L2Type, L2TypeArbitrum, L2TypeOptimism)
+		}
+	}
+	if o.GasEstimatorMode != nil {
+		switch *o.GasEstimatorMode {
+		case "BlockHistory", "FixedPrice", "Optimism":
+		default:
+			return errors.Errorf("invalid gasEstimatorMode '%s', must be BlockHistory, FixedPrice or Optimism", *o.GasEstimatorMode)
+		}
+	}
+	if o.LinkContractAddress != nil && *o.LinkContractAddress != "" && !common.IsHexAddress(*o.LinkContractAddress) {
+		return errors.Errorf("invalid linkContractAddress '%s'", *o.LinkContractAddress)
+	}
+	if o.EthFinalityDepth != nil && *o.EthFinalityDepth == 0 {
+		return errors.New("ethFinalityDepth must be greater than 0")
+	}
+	if o.EthMinGasPriceWei != nil && o.EthMaxGasPriceWei != nil && o.EthMinGasPriceWei.ToInt().Cmp(o.EthMaxGasPriceWei.ToInt()) > 0 {
+		return errors.New("ethMinGasPriceWei must not be greater than ethMaxGasPriceWei")
+	}
+	return nil
+}
+
+// apply returns cfg with the overrides set
+func (o ChainOverrides) apply(cfg ChainSpecificConfig) ChainSpecificConfig {
+	if o.BlockTime != nil {
+		cfg.BlockTime = o.BlockTime.Duration()
+	}
+	if o.EthFinalityDepth != nil {
+		cfg.EthFinalityDepth = uint(*o.EthFinalityDepth)
+	}
+	if o.EthGasBumpThreshold != nil {
+		cfg.EthGasBumpThreshold = *o.EthGasBumpThreshold
+	}
+	if o.EthGasBumpWei != nil {
+		cfg.EthGasBumpWei = *new(big.Int).Set(o.EthGasBumpWei.ToInt())
+	}
+	if o.EthGasLimitDefault != nil {
+		cfg.EthGasLimitDefault = *o.EthGasLimitDefault
+	}
+	if o.EthGasPriceDefault != nil {
+		cfg.EthGasPriceDefault = *new(big.Int).Set(o.EthGasPriceDefault.ToInt())
+	}
+	if o.EthHeadTrackerHistoryDepth != nil {
+		cfg.EthHeadTrackerHistoryDepth = uint(*o.EthHeadTrackerHistoryDepth)
+	}
+	if o.EthMaxGasPriceWei != nil {
+		cfg.EthMaxGasPriceWei = *new(big.Int).Set(o.EthMaxGasPriceWei.ToInt())
+	}
+	if o.EthMaxInFlightTransactions != nil {
+		cfg.EthMaxInFlightTransactions = *o.EthMaxInFlightTransactions
+	}
+	if o.EthMaxQueuedTransactions != nil {
+		cfg.EthMaxQueuedTransactions = *o.EthMaxQueuedTransactions
+	}
+	if o.EthMinGasPriceWei != nil {
+		cfg.EthMinGasPriceWei = *new(big.Int).Set(o.EthMinGasPriceWei.ToInt())
+	}
+	if o.EthTxResendAfterThreshold != nil {
+		cfg.EthTxResendAfterThreshold = o.EthTxResendAfterThreshold.Duration()
+	}
+	if o.GasEstimatorMode != nil {
+		cfg.GasEstimatorMode = *o.GasEstimatorMode
+	}
+	if o.L2Type != nil {
+		cfg.L2Type = *o.L2Type
+	}
+	if o.LinkContractAddress != nil {
+		cfg.LinkContractAddress = *o.LinkContractAddress
+	}
+	if o.MinIncomingConfirmations != nil {
+		cfg.MinIncomingConfirmations = *o.MinIncomingConfirmations
+	}
+	if o.MinRequiredOutgoingConfirmations != nil {
+		cfg.MinRequiredOutgoingConfirmations = *o.MinRequiredOutgoingConfirmations
+	}
+	return cfg
+}
+
+// Value implements the driver.Valuer interface
+func (o ChainOverrides) Value() (driver.Value, error) {
+	return json.Marshal(o)
+}
+
+// Scan implements the sql.Scanner interface
+func (o *ChainOverrides) Scan(value interface{}) error {
+	b, ok := value.([]byte)
+	if !ok {
+		return errors.Errorf("unable to convert %v of %T to ChainOverrides", value, value)
+	}
+	return json.Unmarshal(b, o)
+}
+
+// SetOverrides configures the chain with the overrides applied to its
+// built-in config. Most settings are read by the services as they use them,
+// the others apply once the node restarts.
+func SetOverrides(id *big.Int, o ChainOverrides) {
+	chain := ChainFromID(id)
+	chain.mu.Lock()
+	defer chain.mu.Unlock()
+	base := chain.builtin
+	if !base.set {
+		base = FallbackConfig
+	}
+	chain.config = o.apply(base)
+	chain.overrides = o
+}
+
+// ResetOverrides restores the built-in config of the chain
+func ResetOverrides(id *big.Int) {
+	chain := ChainFromID(id)
+	chain.mu.Lock()
+	defer chain.mu.Unlock()
+	chain.config = chain.builtin
+	chain.overrides = ChainOverrides{}
+}
+
+// EVMChain is a chain in the chain registry
+type EVMChain struct {
+	ID        utils.Big
+	Cfg       ChainOverrides
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// TableName returns the name of the table of the chain registry
+func (EVMChain) TableName() string {
+	return "evm_chains"
+}
+
+// CreateChain adds a chain to the registry and configures it
+func CreateChain(db *gorm.DB, id *big.Int, cfg ChainOverrides) (chain EVMChain, err error) {
+	err = db.Raw(`
+INSERT INTO evm_chains (id, cfg, created_at, updated_at)
+VALUES (?, ?, NOW(), NOW())
+RETURNING *`, utils.NewBig(id), cfg).Scan(&chain).Error
+	if err != nil {
+		return chain, errors.Wrap(err, "CreateChain failed")
+	}
+	SetOverrides(id, cfg)
+	return chain, nil
+}
+
+// UpdateChain replaces the overrides of a chain in the registry and
+// configures it
+func UpdateChain(db *gorm.DB, id *big.Int, cfg ChainOverrides) (chain EVMChain, err error) {
+	res := db.Raw(`
+UPDATE evm_chains SET cfg = ?, updated_at = NOW()
+WHERE id = ?
+RETURNING *`, cfg, utils.NewBig(id)).Scan(&chain)
+	if res.Error != nil {
+		return chain, errors.Wrap(res.Error, "UpdateChain failed")
+	}
+	if res.RowsAffected == 0 {
+		return chain, gorm.ErrRecordNotFound
+	}
+	SetOverrides(id, cfg)
+	return chain, nil
+}
+
+// DeleteChain removes a chain from the registry and restores its built-in
+// config
+func DeleteChain(db *gorm.DB, id *big.Int) error {
+	res := db.Exec(`DELETE FROM evm_chains WHERE id = ?`, utils.NewBig(id))
+	if res.Error != nil {
+		return errors.Wrap(res.Error, "DeleteChain failed")
+	}
+	if res.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	ResetOverrides(id)
+	return nil
+}
+
+// FindChains returns the chains of the registry
+func FindChains(db *gorm.DB) (chains []EVMChain, err error) {
+	err = db.Raw(`SELECT * FROM evm_chains ORDER BY id ASC`).Scan(&chains).Error
+	return chains, errors.Wrap(err, "FindChains failed")
+}
+
+// LoadRegistry configures the chains of the registry. It is called when the
+// node starts.
+func LoadRegistry(db *gorm.DB) error {
+	registered, err := FindChains(db)
+	if err != nil {
+		return err
+	}
+	for _, c := range registered {
+		SetOverrides(c.ID.ToInt(), c.Cfg)
+		logger.Infow("Configured chain from the chain registry", "evmChainID", c.ID.String())
+	}
+	return nil
+}
@@ -0,0 +1,81 @@
+package chains_test
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/smartcontractkit/chainlink/core/chains"
+	"github.com/smartcontractkit/chainlink/core/store/models"
+	"github.com/smartcontractkit/chainlink/core/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_SetOverrides(t *testing.T) {
+	t.Run("overrides the built-in config of a chain", func(t *testing.T) {
+		id := big.NewInt(10) // Optimism mainnet
+		defer chains.ResetOverrides(id)
+		builtin := chains.ChainFromID(id).Config()
+
+		depth := uint32(7)
+		gasPrice := utils.NewBigI(42)
+		chains.SetOverrides(id, chains.ChainOverrides{EthFinalityDepth: &depth, EthGasPriceDefault: gasPrice})
+
+		cfg := chains.ChainFromID(id).Config()
+		assert.Equal(t, uint(7), cfg.EthFinalityDepth)
+		assert.Equal(t, big.NewInt(42), &cfg.EthGasPriceDefault)
+		assert.Equal(t, builtin.BlockTime, cfg.BlockTime)
+		assert.True(t, chains.ChainFromID(id).IsOptimism())
+
+		chains.ResetOverrides(id)
+		assert.Equal(t, builtin, chains.ChainFromID(id).Config())
+	})
+
+	t.Run("configures a chain without a built-in config from the fallback config", func(t *testing.T) {
+		id := big.NewInt(424242)
+		defer chains.ResetOverrides(id)
+
+		l2Type := chains.L2TypeArbitrum
+		blockTime := models.MustMakeDuration(250 * time.Millisecond)
+		chains.SetOverrides(id, chains.ChainOverrides{L2Type: &l2Type, BlockTime: &blockTime})
+
+		chain := chains.ChainFromID(id)
+		assert.True(t, chain.IsArbitrum())
+		assert.Equal(t, 250*time.Millisecond, chain.Config().BlockTime)
+		assert.Equal(t, chains.FallbackConfig.EthFinalityDepth, chain.Config().EthFinalityDepth)
+	})
+}
+
+func Test_ChainOverrides(t *testing.T) {
+	t.Run("round trips through JSON", func(t *testing.T) {
+		var o chains.ChainOverrides
+		require.NoError(t, json.Unmarshal([]byte(`{"ethFinalityDepth": 50, "l2Type": "optimism", "ethMaxGasPriceWei": "1000"}`), &o))
+		assert.Equal(t, uint32(50), *o.EthFinalityDepth)
+		assert.Equal(t, "optimism", *o.L2Type)
+		assert.Equal(t, big.NewInt(1000), o.EthMaxGasPriceWei.ToInt())
+		assert.Nil(t, o.BlockTime)
+
+		b, err := json.Marshal(o)
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"ethFinalityDepth": 50, "l2Type": "optimism", "ethMaxGasPriceWei": "1000"}`, string(b))
+	})
+
+	t.Run("validates", func(t *testing.T) {
+		zero := uint32(0)
+		badL2 := "zksync"
+		badMode := "Magic"
+		badAddress := "0xnope"
+		for _, o := range []chains.ChainOverrides{
+			{EthFinalityDepth: &zero},
+			{L2Type: &badL2},
+			{GasEstimatorMode: &badMode},
+			{LinkContractAddress: &badAddress},
+			{EthMinGasPriceWei: utils.NewBigI(2), EthMaxGasPriceWei: utils.NewBigI(1)},
+		} {
+			assert.Error(t, o.Validate())
+		}
+		assert.NoError(t, chains.ChainOverrides{}.Validate())
+	})
+}
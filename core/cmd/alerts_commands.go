@@ -0,0 +1,326 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/urfave/cli"
+	"go.uber.org/multierr"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/core/services/alerts"
+	"github.com/smartcontractkit/chainlink/core/store/models"
+	"github.com/smartcontractkit/chainlink/core/web"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+type AlertRulePresenter struct {
+	JAID
+	presenters.AlertRuleResource
+}
+
+func (p *AlertRulePresenter) ToRow() []string {
+	params, err := json.Marshal(p.Params)
+	if err != nil {
+		params = []byte(err.Error())
+	}
+	channels := "all"
+	if len(p.ChannelIDs) > 0 {
+		var ids []string
+		for _, id := range p.ChannelIDs {
+			ids = append(ids, strconv.FormatInt(id, 10))
+		}
+		channels = strings.Join(ids, ", ")
+	}
+	return []string{
+		p.ID,
+		p.Name,
+		string(p.Type),
+		string(params),
+		channels,
+		p.CreatedAt.String(),
+	}
+}
+
+var alertRuleHeaders = []string{"ID", "Name", "Type", "Params", "Channels", "Created"}
+
+// RenderTable implements TableRenderer
+func (p *AlertRulePresenter) RenderTable(rt RendererTable) error {
+	renderList(alertRuleHeaders, [][]string{p.ToRow()}, rt.Writer)
+	return nil
+}
+
+type AlertRulePresenters []AlertRulePresenter
+
+// RenderTable implements TableRenderer
+func (ps AlertRulePresenters) RenderTable(rt RendererTable) error {
+	rows := [][]string{}
+	for _, p := range ps {
+		rows = append(rows, p.ToRow())
+	}
+	renderList(alertRuleHeaders, rows, rt.Writer)
+	return nil
+}
+
+type AlertChannelPresenter struct {
+	JAID
+	presenters.AlertChannelResource
+}
+
+func (p *AlertChannelPresenter) ToRow() []string {
+	target := p.URL
+	if p.Type == alerts.ChannelTypeEmail {
+		target = strings.Join(p.Recipients, ", ")
+	}
+	return []string{
+		p.ID,
+		p.Name,
+		string(p.Type),
+		target,
+		p.CreatedAt.String(),
+	}
+}
+
+var alertChannelHeaders = []string{"ID", "Name", "Type", "Target", "Created"}
+
+// RenderTable implements TableRenderer
+func (p *AlertChannelPresenter) RenderTable(rt RendererTable) error {
+	renderList(alertChannelHeaders, [][]string{p.ToRow()}, rt.Writer)
+	return nil
+}
+
+type AlertChannelPresenters []AlertChannelPresenter
+
+// RenderTable implements TableRenderer
+func (ps AlertChannelPresenters) RenderTable(rt RendererTable) error {
+	rows := [][]string{}
+	for _, p := range ps {
+		rows = append(rows, p.ToRow())
+	}
+	renderList(alertChannelHeaders, rows, rt.Writer)
+	return nil
+}
+
+type AlertSilencePresenter struct {
+	JAID
+	presenters.AlertSilenceResource
+}
+
+func (p *AlertSilencePresenter) ToRow() []string {
+	rule := "all"
+	if p.RuleID.Valid {
+		rule = strconv.FormatInt(p.RuleID.Int64, 10)
+	}
+	return []string{
+		p.ID,
+		rule,
+		p.Reason,
+		p.EndsAt.String(),
+	}
+}
+
+var alertSilenceHeaders = []string{"ID", "Rule", "Reason", "Ends"}
+
+// RenderTable implements TableRenderer
+func (p *AlertSilencePresenter) RenderTable(rt RendererTable) error {
+	renderList(alertSilenceHeaders, [][]string{p.ToRow()}, rt.Writer)
+	return nil
+}
+
+type AlertSilencePresenters []AlertSilencePresenter
+
+// RenderTable implements TableRenderer
+func (ps AlertSilencePresenters) RenderTable(rt RendererTable) error {
+	rows := [][]string{}
+	for _, p := range ps {
+		rows = append(rows, p.ToRow())
+	}
+	renderList(alertSilenceHeaders, rows, rt.Writer)
+	return nil
+}
+
+type AlertPresenter struct {
+	JAID
+	presenters.AlertResource
+}
+
+func (p *AlertPresenter) ToRow() []string {
+	return []string{
+		p.ID,
+		p.RuleName,
+		string(p.State),
+		p.Message,
+		strconv.FormatBool(p.Silenced),
+		p.DeliveryError.ValueOrZero(),
+		p.CreatedAt.String(),
+	}
+}
+
+type AlertPresenters []AlertPresenter
+
+// RenderTable implements TableRenderer
+func (ps AlertPresenters) RenderTable(rt RendererTable) error {
+	headers := []string{"ID", "Rule", "State", "Message", "Silenced", "Delivery Error", "Time"}
+	rows := [][]string{}
+	for _, p := range ps {
+		rows = append(rows, p.ToRow())
+	}
+	renderList(headers, rows, rt.Writer)
+	return nil
+}
+
+// IndexAlerts lists the alert history, newest first
+func (cli *Client) IndexAlerts(c *cli.Context) error {
+	return cli.getPage("/v2/alerts", c.Int("page"), &AlertPresenters{})
+}
+
+// ListAlertRules lists the alert rules
+func (cli *Client) ListAlertRules(c *cli.Context) error {
+	return cli.listAlertResources("/v2/alerts/rules", &AlertRulePresenters{}, "Alert rules")
+}
+
+// CreateAlertRule adds an alert rule from its name, type and parameters as
+// JSON
+func (cli *Client) CreateAlertRule(c *cli.Context) error {
+	if c.NArg() < 2 {
+		return cli.errorOut(errors.New("Must pass the name and type of the rule, and its params as JSON"))
+	}
+	request := web.CreateAlertRuleRequest{
+		Name:       c.Args().Get(0),
+		Type:       alerts.RuleType(c.Args().Get(1)),
+		ChannelIDs: []int64{},
+	}
+	if c.NArg() > 2 {
+		if err := json.Unmarshal([]byte(c.Args().Get(2)), &request.Params); err != nil {
+			return cli.errorOut(errors.Wrap(err, "while parsing rule params"))
+		}
+	}
+	for _, id := range c.IntSlice("channel") {
+		request.ChannelIDs = append(request.ChannelIDs, int64(id))
+	}
+	return cli.createAlertResource("/v2/alerts/rules", request, &AlertRulePresenter{}, "Alert rule added")
+}
+
+// RemoveAlertRule removes an alert rule by ID
+func (cli *Client) RemoveAlertRule(c *cli.Context) error {
+	return cli.removeAlertResource(c, "/v2/alerts/rules", "rule")
+}
+
+// ListAlertChannels lists the alert channels
+func (cli *Client) ListAlertChannels(c *cli.Context) error {
+	return cli.listAlertResources("/v2/alerts/channels", &AlertChannelPresenters{}, "Alert channels")
+}
+
+// CreateAlertChannel adds an alert channel from its name, type and target:
+// the URL of webhook and slack channels, or the recipients of email channels
+func (cli *Client) CreateAlertChannel(c *cli.Context) error {
+	if c.NArg() < 3 {
+		return cli.errorOut(errors.New("Must pass the name and type of the channel, and its URL or email recipients"))
+	}
+	request := web.CreateAlertChannelRequest{
+		Name: c.Args().Get(0),
+		Type: alerts.ChannelType(c.Args().Get(1)),
+	}
+	if request.Type == alerts.ChannelTypeEmail {
+		request.Recipients = c.Args()[2:]
+	} else {
+		request.URL = c.Args().Get(2)
+	}
+	return cli.createAlertResource("/v2/alerts/channels", request, &AlertChannelPresenter{}, "Alert channel added")
+}
+
+// RemoveAlertChannel removes an alert channel by ID
+func (cli *Client) RemoveAlertChannel(c *cli.Context) error {
+	return cli.removeAlertResource(c, "/v2/alerts/channels", "channel")
+}
+
+// ListAlertSilences lists the alert silences which have not ended
+func (cli *Client) ListAlertSilences(c *cli.Context) error {
+	uri := "/v2/alerts/silences"
+	if c.Bool("all") {
+		uri += "?all=true"
+	}
+	return cli.listAlertResources(uri, &AlertSilencePresenters{}, "Alert silences")
+}
+
+// CreateAlertSilence silences an alert rule, or every rule
+func (cli *Client) CreateAlertSilence(c *cli.Context) error {
+	duration, err := time.ParseDuration(c.String("duration"))
+	if err != nil {
+		return cli.errorOut(errors.Wrap(err, "while parsing duration"))
+	}
+	d, err := models.MakeDuration(duration)
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	request := web.CreateAlertSilenceRequest{
+		Reason:   c.String("reason"),
+		Duration: d,
+	}
+	if c.IsSet("rule") {
+		request.RuleID = null.IntFrom(c.Int64("rule"))
+	}
+	return cli.createAlertResource("/v2/alerts/silences", request, &AlertSilencePresenter{}, "Alert silence added")
+}
+
+// RemoveAlertSilence ends an alert silence early by ID
+func (cli *Client) RemoveAlertSilence(c *cli.Context) error {
+	return cli.removeAlertResource(c, "/v2/alerts/silences", "silence")
+}
+
+func (cli *Client) listAlertResources(uri string, model interface{}, title string) (err error) {
+	resp, err := cli.HTTP.Get(uri)
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return cli.renderAPIResponse(resp, model, title)
+}
+
+func (cli *Client) createAlertResource(uri string, request interface{}, model interface{}, title string) (err error) {
+	requestData, err := json.Marshal(request)
+	if err != nil {
+		return cli.errorOut(err)
+	}
+
+	resp, err := cli.HTTP.Post(uri, bytes.NewBuffer(requestData))
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return cli.renderAPIResponse(resp, model, title)
+}
+
+func (cli *Client) removeAlertResource(c *cli.Context, uri string, name string) (err error) {
+	if !c.Args().Present() {
+		return cli.errorOut(errors.Errorf("Must pass the ID of the %s to be removed", name))
+	}
+
+	resp, err := cli.HTTP.Delete(fmt.Sprintf("%s/%s", uri, c.Args().Get(0)))
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	_, err = cli.parseResponse(resp)
+	return err
+}
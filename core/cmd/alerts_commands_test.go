@@ -0,0 +1,159 @@
+package cmd_test
+
+import (
+	"bytes"
+	"context"
+	"flag"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/urfave/cli"
+
+	"github.com/smartcontractkit/chainlink/core/cmd"
+	"github.com/smartcontractkit/chainlink/core/services/alerts"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+func TestAlertRulePresenter_RenderTable(t *testing.T) {
+	t.Parallel()
+
+	buffer := bytes.NewBufferString("")
+	r := cmd.RendererTable{Writer: buffer}
+	maxErrors := uint32(3)
+	p := cmd.AlertRulePresenter{
+		AlertRuleResource: presenters.AlertRuleResource{
+			JAID:       presenters.NewJAIDInt64(1),
+			Name:       "job errors",
+			Type:       alerts.RuleTypeJobErrors,
+			Params:     alerts.RuleParams{MaxErrors: &maxErrors},
+			ChannelIDs: []int64{4, 5},
+		},
+	}
+
+	require.NoError(t, p.RenderTable(r))
+	output := buffer.String()
+	assert.Contains(t, output, "job errors")
+	assert.Contains(t, output, `{"maxErrors":3}`)
+	assert.Contains(t, output, "4, 5")
+
+	buffer.Reset()
+	p.ChannelIDs = nil
+	require.NoError(t, cmd.AlertRulePresenters{p}.RenderTable(r))
+	assert.Contains(t, buffer.String(), "all")
+}
+
+func TestClient_AlertChannels(t *testing.T) {
+	t.Parallel()
+
+	app := startNewApplication(t)
+	client, r := app.NewClientAndRenderer()
+
+	set := flag.NewFlagSet("test", 0)
+	require.NoError(t, set.Parse([]string{"ops"}))
+	require.Error(t, client.CreateAlertChannel(cli.NewContext(nil, set, nil)), "the type and target are required")
+
+	set = flag.NewFlagSet("test", 0)
+	require.NoError(t, set.Parse([]string{"ops", "email", "ops@example.com", "oncall@example.com"}))
+	require.NoError(t, client.CreateAlertChannel(cli.NewContext(nil, set, nil)))
+	require.Len(t, r.Renders, 1)
+	channel := r.Renders[0].(*cmd.AlertChannelPresenter)
+	assert.Equal(t, alerts.ChannelTypeEmail, channel.Type)
+	assert.Equal(t, []string{"ops@example.com", "oncall@example.com"}, channel.Recipients)
+
+	require.NoError(t, client.ListAlertChannels(cli.NewContext(nil, flag.NewFlagSet("test", 0), nil)))
+	require.Len(t, r.Renders, 2)
+	assert.Len(t, *r.Renders[1].(*cmd.AlertChannelPresenters), 1)
+
+	set = flag.NewFlagSet("test", 0)
+	require.NoError(t, set.Parse([]string{channel.ID}))
+	require.NoError(t, client.RemoveAlertChannel(cli.NewContext(nil, set, nil)))
+	require.Error(t, client.RemoveAlertChannel(cli.NewContext(nil, set, nil)), "the channel is already removed")
+
+	channels, err := alerts.NewORM(app.GetStore().DB).ListChannels(context.Background())
+	require.NoError(t, err)
+	assert.Empty(t, channels)
+}
+
+func TestClient_AlertRulesAndSilences(t *testing.T) {
+	t.Parallel()
+
+	app := startNewApplication(t)
+	client, r := app.NewClientAndRenderer()
+	orm := alerts.NewORM(app.GetStore().DB)
+
+	channel := alerts.Channel{Name: "ops", Type: alerts.ChannelTypeWebhook, URL: "http://localhost:8080/alerts"}
+	require.NoError(t, orm.CreateChannel(context.Background(), &channel))
+
+	set := flag.NewFlagSet("test", 0)
+	require.NoError(t, set.Parse([]string{"rule", "no_heads", `not json`}))
+	require.Error(t, client.CreateAlertRule(cli.NewContext(nil, set, nil)))
+
+	set = flag.NewFlagSet("test", 0)
+	cli.IntSliceFlag{Name: "channel"}.Apply(set)
+	require.NoError(t, set.Parse([]string{"--channel", strconv.FormatInt(channel.ID, 10), "rule", "no_heads", `{"duration": "5m"}`}))
+	require.NoError(t, client.CreateAlertRule(cli.NewContext(nil, set, nil)))
+	require.Len(t, r.Renders, 1)
+	rule := r.Renders[0].(*cmd.AlertRulePresenter)
+	assert.Equal(t, alerts.RuleTypeNoHeads, rule.Type)
+	assert.Equal(t, 5*time.Minute, rule.Params.Duration.Duration())
+	assert.Equal(t, []int64{channel.ID}, rule.ChannelIDs)
+
+	require.NoError(t, client.ListAlertRules(cli.NewContext(nil, flag.NewFlagSet("test", 0), nil)))
+	require.Len(t, r.Renders, 2)
+	assert.Len(t, *r.Renders[1].(*cmd.AlertRulePresenters), 1)
+
+	set = flag.NewFlagSet("test", 0)
+	set.String("duration", "", "")
+	set.String("reason", "", "")
+	set.Int64("rule", 0, "")
+	require.NoError(t, set.Parse([]string{"--rule", rule.ID, "--duration", "2h", "--reason", "maintenance"}))
+	require.NoError(t, client.CreateAlertSilence(cli.NewContext(nil, set, nil)))
+	require.Len(t, r.Renders, 3)
+	silence := r.Renders[2].(*cmd.AlertSilencePresenter)
+	assert.Equal(t, rule.ID, strconv.FormatInt(silence.RuleID.Int64, 10))
+	assert.Equal(t, "maintenance", silence.Reason)
+
+	set = flag.NewFlagSet("test", 0)
+	set.String("duration", "", "")
+	require.NoError(t, set.Parse([]string{"--duration", "soon"}))
+	require.Error(t, client.CreateAlertSilence(cli.NewContext(nil, set, nil)))
+
+	set = flag.NewFlagSet("test", 0)
+	set.Bool("all", false, "")
+	require.NoError(t, client.ListAlertSilences(cli.NewContext(nil, set, nil)))
+	require.Len(t, r.Renders, 4)
+	assert.Len(t, *r.Renders[3].(*cmd.AlertSilencePresenters), 1)
+
+	set = flag.NewFlagSet("test", 0)
+	require.NoError(t, set.Parse([]string{silence.ID}))
+	require.NoError(t, client.RemoveAlertSilence(cli.NewContext(nil, set, nil)))
+
+	set = flag.NewFlagSet("test", 0)
+	require.NoError(t, set.Parse([]string{rule.ID}))
+	require.NoError(t, client.RemoveAlertRule(cli.NewContext(nil, set, nil)))
+	rules, err := orm.ListRules(context.Background())
+	require.NoError(t, err)
+	assert.Empty(t, rules)
+}
+
+func TestClient_IndexAlerts(t *testing.T) {
+	t.Parallel()
+
+	app := startNewApplication(t)
+	client, r := app.NewClientAndRenderer()
+
+	alert := alerts.Alert{RuleName: "no heads", State: alerts.AlertStateFiring, Message: "no new head for 5m0s", CreatedAt: time.Now()}
+	require.NoError(t, alerts.NewORM(app.GetStore().DB).CreateAlert(context.Background(), &alert))
+
+	set := flag.NewFlagSet("test", 0)
+	set.Int("page", 0, "")
+	require.NoError(t, client.IndexAlerts(cli.NewContext(nil, set, nil)))
+	require.Len(t, r.Renders, 1)
+	history := *r.Renders[0].(*cmd.AlertPresenters)
+	require.Len(t, history, 1)
+	assert.Equal(t, "no heads", history[0].RuleName)
+	assert.Equal(t, alerts.AlertStateFiring, history[0].State)
+}
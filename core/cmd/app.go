@@ -30,11 +30,18 @@ func NewApp(client *Client) *cli.App {
 			Name:  "json, j",
 			Usage: "json output as opposed to table",
 		},
+		cli.StringFlag{
+			Name:  "totp",
+			Usage: "TOTP code sent with every change made through the API, required for them if the user has enabled TOTP",
+		},
 	}
 	app.Before = func(c *cli.Context) error {
 		if c.Bool("json") {
 			client.Renderer = RendererJSON{Writer: os.Stdout}
 		}
+		if h, ok := client.HTTP.(*authenticatedHTTPClient); ok {
+			h.totpCode = c.String("totp")
+		}
 		return nil
 	}
 	app.Commands = removeHidden([]cli.Command{
@@ -56,6 +63,10 @@ func NewApp(client *Client) *cli.App {
 							Name:  "file, f",
 							Usage: "text file holding the API email and password needed to create a session cookie",
 						},
+						cli.StringFlag{
+							Name:  "totp",
+							Usage: "TOTP code, required if the user has enabled TOTP",
+						},
 					},
 				},
 			},
@@ -75,6 +86,113 @@ func NewApp(client *Client) *cli.App {
 			},
 		},
 
+		{
+			Name:  "alerts",
+			Usage: "Commands for managing the alert rules evaluated by the node, the channels they notify and their silences",
+			Subcommands: []cli.Command{
+				{
+					Name:   "history",
+					Usage:  "List the alerts raised by rules firing and resolving, newest first",
+					Action: client.IndexAlerts,
+					Flags: []cli.Flag{
+						cli.IntFlag{
+							Name:  "page",
+							Usage: "page of results to display",
+						},
+					},
+				},
+				{
+					Name:  "rules",
+					Usage: "Commands for managing alert rules",
+					Subcommands: []cli.Command{
+						{
+							Name:   "add",
+							Usage:  format(`Add a rule from its name, type (key_balance_low, job_errors or no_heads) and params as JSON, e.g. '{"maxErrors": 10, "window": "1h"}'`),
+							Action: client.CreateAlertRule,
+							Flags: []cli.Flag{
+								cli.IntSliceFlag{
+									Name:  "channel",
+									Usage: "ID of a channel to notify, every channel if unset",
+								},
+							},
+						},
+						{
+							Name:   "list",
+							Usage:  "List the alert rules",
+							Action: client.ListAlertRules,
+						},
+						{
+							Name:   "remove",
+							Usage:  "Remove an alert rule and its silences by ID",
+							Action: client.RemoveAlertRule,
+						},
+					},
+				},
+				{
+					Name:  "channels",
+					Usage: "Commands for managing the channels alerts are delivered to",
+					Subcommands: []cli.Command{
+						{
+							Name:   "add",
+							Usage:  format(`Add a channel from its name, type (webhook, slack or email) and URL, or email recipients`),
+							Action: client.CreateAlertChannel,
+						},
+						{
+							Name:   "list",
+							Usage:  "List the alert channels",
+							Action: client.ListAlertChannels,
+						},
+						{
+							Name:   "remove",
+							Usage:  "Remove an alert channel by ID",
+							Action: client.RemoveAlertChannel,
+						},
+					},
+				},
+				{
+					Name:  "silences",
+					Usage: "Commands for managing the silences which suppress the notifications of alert rules",
+					Subcommands: []cli.Command{
+						{
+							Name:   "add",
+							Usage:  "Silence an alert rule, or every rule, for a duration",
+							Action: client.CreateAlertSilence,
+							Flags: []cli.Flag{
+								cli.Int64Flag{
+									Name:  "rule",
+									Usage: "ID of the rule to silence, every rule if unset",
+								},
+								cli.StringFlag{
+									Name:  "duration",
+									Usage: "how long to silence the rule for, e.g. 2h",
+								},
+								cli.StringFlag{
+									Name:  "reason",
+									Usage: "why the rule is silenced",
+								},
+							},
+						},
+						{
+							Name:   "list",
+							Usage:  "List the alert silences which have not ended",
+							Action: client.ListAlertSilences,
+							Flags: []cli.Flag{
+								cli.BoolFlag{
+									Name:  "all",
+									Usage: "include the silences which have ended",
+								},
+							},
+						},
+						{
+							Name:   "remove",
+							Usage:  "End an alert silence early by ID",
+							Action: client.RemoveAlertSilence,
+						},
+					},
+				},
+			},
+		},
+
 		{
 			Name:    "attempts",
 			Aliases: []string{"txas"},
@@ -146,6 +264,40 @@ func NewApp(client *Client) *cli.App {
 			},
 		},
 
+		{
+			Name:  "secrets",
+			Usage: "Commands for the secrets referenced by ${secret.NAME} in job specs",
+			Subcommands: []cli.Command{
+				{
+					Name:   "set",
+					Usage:  format(`Encrypt a secret with the keystore password and store it in the database, replacing any existing value`),
+					Action: client.SetSecret,
+					Flags: []cli.Flag{
+						cli.StringFlag{
+							Name:  "file, f",
+							Usage: "`FILE` containing the secret value (required)",
+						},
+					},
+				},
+				{
+					Name:   "list",
+					Usage:  "List the names of all secrets",
+					Action: client.ListSecrets,
+				},
+				{
+					Name:   "delete",
+					Usage:  "Delete a secret",
+					Action: client.DeleteSecret,
+					Flags: []cli.Flag{
+						cli.BoolFlag{
+							Name:  "yes, y",
+							Usage: "skip the confirmation prompt",
+						},
+					},
+				},
+			},
+		},
+
 		{
 			Name:  "config",
 			Usage: "Commands for the node's configuration",
@@ -210,6 +362,50 @@ func NewApp(client *Client) *cli.App {
 			},
 		},
 
+		{
+			Name:   "export",
+			Usage:  "Stream a dataset (eth_txes, flux_monitor_round_stats, pipeline_runs) from the node as CSV or NDJSON",
+			Action: client.ExportDataset,
+			Flags: []cli.Flag{
+				cli.Int64Flag{
+					Name:  "after",
+					Usage: "only export rows after this cursor, as printed by the previous export",
+				},
+				cli.IntFlag{
+					Name:  "limit",
+					Usage: "maximum number of rows to export",
+				},
+				cli.StringFlag{
+					Name:  "format",
+					Usage: "csv or ndjson, defaults to csv",
+				},
+				cli.StringFlag{
+					Name:  "since",
+					Usage: "only export rows created at or after this RFC3339 time",
+				},
+				cli.StringFlag{
+					Name:  "until",
+					Usage: "only export rows created at or before this RFC3339 time",
+				},
+				cli.IntFlag{
+					Name:  "job-id",
+					Usage: "only export the rows of this job",
+				},
+				cli.StringFlag{
+					Name:  "state",
+					Usage: "only export rows in this state",
+				},
+				cli.StringFlag{
+					Name:  "address",
+					Usage: "only export the transactions sent from, or the round stats of, this address",
+				},
+				cli.StringFlag{
+					Name:  "output, o",
+					Usage: "file to write the export to, defaults to stdout",
+				},
+			},
+		},
+
 		{
 			Name:  "job_specs",
 			Usage: "Commands for managing Job Specs (jobs V1)",
@@ -255,34 +451,158 @@ func NewApp(client *Client) *cli.App {
 							Name:  "page",
 							Usage: "page of results to display",
 						},
+						cli.StringFlag{
+							Name:  "labels",
+							Usage: "only list the jobs with all these comma separated name=value labels",
+						},
 					},
 				},
 				{
 					Name:   "create",
-					Usage:  "Create a V2 job",
+					Usage:  "Create a V2 job from a TOML spec, or path to one, or from a template: `chainlink jobs create --template <name> --var Name=value`",
 					Action: client.CreateJobV2,
+					Flags: []cli.Flag{
+						cli.StringFlag{
+							Name:  "template, t",
+							Usage: "name of the template to create the job from",
+						},
+						cli.StringSliceFlag{
+							Name:  "var",
+							Usage: "Name=value variable substituted in the template, may be repeated",
+						},
+					},
 				},
 				{
 					Name:   "delete",
 					Usage:  "Delete a V2 job",
 					Action: client.DeleteJobV2,
 				},
+				{
+					Name:   "reactivate",
+					Usage:  "Reactivate a V2 job quarantined after failing to start repeatedly",
+					Action: client.ReactivateJobV2,
+				},
 				{
 					Name:   "run",
 					Usage:  "Trigger a V2 job run",
 					Action: client.TriggerPipelineRun,
 				},
+				{
+					Name:   "diff",
+					Usage:  "Compare a local V2 job TOML spec, or path to one, with a running job: `chainlink jobs diff <job id> <toml>`",
+					Action: client.DiffJobV2,
+				},
+				{
+					Name:   "validate",
+					Usage:  "Validate a V2 job TOML spec, or path to one, locally without a running node: `chainlink jobs validate <toml>`",
+					Action: client.ValidateJobV2,
+					Flags: []cli.Flag{
+						cli.BoolFlag{
+							Name:  "offline",
+							Usage: "do not look up the bridges, keys and external initiators referenced by the spec in the database",
+						},
+						cli.StringFlag{
+							Name:  "graph, g",
+							Usage: "path where a Graphviz rendering of the pipeline will be saved, or - for stdout",
+						},
+					},
+				},
 				{
 					Name:   "migrate",
 					Usage:  "Migrate a V1 job (JSON) to a V2 job (TOML)",
 					Action: client.Migrate,
 				},
+				{
+					Name:  "templates",
+					Usage: "Commands for managing job spec templates",
+					Subcommands: []cli.Command{
+						{
+							Name:   "list",
+							Usage:  "List all job templates",
+							Action: client.ListJobTemplates,
+							Flags: []cli.Flag{
+								cli.IntFlag{
+									Name:  "page",
+									Usage: "page of results to display",
+								},
+							},
+						},
+						{
+							Name:   "create",
+							Usage:  "Create a job template from a TOML spec with {{.Name}} variables: `chainlink jobs templates create <name> <path>`",
+							Action: client.CreateJobTemplate,
+						},
+						{
+							Name:   "delete",
+							Usage:  "Delete a job template, keeping the jobs created from it",
+							Action: client.DeleteJobTemplate,
+						},
+					},
+				},
 			},
 		},
 		{
 			Name:  "keys",
 			Usage: "Commands for managing various types of keys used by the Chainlink node",
 			Subcommands: []cli.Command{
+				{
+					Name:  "chpass",
+					Usage: format(`Change the keystore password. Re-encrypts all keys and secrets with the new password in one transaction, which is rolled back if any key fails. VRF keys are re-encrypted with the new VRF password, which must be given once any VRF key exists. The node must be started with the new passwords from then on`),
+					Flags: []cli.Flag{
+						cli.StringFlag{
+							Name:  "oldpassword",
+							Usage: "`FILE` containing the current keystore password",
+						},
+						cli.StringFlag{
+							Name:  "newpassword",
+							Usage: "`FILE` containing the new keystore password",
+						},
+						cli.StringFlag{
+							Name:  "oldvrfpassword",
+							Usage: "`FILE` containing the current VRF password",
+						},
+						cli.StringFlag{
+							Name:  "newvrfpassword",
+							Usage: "`FILE` containing the new VRF password",
+						},
+					},
+					Action: client.ChangeKeyStorePassword,
+				},
+				{
+					Name:  "audit",
+					Usage: "Show the audit log of key material access and signing operations, newest first",
+					Flags: []cli.Flag{
+						cli.StringFlag{
+							Name:  "type",
+							Usage: "only show accesses of this key type (csa, eth, ocr, p2p or vrf)",
+						},
+						cli.StringFlag{
+							Name:  "key",
+							Usage: "only show accesses of the key with this ID, i.e. its address, public key or bundle ID",
+						},
+						cli.StringFlag{
+							Name:  "operation",
+							Usage: "only show this operation (sign_tx, sign_message, sign_offchain, vrf_proof, export or private_key_access)",
+						},
+						cli.StringFlag{
+							Name:  "job",
+							Usage: "only show accesses on behalf of the job with this ID",
+						},
+						cli.StringFlag{
+							Name:  "since",
+							Usage: "only show accesses at or after this RFC3339 time",
+						},
+						cli.StringFlag{
+							Name:  "until",
+							Usage: "only show accesses before this RFC3339 time",
+						},
+						cli.IntFlag{
+							Name:  "page",
+							Usage: "page of results to display",
+						},
+					},
+					Action: client.ListKeyAccesses,
+				},
 				{
 					Name:  "eth",
 					Usage: "Remote commands for administering the node's Ethereum keys",
@@ -335,9 +655,53 @@ func NewApp(client *Client) *cli.App {
 									Name:  "output, o",
 									Usage: "Path where the JSON file will be saved (required)",
 								},
+								cli.StringFlag{
+									Name:  "scrypt-strength",
+									Usage: "Strength of the key's encryption, either standard or light. Defaults to the node's own",
+								},
 							},
 							Action: client.ExportETHKey,
 						},
+						{
+							Name:  "set-policy",
+							Usage: format(`Set the usage policy of an ETH key. A funding-only key is never used by jobs, only to send ETH out of the node. A key restricted to job types or contract addresses is only used by those jobs to send transactions to those contracts. Without flags, the key is unrestricted`),
+							Flags: []cli.Flag{
+								cli.BoolFlag{
+									Name:  "funding-only",
+									Usage: "only use the key to send ETH out of the node",
+								},
+								cli.StringSliceFlag{
+									Name:  "job-types",
+									Usage: "restrict the key to jobs of these types, e.g. vrf",
+								},
+								cli.StringSliceFlag{
+									Name:  "to-addresses",
+									Usage: "restrict the key to transactions to these contract addresses",
+								},
+							},
+							Action: client.SetETHKeyPolicy,
+						},
+						{
+							Name:   "reconcile-nonce",
+							Usage:  format(`Reconcile the nonces of an ETH key which has also been used outside the node. Abandons transactions whose nonce was used externally, rebroadcasts dropped transactions and fast-forwards the key's next nonce`),
+							Action: client.ReconcileETHKeyNonce,
+						},
+						{
+							Name:   "diagnose",
+							Usage:  format(`Diagnose why the unconfirmed transactions of an ETH key are stuck. Reports nonce gaps, underpriced transactions, balance shortfalls and transactions unknown to the eth node, with recommended remediation`),
+							Action: client.DiagnoseETHKey,
+						},
+						{
+							Name:  "rotate",
+							Usage: format(`Replace a sending ETH key with a new key, which gets its usage policy. Sweeps the residual funds of the old key to the new one, re-points the OCR and keeper jobs which send from it and makes it funding-only`),
+							Flags: []cli.Flag{
+								cli.BoolFlag{
+									Name:  "yes, y",
+									Usage: "skip the confirmation prompt",
+								},
+							},
+							Action: client.RotateETHKey,
+						},
 					},
 				},
 
@@ -393,6 +757,10 @@ func NewApp(client *Client) *cli.App {
 									Name:  "output, o",
 									Usage: "`FILE` where the JSON file will be saved (required)",
 								},
+								cli.StringFlag{
+									Name:  "scrypt-strength",
+									Usage: "Strength of the key's encryption, either standard or light. Defaults to the node's own",
+								},
 							},
 							Action: client.ExportP2PKey,
 						},
@@ -413,6 +781,36 @@ func NewApp(client *Client) *cli.App {
 							Usage:  format(`List available CSA keys`),
 							Action: client.ListCSAKeys,
 						},
+						{
+							Name:  "import",
+							Usage: format(`Import a CSA key from a JSON file`),
+							Flags: []cli.Flag{
+								cli.StringFlag{
+									Name:  "oldpassword, p",
+									Usage: "`FILE` containing the password used to encrypt the key in the JSON file",
+								},
+							},
+							Action: client.ImportCSAKey,
+						},
+						{
+							Name:  "export",
+							Usage: format(`Exports a CSA key to a JSON file`),
+							Flags: []cli.Flag{
+								cli.StringFlag{
+									Name:  "newpassword, p",
+									Usage: "`FILE` containing the password to encrypt the key (required)",
+								},
+								cli.StringFlag{
+									Name:  "output, o",
+									Usage: "`FILE` where the JSON file will be saved (required)",
+								},
+								cli.StringFlag{
+									Name:  "scrypt-strength",
+									Usage: "Strength of the key's encryption, either standard or light. Defaults to the node's own",
+								},
+							},
+							Action: client.ExportCSAKey,
+						},
 					},
 				},
 
@@ -468,6 +866,10 @@ func NewApp(client *Client) *cli.App {
 									Name:  "output, o",
 									Usage: "`FILE` where the JSON file will be saved (required)",
 								},
+								cli.StringFlag{
+									Name:  "scrypt-strength",
+									Usage: "Strength of the key's encryption, either standard or light. Defaults to the node's own",
+								},
 							},
 							Action: client.ExportOCRKey,
 						},
@@ -506,6 +908,10 @@ func NewApp(client *Client) *cli.App {
 									Name:  "output, o",
 									Usage: "`FILE` where the JSON file will be saved (required)",
 								},
+								cli.StringFlag{
+									Name:  "scrypt-strength",
+									Usage: "Strength of the key's encryption, either standard or light. Defaults to the node's own",
+								},
 							},
 							Action: client.ExportVRFKey,
 						},
@@ -523,6 +929,10 @@ func NewApp(client *Client) *cli.App {
 									Name:  "hard",
 									Usage: "hard-delete the key instead of archiving (irreversible!)",
 								},
+								cli.BoolFlag{
+									Name:  "force",
+									Usage: "delete the key even if it is still registered with the coordinator of one of its jobs",
+								},
 							},
 							Action: client.DeleteVRFKey,
 						},
@@ -580,6 +990,21 @@ func NewApp(client *Client) *cli.App {
 						},
 					},
 				},
+				{
+					Name:   "bootstrap",
+					Usage:  "Create or unlock the keystore and create the API user if it does not exist, without prompting. Safe to run on every start.",
+					Action: client.Bootstrap,
+					Flags: []cli.Flag{
+						cli.StringFlag{
+							Name:  "api, a",
+							Usage: "text file holding the API email and password, each on a line, defaults to the API_EMAIL and API_PASSWORD environment variables",
+						},
+						cli.StringFlag{
+							Name:  "password, p",
+							Usage: "text file holding the password for the node's account, defaults to the KEYSTORE_PASSWORD environment variable",
+						},
+					},
+				},
 				{
 					Name:    "start",
 					Aliases: []string{"node", "n"},
@@ -594,7 +1019,7 @@ func NewApp(client *Client) *cli.App {
 						},
 						cli.StringFlag{
 							Name:  "password, p",
-							Usage: "text file holding the password for the node's account",
+							Usage: "text file holding the password for the node's account, defaults to the KEYSTORE_PASSWORD environment variable",
 						},
 						cli.StringFlag{
 							Name:  "vrfpassword, vp",
@@ -683,6 +1108,23 @@ func NewApp(client *Client) *cli.App {
 							Action: client.MigrateDatabase,
 							Flags:  []cli.Flag{},
 						},
+						{
+							Name:   "export-job",
+							Usage:  "Export a job with its pipeline runs, flux monitor rounds and consumed logs, to import it into another node.",
+							Action: client.ExportJobSnapshot,
+							Flags: []cli.Flag{
+								cli.StringFlag{
+									Name:  "output, o",
+									Usage: "path where the JSON snapshot of the job will be saved",
+								},
+							},
+						},
+						{
+							Name:   "import-job",
+							Usage:  "Import a job exported from another node with export-job. The job must have been deleted from the other node.",
+							Action: client.ImportJobSnapshot,
+							Flags:  []cli.Flag{},
+						},
 					},
 				},
 			},
@@ -751,6 +1193,61 @@ func NewApp(client *Client) *cli.App {
 			},
 		},
 
+		{
+			Name:  "chains",
+			Usage: "Commands for managing the chain registry, which configures chains without a release",
+			Subcommands: []cli.Command{
+				{
+					Name:   "add",
+					Usage:  format(`Add a chain to the registry, optionally with its config as JSON, e.g. '{"ethFinalityDepth": 50, "l2Type": "optimism"}'. Unset settings keep their built-in value`),
+					Action: client.CreateChain,
+				},
+				{
+					Name:   "configure",
+					Usage:  "Replace the config of a chain of the registry with the given JSON",
+					Action: client.ConfigureChain,
+				},
+				{
+					Name:   "list",
+					Usage:  "List the chains of the registry",
+					Action: client.ListChains,
+				},
+				{
+					Name:   "remove",
+					Usage:  "Remove a chain from the registry by ID, restoring its built-in config",
+					Action: client.RemoveChain,
+				},
+			},
+		},
+
+		{
+			Name:  "forwarders",
+			Usage: "Commands for managing the allowlist of forwarder contracts, which ethtx tasks can route transactions through",
+			Subcommands: []cli.Command{
+				{
+					Name:   "add",
+					Usage:  format(`Add a forwarder contract to the allowlist. All of the node's ETH keys must be authorized senders of the forwarder`),
+					Action: client.CreateForwarder,
+					Flags: []cli.Flag{
+						cli.StringFlag{
+							Name:  "evm-chain-id",
+							Usage: "chain of the forwarder, if it is not on ETH_CHAIN_ID",
+						},
+					},
+				},
+				{
+					Name:   "list",
+					Usage:  "List the allowlisted forwarder contracts",
+					Action: client.ListForwarders,
+				},
+				{
+					Name:   "remove",
+					Usage:  "Remove a forwarder contract from the allowlist by ID",
+					Action: client.DeleteForwarder,
+				},
+			},
+		},
+
 		{
 			Name:  "txs",
 			Usage: "Commands for handling Ethereum transactions",
@@ -776,6 +1273,50 @@ func NewApp(client *Client) *cli.App {
 					Usage:  "get information on a specific Ethereum Transaction",
 					Action: client.ShowTransaction,
 				},
+				{
+					Name:   "sign",
+					Usage:  format(`Build a transaction and sign it with an ETH key of the keystore, without broadcasting it. Runs locally against the database, for manual recovery when the tx manager is stuck`),
+					Action: client.SignTransaction,
+					Flags: []cli.Flag{
+						cli.StringFlag{
+							Name:  "password, p",
+							Usage: "text file holding the password for the node's account",
+						},
+						cli.StringFlag{
+							Name:  "from, f",
+							Usage: "address (in hex format) of the key to sign with",
+						},
+						cli.StringFlag{
+							Name:  "to, t",
+							Usage: "destination address (in hex format)",
+						},
+						cli.StringFlag{
+							Name:  "value, v",
+							Usage: "amount of Wei to send",
+						},
+						cli.Uint64Flag{
+							Name:  "nonce, n",
+							Usage: "nonce of the transaction, defaults to the next nonce of the key",
+						},
+						cli.Uint64Flag{
+							Name:  "gasPriceWei, g",
+							Usage: "gas price (in Wei), defaults to ETH_GAS_PRICE_DEFAULT",
+						},
+						cli.Uint64Flag{
+							Name:  "gasLimit",
+							Usage: "gas limit, defaults to ETH_GAS_LIMIT_DEFAULT",
+						},
+						cli.StringFlag{
+							Name:  "data, d",
+							Usage: "hex encoded transaction data",
+						},
+					},
+				},
+				{
+					Name:   "broadcast-raw",
+					Usage:  format(`Send a hex encoded signed transaction, such as produced by "txs sign", directly to the eth node. Runs locally, the tx manager does not track the transaction`),
+					Action: client.BroadcastRawTransaction,
+				},
 			},
 		},
 	}...)
@@ -0,0 +1,125 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/pkg/errors"
+	clipkg "github.com/urfave/cli"
+	"go.uber.org/multierr"
+	"gorm.io/gorm"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/store/dialects"
+	"github.com/smartcontractkit/chainlink/core/store/models"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+const (
+	// EnvAPIEmail and EnvAPIPassword hold the credentials of the API user
+	// created by `node bootstrap`, when no credentials file is passed
+	EnvAPIEmail    = "API_EMAIL"
+	EnvAPIPassword = "API_PASSWORD"
+	// EnvKeystorePassword holds the password of the keystore, when no
+	// password file is passed
+	EnvKeystorePassword = "KEYSTORE_PASSWORD"
+)
+
+// keystorePassword reads the keystore password from the file, or from the
+// environment if no file is passed
+func keystorePassword(pwdFile string) (string, error) {
+	if pwdFile != "" {
+		return passwordFromFile(pwdFile)
+	}
+	return strings.TrimSpace(os.Getenv(EnvKeystorePassword)), nil
+}
+
+// apiCredentials reads the credentials of the API user from the file, or
+// from the environment if no file is passed
+func apiCredentials(file string) (models.SessionRequest, error) {
+	if file != "" {
+		return credentialsFromFile(file)
+	}
+	request := models.SessionRequest{
+		Email:    strings.TrimSpace(os.Getenv(EnvAPIEmail)),
+		Password: strings.TrimSpace(os.Getenv(EnvAPIPassword)),
+	}
+	if request.Email == "" || request.Password == "" {
+		return request, errors.Errorf("no API credentials, pass --api or set %s and %s", EnvAPIEmail, EnvAPIPassword)
+	}
+	return request, nil
+}
+
+// Bootstrap run locally provisions a node without prompting: it creates or
+// unlocks the keystore and creates the API user unless it already exists.
+// It can be run again with the same credentials, e.g. on every start of a
+// container, and only creates what is missing.
+func (cli *Client) Bootstrap(c *clipkg.Context) (err error) {
+	credentials, err := apiCredentials(c.String("api"))
+	if err != nil {
+		return cli.errorOut(errors.Wrap(err, "error reading API credentials"))
+	}
+	pwd, err := keystorePassword(c.String("password"))
+	if err != nil {
+		return cli.errorOut(errors.Wrap(err, "error reading keystore password"))
+	}
+	if pwd == "" {
+		return cli.errorOut(errors.Errorf("no keystore password, pass --password or set %s", EnvKeystorePassword))
+	}
+
+	logger.SetLogger(cli.Config.CreateProductionLogger())
+	cli.Config.Dialect = dialects.PostgresWithoutLock
+	app, err := cli.AppFactory.NewApplication(cli.Config)
+	if err != nil {
+		return cli.errorOut(errors.Wrap(err, "creating application"))
+	}
+	defer func() {
+		if serr := app.Stop(); serr != nil {
+			err = multierr.Append(err, serr)
+		}
+	}()
+	store := app.GetStore()
+	keyStore := app.GetKeyStore()
+
+	keyStorePwd, err := cli.KeyStoreAuthenticator.AuthenticateEthKey(keyStore.Eth(), pwd)
+	if err != nil {
+		return cli.errorOut(fmt.Errorf("error authenticating keystore: %+v", err))
+	}
+	if err = cli.KeyStoreAuthenticator.AuthenticateOCRKey(keyStore.OCR(), store.Config, keyStorePwd); err != nil {
+		return cli.errorOut(errors.Wrap(err, "while authenticating with OCR password"))
+	}
+	if err = cli.KeyStoreAuthenticator.AuthenticateCSAKey(keyStore.CSA(), keyStorePwd); err != nil {
+		return cli.errorOut(errors.Wrap(err, "while authenticating CSA keystore"))
+	}
+	sendingKeys, err := keyStore.Eth().SendingKeys()
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	fmt.Printf("Keystore unlocked with %d sending keys\n", len(sendingKeys))
+
+	user, err := store.FindUserByEmail(credentials.Email)
+	switch {
+	case err == nil:
+		if !utils.CheckPasswordHash(credentials.Password, user.HashedPassword) {
+			return cli.errorOut(errors.Errorf("API user %s already exists with another password", user.Email))
+		}
+		fmt.Printf("API user %s already exists\n", user.Email)
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		if err = models.ValidatePasswordComplexity(credentials.Email, credentials.Password); err != nil {
+			return cli.errorOut(err)
+		}
+		user, err = models.NewUser(credentials.Email, credentials.Password)
+		if err != nil {
+			return cli.errorOut(err)
+		}
+		user.Admin = true
+		if err = store.CreateUser(&user); err != nil {
+			return cli.errorOut(errors.Wrap(err, "error creating API user"))
+		}
+		fmt.Printf("Created API user %s\n", user.Email)
+	default:
+		return cli.errorOut(err)
+	}
+	return nil
+}
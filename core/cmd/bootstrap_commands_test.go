@@ -0,0 +1,166 @@
+package cmd_test
+
+import (
+	"flag"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/urfave/cli"
+
+	"github.com/smartcontractkit/chainlink/core/cmd"
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/internal/mocks"
+	"github.com/smartcontractkit/chainlink/core/services/keystore"
+	"github.com/smartcontractkit/chainlink/core/store"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+// setBootstrapEnv sets the environment variables Bootstrap reads its
+// credentials from, restoring them once the test is done. Tests which call
+// it must not be parallel.
+func setBootstrapEnv(t *testing.T, email, password, keystorePassword string) {
+	t.Helper()
+	for name, value := range map[string]string{
+		cmd.EnvAPIEmail:         email,
+		cmd.EnvAPIPassword:      password,
+		cmd.EnvKeystorePassword: keystorePassword,
+	} {
+		previous, exists := os.LookupEnv(name)
+		require.NoError(t, os.Setenv(name, value))
+		name := name
+		t.Cleanup(func() {
+			if exists {
+				os.Setenv(name, previous)
+			} else {
+				os.Unsetenv(name)
+			}
+		})
+	}
+}
+
+// newBootstrapClient returns a client which bootstraps an application of a
+// store without an API user. unlocked is set once the keystore is unlocked.
+func newBootstrapClient(t *testing.T) (client cmd.Client, s *store.Store, unlocked *bool) {
+	t.Helper()
+	s, cleanup := cltest.NewStore(t)
+	t.Cleanup(cleanup)
+	keyStore := cltest.NewKeyStore(t, s.DB)
+	require.NoError(t, s.DeleteUser())
+
+	app := new(mocks.Application)
+	app.On("GetStore").Return(s)
+	app.On("GetKeyStore").Return(keyStore)
+	app.On("Stop").Return(nil)
+
+	unlocked = new(bool)
+	auth := cltest.CallbackAuthenticator{Callback: func(_ *keystore.Eth, phrase string) (string, error) {
+		err := keyStore.Eth().Unlock(phrase)
+		*unlocked = err == nil
+		return phrase, err
+	}}
+	client = cmd.Client{
+		Config:                s.Config,
+		AppFactory:            cltest.InstanceAppFactory{App: app},
+		KeyStoreAuthenticator: auth,
+	}
+	return client, s, unlocked
+}
+
+func bootstrapContext(apiFile, passwordFile string) *cli.Context {
+	set := flag.NewFlagSet("test", 0)
+	set.String("api", apiFile, "")
+	set.String("password", passwordFile, "")
+	return cli.NewContext(nil, set, nil)
+}
+
+func TestClient_Bootstrap_CredentialsFromFiles(t *testing.T) {
+	// Credentials files take precedence over the environment
+	setBootstrapEnv(t, "env@chainlink.test", "env-p4SsW0rD1!@#_", "wrong keystore password")
+	client, s, unlocked := newBootstrapClient(t)
+
+	require.NoError(t, client.Bootstrap(bootstrapContext("../internal/fixtures/apicredentials", "../internal/fixtures/correct_password.txt")))
+	assert.True(t, *unlocked)
+
+	user, err := s.FindUserByEmail(cltest.APIEmail)
+	require.NoError(t, err)
+	assert.True(t, user.Admin)
+	assert.True(t, utils.CheckPasswordHash(cltest.Password, user.HashedPassword))
+	_, err = s.FindUserByEmail("env@chainlink.test")
+	require.Error(t, err)
+}
+
+func TestClient_Bootstrap_CredentialsFromEnv(t *testing.T) {
+	setBootstrapEnv(t, "env@chainlink.test", "env-p4SsW0rD1!@#_", cltest.Password)
+	client, s, unlocked := newBootstrapClient(t)
+
+	require.NoError(t, client.Bootstrap(bootstrapContext("", "")))
+	assert.True(t, *unlocked)
+
+	user, err := s.FindUserByEmail("env@chainlink.test")
+	require.NoError(t, err)
+	assert.True(t, user.Admin)
+	assert.True(t, utils.CheckPasswordHash("env-p4SsW0rD1!@#_", user.HashedPassword))
+}
+
+func TestClient_Bootstrap_Rerun(t *testing.T) {
+	setBootstrapEnv(t, "", "", "")
+	client, s, _ := newBootstrapClient(t)
+	c := bootstrapContext("../internal/fixtures/apicredentials", "../internal/fixtures/correct_password.txt")
+	require.NoError(t, client.Bootstrap(c))
+	created, err := s.FindUserByEmail(cltest.APIEmail)
+	require.NoError(t, err)
+
+	t.Run("with the same API user", func(t *testing.T) {
+		require.NoError(t, client.Bootstrap(c))
+
+		user, err := s.FindUserByEmail(cltest.APIEmail)
+		require.NoError(t, err)
+		assert.Equal(t, created.HashedPassword, user.HashedPassword)
+		assert.Equal(t, created.CreatedAt.Unix(), user.CreatedAt.Unix())
+	})
+
+	t.Run("with another password for the API user", func(t *testing.T) {
+		credentials := filepath.Join(t.TempDir(), "apicredentials")
+		require.NoError(t, ioutil.WriteFile(credentials, []byte(cltest.APIEmail+"\nan0ther-p4SsW0rD1!@#_"), 0600))
+
+		err := client.Bootstrap(bootstrapContext(credentials, "../internal/fixtures/correct_password.txt"))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "already exists with another password")
+
+		user, err := s.FindUserByEmail(cltest.APIEmail)
+		require.NoError(t, err)
+		assert.Equal(t, created.HashedPassword, user.HashedPassword)
+	})
+}
+
+func TestClient_Bootstrap_KeystorePassword(t *testing.T) {
+	setBootstrapEnv(t, "", "", "")
+	emptyPassword := filepath.Join(t.TempDir(), "password.txt")
+	require.NoError(t, ioutil.WriteFile(emptyPassword, []byte(" \n"), 0600))
+
+	tests := []struct {
+		name         string
+		passwordFile string
+	}{
+		{"missing", ""},
+		{"empty", emptyPassword},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			client, s, unlocked := newBootstrapClient(t)
+
+			err := client.Bootstrap(bootstrapContext("../internal/fixtures/apicredentials", test.passwordFile))
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), "no keystore password")
+			assert.False(t, *unlocked)
+
+			_, err = s.FindUserByEmail(cltest.APIEmail)
+			require.Error(t, err, "no API user is created without a keystore password")
+		})
+	}
+}
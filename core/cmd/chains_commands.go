@@ -0,0 +1,165 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math/big"
+
+	"github.com/pkg/errors"
+	"github.com/smartcontractkit/chainlink/core/chains"
+	"github.com/smartcontractkit/chainlink/core/utils"
+	"github.com/smartcontractkit/chainlink/core/web"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+	"github.com/urfave/cli"
+	"go.uber.org/multierr"
+)
+
+type ChainPresenter struct {
+	JAID
+	presenters.ChainResource
+}
+
+func (p *ChainPresenter) ToRow() []string {
+	config, err := json.Marshal(p.Config)
+	if err != nil {
+		config = []byte(err.Error())
+	}
+	return []string{
+		p.ID,
+		string(config),
+		p.CreatedAt.String(),
+		p.UpdatedAt.String(),
+	}
+}
+
+// RenderTable implements TableRenderer
+func (p *ChainPresenter) RenderTable(rt RendererTable) error {
+	headers := []string{"ID", "Config", "Created", "Updated"}
+	rows := [][]string{p.ToRow()}
+
+	renderList(headers, rows, rt.Writer)
+	return nil
+}
+
+type ChainPresenters []ChainPresenter
+
+// RenderTable implements TableRenderer
+func (ps ChainPresenters) RenderTable(rt RendererTable) error {
+	headers := []string{"ID", "Config", "Created", "Updated"}
+	rows := [][]string{}
+
+	for _, p := range ps {
+		rows = append(rows, p.ToRow())
+	}
+
+	renderList(headers, rows, rt.Writer)
+	return nil
+}
+
+// ListChains lists the chains of the chain registry
+func (cli *Client) ListChains(c *cli.Context) (err error) {
+	resp, err := cli.HTTP.Get("/v2/chains/evm")
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return cli.renderAPIResponse(resp, &ChainPresenters{}, "Chains")
+}
+
+// parseChainArgs parses the chain ID and, if present, the JSON config of a
+// chain from the command's arguments
+func parseChainArgs(c *cli.Context) (*big.Int, chains.ChainOverrides, error) {
+	var config chains.ChainOverrides
+	id, ok := new(big.Int).SetString(c.Args().Get(0), 10)
+	if !ok {
+		return nil, config, errors.Errorf("invalid chain ID %q", c.Args().Get(0))
+	}
+	if c.NArg() > 1 {
+		if err := json.Unmarshal([]byte(c.Args().Get(1)), &config); err != nil {
+			return nil, config, errors.Wrap(err, "while parsing chain config")
+		}
+	}
+	return id, config, nil
+}
+
+// CreateChain adds a chain to the chain registry
+func (cli *Client) CreateChain(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return cli.errorOut(errors.New("Must pass the ID of the chain to be added"))
+	}
+	id, config, err := parseChainArgs(c)
+	if err != nil {
+		return cli.errorOut(err)
+	}
+
+	requestData, err := json.Marshal(web.CreateChainRequest{ID: utils.NewBig(id), Config: config})
+	if err != nil {
+		return cli.errorOut(err)
+	}
+
+	resp, err := cli.HTTP.Post("/v2/chains/evm", bytes.NewBuffer(requestData))
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return cli.renderAPIResponse(resp, &ChainPresenter{}, "Chain added")
+}
+
+// ConfigureChain replaces the config of a chain of the chain registry
+func (cli *Client) ConfigureChain(c *cli.Context) (err error) {
+	if c.NArg() < 2 {
+		return cli.errorOut(errors.New("Must pass the ID of the chain and its config as JSON"))
+	}
+	id, config, err := parseChainArgs(c)
+	if err != nil {
+		return cli.errorOut(err)
+	}
+
+	requestData, err := json.Marshal(web.UpdateChainRequest{Config: config})
+	if err != nil {
+		return cli.errorOut(err)
+	}
+
+	resp, err := cli.HTTP.Patch(fmt.Sprintf("/v2/chains/evm/%s", id), bytes.NewBuffer(requestData))
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return cli.renderAPIResponse(resp, &ChainPresenter{}, "Chain configured")
+}
+
+// RemoveChain removes a chain from the chain registry
+func (cli *Client) RemoveChain(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return cli.errorOut(errors.New("Must pass the ID of the chain to be removed"))
+	}
+
+	resp, err := cli.HTTP.Delete(fmt.Sprintf("/v2/chains/evm/%s", c.Args().Get(0)))
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	_, err = cli.parseResponse(resp)
+	return err
+}
@@ -0,0 +1,97 @@
+package cmd_test
+
+import (
+	"bytes"
+	"flag"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/urfave/cli"
+
+	"github.com/smartcontractkit/chainlink/core/chains"
+	"github.com/smartcontractkit/chainlink/core/cmd"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+func TestChainPresenter_RenderTable(t *testing.T) {
+	t.Parallel()
+
+	buffer := bytes.NewBufferString("")
+	r := cmd.RendererTable{Writer: buffer}
+	finalityDepth := uint32(12)
+	p := cmd.ChainPresenter{
+		JAID: cmd.JAID{ID: "440911"},
+		ChainResource: presenters.ChainResource{
+			JAID:      presenters.NewJAID("440911"),
+			Config:    chains.ChainOverrides{EthFinalityDepth: &finalityDepth},
+			CreatedAt: time.Now(),
+			UpdatedAt: time.Now(),
+		},
+	}
+
+	require.NoError(t, p.RenderTable(r))
+	output := buffer.String()
+	assert.Contains(t, output, "440911")
+	assert.Contains(t, output, `{"ethFinalityDepth":12}`)
+
+	buffer.Reset()
+	require.NoError(t, cmd.ChainPresenters{p}.RenderTable(r))
+	assert.Contains(t, buffer.String(), "440911")
+}
+
+func TestClient_Chains(t *testing.T) {
+	t.Parallel()
+
+	app := startNewApplication(t)
+	client, r := app.NewClientAndRenderer()
+	id := big.NewInt(440912)
+	t.Cleanup(func() { chains.ResetOverrides(id) })
+
+	set := flag.NewFlagSet("test", 0)
+	require.Error(t, client.CreateChain(cli.NewContext(nil, set, nil)), "the chain ID is required")
+
+	set = flag.NewFlagSet("test", 0)
+	require.NoError(t, set.Parse([]string{"440912", "not json"}))
+	require.Error(t, client.CreateChain(cli.NewContext(nil, set, nil)))
+
+	set = flag.NewFlagSet("test", 0)
+	require.NoError(t, set.Parse([]string{"440912", `{"l2Type": "zksync"}`}))
+	require.Error(t, client.CreateChain(cli.NewContext(nil, set, nil)), "the config is invalid")
+
+	set = flag.NewFlagSet("test", 0)
+	require.NoError(t, set.Parse([]string{"440912", `{"ethFinalityDepth": 12}`}))
+	require.NoError(t, client.CreateChain(cli.NewContext(nil, set, nil)))
+	require.Len(t, r.Renders, 1)
+	chain := r.Renders[0].(*cmd.ChainPresenter)
+	assert.Equal(t, "440912", chain.ID)
+	require.NotNil(t, chain.Config.EthFinalityDepth)
+	assert.Equal(t, uint32(12), *chain.Config.EthFinalityDepth)
+
+	require.NoError(t, client.ListChains(cli.NewContext(nil, flag.NewFlagSet("test", 0), nil)))
+	require.Len(t, r.Renders, 2)
+	assert.Len(t, *r.Renders[1].(*cmd.ChainPresenters), 1)
+
+	set = flag.NewFlagSet("test", 0)
+	require.NoError(t, set.Parse([]string{"440912"}))
+	require.Error(t, client.ConfigureChain(cli.NewContext(nil, set, nil)), "the config is required")
+
+	set = flag.NewFlagSet("test", 0)
+	require.NoError(t, set.Parse([]string{"440912", `{"ethFinalityDepth": 20}`}))
+	require.NoError(t, client.ConfigureChain(cli.NewContext(nil, set, nil)))
+	require.Len(t, r.Renders, 3)
+	chain = r.Renders[2].(*cmd.ChainPresenter)
+	require.NotNil(t, chain.Config.EthFinalityDepth)
+	assert.Equal(t, uint32(20), *chain.Config.EthFinalityDepth)
+
+	set = flag.NewFlagSet("test", 0)
+	require.NoError(t, set.Parse([]string{"440912"}))
+	require.NoError(t, client.RemoveChain(cli.NewContext(nil, set, nil)))
+	require.Error(t, client.RemoveChain(cli.NewContext(nil, set, nil)), "the chain is already removed")
+
+	registered, err := chains.FindChains(app.GetStore().DB)
+	require.NoError(t, err)
+	assert.Empty(t, registered)
+}
@@ -76,11 +76,21 @@ func (n ChainlinkAppFactory) NewApplication(config *config.Config, onConnectCall
 	if config.EthereumDisabled() {
 		ethClient = &eth.NullClient{}
 	} else {
-		var err error
-		ethClient, err = eth.NewClient(config.EthereumURL(), config.EthereumHTTPURL(), config.EthereumSecondaryURLs())
+		c, err := eth.NewClientWithPool(config.EthereumURL(), config.EthereumHTTPURL(), config.EthereumSecondaryURLs(), eth.PoolConfig{
+			AdditionalPrimaryURLs: config.EthereumPrimaryURLs(),
+			PollInterval:          config.EthNodePollInterval(),
+			SendToAllNodes:        config.EthSendToAllNodes(),
+			DetectCapabilities:    config.EthRPCDetectCapabilities(),
+			HTTPPollInterval:      config.Chain().Config().BlockTime,
+		})
 		if err != nil {
 			return nil, err
 		}
+		ethClient = eth.NewCachingClient(eth.NewInstrumentedClient(c, eth.EndpointName(config.EthereumURL())), eth.CacheConfig{
+			Size:          config.EthRPCCacheSize(),
+			TTL:           config.EthRPCCacheTTL(),
+			FinalityDepth: config.EthFinalityDepth(),
+		})
 	}
 
 	advisoryLock := postgres.NewAdvisoryLock(config.DatabaseURL())
@@ -172,6 +182,9 @@ type authenticatedHTTPClient struct {
 	client         *http.Client
 	cookieAuth     CookieAuthenticator
 	sessionRequest models.SessionRequest
+	// totpCode is sent with every request other than a GET, the server
+	// requires it once the user enabled TOTP
+	totpCode string
 }
 
 // NewAuthenticatedHTTPClient uses the CookieAuthenticator to generate a sessionID
@@ -236,6 +249,9 @@ func (h *authenticatedHTTPClient) doRequest(verb, path string, body io.Reader, h
 	}
 
 	request.Header.Set("Content-Type", "application/json")
+	if h.totpCode != "" && verb != "GET" {
+		request.Header.Set(web.TOTPHeader, h.totpCode)
+	}
 	for key, value := range headers {
 		request.Header.Add(key, value)
 	}
@@ -449,6 +465,7 @@ func (t *promptingAPIInitializer) Initialize(store *store.Store) (models.User, e
 			fmt.Println("Error creating API user: ", err)
 			continue
 		}
+		user.Admin = true
 		if err = store.SaveUser(&user); err != nil {
 			fmt.Println("Error creating API user: ", err)
 		}
@@ -480,6 +497,7 @@ func (f fileAPIInitializer) Initialize(store *store.Store) (models.User, error)
 	if err != nil {
 		return user, err
 	}
+	user.Admin = true
 	return user, store.SaveUser(&user)
 }
 
@@ -1,8 +1,14 @@
 package cmd
 
 import (
+	"bytes"
 	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
 
+	"github.com/pkg/errors"
+	"github.com/smartcontractkit/chainlink/core/utils"
 	"github.com/smartcontractkit/chainlink/core/web/presenters"
 	"github.com/urfave/cli"
 	"go.uber.org/multierr"
@@ -15,7 +21,7 @@ type CSAKeyPresenter struct {
 
 // RenderTable implements TableRenderer
 func (p *CSAKeyPresenter) RenderTable(rt RendererTable) error {
-	headers := []string{"ID", "Public key", "Created", "Updated"}
+	headers := []string{"ID", "Public key", "Fingerprint", "Created", "Updated"}
 	rows := [][]string{p.ToRow()}
 
 	if _, err := rt.Write([]byte("🔑 CSA Keys\n")); err != nil {
@@ -30,6 +36,7 @@ func (p *CSAKeyPresenter) ToRow() []string {
 	row := []string{
 		p.ID,
 		p.PubKey,
+		p.Fingerprint,
 		fmt.Sprintf("%v", p.CreatedAt),
 		fmt.Sprintf("%v", p.UpdatedAt),
 	}
@@ -41,7 +48,7 @@ type CSAKeyPresenters []CSAKeyPresenter
 
 // RenderTable implements TableRenderer
 func (ps CSAKeyPresenters) RenderTable(rt RendererTable) error {
-	headers := []string{"ID", "Public key", "Created", "Updated"}
+	headers := []string{"ID", "Public key", "Fingerprint", "Created", "Updated"}
 	rows := [][]string{}
 
 	for _, p := range ps {
@@ -85,3 +92,95 @@ func (cli *Client) CreateCSAKey(c *cli.Context) (err error) {
 
 	return cli.renderAPIResponse(resp, &CSAKeyPresenter{}, "Created CSA key")
 }
+
+// ImportCSAKey imports and stores a CSA key,
+// path to key must be passed
+func (cli *Client) ImportCSAKey(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return cli.errorOut(errors.New("Must pass the filepath of the key to be imported"))
+	}
+
+	oldPasswordFile := c.String("oldpassword")
+	if len(oldPasswordFile) == 0 {
+		return cli.errorOut(errors.New("Must specify --oldpassword/-p flag"))
+	}
+	oldPassword, err := ioutil.ReadFile(oldPasswordFile)
+	if err != nil {
+		return cli.errorOut(errors.Wrap(err, "Could not read password file"))
+	}
+
+	filepath := c.Args().Get(0)
+	keyJSON, err := ioutil.ReadFile(filepath)
+	if err != nil {
+		return cli.errorOut(err)
+	}
+
+	normalizedPassword := normalizePassword(string(oldPassword))
+	resp, err := cli.HTTP.Post("/v2/keys/csa/import?oldpassword="+normalizedPassword, bytes.NewReader(keyJSON))
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return cli.renderAPIResponse(resp, &CSAKeyPresenter{}, "🔑 Imported CSA key")
+}
+
+// ExportCSAKey exports a CSA key,
+// key ID must be passed
+func (cli *Client) ExportCSAKey(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return cli.errorOut(errors.New("Must pass the ID of the key to export"))
+	}
+
+	newPasswordFile := c.String("newpassword")
+	if len(newPasswordFile) == 0 {
+		return cli.errorOut(errors.New("Must specify --newpassword/-p flag"))
+	}
+	newPassword, err := ioutil.ReadFile(newPasswordFile)
+	if err != nil {
+		return cli.errorOut(errors.Wrap(err, "Could not read password file"))
+	}
+
+	filepath := c.String("output")
+	if len(filepath) == 0 {
+		return cli.errorOut(errors.New("Must specify --output/-o flag"))
+	}
+
+	ID := c.Args().Get(0)
+
+	normalizedPassword := normalizePassword(string(newPassword))
+	resp, err := cli.HTTP.Post("/v2/keys/csa/export/"+ID+"?newpassword="+normalizedPassword+scryptStrengthQuery(c), nil)
+	if err != nil {
+		return cli.errorOut(errors.Wrap(err, "Could not make HTTP request"))
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	if resp.StatusCode != http.StatusOK {
+		return cli.errorOut(errors.New("Error exporting"))
+	}
+
+	keyJSON, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return cli.errorOut(errors.Wrap(err, "Could not read response body"))
+	}
+
+	err = utils.WriteFileWithMaxPerms(filepath, keyJSON, 0600)
+	if err != nil {
+		return cli.errorOut(errors.Wrapf(err, "Could not write %v", filepath))
+	}
+
+	_, err = os.Stderr.WriteString(fmt.Sprintf("🔑 Exported CSA key %s to %s\n", ID, filepath))
+	if err != nil {
+		return cli.errorOut(err)
+	}
+
+	return nil
+}
@@ -2,36 +2,43 @@ package cmd_test
 
 import (
 	"bytes"
+	"flag"
+	"fmt"
+	"os"
 	"testing"
 	"time"
 
 	"github.com/smartcontractkit/chainlink/core/cmd"
 	"github.com/smartcontractkit/chainlink/core/internal/cltest"
 	"github.com/smartcontractkit/chainlink/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/core/utils"
 	"github.com/smartcontractkit/chainlink/core/web/presenters"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/urfave/cli"
 )
 
 func TestCSAKeyPresenter_RenderTable(t *testing.T) {
 	t.Parallel()
 
 	var (
-		id        = "1"
-		pubKey    = "somepubkey"
-		createdAt = time.Now()
-		updatedAt = time.Now().Add(time.Second)
-		buffer    = bytes.NewBufferString("")
-		r         = cmd.RendererTable{Writer: buffer}
+		id          = "1"
+		pubKey      = "somepubkey"
+		fingerprint = "SHA256:somefingerprint"
+		createdAt   = time.Now()
+		updatedAt   = time.Now().Add(time.Second)
+		buffer      = bytes.NewBufferString("")
+		r           = cmd.RendererTable{Writer: buffer}
 	)
 
 	p := cmd.CSAKeyPresenter{
 		JAID: cmd.JAID{ID: id},
 		CSAKeyResource: presenters.CSAKeyResource{
-			JAID:      presenters.NewJAID(id),
-			PubKey:    pubKey,
-			CreatedAt: createdAt,
-			UpdatedAt: updatedAt,
+			JAID:        presenters.NewJAID(id),
+			PubKey:      pubKey,
+			Fingerprint: fingerprint,
+			CreatedAt:   createdAt,
+			UpdatedAt:   updatedAt,
 		},
 	}
 
@@ -41,6 +48,7 @@ func TestCSAKeyPresenter_RenderTable(t *testing.T) {
 	output := buffer.String()
 	assert.Contains(t, output, id)
 	assert.Contains(t, output, pubKey)
+	assert.Contains(t, output, fingerprint)
 	assert.Contains(t, output, createdAt.String())
 	assert.Contains(t, output, updatedAt.String())
 
@@ -52,6 +60,7 @@ func TestCSAKeyPresenter_RenderTable(t *testing.T) {
 	output = buffer.String()
 	assert.Contains(t, output, id)
 	assert.Contains(t, output, pubKey)
+	assert.Contains(t, output, fingerprint)
 	assert.Contains(t, output, createdAt.String())
 	assert.Contains(t, output, updatedAt.String())
 }
@@ -71,6 +80,7 @@ func TestClient_ListCSAKeys(t *testing.T) {
 	require.Equal(t, 1, len(r.Renders))
 	keys := *r.Renders[0].(*cmd.CSAKeyPresenters)
 	assert.Equal(t, key.PublicKey.String(), keys[0].PubKey)
+	assert.Equal(t, key.Fingerprint(), keys[0].Fingerprint)
 }
 
 func TestClient_CreateCSAKey(t *testing.T) {
@@ -84,6 +94,52 @@ func TestClient_CreateCSAKey(t *testing.T) {
 	require.NoError(t, client.CreateCSAKey(nilContext))
 
 	requireCSAKeyCount(t, app, 1)
+
+	require.NoError(t, client.CreateCSAKey(nilContext))
+
+	requireCSAKeyCount(t, app, 2)
+}
+
+func TestClient_ImportExportCSAKey(t *testing.T) {
+	t.Parallel()
+
+	defer deleteKeyExportFile(t)
+
+	app := startNewApplication(t)
+	client, _ := app.NewClientAndRenderer()
+
+	key, err := app.GetKeyStore().CSA().CreateCSAKey()
+	require.NoError(t, err)
+	keyName := keyNameForTest(t)
+
+	// Export test invalid scrypt strength
+	set := flag.NewFlagSet("test CSA export", 0)
+	set.Parse([]string{fmt.Sprint(key.ID)})
+	set.String("newpassword", "../internal/fixtures/apicredentials", "")
+	set.String("output", keyName, "")
+	set.String("scrypt-strength", "weak", "")
+	c := cli.NewContext(nil, set, nil)
+	require.Error(t, client.ExportCSAKey(c), "Error exporting")
+	require.Error(t, utils.JustError(os.Stat(keyName)))
+
+	// Export test
+	set = flag.NewFlagSet("test CSA export", 0)
+	set.Parse([]string{fmt.Sprint(key.ID)})
+	set.String("newpassword", "../internal/fixtures/apicredentials", "")
+	set.String("output", keyName, "")
+	set.String("scrypt-strength", "light", "")
+	c = cli.NewContext(nil, set, nil)
+	require.NoError(t, client.ExportCSAKey(c))
+	require.NoError(t, utils.JustError(os.Stat(keyName)))
+
+	// Import test, the same key can't be imported twice
+	set = flag.NewFlagSet("test CSA import", 0)
+	set.Parse([]string{keyName})
+	set.String("oldpassword", "../internal/fixtures/apicredentials", "")
+	c = cli.NewContext(nil, set, nil)
+	require.Error(t, client.ImportCSAKey(c))
+
+	requireCSAKeyCount(t, app, 1)
 }
 
 func requireCSAKeyCount(t *testing.T, app chainlink.Application, length int64) {
@@ -0,0 +1,125 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math/big"
+
+	"github.com/pkg/errors"
+	"github.com/smartcontractkit/chainlink/core/utils"
+	"github.com/smartcontractkit/chainlink/core/web"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+	"github.com/urfave/cli"
+	"go.uber.org/multierr"
+)
+
+type EthForwarderPresenter struct {
+	JAID
+	presenters.EthForwarderResource
+}
+
+func (p *EthForwarderPresenter) ToRow() []string {
+	return []string{
+		p.ID,
+		p.Address,
+		p.EVMChainID,
+		p.CreatedAt.String(),
+	}
+}
+
+// RenderTable implements TableRenderer
+func (p *EthForwarderPresenter) RenderTable(rt RendererTable) error {
+	headers := []string{"ID", "Address", "EVM Chain ID", "Created"}
+	rows := [][]string{p.ToRow()}
+
+	renderList(headers, rows, rt.Writer)
+	return nil
+}
+
+type EthForwarderPresenters []EthForwarderPresenter
+
+// RenderTable implements TableRenderer
+func (ps EthForwarderPresenters) RenderTable(rt RendererTable) error {
+	headers := []string{"ID", "Address", "EVM Chain ID", "Created"}
+	rows := [][]string{}
+
+	for _, p := range ps {
+		rows = append(rows, p.ToRow())
+	}
+
+	renderList(headers, rows, rt.Writer)
+	return nil
+}
+
+// ListForwarders lists the allowlisted forwarder contracts
+func (cli *Client) ListForwarders(c *cli.Context) (err error) {
+	resp, err := cli.HTTP.Get("/v2/forwarders")
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return cli.renderAPIResponse(resp, &EthForwarderPresenters{}, "Forwarders")
+}
+
+// CreateForwarder adds a forwarder contract to the allowlist
+func (cli *Client) CreateForwarder(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return cli.errorOut(errors.New("Must pass the address of the forwarder to be added"))
+	}
+
+	address, err := utils.ParseEthereumAddress(c.Args().Get(0))
+	if err != nil {
+		return cli.errorOut(errors.Wrap(err, "while parsing forwarder address"))
+	}
+	request := web.CreateEthForwarderRequest{Address: address}
+	if c.IsSet("evm-chain-id") {
+		chainID, ok := new(big.Int).SetString(c.String("evm-chain-id"), 10)
+		if !ok {
+			return cli.errorOut(errors.Errorf("invalid evm-chain-id %q", c.String("evm-chain-id")))
+		}
+		request.EVMChainID = utils.NewBig(chainID)
+	}
+
+	requestData, err := json.Marshal(request)
+	if err != nil {
+		return cli.errorOut(err)
+	}
+
+	resp, err := cli.HTTP.Post("/v2/forwarders", bytes.NewBuffer(requestData))
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return cli.renderAPIResponse(resp, &EthForwarderPresenter{}, "Forwarder added")
+}
+
+// DeleteForwarder removes a forwarder contract from the allowlist
+func (cli *Client) DeleteForwarder(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return cli.errorOut(errors.New("Must pass the ID of the forwarder to be removed"))
+	}
+
+	resp, err := cli.HTTP.Delete(fmt.Sprintf("/v2/forwarders/%s", c.Args().Get(0)))
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	_, err = cli.parseResponse(resp)
+	return err
+}
@@ -2,12 +2,16 @@ package cmd
 
 import (
 	"bytes"
+	"encoding/json"
 	"fmt"
 	"io/ioutil"
 	"net/http"
 	"os"
+	"strings"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/pkg/errors"
+	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
 	"github.com/smartcontractkit/chainlink/core/utils"
 	"github.com/smartcontractkit/chainlink/core/web/presenters"
 	"github.com/urfave/cli"
@@ -31,6 +35,7 @@ func (p *EthKeyPresenter) ToRow() []string {
 		p.LinkBalance.String(),
 		nextNonce,
 		fmt.Sprintf("%v", p.IsFunding),
+		formatKeyPolicy(p.Policy),
 		p.CreatedAt.String(),
 		p.UpdatedAt.String(),
 		deletedAt,
@@ -39,7 +44,7 @@ func (p *EthKeyPresenter) ToRow() []string {
 
 // RenderTable implements TableRenderer
 func (p *EthKeyPresenter) RenderTable(rt RendererTable) error {
-	headers := []string{"Address", "ETH", "LINK", "Next nonce", "Is funding", "Created", "Updated", "Deleted"}
+	headers := []string{"Address", "ETH", "LINK", "Next nonce", "Is funding", "Policy", "Created", "Updated", "Deleted"}
 	rows := [][]string{p.ToRow()}
 
 	renderList(headers, rows, rt.Writer)
@@ -51,7 +56,7 @@ type EthKeyPresenters []EthKeyPresenter
 
 // RenderTable implements TableRenderer
 func (ps EthKeyPresenters) RenderTable(rt RendererTable) error {
-	headers := []string{"Address", "ETH", "LINK", "Next nonce", "Is funding", "Created", "Updated", "Deleted"}
+	headers := []string{"Address", "ETH", "LINK", "Next nonce", "Is funding", "Policy", "Created", "Updated", "Deleted"}
 	rows := [][]string{}
 
 	for _, p := range ps {
@@ -63,6 +68,28 @@ func (ps EthKeyPresenters) RenderTable(rt RendererTable) error {
 	return nil
 }
 
+// formatKeyPolicy describes the usage policy of a key
+func formatKeyPolicy(policy ethkey.Policy) string {
+	if policy.FundingOnly {
+		return "funding-only"
+	}
+	var restrictions []string
+	if len(policy.AllowedJobTypes) > 0 {
+		restrictions = append(restrictions, "jobs: "+strings.Join(policy.AllowedJobTypes, ", "))
+	}
+	if len(policy.AllowedToAddresses) > 0 {
+		addresses := make([]string, len(policy.AllowedToAddresses))
+		for i, address := range policy.AllowedToAddresses {
+			addresses[i] = address.Hex()
+		}
+		restrictions = append(restrictions, "contracts: "+strings.Join(addresses, ", "))
+	}
+	if len(restrictions) == 0 {
+		return "unrestricted"
+	}
+	return strings.Join(restrictions, "; ")
+}
+
 // ListETHKeys renders the active account address with its ETH & LINK balance
 func (cli *Client) ListETHKeys(c *cli.Context) (err error) {
 	resp, err := cli.HTTP.Get("/v2/keys/eth")
@@ -188,7 +215,7 @@ func (cli *Client) ExportETHKey(c *cli.Context) (err error) {
 	address := c.Args().Get(0)
 
 	normalizedPassword := normalizePassword(string(newPassword))
-	resp, err := cli.HTTP.Post("/v2/keys/eth/export/"+address+"?newpassword="+normalizedPassword, nil)
+	resp, err := cli.HTTP.Post("/v2/keys/eth/export/"+address+"?newpassword="+normalizedPassword+scryptStrengthQuery(c), nil)
 	if err != nil {
 		return cli.errorOut(errors.Wrap(err, "Could not make HTTP request"))
 	}
@@ -219,3 +246,195 @@ func (cli *Client) ExportETHKey(c *cli.Context) (err error) {
 
 	return nil
 }
+
+type NonceReconciliationPresenter struct {
+	presenters.NonceReconciliationResource
+}
+
+// RenderTable implements TableRenderer
+func (p *NonceReconciliationPresenter) RenderTable(rt RendererTable) error {
+	var fastForwardedTo string
+	if p.FastForwardedTo != nil {
+		fastForwardedTo = fmt.Sprintf("%d", *p.FastForwardedTo)
+	}
+
+	headers := []string{"Address", "Chain nonce", "Pending chain nonce", "Local next nonce", "Fast-forwarded to", "Abandoned txs", "Rebroadcast txs"}
+	rows := [][]string{{
+		p.Address,
+		fmt.Sprintf("%d", p.ChainNonce),
+		fmt.Sprintf("%d", p.PendingChainNonce),
+		fmt.Sprintf("%d", p.LocalNextNonce),
+		fastForwardedTo,
+		strings.Join(p.AbandonedEthTxIDs, ", "),
+		strings.Join(p.RebroadcastEthTxIDs, ", "),
+	}}
+
+	renderList(headers, rows, rt.Writer)
+	return nil
+}
+
+// ReconcileETHKeyNonce reconciles the nonces of an ETH key with the chain,
+// address must be passed
+func (cli *Client) ReconcileETHKeyNonce(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return cli.errorOut(errors.New("Must pass the address of the key to reconcile"))
+	}
+
+	address := c.Args().Get(0)
+	resp, err := cli.HTTP.Post("/v2/keys/eth/reconcile_nonce/"+address, nil)
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return cli.renderAPIResponse(resp, &NonceReconciliationPresenter{}, "🔑 Reconciled ETH key nonce")
+}
+
+// SetETHKeyPolicy sets the usage policy of an ETH key
+func (cli *Client) SetETHKeyPolicy(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return cli.errorOut(errors.New("Must pass the address of the key"))
+	}
+	address := c.Args().Get(0)
+
+	policy := ethkey.Policy{
+		FundingOnly:     c.Bool("funding-only"),
+		AllowedJobTypes: c.StringSlice("job-types"),
+	}
+	for _, toAddress := range c.StringSlice("to-addresses") {
+		if !common.IsHexAddress(toAddress) {
+			return cli.errorOut(errors.Errorf("invalid contract address %s", toAddress))
+		}
+		policy.AllowedToAddresses = append(policy.AllowedToAddresses, common.HexToAddress(toAddress))
+	}
+	request, err := json.Marshal(policy)
+	if err != nil {
+		return cli.errorOut(err)
+	}
+
+	resp, err := cli.HTTP.Patch("/v2/keys/eth/policy/"+address, bytes.NewReader(request))
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return cli.renderAPIResponse(resp, &EthKeyPresenter{}, "🔑 Updated ETH key policy")
+}
+
+type KeyDiagnosisPresenter struct {
+	presenters.KeyDiagnosisResource
+}
+
+// RenderTable implements TableRenderer
+func (p *KeyDiagnosisPresenter) RenderTable(rt RendererTable) error {
+	var gasPrice string
+	if p.GasPriceWei != nil {
+		gasPrice = *p.GasPriceWei
+	}
+
+	headers := []string{"Address", "Chain nonce", "Pending chain nonce", "Local next nonce", "Balance (wei)", "Gas price (wei)", "Unconfirmed txs", "Healthy"}
+	rows := [][]string{{
+		p.Address,
+		fmt.Sprintf("%d", p.ChainNonce),
+		fmt.Sprintf("%d", p.PendingChainNonce),
+		fmt.Sprintf("%d", p.LocalNextNonce),
+		p.BalanceWei,
+		gasPrice,
+		strings.Join(p.UnconfirmedEthTxIDs, ", "),
+		fmt.Sprintf("%v", p.Healthy),
+	}}
+	renderList(headers, rows, rt.Writer)
+
+	if len(p.Issues) == 0 {
+		return nil
+	}
+	issueRows := [][]string{}
+	for _, issue := range p.Issues {
+		issueRows = append(issueRows, []string{
+			issue.Kind,
+			strings.Join(issue.EthTxIDs, ", "),
+			issue.Description,
+			issue.Remediation,
+		})
+	}
+	renderList([]string{"Issue", "Txs", "Description", "Remediation"}, issueRows, rt.Writer)
+	return nil
+}
+
+// DiagnoseETHKey explains why the unconfirmed transactions of an ETH key are
+// stuck, address must be passed
+func (cli *Client) DiagnoseETHKey(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return cli.errorOut(errors.New("Must pass the address of the key to diagnose"))
+	}
+
+	address := c.Args().Get(0)
+	resp, err := cli.HTTP.Get("/v2/keys/eth/diagnose/" + address)
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return cli.renderAPIResponse(resp, &KeyDiagnosisPresenter{}, "🩺 ETH key diagnosis")
+}
+
+type KeyRotationPresenter struct {
+	presenters.KeyRotationResource
+}
+
+// RenderTable implements TableRenderer
+func (p *KeyRotationPresenter) RenderTable(rt RendererTable) error {
+	var sweepEthTxID string
+	if p.SweepEthTxID != nil {
+		sweepEthTxID = *p.SweepEthTxID
+	}
+
+	headers := []string{"Old address", "New address", "Sweep tx", "Sweep value", "Restarted jobs", "Created"}
+	rows := [][]string{{
+		p.OldAddress,
+		p.NewAddress,
+		sweepEthTxID,
+		p.SweepValue,
+		strings.Join(p.JobIDs, ", "),
+		p.CreatedAt.String(),
+	}}
+
+	renderList(headers, rows, rt.Writer)
+	return nil
+}
+
+// RotateETHKey replaces a sending ETH key with a new one, address must be
+// passed
+func (cli *Client) RotateETHKey(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return cli.errorOut(errors.New("Must pass the address of the key to rotate"))
+	}
+	if !confirmAction(c) {
+		return nil
+	}
+
+	address := c.Args().Get(0)
+	resp, err := cli.HTTP.Post("/v2/keys/eth/rotate/"+address, nil)
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return cli.renderAPIResponse(resp, &KeyRotationPresenter{}, "🔑 Rotated ETH key")
+}
@@ -161,6 +161,48 @@ func TestClient_DeleteEthKey(t *testing.T) {
 	assert.Error(t, err)
 }
 
+func TestClient_SetETHKeyPolicy(t *testing.T) {
+	t.Parallel()
+
+	ethClient := newEthMock(t)
+	app := startNewApplication(t,
+		withKey(),
+		withMocks(ethClient),
+	)
+	ethKeyStore := app.GetKeyStore().Eth()
+	client, r := app.NewClientAndRenderer()
+
+	ethClient.On("Dial", mock.Anything)
+	ethClient.On("BalanceAt", mock.Anything, mock.Anything, mock.Anything).Maybe().Return(big.NewInt(42), nil)
+	ethClient.On("GetLINKBalance", mock.Anything, mock.Anything).Return(assets.NewLink(42), nil)
+
+	key, err := ethKeyStore.CreateNewKey()
+	require.NoError(t, err)
+	contract := cltest.NewAddress()
+
+	// Unknown job type
+	set := flag.NewFlagSet("test", 0)
+	set.Var(&cli.StringSlice{"unknown"}, "job-types", "")
+	set.Parse([]string{key.Address.Hex()})
+	require.Error(t, client.SetETHKeyPolicy(cli.NewContext(nil, set, nil)))
+
+	set = flag.NewFlagSet("test", 0)
+	set.Var(&cli.StringSlice{"vrf"}, "job-types", "")
+	set.Var(&cli.StringSlice{contract.Hex()}, "to-addresses", "")
+	set.Parse([]string{key.Address.Hex()})
+	require.NoError(t, client.SetETHKeyPolicy(cli.NewContext(nil, set, nil)))
+
+	require.Len(t, r.Renders, 1)
+	policy := r.Renders[0].(*cmd.EthKeyPresenter).Policy
+	assert.Equal(t, []string{"vrf"}, policy.AllowedJobTypes)
+	assert.Equal(t, []common.Address{contract}, policy.AllowedToAddresses)
+
+	key, err = ethKeyStore.KeyByAddress(key.Address.Address())
+	require.NoError(t, err)
+	assert.True(t, key.AllowsJob("vrf", contract))
+	assert.False(t, key.AllowsJob("fluxmonitor", contract))
+}
+
 func TestClient_ImportExportETHKey(t *testing.T) {
 	t.Parallel()
 
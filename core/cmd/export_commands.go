@@ -0,0 +1,83 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+
+	"github.com/pkg/errors"
+	clipkg "github.com/urfave/cli"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink/core/web"
+)
+
+// ExportDataset streams a dataset from the node as CSV or NDJSON to a file, or
+// to stdout if no file is given, and prints the cursor for the next export.
+func (cli *Client) ExportDataset(c *clipkg.Context) (err error) {
+	if !c.Args().Present() {
+		return cli.errorOut(errors.New("Must pass the name of the dataset to export"))
+	}
+
+	uri := url.URL{Path: "/v2/exports/" + c.Args().First()}
+	q := uri.Query()
+	if c.IsSet("after") {
+		q.Set("after", strconv.FormatInt(c.Int64("after"), 10))
+	}
+	if c.IsSet("limit") {
+		q.Set("limit", strconv.Itoa(c.Int("limit")))
+	}
+	for _, name := range []string{"format", "since", "until", "state", "address"} {
+		if value := c.String(name); value != "" {
+			q.Set(name, value)
+		}
+	}
+	if c.IsSet("job-id") {
+		q.Set("jobID", strconv.Itoa(c.Int("job-id")))
+	}
+	uri.RawQuery = q.Encode()
+
+	resp, err := cli.HTTP.Get(uri.String())
+	if err != nil {
+		return cli.errorOut(errors.Wrap(err, "Could not make HTTP request"))
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	if resp.StatusCode != http.StatusOK {
+		_, err = cli.parseResponse(resp)
+		return err
+	}
+
+	var out io.Writer = os.Stdout
+	if filepath := c.String("output"); filepath != "" {
+		f, err2 := os.Create(filepath)
+		if err2 != nil {
+			return cli.errorOut(errors.Wrapf(err2, "Could not create %v", filepath))
+		}
+		defer func() {
+			if cerr := f.Close(); cerr != nil {
+				err = multierr.Append(err, cerr)
+			}
+		}()
+		out = f
+	}
+
+	if _, err = io.Copy(out, resp.Body); err != nil {
+		return cli.errorOut(errors.Wrap(err, "Could not read response body"))
+	}
+
+	// The cursor is only sent once the whole dataset has been written
+	cursor := resp.Trailer.Get(web.ExportCursorTrailer)
+	if cursor == "" {
+		return cli.errorOut(errors.New("Export did not complete, check the node logs"))
+	}
+	_, err = fmt.Fprintf(os.Stderr, "Exported %s, pass --after %s to export the following rows\n", c.Args().First(), cursor)
+	return cli.errorOut(err)
+}
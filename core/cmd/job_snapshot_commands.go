@@ -0,0 +1,107 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"strconv"
+
+	"github.com/pkg/errors"
+	clipkg "github.com/urfave/cli"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/job"
+	"github.com/smartcontractkit/chainlink/core/services/postgres"
+	"github.com/smartcontractkit/chainlink/core/store/dialects"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+// ExportJobSnapshot run locally writes the state of a job to a file, from
+// which it can be imported into another node with ImportJobSnapshot
+func (cli *Client) ExportJobSnapshot(c *clipkg.Context) (err error) {
+	if !c.Args().Present() {
+		return cli.errorOut(errors.New("Must pass the ID of the job to export"))
+	}
+	jobID, err := strconv.ParseInt(c.Args().First(), 10, 32)
+	if err != nil {
+		return cli.errorOut(errors.Wrap(err, "invalid job ID"))
+	}
+	output := c.String("output")
+	if output == "" {
+		return cli.errorOut(errors.New("Must specify --output/-o flag"))
+	}
+
+	logger.SetLogger(cli.Config.CreateProductionLogger())
+	cli.Config.Dialect = dialects.PostgresWithoutLock
+	app, err := cli.AppFactory.NewApplication(cli.Config)
+	if err != nil {
+		return cli.errorOut(errors.Wrap(err, "creating application"))
+	}
+	defer func() {
+		if serr := app.Stop(); serr != nil {
+			err = multierr.Append(err, serr)
+		}
+	}()
+
+	snapshot, err := app.JobORM().ExportSnapshot(int32(jobID))
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	b, err := json.MarshalIndent(snapshot, "", "  ")
+	if err != nil {
+		return cli.errorOut(errors.Wrap(err, "failed to encode snapshot"))
+	}
+	if err = utils.WriteFileWithMaxPerms(output, b, 0600); err != nil {
+		return cli.errorOut(errors.Wrapf(err, "Could not write %v", output))
+	}
+
+	fmt.Printf("Exported job %d with %d pipeline runs, %d flux monitor rounds and %d log broadcasts to %s\n",
+		jobID, len(snapshot.PipelineRuns), len(snapshot.FluxMonitorRoundStats), len(snapshot.LogBroadcasts), output)
+	return nil
+}
+
+// ImportJobSnapshot run locally creates a job exported from another node
+// with ExportJobSnapshot, with its state. The job is started the next time
+// the node is started, or right away if the node is running.
+func (cli *Client) ImportJobSnapshot(c *clipkg.Context) (err error) {
+	if !c.Args().Present() {
+		return cli.errorOut(errors.New("Must pass the path of the snapshot to import"))
+	}
+	b, err := ioutil.ReadFile(c.Args().First())
+	if err != nil {
+		return cli.errorOut(errors.Wrap(err, "could not read snapshot"))
+	}
+	var snapshot job.Snapshot
+	if err = json.Unmarshal(b, &snapshot); err != nil {
+		return cli.errorOut(errors.Wrap(err, "could not decode snapshot"))
+	}
+
+	logger.SetLogger(cli.Config.CreateProductionLogger())
+	cli.Config.Dialect = dialects.PostgresWithoutLock
+	app, err := cli.AppFactory.NewApplication(cli.Config)
+	if err != nil {
+		return cli.errorOut(errors.Wrap(err, "creating application"))
+	}
+	defer func() {
+		if serr := app.Stop(); serr != nil {
+			err = multierr.Append(err, serr)
+		}
+	}()
+
+	var jb job.Job
+	ctx, cancel := postgres.DefaultQueryCtx()
+	defer cancel()
+	txm := postgres.NewGormTransactionManager(app.GetStore().DB)
+	err = txm.TransactWithContext(ctx, func(ctx context.Context) error {
+		jb, err = app.JobORM().ImportSnapshot(ctx, snapshot)
+		return err
+	})
+	if err != nil {
+		return cli.errorOut(errors.Wrap(err, "failed to import job"))
+	}
+
+	fmt.Printf("Imported job %s as job %d\n", snapshot.Job.ExternalJobID, jb.ID)
+	return nil
+}
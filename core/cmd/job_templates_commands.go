@@ -0,0 +1,113 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/urfave/cli"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink/core/services/job"
+	"github.com/smartcontractkit/chainlink/core/web"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+// JobTemplatePresenter wraps the JSONAPI job template resource and adds
+// rendering functionality
+type JobTemplatePresenter struct {
+	JAID
+	presenters.JobTemplateResource
+}
+
+func (p JobTemplatePresenter) toRow() []string {
+	return []string{p.Name, strings.Join(p.Variables, ", "), p.UpdatedAt.String()}
+}
+
+// RenderTable implements TableRenderer
+func (p *JobTemplatePresenter) RenderTable(rt RendererTable) error {
+	table := rt.newTable([]string{"Name", "Variables", "Updated At"})
+	table.Append(p.toRow())
+	render("Job Template", table)
+	return nil
+}
+
+// JobTemplatePresenters is a list of job templates
+type JobTemplatePresenters []JobTemplatePresenter
+
+// RenderTable implements TableRenderer
+func (ps JobTemplatePresenters) RenderTable(rt RendererTable) error {
+	table := rt.newTable([]string{"Name", "Variables", "Updated At"})
+	for _, p := range ps {
+		table.Append(p.toRow())
+	}
+	render("Job Templates", table)
+	return nil
+}
+
+// ListJobTemplates lists the templates which jobs can be created from
+func (cli *Client) ListJobTemplates(c *cli.Context) (err error) {
+	return cli.getPage("/v2/job_templates", c.Int("page"), &JobTemplatePresenters{})
+}
+
+// CreateJobTemplate creates a template from a file holding a job spec with
+// {{.Var}} placeholders
+func (cli *Client) CreateJobTemplate(c *cli.Context) (err error) {
+	if c.NArg() != 2 {
+		return cli.errorOut(errors.New("must pass the name of the template and the path of its file"))
+	}
+	b, err := ioutil.ReadFile(c.Args().Get(1))
+	if err != nil {
+		return cli.errorOut(errors.Wrap(err, "could not read template"))
+	}
+
+	request, err := json.Marshal(web.CreateJobTemplateRequest{Name: c.Args().First(), TOML: string(b)})
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	resp, err := cli.HTTP.Post("/v2/job_templates", bytes.NewReader(request))
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return cli.renderAPIResponse(resp, &JobTemplatePresenter{}, "Job template created")
+}
+
+// DeleteJobTemplate deletes a template. The jobs created from it are kept.
+func (cli *Client) DeleteJobTemplate(c *cli.Context) error {
+	if !c.Args().Present() {
+		return cli.errorOut(errors.New("must pass the name of the template"))
+	}
+	resp, err := cli.HTTP.Delete("/v2/job_templates/" + c.Args().First())
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	_, err = cli.parseResponse(resp)
+	if err != nil {
+		return cli.errorOut(err)
+	}
+
+	fmt.Printf("Job template %v deleted\n", c.Args().First())
+	return nil
+}
+
+// parseTemplateVariables parses Name=value pairs
+func parseTemplateVariables(pairs []string) (job.TemplateVariables, error) {
+	variables := job.TemplateVariables{}
+	for _, pair := range pairs {
+		parts := strings.SplitN(pair, "=", 2)
+		if len(parts) != 2 {
+			return nil, errors.Errorf("invalid variable %q, must be Name=value", pair)
+		}
+		variables[parts[0]] = parts[1]
+	}
+	return variables, nil
+}
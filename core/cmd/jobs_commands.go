@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
+	"net/url"
 	"time"
 
 	"github.com/pkg/errors"
@@ -146,26 +147,35 @@ func (ps JobPresenters) RenderTable(rt RendererTable) error {
 	return nil
 }
 
-// ListJobsV2 lists all v2 jobs
+// ListJobsV2 lists all v2 jobs, or those with all the labels of --labels
 func (cli *Client) ListJobsV2(c *cli.Context) (err error) {
-	return cli.getPage("/v2/jobs", c.Int("page"), &JobPresenters{})
+	requestURI := "/v2/jobs"
+	if labels := c.String("labels"); labels != "" {
+		requestURI += "?labels=" + url.QueryEscape(labels)
+	}
+	return cli.getPage(requestURI, c.Int("page"), &JobPresenters{})
 }
 
 // CreateJobV2 creates a V2 job
-// Valid input is a TOML string or a path to TOML file
+// Valid input is a TOML string or a path to TOML file, or the name of a
+// template with --template and its variables with --var
 func (cli *Client) CreateJobV2(c *cli.Context) (err error) {
-	if !c.Args().Present() {
-		return cli.errorOut(errors.New("must pass in TOML or filepath"))
-	}
-
-	tomlString, err := getTOMLString(c.Args().First())
-	if err != nil {
-		return cli.errorOut(err)
+	var createRequest web.CreateJobRequest
+	if template := c.String("template"); template != "" {
+		createRequest.Template = template
+		if createRequest.Variables, err = parseTemplateVariables(c.StringSlice("var")); err != nil {
+			return cli.errorOut(err)
+		}
+	} else {
+		if !c.Args().Present() {
+			return cli.errorOut(errors.New("must pass in TOML or filepath"))
+		}
+		if createRequest.TOML, err = getTOMLString(c.Args().First()); err != nil {
+			return cli.errorOut(err)
+		}
 	}
 
-	request, err := json.Marshal(web.CreateJobRequest{
-		TOML: tomlString,
-	})
+	request, err := json.Marshal(createRequest)
 	if err != nil {
 		return cli.errorOut(err)
 	}
@@ -212,6 +222,24 @@ func (cli *Client) DeleteJobV2(c *cli.Context) error {
 	return nil
 }
 
+// ReactivateJobV2 lifts the quarantine of a V2 job which kept failing to start
+func (cli *Client) ReactivateJobV2(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return cli.errorOut(errors.New("must pass the job id to be reactivated"))
+	}
+	resp, err := cli.HTTP.Post("/v2/jobs/"+c.Args().First()+"/reactivate", nil)
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return cli.renderAPIResponse(resp, &JobPresenter{}, "Job reactivated")
+}
+
 // Migrate jobs from the v1 (json) to v2 (toml) format.
 func (cli *Client) Migrate(c *cli.Context) error {
 	if !c.Args().Present() {
@@ -3,6 +3,8 @@ package cmd_test
 import (
 	"bytes"
 	"flag"
+	"io/ioutil"
+	"path/filepath"
 	"testing"
 	"time"
 
@@ -342,6 +344,103 @@ func TestClient_DeleteJobV2(t *testing.T) {
 	requireJobsCount(t, app.JobORM(), 0)
 }
 
+func TestClient_DiffJobV2(t *testing.T) {
+	t.Parallel()
+
+	app := startNewApplication(t)
+	client, r := app.NewClientAndRenderer()
+
+	fs := flag.NewFlagSet("", flag.ExitOnError)
+	fs.Parse([]string{"../testdata/tomlspecs/direct-request-spec.toml"})
+	require.NoError(t, client.CreateJobV2(cli.NewContext(nil, fs, nil)))
+	output := *r.Renders[0].(*cmd.JobPresenter)
+
+	// Must supply the job id and the spec
+	set := flag.NewFlagSet("test", 0)
+	set.Parse([]string{output.ID})
+	require.Error(t, client.DiffJobV2(cli.NewContext(nil, set, nil)))
+
+	set = flag.NewFlagSet("test", 0)
+	set.Parse([]string{output.ID, "../testdata/tomlspecs/direct-request-spec.toml"})
+	require.NoError(t, client.DiffJobV2(cli.NewContext(nil, set, nil)))
+	diff := r.Renders[len(r.Renders)-1].(*cmd.JobDiff)
+	assert.True(t, diff.Empty(), "unexpected diff %+v", diff)
+
+	updated := `
+type                = "directrequest"
+schemaVersion       = 1
+name                = "updated eth request event spec"
+contractAddress     = "0x613a38ac1659769640aae063c651f48e0250454c"
+externalJobID       = "0eec7e1d-d0d2-476c-a1a8-72dfb6633f47"
+observationSource   = """
+    ds1          [type=http method=GET url="http://example.com" allowunrestrictednetworkaccess="true"];
+    ds1_parse    [type=jsonparse path="USD"];
+    ds1_multiply [type=multiply times=1000];
+    ds1_divide   [type=divide divisor=10];
+    ds1 -> ds1_parse -> ds1_multiply -> ds1_divide;
+"""
+`
+	set = flag.NewFlagSet("test", 0)
+	set.Parse([]string{output.ID, updated})
+	require.NoError(t, client.DiffJobV2(cli.NewContext(nil, set, nil)))
+	diff = r.Renders[len(r.Renders)-1].(*cmd.JobDiff)
+
+	assert.Equal(t, []cmd.FieldDiff{{Field: "name", Local: "updated eth request event spec", Running: "example eth request event spec"}}, diff.Fields)
+	require.Len(t, diff.Tasks, 2)
+	assert.Equal(t, "ds1_divide", diff.Tasks[0].Task)
+	assert.Equal(t, "added", diff.Tasks[0].Change)
+	assert.Equal(t, cmd.TaskDiff{
+		Task:       "ds1_multiply",
+		Change:     "changed",
+		Attributes: []cmd.FieldDiff{{Field: "times", Local: "1000", Running: "100"}},
+	}, diff.Tasks[1])
+	assert.Equal(t, []cmd.EdgeDiff{{From: "ds1_multiply", To: "ds1_divide", Change: "added"}}, diff.Edges)
+}
+
+func TestClient_ValidateJobV2(t *testing.T) {
+	t.Parallel()
+
+	app := startNewApplication(t)
+	client, r := app.NewClientAndRenderer()
+
+	graph := filepath.Join(t.TempDir(), "pipeline.dot")
+	set := flag.NewFlagSet("test", 0)
+	set.Bool("offline", true, "")
+	set.String("graph", graph, "")
+	set.Parse([]string{"../testdata/tomlspecs/direct-request-spec.toml"})
+	require.NoError(t, client.ValidateJobV2(cli.NewContext(nil, set, nil)))
+	validation := r.Renders[len(r.Renders)-1].(*cmd.SpecValidation)
+	assert.True(t, validation.Valid())
+
+	dot, err := ioutil.ReadFile(graph)
+	require.NoError(t, err)
+	assert.Contains(t, string(dot), `"ds1" -> "ds1_parse";`)
+
+	withBridge := `
+type                = "directrequest"
+schemaVersion       = 1
+contractAddress     = "0x613a38ac1659769640aae063c651f48e0250454c"
+observationSource   = """
+    ds1       [type=bridge name=nonexistent];
+    ds1_parse [type=jsonparse path="USD"];
+    ds1 -> ds1_parse;
+"""
+`
+	set = flag.NewFlagSet("test", 0)
+	set.Bool("offline", false, "")
+	set.Parse([]string{withBridge})
+	require.Error(t, client.ValidateJobV2(cli.NewContext(nil, set, nil)))
+	validation = r.Renders[len(r.Renders)-1].(*cmd.SpecValidation)
+	assert.False(t, validation.Valid())
+	assert.Contains(t, validation.Checks, cmd.SpecCheck{Check: "bridge nonexistent", Status: "failed", Detail: "does not exist"})
+
+	set = flag.NewFlagSet("test", 0)
+	set.Parse([]string{`type = "directrequest"`})
+	require.Error(t, client.ValidateJobV2(cli.NewContext(nil, set, nil)))
+	validation = r.Renders[len(r.Renders)-1].(*cmd.SpecValidation)
+	assert.Equal(t, "failed", validation.Checks[0].Status)
+}
+
 func requireJobsCount(t *testing.T, orm job.ORM, expected int) {
 	jobs, _, err := orm.JobsV2(0, 1000)
 	require.NoError(t, err)
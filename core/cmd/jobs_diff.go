@@ -0,0 +1,419 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/pelletier/go-toml"
+	"github.com/pkg/errors"
+	uuid "github.com/satori/go.uuid"
+	"github.com/urfave/cli"
+	"go.uber.org/multierr"
+	"gonum.org/v1/gonum/graph/topo"
+
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+)
+
+// jobSpecAttributes are the attributes of the JSONAPI job resource holding
+// the type specific fields of each type of job
+var jobSpecAttributes = map[string]string{
+	"directrequest":     "directRequestSpec",
+	"fluxmonitor":       "fluxMonitorSpec",
+	"offchainreporting": "offChainReportingOracleSpec",
+	"keeper":            "keeperSpec",
+	"cron":              "cronSpec",
+	"vrf":               "vrfSpec",
+	"webhook":           "webhookSpec",
+}
+
+// jobDiffIgnoredFields are fields of running jobs which are managed by the
+// node rather than set by their TOML spec
+var jobDiffIgnoredFields = map[string]bool{
+	"id":        true,
+	"createdAt": true,
+	"updatedAt": true,
+	"initiator": true,
+}
+
+// jobDiffUnsetFields are fields which get a new value when they are not set
+// by the TOML spec, and so are not compared unless they are
+var jobDiffUnsetFields = map[string]bool{
+	"externalJobID": true,
+}
+
+const (
+	diffAdded   = "added"
+	diffRemoved = "removed"
+	diffChanged = "changed"
+)
+
+// FieldDiff is a field of a job spec whose local value differs from the
+// running one. Empty values are unset.
+type FieldDiff struct {
+	Field   string `json:"field"`
+	Local   string `json:"local"`
+	Running string `json:"running"`
+}
+
+// TaskDiff is a task of the pipeline which is added, removed or changed by
+// the local spec
+type TaskDiff struct {
+	Task       string      `json:"task"`
+	Change     string      `json:"change"`
+	Attributes []FieldDiff `json:"attributes"`
+}
+
+// EdgeDiff is a dependency between tasks of the pipeline which is added or
+// removed by the local spec
+type EdgeDiff struct {
+	From   string `json:"from"`
+	To     string `json:"to"`
+	Change string `json:"change"`
+}
+
+// JobDiff is what updating a running job to a local TOML spec would change
+type JobDiff struct {
+	JobID  string      `json:"jobID"`
+	Fields []FieldDiff `json:"fields"`
+	Tasks  []TaskDiff  `json:"tasks"`
+	Edges  []EdgeDiff  `json:"edges"`
+}
+
+// Empty returns whether the local spec matches the running job
+func (d JobDiff) Empty() bool {
+	return len(d.Fields) == 0 && len(d.Tasks) == 0 && len(d.Edges) == 0
+}
+
+// RenderTable implements TableRenderer
+func (d *JobDiff) RenderTable(rt RendererTable) error {
+	if d.Empty() {
+		_, err := fmt.Fprintf(rt, "Job %s matches the local spec\n", d.JobID)
+		return err
+	}
+
+	if len(d.Fields) > 0 {
+		table := rt.newTable([]string{"Field", "Local", "Running"})
+		for _, f := range d.Fields {
+			table.Append([]string{f.Field, displayDiffValue(f.Local), displayDiffValue(f.Running)})
+		}
+		render(fmt.Sprintf("Job %s fields", d.JobID), table)
+	}
+	if len(d.Tasks) > 0 {
+		table := rt.newTable([]string{"Task", "Change", "Attribute", "Local", "Running"})
+		table.SetAutoMergeCells(true)
+		for _, t := range d.Tasks {
+			if len(t.Attributes) == 0 {
+				table.Append([]string{t.Task, t.Change, "", "", ""})
+			}
+			for _, a := range t.Attributes {
+				table.Append([]string{t.Task, t.Change, a.Field, displayDiffValue(a.Local), displayDiffValue(a.Running)})
+			}
+		}
+		render(fmt.Sprintf("Job %s pipeline tasks", d.JobID), table)
+	}
+	if len(d.Edges) > 0 {
+		table := rt.newTable([]string{"From", "To", "Change"})
+		for _, e := range d.Edges {
+			table.Append([]string{e.From, e.To, e.Change})
+		}
+		render(fmt.Sprintf("Job %s pipeline dependencies", d.JobID), table)
+	}
+	return nil
+}
+
+func displayDiffValue(v string) string {
+	if v == "" {
+		return "(unset)"
+	}
+	return v
+}
+
+// DiffJobV2 compares a local TOML spec with the spec of a running job, to
+// verify what updating the job to it would change
+func (cli *Client) DiffJobV2(c *cli.Context) (err error) {
+	if c.NArg() != 2 {
+		return cli.errorOut(errors.New("must pass the job id and the TOML or filepath of the local spec"))
+	}
+	jobID := c.Args().Get(0)
+	tomlString, err := getTOMLString(c.Args().Get(1))
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	local, err := toml.Load(tomlString)
+	if err != nil {
+		return cli.errorOut(errors.Wrap(err, "failed to parse local spec"))
+	}
+
+	resp, err := cli.HTTP.Get("/v2/jobs/" + jobID)
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+	body, err := cli.parseResponse(resp)
+	if err != nil {
+		return err
+	}
+	var document struct {
+		Data struct {
+			Attributes map[string]interface{} `json:"attributes"`
+		} `json:"data"`
+	}
+	if err = json.Unmarshal(body, &document); err != nil {
+		return cli.errorOut(errors.Wrap(err, "failed to parse running job"))
+	}
+
+	diff, err := diffJobSpecs(local.ToMap(), document.Data.Attributes)
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	diff.JobID = jobID
+	return cli.errorOut(cli.Render(&diff))
+}
+
+// diffJobSpecs compares the fields of a local TOML spec with the attributes
+// of the JSONAPI resource of a running job, and their pipelines task by task
+func diffJobSpecs(local, running map[string]interface{}) (JobDiff, error) {
+	localFields := make(map[string]string)
+	flattenTOML("", local, localFields)
+	localSource := localFields["observationSource"]
+	delete(localFields, "observationSource")
+
+	runningFields := make(map[string]string)
+	for _, field := range []string{"type", "schemaVersion", "name", "maxTaskDuration", "externalJobID"} {
+		runningFields[field] = normalizeSpecValue(running[field])
+	}
+	jobType, _ := running["type"].(string)
+	if spec, ok := running[jobSpecAttributes[jobType]].(map[string]interface{}); ok {
+		for field, value := range spec {
+			if !jobDiffIgnoredFields[field] {
+				runningFields[field] = normalizeSpecValue(value)
+			}
+		}
+	}
+	var runningSource string
+	if pipelineSpec, ok := running["pipelineSpec"].(map[string]interface{}); ok {
+		runningSource, _ = pipelineSpec["dotDagSource"].(string)
+	}
+
+	var diff JobDiff
+	diff.Fields = diffFields(localFields, runningFields, func(field string) bool {
+		_, known := runningFields[field]
+		return known
+	})
+
+	var err error
+	diff.Tasks, diff.Edges, err = diffPipelines(localSource, runningSource)
+	return diff, err
+}
+
+// diffFields returns the fields whose values differ, ordered by name. Fields
+// which are only set locally are only compared if known returns true, as the
+// running job may not report them.
+func diffFields(local, running map[string]string, known func(field string) bool) []FieldDiff {
+	fields := make(map[string]bool)
+	for field, value := range local {
+		if value != "" && known(field) {
+			fields[field] = true
+		}
+	}
+	for field, value := range running {
+		if value != "" && (local[field] != "" || !jobDiffUnsetFields[field]) {
+			fields[field] = true
+		}
+	}
+
+	diffs := []FieldDiff{}
+	for field := range fields {
+		if local[field] != running[field] {
+			diffs = append(diffs, FieldDiff{Field: field, Local: local[field], Running: running[field]})
+		}
+	}
+	sort.Slice(diffs, func(i, j int) bool { return diffs[i].Field < diffs[j].Field })
+	return diffs
+}
+
+// diffPipelines compares the tasks, their attributes and the dependencies of
+// two pipelines in DOT
+func diffPipelines(localSource, runningSource string) ([]TaskDiff, []EdgeDiff, error) {
+	localTasks, localEdges, err := parsePipelineDAG(localSource)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to parse local observationSource")
+	}
+	runningTasks, runningEdges, err := parsePipelineDAG(runningSource)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to parse running observationSource")
+	}
+
+	ids := make(map[string]bool)
+	for id := range localTasks {
+		ids[id] = true
+	}
+	for id := range runningTasks {
+		ids[id] = true
+	}
+	tasks := []TaskDiff{}
+	for id := range ids {
+		localAttrs, inLocal := localTasks[id]
+		runningAttrs, inRunning := runningTasks[id]
+		attrs := diffFields(localAttrs, runningAttrs, func(string) bool { return true })
+		switch {
+		case !inRunning:
+			tasks = append(tasks, TaskDiff{Task: id, Change: diffAdded, Attributes: attrs})
+		case !inLocal:
+			tasks = append(tasks, TaskDiff{Task: id, Change: diffRemoved, Attributes: attrs})
+		case len(attrs) > 0:
+			tasks = append(tasks, TaskDiff{Task: id, Change: diffChanged, Attributes: attrs})
+		}
+	}
+	sort.Slice(tasks, func(i, j int) bool { return tasks[i].Task < tasks[j].Task })
+
+	edges := []EdgeDiff{}
+	for edge := range localEdges {
+		if !runningEdges[edge] {
+			edges = append(edges, EdgeDiff{From: edge[0], To: edge[1], Change: diffAdded})
+		}
+	}
+	for edge := range runningEdges {
+		if !localEdges[edge] {
+			edges = append(edges, EdgeDiff{From: edge[0], To: edge[1], Change: diffRemoved})
+		}
+	}
+	sort.Slice(edges, func(i, j int) bool {
+		if edges[i].From != edges[j].From {
+			return edges[i].From < edges[j].From
+		}
+		return edges[i].To < edges[j].To
+	})
+	return tasks, edges, nil
+}
+
+// parsePipelineDAG returns the normalized attributes of the tasks of a
+// pipeline by DOT ID, and its edges
+func parsePipelineDAG(source string) (map[string]map[string]string, map[[2]string]bool, error) {
+	tasks := make(map[string]map[string]string)
+	edges := make(map[[2]string]bool)
+	if strings.TrimSpace(source) == "" {
+		return tasks, edges, nil
+	}
+
+	g := pipeline.NewGraph()
+	if err := g.UnmarshalText([]byte(source)); err != nil {
+		return nil, nil, err
+	}
+	nodes, err := topo.SortStabilized(g, nil)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "cycle detected")
+	}
+	for _, n := range nodes {
+		node := n.(*pipeline.GraphNode)
+		attrs := make(map[string]string)
+		for _, attr := range node.Attributes() {
+			attrs[attr.Key] = normalizeDAGAttribute(attr.Value)
+		}
+		tasks[node.DOTID()] = attrs
+
+		for inputs := g.To(node.ID()); inputs.Next(); {
+			from := inputs.Node().(*pipeline.GraphNode)
+			edges[[2]string{from.DOTID(), node.DOTID()}] = true
+		}
+	}
+	return tasks, edges, nil
+}
+
+// flattenTOML normalizes the values of a TOML document, naming those of
+// nested tables after the camel cased path to them, e.g. pollTimerPeriod for
+// the period of the pollTimer table, like the attributes of job resources
+func flattenTOML(prefix string, tree map[string]interface{}, fields map[string]string) {
+	for key, value := range tree {
+		field := key
+		if prefix != "" {
+			field = prefix + upperFirst(key)
+		}
+		if table, ok := value.(map[string]interface{}); ok {
+			flattenTOML(field, table, fields)
+			continue
+		}
+		fields[field] = normalizeSpecValue(value)
+	}
+}
+
+func upperFirst(s string) string {
+	r, size := utf8.DecodeRuneInString(s)
+	return string(unicode.ToUpper(r)) + s[size:]
+}
+
+// normalizeSpecValue formats a TOML or JSON value so that equivalent values
+// compare equal, e.g. durations, numbers and hex of different spellings. Zero
+// values are normalized to the empty string, like unset ones.
+func normalizeSpecValue(value interface{}) string {
+	var s string
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		s = normalizeSpecString(v)
+	case bool:
+		s = strconv.FormatBool(v)
+	case int64:
+		s = strconv.FormatInt(v, 10)
+	case uint64:
+		s = strconv.FormatUint(v, 10)
+	case float64:
+		s = strconv.FormatFloat(v, 'f', -1, 64)
+	case time.Time:
+		s = v.UTC().Format(time.RFC3339Nano)
+	case []interface{}:
+		elems := make([]string, len(v))
+		for i, elem := range v {
+			elems[i] = normalizeSpecValue(elem)
+		}
+		s = strings.Join(elems, ",")
+	default:
+		b, err := json.Marshal(v)
+		if err != nil {
+			return fmt.Sprint(v)
+		}
+		s = string(b)
+	}
+
+	switch s {
+	case "0", "0s", "false", "{}", "null":
+		return ""
+	}
+	return s
+}
+
+func normalizeSpecString(s string) string {
+	s = strings.TrimSpace(s)
+	if d, err := time.ParseDuration(s); err == nil {
+		return d.String()
+	}
+	if strings.HasPrefix(s, "0x") || strings.HasPrefix(s, "0X") {
+		return strings.ToLower(s)
+	}
+	if id, err := uuid.FromString(s); err == nil {
+		return id.String()
+	}
+	return s
+}
+
+// normalizeDAGAttribute compacts JSON attributes and collapses the
+// whitespace of others, as neither changes the task
+func normalizeDAGAttribute(value string) string {
+	var compacted bytes.Buffer
+	if err := json.Compact(&compacted, []byte(value)); err == nil {
+		return compacted.String()
+	}
+	return strings.Join(strings.Fields(value), " ")
+}
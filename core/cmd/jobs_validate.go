@@ -0,0 +1,254 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/urfave/cli"
+	"go.uber.org/multierr"
+	gormpostgres "gorm.io/driver/postgres"
+	"gorm.io/gorm"
+
+	"github.com/smartcontractkit/chainlink/core/services/job"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+	"github.com/smartcontractkit/chainlink/core/services/webhook"
+	"github.com/smartcontractkit/chainlink/core/store/models"
+	"github.com/smartcontractkit/chainlink/core/web"
+)
+
+const (
+	specCheckPassed  = "passed"
+	specCheckFailed  = "failed"
+	specCheckSkipped = "skipped"
+)
+
+// SpecCheck is the outcome of one of the checks of a job spec
+type SpecCheck struct {
+	Check  string `json:"check"`
+	Status string `json:"status"`
+	Detail string `json:"detail"`
+}
+
+// SpecValidation are the outcomes of all the checks of a job spec
+type SpecValidation struct {
+	Checks []SpecCheck `json:"checks"`
+}
+
+// Valid returns whether none of the checks failed
+func (v SpecValidation) Valid() bool {
+	for _, check := range v.Checks {
+		if check.Status == specCheckFailed {
+			return false
+		}
+	}
+	return true
+}
+
+func (v *SpecValidation) add(check, status, detail string) {
+	v.Checks = append(v.Checks, SpecCheck{Check: check, Status: status, Detail: detail})
+}
+
+// RenderTable implements TableRenderer
+func (v *SpecValidation) RenderTable(rt RendererTable) error {
+	table := rt.newTable([]string{"Check", "Status", "Detail"})
+	for _, check := range v.Checks {
+		table.Append([]string{check.Check, check.Status, check.Detail})
+	}
+	render("Job spec validation", table)
+	return nil
+}
+
+// ValidateJobV2 runs the validations the node runs on a V2 job spec, locally
+// and without a running node. Unless --offline is passed, the bridges, keys
+// and external initiators the spec references are looked up in the database
+// of the node.
+// Valid input is a TOML string or a path to TOML file
+func (cli *Client) ValidateJobV2(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return cli.errorOut(errors.New("must pass in TOML or filepath"))
+	}
+	tomlString, err := getTOMLString(c.Args().First())
+	if err != nil {
+		return cli.errorOut(err)
+	}
+
+	var v SpecValidation
+	eis := &recordingExternalInitiators{}
+	jb, ok := validateJobSpec(&v, cli, eis, tomlString)
+	if ok {
+		if c.Bool("offline") {
+			v.add("references", specCheckSkipped, "bridges, keys and external initiators are not checked offline")
+		} else if err = cli.validateJobReferences(&v, jb, eis.names); err != nil {
+			return cli.errorOut(err)
+		}
+
+		if graph := c.String("graph"); graph != "" {
+			if err = writePipelineGraph(graph, jb); err != nil {
+				return cli.errorOut(err)
+			}
+		}
+	}
+
+	if err = cli.Render(&v); err != nil {
+		return cli.errorOut(err)
+	}
+	if !v.Valid() {
+		return cli.errorOut(errors.New("job spec is invalid"))
+	}
+	return nil
+}
+
+// validateJobSpec runs the common and the type specific validations of the
+// spec, and returns the job if they pass
+func validateJobSpec(v *SpecValidation, cli *Client, eis *recordingExternalInitiators, tomlString string) (job.Job, bool) {
+	jobType, err := job.ValidateSpec(tomlString)
+	if err != nil {
+		v.add("spec", specCheckFailed, err.Error())
+		return job.Job{}, false
+	}
+	v.add("spec", specCheckPassed, fmt.Sprintf("%s job", jobType))
+
+	jb, err := web.ValidatedJobSpec(cli.Config, eis, jobType, tomlString)
+	if err != nil {
+		v.add(fmt.Sprintf("%s spec", jobType), specCheckFailed, err.Error())
+		return jb, false
+	}
+	v.add(fmt.Sprintf("%s spec", jobType), specCheckPassed, "")
+
+	if jobType == job.OffchainReporting && !cli.Config.Dev() && !cli.Config.FeatureOffchainReporting() {
+		v.add("features", specCheckFailed, "the Offchain Reporting feature is disabled by configuration")
+	}
+	if len(jb.Pipeline.Tasks) > 0 {
+		v.add("pipeline", specCheckPassed, fmt.Sprintf("%d tasks", len(jb.Pipeline.Tasks)))
+	}
+	return jb, true
+}
+
+// jobReference is a record which must exist in the database of the node for
+// a job to be created
+type jobReference struct {
+	check string
+	query string
+	arg   interface{}
+}
+
+// validateJobReferences looks up the records referenced by the job in the
+// database of the node, without locking it
+func (cli *Client) validateJobReferences(v *SpecValidation, jb job.Job, externalInitiators []string) (err error) {
+	var refs []jobReference
+	for _, task := range jb.Pipeline.Tasks {
+		if bridge, ok := task.(*pipeline.BridgeTask); ok {
+			refs = append(refs, jobReference{"bridge " + bridge.Name, `SELECT EXISTS(SELECT 1 FROM bridge_types WHERE name = ?)`, bridge.Name})
+		}
+	}
+	if spec := jb.OffchainreportingOracleSpec; spec != nil {
+		if spec.P2PPeerID != nil {
+			refs = append(refs, jobReference{"p2p key " + spec.P2PPeerID.Raw(), `SELECT EXISTS(SELECT 1 FROM encrypted_p2p_keys WHERE peer_id = ? AND deleted_at IS NULL)`, spec.P2PPeerID})
+		}
+		if spec.EncryptedOCRKeyBundleID != nil {
+			refs = append(refs, jobReference{"ocr key bundle " + spec.EncryptedOCRKeyBundleID.String(), `SELECT EXISTS(SELECT 1 FROM encrypted_ocr_key_bundles WHERE id = ? AND deleted_at IS NULL)`, spec.EncryptedOCRKeyBundleID})
+		}
+		if spec.TransmitterAddress != nil {
+			refs = append(refs, jobReference{"eth key " + spec.TransmitterAddress.Hex(), `SELECT EXISTS(SELECT 1 FROM keys WHERE address = ? AND deleted_at IS NULL)`, spec.TransmitterAddress})
+		}
+	}
+	if spec := jb.KeeperSpec; spec != nil {
+		refs = append(refs, jobReference{"eth key " + spec.FromAddress.Hex(), `SELECT EXISTS(SELECT 1 FROM keys WHERE address = ? AND deleted_at IS NULL)`, spec.FromAddress})
+	}
+	if spec := jb.VRFSpec; spec != nil {
+		refs = append(refs, jobReference{"vrf key " + spec.PublicKey.String(), `SELECT EXISTS(SELECT 1 FROM encrypted_vrf_keys WHERE public_key = ? AND deleted_at IS NULL)`, spec.PublicKey})
+	}
+	for _, name := range externalInitiators {
+		refs = append(refs, jobReference{"external initiator " + name, `SELECT EXISTS(SELECT 1 FROM external_initiators WHERE name = ?)`, name})
+	}
+	if len(refs) == 0 {
+		return nil
+	}
+
+	dbURL := cli.Config.DatabaseURL()
+	db, err := gorm.Open(gormpostgres.New(gormpostgres.Config{
+		DSN: dbURL.String(),
+	}), &gorm.Config{})
+	if err != nil {
+		return errors.Wrap(err, "could not connect to the database, pass --offline to skip checking references")
+	}
+	sqlDB, err := db.DB()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if cerr := sqlDB.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	for _, ref := range refs {
+		var exists bool
+		if err = db.Raw(ref.query, ref.arg).Scan(&exists).Error; err != nil {
+			return errors.Wrapf(err, "failed to look up %s", ref.check)
+		}
+		if exists {
+			v.add(ref.check, specCheckPassed, "")
+		} else {
+			v.add(ref.check, specCheckFailed, "does not exist")
+		}
+	}
+	return nil
+}
+
+// recordingExternalInitiators resolves any external initiator of a webhook
+// spec, recording their names so that they can be looked up afterwards
+type recordingExternalInitiators struct {
+	webhook.ExternalInitiatorManager
+	names []string
+}
+
+func (r *recordingExternalInitiators) FindExternalInitiatorByName(name string) (models.ExternalInitiator, error) {
+	r.names = append(r.names, name)
+	return models.ExternalInitiator{Name: name}, nil
+}
+
+// writePipelineGraph writes a Graphviz rendering of the pipeline of the job
+// to a file, or to stdout if the path is -
+func writePipelineGraph(path string, jb job.Job) (err error) {
+	var w io.Writer = os.Stdout
+	if path != "-" {
+		f, err2 := os.Create(path)
+		if err2 != nil {
+			return errors.Wrapf(err2, "Could not create %v", path)
+		}
+		defer func() {
+			if cerr := f.Close(); cerr != nil {
+				err = multierr.Append(err, cerr)
+			}
+		}()
+		w = f
+	}
+	_, err = io.WriteString(w, pipelineGraph(jb))
+	return err
+}
+
+// pipelineGraph renders the tasks of the pipeline of the job, labelled with
+// their type, and their dependencies in the DOT language
+func pipelineGraph(jb job.Job) string {
+	var b strings.Builder
+	name := jb.Name.ValueOrZero()
+	if name == "" {
+		name = jb.Type.String()
+	}
+	fmt.Fprintf(&b, "digraph %q {\n", name)
+	b.WriteString("  rankdir=TB;\n  node [shape=box];\n")
+	for _, task := range jb.Pipeline.Tasks {
+		fmt.Fprintf(&b, "  %q [label=%q];\n", task.DotID(), fmt.Sprintf("%s\n%s", task.DotID(), task.Type()))
+	}
+	for _, task := range jb.Pipeline.Tasks {
+		for _, output := range task.Outputs() {
+			fmt.Fprintf(&b, "  %q -> %q;\n", task.DotID(), output.DotID())
+		}
+	}
+	b.WriteString("}\n")
+	return b.String()
+}
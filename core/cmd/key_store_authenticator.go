@@ -19,6 +19,7 @@ type KeyStoreAuthenticator interface {
 	AuthenticateCSAKey(*keystore.CSA, string) error
 	AuthenticateVRFKey(*keystore.VRF, string) error
 	AuthenticateOCRKey(*keystore.OCR, *config.Config, string) error
+	AuthenticateSecrets(*keystore.Secrets, string) error
 }
 
 // TerminalKeyStoreAuthenticator contains fields for prompting the user and an
@@ -194,6 +195,13 @@ func (auth TerminalKeyStoreAuthenticator) AuthenticateCSAKey(csaKeyStore *keysto
 			"them... please check the password in the file")
 }
 
+// AuthenticateSecrets decrypts the secrets referenced by job specs
+func (auth TerminalKeyStoreAuthenticator) AuthenticateSecrets(secrets *keystore.Secrets, password string) error {
+	return errors.Wrapf(secrets.Unlock(password),
+		"there are secrets in the DB, but that password did not decrypt "+
+			"them... please check the password in the file")
+}
+
 // AuthenticateOCRKey authenticates OCR keypairs
 func (auth TerminalKeyStoreAuthenticator) AuthenticateOCRKey(ocrKeyStore *keystore.OCR, config *config.Config, password string) error {
 	err := ocrKeyStore.Unlock(password)
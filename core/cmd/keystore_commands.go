@@ -0,0 +1,126 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/pkg/errors"
+	"github.com/smartcontractkit/chainlink/core/web"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+	"github.com/urfave/cli"
+	"go.uber.org/multierr"
+)
+
+// ChangeKeyStorePassword re-encrypts all keys and secrets of the keystore
+// with a new password, and VRF keys with a new VRF password if given
+func (cli *Client) ChangeKeyStorePassword(c *cli.Context) (err error) {
+	oldPasswordFile := c.String("oldpassword")
+	if len(oldPasswordFile) == 0 {
+		return cli.errorOut(errors.New("Must specify --oldpassword flag"))
+	}
+	oldPassword, err := passwordFromFile(oldPasswordFile)
+	if err != nil {
+		return cli.errorOut(errors.Wrap(err, "Could not read password file"))
+	}
+	newPasswordFile := c.String("newpassword")
+	if len(newPasswordFile) == 0 {
+		return cli.errorOut(errors.New("Must specify --newpassword flag"))
+	}
+	newPassword, err := passwordFromFile(newPasswordFile)
+	if err != nil {
+		return cli.errorOut(errors.Wrap(err, "Could not read password file"))
+	}
+	if (c.String("oldvrfpassword") == "") != (c.String("newvrfpassword") == "") {
+		return cli.errorOut(errors.New("Must specify both --oldvrfpassword and --newvrfpassword, or neither"))
+	}
+	oldVRFPassword, err := passwordFromFile(c.String("oldvrfpassword"))
+	if err != nil {
+		return cli.errorOut(errors.Wrap(err, "Could not read VRF password file"))
+	}
+	newVRFPassword, err := passwordFromFile(c.String("newvrfpassword"))
+	if err != nil {
+		return cli.errorOut(errors.Wrap(err, "Could not read VRF password file"))
+	}
+
+	request, err := json.Marshal(web.ChangeKeyStorePasswordRequest{
+		OldPassword:    oldPassword,
+		NewPassword:    newPassword,
+		OldVRFPassword: oldVRFPassword,
+		NewVRFPassword: newVRFPassword,
+	})
+	if err != nil {
+		return cli.errorOut(err)
+	}
+
+	resp, err := cli.HTTP.Patch("/v2/keys/password", bytes.NewReader(request))
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	if resp.StatusCode == http.StatusNoContent {
+		fmt.Println("Keystore password updated. The node must be started with the new password from now on.")
+	} else if resp.StatusCode == http.StatusConflict {
+		fmt.Println("Old password or old VRF password did not match.")
+	} else {
+		return cli.printResponseBody(resp)
+	}
+	return nil
+}
+
+type KeyAccessPresenter struct {
+	JAID
+	presenters.KeyAccessResource
+}
+
+type KeyAccessPresenters []KeyAccessPresenter
+
+// RenderTable implements TableRenderer
+func (ps KeyAccessPresenters) RenderTable(rt RendererTable) error {
+	table := rt.newTable([]string{"ID", "Key type", "Key ID", "Operation", "Service", "Job ID", "Purpose", "Time"})
+	for _, p := range ps {
+		jobID := ""
+		if p.JobID.Valid {
+			jobID = fmt.Sprint(p.JobID.Int64)
+		}
+		table.Append([]string{
+			p.ID,
+			p.KeyType,
+			p.KeyID,
+			p.Operation,
+			p.Service,
+			jobID,
+			p.Purpose,
+			p.CreatedAt.String(),
+		})
+	}
+
+	render("Key Accesses", table)
+	return nil
+}
+
+// ListKeyAccesses shows the audit log of key material access and signing
+// operations, newest first
+func (cli *Client) ListKeyAccesses(c *cli.Context) error {
+	query := url.Values{}
+	for flag, param := range map[string]string{
+		"type":      "keyType",
+		"key":       "keyID",
+		"operation": "operation",
+		"job":       "jobID",
+		"since":     "since",
+		"until":     "until",
+	} {
+		if value := c.String(flag); value != "" {
+			query.Set(param, value)
+		}
+	}
+	return cli.getPage("/v2/keys/audit?"+query.Encode(), c.Int("page"), &KeyAccessPresenters{})
+}
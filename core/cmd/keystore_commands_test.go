@@ -0,0 +1,112 @@
+package cmd_test
+
+import (
+	"context"
+	"flag"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/smartcontractkit/chainlink/core/cmd"
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/urfave/cli"
+)
+
+func TestClient_ChangeKeyStorePassword(t *testing.T) {
+	t.Parallel()
+
+	app := startNewApplication(t, withKey())
+	client, _ := app.NewClientAndRenderer()
+
+	// The old password does not match, nothing is changed
+	set := flag.NewFlagSet("test keystore chpass", 0)
+	set.String("oldpassword", "../internal/fixtures/incorrect_password.txt", "")
+	set.String("newpassword", "../internal/fixtures/correct_password.txt", "")
+	c := cli.NewContext(nil, set, nil)
+	require.NoError(t, client.ChangeKeyStorePassword(c))
+	require.NoError(t, cltest.NewKeyStore(t, app.GetStore().DB).Eth().Unlock(cltest.Password))
+
+	set = flag.NewFlagSet("test keystore chpass", 0)
+	set.String("oldpassword", "../internal/fixtures/correct_password.txt", "")
+	set.String("newpassword", "../internal/fixtures/incorrect_password.txt", "")
+	c = cli.NewContext(nil, set, nil)
+	require.NoError(t, client.ChangeKeyStorePassword(c))
+
+	require.Error(t, cltest.NewKeyStore(t, app.GetStore().DB).Eth().Unlock(cltest.Password))
+	require.NoError(t, cltest.NewKeyStore(t, app.GetStore().DB).Eth().Unlock("IamnotapoliticianIonlysuffertheconsequences-PeterTosh123!@#"))
+
+	// Keys can still be created after the change
+	_, err := app.GetKeyStore().CSA().CreateCSAKey()
+	require.NoError(t, err)
+	require.NoError(t, app.GetKeyStore().ChangePassword(context.Background(), "IamnotapoliticianIonlysuffertheconsequences-PeterTosh123!@#", cltest.Password, nil))
+}
+
+func TestClient_ChangeKeyStorePassword_VRFKeys(t *testing.T) {
+	t.Parallel()
+
+	app := startNewApplication(t, withKey())
+	client, _ := app.NewClientAndRenderer()
+	_, err := app.GetKeyStore().VRF().CreateKey()
+	require.NoError(t, err)
+
+	dir := t.TempDir()
+	oldVRFPasswordFile := filepath.Join(dir, "old_vrf_password.txt")
+	require.NoError(t, ioutil.WriteFile(oldVRFPasswordFile, []byte(cltest.VRFPassword), 0600))
+	newVRFPasswordFile := filepath.Join(dir, "new_vrf_password.txt")
+	require.NoError(t, ioutil.WriteFile(newVRFPasswordFile, []byte("n3w-vrf-p4ssw0rd"), 0600))
+
+	// VRF keys exist, so the VRF passwords are required
+	set := flag.NewFlagSet("test keystore chpass", 0)
+	set.String("oldpassword", "../internal/fixtures/correct_password.txt", "")
+	set.String("newpassword", "../internal/fixtures/incorrect_password.txt", "")
+	c := cli.NewContext(nil, set, nil)
+	require.Error(t, client.ChangeKeyStorePassword(c))
+	require.NoError(t, cltest.NewKeyStore(t, app.GetStore().DB).Eth().Unlock(cltest.Password))
+
+	// Both VRF passwords must be given
+	set = flag.NewFlagSet("test keystore chpass", 0)
+	set.String("oldpassword", "../internal/fixtures/correct_password.txt", "")
+	set.String("newpassword", "../internal/fixtures/incorrect_password.txt", "")
+	set.String("oldvrfpassword", oldVRFPasswordFile, "")
+	c = cli.NewContext(nil, set, nil)
+	require.Error(t, client.ChangeKeyStorePassword(c))
+
+	set = flag.NewFlagSet("test keystore chpass", 0)
+	set.String("oldpassword", "../internal/fixtures/correct_password.txt", "")
+	set.String("newpassword", "../internal/fixtures/incorrect_password.txt", "")
+	set.String("oldvrfpassword", oldVRFPasswordFile, "")
+	set.String("newvrfpassword", newVRFPasswordFile, "")
+	c = cli.NewContext(nil, set, nil)
+	require.NoError(t, client.ChangeKeyStorePassword(c))
+
+	require.NoError(t, cltest.NewKeyStore(t, app.GetStore().DB).Eth().Unlock("IamnotapoliticianIonlysuffertheconsequences-PeterTosh123!@#"))
+	keys, err := cltest.NewKeyStore(t, app.GetStore().DB).VRF().Unlock("n3w-vrf-p4ssw0rd")
+	require.NoError(t, err)
+	assert.Len(t, keys, 1)
+}
+
+func TestClient_ListKeyAccesses(t *testing.T) {
+	t.Parallel()
+
+	app := startNewApplication(t, withKey())
+	client, r := app.NewClientAndRenderer()
+
+	key, err := app.GetKeyStore().CSA().CreateCSAKey()
+	require.NoError(t, err)
+	_, err = app.GetKeyStore().CSA().ExportCSAKey(context.Background(), key.ID, "password", utils.FastScryptParams)
+	require.NoError(t, err)
+
+	set := flag.NewFlagSet("test keys audit", 0)
+	set.String("type", "csa", "")
+	c := cli.NewContext(nil, set, nil)
+	require.NoError(t, client.ListKeyAccesses(c))
+
+	require.Len(t, r.Renders, 1)
+	accesses := *r.Renders[0].(*cmd.KeyAccessPresenters)
+	require.Len(t, accesses, 1)
+	assert.Equal(t, key.PublicKey.String(), accesses[0].KeyID)
+	assert.Equal(t, "export", accesses[0].Operation)
+}
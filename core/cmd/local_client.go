@@ -68,7 +68,7 @@ func (cli *Client) RunNode(c *clipkg.Context) error {
 		logger.Warn("Ethereum is disabled. Chainlink will only run services that can operate without an ethereum connection")
 	}
 
-	pwd, err := passwordFromFile(c.String("password"))
+	pwd, err := keystorePassword(c.String("password"))
 	if err != nil {
 		return cli.errorOut(fmt.Errorf("error reading password: %+v", err))
 	}
@@ -97,6 +97,10 @@ func (cli *Client) RunNode(c *clipkg.Context) error {
 		return cli.errorOut(errors.Wrapf(authErr, "while authenticating CSA keystore"))
 	}
 
+	if authErr := cli.KeyStoreAuthenticator.AuthenticateSecrets(keyStore.Secrets(), keyStorePwd); authErr != nil {
+		return cli.errorOut(errors.Wrapf(authErr, "while authenticating secrets keystore"))
+	}
+
 	if len(c.String("vrfpassword")) != 0 {
 		vrfpwd, fileErr := passwordFromFile(c.String("vrfpassword"))
 		if fileErr != nil {
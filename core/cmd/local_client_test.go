@@ -18,8 +18,11 @@ import (
 	"github.com/smartcontractkit/chainlink/core/internal/cltest/heavyweight"
 	"github.com/smartcontractkit/chainlink/core/internal/mocks"
 	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/bulletprooftxmanager"
 
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	gethTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
@@ -527,3 +530,115 @@ func TestClient_SetNextNonce(t *testing.T) {
 	require.NotNil(t, key.NextNonce)
 	require.Equal(t, int64(42), key.NextNonce)
 }
+
+func TestClient_SignTransaction(t *testing.T) {
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+	store.Config.Set("ETH_CHAIN_ID", 3)
+	keyStore := cltest.NewKeyStore(t, store.DB)
+	require.NoError(t, keyStore.Eth().Unlock(cltest.Password))
+	_, fromAddress := cltest.MustAddRandomKeyToKeystore(t, keyStore.Eth(), 7)
+	toAddress := cltest.NewAddress()
+
+	app := new(mocks.Application)
+	app.On("GetStore").Return(store)
+	app.On("GetKeyStore").Return(keyStore)
+	app.On("Stop").Return(nil)
+
+	r := &cltest.RendererMock{}
+	auth := cltest.CallbackAuthenticator{Callback: func(*keystore.Eth, string) (string, error) { return "", nil }}
+	client := cmd.Client{
+		Renderer:              r,
+		Config:                store.Config,
+		AppFactory:            cltest.InstanceAppFactory{App: app},
+		KeyStoreAuthenticator: auth,
+		Runner:                cltest.EmptyRunner{},
+	}
+
+	set := flag.NewFlagSet("test", 0)
+	set.String("from", fromAddress.Hex(), "")
+	set.String("to", toAddress.Hex(), "")
+	set.String("value", "1000", "")
+	set.Uint64("gasPriceWei", 42, "")
+	set.String("data", "0xdeadbeef", "")
+	c := cli.NewContext(nil, set, nil)
+	require.NoError(t, client.SignTransaction(c))
+
+	p := r.Renders[0].(*cmd.RawTransactionPresenter)
+	assert.Equal(t, fromAddress.Hex(), p.From)
+	assert.Equal(t, toAddress.Hex(), p.To)
+	assert.Equal(t, uint64(7), p.Nonce)
+	assert.Equal(t, "1000", p.Value)
+	assert.Equal(t, "42", p.GasPrice)
+	assert.Equal(t, store.Config.EthGasLimitDefault(), p.GasLimit)
+	assert.Equal(t, "0xdeadbeef", p.Data)
+
+	raw, err := hexutil.Decode(p.RawTx)
+	require.NoError(t, err)
+	tx := new(gethTypes.Transaction)
+	require.NoError(t, tx.UnmarshalBinary(raw))
+	sender, err := gethTypes.Sender(gethTypes.LatestSignerForChainID(store.Config.ChainID()), tx)
+	require.NoError(t, err)
+	assert.Equal(t, fromAddress, sender)
+	assert.Equal(t, p.Hash, tx.Hash().Hex())
+
+	// Signing does not use up the nonce
+	nonce, err := bulletprooftxmanager.GetNextNonce(store.DB, nil, fromAddress)
+	require.NoError(t, err)
+	assert.Equal(t, int64(7), nonce)
+
+	app.AssertExpectations(t)
+}
+
+func TestClient_BroadcastRawTransaction(t *testing.T) {
+	t.Parallel()
+
+	config := cltest.NewTestConfig(t)
+	config.Set("LOG_TO_DISK", false)
+	config.Set("ETH_CHAIN_ID", 3)
+	privateKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	fromAddress := crypto.PubkeyToAddress(privateKey.PublicKey)
+	toAddress := cltest.NewAddress()
+	tx, err := gethTypes.SignTx(
+		gethTypes.NewTransaction(3, toAddress, big.NewInt(1000), 21000, big.NewInt(42), nil),
+		gethTypes.LatestSignerForChainID(config.ChainID()),
+		privateKey,
+	)
+	require.NoError(t, err)
+	raw, err := tx.MarshalBinary()
+	require.NoError(t, err)
+
+	app := new(mocks.Application)
+	app.On("Stop").Return(nil)
+	ethClient := new(mocks.Client)
+	app.On("GetEthClient").Return(ethClient)
+	ethClient.On("Dial", mock.Anything).Return(nil)
+	ethClient.On("SendTransaction", mock.Anything, mock.MatchedBy(func(sent *gethTypes.Transaction) bool {
+		return sent.Hash() == tx.Hash()
+	})).Once().Return(nil)
+
+	r := &cltest.RendererMock{}
+	client := cmd.Client{
+		Renderer:   r,
+		Config:     config.Config,
+		AppFactory: cltest.InstanceAppFactory{App: app},
+		Runner:     cltest.EmptyRunner{},
+	}
+
+	set := flag.NewFlagSet("test", 0)
+	require.NoError(t, set.Parse([]string{hexutil.Encode(raw)}))
+	require.NoError(t, client.BroadcastRawTransaction(cli.NewContext(nil, set, nil)))
+
+	p := r.Renders[0].(*cmd.RawTransactionPresenter)
+	assert.Equal(t, tx.Hash().Hex(), p.Hash)
+	assert.Equal(t, fromAddress.Hex(), p.From)
+	assert.Equal(t, uint64(3), p.Nonce)
+
+	set = flag.NewFlagSet("test", 0)
+	require.NoError(t, set.Parse([]string{"0xdeadbeef"}))
+	assert.Error(t, client.BroadcastRawTransaction(cli.NewContext(nil, set, nil)))
+
+	app.AssertExpectations(t)
+	ethClient.AssertExpectations(t)
+}
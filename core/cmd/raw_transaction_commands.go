@@ -0,0 +1,215 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	gethTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/pkg/errors"
+	clipkg "github.com/urfave/cli"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/bulletprooftxmanager"
+	"github.com/smartcontractkit/chainlink/core/services/keystore"
+	"github.com/smartcontractkit/chainlink/core/store/dialects"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+// RawTransactionPresenter presents a signed transaction, encoded for
+// broadcasting with `txs broadcast-raw`
+type RawTransactionPresenter struct {
+	Hash     string
+	From     string
+	To       string
+	Nonce    uint64
+	Value    string
+	GasLimit uint64
+	GasPrice string
+	Data     string
+	RawTx    string
+}
+
+// NewRawTransactionPresenter presents the signed tx sent from the from address
+func NewRawTransactionPresenter(tx *gethTypes.Transaction, from string) (*RawTransactionPresenter, error) {
+	raw, err := tx.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	p := &RawTransactionPresenter{
+		Hash:     tx.Hash().Hex(),
+		From:     from,
+		Nonce:    tx.Nonce(),
+		Value:    tx.Value().String(),
+		GasLimit: tx.Gas(),
+		GasPrice: tx.GasPrice().String(),
+		Data:     hexutil.Encode(tx.Data()),
+		RawTx:    hexutil.Encode(raw),
+	}
+	if tx.To() != nil {
+		p.To = tx.To().Hex()
+	}
+	return p, nil
+}
+
+// RenderTable implements TableRenderer
+func (p *RawTransactionPresenter) RenderTable(rt RendererTable) error {
+	headers := []string{"Hash", "From", "To", "Nonce", "Value", "Gas limit", "Gas price", "Data", "Raw tx"}
+	rows := [][]string{{
+		p.Hash,
+		p.From,
+		p.To,
+		fmt.Sprintf("%d", p.Nonce),
+		p.Value,
+		fmt.Sprintf("%d", p.GasLimit),
+		p.GasPrice,
+		p.Data,
+		p.RawTx,
+	}}
+
+	renderList(headers, rows, rt.Writer)
+	return nil
+}
+
+// SignTransaction run locally builds a transaction and signs it with a key of
+// the keystore, without broadcasting it or recording it in the tx manager.
+// The nonce defaults to the next nonce of the key, and the gas to the
+// configured defaults.
+func (cli *Client) SignTransaction(c *clipkg.Context) (err error) {
+	from, err := utils.ParseEthereumAddress(c.String("from"))
+	if err != nil {
+		return cli.errorOut(errors.Wrap(err, "while parsing from address"))
+	}
+	to, err := utils.ParseEthereumAddress(c.String("to"))
+	if err != nil {
+		return cli.errorOut(errors.Wrap(err, "while parsing to address"))
+	}
+	value := big.NewInt(0)
+	if c.IsSet("value") {
+		if _, ok := value.SetString(c.String("value"), 10); !ok || value.Sign() < 0 {
+			return cli.errorOut(fmt.Errorf("invalid value %q, must be an amount of Wei", c.String("value")))
+		}
+	}
+	var data []byte
+	if c.IsSet("data") {
+		data, err = hexutil.Decode(c.String("data"))
+		if err != nil {
+			return cli.errorOut(errors.Wrap(err, "while decoding data"))
+		}
+	}
+
+	logger.SetLogger(cli.Config.CreateProductionLogger())
+	cli.Config.Dialect = dialects.PostgresWithoutLock
+	app, err := cli.AppFactory.NewApplication(cli.Config)
+	if err != nil {
+		return cli.errorOut(errors.Wrap(err, "creating application"))
+	}
+	defer func() {
+		if serr := app.Stop(); serr != nil {
+			err = multierr.Append(err, serr)
+		}
+	}()
+	keyStore := app.GetKeyStore()
+
+	pwd, err := passwordFromFile(c.String("password"))
+	if err != nil {
+		return cli.errorOut(fmt.Errorf("error reading password: %+v", err))
+	}
+	_, err = cli.KeyStoreAuthenticator.AuthenticateEthKey(keyStore.Eth(), pwd)
+	if err != nil {
+		return cli.errorOut(fmt.Errorf("error authenticating keystore: %+v", err))
+	}
+
+	var nonce uint64
+	if c.IsSet("nonce") {
+		nonce = c.Uint64("nonce")
+	} else {
+		next, nerr := bulletprooftxmanager.GetNextNonce(app.GetStore().DB, nil, from)
+		if nerr != nil {
+			return cli.errorOut(errors.Wrapf(nerr, "while getting the next nonce of %s", from.Hex()))
+		}
+		nonce = uint64(next)
+	}
+	gasLimit := cli.Config.EthGasLimitDefault()
+	if c.IsSet("gasLimit") {
+		gasLimit = c.Uint64("gasLimit")
+	}
+	gasPrice := cli.Config.EthGasPriceDefault()
+	if c.IsSet("gasPriceWei") {
+		gasPrice = new(big.Int).SetUint64(c.Uint64("gasPriceWei"))
+	}
+
+	tx := gethTypes.NewTx(&gethTypes.LegacyTx{
+		Nonce:    nonce,
+		To:       &to,
+		Value:    value,
+		Gas:      gasLimit,
+		GasPrice: gasPrice,
+		Data:     data,
+	})
+	ctx := keystore.WithRequester(context.Background(), keystore.Requester{
+		Service: "CLI",
+		Purpose: "txs sign",
+	})
+	signed, err := keyStore.Eth().SignTx(ctx, from, tx, cli.Config.ChainID())
+	if err != nil {
+		return cli.errorOut(errors.Wrap(err, "while signing transaction"))
+	}
+	logger.Infow("Signed transaction, it was not broadcast", "hash", signed.Hash().Hex(), "from", from.Hex(), "nonce", nonce)
+
+	p, err := NewRawTransactionPresenter(signed, from.Hex())
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	return cli.errorOut(cli.Render(p))
+}
+
+// BroadcastRawTransaction run locally sends a signed transaction, hex encoded
+// as by `txs sign`, directly to the eth node. The tx manager does not track
+// it.
+func (cli *Client) BroadcastRawTransaction(c *clipkg.Context) (err error) {
+	if !c.Args().Present() {
+		return cli.errorOut(errors.New("must pass the hex encoded signed transaction"))
+	}
+	raw, err := hexutil.Decode(c.Args().First())
+	if err != nil {
+		return cli.errorOut(errors.Wrap(err, "while decoding transaction"))
+	}
+	tx := new(gethTypes.Transaction)
+	if err = tx.UnmarshalBinary(raw); err != nil {
+		return cli.errorOut(errors.Wrap(err, "while decoding transaction"))
+	}
+	sender, err := gethTypes.Sender(gethTypes.LatestSignerForChainID(cli.Config.ChainID()), tx)
+	if err != nil {
+		return cli.errorOut(errors.Wrapf(err, "transaction is not signed for chain %s", cli.Config.ChainID()))
+	}
+
+	logger.SetLogger(cli.Config.CreateProductionLogger())
+	cli.Config.Dialect = dialects.PostgresWithoutLock
+	app, err := cli.AppFactory.NewApplication(cli.Config)
+	if err != nil {
+		return cli.errorOut(errors.Wrap(err, "creating application"))
+	}
+	defer func() {
+		if serr := app.Stop(); serr != nil {
+			err = multierr.Append(err, serr)
+		}
+	}()
+
+	ethClient := app.GetEthClient()
+	if err = ethClient.Dial(context.TODO()); err != nil {
+		return cli.errorOut(errors.Wrap(err, "while dialing eth node"))
+	}
+	if err = ethClient.SendTransaction(context.TODO(), tx); err != nil {
+		return cli.errorOut(errors.Wrap(err, "while broadcasting transaction"))
+	}
+	logger.Infow("Broadcast raw transaction", "hash", tx.Hash().Hex(), "from", sender.Hex(), "nonce", tx.Nonce())
+
+	p, err := NewRawTransactionPresenter(tx, sender.Hex())
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	return cli.errorOut(cli.Render(p))
+}
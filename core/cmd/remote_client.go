@@ -311,6 +311,7 @@ func (cli *Client) RemoteLogin(c *clipkg.Context) error {
 	if err != nil {
 		return cli.errorOut(err)
 	}
+	sessionRequest.TOTPCode = c.String("totp")
 	_, err = cli.CookieAuthenticator.Authenticate(sessionRequest)
 	return cli.errorOut(err)
 }
@@ -487,6 +488,15 @@ func normalizePassword(password string) string {
 	return url.PathEscape(strings.TrimSpace(password))
 }
 
+// scryptStrengthQuery returns the query param which sets the scrypt strength
+// of a key export, if the --scrypt-strength flag was passed
+func scryptStrengthQuery(c *clipkg.Context) string {
+	if strength := c.String("scrypt-strength"); strength != "" {
+		return "&scryptStrength=" + url.QueryEscape(strength)
+	}
+	return ""
+}
+
 // SetLogLevel sets the log level on the node
 func (cli *Client) SetLogLevel(c *clipkg.Context) (err error) {
 	logLevel := c.String("level")
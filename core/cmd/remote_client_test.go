@@ -10,6 +10,7 @@ import (
 	"os"
 	"strconv"
 	"testing"
+	"time"
 
 	"github.com/smartcontractkit/chainlink/core/logger"
 	"github.com/smartcontractkit/chainlink/core/services/job"
@@ -734,6 +735,32 @@ func TestClient_AutoLogin_AuthFails(t *testing.T) {
 	require.Error(t, err)
 }
 
+func TestClient_TOTPFlag(t *testing.T) {
+	t.Parallel()
+
+	app := startNewApplication(t)
+	client, _ := app.NewClientAndRenderer()
+
+	user, err := app.Store.FindUserByEmail(cltest.APIEmail)
+	require.NoError(t, err)
+	user.TOTPSecret, err = auth.NewTOTPSecret()
+	require.NoError(t, err)
+	user.TOTPEnabled = true
+	require.NoError(t, app.Store.SaveUser(&user))
+
+	bridge := `{"name":"totpbridge","url":"http://localhost:8080"}`
+	set := flag.NewFlagSet("create", 0)
+	require.NoError(t, set.Parse([]string{bridge}))
+	require.Error(t, client.CreateBridge(cli.NewContext(nil, set, nil)))
+
+	code, err := auth.TOTPCode(user.TOTPSecret, time.Now())
+	require.NoError(t, err)
+	require.NoError(t, cmd.NewApp(client).Run([]string{"chainlink", "--totp", code, "bridges", "create", bridge}))
+
+	_, err = app.Store.FindBridge("totpbridge")
+	require.NoError(t, err)
+}
+
 type FailingAuthenticator struct{}
 
 func (FailingAuthenticator) Cookie() (*http.Cookie, error) {
@@ -313,7 +313,7 @@ func (rt RendererTable) renderServiceAgreement(sa presenters.ServiceAgreement) e
 }
 
 func (rt RendererTable) renderExternalInitiatorAuthentication(eia webpresenters.ExternalInitiatorAuthentication) error {
-	table := rt.newTable([]string{"Name", "URL", "AccessKey", "Secret", "OutgoingToken", "OutgoingSecret"})
+	table := rt.newTable([]string{"Name", "URL", "AccessKey", "Secret", "OutgoingToken", "OutgoingSecret", "SigningSecret"})
 	table.Append([]string{
 		eia.Name,
 		eia.URL.String(),
@@ -321,6 +321,7 @@ func (rt RendererTable) renderExternalInitiatorAuthentication(eia webpresenters.
 		eia.Secret,
 		eia.OutgoingToken,
 		eia.OutgoingSecret,
+		eia.SigningSecret,
 	})
 	render("External Initiator Credentials:", table)
 	return nil
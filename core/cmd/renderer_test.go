@@ -138,6 +138,7 @@ func TestRendererTable_RenderExternalInitiatorAuthentication(t *testing.T) {
 		Secret:         "secret",
 		OutgoingToken:  "outgoingToken",
 		OutgoingSecret: "outgoingSecret",
+		SigningSecret:  "signingSecret",
 	}
 	tests := []struct {
 		name, content string
@@ -148,6 +149,7 @@ func TestRendererTable_RenderExternalInitiatorAuthentication(t *testing.T) {
 		{"Secret", eia.Secret},
 		{"OutgoingToken", eia.OutgoingToken},
 		{"OutgoingSecret", eia.OutgoingSecret},
+		{"SigningSecret", eia.SigningSecret},
 	}
 
 	for _, test := range tests {
@@ -0,0 +1,136 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/smartcontractkit/chainlink/core/web"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+	"github.com/urfave/cli"
+	"go.uber.org/multierr"
+)
+
+type SecretPresenter struct {
+	JAID
+	presenters.SecretResource
+}
+
+// RenderTable implements TableRenderer
+func (p *SecretPresenter) RenderTable(rt RendererTable) error {
+	headers := []string{"Name", "Created", "Updated"}
+	rows := [][]string{p.ToRow()}
+
+	if _, err := rt.Write([]byte("🔒 Secrets\n")); err != nil {
+		return err
+	}
+	renderList(headers, rows, rt.Writer)
+
+	return nil
+}
+
+func (p *SecretPresenter) ToRow() []string {
+	row := []string{
+		p.Name,
+		fmt.Sprintf("%v", p.CreatedAt),
+		fmt.Sprintf("%v", p.UpdatedAt),
+	}
+
+	return row
+}
+
+type SecretPresenters []SecretPresenter
+
+// RenderTable implements TableRenderer
+func (ps SecretPresenters) RenderTable(rt RendererTable) error {
+	headers := []string{"Name", "Created", "Updated"}
+	rows := [][]string{}
+
+	for _, p := range ps {
+		rows = append(rows, p.ToRow())
+	}
+
+	if _, err := rt.Write([]byte("🔒 Secrets\n")); err != nil {
+		return err
+	}
+	renderList(headers, rows, rt.Writer)
+
+	return nil
+}
+
+// ListSecrets lists the names of the node's secrets
+func (cli *Client) ListSecrets(c *cli.Context) (err error) {
+	resp, err := cli.HTTP.Get("/v2/secrets", nil)
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return cli.renderAPIResponse(resp, &SecretPresenters{})
+}
+
+// SetSecret encrypts and stores a secret, replacing any existing value. The
+// value is read from a file so that it does not end up in the shell history.
+func (cli *Client) SetSecret(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return cli.errorOut(errors.New("Must pass the name of the secret"))
+	}
+	valueFile := c.String("file")
+	if len(valueFile) == 0 {
+		return cli.errorOut(errors.New("Must specify --file flag"))
+	}
+	value, err := ioutil.ReadFile(valueFile)
+	if err != nil {
+		return cli.errorOut(errors.Wrap(err, "failed to read secret value"))
+	}
+
+	request, err := json.Marshal(web.SecretRequest{
+		Name:  c.Args().First(),
+		Value: strings.TrimRight(string(value), "\r\n"),
+	})
+	if err != nil {
+		return cli.errorOut(err)
+	}
+
+	resp, err := cli.HTTP.Post("/v2/secrets", bytes.NewReader(request))
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return cli.renderAPIResponse(resp, &SecretPresenter{}, "Secret set")
+}
+
+// DeleteSecret removes a secret. Jobs referencing it will fail to run.
+func (cli *Client) DeleteSecret(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return cli.errorOut(errors.New("Must pass the name of the secret to be deleted"))
+	}
+
+	if !confirmAction(c) {
+		return nil
+	}
+
+	resp, err := cli.HTTP.Delete("/v2/secrets/" + c.Args().First())
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+	_, err = cli.parseResponse(resp)
+	return err
+}
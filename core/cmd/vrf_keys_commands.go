@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"io/ioutil"
 	"net/http"
+	"net/url"
 	"os"
 
 	"github.com/smartcontractkit/chainlink/core/services/signatures/secp256k1"
@@ -144,7 +145,7 @@ func (cli *Client) ExportVRFKey(c *cli.Context) error {
 	}
 
 	normalizedPassword := normalizePassword(string(newPassword))
-	resp, err := cli.HTTP.Post("/v2/keys/vrf/export/"+pk.String()+"?newpassword="+normalizedPassword, nil)
+	resp, err := cli.HTTP.Post("/v2/keys/vrf/export/"+pk.String()+"?newpassword="+normalizedPassword+scryptStrengthQuery(c), nil)
 	if err != nil {
 		return cli.errorOut(errors.Wrap(err, "Could not make HTTP request"))
 	}
@@ -182,7 +183,8 @@ func (cli *Client) ExportVRFKey(c *cli.Context) error {
 
 // DeleteVRFKey deletes (hard or soft) the VRF key with given public key from the db
 // and memory. V2 jobs referencing the VRF key will be removed if the key is deleted
-// (no such protection for the V1 jobs exists).
+// (no such protection for the V1 jobs exists). Keys which are still registered with
+// the coordinator of one of their jobs are only deleted with --force.
 func (cli *Client) DeleteVRFKey(c *cli.Context) error {
 	if !c.Args().Present() {
 		return cli.errorOut(errors.New("Must pass the key ID (compressed public key) to be deleted"))
@@ -196,12 +198,19 @@ func (cli *Client) DeleteVRFKey(c *cli.Context) error {
 		return nil
 	}
 
-	var queryStr string
+	query := url.Values{}
 	if c.Bool("hard") {
-		queryStr = "?hard=true"
+		query.Set("hard", "true")
+	}
+	if c.Bool("force") {
+		query.Set("force", "true")
+	}
+	path := fmt.Sprintf("/v2/keys/vrf/%s", id)
+	if len(query) > 0 {
+		path += "?" + query.Encode()
 	}
 
-	resp, err := cli.HTTP.Delete(fmt.Sprintf("/v2/keys/vrf/%s%s", id, queryStr))
+	resp, err := cli.HTTP.Delete(path)
 	if err != nil {
 		return cli.errorOut(err)
 	}
@@ -274,7 +274,7 @@ func NewJobPipelineV2(t testing.TB, tc *TestConfig, db *gorm.DB, ethClient eth.C
 	prm, eb, cleanup := NewPipelineORM(t, tc, db)
 	jrm := job.NewORM(db, tc.Config, prm, eb, &postgres.NullAdvisoryLocker{})
 	t.Cleanup(cleanup)
-	pr := pipeline.NewRunner(prm, tc.Config, ethClient, keyStore, nil, txManager)
+	pr := pipeline.NewRunner(prm, tc.Config, ethClient, keyStore, nil, nil, txManager, nil)
 	return JobPipelineV2TestHelper{
 		prm,
 		eb,
@@ -427,6 +427,7 @@ func NewApplicationWithConfigAndKey(t testing.TB, tc *TestConfig, flagsAndDeps .
 	require.NoError(t, app.KeyStore.Eth().Unlock(Password))
 	_, err := app.KeyStore.VRF().Unlock(VRFPassword)
 	require.NoError(t, err)
+	require.NoError(t, app.KeyStore.Secrets().Unlock(Password))
 
 	return app, cleanup
 }
@@ -1666,6 +1667,7 @@ func MustParseDuration(t testing.TB, durationStr string) time.Duration {
 
 func NewSession(optionalSessionID ...string) models.Session {
 	session := models.NewSession()
+	session.UserEmail = APIEmail
 	if len(optionalSessionID) > 0 {
 		session.ID = optionalSessionID[0]
 	}
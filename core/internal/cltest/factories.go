@@ -978,6 +978,7 @@ type ExternalInitiatorOpts struct {
 	URL            *models.WebURL
 	OutgoingSecret string
 	OutgoingToken  string
+	SigningSecret  string
 }
 
 func MustInsertExternalInitiatorWithOpts(t *testing.T, db *gorm.DB, opts ExternalInitiatorOpts) (ei models.ExternalInitiator) {
@@ -991,6 +992,7 @@ func MustInsertExternalInitiatorWithOpts(t *testing.T, db *gorm.DB, opts Externa
 	ei.URL = opts.URL
 	ei.OutgoingSecret = opts.OutgoingSecret
 	ei.OutgoingToken = opts.OutgoingToken
+	ei.SigningSecret = opts.SigningSecret
 	token := auth.NewToken()
 	ei.AccessKey = token.AccessKey
 	err := db.Create(&ei).Error
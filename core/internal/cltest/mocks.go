@@ -187,6 +187,10 @@ func (a CallbackAuthenticator) AuthenticateCSAKey(*keystore.CSA, string) error {
 	return nil
 }
 
+func (a CallbackAuthenticator) AuthenticateSecrets(*keystore.Secrets, string) error {
+	return nil
+}
+
 var _ cmd.KeyStoreAuthenticator = CallbackAuthenticator{}
 
 // BlockedRunner is a Runner that blocks until its channel is posted to
@@ -362,9 +366,11 @@ type MockCronEntry struct {
 
 // MockHeadTrackable allows you to mock HeadTrackable
 type MockHeadTrackable struct {
-	connectedCount    int32
-	ConnectedCallback func(bn *models.Head)
-	onNewHeadCount    int32
+	connectedCount          int32
+	ConnectedCallback       func(bn *models.Head)
+	onNewHeadCount          int32
+	onNewFinalizedHeadCount int32
+	latestFinalizedNumber   int64
 }
 
 // Connect increases the connected count by one
@@ -391,6 +397,22 @@ func (m *MockHeadTrackable) OnNewLongestChainCount() int32 {
 	return atomic.LoadInt32(&m.onNewHeadCount)
 }
 
+// OnNewFinalizedHead increases the OnNewFinalizedHeadCount count by one
+func (m *MockHeadTrackable) OnNewFinalizedHead(_ context.Context, head models.Head) {
+	atomic.StoreInt64(&m.latestFinalizedNumber, head.Number)
+	atomic.AddInt32(&m.onNewFinalizedHeadCount, 1)
+}
+
+// OnNewFinalizedHeadCount returns the count of new finalized heads, safely.
+func (m *MockHeadTrackable) OnNewFinalizedHeadCount() int32 {
+	return atomic.LoadInt32(&m.onNewFinalizedHeadCount)
+}
+
+// LatestFinalizedNumber returns the number of the last finalized head received, safely.
+func (m *MockHeadTrackable) LatestFinalizedNumber() int64 {
+	return atomic.LoadInt64(&m.latestFinalizedNumber)
+}
+
 // NeverSleeper is a struct that never sleeps
 type NeverSleeper struct{}
 
@@ -433,6 +455,7 @@ func (m *MockAPIInitializer) Initialize(store *store.Store) (models.User, error)
 	}
 	m.Count++
 	user := MustRandomUser()
+	user.Admin = true
 	return user, store.SaveUser(&user)
 }
 
@@ -383,6 +383,10 @@ func (c *SimulatedBackendClient) CallContext(ctx context.Context, result interfa
 }
 
 func (c *SimulatedBackendClient) CallContract(ctx context.Context, msg ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	// A block number of -1 is the pending block, as in ethclient
+	if blockNumber != nil && blockNumber.Sign() < 0 {
+		return c.b.PendingCallContract(ctx, msg)
+	}
 	return c.b.CallContract(ctx, msg, blockNumber)
 }
 
@@ -134,19 +134,28 @@ func generateCode(fset *token.FileSet, fileNode *ast.File) []byte {
 }
 
 func getContractName(fileNode *ast.File) string {
-	// Grab the contract name. It's always the first type in the file.
-	var contractName string
+	// Grab the contract name. It's the first type in the file which has a
+	// Caller type, since the structs of the contract's ABI come before it.
+	var typeNames []string
 	astutil.Apply(fileNode, func(cursor *astutil.Cursor) bool {
 		x, is := cursor.Node().(*ast.TypeSpec)
 		if !is {
 			return true
 		}
-		if contractName == "" {
-			contractName = x.Name.Name
-		}
+		typeNames = append(typeNames, x.Name.Name)
 		return false
 	}, nil)
-	return contractName
+	for _, name := range typeNames {
+		for _, other := range typeNames {
+			if other == name+"Caller" {
+				return name
+			}
+		}
+	}
+	if len(typeNames) == 0 {
+		return ""
+	}
+	return typeNames[0]
 }
 
 func addContractStructFields(contractName string, fileNode *ast.File) *ast.File {
@@ -5,12 +5,18 @@ package mocks
 import (
 	big "math/big"
 
+	bulletprooftxmanager "github.com/smartcontractkit/chainlink/core/services/bulletprooftxmanager"
+
+	chainlink "github.com/smartcontractkit/chainlink/core/services/chainlink"
+
 	config "github.com/smartcontractkit/chainlink/core/store/config"
 
 	context "context"
 
 	eth "github.com/smartcontractkit/chainlink/core/services/eth"
 
+	events "github.com/smartcontractkit/chainlink/core/services/events"
+
 	feeds "github.com/smartcontractkit/chainlink/core/services/feeds"
 
 	health "github.com/smartcontractkit/chainlink/core/services/health"
@@ -35,6 +41,8 @@ import (
 
 	synchronization "github.com/smartcontractkit/chainlink/core/services/synchronization"
 
+	time "time"
+
 	types "github.com/smartcontractkit/chainlink/core/services/headtracker/types"
 
 	uuid "github.com/satori/go.uuid"
@@ -195,6 +203,41 @@ func (_m *Application) DeleteJobV2(ctx context.Context, jobID int32) error {
 	return r0
 }
 
+// Drain provides a mock function with given fields: timeout
+func (_m *Application) Drain(timeout time.Duration) error {
+	ret := _m.Called(timeout)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(time.Duration) error); ok {
+		r0 = rf(timeout)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DrainStatus provides a mock function with given fields:
+func (_m *Application) DrainStatus() (chainlink.DrainStatus, error) {
+	ret := _m.Called()
+
+	var r0 chainlink.DrainStatus
+	if rf, ok := ret.Get(0).(func() chainlink.DrainStatus); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(chainlink.DrainStatus)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetConfig provides a mock function with given fields:
 func (_m *Application) GetConfig() *config.Config {
 	ret := _m.Called()
@@ -355,6 +398,22 @@ func (_m *Application) GetStore() *store.Store {
 	return r0
 }
 
+// GetTxManager provides a mock function with given fields:
+func (_m *Application) GetTxManager() bulletprooftxmanager.TxManager {
+	ret := _m.Called()
+
+	var r0 bulletprooftxmanager.TxManager
+	if rf, ok := ret.Get(0).(func() bulletprooftxmanager.TxManager); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(bulletprooftxmanager.TxManager)
+		}
+	}
+
+	return r0
+}
+
 // JobORM provides a mock function with given fields:
 func (_m *Application) JobORM() job.ORM {
 	ret := _m.Called()
@@ -401,6 +460,22 @@ func (_m *Application) NewBox() packr.Box {
 	return r0
 }
 
+// NodeEvents provides a mock function with given fields:
+func (_m *Application) NodeEvents() events.Broadcaster {
+	ret := _m.Called()
+
+	var r0 events.Broadcaster
+	if rf, ok := ret.Get(0).(func() events.Broadcaster); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(events.Broadcaster)
+		}
+	}
+
+	return r0
+}
+
 // PipelineORM provides a mock function with given fields:
 func (_m *Application) PipelineORM() pipeline.ORM {
 	ret := _m.Called()
@@ -417,6 +492,22 @@ func (_m *Application) PipelineORM() pipeline.ORM {
 	return r0
 }
 
+// PipelineRunner provides a mock function with given fields:
+func (_m *Application) PipelineRunner() pipeline.Runner {
+	ret := _m.Called()
+
+	var r0 pipeline.Runner
+	if rf, ok := ret.Get(0).(func() pipeline.Runner); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(pipeline.Runner)
+		}
+	}
+
+	return r0
+}
+
 // ReplayFromBlock provides a mock function with given fields: number
 func (_m *Application) ReplayFromBlock(number uint64) error {
 	ret := _m.Called(number)
@@ -72,13 +72,20 @@ func (_m *Checker) IsReady() (bool, map[string]error) {
 	return r0, r1
 }
 
-// Register provides a mock function with given fields: name, service
-func (_m *Checker) Register(name string, service health.Checkable) error {
-	ret := _m.Called(name, service)
+// Register provides a mock function with given fields: name, service, dependsOn
+func (_m *Checker) Register(name string, service health.Checkable, dependsOn ...string) error {
+	_va := make([]interface{}, len(dependsOn))
+	for _i := range dependsOn {
+		_va[_i] = dependsOn[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, name, service)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(string, health.Checkable) error); ok {
-		r0 = rf(name, service)
+	if rf, ok := ret.Get(0).(func(string, health.Checkable, ...string) error); ok {
+		r0 = rf(name, service, dependsOn...)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -100,6 +107,22 @@ func (_m *Checker) Start() error {
 	return r0
 }
 
+// Tree provides a mock function with given fields:
+func (_m *Checker) Tree() []health.Node {
+	ret := _m.Called()
+
+	var r0 []health.Node
+	if rf, ok := ret.Get(0).(func() []health.Node); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]health.Node)
+		}
+	}
+
+	return r0
+}
+
 // Unregister provides a mock function with given fields: name
 func (_m *Checker) Unregister(name string) error {
 	ret := _m.Called(name)
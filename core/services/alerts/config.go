@@ -0,0 +1,15 @@
+package alerts
+
+import (
+	"net/url"
+	"time"
+
+	"github.com/smartcontractkit/chainlink/core/store/models"
+)
+
+type Config interface {
+	AlertsEmailFrom() string
+	AlertsEvaluationInterval() time.Duration
+	AlertsSMTPURL() *url.URL
+	DefaultHTTPTimeout() models.Duration
+}
@@ -0,0 +1,450 @@
+package alerts
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+	"go.uber.org/multierr"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/core/assets"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/service"
+	"github.com/smartcontractkit/chainlink/core/services/events"
+	httypes "github.com/smartcontractkit/chainlink/core/services/headtracker/types"
+	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+	"github.com/smartcontractkit/chainlink/core/services/postgres"
+	"github.com/smartcontractkit/chainlink/core/store/models"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+// Engine evaluates the alert rules every ALERTS_EVALUATION_INTERVAL. When a
+// rule fires or resolves, the engine records an alert in the history and
+// notifies the rule's channels, unless the rule is silenced. Notifications
+// are delivered in the background, and retried up to maxDeliveryAttempts
+// times.
+//
+// The state of the rules is kept in memory, so rules still firing when the
+// node restarts fire again.
+type Engine interface {
+	service.Service
+	httypes.HeadTrackable
+}
+
+// BalanceMonitor is the source of the ETH balances of the keys
+type BalanceMonitor interface {
+	GetEthBalance(common.Address) *assets.Eth
+}
+
+// KeyStore is the source of the eth keys checked by key_balance_low rules
+type KeyStore interface {
+	AllKeys() ([]ethkey.Key, error)
+}
+
+const (
+	// maxDeliveryAttempts is how many times a notification is sent to a
+	// channel before it is given up on
+	maxDeliveryAttempts = 3
+	// deliveryRetryInterval is the wait before the first retry of a failed
+	// delivery, which doubles for each following retry
+	deliveryRetryInterval = 5 * time.Second
+)
+
+type engine struct {
+	orm            ORM
+	config         Config
+	balanceMonitor BalanceMonitor
+	keyStore       KeyStore
+	nodeEvents     events.Broadcaster
+	notifier       *notifier
+	retryInterval  time.Duration
+
+	mu           sync.Mutex
+	startedAt    time.Time
+	latestHeadAt time.Time
+	erroredRuns  map[int32][]time.Time
+
+	// firing is only accessed by evaluate
+	firing map[int64]bool
+
+	runs   events.Subscription
+	chStop chan struct{}
+	wgDone sync.WaitGroup
+	utils.StartStopOnce
+}
+
+var _ Engine = (*engine)(nil)
+
+// NewEngine returns the alert engine. Errored runs are counted from the run
+// completed events of nodeEvents.
+func NewEngine(orm ORM, config Config, balanceMonitor BalanceMonitor, keyStore KeyStore, nodeEvents events.Broadcaster) *engine {
+	return &engine{
+		orm:            orm,
+		config:         config,
+		balanceMonitor: balanceMonitor,
+		keyStore:       keyStore,
+		nodeEvents:     nodeEvents,
+		notifier:       newNotifier(config),
+		retryInterval:  deliveryRetryInterval,
+		erroredRuns:    make(map[int32][]time.Time),
+		firing:         make(map[int64]bool),
+		chStop:         make(chan struct{}),
+	}
+}
+
+func (e *engine) Start() error {
+	return e.StartOnce("AlertEngine", func() error {
+		e.mu.Lock()
+		e.startedAt = time.Now()
+		e.mu.Unlock()
+
+		e.runs = e.nodeEvents.Subscribe(events.Filter{Types: []events.Type{events.TypeRunCompleted}})
+		e.wgDone.Add(2)
+		go e.recordErroredRuns()
+		go e.run()
+		return nil
+	})
+}
+
+func (e *engine) Close() error {
+	return e.StopOnce("AlertEngine", func() error {
+		close(e.chStop)
+		e.runs.Close()
+		e.wgDone.Wait()
+		return nil
+	})
+}
+
+// Connect complies with HeadTrackable
+func (e *engine) Connect(*models.Head) error {
+	return nil
+}
+
+// OnNewLongestChain records when the latest head was received, for no_heads
+// rules
+func (e *engine) OnNewLongestChain(context.Context, models.Head) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.latestHeadAt = time.Now()
+}
+
+func (e *engine) recordErroredRuns() {
+	defer e.wgDone.Done()
+	for {
+		select {
+		case event, ok := <-e.runs.Events():
+			if !ok {
+				return
+			}
+			if event.RunState == pipeline.RunStatusErrored {
+				e.recordErroredRun(event.JobID, event.Time)
+			}
+		case <-e.chStop:
+			return
+		}
+	}
+}
+
+func (e *engine) recordErroredRun(jobID int32, at time.Time) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.erroredRuns[jobID] = append(e.erroredRuns[jobID], at)
+}
+
+func (e *engine) run() {
+	defer e.wgDone.Done()
+	ticker := time.NewTicker(e.config.AlertsEvaluationInterval())
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			ctx, cancel := utils.ContextFromChan(e.chStop)
+			e.evaluate(ctx, time.Now())
+			cancel()
+		case <-e.chStop:
+			return
+		}
+	}
+}
+
+// evaluate checks every rule, raising an alert for each which fired or
+// resolved since the previous evaluation
+func (e *engine) evaluate(ctx context.Context, now time.Time) {
+	queryCtx, cancel := context.WithTimeout(ctx, postgres.DefaultQueryTimeout)
+	defer cancel()
+	rules, err := e.orm.ListRules(queryCtx)
+	if err != nil {
+		logger.Errorw("AlertEngine: failed to load rules", "err", err)
+		return
+	}
+	e.pruneErroredRuns(now, rules)
+
+	rulesByID := make(map[int64]struct{})
+	var raised []Alert
+	var raisedRules []Rule
+	for _, rule := range rules {
+		rulesByID[rule.ID] = struct{}{}
+		firing, message := e.check(rule, now)
+		if firing == e.firing[rule.ID] {
+			continue
+		}
+		e.firing[rule.ID] = firing
+		state := AlertStateResolved
+		if firing {
+			state = AlertStateFiring
+		}
+		raised = append(raised, Alert{
+			RuleID:    null.IntFrom(rule.ID),
+			RuleName:  rule.Name,
+			State:     state,
+			Message:   message,
+			CreatedAt: now,
+		})
+		raisedRules = append(raisedRules, rule)
+	}
+	for id := range e.firing {
+		if _, exists := rulesByID[id]; !exists {
+			delete(e.firing, id)
+		}
+	}
+	if len(raised) == 0 {
+		return
+	}
+
+	channels, err := e.orm.ListChannels(queryCtx)
+	if err != nil {
+		logger.Errorw("AlertEngine: failed to load channels", "err", err)
+	}
+	silences, err := e.orm.ListSilences(queryCtx, now)
+	if err != nil {
+		logger.Errorw("AlertEngine: failed to load silences", "err", err)
+	}
+	for i := range raised {
+		e.raise(ctx, raisedRules[i], raised[i], channels, silences)
+	}
+}
+
+// raise records the alert in the history and, unless the rule is silenced,
+// notifies the channels of the rule in the background
+func (e *engine) raise(ctx context.Context, rule Rule, alert Alert, channels []Channel, silences []Silence) {
+	for _, silence := range silences {
+		if silence.silences(rule.ID, alert.CreatedAt) {
+			alert.Silenced = true
+			break
+		}
+	}
+	logFn := logger.Infow
+	if alert.State == AlertStateFiring {
+		logFn = logger.Warnw
+	}
+	logFn(fmt.Sprintf("AlertEngine: rule %s %s: %s", rule.Name, alert.State, alert.Message),
+		"rule", rule.Name, "state", alert.State, "silenced", alert.Silenced)
+
+	queryCtx, cancel := context.WithTimeout(ctx, postgres.DefaultQueryTimeout)
+	defer cancel()
+	if err := e.orm.CreateAlert(queryCtx, &alert); err != nil {
+		logger.Errorw("AlertEngine: failed to record alert", "rule", rule.Name, "err", err)
+	}
+
+	if alert.Silenced {
+		return
+	}
+	var notified []Channel
+	for _, channel := range channels {
+		if rule.notifies(channel.ID) {
+			notified = append(notified, channel)
+		}
+	}
+	if len(notified) > 0 {
+		e.wgDone.Add(1)
+		go e.notify(rule, alert, notified)
+	}
+}
+
+// notify delivers the alert to the channels, so that slow channels hold up
+// neither the evaluation nor Close, and records if any of them failed
+func (e *engine) notify(rule Rule, alert Alert, channels []Channel) {
+	defer e.wgDone.Done()
+	ctx, cancel := utils.ContextFromChan(e.chStop)
+	defer cancel()
+
+	notification := Notification{
+		Rule:     rule.Name,
+		RuleType: rule.Type,
+		State:    alert.State,
+		Message:  alert.Message,
+		Time:     alert.CreatedAt,
+	}
+	var merr error
+	for _, channel := range channels {
+		if err := e.deliver(ctx, channel, notification); err != nil {
+			merr = multierr.Append(merr, errors.Wrapf(err, "failed to notify channel %s", channel.Name))
+		}
+	}
+	if merr == nil {
+		return
+	}
+	logger.Errorw("AlertEngine: failed to deliver alert", "rule", rule.Name, "err", merr)
+	if alert.ID == 0 {
+		return
+	}
+	queryCtx, cancelQuery := context.WithTimeout(ctx, postgres.DefaultQueryTimeout)
+	defer cancelQuery()
+	if err := e.orm.SetAlertDeliveryError(queryCtx, alert.ID, merr.Error()); err != nil {
+		logger.Errorw("AlertEngine: failed to record delivery error", "rule", rule.Name, "err", err)
+	}
+}
+
+// deliver sends the notification to the channel, retrying failed attempts
+// with a backoff until maxDeliveryAttempts or ctx is done. Each attempt is
+// bounded by DEFAULT_HTTP_TIMEOUT.
+func (e *engine) deliver(ctx context.Context, channel Channel, notification Notification) error {
+	wait := e.retryInterval
+	for attempt := 1; ; attempt++ {
+		attemptCtx, cancel := context.WithTimeout(ctx, e.config.DefaultHTTPTimeout().Duration())
+		err := e.notifier.deliver(attemptCtx, channel, notification)
+		cancel()
+		if err == nil || attempt == maxDeliveryAttempts {
+			return err
+		}
+		select {
+		case <-time.After(wait):
+			wait *= 2
+		case <-ctx.Done():
+			return err
+		}
+	}
+}
+
+// check returns whether the rule fires at the given time, and a description
+// of the state it checks
+func (e *engine) check(rule Rule, now time.Time) (firing bool, message string) {
+	switch rule.Type {
+	case RuleTypeKeyBalanceLow:
+		return e.checkKeyBalances(rule.Params)
+	case RuleTypeJobErrors:
+		return e.checkJobErrors(rule.Params, now)
+	case RuleTypeNoHeads:
+		return e.checkHeads(rule.Params, now)
+	default:
+		return false, fmt.Sprintf("unknown rule type '%s'", rule.Type)
+	}
+}
+
+func (e *engine) checkKeyBalances(params RuleParams) (bool, string) {
+	if params.MinBalance == nil {
+		return false, "minBalance is not set"
+	}
+	keys, err := e.keyStore.AllKeys()
+	if err != nil {
+		return false, fmt.Sprintf("unable to load keys: %v", err)
+	}
+	var low []string
+	for _, key := range keys {
+		if params.Address != nil && key.Address != *params.Address {
+			continue
+		}
+		balance := e.balanceMonitor.GetEthBalance(key.Address.Address())
+		if balance == nil || balance.Cmp(params.MinBalance) >= 0 {
+			continue
+		}
+		low = append(low, fmt.Sprintf("%s has %s ETH", key.Address.Hex(), balance.String()))
+	}
+	if len(low) > 0 {
+		return true, fmt.Sprintf("ETH balance below %s ETH: %s", params.MinBalance.String(), strings.Join(low, ", "))
+	}
+	return false, fmt.Sprintf("ETH balances are at least %s ETH", params.MinBalance.String())
+}
+
+func (e *engine) checkJobErrors(params RuleParams, now time.Time) (bool, string) {
+	if params.MaxErrors == nil {
+		return false, "maxErrors is not set"
+	}
+	window := jobErrorsWindow(params)
+	since := now.Add(-window)
+
+	e.mu.Lock()
+	counts := make(map[int32]int)
+	for jobID, times := range e.erroredRuns {
+		if params.JobID != nil && jobID != *params.JobID {
+			continue
+		}
+		for _, t := range times {
+			if t.After(since) {
+				counts[jobID]++
+			}
+		}
+	}
+	e.mu.Unlock()
+
+	var erroring []string
+	for jobID, count := range counts {
+		if count > int(*params.MaxErrors) {
+			erroring = append(erroring, fmt.Sprintf("job %d errored %d times", jobID, count))
+		}
+	}
+	sort.Strings(erroring)
+	if len(erroring) > 0 {
+		return true, fmt.Sprintf("more than %d errored runs in the last %s: %s", *params.MaxErrors, window, strings.Join(erroring, ", "))
+	}
+	return false, fmt.Sprintf("at most %d errored runs in the last %s", *params.MaxErrors, window)
+}
+
+func (e *engine) checkHeads(params RuleParams, now time.Time) (bool, string) {
+	if params.Duration == nil {
+		return false, "duration is not set"
+	}
+	e.mu.Lock()
+	since, received := e.latestHeadAt, !e.latestHeadAt.IsZero()
+	if !received {
+		since = e.startedAt
+	}
+	e.mu.Unlock()
+
+	if elapsed := now.Sub(since); elapsed > params.Duration.Duration() {
+		if !received {
+			return true, fmt.Sprintf("no head received since the node started %s ago", elapsed.Round(time.Second))
+		}
+		return true, fmt.Sprintf("no new head for %s", elapsed.Round(time.Second))
+	}
+	return false, "receiving new heads"
+}
+
+// pruneErroredRuns forgets the errored runs older than the longest window of
+// the job_errors rules
+func (e *engine) pruneErroredRuns(now time.Time, rules []Rule) {
+	var longest time.Duration
+	for _, rule := range rules {
+		if rule.Type == RuleTypeJobErrors {
+			if window := jobErrorsWindow(rule.Params); window > longest {
+				longest = window
+			}
+		}
+	}
+	since := now.Add(-longest)
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	for jobID, times := range e.erroredRuns {
+		i := sort.Search(len(times), func(i int) bool { return times[i].After(since) })
+		if i == len(times) {
+			delete(e.erroredRuns, jobID)
+		} else {
+			e.erroredRuns[jobID] = times[i:]
+		}
+	}
+}
+
+func jobErrorsWindow(params RuleParams) time.Duration {
+	if params.Window == nil {
+		return DefaultJobErrorsWindow
+	}
+	return params.Window.Duration()
+}
@@ -0,0 +1,313 @@
+package alerts
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"mime"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/mail"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/core/assets"
+	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
+	"github.com/smartcontractkit/chainlink/core/store/models"
+)
+
+type testConfig struct {
+	smtpURL   *url.URL
+	emailFrom string
+}
+
+func (c testConfig) AlertsEmailFrom() string               { return c.emailFrom }
+func (testConfig) AlertsEvaluationInterval() time.Duration { return time.Second }
+func (c testConfig) AlertsSMTPURL() *url.URL               { return c.smtpURL }
+func (testConfig) DefaultHTTPTimeout() models.Duration     { return models.MustMakeDuration(time.Second) }
+
+// memoryORM keeps the rules, channels and silences in memory
+type memoryORM struct {
+	ORM
+	rules    []Rule
+	channels []Channel
+	silences []Silence
+
+	mu     sync.Mutex
+	alerts []Alert
+}
+
+func (o *memoryORM) ListRules(context.Context) ([]Rule, error)       { return o.rules, nil }
+func (o *memoryORM) ListChannels(context.Context) ([]Channel, error) { return o.channels, nil }
+func (o *memoryORM) ListSilences(_ context.Context, activeAt time.Time) ([]Silence, error) {
+	return o.silences, nil
+}
+func (o *memoryORM) CreateAlert(_ context.Context, alert *Alert) error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	alert.ID = int64(len(o.alerts) + 1)
+	o.alerts = append(o.alerts, *alert)
+	return nil
+}
+func (o *memoryORM) SetAlertDeliveryError(_ context.Context, id int64, deliveryError string) error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.alerts[id-1].DeliveryError = null.StringFrom(deliveryError)
+	return nil
+}
+
+type testBalances map[common.Address]*assets.Eth
+
+func (b testBalances) GetEthBalance(address common.Address) *assets.Eth { return b[address] }
+
+type testKeys []ethkey.Key
+
+func (k testKeys) AllKeys() ([]ethkey.Key, error) { return k, nil }
+
+func TestEngine_Evaluate(t *testing.T) {
+	t.Parallel()
+
+	var mu sync.Mutex
+	var notifications []Notification
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var n Notification
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&n))
+		mu.Lock()
+		defer mu.Unlock()
+		notifications = append(notifications, n)
+	}))
+	defer server.Close()
+
+	funded, unfunded := common.HexToAddress("0x1"), common.HexToAddress("0x2")
+	balances := testBalances{
+		funded:   assets.NewEth(100),
+		unfunded: assets.NewEth(1),
+	}
+	keys := testKeys{
+		{Address: ethkey.EIP55AddressFromAddress(funded)},
+		{Address: ethkey.EIP55AddressFromAddress(unfunded)},
+	}
+
+	maxErrors := uint32(1)
+	window := models.MustMakeDuration(time.Hour)
+	noHeadsFor := models.MustMakeDuration(2 * time.Minute)
+	orm := &memoryORM{
+		rules: []Rule{
+			{ID: 1, Name: "low balance", Type: RuleTypeKeyBalanceLow, Params: RuleParams{MinBalance: assets.NewEth(10)}},
+			{ID: 2, Name: "job errors", Type: RuleTypeJobErrors, Params: RuleParams{MaxErrors: &maxErrors, Window: &window}},
+			{ID: 3, Name: "no heads", Type: RuleTypeNoHeads, Params: RuleParams{Duration: &noHeadsFor}},
+		},
+		channels: []Channel{{ID: 1, Name: "webhook", Type: ChannelTypeWebhook, URL: server.URL}},
+		silences: []Silence{{ID: 1, RuleID: null.IntFrom(3), EndsAt: time.Now().Add(time.Hour)}},
+	}
+
+	e := NewEngine(orm, testConfig{}, balances, keys, nil)
+	now := time.Now()
+	e.startedAt = now.Add(-time.Hour)
+	e.recordErroredRun(7, now.Add(-2*time.Hour))
+	e.recordErroredRun(7, now.Add(-time.Minute))
+	e.recordErroredRun(7, now.Add(-time.Second))
+
+	e.evaluate(context.Background(), now)
+	e.wgDone.Wait()
+
+	require.Len(t, orm.alerts, 3)
+	for _, alert := range orm.alerts {
+		assert.Equal(t, AlertStateFiring, alert.State)
+		assert.False(t, alert.DeliveryError.Valid)
+	}
+	assert.Contains(t, orm.alerts[0].Message, unfunded.Hex())
+	assert.NotContains(t, orm.alerts[0].Message, funded.Hex())
+	assert.Contains(t, orm.alerts[1].Message, "job 7 errored 2 times")
+	assert.True(t, orm.alerts[2].Silenced)
+
+	mu.Lock()
+	require.Len(t, notifications, 2, "silenced rules must not be delivered")
+	// Each alert is delivered in the background, in any order
+	assert.ElementsMatch(t, []string{"low balance", "job errors"}, []string{notifications[0].Rule, notifications[1].Rule})
+	mu.Unlock()
+
+	// Firing rules are only raised again once they resolve
+	e.evaluate(context.Background(), now.Add(time.Second))
+	assert.Len(t, orm.alerts, 3)
+
+	balances[unfunded] = assets.NewEth(10)
+	e.latestHeadAt = now.Add(2 * time.Hour)
+	e.evaluate(context.Background(), now.Add(2*time.Hour))
+
+	require.Len(t, orm.alerts, 6)
+	for _, alert := range orm.alerts[3:] {
+		assert.Equal(t, AlertStateResolved, alert.State)
+	}
+	assert.Empty(t, e.erroredRuns, "errored runs older than the longest window are forgotten")
+	e.wgDone.Wait()
+}
+
+func TestNotifier_Slack(t *testing.T) {
+	t.Parallel()
+
+	var body map[string]string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+	}))
+	defer server.Close()
+
+	n := newNotifier(testConfig{})
+	err := n.deliver(context.Background(), Channel{Type: ChannelTypeSlack, URL: server.URL}, Notification{
+		Rule:    "no heads",
+		State:   AlertStateFiring,
+		Message: "no new head for 3m0s",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "[FIRING] no heads: no new head for 3m0s", body["text"])
+
+	err = n.deliver(context.Background(), Channel{Type: ChannelTypeEmail, Recipients: []string{"ops@example.com"}}, Notification{})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "ALERTS_SMTP_URL")
+}
+
+func TestNotifier_EmailSubject(t *testing.T) {
+	t.Parallel()
+
+	msg, err := mail.ReadMessage(bytes.NewReader(emailMessage("node@example.com", []string{"ops@example.com"}, Notification{
+		Rule:    "no heads\r\nBcc: attacker@example.com",
+		State:   AlertStateFiring,
+		Message: "no new head\nfor 3m0s",
+	})))
+	require.NoError(t, err)
+	assert.Empty(t, msg.Header.Get("Bcc"))
+
+	subject, err := new(mime.WordDecoder).DecodeHeader(msg.Header.Get("Subject"))
+	require.NoError(t, err)
+	assert.Equal(t, "[FIRING] no heads Bcc: attacker@example.com: no new head for 3m0s", subject)
+}
+
+func TestEngine_DeliveryRetries(t *testing.T) {
+	t.Parallel()
+
+	var mu sync.Mutex
+	attempts := make(map[string]int)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		attempts[r.URL.Path]++
+		// The flaky channel fails once, the broken channel always fails
+		if r.URL.Path == "/broken" || attempts[r.URL.Path] == 1 {
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+	defer server.Close()
+
+	orm := &memoryORM{
+		rules: []Rule{
+			{ID: 1, Name: "flaky", Type: RuleTypeNoHeads, Params: RuleParams{Duration: durationPtr(time.Minute)}, ChannelIDs: []int64{1}},
+			{ID: 2, Name: "broken", Type: RuleTypeNoHeads, Params: RuleParams{Duration: durationPtr(time.Minute)}, ChannelIDs: []int64{2}},
+		},
+		channels: []Channel{
+			{ID: 1, Name: "flaky", Type: ChannelTypeWebhook, URL: server.URL + "/flaky"},
+			{ID: 2, Name: "broken", Type: ChannelTypeWebhook, URL: server.URL + "/broken"},
+		},
+	}
+	e := NewEngine(orm, testConfig{}, nil, nil, nil)
+	e.retryInterval = time.Millisecond
+	now := time.Now()
+	e.startedAt = now.Add(-time.Hour)
+
+	e.evaluate(context.Background(), now)
+	e.wgDone.Wait()
+
+	mu.Lock()
+	assert.Equal(t, 2, attempts["/flaky"])
+	assert.Equal(t, maxDeliveryAttempts, attempts["/broken"])
+	mu.Unlock()
+	require.Len(t, orm.alerts, 2)
+	assert.False(t, orm.alerts[0].DeliveryError.Valid)
+	require.True(t, orm.alerts[1].DeliveryError.Valid)
+	assert.Contains(t, orm.alerts[1].DeliveryError.String, "failed to notify channel broken")
+}
+
+func TestEngine_DeliveryDoesNotBlock(t *testing.T) {
+	t.Parallel()
+
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-release:
+		case <-r.Context().Done():
+		}
+	}))
+	defer server.Close()
+	defer close(release)
+
+	orm := &memoryORM{
+		rules:    []Rule{{ID: 1, Name: "no heads", Type: RuleTypeNoHeads, Params: RuleParams{Duration: durationPtr(time.Minute)}}},
+		channels: []Channel{{ID: 1, Name: "webhook", Type: ChannelTypeWebhook, URL: server.URL}},
+	}
+	e := NewEngine(orm, testConfig{}, nil, nil, nil)
+	now := time.Now()
+	e.startedAt = now.Add(-time.Hour)
+
+	evaluated := make(chan struct{})
+	go func() {
+		e.evaluate(context.Background(), now)
+		close(evaluated)
+	}()
+	select {
+	case <-evaluated:
+	case <-time.After(5 * time.Second):
+		t.Fatal("evaluate waited for the delivery")
+	}
+
+	// Stopping the engine gives up on the delivery
+	close(e.chStop)
+	stopped := make(chan struct{})
+	go func() {
+		e.wgDone.Wait()
+		close(stopped)
+	}()
+	select {
+	case <-stopped:
+	case <-time.After(5 * time.Second):
+		t.Fatal("the delivery did not stop with the engine")
+	}
+}
+
+func TestNotifier_EmailTimeout(t *testing.T) {
+	t.Parallel()
+
+	// An SMTP server which accepts connections but never responds
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer listener.Close()
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			defer conn.Close()
+		}
+	}()
+
+	n := newNotifier(testConfig{smtpURL: &url.URL{Scheme: "smtp", Host: listener.Addr().String()}, emailFrom: "node@example.com"})
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	err = n.deliver(ctx, Channel{Type: ChannelTypeEmail, Recipients: []string{"ops@example.com"}}, Notification{})
+	require.Error(t, err)
+	assert.Less(t, int64(time.Since(start)), int64(5*time.Second))
+}
+
+func durationPtr(d time.Duration) *models.Duration {
+	duration := models.MustMakeDuration(d)
+	return &duration
+}
@@ -0,0 +1,228 @@
+package alerts
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/core/assets"
+	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
+	"github.com/smartcontractkit/chainlink/core/store/models"
+)
+
+// RuleType is the condition an alert rule checks
+type RuleType string
+
+const (
+	// RuleTypeKeyBalanceLow fires when the ETH balance of a key falls below
+	// MinBalance
+	RuleTypeKeyBalanceLow = RuleType("key_balance_low")
+	// RuleTypeJobErrors fires when more than MaxErrors runs of a job errored
+	// within Window
+	RuleTypeJobErrors = RuleType("job_errors")
+	// RuleTypeNoHeads fires when the node received no new head for Duration
+	RuleTypeNoHeads = RuleType("no_heads")
+)
+
+// DefaultJobErrorsWindow is the window of job_errors rules which do not set
+// one
+const DefaultJobErrorsWindow = time.Hour
+
+// RuleParams are the parameters of a rule. Only the ones relevant to its type
+// are used.
+type RuleParams struct {
+	// Address is the key checked by key_balance_low rules, every eth key if
+	// unset
+	Address *ethkey.EIP55Address `json:"address,omitempty"`
+	// MinBalance is the balance, in wei, below which key_balance_low rules
+	// fire
+	MinBalance *assets.Eth `json:"minBalance,omitempty"`
+	// JobID is the job checked by job_errors rules, every job if unset
+	JobID *int32 `json:"jobID,omitempty"`
+	// MaxErrors is the number of errored runs within Window above which
+	// job_errors rules fire
+	MaxErrors *uint32 `json:"maxErrors,omitempty"`
+	// Window is the period over which job_errors rules count errored runs
+	Window *models.Duration `json:"window,omitempty"`
+	// Duration is how long without a new head no_heads rules wait to fire
+	Duration *models.Duration `json:"duration,omitempty"`
+}
+
+// Value implements the driver.Valuer interface
+func (p RuleParams) Value() (driver.Value, error) {
+	return json.Marshal(p)
+}
+
+// Scan implements the sql.Scanner interface
+func (p *RuleParams) Scan(value interface{}) error {
+	b, ok := value.([]byte)
+	if !ok {
+		return errors.Errorf("unable to convert %v of %T to RuleParams", value, value)
+	}
+	return json.Unmarshal(b, p)
+}
+
+// Rule is a condition over the state of the node which notifies the rule's
+// channels when it fires, and again when it resolves
+type Rule struct {
+	ID     int64
+	Name   string
+	Type   RuleType
+	Params RuleParams
+	// ChannelIDs are the channels notified by the rule, every channel if
+	// empty
+	ChannelIDs pq.Int64Array `gorm:"type:bigint[]"`
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+// TableName returns the name of the table of alert rules
+func (Rule) TableName() string {
+	return "alert_rules"
+}
+
+// Validate checks that the rule has the parameters its type needs
+func (r Rule) Validate() error {
+	if r.Name == "" {
+		return errors.New("name is required")
+	}
+	switch r.Type {
+	case RuleTypeKeyBalanceLow:
+		if r.Params.MinBalance == nil {
+			return errors.New("minBalance is required for key_balance_low rules")
+		}
+	case RuleTypeJobErrors:
+		if r.Params.MaxErrors == nil {
+			return errors.New("maxErrors is required for job_errors rules")
+		}
+		if r.Params.Window != nil && r.Params.Window.Duration() <= 0 {
+			return errors.New("window must be positive")
+		}
+	case RuleTypeNoHeads:
+		if r.Params.Duration == nil || r.Params.Duration.Duration() <= 0 {
+			return errors.New("a positive duration is required for no_heads rules")
+		}
+	default:
+		return errors.Errorf("invalid type '%s', must be %s, %s or %s", r.Type, RuleTypeKeyBalanceLow, RuleTypeJobErrors, RuleTypeNoHeads)
+	}
+	return nil
+}
+
+// notifies returns whether the rule notifies the channel
+func (r Rule) notifies(channelID int64) bool {
+	if len(r.ChannelIDs) == 0 {
+		return true
+	}
+	for _, id := range r.ChannelIDs {
+		if id == channelID {
+			return true
+		}
+	}
+	return false
+}
+
+// ChannelType is how a channel delivers notifications
+type ChannelType string
+
+const (
+	// ChannelTypeWebhook POSTs notifications as JSON to URL
+	ChannelTypeWebhook = ChannelType("webhook")
+	// ChannelTypeSlack POSTs notifications as a Slack compatible message to
+	// URL, e.g. a Slack incoming webhook
+	ChannelTypeSlack = ChannelType("slack")
+	// ChannelTypeEmail emails notifications to Recipients, through the SMTP
+	// server of ALERTS_SMTP_URL
+	ChannelTypeEmail = ChannelType("email")
+)
+
+// Channel is a destination of notifications
+type Channel struct {
+	ID         int64
+	Name       string
+	Type       ChannelType
+	URL        string
+	Recipients pq.StringArray `gorm:"type:text[]"`
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+// TableName returns the name of the table of alert channels
+func (Channel) TableName() string {
+	return "alert_channels"
+}
+
+// Validate checks that the channel has the settings its type needs
+func (c Channel) Validate() error {
+	if c.Name == "" {
+		return errors.New("name is required")
+	}
+	switch c.Type {
+	case ChannelTypeWebhook, ChannelTypeSlack:
+		if c.URL == "" {
+			return errors.Errorf("url is required for %s channels", c.Type)
+		}
+	case ChannelTypeEmail:
+		if len(c.Recipients) == 0 {
+			return errors.New("recipients are required for email channels")
+		}
+	default:
+		return errors.Errorf("invalid type '%s', must be %s, %s or %s", c.Type, ChannelTypeWebhook, ChannelTypeSlack, ChannelTypeEmail)
+	}
+	return nil
+}
+
+// Silence suppresses the notifications of a rule, or of every rule if RuleID
+// is not set, until EndsAt. Silenced alerts are still recorded.
+type Silence struct {
+	ID        int64
+	RuleID    null.Int
+	Reason    string
+	EndsAt    time.Time
+	CreatedAt time.Time
+}
+
+// TableName returns the name of the table of alert silences
+func (Silence) TableName() string {
+	return "alert_silences"
+}
+
+// silences returns whether the silence applies to the rule at the given time
+func (s Silence) silences(ruleID int64, at time.Time) bool {
+	if !at.Before(s.EndsAt) {
+		return false
+	}
+	return !s.RuleID.Valid || s.RuleID.Int64 == ruleID
+}
+
+// AlertState is whether an alert was raised by its rule firing or resolving
+type AlertState string
+
+const (
+	AlertStateFiring   = AlertState("firing")
+	AlertStateResolved = AlertState("resolved")
+)
+
+// Alert is an entry of the alert history, recorded each time a rule fires or
+// resolves
+type Alert struct {
+	ID       int64
+	RuleID   null.Int
+	RuleName string
+	State    AlertState
+	Message  string
+	// Silenced is whether the notifications of the alert were suppressed
+	Silenced bool
+	// DeliveryError is set if the alert failed to be delivered to any of its
+	// channels
+	DeliveryError null.String
+	CreatedAt     time.Time
+}
+
+// TableName returns the name of the table of the alert history
+func (Alert) TableName() string {
+	return "alerts"
+}
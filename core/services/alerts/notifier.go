@@ -0,0 +1,197 @@
+package alerts
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"mime"
+	"net"
+	"net/http"
+	"net/smtp"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// Notification is the content of an alert delivered to a channel. Webhook
+// channels receive it as the JSON body of a POST.
+type Notification struct {
+	Rule     string     `json:"rule"`
+	RuleType RuleType   `json:"ruleType"`
+	State    AlertState `json:"state"`
+	Message  string     `json:"message"`
+	Time     time.Time  `json:"time"`
+}
+
+// summary is the one line description of the notification used by Slack
+// messages and email subjects
+func (n Notification) summary() string {
+	return fmt.Sprintf("[%s] %s: %s", strings.ToUpper(string(n.State)), n.Rule, n.Message)
+}
+
+// notifier delivers notifications to channels
+type notifier struct {
+	config     Config
+	httpClient *http.Client
+}
+
+func newNotifier(config Config) *notifier {
+	return &notifier{
+		config:     config,
+		httpClient: &http.Client{Timeout: config.DefaultHTTPTimeout().Duration()},
+	}
+}
+
+// deliver sends the notification to the channel
+func (n *notifier) deliver(ctx context.Context, channel Channel, notification Notification) error {
+	switch channel.Type {
+	case ChannelTypeWebhook:
+		return n.post(ctx, channel.URL, notification)
+	case ChannelTypeSlack:
+		return n.post(ctx, channel.URL, map[string]string{"text": notification.summary()})
+	case ChannelTypeEmail:
+		return n.email(ctx, channel.Recipients, notification)
+	default:
+		return errors.Errorf("unknown channel type '%s'", channel.Type)
+	}
+}
+
+func (n *notifier) post(ctx context.Context, url string, body interface{}) error {
+	b, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(b))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := n.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= 300 {
+		msg, _ := ioutil.ReadAll(io.LimitReader(resp.Body, 1024))
+		return errors.Errorf("POST to channel returned %s: %s", resp.Status, msg)
+	}
+	return nil
+}
+
+// email sends the notification through the SMTP server of ALERTS_SMTP_URL,
+// authenticating with the credentials of the URL if it has any
+func (n *notifier) email(ctx context.Context, recipients []string, notification Notification) error {
+	smtpURL := n.config.AlertsSMTPURL()
+	if smtpURL == nil {
+		return errors.New("ALERTS_SMTP_URL is not set")
+	}
+	from := n.config.AlertsEmailFrom()
+	if from == "" {
+		return errors.New("ALERTS_EMAIL_FROM is not set")
+	}
+	var auth smtp.Auth
+	if smtpURL.User != nil {
+		password, _ := smtpURL.User.Password()
+		host, _, err := net.SplitHostPort(smtpURL.Host)
+		if err != nil {
+			host = smtpURL.Host
+		}
+		auth = smtp.PlainAuth("", smtpURL.User.Username(), password, host)
+	}
+
+	return sendMail(ctx, smtpURL.Host, auth, from, recipients, emailMessage(from, recipients, notification))
+}
+
+// emailMessage returns the email of notification
+func emailMessage(from string, recipients []string, notification Notification) []byte {
+	var msg bytes.Buffer
+	fmt.Fprintf(&msg, "From: %s\r\n", from)
+	fmt.Fprintf(&msg, "To: %s\r\n", strings.Join(recipients, ", "))
+	fmt.Fprintf(&msg, "Subject: %s\r\n", emailSubject(notification))
+	fmt.Fprintf(&msg, "Date: %s\r\n", notification.Time.Format(time.RFC1123Z))
+	fmt.Fprintf(&msg, "Content-Type: text/plain; charset=UTF-8\r\n\r\n")
+	fmt.Fprintf(&msg, "Rule: %s (%s)\r\nState: %s\r\nTime: %s\r\n\r\n%s\r\n",
+		notification.Rule, notification.RuleType, notification.State, notification.Time.Format(time.RFC3339), notification.Message)
+	return msg.Bytes()
+}
+
+// emailSubject returns the summary of notification for the Subject header.
+// Line breaks, which would end the header and let the rule name or message
+// add headers of their own, are replaced with spaces, and the summary is
+// encoded as RFC 2047 encoded words if it has any other special characters.
+func emailSubject(notification Notification) string {
+	summary := strings.NewReplacer("\r\n", " ", "\r", " ", "\n", " ").Replace(notification.summary())
+	return mime.QEncoding.Encode("utf-8", summary)
+}
+
+// sendMail is smtp.SendMail, but gives up once ctx is done, including when
+// the server stops responding
+func sendMail(ctx context.Context, addr string, auth smtp.Auth, from string, to []string, msg []byte) error {
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, "tcp", addr)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	if deadline, ok := ctx.Deadline(); ok {
+		if err = conn.SetDeadline(deadline); err != nil {
+			return err
+		}
+	}
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		select {
+		case <-ctx.Done():
+			conn.Close()
+		case <-done:
+		}
+	}()
+
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		host = addr
+	}
+	c, err := smtp.NewClient(conn, host)
+	if err != nil {
+		return err
+	}
+	defer c.Close()
+	if ok, _ := c.Extension("STARTTLS"); ok {
+		if err = c.StartTLS(&tls.Config{ServerName: host}); err != nil {
+			return err
+		}
+	}
+	if auth != nil {
+		if ok, _ := c.Extension("AUTH"); !ok {
+			return errors.New("SMTP server does not support AUTH")
+		}
+		if err = c.Auth(auth); err != nil {
+			return err
+		}
+	}
+	if err = c.Mail(from); err != nil {
+		return err
+	}
+	for _, recipient := range to {
+		if err = c.Rcpt(recipient); err != nil {
+			return err
+		}
+	}
+	w, err := c.Data()
+	if err != nil {
+		return err
+	}
+	if _, err = w.Write(msg); err != nil {
+		return err
+	}
+	if err = w.Close(); err != nil {
+		return err
+	}
+	return c.Quit()
+}
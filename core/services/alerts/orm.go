@@ -0,0 +1,130 @@
+package alerts
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+// ORM persists the alert rules, channels, silences and history
+type ORM interface {
+	CreateRule(ctx context.Context, rule *Rule) error
+	DeleteRule(ctx context.Context, id int64) error
+	ListRules(ctx context.Context) ([]Rule, error)
+
+	CreateChannel(ctx context.Context, channel *Channel) error
+	DeleteChannel(ctx context.Context, id int64) error
+	ListChannels(ctx context.Context) ([]Channel, error)
+
+	CreateSilence(ctx context.Context, silence *Silence) error
+	DeleteSilence(ctx context.Context, id int64) error
+	ListSilences(ctx context.Context, activeAt time.Time) ([]Silence, error)
+
+	CreateAlert(ctx context.Context, alert *Alert) error
+	SetAlertDeliveryError(ctx context.Context, id int64, deliveryError string) error
+	ListAlerts(ctx context.Context, offset, limit int) ([]Alert, int, error)
+}
+
+type orm struct {
+	db *gorm.DB
+}
+
+var _ ORM = (*orm)(nil)
+
+// NewORM returns the ORM of the alerting tables
+func NewORM(db *gorm.DB) ORM {
+	return &orm{db: db}
+}
+
+// CreateRule adds a rule, which is evaluated from the next evaluation on
+func (o *orm) CreateRule(ctx context.Context, rule *Rule) error {
+	return errors.Wrap(o.db.WithContext(ctx).Create(rule).Error, "CreateRule failed")
+}
+
+// DeleteRule removes a rule along with its silences. Its alerts are kept in
+// the history.
+func (o *orm) DeleteRule(ctx context.Context, id int64) error {
+	return o.delete(ctx, "alert_rules", id)
+}
+
+// ListRules returns every rule
+func (o *orm) ListRules(ctx context.Context) (rules []Rule, err error) {
+	err = o.db.WithContext(ctx).Order("id ASC").Find(&rules).Error
+	return rules, errors.Wrap(err, "ListRules failed")
+}
+
+// CreateChannel adds a channel
+func (o *orm) CreateChannel(ctx context.Context, channel *Channel) error {
+	return errors.Wrap(o.db.WithContext(ctx).Create(channel).Error, "CreateChannel failed")
+}
+
+// DeleteChannel removes a channel. Rules which notify it are left as they
+// are, and skip it.
+func (o *orm) DeleteChannel(ctx context.Context, id int64) error {
+	return o.delete(ctx, "alert_channels", id)
+}
+
+// ListChannels returns every channel
+func (o *orm) ListChannels(ctx context.Context) (channels []Channel, err error) {
+	err = o.db.WithContext(ctx).Order("id ASC").Find(&channels).Error
+	return channels, errors.Wrap(err, "ListChannels failed")
+}
+
+// CreateSilence adds a silence
+func (o *orm) CreateSilence(ctx context.Context, silence *Silence) error {
+	return errors.Wrap(o.db.WithContext(ctx).Create(silence).Error, "CreateSilence failed")
+}
+
+// DeleteSilence removes a silence, ending it early
+func (o *orm) DeleteSilence(ctx context.Context, id int64) error {
+	return o.delete(ctx, "alert_silences", id)
+}
+
+// ListSilences returns the silences which have not ended as of activeAt, or
+// every silence if activeAt is zero
+func (o *orm) ListSilences(ctx context.Context, activeAt time.Time) (silences []Silence, err error) {
+	db := o.db.WithContext(ctx)
+	if !activeAt.IsZero() {
+		db = db.Where("ends_at > ?", activeAt)
+	}
+	err = db.Order("id ASC").Find(&silences).Error
+	return silences, errors.Wrap(err, "ListSilences failed")
+}
+
+// CreateAlert records an alert in the history
+func (o *orm) CreateAlert(ctx context.Context, alert *Alert) error {
+	return errors.Wrap(o.db.WithContext(ctx).Create(alert).Error, "CreateAlert failed")
+}
+
+// SetAlertDeliveryError records that the alert failed to be delivered to any
+// of its channels, once its deliveries are done
+func (o *orm) SetAlertDeliveryError(ctx context.Context, id int64, deliveryError string) error {
+	err := o.db.WithContext(ctx).Exec(`UPDATE alerts SET delivery_error = ? WHERE id = ?`, deliveryError, id).Error
+	return errors.Wrap(err, "SetAlertDeliveryError failed")
+}
+
+// ListAlerts returns a page of the alert history, newest first, and the total
+// count of alerts
+func (o *orm) ListAlerts(ctx context.Context, offset, limit int) (alerts []Alert, count int, err error) {
+	var total int64
+	if err = o.db.WithContext(ctx).Model(&Alert{}).Count(&total).Error; err != nil {
+		return nil, 0, errors.Wrap(err, "ListAlerts failed to count alerts")
+	}
+	err = o.db.WithContext(ctx).Order("id DESC").Offset(offset).Limit(limit).Find(&alerts).Error
+	return alerts, int(total), errors.Wrap(err, "ListAlerts failed")
+}
+
+// delete removes the row of the given ID from table, or returns
+// gorm.ErrRecordNotFound if there is none
+func (o *orm) delete(ctx context.Context, table string, id int64) error {
+	res := o.db.WithContext(ctx).Exec(`DELETE FROM `+table+` WHERE id = ?`, id)
+	if res.Error != nil {
+		return errors.Wrapf(res.Error, "failed to delete from %s", table)
+	}
+	if res.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
@@ -31,6 +31,7 @@ type (
 	BalanceMonitor interface {
 		httypes.HeadTrackable
 		GetEthBalance(gethCommon.Address) *assets.Eth
+		SufficientBalanceFor(address gethCommon.Address, estimatedCost *big.Int) bool
 		service.Service
 	}
 
@@ -125,6 +126,17 @@ func (bm *balanceMonitor) GetEthBalance(address gethCommon.Address) *assets.Eth
 	return bm.ethBalances[address]
 }
 
+// SufficientBalanceFor reports whether the last seen balance of the address
+// covers the estimated cost in wei. If the balance has not been fetched yet,
+// the address is assumed to be funded.
+func (bm *balanceMonitor) SufficientBalanceFor(address gethCommon.Address, estimatedCost *big.Int) bool {
+	bal := bm.GetEthBalance(address)
+	if bal == nil {
+		return true
+	}
+	return bal.ToInt().Cmp(estimatedCost) >= 0
+}
+
 type worker struct {
 	bm *balanceMonitor
 }
@@ -174,6 +186,9 @@ func (w *worker) checkAccountBalance(k ethkey.Key) {
 func (*NullBalanceMonitor) GetEthBalance(gethCommon.Address) *assets.Eth {
 	return nil
 }
+func (*NullBalanceMonitor) SufficientBalanceFor(gethCommon.Address, *big.Int) bool {
+	return true
+}
 func (*NullBalanceMonitor) Start() error   { return nil }
 func (*NullBalanceMonitor) Close() error   { return nil }
 func (*NullBalanceMonitor) Ready() error   { return nil }
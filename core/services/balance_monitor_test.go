@@ -155,6 +155,32 @@ func TestBalanceMonitor_OnNewLongestChain_UpdatesBalance(t *testing.T) {
 	})
 }
 
+func TestBalanceMonitor_SufficientBalanceFor(t *testing.T) {
+	db := pgtest.NewGormDB(t)
+	ethKeyStore := cltest.NewKeyStore(t, db).Eth()
+
+	ethClient := new(mocks.Client)
+	defer ethClient.AssertExpectations(t)
+
+	_, k0Addr := cltest.MustAddRandomKeyToKeystore(t, ethKeyStore, 0)
+
+	bm := services.NewBalanceMonitor(db, ethClient, ethKeyStore)
+	defer bm.Close()
+
+	// Unknown balances do not block transactions
+	assert.True(t, bm.SufficientBalanceFor(k0Addr, big.NewInt(100)))
+
+	ethClient.On("BalanceAt", mock.Anything, k0Addr, nilBigInt).Once().Return(big.NewInt(100), nil)
+	bm.OnNewLongestChain(context.TODO(), *cltest.Head(0))
+	gomega.NewGomegaWithT(t).Eventually(func() *assets.Eth {
+		return bm.GetEthBalance(k0Addr)
+	}).ShouldNot(gomega.BeNil())
+
+	assert.True(t, bm.SufficientBalanceFor(k0Addr, big.NewInt(99)))
+	assert.True(t, bm.SufficientBalanceFor(k0Addr, big.NewInt(100)))
+	assert.False(t, bm.SufficientBalanceFor(k0Addr, big.NewInt(101)))
+}
+
 func TestBalanceMonitor_FewerRPCCallsWhenBehind(t *testing.T) {
 	db := pgtest.NewGormDB(t)
 	ethKeyStore := cltest.NewKeyStore(t, db).Eth()
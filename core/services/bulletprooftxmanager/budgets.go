@@ -0,0 +1,95 @@
+package bulletprooftxmanager
+
+import (
+	"math/big"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	uuid "github.com/satori/go.uuid"
+	"gorm.io/gorm"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+// ErrSpendBudgetExhausted is returned when creating a transaction after the
+// node or the transaction's job has spent its daily budget
+var ErrSpendBudgetExhausted = errors.New("daily spend budget exhausted")
+
+// globalBudgetLabel is the subject label of the node-wide budget metrics
+const globalBudgetLabel = "global"
+
+var (
+	promSpendBudgetExhausted = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "tx_manager_spend_budget_exhausted",
+		Help: "1 if the daily spend budget of the node (subject=\"global\") or a job is exhausted, 0 otherwise",
+	},
+		[]string{"evm_chain_id", "subject"},
+	)
+	promTxRejectedOverBudget = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "tx_manager_num_tx_rejected_over_budget",
+		Help: "Number of transactions which were rejected because a daily spend budget was exhausted",
+	},
+		[]string{"evm_chain_id", "subject"},
+	)
+)
+
+// GetDailySpend returns the amount in Wei spent on gas by the confirmed
+// transactions of the last 24 hours, i.e. the sum of the gas used by each
+// receipt times the gas price of its attempt. If subject is valid, only the
+// transactions of that subject are counted.
+//
+// Transactions sent in a multicall batch are not attributed to their subject,
+// their cost is part of the batch's.
+func GetDailySpend(db *gorm.DB, evmChainID *utils.Big, subject uuid.NullUUID) (*big.Int, error) {
+	query := `
+SELECT COALESCE(SUM(eth_receipts.gas_used * eth_tx_attempts.gas_price), 0)::text
+FROM eth_receipts
+JOIN eth_tx_attempts ON eth_tx_attempts.hash = eth_receipts.tx_hash
+JOIN eth_txes ON eth_txes.id = eth_tx_attempts.eth_tx_id
+WHERE eth_receipts.created_at > NOW() - interval '24 hours' AND eth_txes.evm_chain_id IS NOT DISTINCT FROM ?`
+	args := []interface{}{evmChainID}
+	if subject.Valid {
+		query += ` AND eth_txes.subject = ?`
+		args = append(args, subject.UUID)
+	}
+	var spend utils.Big
+	if err := db.Raw(query, args...).Scan(&spend).Error; err != nil {
+		return nil, errors.Wrap(err, "GetDailySpend failed")
+	}
+	return spend.ToInt(), nil
+}
+
+// checkSpendBudgets returns ErrSpendBudgetExhausted if the node has spent
+// ETH_TX_DAILY_BUDGET_WEI, or subject has spent ETH_TX_JOB_DAILY_BUDGET_WEI,
+// in the last 24 hours
+func checkSpendBudgets(db *gorm.DB, config Config, evmChainID *utils.Big, subject uuid.NullUUID) error {
+	check := func(budget *big.Int, subject uuid.NullUUID, label string) error {
+		spend, err := GetDailySpend(db, evmChainID, subject)
+		if err != nil {
+			return err
+		}
+		chainID := config.ChainID().String()
+		if spend.Cmp(budget) < 0 {
+			promSpendBudgetExhausted.WithLabelValues(chainID, label).Set(0)
+			return nil
+		}
+		promSpendBudgetExhausted.WithLabelValues(chainID, label).Set(1)
+		promTxRejectedOverBudget.WithLabelValues(chainID, label).Inc()
+		logger.Errorw("BulletproofTxManager: daily spend budget exhausted, rejecting transaction", "subject", label, "spendWei", spend, "budgetWei", budget)
+		return errors.Wrapf(ErrSpendBudgetExhausted, "%s spent %s of %s Wei in the last 24 hours", label, spend, budget)
+	}
+
+	if budget := config.EthTxDailyBudgetWei(); budget != nil {
+		if err := check(budget, uuid.NullUUID{}, globalBudgetLabel); err != nil {
+			return err
+		}
+	}
+	if budget := config.EthTxJobDailyBudgetWei(); budget != nil && subject.Valid {
+		if err := check(budget, subject, subject.UUID.String()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
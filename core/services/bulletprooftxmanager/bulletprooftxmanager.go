@@ -6,7 +6,9 @@ import (
 	"encoding/json"
 	"fmt"
 	"math/big"
+	"net/url"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
@@ -17,6 +19,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/services/eth"
 	"github.com/smartcontractkit/chainlink/core/services/gas"
 	httypes "github.com/smartcontractkit/chainlink/core/services/headtracker/types"
+	"github.com/smartcontractkit/chainlink/core/services/keystore"
 	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
 	"github.com/smartcontractkit/chainlink/core/services/postgres"
 	"github.com/smartcontractkit/chainlink/core/static"
@@ -26,6 +29,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	gethTypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/pkg/errors"
+	"gopkg.in/guregu/null.v4"
 	"gorm.io/gorm"
 )
 
@@ -40,32 +44,59 @@ type Config interface {
 	ChainID() *big.Int
 	EthFinalityDepth() uint
 	EthGasBumpPercent() uint16
+	EthGasBumpPercentHighUrgency() uint16
 	EthGasBumpThreshold() uint64
+	EthGasBumpThresholdHighUrgency() uint64
+	EthGasBumpThresholdLowUrgency() uint64
 	EthGasBumpTxDepth() uint16
 	EthGasBumpWei() *big.Int
 	EthGasLimitDefault() uint64
 	EthGasLimitMultiplier() float32
 	EthGasPriceDefault() *big.Int
 	EthMaxGasPriceWei() *big.Int
+	EthMaxGasPriceWeiLowUrgency() *big.Int
 	EthMaxInFlightTransactions() uint32
+	EthMaxInFlightTransactionsForKey(address common.Address) uint32
 	EthMaxQueuedTransactions() uint64
 	EthMinGasPriceWei() *big.Int
 	EthNonceAutoSync() bool
+	EthNonceReconciliationInterval() time.Duration
 	EthRPCDefaultBatchSize() uint32
+	EthTxDailyBudgetWei() *big.Int
+	EthTxJobDailyBudgetWei() *big.Int
+	EthTxMulticallAddress() *common.Address
+	EthTxMulticallMaxBatchSize() uint32
+	EthTxMulticallWindow() time.Duration
 	EthTxReaperInterval() time.Duration
 	EthTxReaperThreshold() time.Duration
 	EthTxResendAfterThreshold() time.Duration
+	EthTxSimulateBeforeBroadcast() bool
 	GasEstimatorMode() string
+	GasOracleGasPricePath() string
+	GasOracleGasPriceUnit() string
+	GasOracleMaxAge() time.Duration
+	GasOraclePollPeriod() time.Duration
+	GasOracleURL() *url.URL
 	TriggerFallbackDBPollInterval() time.Duration
 }
 
 // KeyStore encompasses the subset of keystore used by bulletprooftxmanager
 type KeyStore interface {
 	AllKeys() (keys []ethkey.Key, err error)
-	SignTx(fromAddress common.Address, tx *gethTypes.Transaction, chainID *big.Int) (*gethTypes.Transaction, error)
+	SignTx(ctx context.Context, fromAddress common.Address, tx *gethTypes.Transaction, chainID *big.Int) (*gethTypes.Transaction, error)
 	SubscribeToKeyChanges() (ch chan struct{}, unsub func())
 }
 
+// BalanceChecker reports whether an address holds enough ETH to pay for a
+// transaction, typically from a cache of recently fetched balances
+type BalanceChecker interface {
+	SufficientBalanceFor(address common.Address, estimatedCost *big.Int) bool
+}
+
+// ErrInsufficientBalance is returned when creating a transaction from an
+// address which cannot pay for its gas
+var ErrInsufficientBalance = errors.New("insufficient eth balance")
+
 // For more information about the BulletproofTxManager architecture, see the design doc:
 // https://www.notion.so/chainlink/BulletproofTxManager-Architecture-Overview-9dc62450cd7a443ba9e7dceffa1a8d6b
 
@@ -74,6 +105,24 @@ var (
 		Name: "tx_manager_num_tx_reverted",
 		Help: "Number of times a transaction reverted on-chain",
 	})
+	promQueueDepth = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "tx_manager_queue_depth",
+		Help: "The number of unstarted transactions queued for a sending key",
+	},
+		[]string{"evm_chain_id", "from_address"},
+	)
+	promInFlightTransactions = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "tx_manager_in_flight_transactions",
+		Help: "The number of broadcast but unconfirmed transactions of a sending key",
+	},
+		[]string{"evm_chain_id", "from_address"},
+	)
+	promOldestUnconfirmedAge = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "tx_manager_oldest_unconfirmed_transaction_age_seconds",
+		Help: "The time since the oldest unconfirmed transaction of a sending key was first broadcast, or 0 if there is none",
+	},
+		[]string{"evm_chain_id", "from_address"},
+	)
 )
 
 var _ TxManager = &BulletproofTxManager{}
@@ -81,10 +130,13 @@ var _ TxManager = &BulletproofTxManager{}
 //go:generate mockery --recursive --name TxManager --output ./mocks/ --case=underscore --structname TxManager --filename tx_manager.go
 type TxManager interface {
 	httypes.HeadTrackable
+	httypes.FinalizedHeadTrackable
 	service.Service
 	Trigger(addr common.Address)
-	CreateEthTransaction(db *gorm.DB, fromAddress, toAddress common.Address, payload []byte, gasLimit uint64, meta interface{}, strategy TxStrategy) (etx EthTx, err error)
+	CreateEthTransaction(db *gorm.DB, fromAddress, toAddress common.Address, payload []byte, gasLimit uint64, meta interface{}, strategy TxStrategy, urgency EthTxUrgency, expiry EthTxExpiry, simulate bool) (etx EthTx, err error)
 	GetGasEstimator() gas.Estimator
+	ForChain(chainID *big.Int) (TxManager, error)
+	RegisterConfirmationCallback(ethTxID int64, minConfirmations uint32, fn ConfirmationCallback) (unregister func())
 }
 
 type BulletproofTxManager struct {
@@ -97,6 +149,7 @@ type BulletproofTxManager struct {
 	advisoryLocker   postgres.AdvisoryLocker
 	eventBroadcaster postgres.EventBroadcaster
 	gasEstimator     gas.Estimator
+	balanceChecker   BalanceChecker
 
 	chHeads chan models.Head
 	trigger chan common.Address
@@ -104,11 +157,28 @@ type BulletproofTxManager struct {
 	chStop chan struct{}
 	wg     sync.WaitGroup
 
-	reaper      *Reaper
-	ethResender *EthResender
+	reaper         *Reaper
+	ethResender    *EthResender
+	nonceReconcile *nonceReconciliationLoop
+
+	latestFinalizedBlockNum int64
+
+	evmChainID *utils.Big
+	// chains are the managers of additional chains, by chain ID
+	chains     map[string]*BulletproofTxManager
+	headPoller *headPoller
+
+	callbacks *confirmationCallbacks
+}
+
+// NewBulletproofTxManager constructs a new BulletproofTxManager. If
+// balanceChecker is nil, transactions are created without checking the
+// balance of the sending address.
+func NewBulletproofTxManager(db *gorm.DB, ethClient eth.Client, config Config, keyStore KeyStore, advisoryLocker postgres.AdvisoryLocker, eventBroadcaster postgres.EventBroadcaster, balanceChecker BalanceChecker) *BulletproofTxManager {
+	return newBulletproofTxManager(nil, db, ethClient, config, keyStore, advisoryLocker, eventBroadcaster, balanceChecker)
 }
 
-func NewBulletproofTxManager(db *gorm.DB, ethClient eth.Client, config Config, keyStore KeyStore, advisoryLocker postgres.AdvisoryLocker, eventBroadcaster postgres.EventBroadcaster) *BulletproofTxManager {
+func newBulletproofTxManager(evmChainID *utils.Big, db *gorm.DB, ethClient eth.Client, config Config, keyStore KeyStore, advisoryLocker postgres.AdvisoryLocker, eventBroadcaster postgres.EventBroadcaster, balanceChecker BalanceChecker) *BulletproofTxManager {
 	b := BulletproofTxManager{
 		StartStopOnce:    utils.StartStopOnce{},
 		db:               db,
@@ -117,20 +187,36 @@ func NewBulletproofTxManager(db *gorm.DB, ethClient eth.Client, config Config, k
 		keyStore:         keyStore,
 		advisoryLocker:   advisoryLocker,
 		eventBroadcaster: eventBroadcaster,
+		balanceChecker:   balanceChecker,
 		chHeads:          make(chan models.Head),
 		trigger:          make(chan common.Address),
 		chStop:           make(chan struct{}),
+		evmChainID:       evmChainID,
+		chains:           make(map[string]*BulletproofTxManager),
+		callbacks:        newConfirmationCallbacks(),
 	}
 	if config.EthTxResendAfterThreshold() > 0 {
 		b.ethResender = NewEthResender(db, ethClient, defaultResenderPollInterval, config)
+		b.ethResender.evmChainID = evmChainID
 	} else {
 		logger.Info("EthResender: Disabled")
 	}
 	if config.EthTxReaperThreshold() > 0 {
 		b.reaper = NewReaper(db, config)
+		b.reaper.evmChainID = evmChainID
 	} else {
 		logger.Info("EthTxReaper: Disabled")
 	}
+	if interval := config.EthNonceReconciliationInterval(); interval > 0 {
+		reconciler := NewNonceReconciler(db, ethClient)
+		reconciler.evmChainID = evmChainID
+		b.nonceReconcile = newNonceReconciliationLoop(reconciler, keyStore, interval)
+	} else {
+		logger.Info("NonceReconciler: Disabled")
+	}
+	if evmChainID != nil {
+		b.headPoller = newHeadPoller(ethClient, &b, defaultHeadPollInterval, config.EthFinalityDepth())
+	}
 	b.gasEstimator = gas.NewEstimator(ethClient, config)
 
 	return &b
@@ -143,10 +229,18 @@ func (b *BulletproofTxManager) Start() (merr error) {
 			return errors.Wrap(err, "BulletproofTxManager: failed to load keys")
 		}
 
-		logger.Debugw("BulletproofTxManager: booting", "keys", keys)
+		logger.Debugw("BulletproofTxManager: booting", "keys", keys, "chainID", b.config.ChainID())
+
+		if b.evmChainID != nil {
+			if err = b.ethClient.Dial(context.Background()); err != nil {
+				return errors.Wrapf(err, "BulletproofTxManager: failed to dial eth client of chain %s", b.evmChainID)
+			}
+		}
 
-		eb := NewEthBroadcaster(b.db, b.ethClient, b.config, b.keyStore, b.advisoryLocker, b.eventBroadcaster, keys, b.gasEstimator)
-		ec := NewEthConfirmer(b.db, b.ethClient, b.config, b.keyStore, b.advisoryLocker, keys, b.gasEstimator)
+		eb, ec, err := b.newEthBroadcasterAndConfirmer(keys)
+		if err != nil {
+			return errors.Wrap(err, "BulletproofTxManager: failed to load key states")
+		}
 		if err := eb.Start(); err != nil {
 			return errors.Wrap(err, "BulletproofTxManager: EthBroadcaster failed to start")
 		}
@@ -169,12 +263,34 @@ func (b *BulletproofTxManager) Start() (merr error) {
 			b.ethResender.Start()
 		}
 
+		if b.nonceReconcile != nil {
+			b.nonceReconcile.Start()
+		}
+
+		if b.headPoller != nil {
+			b.headPoller.Start()
+		}
+
+		for id, chain := range b.chains {
+			if err := chain.Start(); err != nil {
+				return errors.Wrapf(err, "BulletproofTxManager: failed to start chain %s", id)
+			}
+		}
+
 		return nil
 	})
 }
 
 func (b *BulletproofTxManager) Close() (merr error) {
 	return b.StopOnce("BulletproofTxManager", func() error {
+		for _, chain := range b.chains {
+			logger.ErrorIfCalling(chain.Close)
+		}
+
+		if b.headPoller != nil {
+			b.headPoller.Stop()
+		}
+
 		close(b.chStop)
 
 		if b.reaper != nil {
@@ -183,15 +299,36 @@ func (b *BulletproofTxManager) Close() (merr error) {
 		if b.ethResender != nil {
 			b.ethResender.Stop()
 		}
+		if b.nonceReconcile != nil {
+			b.nonceReconcile.Stop()
+		}
 
 		b.wg.Wait()
 
 		b.gasEstimator.Close()
 
+		if b.evmChainID != nil {
+			b.ethClient.Close()
+		}
+
 		return nil
 	})
 }
 
+func (b *BulletproofTxManager) newEthBroadcasterAndConfirmer(keys []ethkey.Key) (*EthBroadcaster, *EthConfirmer, error) {
+	if b.evmChainID != nil {
+		if err := ensureEthKeyStates(b.db, b.evmChainID, keys); err != nil {
+			return nil, nil, err
+		}
+	}
+	eb := NewEthBroadcaster(b.db, b.ethClient, b.config, b.keyStore, b.advisoryLocker, b.eventBroadcaster, keys, b.gasEstimator)
+	eb.evmChainID = b.evmChainID
+	ec := NewEthConfirmer(b.db, b.ethClient, b.config, b.keyStore, b.advisoryLocker, keys, b.gasEstimator)
+	ec.evmChainID = b.evmChainID
+	ec.callbacks = b.callbacks
+	return eb, ec, nil
+}
+
 func (b *BulletproofTxManager) runLoop(eb *EthBroadcaster, ec *EthConfirmer) {
 	defer b.wg.Done()
 	keysChanged, unsub := b.keyStore.SubscribeToKeyChanges()
@@ -202,6 +339,7 @@ func (b *BulletproofTxManager) runLoop(eb *EthBroadcaster, ec *EthConfirmer) {
 		case address := <-b.trigger:
 			eb.Trigger(address)
 		case head := <-b.chHeads:
+			ec.SetLatestFinalizedBlockNum(atomic.LoadInt64(&b.latestFinalizedBlockNum))
 			ec.mb.Deliver(head)
 		case <-b.chStop:
 			logger.ErrorIfCalling(eb.Close)
@@ -218,8 +356,10 @@ func (b *BulletproofTxManager) runLoop(eb *EthBroadcaster, ec *EthConfirmer) {
 			logger.ErrorIfCalling(eb.Close)
 			logger.ErrorIfCalling(ec.Close)
 
-			eb = NewEthBroadcaster(b.db, b.ethClient, b.config, b.keyStore, b.advisoryLocker, b.eventBroadcaster, keys, b.gasEstimator)
-			ec = NewEthConfirmer(b.db, b.ethClient, b.config, b.keyStore, b.advisoryLocker, keys, b.gasEstimator)
+			eb, ec, err = b.newEthBroadcasterAndConfirmer(keys)
+			if err != nil {
+				logger.Fatalf("BulletproofTxManager: failed to load key states: %s", err.Error())
+			}
 
 			logger.ErrorIfCalling(eb.Start)
 			logger.ErrorIfCalling(ec.Start)
@@ -245,6 +385,11 @@ func (b *BulletproofTxManager) OnNewLongestChain(ctx context.Context, head model
 	}
 }
 
+// OnNewFinalizedHead conforms to FinalizedHeadTrackable
+func (b *BulletproofTxManager) OnNewFinalizedHead(ctx context.Context, head models.Head) {
+	atomic.StoreInt64(&b.latestFinalizedBlockNum, head.Number)
+}
+
 // Trigger forces the EthBroadcaster to check early for the given address
 func (b *BulletproofTxManager) Trigger(addr common.Address) {
 	select {
@@ -258,9 +403,36 @@ func (b *BulletproofTxManager) Connect(*models.Head) error {
 	return nil
 }
 
-// CreateEthTransaction inserts a new transaction
-func (b *BulletproofTxManager) CreateEthTransaction(db *gorm.DB, fromAddress, toAddress common.Address, payload []byte, gasLimit uint64, meta interface{}, strategy TxStrategy) (etx EthTx, err error) {
-	err = CheckEthTxQueueCapacity(db, fromAddress, b.config.EthMaxQueuedTransactions())
+// CreateEthTransaction inserts a new transaction. Its urgency selects how
+// aggressively its gas is bumped, and defaults to normal if empty. If it is
+// still unconfirmed at its expiry, the transaction is cancelled. If simulate
+// is false, the transaction is never simulated before broadcast, even if
+// ETH_TX_SIMULATE_BEFORE_BROADCAST is enabled. Transactions created with a
+// BatchingStrategy may be sent in a multicall batch. ErrKeyPolicyViolation
+// is returned if the usage policy of fromAddress does not allow the
+// strategy's subject job to send to toAddress, and ErrSpendBudgetExhausted if
+// the node or the subject has spent its daily budget.
+func (b *BulletproofTxManager) CreateEthTransaction(db *gorm.DB, fromAddress, toAddress common.Address, payload []byte, gasLimit uint64, meta interface{}, strategy TxStrategy, urgency EthTxUrgency, expiry EthTxExpiry, simulate bool) (etx EthTx, err error) {
+	if urgency, err = ParseEthTxUrgency(string(urgency)); err != nil {
+		return etx, errors.Wrap(err, "BulletproofTxManager#CreateEthTransaction")
+	}
+
+	err = CheckEthTxQueueCapacity(db, b.evmChainID, fromAddress, b.config.EthMaxQueuedTransactions())
+	if err != nil {
+		return etx, errors.Wrap(err, "BulletproofTxManager#CreateEthTransaction")
+	}
+
+	err = b.checkBalance(fromAddress, payload, gasLimit)
+	if err != nil {
+		return etx, errors.Wrap(err, "BulletproofTxManager#CreateEthTransaction")
+	}
+
+	err = checkKeyPolicy(db, fromAddress, toAddress, strategy.Subject())
+	if err != nil {
+		return etx, errors.Wrap(err, "BulletproofTxManager#CreateEthTransaction")
+	}
+
+	err = checkSpendBudgets(db, b.config, b.evmChainID, strategy.Subject())
 	if err != nil {
 		return etx, errors.Wrap(err, "BulletproofTxManager#CreateEthTransaction")
 	}
@@ -275,14 +447,15 @@ func (b *BulletproofTxManager) CreateEthTransaction(db *gorm.DB, fromAddress, to
 	}
 
 	value := 0
+	_, batchable := strategy.(BatchingStrategy)
 	err = postgres.GormTransactionWithDefaultContext(db, func(tx *gorm.DB) error {
 		res := tx.Raw(`
-INSERT INTO eth_txes (from_address, to_address, encoded_payload, value, gas_limit, state, created_at, meta, subject)
+INSERT INTO eth_txes (from_address, to_address, encoded_payload, value, gas_limit, state, created_at, meta, subject, urgency, expires_at, expires_at_block, simulate, evm_chain_id, batchable)
 VALUES (
-?,?,?,?,?,'unstarted',NOW(),?,?
+?,?,?,?,?,'unstarted',NOW(),?,?,?,?,?,?,?,?
 )
 RETURNING "eth_txes".*
-`, fromAddress, toAddress, payload, value, gasLimit, metaBytes, strategy.Subject()).Scan(&etx)
+`, fromAddress, toAddress, payload, value, gasLimit, metaBytes, strategy.Subject(), urgency, expiry.Time, expiry.Block, simulate, b.evmChainID, batchable).Scan(&etx)
 		err = res.Error
 		if err != nil {
 			return errors.Wrap(err, "BulletproofTxManager#CreateEthTransaction failed to insert eth_tx")
@@ -300,6 +473,35 @@ RETURNING "eth_txes".*
 	return
 }
 
+// RegisterConfirmationCallback calls fn once the eth_tx has been confirmed
+// with at least minConfirmations blocks, which defaults to 1, or has failed
+// and will never be mined. Callbacks are called from the EthConfirmer and must
+// not block. The returned function unregisters the callback.
+func (b *BulletproofTxManager) RegisterConfirmationCallback(ethTxID int64, minConfirmations uint32, fn ConfirmationCallback) (unregister func()) {
+	return b.callbacks.register(ethTxID, minConfirmations, fn)
+}
+
+// checkBalance returns ErrInsufficientBalance if the sending address cannot
+// pay for the transaction at the current gas price. Submitting it anyway would
+// only fail later with an "insufficient funds" error from the eth node.
+func (b *BulletproofTxManager) checkBalance(fromAddress common.Address, payload []byte, gasLimit uint64) error {
+	if b.balanceChecker == nil {
+		return nil
+	}
+	gasPrice, chainSpecificGasLimit, err := b.gasEstimator.EstimateGas(payload, gasLimit)
+	if err != nil {
+		// The broadcaster will estimate again when sending, so do not block
+		// the transaction on a failed estimate
+		logger.Debugw("BulletproofTxManager: could not estimate gas, skipping balance check", "err", err, "fromAddress", fromAddress)
+		return nil
+	}
+	estimatedCost := new(big.Int).Mul(gasPrice, new(big.Int).SetUint64(chainSpecificGasLimit))
+	if !b.balanceChecker.SufficientBalanceFor(fromAddress, estimatedCost) {
+		return errors.Wrapf(ErrInsufficientBalance, "address %s cannot pay estimated cost of %s wei (gas limit %d at gas price %s wei)", fromAddress.Hex(), estimatedCost.String(), chainSpecificGasLimit, gasPrice.String())
+	}
+	return nil
+}
+
 // GetGasEstimator returns the gas estimator, mostly useful for tests
 func (b *BulletproofTxManager) GetGasEstimator() gas.Estimator {
 	return b.gasEstimator
@@ -325,17 +527,22 @@ func SendEther(db *gorm.DB, from, to common.Address, value assets.Eth, gasLimit
 func newAttempt(ethClient eth.Client, ks KeyStore, chainID *big.Int, etx EthTx, gasPrice *big.Int, gasLimit uint64) (EthTxAttempt, error) {
 	attempt := EthTxAttempt{}
 
+	toAddress, value, payload := etx.ToAddress, etx.Value.ToInt(), etx.EncodedPayload
+	if etx.CancelledAt.Valid {
+		// Replace the cancelled transaction with a zero-value send to self
+		toAddress, value, payload = etx.FromAddress, big.NewInt(0), []byte{}
+	}
 	tx := newLegacyTransaction(
 		uint64(*etx.Nonce),
-		etx.ToAddress,
-		etx.Value.ToInt(),
+		toAddress,
+		value,
 		gasLimit,
 		gasPrice,
-		etx.EncodedPayload,
+		payload,
 	)
 
 	transaction := gethTypes.NewTx(&tx)
-	hash, signedTxBytes, err := signTx(ks, etx.FromAddress, transaction, chainID)
+	hash, signedTxBytes, err := signTx(signingContext(etx), ks, etx.FromAddress, transaction, chainID)
 	if err != nil {
 		return attempt, errors.Wrapf(err, "error using account %s to sign transaction %v", etx.FromAddress.String(), etx.ID)
 	}
@@ -360,8 +567,18 @@ func newLegacyTransaction(nonce uint64, to common.Address, value *big.Int, gasLi
 	}
 }
 
-func signTx(keyStore KeyStore, address common.Address, tx *gethTypes.Transaction, chainID *big.Int) (common.Hash, []byte, error) {
-	signedTx, err := keyStore.SignTx(address, tx, chainID)
+// signingContext identifies etx, and the job which created it if any, as the
+// requester of its signatures in the keystore audit log
+func signingContext(etx EthTx) context.Context {
+	return keystore.WithRequester(context.Background(), keystore.Requester{
+		Service:       "BulletproofTxManager",
+		ExternalJobID: etx.Subject,
+		Purpose:       fmt.Sprintf("eth_tx %d", etx.ID),
+	})
+}
+
+func signTx(ctx context.Context, keyStore KeyStore, address common.Address, tx *gethTypes.Transaction, chainID *big.Int) (common.Hash, []byte, error) {
+	signedTx, err := keyStore.SignTx(ctx, address, tx, chainID)
 	if err != nil {
 		return common.Hash{}, nil, errors.Wrap(err, "signTx failed")
 	}
@@ -424,7 +641,11 @@ func makeEmptyTransaction(keyStore KeyStore, nonce uint64, gasLimit uint64, gasP
 	value := big.NewInt(0)
 	payload := []byte{}
 	tx := gethTypes.NewTransaction(nonce, fromAddress, value, gasLimit, gasPriceWei, payload)
-	return keyStore.SignTx(fromAddress, tx, chainID)
+	ctx := keystore.WithRequester(context.Background(), keystore.Requester{
+		Service: "BulletproofTxManager",
+		Purpose: fmt.Sprintf("empty transaction with nonce %d", nonce),
+	})
+	return keyStore.SignTx(ctx, fromAddress, tx, chainID)
 }
 
 func saveReplacementInProgressAttempt(db *gorm.DB, oldAttempt EthTxAttempt, replacementAttempt *EthTxAttempt) error {
@@ -443,30 +664,66 @@ func saveReplacementInProgressAttempt(db *gorm.DB, oldAttempt EthTxAttempt, repl
 }
 
 // CountUnconfirmedTransactions returns the number of unconfirmed transactions
-func CountUnconfirmedTransactions(db *gorm.DB, fromAddress common.Address) (count uint32, err error) {
-	return countTransactionsWithState(db, fromAddress, EthTxUnconfirmed)
+func CountUnconfirmedTransactions(db *gorm.DB, evmChainID *utils.Big, fromAddress common.Address) (count uint32, err error) {
+	return countTransactionsWithState(db, evmChainID, fromAddress, EthTxUnconfirmed)
 }
 
 // CountUnstartedTransactions returns the number of unconfirmed transactions
-func CountUnstartedTransactions(db *gorm.DB, fromAddress common.Address) (count uint32, err error) {
-	return countTransactionsWithState(db, fromAddress, EthTxUnstarted)
+func CountUnstartedTransactions(db *gorm.DB, evmChainID *utils.Big, fromAddress common.Address) (count uint32, err error) {
+	return countTransactionsWithState(db, evmChainID, fromAddress, EthTxUnstarted)
 }
 
-func countTransactionsWithState(db *gorm.DB, fromAddress common.Address, state EthTxState) (count uint32, err error) {
+// CountPendingTransactions returns the number of transactions of all keys and
+// chains which are yet to be confirmed, including those not broadcast yet
+func CountPendingTransactions(db *gorm.DB) (count uint32, err error) {
 	ctx, cancel := postgres.DefaultQueryCtx()
 	defer cancel()
-	err = db.WithContext(ctx).Raw(`SELECT count(*) FROM eth_txes WHERE from_address = ? AND state = ?`, fromAddress, state).Scan(&count).Error
+	err = db.WithContext(ctx).Raw(`SELECT count(*) FROM eth_txes WHERE state IN (?, ?, ?)`, EthTxUnstarted, EthTxInProgress, EthTxUnconfirmed).Scan(&count).Error
 	return
 }
 
+func countTransactionsWithState(db *gorm.DB, evmChainID *utils.Big, fromAddress common.Address, state EthTxState) (count uint32, err error) {
+	ctx, cancel := postgres.DefaultQueryCtx()
+	defer cancel()
+	err = db.WithContext(ctx).Raw(`SELECT count(*) FROM eth_txes WHERE evm_chain_id IS NOT DISTINCT FROM ? AND from_address = ? AND state = ?`, evmChainID, fromAddress, state).Scan(&count).Error
+	return
+}
+
+// EthTxQueueStats summarises the transactions of a sending key
+type EthTxQueueStats struct {
+	Unstarted   uint32
+	Unconfirmed uint32
+	// OldestUnconfirmedAt is when the oldest unconfirmed transaction was
+	// first broadcast
+	OldestUnconfirmedAt null.Time
+}
+
+// GetEthTxQueueStats returns the EthTxQueueStats of fromAddress
+func GetEthTxQueueStats(db *gorm.DB, evmChainID *utils.Big, fromAddress common.Address) (stats EthTxQueueStats, err error) {
+	ctx, cancel := postgres.DefaultQueryCtx()
+	defer cancel()
+	err = db.WithContext(ctx).Raw(`
+SELECT
+	count(*) FILTER (WHERE eth_txes.state = 'unstarted') AS unstarted,
+	count(*) FILTER (WHERE eth_txes.state = 'unconfirmed') AS unconfirmed,
+	(
+		SELECT min(eth_tx_attempts.created_at) FROM eth_tx_attempts
+		JOIN eth_txes AS unconfirmed ON unconfirmed.id = eth_tx_attempts.eth_tx_id
+		WHERE unconfirmed.evm_chain_id IS NOT DISTINCT FROM ? AND unconfirmed.from_address = ? AND unconfirmed.state = 'unconfirmed'
+	) AS oldest_unconfirmed_at
+FROM eth_txes WHERE eth_txes.evm_chain_id IS NOT DISTINCT FROM ? AND eth_txes.from_address = ? AND eth_txes.state IN ('unstarted', 'unconfirmed')
+`, evmChainID, fromAddress, evmChainID, fromAddress).Scan(&stats).Error
+	return stats, errors.Wrap(err, "GetEthTxQueueStats failed")
+}
+
 // CheckEthTxQueueCapacity returns an error if inserting this transaction would
 // exceed the maximum queue size.
-func CheckEthTxQueueCapacity(db *gorm.DB, fromAddress common.Address, maxQueuedTransactions uint64) (err error) {
+func CheckEthTxQueueCapacity(db *gorm.DB, evmChainID *utils.Big, fromAddress common.Address, maxQueuedTransactions uint64) (err error) {
 	if maxQueuedTransactions == 0 {
 		return nil
 	}
 	var count uint64
-	err = db.Raw(`SELECT count(*) FROM eth_txes WHERE from_address = ? AND state = 'unstarted'`, fromAddress).Scan(&count).Error
+	err = db.Raw(`SELECT count(*) FROM eth_txes WHERE evm_chain_id IS NOT DISTINCT FROM ? AND from_address = ? AND state = 'unstarted'`, evmChainID, fromAddress).Scan(&count).Error
 	if err != nil {
 		err = errors.Wrap(err, "bulletprooftxmanager.CheckEthTxQueueCapacity query failed")
 		return
@@ -484,14 +741,19 @@ type NullTxManager struct {
 	ErrMsg string
 }
 
-func (n *NullTxManager) Connect(*models.Head) error                     { return errors.New(n.ErrMsg) }
-func (n *NullTxManager) OnNewLongestChain(context.Context, models.Head) {}
-func (n *NullTxManager) Start() error                                   { return errors.New(n.ErrMsg) }
-func (n *NullTxManager) Close() error                                   { return errors.New(n.ErrMsg) }
-func (n *NullTxManager) Trigger(common.Address)                         { panic(n.ErrMsg) }
-func (n *NullTxManager) CreateEthTransaction(*gorm.DB, common.Address, common.Address, []byte, uint64, interface{}, TxStrategy) (etx EthTx, err error) {
+func (n *NullTxManager) Connect(*models.Head) error                      { return errors.New(n.ErrMsg) }
+func (n *NullTxManager) OnNewLongestChain(context.Context, models.Head)  {}
+func (n *NullTxManager) OnNewFinalizedHead(context.Context, models.Head) {}
+func (n *NullTxManager) Start() error                                    { return errors.New(n.ErrMsg) }
+func (n *NullTxManager) Close() error                                    { return errors.New(n.ErrMsg) }
+func (n *NullTxManager) Trigger(common.Address)                          { panic(n.ErrMsg) }
+func (n *NullTxManager) CreateEthTransaction(*gorm.DB, common.Address, common.Address, []byte, uint64, interface{}, TxStrategy, EthTxUrgency, EthTxExpiry, bool) (etx EthTx, err error) {
 	return etx, errors.New(n.ErrMsg)
 }
+func (n *NullTxManager) ForChain(*big.Int) (TxManager, error) { return nil, errors.New(n.ErrMsg) }
+func (n *NullTxManager) RegisterConfirmationCallback(int64, uint32, ConfirmationCallback) func() {
+	return func() {}
+}
 func (n *NullTxManager) Healthy() error                 { return nil }
 func (n *NullTxManager) Ready() error                   { return nil }
 func (n *NullTxManager) GetGasEstimator() gas.Estimator { return nil }
@@ -3,9 +3,13 @@ package bulletprooftxmanager_test
 import (
 	"context"
 	"fmt"
+	"math/big"
 	"testing"
 	"time"
 
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
 	uuid "github.com/satori/go.uuid"
 	"github.com/smartcontractkit/chainlink/core/assets"
 	"github.com/smartcontractkit/chainlink/core/internal/cltest"
@@ -22,6 +26,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
 )
 
 func TestBulletproofTxManager_SendEther_DoesNotSendToZero(t *testing.T) {
@@ -49,7 +54,7 @@ func TestBulletproofTxManager_CheckEthTxQueueCapacity(t *testing.T) {
 	var maxUnconfirmedTransactions uint64 = 2
 
 	t.Run("with no eth_txes returns nil", func(t *testing.T) {
-		err := bulletprooftxmanager.CheckEthTxQueueCapacity(db, fromAddress, maxUnconfirmedTransactions)
+		err := bulletprooftxmanager.CheckEthTxQueueCapacity(db, nil, fromAddress, maxUnconfirmedTransactions)
 		require.NoError(t, err)
 	})
 
@@ -59,7 +64,7 @@ func TestBulletproofTxManager_CheckEthTxQueueCapacity(t *testing.T) {
 	}
 
 	t.Run("with eth_txes from another address returns nil", func(t *testing.T) {
-		err := bulletprooftxmanager.CheckEthTxQueueCapacity(db, fromAddress, maxUnconfirmedTransactions)
+		err := bulletprooftxmanager.CheckEthTxQueueCapacity(db, nil, fromAddress, maxUnconfirmedTransactions)
 		require.NoError(t, err)
 	})
 
@@ -68,7 +73,7 @@ func TestBulletproofTxManager_CheckEthTxQueueCapacity(t *testing.T) {
 	}
 
 	t.Run("ignores fatally_errored transactions", func(t *testing.T) {
-		err := bulletprooftxmanager.CheckEthTxQueueCapacity(db, fromAddress, maxUnconfirmedTransactions)
+		err := bulletprooftxmanager.CheckEthTxQueueCapacity(db, nil, fromAddress, maxUnconfirmedTransactions)
 		require.NoError(t, err)
 	})
 
@@ -79,7 +84,7 @@ func TestBulletproofTxManager_CheckEthTxQueueCapacity(t *testing.T) {
 	n++
 
 	t.Run("unconfirmed and in_progress transactions do not count", func(t *testing.T) {
-		err := bulletprooftxmanager.CheckEthTxQueueCapacity(db, fromAddress, 1)
+		err := bulletprooftxmanager.CheckEthTxQueueCapacity(db, nil, fromAddress, 1)
 		require.NoError(t, err)
 	})
 
@@ -90,7 +95,7 @@ func TestBulletproofTxManager_CheckEthTxQueueCapacity(t *testing.T) {
 	}
 
 	t.Run("with many confirmed eth_txes from the same address returns nil", func(t *testing.T) {
-		err := bulletprooftxmanager.CheckEthTxQueueCapacity(db, fromAddress, maxUnconfirmedTransactions)
+		err := bulletprooftxmanager.CheckEthTxQueueCapacity(db, nil, fromAddress, maxUnconfirmedTransactions)
 		require.NoError(t, err)
 	})
 
@@ -99,30 +104,61 @@ func TestBulletproofTxManager_CheckEthTxQueueCapacity(t *testing.T) {
 	}
 
 	t.Run("with fewer unstarted eth_txes than limit returns nil", func(t *testing.T) {
-		err := bulletprooftxmanager.CheckEthTxQueueCapacity(db, fromAddress, maxUnconfirmedTransactions)
+		err := bulletprooftxmanager.CheckEthTxQueueCapacity(db, nil, fromAddress, maxUnconfirmedTransactions)
 		require.NoError(t, err)
 	})
 
 	cltest.MustInsertUnstartedEthTx(t, db, fromAddress)
 
 	t.Run("with equal or more unstarted eth_txes than limit returns error", func(t *testing.T) {
-		err := bulletprooftxmanager.CheckEthTxQueueCapacity(db, fromAddress, maxUnconfirmedTransactions)
+		err := bulletprooftxmanager.CheckEthTxQueueCapacity(db, nil, fromAddress, maxUnconfirmedTransactions)
 		require.Error(t, err)
 		require.EqualError(t, err, fmt.Sprintf("cannot create transaction; too many unstarted transactions in the queue (2/%d). WARNING: Hitting ETH_MAX_QUEUED_TRANSACTIONS is a sanity limit and should never happen under normal operation. This error is very unlikely to be a problem with Chainlink, and instead more likely to be caused by a problem with your eth node's connectivity. Check your eth node: it may not be broadcasting transactions to the network, or it might be overloaded and evicting Chainlink's transactions from its mempool. Increasing ETH_MAX_QUEUED_TRANSACTIONS is almost certainly not the correct action to take here unless you ABSOLUTELY know what you are doing, and will probably make things worse", maxUnconfirmedTransactions))
 
 		cltest.MustInsertUnstartedEthTx(t, db, fromAddress)
-		err = bulletprooftxmanager.CheckEthTxQueueCapacity(db, fromAddress, maxUnconfirmedTransactions)
+		err = bulletprooftxmanager.CheckEthTxQueueCapacity(db, nil, fromAddress, maxUnconfirmedTransactions)
 		require.Error(t, err)
 
 		require.EqualError(t, err, fmt.Sprintf("cannot create transaction; too many unstarted transactions in the queue (3/%d). WARNING: Hitting ETH_MAX_QUEUED_TRANSACTIONS is a sanity limit and should never happen under normal operation. This error is very unlikely to be a problem with Chainlink, and instead more likely to be caused by a problem with your eth node's connectivity. Check your eth node: it may not be broadcasting transactions to the network, or it might be overloaded and evicting Chainlink's transactions from its mempool. Increasing ETH_MAX_QUEUED_TRANSACTIONS is almost certainly not the correct action to take here unless you ABSOLUTELY know what you are doing, and will probably make things worse", maxUnconfirmedTransactions))
 	})
 
 	t.Run("disables check with 0 limit", func(t *testing.T) {
-		err := bulletprooftxmanager.CheckEthTxQueueCapacity(db, fromAddress, 0)
+		err := bulletprooftxmanager.CheckEthTxQueueCapacity(db, nil, fromAddress, 0)
 		require.NoError(t, err)
 	})
 }
 
+func TestBulletproofTxManager_NonceIsUniquePerChain(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewGormDB(t)
+	ethKeyStore := cltest.NewKeyStore(t, db).Eth()
+
+	_, fromAddress := cltest.MustAddRandomKeyToKeystore(t, ethKeyStore, 0)
+
+	insert := func(chainID *utils.Big) error {
+		etx := cltest.NewEthTx(t, fromAddress)
+		broadcastAt := time.Now()
+		nonce := int64(0)
+		etx.BroadcastAt = &broadcastAt
+		etx.Nonce = &nonce
+		etx.State = bulletprooftxmanager.EthTxUnconfirmed
+		etx.EVMChainID = chainID
+		return db.Create(&etx).Error
+	}
+
+	require.NoError(t, insert(utils.NewBigI(1)))
+	require.NoError(t, insert(utils.NewBigI(2)))
+	require.NoError(t, insert(nil))
+
+	// A failed insert aborts the test transaction, so roll back to before it
+	for _, chainID := range []*utils.Big{utils.NewBigI(1), utils.NewBigI(2), nil} {
+		require.NoError(t, db.Exec(`SAVEPOINT duplicate_nonce`).Error)
+		require.Error(t, insert(chainID), "the same key cannot send twice at a nonce on chain %v", chainID)
+		require.NoError(t, db.Exec(`ROLLBACK TO SAVEPOINT duplicate_nonce`).Error)
+	}
+}
+
 func TestBulletproofTxManager_CountUnconfirmedTransactions(t *testing.T) {
 	t.Parallel()
 
@@ -137,7 +173,7 @@ func TestBulletproofTxManager_CountUnconfirmedTransactions(t *testing.T) {
 	cltest.MustInsertUnconfirmedEthTxWithBroadcastAttempt(t, db, 1, fromAddress)
 	cltest.MustInsertUnconfirmedEthTxWithBroadcastAttempt(t, db, 2, fromAddress)
 
-	count, err := bulletprooftxmanager.CountUnconfirmedTransactions(db, fromAddress)
+	count, err := bulletprooftxmanager.CountUnconfirmedTransactions(db, nil, fromAddress)
 	require.NoError(t, err)
 	assert.Equal(t, int(count), 3)
 }
@@ -156,10 +192,38 @@ func TestBulletproofTxManager_CountUnstartedTransactions(t *testing.T) {
 	cltest.MustInsertUnstartedEthTx(t, db, otherAddress)
 	cltest.MustInsertUnconfirmedEthTxWithBroadcastAttempt(t, db, 2, fromAddress)
 
-	count, err := bulletprooftxmanager.CountUnstartedTransactions(db, fromAddress)
+	count, err := bulletprooftxmanager.CountUnstartedTransactions(db, nil, fromAddress)
 	require.NoError(t, err)
 	assert.Equal(t, int(count), 2)
 }
+func TestBulletproofTxManager_GetEthTxQueueStats(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewGormDB(t)
+	ethKeyStore := cltest.NewKeyStore(t, db).Eth()
+
+	_, fromAddress := cltest.MustAddRandomKeyToKeystore(t, ethKeyStore, 0)
+	_, otherAddress := cltest.MustAddRandomKeyToKeystore(t, ethKeyStore, 0)
+
+	stats, err := bulletprooftxmanager.GetEthTxQueueStats(db, nil, fromAddress)
+	require.NoError(t, err)
+	assert.Equal(t, bulletprooftxmanager.EthTxQueueStats{}, stats)
+
+	cltest.MustInsertUnstartedEthTx(t, db, fromAddress)
+	cltest.MustInsertUnstartedEthTx(t, db, otherAddress)
+	etx := cltest.MustInsertUnconfirmedEthTxWithBroadcastAttempt(t, db, 0, fromAddress)
+	cltest.MustInsertUnconfirmedEthTxWithBroadcastAttempt(t, db, 1, fromAddress)
+	cltest.MustInsertUnconfirmedEthTxWithBroadcastAttempt(t, db, 0, otherAddress)
+	cltest.MustInsertConfirmedEthTxWithAttempt(t, db, 2, 1, fromAddress)
+
+	stats, err = bulletprooftxmanager.GetEthTxQueueStats(db, nil, fromAddress)
+	require.NoError(t, err)
+	assert.Equal(t, uint32(1), stats.Unstarted)
+	assert.Equal(t, uint32(2), stats.Unconfirmed)
+	require.True(t, stats.OldestUnconfirmedAt.Valid)
+	assert.WithinDuration(t, etx.EthTxAttempts[0].CreatedAt, stats.OldestUnconfirmedAt.Time, time.Millisecond)
+}
+
 func TestBulletproofTxManager_CreateEthTransaction(t *testing.T) {
 	t.Parallel()
 
@@ -174,9 +238,12 @@ func TestBulletproofTxManager_CreateEthTransaction(t *testing.T) {
 	config := new(bptxmmocks.Config)
 	config.On("EthTxResendAfterThreshold").Return(time.Duration(0))
 	config.On("EthTxReaperThreshold").Return(time.Duration(0))
+	config.On("EthNonceReconciliationInterval").Return(time.Duration(0))
 	config.On("GasEstimatorMode").Return("FixedPrice")
+	config.On("EthTxDailyBudgetWei").Return(nil)
+	config.On("EthTxJobDailyBudgetWei").Return(nil)
 
-	bptxm := bulletprooftxmanager.NewBulletproofTxManager(db, nil, config, nil, nil, nil)
+	bptxm := bulletprooftxmanager.NewBulletproofTxManager(db, nil, config, nil, nil, nil, nil)
 
 	t.Run("with queue under capacity inserts eth_tx", func(t *testing.T) {
 		subject := uuid.NewV4()
@@ -184,7 +251,7 @@ func TestBulletproofTxManager_CreateEthTransaction(t *testing.T) {
 		strategy.On("Subject").Return(uuid.NullUUID{UUID: subject, Valid: true})
 		strategy.On("PruneQueue", mock.AnythingOfType("*gorm.DB")).Return(int64(0), nil)
 		config.On("EthMaxQueuedTransactions").Return(uint64(1))
-		etx, err := bptxm.CreateEthTransaction(db, fromAddress, toAddress, payload, gasLimit, nil, strategy)
+		etx, err := bptxm.CreateEthTransaction(db, fromAddress, toAddress, payload, gasLimit, nil, strategy, bulletprooftxmanager.EthTxUrgencyNormal, bulletprooftxmanager.EthTxExpiry{}, true)
 		assert.NoError(t, err)
 
 		assert.Greater(t, etx.ID, int64(0))
@@ -207,13 +274,33 @@ func TestBulletproofTxManager_CreateEthTransaction(t *testing.T) {
 		assert.Equal(t, payload, etx.EncodedPayload)
 		assert.Equal(t, assets.NewEthValue(0), etx.Value)
 		assert.Equal(t, subject, etx.Subject.UUID)
+		assert.Equal(t, bulletprooftxmanager.EthTxUrgencyNormal, etx.Urgency)
+	})
+
+	t.Run("with an expiry inserts eth_tx with the expiry", func(t *testing.T) {
+		require.NoError(t, db.Exec(`DELETE FROM eth_txes`).Error)
+		expiry := bulletprooftxmanager.EthTxExpiry{Block: null.IntFrom(42), Time: null.TimeFrom(time.Now().Add(time.Hour))}
+		etx, err := bptxm.CreateEthTransaction(db, fromAddress, toAddress, payload, gasLimit, nil, bulletprooftxmanager.SendEveryStrategy{}, bulletprooftxmanager.EthTxUrgencyNormal, expiry, true)
+		require.NoError(t, err)
+
+		require.NoError(t, db.First(&etx).Error)
+		assert.Equal(t, int64(42), etx.ExpiresAtBlock.Int64)
+		assert.WithinDuration(t, expiry.Time.Time, etx.ExpiresAt.Time, time.Millisecond)
+		assert.False(t, etx.CancelledAt.Valid)
+	})
+
+	t.Run("with an unknown urgency does not insert eth_tx", func(t *testing.T) {
+		_, err := bptxm.CreateEthTransaction(db, fromAddress, toAddress, payload, gasLimit, nil, bulletprooftxmanager.SendEveryStrategy{}, bulletprooftxmanager.EthTxUrgency("urgent"), bulletprooftxmanager.EthTxExpiry{}, true)
+		assert.EqualError(t, err, `BulletproofTxManager#CreateEthTransaction: unknown urgency "urgent", must be one of low, normal or high`)
+
+		cltest.AssertCount(t, db, bulletprooftxmanager.EthTx{}, 1)
 	})
 
 	cltest.MustInsertUnconfirmedEthTxWithInsufficientEthAttempt(t, db, 0, fromAddress)
 
 	t.Run("with queue at capacity does not insert eth_tx", func(t *testing.T) {
 		config.On("EthMaxQueuedTransactions").Return(uint64(1))
-		_, err := bptxm.CreateEthTransaction(db, fromAddress, cltest.NewAddress(), []byte{1, 2, 3}, 21000, nil, bulletprooftxmanager.SendEveryStrategy{})
+		_, err := bptxm.CreateEthTransaction(db, fromAddress, cltest.NewAddress(), []byte{1, 2, 3}, 21000, nil, bulletprooftxmanager.SendEveryStrategy{}, bulletprooftxmanager.EthTxUrgencyNormal, bulletprooftxmanager.EthTxExpiry{}, true)
 		assert.EqualError(t, err, "BulletproofTxManager#CreateEthTransaction: cannot create transaction; too many unstarted transactions in the queue (1/1). WARNING: Hitting ETH_MAX_QUEUED_TRANSACTIONS is a sanity limit and should never happen under normal operation. This error is very unlikely to be a problem with Chainlink, and instead more likely to be caused by a problem with your eth node's connectivity. Check your eth node: it may not be broadcasting transactions to the network, or it might be overloaded and evicting Chainlink's transactions from its mempool. Increasing ETH_MAX_QUEUED_TRANSACTIONS is almost certainly not the correct action to take here unless you ABSOLUTELY know what you are doing, and will probably make things worse")
 	})
 }
@@ -231,8 +318,11 @@ func TestBulletproofTxManager_CreateEthTransaction_OutOfEth(t *testing.T) {
 	config := new(bptxmmocks.Config)
 	config.On("EthTxResendAfterThreshold").Return(time.Duration(0))
 	config.On("EthTxReaperThreshold").Return(time.Duration(0))
+	config.On("EthNonceReconciliationInterval").Return(time.Duration(0))
 	config.On("GasEstimatorMode").Return("FixedPrice")
-	bptxm := bulletprooftxmanager.NewBulletproofTxManager(db, nil, config, nil, nil, nil)
+	config.On("EthTxDailyBudgetWei").Return(nil)
+	config.On("EthTxJobDailyBudgetWei").Return(nil)
+	bptxm := bulletprooftxmanager.NewBulletproofTxManager(db, nil, config, nil, nil, nil, nil)
 
 	t.Run("if another key has any transactions with insufficient eth errors, transmits as normal", func(t *testing.T) {
 		payload := cltest.MustRandomBytes(t, 100)
@@ -242,7 +332,7 @@ func TestBulletproofTxManager_CreateEthTransaction_OutOfEth(t *testing.T) {
 		strategy.On("Subject").Return(uuid.NullUUID{})
 		strategy.On("PruneQueue", mock.AnythingOfType("*gorm.DB")).Return(int64(0), nil)
 
-		etx, err := bptxm.CreateEthTransaction(db, fromAddress, toAddress, payload, gasLimit, nil, strategy)
+		etx, err := bptxm.CreateEthTransaction(db, fromAddress, toAddress, payload, gasLimit, nil, strategy, bulletprooftxmanager.EthTxUrgencyNormal, bulletprooftxmanager.EthTxExpiry{}, true)
 		assert.NoError(t, err)
 
 		require.Equal(t, payload, etx.EncodedPayload)
@@ -259,7 +349,7 @@ func TestBulletproofTxManager_CreateEthTransaction_OutOfEth(t *testing.T) {
 		strategy.On("Subject").Return(uuid.NullUUID{})
 		strategy.On("PruneQueue", mock.AnythingOfType("*gorm.DB")).Return(int64(0), nil)
 
-		etx, err := bptxm.CreateEthTransaction(db, fromAddress, toAddress, payload, gasLimit, nil, strategy)
+		etx, err := bptxm.CreateEthTransaction(db, fromAddress, toAddress, payload, gasLimit, nil, strategy, bulletprooftxmanager.EthTxUrgencyNormal, bulletprooftxmanager.EthTxExpiry{}, true)
 		assert.NoError(t, err)
 
 		require.Equal(t, payload, etx.EncodedPayload)
@@ -276,7 +366,7 @@ func TestBulletproofTxManager_CreateEthTransaction_OutOfEth(t *testing.T) {
 		strategy.On("PruneQueue", mock.AnythingOfType("*gorm.DB")).Return(int64(0), nil)
 
 		config.On("EthMaxQueuedTransactions").Return(uint64(1))
-		etx, err := bptxm.CreateEthTransaction(db, fromAddress, toAddress, payload, gasLimit, nil, strategy)
+		etx, err := bptxm.CreateEthTransaction(db, fromAddress, toAddress, payload, gasLimit, nil, strategy, bulletprooftxmanager.EthTxUrgencyNormal, bulletprooftxmanager.EthTxExpiry{}, true)
 		assert.NoError(t, err)
 
 		require.Equal(t, payload, etx.EncodedPayload)
@@ -284,6 +374,189 @@ func TestBulletproofTxManager_CreateEthTransaction_OutOfEth(t *testing.T) {
 	})
 }
 
+type fakeBalanceChecker map[common.Address]*big.Int
+
+func (f fakeBalanceChecker) SufficientBalanceFor(address common.Address, estimatedCost *big.Int) bool {
+	return f[address].Cmp(estimatedCost) >= 0
+}
+
+func TestBulletproofTxManager_CreateEthTransaction_InsufficientBalance(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewGormDB(t)
+
+	key := cltest.MustInsertRandomKey(t, db, 0)
+	fromAddress := key.Address.Address()
+	toAddress := cltest.NewAddress()
+	gasLimit := uint64(1000)
+	payload := []byte{1, 2, 3}
+
+	config := new(bptxmmocks.Config)
+	config.On("EthTxResendAfterThreshold").Return(time.Duration(0))
+	config.On("EthTxReaperThreshold").Return(time.Duration(0))
+	config.On("EthNonceReconciliationInterval").Return(time.Duration(0))
+	config.On("GasEstimatorMode").Return("FixedPrice")
+	config.On("EthMaxQueuedTransactions").Return(uint64(0))
+	config.On("EthGasPriceDefault").Return(big.NewInt(20))
+	config.On("EthGasLimitMultiplier").Return(float32(1))
+	config.On("EthTxDailyBudgetWei").Return(nil)
+	config.On("EthTxJobDailyBudgetWei").Return(nil)
+
+	balances := fakeBalanceChecker{fromAddress: big.NewInt(20000)}
+	bptxm := bulletprooftxmanager.NewBulletproofTxManager(db, nil, config, nil, nil, nil, balances)
+
+	t.Run("with sufficient balance inserts eth_tx", func(t *testing.T) {
+		strategy := bulletprooftxmanager.SendEveryStrategy{}
+		_, err := bptxm.CreateEthTransaction(db, fromAddress, toAddress, payload, gasLimit, nil, strategy, bulletprooftxmanager.EthTxUrgencyNormal, bulletprooftxmanager.EthTxExpiry{}, true)
+		require.NoError(t, err)
+
+		cltest.AssertCount(t, db, bulletprooftxmanager.EthTx{}, 1)
+	})
+
+	t.Run("with insufficient balance returns error", func(t *testing.T) {
+		balances[fromAddress] = big.NewInt(19999)
+
+		strategy := bulletprooftxmanager.SendEveryStrategy{}
+		_, err := bptxm.CreateEthTransaction(db, fromAddress, toAddress, payload, gasLimit, nil, strategy, bulletprooftxmanager.EthTxUrgencyNormal, bulletprooftxmanager.EthTxExpiry{}, true)
+		require.Error(t, err)
+		assert.True(t, errors.Is(err, bulletprooftxmanager.ErrInsufficientBalance))
+
+		cltest.AssertCount(t, db, bulletprooftxmanager.EthTx{}, 1)
+	})
+}
+
+func TestBulletproofTxManager_CreateEthTransaction_SpendBudgets(t *testing.T) {
+	db := pgtest.NewGormDB(t)
+
+	key := cltest.MustInsertRandomKey(t, db, 0)
+	fromAddress := key.Address.Address()
+
+	// A job which spent 10 gas at 100 Wei in the last 24 hours
+	spender := uuid.NewV4()
+	etx := cltest.MustInsertConfirmedEthTxWithAttempt(t, db, 0, 1, fromAddress)
+	require.NoError(t, db.Exec(`UPDATE eth_txes SET subject = ? WHERE id = ?`, spender, etx.ID).Error)
+	require.NoError(t, db.Exec(`UPDATE eth_tx_attempts SET gas_price = 100 WHERE id = ?`, etx.EthTxAttempts[0].ID).Error)
+	require.NoError(t, db.Save(&bulletprooftxmanager.EthReceipt{
+		BlockNumber: 2,
+		BlockHash:   utils.NewHash(),
+		TxHash:      etx.EthTxAttempts[0].Hash,
+		Receipt:     []byte(`{}`),
+		GasUsed:     null.IntFrom(10),
+		CreatedAt:   time.Now(),
+	}).Error)
+
+	spend, err := bulletprooftxmanager.GetDailySpend(db, nil, uuid.NullUUID{UUID: spender, Valid: true})
+	require.NoError(t, err)
+	assert.Equal(t, big.NewInt(1000), spend)
+
+	newTxManager := func(globalBudget, jobBudget *big.Int) *bulletprooftxmanager.BulletproofTxManager {
+		config := new(bptxmmocks.Config)
+		config.On("EthTxResendAfterThreshold").Return(time.Duration(0))
+		config.On("EthTxReaperThreshold").Return(time.Duration(0))
+		config.On("EthNonceReconciliationInterval").Return(time.Duration(0))
+		config.On("GasEstimatorMode").Return("FixedPrice")
+		config.On("EthMaxQueuedTransactions").Return(uint64(0))
+		config.On("ChainID").Return(big.NewInt(0))
+		config.On("EthTxDailyBudgetWei").Return(globalBudget)
+		config.On("EthTxJobDailyBudgetWei").Return(jobBudget)
+		return bulletprooftxmanager.NewBulletproofTxManager(db, nil, config, nil, nil, nil, nil)
+	}
+	create := func(bptxm *bulletprooftxmanager.BulletproofTxManager, subject uuid.UUID) error {
+		_, err := bptxm.CreateEthTransaction(db, fromAddress, cltest.NewAddress(), []byte{1, 2, 3}, 21000, nil, bulletprooftxmanager.NewSendEveryStrategy(subject), bulletprooftxmanager.EthTxUrgencyNormal, bulletprooftxmanager.EthTxExpiry{}, true)
+		return err
+	}
+
+	t.Run("rejects transactions of a job which spent its budget", func(t *testing.T) {
+		bptxm := newTxManager(big.NewInt(5000), big.NewInt(1000))
+
+		err := create(bptxm, spender)
+		require.Error(t, err)
+		assert.True(t, errors.Is(err, bulletprooftxmanager.ErrSpendBudgetExhausted))
+
+		require.NoError(t, create(bptxm, uuid.NewV4()))
+	})
+
+	t.Run("rejects all transactions once the node spent its budget", func(t *testing.T) {
+		bptxm := newTxManager(big.NewInt(1000), nil)
+
+		err := create(bptxm, uuid.NewV4())
+		require.Error(t, err)
+		assert.True(t, errors.Is(err, bulletprooftxmanager.ErrSpendBudgetExhausted))
+	})
+}
+
+func TestBulletproofTxManager_CreateEthTransaction_KeyPolicy(t *testing.T) {
+	db := pgtest.NewGormDB(t)
+
+	key := cltest.MustInsertRandomKey(t, db, 0)
+	fromAddress := key.Address.Address()
+	webhookJob, _ := cltest.MustInsertWebhookSpec(t, db)
+	contract := cltest.NewAddress()
+
+	config := new(bptxmmocks.Config)
+	config.On("EthTxResendAfterThreshold").Return(time.Duration(0))
+	config.On("EthTxReaperThreshold").Return(time.Duration(0))
+	config.On("EthNonceReconciliationInterval").Return(time.Duration(0))
+	config.On("GasEstimatorMode").Return("FixedPrice")
+	config.On("EthMaxQueuedTransactions").Return(uint64(0))
+	config.On("EthTxDailyBudgetWei").Return(nil)
+	config.On("EthTxJobDailyBudgetWei").Return(nil)
+	bptxm := bulletprooftxmanager.NewBulletproofTxManager(db, nil, config, nil, nil, nil, nil)
+
+	setPolicy := func(policy ethkey.Policy) {
+		var allowedJobTypes pq.StringArray
+		if len(policy.AllowedJobTypes) > 0 {
+			allowedJobTypes = policy.AllowedJobTypes
+		}
+		var allowedToAddresses ethkey.AddressArray
+		if len(policy.AllowedToAddresses) > 0 {
+			allowedToAddresses = policy.AllowedToAddresses
+		}
+		require.NoError(t, db.Exec(`UPDATE keys SET funding_only = ?, allowed_job_types = ?, allowed_to_addresses = ? WHERE address = ?`,
+			policy.FundingOnly, allowedJobTypes, allowedToAddresses, fromAddress).Error)
+	}
+	create := func(toAddress common.Address, strategy bulletprooftxmanager.TxStrategy) error {
+		_, err := bptxm.CreateEthTransaction(db, fromAddress, toAddress, []byte{1, 2, 3}, 21000, nil, strategy, bulletprooftxmanager.EthTxUrgencyNormal, bulletprooftxmanager.EthTxExpiry{}, true)
+		return err
+	}
+	fromJob := bulletprooftxmanager.NewSendEveryStrategy(webhookJob.ExternalJobID)
+	notFromJob := bulletprooftxmanager.SendEveryStrategy{}
+
+	t.Run("allows any transaction from an unrestricted key", func(t *testing.T) {
+		setPolicy(ethkey.Policy{})
+
+		require.NoError(t, create(contract, fromJob))
+		require.NoError(t, create(contract, notFromJob))
+	})
+
+	t.Run("rejects all transactions from a funding-only key", func(t *testing.T) {
+		setPolicy(ethkey.Policy{FundingOnly: true})
+
+		err := create(contract, fromJob)
+		require.Error(t, err)
+		assert.True(t, errors.Is(err, bulletprooftxmanager.ErrKeyPolicyViolation))
+		assert.True(t, errors.Is(create(contract, notFromJob), bulletprooftxmanager.ErrKeyPolicyViolation))
+	})
+
+	t.Run("rejects transactions of other job types", func(t *testing.T) {
+		setPolicy(ethkey.Policy{AllowedJobTypes: []string{"vrf"}})
+
+		assert.True(t, errors.Is(create(contract, fromJob), bulletprooftxmanager.ErrKeyPolicyViolation))
+		assert.True(t, errors.Is(create(contract, notFromJob), bulletprooftxmanager.ErrKeyPolicyViolation))
+
+		setPolicy(ethkey.Policy{AllowedJobTypes: []string{"vrf", "webhook"}})
+
+		require.NoError(t, create(contract, fromJob))
+	})
+
+	t.Run("rejects transactions to other contracts", func(t *testing.T) {
+		setPolicy(ethkey.Policy{AllowedToAddresses: []common.Address{contract}})
+
+		assert.True(t, errors.Is(create(cltest.NewAddress(), fromJob), bulletprooftxmanager.ErrKeyPolicyViolation))
+		require.NoError(t, create(contract, fromJob))
+	})
+}
+
 func TestBulletproofTxManager_Lifecycle(t *testing.T) {
 	db := pgtest.NewGormDB(t)
 
@@ -296,6 +569,7 @@ func TestBulletproofTxManager_Lifecycle(t *testing.T) {
 	config.On("EthTxResendAfterThreshold").Return(1 * time.Hour)
 	config.On("EthTxReaperThreshold").Return(1 * time.Hour)
 	config.On("EthTxReaperInterval").Return(1 * time.Hour)
+	config.On("EthNonceReconciliationInterval").Return(time.Duration(0))
 	config.On("EthMaxInFlightTransactions").Return(uint32(42))
 	config.On("EthFinalityDepth").Maybe().Return(uint(42))
 	config.On("GasEstimatorMode").Return("FixedPrice")
@@ -305,7 +579,7 @@ func TestBulletproofTxManager_Lifecycle(t *testing.T) {
 	unsub := cltest.NewAwaiter()
 	kst.On("SubscribeToKeyChanges").Return(keyChangeCh, unsub.ItHappened)
 
-	bptxm := bulletprooftxmanager.NewBulletproofTxManager(db, ethClient, config, kst, advisoryLocker, eventBroadcaster)
+	bptxm := bulletprooftxmanager.NewBulletproofTxManager(db, ethClient, config, kst, advisoryLocker, eventBroadcaster, nil)
 
 	head := cltest.Head(42)
 	// It should not hang or panic
@@ -0,0 +1,199 @@
+package bulletprooftxmanager
+
+import (
+	"encoding/json"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+	"gopkg.in/guregu/null.v4"
+	"gorm.io/gorm"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+)
+
+// ConfirmationStatus is the outcome of an eth_tx which a
+// ConfirmationCallback is called with
+type ConfirmationStatus string
+
+const (
+	// ConfirmationStatusConfirmed means the transaction was mined and
+	// succeeded
+	ConfirmationStatusConfirmed = ConfirmationStatus("confirmed")
+	// ConfirmationStatusReverted means the transaction was mined but reverted
+	ConfirmationStatusReverted = ConfirmationStatus("reverted")
+	// ConfirmationStatusCancelled means the transaction was cancelled and the
+	// self-send which replaced it was mined
+	ConfirmationStatusCancelled = ConfirmationStatus("cancelled")
+	// ConfirmationStatusFailed means the transaction will never be mined,
+	// e.g. because it reverted in simulation
+	ConfirmationStatusFailed = ConfirmationStatus("failed")
+)
+
+// Confirmation is passed to a ConfirmationCallback once its eth_tx is final
+type Confirmation struct {
+	EthTxID int64
+	Status  ConfirmationStatus
+	// Receipt is nil if the transaction failed. For a transaction sent in a
+	// multicall batch, it is the receipt of the batch.
+	Receipt *Receipt
+	// Error is set if the transaction failed
+	Error null.String
+}
+
+// ConfirmationCallback is called from the EthConfirmer, and so must not
+// block
+type ConfirmationCallback func(Confirmation)
+
+type confirmationCallback struct {
+	id               int64
+	minConfirmations uint32
+	fn               ConfirmationCallback
+}
+
+// confirmationCallbacks holds the callbacks registered with
+// RegisterConfirmationCallback, by eth_tx ID. It outlives the EthConfirmer,
+// which is recreated when keys change.
+type confirmationCallbacks struct {
+	mu        sync.Mutex
+	nextID    int64
+	callbacks map[int64][]confirmationCallback
+}
+
+func newConfirmationCallbacks() *confirmationCallbacks {
+	return &confirmationCallbacks{callbacks: make(map[int64][]confirmationCallback)}
+}
+
+func (c *confirmationCallbacks) register(ethTxID int64, minConfirmations uint32, fn ConfirmationCallback) (unregister func()) {
+	if minConfirmations == 0 {
+		minConfirmations = 1
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.nextID++
+	id := c.nextID
+	c.callbacks[ethTxID] = append(c.callbacks[ethTxID], confirmationCallback{id, minConfirmations, fn})
+	return func() {
+		c.mu.Lock()
+		defer c.mu.Unlock()
+		c.remove(ethTxID, id)
+	}
+}
+
+// remove must be called with the lock held
+func (c *confirmationCallbacks) remove(ethTxID, id int64) {
+	cbs := c.callbacks[ethTxID]
+	for i, cb := range cbs {
+		if cb.id == id {
+			cbs = append(cbs[:i:i], cbs[i+1:]...)
+			break
+		}
+	}
+	if len(cbs) == 0 {
+		delete(c.callbacks, ethTxID)
+	} else {
+		c.callbacks[ethTxID] = cbs
+	}
+}
+
+func (c *confirmationCallbacks) ethTxIDs() []int64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	ids := make([]int64, 0, len(c.callbacks))
+	for id := range c.callbacks {
+		ids = append(ids, id)
+	}
+	return ids
+}
+
+// ethTxOutcome is the state of an eth_tx with its receipt, if it has one.
+// For batched eth_txes, these are the state and receipt of the batch.
+type ethTxOutcome struct {
+	ID          int64
+	State       EthTxState
+	Error       null.String
+	CancelledAt null.Time
+	BlockNumber null.Int
+	Receipt     []byte
+	// BatchIndex and BatchToAddress are only set for batched eth_txes
+	BatchIndex     null.Int
+	BatchToAddress *common.Address
+}
+
+// process calls the callbacks of eth_txes which failed, or whose receipt has
+// at least the requested number of confirmations at headNumber. Each callback
+// is only ever called once.
+//
+// Receipts are deleted by the EthConfirmer if their block is re-orged out, so
+// a callback is never called for a receipt which is not in the longest chain
+// as of headNumber.
+func (c *confirmationCallbacks) process(db *gorm.DB, headNumber int64) error {
+	ids := c.ethTxIDs()
+	if len(ids) == 0 {
+		return nil
+	}
+
+	var outcomes []ethTxOutcome
+	err := db.Raw(`
+SELECT DISTINCT ON (eth_txes.id) eth_txes.id, COALESCE(batches.state, eth_txes.state) AS state, COALESCE(batches.error, eth_txes.error) AS error,
+	COALESCE(batches.cancelled_at, eth_txes.cancelled_at) AS cancelled_at, eth_txes.batch_index, batches.to_address AS batch_to_address,
+	eth_receipts.block_number, eth_receipts.receipt
+FROM eth_txes
+LEFT JOIN eth_txes batches ON batches.id = eth_txes.batch_eth_tx_id
+LEFT JOIN eth_tx_attempts ON eth_tx_attempts.eth_tx_id = COALESCE(batches.id, eth_txes.id)
+LEFT JOIN eth_receipts ON eth_receipts.tx_hash = eth_tx_attempts.hash
+WHERE eth_txes.id IN (?) AND COALESCE(batches.state, eth_txes.state) IN ('confirmed', 'fatal_error')
+ORDER BY eth_txes.id, eth_receipts.block_number ASC NULLS LAST
+`, ids).Scan(&outcomes).Error
+	if err != nil {
+		return errors.Wrap(err, "failed to load eth_txes with confirmation callbacks")
+	}
+
+	for _, outcome := range outcomes {
+		confirmation := Confirmation{EthTxID: outcome.ID}
+		var confirmations int64
+		if outcome.State == EthTxFatalError {
+			confirmation.Status = ConfirmationStatusFailed
+			confirmation.Error = outcome.Error
+		} else {
+			if !outcome.BlockNumber.Valid {
+				continue
+			}
+			var receipt Receipt
+			if err := json.Unmarshal(outcome.Receipt, &receipt); err != nil {
+				logger.Errorw("BulletproofTxManager: failed to unmarshal receipt for confirmation callback", "err", err, "ethTxID", outcome.ID)
+				continue
+			}
+			confirmation.Receipt = &receipt
+			confirmations = headNumber - outcome.BlockNumber.Int64 + 1
+			switch {
+			case outcome.CancelledAt.Valid:
+				confirmation.Status = ConfirmationStatusCancelled
+			case receipt.Status == 0:
+				confirmation.Status = ConfirmationStatusReverted
+			case outcome.BatchIndex.Valid && outcome.BatchToAddress != nil && !batchedCallSucceeded(receipt, *outcome.BatchToAddress, outcome.BatchIndex.Int64):
+				confirmation.Status = ConfirmationStatusReverted
+			default:
+				confirmation.Status = ConfirmationStatusConfirmed
+			}
+		}
+		for _, fn := range c.due(outcome.ID, confirmation.Status == ConfirmationStatusFailed, confirmations) {
+			fn(confirmation)
+		}
+	}
+	return nil
+}
+
+// due removes and returns the callbacks of the eth_tx which are due to be
+// called
+func (c *confirmationCallbacks) due(ethTxID int64, failed bool, confirmations int64) (fns []ConfirmationCallback) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, cb := range c.callbacks[ethTxID] {
+		if failed || confirmations >= int64(cb.minConfirmations) {
+			fns = append(fns, cb.fn)
+			c.remove(ethTxID, cb.id)
+		}
+	}
+	return fns
+}
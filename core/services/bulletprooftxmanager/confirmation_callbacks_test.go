@@ -0,0 +1,122 @@
+package bulletprooftxmanager_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/core/services/bulletprooftxmanager"
+	bptxmmocks "github.com/smartcontractkit/chainlink/core/services/bulletprooftxmanager/mocks"
+	"github.com/smartcontractkit/chainlink/core/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
+)
+
+func TestBulletproofTxManager_RegisterConfirmationCallback(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewGormDB(t)
+
+	key := cltest.MustInsertRandomKey(t, db, 0)
+	fromAddress := key.Address.Address()
+
+	config := new(bptxmmocks.Config)
+	config.On("EthTxResendAfterThreshold").Return(time.Duration(0))
+	config.On("EthTxReaperThreshold").Return(time.Duration(0))
+	config.On("EthNonceReconciliationInterval").Return(time.Duration(0))
+	config.On("GasEstimatorMode").Return("FixedPrice")
+	bptxm := bulletprooftxmanager.NewBulletproofTxManager(db, nil, config, nil, nil, nil, nil)
+
+	t.Run("calls back once the receipt has enough confirmations", func(t *testing.T) {
+		etx := cltest.MustInsertConfirmedEthTxWithAttempt(t, db, 0, 41, fromAddress)
+		cltest.MustInsertEthReceipt(t, db, 42, utils.NewHash(), etx.EthTxAttempts[0].Hash)
+
+		var confirmations []bulletprooftxmanager.Confirmation
+		bptxm.RegisterConfirmationCallback(etx.ID, 3, func(c bulletprooftxmanager.Confirmation) {
+			confirmations = append(confirmations, c)
+		})
+
+		require.NoError(t, bulletprooftxmanager.ProcessConfirmationCallbacks(bptxm, 43))
+		assert.Len(t, confirmations, 0)
+
+		require.NoError(t, bulletprooftxmanager.ProcessConfirmationCallbacks(bptxm, 44))
+		require.Len(t, confirmations, 1)
+		assert.Equal(t, etx.ID, confirmations[0].EthTxID)
+		// The receipt inserted by cltest has no status, i.e. it reverted
+		assert.Equal(t, bulletprooftxmanager.ConfirmationStatusReverted, confirmations[0].Status)
+		require.NotNil(t, confirmations[0].Receipt)
+
+		// Callbacks are only called once
+		require.NoError(t, bulletprooftxmanager.ProcessConfirmationCallbacks(bptxm, 45))
+		assert.Len(t, confirmations, 1)
+	})
+
+	t.Run("calls back immediately if the transaction failed", func(t *testing.T) {
+		etx := cltest.MustInsertFatalErrorEthTx(t, db, fromAddress)
+
+		var confirmations []bulletprooftxmanager.Confirmation
+		bptxm.RegisterConfirmationCallback(etx.ID, 12, func(c bulletprooftxmanager.Confirmation) {
+			confirmations = append(confirmations, c)
+		})
+
+		require.NoError(t, bulletprooftxmanager.ProcessConfirmationCallbacks(bptxm, 1))
+		require.Len(t, confirmations, 1)
+		assert.Equal(t, bulletprooftxmanager.ConfirmationStatusFailed, confirmations[0].Status)
+		assert.Equal(t, "something exploded", confirmations[0].Error.String)
+		assert.Nil(t, confirmations[0].Receipt)
+	})
+
+	t.Run("does not call back unconfirmed transactions or unregistered callbacks", func(t *testing.T) {
+		unconfirmed := cltest.MustInsertUnconfirmedEthTxWithBroadcastAttempt(t, db, 1, fromAddress)
+		failed := cltest.MustInsertFatalErrorEthTx(t, db, fromAddress)
+
+		called := false
+		bptxm.RegisterConfirmationCallback(unconfirmed.ID, 1, func(bulletprooftxmanager.Confirmation) { called = true })
+		unregister := bptxm.RegisterConfirmationCallback(failed.ID, 1, func(bulletprooftxmanager.Confirmation) { called = true })
+		unregister()
+
+		require.NoError(t, bulletprooftxmanager.ProcessConfirmationCallbacks(bptxm, 100))
+		assert.False(t, called)
+	})
+
+	t.Run("calls back batched transactions with the outcome of their call", func(t *testing.T) {
+		batch := cltest.MustInsertConfirmedEthTxWithAttempt(t, db, 50, 41, fromAddress)
+		receipt, err := json.Marshal(bulletprooftxmanager.Receipt{
+			Status: 1,
+			TxHash: batch.EthTxAttempts[0].Hash,
+			Logs: []*bulletprooftxmanager.Log{
+				newCallResultLog(t, batch.ToAddress, 0, true),
+				newCallResultLog(t, batch.ToAddress, 1, false),
+			},
+		})
+		require.NoError(t, err)
+		require.NoError(t, db.Save(&bulletprooftxmanager.EthReceipt{
+			BlockNumber: 42,
+			BlockHash:   utils.NewHash(),
+			TxHash:      batch.EthTxAttempts[0].Hash,
+			Receipt:     receipt,
+		}).Error)
+
+		statuses := make(map[int64]bulletprooftxmanager.ConfirmationStatus)
+		for i := int64(0); i < 2; i++ {
+			etx := cltest.NewEthTx(t, fromAddress)
+			etx.State = bulletprooftxmanager.EthTxBatched
+			etx.BatchEthTxID = null.IntFrom(batch.ID)
+			etx.BatchIndex = null.IntFrom(i)
+			require.NoError(t, db.Save(&etx).Error)
+			i := i
+			bptxm.RegisterConfirmationCallback(etx.ID, 1, func(c bulletprooftxmanager.Confirmation) {
+				statuses[i] = c.Status
+			})
+		}
+
+		require.NoError(t, bulletprooftxmanager.ProcessConfirmationCallbacks(bptxm, 42))
+		assert.Equal(t, map[int64]bulletprooftxmanager.ConfirmationStatus{
+			0: bulletprooftxmanager.ConfirmationStatusConfirmed,
+			1: bulletprooftxmanager.ConfirmationStatusReverted,
+		}, statuses)
+	})
+}
@@ -0,0 +1,332 @@
+package bulletprooftxmanager
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+
+	"github.com/smartcontractkit/chainlink/core/services/eth"
+	"github.com/smartcontractkit/chainlink/core/services/gas"
+	"github.com/smartcontractkit/chainlink/core/services/postgres"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+// maxMempoolChecks is the maximum number of transactions which are looked up
+// on the eth node in a single diagnosis
+const maxMempoolChecks = 50
+
+// DiagnosisIssueKind is the kind of problem found by the StuckTxDiagnoser
+type DiagnosisIssueKind string
+
+const (
+	// DiagnosisNonceGap means transactions are waiting on a nonce which the
+	// eth node has never seen
+	DiagnosisNonceGap = DiagnosisIssueKind("nonce_gap")
+	// DiagnosisNonceUsedExternally means transactions have a nonce which was
+	// already mined, but not by one of their attempts
+	DiagnosisNonceUsedExternally = DiagnosisIssueKind("nonce_used_externally")
+	// DiagnosisNonceBehindChain means the key's next nonce is behind the
+	// chain, so that new transactions will be rejected
+	DiagnosisNonceBehindChain = DiagnosisIssueKind("nonce_behind_chain")
+	// DiagnosisUnderpriced means transactions are priced below the current
+	// gas price estimate
+	DiagnosisUnderpriced = DiagnosisIssueKind("underpriced")
+	// DiagnosisMaxGasPriceReached means transactions can no longer be bumped
+	// because they reached ETH_MAX_GAS_PRICE_WEI
+	DiagnosisMaxGasPriceReached = DiagnosisIssueKind("max_gas_price_reached")
+	// DiagnosisInsufficientBalance means the key cannot pay for its
+	// unconfirmed transactions
+	DiagnosisInsufficientBalance = DiagnosisIssueKind("insufficient_balance")
+	// DiagnosisMissingFromEthNode means the eth node does not know about
+	// transactions which were broadcast, e.g. because it rejected or dropped
+	// them
+	DiagnosisMissingFromEthNode = DiagnosisIssueKind("missing_from_eth_node")
+)
+
+// DiagnosisIssue is a problem which prevents some of a key's transactions
+// from being mined
+type DiagnosisIssue struct {
+	Kind        DiagnosisIssueKind
+	EthTxIDs    []int64
+	Description string
+	Remediation string
+}
+
+// KeyDiagnosis is the report of a StuckTxDiagnoser for a single key
+type KeyDiagnosis struct {
+	Address common.Address
+	// ChainNonce is the nonce of the key as of the latest block
+	ChainNonce uint64
+	// PendingChainNonce also counts transactions in the eth node's mempool
+	PendingChainNonce uint64
+	// LocalNextNonce is the nonce the next transaction will be sent with
+	LocalNextNonce int64
+	Balance        *big.Int
+	// GasPrice is the current estimate of the gas estimator, or nil if it
+	// has none yet
+	GasPrice            *big.Int
+	UnconfirmedEthTxIDs []int64
+	Issues              []DiagnosisIssue
+}
+
+// Healthy returns true if no issues were found
+func (d KeyDiagnosis) Healthy() bool {
+	return len(d.Issues) == 0
+}
+
+// StuckTxDiagnoser analyzes the unconfirmed transactions of a key to explain
+// why they are not being mined. It only reads from the database and the eth
+// node, remediation is left to the operator.
+type StuckTxDiagnoser struct {
+	db         *gorm.DB
+	ethClient  eth.Client
+	estimator  gas.Estimator
+	config     Config
+	evmChainID *utils.Big
+}
+
+// NewStuckTxDiagnoser returns a new diagnoser
+func NewStuckTxDiagnoser(db *gorm.DB, ethClient eth.Client, estimator gas.Estimator, config Config) *StuckTxDiagnoser {
+	return &StuckTxDiagnoser{db, ethClient, estimator, config, nil}
+}
+
+// Diagnose returns a diagnosis of the unconfirmed transactions of address
+func (d *StuckTxDiagnoser) Diagnose(ctx context.Context, address common.Address) (diagnosis KeyDiagnosis, err error) {
+	diagnosis.Address = address
+	diagnosis.GasPrice = d.estimator.CurrentEstimate().GasPrice
+
+	queryCtx, cancel := eth.DefaultQueryCtx(ctx)
+	defer cancel()
+	diagnosis.ChainNonce, err = d.ethClient.NonceAt(queryCtx, address, nil)
+	if err != nil {
+		return diagnosis, errors.Wrap(err, "StuckTxDiagnoser#Diagnose failed to fetch chain nonce")
+	}
+	diagnosis.PendingChainNonce, err = d.ethClient.PendingNonceAt(queryCtx, address)
+	if err != nil {
+		return diagnosis, errors.Wrap(err, "StuckTxDiagnoser#Diagnose failed to fetch pending chain nonce")
+	}
+	diagnosis.Balance, err = d.ethClient.BalanceAt(queryCtx, address, nil)
+	if err != nil {
+		return diagnosis, errors.Wrap(err, "StuckTxDiagnoser#Diagnose failed to fetch balance")
+	}
+
+	err = postgres.DBWithDefaultContext(d.db, func(db *gorm.DB) error {
+		diagnosis.LocalNextNonce, err = GetNextNonce(db, d.evmChainID, address)
+		return err
+	})
+	if err != nil {
+		return diagnosis, errors.Wrap(err, "StuckTxDiagnoser#Diagnose failed to load next nonce")
+	}
+
+	etxs, err := d.findUnconfirmedEthTxs(address)
+	if err != nil {
+		return diagnosis, errors.Wrap(err, "StuckTxDiagnoser#Diagnose failed to load transactions")
+	}
+	for _, etx := range etxs {
+		diagnosis.UnconfirmedEthTxIDs = append(diagnosis.UnconfirmedEthTxIDs, etx.ID)
+	}
+
+	d.diagnoseNonces(&diagnosis, etxs)
+	d.diagnoseGasPrices(&diagnosis, etxs)
+	d.diagnoseBalance(&diagnosis, etxs)
+	if err := d.diagnoseMempool(ctx, &diagnosis, etxs); err != nil {
+		return diagnosis, errors.Wrap(err, "StuckTxDiagnoser#Diagnose failed to look up transactions on the eth node")
+	}
+
+	return diagnosis, nil
+}
+
+// findUnconfirmedEthTxs loads the unconfirmed eth_txes of the key, with their
+// attempts ordered by gas price, highest first
+func (d *StuckTxDiagnoser) findUnconfirmedEthTxs(address common.Address) (etxs []EthTx, err error) {
+	err = postgres.DBWithDefaultContext(d.db, func(db *gorm.DB) error {
+		return db.
+			Preload("EthTxAttempts", func(db *gorm.DB) *gorm.DB {
+				return db.Order("eth_tx_attempts.gas_price DESC")
+			}).
+			Preload("EthTxAttempts.EthReceipts").
+			Where("evm_chain_id IS NOT DISTINCT FROM ? AND from_address = ? AND state IN ('unconfirmed', 'confirmed_missing_receipt')", d.evmChainID, address).
+			Order("nonce ASC").
+			Find(&etxs).Error
+	})
+	return etxs, errors.WithStack(err)
+}
+
+func (d *StuckTxDiagnoser) diagnoseNonces(diagnosis *KeyDiagnosis, etxs []EthTx) {
+	reconcile := fmt.Sprintf("Run `chainlink keys eth reconcile-nonce %s`.", diagnosis.Address.Hex())
+
+	if diagnosis.LocalNextNonce < int64(diagnosis.PendingChainNonce) {
+		diagnosis.Issues = append(diagnosis.Issues, DiagnosisIssue{
+			Kind:        DiagnosisNonceBehindChain,
+			Description: fmt.Sprintf("The next nonce of the key is %d but the eth node has already seen nonce %d, new transactions will be rejected as nonce too low. The key has probably been used outside the node.", diagnosis.LocalNextNonce, diagnosis.PendingChainNonce-1),
+			Remediation: reconcile,
+		})
+	}
+
+	var usedExternally []int64
+	for _, etx := range etxs {
+		if uint64(*etx.Nonce) < diagnosis.ChainNonce && !hasReceipt(etx) && etx.State == EthTxUnconfirmed {
+			usedExternally = append(usedExternally, etx.ID)
+		}
+	}
+	if len(usedExternally) > 0 {
+		diagnosis.Issues = append(diagnosis.Issues, DiagnosisIssue{
+			Kind:        DiagnosisNonceUsedExternally,
+			EthTxIDs:    usedExternally,
+			Description: fmt.Sprintf("%d transaction(s) have a nonce below the chain nonce of %d but no receipt. Unless a receipt is found on the next head, their nonce was used by a transaction sent from outside the node and they will never be mined.", len(usedExternally), diagnosis.ChainNonce),
+			Remediation: reconcile,
+		})
+	}
+
+	expected := int64(diagnosis.ChainNonce)
+	for _, etx := range etxs {
+		nonce := *etx.Nonce
+		if nonce < expected {
+			continue
+		}
+		if nonce > expected {
+			diagnosis.Issues = append(diagnosis.Issues, DiagnosisIssue{
+				Kind:        DiagnosisNonceGap,
+				EthTxIDs:    []int64{etx.ID},
+				Description: fmt.Sprintf("Nonces %d to %d are missing, so this transaction and all transactions after it cannot be mined.", expected, nonce-1),
+				Remediation: "If the missing nonces were used by transactions sent from outside the node, make sure they are mined or replace them with self-sends using the same nonces. Otherwise " + reconcile,
+			})
+			break
+		}
+		expected = nonce + 1
+	}
+}
+
+func (d *StuckTxDiagnoser) diagnoseGasPrices(diagnosis *KeyDiagnosis, etxs []EthTx) {
+	if diagnosis.GasPrice == nil {
+		return
+	}
+	maxGasPrice := d.config.EthMaxGasPriceWei()
+	var underpriced, maxedOut []int64
+	for _, etx := range etxs {
+		if len(etx.EthTxAttempts) == 0 || hasReceipt(etx) {
+			continue
+		}
+		gasPrice := etx.EthTxAttempts[0].GasPrice.ToInt()
+		if gasPrice.Cmp(diagnosis.GasPrice) >= 0 {
+			continue
+		}
+		if gasPrice.Cmp(maxGasPrice) >= 0 {
+			maxedOut = append(maxedOut, etx.ID)
+		} else {
+			underpriced = append(underpriced, etx.ID)
+		}
+	}
+	if len(underpriced) > 0 {
+		diagnosis.Issues = append(diagnosis.Issues, DiagnosisIssue{
+			Kind:        DiagnosisUnderpriced,
+			EthTxIDs:    underpriced,
+			Description: fmt.Sprintf("%d transaction(s) are priced below the current gas price estimate of %s wei.", len(underpriced), diagnosis.GasPrice),
+			Remediation: "They will be bumped automatically after ETH_GAS_BUMP_THRESHOLD blocks. To bump sooner, lower ETH_GAS_BUMP_THRESHOLD or send them with a higher urgency.",
+		})
+	}
+	if len(maxedOut) > 0 {
+		diagnosis.Issues = append(diagnosis.Issues, DiagnosisIssue{
+			Kind:        DiagnosisMaxGasPriceReached,
+			EthTxIDs:    maxedOut,
+			Description: fmt.Sprintf("%d transaction(s) have been bumped to ETH_MAX_GAS_PRICE_WEI of %s wei, which is below the current gas price estimate of %s wei.", len(maxedOut), maxGasPrice, diagnosis.GasPrice),
+			Remediation: "Raise ETH_MAX_GAS_PRICE_WEI above the current gas price, or wait for the market gas price to fall.",
+		})
+	}
+}
+
+func (d *StuckTxDiagnoser) diagnoseBalance(diagnosis *KeyDiagnosis, etxs []EthTx) {
+	required := big.NewInt(0)
+	var insufficientEth []int64
+	for _, etx := range etxs {
+		if len(etx.EthTxAttempts) == 0 || hasReceipt(etx) {
+			continue
+		}
+		attempt := etx.EthTxAttempts[0]
+		cost := new(big.Int).Mul(attempt.GasPrice.ToInt(), new(big.Int).SetUint64(attempt.ChainSpecificGasLimit))
+		cost.Add(cost, etx.Value.ToInt())
+		required.Add(required, cost)
+		for _, a := range etx.EthTxAttempts {
+			if a.State == EthTxAttemptInsufficientEth {
+				insufficientEth = append(insufficientEth, etx.ID)
+				break
+			}
+		}
+	}
+	if required.Cmp(diagnosis.Balance) <= 0 && len(insufficientEth) == 0 {
+		return
+	}
+	shortfall := new(big.Int).Sub(required, diagnosis.Balance)
+	if shortfall.Sign() < 0 {
+		shortfall.SetInt64(0)
+	}
+	diagnosis.Issues = append(diagnosis.Issues, DiagnosisIssue{
+		Kind:        DiagnosisInsufficientBalance,
+		EthTxIDs:    insufficientEth,
+		Description: fmt.Sprintf("The key has a balance of %s wei but its unconfirmed transactions cost up to %s wei. %d transaction(s) were rejected by the eth node for insufficient funds.", diagnosis.Balance, required, len(insufficientEth)),
+		Remediation: fmt.Sprintf("Fund %s with at least %s wei, plus a margin for gas bumping. Transactions are retried automatically once it is funded.", diagnosis.Address.Hex(), shortfall),
+	})
+}
+
+// diagnoseMempool looks up the highest priced attempt of each transaction
+// which has not been mined on the eth node. Transactions the node does not
+// know about were rejected when they were broadcast, or dropped since.
+func (d *StuckTxDiagnoser) diagnoseMempool(ctx context.Context, diagnosis *KeyDiagnosis, etxs []EthTx) error {
+	var pending []EthTx
+	for _, etx := range etxs {
+		if etx.State == EthTxUnconfirmed && uint64(*etx.Nonce) >= diagnosis.ChainNonce && len(etx.EthTxAttempts) > 0 && etx.EthTxAttempts[0].State == EthTxAttemptBroadcast {
+			pending = append(pending, etx)
+		}
+		if len(pending) == maxMempoolChecks {
+			break
+		}
+	}
+	if len(pending) == 0 {
+		return nil
+	}
+
+	results := make([]map[string]interface{}, len(pending))
+	reqs := make([]rpc.BatchElem, len(pending))
+	for i, etx := range pending {
+		reqs[i] = rpc.BatchElem{
+			Method: "eth_getTransactionByHash",
+			Args:   []interface{}{etx.EthTxAttempts[0].Hash},
+			Result: &results[i],
+		}
+	}
+	queryCtx, cancel := eth.DefaultQueryCtx(ctx)
+	defer cancel()
+	if err := d.ethClient.BatchCallContext(queryCtx, reqs); err != nil {
+		return err
+	}
+
+	var missing []int64
+	for i, req := range reqs {
+		if req.Error == nil && results[i] == nil {
+			missing = append(missing, pending[i].ID)
+		}
+	}
+	if len(missing) > 0 {
+		diagnosis.Issues = append(diagnosis.Issues, DiagnosisIssue{
+			Kind:        DiagnosisMissingFromEthNode,
+			EthTxIDs:    missing,
+			Description: fmt.Sprintf("%d transaction(s) were broadcast but are unknown to the eth node, it either rejected or dropped them.", len(missing)),
+			Remediation: fmt.Sprintf("Check the node and eth node logs for the broadcast error, e.g. the eth node's mempool being full or its minimum gas price being too high. Run `chainlink keys eth reconcile-nonce %s` to rebroadcast them.", diagnosis.Address.Hex()),
+		})
+	}
+	return nil
+}
+
+func hasReceipt(etx EthTx) bool {
+	for _, attempt := range etx.EthTxAttempts {
+		if len(attempt.EthReceipts) > 0 {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,102 @@
+package bulletprooftxmanager_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/internal/mocks"
+	"github.com/smartcontractkit/chainlink/core/services/bulletprooftxmanager"
+	bptxmmocks "github.com/smartcontractkit/chainlink/core/services/bulletprooftxmanager/mocks"
+	"github.com/smartcontractkit/chainlink/core/services/gas"
+	gasmocks "github.com/smartcontractkit/chainlink/core/services/gas/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_StuckTxDiagnoser_Diagnose(t *testing.T) {
+	t.Parallel()
+
+	config := new(bptxmmocks.Config)
+	config.On("EthMaxGasPriceWei").Return(big.NewInt(100))
+
+	t.Run("reports a healthy key", func(t *testing.T) {
+		store, cleanup := cltest.NewStore(t)
+		defer cleanup()
+		db := store.DB
+		ethClient := new(mocks.Client)
+		estimator := new(gasmocks.Estimator)
+
+		k := cltest.MustInsertRandomKey(t, db, int64(2))
+		from := k.Address.Address()
+		etx := cltest.MustInsertUnconfirmedEthTxWithBroadcastAttempt(t, db, 1, from)
+
+		estimator.On("CurrentEstimate").Return(gas.Estimate{GasPrice: big.NewInt(1)})
+		ethClient.On("NonceAt", mock.Anything, from, (*big.Int)(nil)).Return(uint64(1), nil)
+		ethClient.On("PendingNonceAt", mock.Anything, from).Return(uint64(2), nil)
+		ethClient.On("BalanceAt", mock.Anything, from, (*big.Int)(nil)).Return(big.NewInt(1000000), nil)
+		ethClient.On("BatchCallContext", mock.Anything, mock.MatchedBy(func(b []rpc.BatchElem) bool {
+			return len(b) == 1 && cltest.BatchElemMatchesHash(b[0], etx.EthTxAttempts[0].Hash)
+		})).Return(nil).Run(func(args mock.Arguments) {
+			elems := args.Get(1).([]rpc.BatchElem)
+			*elems[0].Result.(*map[string]interface{}) = map[string]interface{}{"hash": etx.EthTxAttempts[0].Hash.Hex()}
+		})
+
+		diagnosis, err := bulletprooftxmanager.NewStuckTxDiagnoser(db, ethClient, estimator, config).Diagnose(context.Background(), from)
+		require.NoError(t, err)
+
+		assert.True(t, diagnosis.Healthy())
+		assert.Equal(t, int64(2), diagnosis.LocalNextNonce)
+		assert.Equal(t, []int64{etx.ID}, diagnosis.UnconfirmedEthTxIDs)
+
+		ethClient.AssertExpectations(t)
+		estimator.AssertExpectations(t)
+	})
+
+	t.Run("reports nonce gaps, underpriced transactions, balance shortfalls and transactions unknown to the eth node", func(t *testing.T) {
+		store, cleanup := cltest.NewStore(t)
+		defer cleanup()
+		db := store.DB
+		ethClient := new(mocks.Client)
+		estimator := new(gasmocks.Estimator)
+
+		k := cltest.MustInsertRandomKey(t, db, int64(5))
+		from := k.Address.Address()
+		etx3 := cltest.MustInsertUnconfirmedEthTxWithBroadcastAttempt(t, db, 3, from)
+		etx4 := cltest.MustInsertUnconfirmedEthTxWithInsufficientEthAttempt(t, db, 4, from)
+
+		estimator.On("CurrentEstimate").Return(gas.Estimate{GasPrice: big.NewInt(10)})
+		ethClient.On("NonceAt", mock.Anything, from, (*big.Int)(nil)).Return(uint64(1), nil)
+		ethClient.On("PendingNonceAt", mock.Anything, from).Return(uint64(1), nil)
+		ethClient.On("BalanceAt", mock.Anything, from, (*big.Int)(nil)).Return(big.NewInt(0), nil)
+		// The eth node does not know about etx3
+		ethClient.On("BatchCallContext", mock.Anything, mock.MatchedBy(func(b []rpc.BatchElem) bool {
+			return len(b) == 1 && cltest.BatchElemMatchesHash(b[0], etx3.EthTxAttempts[0].Hash)
+		})).Return(nil)
+
+		diagnosis, err := bulletprooftxmanager.NewStuckTxDiagnoser(db, ethClient, estimator, config).Diagnose(context.Background(), from)
+		require.NoError(t, err)
+
+		assert.False(t, diagnosis.Healthy())
+		require.Len(t, diagnosis.Issues, 4)
+
+		assert.Equal(t, bulletprooftxmanager.DiagnosisNonceGap, diagnosis.Issues[0].Kind)
+		assert.Equal(t, []int64{etx3.ID}, diagnosis.Issues[0].EthTxIDs)
+		assert.Equal(t, "Nonces 1 to 2 are missing, so this transaction and all transactions after it cannot be mined.", diagnosis.Issues[0].Description)
+
+		assert.Equal(t, bulletprooftxmanager.DiagnosisUnderpriced, diagnosis.Issues[1].Kind)
+		assert.Equal(t, []int64{etx3.ID, etx4.ID}, diagnosis.Issues[1].EthTxIDs)
+
+		assert.Equal(t, bulletprooftxmanager.DiagnosisInsufficientBalance, diagnosis.Issues[2].Kind)
+		assert.Equal(t, []int64{etx4.ID}, diagnosis.Issues[2].EthTxIDs)
+
+		assert.Equal(t, bulletprooftxmanager.DiagnosisMissingFromEthNode, diagnosis.Issues[3].Kind)
+		assert.Equal(t, []int64{etx3.ID}, diagnosis.Issues[3].EthTxIDs)
+
+		ethClient.AssertExpectations(t)
+		estimator.AssertExpectations(t)
+	})
+}
@@ -2,6 +2,7 @@ package bulletprooftxmanager
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
 	"math/big"
 	"sync"
@@ -56,6 +57,8 @@ type EthBroadcaster struct {
 
 	keys []ethkey.Key
 
+	evmChainID *utils.Big
+
 	// triggers allow other goroutines to force EthBroadcaster to rescan the
 	// database early (before the next poll interval)
 	// Each key has its own trigger
@@ -95,8 +98,11 @@ func (eb *EthBroadcaster) Start() error {
 			return errors.Wrap(err, "EthBroadcaster could not start")
 		}
 
-		if eb.config.EthNonceAutoSync() {
+		// Key states of additional chains start at nonce 0, so they are always
+		// synced
+		if eb.config.EthNonceAutoSync() || eb.evmChainID != nil {
 			syncer := NewNonceSyncer(eb.db, eb.ethClient)
+			syncer.evmChainID = eb.evmChainID
 			if err := syncer.SyncAll(eb.ctx, eb.keys); err != nil {
 				return errors.Wrap(err, "EthBroadcaster failed to sync with on-chain nonce")
 			}
@@ -218,24 +224,39 @@ func (eb *EthBroadcaster) processUnstartedEthTxs(fromAddress gethCommon.Address)
 	if err := eb.handleAnyInProgressEthTx(fromAddress); err != nil {
 		return errors.Wrap(err, "processUnstartedEthTxs failed")
 	}
+	// Batchable transactions created after holdCutoff are held back to be
+	// batched with others, and processed again once the window has passed
+	var holdCutoff *time.Time
+	if multicall := eb.config.EthTxMulticallAddress(); multicall != nil {
+		window := eb.config.EthTxMulticallWindow()
+		cutoff := time.Now().Add(-window)
+		held, err := eb.batchUnstartedEthTxs(*multicall, fromAddress, cutoff)
+		if err != nil {
+			return errors.Wrap(err, "processUnstartedEthTxs failed")
+		}
+		if held {
+			time.AfterFunc(window, func() { eb.Trigger(fromAddress) })
+		}
+		holdCutoff = &cutoff
+	}
 	for {
-		maxInFlightTransactions := eb.config.EthMaxInFlightTransactions()
+		maxInFlightTransactions := eb.config.EthMaxInFlightTransactionsForKey(fromAddress)
 		if maxInFlightTransactions > 0 {
-			nUnconfirmed, err := CountUnconfirmedTransactions(eb.db, fromAddress)
+			nUnconfirmed, err := CountUnconfirmedTransactions(eb.db, eb.evmChainID, fromAddress)
 			if err != nil {
 				return errors.Wrap(err, "CountUnconfirmedTransactions failed")
 			}
 			if nUnconfirmed >= maxInFlightTransactions {
-				nUnstarted, err := CountUnstartedTransactions(eb.db, fromAddress)
+				nUnstarted, err := CountUnstartedTransactions(eb.db, eb.evmChainID, fromAddress)
 				if err != nil {
 					return errors.Wrap(err, "CountUnstartedTransactions failed")
 				}
-				logger.Warnw(fmt.Sprintf(`EthBroadcaster: transaction throttling; current queue size is %d but maximum number of in-flight transactions is %d per key. %s`, nUnstarted, maxInFlightTransactions, static.EthMaxInFlightTransactionsWarningLabel), "maxInFlightTransactions", maxInFlightTransactions, "nUnconfirmed", nUnconfirmed, "nUnstarted", nUnstarted)
+				logger.Warnw(fmt.Sprintf(`EthBroadcaster: transaction throttling; current queue size is %d but maximum number of in-flight transactions is %d per key. %s`, nUnstarted, maxInFlightTransactions, static.EthMaxInFlightTransactionsWarningLabel), "maxInFlightTransactions", maxInFlightTransactions, "nUnconfirmed", nUnconfirmed, "nUnstarted", nUnstarted, "fromAddress", fromAddress)
 				time.Sleep(InFlightTransactionRecheckInterval)
 				continue
 			}
 		}
-		etx, err := eb.nextUnstartedTransactionWithNonce(fromAddress)
+		etx, err := eb.nextUnstartedTransactionWithNonce(fromAddress, holdCutoff)
 		if err != nil {
 			return errors.Wrap(err, "processUnstartedEthTxs failed")
 		}
@@ -243,10 +264,18 @@ func (eb *EthBroadcaster) processUnstartedEthTxs(fromAddress gethCommon.Address)
 			return nil
 		}
 		n++
+		if eb.config.EthTxSimulateBeforeBroadcast() && etx.Simulate {
+			if reverted, err := eb.revertsInSimulation(etx); err != nil {
+				return errors.Wrap(err, "processUnstartedEthTxs failed")
+			} else if reverted {
+				continue
+			}
+		}
 		gasPrice, gasLimit, err := eb.estimator.EstimateGas(etx.EncodedPayload, etx.GasLimit)
 		if err != nil {
 			return errors.Wrap(err, "failed to estimate gas")
 		}
+		gasPrice = initialGasPrice(eb.config, *etx, gasPrice)
 		a, err := newAttempt(eb.ethClient, eb.keystore, eb.config.ChainID(), *etx, gasPrice, gasLimit)
 		if err != nil {
 			return errors.Wrap(err, "processUnstartedEthTxs failed")
@@ -268,7 +297,7 @@ func (eb *EthBroadcaster) processUnstartedEthTxs(fromAddress gethCommon.Address)
 // handleInProgressEthTx checks if there is any transaction
 // in_progress and if so, finishes the job
 func (eb *EthBroadcaster) handleAnyInProgressEthTx(fromAddress gethCommon.Address) error {
-	etx, err := getInProgressEthTx(eb.db, fromAddress)
+	etx, err := getInProgressEthTx(eb.db, eb.evmChainID, fromAddress)
 	if err != nil {
 		return errors.Wrap(err, "handleAnyInProgressEthTx failed")
 	}
@@ -284,9 +313,9 @@ func (eb *EthBroadcaster) handleAnyInProgressEthTx(fromAddress gethCommon.Addres
 // an unfinished state because something went screwy the last time. Most likely
 // the node crashed in the middle of the ProcessUnstartedEthTxs loop.
 // It may or may not have been broadcast to an eth node.
-func getInProgressEthTx(db *gorm.DB, fromAddress gethCommon.Address) (*EthTx, error) {
+func getInProgressEthTx(db *gorm.DB, evmChainID *utils.Big, fromAddress gethCommon.Address) (*EthTx, error) {
 	etx := &EthTx{}
-	err := db.Preload("EthTxAttempts").First(etx, "from_address = ? AND state = 'in_progress'", fromAddress.Bytes()).Error
+	err := db.Preload("EthTxAttempts").First(etx, "evm_chain_id IS NOT DISTINCT FROM ? AND from_address = ? AND state = 'in_progress'", evmChainID, fromAddress.Bytes()).Error
 	if errors.Is(err, gorm.ErrRecordNotFound) {
 		return nil, nil
 	}
@@ -409,11 +438,29 @@ func (eb *EthBroadcaster) handleInProgressEthTx(etx EthTx, attempt EthTxAttempt,
 	return errors.Wrapf(sendError, "error while sending transaction %v", etx.ID)
 }
 
+// revertsInSimulation simulates the transaction and, if it reverted, marks it
+// as errored with the revert reason instead of broadcasting it. If the
+// simulation itself fails the transaction is broadcast as normal.
+func (eb *EthBroadcaster) revertsInSimulation(etx *EthTx) (bool, error) {
+	ctx, cancel := eth.DefaultQueryCtx()
+	defer cancel()
+	reverted, reason, err := simulateEthTx(ctx, eb.ethClient, *etx)
+	if err != nil {
+		logger.Warnw("EthBroadcaster: failed to simulate transaction, broadcasting it anyway", "ethTxID", etx.ID, "err", err)
+		return false, nil
+	}
+	if !reverted {
+		return false, nil
+	}
+	logger.Warnw("EthBroadcaster: transaction reverted in simulation, it will not be broadcast", "ethTxID", etx.ID, "fromAddress", etx.FromAddress, "toAddress", etx.ToAddress, "revertReason", reason)
+	return true, saveSimulationRevertedTransaction(eb.db, etx, reason)
+}
+
 // Finds next transaction in the queue, assigns a nonce, and moves it to "in_progress" state ready for broadcast.
 // Returns nil if no transactions are in queue
-func (eb *EthBroadcaster) nextUnstartedTransactionWithNonce(fromAddress gethCommon.Address) (*EthTx, error) {
+func (eb *EthBroadcaster) nextUnstartedTransactionWithNonce(fromAddress gethCommon.Address, holdCutoff *time.Time) (*EthTx, error) {
 	etx := &EthTx{}
-	if err := findNextUnstartedTransactionFromAddress(eb.db, etx, fromAddress); err != nil {
+	if err := findNextUnstartedTransactionFromAddress(eb.db, eb.evmChainID, etx, fromAddress, holdCutoff); err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			// Finish. No more transactions left to process. Hoorah!
 			return nil, nil
@@ -421,7 +468,7 @@ func (eb *EthBroadcaster) nextUnstartedTransactionWithNonce(fromAddress gethComm
 		return nil, errors.Wrap(err, "findNextUnstartedTransactionFromAddress failed")
 	}
 
-	nonce, err := GetNextNonce(eb.db, etx.FromAddress)
+	nonce, err := GetNextNonce(eb.db, eb.evmChainID, etx.FromAddress)
 	if err != nil {
 		return nil, err
 	}
@@ -457,10 +504,14 @@ func (eb *EthBroadcaster) saveInProgressTransaction(etx *EthTx, attempt *EthTxAt
 	})
 }
 
-// Finds earliest saved transaction that has yet to be broadcast from the given address
-func findNextUnstartedTransactionFromAddress(db *gorm.DB, etx *EthTx, fromAddress gethCommon.Address) error {
+// Finds earliest saved transaction that has yet to be broadcast from the given address.
+// Batchable transactions created after holdCutoff are skipped, if it is set.
+func findNextUnstartedTransactionFromAddress(db *gorm.DB, evmChainID *utils.Big, etx *EthTx, fromAddress gethCommon.Address, holdCutoff *time.Time) error {
+	if holdCutoff != nil {
+		db = db.Where("NOT (batchable AND value = 0 AND created_at > ?)", *holdCutoff)
+	}
 	return db.
-		Where("from_address = ? AND state = 'unstarted'", fromAddress).
+		Where("evm_chain_id IS NOT DISTINCT FROM ? AND from_address = ? AND state = 'unstarted'", evmChainID, fromAddress).
 		Order("value ASC, created_at ASC, id ASC").
 		First(etx).
 		Error
@@ -479,7 +530,7 @@ func saveAttempt(db *gorm.DB, etx *EthTx, attempt EthTxAttempt, newAttemptState
 	etx.State = EthTxUnconfirmed
 	attempt.State = newAttemptState
 	return postgres.GormTransactionWithDefaultContext(db, func(tx *gorm.DB) error {
-		if err := IncrementNextNonce(tx, etx.FromAddress, *etx.Nonce); err != nil {
+		if err := IncrementNextNonce(tx, etx.EVMChainID, etx.FromAddress, *etx.Nonce); err != nil {
 			return errors.Wrap(err, "saveUnconfirmed failed")
 		}
 		if err := tx.Save(etx).Error; err != nil {
@@ -498,7 +549,7 @@ func saveAttempt(db *gorm.DB, etx *EthTx, attempt EthTxAttempt, newAttemptState
 }
 
 func (eb *EthBroadcaster) tryAgainBumpingGas(sendError *eth.SendError, etx EthTx, attempt EthTxAttempt, initialBroadcastAt time.Time) error {
-	bumpedGasPrice, bumpedGasLimit, err := eb.estimator.BumpGas(attempt.GasPrice.ToInt(), etx.GasLimit)
+	bumpedGasPrice, bumpedGasLimit, err := bumpGas(eb.config, eb.estimator, etx, attempt.GasPrice.ToInt())
 	if err != nil {
 		return errors.Wrap(err, "tryAgainWithHigherGasPrice failed")
 	}
@@ -506,7 +557,7 @@ func (eb *EthBroadcaster) tryAgainBumpingGas(sendError *eth.SendError, etx EthTx
 		"Eth node returned: '%s'. "+
 		"Bumping to %v wei and retrying. ACTION REQUIRED: This is a configuration error. "+
 		"Consider increasing ETH_GAS_PRICE_DEFAULT", eb.config.EthGasPriceDefault(), sendError.Error(), bumpedGasPrice), "err", err)
-	if bumpedGasPrice.Cmp(attempt.GasPrice.ToInt()) == 0 && bumpedGasPrice.Cmp(eb.config.EthMaxGasPriceWei()) == 0 {
+	if bumpedGasPrice.Cmp(attempt.GasPrice.ToInt()) == 0 && bumpedGasPrice.Cmp(maxGasPrice(eb.config, etx)) == 0 {
 		return errors.Errorf("Hit gas price bump ceiling, will not bump further. This is a terminal error")
 	}
 	return eb.tryAgainWithNewGas(etx, attempt, initialBroadcastAt, bumpedGasPrice, bumpedGasLimit)
@@ -551,19 +602,31 @@ func saveFatallyErroredTransaction(db *gorm.DB, etx *EthTx) error {
 	})
 }
 
-// GetNextNonce returns keys.next_nonce for the given address
-func GetNextNonce(db *gorm.DB, address gethCommon.Address) (int64, error) {
+// GetNextNonce returns the next nonce of the given address. On the node's
+// primary chain this is keys.next_nonce, on other chains it is
+// eth_key_states.next_nonce.
+func GetNextNonce(db *gorm.DB, evmChainID *utils.Big, address gethCommon.Address) (int64, error) {
 	var nonce int64
-	row := db.Raw("SELECT next_nonce FROM keys WHERE address = ?", address).Row()
+	var row *sql.Row
+	if evmChainID == nil {
+		row = db.Raw("SELECT next_nonce FROM keys WHERE address = ?", address).Row()
+	} else {
+		row = db.Raw("SELECT next_nonce FROM eth_key_states WHERE address = ? AND evm_chain_id = ?", address, evmChainID).Row()
+	}
 	if err := row.Scan(&nonce); err != nil {
 		return 0, errors.Wrap(err, "GetNextNonce failed scanning row")
 	}
 	return nonce, nil
 }
 
-// IncrementNextNonce increments keys.next_nonce by 1
-func IncrementNextNonce(db *gorm.DB, address gethCommon.Address, currentNonce int64) error {
-	res := db.Exec("UPDATE keys SET next_nonce = next_nonce + 1, updated_at = NOW() WHERE address = ? AND next_nonce = ?", address.Bytes(), currentNonce)
+// IncrementNextNonce increments the next nonce of the given address by 1
+func IncrementNextNonce(db *gorm.DB, evmChainID *utils.Big, address gethCommon.Address, currentNonce int64) error {
+	var res *gorm.DB
+	if evmChainID == nil {
+		res = db.Exec("UPDATE keys SET next_nonce = next_nonce + 1, updated_at = NOW() WHERE address = ? AND next_nonce = ?", address.Bytes(), currentNonce)
+	} else {
+		res = db.Exec("UPDATE eth_key_states SET next_nonce = next_nonce + 1, updated_at = NOW() WHERE address = ? AND evm_chain_id = ? AND next_nonce = ?", address.Bytes(), evmChainID, currentNonce)
+	}
 	if res.Error != nil {
 		return errors.Wrap(res.Error, "IncrementNextNonce failed to update keys")
 	}
@@ -9,7 +9,9 @@ import (
 	"testing"
 	"time"
 
+	"github.com/ethereum/go-ethereum"
 	gethCommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	gethTypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/onsi/gomega"
 	uuid "github.com/satori/go.uuid"
@@ -19,6 +21,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/internal/mocks"
 	"github.com/smartcontractkit/chainlink/core/internal/testutils/pgtest"
 	"github.com/smartcontractkit/chainlink/core/services/bulletprooftxmanager"
+	"github.com/smartcontractkit/chainlink/core/services/eth"
 	gasmocks "github.com/smartcontractkit/chainlink/core/services/gas/mocks"
 	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
 	ksmocks "github.com/smartcontractkit/chainlink/core/services/keystore/mocks"
@@ -26,6 +29,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/store"
 	"github.com/smartcontractkit/chainlink/core/store/dialects"
 	"github.com/smartcontractkit/chainlink/core/store/models"
+	"github.com/smartcontractkit/chainlink/core/utils"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
@@ -331,6 +335,190 @@ func TestEthBroadcaster_ProcessUnstartedEthTxs_Success_WithMultiplier(t *testing
 	ethClient.AssertExpectations(t)
 }
 
+func TestEthBroadcaster_ProcessUnstartedEthTxs_SimulateBeforeBroadcast(t *testing.T) {
+	db := pgtest.NewGormDB(t)
+
+	ethKeyStore := cltest.NewKeyStore(t, db).Eth()
+	key, fromAddress := cltest.MustAddRandomKeyToKeystore(t, ethKeyStore, 0)
+	ethKeyStore.Unlock(cltest.Password)
+
+	config, cleanup := cltest.NewConfig(t)
+	defer cleanup()
+	config.Set("ETH_TX_SIMULATE_BEFORE_BROADCAST", true)
+
+	ethClient := new(mocks.Client)
+
+	eb, cleanup := cltest.NewEthBroadcaster(t, db, ethClient, ethKeyStore, config, key)
+	defer cleanup()
+
+	toAddress := gethCommon.HexToAddress("0x6C03DDA95a2AEd917EeCc6eddD4b9D16E6380411")
+
+	t.Run("marks transactions which revert in simulation as errored without broadcasting them", func(t *testing.T) {
+		revertErr := &eth.JsonError{
+			Code:    3,
+			Data:    fmt.Sprintf("0x%s%s", "08c379a0", utils.RemoveHexPrefix(hexutil.Encode([]byte("not enough LINK")))),
+			Message: "execution reverted: not enough LINK",
+		}
+		ethClient.On("CallContract", mock.Anything, mock.MatchedBy(func(msg ethereum.CallMsg) bool {
+			return msg.From == fromAddress && *msg.To == toAddress && msg.Gas == 1231
+		}), (*big.Int)(nil)).Return(nil, revertErr).Once()
+
+		etx := bulletprooftxmanager.EthTx{
+			FromAddress:    fromAddress,
+			ToAddress:      toAddress,
+			EncodedPayload: []byte{42, 42, 0},
+			Value:          assets.NewEthValue(0),
+			GasLimit:       1231,
+			CreatedAt:      time.Unix(0, 0),
+			State:          bulletprooftxmanager.EthTxUnstarted,
+			Simulate:       true,
+		}
+		require.NoError(t, db.Save(&etx).Error)
+
+		require.NoError(t, eb.ProcessUnstartedEthTxs(key))
+
+		etx, err := cltest.FindEthTxWithAttempts(db, etx.ID)
+		require.NoError(t, err)
+		assert.Equal(t, bulletprooftxmanager.EthTxFatalError, etx.State)
+		assert.Nil(t, etx.Nonce)
+		assert.Len(t, etx.EthTxAttempts, 0)
+		assert.Equal(t, bulletprooftxmanager.ErrEthTxSimulationReverted+": not enough LINK", etx.Error.String)
+
+		// The nonce was not used up
+		nonce, err := bulletprooftxmanager.GetNextNonce(db, nil, fromAddress)
+		require.NoError(t, err)
+		assert.Equal(t, int64(0), nonce)
+
+		ethClient.AssertExpectations(t)
+	})
+
+	t.Run("broadcasts transactions which succeed in simulation", func(t *testing.T) {
+		ethClient.On("CallContract", mock.Anything, mock.Anything, (*big.Int)(nil)).Return([]byte{}, nil).Once()
+		ethClient.On("SendTransaction", mock.Anything, mock.MatchedBy(func(tx *gethTypes.Transaction) bool {
+			return tx.Nonce() == uint64(0)
+		})).Return(nil).Once()
+
+		etx := bulletprooftxmanager.EthTx{
+			FromAddress:    fromAddress,
+			ToAddress:      toAddress,
+			EncodedPayload: []byte{42, 42, 0},
+			Value:          assets.NewEthValue(0),
+			GasLimit:       1231,
+			CreatedAt:      time.Unix(0, 0),
+			State:          bulletprooftxmanager.EthTxUnstarted,
+			Simulate:       true,
+		}
+		require.NoError(t, db.Save(&etx).Error)
+
+		require.NoError(t, eb.ProcessUnstartedEthTxs(key))
+
+		etx, err := cltest.FindEthTxWithAttempts(db, etx.ID)
+		require.NoError(t, err)
+		assert.Equal(t, bulletprooftxmanager.EthTxUnconfirmed, etx.State)
+
+		ethClient.AssertExpectations(t)
+	})
+
+	t.Run("does not simulate transactions which opted out", func(t *testing.T) {
+		ethClient.On("SendTransaction", mock.Anything, mock.MatchedBy(func(tx *gethTypes.Transaction) bool {
+			return tx.Nonce() == uint64(1)
+		})).Return(nil).Once()
+
+		etx := cltest.NewEthTx(t, fromAddress)
+		require.NoError(t, db.Save(&etx).Error)
+		require.NoError(t, db.Exec(`UPDATE eth_txes SET simulate = false WHERE id = ?`, etx.ID).Error)
+
+		require.NoError(t, eb.ProcessUnstartedEthTxs(key))
+
+		etx, err := cltest.FindEthTxWithAttempts(db, etx.ID)
+		require.NoError(t, err)
+		assert.Equal(t, bulletprooftxmanager.EthTxUnconfirmed, etx.State)
+
+		ethClient.AssertExpectations(t)
+	})
+}
+
+func TestEthBroadcaster_ProcessUnstartedEthTxs_Multicall(t *testing.T) {
+	db := pgtest.NewGormDB(t)
+
+	ethKeyStore := cltest.NewKeyStore(t, db).Eth()
+	key, fromAddress := cltest.MustAddRandomKeyToKeystore(t, ethKeyStore, 0)
+	ethKeyStore.Unlock(cltest.Password)
+
+	multicallAddress := cltest.NewAddress()
+	config, cleanup := cltest.NewConfig(t)
+	defer cleanup()
+	config.Set("ETH_TX_MULTICALL_ADDRESS", multicallAddress.Hex())
+	config.Set("ETH_TX_MULTICALL_WINDOW", "1m")
+
+	ethClient := new(mocks.Client)
+
+	eb, cleanup := cltest.NewEthBroadcaster(t, db, ethClient, ethKeyStore, config, key)
+	defer cleanup()
+
+	toAddress := gethCommon.HexToAddress("0x6C03DDA95a2AEd917EeCc6eddD4b9D16E6380411")
+	newBatchableEthTx := func(payload []byte, createdAt time.Time) bulletprooftxmanager.EthTx {
+		etx := bulletprooftxmanager.EthTx{
+			FromAddress:    fromAddress,
+			ToAddress:      toAddress,
+			EncodedPayload: payload,
+			Value:          assets.NewEthValue(0),
+			GasLimit:       100000,
+			CreatedAt:      createdAt,
+			State:          bulletprooftxmanager.EthTxUnstarted,
+			Batchable:      true,
+		}
+		require.NoError(t, db.Save(&etx).Error)
+		return etx
+	}
+
+	// Three calls of the same method which have waited for the window, and
+	// one of another method which has not
+	var batched []bulletprooftxmanager.EthTx
+	var calls []bulletprooftxmanager.MulticallCall
+	for i := 0; i < 3; i++ {
+		payload := []byte{0xde, 0xad, 0xbe, 0xef, byte(i)}
+		batched = append(batched, newBatchableEthTx(payload, time.Unix(int64(i), 0)))
+		calls = append(calls, bulletprooftxmanager.MulticallCall{Target: toAddress, Data: payload})
+	}
+	held := newBatchableEthTx([]byte{0xca, 0xfe, 0xba, 0xbe}, time.Now())
+
+	expectedPayload, err := bulletprooftxmanager.EncodeMulticallPayload(calls)
+	require.NoError(t, err)
+
+	ethClient.On("SendTransaction", mock.Anything, mock.MatchedBy(func(tx *gethTypes.Transaction) bool {
+		return tx.Nonce() == uint64(0) && *tx.To() == multicallAddress && tx.Value().Sign() == 0 && assert.Equal(t, expectedPayload, tx.Data())
+	})).Return(nil).Once()
+
+	require.NoError(t, eb.ProcessUnstartedEthTxs(key))
+
+	var batchID int64
+	for i, etx := range batched {
+		etx, err := cltest.FindEthTxWithAttempts(db, etx.ID)
+		require.NoError(t, err)
+		assert.Equal(t, bulletprooftxmanager.EthTxBatched, etx.State)
+		assert.Nil(t, etx.Nonce)
+		assert.Len(t, etx.EthTxAttempts, 0)
+		require.True(t, etx.BatchEthTxID.Valid)
+		assert.Equal(t, int64(i), etx.BatchIndex.Int64)
+		batchID = etx.BatchEthTxID.Int64
+	}
+
+	batch, err := cltest.FindEthTxWithAttempts(db, batchID)
+	require.NoError(t, err)
+	assert.Equal(t, bulletprooftxmanager.EthTxUnconfirmed, batch.State)
+	assert.Equal(t, multicallAddress, batch.ToAddress)
+	assert.Equal(t, time.Unix(0, 0).Unix(), batch.CreatedAt.Unix())
+	assert.False(t, batch.Batchable)
+	require.Len(t, batch.EthTxAttempts, 1)
+
+	held, err = cltest.FindEthTxWithAttempts(db, held.ID)
+	require.NoError(t, err)
+	assert.Equal(t, bulletprooftxmanager.EthTxUnstarted, held.State)
+
+	ethClient.AssertExpectations(t)
+}
+
 func TestEthBroadcaster_AssignsNonceOnStart(t *testing.T) {
 	var err error
 	db := pgtest.NewGormDB(t)
@@ -706,7 +894,7 @@ func TestEthBroadcaster_ProcessUnstartedEthTxs_ResumingFromCrash(t *testing.T) {
 }
 
 func getLocalNextNonce(t *testing.T, str *store.Store, fromAddress gethCommon.Address) uint64 {
-	n, err := bulletprooftxmanager.GetNextNonce(str.DB, fromAddress)
+	n, err := bulletprooftxmanager.GetNextNonce(str.DB, nil, fromAddress)
 	require.NoError(t, err)
 	require.NotNil(t, n)
 	return uint64(n)
@@ -778,7 +966,7 @@ func TestEthBroadcaster_ProcessUnstartedEthTxs_Errors(t *testing.T) {
 
 		// Check that the local nonce was incremented by one
 		var finalNextNonce int64
-		finalNextNonce, err = bulletprooftxmanager.GetNextNonce(db, fromAddress)
+		finalNextNonce, err = bulletprooftxmanager.GetNextNonce(db, nil, fromAddress)
 		require.NoError(t, err)
 		require.NotNil(t, finalNextNonce)
 		require.Equal(t, int64(1), finalNextNonce)
@@ -1148,7 +1336,7 @@ func TestEthBroadcaster_ProcessUnstartedEthTxs_KeystoreErrors(t *testing.T) {
 		require.NoError(t, db.Save(&etx).Error)
 
 		tx := *gethTypes.NewTx(&gethTypes.LegacyTx{})
-		kst.On("SignTx",
+		kst.On("SignTx", mock.Anything,
 			fromAddress,
 			mock.AnythingOfType("*types.Transaction"),
 			mock.MatchedBy(func(chainID *big.Int) bool {
@@ -1209,7 +1397,7 @@ func TestEthBroadcaster_GetNextNonce(t *testing.T) {
 	ethKeyStore := cltest.NewKeyStore(t, db).Eth()
 	key, _ := cltest.MustAddRandomKeyToKeystore(t, ethKeyStore, 0)
 
-	nonce, err := bulletprooftxmanager.GetNextNonce(db, key.Address.Address())
+	nonce, err := bulletprooftxmanager.GetNextNonce(db, nil, key.Address.Address())
 	assert.NoError(t, err)
 	require.NotNil(t, nonce)
 	assert.Equal(t, int64(0), nonce)
@@ -1222,9 +1410,9 @@ func TestEthBroadcaster_IncrementNextNonce(t *testing.T) {
 	key, _ := cltest.MustAddRandomKeyToKeystore(t, ethKeyStore, 0)
 
 	// Cannot increment if supplied nonce doesn't match existing
-	require.Error(t, bulletprooftxmanager.IncrementNextNonce(db, key.Address.Address(), int64(42)))
+	require.Error(t, bulletprooftxmanager.IncrementNextNonce(db, nil, key.Address.Address(), int64(42)))
 
-	require.NoError(t, bulletprooftxmanager.IncrementNextNonce(db, key.Address.Address(), int64(0)))
+	require.NoError(t, bulletprooftxmanager.IncrementNextNonce(db, nil, key.Address.Address(), int64(0)))
 
 	// Nonce bumped to 1
 	require.NoError(t, db.First(&key).Error)
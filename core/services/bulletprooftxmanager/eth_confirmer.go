@@ -8,6 +8,7 @@ import (
 	"sort"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/smartcontractkit/chainlink/core/logger"
@@ -43,7 +44,7 @@ var (
 // EthConfirmer is a broad service which performs four different tasks in sequence on every new longest chain
 // Step 1: Mark that all currently pending transaction attempts were broadcast before this block
 // Step 2: Check pending transactions for receipts
-// Step 3: See if any transactions have exceeded the gas bumping block threshold and, if so, bump them
+// Step 3: Cancel any expired transactions, then see if any transactions have exceeded the gas bumping block threshold and, if so, bump them
 // Step 4: Check confirmed transactions to make sure they are still in the longest chain (reorg protection)
 
 type EthConfirmer struct {
@@ -58,10 +59,20 @@ type EthConfirmer struct {
 
 	keys []ethkey.Key
 
+	evmChainID *utils.Big
+
 	mb        *utils.Mailbox
 	ctx       context.Context
 	ctxCancel context.CancelFunc
 	wg        sync.WaitGroup
+
+	// latestFinalizedBlockNum is the highest finalized block reported by the
+	// head tracker, or 0 if unknown
+	latestFinalizedBlockNum int64
+
+	// callbacks are called once their eth_txes are final, see
+	// RegisterConfirmationCallback
+	callbacks *confirmationCallbacks
 }
 
 // NewEthConfirmer instantiates a new eth confirmer
@@ -76,13 +87,23 @@ func NewEthConfirmer(db *gorm.DB, ethClient eth.Client, config Config, keystore
 		advisoryLocker,
 		estimator,
 		keys,
+		nil,
 		utils.NewMailbox(1),
 		context,
 		cancel,
 		sync.WaitGroup{},
+		0,
+		nil,
 	}
 }
 
+// SetLatestFinalizedBlockNum sets the highest finalized block number. When
+// set, it is used instead of ETH_FINALITY_DEPTH to decide when transactions
+// missing receipts can be given up on.
+func (ec *EthConfirmer) SetLatestFinalizedBlockNum(n int64) {
+	atomic.StoreInt64(&ec.latestFinalizedBlockNum, n)
+}
+
 func (ec *EthConfirmer) Start() error {
 	return ec.StartOnce("EthConfirmer", func() error {
 		if ec.config.EthGasBumpThreshold() == 0 {
@@ -171,11 +192,42 @@ func (ec *EthConfirmer) processHead(ctx context.Context, head models.Head) error
 	logger.Debugw("EthConfirmer: finished RebroadcastWhereNecessary", "headNum", head.Number, "time", time.Since(mark), "id", "eth_confirmer")
 	mark = time.Now()
 
+	ec.recordQueueMetrics()
+
 	defer func() {
 		logger.Debugw("EthConfirmer: finished EnsureConfirmedTransactionsInLongestChain", "headNum", head.Number, "time", time.Since(mark), "id", "eth_confirmer")
 	}()
 
-	return errors.Wrap(ec.EnsureConfirmedTransactionsInLongestChain(ctx, head), "EnsureConfirmedTransactionsInLongestChain failed")
+	if err := ec.EnsureConfirmedTransactionsInLongestChain(ctx, head); err != nil {
+		return errors.Wrap(err, "EnsureConfirmedTransactionsInLongestChain failed")
+	}
+
+	if ec.callbacks == nil {
+		return nil
+	}
+	return errors.Wrap(ec.callbacks.process(ec.db, head.Number), "processing confirmation callbacks failed")
+}
+
+// recordQueueMetrics updates the queue depth, in-flight and oldest
+// unconfirmed age gauges of each sending key. Failures are only logged, since
+// they do not affect the processing of transactions.
+func (ec *EthConfirmer) recordQueueMetrics() {
+	for _, key := range ec.keys {
+		address := key.Address.Address()
+		stats, err := GetEthTxQueueStats(ec.db, ec.evmChainID, address)
+		if err != nil {
+			logger.Warnw("EthConfirmer: failed to record transaction queue metrics", "err", err, "address", address)
+			continue
+		}
+		labels := []string{ec.config.ChainID().String(), address.Hex()}
+		promQueueDepth.WithLabelValues(labels...).Set(float64(stats.Unstarted))
+		promInFlightTransactions.WithLabelValues(labels...).Set(float64(stats.Unconfirmed))
+		var age time.Duration
+		if stats.OldestUnconfirmedAt.Valid {
+			age = time.Since(stats.OldestUnconfirmedAt.Time)
+		}
+		promOldestUnconfirmedAge.WithLabelValues(labels...).Set(age.Seconds())
+	}
 }
 
 // SetBroadcastBeforeBlockNum updates already broadcast attempts with the
@@ -183,8 +235,9 @@ func (ec *EthConfirmer) processHead(ctx context.Context, head models.Head) error
 // the attempt is already broadcast it _must_ have been before this head.
 func (ec *EthConfirmer) SetBroadcastBeforeBlockNum(blockNum int64) error {
 	return ec.db.Exec(
-		`UPDATE eth_tx_attempts SET broadcast_before_block_num = ? WHERE broadcast_before_block_num IS NULL AND state = 'broadcast'`,
-		blockNum,
+		`UPDATE eth_tx_attempts SET broadcast_before_block_num = ? WHERE broadcast_before_block_num IS NULL AND state = 'broadcast'
+		AND eth_tx_id IN (SELECT id FROM eth_txes WHERE evm_chain_id IS NOT DISTINCT FROM ?)`,
+		blockNum, ec.evmChainID,
 	).Error
 }
 
@@ -301,7 +354,7 @@ func (ec *EthConfirmer) findEthTxAttemptsRequiringReceiptFetch() (attempts []Eth
 		Joins("EthTx"). // Joins("EthTx") is needed for the query to actually return data from eth_txes table as well.
 		Joins("JOIN eth_txes ON eth_txes.id = eth_tx_attempts.eth_tx_id AND eth_txes.state IN ('unconfirmed', 'confirmed_missing_receipt')").
 		Order("eth_txes.nonce ASC, eth_tx_attempts.gas_price DESC").
-		Where("eth_tx_attempts.state != 'insufficient_eth'").
+		Where("eth_tx_attempts.state != 'insufficient_eth' AND eth_txes.evm_chain_id IS NOT DISTINCT FROM ?", ec.evmChainID).
 		Find(&attempts).Error
 
 	return
@@ -312,7 +365,9 @@ func (ec *EthConfirmer) getNonceForLatestBlock(ctx context.Context, from gethCom
 }
 
 // Note this function will increment promRevertedTxCount upon receiving
-// a reverted transaction receipt. Should only be called with unconfirmed attempts.
+// a reverted transaction receipt, and promBatchedTransactionsReverted upon
+// receiving a multicall receipt with reverted calls. Should only be called
+// with unconfirmed attempts.
 func (ec *EthConfirmer) batchFetchReceipts(ctx context.Context, attempts []EthTxAttempt) (receipts []Receipt, err error) {
 	var reqs []rpc.BatchElem
 	for _, attempt := range attempts {
@@ -386,6 +441,11 @@ func (ec *EthConfirmer) batchFetchReceipts(ctx context.Context, attempts []EthTx
 			// This is safe to increment here because we save the receipt immediately after
 			// and once its saved we do not fetch it again.
 			promRevertedTxCount.Add(1)
+		} else if multicall := ec.config.EthTxMulticallAddress(); multicall != nil && attempt.EthTx.ToAddress == *multicall {
+			if n := countRevertedCalls(*receipt, *multicall); n > 0 {
+				l.Warnf("%d calls of multicall batch %s reverted on-chain", n, receipt.TxHash)
+				promBatchedTransactionsReverted.WithLabelValues(ec.config.ChainID().String()).Add(float64(n))
+			}
 		}
 
 		receipts = append(receipts, *receipt)
@@ -433,19 +493,20 @@ func (ec *EthConfirmer) saveFetchedReceipts(receipts []Receipt) (err error) {
 		if err != nil {
 			return errors.Wrap(err, "saveFetchedReceipts failed to marshal JSON")
 		}
-		valueStrs = append(valueStrs, "(?,?,?,?,?,NOW())")
-		valueArgs = append(valueArgs, r.TxHash, r.BlockHash, r.BlockNumber.Int64(), r.TransactionIndex, receiptJSON)
+		valueStrs = append(valueStrs, "(?,?,?,?,?,?,NOW())")
+		valueArgs = append(valueArgs, r.TxHash, r.BlockHash, r.BlockNumber.Int64(), r.TransactionIndex, receiptJSON, r.GasUsed)
 	}
 
 	/* #nosec G201 */
 	sql := `
 	WITH inserted_receipts AS (
-		INSERT INTO eth_receipts (tx_hash, block_hash, block_number, transaction_index, receipt, created_at)
+		INSERT INTO eth_receipts (tx_hash, block_hash, block_number, transaction_index, receipt, gas_used, created_at)
 		VALUES %s
 		ON CONFLICT (tx_hash, block_hash) DO UPDATE SET
 			block_number = EXCLUDED.block_number,
 			transaction_index = EXCLUDED.transaction_index,
-			receipt = EXCLUDED.receipt
+			receipt = EXCLUDED.receipt,
+			gas_used = EXCLUDED.gas_used
 		RETURNING eth_receipts.tx_hash, eth_receipts.block_number
 	),
 	updated_eth_tx_attempts AS (
@@ -499,12 +560,12 @@ func (ec *EthConfirmer) markConfirmedMissingReceipt() (err error) {
 	res := ec.db.WithContext(ctx).Exec(`
 UPDATE eth_txes
 SET state = 'confirmed_missing_receipt'
-WHERE state = 'unconfirmed'
+WHERE state = 'unconfirmed' AND evm_chain_id IS NOT DISTINCT FROM ?
 AND nonce < (
 	SELECT MAX(nonce) FROM eth_txes
-	WHERE state = 'confirmed'
+	WHERE state = 'confirmed' AND evm_chain_id IS NOT DISTINCT FROM ?
 )
-	`)
+	`, ec.evmChainID, ec.evmChainID)
 	if res.Error != nil {
 		return res.Error
 	}
@@ -526,6 +587,11 @@ func (ec *EthConfirmer) markOldTxesMissingReceiptAsErrored(blockNum int64) error
 	// Any 'confirmed_missing_receipt' eth_tx with all attempts older than this block height will be marked as errored
 	// We will not try to query for receipts for this transaction any more
 	cutoff := blockNum - int64(ec.config.EthFinalityDepth())
+	if finalized := atomic.LoadInt64(&ec.latestFinalizedBlockNum); finalized > 0 && finalized <= blockNum {
+		// Anything broadcast before the finalized block and still missing a
+		// receipt will never be mined
+		cutoff = finalized - 1
+	}
 	if cutoff <= 0 {
 		return nil
 	}
@@ -544,14 +610,14 @@ FROM (
 	SELECT e1.id, e1.nonce, e1.from_address FROM eth_txes AS e1 WHERE id IN (
 		SELECT e2.id FROM eth_txes AS e2
 		INNER JOIN eth_tx_attempts ON e2.id = eth_tx_attempts.eth_tx_id
-		WHERE e2.state = 'confirmed_missing_receipt'
+		WHERE e2.state = 'confirmed_missing_receipt' AND e2.evm_chain_id IS NOT DISTINCT FROM $3
 		GROUP BY e2.id
 		HAVING max(eth_tx_attempts.broadcast_before_block_num) < $2
 	)
 	FOR UPDATE OF e1
 ) e0
 WHERE e0.id = eth_txes.id
-RETURNING e0.id, e0.nonce, e0.from_address`, ErrCouldNotGetReceipt, cutoff)
+RETURNING e0.id, e0.nonce, e0.from_address`, ErrCouldNotGetReceipt, cutoff, ec.evmChainID)
 
 	if err != nil {
 		return errors.Wrap(err, "markOldTxesMissingReceiptAsErrored failed to query")
@@ -609,10 +675,14 @@ func (ec *EthConfirmer) rebroadcastWhereNecessary(ctx context.Context, address g
 		return errors.Wrap(err, "handleAnyInProgressAttempts failed")
 	}
 
-	threshold := int64(ec.config.EthGasBumpThreshold())
+	if err := ec.cancelExpiredEthTxs(ctx, address, blockHeight); err != nil {
+		return errors.Wrap(err, "cancelExpiredEthTxs failed")
+	}
+
+	thresholds := NewGasBumpThresholds(ec.config)
 	bumpDepth := int64(ec.config.EthGasBumpTxDepth())
-	maxInFlightTransactions := ec.config.EthMaxInFlightTransactions()
-	etxs, err := FindEthTxsRequiringRebroadcast(ec.db, address, blockHeight, threshold, bumpDepth, maxInFlightTransactions)
+	maxInFlightTransactions := ec.config.EthMaxInFlightTransactionsForKey(address)
+	etxs, err := FindEthTxsRequiringRebroadcast(ec.db, ec.evmChainID, address, blockHeight, thresholds, bumpDepth, maxInFlightTransactions)
 	if err != nil {
 		return errors.Wrap(err, "FindEthTxsRequiringRebroadcast failed")
 	}
@@ -635,13 +705,72 @@ func (ec *EthConfirmer) rebroadcastWhereNecessary(ctx context.Context, address g
 	return nil
 }
 
+// cancelExpiredEthTxs errors any unstarted transactions which have expired,
+// and replaces unconfirmed ones with a zero-value send to self at a bumped gas
+// price. Once cancelled, further gas bumps also send to self.
+func (ec *EthConfirmer) cancelExpiredEthTxs(ctx context.Context, address gethCommon.Address, blockHeight int64) error {
+	expired, err := expireUnstartedEthTxs(ec.db, ec.evmChainID, address, blockHeight)
+	if err != nil {
+		return err
+	}
+	if expired > 0 {
+		logger.Warnw(fmt.Sprintf("EthConfirmer: %d transactions expired before they were broadcast", expired), "blockNum", blockHeight, "address", address)
+	}
+
+	etxs, err := FindEthTxsRequiringCancellation(ec.db, ec.evmChainID, address, blockHeight)
+	if err != nil {
+		return err
+	}
+	for _, etx := range etxs {
+		etx.CancelledAt.SetValid(time.Now())
+		attempt, err := ec.attemptForCancellation(etx)
+		if err != nil {
+			logger.Errorw("EthConfirmer: could not cancel transaction, it will be retried on the next head", "ethTxID", etx.ID, "nonce", etx.Nonce, "err", err)
+			continue
+		}
+
+		logger.Infow("EthConfirmer: Cancelling expired transaction", "ethTxID", etx.ID, "nonce", etx.Nonce, "expiresAt", etx.ExpiresAt, "expiresAtBlock", etx.ExpiresAtBlock, "gasPrice", attempt.GasPrice)
+
+		err = postgres.GormTransactionWithDefaultContext(ec.db, func(tx *gorm.DB) error {
+			if err := tx.Exec(`UPDATE eth_txes SET cancelled_at = ? WHERE id = ?`, etx.CancelledAt, etx.ID).Error; err != nil {
+				return errors.Wrap(err, "failed to mark eth_tx as cancelled")
+			}
+			return errors.Wrap(tx.Create(&attempt).Error, "failed to save cancellation attempt")
+		})
+		if err != nil {
+			return err
+		}
+
+		if err := ec.handleInProgressAttempt(ctx, etx, attempt, blockHeight); err != nil {
+			return errors.Wrap(err, "handleInProgressAttempt failed")
+		}
+	}
+	return nil
+}
+
+// attemptForCancellation returns a zero-value send to self replacing etx. The
+// gas price is bumped as for a high urgency transaction, since the
+// replacement must be accepted for the cancellation to have any effect.
+func (ec *EthConfirmer) attemptForCancellation(etx EthTx) (attempt EthTxAttempt, err error) {
+	replacement := etx
+	replacement.Urgency = EthTxUrgencyHigh
+	gasPrice, gasLimit := ec.config.EthGasPriceDefault(), etx.GasLimit
+	if len(etx.EthTxAttempts) > 0 {
+		gasPrice, gasLimit, err = bumpGas(ec.config, ec.estimator, replacement, etx.EthTxAttempts[0].GasPrice.ToInt())
+		if err != nil {
+			return attempt, errors.Wrap(err, "failed to bump gas for cancellation")
+		}
+	}
+	return newAttempt(ec.ethClient, ec.keystore, ec.config.ChainID(), replacement, gasPrice, gasLimit)
+}
+
 // "in_progress" attempts were left behind after a crash/restart and may or may not have been sent.
 // We should try to ensure they get on-chain so we can fetch a receipt for them.
 // NOTE: We also use this to mark attempts for rebroadcast in event of a
 // re-org, so multiple attempts are allowed to be in in_progress state (but
 // only one per eth_tx).
 func (ec *EthConfirmer) handleAnyInProgressAttempts(ctx context.Context, address gethCommon.Address, blockHeight int64) error {
-	attempts, err := getInProgressEthTxAttempts(ec.db, address)
+	attempts, err := getInProgressEthTxAttempts(ec.db, ec.evmChainID, address)
 	if err != nil {
 		return errors.Wrap(err, "getInProgressEthTxAttempts failed")
 	}
@@ -656,7 +785,7 @@ func (ec *EthConfirmer) handleAnyInProgressAttempts(ctx context.Context, address
 	return nil
 }
 
-func getInProgressEthTxAttempts(db *gorm.DB, address gethCommon.Address) ([]EthTxAttempt, error) {
+func getInProgressEthTxAttempts(db *gorm.DB, evmChainID *utils.Big, address gethCommon.Address) ([]EthTxAttempt, error) {
 	ctx, cancel := postgres.DefaultQueryCtx()
 	defer cancel()
 
@@ -666,17 +795,17 @@ func getInProgressEthTxAttempts(db *gorm.DB, address gethCommon.Address) ([]EthT
 		Preload("EthTx").
 		Joins("INNER JOIN eth_txes ON eth_txes.id = eth_tx_attempts.eth_tx_id AND eth_txes.state in ('confirmed', 'confirmed_missing_receipt', 'unconfirmed')").
 		Where("eth_tx_attempts.state = 'in_progress'").
-		Where("eth_txes.from_address = ?", address).
+		Where("eth_txes.evm_chain_id IS NOT DISTINCT FROM ? AND eth_txes.from_address = ?", evmChainID, address).
 		Find(&attempts).Error
 	return attempts, errors.Wrap(err, "getInProgressEthTxAttempts failed")
 }
 
 // FindEthTxsRequiringRebroadcast returns attempts that hit insufficient eth,
 // and attempts that need bumping, in nonce ASC order
-func FindEthTxsRequiringRebroadcast(db *gorm.DB, address gethCommon.Address, blockNum, gasBumpThreshold, bumpDepth int64, maxInFlightTransactions uint32) (etxs []EthTx, err error) {
+func FindEthTxsRequiringRebroadcast(db *gorm.DB, evmChainID *utils.Big, address gethCommon.Address, blockNum int64, gasBumpThresholds GasBumpThresholds, bumpDepth int64, maxInFlightTransactions uint32) (etxs []EthTx, err error) {
 	// NOTE: These two queries could be combined into one using union but it
 	// becomes harder to read and difficult to test in isolation. KISS principle
-	etxInsufficientEths, err := FindEthTxsRequiringResubmissionDueToInsufficientEth(db, address)
+	etxInsufficientEths, err := FindEthTxsRequiringResubmissionDueToInsufficientEth(db, evmChainID, address)
 	if err != nil {
 		return nil, err
 	}
@@ -685,7 +814,7 @@ func FindEthTxsRequiringRebroadcast(db *gorm.DB, address gethCommon.Address, blo
 		logger.Infow(fmt.Sprintf("EthConfirmer: Found %d transactions to be re-sent that were previously rejected due to insufficient eth balance", len(etxInsufficientEths)), "blockNum", blockNum, "address", address)
 	}
 
-	etxBumps, err := FindEthTxsRequiringGasBump(db, address, blockNum, gasBumpThreshold, bumpDepth)
+	etxBumps, err := FindEthTxsRequiringGasBump(db, evmChainID, address, blockNum, gasBumpThresholds, bumpDepth)
 	if err != nil {
 		return nil, err
 	}
@@ -703,7 +832,7 @@ func FindEthTxsRequiringRebroadcast(db *gorm.DB, address gethCommon.Address, blo
 		} else {
 			logger.Warnw("EthConfirmer: expected eth_tx for gas bump to have at least one attempt", "etxID", etx.ID, "blockNum", blockNum, "address", address)
 		}
-		logger.Infow(fmt.Sprintf("EthConfirmer: Found %d transactions to re-sent that have still not been confirmed after at least %d blocks. The oldest of these has not still not been confirmed after %d blocks. These transactions will have their gas price bumped. %s", len(etxBumps), gasBumpThreshold, oldestBlocksBehind, static.EthNodeConnectivityProblemLabel), "blockNum", blockNum, "address", address, "gasBumpThreshold", gasBumpThreshold)
+		logger.Infow(fmt.Sprintf("EthConfirmer: Found %d transactions to re-sent that have still not been confirmed after at least %d blocks. The oldest of these has not still not been confirmed after %d blocks. These transactions will have their gas price bumped. %s", len(etxBumps), gasBumpThresholds.High, oldestBlocksBehind, static.EthNodeConnectivityProblemLabel), "blockNum", blockNum, "address", address, "gasBumpThresholds", gasBumpThresholds)
 	}
 
 	seen := make(map[int64]struct{})
@@ -733,13 +862,13 @@ func FindEthTxsRequiringRebroadcast(db *gorm.DB, address gethCommon.Address, blo
 // FindEthTxsRequiringResubmissionDueToInsufficientEth returns transactions
 // that need to be re-sent because they hit an out-of-eth error on a previous
 // block
-func FindEthTxsRequiringResubmissionDueToInsufficientEth(db *gorm.DB, address gethCommon.Address) (etxs []EthTx, err error) {
+func FindEthTxsRequiringResubmissionDueToInsufficientEth(db *gorm.DB, evmChainID *utils.Big, address gethCommon.Address) (etxs []EthTx, err error) {
 	err = db.
 		Preload("EthTxAttempts", func(db *gorm.DB) *gorm.DB {
 			return db.Order("eth_tx_attempts.gas_price DESC")
 		}).
 		Joins("INNER JOIN eth_tx_attempts ON eth_txes.id = eth_tx_attempts.eth_tx_id AND eth_tx_attempts.state = 'insufficient_eth'").
-		Where("eth_txes.from_address = ? AND eth_txes.state = 'unconfirmed'", address).
+		Where("eth_txes.evm_chain_id IS NOT DISTINCT FROM ? AND eth_txes.from_address = ? AND eth_txes.state = 'unconfirmed'", evmChainID, address).
 		Order("nonce ASC").
 		Find(&etxs).Error
 
@@ -750,12 +879,12 @@ func FindEthTxsRequiringResubmissionDueToInsufficientEth(db *gorm.DB, address ge
 }
 
 // FindEthTxsRequiringGasBump returns transactions that have all
-// attempts which are unconfirmed for at least gasBumpThreshold blocks,
-// limited by limit pending transactions
+// attempts which are unconfirmed for at least the gas bump threshold of their
+// urgency in blocks, limited by limit pending transactions
 //
 // It also returns eth_txes that are unconfirmed with no eth_tx_attempts
-func FindEthTxsRequiringGasBump(db *gorm.DB, address gethCommon.Address, blockNum, gasBumpThreshold, depth int64) (etxs []EthTx, err error) {
-	if gasBumpThreshold == 0 {
+func FindEthTxsRequiringGasBump(db *gorm.DB, evmChainID *utils.Big, address gethCommon.Address, blockNum int64, gasBumpThresholds GasBumpThresholds, depth int64) (etxs []EthTx, err error) {
+	if gasBumpThresholds.Normal == 0 {
 		return
 	}
 	q := db.
@@ -763,11 +892,13 @@ func FindEthTxsRequiringGasBump(db *gorm.DB, address gethCommon.Address, blockNu
 			return db.Order("eth_tx_attempts.gas_price DESC")
 		}).
 		Joins("LEFT JOIN eth_tx_attempts ON eth_txes.id = eth_tx_attempts.eth_tx_id "+
-			"AND (broadcast_before_block_num > ? OR broadcast_before_block_num IS NULL OR eth_tx_attempts.state != 'broadcast')", blockNum-gasBumpThreshold).
-		Where("eth_txes.state = 'unconfirmed' AND eth_tx_attempts.id IS NULL AND eth_txes.from_address = ?", address)
+			"AND (broadcast_before_block_num > CASE eth_txes.urgency WHEN 'low' THEN ? WHEN 'high' THEN ? ELSE ? END "+
+			"OR broadcast_before_block_num IS NULL OR eth_tx_attempts.state != 'broadcast')",
+			blockNum-gasBumpThresholds.Low, blockNum-gasBumpThresholds.High, blockNum-gasBumpThresholds.Normal).
+		Where("eth_txes.state = 'unconfirmed' AND eth_tx_attempts.id IS NULL AND eth_txes.evm_chain_id IS NOT DISTINCT FROM ? AND eth_txes.from_address = ?", evmChainID, address)
 
 	if depth > 0 {
-		q = q.Where("eth_txes.id IN (SELECT id FROM eth_txes WHERE state = 'unconfirmed' AND from_address = ? ORDER BY nonce ASC LIMIT ?)", address, depth)
+		q = q.Where("eth_txes.id IN (SELECT id FROM eth_txes WHERE state = 'unconfirmed' AND evm_chain_id IS NOT DISTINCT FROM ? AND from_address = ? ORDER BY nonce ASC LIMIT ?)", evmChainID, address, depth)
 	}
 
 	err = q.Order("nonce ASC").Find(&etxs).Error
@@ -789,14 +920,15 @@ func (ec *EthConfirmer) attemptForRebroadcast(ctx context.Context, etx EthTx) (a
 			// TODO: Handle optimism case here
 			return previousAttempt, nil
 		}
-		bumpedGasPrice, bumpedGasLimit, err = ec.estimator.BumpGas(previousAttempt.GasPrice.ToInt(), etx.GasLimit)
+		bumpedGasPrice, bumpedGasLimit, err = bumpGas(ec.config, ec.estimator, etx, previousAttempt.GasPrice.ToInt())
 		logFields := []interface{}{
 			"etxID", etx.ID,
 			"txHash", attempt.Hash,
 			"originalGasPrice", previousAttempt.GasPrice.String(),
 			"gasLimit", etx.GasLimit,
 			"originalChainSpecificGasLimit", previousAttempt.ChainSpecificGasLimit,
-			"maxGasPrice", ec.config.EthMaxGasPriceWei(),
+			"maxGasPrice", maxGasPrice(ec.config, etx),
+			"urgency", etx.Urgency,
 			"nonce", etx.Nonce,
 			"previousTxHash", previousAttempt.Hash,
 			"previousAttemptID", previousAttempt.ID,
@@ -840,7 +972,7 @@ func (ec *EthConfirmer) handleInProgressAttempt(ctx context.Context, etx EthTx,
 		// already bumped above the required minimum in ethBroadcaster.
 		//
 		// It could conceivably happen if the remote eth node changed its configuration.
-		bumpedGasPrice, bumpedGasLimit, err := ec.estimator.BumpGas(attempt.GasPrice.ToInt(), etx.GasLimit)
+		bumpedGasPrice, bumpedGasLimit, err := bumpGas(ec.config, ec.estimator, etx, attempt.GasPrice.ToInt())
 		if err != nil {
 			return errors.Wrap(err, "could not bump gas for terminally underpriced transaction")
 		}
@@ -1009,7 +1141,7 @@ func saveInsufficientEthAttempt(db *gorm.DB, attempt *EthTxAttempt, broadcastAt
 // If any of the confirmed transactions does not have a receipt in the chain, it has been
 // re-org'd out and will be rebroadcast.
 func (ec *EthConfirmer) EnsureConfirmedTransactionsInLongestChain(ctx context.Context, head models.Head) error {
-	etxs, err := findTransactionsConfirmedInBlockRange(ec.db, head.Number, head.EarliestInChain().Number)
+	etxs, err := findTransactionsConfirmedInBlockRange(ec.db, ec.evmChainID, head.Number, head.EarliestInChain().Number)
 	if err != nil {
 		return errors.Wrap(err, "findTransactionsConfirmedInBlockRange failed")
 	}
@@ -1046,7 +1178,7 @@ func (ec *EthConfirmer) EnsureConfirmedTransactionsInLongestChain(ctx context.Co
 	return multierr.Combine(errors...)
 }
 
-func findTransactionsConfirmedInBlockRange(db *gorm.DB, highBlockNumber, lowBlockNumber int64) ([]EthTx, error) {
+func findTransactionsConfirmedInBlockRange(db *gorm.DB, evmChainID *utils.Big, highBlockNumber, lowBlockNumber int64) ([]EthTx, error) {
 	var etxs []EthTx
 	err := db.
 		Preload("EthTxAttempts", func(db *gorm.DB) *gorm.DB {
@@ -1056,7 +1188,7 @@ func findTransactionsConfirmedInBlockRange(db *gorm.DB, highBlockNumber, lowBloc
 		Joins("INNER JOIN eth_tx_attempts ON eth_txes.id = eth_tx_attempts.eth_tx_id AND eth_tx_attempts.state = 'broadcast'").
 		Joins("INNER JOIN eth_receipts ON eth_receipts.tx_hash = eth_tx_attempts.hash").
 		Order("nonce ASC").
-		Where("eth_txes.state IN ('confirmed', 'confirmed_missing_receipt') AND block_number BETWEEN ? AND ?", lowBlockNumber, highBlockNumber).
+		Where("eth_txes.state IN ('confirmed', 'confirmed_missing_receipt') AND eth_txes.evm_chain_id IS NOT DISTINCT FROM ? AND block_number BETWEEN ? AND ?", evmChainID, lowBlockNumber, highBlockNumber).
 		Find(&etxs).Error
 	return etxs, errors.Wrap(err, "findTransactionsConfirmedInBlockRange failed")
 }
@@ -1150,7 +1282,7 @@ func (ec *EthConfirmer) ForceRebroadcast(beginningNonce uint, endingNonce uint,
 	logger.Infof("ForceRebroadcast: will rebroadcast transactions for all nonces between %v and %v", beginningNonce, endingNonce)
 
 	for n := beginningNonce; n <= endingNonce; n++ {
-		etx, err := findEthTxWithNonce(ec.db, address, n)
+		etx, err := findEthTxWithNonce(ec.db, ec.evmChainID, address, n)
 		if err != nil {
 			return errors.Wrap(err, "ForceRebroadcast failed")
 		}
@@ -1195,13 +1327,13 @@ func (ec *EthConfirmer) sendEmptyTransaction(ctx context.Context, fromAddress ge
 }
 
 // findEthTxWithNonce returns any broadcast ethtx with the given nonce
-func findEthTxWithNonce(db *gorm.DB, fromAddress gethCommon.Address, nonce uint) (*EthTx, error) {
+func findEthTxWithNonce(db *gorm.DB, evmChainID *utils.Big, fromAddress gethCommon.Address, nonce uint) (*EthTx, error) {
 	etx := EthTx{}
 	err := db.
 		Preload("EthTxAttempts", func(db *gorm.DB) *gorm.DB {
 			return db.Order("eth_tx_attempts.gas_price DESC")
 		}).
-		First(&etx, "from_address = ? AND nonce = ? AND state IN ('confirmed', 'confirmed_missing_receipt', 'unconfirmed')", fromAddress, nonce).
+		First(&etx, "evm_chain_id IS NOT DISTINCT FROM ? AND from_address = ? AND nonce = ? AND state IN ('confirmed', 'confirmed_missing_receipt', 'unconfirmed')", evmChainID, fromAddress, nonce).
 		Error
 	if errors.Is(err, gorm.ErrRecordNotFound) {
 		return nil, nil
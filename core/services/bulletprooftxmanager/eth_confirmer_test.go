@@ -940,7 +940,7 @@ func TestEthConfirmer_FindEthTxsRequiringResubmissionDueToInsufficientEth(t *tes
 	cltest.MustInsertUnconfirmedEthTxWithInsufficientEthAttempt(t, db, 0, otherAddress)
 
 	t.Run("returns all eth_txes with at least one attempt that is in insufficient_eth state", func(t *testing.T) {
-		etxs, err := bulletprooftxmanager.FindEthTxsRequiringResubmissionDueToInsufficientEth(store.DB, fromAddress)
+		etxs, err := bulletprooftxmanager.FindEthTxsRequiringResubmissionDueToInsufficientEth(store.DB, nil, fromAddress)
 		require.NoError(t, err)
 
 		assert.Len(t, etxs, 3)
@@ -957,7 +957,7 @@ func TestEthConfirmer_FindEthTxsRequiringResubmissionDueToInsufficientEth(t *tes
 		require.NoError(t, store.DB.Exec(`UPDATE eth_txes SET state='confirmed' WHERE id = ?`, etx1.ID).Error)
 		require.NoError(t, store.DB.Exec(`UPDATE eth_txes SET state='fatal_error', nonce=NULL, error='foo', broadcast_at=NULL WHERE id = ?`, etx2.ID).Error)
 
-		etxs, err := bulletprooftxmanager.FindEthTxsRequiringResubmissionDueToInsufficientEth(store.DB, fromAddress)
+		etxs, err := bulletprooftxmanager.FindEthTxsRequiringResubmissionDueToInsufficientEth(store.DB, nil, fromAddress)
 		require.NoError(t, err)
 
 		assert.Len(t, etxs, 1)
@@ -978,7 +978,7 @@ func TestEthConfirmer_FindEthTxsRequiringRebroadcast(t *testing.T) {
 	_, fromAddress := cltest.MustAddRandomKeyToKeystore(t, ethKeyStore, 0)
 
 	currentHead := int64(30)
-	gasBumpThreshold := int64(10)
+	gasBumpThreshold := bulletprooftxmanager.GasBumpThresholds{Low: 10, Normal: 10, High: 10}
 	tooNew := int64(21)
 	onTheMoney := int64(20)
 	oldEnough := int64(19)
@@ -990,7 +990,7 @@ func TestEthConfirmer_FindEthTxsRequiringRebroadcast(t *testing.T) {
 	_, otherAddress := cltest.MustAddRandomKeyToKeystore(t, ethKeyStore, 0)
 
 	t.Run("returns nothing when there are no transactions", func(t *testing.T) {
-		etxs, err := bulletprooftxmanager.FindEthTxsRequiringRebroadcast(store.DB, fromAddress, currentHead, gasBumpThreshold, 10, 0)
+		etxs, err := bulletprooftxmanager.FindEthTxsRequiringRebroadcast(store.DB, nil, fromAddress, currentHead, gasBumpThreshold, 10, 0)
 		require.NoError(t, err)
 
 		assert.Len(t, etxs, 0)
@@ -1000,7 +1000,7 @@ func TestEthConfirmer_FindEthTxsRequiringRebroadcast(t *testing.T) {
 	nonce++
 
 	t.Run("returns nothing when the transaction is in_progress", func(t *testing.T) {
-		etxs, err := bulletprooftxmanager.FindEthTxsRequiringRebroadcast(store.DB, fromAddress, currentHead, gasBumpThreshold, 10, 0)
+		etxs, err := bulletprooftxmanager.FindEthTxsRequiringRebroadcast(store.DB, nil, fromAddress, currentHead, gasBumpThreshold, 10, 0)
 		require.NoError(t, err)
 
 		assert.Len(t, etxs, 0)
@@ -1011,7 +1011,7 @@ func TestEthConfirmer_FindEthTxsRequiringRebroadcast(t *testing.T) {
 	nonce++
 
 	t.Run("ignores unconfirmed transactions with nil BroadcastBeforeBlockNum", func(t *testing.T) {
-		etxs, err := bulletprooftxmanager.FindEthTxsRequiringRebroadcast(store.DB, fromAddress, currentHead, gasBumpThreshold, 10, 0)
+		etxs, err := bulletprooftxmanager.FindEthTxsRequiringRebroadcast(store.DB, nil, fromAddress, currentHead, gasBumpThreshold, 10, 0)
 		require.NoError(t, err)
 
 		assert.Len(t, etxs, 0)
@@ -1028,7 +1028,7 @@ func TestEthConfirmer_FindEthTxsRequiringRebroadcast(t *testing.T) {
 	require.NoError(t, store.DB.Save(&attempt1_2).Error)
 
 	t.Run("returns nothing when the transaction is unconfirmed with an attempt that is recent", func(t *testing.T) {
-		etxs, err := bulletprooftxmanager.FindEthTxsRequiringRebroadcast(store.DB, fromAddress, currentHead, gasBumpThreshold, 10, 0)
+		etxs, err := bulletprooftxmanager.FindEthTxsRequiringRebroadcast(store.DB, nil, fromAddress, currentHead, gasBumpThreshold, 10, 0)
 		require.NoError(t, err)
 
 		assert.Len(t, etxs, 0)
@@ -1041,7 +1041,7 @@ func TestEthConfirmer_FindEthTxsRequiringRebroadcast(t *testing.T) {
 	require.NoError(t, store.DB.Save(&attempt2_1).Error)
 
 	t.Run("returns nothing when the transaction has attempts that are too new", func(t *testing.T) {
-		etxs, err := bulletprooftxmanager.FindEthTxsRequiringRebroadcast(store.DB, fromAddress, currentHead, gasBumpThreshold, 10, 0)
+		etxs, err := bulletprooftxmanager.FindEthTxsRequiringRebroadcast(store.DB, nil, fromAddress, currentHead, gasBumpThreshold, 10, 0)
 		require.NoError(t, err)
 
 		assert.Len(t, etxs, 0)
@@ -1059,14 +1059,14 @@ func TestEthConfirmer_FindEthTxsRequiringRebroadcast(t *testing.T) {
 	nonce++
 
 	t.Run("does nothing if the transaction is from a different address than the one given", func(t *testing.T) {
-		etxs, err := bulletprooftxmanager.FindEthTxsRequiringRebroadcast(store.DB, otherAddress, currentHead, gasBumpThreshold, 10, 0)
+		etxs, err := bulletprooftxmanager.FindEthTxsRequiringRebroadcast(store.DB, nil, otherAddress, currentHead, gasBumpThreshold, 10, 0)
 		require.NoError(t, err)
 
 		assert.Len(t, etxs, 0)
 	})
 
 	t.Run("returns the transaction if it is unconfirmed and has no attempts (note that this is an invariant violation, but we handle it anyway)", func(t *testing.T) {
-		etxs, err := bulletprooftxmanager.FindEthTxsRequiringRebroadcast(store.DB, fromAddress, currentHead, gasBumpThreshold, 10, 0)
+		etxs, err := bulletprooftxmanager.FindEthTxsRequiringRebroadcast(store.DB, nil, fromAddress, currentHead, gasBumpThreshold, 10, 0)
 		require.NoError(t, err)
 
 		require.Len(t, etxs, 1)
@@ -1086,7 +1086,7 @@ func TestEthConfirmer_FindEthTxsRequiringRebroadcast(t *testing.T) {
 	require.NoError(t, store.DB.Save(&attemptOther1).Error)
 
 	t.Run("returns the transaction if it is unconfirmed with an attempt that is older than gasBumpThreshold blocks", func(t *testing.T) {
-		etxs, err := bulletprooftxmanager.FindEthTxsRequiringRebroadcast(store.DB, fromAddress, currentHead, gasBumpThreshold, 10, 0)
+		etxs, err := bulletprooftxmanager.FindEthTxsRequiringRebroadcast(store.DB, nil, fromAddress, currentHead, gasBumpThreshold, 10, 0)
 		require.NoError(t, err)
 
 		require.Len(t, etxs, 2)
@@ -1095,7 +1095,7 @@ func TestEthConfirmer_FindEthTxsRequiringRebroadcast(t *testing.T) {
 	})
 
 	t.Run("returns nothing if threshold is zero", func(t *testing.T) {
-		etxs, err := bulletprooftxmanager.FindEthTxsRequiringRebroadcast(store.DB, fromAddress, currentHead, 0, 10, 0)
+		etxs, err := bulletprooftxmanager.FindEthTxsRequiringRebroadcast(store.DB, nil, fromAddress, currentHead, bulletprooftxmanager.GasBumpThresholds{}, 10, 0)
 		require.NoError(t, err)
 
 		require.Len(t, etxs, 0)
@@ -1109,13 +1109,13 @@ func TestEthConfirmer_FindEthTxsRequiringRebroadcast(t *testing.T) {
 		// etxWithoutAttempts (nonce 5)
 		// etx3 (nonce 6) - ready for bump
 		// etx4 (nonce 7) - ready for bump
-		etxs, err := bulletprooftxmanager.FindEthTxsRequiringRebroadcast(store.DB, fromAddress, currentHead, gasBumpThreshold, 4, 0)
+		etxs, err := bulletprooftxmanager.FindEthTxsRequiringRebroadcast(store.DB, nil, fromAddress, currentHead, gasBumpThreshold, 4, 0)
 		require.NoError(t, err)
 
 		require.Len(t, etxs, 1) // returns etxWithoutAttempts only - eligible for gas bumping because it technically doesn't have any attempts withing gasBumpThreshold blocks
 		assert.Equal(t, etxWithoutAttempts.ID, etxs[0].ID)
 
-		etxs, err = bulletprooftxmanager.FindEthTxsRequiringRebroadcast(store.DB, fromAddress, currentHead, gasBumpThreshold, 5, 0)
+		etxs, err = bulletprooftxmanager.FindEthTxsRequiringRebroadcast(store.DB, nil, fromAddress, currentHead, gasBumpThreshold, 5, 0)
 		require.NoError(t, err)
 
 		require.Len(t, etxs, 2) // includes etxWithoutAttempts, etx3 and etx4
@@ -1123,7 +1123,7 @@ func TestEthConfirmer_FindEthTxsRequiringRebroadcast(t *testing.T) {
 		assert.Equal(t, etx3.ID, etxs[1].ID)
 
 		// Zero limit disables it
-		etxs, err = bulletprooftxmanager.FindEthTxsRequiringRebroadcast(store.DB, fromAddress, currentHead, gasBumpThreshold, 0, 0)
+		etxs, err = bulletprooftxmanager.FindEthTxsRequiringRebroadcast(store.DB, nil, fromAddress, currentHead, gasBumpThreshold, 0, 0)
 		require.NoError(t, err)
 
 		require.Len(t, etxs, 2) // includes etxWithoutAttempts, etx3 and etx4
@@ -1142,7 +1142,7 @@ func TestEthConfirmer_FindEthTxsRequiringRebroadcast(t *testing.T) {
 		aOther.BroadcastBeforeBlockNum = &oldEnough
 		require.NoError(t, store.DB.Save(&aOther).Error)
 
-		etxs, err := bulletprooftxmanager.FindEthTxsRequiringRebroadcast(store.DB, fromAddress, currentHead, gasBumpThreshold, 6, 0)
+		etxs, err := bulletprooftxmanager.FindEthTxsRequiringRebroadcast(store.DB, nil, fromAddress, currentHead, gasBumpThreshold, 6, 0)
 		require.NoError(t, err)
 
 		require.Len(t, etxs, 3) // includes etxWithoutAttempts, etx3 and etx4
@@ -1157,7 +1157,7 @@ func TestEthConfirmer_FindEthTxsRequiringRebroadcast(t *testing.T) {
 	require.NoError(t, store.DB.Save(&attempt3_2).Error)
 
 	t.Run("returns the transaction if it is unconfirmed with two attempts that are older than gasBumpThreshold blocks", func(t *testing.T) {
-		etxs, err := bulletprooftxmanager.FindEthTxsRequiringRebroadcast(store.DB, fromAddress, currentHead, gasBumpThreshold, 10, 0)
+		etxs, err := bulletprooftxmanager.FindEthTxsRequiringRebroadcast(store.DB, nil, fromAddress, currentHead, gasBumpThreshold, 10, 0)
 		require.NoError(t, err)
 
 		require.Len(t, etxs, 3)
@@ -1172,7 +1172,7 @@ func TestEthConfirmer_FindEthTxsRequiringRebroadcast(t *testing.T) {
 	require.NoError(t, store.DB.Save(&attempt3_3).Error)
 
 	t.Run("does not return the transaction if it has some older but one newer attempt", func(t *testing.T) {
-		etxs, err := bulletprooftxmanager.FindEthTxsRequiringRebroadcast(store.DB, fromAddress, currentHead, gasBumpThreshold, 10, 0)
+		etxs, err := bulletprooftxmanager.FindEthTxsRequiringRebroadcast(store.DB, nil, fromAddress, currentHead, gasBumpThreshold, 10, 0)
 		require.NoError(t, err)
 
 		require.Len(t, etxs, 2)
@@ -1210,7 +1210,7 @@ func TestEthConfirmer_FindEthTxsRequiringRebroadcast(t *testing.T) {
 	nonce++
 
 	t.Run("returns unique attempts requiring resubmission due to insufficient eth, ordered by nonce asc", func(t *testing.T) {
-		etxs, err := bulletprooftxmanager.FindEthTxsRequiringRebroadcast(store.DB, fromAddress, currentHead, gasBumpThreshold, 10, 0)
+		etxs, err := bulletprooftxmanager.FindEthTxsRequiringRebroadcast(store.DB, nil, fromAddress, currentHead, gasBumpThreshold, 10, 0)
 		require.NoError(t, err)
 
 		require.Len(t, etxs, 4)
@@ -1225,7 +1225,7 @@ func TestEthConfirmer_FindEthTxsRequiringRebroadcast(t *testing.T) {
 	})
 
 	t.Run("applies limit", func(t *testing.T) {
-		etxs, err := bulletprooftxmanager.FindEthTxsRequiringRebroadcast(store.DB, fromAddress, currentHead, gasBumpThreshold, 10, 2)
+		etxs, err := bulletprooftxmanager.FindEthTxsRequiringRebroadcast(store.DB, nil, fromAddress, currentHead, gasBumpThreshold, 10, 2)
 		require.NoError(t, err)
 
 		require.Len(t, etxs, 2)
@@ -1274,7 +1274,7 @@ func TestEthConfirmer_RebroadcastWhereNecessary(t *testing.T) {
 
 	t.Run("returns on keystore error", func(t *testing.T) {
 		// simulate transaction that is somehow impossible to sign
-		kst.On("SignTx", fromAddress,
+		kst.On("SignTx", mock.Anything, fromAddress,
 			mock.MatchedBy(func(tx *types.Transaction) bool {
 				return tx.Nonce() == uint64(*etx.Nonce)
 			}),
@@ -1296,7 +1296,7 @@ func TestEthConfirmer_RebroadcastWhereNecessary(t *testing.T) {
 
 	t.Run("does nothing and continues on fatal error", func(t *testing.T) {
 		ethTx := *types.NewTx(&types.LegacyTx{})
-		kst.On("SignTx",
+		kst.On("SignTx", mock.Anything,
 			fromAddress,
 			mock.MatchedBy(func(tx *types.Transaction) bool {
 				if tx.Nonce() != uint64(*etx.Nonce) {
@@ -1329,7 +1329,7 @@ func TestEthConfirmer_RebroadcastWhereNecessary(t *testing.T) {
 
 	t.Run("does nothing and continues if bumped attempt transaction was too expensive", func(t *testing.T) {
 		ethTx := *types.NewTx(&types.LegacyTx{})
-		kst.On("SignTx",
+		kst.On("SignTx", mock.Anything,
 			fromAddress,
 			mock.MatchedBy(func(tx *types.Transaction) bool {
 				if tx.Nonce() != uint64(*etx.Nonce) {
@@ -1372,7 +1372,7 @@ func TestEthConfirmer_RebroadcastWhereNecessary(t *testing.T) {
 		require.Greater(t, expectedBumpedGasPrice.Int64(), attempt1_1.GasPrice.ToInt().Int64())
 
 		ethTx := *types.NewTx(&types.LegacyTx{})
-		kst.On("SignTx",
+		kst.On("SignTx", mock.Anything,
 			fromAddress,
 			mock.MatchedBy(func(tx *types.Transaction) bool {
 				if expectedBumpedGasPrice.Cmp(tx.GasPrice()) != 0 {
@@ -1424,7 +1424,7 @@ func TestEthConfirmer_RebroadcastWhereNecessary(t *testing.T) {
 		require.Greater(t, expectedBumpedGasPrice.Int64(), attempt1_2.GasPrice.ToInt().Int64())
 
 		ethTx := *types.NewTx(&types.LegacyTx{})
-		kst.On("SignTx",
+		kst.On("SignTx", mock.Anything,
 			fromAddress,
 			mock.MatchedBy(func(tx *types.Transaction) bool {
 				if int64(tx.Nonce()) != *etx.Nonce || expectedBumpedGasPrice.Cmp(tx.GasPrice()) != 0 {
@@ -1467,7 +1467,7 @@ func TestEthConfirmer_RebroadcastWhereNecessary(t *testing.T) {
 
 		ethTx := *types.NewTx(&types.LegacyTx{})
 		receipt := bulletprooftxmanager.Receipt{BlockNumber: big.NewInt(40)}
-		kst.On("SignTx",
+		kst.On("SignTx", mock.Anything,
 			fromAddress,
 			mock.MatchedBy(func(tx *types.Transaction) bool {
 				if int64(tx.Nonce()) != *etx.Nonce || expectedBumpedGasPrice.Cmp(tx.GasPrice()) != 0 {
@@ -1524,7 +1524,7 @@ func TestEthConfirmer_RebroadcastWhereNecessary(t *testing.T) {
 
 		ethTx := *types.NewTx(&types.LegacyTx{})
 		n := *etx2.Nonce
-		kst.On("SignTx",
+		kst.On("SignTx", mock.Anything,
 			fromAddress,
 			mock.MatchedBy(func(tx *types.Transaction) bool {
 				if int64(tx.Nonce()) != n || expectedBumpedGasPrice.Cmp(tx.GasPrice()) != 0 {
@@ -1595,7 +1595,7 @@ func TestEthConfirmer_RebroadcastWhereNecessary(t *testing.T) {
 
 		ethTx := *types.NewTx(&types.LegacyTx{})
 		n := *etx2.Nonce
-		kst.On("SignTx",
+		kst.On("SignTx", mock.Anything,
 			fromAddress,
 			mock.MatchedBy(func(tx *types.Transaction) bool {
 				if int64(tx.Nonce()) != n || expectedBumpedGasPrice.Cmp(tx.GasPrice()) != 0 {
@@ -1640,7 +1640,7 @@ func TestEthConfirmer_RebroadcastWhereNecessary(t *testing.T) {
 		require.Greater(t, expectedBumpedGasPrice.Int64(), attempt3_1.GasPrice.ToInt().Int64())
 
 		ethTx := *types.NewTx(&types.LegacyTx{})
-		kst.On("SignTx",
+		kst.On("SignTx", mock.Anything,
 			fromAddress,
 			mock.MatchedBy(func(tx *types.Transaction) bool {
 				if int64(tx.Nonce()) != *etx3.Nonce || expectedBumpedGasPrice.Cmp(tx.GasPrice()) != 0 {
@@ -1681,7 +1681,7 @@ func TestEthConfirmer_RebroadcastWhereNecessary(t *testing.T) {
 		require.Greater(t, expectedBumpedGasPrice.Int64(), attempt3_1.GasPrice.ToInt().Int64())
 
 		ethTx := *types.NewTx(&types.LegacyTx{})
-		kst.On("SignTx",
+		kst.On("SignTx", mock.Anything,
 			fromAddress,
 			mock.MatchedBy(func(tx *types.Transaction) bool {
 				if int64(tx.Nonce()) != *etx3.Nonce || expectedBumpedGasPrice.Cmp(tx.GasPrice()) != 0 {
@@ -1724,7 +1724,7 @@ func TestEthConfirmer_RebroadcastWhereNecessary(t *testing.T) {
 		require.Greater(t, expectedBumpedGasPrice.Int64(), attempt3_2.GasPrice.ToInt().Int64())
 
 		ethTx := *types.NewTx(&types.LegacyTx{})
-		kst.On("SignTx",
+		kst.On("SignTx", mock.Anything,
 			fromAddress,
 			mock.MatchedBy(func(tx *types.Transaction) bool {
 				if int64(tx.Nonce()) != *etx3.Nonce || expectedBumpedGasPrice.Cmp(tx.GasPrice()) != 0 {
@@ -1958,6 +1958,69 @@ func TestEthConfirmer_RebroadcastWhereNecessary_WhenOutOfEth(t *testing.T) {
 	})
 }
 
+func TestEthConfirmer_RebroadcastWhereNecessary_CancelsExpiredTransactions(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+	db := store.DB
+	ethClient := new(mocks.Client)
+	ethKeyStore := cltest.NewKeyStore(t, store.DB).Eth()
+
+	_, fromAddress := cltest.MustAddRandomKeyToKeystore(t, ethKeyStore, 0)
+	ethKeyStore.Unlock(cltest.Password)
+
+	keys, err := ethKeyStore.SendingKeys()
+	require.NoError(t, err)
+
+	config, cleanup := cltest.NewConfig(t)
+	defer cleanup()
+
+	currentHead := int64(30)
+
+	etxUnstarted := cltest.MustInsertUnstartedEthTx(t, db, fromAddress)
+	require.NoError(t, db.Exec(`UPDATE eth_txes SET expires_at_block = ? WHERE id = ?`, currentHead, etxUnstarted.ID).Error)
+
+	etxExpired := cltest.MustInsertUnconfirmedEthTxWithBroadcastAttempt(t, db, 0, fromAddress)
+	require.NoError(t, db.Exec(`UPDATE eth_txes SET expires_at = NOW() - interval '1 minute' WHERE id = ?`, etxExpired.ID).Error)
+
+	etxNotExpired := cltest.MustInsertUnconfirmedEthTxWithBroadcastAttempt(t, db, 1, fromAddress)
+	require.NoError(t, db.Exec(`UPDATE eth_txes SET expires_at_block = ? WHERE id = ?`, currentHead+10, etxNotExpired.ID).Error)
+
+	ec := cltest.NewEthConfirmer(t, store.DB, ethClient, config, ethKeyStore, keys)
+
+	ethClient.On("SendTransaction", mock.Anything, mock.MatchedBy(func(tx *types.Transaction) bool {
+		return tx.Nonce() == 0 && *tx.To() == fromAddress && tx.Value().Sign() == 0 && len(tx.Data()) == 0 &&
+			tx.GasPrice().Cmp(etxExpired.EthTxAttempts[0].GasPrice.ToInt()) > 0
+	})).Return(nil).Once()
+
+	// Do the thing
+	require.NoError(t, ec.RebroadcastWhereNecessary(context.TODO(), currentHead))
+	ethClient.AssertExpectations(t)
+
+	etxUnstarted, err = cltest.FindEthTxWithAttempts(db, etxUnstarted.ID)
+	require.NoError(t, err)
+	assert.Equal(t, bulletprooftxmanager.EthTxFatalError, etxUnstarted.State)
+	assert.Equal(t, bulletprooftxmanager.ErrEthTxExpired, etxUnstarted.Error.String)
+	assert.True(t, etxUnstarted.CancelledAt.Valid)
+
+	etxExpired, err = cltest.FindEthTxWithAttempts(db, etxExpired.ID)
+	require.NoError(t, err)
+	assert.Equal(t, bulletprooftxmanager.EthTxUnconfirmed, etxExpired.State)
+	assert.True(t, etxExpired.CancelledAt.Valid)
+	require.Len(t, etxExpired.EthTxAttempts, 2)
+	assert.Equal(t, bulletprooftxmanager.EthTxAttemptBroadcast, etxExpired.EthTxAttempts[1].State)
+
+	etxNotExpired, err = cltest.FindEthTxWithAttempts(db, etxNotExpired.ID)
+	require.NoError(t, err)
+	assert.False(t, etxNotExpired.CancelledAt.Valid)
+	assert.Len(t, etxNotExpired.EthTxAttempts, 1)
+
+	// Cancelled transactions are not cancelled again
+	require.NoError(t, ec.RebroadcastWhereNecessary(context.TODO(), currentHead+1))
+	ethClient.AssertExpectations(t)
+}
+
 func TestEthConfirmer_EnsureConfirmedTransactionsInLongestChain(t *testing.T) {
 	t.Parallel()
 
@@ -35,6 +35,8 @@ type EthResender struct {
 	interval  time.Duration
 	config    Config
 
+	evmChainID *utils.Big
+
 	chStop chan struct{}
 	chDone chan struct{}
 }
@@ -48,6 +50,7 @@ func NewEthResender(db *gorm.DB, ethClient eth.Client, pollInterval time.Duratio
 		ethClient,
 		pollInterval,
 		config,
+		nil,
 		make(chan struct{}),
 		make(chan struct{}),
 	}
@@ -89,7 +92,7 @@ func (er *EthResender) resendUnconfirmed() error {
 	maxInFlightTransactions := er.config.EthMaxInFlightTransactions()
 
 	olderThan := time.Now().Add(-ageThreshold)
-	attempts, err := FindEthTxesRequiringResend(er.db, olderThan, maxInFlightTransactions)
+	attempts, err := FindEthTxesRequiringResend(er.db, er.evmChainID, olderThan, maxInFlightTransactions)
 	if err != nil {
 		return errors.Wrap(err, "failed to findEthTxAttemptsRequiringReceiptFetch")
 	}
@@ -143,7 +146,7 @@ func (er *EthResender) resendUnconfirmed() error {
 
 // FindEthTxesRequiringResend returns the highest priced attempt for each
 // eth_tx that was last sent before or at the given time (up to limit)
-func FindEthTxesRequiringResend(db *gorm.DB, olderThan time.Time, maxInFlightTransactions uint32) (attempts []EthTxAttempt, err error) {
+func FindEthTxesRequiringResend(db *gorm.DB, evmChainID *utils.Big, olderThan time.Time, maxInFlightTransactions uint32) (attempts []EthTxAttempt, err error) {
 	var limit null.Uint32
 	if maxInFlightTransactions > 0 {
 		limit = null.Uint32From(maxInFlightTransactions)
@@ -152,10 +155,10 @@ func FindEthTxesRequiringResend(db *gorm.DB, olderThan time.Time, maxInFlightTra
 SELECT DISTINCT ON (eth_tx_id) eth_tx_attempts.*
 FROM eth_tx_attempts
 JOIN eth_txes ON eth_txes.id = eth_tx_attempts.eth_tx_id AND eth_txes.state IN ('unconfirmed', 'confirmed_missing_receipt')
-WHERE eth_tx_attempts.state <> 'in_progress' AND eth_txes.broadcast_at <= ?
+WHERE eth_tx_attempts.state <> 'in_progress' AND eth_txes.broadcast_at <= ? AND eth_txes.evm_chain_id IS NOT DISTINCT FROM ?
 ORDER BY eth_tx_attempts.eth_tx_id ASC, eth_txes.nonce ASC, eth_tx_attempts.gas_price DESC
 LIMIT ?
-`, olderThan, limit).
+`, olderThan, evmChainID, limit).
 		Find(&attempts).Error
 
 	return
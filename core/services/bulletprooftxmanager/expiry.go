@@ -0,0 +1,83 @@
+package bulletprooftxmanager
+
+import (
+	"fmt"
+
+	gethCommon "github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+
+	"github.com/smartcontractkit/chainlink/core/services/postgres"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+const (
+	// ErrEthTxExpired is the error of unstarted eth_txes which expired before
+	// they were broadcast
+	ErrEthTxExpired = "transaction expired before it was broadcast"
+	// ErrEthTxCancelled is the error of unstarted eth_txes which were
+	// cancelled before they were broadcast
+	ErrEthTxCancelled = "transaction was cancelled before it was broadcast"
+)
+
+// ErrCannotCancelEthTx is returned by CancelEthTx for transactions which are
+// already cancelled, confirmed or errored
+var ErrCannotCancelEthTx = errors.New("transaction cannot be cancelled")
+
+// CancelEthTx cancels the eth_tx with the given ID.
+//
+// Unstarted transactions are marked as errored straight away. Transactions
+// which are already broadcast are expired, and the EthConfirmer replaces them
+// with a zero-value send to self on the next head.
+func CancelEthTx(db *gorm.DB, etxID int64) (etx EthTx, err error) {
+	err = postgres.GormTransactionWithDefaultContext(db, func(tx *gorm.DB) error {
+		res := tx.Exec(`UPDATE eth_txes SET state = 'fatal_error', error = ?, cancelled_at = NOW() WHERE id = ? AND state = 'unstarted'`, ErrEthTxCancelled, etxID)
+		if res.Error != nil {
+			return errors.Wrap(res.Error, "failed to cancel unstarted eth_tx")
+		}
+		if res.RowsAffected == 0 {
+			res = tx.Exec(`UPDATE eth_txes SET expires_at = NOW() WHERE id = ? AND state IN ('in_progress', 'unconfirmed') AND cancelled_at IS NULL`, etxID)
+			if res.Error != nil {
+				return errors.Wrap(res.Error, "failed to expire eth_tx")
+			}
+		}
+		if err := tx.First(&etx, etxID).Error; err != nil {
+			return errors.Wrap(err, "failed to load eth_tx")
+		}
+		if res.RowsAffected == 0 {
+			return errors.Wrapf(ErrCannotCancelEthTx, "eth_tx %d is %s", etx.ID, describeUncancellable(etx))
+		}
+		return nil
+	})
+	return etx, errors.Wrap(err, "CancelEthTx failed")
+}
+
+func describeUncancellable(etx EthTx) string {
+	if etx.CancelledAt.Valid {
+		return "already cancelled"
+	}
+	return fmt.Sprintf("already %s", etx.State)
+}
+
+// expireUnstartedEthTxs marks unstarted eth_txes which have expired as errored,
+// so that they are never broadcast
+func expireUnstartedEthTxs(db *gorm.DB, evmChainID *utils.Big, address gethCommon.Address, blockNum int64) (int64, error) {
+	res := db.Exec(`
+UPDATE eth_txes SET state = 'fatal_error', error = ?, cancelled_at = NOW()
+WHERE evm_chain_id IS NOT DISTINCT FROM ? AND from_address = ? AND state = 'unstarted' AND (expires_at <= NOW() OR expires_at_block <= ?)
+`, ErrEthTxExpired, evmChainID, address, blockNum)
+	return res.RowsAffected, errors.Wrap(res.Error, "expireUnstartedEthTxs failed")
+}
+
+// FindEthTxsRequiringCancellation returns unconfirmed eth_txes which have
+// expired but have not been cancelled yet, in nonce ASC order
+func FindEthTxsRequiringCancellation(db *gorm.DB, evmChainID *utils.Big, address gethCommon.Address, blockNum int64) (etxs []EthTx, err error) {
+	err = db.
+		Preload("EthTxAttempts", func(db *gorm.DB) *gorm.DB {
+			return db.Order("eth_tx_attempts.gas_price DESC")
+		}).
+		Where("evm_chain_id IS NOT DISTINCT FROM ? AND from_address = ? AND state = 'unconfirmed' AND cancelled_at IS NULL AND (expires_at <= NOW() OR expires_at_block <= ?)", evmChainID, address, blockNum).
+		Order("nonce ASC").
+		Find(&etxs).Error
+	return etxs, errors.Wrap(err, "FindEthTxsRequiringCancellation failed")
+}
@@ -0,0 +1,56 @@
+package bulletprooftxmanager_test
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/core/services/bulletprooftxmanager"
+)
+
+func TestCancelEthTx(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewGormDB(t)
+	key := cltest.MustInsertRandomKey(t, db, 0)
+	fromAddress := key.Address.Address()
+
+	t.Run("cancels unstarted transactions immediately", func(t *testing.T) {
+		etx := cltest.MustInsertUnstartedEthTx(t, db, fromAddress)
+
+		etx, err := bulletprooftxmanager.CancelEthTx(db, etx.ID)
+		require.NoError(t, err)
+
+		assert.Equal(t, bulletprooftxmanager.EthTxFatalError, etx.State)
+		assert.Equal(t, bulletprooftxmanager.ErrEthTxCancelled, etx.Error.String)
+		assert.True(t, etx.CancelledAt.Valid)
+
+		_, err = bulletprooftxmanager.CancelEthTx(db, etx.ID)
+		assert.True(t, errors.Is(err, bulletprooftxmanager.ErrCannotCancelEthTx))
+		assert.Contains(t, err.Error(), "already cancelled")
+	})
+
+	t.Run("expires unconfirmed transactions", func(t *testing.T) {
+		etx := cltest.MustInsertUnconfirmedEthTxWithBroadcastAttempt(t, db, 0, fromAddress)
+
+		etx, err := bulletprooftxmanager.CancelEthTx(db, etx.ID)
+		require.NoError(t, err)
+
+		assert.Equal(t, bulletprooftxmanager.EthTxUnconfirmed, etx.State)
+		assert.True(t, etx.ExpiresAt.Valid)
+		// The EthConfirmer cancels it on the next head
+		assert.False(t, etx.CancelledAt.Valid)
+	})
+
+	t.Run("does not cancel confirmed transactions", func(t *testing.T) {
+		etx := cltest.MustInsertConfirmedEthTxWithAttempt(t, db, 1, 1, fromAddress)
+
+		_, err := bulletprooftxmanager.CancelEthTx(db, etx.ID)
+		assert.True(t, errors.Is(err, bulletprooftxmanager.ErrCannotCancelEthTx))
+		assert.Contains(t, err.Error(), "already confirmed")
+	})
+}
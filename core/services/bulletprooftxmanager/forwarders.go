@@ -0,0 +1,82 @@
+package bulletprooftxmanager
+
+import (
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+
+	"github.com/smartcontractkit/chainlink/core/services/eth"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+// ErrForwarderNotAllowed is returned when a transaction is routed through a
+// forwarder which is not on the node's allowlist
+var ErrForwarderNotAllowed = errors.New("forwarder is not on the allowlist")
+
+// forwarderABI is the interface of operator forwarder contracts, which relay
+// calls from any of their authorized senders
+var forwarderABI = eth.MustGetABI(`[{"inputs":[{"internalType":"address","name":"to","type":"address"},{"internalType":"bytes","name":"data","type":"bytes"}],"name":"forward","outputs":[],"stateMutability":"nonpayable","type":"function"}]`)
+
+// EthForwarder is a forwarder contract which transactions may be routed
+// through. Each of the node's keys must be an authorized sender of the
+// forwarder, which lets many jobs share a small set of funded keys.
+type EthForwarder struct {
+	ID      int64
+	Address common.Address
+	// EVMChainID is nil for forwarders on the node's primary chain
+	EVMChainID *utils.Big
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+// CreateEthForwarder adds a forwarder to the allowlist
+func CreateEthForwarder(db *gorm.DB, address common.Address, evmChainID *utils.Big) (fwd EthForwarder, err error) {
+	err = db.Raw(`
+INSERT INTO eth_forwarders (address, evm_chain_id, created_at, updated_at)
+VALUES (?, ?, NOW(), NOW())
+RETURNING *`, address, evmChainID).Scan(&fwd).Error
+	return fwd, errors.Wrap(err, "CreateEthForwarder failed")
+}
+
+// DeleteEthForwarder removes a forwarder from the allowlist. Transactions
+// which were already routed through it are unaffected.
+func DeleteEthForwarder(db *gorm.DB, id int64) error {
+	res := db.Exec(`DELETE FROM eth_forwarders WHERE id = ?`, id)
+	if res.Error != nil {
+		return errors.Wrap(res.Error, "DeleteEthForwarder failed")
+	}
+	if res.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+// FindEthForwarders returns the allowlisted forwarders of all chains
+func FindEthForwarders(db *gorm.DB) (fwds []EthForwarder, err error) {
+	err = db.Raw(`SELECT * FROM eth_forwarders ORDER BY id ASC`).Scan(&fwds).Error
+	return fwds, errors.Wrap(err, "FindEthForwarders failed")
+}
+
+// EncodeForwardedPayload wraps payload in a call to the forwarder, which
+// relays it to toAddress
+func EncodeForwardedPayload(toAddress common.Address, payload []byte) ([]byte, error) {
+	data, err := forwarderABI.Pack("forward", toAddress, payload)
+	return data, errors.Wrap(err, "failed to encode forwarded payload")
+}
+
+// ForwardPayload routes a transaction to toAddress through forwarder. It
+// returns the payload of a transaction sent to the forwarder instead, or
+// ErrForwarderNotAllowed if forwarder is not allowlisted on the chain.
+func ForwardPayload(db *gorm.DB, evmChainID *utils.Big, forwarder, toAddress common.Address, payload []byte) ([]byte, error) {
+	var count int64
+	err := db.Raw(`SELECT count(*) FROM eth_forwarders WHERE address = ? AND evm_chain_id IS NOT DISTINCT FROM ?`, forwarder, evmChainID).Scan(&count).Error
+	if err != nil {
+		return nil, errors.Wrap(err, "ForwardPayload failed to load forwarder")
+	}
+	if count == 0 {
+		return nil, errors.Wrapf(ErrForwarderNotAllowed, "forwarder %s", forwarder.Hex())
+	}
+	return EncodeForwardedPayload(toAddress, payload)
+}
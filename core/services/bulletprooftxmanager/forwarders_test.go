@@ -0,0 +1,68 @@
+package bulletprooftxmanager_test
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/services/bulletprooftxmanager"
+	"github.com/smartcontractkit/chainlink/core/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+func Test_EncodeForwardedPayload(t *testing.T) {
+	t.Parallel()
+
+	to := common.HexToAddress("0xDeaDbeefdEAdbeefdEadbEEFdeadbeEFdEaDbeeF")
+	payload, err := bulletprooftxmanager.EncodeForwardedPayload(to, []byte{0xca, 0xfe})
+	require.NoError(t, err)
+
+	expected := "0x6fadcf72" + // forward(address,bytes)
+		"000000000000000000000000deadbeefdeadbeefdeadbeefdeadbeefdeadbeef" +
+		"0000000000000000000000000000000000000000000000000000000000000040" +
+		"0000000000000000000000000000000000000000000000000000000000000002" +
+		"cafe000000000000000000000000000000000000000000000000000000000000"
+	assert.Equal(t, expected, hexutil.Encode(payload))
+}
+
+func Test_ForwardPayload(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+	db := store.DB
+
+	to := cltest.NewAddress()
+	forwarder := cltest.NewAddress()
+	chainID := utils.NewBigI(10)
+
+	fwd, err := bulletprooftxmanager.CreateEthForwarder(db, forwarder, chainID)
+	require.NoError(t, err)
+
+	fwds, err := bulletprooftxmanager.FindEthForwarders(db)
+	require.NoError(t, err)
+	require.Len(t, fwds, 1)
+	assert.Equal(t, forwarder, fwds[0].Address)
+	assert.Equal(t, "10", fwds[0].EVMChainID.String())
+
+	payload, err := bulletprooftxmanager.ForwardPayload(db, chainID, forwarder, to, []byte{1})
+	require.NoError(t, err)
+	expected, err := bulletprooftxmanager.EncodeForwardedPayload(to, []byte{1})
+	require.NoError(t, err)
+	assert.Equal(t, expected, payload)
+
+	// The forwarder is only allowlisted on the chain it was added for
+	_, err = bulletprooftxmanager.ForwardPayload(db, nil, forwarder, to, []byte{1})
+	assert.True(t, errors.Is(err, bulletprooftxmanager.ErrForwarderNotAllowed))
+
+	require.NoError(t, bulletprooftxmanager.DeleteEthForwarder(db, fwd.ID))
+	_, err = bulletprooftxmanager.ForwardPayload(db, chainID, forwarder, to, []byte{1})
+	assert.True(t, errors.Is(err, bulletprooftxmanager.ErrForwarderNotAllowed))
+
+	err = bulletprooftxmanager.DeleteEthForwarder(db, fwd.ID)
+	assert.True(t, errors.Is(err, gorm.ErrRecordNotFound))
+}
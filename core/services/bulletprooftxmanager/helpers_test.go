@@ -4,6 +4,15 @@ import (
 	"github.com/smartcontractkit/chainlink/core/services/eth"
 )
 
+var (
+	BumpGas         = bumpGas
+	InitialGasPrice = initialGasPrice
+)
+
 func SetEthClientOnEthConfirmer(ethClient eth.Client, ethConfirmer *EthConfirmer) {
 	ethConfirmer.ethClient = ethClient
 }
+
+func ProcessConfirmationCallbacks(b *BulletproofTxManager, headNumber int64) error {
+	return b.callbacks.process(b.db, headNumber)
+}
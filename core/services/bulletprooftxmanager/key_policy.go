@@ -0,0 +1,57 @@
+package bulletprooftxmanager
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+	uuid "github.com/satori/go.uuid"
+	"gorm.io/gorm"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
+)
+
+// ErrKeyPolicyViolation is returned when creating a transaction which the
+// usage policy of its from address does not allow
+var ErrKeyPolicyViolation = errors.New("transaction not allowed by key policy")
+
+// checkKeyPolicy returns ErrKeyPolicyViolation if the usage policy of
+// fromAddress does not allow the job which subject is the external ID of to
+// send a transaction to toAddress. Transactions which are not sent by a job,
+// i.e. without a subject, are only allowed from keys which are not
+// restricted to job types.
+func checkKeyPolicy(db *gorm.DB, fromAddress, toAddress common.Address, subject uuid.NullUUID) error {
+	var rows []struct {
+		FundingOnly        bool
+		AllowedJobTypes    pq.StringArray
+		AllowedToAddresses ethkey.AddressArray
+		JobType            *string
+	}
+	err := db.Raw(`
+SELECT keys.funding_only, keys.allowed_job_types, keys.allowed_to_addresses, jobs.type AS job_type
+FROM keys
+LEFT JOIN jobs ON jobs.external_job_id = ?
+WHERE keys.address = ? AND keys.deleted_at IS NULL
+`, subject, fromAddress).Scan(&rows).Error
+	if err != nil {
+		return errors.Wrap(err, "checkKeyPolicy failed to load key")
+	}
+	if len(rows) == 0 {
+		return nil
+	}
+
+	policy := ethkey.Policy{
+		FundingOnly:        rows[0].FundingOnly,
+		AllowedJobTypes:    rows[0].AllowedJobTypes,
+		AllowedToAddresses: rows[0].AllowedToAddresses,
+	}
+	var jobType string
+	if rows[0].JobType != nil {
+		jobType = *rows[0].JobType
+	}
+	if policy.AllowsJob(jobType, toAddress) {
+		return nil
+	}
+	logger.Errorw("BulletproofTxManager: key policy does not allow transaction, rejecting it", "fromAddress", fromAddress, "toAddress", toAddress, "jobType", jobType, "policy", policy)
+	return errors.Wrapf(ErrKeyPolicyViolation, "key %s may not send transactions to %s (job type: %q)", fromAddress.Hex(), toAddress.Hex(), jobType)
+}
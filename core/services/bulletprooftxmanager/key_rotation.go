@@ -0,0 +1,181 @@
+package bulletprooftxmanager
+
+import (
+	"context"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+	"gopkg.in/guregu/null.v4"
+	"gorm.io/gorm"
+
+	"github.com/smartcontractkit/chainlink/core/assets"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/eth"
+	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
+	"github.com/smartcontractkit/chainlink/core/services/postgres"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+// KeyRotation is the audit record of a key rotation
+type KeyRotation struct {
+	ID         int64
+	OldAddress common.Address
+	NewAddress common.Address
+	// SweepEthTxID is the transaction which sent the residual funds of the
+	// old key to the new one, if there were any
+	SweepEthTxID null.Int
+	SweepValue   assets.Eth
+	// JobIDs are the jobs which were re-pointed from the old key to the new
+	// one, and must be restarted to pick up the change
+	JobIDs    pq.Int32Array `gorm:"type:integer[]"`
+	CreatedAt time.Time
+}
+
+// RotationKeyStore encompasses the subset of keystore used by the KeyRotator
+type RotationKeyStore interface {
+	KeyByAddress(address common.Address) (ethkey.Key, error)
+	CreateNewKey() (ethkey.Key, error)
+	RemoveKey(address common.Address, hardDelete bool) (ethkey.Key, error)
+	SetKeyPolicy(address common.Address, policy ethkey.Policy) (ethkey.Key, error)
+}
+
+// KeyRotator replaces a sending key with a new one on the node's primary
+// chain
+type KeyRotator struct {
+	db         *gorm.DB
+	ethClient  eth.Client
+	keyStore   RotationKeyStore
+	config     Config
+	evmChainID *utils.Big
+}
+
+// NewKeyRotator returns a new key rotator
+func NewKeyRotator(db *gorm.DB, ethClient eth.Client, keyStore RotationKeyStore, config Config) *KeyRotator {
+	return &KeyRotator{db, ethClient, keyStore, config, nil}
+}
+
+// Rotate creates a new sending key with the usage policy of oldAddress, and
+// in one transaction:
+//
+// - points the OCR and keeper jobs which send from oldAddress at the new key
+// - makes oldAddress funding-only, so no new transactions are sent from it
+// - sweeps the balance of oldAddress to the new key, less what its pending
+// transactions and the sweep itself may spend at ETH_MAX_GAS_PRICE_WEI
+// - records the rotation in key_rotations
+//
+// The old key is kept so that its pending transactions and the sweep are
+// still sent. Flux monitor jobs pick a sending key for every submission, so
+// they move to the new key without being updated. The returned JobIDs must
+// be restarted by the caller.
+func (r *KeyRotator) Rotate(ctx context.Context, oldAddress common.Address, sweepGasLimit uint64) (rotation KeyRotation, err error) {
+	oldKey, err := r.keyStore.KeyByAddress(oldAddress)
+	if err != nil {
+		return rotation, errors.Wrap(err, "KeyRotator#Rotate failed to load key")
+	}
+	if oldKey.IsFunding || oldKey.FundingOnly {
+		return rotation, errors.Errorf("key %s is not a sending key", oldAddress.Hex())
+	}
+
+	queryCtx, cancel := eth.DefaultQueryCtx(ctx)
+	defer cancel()
+	balance, err := r.ethClient.BalanceAt(queryCtx, oldAddress, nil)
+	if err != nil {
+		return rotation, errors.Wrap(err, "KeyRotator#Rotate failed to fetch balance")
+	}
+
+	newKey, err := r.keyStore.CreateNewKey()
+	if err != nil {
+		return rotation, errors.Wrap(err, "KeyRotator#Rotate failed to create key")
+	}
+	newAddress := newKey.Address.Address()
+	defer func() {
+		if err == nil {
+			return
+		}
+		if _, rerr := r.keyStore.RemoveKey(newAddress, true); rerr != nil {
+			logger.Errorw("KeyRotator: failed to remove new key after failed rotation", "address", newAddress, "err", rerr)
+		}
+	}()
+	if _, err = r.keyStore.SetKeyPolicy(newAddress, oldKey.Policy()); err != nil {
+		return rotation, errors.Wrap(err, "KeyRotator#Rotate failed to copy key policy")
+	}
+
+	rotation = KeyRotation{
+		OldAddress: oldAddress,
+		NewAddress: newAddress,
+		JobIDs:     pq.Int32Array{},
+		CreatedAt:  time.Now(),
+	}
+	err = postgres.GormTransactionWithDefaultContext(r.db, func(tx *gorm.DB) error {
+		var ocrJobIDs, keeperJobIDs []int32
+		if err := tx.Raw(`
+UPDATE offchainreporting_oracle_specs SET transmitter_address = ?, updated_at = NOW()
+FROM jobs WHERE jobs.offchainreporting_oracle_spec_id = offchainreporting_oracle_specs.id AND transmitter_address = ?
+RETURNING jobs.id
+`, newAddress, oldAddress).Scan(&ocrJobIDs).Error; err != nil {
+			return errors.Wrap(err, "failed to update OCR jobs")
+		}
+		if err := tx.Raw(`
+UPDATE keeper_specs SET from_address = ?, updated_at = NOW()
+FROM jobs WHERE jobs.keeper_spec_id = keeper_specs.id AND from_address = ?
+RETURNING jobs.id
+`, newAddress, oldAddress).Scan(&keeperJobIDs).Error; err != nil {
+			return errors.Wrap(err, "failed to update keeper jobs")
+		}
+		rotation.JobIDs = append(append(rotation.JobIDs, ocrJobIDs...), keeperJobIDs...)
+		if err := tx.Exec(`UPDATE keeper_registries SET from_address = ? WHERE from_address = ?`, newAddress, oldAddress).Error; err != nil {
+			return errors.Wrap(err, "failed to update keeper registries")
+		}
+		if err := tx.Exec(`UPDATE keys SET funding_only = true, allowed_job_types = NULL, allowed_to_addresses = NULL, updated_at = NOW() WHERE address = ?`, oldAddress).Error; err != nil {
+			return errors.Wrap(err, "failed to disable old key")
+		}
+
+		reserved, err := r.reservedBalance(tx, oldAddress, sweepGasLimit)
+		if err != nil {
+			return err
+		}
+		if sweep := new(big.Int).Sub(balance, reserved); sweep.Sign() > 0 {
+			etx, err := SendEther(tx, oldAddress, newAddress, assets.Eth(*sweep), sweepGasLimit)
+			if err != nil {
+				return errors.Wrap(err, "failed to create sweep transaction")
+			}
+			rotation.SweepEthTxID = null.IntFrom(etx.ID)
+			rotation.SweepValue = etx.Value
+		}
+
+		return errors.Wrap(tx.Create(&rotation).Error, "failed to insert key rotation")
+	})
+	if err != nil {
+		return rotation, errors.Wrap(err, "KeyRotator#Rotate failed")
+	}
+
+	// Syncs the in-memory key, the DB was already updated above
+	if _, serr := r.keyStore.SetKeyPolicy(oldAddress, ethkey.Policy{FundingOnly: true}); serr != nil {
+		logger.Errorw("KeyRotator: failed to disable old key in keystore", "address", oldAddress, "err", serr)
+	}
+	logger.Infow("KeyRotator: rotated key", "oldAddress", oldAddress, "newAddress", newAddress, "sweepEthTxID", rotation.SweepEthTxID, "sweepValue", rotation.SweepValue.String(), "jobIDs", rotation.JobIDs)
+	return rotation, nil
+}
+
+// reservedBalance is what the pending transactions of address on the
+// rotator's chain, and a sweep with gasLimit, may spend at most
+func (r *KeyRotator) reservedBalance(tx *gorm.DB, address common.Address, gasLimit uint64) (*big.Int, error) {
+	var pending struct {
+		GasLimit utils.Big
+		Value    utils.Big
+	}
+	err := tx.Raw(`
+SELECT COALESCE(SUM(gas_limit), 0)::text AS gas_limit, COALESCE(SUM(value), 0)::text AS value
+FROM eth_txes
+WHERE evm_chain_id IS NOT DISTINCT FROM ? AND from_address = ? AND state IN ('unstarted', 'in_progress', 'unconfirmed', 'confirmed_missing_receipt')
+`, r.evmChainID, address).Scan(&pending).Error
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to load pending transactions")
+	}
+	gas := new(big.Int).Add(pending.GasLimit.ToInt(), new(big.Int).SetUint64(gasLimit))
+	reserved := new(big.Int).Mul(gas, r.config.EthMaxGasPriceWei())
+	return reserved.Add(reserved, pending.Value.ToInt()), nil
+}
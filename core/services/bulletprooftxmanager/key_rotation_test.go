@@ -0,0 +1,100 @@
+package bulletprooftxmanager_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	uuid "github.com/satori/go.uuid"
+	"github.com/smartcontractkit/chainlink/core/assets"
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/internal/mocks"
+	"github.com/smartcontractkit/chainlink/core/services/bulletprooftxmanager"
+	bptxmmocks "github.com/smartcontractkit/chainlink/core/services/bulletprooftxmanager/mocks"
+	"github.com/smartcontractkit/chainlink/core/services/job"
+	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+	"github.com/smartcontractkit/chainlink/core/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKeyRotator_Rotate(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+	db := store.DB
+	ethKeyStore := cltest.NewKeyStore(t, db).Eth()
+	ethClient := new(mocks.Client)
+	config := new(bptxmmocks.Config)
+	config.On("EthMaxGasPriceWei").Return(big.NewInt(10))
+
+	oldKey, oldAddress := cltest.MustAddRandomKeyToKeystore(t, ethKeyStore)
+	_, err := ethKeyStore.SetKeyPolicy(oldAddress, ethkey.Policy{AllowedJobTypes: []string{job.OffchainReporting.String()}})
+	require.NoError(t, err)
+	ocrSpec := cltest.MustInsertOffchainreportingOracleSpec(t, db, oldKey.Address)
+	pipelineSpec := pipeline.Spec{}
+	require.NoError(t, db.Create(&pipelineSpec).Error)
+	ocrJob := job.Job{
+		OffchainreportingOracleSpecID: &ocrSpec.ID,
+		ExternalJobID:                 uuid.NewV4(),
+		Type:                          job.OffchainReporting,
+		SchemaVersion:                 1,
+		PipelineSpecID:                pipelineSpec.ID,
+	}
+	require.NoError(t, db.Create(&ocrJob).Error)
+	keeperJob := cltest.MustInsertKeeperJob(t, store, oldKey.Address, cltest.NewEIP55Address())
+	otherKeeperJob := cltest.MustInsertKeeperJob(t, store, cltest.NewEIP55Address(), cltest.NewEIP55Address())
+	// Reserves 21000 gas and 1000 Wei
+	_, err = bulletprooftxmanager.SendEther(db, oldAddress, cltest.NewAddress(), assets.NewEthValue(1000), 21000)
+	require.NoError(t, err)
+	// Pending on another chain, so spends another balance
+	otherChainEtx := cltest.NewEthTx(t, oldAddress)
+	otherChainEtx.Value = assets.NewEthValue(5000)
+	otherChainEtx.EVMChainID = utils.NewBigI(2)
+	require.NoError(t, db.Create(&otherChainEtx).Error)
+
+	ethClient.On("BalanceAt", mock.Anything, oldAddress, (*big.Int)(nil)).Return(big.NewInt(1000000), nil)
+
+	rotator := bulletprooftxmanager.NewKeyRotator(db, ethClient, ethKeyStore, config)
+	rotation, err := rotator.Rotate(context.Background(), oldAddress, 21000)
+	require.NoError(t, err)
+
+	assert.Equal(t, oldAddress, rotation.OldAddress)
+	assert.NotEqual(t, oldAddress, rotation.NewAddress)
+	assert.ElementsMatch(t, []int32{ocrJob.ID, keeperJob.ID}, []int32(rotation.JobIDs))
+
+	// 1000000 - (21000 + 21000) * 10 - 1000
+	require.True(t, rotation.SweepEthTxID.Valid)
+	etx, err := cltest.FindEthTxWithAttempts(db, rotation.SweepEthTxID.Int64)
+	require.NoError(t, err)
+	assert.Equal(t, rotation.NewAddress, etx.ToAddress)
+	assert.Equal(t, big.NewInt(579000), etx.Value.ToInt())
+
+	oldKey, err = ethKeyStore.KeyByAddress(oldAddress)
+	require.NoError(t, err)
+	assert.True(t, oldKey.FundingOnly)
+	newKey, err := ethKeyStore.KeyByAddress(rotation.NewAddress)
+	require.NoError(t, err)
+	assert.Equal(t, []string{job.OffchainReporting.String()}, []string(newKey.AllowedJobTypes))
+
+	var transmitterAddress ethkey.EIP55Address
+	require.NoError(t, db.Raw(`SELECT transmitter_address FROM offchainreporting_oracle_specs WHERE id = ?`, ocrSpec.ID).Scan(&transmitterAddress).Error)
+	assert.Equal(t, rotation.NewAddress, transmitterAddress.Address())
+	var fromAddress ethkey.EIP55Address
+	require.NoError(t, db.Raw(`SELECT from_address FROM keeper_specs WHERE id = ?`, *otherKeeperJob.KeeperSpecID).Scan(&fromAddress).Error)
+	assert.NotEqual(t, rotation.NewAddress, fromAddress.Address())
+
+	var count int64
+	require.NoError(t, db.Table("key_rotations").Where("old_address = ? AND new_address = ?", oldAddress, rotation.NewAddress).Count(&count).Error)
+	assert.Equal(t, int64(1), count)
+
+	t.Run("rejects rotating a funding-only key", func(t *testing.T) {
+		_, err := rotator.Rotate(context.Background(), oldAddress, 21000)
+		require.Error(t, err)
+	})
+
+	ethClient.AssertExpectations(t)
+}
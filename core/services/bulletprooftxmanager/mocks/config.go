@@ -5,9 +5,13 @@ package mocks
 import (
 	big "math/big"
 
+	common "github.com/ethereum/go-ethereum/common"
+
 	mock "github.com/stretchr/testify/mock"
 
 	time "time"
+
+	url "net/url"
 )
 
 // Config is an autogenerated mock type for the Config type
@@ -115,6 +119,20 @@ func (_m *Config) EthGasBumpPercent() uint16 {
 	return r0
 }
 
+// EthGasBumpPercentHighUrgency provides a mock function with given fields:
+func (_m *Config) EthGasBumpPercentHighUrgency() uint16 {
+	ret := _m.Called()
+
+	var r0 uint16
+	if rf, ok := ret.Get(0).(func() uint16); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint16)
+	}
+
+	return r0
+}
+
 // EthGasBumpThreshold provides a mock function with given fields:
 func (_m *Config) EthGasBumpThreshold() uint64 {
 	ret := _m.Called()
@@ -129,6 +147,34 @@ func (_m *Config) EthGasBumpThreshold() uint64 {
 	return r0
 }
 
+// EthGasBumpThresholdHighUrgency provides a mock function with given fields:
+func (_m *Config) EthGasBumpThresholdHighUrgency() uint64 {
+	ret := _m.Called()
+
+	var r0 uint64
+	if rf, ok := ret.Get(0).(func() uint64); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint64)
+	}
+
+	return r0
+}
+
+// EthGasBumpThresholdLowUrgency provides a mock function with given fields:
+func (_m *Config) EthGasBumpThresholdLowUrgency() uint64 {
+	ret := _m.Called()
+
+	var r0 uint64
+	if rf, ok := ret.Get(0).(func() uint64); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint64)
+	}
+
+	return r0
+}
+
 // EthGasBumpTxDepth provides a mock function with given fields:
 func (_m *Config) EthGasBumpTxDepth() uint16 {
 	ret := _m.Called()
@@ -219,6 +265,22 @@ func (_m *Config) EthMaxGasPriceWei() *big.Int {
 	return r0
 }
 
+// EthMaxGasPriceWeiLowUrgency provides a mock function with given fields:
+func (_m *Config) EthMaxGasPriceWeiLowUrgency() *big.Int {
+	ret := _m.Called()
+
+	var r0 *big.Int
+	if rf, ok := ret.Get(0).(func() *big.Int); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*big.Int)
+		}
+	}
+
+	return r0
+}
+
 // EthMaxInFlightTransactions provides a mock function with given fields:
 func (_m *Config) EthMaxInFlightTransactions() uint32 {
 	ret := _m.Called()
@@ -233,6 +295,20 @@ func (_m *Config) EthMaxInFlightTransactions() uint32 {
 	return r0
 }
 
+// EthMaxInFlightTransactionsForKey provides a mock function with given fields: address
+func (_m *Config) EthMaxInFlightTransactionsForKey(address common.Address) uint32 {
+	ret := _m.Called(address)
+
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func(common.Address) uint32); ok {
+		r0 = rf(address)
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	return r0
+}
+
 // EthMaxQueuedTransactions provides a mock function with given fields:
 func (_m *Config) EthMaxQueuedTransactions() uint64 {
 	ret := _m.Called()
@@ -277,6 +353,20 @@ func (_m *Config) EthNonceAutoSync() bool {
 	return r0
 }
 
+// EthNonceReconciliationInterval provides a mock function with given fields:
+func (_m *Config) EthNonceReconciliationInterval() time.Duration {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
 // EthRPCDefaultBatchSize provides a mock function with given fields:
 func (_m *Config) EthRPCDefaultBatchSize() uint32 {
 	ret := _m.Called()
@@ -291,6 +381,82 @@ func (_m *Config) EthRPCDefaultBatchSize() uint32 {
 	return r0
 }
 
+// EthTxDailyBudgetWei provides a mock function with given fields:
+func (_m *Config) EthTxDailyBudgetWei() *big.Int {
+	ret := _m.Called()
+
+	var r0 *big.Int
+	if rf, ok := ret.Get(0).(func() *big.Int); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*big.Int)
+		}
+	}
+
+	return r0
+}
+
+// EthTxJobDailyBudgetWei provides a mock function with given fields:
+func (_m *Config) EthTxJobDailyBudgetWei() *big.Int {
+	ret := _m.Called()
+
+	var r0 *big.Int
+	if rf, ok := ret.Get(0).(func() *big.Int); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*big.Int)
+		}
+	}
+
+	return r0
+}
+
+// EthTxMulticallAddress provides a mock function with given fields:
+func (_m *Config) EthTxMulticallAddress() *common.Address {
+	ret := _m.Called()
+
+	var r0 *common.Address
+	if rf, ok := ret.Get(0).(func() *common.Address); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*common.Address)
+		}
+	}
+
+	return r0
+}
+
+// EthTxMulticallMaxBatchSize provides a mock function with given fields:
+func (_m *Config) EthTxMulticallMaxBatchSize() uint32 {
+	ret := _m.Called()
+
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func() uint32); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	return r0
+}
+
+// EthTxMulticallWindow provides a mock function with given fields:
+func (_m *Config) EthTxMulticallWindow() time.Duration {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
 // EthTxReaperInterval provides a mock function with given fields:
 func (_m *Config) EthTxReaperInterval() time.Duration {
 	ret := _m.Called()
@@ -333,6 +499,20 @@ func (_m *Config) EthTxResendAfterThreshold() time.Duration {
 	return r0
 }
 
+// EthTxSimulateBeforeBroadcast provides a mock function with given fields:
+func (_m *Config) EthTxSimulateBeforeBroadcast() bool {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
 // GasEstimatorMode provides a mock function with given fields:
 func (_m *Config) GasEstimatorMode() string {
 	ret := _m.Called()
@@ -347,6 +527,78 @@ func (_m *Config) GasEstimatorMode() string {
 	return r0
 }
 
+// GasOracleGasPricePath provides a mock function with given fields:
+func (_m *Config) GasOracleGasPricePath() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// GasOracleGasPriceUnit provides a mock function with given fields:
+func (_m *Config) GasOracleGasPriceUnit() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// GasOracleMaxAge provides a mock function with given fields:
+func (_m *Config) GasOracleMaxAge() time.Duration {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
+// GasOraclePollPeriod provides a mock function with given fields:
+func (_m *Config) GasOraclePollPeriod() time.Duration {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
+// GasOracleURL provides a mock function with given fields:
+func (_m *Config) GasOracleURL() *url.URL {
+	ret := _m.Called()
+
+	var r0 *url.URL
+	if rf, ok := ret.Get(0).(func() *url.URL); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*url.URL)
+		}
+	}
+
+	return r0
+}
+
 // TriggerFallbackDBPollInterval provides a mock function with given fields:
 func (_m *Config) TriggerFallbackDBPollInterval() time.Duration {
 	ret := _m.Called()
@@ -3,6 +3,8 @@
 package mocks
 
 import (
+	big "math/big"
+
 	common "github.com/ethereum/go-ethereum/common"
 	bulletprooftxmanager "github.com/smartcontractkit/chainlink/core/services/bulletprooftxmanager"
 
@@ -50,20 +52,43 @@ func (_m *TxManager) Connect(head *models.Head) error {
 	return r0
 }
 
-// CreateEthTransaction provides a mock function with given fields: db, fromAddress, toAddress, payload, gasLimit, meta, strategy
-func (_m *TxManager) CreateEthTransaction(db *gorm.DB, fromAddress common.Address, toAddress common.Address, payload []byte, gasLimit uint64, meta interface{}, strategy bulletprooftxmanager.TxStrategy) (bulletprooftxmanager.EthTx, error) {
-	ret := _m.Called(db, fromAddress, toAddress, payload, gasLimit, meta, strategy)
+// CreateEthTransaction provides a mock function with given fields: db, fromAddress, toAddress, payload, gasLimit, meta, strategy, urgency, expiry
+func (_m *TxManager) CreateEthTransaction(db *gorm.DB, fromAddress common.Address, toAddress common.Address, payload []byte, gasLimit uint64, meta interface{}, strategy bulletprooftxmanager.TxStrategy, urgency bulletprooftxmanager.EthTxUrgency, expiry bulletprooftxmanager.EthTxExpiry, simulate bool) (bulletprooftxmanager.EthTx, error) {
+	ret := _m.Called(db, fromAddress, toAddress, payload, gasLimit, meta, strategy, urgency, expiry, simulate)
 
 	var r0 bulletprooftxmanager.EthTx
-	if rf, ok := ret.Get(0).(func(*gorm.DB, common.Address, common.Address, []byte, uint64, interface{}, bulletprooftxmanager.TxStrategy) bulletprooftxmanager.EthTx); ok {
-		r0 = rf(db, fromAddress, toAddress, payload, gasLimit, meta, strategy)
+	if rf, ok := ret.Get(0).(func(*gorm.DB, common.Address, common.Address, []byte, uint64, interface{}, bulletprooftxmanager.TxStrategy, bulletprooftxmanager.EthTxUrgency, bulletprooftxmanager.EthTxExpiry, bool) bulletprooftxmanager.EthTx); ok {
+		r0 = rf(db, fromAddress, toAddress, payload, gasLimit, meta, strategy, urgency, expiry, simulate)
 	} else {
 		r0 = ret.Get(0).(bulletprooftxmanager.EthTx)
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*gorm.DB, common.Address, common.Address, []byte, uint64, interface{}, bulletprooftxmanager.TxStrategy) error); ok {
-		r1 = rf(db, fromAddress, toAddress, payload, gasLimit, meta, strategy)
+	if rf, ok := ret.Get(1).(func(*gorm.DB, common.Address, common.Address, []byte, uint64, interface{}, bulletprooftxmanager.TxStrategy, bulletprooftxmanager.EthTxUrgency, bulletprooftxmanager.EthTxExpiry, bool) error); ok {
+		r1 = rf(db, fromAddress, toAddress, payload, gasLimit, meta, strategy, urgency, expiry, simulate)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ForChain provides a mock function with given fields: chainID
+func (_m *TxManager) ForChain(chainID *big.Int) (bulletprooftxmanager.TxManager, error) {
+	ret := _m.Called(chainID)
+
+	var r0 bulletprooftxmanager.TxManager
+	if rf, ok := ret.Get(0).(func(*big.Int) bulletprooftxmanager.TxManager); ok {
+		r0 = rf(chainID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(bulletprooftxmanager.TxManager)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*big.Int) error); ok {
+		r1 = rf(chainID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -101,6 +126,11 @@ func (_m *TxManager) Healthy() error {
 	return r0
 }
 
+// OnNewFinalizedHead provides a mock function with given fields: ctx, head
+func (_m *TxManager) OnNewFinalizedHead(ctx context.Context, head models.Head) {
+	_m.Called(ctx, head)
+}
+
 // OnNewLongestChain provides a mock function with given fields: ctx, head
 func (_m *TxManager) OnNewLongestChain(ctx context.Context, head models.Head) {
 	_m.Called(ctx, head)
@@ -120,6 +150,22 @@ func (_m *TxManager) Ready() error {
 	return r0
 }
 
+// RegisterConfirmationCallback provides a mock function with given fields: ethTxID, minConfirmations, fn
+func (_m *TxManager) RegisterConfirmationCallback(ethTxID int64, minConfirmations uint32, fn bulletprooftxmanager.ConfirmationCallback) func() {
+	ret := _m.Called(ethTxID, minConfirmations, fn)
+
+	var r0 func()
+	if rf, ok := ret.Get(0).(func(int64, uint32, bulletprooftxmanager.ConfirmationCallback) func()); ok {
+		r0 = rf(ethTxID, minConfirmations, fn)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(func())
+		}
+	}
+
+	return r0
+}
+
 // Start provides a mock function with given fields:
 func (_m *TxManager) Start() error {
 	ret := _m.Called()
@@ -27,12 +27,53 @@ const (
 	EthTxUnconfirmed             = EthTxState("unconfirmed")
 	EthTxConfirmed               = EthTxState("confirmed")
 	EthTxConfirmedMissingReceipt = EthTxState("confirmed_missing_receipt")
+	// EthTxBatched means the transaction was sent as part of a multicall
+	// batch, whose state it shares from then on
+	EthTxBatched = EthTxState("batched")
 
 	EthTxAttemptInProgress      = EthTxAttemptState("in_progress")
 	EthTxAttemptInsufficientEth = EthTxAttemptState("insufficient_eth")
 	EthTxAttemptBroadcast       = EthTxAttemptState("broadcast")
 )
 
+// EthTxUrgency selects how aggressively the gas price of an eth_tx is bumped
+// while it is unconfirmed
+type EthTxUrgency string
+
+const (
+	// EthTxUrgencyLow bumps gas less often, and only up to
+	// ETH_MAX_GAS_PRICE_WEI_LOW_URGENCY
+	EthTxUrgencyLow = EthTxUrgency("low")
+	// EthTxUrgencyNormal bumps gas according to ETH_GAS_BUMP_THRESHOLD and
+	// ETH_GAS_BUMP_PERCENT
+	EthTxUrgencyNormal = EthTxUrgency("normal")
+	// EthTxUrgencyHigh bumps gas more often and by a larger percentage
+	EthTxUrgencyHigh = EthTxUrgency("high")
+)
+
+// ParseEthTxUrgency parses s as an EthTxUrgency, defaulting to normal if it is
+// empty
+func ParseEthTxUrgency(s string) (EthTxUrgency, error) {
+	switch u := EthTxUrgency(s); u {
+	case "":
+		return EthTxUrgencyNormal, nil
+	case EthTxUrgencyLow, EthTxUrgencyNormal, EthTxUrgencyHigh:
+		return u, nil
+	default:
+		return "", errors.Errorf("unknown urgency %q, must be one of low, normal or high", s)
+	}
+}
+
+// EthTxExpiry is the point at which an eth_tx is cancelled if it has not been
+// confirmed. Whichever of Block and Time is reached first applies, and the
+// zero value never expires.
+type EthTxExpiry struct {
+	// Block is the block number at which the eth_tx expires
+	Block null.Int
+	// Time is the wall clock time at which the eth_tx expires
+	Time null.Time
+}
+
 type EthTaskRunTx struct {
 	TaskRunID uuid.UUID
 	EthTxID   int64
@@ -61,6 +102,30 @@ type EthTx struct {
 	// at send time.
 	Meta    datatypes.JSON
 	Subject uuid.NullUUID
+	// Urgency selects the gas bumping schedule of the transaction
+	Urgency EthTxUrgency `gorm:"default:normal"`
+	// ExpiresAt and ExpiresAtBlock are the wall clock time and block number
+	// after which the transaction is cancelled if it is still unconfirmed
+	ExpiresAt      null.Time
+	ExpiresAtBlock null.Int
+	// CancelledAt is set once the transaction has been cancelled. Any further
+	// attempts replace it with a zero-value send to FromAddress.
+	CancelledAt null.Time
+	// Simulate is false if the transaction opted out of being simulated
+	// with eth_call before it is broadcast
+	Simulate bool `gorm:"default:true"`
+	// EVMChainID is the chain the transaction is sent on. It is nil for
+	// transactions on the node's primary chain, ETH_CHAIN_ID. The evmChainID
+	// of the services of this package is the chain they run on, in the same
+	// form.
+	EVMChainID *utils.Big
+	// Batchable transactions may be batched into a single multicall
+	// transaction with others to the same contract method
+	Batchable bool
+	// BatchEthTxID is the multicall transaction a batched transaction was
+	// sent in, and BatchIndex its position among the calls of the batch
+	BatchEthTxID null.Int
+	BatchIndex   null.Int
 }
 
 func (e EthTx) GetError() error {
@@ -108,5 +173,7 @@ type EthReceipt struct {
 	BlockNumber      int64
 	TransactionIndex uint
 	Receipt          []byte
-	CreatedAt        time.Time
+	// GasUsed is null for receipts saved without it
+	GasUsed   null.Int
+	CreatedAt time.Time
 }
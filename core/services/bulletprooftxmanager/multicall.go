@@ -0,0 +1,222 @@
+package bulletprooftxmanager
+
+import (
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"gorm.io/gorm"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/eth"
+	"github.com/smartcontractkit/chainlink/core/services/postgres"
+)
+
+// multicallABI is the interface of the multicall contract which batches are
+// sent to. It calls each target in turn, without reverting if a call fails,
+// and emits a CallResult event for every call.
+var multicallABI = eth.MustGetABI(`[{"inputs":[{"components":[{"internalType":"address","name":"target","type":"address"},{"internalType":"bytes","name":"data","type":"bytes"}],"internalType":"struct Multicall.Call[]","name":"calls","type":"tuple[]"}],"name":"multicall","outputs":[],"stateMutability":"nonpayable","type":"function"},{"anonymous":false,"inputs":[{"indexed":true,"internalType":"uint256","name":"index","type":"uint256"},{"indexed":false,"internalType":"bool","name":"success","type":"bool"},{"indexed":false,"internalType":"bytes","name":"returnData","type":"bytes"}],"name":"CallResult","type":"event"}]`)
+
+// multicallGasOverheadPerCall is added to the gas limit of a batch for each
+// of its calls, to pay for the multicall contract's loop and CallResult event
+const multicallGasOverheadPerCall = 10000
+
+var (
+	promMulticallBatches = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "tx_manager_num_multicall_batches",
+		Help: "Number of multicall transactions which batched transactions were sent in",
+	},
+		[]string{"evm_chain_id"},
+	)
+	promBatchedTransactions = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "tx_manager_num_batched_transactions",
+		Help: "Number of transactions which were sent in a multicall batch",
+	},
+		[]string{"evm_chain_id"},
+	)
+	promBatchedTransactionsReverted = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "tx_manager_num_batched_transactions_reverted",
+		Help: "Number of calls of mined multicall batches which reverted",
+	},
+		[]string{"evm_chain_id"},
+	)
+)
+
+// MulticallCall is a single call of a multicall batch
+type MulticallCall struct {
+	Target common.Address
+	Data   []byte
+}
+
+// EncodeMulticallPayload encodes the payload of a multicall transaction which
+// makes calls in order
+func EncodeMulticallPayload(calls []MulticallCall) ([]byte, error) {
+	data, err := multicallABI.Pack("multicall", calls)
+	return data, errors.Wrap(err, "failed to encode multicall payload")
+}
+
+// MulticallResults decodes the CallResult events which the multicall contract
+// at multicall emitted in receipt, by call index. Calls without an event did
+// not run, e.g. because the batch ran out of gas.
+func MulticallResults(receipt Receipt, multicall common.Address) map[int64]bool {
+	results := make(map[int64]bool)
+	event := multicallABI.Events["CallResult"]
+	for _, log := range receipt.Logs {
+		if log == nil || log.Address != multicall || len(log.Topics) != 2 || log.Topics[0] != event.ID {
+			continue
+		}
+		values, err := event.Inputs.NonIndexed().Unpack(log.Data)
+		if err != nil || len(values) != 2 {
+			logger.Warnw("BulletproofTxManager: failed to decode multicall CallResult", "err", err, "txHash", receipt.TxHash)
+			continue
+		}
+		success, _ := values[0].(bool)
+		results[log.Topics[1].Big().Int64()] = success
+	}
+	return results
+}
+
+// countRevertedCalls is the number of calls of a multicall batch which did
+// not succeed. It is 0 if the receipt is not of a batch.
+func countRevertedCalls(receipt Receipt, multicall common.Address) (n int) {
+	for _, success := range MulticallResults(receipt, multicall) {
+		if !success {
+			n++
+		}
+	}
+	return n
+}
+
+// multicallGroup is a set of unstarted batchable transactions of a key to the
+// same contract method
+type multicallGroup struct {
+	ToAddress common.Address
+	Selector  []byte
+	Count     int64
+	OldestAt  time.Time
+}
+
+// batchUnstartedEthTxs batches the unstarted batchable transactions of
+// fromAddress to the same contract method into multicall transactions. A
+// group of transactions is only batched once its oldest transaction was
+// created before cutoff, and until then none of its transactions are
+// broadcast. held is true if any group is still waiting.
+//
+// Batched transactions are moved to the batched state and keep their own
+// eth_tx, whose outcome is that of the batch and its CallResult event.
+func (eb *EthBroadcaster) batchUnstartedEthTxs(multicall common.Address, fromAddress common.Address, cutoff time.Time) (held bool, err error) {
+	var groups []multicallGroup
+	err = eb.db.Raw(`
+SELECT to_address, substring(encoded_payload from 1 for 4) AS selector, count(*) AS count, min(created_at) AS oldest_at
+FROM eth_txes
+WHERE evm_chain_id IS NOT DISTINCT FROM ? AND from_address = ? AND state = 'unstarted' AND batchable AND value = 0 AND length(encoded_payload) >= 4
+GROUP BY to_address, selector
+`, eb.evmChainID, fromAddress).Scan(&groups).Error
+	if err != nil {
+		return false, errors.Wrap(err, "batchUnstartedEthTxs failed to load batchable transactions")
+	}
+
+	for _, group := range groups {
+		if group.OldestAt.After(cutoff) {
+			held = true
+			continue
+		}
+		// Groups larger than ETH_TX_MULTICALL_MAX_BATCH_SIZE are split into
+		// several batches
+		for n := group.Count; n >= 2; {
+			n, err = eb.saveMulticallBatch(multicall, fromAddress, group)
+			if err != nil {
+				return held, err
+			}
+			if n < int64(eb.config.EthTxMulticallMaxBatchSize()) {
+				break
+			}
+		}
+	}
+	return held, nil
+}
+
+// saveMulticallBatch batches the oldest transactions of group, and returns
+// how many were batched
+func (eb *EthBroadcaster) saveMulticallBatch(multicall common.Address, fromAddress common.Address, group multicallGroup) (int64, error) {
+	var etxs []EthTx
+	err := eb.db.
+		Where("evm_chain_id IS NOT DISTINCT FROM ? AND from_address = ? AND state = 'unstarted' AND batchable AND value = 0 AND to_address = ? AND substring(encoded_payload from 1 for 4) = ?", eb.evmChainID, fromAddress, group.ToAddress, group.Selector).
+		Order("created_at ASC, id ASC").
+		Limit(int(eb.config.EthTxMulticallMaxBatchSize())).
+		Find(&etxs).
+		Error
+	if err != nil {
+		return 0, errors.Wrap(err, "saveMulticallBatch failed to load transactions")
+	}
+	if len(etxs) < 2 {
+		return 0, nil
+	}
+
+	calls := make([]MulticallCall, len(etxs))
+	var gasLimit uint64
+	urgency := EthTxUrgencyLow
+	simulate := true
+	for i, etx := range etxs {
+		calls[i] = MulticallCall{Target: etx.ToAddress, Data: etx.EncodedPayload}
+		gasLimit += etx.GasLimit + multicallGasOverheadPerCall
+		// The batch is as urgent as its most urgent transaction
+		switch {
+		case etx.Urgency == EthTxUrgencyHigh:
+			urgency = EthTxUrgencyHigh
+		case etx.Urgency != EthTxUrgencyLow && urgency == EthTxUrgencyLow:
+			urgency = EthTxUrgencyNormal
+		}
+		simulate = simulate && etx.Simulate
+	}
+	payload, err := EncodeMulticallPayload(calls)
+	if err != nil {
+		return 0, errors.Wrap(err, "saveMulticallBatch failed")
+	}
+
+	var batch EthTx
+	err = postgres.GormTransactionWithDefaultContext(eb.db, func(tx *gorm.DB) error {
+		err := tx.Raw(`
+INSERT INTO eth_txes (from_address, to_address, encoded_payload, value, gas_limit, state, created_at, urgency, simulate, evm_chain_id)
+VALUES (?,?,?,0,?,'unstarted',?,?,?,?)
+RETURNING "eth_txes".*
+`, fromAddress, multicall, payload, gasLimit, etxs[0].CreatedAt, urgency, simulate, eb.evmChainID).Scan(&batch).Error
+		if err != nil {
+			return errors.Wrap(err, "failed to insert multicall eth_tx")
+		}
+		for i, etx := range etxs {
+			res := tx.Exec(`UPDATE eth_txes SET state = 'batched', batch_eth_tx_id = ?, batch_index = ? WHERE id = ? AND state = 'unstarted'`, batch.ID, i, etx.ID)
+			if res.Error != nil {
+				return errors.Wrap(res.Error, "failed to batch eth_tx")
+			}
+			if res.RowsAffected == 0 {
+				// Pruned by its strategy in the meantime, so the batch is
+				// rebuilt next time
+				return errEthTxRemoved
+			}
+		}
+		return nil
+	})
+	if errors.Is(err, errEthTxRemoved) {
+		return 0, nil
+	} else if err != nil {
+		return 0, errors.Wrap(err, "saveMulticallBatch failed")
+	}
+
+	chainID := eb.config.ChainID().String()
+	promMulticallBatches.WithLabelValues(chainID).Inc()
+	promBatchedTransactions.WithLabelValues(chainID).Add(float64(len(etxs)))
+	logger.Debugw("EthBroadcaster: batched transactions into multicall", "batchEthTxID", batch.ID, "n", len(etxs), "toAddress", group.ToAddress, "fromAddress", fromAddress)
+	return int64(len(etxs)), nil
+}
+
+// batchedCallSucceeded reports whether the call at index of a mined multicall
+// batch succeeded
+func batchedCallSucceeded(receipt Receipt, multicall common.Address, index int64) bool {
+	if receipt.Status == 0 {
+		return false
+	}
+	return MulticallResults(receipt, multicall)[index]
+}
@@ -0,0 +1,56 @@
+package bulletprooftxmanager_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/services/bulletprooftxmanager"
+	"github.com/smartcontractkit/chainlink/core/services/eth"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_EncodeMulticallPayload(t *testing.T) {
+	t.Parallel()
+
+	target := cltest.NewAddress()
+	payload, err := bulletprooftxmanager.EncodeMulticallPayload([]bulletprooftxmanager.MulticallCall{
+		{Target: target, Data: []byte{1, 2, 3, 4}},
+		{Target: target, Data: []byte{5, 6, 7, 8}},
+	})
+	require.NoError(t, err)
+
+	// multicall((address,bytes)[])
+	assert.Equal(t, []byte{0xca, 0xa5, 0xc2, 0x3f}, payload[:4])
+}
+
+var callResultEvent = eth.MustGetABI(`[{"anonymous":false,"inputs":[{"indexed":true,"internalType":"uint256","name":"index","type":"uint256"},{"indexed":false,"internalType":"bool","name":"success","type":"bool"},{"indexed":false,"internalType":"bytes","name":"returnData","type":"bytes"}],"name":"CallResult","type":"event"}]`).Events["CallResult"]
+
+func newCallResultLog(t *testing.T, address common.Address, index int64, success bool) *bulletprooftxmanager.Log {
+	data, err := callResultEvent.Inputs.NonIndexed().Pack(success, []byte{})
+	require.NoError(t, err)
+	return &bulletprooftxmanager.Log{
+		Address: address,
+		Topics:  []common.Hash{callResultEvent.ID, common.BigToHash(big.NewInt(index))},
+		Data:    data,
+	}
+}
+
+func Test_MulticallResults(t *testing.T) {
+	t.Parallel()
+
+	multicall := cltest.NewAddress()
+	receipt := bulletprooftxmanager.Receipt{
+		Status: 1,
+		Logs: []*bulletprooftxmanager.Log{
+			newCallResultLog(t, multicall, 0, true),
+			newCallResultLog(t, multicall, 1, false),
+			// Emitted by a called contract rather than the multicall contract
+			newCallResultLog(t, cltest.NewAddress(), 2, true),
+		},
+	}
+
+	assert.Equal(t, map[int64]bool{0: true, 1: false}, bulletprooftxmanager.MulticallResults(receipt, multicall))
+}
@@ -0,0 +1,193 @@
+package bulletprooftxmanager
+
+import (
+	"context"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/eth"
+	httypes "github.com/smartcontractkit/chainlink/core/services/headtracker/types"
+	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
+	"github.com/smartcontractkit/chainlink/core/services/postgres"
+	"github.com/smartcontractkit/chainlink/core/store/models"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+// defaultHeadPollInterval is how often additional chains are polled for a
+// new head
+const defaultHeadPollInterval = 5 * time.Second
+
+// NewChainBulletproofTxManager constructs a BulletproofTxManager for one of
+// the node's additional EVM chains, which is added to the primary
+// BulletproofTxManager with AddChain.
+//
+// config should be scoped to the chain, so that its gas and finality settings
+// are those of the chain. Since the HeadTracker only follows the primary
+// chain, the manager dials ethClient itself on Start and polls it for heads.
+func NewChainBulletproofTxManager(db *gorm.DB, ethClient eth.Client, config Config, keyStore KeyStore, advisoryLocker postgres.AdvisoryLocker, eventBroadcaster postgres.EventBroadcaster) *BulletproofTxManager {
+	return newBulletproofTxManager(utils.NewBig(config.ChainID()), db, ethClient, config, keyStore, advisoryLocker, eventBroadcaster, nil)
+}
+
+// AddChain registers the manager of an additional chain, which is started
+// and closed along with b
+func (b *BulletproofTxManager) AddChain(chain *BulletproofTxManager) error {
+	if b.evmChainID != nil {
+		return errors.New("chains can only be added to the manager of the primary chain")
+	}
+	if chain.evmChainID == nil {
+		return errors.New("cannot add the manager of a primary chain")
+	}
+	id := chain.evmChainID.String()
+	if id == b.config.ChainID().String() {
+		return errors.Errorf("chain %s is already the primary chain", id)
+	}
+	if _, exists := b.chains[id]; exists {
+		return errors.Errorf("chain %s was already added", id)
+	}
+	b.chains[id] = chain
+	return nil
+}
+
+// ForChain returns the manager of the given chain. A nil chainID selects the
+// node's primary chain.
+func (b *BulletproofTxManager) ForChain(chainID *big.Int) (TxManager, error) {
+	if chainID == nil || chainID.Cmp(b.config.ChainID()) == 0 {
+		return b, nil
+	}
+	chain, exists := b.chains[chainID.String()]
+	if !exists {
+		return nil, errors.Errorf("chain %s is not configured, it must be ETH_CHAIN_ID or one of ETH_ADDITIONAL_CHAINS", chainID)
+	}
+	return chain, nil
+}
+
+// setNextNonce sets the next nonce of address to nextNonce, if it is still
+// currentNonce. It returns false if the nonce had changed in the meantime.
+func setNextNonce(db *gorm.DB, evmChainID *utils.Big, address common.Address, nextNonce, currentNonce int64) (bool, error) {
+	var res *gorm.DB
+	if evmChainID == nil {
+		res = db.Exec(`UPDATE keys SET next_nonce = ?, updated_at = NOW() WHERE address = ? AND next_nonce = ?`, nextNonce, address, currentNonce)
+	} else {
+		res = db.Exec(`UPDATE eth_key_states SET next_nonce = ?, updated_at = NOW() WHERE address = ? AND evm_chain_id = ? AND next_nonce = ?`, nextNonce, address, evmChainID, currentNonce)
+	}
+	return res.RowsAffected > 0, res.Error
+}
+
+// ensureEthKeyStates creates the nonce tracking rows of keys on an additional
+// chain. New rows start at nonce 0 and are fast-forwarded by the NonceSyncer.
+func ensureEthKeyStates(db *gorm.DB, evmChainID *utils.Big, keys []ethkey.Key) error {
+	for _, key := range keys {
+		err := db.Exec(`
+INSERT INTO eth_key_states (address, evm_chain_id, next_nonce, created_at, updated_at)
+VALUES (?, ?, 0, NOW(), NOW())
+ON CONFLICT DO NOTHING`, key.Address, evmChainID).Error
+		if err != nil {
+			return errors.Wrapf(err, "failed to create key state for %s on chain %s", key.Address.Hex(), evmChainID)
+		}
+	}
+	return nil
+}
+
+// headPoller delivers the heads of an additional chain to its
+// BulletproofTxManager. Heads are linked to their parents up to the finality
+// depth, which gives the EthConfirmer the same re-org protection as on the
+// primary chain.
+type headPoller struct {
+	ethClient eth.Client
+	trackable httypes.HeadTrackable
+	interval  time.Duration
+	depth     uint
+
+	latest *models.Head
+
+	chStop chan struct{}
+	wg     sync.WaitGroup
+}
+
+func newHeadPoller(ethClient eth.Client, trackable httypes.HeadTrackable, interval time.Duration, depth uint) *headPoller {
+	return &headPoller{
+		ethClient: ethClient,
+		trackable: trackable,
+		interval:  interval,
+		depth:     depth,
+		chStop:    make(chan struct{}),
+	}
+}
+
+func (p *headPoller) Start() {
+	p.wg.Add(1)
+	go p.runLoop()
+}
+
+func (p *headPoller) Stop() {
+	close(p.chStop)
+	p.wg.Wait()
+}
+
+func (p *headPoller) runLoop() {
+	defer p.wg.Done()
+	ctx, cancel := utils.ContextFromChan(p.chStop)
+	defer cancel()
+
+	p.poll(ctx)
+
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-p.chStop:
+			return
+		case <-ticker.C:
+			p.poll(ctx)
+		}
+	}
+}
+
+func (p *headPoller) poll(ctx context.Context) {
+	queryCtx, cancel := eth.DefaultQueryCtx(ctx)
+	head, err := p.ethClient.HeadByNumber(queryCtx, nil)
+	cancel()
+	if err != nil {
+		logger.Warnw("BulletproofTxManager: failed to poll for head", "err", err)
+		return
+	}
+	if head == nil || (p.latest != nil && head.Hash == p.latest.Hash) {
+		return
+	}
+	p.latest = p.linkParents(ctx, head)
+	p.trackable.OnNewLongestChain(ctx, *p.latest)
+}
+
+// linkParents attaches the parents of head up to the finality depth. Parents
+// already known from the previous head are reused, the rest are fetched.
+func (p *headPoller) linkParents(ctx context.Context, head *models.Head) *models.Head {
+	known := make(map[common.Hash]models.Head)
+	for h := p.latest; h != nil; h = h.Parent {
+		known[h.Hash] = *h
+	}
+
+	h := head
+	for n := uint(1); n < p.depth && h.Number > 0; n++ {
+		if parent, exists := known[h.ParentHash]; exists {
+			// Copy, since the previous chain may still be in use
+			parent.Parent = nil
+			h.Parent = &parent
+		} else {
+			queryCtx, cancel := eth.DefaultQueryCtx(ctx)
+			parent, err := p.ethClient.HeadByNumber(queryCtx, big.NewInt(h.Number-1))
+			cancel()
+			if err != nil || parent == nil || parent.Hash != h.ParentHash {
+				break
+			}
+			h.Parent = parent
+		}
+		h = h.Parent
+	}
+	return head
+}
@@ -0,0 +1,309 @@
+package bulletprooftxmanager
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/eth"
+	"github.com/smartcontractkit/chainlink/core/services/postgres"
+	"github.com/smartcontractkit/chainlink/core/utils"
+	"gopkg.in/guregu/null.v4"
+	"gorm.io/gorm"
+)
+
+// ErrNonceUsedExternally is the error of eth_txes which were abandoned
+// because their nonce was consumed by a transaction sent from outside the node
+const ErrNonceUsedExternally = "abandoned: nonce was used by a transaction sent from outside this node"
+
+// NonceReconciliationReport describes the divergence found between the local
+// and on-chain nonces of a key, and what was done to repair it
+type NonceReconciliationReport struct {
+	Address common.Address
+	// ChainNonce is the nonce of the key as of the latest block, i.e. the
+	// number of transactions it has had mined
+	ChainNonce uint64
+	// PendingChainNonce also counts transactions in the eth node's mempool
+	PendingChainNonce uint64
+	// LocalNextNonce is keys.next_nonce before reconciliation
+	LocalNextNonce int64
+	// FastForwardedTo is the new keys.next_nonce, if it was behind the chain
+	FastForwardedTo null.Int
+	// AbandonedEthTxIDs are eth_txes whose nonce was used by a transaction
+	// sent from outside the node
+	AbandonedEthTxIDs []int64
+	// RebroadcastEthTxIDs are eth_txes which were missing from the eth node's
+	// mempool, leaving a gap in the nonce sequence, and were sent again
+	RebroadcastEthTxIDs []int64
+}
+
+// InSync returns true if no divergence was found
+func (r NonceReconciliationReport) InSync() bool {
+	return !r.FastForwardedTo.Valid && len(r.AbandonedEthTxIDs) == 0 && len(r.RebroadcastEthTxIDs) == 0
+}
+
+// NonceReconciler repairs the nonces of keys which are also used outside the
+// node.
+//
+// Transactions sent by an external wallet desync the local nonce from the
+// chain in two ways:
+//
+// - Overlaps: an external transaction is mined with a nonce that one of our
+// unconfirmed eth_txes also uses. Ours can never be mined, so it is marked
+// as errored and its nonce released.
+// - Gaps: the eth node drops one of our transactions, e.g. because it was
+// replaced or evicted, so that the transactions after it can never be mined.
+// These are sent again.
+//
+// Finally keys.next_nonce is fast-forwarded past any nonces which were used
+// externally.
+//
+// Reconciliation is safe to run while the EthBroadcaster and EthConfirmer
+// are running; all updates are conditional on the state it observed.
+type NonceReconciler struct {
+	db         *gorm.DB
+	ethClient  eth.Client
+	evmChainID *utils.Big
+}
+
+// NewNonceReconciler returns a new reconciler
+func NewNonceReconciler(db *gorm.DB, ethClient eth.Client) *NonceReconciler {
+	return &NonceReconciler{db, ethClient, nil}
+}
+
+// Reconcile detects and repairs nonce divergence for the given address, and
+// logs a report of what it did
+func (r *NonceReconciler) Reconcile(ctx context.Context, address common.Address) (report NonceReconciliationReport, err error) {
+	report.Address = address
+
+	queryCtx, cancel := eth.DefaultQueryCtx(ctx)
+	defer cancel()
+	report.ChainNonce, err = r.ethClient.NonceAt(queryCtx, address, nil)
+	if err != nil {
+		return report, errors.Wrap(err, "NonceReconciler#Reconcile failed to fetch chain nonce")
+	}
+	report.PendingChainNonce, err = r.ethClient.PendingNonceAt(queryCtx, address)
+	if err != nil {
+		return report, errors.Wrap(err, "NonceReconciler#Reconcile failed to fetch pending chain nonce")
+	}
+
+	etxs, err := r.findBroadcastEthTxs(address)
+	if err != nil {
+		return report, errors.Wrap(err, "NonceReconciler#Reconcile failed to load transactions")
+	}
+	for _, etx := range etxs {
+		nonce := uint64(*etx.Nonce)
+		if nonce < report.ChainNonce {
+			abandoned, err := r.abandonIfUsedExternally(ctx, etx)
+			if err != nil {
+				return report, errors.Wrapf(err, "NonceReconciler#Reconcile failed to check eth_tx %d", etx.ID)
+			}
+			if abandoned {
+				report.AbandonedEthTxIDs = append(report.AbandonedEthTxIDs, etx.ID)
+			}
+		} else if nonce >= report.PendingChainNonce && etx.State == EthTxUnconfirmed {
+			if r.rebroadcast(ctx, etx) {
+				report.RebroadcastEthTxIDs = append(report.RebroadcastEthTxIDs, etx.ID)
+			}
+		}
+	}
+
+	report.LocalNextNonce, report.FastForwardedTo, err = r.fastForwardNonce(address, report.PendingChainNonce)
+	if err != nil {
+		return report, errors.Wrap(err, "NonceReconciler#Reconcile failed to fast-forward nonce")
+	}
+
+	logReconciliationReport(report)
+	return report, nil
+}
+
+// ReconcileAll reconciles the nonces of the given addresses one at a time
+func (r *NonceReconciler) ReconcileAll(ctx context.Context, addresses []common.Address) (reports []NonceReconciliationReport, err error) {
+	for _, address := range addresses {
+		report, err := r.Reconcile(ctx, address)
+		if err != nil {
+			return reports, err
+		}
+		reports = append(reports, report)
+	}
+	return reports, nil
+}
+
+func (r *NonceReconciler) findBroadcastEthTxs(address common.Address) (etxs []EthTx, err error) {
+	err = postgres.DBWithDefaultContext(r.db, func(db *gorm.DB) error {
+		return db.
+			Preload("EthTxAttempts", func(db *gorm.DB) *gorm.DB {
+				return db.Where("state = ?", EthTxAttemptBroadcast).Order("eth_tx_attempts.gas_price DESC")
+			}).
+			Preload("EthTxAttempts.EthReceipts").
+			Where("evm_chain_id IS NOT DISTINCT FROM ? AND from_address = ? AND state IN ('unconfirmed', 'confirmed_missing_receipt')", r.evmChainID, address).
+			Order("nonce ASC").
+			Find(&etxs).Error
+	})
+	return etxs, errors.WithStack(err)
+}
+
+// abandonIfUsedExternally marks the eth_tx as errored if none of its attempts
+// was mined, even though its nonce has been used
+func (r *NonceReconciler) abandonIfUsedExternally(ctx context.Context, etx EthTx) (bool, error) {
+	for _, attempt := range etx.EthTxAttempts {
+		if len(attempt.EthReceipts) > 0 {
+			return false, nil
+		}
+		queryCtx, cancel := eth.DefaultQueryCtx(ctx)
+		receipt, err := r.ethClient.TransactionReceipt(queryCtx, attempt.Hash)
+		cancel()
+		if errors.Is(err, ethereum.NotFound) {
+			continue
+		} else if err != nil {
+			return false, errors.Wrapf(err, "failed to fetch receipt for attempt %s", attempt.Hash.Hex())
+		}
+		if receipt != nil {
+			// One of our attempts was mined; the EthConfirmer will pick it up
+			return false, nil
+		}
+	}
+
+	var rowsAffected int64
+	err := postgres.DBWithDefaultContext(r.db, func(db *gorm.DB) error {
+		res := db.Exec(`
+UPDATE eth_txes SET state = 'fatal_error', nonce = NULL, error = ?, broadcast_at = NULL
+WHERE id = ? AND nonce = ? AND state IN ('unconfirmed', 'confirmed_missing_receipt')
+`, ErrNonceUsedExternally, etx.ID, *etx.Nonce)
+		rowsAffected = res.RowsAffected
+		return res.Error
+	})
+	return rowsAffected > 0, errors.Wrap(err, "failed to abandon eth_tx")
+}
+
+// rebroadcast sends the highest priced attempt of the eth_tx again
+func (r *NonceReconciler) rebroadcast(ctx context.Context, etx EthTx) bool {
+	if len(etx.EthTxAttempts) == 0 {
+		return false
+	}
+	attempt := etx.EthTxAttempts[0]
+	sendError := sendTransaction(ctx, r.ethClient, attempt, etx)
+	if sendError != nil && !sendError.IsTransactionAlreadyInMempool() {
+		logger.Warnw("NonceReconciler: failed to rebroadcast transaction", "ethTxID", etx.ID, "nonce", *etx.Nonce, "txHash", attempt.Hash, "err", sendError)
+		return false
+	}
+	return true
+}
+
+// fastForwardNonce moves keys.next_nonce up to the pending chain nonce if it
+// is behind.
+// It is skipped if the EthBroadcaster is in the middle of sending a
+// transaction, and retried on the next run.
+func (r *NonceReconciler) fastForwardNonce(address common.Address, chainNonce uint64) (localNonce int64, fastForwardedTo null.Int, err error) {
+	err = postgres.DBWithDefaultContext(r.db, func(db *gorm.DB) error {
+		localNonce, err = GetNextNonce(db, r.evmChainID, address)
+		if err != nil {
+			return err
+		}
+		if chainNonce <= uint64(localNonce) {
+			return nil
+		}
+		var inProgress bool
+		if err = db.Raw(`SELECT EXISTS(SELECT 1 FROM eth_txes WHERE state = 'in_progress' AND evm_chain_id IS NOT DISTINCT FROM ? AND from_address = ?)`, r.evmChainID, address).Scan(&inProgress).Error; err != nil {
+			return errors.Wrap(err, "failed to query for in_progress transaction")
+		}
+		if inProgress {
+			logger.Debugw("NonceReconciler: transaction in progress, not fast-forwarding nonce", "address", address, "localNonce", localNonce, "chainNonce", chainNonce)
+			return nil
+		}
+		// Use next_nonce as an optimistic lock, in case the EthBroadcaster
+		// has moved it since we read it
+		updated, err := setNextNonce(db, r.evmChainID, address, int64(chainNonce), localNonce)
+		if err != nil {
+			return errors.Wrap(err, "failed to update next_nonce")
+		}
+		if updated {
+			fastForwardedTo = null.IntFrom(int64(chainNonce))
+		}
+		return nil
+	})
+	return localNonce, fastForwardedTo, err
+}
+
+func logReconciliationReport(report NonceReconciliationReport) {
+	fields := []interface{}{
+		"address", report.Address,
+		"chainNonce", report.ChainNonce,
+		"pendingChainNonce", report.PendingChainNonce,
+		"localNextNonce", report.LocalNextNonce,
+		"fastForwardedTo", report.FastForwardedTo,
+		"abandonedEthTxIDs", report.AbandonedEthTxIDs,
+		"rebroadcastEthTxIDs", report.RebroadcastEthTxIDs,
+	}
+	if report.InSync() {
+		logger.Debugw("NonceReconciler: nonces in sync", fields...)
+		return
+	}
+	logger.Warnw(fmt.Sprintf("NonceReconciler: address %s has been used outside this node and its nonces were reconciled. "+
+		"%d transaction(s) were abandoned and %d rebroadcast. "+
+		"Please note that using the chainlink keys with an external wallet is NOT SUPPORTED and can lead to missed or stuck transactions.",
+		report.Address.Hex(), len(report.AbandonedEthTxIDs), len(report.RebroadcastEthTxIDs)), fields...)
+}
+
+// nonceReconciliationLoop periodically reconciles the nonces of all keys
+type nonceReconciliationLoop struct {
+	reconciler *NonceReconciler
+	keyStore   KeyStore
+	interval   time.Duration
+
+	chStop chan struct{}
+	wg     sync.WaitGroup
+}
+
+func newNonceReconciliationLoop(reconciler *NonceReconciler, keyStore KeyStore, interval time.Duration) *nonceReconciliationLoop {
+	return &nonceReconciliationLoop{
+		reconciler: reconciler,
+		keyStore:   keyStore,
+		interval:   interval,
+		chStop:     make(chan struct{}),
+	}
+}
+
+func (l *nonceReconciliationLoop) Start() {
+	logger.Infof("NonceReconciler: Enabled with interval of %s", l.interval)
+	l.wg.Add(1)
+	go l.runLoop()
+}
+
+func (l *nonceReconciliationLoop) Stop() {
+	close(l.chStop)
+	l.wg.Wait()
+}
+
+func (l *nonceReconciliationLoop) runLoop() {
+	defer l.wg.Done()
+	ctx, cancel := utils.ContextFromChan(l.chStop)
+	defer cancel()
+
+	ticker := time.NewTicker(utils.WithJitter(l.interval))
+	defer ticker.Stop()
+	for {
+		select {
+		case <-l.chStop:
+			return
+		case <-ticker.C:
+			keys, err := l.keyStore.AllKeys()
+			if err != nil {
+				logger.Errorw("NonceReconciler: failed to load keys", "err", err)
+				continue
+			}
+			addresses := make([]common.Address, len(keys))
+			for i, key := range keys {
+				addresses[i] = key.Address.Address()
+			}
+			if _, err := l.reconciler.ReconcileAll(ctx, addresses); err != nil {
+				logger.Errorw("NonceReconciler: failed to reconcile nonces", "err", err)
+			}
+		}
+	}
+}
@@ -0,0 +1,157 @@
+package bulletprooftxmanager_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	gethTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/internal/mocks"
+	"github.com/smartcontractkit/chainlink/core/services/bulletprooftxmanager"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_NonceReconciler_Reconcile(t *testing.T) {
+	t.Parallel()
+
+	t.Run("does nothing if nonces are in sync", func(t *testing.T) {
+		store, cleanup := cltest.NewStore(t)
+		defer cleanup()
+		db := store.DB
+		ethClient := new(mocks.Client)
+
+		k := cltest.MustInsertRandomKey(t, db, int64(2))
+		from := k.Address.Address()
+		cltest.MustInsertUnconfirmedEthTxWithBroadcastAttempt(t, db, 1, from)
+
+		ethClient.On("NonceAt", mock.Anything, from, (*big.Int)(nil)).Return(uint64(1), nil)
+		ethClient.On("PendingNonceAt", mock.Anything, from).Return(uint64(2), nil)
+
+		report, err := bulletprooftxmanager.NewNonceReconciler(db, ethClient).Reconcile(context.Background(), from)
+		require.NoError(t, err)
+
+		assert.True(t, report.InSync())
+		assert.Equal(t, int64(2), report.LocalNextNonce)
+		assertDatabaseNonce(t, db, from, 2)
+
+		ethClient.AssertExpectations(t)
+	})
+
+	t.Run("abandons transactions whose nonce was used externally and fast-forwards the nonce", func(t *testing.T) {
+		store, cleanup := cltest.NewStore(t)
+		defer cleanup()
+		db := store.DB
+		ethClient := new(mocks.Client)
+
+		k := cltest.MustInsertRandomKey(t, db, int64(2))
+		from := k.Address.Address()
+		etx0 := cltest.MustInsertUnconfirmedEthTxWithBroadcastAttempt(t, db, 0, from)
+		etx1 := cltest.MustInsertUnconfirmedEthTxWithBroadcastAttempt(t, db, 1, from)
+
+		ethClient.On("NonceAt", mock.Anything, from, (*big.Int)(nil)).Return(uint64(5), nil)
+		ethClient.On("PendingNonceAt", mock.Anything, from).Return(uint64(5), nil)
+		// etx0 was mined, etx1's nonce was used by an external transaction
+		ethClient.On("TransactionReceipt", mock.Anything, etx0.EthTxAttempts[0].Hash).Return(&gethTypes.Receipt{TxHash: etx0.EthTxAttempts[0].Hash}, nil)
+		ethClient.On("TransactionReceipt", mock.Anything, etx1.EthTxAttempts[0].Hash).Return(nil, ethereum.NotFound)
+
+		report, err := bulletprooftxmanager.NewNonceReconciler(db, ethClient).Reconcile(context.Background(), from)
+		require.NoError(t, err)
+
+		assert.False(t, report.InSync())
+		assert.Equal(t, []int64{etx1.ID}, report.AbandonedEthTxIDs)
+		assert.Equal(t, int64(5), report.FastForwardedTo.Int64)
+		assertDatabaseNonce(t, db, from, 5)
+
+		etx0, err = cltest.FindEthTxWithAttempts(db, etx0.ID)
+		require.NoError(t, err)
+		assert.Equal(t, bulletprooftxmanager.EthTxUnconfirmed, etx0.State)
+
+		etx1, err = cltest.FindEthTxWithAttempts(db, etx1.ID)
+		require.NoError(t, err)
+		assert.Equal(t, bulletprooftxmanager.EthTxFatalError, etx1.State)
+		assert.Nil(t, etx1.Nonce)
+		assert.Equal(t, bulletprooftxmanager.ErrNonceUsedExternally, etx1.Error.String)
+
+		ethClient.AssertExpectations(t)
+	})
+
+	t.Run("rebroadcasts transactions missing from the mempool", func(t *testing.T) {
+		store, cleanup := cltest.NewStore(t)
+		defer cleanup()
+		db := store.DB
+		ethClient := new(mocks.Client)
+
+		k := cltest.MustInsertRandomKey(t, db, int64(2))
+		from := k.Address.Address()
+		etx := cltest.MustInsertUnconfirmedEthTxWithBroadcastAttempt(t, db, 1, from)
+
+		ethClient.On("NonceAt", mock.Anything, from, (*big.Int)(nil)).Return(uint64(1), nil)
+		ethClient.On("PendingNonceAt", mock.Anything, from).Return(uint64(1), nil)
+		ethClient.On("SendTransaction", mock.Anything, mock.MatchedBy(func(tx *gethTypes.Transaction) bool {
+			return tx.Nonce() == uint64(1)
+		})).Return(nil).Once()
+
+		report, err := bulletprooftxmanager.NewNonceReconciler(db, ethClient).Reconcile(context.Background(), from)
+		require.NoError(t, err)
+
+		assert.Equal(t, []int64{etx.ID}, report.RebroadcastEthTxIDs)
+		assert.False(t, report.FastForwardedTo.Valid)
+		assertDatabaseNonce(t, db, from, 2)
+
+		ethClient.AssertExpectations(t)
+	})
+
+	t.Run("does not fast-forward the nonce while a transaction is in progress", func(t *testing.T) {
+		store, cleanup := cltest.NewStore(t)
+		defer cleanup()
+		db := store.DB
+		ethClient := new(mocks.Client)
+
+		k := cltest.MustInsertRandomKey(t, db, int64(0))
+		from := k.Address.Address()
+		cltest.MustInsertInProgressEthTxWithAttempt(t, db, 0, from)
+
+		ethClient.On("NonceAt", mock.Anything, from, (*big.Int)(nil)).Return(uint64(3), nil)
+		ethClient.On("PendingNonceAt", mock.Anything, from).Return(uint64(3), nil)
+
+		report, err := bulletprooftxmanager.NewNonceReconciler(db, ethClient).Reconcile(context.Background(), from)
+		require.NoError(t, err)
+
+		assert.False(t, report.FastForwardedTo.Valid)
+		assertDatabaseNonce(t, db, from, 0)
+
+		ethClient.AssertExpectations(t)
+	})
+}
+
+func Test_NonceReconciler_ReconcileAll(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+	db := store.DB
+	ethClient := new(mocks.Client)
+
+	k1 := cltest.MustInsertRandomKey(t, db, int64(0))
+	k2 := cltest.MustInsertRandomKey(t, db, int64(4))
+	addresses := []common.Address{k1.Address.Address(), k2.Address.Address()}
+
+	ethClient.On("NonceAt", mock.Anything, mock.Anything, (*big.Int)(nil)).Return(uint64(4), nil)
+	ethClient.On("PendingNonceAt", mock.Anything, mock.Anything).Return(uint64(4), nil)
+
+	reports, err := bulletprooftxmanager.NewNonceReconciler(db, ethClient).ReconcileAll(context.Background(), addresses)
+	require.NoError(t, err)
+	require.Len(t, reports, 2)
+
+	assert.Equal(t, int64(4), reports[0].FastForwardedTo.Int64)
+	assert.True(t, reports[1].InSync())
+	assertDatabaseNonce(t, db, k1.Address.Address(), 4)
+	assertDatabaseNonce(t, db, k2.Address.Address(), 4)
+
+	ethClient.AssertExpectations(t)
+}
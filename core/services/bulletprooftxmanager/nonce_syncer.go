@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 	"sync"
-	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/pkg/errors"
@@ -12,6 +11,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/services/eth"
 	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
 	"github.com/smartcontractkit/chainlink/core/services/postgres"
+	"github.com/smartcontractkit/chainlink/core/utils"
 	"go.uber.org/multierr"
 	"gorm.io/gorm"
 )
@@ -50,8 +50,9 @@ type (
 	// This gives us re-org protection up to ETH_FINALITY_DEPTH deep in the
 	// worst case, which is in line with our other guarantees.
 	NonceSyncer struct {
-		db        *gorm.DB
-		ethClient eth.Client
+		db         *gorm.DB
+		ethClient  eth.Client
+		evmChainID *utils.Big
 	}
 	// NSinserttx represents an EthTx and Attempt to be inserted together
 	NSinserttx struct {
@@ -65,6 +66,7 @@ func NewNonceSyncer(db *gorm.DB, ethClient eth.Client) *NonceSyncer {
 	return &NonceSyncer{
 		db,
 		ethClient,
+		nil,
 	}
 }
 
@@ -104,7 +106,7 @@ func (s NonceSyncer) fastForwardNonceIfNecessary(ctx context.Context, address co
 
 	selectCtx, cancel := postgres.DefaultQueryCtx()
 	defer cancel()
-	keyNextNonce, err := GetNextNonce(s.db.WithContext(selectCtx), address)
+	keyNextNonce, err := GetNextNonce(s.db.WithContext(selectCtx), s.evmChainID, address)
 	if err != nil {
 		return err
 	}
@@ -138,11 +140,11 @@ func (s NonceSyncer) fastForwardNonceIfNecessary(ctx context.Context, address co
 	//  We pass in next_nonce here as an optimistic lock to make sure it
 	//  didn't get changed out from under us. Shouldn't happen but can't hurt.
 	return postgres.DBWithDefaultContext(s.db, func(db *gorm.DB) error {
-		res := db.Exec(`UPDATE keys SET next_nonce = ?, updated_at = ? WHERE address = ? AND next_nonce = ?`, newNextNonce, time.Now(), address, keyNextNonce)
-		if res.Error != nil {
-			return errors.Wrap(res.Error, "NonceSyncer#fastForwardNonceIfNecessary failed to update keys.next_nonce")
+		updated, err := setNextNonce(db, s.evmChainID, address, int64(newNextNonce), keyNextNonce)
+		if err != nil {
+			return errors.Wrap(err, "NonceSyncer#fastForwardNonceIfNecessary failed to update next_nonce")
 		}
-		if res.RowsAffected == 0 {
+		if !updated {
 			return errors.Errorf("NonceSyncer#fastForwardNonceIfNecessary optimistic lock failure fastforwarding nonce %v to %v for key %s", localNonce, chainNonce, address.Hex())
 		}
 		return nil
@@ -158,7 +160,7 @@ func (s NonceSyncer) pendingNonceFromEthClient(ctx context.Context, account comm
 
 func (s NonceSyncer) hasInProgressTransaction(account common.Address) (exists bool, err error) {
 	err = postgres.DBWithDefaultContext(s.db, func(db *gorm.DB) error {
-		return db.Raw(`SELECT EXISTS(SELECT 1 FROM eth_txes WHERE state = 'in_progress' AND from_address = ?)`, account).Scan(&exists).Error
+		return db.Raw(`SELECT EXISTS(SELECT 1 FROM eth_txes WHERE state = 'in_progress' AND evm_chain_id IS NOT DISTINCT FROM ? AND from_address = ?)`, s.evmChainID, account).Scan(&exists).Error
 	})
 	return
 }
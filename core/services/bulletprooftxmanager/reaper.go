@@ -9,6 +9,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/logger"
 	"github.com/smartcontractkit/chainlink/core/services/postgres"
 	"github.com/smartcontractkit/chainlink/core/store/models"
+	"github.com/smartcontractkit/chainlink/core/utils"
 	"gorm.io/gorm"
 )
 
@@ -27,6 +28,7 @@ type Reaper struct {
 	config         ReaperConfig
 	log            *logger.Logger
 	latestBlockNum int64
+	evmChainID     *utils.Big
 	trigger        chan struct{}
 	chStop         chan struct{}
 	chDone         chan struct{}
@@ -39,6 +41,7 @@ func NewReaper(db *gorm.DB, config ReaperConfig) *Reaper {
 		config,
 		logger.CreateLogger(logger.Default.With("id", "bptxm_reaper")),
 		-1,
+		nil,
 		make(chan struct{}, 1),
 		make(chan struct{}),
 		make(chan struct{}),
@@ -83,6 +86,11 @@ func (r *Reaper) work() {
 	if err != nil {
 		r.log.Error("BPTXMReaper: unable to reap old eth_txes: ", err)
 	}
+	if r.evmChainID != nil {
+		// Job runs are not chain specific, the reaper of the primary chain
+		// takes care of them
+		return
+	}
 	err = r.ReapJobRuns()
 	if err != nil {
 		r.log.Error("BPTXMReaper: unable to reap old runs: ", err)
@@ -120,9 +128,11 @@ func (r *Reaper) ReapEthTxes(headNum int64) error {
 	err := postgres.Batch(func(_, limit uint) (count uint, err error) {
 		res := r.db.Exec(`
 WITH old_enough_receipts AS (
-	SELECT tx_hash FROM eth_receipts
-	WHERE block_number < ?
-	ORDER BY block_number ASC, id ASC
+	SELECT eth_receipts.tx_hash FROM eth_receipts
+	JOIN eth_tx_attempts ON eth_tx_attempts.hash = eth_receipts.tx_hash
+	JOIN eth_txes ON eth_txes.id = eth_tx_attempts.eth_tx_id AND eth_txes.evm_chain_id IS NOT DISTINCT FROM ?
+	WHERE eth_receipts.block_number < ?
+	ORDER BY eth_receipts.block_number ASC, eth_receipts.id ASC
 	LIMIT ?
 )
 DELETE FROM eth_txes
@@ -130,7 +140,7 @@ USING old_enough_receipts, eth_tx_attempts
 WHERE eth_tx_attempts.eth_tx_id = eth_txes.id
 AND eth_tx_attempts.hash = old_enough_receipts.tx_hash
 AND eth_txes.created_at < ?
-AND eth_txes.state = 'confirmed'`, minBlockNumberToKeep, limit, timeThreshold)
+AND eth_txes.state = 'confirmed'`, r.evmChainID, minBlockNumberToKeep, limit, timeThreshold)
 		if res.Error != nil {
 			return count, res.Error
 		}
@@ -144,7 +154,8 @@ AND eth_txes.state = 'confirmed'`, minBlockNumberToKeep, limit, timeThreshold)
 		res := r.db.Exec(`
 DELETE FROM eth_txes
 WHERE created_at < ?
-AND state = 'fatal_error'`, timeThreshold)
+AND state = 'fatal_error'
+AND evm_chain_id IS NOT DISTINCT FROM ?`, timeThreshold, r.evmChainID)
 		if res.Error != nil {
 			return count, res.Error
 		}
@@ -0,0 +1,74 @@
+package bulletprooftxmanager
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+
+	"github.com/smartcontractkit/chainlink/core/services/eth"
+)
+
+// ErrEthTxSimulationReverted prefixes the error of eth_txes which were not
+// broadcast because they reverted when simulated
+const ErrEthTxSimulationReverted = "transaction reverted in simulation"
+
+// revertErrorCode is the JSON-RPC error code geth returns for eth_calls which
+// reverted
+const revertErrorCode = 3
+
+// simulateEthTx runs the transaction with eth_call against the latest block.
+// If it reverted, the decoded revert reason is returned. Any other error,
+// e.g. from the eth node being unreachable, is returned as err.
+func simulateEthTx(ctx context.Context, ethClient eth.Client, etx EthTx) (reverted bool, reason string, err error) {
+	to := etx.ToAddress
+	msg := ethereum.CallMsg{
+		From:  etx.FromAddress,
+		To:    &to,
+		Gas:   etx.GasLimit,
+		Value: etx.Value.ToInt(),
+		Data:  etx.EncodedPayload,
+	}
+	_, err = ethClient.CallContract(ctx, msg, nil)
+	if err == nil {
+		return false, "", nil
+	}
+	if !isRevertError(err) {
+		return false, "", errors.Wrap(err, "eth_call failed")
+	}
+	reason, extractErr := eth.ExtractRevertReasonFromRPCError(err)
+	if extractErr != nil || reason == "" {
+		// The node did not return the revert data, e.g. because the
+		// contract reverted without a reason
+		reason = err.Error()
+	}
+	return true, reason, nil
+}
+
+func isRevertError(err error) bool {
+	var rpcErr rpc.Error
+	if errors.As(err, &rpcErr) && rpcErr.ErrorCode() == revertErrorCode {
+		return true
+	}
+	msg := strings.ToLower(err.Error())
+	// geth: "execution reverted", parity/openethereum: "VM execution error."
+	return strings.Contains(msg, "revert") || strings.Contains(msg, "vm execution error")
+}
+
+// saveSimulationRevertedTransaction marks an unstarted eth_tx which reverted
+// in simulation as errored, so that it is never broadcast and does not use up
+// a nonce
+func saveSimulationRevertedTransaction(db *gorm.DB, etx *EthTx, reason string) error {
+	if etx.State != EthTxUnstarted {
+		return errors.Errorf("can only fail simulation of unstarted transactions, transaction is currently %s", etx.State)
+	}
+	etx.Nonce = nil
+	etx.State = EthTxFatalError
+	etx.Error.SetValid(fmt.Sprintf("%s: %s", ErrEthTxSimulationReverted, reason))
+	err := db.Exec(`UPDATE eth_txes SET state = 'fatal_error', error = ? WHERE id = ? AND state = 'unstarted'`, etx.Error, etx.ID).Error
+	return errors.Wrap(err, "saveSimulationRevertedTransaction failed")
+}
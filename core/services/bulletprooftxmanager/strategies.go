@@ -20,15 +20,23 @@ func NewQueueingTxStrategy(subject uuid.UUID, queueSize uint32) (strategy TxStra
 	if queueSize > 0 {
 		strategy = NewDropOldestStrategy(subject, queueSize)
 	} else {
-		strategy = SendEveryStrategy{}
+		strategy = NewSendEveryStrategy(subject)
 	}
 	return
 }
 
 // SendEveryStrategy will always send the tx
-type SendEveryStrategy struct{}
+type SendEveryStrategy struct {
+	subject uuid.NullUUID
+}
+
+// NewSendEveryStrategy returns a SendEveryStrategy which saves subject, so
+// that the transactions are attributed to it, e.g. for spend budgets
+func NewSendEveryStrategy(subject uuid.UUID) SendEveryStrategy {
+	return SendEveryStrategy{uuid.NullUUID{UUID: subject, Valid: true}}
+}
 
-func (SendEveryStrategy) Subject() uuid.NullUUID             { return uuid.NullUUID{} }
+func (s SendEveryStrategy) Subject() uuid.NullUUID           { return s.subject }
 func (SendEveryStrategy) PruneQueue(*gorm.DB) (int64, error) { return 0, nil }
 
 var _ TxStrategy = DropOldestStrategy{}
@@ -63,3 +71,19 @@ id < (
 )`, s.subject, s.subject, s.queueSize)
 	return res.RowsAffected, res.Error
 }
+
+var _ TxStrategy = BatchingStrategy{}
+
+// BatchingStrategy marks the transactions of the wrapped strategy as
+// batchable. If ETH_TX_MULTICALL_ADDRESS is set, the EthBroadcaster batches
+// those with the same sender and contract method into a single multicall
+// transaction. The target contract will see the multicall contract as the
+// sender, so it must accept calls from it.
+type BatchingStrategy struct {
+	TxStrategy
+}
+
+// NewBatchingStrategy wraps strategy so that its transactions are batchable
+func NewBatchingStrategy(strategy TxStrategy) BatchingStrategy {
+	return BatchingStrategy{strategy}
+}
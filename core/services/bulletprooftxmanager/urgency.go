@@ -0,0 +1,78 @@
+package bulletprooftxmanager
+
+import (
+	"math/big"
+
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/services/gas"
+)
+
+// GasBumpThresholds are the number of blocks to wait before bumping gas on
+// unconfirmed transactions of each urgency. A Normal threshold of 0 disables
+// gas bumping.
+type GasBumpThresholds struct {
+	Low, Normal, High int64
+}
+
+// NewGasBumpThresholds returns the gas bump thresholds set in config
+func NewGasBumpThresholds(config Config) GasBumpThresholds {
+	return GasBumpThresholds{
+		Low:    int64(config.EthGasBumpThresholdLowUrgency()),
+		Normal: int64(config.EthGasBumpThreshold()),
+		High:   int64(config.EthGasBumpThresholdHighUrgency()),
+	}
+}
+
+// bumpGas bumps the gas price of an attempt of etx according to its urgency:
+// high urgency transactions are bumped by at least
+// ETH_GAS_BUMP_PERCENT_HIGH_URGENCY, and low urgency transactions never above
+// ETH_MAX_GAS_PRICE_WEI_LOW_URGENCY
+func bumpGas(config Config, estimator gas.Estimator, etx EthTx, originalGasPrice *big.Int) (bumpedGasPrice *big.Int, chainSpecificGasLimit uint64, err error) {
+	bumpedGasPrice, chainSpecificGasLimit, err = estimator.BumpGas(originalGasPrice, etx.GasLimit)
+	if err != nil {
+		return bumpedGasPrice, chainSpecificGasLimit, err
+	}
+
+	switch etx.Urgency {
+	case EthTxUrgencyHigh:
+		byPercentage := new(big.Int).Mul(originalGasPrice, big.NewInt(int64(100+config.EthGasBumpPercentHighUrgency())))
+		byPercentage.Div(byPercentage, big.NewInt(100))
+		if byPercentage.Cmp(bumpedGasPrice) > 0 {
+			bumpedGasPrice = byPercentage
+		}
+		if max := maxGasPrice(config, etx); bumpedGasPrice.Cmp(max) > 0 {
+			bumpedGasPrice = max
+		}
+	case EthTxUrgencyLow:
+		max := maxGasPrice(config, etx)
+		if bumpedGasPrice.Cmp(max) > 0 {
+			if originalGasPrice.Cmp(max) >= 0 {
+				return max, chainSpecificGasLimit, errors.Errorf("bumped gas price of %s would exceed configured max gas price of %s for low urgency transactions (original price was %s)",
+					bumpedGasPrice.String(), max.String(), originalGasPrice.String())
+			}
+			bumpedGasPrice = max
+		}
+	}
+	return bumpedGasPrice, chainSpecificGasLimit, nil
+}
+
+// maxGasPrice is the highest gas price etx may be bumped to
+func maxGasPrice(config Config, etx EthTx) *big.Int {
+	if etx.Urgency == EthTxUrgencyLow {
+		return config.EthMaxGasPriceWeiLowUrgency()
+	}
+	return config.EthMaxGasPriceWei()
+}
+
+// initialGasPrice caps the estimated gas price of the first attempt of etx at
+// ETH_MAX_GAS_PRICE_WEI_LOW_URGENCY if it is low urgency
+func initialGasPrice(config Config, etx EthTx, estimatedGasPrice *big.Int) *big.Int {
+	if etx.Urgency != EthTxUrgencyLow {
+		return estimatedGasPrice
+	}
+	if max := maxGasPrice(config, etx); estimatedGasPrice.Cmp(max) > 0 {
+		return max
+	}
+	return estimatedGasPrice
+}
@@ -0,0 +1,92 @@
+package bulletprooftxmanager_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/services/bulletprooftxmanager"
+	bptxmmocks "github.com/smartcontractkit/chainlink/core/services/bulletprooftxmanager/mocks"
+	gasmocks "github.com/smartcontractkit/chainlink/core/services/gas/mocks"
+)
+
+func Test_ParseEthTxUrgency(t *testing.T) {
+	t.Parallel()
+
+	for s, expected := range map[string]bulletprooftxmanager.EthTxUrgency{
+		"":       bulletprooftxmanager.EthTxUrgencyNormal,
+		"low":    bulletprooftxmanager.EthTxUrgencyLow,
+		"normal": bulletprooftxmanager.EthTxUrgencyNormal,
+		"high":   bulletprooftxmanager.EthTxUrgencyHigh,
+	} {
+		urgency, err := bulletprooftxmanager.ParseEthTxUrgency(s)
+		require.NoError(t, err)
+		assert.Equal(t, expected, urgency)
+	}
+
+	_, err := bulletprooftxmanager.ParseEthTxUrgency("urgent")
+	assert.EqualError(t, err, `unknown urgency "urgent", must be one of low, normal or high`)
+}
+
+func Test_BumpGas(t *testing.T) {
+	t.Parallel()
+
+	config := new(bptxmmocks.Config)
+	config.On("EthGasBumpPercentHighUrgency").Return(uint16(50))
+	config.On("EthMaxGasPriceWei").Return(big.NewInt(1000))
+	config.On("EthMaxGasPriceWeiLowUrgency").Return(big.NewInt(200))
+
+	estimator := new(gasmocks.Estimator)
+	// The estimator bumps by 20%
+	estimator.On("BumpGas", big.NewInt(100), uint64(21000)).Return(big.NewInt(120), uint64(21000), nil)
+	estimator.On("BumpGas", big.NewInt(180), uint64(21000)).Return(big.NewInt(216), uint64(21000), nil)
+	estimator.On("BumpGas", big.NewInt(200), uint64(21000)).Return(big.NewInt(240), uint64(21000), nil)
+	estimator.On("BumpGas", big.NewInt(800), uint64(21000)).Return(big.NewInt(960), uint64(21000), nil)
+
+	tests := []struct {
+		name             string
+		urgency          bulletprooftxmanager.EthTxUrgency
+		originalGasPrice int64
+		expectedGasPrice int64
+		expectedErr      string
+	}{
+		{"normal bumps according to the estimator", bulletprooftxmanager.EthTxUrgencyNormal, 100, 120, ""},
+		{"high bumps by the high urgency percentage", bulletprooftxmanager.EthTxUrgencyHigh, 100, 150, ""},
+		{"high is capped at the max gas price", bulletprooftxmanager.EthTxUrgencyHigh, 800, 1000, ""},
+		{"low bumps according to the estimator", bulletprooftxmanager.EthTxUrgencyLow, 100, 120, ""},
+		{"low is capped at the low urgency max gas price", bulletprooftxmanager.EthTxUrgencyLow, 180, 200, ""},
+		{"low errors once at the low urgency max gas price", bulletprooftxmanager.EthTxUrgencyLow, 200, 200, "bumped gas price of 240 would exceed configured max gas price of 200 for low urgency transactions (original price was 200)"},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			etx := bulletprooftxmanager.EthTx{GasLimit: 21000, Urgency: test.urgency}
+
+			gasPrice, gasLimit, err := bulletprooftxmanager.BumpGas(config, estimator, etx, big.NewInt(test.originalGasPrice))
+			if test.expectedErr != "" {
+				assert.EqualError(t, err, test.expectedErr)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, uint64(21000), gasLimit)
+			}
+			assert.Equal(t, big.NewInt(test.expectedGasPrice), gasPrice)
+		})
+	}
+}
+
+func Test_InitialGasPrice(t *testing.T) {
+	t.Parallel()
+
+	config := new(bptxmmocks.Config)
+	config.On("EthMaxGasPriceWeiLowUrgency").Return(big.NewInt(200))
+
+	low := bulletprooftxmanager.EthTx{Urgency: bulletprooftxmanager.EthTxUrgencyLow}
+	normal := bulletprooftxmanager.EthTx{Urgency: bulletprooftxmanager.EthTxUrgencyNormal}
+
+	assert.Equal(t, big.NewInt(150), bulletprooftxmanager.InitialGasPrice(config, low, big.NewInt(150)))
+	assert.Equal(t, big.NewInt(200), bulletprooftxmanager.InitialGasPrice(config, low, big.NewInt(300)))
+	assert.Equal(t, big.NewInt(300), bulletprooftxmanager.InitialGasPrice(config, normal, big.NewInt(300)))
+}
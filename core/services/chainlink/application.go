@@ -11,6 +11,7 @@ import (
 	"reflect"
 	"sync"
 	"syscall"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
@@ -32,12 +33,15 @@ import (
 	"github.com/smartcontractkit/chainlink/core/services/webhook"
 
 	"github.com/gobuffalo/packr"
+	"github.com/smartcontractkit/chainlink/core/chains"
 	"github.com/smartcontractkit/chainlink/core/gracefulpanic"
 	"github.com/smartcontractkit/chainlink/core/logger"
 	"github.com/smartcontractkit/chainlink/core/services"
+	"github.com/smartcontractkit/chainlink/core/services/alerts"
 	"github.com/smartcontractkit/chainlink/core/services/bulletprooftxmanager"
 	"github.com/smartcontractkit/chainlink/core/services/directrequest"
 	"github.com/smartcontractkit/chainlink/core/services/eth"
+	"github.com/smartcontractkit/chainlink/core/services/events"
 	"github.com/smartcontractkit/chainlink/core/services/fluxmonitor"
 	"github.com/smartcontractkit/chainlink/core/services/health"
 	"github.com/smartcontractkit/chainlink/core/services/job"
@@ -69,6 +73,7 @@ type Application interface {
 	GetStore() *strpkg.Store
 	GetEthClient() eth.Client
 	GetConfig() *config.Config
+	GetTxManager() bulletprooftxmanager.TxManager
 	GetKeyStore() *keystore.Master
 	GetStatsPusher() synchronization.StatsPusher
 	GetHeadBroadcaster() httypes.HeadBroadcasterRegistry
@@ -86,6 +91,8 @@ type Application interface {
 	JobSpawner() job.Spawner
 	JobORM() job.ORM
 	PipelineORM() pipeline.ORM
+	PipelineRunner() pipeline.Runner
+	NodeEvents() events.Broadcaster
 	AddJobV2(ctx context.Context, job job.Job, name null.String) (job.Job, error)
 	DeleteJobV2(ctx context.Context, jobID int32) error
 	RunWebhookJobV2(ctx context.Context, jobUUID uuid.UUID, requestBody string, meta pipeline.JSONSerializable) (int64, error)
@@ -99,6 +106,10 @@ type Application interface {
 
 	// ReplayFromBlock of blocks
 	ReplayFromBlock(number uint64) error
+
+	// Drain the node and exit once drained
+	Drain(timeout time.Duration) error
+	DrainStatus() (DrainStatus, error)
 }
 
 // ChainlinkApplication contains fields for the JobSubscriber, Scheduler,
@@ -119,6 +130,7 @@ type ChainlinkApplication struct {
 	jobSpawner               job.Spawner
 	pipelineORM              pipeline.ORM
 	pipelineRunner           pipeline.Runner
+	nodeEvents               events.Broadcaster
 	FluxMonitor              fluxmonitor.Service
 	FeedsService             feeds.Service
 	webhookJobRunner         webhook.JobRunner
@@ -139,6 +151,10 @@ type ChainlinkApplication struct {
 
 	started     bool
 	startStopMu sync.Mutex
+
+	drainStartedAt time.Time
+	drainDeadline  time.Time
+	drainMu        sync.Mutex
 }
 
 // NewApplication initializes a new store if one is not already
@@ -157,6 +173,10 @@ func NewApplication(cfg *config.Config, ethClient eth.Client, advisoryLocker pos
 
 	setupConfig(cfg, store.DB)
 
+	if err = chains.LoadRegistry(store.DB); err != nil {
+		return nil, errors.Wrap(err, "failed to load the chain registry")
+	}
+
 	healthChecker := health.NewChecker()
 
 	scryptParams := utils.GetScryptParams(cfg)
@@ -200,9 +220,17 @@ func NewApplication(cfg *config.Config, ethClient eth.Client, advisoryLocker pos
 		headBroadcaster = &headtracker.NullBroadcaster{}
 		headTracker = &headtracker.NullTracker{}
 	} else {
-		headBroadcaster = headtracker.NewHeadBroadcaster()
+		headBroadcaster = headtracker.NewHeadBroadcaster(cfg)
 		orm := headtracker.NewORM(store.DB)
-		headTracker = headtracker.NewHeadTracker(headTrackerLogger, ethClient, cfg, orm, headBroadcaster)
+		ht := headtracker.NewHeadTracker(headTrackerLogger, ethClient, cfg, orm, headBroadcaster)
+		for _, u := range cfg.EthHeadTrackerURLs() {
+			htClient, err := eth.NewClient(u.String(), nil, nil)
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to create eth client for ETH_HEAD_TRACKER_URLS")
+			}
+			ht.AddHeadProvider(u.String(), eth.NewInstrumentedClient(htClient, eth.EndpointName(u.String())))
+		}
+		headTracker = ht
 	}
 
 	var runExecutor services.RunExecutor
@@ -224,6 +252,13 @@ func NewApplication(cfg *config.Config, ethClient eth.Client, advisoryLocker pos
 	eventBroadcaster := postgres.NewEventBroadcaster(cfg.DatabaseURL(), cfg.DatabaseListenerMinReconnectInterval(), cfg.DatabaseListenerMaxReconnectDuration())
 	subservices = append(subservices, eventBroadcaster)
 
+	var balanceMonitor services.BalanceMonitor
+	if cfg.BalanceMonitorEnabled() {
+		balanceMonitor = services.NewBalanceMonitor(store.DB, ethClient, keyStore.Eth())
+	} else {
+		balanceMonitor = &services.NullBalanceMonitor{}
+	}
+
 	var txManager bulletprooftxmanager.TxManager
 	var logBroadcaster log.Broadcaster
 	if cfg.EthereumDisabled() {
@@ -237,7 +272,11 @@ func NewApplication(cfg *config.Config, ethClient eth.Client, advisoryLocker pos
 		}
 
 		logBroadcaster = log.NewBroadcaster(log.NewORM(store.DB), ethClient, cfg, highestSeenHead)
-		txManager = bulletprooftxmanager.NewBulletproofTxManager(store.DB, ethClient, cfg, keyStore.Eth(), advisoryLocker, eventBroadcaster)
+		bptxm := bulletprooftxmanager.NewBulletproofTxManager(store.DB, ethClient, cfg, keyStore.Eth(), advisoryLocker, eventBroadcaster, balanceMonitor)
+		if err2 = addEVMChains(bptxm, cfg, store.DB, keyStore.Eth(), advisoryLocker, eventBroadcaster); err2 != nil {
+			return nil, err2
+		}
+		txManager = bptxm
 		subservices = append(subservices, logBroadcaster, txManager)
 	}
 
@@ -248,12 +287,6 @@ func NewApplication(cfg *config.Config, ethClient eth.Client, advisoryLocker pos
 		jobSubscriber,
 	)
 
-	var balanceMonitor services.BalanceMonitor
-	if cfg.BalanceMonitorEnabled() {
-		balanceMonitor = services.NewBalanceMonitor(store.DB, ethClient, keyStore.Eth())
-	} else {
-		balanceMonitor = &services.NullBalanceMonitor{}
-	}
 	subservices = append(subservices, balanceMonitor)
 
 	promReporter := services.NewPromReporter(store.MustSQLDB())
@@ -261,7 +294,7 @@ func NewApplication(cfg *config.Config, ethClient eth.Client, advisoryLocker pos
 
 	var (
 		pipelineORM    = pipeline.NewORM(store.DB)
-		pipelineRunner = pipeline.NewRunner(pipelineORM, cfg, ethClient, keyStore.Eth(), keyStore.VRF(), txManager)
+		pipelineRunner = pipeline.NewRunner(pipelineORM, cfg, ethClient, keyStore.Eth(), keyStore.VRF(), keyStore.OCR(), txManager, keyStore.Secrets())
 		jobORM         = job.NewORM(store.ORM.DB, cfg, pipelineORM, eventBroadcaster, advisoryLocker)
 	)
 
@@ -345,14 +378,37 @@ func NewApplication(cfg *config.Config, ethClient eth.Client, advisoryLocker pos
 	}
 
 	if cfg.Dev() || cfg.FeatureCronV2() {
-		delegates[job.Cron] = cron.NewDelegate(pipelineRunner)
+		delegates[job.Cron] = cron.NewDelegate(pipelineRunner, cron.NewORM(store.DB))
 	}
 
-	jobSpawner := job.NewSpawner(jobORM, cfg, delegates, gormTxm)
+	// The services of these job types need the following subsystems to be
+	// ready before they can start
+	var (
+		logBroadcasterReady = job.Dependency{Name: "log broadcaster", Ready: logBroadcaster.Ready}
+		headTrackerReady    = job.Dependency{Name: "head tracker", Ready: headTracker.Ready}
+		ethKeysLoaded       = job.Dependency{Name: "eth keystore", Ready: func() error {
+			_, err := keyStore.Eth().SendingKeys()
+			return err
+		}}
+		dependencies = map[job.Type][]job.Dependency{
+			job.DirectRequest:     {logBroadcasterReady},
+			job.FluxMonitor:       {logBroadcasterReady, headTrackerReady, ethKeysLoaded},
+			job.Keeper:            {logBroadcasterReady, headTrackerReady, ethKeysLoaded},
+			job.OffchainReporting: {logBroadcasterReady, headTrackerReady, ethKeysLoaded},
+			job.VRF:               {logBroadcasterReady, headTrackerReady, ethKeysLoaded},
+		}
+	)
+	jobSpawner := job.NewSpawner(jobORM, cfg, delegates, dependencies, gormTxm)
 	subservices = append(subservices, jobSpawner, pipelineRunner, headBroadcaster)
 
+	nodeEvents := events.NewBroadcaster(eventBroadcaster, pipelineRunner, logBroadcaster)
+	subservices = append(subservices, nodeEvents)
+
+	alertEngine := alerts.NewEngine(alerts.NewORM(store.DB), cfg, balanceMonitor, keyStore.Eth(), nodeEvents)
+	subservices = append(subservices, alertEngine)
+
 	feedsORM := feeds.NewORM(store.DB)
-	feedsService := feeds.NewService(feedsORM, gormTxm, jobSpawner, keyStore.CSA(), keyStore.Eth(), cfg)
+	feedsService := feeds.NewService(feedsORM, gormTxm, jobSpawner, keyStore.CSA(), keyStore.Eth(), externalInitiatorManager, cfg)
 
 	app := &ChainlinkApplication{
 		ethClient:                ethClient,
@@ -364,6 +420,7 @@ func NewApplication(cfg *config.Config, ethClient eth.Client, advisoryLocker pos
 		jobORM:                   jobORM,
 		jobSpawner:               jobSpawner,
 		pipelineRunner:           pipelineRunner,
+		nodeEvents:               nodeEvents,
 		pipelineORM:              pipelineORM,
 		FluxMonitor:              fluxMonitor,
 		FeedsService:             feedsService,
@@ -394,6 +451,10 @@ func NewApplication(cfg *config.Config, ethClient eth.Client, advisoryLocker pos
 	headBroadcaster.Subscribe(promReporter)
 	headBroadcaster.Subscribe(jobSubscriber)
 	headBroadcaster.Subscribe(balanceMonitor)
+	headBroadcaster.Subscribe(alertEngine)
+
+	headBroadcaster.SubscribeFinalized(logBroadcaster)
+	headBroadcaster.SubscribeFinalized(txManager)
 
 	headBroadcaster.Subscribe(&httypes.HeadTrackableCallback{OnConnect: func() error {
 		return runManager.ResumeAllPendingConnection()
@@ -410,13 +471,33 @@ func NewApplication(cfg *config.Config, ethClient eth.Client, advisoryLocker pos
 	// until app.LogBroadcaster.DependentReady() call (see below)
 	logBroadcaster.AddDependents(1)
 
+	// The health tree reports services under the services they depend on, so
+	// that the root cause of a failure shows at a glance
+	checkName := func(service interface{}) string {
+		return reflect.TypeOf(service).String()
+	}
+	dependsOn := map[string][]string{
+		checkName(headTracker):     {"EthClient"},
+		checkName(headBroadcaster): {checkName(headTracker)},
+		checkName(logBroadcaster):  {checkName(headBroadcaster)},
+		checkName(txManager):       {checkName(headBroadcaster)},
+		checkName(balanceMonitor):  {checkName(headBroadcaster)},
+		checkName(jobSpawner):      {checkName(logBroadcaster), checkName(headBroadcaster)},
+		checkName(alertEngine):     {checkName(headBroadcaster), checkName(nodeEvents)},
+	}
+
 	for _, service := range app.subservices {
-		if err = app.HealthChecker.Register(reflect.TypeOf(service).String(), service); err != nil {
+		name := checkName(service)
+		if err = app.HealthChecker.Register(name, service, dependsOn[name]...); err != nil {
 			return nil, err
 		}
 	}
 
-	if err = app.HealthChecker.Register(reflect.TypeOf(headTracker).String(), headTracker); err != nil {
+	if err = app.HealthChecker.Register(checkName(headTracker), headTracker, dependsOn[checkName(headTracker)]...); err != nil {
+		return nil, err
+	}
+
+	if err = app.HealthChecker.Register("EthClient", eth.NewCapabilitiesCheck(ethClient)); err != nil {
 		return nil, err
 	}
 
@@ -465,6 +546,27 @@ func setupConfig(cfg *config.Config, db *gorm.DB) {
 	}
 }
 
+// addEVMChains adds a BulletproofTxManager for each of ETH_ADDITIONAL_CHAINS
+// to the manager of the primary chain
+func addEVMChains(txManager *bulletprooftxmanager.BulletproofTxManager, cfg *config.Config, db *gorm.DB, keyStore bulletprooftxmanager.KeyStore, advisoryLocker postgres.AdvisoryLocker, eventBroadcaster postgres.EventBroadcaster) error {
+	evmChains, err := cfg.EthAdditionalChains()
+	if err != nil {
+		return err
+	}
+	for _, evmChain := range evmChains {
+		ethClient, err := eth.NewClient(evmChain.URL, nil, nil)
+		if err != nil {
+			return errors.Wrapf(err, "failed to create eth client for chain %s", evmChain.ID)
+		}
+		chain := bulletprooftxmanager.NewChainBulletproofTxManager(db, eth.NewInstrumentedClient(ethClient, eth.EndpointName(evmChain.URL)), cfg.ForChain(evmChain.ID), keyStore, advisoryLocker, eventBroadcaster)
+		if err := txManager.AddChain(chain); err != nil {
+			return err
+		}
+		logger.Infow("Added EVM chain", "chainID", evmChain.ID)
+	}
+	return nil
+}
+
 // Start all necessary services. If successful, nil will be returned.  Also
 // listens for interrupt signals from the operating system so that the
 // application can be properly closed before the application exits.
@@ -623,6 +725,10 @@ func (app *ChainlinkApplication) GetConfig() *config.Config {
 	return app.Config
 }
 
+func (app *ChainlinkApplication) GetTxManager() bulletprooftxmanager.TxManager {
+	return app.TxManager
+}
+
 func (app *ChainlinkApplication) GetKeyStore() *keystore.Master {
 	return app.KeyStore
 }
@@ -647,6 +753,15 @@ func (app *ChainlinkApplication) PipelineORM() pipeline.ORM {
 	return app.pipelineORM
 }
 
+func (app *ChainlinkApplication) PipelineRunner() pipeline.Runner {
+	return app.pipelineRunner
+}
+
+// NodeEvents returns the broadcaster of the structured events of the node
+func (app *ChainlinkApplication) NodeEvents() events.Broadcaster {
+	return app.nodeEvents
+}
+
 func (app *ChainlinkApplication) GetExternalInitiatorManager() webhook.ExternalInitiatorManager {
 	return app.ExternalInitiatorManager
 }
@@ -688,6 +803,9 @@ func (app *ChainlinkApplication) DeleteJobV2(ctx context.Context, jobID int32) e
 }
 
 func (app *ChainlinkApplication) RunWebhookJobV2(ctx context.Context, jobUUID uuid.UUID, requestBody string, meta pipeline.JSONSerializable) (int64, error) {
+	if app.isDraining() {
+		return 0, ErrDraining
+	}
 	return app.webhookJobRunner.RunJob(ctx, jobUUID, requestBody, meta)
 }
 
@@ -700,6 +818,9 @@ func (app *ChainlinkApplication) RunJobV2(
 	if !app.Store.Config.Dev() {
 		return 0, errors.New("manual job runs only supported in dev mode - export CHAINLINK_DEV=true to use")
 	}
+	if app.isDraining() {
+		return 0, ErrDraining
+	}
 	jb, err := app.jobORM.FindJob(ctx, jobID)
 	if err != nil {
 		return 0, errors.Wrapf(err, "job ID %v", jobID)
@@ -0,0 +1,121 @@
+package chainlink
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/bulletprooftxmanager"
+)
+
+// drainPollInterval is how often a draining node checks whether it has any
+// work left
+const drainPollInterval = time.Second
+
+var (
+	// ErrDraining is returned when the node refuses to start a run because
+	// it is draining
+	ErrDraining = errors.New("node is draining")
+	// ErrAlreadyDraining is returned when the node is asked to drain again
+	ErrAlreadyDraining = errors.New("node is already draining")
+)
+
+// DrainStatus is the progress of draining the node before it exits
+type DrainStatus struct {
+	Draining  bool
+	StartedAt time.Time
+	// Deadline is when the node exits even if it has work left
+	Deadline time.Time
+	// ActiveJobs are the jobs whose services have not stopped yet
+	ActiveJobs int
+	// InFlightRuns are the pipeline runs still being executed
+	InFlightRuns int64
+	// PendingTxes are the transactions yet to be confirmed
+	PendingTxes uint32
+}
+
+// Drained returns whether the node is draining and has no work left
+func (s DrainStatus) Drained() bool {
+	return s.Draining && s.ActiveJobs == 0 && s.InFlightRuns == 0 && s.PendingTxes == 0
+}
+
+// Drain puts the node into drain mode, e.g. before it is replaced by a new
+// version: it stops the services of its jobs and releases them to the other
+// nodes sharing the database, refuses new webhook and manual runs, waits for
+// the in-flight pipeline runs to finish and the pending transactions to be
+// confirmed, then exits cleanly. It exits regardless once timeout elapses.
+func (app *ChainlinkApplication) Drain(timeout time.Duration) error {
+	app.drainMu.Lock()
+	defer app.drainMu.Unlock()
+	if !app.drainStartedAt.IsZero() {
+		return ErrAlreadyDraining
+	}
+	app.drainStartedAt = time.Now()
+	app.drainDeadline = app.drainStartedAt.Add(timeout)
+
+	logger.Infow("Draining node", "timeout", timeout)
+	go app.drain(app.drainDeadline)
+	return nil
+}
+
+func (app *ChainlinkApplication) drain(deadline time.Time) {
+	ctx, cancel := context.WithDeadline(context.Background(), deadline)
+	defer cancel()
+
+	if err := app.jobSpawner.Drain(ctx); err != nil {
+		logger.Errorw("Error draining jobs", "error", err)
+	}
+	app.waitUntilDrained(ctx)
+
+	logger.ErrorIf(app.StopIfStarted())
+	app.Exiter(0)
+}
+
+func (app *ChainlinkApplication) waitUntilDrained(ctx context.Context) {
+	ticker := time.NewTicker(drainPollInterval)
+	defer ticker.Stop()
+	for {
+		status, err := app.DrainStatus()
+		if err != nil {
+			logger.Errorw("Error checking drain status", "error", err)
+		} else if status.Drained() {
+			logger.Infow("Node drained", "duration", time.Since(status.StartedAt))
+			return
+		}
+
+		select {
+		case <-ctx.Done():
+			logger.Warnw("Timed out draining node, exiting anyway", "status", status)
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// DrainStatus returns the progress of draining the node
+func (app *ChainlinkApplication) DrainStatus() (DrainStatus, error) {
+	app.drainMu.Lock()
+	status := DrainStatus{
+		Draining:  !app.drainStartedAt.IsZero(),
+		StartedAt: app.drainStartedAt,
+		Deadline:  app.drainDeadline,
+	}
+	app.drainMu.Unlock()
+
+	status.ActiveJobs = len(app.jobSpawner.ActiveJobs())
+	status.InFlightRuns = app.pipelineRunner.InFlightRuns()
+	pendingTxes, err := bulletprooftxmanager.CountPendingTransactions(app.Store.DB)
+	if err != nil {
+		return status, errors.Wrap(err, "failed to count pending transactions")
+	}
+	status.PendingTxes = pendingTxes
+	return status, nil
+}
+
+func (app *ChainlinkApplication) isDraining() bool {
+	app.drainMu.Lock()
+	defer app.drainMu.Unlock()
+	return !app.drainStartedAt.IsZero()
+}
@@ -2,6 +2,8 @@ package cron
 
 import (
 	"fmt"
+	"sync"
+	"time"
 
 	"github.com/robfig/cron/v3"
 
@@ -14,29 +16,48 @@ import (
 // Cron runs a cron jobSpec from a CronSpec
 type Cron struct {
 	cronRunner     *cron.Cron
+	oneShotTimer   *time.Timer
 	logger         *logger.Logger
 	jobSpec        job.Job
 	pipelineRunner pipeline.Runner
+	orm            ORM
 	chStop         chan struct{}
+	wg             sync.WaitGroup
+
+	// mu guards starting the cron runner after the missed runs, against the
+	// job being closed meanwhile
+	mu      sync.Mutex
+	stopped bool
 }
 
-// NewCronFromJobSpec instantiates a job that executes on a predefined schedule.
+const (
+	// triggerSchedule is the trigger of the runs started by the schedule
+	triggerSchedule = "schedule"
+	// triggerCatchUp is the trigger of the runs which were missed, e.g.
+	// while the node was down, and which are executed on startup
+	triggerCatchUp = "catchUp"
+)
+
+// NewCronFromJobSpec instantiates a job that executes on a predefined schedule,
+// or once at a given time.
 func NewCronFromJobSpec(
 	jobSpec job.Job,
 	pipelineRunner pipeline.Runner,
+	orm ORM,
 ) (*Cron, error) {
 	cronLogger := logger.CreateLogger(
 		logger.Default.With(
 			"jobID", jobSpec.ID,
-			"schedule", jobSpec.CronSpec.CronSchedule,
+			"schedule", jobSpec.CronSpec.Schedule(),
 		),
 	)
 
 	return &Cron{
-		cronRunner:     cronRunner(),
+		cronRunner:     cronRunner(jobSpec.CronSpec.OverlapPolicy, cronLogger),
 		logger:         cronLogger,
 		jobSpec:        jobSpec,
 		pipelineRunner: pipelineRunner,
+		orm:            orm,
 		chStop:         make(chan struct{}),
 	}, nil
 }
@@ -45,27 +66,97 @@ func NewCronFromJobSpec(
 func (cr *Cron) Start() error {
 	cr.logger.Debug("Cron: Starting")
 
-	_, err := cr.cronRunner.AddFunc(cr.jobSpec.CronSpec.CronSchedule, cr.runPipeline)
+	if runAt := cr.jobSpec.CronSpec.RunAt; runAt != nil {
+		if cr.jobSpec.CronSpec.LastRunAt != nil {
+			cr.logger.Debugw("Cron: one-shot job already ran", "runAt", runAt, "lastRunAt", cr.jobSpec.CronSpec.LastRunAt)
+			return nil
+		}
+		// A run missed while the node was down happens right away
+		cr.oneShotTimer = time.AfterFunc(time.Until(*runAt), func() {
+			cr.runPipeline(triggerSchedule, *runAt)
+		})
+		return nil
+	}
+
+	_, err := cr.cronRunner.AddFunc(cr.jobSpec.CronSpec.Schedule(), func() {
+		cr.runPipeline(triggerSchedule, time.Now())
+	})
 	if err != nil {
-		cr.logger.Errorw(fmt.Sprintf("Error running cron job %d", cr.jobSpec.ID), "error", err, "schedule", cr.jobSpec.CronSpec.CronSchedule, "jobID", cr.jobSpec.ID)
+		cr.logger.Errorw(fmt.Sprintf("Error running cron job %d", cr.jobSpec.ID), "error", err, "schedule", cr.jobSpec.CronSpec.Schedule(), "jobID", cr.jobSpec.ID)
 		return err
 	}
-	cr.cronRunner.Start()
+
+	missed, skipped := cr.jobSpec.CronSpec.MissedRuns(time.Now())
+	if skipped > 0 {
+		cr.logger.Warnw("Cron: more runs were missed than maxCatchUpRuns, skipping the oldest ones", "skipped", skipped, "maxCatchUpRuns", cr.jobSpec.CronSpec.MaxCatchUpRuns)
+	}
+	if len(missed) == 0 {
+		cr.cronRunner.Start()
+		return nil
+	}
+	// The missed runs are executed one at a time before the schedule resumes,
+	// so that they do not overlap with each other nor with scheduled runs
+	cr.logger.Infow("Cron: catching up on missed runs", "runs", len(missed), "lastRunAt", cr.jobSpec.CronSpec.LastRunAt)
+	cr.wg.Add(1)
+	go func() {
+		defer cr.wg.Done()
+		cr.catchUp(missed)
+	}()
 	return nil
 }
 
+// catchUp executes the runs missed at the given times, then starts the
+// schedule
+func (cr *Cron) catchUp(missed []time.Time) {
+	for _, scheduledAt := range missed {
+		select {
+		case <-cr.chStop:
+			return
+		default:
+		}
+		cr.runPipeline(triggerCatchUp, scheduledAt)
+	}
+
+	cr.mu.Lock()
+	defer cr.mu.Unlock()
+	if !cr.stopped {
+		cr.cronRunner.Start()
+	}
+}
+
 // Close implements the job.Service interface. It stops this job from
 // running and cleans up resources.
 func (cr *Cron) Close() error {
 	cr.logger.Debug("Cron: Closing")
-	cr.cronRunner.Stop()
+	if cr.oneShotTimer != nil {
+		cr.oneShotTimer.Stop()
+	}
+	cr.mu.Lock()
+	cr.stopped = true
+	cr.mu.Unlock()
+	close(cr.chStop)
+	cr.wg.Wait()
+	<-cr.cronRunner.Stop().Done()
 	return nil
 }
 
-func (cr *Cron) runPipeline() {
+// runPipeline runs the job, recording in the run metadata what triggered it
+// and when it was scheduled
+func (cr *Cron) runPipeline(trigger string, scheduledAt time.Time) {
 	ctx, cancel := utils.ContextFromChan(cr.chStop)
 	defer cancel()
 
+	// The run is recorded before it starts, so that a one-shot job runs at
+	// most once even if the node stops during the run. The scheduled time is
+	// recorded so that catching up resumes after the runs already executed.
+	if err := cr.orm.RecordRun(ctx, cr.jobSpec.CronSpec.ID, scheduledAt); err != nil {
+		cr.logger.Errorw("Error recording cron job run", "error", err)
+	}
+
+	meta := map[string]interface{}{
+		"trigger":     trigger,
+		"scheduledAt": scheduledAt,
+	}
 	vars := pipeline.NewVarsFrom(map[string]interface{}{
 		"jobSpec": map[string]interface{}{
 			"databaseID":    cr.jobSpec.ID,
@@ -73,11 +164,12 @@ func (cr *Cron) runPipeline() {
 			"name":          cr.jobSpec.Name.ValueOrZero(),
 		},
 		"jobRun": map[string]interface{}{
-			"meta": map[string]interface{}{},
+			"meta": meta,
 		},
 	})
 
 	run := pipeline.NewRun(*cr.jobSpec.PipelineSpec, vars)
+	run.Meta = pipeline.JSONSerializable{Val: meta}
 
 	_, err := cr.pipelineRunner.Run(ctx, &run, *cr.logger, false)
 	if err != nil {
@@ -85,6 +177,26 @@ func (cr *Cron) runPipeline() {
 	}
 }
 
-func cronRunner() *cron.Cron {
-	return cron.New(cron.WithSeconds())
+func cronRunner(policy job.OverlapPolicy, l *logger.Logger) *cron.Cron {
+	options := []cron.Option{cron.WithSeconds()}
+	switch policy {
+	case job.OverlapSkip:
+		options = append(options, cron.WithChain(cron.SkipIfStillRunning(cronLogger{l})))
+	case job.OverlapQueue:
+		options = append(options, cron.WithChain(cron.DelayIfStillRunning(cronLogger{l})))
+	}
+	return cron.New(options...)
+}
+
+// cronLogger logs the runs skipped or delayed by the cron runner
+type cronLogger struct {
+	*logger.Logger
+}
+
+func (l cronLogger) Info(msg string, keysAndValues ...interface{}) {
+	l.Debugw("Cron: "+msg, keysAndValues...)
+}
+
+func (l cronLogger) Error(err error, msg string, keysAndValues ...interface{}) {
+	l.Errorw("Cron: "+msg, append(keysAndValues, "error", err)...)
 }
@@ -7,6 +7,7 @@ import (
 
 	uuid "github.com/satori/go.uuid"
 
+	cronmocks "github.com/smartcontractkit/chainlink/core/services/cron/mocks"
 	pipelinemocks "github.com/smartcontractkit/chainlink/core/services/pipeline/mocks"
 
 	"github.com/smartcontractkit/chainlink/core/internal/cltest"
@@ -37,7 +38,7 @@ func TestCronV2Pipeline(t *testing.T) {
 		PipelineSpec:  &pipeline.Spec{},
 		ExternalJobID: uuid.NewV4(),
 	}
-	delegate := cron.NewDelegate(runner)
+	delegate := cron.NewDelegate(runner, cron.NewORM(db))
 
 	jb, err := jobORM.CreateJob(context.Background(), spec, spec.Pipeline)
 	require.NoError(t, err)
@@ -61,11 +62,13 @@ func TestCronV2Schedule(t *testing.T) {
 		PipelineSpec:  &pipeline.Spec{},
 	}
 	runner := new(pipelinemocks.Runner)
+	orm := new(cronmocks.ORM)
 
 	runner.On("Run", mock.Anything, mock.AnythingOfType("*pipeline.Run"), mock.Anything, mock.Anything).
 		Return(false, nil).Once()
+	orm.On("RecordRun", mock.Anything, mock.Anything, mock.Anything).Return(nil)
 
-	service, err := cron.NewCronFromJobSpec(spec, runner)
+	service, err := cron.NewCronFromJobSpec(spec, runner, orm)
 	require.NoError(t, err)
 	err = service.Start()
 	require.NoError(t, err)
@@ -73,3 +76,123 @@ func TestCronV2Schedule(t *testing.T) {
 
 	cltest.EventuallyExpectationsMet(t, runner, 10*time.Second, 1*time.Second)
 }
+
+func TestCronV2RunAt(t *testing.T) {
+	t.Parallel()
+
+	t.Run("runs a one-shot job once at runAt", func(t *testing.T) {
+		runAt := time.Now().Add(500 * time.Millisecond)
+		spec := job.Job{
+			Type:          job.Cron,
+			SchemaVersion: 1,
+			CronSpec:      &job.CronSpec{ID: 1, RunAt: &runAt},
+			PipelineSpec:  &pipeline.Spec{},
+		}
+		runner := new(pipelinemocks.Runner)
+		orm := new(cronmocks.ORM)
+
+		runner.On("Run", mock.Anything, mock.AnythingOfType("*pipeline.Run"), mock.Anything, mock.Anything).
+			Return(false, nil).Once()
+		orm.On("RecordRun", mock.Anything, int32(1), mock.Anything).Return(nil).Once()
+
+		service, err := cron.NewCronFromJobSpec(spec, runner, orm)
+		require.NoError(t, err)
+		require.NoError(t, service.Start())
+		defer service.Close()
+
+		cltest.EventuallyExpectationsMet(t, runner, 10*time.Second, 100*time.Millisecond)
+		orm.AssertExpectations(t)
+	})
+
+	t.Run("does not run a one-shot job which already ran", func(t *testing.T) {
+		runAt := time.Now().Add(-time.Hour)
+		spec := job.Job{
+			Type:          job.Cron,
+			SchemaVersion: 1,
+			CronSpec:      &job.CronSpec{ID: 1, RunAt: &runAt, LastRunAt: &runAt},
+			PipelineSpec:  &pipeline.Spec{},
+		}
+		runner := new(pipelinemocks.Runner)
+		orm := new(cronmocks.ORM)
+
+		service, err := cron.NewCronFromJobSpec(spec, runner, orm)
+		require.NoError(t, err)
+		require.NoError(t, service.Start())
+		defer service.Close()
+
+		time.Sleep(100 * time.Millisecond)
+		runner.AssertNotCalled(t, "Run", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestCronV2CatchUp(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now().UTC()
+	lastRunAt := now.Truncate(time.Hour).Add(-3*time.Hour - time.Minute)
+	spec := job.Job{
+		Type:          job.Cron,
+		SchemaVersion: 1,
+		CronSpec: &job.CronSpec{
+			ID:             1,
+			CronSchedule:   "CRON_TZ=UTC 0 0 * * * *",
+			MaxCatchUpRuns: 2,
+			LastRunAt:      &lastRunAt,
+		},
+		PipelineSpec: &pipeline.Spec{},
+	}
+	runner := new(pipelinemocks.Runner)
+	orm := new(cronmocks.ORM)
+
+	// Three runs were missed, only the two most recent are executed
+	for _, scheduledAt := range []time.Time{now.Truncate(time.Hour).Add(-time.Hour), now.Truncate(time.Hour)} {
+		scheduledAt := scheduledAt
+		runner.On("Run", mock.Anything, mock.MatchedBy(func(run *pipeline.Run) bool {
+			meta := run.Meta.Val.(map[string]interface{})
+			return meta["trigger"] == "catchUp" && meta["scheduledAt"].(time.Time).Equal(scheduledAt)
+		}), mock.Anything, mock.Anything).Return(false, nil).Once()
+		orm.On("RecordRun", mock.Anything, int32(1), mock.MatchedBy(scheduledAt.Equal)).Return(nil).Once()
+	}
+
+	service, err := cron.NewCronFromJobSpec(spec, runner, orm)
+	require.NoError(t, err)
+	require.NoError(t, service.Start())
+	defer service.Close()
+
+	cltest.EventuallyExpectationsMet(t, runner, 10*time.Second, 100*time.Millisecond)
+	orm.AssertExpectations(t)
+}
+
+func TestCronSpec_MissedRuns(t *testing.T) {
+	t.Parallel()
+
+	lastRunAt := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	now := lastRunAt.Add(5*time.Hour + 30*time.Minute)
+	hourly := "CRON_TZ=UTC 0 0 * * * *"
+
+	t.Run("runs up to maxCatchUpRuns of the most recent missed runs", func(t *testing.T) {
+		spec := job.CronSpec{CronSchedule: hourly, MaxCatchUpRuns: 2, LastRunAt: &lastRunAt}
+		runs, skipped := spec.MissedRuns(now)
+		assert.Equal(t, []time.Time{lastRunAt.Add(4 * time.Hour), lastRunAt.Add(5 * time.Hour)}, runs)
+		assert.Equal(t, 3, skipped)
+	})
+
+	t.Run("runs all missed runs within maxCatchUpRuns", func(t *testing.T) {
+		spec := job.CronSpec{CronSchedule: hourly, MaxCatchUpRuns: 10, LastRunAt: &lastRunAt}
+		runs, skipped := spec.MissedRuns(now)
+		assert.Len(t, runs, 5)
+		assert.Equal(t, 0, skipped)
+	})
+
+	t.Run("does not catch up without maxCatchUpRuns", func(t *testing.T) {
+		spec := job.CronSpec{CronSchedule: hourly, LastRunAt: &lastRunAt}
+		runs, _ := spec.MissedRuns(now)
+		assert.Empty(t, runs)
+	})
+
+	t.Run("does not catch up a job which never ran", func(t *testing.T) {
+		spec := job.CronSpec{CronSchedule: hourly, MaxCatchUpRuns: 2}
+		runs, _ := spec.MissedRuns(now)
+		assert.Empty(t, runs)
+	})
+}
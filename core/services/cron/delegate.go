@@ -9,13 +9,15 @@ import (
 
 type Delegate struct {
 	pipelineRunner pipeline.Runner
+	orm            ORM
 }
 
 var _ job.Delegate = (*Delegate)(nil)
 
-func NewDelegate(pipelineRunner pipeline.Runner) *Delegate {
+func NewDelegate(pipelineRunner pipeline.Runner, orm ORM) *Delegate {
 	return &Delegate{
 		pipelineRunner: pipelineRunner,
+		orm:            orm,
 	}
 }
 
@@ -36,7 +38,7 @@ func (d *Delegate) ServicesForSpec(spec job.Job) (services []job.Service, err er
 		return nil, errors.Errorf("services.Delegate expects a *jobSpec.CronSpec to be present, got %v", spec)
 	}
 
-	cron, err := NewCronFromJobSpec(spec, d.pipelineRunner)
+	cron, err := NewCronFromJobSpec(spec, d.pipelineRunner, d.orm)
 	if err != nil {
 		return nil, err
 	}
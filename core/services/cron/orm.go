@@ -0,0 +1,34 @@
+package cron
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+
+	"github.com/smartcontractkit/chainlink/core/services/postgres"
+)
+
+//go:generate mockery --name ORM --output ./mocks/ --case=underscore
+
+// ORM defines an interface for database commands related to cron jobs
+type ORM interface {
+	RecordRun(ctx context.Context, specID int32, at time.Time) error
+}
+
+type orm struct {
+	db *gorm.DB
+}
+
+// NewORM initializes a new ORM
+func NewORM(db *gorm.DB) *orm {
+	return &orm{db}
+}
+
+// RecordRun records when a cron job last ran, so that a one-shot job runs
+// at most once
+func (o *orm) RecordRun(ctx context.Context, specID int32, at time.Time) error {
+	err := postgres.TxFromContext(ctx, o.db).Exec(`UPDATE cron_specs SET last_run_at = ? WHERE id = ?`, at, specID).Error
+	return errors.Wrap(err, "RecordRun failed to update cron spec")
+}
@@ -1,6 +1,9 @@
 package cron
 
 import (
+	"strings"
+	"time"
+
 	"github.com/pelletier/go-toml"
 	"github.com/pkg/errors"
 	uuid "github.com/satori/go.uuid"
@@ -34,7 +37,40 @@ func ValidatedCronSpec(tomlString string) (job.Job, error) {
 	if jb.Type != job.Cron {
 		return jb, errors.Errorf("unsupported type %s", jb.Type)
 	}
-	if err := utils.ValidateCronSchedule(spec.CronSchedule); err != nil {
+
+	var loc *time.Location
+	if spec.Timezone != "" {
+		if loc, err = time.LoadLocation(spec.Timezone); err != nil {
+			return jb, errors.Wrapf(err, "invalid timezone '%v'", spec.Timezone)
+		}
+		if strings.HasPrefix(spec.CronSchedule, "CRON_TZ=") {
+			return jb, errors.New("the timezone must be specified either with CRON_TZ in the schedule or with timezone, not both")
+		}
+	}
+
+	if err = job.ValidateOverlapPolicy(&spec.OverlapPolicy); err != nil {
+		return jb, err
+	}
+
+	if spec.RunAt != nil {
+		if spec.CronSchedule != "" {
+			return jb, errors.New("a cron job runs either on a schedule or once at runAt, not both")
+		}
+		// A date-time without offset is in the timezone of the job, or UTC
+		if local, ok := tree.Get("runAt").(toml.LocalDateTime); ok && loc != nil {
+			runAt := local.In(loc)
+			spec.RunAt = &runAt
+		}
+		if spec.RunAt.Before(time.Now()) {
+			return jb, errors.Errorf("runAt %v is in the past", spec.RunAt)
+		}
+		if spec.MaxCatchUpRuns != 0 {
+			return jb, errors.New("maxCatchUpRuns only applies to jobs which run on a schedule, a one-shot job missed while the node was down runs on startup")
+		}
+		return jb, nil
+	}
+
+	if err := utils.ValidateCronSchedule(spec.Schedule()); err != nil {
 		return jb, errors.Wrapf(err, "while validating cron schedule '%v'", spec.CronSchedule)
 	}
 
@@ -3,6 +3,7 @@ package cron_test
 import (
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/manyminds/api2go/jsonapi"
 	"github.com/stretchr/testify/assert"
@@ -77,6 +78,142 @@ ds -> ds_parse -> ds_multiply;
 				assert.True(t, strings.Contains(err.Error(), "invalid cron schedule"))
 			},
 		},
+		{
+			name: "one-shot with local runAt in timezone",
+			toml: `
+type            = "cron"
+schemaVersion   = 1
+runAt           = 2100-01-01T09:30:00
+timezone        = "Europe/Paris"
+overlapPolicy   = "skip"
+observationSource   = """
+ds          [type=http method=GET url="https://chain.link/ETH-USD"];
+ds_parse    [type=jsonparse path="data,price"];
+ds_multiply [type=multiply times=100];
+ds -> ds_parse -> ds_multiply;
+"""
+`,
+			assertion: func(t *testing.T, s job.Job, err error) {
+				require.NoError(t, err)
+				require.NotNil(t, s.CronSpec.RunAt)
+				assert.Equal(t, "2100-01-01T08:30:00Z", s.CronSpec.RunAt.UTC().Format(time.RFC3339))
+				assert.Equal(t, job.OverlapSkip, s.CronSpec.OverlapPolicy)
+			},
+		},
+		{
+			name: "runAt in the past",
+			toml: `
+type            = "cron"
+schemaVersion   = 1
+runAt           = 2000-01-01T00:00:00Z
+observationSource   = """
+ds          [type=http method=GET url="https://chain.link/ETH-USD"];
+ds_parse    [type=jsonparse path="data,price"];
+ds_multiply [type=multiply times=100];
+ds -> ds_parse -> ds_multiply;
+"""
+`,
+			assertion: func(t *testing.T, s job.Job, err error) {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), "is in the past")
+			},
+		},
+		{
+			name: "both schedule and runAt",
+			toml: `
+type            = "cron"
+schemaVersion   = 1
+schedule        = "CRON_TZ=UTC 0 0 1 1 * *"
+runAt           = 2100-01-01T00:00:00Z
+observationSource   = """
+ds          [type=http method=GET url="https://chain.link/ETH-USD"];
+ds_parse    [type=jsonparse path="data,price"];
+ds_multiply [type=multiply times=100];
+ds -> ds_parse -> ds_multiply;
+"""
+`,
+			assertion: func(t *testing.T, s job.Job, err error) {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), "not both")
+			},
+		},
+		{
+			name: "schedule in timezone",
+			toml: `
+type            = "cron"
+schemaVersion   = 1
+schedule        = "0 0 1 1 * *"
+timezone        = "America/New_York"
+observationSource   = """
+ds          [type=http method=GET url="https://chain.link/ETH-USD"];
+ds_parse    [type=jsonparse path="data,price"];
+ds_multiply [type=multiply times=100];
+ds -> ds_parse -> ds_multiply;
+"""
+`,
+			assertion: func(t *testing.T, s job.Job, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, "CRON_TZ=America/New_York 0 0 1 1 * *", s.CronSpec.Schedule())
+				assert.Equal(t, job.OverlapParallel, s.CronSpec.OverlapPolicy)
+			},
+		},
+		{
+			name: "invalid overlap policy",
+			toml: `
+type            = "cron"
+schemaVersion   = 1
+schedule        = "CRON_TZ=UTC 0 0 1 1 * *"
+overlapPolicy   = "wait"
+observationSource   = """
+ds          [type=http method=GET url="https://chain.link/ETH-USD"];
+ds_parse    [type=jsonparse path="data,price"];
+ds_multiply [type=multiply times=100];
+ds -> ds_parse -> ds_multiply;
+"""
+`,
+			assertion: func(t *testing.T, s job.Job, err error) {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), "invalid overlapPolicy")
+			},
+		},
+		{
+			name: "schedule with catch-up runs",
+			toml: `
+type            = "cron"
+schemaVersion   = 1
+schedule        = "CRON_TZ=UTC 0 0 * * * *"
+maxCatchUpRuns  = 3
+observationSource   = """
+ds          [type=http method=GET url="https://chain.link/ETH-USD"];
+ds_parse    [type=jsonparse path="data,price"];
+ds_multiply [type=multiply times=100];
+ds -> ds_parse -> ds_multiply;
+"""
+`,
+			assertion: func(t *testing.T, s job.Job, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, uint32(3), s.CronSpec.MaxCatchUpRuns)
+			},
+		},
+		{
+			name: "one-shot with catch-up runs",
+			toml: `
+type            = "cron"
+schemaVersion   = 1
+runAt           = 2100-01-01T00:00:00Z
+maxCatchUpRuns  = 3
+observationSource   = """
+ds          [type=http method=GET url="https://chain.link/ETH-USD"];
+ds_parse    [type=jsonparse path="data,price"];
+ds_multiply [type=multiply times=100];
+ds -> ds_parse -> ds_multiply;
+"""
+`,
+			assertion: func(t *testing.T, s job.Job, err error) {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), "maxCatchUpRuns")
+			},
+		},
 	}
 	for _, tc := range tt {
 		t.Run(tc.name, func(t *testing.T) {
@@ -10,6 +10,8 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
 	"github.com/smartcontractkit/chainlink/core/assets"
 	"github.com/smartcontractkit/chainlink/core/internal/gethwrappers/generated/operator_wrapper"
 	"github.com/smartcontractkit/chainlink/core/logger"
@@ -107,6 +109,20 @@ var (
 	_ job.Service  = &listener{}
 )
 
+// The reasons why oracle requests are rejected before a run is started
+const (
+	rejectedRequesterDenied     = "requester_denied"
+	rejectedRequesterNotAllowed = "requester_not_allowed"
+	rejectedInsufficientPayment = "insufficient_payment"
+)
+
+var promRejectedRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "direct_request_rejected_requests",
+	Help: "The number of oracle requests rejected by a direct request job before running its pipeline, by reason",
+},
+	[]string{"job_id", "reason"},
+)
+
 type listener struct {
 	config                   Config
 	logBroadcaster           log.Broadcaster
@@ -254,21 +270,14 @@ func (l *listener) handleOracleRequest(request *operator_wrapper.OperatorOracleR
 		"data", fmt.Sprintf("%0x", request.Data),
 	)
 
-	minimumContractPayment := l.config.MinimumContractPayment()
-	if minimumContractPayment != nil {
-		requestPayment := assets.Link(*request.Payment)
-		if minimumContractPayment.Cmp(&requestPayment) > 0 {
-			logger.Infow("Rejected run for insufficient payment",
-				"minimumContractPayment", minimumContractPayment.String(),
-				"requestPayment", requestPayment.String(),
-			)
-			ctx, cancel := postgres.DefaultQueryCtx()
-			defer cancel()
-			if err := l.logBroadcaster.MarkConsumed(l.db.WithContext(ctx), lb); err != nil {
-				logger.Errorw("DirectRequest: unable to mark log consumed", "err", err, "log", lb.String())
-			}
-			return
+	if reason := l.rejectionReason(request); reason != "" {
+		promRejectedRequests.WithLabelValues(fmt.Sprintf("%d", l.job.ID), reason).Inc()
+		ctx, cancel := postgres.DefaultQueryCtx()
+		defer cancel()
+		if err := l.logBroadcaster.MarkConsumed(l.db.WithContext(ctx), lb); err != nil {
+			logger.Errorw("DirectRequest: unable to mark log consumed", "err", err, "log", lb.String())
 		}
+		return
 	}
 
 	meta := make(map[string]interface{})
@@ -331,6 +340,49 @@ func (l *listener) handleOracleRequest(request *operator_wrapper.OperatorOracleR
 	}()
 }
 
+// rejectionReason returns why a request does not conform to the job, if it
+// does not
+func (l *listener) rejectionReason(request *operator_wrapper.OperatorOracleRequest) string {
+	spec := l.job.DirectRequestSpec
+	for _, denied := range spec.DeniedRequesters {
+		if request.Requester == denied {
+			logger.Infow("Rejected run for denied requester", "jobID", l.job.ID, "requester", request.Requester)
+			return rejectedRequesterDenied
+		}
+	}
+	if len(spec.Requesters) > 0 {
+		allowed := false
+		for _, requester := range spec.Requesters {
+			if request.Requester == requester {
+				allowed = true
+				break
+			}
+		}
+		if !allowed {
+			logger.Infow("Rejected run for requester not allowed", "jobID", l.job.ID, "requester", request.Requester)
+			return rejectedRequesterNotAllowed
+		}
+	}
+
+	// The minimum payment of the job takes precedence over the one of the node
+	minimumContractPayment := spec.MinContractPayment
+	if minimumContractPayment == nil {
+		minimumContractPayment = l.config.MinimumContractPayment()
+	}
+	if minimumContractPayment != nil {
+		requestPayment := assets.Link(*request.Payment)
+		if minimumContractPayment.Cmp(&requestPayment) > 0 {
+			logger.Infow("Rejected run for insufficient payment",
+				"jobID", l.job.ID,
+				"minimumContractPayment", minimumContractPayment.String(),
+				"requestPayment", requestPayment.String(),
+			)
+			return rejectedInsufficientPayment
+		}
+	}
+	return ""
+}
+
 // Cancels runs that haven't been started yet, with the given request ID
 func (l *listener) handleCancelOracleRequest(request *operator_wrapper.OperatorCancelOracleRequest, lb log.Broadcast) {
 	runCloserChannelIf, loaded := l.runs.LoadAndDelete(formatRequestId(request.RequestId))
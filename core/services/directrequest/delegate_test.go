@@ -21,6 +21,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/services/pipeline"
 	pipeline_mocks "github.com/smartcontractkit/chainlink/core/services/pipeline/mocks"
 	"github.com/smartcontractkit/chainlink/core/services/postgres"
+	"github.com/smartcontractkit/chainlink/core/store/models"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
@@ -64,7 +65,7 @@ type DirectRequestUniverse struct {
 	cleanup        func()
 }
 
-func NewDirectRequestUniverseWithConfig(t *testing.T, drConfig testConfig) *DirectRequestUniverse {
+func NewDirectRequestUniverseWithConfig(t *testing.T, drConfig testConfig, specF func(spec *job.Job)) *DirectRequestUniverse {
 	gethClient := new(mocks.Client)
 	broadcaster := new(log_mocks.Broadcaster)
 	runner := new(pipeline_mocks.Runner)
@@ -85,6 +86,9 @@ func NewDirectRequestUniverseWithConfig(t *testing.T, drConfig testConfig) *Dire
 
 	spec := cltest.MakeDirectRequestJobSpec(t)
 	spec.ExternalJobID = uuid.NewV4()
+	if specF != nil {
+		specF(spec)
+	}
 	jb, err := jobORM.CreateJob(context.Background(), spec, spec.Pipeline)
 	require.NoError(t, err)
 	serviceArray, err := delegate.ServicesForSpec(jb)
@@ -113,7 +117,7 @@ func NewDirectRequestUniverse(t *testing.T) *DirectRequestUniverse {
 	drConfig := testConfig{
 		minIncomingConfirmations: 1,
 	}
-	return NewDirectRequestUniverseWithConfig(t, drConfig)
+	return NewDirectRequestUniverseWithConfig(t, drConfig, nil)
 }
 
 func (uni *DirectRequestUniverse) Cleanup() {
@@ -338,7 +342,7 @@ func TestDelegate_ServicesListenerHandleLog(t *testing.T) {
 			minIncomingConfirmations: 1,
 			minimumContractPayment:   assets.NewLink(100),
 		}
-		uni := NewDirectRequestUniverseWithConfig(t, drConfig)
+		uni := NewDirectRequestUniverseWithConfig(t, drConfig, nil)
 		defer uni.Cleanup()
 
 		log := new(log_mocks.Broadcast)
@@ -389,7 +393,7 @@ func TestDelegate_ServicesListenerHandleLog(t *testing.T) {
 			minIncomingConfirmations: 1,
 			minimumContractPayment:   assets.NewLink(100),
 		}
-		uni := NewDirectRequestUniverseWithConfig(t, drConfig)
+		uni := NewDirectRequestUniverseWithConfig(t, drConfig, nil)
 		defer uni.Cleanup()
 
 		log := new(log_mocks.Broadcast)
@@ -423,6 +427,67 @@ func TestDelegate_ServicesListenerHandleLog(t *testing.T) {
 		uni.logBroadcaster.AssertExpectations(t)
 		uni.runner.AssertExpectations(t)
 	})
+
+	rejectedTests := []struct {
+		name      string
+		requester common.Address
+		payment   int64
+		specF     func(spec *job.Job)
+	}{
+		{"Log is from a denied requester", common.HexToAddress("0x1"), 100, func(spec *job.Job) {
+			spec.DirectRequestSpec.DeniedRequesters = models.AddressCollection{common.HexToAddress("0x1")}
+		}},
+		{"Log is from a requester not allowed", common.HexToAddress("0x2"), 100, func(spec *job.Job) {
+			spec.DirectRequestSpec.Requesters = models.AddressCollection{common.HexToAddress("0x1")}
+		}},
+		{"Log has less than the minimum payment of the job", common.HexToAddress("0x1"), 100, func(spec *job.Job) {
+			spec.DirectRequestSpec.MinContractPayment = assets.NewLink(1000)
+		}},
+	}
+	for _, test := range rejectedTests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			drConfig := testConfig{
+				minIncomingConfirmations: 1,
+				minimumContractPayment:   assets.NewLink(100),
+			}
+			uni := NewDirectRequestUniverseWithConfig(t, drConfig, test.specF)
+			defer uni.Cleanup()
+
+			log := new(log_mocks.Broadcast)
+			defer log.AssertExpectations(t)
+
+			uni.logBroadcaster.On("WasAlreadyConsumed", mock.Anything, mock.Anything).Return(false, nil)
+			logOracleRequest := operator_wrapper.OperatorOracleRequest{
+				CancelExpiration: big.NewInt(0),
+				Requester:        test.requester,
+				Payment:          big.NewInt(test.payment),
+			}
+			log.On("RawLog").Return(types.Log{
+				Topics: []common.Hash{
+					{},
+					uni.spec.ExternalIDEncodeStringToTopic(),
+				},
+			})
+			log.On("DecodedLog").Return(&logOracleRequest)
+			markConsumedLogAwaiter := cltest.NewAwaiter()
+			uni.logBroadcaster.On("MarkConsumed", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+				markConsumedLogAwaiter.ItHappened()
+			}).Return(nil)
+
+			err := uni.service.Start()
+			require.NoError(t, err)
+
+			uni.listener.HandleLog(log)
+
+			markConsumedLogAwaiter.AwaitOrFail(t, 5*time.Second)
+
+			uni.service.Close()
+			uni.logBroadcaster.AssertExpectations(t)
+			// No run is started for the rejected request
+			uni.runner.AssertExpectations(t)
+		})
+	}
 }
 
 type testConfig struct {
@@ -3,12 +3,17 @@ package directrequest
 import (
 	"github.com/pelletier/go-toml"
 	"github.com/pkg/errors"
+	"github.com/smartcontractkit/chainlink/core/assets"
 	"github.com/smartcontractkit/chainlink/core/services/job"
 	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
+	"github.com/smartcontractkit/chainlink/core/store/models"
 )
 
 type DirectRequestToml struct {
-	ContractAddress ethkey.EIP55Address `toml:"contractAddress"`
+	ContractAddress    ethkey.EIP55Address      `toml:"contractAddress"`
+	Requesters         models.AddressCollection `toml:"requesters"`
+	DeniedRequesters   models.AddressCollection `toml:"deniedRequesters"`
+	MinContractPayment *assets.Link             `toml:"minContractPaymentLinkJuels"`
 }
 
 func ValidatedDirectRequestSpec(tomlString string) (job.Job, error) {
@@ -26,10 +31,25 @@ func ValidatedDirectRequestSpec(tomlString string) (job.Job, error) {
 	if err != nil {
 		return jb, err
 	}
-	jb.DirectRequestSpec = &job.DirectRequestSpec{ContractAddress: spec.ContractAddress}
+	jb.DirectRequestSpec = &job.DirectRequestSpec{
+		ContractAddress:    spec.ContractAddress,
+		Requesters:         spec.Requesters,
+		DeniedRequesters:   spec.DeniedRequesters,
+		MinContractPayment: spec.MinContractPayment,
+	}
 
 	if jb.Type != job.DirectRequest {
 		return jb, errors.Errorf("unsupported type %s", jb.Type)
 	}
+	for _, denied := range spec.DeniedRequesters {
+		for _, allowed := range spec.Requesters {
+			if denied == allowed {
+				return jb, errors.Errorf("requester %s is both allowed and denied", denied.Hex())
+			}
+		}
+	}
+	if spec.MinContractPayment != nil && spec.MinContractPayment.ToInt().Sign() < 0 {
+		return jb, errors.New("minContractPaymentLinkJuels must not be negative")
+	}
 	return jb, nil
 }
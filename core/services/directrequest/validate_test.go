@@ -1,6 +1,7 @@
 package directrequest
 
 import (
+	"strings"
 	"testing"
 	"time"
 
@@ -34,3 +35,32 @@ observationSource   = """
 	assert.Equal(t, time.Time{}, s.DirectRequestSpec.CreatedAt)
 	assert.Equal(t, time.Time{}, s.DirectRequestSpec.UpdatedAt)
 }
+
+func TestValidatedDirectRequestSpec_Requesters(t *testing.T) {
+	toml := `
+type                        = "directrequest"
+schemaVersion               = 1
+contractAddress             = "0x613a38AC1659769640aaE063C651F48E0250454C"
+requesters                  = ["0x9E40733cC9df84636505f4e6Db28DCa0dC5D1bba"]
+deniedRequesters            = ["0xa8037A20989AFcBC51798de9762b351D63ff462e"]
+minContractPaymentLinkJuels = "1000000000000000000"
+observationSource           = """
+    ds1          [type=http method=GET url="example.com" allowunrestrictednetworkaccess="true"];
+    ds1_parse    [type=jsonparse path="USD"];
+    ds1 -> ds1_parse;
+"""
+`
+
+	s, err := ValidatedDirectRequestSpec(toml)
+	require.NoError(t, err)
+
+	require.Len(t, s.DirectRequestSpec.Requesters, 1)
+	assert.Equal(t, "0x9E40733cC9df84636505f4e6Db28DCa0dC5D1bba", s.DirectRequestSpec.Requesters[0].Hex())
+	require.Len(t, s.DirectRequestSpec.DeniedRequesters, 1)
+	assert.Equal(t, "0xa8037A20989AFcBC51798de9762b351D63ff462e", s.DirectRequestSpec.DeniedRequesters[0].Hex())
+	assert.Equal(t, "1000000000000000000", s.DirectRequestSpec.MinContractPayment.String())
+
+	_, err = ValidatedDirectRequestSpec(strings.Replace(toml, "0xa8037A20989AFcBC51798de9762b351D63ff462e", "0x9E40733cC9df84636505f4e6Db28DCa0dC5D1bba", 1))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "both allowed and denied")
+}
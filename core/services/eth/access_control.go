@@ -0,0 +1,57 @@
+package eth
+
+import (
+	"bytes"
+	"context"
+	"strings"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+)
+
+var accessControlledABI = MustGetABI(`[{"inputs":[{"internalType":"address","name":"_user","type":"address"},{"internalType":"bytes","name":"_calldata","type":"bytes"}],"name":"hasAccess","outputs":[{"internalType":"bool","name":"","type":"bool"}],"stateMutability":"view","type":"function"}]`)
+
+// hasAccessDispatch is the PUSH4 of the hasAccess selector with which the
+// function dispatcher of contracts implementing it compares the calldata
+var hasAccessDispatch = append([]byte{0x63}, accessControlledABI.Methods["hasAccess"].ID...)
+
+// HasAccess calls the hasAccess view of access controlled contracts, such as
+// AccessControlledAggregator and AccessControlledOffchainAggregator, to check
+// whether user may make the call encoded in calldata. Contracts whose code
+// does not implement hasAccess do not enforce access control, so true is
+// returned for them. Access is denied if hasAccess reverts, and an error is
+// returned if there is no contract at the address, or if hasAccess fails or
+// returns nothing.
+func HasAccess(ctx context.Context, client Client, contract, user common.Address, calldata []byte) (bool, error) {
+	code, err := client.CodeAt(ctx, contract, nil)
+	if err != nil {
+		return false, errors.Wrap(err, "failed to get contract code")
+	}
+	if len(code) == 0 {
+		return false, errors.Errorf("no contract deployed at %s", contract.Hex())
+	}
+	if !bytes.Contains(code, hasAccessDispatch) {
+		return true, nil
+	}
+
+	data, err := accessControlledABI.Pack("hasAccess", user, calldata)
+	if err != nil {
+		return false, errors.Wrap(err, "failed to pack hasAccess call")
+	}
+	out, err := client.CallContract(ctx, ethereum.CallMsg{To: &contract, Data: data}, nil)
+	if err != nil {
+		if strings.Contains(err.Error(), "execution reverted") {
+			return false, nil
+		}
+		return false, errors.Wrap(err, "failed to call hasAccess")
+	}
+	if len(out) == 0 {
+		return false, errors.New("hasAccess returned no output")
+	}
+	results, err := accessControlledABI.Unpack("hasAccess", out)
+	if err != nil {
+		return false, errors.Wrap(err, "failed to unpack hasAccess result")
+	}
+	return results[0].(bool), nil
+}
@@ -0,0 +1,268 @@
+package eth
+
+import (
+	"container/list"
+	"context"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/smartcontractkit/chainlink/core/store/models"
+)
+
+var (
+	promRPCCacheHits = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "eth_rpc_cache_hits_total",
+		Help: "The number of calls to the eth node answered from the cache of immutable responses, by method",
+	},
+		[]string{"method"},
+	)
+	promRPCCacheMisses = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "eth_rpc_cache_misses_total",
+		Help: "The number of cacheable calls to the eth node which were not cached, by method",
+	},
+		[]string{"method"},
+	)
+)
+
+// CacheConfig configures the cache of immutable responses of the eth node
+type CacheConfig struct {
+	// Size is the maximum number of cached responses, the least recently
+	// used ones are evicted beyond it. 0 disables the cache.
+	Size uint32
+	// TTL is how long a response is cached for
+	TTL time.Duration
+	// FinalityDepth is the number of blocks after which a block, and the
+	// receipts in it, are considered final and so immutable
+	FinalityDepth uint
+}
+
+// responseCache is a size limited LRU cache of responses which expire after
+// a TTL
+type responseCache struct {
+	size int
+	ttl  time.Duration
+
+	mu      sync.Mutex
+	lru     *list.List
+	entries map[string]*list.Element
+}
+
+type responseCacheEntry struct {
+	key       string
+	value     interface{}
+	expiresAt time.Time
+}
+
+func newResponseCache(size uint32, ttl time.Duration) *responseCache {
+	return &responseCache{
+		size:    int(size),
+		ttl:     ttl,
+		lru:     list.New(),
+		entries: make(map[string]*list.Element),
+	}
+}
+
+func (c *responseCache) get(key string) (interface{}, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	el, exists := c.entries[key]
+	if !exists {
+		return nil, false
+	}
+	entry := el.Value.(*responseCacheEntry)
+	if !time.Now().Before(entry.expiresAt) {
+		c.lru.Remove(el)
+		delete(c.entries, key)
+		return nil, false
+	}
+	c.lru.MoveToFront(el)
+	return entry.value, true
+}
+
+// set caches the value for key. Values must not be mutated once cached.
+func (c *responseCache) set(key string, value interface{}) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	expiresAt := time.Now().Add(c.ttl)
+	if el, exists := c.entries[key]; exists {
+		entry := el.Value.(*responseCacheEntry)
+		entry.value, entry.expiresAt = value, expiresAt
+		c.lru.MoveToFront(el)
+		return
+	}
+	c.entries[key] = c.lru.PushFront(&responseCacheEntry{key: key, value: value, expiresAt: expiresAt})
+	for c.lru.Len() > c.size {
+		oldest := c.lru.Back()
+		c.lru.Remove(oldest)
+		delete(c.entries, oldest.Value.(*responseCacheEntry).key)
+	}
+}
+
+func (c *responseCache) len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.lru.Len()
+}
+
+// cachingClient answers the calls for immutable data from a cache, so that
+// the services requesting the same data only call the eth node once: the
+// chain ID, and the code, receipts and blocks at blocks which are final as of
+// the latest head the client fetched
+type cachingClient struct {
+	Client
+	cache         *responseCache
+	finalityDepth int64
+
+	mu         sync.RWMutex
+	latestHead int64
+}
+
+var _ Client = (*cachingClient)(nil)
+
+// NewCachingClient wraps the client to cache its immutable responses, or
+// returns it as is if the cache is disabled
+func NewCachingClient(c Client, cfg CacheConfig) Client {
+	if cfg.Size == 0 || cfg.TTL <= 0 {
+		return c
+	}
+	return &cachingClient{
+		Client:        c,
+		cache:         newResponseCache(cfg.Size, cfg.TTL),
+		finalityDepth: int64(cfg.FinalityDepth),
+	}
+}
+
+func (c *cachingClient) unwrap() Client {
+	return c.Client
+}
+
+// observeHead records the number of a head, to know which blocks are final
+func (c *cachingClient) observeHead(number int64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if number > c.latestHead {
+		c.latestHead = number
+	}
+}
+
+// isFinal returns true if the block of the given number is final as of the
+// latest head, and so its contents may be cached
+func (c *cachingClient) isFinal(number int64) bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.latestHead > 0 && number <= c.latestHead-c.finalityDepth
+}
+
+func (c *cachingClient) hit(method, key string) (interface{}, bool) {
+	v, exists := c.cache.get(key)
+	if exists {
+		promRPCCacheHits.WithLabelValues(method).Inc()
+	} else {
+		promRPCCacheMisses.WithLabelValues(method).Inc()
+	}
+	return v, exists
+}
+
+func (c *cachingClient) ChainID(ctx context.Context) (*big.Int, error) {
+	const key = "ChainID"
+	if v, exists := c.hit("ChainID", key); exists {
+		return new(big.Int).Set(v.(*big.Int)), nil
+	}
+	id, err := c.Client.ChainID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	c.cache.set(key, new(big.Int).Set(id))
+	return id, nil
+}
+
+// CodeAt caches the code of accounts at final blocks. The code at the latest
+// block is not cached since a contract may be deployed or destroyed since.
+func (c *cachingClient) CodeAt(ctx context.Context, account common.Address, blockNumber *big.Int) ([]byte, error) {
+	key := fmt.Sprintf("CodeAt/%s/%s", account.Hex(), toBlockNumArg(blockNumber))
+	final := blockNumber != nil && c.isFinal(blockNumber.Int64())
+	if final {
+		if v, exists := c.hit("CodeAt", key); exists {
+			return append([]byte(nil), v.([]byte)...), nil
+		}
+	}
+	code, err := c.Client.CodeAt(ctx, account, blockNumber)
+	if err != nil {
+		return nil, err
+	}
+	if final {
+		c.cache.set(key, append([]byte(nil), code...))
+	}
+	return code, nil
+}
+
+// TransactionReceipt caches the receipts of transactions in final blocks
+func (c *cachingClient) TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error) {
+	key := "TransactionReceipt/" + txHash.Hex()
+	if v, exists := c.hit("TransactionReceipt", key); exists {
+		receipt := *v.(*types.Receipt)
+		return &receipt, nil
+	}
+	receipt, err := c.Client.TransactionReceipt(ctx, txHash)
+	if err != nil {
+		return nil, err
+	}
+	if receipt != nil && receipt.BlockNumber != nil && c.isFinal(receipt.BlockNumber.Int64()) {
+		cached := *receipt
+		c.cache.set(key, &cached)
+	}
+	return receipt, nil
+}
+
+// HeadByNumber caches final heads, and records the latest head
+func (c *cachingClient) HeadByNumber(ctx context.Context, number *big.Int) (*models.Head, error) {
+	key := "HeadByNumber/" + toBlockNumArg(number)
+	if number != nil && c.isFinal(number.Int64()) {
+		if v, exists := c.hit("HeadByNumber", key); exists {
+			head := *v.(*models.Head)
+			return &head, nil
+		}
+	}
+	head, err := c.Client.HeadByNumber(ctx, number)
+	if err != nil || head == nil {
+		return head, err
+	}
+	if number == nil {
+		c.observeHead(head.Number)
+	} else if c.isFinal(head.Number) {
+		// Heads are linked to their parents once fetched, which must not be
+		// shared between callers
+		cached := *head
+		cached.Parent = nil
+		c.cache.set(key, &cached)
+	}
+	return head, nil
+}
+
+// BlockByNumber caches final blocks, and records the latest head
+func (c *cachingClient) BlockByNumber(ctx context.Context, number *big.Int) (*types.Block, error) {
+	key := "BlockByNumber/" + toBlockNumArg(number)
+	if number != nil && c.isFinal(number.Int64()) {
+		if v, exists := c.hit("BlockByNumber", key); exists {
+			return v.(*types.Block), nil
+		}
+	}
+	block, err := c.Client.BlockByNumber(ctx, number)
+	if err != nil || block == nil {
+		return block, err
+	}
+	if number == nil {
+		c.observeHead(block.Number().Int64())
+	} else if c.isFinal(block.Number().Int64()) {
+		// Blocks are immutable, so they are shared between callers
+		c.cache.set(key, block)
+	}
+	return block, nil
+}
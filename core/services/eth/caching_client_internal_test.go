@@ -0,0 +1,37 @@
+package eth
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResponseCache(t *testing.T) {
+	t.Parallel()
+
+	t.Run("evicts the least recently used responses beyond its size", func(t *testing.T) {
+		c := newResponseCache(2, time.Hour)
+		c.set("a", 1)
+		c.set("b", 2)
+		_, exists := c.get("a")
+		assert.True(t, exists)
+		c.set("c", 3)
+
+		assert.Equal(t, 2, c.len())
+		_, exists = c.get("b")
+		assert.False(t, exists)
+		v, exists := c.get("a")
+		assert.True(t, exists)
+		assert.Equal(t, 1, v)
+	})
+
+	t.Run("expires responses after the TTL", func(t *testing.T) {
+		c := newResponseCache(2, time.Millisecond)
+		c.set("a", 1)
+		time.Sleep(5 * time.Millisecond)
+		_, exists := c.get("a")
+		assert.False(t, exists)
+		assert.Equal(t, 0, c.len())
+	})
+}
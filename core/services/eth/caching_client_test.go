@@ -0,0 +1,102 @@
+package eth_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/services/eth"
+	"github.com/smartcontractkit/chainlink/core/services/eth/mocks"
+	"github.com/smartcontractkit/chainlink/core/store/models"
+)
+
+func TestCachingClient(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	cfg := eth.CacheConfig{Size: 100, TTL: time.Hour, FinalityDepth: 10}
+
+	t.Run("caches the chain ID", func(t *testing.T) {
+		inner := new(mocks.Client)
+		client := eth.NewCachingClient(inner, cfg)
+		inner.On("ChainID", mock.Anything).Return(big.NewInt(42), nil).Once()
+
+		for i := 0; i < 3; i++ {
+			id, err := client.ChainID(ctx)
+			require.NoError(t, err)
+			assert.Equal(t, big.NewInt(42), id)
+		}
+		inner.AssertExpectations(t)
+	})
+
+	t.Run("caches code at final blocks", func(t *testing.T) {
+		inner := new(mocks.Client)
+		client := eth.NewCachingClient(inner, cfg)
+		contract, eoa := common.HexToAddress("0x1"), common.HexToAddress("0x2")
+		inner.On("HeadByNumber", mock.Anything, (*big.Int)(nil)).Return(&models.Head{Number: 100}, nil).Once()
+		inner.On("CodeAt", mock.Anything, contract, big.NewInt(90)).Return([]byte{1, 2, 3}, nil).Once()
+		inner.On("CodeAt", mock.Anything, eoa, big.NewInt(90)).Return([]byte{}, nil).Once()
+		inner.On("CodeAt", mock.Anything, contract, big.NewInt(91)).Return([]byte{1, 2, 3}, nil).Twice()
+		inner.On("CodeAt", mock.Anything, contract, (*big.Int)(nil)).Return([]byte{1, 2, 3}, nil).Twice()
+
+		_, err := client.HeadByNumber(ctx, nil)
+		require.NoError(t, err)
+		for i := 0; i < 2; i++ {
+			code, err := client.CodeAt(ctx, contract, big.NewInt(90))
+			require.NoError(t, err)
+			assert.Equal(t, []byte{1, 2, 3}, code)
+			code, err = client.CodeAt(ctx, eoa, big.NewInt(90))
+			require.NoError(t, err)
+			assert.Empty(t, code)
+			code, err = client.CodeAt(ctx, contract, big.NewInt(91))
+			require.NoError(t, err)
+			assert.Equal(t, []byte{1, 2, 3}, code)
+			code, err = client.CodeAt(ctx, contract, nil)
+			require.NoError(t, err)
+			assert.Equal(t, []byte{1, 2, 3}, code)
+		}
+		inner.AssertExpectations(t)
+	})
+
+	t.Run("caches receipts and heads once they are final", func(t *testing.T) {
+		inner := new(mocks.Client)
+		client := eth.NewCachingClient(inner, cfg)
+		finalTx, recentTx := common.HexToHash("0x1"), common.HexToHash("0x2")
+		inner.On("HeadByNumber", mock.Anything, (*big.Int)(nil)).Return(&models.Head{Number: 100}, nil).Once()
+		inner.On("TransactionReceipt", mock.Anything, finalTx).Return(&types.Receipt{TxHash: finalTx, BlockNumber: big.NewInt(90)}, nil).Once()
+		inner.On("TransactionReceipt", mock.Anything, recentTx).Return(&types.Receipt{TxHash: recentTx, BlockNumber: big.NewInt(91)}, nil).Twice()
+		inner.On("HeadByNumber", mock.Anything, big.NewInt(90)).Return(&models.Head{Number: 90}, nil).Once()
+		inner.On("HeadByNumber", mock.Anything, big.NewInt(91)).Return(&models.Head{Number: 91}, nil).Twice()
+
+		_, err := client.HeadByNumber(ctx, nil)
+		require.NoError(t, err)
+		for i := 0; i < 2; i++ {
+			receipt, err := client.TransactionReceipt(ctx, finalTx)
+			require.NoError(t, err)
+			assert.Equal(t, finalTx, receipt.TxHash)
+			receipt, err = client.TransactionReceipt(ctx, recentTx)
+			require.NoError(t, err)
+			assert.Equal(t, recentTx, receipt.TxHash)
+
+			head, err := client.HeadByNumber(ctx, big.NewInt(90))
+			require.NoError(t, err)
+			assert.Equal(t, int64(90), head.Number)
+			head, err = client.HeadByNumber(ctx, big.NewInt(91))
+			require.NoError(t, err)
+			assert.Equal(t, int64(91), head.Number)
+		}
+		inner.AssertExpectations(t)
+	})
+
+	t.Run("is disabled without a size", func(t *testing.T) {
+		inner := new(mocks.Client)
+		assert.Equal(t, eth.Client(inner), eth.NewCachingClient(inner, eth.CacheConfig{TTL: time.Hour}))
+	})
+}
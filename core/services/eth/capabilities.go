@@ -0,0 +1,177 @@
+package eth
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/smartcontractkit/chainlink/core/services/health"
+	"github.com/smartcontractkit/chainlink/core/store/models"
+)
+
+// capabilityProbeTimeout is how long the node has to respond to each probe
+const capabilityProbeTimeout = 5 * time.Second
+
+// archiveProbeDepths are the depths, in blocks before the latest one, at
+// which the node is probed for state. Non archive nodes usually serve the
+// state of the latest 128 blocks.
+var archiveProbeDepths = []int64{128, 1024, 8192, 65536}
+
+// Capabilities are the RPC APIs the eth node of ETH_URL supports
+type Capabilities struct {
+	// Subscriptions is whether the node supports eth_subscribe, which needs a
+	// websocket URL. Without subscriptions, heads and logs are polled.
+	Subscriptions bool `json:"subscriptions"`
+	// PendingState is whether the node serves the pending block
+	PendingState bool `json:"pendingState"`
+	// TxPool is whether the node serves the txpool API
+	TxPool bool `json:"txPool"`
+	// ArchiveDepth is the deepest of the probed depths, in blocks before the
+	// latest one, at which the node serves state
+	ArchiveDepth int64 `json:"archiveDepth"`
+	// FullArchive is whether the node serves the state of every block
+	FullArchive bool `json:"fullArchive"`
+	// Probed is whether the capabilities were probed, else only Subscriptions
+	// is known, from the scheme of ETH_URL
+	Probed bool `json:"probed"`
+}
+
+// Missing returns the capabilities the node lacks, as human readable
+// descriptions
+func (c Capabilities) Missing() []string {
+	var missing []string
+	if !c.Subscriptions {
+		missing = append(missing, "no eth_subscribe support, heads and logs are polled over HTTP")
+	}
+	if !c.Probed {
+		return missing
+	}
+	if !c.PendingState {
+		missing = append(missing, "no pending block state")
+	}
+	if !c.TxPool {
+		missing = append(missing, "no txpool API")
+	}
+	if !c.FullArchive {
+		missing = append(missing, fmt.Sprintf("state history limited to %d blocks", c.ArchiveDepth))
+	}
+	return missing
+}
+
+// probeCapabilities probes the node for the RPC APIs it supports. Probes
+// which fail count as unsupported.
+func probeCapabilities(ctx context.Context, n *node) (caps Capabilities) {
+	caps.Probed = true
+	caps.Subscriptions = n.supportsSubscriptions()
+	if caps.Subscriptions {
+		caps.Subscriptions = probe(ctx, func(ctx context.Context) error {
+			sub, err := n.EthSubscribe(ctx, make(chan *models.Head, 1), "newHeads")
+			if err != nil {
+				return err
+			}
+			sub.Unsubscribe()
+			return nil
+		})
+	}
+	caps.PendingState = probe(ctx, func(ctx context.Context) error {
+		var pending json.RawMessage
+		if err := n.CallContext(ctx, &pending, "eth_getBlockByNumber", "pending", false); err != nil {
+			return err
+		}
+		if len(pending) == 0 || string(pending) == "null" {
+			return fmt.Errorf("no pending block")
+		}
+		return nil
+	})
+	caps.TxPool = probe(ctx, func(ctx context.Context) error {
+		var status json.RawMessage
+		return n.CallContext(ctx, &status, "txpool_status")
+	})
+
+	var latest hexutil.Big
+	if !probe(ctx, func(ctx context.Context) error {
+		return n.CallContext(ctx, &latest, "eth_blockNumber")
+	}) {
+		return caps
+	}
+	hasState := func(number int64) bool {
+		return probe(ctx, func(ctx context.Context) error {
+			var balance hexutil.Big
+			return n.CallContext(ctx, &balance, "eth_getBalance", common.Address{}, hexutil.EncodeBig(big.NewInt(number)))
+		})
+	}
+	latestNumber := latest.ToInt().Int64()
+	if latestNumber > 1 && hasState(1) {
+		caps.FullArchive = true
+		caps.ArchiveDepth = latestNumber - 1
+		return caps
+	}
+	for _, depth := range archiveProbeDepths {
+		if depth >= latestNumber || !hasState(latestNumber-depth) {
+			break
+		}
+		caps.ArchiveDepth = depth
+	}
+	return caps
+}
+
+func probe(ctx context.Context, fn func(ctx context.Context) error) bool {
+	ctx, cancel := context.WithTimeout(ctx, capabilityProbeTimeout)
+	defer cancel()
+	return fn(ctx) == nil
+}
+
+// unwrapper is implemented by the clients which wrap another client
+type unwrapper interface {
+	unwrap() Client
+}
+
+// ClientCapabilities returns the capabilities of the eth node of the client,
+// or false if the client does not know them, e.g. because it is a mock
+func ClientCapabilities(c Client) (Capabilities, bool) {
+	for {
+		switch v := c.(type) {
+		case *client:
+			return v.Capabilities(), true
+		case unwrapper:
+			c = v.unwrap()
+		default:
+			return Capabilities{}, false
+		}
+	}
+}
+
+// capabilitiesCheck reports the capabilities the eth node lacks as a
+// degraded health check
+type capabilitiesCheck struct {
+	client Client
+}
+
+// NewCapabilitiesCheck returns the health check of the capabilities of the
+// eth node of the client
+func NewCapabilitiesCheck(c Client) health.Checkable {
+	return capabilitiesCheck{c}
+}
+
+func (c capabilitiesCheck) Ready() error {
+	return nil
+}
+
+func (c capabilitiesCheck) Healthy() error {
+	caps, known := ClientCapabilities(c.client)
+	if !known {
+		return nil
+	}
+	if missing := caps.Missing(); len(missing) > 0 {
+		return health.Degraded{Reason: strings.Join(missing, "; ")}
+	}
+	return nil
+}
+
+var _ health.Checkable = capabilitiesCheck{}
@@ -0,0 +1,173 @@
+package eth_test
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/services/eth"
+	"github.com/smartcontractkit/chainlink/core/services/health"
+	"github.com/smartcontractkit/chainlink/core/store/models"
+)
+
+// newHTTPRPCServer serves JSON-RPC over HTTP, answering every call with
+// respond. A nil result with a nil error is answered with a method not found
+// error.
+func newHTTPRPCServer(t *testing.T, respond func(method string, params []json.RawMessage) interface{}) string {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			ID     json.RawMessage   `json:"id"`
+			Method string            `json:"method"`
+			Params []json.RawMessage `json:"params"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		resp := map[string]interface{}{"jsonrpc": "2.0", "id": req.ID}
+		if result := respond(req.Method, req.Params); result != nil {
+			resp["result"] = result
+		} else {
+			resp["error"] = map[string]interface{}{"code": -32601, "message": "the method " + req.Method + " does not exist/is not available"}
+		}
+		w.Header().Set("Content-Type", "application/json")
+		require.NoError(t, json.NewEncoder(w).Encode(resp))
+	}))
+	t.Cleanup(server.Close)
+	return server.URL
+}
+
+func headJSON(number int64) map[string]interface{} {
+	return map[string]interface{}{
+		"number":     hexutil.EncodeBig(big.NewInt(number)),
+		"hash":       common.BigToHash(big.NewInt(number)).Hex(),
+		"parentHash": common.BigToHash(big.NewInt(number - 1)).Hex(),
+		"timestamp":  "0x1",
+	}
+}
+
+func TestClient_DetectsCapabilities(t *testing.T) {
+	t.Parallel()
+
+	const latest = 100000
+	url := newHTTPRPCServer(t, func(method string, params []json.RawMessage) interface{} {
+		switch method {
+		case "eth_blockNumber":
+			return hexutil.EncodeBig(big.NewInt(latest))
+		case "eth_getBlockByNumber":
+			return headJSON(latest + 1)
+		case "eth_getBalance":
+			var number hexutil.Big
+			require.NoError(t, json.Unmarshal(params[1], &number))
+			// Serves the state of the latest 1024 blocks only
+			if number.ToInt().Int64() < latest-1024 {
+				return nil
+			}
+			return "0x0"
+		}
+		return nil
+	})
+
+	client, err := eth.NewClientWithPool(url, nil, nil, eth.PoolConfig{DetectCapabilities: true})
+	require.NoError(t, err)
+	require.NoError(t, client.Dial(context.Background()))
+	defer client.Close()
+
+	caps, known := eth.ClientCapabilities(eth.NewInstrumentedClient(client, "test"))
+	require.True(t, known)
+	assert.Equal(t, eth.Capabilities{
+		Subscriptions: false,
+		PendingState:  true,
+		TxPool:        false,
+		ArchiveDepth:  1024,
+		FullArchive:   false,
+		Probed:        true,
+	}, caps)
+
+	err = eth.NewCapabilitiesCheck(client).Healthy()
+	require.Error(t, err)
+	assert.True(t, health.IsDegraded(err))
+	assert.Contains(t, err.Error(), "heads and logs are polled over HTTP")
+	assert.Contains(t, err.Error(), "no txpool API")
+	assert.Contains(t, err.Error(), "state history limited to 1024 blocks")
+}
+
+func TestClient_PollsWithoutSubscriptions(t *testing.T) {
+	t.Parallel()
+
+	var mu sync.Mutex
+	var latest int64 = 10
+	setLatest := func(n int64) {
+		mu.Lock()
+		defer mu.Unlock()
+		latest = n
+	}
+	var filters []map[string]interface{}
+	url := newHTTPRPCServer(t, func(method string, params []json.RawMessage) interface{} {
+		mu.Lock()
+		defer mu.Unlock()
+		switch method {
+		case "eth_getBlockByNumber":
+			return headJSON(latest)
+		case "eth_getLogs":
+			var filter map[string]interface{}
+			require.NoError(t, json.Unmarshal(params[0], &filter))
+			filters = append(filters, filter)
+			return []map[string]interface{}{{
+				"address":          common.HexToAddress("0x1").Hex(),
+				"topics":           []string{},
+				"data":             "0x",
+				"blockNumber":      filter["toBlock"],
+				"transactionHash":  common.Hash{}.Hex(),
+				"transactionIndex": "0x0",
+				"blockHash":        common.Hash{}.Hex(),
+				"logIndex":         "0x0",
+				"removed":          false,
+			}}
+		}
+		return nil
+	})
+
+	client, err := eth.NewClientWithPool(url, nil, nil, eth.PoolConfig{HTTPPollInterval: 10 * time.Millisecond})
+	require.NoError(t, err)
+	require.NoError(t, client.Dial(context.Background()))
+	defer client.Close()
+
+	chHeads := make(chan *models.Head)
+	headsSub, err := client.SubscribeNewHead(context.Background(), chHeads)
+	require.NoError(t, err)
+	defer headsSub.Unsubscribe()
+
+	chLogs := make(chan types.Log)
+	logsSub, err := client.SubscribeFilterLogs(context.Background(), ethereum.FilterQuery{Addresses: []common.Address{common.HexToAddress("0x1")}}, chLogs)
+	require.NoError(t, err)
+
+	head := <-chHeads
+	assert.Equal(t, int64(10), head.Number)
+
+	setLatest(12)
+	head = <-chHeads
+	assert.Equal(t, int64(12), head.Number)
+
+	log := <-chLogs
+	assert.Equal(t, uint64(12), log.BlockNumber)
+	logsSub.Unsubscribe()
+	_, open := <-logsSub.Err()
+	assert.False(t, open)
+
+	mu.Lock()
+	defer mu.Unlock()
+	require.Len(t, filters, 1)
+	assert.Equal(t, "0xb", filters[0]["fromBlock"])
+	assert.Equal(t, "0xc", filters[0]["toBlock"])
+}
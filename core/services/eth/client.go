@@ -91,9 +91,15 @@ func DefaultQueryCtx(ctxs ...context.Context) (ctx context.Context, cancel conte
 // client represents an abstract client that manages connections to
 // multiple ethereum nodes
 type client struct {
-	primary     *node
-	secondaries []*secondarynode
-	mocked      bool
+	primaries      *pool
+	secondaries    []*secondarynode
+	sendToAllNodes bool
+	mocked         bool
+
+	detectCapabilities bool
+	pollInterval       time.Duration
+	capabilitiesMu     sync.RWMutex
+	capabilities       Capabilities
 
 	roundRobinCount uint32
 }
@@ -101,19 +107,43 @@ type client struct {
 var _ Client = (*client)(nil)
 
 func NewClient(rpcUrl string, rpcHTTPURL *url.URL, secondaryRPCURLs []url.URL) (*client, error) {
+	return NewClientWithPool(rpcUrl, rpcHTTPURL, secondaryRPCURLs, PoolConfig{})
+}
+
+// NewClientWithPool returns a client which fails over to the additional
+// primary nodes of the pool config when the node of rpcUrl is unhealthy.
+// rpcUrl may be a HTTP URL for nodes without websockets, in which case heads
+// and logs are polled instead of subscribed to.
+func NewClientWithPool(rpcUrl string, rpcHTTPURL *url.URL, secondaryRPCURLs []url.URL, poolConfig PoolConfig) (*client, error) {
 	parsed, err := url.ParseRequestURI(rpcUrl)
 	if err != nil {
 		return nil, err
 	}
 
-	if parsed.Scheme != "ws" && parsed.Scheme != "wss" {
-		return nil, errors.Errorf("ethereum url scheme must be websocket: %s", parsed.String())
+	switch parsed.Scheme {
+	case "ws", "wss":
+	case "http", "https":
+		logger.Warnw("eth.Client: ethereum url is not a websocket url, heads and logs will be polled over HTTP", "url", parsed.Redacted())
+	default:
+		return nil, errors.Errorf("ethereum url scheme must be websocket or http(s): %s", parsed.String())
 	}
 
-	c := client{}
+	c := client{
+		sendToAllNodes:     poolConfig.SendToAllNodes,
+		detectCapabilities: poolConfig.DetectCapabilities,
+		pollInterval:       poolConfig.HTTPPollInterval,
+	}
 
-	// for now only one primary is supported
-	c.primary = newNode(*parsed, rpcHTTPURL, "eth-primary-0")
+	// The HTTP URL only applies to the node of rpcUrl
+	nodes := []*node{newNode(*parsed, rpcHTTPURL, "eth-primary-0")}
+	for i, u := range poolConfig.AdditionalPrimaryURLs {
+		if u.Scheme != "ws" && u.Scheme != "wss" {
+			return nil, errors.Errorf("additional primary ethereum url scheme must be websocket: %s", u.String())
+		}
+		nodes = append(nodes, newNode(u, nil, fmt.Sprintf("eth-primary-%d", i+1)))
+	}
+	c.primaries = newPool(nodes, poolConfig.PollInterval)
+	c.capabilities = Capabilities{Subscriptions: nodes[0].supportsSubscriptions()}
 
 	for i, url := range secondaryRPCURLs {
 		if url.Scheme != "http" && url.Scheme != "https" {
@@ -129,7 +159,7 @@ func (client *client) Dial(ctx context.Context) error {
 	if client.mocked {
 		return nil
 	}
-	if err := client.primary.Dial(ctx); err != nil {
+	if err := client.primaries.dial(ctx); err != nil {
 		return err
 	}
 
@@ -139,11 +169,53 @@ func (client *client) Dial(ctx context.Context) error {
 			return err
 		}
 	}
+	client.detect(ctx)
+	client.primaries.start()
 	return nil
 }
 
+// detect probes the capabilities of the node of ETH_URL, if enabled.
+// Otherwise only whether it supports subscriptions is known, from its URL.
+func (client *client) detect(ctx context.Context) {
+	if !client.detectCapabilities {
+		return
+	}
+	n := client.primaries.nodes[0]
+	caps := probeCapabilities(ctx, n)
+	if missing := caps.Missing(); len(missing) > 0 {
+		n.log.Warnw("eth.Client: the eth node lacks some capabilities", "missing", missing)
+	} else {
+		n.log.Infow("eth.Client: the eth node supports every capability")
+	}
+	client.capabilitiesMu.Lock()
+	defer client.capabilitiesMu.Unlock()
+	client.capabilities = caps
+}
+
+// Capabilities returns the RPC APIs the node of ETH_URL supports, as detected
+// when the client was dialed
+func (client *client) Capabilities() Capabilities {
+	client.capabilitiesMu.RLock()
+	defer client.capabilitiesMu.RUnlock()
+	return client.capabilities
+}
+
+// httpPollInterval returns how often heads and logs are polled from a node
+// without subscriptions
+func (client *client) httpPollInterval() time.Duration {
+	if client.pollInterval <= 0 {
+		return defaultHTTPPollInterval
+	}
+	return client.pollInterval
+}
+
 func (client *client) Close() {
-	client.primary.Close()
+	client.primaries.close()
+}
+
+// primary returns the primary node in use
+func (client *client) primary() *node {
+	return client.primaries.activeNode()
 }
 
 // CallArgs represents the data used to call the balance method of a contract.
@@ -192,7 +264,7 @@ func (client *client) GetEthBalance(ctx context.Context, account common.Address,
 // We wrap the GethClient's `TransactionReceipt` method so that we can ignore the error that arises
 // when we're talking to a Parity node that has no receipt yet.
 func (client *client) TransactionReceipt(ctx context.Context, txHash common.Hash) (receipt *types.Receipt, err error) {
-	receipt, err = client.primary.TransactionReceipt(ctx, txHash)
+	receipt, err = client.primary().TransactionReceipt(ctx, txHash)
 
 	if err != nil && strings.Contains(err.Error(), "missing required field") {
 		return nil, ethereum.NotFound
@@ -201,70 +273,86 @@ func (client *client) TransactionReceipt(ctx context.Context, txHash common.Hash
 }
 
 func (client *client) ChainID(ctx context.Context) (*big.Int, error) {
-	return client.primary.ChainID(ctx)
+	return client.primary().ChainID(ctx)
 }
 
 func (client *client) HeaderByNumber(ctx context.Context, n *big.Int) (*types.Header, error) {
-	return client.primary.HeaderByNumber(ctx, n)
+	return client.primary().HeaderByNumber(ctx, n)
 }
 
-// SendTransaction also uses the secondary HTTP RPC URLs if set
+// SendTransaction also uses the secondary HTTP RPC URLs if set, and the
+// healthy primary nodes not in use if sending to all nodes
 func (client *client) SendTransaction(ctx context.Context, tx *types.Transaction) error {
+	var wg sync.WaitGroup
+	defer wg.Wait()
 	for _, s := range client.secondaries {
 		// Parallel send to secondary node
-		var wg sync.WaitGroup
-		defer wg.Wait()
 		wg.Add(1)
 		go func(s *secondarynode) {
 			defer wg.Done()
-			err := NewSendError(s.SendTransaction(ctx, tx))
-			if err == nil || err.IsNonceTooLowError() || err.IsTransactionAlreadyInMempool() {
-				// Nonce too low or transaction known errors are expected since
-				// the primary SendTransaction may well have succeeded already
-				return
-			}
-			logger.Warnw("secondary eth client returned error", "err", err, "tx", tx)
+			logBroadcastError(s.SendTransaction(ctx, tx), "secondary", tx)
 		}(s)
 	}
+	if client.sendToAllNodes {
+		for _, n := range client.primaries.standbyNodes() {
+			wg.Add(1)
+			go func(n *node) {
+				defer wg.Done()
+				logBroadcastError(n.SendTransaction(ctx, tx), "standby primary", tx)
+			}(n)
+		}
+	}
+
+	return client.primary().SendTransaction(ctx, tx)
+}
 
-	return client.primary.SendTransaction(ctx, tx)
+// logBroadcastError logs the unexpected errors of broadcasting a transaction
+// to a node other than the primary in use
+func logBroadcastError(sendErr error, tier string, tx *types.Transaction) {
+	err := NewSendError(sendErr)
+	if err == nil || err.IsNonceTooLowError() || err.IsTransactionAlreadyInMempool() {
+		// Nonce too low or transaction known errors are expected since
+		// the primary SendTransaction may well have succeeded already
+		return
+	}
+	logger.Warnw(tier+" eth client returned error", "err", err, "tx", tx)
 }
 
 func (client *client) PendingNonceAt(ctx context.Context, account common.Address) (uint64, error) {
-	return client.primary.PendingNonceAt(ctx, account)
+	return client.primary().PendingNonceAt(ctx, account)
 }
 
 func (client *client) NonceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (uint64, error) {
-	return client.primary.NonceAt(ctx, account, blockNumber)
+	return client.primary().NonceAt(ctx, account, blockNumber)
 }
 
 func (client *client) PendingCodeAt(ctx context.Context, account common.Address) ([]byte, error) {
-	return client.primary.PendingCodeAt(ctx, account)
+	return client.primary().PendingCodeAt(ctx, account)
 }
 
 func (client *client) EstimateGas(ctx context.Context, call ethereum.CallMsg) (gas uint64, err error) {
-	return client.primary.EstimateGas(ctx, call)
+	return client.primary().EstimateGas(ctx, call)
 }
 
 func (client *client) SuggestGasPrice(ctx context.Context) (*big.Int, error) {
-	return client.primary.SuggestGasPrice(ctx)
+	return client.primary().SuggestGasPrice(ctx)
 }
 
 func (client *client) CallContract(ctx context.Context, msg ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
-	return client.primary.CallContract(ctx, msg, blockNumber)
+	return client.primary().CallContract(ctx, msg, blockNumber)
 }
 
 func (client *client) CodeAt(ctx context.Context, account common.Address, blockNumber *big.Int) ([]byte, error) {
-	return client.primary.CodeAt(ctx, account, blockNumber)
+	return client.primary().CodeAt(ctx, account, blockNumber)
 }
 
 func (client *client) BlockByNumber(ctx context.Context, number *big.Int) (*types.Block, error) {
-	return client.primary.BlockByNumber(ctx, number)
+	return client.primary().BlockByNumber(ctx, number)
 }
 
 func (client *client) HeadByNumber(ctx context.Context, number *big.Int) (head *models.Head, err error) {
 	hex := toBlockNumArg(number)
-	err = client.primary.CallContext(ctx, &head, "eth_getBlockByNumber", hex, false)
+	err = client.primary().CallContext(ctx, &head, "eth_getBlockByNumber", hex, false)
 	if err == nil && head == nil {
 		err = ethereum.NotFound
 	}
@@ -279,40 +367,52 @@ func toBlockNumArg(number *big.Int) string {
 }
 
 func (client *client) BalanceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (*big.Int, error) {
-	return client.primary.BalanceAt(ctx, account, blockNumber)
+	return client.primary().BalanceAt(ctx, account, blockNumber)
 }
 
 func (client *client) FilterLogs(ctx context.Context, q ethereum.FilterQuery) ([]types.Log, error) {
-	return client.primary.FilterLogs(ctx, q)
+	return client.primary().FilterLogs(ctx, q)
 }
 
 func (client *client) SubscribeFilterLogs(ctx context.Context, q ethereum.FilterQuery, ch chan<- types.Log) (ethereum.Subscription, error) {
 	logger.Debugw("eth.Client#SubscribeFilterLogs(...)",
 		"q", q,
 	)
-	return client.primary.SubscribeFilterLogs(ctx, q, ch)
+	if !client.Capabilities().Subscriptions {
+		return client.pollFilterLogs(ctx, q, ch)
+	}
+	return client.primaries.subscribe(func(n *node) (ethereum.Subscription, error) {
+		return n.SubscribeFilterLogs(ctx, q, ch)
+	})
 }
 
 func (client *client) SubscribeNewHead(ctx context.Context, ch chan<- *models.Head) (ethereum.Subscription, error) {
-	return client.primary.EthSubscribe(ctx, ch, "newHeads")
+	if !client.Capabilities().Subscriptions {
+		return client.pollNewHeads(ch), nil
+	}
+	return client.primaries.subscribe(func(n *node) (ethereum.Subscription, error) {
+		return n.EthSubscribe(ctx, ch, "newHeads")
+	})
 }
 
 func (client *client) EthSubscribe(ctx context.Context, channel interface{}, args ...interface{}) (ethereum.Subscription, error) {
-	return client.primary.EthSubscribe(ctx, channel, args...)
+	return client.primaries.subscribe(func(n *node) (ethereum.Subscription, error) {
+		return n.EthSubscribe(ctx, channel, args...)
+	})
 }
 
 func (client *client) Call(result interface{}, method string, args ...interface{}) error {
 	ctx, cancel := DefaultQueryCtx()
 	defer cancel()
-	return client.primary.CallContext(ctx, result, method, args...)
+	return client.primary().CallContext(ctx, result, method, args...)
 }
 
 func (client *client) CallContext(ctx context.Context, result interface{}, method string, args ...interface{}) error {
-	return client.primary.CallContext(ctx, result, method, args...)
+	return client.primary().CallContext(ctx, result, method, args...)
 }
 
 func (client *client) BatchCallContext(ctx context.Context, b []rpc.BatchElem) error {
-	return client.primary.BatchCallContext(ctx, b)
+	return client.primary().BatchCallContext(ctx, b)
 }
 
 // RoundRobinBatchCallContext rotates through Primary and all Secondaries, changing node on each call
@@ -334,5 +434,5 @@ func (client *client) RoundRobinBatchCallContext(ctx context.Context, b []rpc.Ba
 }
 
 func (client *client) SuggestGasTipCap(ctx context.Context) (tipCap *big.Int, err error) {
-	return client.primary.SuggestGasTipCap(ctx)
+	return client.primary().SuggestGasTipCap(ctx)
 }
@@ -333,3 +333,36 @@ func TestEthClient_SendTransaction_WithSecondaryURLs(t *testing.T) {
 		return len(requests)
 	}).Should(gomega.Equal(2))
 }
+
+func TestEthClient_SendTransaction_SendsToAllNodes(t *testing.T) {
+	t.Parallel()
+
+	tx := types.NewTransaction(uint64(42), cltest.NewAddress(), big.NewInt(142), 242, big.NewInt(342), []byte{1, 2, 3})
+	response := `{
+  "id": 1,
+  "jsonrpc": "2.0",
+  "result": "` + tx.Hash().Hex() + `"
+}`
+
+	requests := make(chan string, 2)
+	callback := func(data []byte) {
+		requests <- cltest.ParseJSON(t, bytes.NewReader(data)).Get("method").String()
+	}
+	_, primaryURL, cleanup := cltest.NewWSServer(response, callback)
+	defer cleanup()
+	_, additionalURL, additionalCleanup := cltest.NewWSServer(response, callback)
+	defer additionalCleanup()
+
+	ethClient, err := eth.NewClientWithPool(primaryURL, nil, nil, eth.PoolConfig{
+		AdditionalPrimaryURLs: []url.URL{*cltest.MustParseURL(additionalURL)},
+		SendToAllNodes:        true,
+	})
+	require.NoError(t, err)
+	require.NoError(t, ethClient.Dial(context.Background()))
+	defer ethClient.Close()
+
+	require.NoError(t, ethClient.SendTransaction(context.Background(), tx))
+
+	assert.Equal(t, "eth_sendRawTransaction", <-requests)
+	assert.Equal(t, "eth_sendRawTransaction", <-requests)
+}
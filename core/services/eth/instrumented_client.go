@@ -0,0 +1,318 @@
+package eth
+
+import (
+	"context"
+	"math/big"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/smartcontractkit/chainlink/core/assets"
+	"github.com/smartcontractkit/chainlink/core/store/models"
+)
+
+var (
+	promRPCCallDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "eth_rpc_call_duration_seconds",
+		Help:    "How long calls to the eth node took, by endpoint and method",
+		Buckets: prometheus.ExponentialBuckets(0.005, 2, 12),
+	},
+		[]string{"endpoint", "method"},
+	)
+	promRPCCallErrors = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "eth_rpc_call_errors_total",
+		Help: "The number of calls to the eth node which failed, by endpoint and method",
+	},
+		[]string{"endpoint", "method"},
+	)
+	promRPCCallRateLimited = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "eth_rpc_call_rate_limited_total",
+		Help: "The number of calls to the eth node which were rate limited, by endpoint and method",
+	},
+		[]string{"endpoint", "method"},
+	)
+)
+
+// RPCStats are the statistics of the calls of one method to one eth node
+// endpoint since the node started
+type RPCStats struct {
+	Endpoint    string
+	Method      string
+	Calls       uint64
+	Errors      uint64
+	RateLimited uint64
+	// TotalLatency is the sum of the latencies of the calls
+	TotalLatency time.Duration
+	MaxLatency   time.Duration
+	LastErrorAt  *time.Time
+}
+
+// AverageLatency returns the average latency of the calls
+func (s RPCStats) AverageLatency() time.Duration {
+	if s.Calls == 0 {
+		return 0
+	}
+	return s.TotalLatency / time.Duration(s.Calls)
+}
+
+type rpcStatsKey struct {
+	endpoint string
+	method   string
+}
+
+// rpcStatsRegistry collects the RPCStats of every instrumented client
+type rpcStatsRegistry struct {
+	mu    sync.RWMutex
+	stats map[rpcStatsKey]*RPCStats
+}
+
+var rpcStats = &rpcStatsRegistry{stats: make(map[rpcStatsKey]*RPCStats)}
+
+// GetRPCStats returns the statistics of the calls made by the instrumented
+// clients, ordered by endpoint and method
+func GetRPCStats() []RPCStats {
+	return rpcStats.all()
+}
+
+func (r *rpcStatsRegistry) observe(endpoint, method string, latency time.Duration, err error, rateLimited bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	key := rpcStatsKey{endpoint, method}
+	s, exists := r.stats[key]
+	if !exists {
+		s = &RPCStats{Endpoint: endpoint, Method: method}
+		r.stats[key] = s
+	}
+	s.Calls++
+	s.TotalLatency += latency
+	if latency > s.MaxLatency {
+		s.MaxLatency = latency
+	}
+	if err != nil {
+		now := time.Now()
+		s.Errors++
+		s.LastErrorAt = &now
+	}
+	if rateLimited {
+		s.RateLimited++
+	}
+}
+
+func (r *rpcStatsRegistry) all() []RPCStats {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	stats := make([]RPCStats, 0, len(r.stats))
+	for _, s := range r.stats {
+		stats = append(stats, *s)
+	}
+	sort.Slice(stats, func(i, j int) bool {
+		if stats[i].Endpoint != stats[j].Endpoint {
+			return stats[i].Endpoint < stats[j].Endpoint
+		}
+		return stats[i].Method < stats[j].Method
+	})
+	return stats
+}
+
+// rateLimitedErrorCode is the JSON-RPC error code of requests rejected for
+// exceeding a limit, e.g. by Infura
+const rateLimitedErrorCode = -32005
+
+// isRateLimited returns true if the error is a response of the eth node
+// rejecting the call for exceeding its rate limit
+func isRateLimited(err error) bool {
+	if err == nil {
+		return false
+	}
+	var httpErr rpc.HTTPError
+	if errors.As(err, &httpErr) && httpErr.StatusCode == http.StatusTooManyRequests {
+		return true
+	}
+	var rpcErr rpc.Error
+	if errors.As(err, &rpcErr) && rpcErr.ErrorCode() == rateLimitedErrorCode {
+		return true
+	}
+	msg := strings.ToLower(err.Error())
+	return strings.Contains(msg, "rate limit") || strings.Contains(msg, "too many requests")
+}
+
+// EndpointName returns the name of the eth node endpoint with the given URL
+// for metrics, without its path, query or credentials which may contain API
+// keys
+func EndpointName(rawURL string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil || u.Host == "" {
+		return "unknown"
+	}
+	return u.Scheme + "://" + u.Host
+}
+
+// instrumentedClient records the latency and errors of every call to the
+// eth node
+type instrumentedClient struct {
+	Client
+	endpoint string
+}
+
+var _ Client = (*instrumentedClient)(nil)
+
+// NewInstrumentedClient wraps the client to record the latency, errors and
+// rate limited responses of its calls to the given endpoint, both as
+// prometheus metrics and in the stats returned by GetRPCStats
+func NewInstrumentedClient(c Client, endpoint string) Client {
+	return &instrumentedClient{c, endpoint}
+}
+
+func (c *instrumentedClient) unwrap() Client {
+	return c.Client
+}
+
+// observe records a call to method which started at start and returned err
+func (c *instrumentedClient) observe(method string, start time.Time, err error) {
+	latency := time.Since(start)
+	rateLimited := isRateLimited(err)
+	promRPCCallDuration.WithLabelValues(c.endpoint, method).Observe(latency.Seconds())
+	if err != nil {
+		promRPCCallErrors.WithLabelValues(c.endpoint, method).Inc()
+	}
+	if rateLimited {
+		promRPCCallRateLimited.WithLabelValues(c.endpoint, method).Inc()
+	}
+	rpcStats.observe(c.endpoint, method, latency, err, rateLimited)
+}
+
+func (c *instrumentedClient) GetERC20Balance(address common.Address, contractAddress common.Address) (b *big.Int, err error) {
+	defer func(start time.Time) { c.observe("GetERC20Balance", start, err) }(time.Now())
+	return c.Client.GetERC20Balance(address, contractAddress)
+}
+
+func (c *instrumentedClient) GetLINKBalance(linkAddress common.Address, address common.Address) (b *assets.Link, err error) {
+	defer func(start time.Time) { c.observe("GetLINKBalance", start, err) }(time.Now())
+	return c.Client.GetLINKBalance(linkAddress, address)
+}
+
+func (c *instrumentedClient) GetEthBalance(ctx context.Context, account common.Address, blockNumber *big.Int) (b *assets.Eth, err error) {
+	defer func(start time.Time) { c.observe("GetEthBalance", start, err) }(time.Now())
+	return c.Client.GetEthBalance(ctx, account, blockNumber)
+}
+
+func (c *instrumentedClient) Call(result interface{}, method string, args ...interface{}) (err error) {
+	defer func(start time.Time) { c.observe("Call", start, err) }(time.Now())
+	return c.Client.Call(result, method, args...)
+}
+
+func (c *instrumentedClient) CallContext(ctx context.Context, result interface{}, method string, args ...interface{}) (err error) {
+	defer func(start time.Time) { c.observe("CallContext", start, err) }(time.Now())
+	return c.Client.CallContext(ctx, result, method, args...)
+}
+
+func (c *instrumentedClient) BatchCallContext(ctx context.Context, b []rpc.BatchElem) (err error) {
+	defer func(start time.Time) { c.observe("BatchCallContext", start, err) }(time.Now())
+	return c.Client.BatchCallContext(ctx, b)
+}
+
+func (c *instrumentedClient) RoundRobinBatchCallContext(ctx context.Context, b []rpc.BatchElem) (err error) {
+	defer func(start time.Time) { c.observe("RoundRobinBatchCallContext", start, err) }(time.Now())
+	return c.Client.RoundRobinBatchCallContext(ctx, b)
+}
+
+func (c *instrumentedClient) HeadByNumber(ctx context.Context, n *big.Int) (head *models.Head, err error) {
+	defer func(start time.Time) { c.observe("HeadByNumber", start, err) }(time.Now())
+	return c.Client.HeadByNumber(ctx, n)
+}
+
+func (c *instrumentedClient) SubscribeNewHead(ctx context.Context, ch chan<- *models.Head) (sub ethereum.Subscription, err error) {
+	defer func(start time.Time) { c.observe("SubscribeNewHead", start, err) }(time.Now())
+	return c.Client.SubscribeNewHead(ctx, ch)
+}
+
+func (c *instrumentedClient) ChainID(ctx context.Context) (id *big.Int, err error) {
+	defer func(start time.Time) { c.observe("ChainID", start, err) }(time.Now())
+	return c.Client.ChainID(ctx)
+}
+
+func (c *instrumentedClient) SendTransaction(ctx context.Context, tx *types.Transaction) (err error) {
+	defer func(start time.Time) { c.observe("SendTransaction", start, err) }(time.Now())
+	return c.Client.SendTransaction(ctx, tx)
+}
+
+func (c *instrumentedClient) PendingCodeAt(ctx context.Context, account common.Address) (code []byte, err error) {
+	defer func(start time.Time) { c.observe("PendingCodeAt", start, err) }(time.Now())
+	return c.Client.PendingCodeAt(ctx, account)
+}
+
+func (c *instrumentedClient) PendingNonceAt(ctx context.Context, account common.Address) (nonce uint64, err error) {
+	defer func(start time.Time) { c.observe("PendingNonceAt", start, err) }(time.Now())
+	return c.Client.PendingNonceAt(ctx, account)
+}
+
+func (c *instrumentedClient) NonceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (nonce uint64, err error) {
+	defer func(start time.Time) { c.observe("NonceAt", start, err) }(time.Now())
+	return c.Client.NonceAt(ctx, account, blockNumber)
+}
+
+func (c *instrumentedClient) TransactionReceipt(ctx context.Context, txHash common.Hash) (receipt *types.Receipt, err error) {
+	defer func(start time.Time) { c.observe("TransactionReceipt", start, err) }(time.Now())
+	return c.Client.TransactionReceipt(ctx, txHash)
+}
+
+func (c *instrumentedClient) BlockByNumber(ctx context.Context, number *big.Int) (b *types.Block, err error) {
+	defer func(start time.Time) { c.observe("BlockByNumber", start, err) }(time.Now())
+	return c.Client.BlockByNumber(ctx, number)
+}
+
+func (c *instrumentedClient) BalanceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (balance *big.Int, err error) {
+	defer func(start time.Time) { c.observe("BalanceAt", start, err) }(time.Now())
+	return c.Client.BalanceAt(ctx, account, blockNumber)
+}
+
+func (c *instrumentedClient) FilterLogs(ctx context.Context, q ethereum.FilterQuery) (logs []types.Log, err error) {
+	defer func(start time.Time) { c.observe("FilterLogs", start, err) }(time.Now())
+	return c.Client.FilterLogs(ctx, q)
+}
+
+func (c *instrumentedClient) SubscribeFilterLogs(ctx context.Context, q ethereum.FilterQuery, ch chan<- types.Log) (sub ethereum.Subscription, err error) {
+	defer func(start time.Time) { c.observe("SubscribeFilterLogs", start, err) }(time.Now())
+	return c.Client.SubscribeFilterLogs(ctx, q, ch)
+}
+
+func (c *instrumentedClient) EstimateGas(ctx context.Context, call ethereum.CallMsg) (gas uint64, err error) {
+	defer func(start time.Time) { c.observe("EstimateGas", start, err) }(time.Now())
+	return c.Client.EstimateGas(ctx, call)
+}
+
+func (c *instrumentedClient) SuggestGasPrice(ctx context.Context) (price *big.Int, err error) {
+	defer func(start time.Time) { c.observe("SuggestGasPrice", start, err) }(time.Now())
+	return c.Client.SuggestGasPrice(ctx)
+}
+
+func (c *instrumentedClient) CallContract(ctx context.Context, msg ethereum.CallMsg, blockNumber *big.Int) (val []byte, err error) {
+	defer func(start time.Time) { c.observe("CallContract", start, err) }(time.Now())
+	return c.Client.CallContract(ctx, msg, blockNumber)
+}
+
+func (c *instrumentedClient) CodeAt(ctx context.Context, account common.Address, blockNumber *big.Int) (code []byte, err error) {
+	defer func(start time.Time) { c.observe("CodeAt", start, err) }(time.Now())
+	return c.Client.CodeAt(ctx, account, blockNumber)
+}
+
+func (c *instrumentedClient) HeaderByNumber(ctx context.Context, n *big.Int) (header *types.Header, err error) {
+	defer func(start time.Time) { c.observe("HeaderByNumber", start, err) }(time.Now())
+	return c.Client.HeaderByNumber(ctx, n)
+}
+
+func (c *instrumentedClient) SuggestGasTipCap(ctx context.Context) (tipCap *big.Int, err error) {
+	defer func(start time.Time) { c.observe("SuggestGasTipCap", start, err) }(time.Now())
+	return c.Client.SuggestGasTipCap(ctx)
+}
@@ -22,7 +22,8 @@ type rawclient struct {
 }
 
 // node represents one ethereum node.
-// It must have a ws url and may have a http url
+// It must have a ws url and may have a http url. The ws url of the node of
+// ETH_URL may be a http url too, for nodes without websockets.
 type node struct {
 	ws     rawclient
 	http   *rawclient
@@ -58,13 +59,19 @@ func (n *node) Dial(ctx context.Context) error {
 
 	{
 		uri := n.ws.uri.String()
-		rpc, err := rpc.DialWebsocket(ctx, uri, "")
+		var c *rpc.Client
+		var err error
+		if n.supportsSubscriptions() {
+			c, err = rpc.DialWebsocket(ctx, uri, "")
+		} else {
+			c, err = rpc.DialHTTP(uri)
+		}
 		if err != nil {
 			return err
 		}
 		n.dialed = true
-		n.ws.rpc = rpc
-		n.ws.geth = ethclient.NewClient(rpc)
+		n.ws.rpc = c
+		n.ws.geth = ethclient.NewClient(c)
 	}
 
 	if n.http != nil {
@@ -80,6 +87,12 @@ func (n *node) Dial(ctx context.Context) error {
 	return nil
 }
 
+// supportsSubscriptions returns false if the node has no websocket URL, so
+// that subscriptions must be polled
+func (n node) supportsSubscriptions() bool {
+	return n.ws.uri.Scheme == "ws" || n.ws.uri.Scheme == "wss"
+}
+
 // RPC wrappers
 
 func (n node) CallContext(ctx context.Context, result interface{}, method string, args ...interface{}) error {
@@ -334,6 +347,9 @@ func (n node) SuggestGasTipCap(ctx context.Context) (tipCap *big.Int, err error)
 }
 
 func (n node) wrapWS(err error) error {
+	if !n.supportsSubscriptions() {
+		return wrap(err, fmt.Sprintf("primary http (%s)", n.ws.uri.String()))
+	}
 	return wrap(err, fmt.Sprintf("primary websocket (%s)", n.ws.uri.String()))
 }
 
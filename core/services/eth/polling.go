@@ -0,0 +1,138 @@
+package eth
+
+import (
+	"context"
+	"math/big"
+	"sync"
+	"time"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/smartcontractkit/chainlink/core/gracefulpanic"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/store/models"
+)
+
+// defaultHTTPPollInterval is how often heads and logs are polled from nodes
+// without subscriptions, if the client is not configured with an interval
+const defaultHTTPPollInterval = 15 * time.Second
+
+// pollingSubscription emulates a subscription, for nodes which do not
+// support eth_subscribe, by calling poll every interval until unsubscribed.
+// Poll errors are logged and retried on the next interval.
+type pollingSubscription struct {
+	chErr     chan error
+	ctx       context.Context
+	cancel    context.CancelFunc
+	unsubOnce sync.Once
+	wg        sync.WaitGroup
+}
+
+var _ ethereum.Subscription = (*pollingSubscription)(nil)
+
+func newPollingSubscription(interval time.Duration, poll func(ctx context.Context)) *pollingSubscription {
+	ctx, cancel := context.WithCancel(context.Background())
+	s := &pollingSubscription{
+		chErr:  make(chan error),
+		ctx:    ctx,
+		cancel: cancel,
+	}
+	s.wg.Add(1)
+	go gracefulpanic.WrapRecover(func() {
+		defer s.wg.Done()
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			poll(ctx)
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	})
+	return s
+}
+
+func (s *pollingSubscription) Err() <-chan error {
+	return s.chErr
+}
+
+func (s *pollingSubscription) Unsubscribe() {
+	s.unsubOnce.Do(func() {
+		s.cancel()
+		s.wg.Wait()
+		close(s.chErr)
+	})
+}
+
+// pollNewHeads sends the latest head to ch whenever it changes. Heads which
+// were superseded between two polls are skipped, the head tracker backfills
+// them.
+func (client *client) pollNewHeads(ch chan<- *models.Head) ethereum.Subscription {
+	var latest int64 = -1
+	return newPollingSubscription(client.httpPollInterval(), func(ctx context.Context) {
+		queryCtx, cancel := DefaultQueryCtx(ctx)
+		defer cancel()
+		head, err := client.HeadByNumber(queryCtx, nil)
+		if err != nil {
+			if ctx.Err() == nil {
+				logger.Warnw("eth.Client: failed to poll latest head", "err", err)
+			}
+			return
+		}
+		if head.Number <= latest {
+			return
+		}
+		latest = head.Number
+		select {
+		case ch <- head:
+		case <-ctx.Done():
+		}
+	})
+}
+
+// pollFilterLogs sends the logs matching q in the blocks after the latest one
+// at the time of subscribing. Unlike a subscription, logs removed by re-orgs
+// are not sent again as removed.
+func (client *client) pollFilterLogs(ctx context.Context, q ethereum.FilterQuery, ch chan<- types.Log) (ethereum.Subscription, error) {
+	head, err := client.HeadByNumber(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	next := head.Number + 1
+	return newPollingSubscription(client.httpPollInterval(), func(ctx context.Context) {
+		queryCtx, cancel := DefaultQueryCtx(ctx)
+		defer cancel()
+		head, err := client.HeadByNumber(queryCtx, nil)
+		if err != nil {
+			if ctx.Err() == nil {
+				logger.Warnw("eth.Client: failed to poll latest head for logs", "err", err)
+			}
+			return
+		}
+		if head.Number < next {
+			return
+		}
+		filter := q
+		filter.BlockHash = nil
+		filter.FromBlock = big.NewInt(next)
+		filter.ToBlock = big.NewInt(head.Number)
+		logs, err := client.FilterLogs(queryCtx, filter)
+		if err != nil {
+			if ctx.Err() == nil {
+				logger.Warnw("eth.Client: failed to poll logs", "fromBlock", next, "toBlock", head.Number, "err", err)
+			}
+			return
+		}
+		for _, log := range logs {
+			select {
+			case ch <- log:
+			case <-ctx.Done():
+				return
+			}
+		}
+		next = head.Number + 1
+	}), nil
+}
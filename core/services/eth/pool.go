@@ -0,0 +1,322 @@
+package eth
+
+import (
+	"context"
+	"net/url"
+	"sync"
+	"time"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/smartcontractkit/chainlink/core/gracefulpanic"
+	"github.com/smartcontractkit/chainlink/core/logger"
+)
+
+var promPrimaryNodeAlive = promauto.NewGaugeVec(prometheus.GaugeOpts{
+	Name: "eth_primary_node_alive",
+	Help: "Whether the primary eth node passed its last health check, 1 if it did and 0 if not",
+},
+	[]string{"endpoint"},
+)
+
+// nodeHealthCheckTimeout is how long a node has to respond to a health check
+const nodeHealthCheckTimeout = 5 * time.Second
+
+// PoolConfig configures the primary nodes of a client beyond the node of
+// ETH_URL, and how the client uses the node of ETH_URL
+type PoolConfig struct {
+	// AdditionalPrimaryURLs are the websocket URLs of the nodes which reads
+	// and subscriptions fail over to, in order, when the node of ETH_URL is
+	// unhealthy
+	AdditionalPrimaryURLs []url.URL
+	// PollInterval is how often the primary nodes are health checked. 0
+	// disables the health checks, and so failing over.
+	PollInterval time.Duration
+	// SendToAllNodes also broadcasts transactions to the primary nodes which
+	// are not in use, to reduce their propagation latency
+	SendToAllNodes bool
+	// DetectCapabilities probes the node of ETH_URL on connect for the RPC
+	// APIs it supports
+	DetectCapabilities bool
+	// HTTPPollInterval is how often heads and logs are polled when the node
+	// of ETH_URL does not support subscriptions
+	HTTPPollInterval time.Duration
+}
+
+// ErrPrimaryNodeChanged is the error of subscriptions made on a primary node
+// which is no longer in use. Their consumers resubscribe, which subscribes
+// on the node in use.
+var ErrPrimaryNodeChanged = errors.New("eth.Client: the primary node in use changed, resubscribe")
+
+// pool is the primary nodes of a client. Reads and subscriptions use the
+// first healthy node, preferring the node of ETH_URL as soon as it recovers.
+// Subscriptions are tracked per node, and those made on a node are failed
+// with ErrPrimaryNodeChanged once it is no longer in use.
+type pool struct {
+	nodes        []*node
+	pollInterval time.Duration
+	// checkHealth returns an error if the node is unhealthy
+	checkHealth func(ctx context.Context, n *node) error
+
+	mu     sync.RWMutex
+	active int
+	alive  []bool
+	// subs are the subscriptions in effect, by the index of their node
+	subs map[*poolSubscription]int
+
+	chStop    chan struct{}
+	closeOnce sync.Once
+	wg        sync.WaitGroup
+}
+
+func newPool(nodes []*node, pollInterval time.Duration) *pool {
+	alive := make([]bool, len(nodes))
+	for i := range alive {
+		alive[i] = true
+	}
+	return &pool{
+		nodes:        nodes,
+		pollInterval: pollInterval,
+		checkHealth:  checkNodeHealth,
+		alive:        alive,
+		subs:         make(map[*poolSubscription]int),
+		chStop:       make(chan struct{}),
+	}
+}
+
+// checkNodeHealth dials the node if it is not yet, and checks that it
+// responds with its latest block number
+func checkNodeHealth(ctx context.Context, n *node) error {
+	if !n.dialed {
+		if err := n.Dial(ctx); err != nil {
+			return err
+		}
+	}
+	var blockNumber hexutil.Big
+	return n.CallContext(ctx, &blockNumber, "eth_blockNumber")
+}
+
+// dial dials the node of ETH_URL, which must succeed. The additional nodes
+// which fail to dial are dialed again by the health checks.
+func (p *pool) dial(ctx context.Context) error {
+	if err := p.nodes[0].Dial(ctx); err != nil {
+		return err
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for i, n := range p.nodes[1:] {
+		if err := n.Dial(ctx); err != nil {
+			n.log.Warnw("eth.Client: failed to dial additional primary node, it will be dialed again once healthy", "err", err)
+			p.alive[i+1] = false
+		}
+	}
+	return nil
+}
+
+// start health checks the nodes every pollInterval, if there is more than
+// one to fail over to
+func (p *pool) start() {
+	if len(p.nodes) < 2 || p.pollInterval <= 0 {
+		return
+	}
+	p.wg.Add(1)
+	go gracefulpanic.WrapRecover(func() {
+		defer p.wg.Done()
+		ticker := time.NewTicker(p.pollInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-p.chStop:
+				return
+			case <-ticker.C:
+				p.checkNodes()
+			}
+		}
+	})
+}
+
+func (p *pool) close() {
+	p.closeOnce.Do(func() {
+		close(p.chStop)
+		p.wg.Wait()
+		for _, n := range p.nodes {
+			if n.dialed {
+				n.Close()
+			}
+		}
+	})
+}
+
+// checkNodes health checks every node in parallel and fails over to the
+// first healthy one. The node in use is kept if none is healthy.
+func (p *pool) checkNodes() {
+	alive := make([]bool, len(p.nodes))
+	var wg sync.WaitGroup
+	for i, n := range p.nodes {
+		wg.Add(1)
+		go func(i int, n *node) {
+			defer wg.Done()
+			ctx, cancel := context.WithTimeout(context.Background(), nodeHealthCheckTimeout)
+			defer cancel()
+			if err := p.checkHealth(ctx, n); err != nil {
+				n.log.Warnw("eth.Client: primary node failed health check", "err", err)
+				return
+			}
+			alive[i] = true
+		}(i, n)
+	}
+	wg.Wait()
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.alive = alive
+	for i, n := range p.nodes {
+		if alive[i] {
+			promPrimaryNodeAlive.WithLabelValues(EndpointName(n.ws.uri.String())).Set(1)
+		} else {
+			promPrimaryNodeAlive.WithLabelValues(EndpointName(n.ws.uri.String())).Set(0)
+		}
+	}
+	for i := range p.nodes {
+		if !alive[i] {
+			continue
+		}
+		if i != p.active {
+			logger.Warnw("eth.Client: failing over to another primary node",
+				"from", EndpointName(p.nodes[p.active].ws.uri.String()),
+				"to", EndpointName(p.nodes[i].ws.uri.String()),
+			)
+			p.active = i
+			p.failSubscriptions()
+		}
+		return
+	}
+	logger.Errorw("eth.Client: no primary node is healthy, keeping the node in use",
+		"node", EndpointName(p.nodes[p.active].ws.uri.String()),
+	)
+}
+
+// activeNode returns the node reads and subscriptions use
+func (p *pool) activeNode() *node {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	return p.nodes[p.active]
+}
+
+// standbyNodes returns the healthy nodes which are not in use
+func (p *pool) standbyNodes() []*node {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	var nodes []*node
+	for i, n := range p.nodes {
+		if i != p.active && p.alive[i] {
+			nodes = append(nodes, n)
+		}
+	}
+	return nodes
+}
+
+// failSubscriptions fails the subscriptions which were not made on the node
+// in use. Caller must hold p.mu.
+func (p *pool) failSubscriptions() {
+	for s, i := range p.subs {
+		if i != p.active {
+			s.fail()
+		}
+	}
+}
+
+// subscribe subscribes on the node in use with subscribe, and tracks the
+// subscription until it fails or is unsubscribed
+func (p *pool) subscribe(subscribe func(n *node) (ethereum.Subscription, error)) (ethereum.Subscription, error) {
+	p.mu.RLock()
+	active := p.active
+	p.mu.RUnlock()
+
+	inner, err := subscribe(p.nodes[active])
+	if err != nil {
+		return nil, err
+	}
+	s := newPoolSubscription(p, inner)
+	p.mu.Lock()
+	p.subs[s] = active
+	// The node in use may have changed while subscribing
+	p.failSubscriptions()
+	p.mu.Unlock()
+	s.start()
+	return s, nil
+}
+
+func (p *pool) untrack(s *poolSubscription) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	delete(p.subs, s)
+}
+
+// poolSubscription is a subscription on a primary node, which fails with
+// ErrPrimaryNodeChanged once the node is no longer in use
+type poolSubscription struct {
+	pool      *pool
+	inner     ethereum.Subscription
+	chErr     chan error
+	chFail    chan struct{}
+	chUnsub   chan struct{}
+	chDone    chan struct{}
+	failOnce  sync.Once
+	unsubOnce sync.Once
+}
+
+var _ ethereum.Subscription = (*poolSubscription)(nil)
+
+func newPoolSubscription(p *pool, inner ethereum.Subscription) *poolSubscription {
+	return &poolSubscription{
+		pool:    p,
+		inner:   inner,
+		chErr:   make(chan error, 1),
+		chFail:  make(chan struct{}),
+		chUnsub: make(chan struct{}),
+		chDone:  make(chan struct{}),
+	}
+}
+
+// start forwards the error of the inner subscription, or
+// ErrPrimaryNodeChanged once the subscription fails, until unsubscribed
+func (s *poolSubscription) start() {
+	go gracefulpanic.WrapRecover(func() {
+		defer close(s.chDone)
+		defer s.pool.untrack(s)
+		select {
+		case err, open := <-s.inner.Err():
+			if open {
+				s.chErr <- err
+			}
+		case <-s.chFail:
+			s.inner.Unsubscribe()
+			s.chErr <- ErrPrimaryNodeChanged
+		case <-s.chUnsub:
+		}
+	})
+}
+
+func (s *poolSubscription) fail() {
+	s.failOnce.Do(func() {
+		close(s.chFail)
+	})
+}
+
+func (s *poolSubscription) Err() <-chan error {
+	return s.chErr
+}
+
+func (s *poolSubscription) Unsubscribe() {
+	s.unsubOnce.Do(func() {
+		close(s.chUnsub)
+		<-s.chDone
+		s.inner.Unsubscribe()
+		close(s.chErr)
+	})
+}
@@ -0,0 +1,148 @@
+package eth
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/event"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestPool(t *testing.T, n int) (*pool, func(i int, healthy bool)) {
+	t.Helper()
+	var nodes []*node
+	for i := 0; i < n; i++ {
+		u, err := url.Parse(fmt.Sprintf("ws://node-%d.example.com", i))
+		if err != nil {
+			t.Fatal(err)
+		}
+		nodes = append(nodes, newNode(*u, nil, fmt.Sprintf("eth-primary-%d", i)))
+	}
+	p := newPool(nodes, 0)
+
+	var mu sync.Mutex
+	healthy := make(map[*node]bool)
+	for _, n := range nodes {
+		healthy[n] = true
+	}
+	p.checkHealth = func(_ context.Context, n *node) error {
+		mu.Lock()
+		defer mu.Unlock()
+		if !healthy[n] {
+			return errors.New("unhealthy")
+		}
+		return nil
+	}
+	return p, func(i int, h bool) {
+		mu.Lock()
+		defer mu.Unlock()
+		healthy[nodes[i]] = h
+	}
+}
+
+func TestPool_FailsOver(t *testing.T) {
+	t.Parallel()
+
+	p, setHealthy := newTestPool(t, 3)
+	assert.Equal(t, p.nodes[0], p.activeNode())
+	assert.Equal(t, []*node{p.nodes[1], p.nodes[2]}, p.standbyNodes())
+
+	// Fails over to the next healthy node in order
+	setHealthy(0, false)
+	p.checkNodes()
+	assert.Equal(t, p.nodes[1], p.activeNode())
+	assert.Equal(t, []*node{p.nodes[2]}, p.standbyNodes())
+
+	setHealthy(1, false)
+	p.checkNodes()
+	assert.Equal(t, p.nodes[2], p.activeNode())
+	assert.Empty(t, p.standbyNodes())
+
+	// Keeps the node in use if none is healthy
+	setHealthy(2, false)
+	p.checkNodes()
+	assert.Equal(t, p.nodes[2], p.activeNode())
+
+	// Prefers the first node as soon as it recovers
+	setHealthy(0, true)
+	p.checkNodes()
+	assert.Equal(t, p.nodes[0], p.activeNode())
+	assert.Empty(t, p.standbyNodes())
+}
+
+// newTestSubscription returns a subscription which reports on unsubscribed
+// once it is unsubscribed
+func newTestSubscription() (sub ethereum.Subscription, unsubscribed <-chan struct{}) {
+	chUnsubscribed := make(chan struct{})
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		<-quit
+		close(chUnsubscribed)
+		return nil
+	}), chUnsubscribed
+}
+
+func TestPool_FailsSubscriptionsOver(t *testing.T) {
+	t.Parallel()
+
+	p, setHealthy := newTestPool(t, 2)
+	subscribe := func(t *testing.T, expected *node) (ethereum.Subscription, <-chan struct{}) {
+		inner, unsubscribed := newTestSubscription()
+		sub, err := p.subscribe(func(n *node) (ethereum.Subscription, error) {
+			assert.Equal(t, expected, n)
+			return inner, nil
+		})
+		require.NoError(t, err)
+		return sub, unsubscribed
+	}
+
+	sub, unsubscribed := subscribe(t, p.nodes[0])
+	// A subscription unsubscribed before the failover closes without an error
+	other, _ := subscribe(t, p.nodes[0])
+	other.Unsubscribe()
+	err, open := <-other.Err()
+	assert.False(t, open)
+	assert.NoError(t, err)
+
+	// The subscription on the first node fails once the second is in use
+	setHealthy(0, false)
+	p.checkNodes()
+	select {
+	case err := <-sub.Err():
+		assert.Equal(t, ErrPrimaryNodeChanged, err)
+	case <-time.After(time.Second):
+		t.Fatal("subscription did not fail")
+	}
+	select {
+	case <-unsubscribed:
+	case <-time.After(time.Second):
+		t.Fatal("subscription on the first node was not unsubscribed")
+	}
+	sub.Unsubscribe()
+	_, open = <-sub.Err()
+	assert.False(t, open)
+
+	// Resubscribing subscribes on the second node, which is kept while it
+	// is in use
+	resub, _ := subscribe(t, p.nodes[1])
+	p.checkNodes()
+	select {
+	case err := <-resub.Err():
+		t.Fatalf("subscription on the node in use failed: %v", err)
+	default:
+	}
+	resub.Unsubscribe()
+
+	// Subscriptions are no longer tracked once they fail or are unsubscribed
+	assert.Eventually(t, func() bool {
+		p.mu.RLock()
+		defer p.mu.RUnlock()
+		return len(p.subs) == 0
+	}, time.Second, 10*time.Millisecond)
+}
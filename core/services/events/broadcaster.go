@@ -0,0 +1,260 @@
+package events
+
+import (
+	"encoding/json"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/service"
+	"github.com/smartcontractkit/chainlink/core/services/log"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+	"github.com/smartcontractkit/chainlink/core/services/postgres"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+// subscriptionBufferSize is how many events a subscriber may fall behind by
+// before further events are dropped for it
+const subscriptionBufferSize = 100
+
+// Broadcaster collects the events of the node from the services which
+// produce them and broadcasts them to its subscribers, e.g. the events
+// stream of the web API.
+type Broadcaster interface {
+	service.Service
+	Subscribe(filter Filter) Subscription
+	Publish(event Event)
+}
+
+// Subscription receives the node events matching its filter. Events are
+// dropped if the subscriber falls too far behind.
+type Subscription interface {
+	Events() <-chan Event
+	Close()
+}
+
+type subscription struct {
+	filter      Filter
+	broadcaster *broadcaster
+	chEvents    chan Event
+}
+
+var _ Subscription = (*subscription)(nil)
+
+func (sub *subscription) Events() <-chan Event {
+	return sub.chEvents
+}
+
+func (sub *subscription) Close() {
+	sub.broadcaster.unsubscribe(sub)
+}
+
+type broadcaster struct {
+	eventBroadcaster postgres.EventBroadcaster
+	pipelineRunner   pipeline.Runner
+	logBroadcaster   log.Broadcaster
+
+	mu            sync.RWMutex
+	subscriptions map[*subscription]struct{}
+
+	pgSubscriptions []postgres.Subscription
+	runUpdates      pipeline.RunUpdatesSubscription
+	unsubscribeLogs func()
+	chStop          chan struct{}
+	wgDone          sync.WaitGroup
+	utils.StartStopOnce
+}
+
+var _ Broadcaster = (*broadcaster)(nil)
+
+// NewBroadcaster returns a Broadcaster of the job, spec error and eth_tx
+// notifications of eventBroadcaster, the finished runs of pipelineRunner and
+// the reconnects of logBroadcaster
+func NewBroadcaster(eventBroadcaster postgres.EventBroadcaster, pipelineRunner pipeline.Runner, logBroadcaster log.Broadcaster) *broadcaster {
+	return &broadcaster{
+		eventBroadcaster: eventBroadcaster,
+		pipelineRunner:   pipelineRunner,
+		logBroadcaster:   logBroadcaster,
+		subscriptions:    make(map[*subscription]struct{}),
+		chStop:           make(chan struct{}),
+	}
+}
+
+func (b *broadcaster) Start() error {
+	return b.StartOnce("NodeEventBroadcaster", func() error {
+		relays := map[string]func(postgres.Event) (Event, error){
+			postgres.ChannelJobCreated:           parseJobCreated,
+			postgres.ChannelEthTxConfirmed:       parseTxConfirmed,
+			postgres.ChannelJobSpecErrorRecorded: parseSpecErrorRecorded,
+		}
+		for channel, parse := range relays {
+			sub, err := b.eventBroadcaster.Subscribe(channel, "")
+			if err != nil {
+				b.closeSources()
+				return errors.Wrapf(err, "NodeEventBroadcaster: failed to subscribe to %s", channel)
+			}
+			b.pgSubscriptions = append(b.pgSubscriptions, sub)
+			b.wgDone.Add(1)
+			go b.relayNotifications(sub, parse)
+		}
+
+		b.runUpdates = b.pipelineRunner.SubscribeToRunUpdates(pipeline.RunUpdatesFilter{})
+		b.wgDone.Add(1)
+		go b.relayRunUpdates()
+
+		b.unsubscribeLogs = b.logBroadcaster.OnReconnect(func() {
+			b.Publish(Event{Type: TypeLogBroadcasterReconnected, Time: time.Now()})
+		})
+		return nil
+	})
+}
+
+func (b *broadcaster) Close() error {
+	return b.StopOnce("NodeEventBroadcaster", func() error {
+		close(b.chStop)
+		b.closeSources()
+		b.wgDone.Wait()
+
+		b.mu.Lock()
+		defer b.mu.Unlock()
+		for sub := range b.subscriptions {
+			delete(b.subscriptions, sub)
+			close(sub.chEvents)
+		}
+		return nil
+	})
+}
+
+func (b *broadcaster) closeSources() {
+	for _, sub := range b.pgSubscriptions {
+		sub.Close()
+	}
+	if b.runUpdates != nil {
+		b.runUpdates.Close()
+	}
+	if b.unsubscribeLogs != nil {
+		b.unsubscribeLogs()
+	}
+}
+
+func (b *broadcaster) relayNotifications(sub postgres.Subscription, parse func(postgres.Event) (Event, error)) {
+	defer b.wgDone.Done()
+	for {
+		select {
+		case notification := <-sub.Events():
+			event, err := parse(notification)
+			if err != nil {
+				logger.Errorw("NodeEventBroadcaster: failed to parse notification", "channel", notification.Channel, "payload", notification.Payload, "err", err)
+				continue
+			}
+			b.Publish(event)
+		case <-b.chStop:
+			return
+		}
+	}
+}
+
+func (b *broadcaster) relayRunUpdates() {
+	defer b.wgDone.Done()
+	for {
+		select {
+		case update, ok := <-b.runUpdates.Updates():
+			if !ok {
+				return
+			}
+			if update.TaskRun != nil || !update.State.Finished() {
+				continue
+			}
+			b.Publish(Event{
+				Type:     TypeRunCompleted,
+				Time:     update.FinishedAt.Time,
+				JobID:    update.JobID,
+				RunID:    update.RunID,
+				RunState: update.State,
+			})
+		case <-b.chStop:
+			return
+		}
+	}
+}
+
+func (b *broadcaster) Subscribe(filter Filter) Subscription {
+	sub := &subscription{
+		filter:      filter,
+		broadcaster: b,
+		chEvents:    make(chan Event, subscriptionBufferSize),
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.subscriptions[sub] = struct{}{}
+	return sub
+}
+
+func (b *broadcaster) unsubscribe(sub *subscription) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if _, exists := b.subscriptions[sub]; exists {
+		delete(b.subscriptions, sub)
+		close(sub.chEvents)
+	}
+}
+
+// Publish sends event to the matching subscribers. It never blocks on a slow
+// subscriber.
+func (b *broadcaster) Publish(event Event) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	for sub := range b.subscriptions {
+		if !sub.filter.matches(event) {
+			continue
+		}
+		select {
+		case sub.chEvents <- event:
+		default:
+			logger.Warnw("NodeEventBroadcaster: subscriber is not keeping up, dropping event", "type", event.Type)
+		}
+	}
+}
+
+func parseJobCreated(notification postgres.Event) (Event, error) {
+	jobID, err := strconv.ParseInt(notification.Payload, 10, 32)
+	if err != nil {
+		return Event{}, err
+	}
+	return Event{Type: TypeJobCreated, Time: time.Now(), JobID: int32(jobID)}, nil
+}
+
+func parseTxConfirmed(notification postgres.Event) (Event, error) {
+	var payload struct {
+		ID          int64          `json:"id"`
+		FromAddress common.Address `json:"fromAddress"`
+	}
+	if err := json.Unmarshal([]byte(notification.Payload), &payload); err != nil {
+		return Event{}, err
+	}
+	return Event{Type: TypeTxConfirmed, Time: time.Now(), EthTxID: payload.ID, FromAddress: payload.FromAddress}, nil
+}
+
+func parseSpecErrorRecorded(notification postgres.Event) (Event, error) {
+	var payload struct {
+		ID          int64  `json:"id"`
+		JobID       int32  `json:"jobID"`
+		Severity    string `json:"severity"`
+		Occurrences uint   `json:"occurrences"`
+	}
+	if err := json.Unmarshal([]byte(notification.Payload), &payload); err != nil {
+		return Event{}, err
+	}
+	return Event{
+		Type:        TypeSpecErrorRecorded,
+		Time:        time.Now(),
+		JobID:       payload.JobID,
+		SpecErrorID: payload.ID,
+		Severity:    payload.Severity,
+		Occurrences: payload.Occurrences,
+	}, nil
+}
@@ -0,0 +1,130 @@
+package events_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/core/services/events"
+	logmocks "github.com/smartcontractkit/chainlink/core/services/log/mocks"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+	pipelinemocks "github.com/smartcontractkit/chainlink/core/services/pipeline/mocks"
+	"github.com/smartcontractkit/chainlink/core/services/postgres"
+	pgmocks "github.com/smartcontractkit/chainlink/core/services/postgres/mocks"
+)
+
+type runUpdatesSubscription struct {
+	chUpdates chan pipeline.RunUpdate
+}
+
+func (s *runUpdatesSubscription) Updates() <-chan pipeline.RunUpdate { return s.chUpdates }
+func (s *runUpdatesSubscription) Close()                              {}
+
+func TestBroadcaster_RelaysNodeEvents(t *testing.T) {
+	t.Parallel()
+
+	eventBroadcaster := new(pgmocks.EventBroadcaster)
+	pgSubs := make(map[string]chan postgres.Event)
+	for _, channel := range []string{postgres.ChannelJobCreated, postgres.ChannelEthTxConfirmed, postgres.ChannelJobSpecErrorRecorded} {
+		chEvents := make(chan postgres.Event)
+		pgSubs[channel] = chEvents
+		sub := new(pgmocks.Subscription)
+		sub.On("Events").Return((<-chan postgres.Event)(chEvents))
+		sub.On("Close").Return()
+		eventBroadcaster.On("Subscribe", channel, "").Return(sub, nil).Once()
+	}
+
+	runUpdates := &runUpdatesSubscription{chUpdates: make(chan pipeline.RunUpdate)}
+	runner := new(pipelinemocks.Runner)
+	runner.On("SubscribeToRunUpdates", pipeline.RunUpdatesFilter{}).Return(runUpdates)
+
+	var onReconnect func()
+	logBroadcaster := new(logmocks.Broadcaster)
+	logBroadcaster.On("OnReconnect", mock.Anything).Run(func(args mock.Arguments) {
+		onReconnect = args.Get(0).(func())
+	}).Return(func() {})
+
+	b := events.NewBroadcaster(eventBroadcaster, runner, logBroadcaster)
+	require.NoError(t, b.Start())
+	defer func() { assert.NoError(t, b.Close()) }()
+
+	all := b.Subscribe(events.Filter{})
+	defer all.Close()
+	runs := b.Subscribe(events.Filter{Types: []events.Type{events.TypeRunCompleted}})
+	defer runs.Close()
+
+	next := func(sub events.Subscription) events.Event {
+		select {
+		case event := <-sub.Events():
+			return event
+		case <-time.After(5 * time.Second):
+			t.Fatal("timed out waiting for event")
+			return events.Event{}
+		}
+	}
+
+	pgSubs[postgres.ChannelJobCreated] <- postgres.Event{Channel: postgres.ChannelJobCreated, Payload: "42"}
+	event := next(all)
+	assert.Equal(t, events.TypeJobCreated, event.Type)
+	assert.Equal(t, int32(42), event.JobID)
+
+	from := common.HexToAddress("0x3cCad4715152693fE3BC4460591e3D3Fbd071b42")
+	pgSubs[postgres.ChannelEthTxConfirmed] <- postgres.Event{Channel: postgres.ChannelEthTxConfirmed, Payload: `{"id": 7, "fromAddress": "0x3ccad4715152693fe3bc4460591e3d3fbd071b42"}`}
+	event = next(all)
+	assert.Equal(t, events.TypeTxConfirmed, event.Type)
+	assert.Equal(t, int64(7), event.EthTxID)
+	assert.Equal(t, from, event.FromAddress)
+
+	pgSubs[postgres.ChannelJobSpecErrorRecorded] <- postgres.Event{Channel: postgres.ChannelJobSpecErrorRecorded, Payload: `{"id": 3, "jobID": 42, "severity": "warning", "occurrences": 2}`}
+	event = next(all)
+	assert.Equal(t, events.TypeSpecErrorRecorded, event.Type)
+	assert.Equal(t, int64(3), event.SpecErrorID)
+	assert.Equal(t, int32(42), event.JobID)
+	assert.Equal(t, "warning", event.Severity)
+	assert.Equal(t, uint(2), event.Occurrences)
+
+	// Only the final update of a finished run is published
+	finishedAt := time.Now()
+	runUpdates.chUpdates <- pipeline.RunUpdate{RunID: 1, JobID: 42, State: pipeline.RunStatusRunning, TaskRun: &pipeline.TaskRun{}}
+	runUpdates.chUpdates <- pipeline.RunUpdate{RunID: 1, JobID: 42, State: pipeline.RunStatusCompleted, FinishedAt: null.TimeFrom(finishedAt)}
+	for _, sub := range []events.Subscription{all, runs} {
+		event = next(sub)
+		assert.Equal(t, events.TypeRunCompleted, event.Type)
+		assert.Equal(t, int64(1), event.RunID)
+		assert.Equal(t, int32(42), event.JobID)
+		assert.Equal(t, pipeline.RunStatusCompleted, event.RunState)
+		assert.True(t, finishedAt.Equal(event.Time))
+	}
+
+	require.NotNil(t, onReconnect)
+	onReconnect()
+	event = next(all)
+	assert.Equal(t, events.TypeLogBroadcasterReconnected, event.Type)
+
+	// The filtered subscription only received the run
+	select {
+	case event := <-runs.Events():
+		t.Fatalf("unexpected event %v", event.Type)
+	default:
+	}
+}
+
+func TestParseTypes(t *testing.T) {
+	t.Parallel()
+
+	types, err := events.ParseTypes("run_completed, tx_confirmed,")
+	require.NoError(t, err)
+	assert.Equal(t, []events.Type{events.TypeRunCompleted, events.TypeTxConfirmed}, types)
+
+	types, err = events.ParseTypes("")
+	require.NoError(t, err)
+	assert.Empty(t, types)
+
+	_, err = events.ParseTypes("run_completed,bogus")
+	assert.EqualError(t, err, `unknown event type "bogus"`)
+}
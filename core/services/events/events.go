@@ -0,0 +1,108 @@
+package events
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+)
+
+// Type is the type of a node event
+type Type string
+
+const (
+	// TypeJobCreated is published when a job is created on any node sharing
+	// the database
+	TypeJobCreated = Type("job_created")
+	// TypeRunCompleted is published when a pipeline run run by this node
+	// has finished, successfully or not
+	TypeRunCompleted = Type("run_completed")
+	// TypeTxConfirmed is published when an eth_tx is confirmed
+	TypeTxConfirmed = Type("tx_confirmed")
+	// TypeSpecErrorRecorded is published each time a job spec error or
+	// warning is recorded, including repeated occurrences
+	TypeSpecErrorRecorded = Type("spec_error_recorded")
+	// TypeLogBroadcasterReconnected is published when the log broadcaster
+	// has resubscribed to the eth node after losing its subscription
+	TypeLogBroadcasterReconnected = Type("log_broadcaster_reconnected")
+)
+
+// Types are all the types of node events
+var Types = []Type{
+	TypeJobCreated,
+	TypeRunCompleted,
+	TypeTxConfirmed,
+	TypeSpecErrorRecorded,
+	TypeLogBroadcasterReconnected,
+}
+
+// ParseTypes parses a comma separated list of event types
+func ParseTypes(s string) ([]Type, error) {
+	var types []Type
+	for _, part := range strings.Split(s, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		t := Type(part)
+		if !t.IsValid() {
+			return nil, fmt.Errorf("unknown event type %q", part)
+		}
+		types = append(types, t)
+	}
+	return types, nil
+}
+
+// IsValid returns whether t is a known event type
+func (t Type) IsValid() bool {
+	for _, known := range Types {
+		if t == known {
+			return true
+		}
+	}
+	return false
+}
+
+// Event is a structured event of the node. Only the fields relevant to its
+// type are set.
+type Event struct {
+	Type Type
+	Time time.Time
+
+	// JobID is set for job created, run completed and spec error events
+	JobID int32
+
+	// RunID and RunState are set for run completed events
+	RunID    int64
+	RunState pipeline.RunStatus
+
+	// EthTxID and FromAddress are set for tx confirmed events
+	EthTxID     int64
+	FromAddress common.Address
+
+	// SpecErrorID, Severity and Occurrences are set for spec error events
+	SpecErrorID int64
+	Severity    string
+	Occurrences uint
+}
+
+// Filter selects the events of a subscription. An empty filter matches all
+// events.
+type Filter struct {
+	Types []Type
+}
+
+func (f Filter) matches(event Event) bool {
+	if len(f.Types) == 0 {
+		return true
+	}
+	for _, t := range f.Types {
+		if t == event.Type {
+			return true
+		}
+	}
+	return false
+}
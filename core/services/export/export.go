@@ -0,0 +1,345 @@
+package export
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+	"gopkg.in/guregu/null.v4"
+	"gorm.io/gorm"
+)
+
+// Dataset is a table of node data which can be exported for loading into a
+// data warehouse
+type Dataset string
+
+const (
+	// FluxMonitorRoundStats are the per round submission stats of flux
+	// monitor jobs
+	FluxMonitorRoundStats Dataset = "flux_monitor_round_stats"
+	// PipelineRuns are summaries of job runs, without their task runs
+	PipelineRuns Dataset = "pipeline_runs"
+	// EthTxes are the transactions sent by the node, with the gas price and
+	// block of the attempt which was mined, if any
+	EthTxes Dataset = "eth_txes"
+)
+
+var (
+	// ErrUnknownDataset is returned when exporting a dataset which does not exist
+	ErrUnknownDataset = errors.New("unknown dataset")
+	// ErrUnsupportedFilter is returned when filtering a dataset by a field it
+	// does not have
+	ErrUnsupportedFilter = errors.New("unsupported filter")
+)
+
+// Rows are flushed to the writer in batches so that large exports are
+// streamed instead of buffered in memory
+const flushEvery = 1000
+
+// Filter selects the rows of an export. Zero fields match all rows.
+type Filter struct {
+	// Since and Until bound the creation time of the rows, inclusively
+	Since null.Time
+	Until null.Time
+	JobID int32
+	State string
+	// Address is the sending address of transactions, or the aggregator of
+	// round stats
+	Address *common.Address
+}
+
+// Options are the rows of a dataset to export and how to write them
+type Options struct {
+	Format Format
+	Filter Filter
+	// After is the cursor returned by the previous export. Only rows with a
+	// greater id are exported.
+	After int64
+	// Limit is the maximum number of rows to export, if it is not 0
+	Limit int
+}
+
+type dataset struct {
+	header []string
+	// from selects the columns of the header, the first being id. It is
+	// followed by the conditions of the export.
+	from string
+	// id is the column the rows are ordered and paginated by
+	id string
+	// createdAt, jobID, state and address are the columns filtered on, or
+	// empty if the dataset does not support the filter. jobID is a condition
+	// taking the job id as its parameter.
+	createdAt string
+	jobID     string
+	state     string
+	address   string
+	// scan returns the id and the values of a row, which are nil, int64,
+	// string or time.Time
+	scan func(rows *sql.Rows) (id int64, values []interface{}, err error)
+}
+
+var datasets = map[Dataset]dataset{
+	FluxMonitorRoundStats: {
+		header: []string{"id", "aggregator", "round_id", "num_new_round_logs", "num_submissions", "pipeline_run_id"},
+		from: `
+			SELECT id, aggregator, round_id, num_new_round_logs, num_submissions, pipeline_run_id
+			FROM flux_monitor_round_stats_v2
+		`,
+		id: "flux_monitor_round_stats_v2.id",
+		jobID: `flux_monitor_round_stats_v2.aggregator IN (
+			SELECT flux_monitor_specs.contract_address
+			FROM jobs
+			JOIN flux_monitor_specs ON flux_monitor_specs.id = jobs.flux_monitor_spec_id
+			WHERE jobs.id = ?
+		)`,
+		address: "flux_monitor_round_stats_v2.aggregator",
+		scan: func(rows *sql.Rows) (int64, []interface{}, error) {
+			var (
+				id, roundID, numNewRoundLogs, numSubmissions int64
+				aggregator                                   []byte
+				pipelineRunID                                sql.NullInt64
+			)
+			if err := rows.Scan(&id, &aggregator, &roundID, &numNewRoundLogs, &numSubmissions, &pipelineRunID); err != nil {
+				return 0, nil, err
+			}
+			return id, []interface{}{
+				id,
+				common.BytesToAddress(aggregator).Hex(),
+				roundID,
+				numNewRoundLogs,
+				numSubmissions,
+				nullInt(pipelineRunID),
+			}, nil
+		},
+	},
+	PipelineRuns: {
+		header: []string{"id", "job_id", "pipeline_spec_id", "state", "created_at", "finished_at", "num_errors", "numeric_answer", "bridge_credits"},
+		from: `
+			SELECT pipeline_runs.id, jobs.id, pipeline_runs.pipeline_spec_id, pipeline_runs.state, pipeline_runs.created_at, pipeline_runs.finished_at,
+				CASE WHEN jsonb_typeof(pipeline_runs.errors) = 'array'
+					THEN (SELECT count(*) FROM jsonb_array_elements(pipeline_runs.errors) AS e WHERE e <> 'null'::jsonb)
+					ELSE 0
+				END,
+				pipeline_runs.numeric_answer, pipeline_runs.bridge_credits
+			FROM pipeline_runs
+			LEFT JOIN jobs ON jobs.pipeline_spec_id = pipeline_runs.pipeline_spec_id
+		`,
+		id:        "pipeline_runs.id",
+		createdAt: "pipeline_runs.created_at",
+		jobID:     "jobs.id = ?",
+		state:     "pipeline_runs.state",
+		scan: func(rows *sql.Rows) (int64, []interface{}, error) {
+			var (
+				id, pipelineSpecID, numErrors int64
+				jobID                         sql.NullInt64
+				state                         string
+				createdAt                     time.Time
+				finishedAt                    sql.NullTime
+				numericAnswer, bridgeCredits  sql.NullString
+			)
+			if err := rows.Scan(&id, &jobID, &pipelineSpecID, &state, &createdAt, &finishedAt, &numErrors, &numericAnswer, &bridgeCredits); err != nil {
+				return 0, nil, err
+			}
+			return id, []interface{}{
+				id,
+				nullInt(jobID),
+				pipelineSpecID,
+				state,
+				createdAt.UTC(),
+				nullTime(finishedAt),
+				numErrors,
+				nullString(numericAnswer),
+				nullString(bridgeCredits),
+			}, nil
+		},
+	},
+	EthTxes: {
+		header: []string{"id", "evm_chain_id", "nonce", "from_address", "to_address", "value", "gas_limit", "state", "error", "created_at", "broadcast_at", "gas_price", "block_number"},
+		from: `
+			SELECT eth_txes.id, eth_txes.evm_chain_id, eth_txes.nonce, eth_txes.from_address, eth_txes.to_address, eth_txes.value, eth_txes.gas_limit,
+				eth_txes.state, eth_txes.error, eth_txes.created_at, eth_txes.broadcast_at, mined.gas_price, mined.block_number
+			FROM eth_txes
+			LEFT JOIN LATERAL (
+				SELECT eth_tx_attempts.gas_price, eth_receipts.block_number
+				FROM eth_tx_attempts
+				JOIN eth_receipts ON eth_receipts.tx_hash = eth_tx_attempts.hash
+				WHERE eth_tx_attempts.eth_tx_id = eth_txes.id
+				ORDER BY eth_receipts.block_number DESC
+				LIMIT 1
+			) mined ON true
+		`,
+		id:        "eth_txes.id",
+		createdAt: "eth_txes.created_at",
+		state:     "eth_txes.state",
+		address:   "eth_txes.from_address",
+		scan: func(rows *sql.Rows) (int64, []interface{}, error) {
+			var (
+				id, gasLimit                int64
+				evmChainID, value, gasPrice sql.NullString
+				nonce, blockNumber          sql.NullInt64
+				fromAddress, toAddress      []byte
+				state                       string
+				txError                     sql.NullString
+				createdAt                   time.Time
+				broadcastAt                 sql.NullTime
+			)
+			if err := rows.Scan(&id, &evmChainID, &nonce, &fromAddress, &toAddress, &value, &gasLimit, &state, &txError, &createdAt, &broadcastAt, &gasPrice, &blockNumber); err != nil {
+				return 0, nil, err
+			}
+			return id, []interface{}{
+				id,
+				nullString(evmChainID),
+				nullInt(nonce),
+				common.BytesToAddress(fromAddress).Hex(),
+				common.BytesToAddress(toAddress).Hex(),
+				nullString(value),
+				gasLimit,
+				state,
+				nullString(txError),
+				createdAt.UTC(),
+				nullTime(broadcastAt),
+				nullString(gasPrice),
+				nullInt(blockNumber),
+			}, nil
+		},
+	},
+}
+
+// ParseDataset returns the dataset with the given name
+func ParseDataset(name string) (Dataset, error) {
+	if _, exists := datasets[Dataset(name)]; !exists {
+		return "", errors.Wrapf(ErrUnknownDataset, "%q", name)
+	}
+	return Dataset(name), nil
+}
+
+// CheckFilter returns ErrUnsupportedFilter if the dataset cannot be filtered
+// by all the fields of filter
+func CheckFilter(name Dataset, filter Filter) error {
+	ds, exists := datasets[name]
+	if !exists {
+		return errors.Wrapf(ErrUnknownDataset, "%q", name)
+	}
+	_, _, err := ds.query(name, Options{Filter: filter})
+	return err
+}
+
+// query returns the query of the rows of the dataset selected by opts
+func (ds dataset) query(name Dataset, opts Options) (string, []interface{}, error) {
+	conds := []string{ds.id + " > ?"}
+	args := []interface{}{opts.After}
+	filter := func(column, cond string, arg interface{}, field string) error {
+		if column == "" {
+			return errors.Wrapf(ErrUnsupportedFilter, "%s cannot be filtered by %s", name, field)
+		}
+		conds = append(conds, fmt.Sprintf(cond, column))
+		args = append(args, arg)
+		return nil
+	}
+
+	f := opts.Filter
+	if f.Since.Valid {
+		if err := filter(ds.createdAt, "%s >= ?", f.Since.Time, "since"); err != nil {
+			return "", nil, err
+		}
+	}
+	if f.Until.Valid {
+		if err := filter(ds.createdAt, "%s <= ?", f.Until.Time, "until"); err != nil {
+			return "", nil, err
+		}
+	}
+	if f.JobID != 0 {
+		if err := filter(ds.jobID, "%s", f.JobID, "job"); err != nil {
+			return "", nil, err
+		}
+	}
+	if f.State != "" {
+		if err := filter(ds.state, "%s = ?", f.State, "state"); err != nil {
+			return "", nil, err
+		}
+	}
+	if f.Address != nil {
+		if err := filter(ds.address, "%s = ?", f.Address.Bytes(), "address"); err != nil {
+			return "", nil, err
+		}
+	}
+
+	stmt := fmt.Sprintf("%s WHERE %s ORDER BY %s LIMIT NULLIF(?, 0)", ds.from, strings.Join(conds, " AND "), ds.id)
+	return stmt, append(args, opts.Limit), nil
+}
+
+// Write streams the rows of the dataset selected by opts to w in the format
+// of opts. It returns the id of the last row written, to be passed as After
+// to fetch the next batch, or After itself if there were no new rows.
+func Write(ctx context.Context, db *gorm.DB, name Dataset, opts Options, w io.Writer) (cursor int64, err error) {
+	ds, exists := datasets[name]
+	if !exists {
+		return opts.After, errors.Wrapf(ErrUnknownDataset, "%q", name)
+	}
+	rw, err := newRecordWriter(opts.Format, w)
+	if err != nil {
+		return opts.After, err
+	}
+	query, args, err := ds.query(name, opts)
+	if err != nil {
+		return opts.After, err
+	}
+
+	rows, err := db.WithContext(ctx).Raw(query, args...).Rows()
+	if err != nil {
+		return opts.After, errors.Wrapf(err, "failed to query %s", name)
+	}
+	defer rows.Close()
+
+	if err = rw.writeHeader(ds.header); err != nil {
+		return opts.After, err
+	}
+	cursor = opts.After
+	var n int
+	for rows.Next() {
+		id, values, err := ds.scan(rows)
+		if err != nil {
+			return cursor, errors.Wrapf(err, "failed to scan %s", name)
+		}
+		if err = rw.write(values); err != nil {
+			return cursor, err
+		}
+		cursor = id
+		n++
+		if n%flushEvery == 0 {
+			if err = rw.flush(); err != nil {
+				return cursor, err
+			}
+		}
+	}
+	if err = rows.Err(); err != nil {
+		return cursor, errors.Wrapf(err, "failed to read %s", name)
+	}
+	return cursor, rw.flush()
+}
+
+func nullInt(n sql.NullInt64) interface{} {
+	if !n.Valid {
+		return nil
+	}
+	return n.Int64
+}
+
+func nullString(s sql.NullString) interface{} {
+	if !s.Valid {
+		return nil
+	}
+	return s.String
+}
+
+func nullTime(t sql.NullTime) interface{} {
+	if !t.Valid {
+		return nil
+	}
+	return t.Time.UTC()
+}
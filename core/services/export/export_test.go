@@ -0,0 +1,168 @@
+package export_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/core/services/export"
+	"github.com/smartcontractkit/chainlink/core/services/fluxmonitorv2"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+)
+
+func readCSV(t *testing.T, buf *bytes.Buffer) [][]string {
+	t.Helper()
+	records, err := csv.NewReader(buf).ReadAll()
+	require.NoError(t, err)
+	return records
+}
+
+func TestWriteCSV_FluxMonitorRoundStats(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewGormDB(t)
+	orm := fluxmonitorv2.NewORM(db, nil, nil)
+
+	address := cltest.NewAddress()
+	var stats []fluxmonitorv2.FluxMonitorRoundStatsV2
+	for round := uint32(1); round <= 3; round++ {
+		s, err := orm.FindOrCreateFluxMonitorRoundStats(address, round)
+		require.NoError(t, err)
+		stats = append(stats, s)
+	}
+
+	var buf bytes.Buffer
+	cursor, err := export.Write(context.Background(), db, export.FluxMonitorRoundStats, export.Options{Limit: 2}, &buf)
+	require.NoError(t, err)
+	assert.Equal(t, int64(stats[1].ID), cursor)
+
+	records := readCSV(t, &buf)
+	require.Len(t, records, 3)
+	assert.Equal(t, []string{"id", "aggregator", "round_id", "num_new_round_logs", "num_submissions", "pipeline_run_id"}, records[0])
+	assert.Equal(t, []string{strconv.FormatUint(stats[0].ID, 10), address.Hex(), "1", "0", "0", ""}, records[1])
+
+	// Continues from the cursor
+	buf.Reset()
+	cursor, err = export.Write(context.Background(), db, export.FluxMonitorRoundStats, export.Options{After: cursor}, &buf)
+	require.NoError(t, err)
+	assert.Equal(t, int64(stats[2].ID), cursor)
+
+	records = readCSV(t, &buf)
+	require.Len(t, records, 2)
+	assert.Equal(t, "3", records[1][2])
+
+	// Nothing new to export
+	buf.Reset()
+	cursor, err = export.Write(context.Background(), db, export.FluxMonitorRoundStats, export.Options{After: cursor}, &buf)
+	require.NoError(t, err)
+	assert.Equal(t, int64(stats[2].ID), cursor)
+	assert.Len(t, readCSV(t, &buf), 1)
+}
+
+func TestWriteCSV_PipelineRuns(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewGormDB(t)
+	jb := cltest.MustInsertSampleDirectRequestJob(t, db)
+
+	run := pipeline.Run{
+		PipelineSpecID: jb.PipelineSpecID,
+		State:          pipeline.RunStatusErrored,
+		Outputs:        pipeline.JSONSerializable{Null: true},
+		Errors:         pipeline.RunErrors{null.String{}, null.StringFrom("oops")},
+	}
+	require.NoError(t, db.Create(&run).Error)
+
+	var buf bytes.Buffer
+	cursor, err := export.Write(context.Background(), db, export.PipelineRuns, export.Options{}, &buf)
+	require.NoError(t, err)
+	assert.Equal(t, run.ID, cursor)
+
+	records := readCSV(t, &buf)
+	require.Len(t, records, 2)
+	assert.Equal(t, strconv.FormatInt(run.ID, 10), records[1][0])
+	assert.Equal(t, strconv.FormatInt(int64(jb.ID), 10), records[1][1])
+	assert.Equal(t, string(pipeline.RunStatusErrored), records[1][3])
+	assert.Equal(t, "1", records[1][6])
+}
+
+func TestWrite_EthTxes(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewGormDB(t)
+	key := cltest.MustInsertRandomKey(t, db, 0)
+	otherKey := cltest.MustInsertRandomKey(t, db, 0)
+
+	mined := cltest.MustInsertConfirmedEthTxWithReceipt(t, db, key.Address.Address(), 0, 42)
+	unconfirmed := cltest.MustInsertUnconfirmedEthTx(t, db, 1, key.Address.Address())
+	cltest.MustInsertUnconfirmedEthTx(t, db, 0, otherKey.Address.Address())
+
+	var buf bytes.Buffer
+	opts := export.Options{
+		Format: export.FormatNDJSON,
+		Filter: export.Filter{Address: &mined.FromAddress},
+	}
+	cursor, err := export.Write(context.Background(), db, export.EthTxes, opts, &buf)
+	require.NoError(t, err)
+	assert.Equal(t, unconfirmed.ID, cursor)
+
+	var records []map[string]interface{}
+	decoder := json.NewDecoder(&buf)
+	for decoder.More() {
+		var record map[string]interface{}
+		require.NoError(t, decoder.Decode(&record))
+		records = append(records, record)
+	}
+	require.Len(t, records, 2)
+	assert.Equal(t, float64(mined.ID), records[0]["id"])
+	assert.Equal(t, mined.FromAddress.Hex(), records[0]["from_address"])
+	assert.Equal(t, "confirmed", records[0]["state"])
+	assert.Equal(t, mined.EthTxAttempts[0].GasPrice.String(), records[0]["gas_price"])
+	assert.Equal(t, float64(42), records[0]["block_number"])
+	assert.Equal(t, float64(unconfirmed.ID), records[1]["id"])
+	assert.Nil(t, records[1]["block_number"])
+
+	buf.Reset()
+	opts.Filter.State = "unconfirmed"
+	_, err = export.Write(context.Background(), db, export.EthTxes, opts, &buf)
+	require.NoError(t, err)
+	assert.Equal(t, 1, strings.Count(buf.String(), "\n"))
+
+	opts.Filter.JobID = 1
+	_, err = export.Write(context.Background(), db, export.EthTxes, opts, &buf)
+	assert.Equal(t, export.ErrUnsupportedFilter, errors.Cause(err))
+}
+
+func TestParseFormat(t *testing.T) {
+	t.Parallel()
+
+	format, err := export.ParseFormat("ndjson")
+	require.NoError(t, err)
+	assert.Equal(t, export.FormatNDJSON, format)
+	assert.Equal(t, "application/x-ndjson", format.ContentType())
+
+	_, err = export.ParseFormat("parquet")
+	assert.Equal(t, export.ErrUnknownFormat, errors.Cause(err))
+}
+
+func TestParseDataset(t *testing.T) {
+	t.Parallel()
+
+	dataset, err := export.ParseDataset("pipeline_runs")
+	require.NoError(t, err)
+	assert.Equal(t, export.PipelineRuns, dataset)
+
+	_, err = export.ParseDataset("users")
+	assert.Equal(t, export.ErrUnknownDataset, errors.Cause(err))
+}
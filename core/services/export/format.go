@@ -0,0 +1,158 @@
+package export
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// Format is the file format of an export
+type Format string
+
+const (
+	// FormatCSV writes a header line followed by a line per row
+	FormatCSV Format = "csv"
+	// FormatNDJSON writes a JSON object per row, keyed by column, one per
+	// line
+	FormatNDJSON Format = "ndjson"
+)
+
+// ErrUnknownFormat is returned when exporting in a format which is not supported
+var ErrUnknownFormat = errors.New("unknown format")
+
+// ParseFormat returns the format with the given name
+func ParseFormat(name string) (Format, error) {
+	switch f := Format(name); f {
+	case FormatCSV, FormatNDJSON:
+		return f, nil
+	default:
+		return "", errors.Wrapf(ErrUnknownFormat, "%q, only %s and %s are supported", name, FormatCSV, FormatNDJSON)
+	}
+}
+
+// ContentType is the MIME type of files of the format
+func (f Format) ContentType() string {
+	if f == FormatNDJSON {
+		return "application/x-ndjson"
+	}
+	return "text/csv"
+}
+
+type recordWriter interface {
+	writeHeader(header []string) error
+	write(values []interface{}) error
+	flush() error
+}
+
+func newRecordWriter(format Format, w io.Writer) (recordWriter, error) {
+	switch format {
+	case FormatCSV, "":
+		return &csvWriter{cw: csv.NewWriter(w), w: w}, nil
+	case FormatNDJSON:
+		return &ndjsonWriter{bw: bufio.NewWriter(w), w: w}, nil
+	default:
+		return nil, errors.Wrapf(ErrUnknownFormat, "%q", format)
+	}
+}
+
+type csvWriter struct {
+	cw     *csv.Writer
+	w      io.Writer
+	record []string
+}
+
+func (cw *csvWriter) writeHeader(header []string) error {
+	cw.record = make([]string, len(header))
+	return cw.cw.Write(header)
+}
+
+func (cw *csvWriter) write(values []interface{}) error {
+	for i, v := range values {
+		cw.record[i] = formatCSV(v)
+	}
+	return cw.cw.Write(cw.record)
+}
+
+func (cw *csvWriter) flush() error {
+	cw.cw.Flush()
+	if err := cw.cw.Error(); err != nil {
+		return err
+	}
+	flushHTTP(cw.w)
+	return nil
+}
+
+func formatCSV(v interface{}) string {
+	switch v := v.(type) {
+	case nil:
+		return ""
+	case int64:
+		return strconv.FormatInt(v, 10)
+	case string:
+		return v
+	case time.Time:
+		return v.Format(time.RFC3339Nano)
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+type ndjsonWriter struct {
+	bw *bufio.Writer
+	w  io.Writer
+	// keys are the JSON encoded columns of the header
+	keys [][]byte
+}
+
+func (nw *ndjsonWriter) writeHeader(header []string) error {
+	nw.keys = make([][]byte, len(header))
+	for i, column := range header {
+		key, err := json.Marshal(column)
+		if err != nil {
+			return err
+		}
+		nw.keys[i] = key
+	}
+	return nil
+}
+
+// write writes the values as an object with the keys in the order of the
+// header, which encoding a map would not preserve
+func (nw *ndjsonWriter) write(values []interface{}) error {
+	nw.bw.WriteByte('{')
+	for i, v := range values {
+		if i > 0 {
+			nw.bw.WriteByte(',')
+		}
+		value, err := json.Marshal(v)
+		if err != nil {
+			return err
+		}
+		nw.bw.Write(nw.keys[i])
+		nw.bw.WriteByte(':')
+		nw.bw.Write(value)
+	}
+	_, err := nw.bw.WriteString("}\n")
+	return err
+}
+
+func (nw *ndjsonWriter) flush() error {
+	if err := nw.bw.Flush(); err != nil {
+		return err
+	}
+	flushHTTP(nw.w)
+	return nil
+}
+
+func flushHTTP(w io.Writer) {
+	if f, ok := w.(http.Flusher); ok {
+		f.Flush()
+	}
+}
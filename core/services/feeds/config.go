@@ -14,6 +14,7 @@ type Config interface {
 	Dev() bool
 	FeatureOffchainReporting() bool
 	DefaultHTTPTimeout() models.Duration
+	FeedsJobProposalRetention() time.Duration
 	OCRBlockchainTimeout(override time.Duration) time.Duration
 	OCRContractConfirmations(override uint16) uint16
 	OCRContractPollInterval(override time.Duration) time.Duration
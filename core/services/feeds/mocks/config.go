@@ -75,6 +75,20 @@ func (_m *Config) FeatureOffchainReporting() bool {
 	return r0
 }
 
+// FeedsJobProposalRetention provides a mock function with given fields:
+func (_m *Config) FeedsJobProposalRetention() time.Duration {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
 // OCRBlockchainTimeout provides a mock function with given fields: override
 func (_m *Config) OCRBlockchainTimeout(override time.Duration) time.Duration {
 	ret := _m.Called(override)
@@ -8,6 +8,8 @@ import (
 	feeds "github.com/smartcontractkit/chainlink/core/services/feeds"
 	mock "github.com/stretchr/testify/mock"
 
+	time "time"
+
 	uuid "github.com/satori/go.uuid"
 )
 
@@ -30,6 +32,20 @@ func (_m *ORM) ApproveJobProposal(ctx context.Context, id int64, externalJobID u
 	return r0
 }
 
+// ArchiveJobProposal provides a mock function with given fields: ctx, id
+func (_m *ORM) ArchiveJobProposal(ctx context.Context, id int64) error {
+	ret := _m.Called(ctx, id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // CountJobProposals provides a mock function with given fields:
 func (_m *ORM) CountJobProposals() (int64, error) {
 	ret := _m.Called()
@@ -114,6 +130,43 @@ func (_m *ORM) CreateManager(ctx context.Context, ms *feeds.FeedsManager) (int64
 	return r0, r1
 }
 
+// DeleteChainConfig provides a mock function with given fields: ctx, id
+func (_m *ORM) DeleteChainConfig(ctx context.Context, id int64) error {
+	ret := _m.Called(ctx, id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetChainConfig provides a mock function with given fields: ctx, id
+func (_m *ORM) GetChainConfig(ctx context.Context, id int64) (*feeds.ChainConfig, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 *feeds.ChainConfig
+	if rf, ok := ret.Get(0).(func(context.Context, int64) *feeds.ChainConfig); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*feeds.ChainConfig)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetJobProposal provides a mock function with given fields: ctx, id
 func (_m *ORM) GetJobProposal(ctx context.Context, id int64) (*feeds.JobProposal, error) {
 	ret := _m.Called(ctx, id)
@@ -160,6 +213,52 @@ func (_m *ORM) GetManager(ctx context.Context, id int64) (*feeds.FeedsManager, e
 	return r0, r1
 }
 
+// ListArchivedJobProposals provides a mock function with given fields: ctx
+func (_m *ORM) ListArchivedJobProposals(ctx context.Context) ([]feeds.JobProposal, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []feeds.JobProposal
+	if rf, ok := ret.Get(0).(func(context.Context) []feeds.JobProposal); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]feeds.JobProposal)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListChainConfigsByManagerID provides a mock function with given fields: ctx, mgrID
+func (_m *ORM) ListChainConfigsByManagerID(ctx context.Context, mgrID int64) ([]feeds.ChainConfig, error) {
+	ret := _m.Called(ctx, mgrID)
+
+	var r0 []feeds.ChainConfig
+	if rf, ok := ret.Get(0).(func(context.Context, int64) []feeds.ChainConfig); ok {
+		r0 = rf(ctx, mgrID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]feeds.ChainConfig)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, mgrID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ListJobProposals provides a mock function with given fields: ctx
 func (_m *ORM) ListJobProposals(ctx context.Context) ([]feeds.JobProposal, error) {
 	ret := _m.Called(ctx)
@@ -206,6 +305,27 @@ func (_m *ORM) ListManagers(ctx context.Context) ([]feeds.FeedsManager, error) {
 	return r0, r1
 }
 
+// PurgeArchivedJobProposals provides a mock function with given fields: ctx, threshold
+func (_m *ORM) PurgeArchivedJobProposals(ctx context.Context, threshold time.Duration) (int64, error) {
+	ret := _m.Called(ctx, threshold)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(context.Context, time.Duration) int64); ok {
+		r0 = rf(ctx, threshold)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, time.Duration) error); ok {
+		r1 = rf(ctx, threshold)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // UpdateJobProposalSpec provides a mock function with given fields: ctx, id, spec
 func (_m *ORM) UpdateJobProposalSpec(ctx context.Context, id int64, spec string) error {
 	ret := _m.Called(ctx, id, spec)
@@ -233,3 +353,24 @@ func (_m *ORM) UpdateJobProposalStatus(ctx context.Context, id int64, status fee
 
 	return r0
 }
+
+// UpsertChainConfig provides a mock function with given fields: ctx, cfg
+func (_m *ORM) UpsertChainConfig(ctx context.Context, cfg *feeds.ChainConfig) (int64, error) {
+	ret := _m.Called(ctx, cfg)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(context.Context, *feeds.ChainConfig) int64); ok {
+		r0 = rf(ctx, cfg)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *feeds.ChainConfig) error); ok {
+		r1 = rf(ctx, cfg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
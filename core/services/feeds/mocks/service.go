@@ -30,6 +30,29 @@ func (_m *Service) ApproveJobProposal(ctx context.Context, id int64) error {
 	return r0
 }
 
+// ApproveJobProposals provides a mock function with given fields: ctx, ids
+func (_m *Service) ApproveJobProposals(ctx context.Context, ids []int64) ([]feeds.JobProposalApprovalResult, error) {
+	ret := _m.Called(ctx, ids)
+
+	var r0 []feeds.JobProposalApprovalResult
+	if rf, ok := ret.Get(0).(func(context.Context, []int64) []feeds.JobProposalApprovalResult); ok {
+		r0 = rf(ctx, ids)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]feeds.JobProposalApprovalResult)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, []int64) error); ok {
+		r1 = rf(ctx, ids)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Close provides a mock function with given fields:
 func (_m *Service) Close() error {
 	ret := _m.Called()
@@ -44,6 +67,20 @@ func (_m *Service) Close() error {
 	return r0
 }
 
+// ArchiveJobProposal provides a mock function with given fields: ctx, id
+func (_m *Service) ArchiveJobProposal(ctx context.Context, id int64) error {
+	ret := _m.Called(ctx, id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // CountManagers provides a mock function with given fields:
 func (_m *Service) CountManagers() (int64, error) {
 	ret := _m.Called()
@@ -65,6 +102,27 @@ func (_m *Service) CountManagers() (int64, error) {
 	return r0, r1
 }
 
+// CreateChainConfig provides a mock function with given fields: cfg
+func (_m *Service) CreateChainConfig(cfg feeds.ChainConfig) (int64, error) {
+	ret := _m.Called(cfg)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(feeds.ChainConfig) int64); ok {
+		r0 = rf(cfg)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(feeds.ChainConfig) error); ok {
+		r1 = rf(cfg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // CreateJobProposal provides a mock function with given fields: jp
 func (_m *Service) CreateJobProposal(jp *feeds.JobProposal) (int64, error) {
 	ret := _m.Called(jp)
@@ -86,6 +144,43 @@ func (_m *Service) CreateJobProposal(jp *feeds.JobProposal) (int64, error) {
 	return r0, r1
 }
 
+// DeleteChainConfig provides a mock function with given fields: id
+func (_m *Service) DeleteChainConfig(id int64) error {
+	ret := _m.Called(id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int64) error); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetChainConfig provides a mock function with given fields: id
+func (_m *Service) GetChainConfig(id int64) (*feeds.ChainConfig, error) {
+	ret := _m.Called(id)
+
+	var r0 *feeds.ChainConfig
+	if rf, ok := ret.Get(0).(func(int64) *feeds.ChainConfig); ok {
+		r0 = rf(id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*feeds.ChainConfig)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(int64) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetJobProposal provides a mock function with given fields: id
 func (_m *Service) GetJobProposal(id int64) (*feeds.JobProposal, error) {
 	ret := _m.Called(id)
@@ -132,6 +227,29 @@ func (_m *Service) GetManager(id int64) (*feeds.FeedsManager, error) {
 	return r0, r1
 }
 
+// ListChainConfigsByManagerID provides a mock function with given fields: mgrID
+func (_m *Service) ListChainConfigsByManagerID(mgrID int64) ([]feeds.ChainConfig, error) {
+	ret := _m.Called(mgrID)
+
+	var r0 []feeds.ChainConfig
+	if rf, ok := ret.Get(0).(func(int64) []feeds.ChainConfig); ok {
+		r0 = rf(mgrID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]feeds.ChainConfig)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(int64) error); ok {
+		r1 = rf(mgrID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ListJobProposals provides a mock function with given fields:
 func (_m *Service) ListJobProposals() ([]feeds.JobProposal, error) {
 	ret := _m.Called()
@@ -3,16 +3,20 @@ package feeds
 import (
 	"time"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/lib/pq"
 	uuid "github.com/satori/go.uuid"
+	"github.com/smartcontractkit/chainlink/core/store/models"
 	"github.com/smartcontractkit/chainlink/core/utils/crypto"
 	"gopkg.in/guregu/null.v4"
 )
 
-// We only support OCR and FM for the feeds manager
+// The job types which the feeds manager can propose
 const (
 	JobTypeFluxMonitor       = "fluxmonitor"
+	JobTypeKeeper            = "keeper"
 	JobTypeOffchainReporting = "offchainreporting"
+	JobTypeWebhook           = "webhook"
 )
 
 type FeedsManager struct {
@@ -30,6 +34,10 @@ type FeedsManager struct {
 	// for bootstrap peer discovery.
 	OCRBootstrapPeerMultiaddr null.String
 
+	// The CSA key used to connect to the feeds manager. The oldest CSA key is
+	// used if it is not set.
+	CSAKeyID null.Int
+
 	CreatedAt time.Time
 	UpdatedAt time.Time
 }
@@ -38,6 +46,25 @@ func (FeedsManager) TableName() string {
 	return "feeds_managers"
 }
 
+// ChainConfig defines the configuration the node supports for a chain when
+// running jobs proposed by a feeds manager. These are sent to the feeds
+// manager so that job proposals can be targeted at a specific chain.
+type ChainConfig struct {
+	ID             int64
+	FeedsManagerID int64
+	ChainID        int64
+	// AccountAddress is the address used to transmit on the chain.
+	AccountAddress common.Address
+	// OCRKeyBundleID is the OCR key bundle used for jobs on the chain.
+	OCRKeyBundleID *models.Sha256Hash
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+func (ChainConfig) TableName() string {
+	return "feeds_manager_chain_configs"
+}
+
 // JobProposalStatus are the status codes that define the stage of a proposal
 type JobProposalStatus string
 
@@ -45,6 +72,9 @@ const (
 	JobProposalStatusPending  JobProposalStatus = "pending"
 	JobProposalStatusApproved JobProposalStatus = "approved"
 	JobProposalStatusRejected JobProposalStatus = "rejected"
+	// JobProposalStatusCancelled is the status of a pending proposal which was
+	// withdrawn before it was approved or rejected
+	JobProposalStatusCancelled JobProposalStatus = "cancelled"
 )
 
 type JobProposal struct {
@@ -56,6 +86,12 @@ type JobProposal struct {
 	// ExternalJobID is the external job id in the spec.
 	ExternalJobID  uuid.NullUUID
 	FeedsManagerID int64
-	CreatedAt      time.Time
-	UpdatedAt      time.Time
+	// ChainID is the chain the job proposal targets. This is null for
+	// proposals from feeds managers which have no chain configs.
+	ChainID null.Int
+	// DeletedAt is set when the proposal has been archived. Archived
+	// proposals are excluded from listings and eventually purged.
+	DeletedAt null.Time
+	CreatedAt time.Time
+	UpdatedAt time.Time
 }
@@ -14,16 +14,23 @@ import (
 
 type ORM interface {
 	ApproveJobProposal(ctx context.Context, id int64, externalJobID uuid.UUID, status JobProposalStatus) error
+	ArchiveJobProposal(ctx context.Context, id int64) error
 	CountJobProposals() (int64, error)
 	CountManagers() (int64, error)
 	CreateJobProposal(ctx context.Context, jp *JobProposal) (int64, error)
 	CreateManager(ctx context.Context, ms *FeedsManager) (int64, error)
+	DeleteChainConfig(ctx context.Context, id int64) error
+	GetChainConfig(ctx context.Context, id int64) (*ChainConfig, error)
 	GetJobProposal(ctx context.Context, id int64) (*JobProposal, error)
 	GetManager(ctx context.Context, id int64) (*FeedsManager, error)
+	ListArchivedJobProposals(ctx context.Context) ([]JobProposal, error)
+	ListChainConfigsByManagerID(ctx context.Context, mgrID int64) ([]ChainConfig, error)
 	ListJobProposals(ctx context.Context) ([]JobProposal, error)
 	ListManagers(ctx context.Context) ([]FeedsManager, error)
+	PurgeArchivedJobProposals(ctx context.Context, threshold time.Duration) (int64, error)
 	UpdateJobProposalSpec(ctx context.Context, id int64, spec string) error
 	UpdateJobProposalStatus(ctx context.Context, id int64, status JobProposalStatus) error
+	UpsertChainConfig(ctx context.Context, cfg *ChainConfig) (int64, error)
 }
 
 type orm struct {
@@ -42,8 +49,8 @@ func (o *orm) CreateManager(ctx context.Context, ms *FeedsManager) (int64, error
 	now := time.Now()
 
 	stmt := `
-		INSERT INTO feeds_managers (name, uri, public_key, job_types, is_ocr_bootstrap_peer, ocr_bootstrap_peer_multiaddr, created_at, updated_at)
-		VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+		INSERT INTO feeds_managers (name, uri, public_key, job_types, is_ocr_bootstrap_peer, ocr_bootstrap_peer_multiaddr, csa_key_id, created_at, updated_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)
 		RETURNING id;
 	`
 
@@ -54,6 +61,7 @@ func (o *orm) CreateManager(ctx context.Context, ms *FeedsManager) (int64, error
 		ms.JobTypes,
 		ms.IsOCRBootstrapPeer,
 		ms.OCRBootstrapPeerMultiaddr,
+		ms.CSAKeyID,
 		now,
 		now,
 	).Row()
@@ -73,7 +81,7 @@ func (o *orm) CreateManager(ctx context.Context, ms *FeedsManager) (int64, error
 func (o *orm) ListManagers(ctx context.Context) ([]FeedsManager, error) {
 	mgrs := []FeedsManager{}
 	stmt := `
-		SELECT id, name, uri, public_key, job_types, is_ocr_bootstrap_peer, ocr_bootstrap_peer_multiaddr, created_at, updated_at
+		SELECT id, name, uri, public_key, job_types, is_ocr_bootstrap_peer, ocr_bootstrap_peer_multiaddr, csa_key_id, created_at, updated_at
 		FROM feeds_managers;
 	`
 
@@ -88,7 +96,7 @@ func (o *orm) ListManagers(ctx context.Context) ([]FeedsManager, error) {
 // GetManager gets a feeds manager by id
 func (o *orm) GetManager(ctx context.Context, id int64) (*FeedsManager, error) {
 	stmt := `
-		SELECT id, name, uri, public_key, job_types, is_ocr_bootstrap_peer, ocr_bootstrap_peer_multiaddr, created_at, updated_at
+		SELECT id, name, uri, public_key, job_types, is_ocr_bootstrap_peer, ocr_bootstrap_peer_multiaddr, csa_key_id, created_at, updated_at
 		FROM feeds_managers
 		WHERE id = ?;
 	`
@@ -121,18 +129,114 @@ func (o *orm) CountManagers() (int64, error) {
 	return count, nil
 }
 
+// UpsertChainConfig creates a chain config for a feeds manager, or updates the
+// existing config if the feeds manager already has one for the chain.
+func (o *orm) UpsertChainConfig(ctx context.Context, cfg *ChainConfig) (int64, error) {
+	tx := postgres.TxFromContext(ctx, o.db)
+
+	var id int64
+	now := time.Now()
+
+	stmt := `
+		INSERT INTO feeds_manager_chain_configs (feeds_manager_id, chain_id, account_address, ocr_key_bundle_id, created_at, updated_at)
+		VALUES (?, ?, ?, ?, ?, ?)
+		ON CONFLICT (feeds_manager_id, chain_id) DO UPDATE SET
+			account_address = EXCLUDED.account_address,
+			ocr_key_bundle_id = EXCLUDED.ocr_key_bundle_id,
+			updated_at = EXCLUDED.updated_at
+		RETURNING id;
+	`
+
+	row := tx.Raw(stmt,
+		cfg.FeedsManagerID,
+		cfg.ChainID,
+		cfg.AccountAddress,
+		cfg.OCRKeyBundleID,
+		now,
+		now,
+	).Row()
+	if row.Err() != nil {
+		return id, row.Err()
+	}
+
+	err := row.Scan(&id)
+	if err != nil {
+		return id, err
+	}
+
+	return id, err
+}
+
+// ListChainConfigsByManagerID lists the chain configs of a feeds manager
+func (o *orm) ListChainConfigsByManagerID(ctx context.Context, mgrID int64) ([]ChainConfig, error) {
+	cfgs := []ChainConfig{}
+	stmt := `
+		SELECT id, feeds_manager_id, chain_id, account_address, ocr_key_bundle_id, created_at, updated_at
+		FROM feeds_manager_chain_configs
+		WHERE feeds_manager_id = ?
+		ORDER BY chain_id ASC;
+	`
+
+	err := o.db.Raw(stmt, mgrID).Scan(&cfgs).Error
+	if err != nil {
+		return cfgs, err
+	}
+
+	return cfgs, nil
+}
+
+// GetChainConfig gets a chain config by id
+func (o *orm) GetChainConfig(ctx context.Context, id int64) (*ChainConfig, error) {
+	stmt := `
+		SELECT id, feeds_manager_id, chain_id, account_address, ocr_key_bundle_id, created_at, updated_at
+		FROM feeds_manager_chain_configs
+		WHERE id = ?;
+	`
+
+	cfg := ChainConfig{}
+	result := o.db.Raw(stmt, id).Scan(&cfg)
+	if result.RowsAffected == 0 {
+		return nil, sql.ErrNoRows
+	}
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return &cfg, nil
+}
+
+// DeleteChainConfig deletes a chain config by id.
+func (o *orm) DeleteChainConfig(ctx context.Context, id int64) error {
+	tx := postgres.TxFromContext(ctx, o.db)
+
+	stmt := `
+		DELETE FROM feeds_manager_chain_configs
+		WHERE id = ?;
+	`
+
+	result := tx.Exec(stmt, id)
+	if result.RowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+	if result.Error != nil {
+		return result.Error
+	}
+
+	return nil
+}
+
 // CreateJobProposal creates a job proposal.
 func (o *orm) CreateJobProposal(ctx context.Context, jp *JobProposal) (int64, error) {
 	var id int64
 	now := time.Now()
 
 	stmt := `
-		INSERT INTO job_proposals (remote_uuid, spec, status, feeds_manager_id, created_at, updated_at)
-		VALUES (?, ?, ?, ?, ?, ?)
+		INSERT INTO job_proposals (remote_uuid, spec, status, feeds_manager_id, chain_id, created_at, updated_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?)
 		RETURNING id;
 	`
 
-	row := o.db.Raw(stmt, jp.RemoteUUID, jp.Spec, jp.Status, jp.FeedsManagerID, now, now).Row()
+	row := o.db.Raw(stmt, jp.RemoteUUID, jp.Spec, jp.Status, jp.FeedsManagerID, jp.ChainID, now, now).Row()
 	if row.Err() != nil {
 		return id, row.Err()
 	}
@@ -145,12 +249,13 @@ func (o *orm) CreateJobProposal(ctx context.Context, jp *JobProposal) (int64, er
 	return id, err
 }
 
-// ListJobProposals lists all job proposals
+// ListJobProposals lists all job proposals which have not been archived
 func (o *orm) ListJobProposals(ctx context.Context) ([]JobProposal, error) {
 	jps := []JobProposal{}
 	stmt := `
-		SELECT remote_uuid, id, spec, status, external_job_id, feeds_manager_id, created_at, updated_at
-		FROM job_proposals;
+		SELECT remote_uuid, id, spec, status, external_job_id, feeds_manager_id, chain_id, deleted_at, created_at, updated_at
+		FROM job_proposals
+		WHERE deleted_at IS NULL;
 	`
 
 	err := o.db.Raw(stmt).Scan(&jps).Error
@@ -164,7 +269,7 @@ func (o *orm) ListJobProposals(ctx context.Context) ([]JobProposal, error) {
 // GetJobProposal gets a job proposal by id
 func (o *orm) GetJobProposal(ctx context.Context, id int64) (*JobProposal, error) {
 	stmt := `
-		SELECT id, remote_uuid, spec, status, external_job_id, feeds_manager_id, created_at, updated_at
+		SELECT id, remote_uuid, spec, status, external_job_id, feeds_manager_id, chain_id, deleted_at, created_at, updated_at
 		FROM job_proposals
 		WHERE id = ?;
 	`
@@ -254,6 +359,69 @@ func (o *orm) ApproveJobProposal(ctx context.Context, id int64, externalJobID uu
 	return nil
 }
 
+// ListArchivedJobProposals lists all job proposals which have been archived
+func (o *orm) ListArchivedJobProposals(ctx context.Context) ([]JobProposal, error) {
+	jps := []JobProposal{}
+	stmt := `
+		SELECT remote_uuid, id, spec, status, external_job_id, feeds_manager_id, chain_id, deleted_at, created_at, updated_at
+		FROM job_proposals
+		WHERE deleted_at IS NOT NULL;
+	`
+
+	err := o.db.Raw(stmt).Scan(&jps).Error
+	if err != nil {
+		return jps, err
+	}
+
+	return jps, nil
+}
+
+// ArchiveJobProposal soft deletes a job proposal by id. Only proposals which
+// have been rejected or cancelled can be archived, since pending proposals
+// still await a decision and approved proposals are linked to a job.
+func (o *orm) ArchiveJobProposal(ctx context.Context, id int64) error {
+	tx := postgres.TxFromContext(ctx, o.db)
+
+	now := time.Now()
+
+	stmt := `
+		UPDATE job_proposals
+		SET deleted_at = ?,
+		    updated_at = ?
+		WHERE id = ?
+		AND status IN (?, ?)
+		AND deleted_at IS NULL;
+	`
+
+	result := tx.Exec(stmt, now, now, id, JobProposalStatusRejected, JobProposalStatusCancelled)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+// PurgeArchivedJobProposals permanently deletes the job proposals which were
+// archived longer ago than the threshold, returning the number deleted.
+func (o *orm) PurgeArchivedJobProposals(ctx context.Context, threshold time.Duration) (int64, error) {
+	tx := postgres.TxFromContext(ctx, o.db)
+
+	stmt := `
+		DELETE FROM job_proposals
+		WHERE deleted_at < ?;
+	`
+
+	result := tx.Exec(stmt, time.Now().Add(-threshold))
+	if result.Error != nil {
+		return 0, result.Error
+	}
+
+	return result.RowsAffected, nil
+}
+
 // CountJobProposals counts the number of job proposal records.
 func (o *orm) CountJobProposals() (int64, error) {
 	var count int64
@@ -2,12 +2,16 @@ package feeds_test
 
 import (
 	"context"
+	"database/sql"
 	"testing"
+	"time"
 
 	"github.com/lib/pq"
 	uuid "github.com/satori/go.uuid"
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
 	"github.com/smartcontractkit/chainlink/core/internal/testutils/pgtest"
 	"github.com/smartcontractkit/chainlink/core/services/feeds"
+	"github.com/smartcontractkit/chainlink/core/store/models"
 	"github.com/smartcontractkit/chainlink/core/utils/crypto"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -302,6 +306,130 @@ func Test_ORM_ApproveJobProposal(t *testing.T) {
 	assert.Equal(t, feeds.JobProposalStatusApproved, actual.Status)
 }
 
+func Test_ORM_ArchiveJobProposal(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	orm := setupORM(t)
+	fmID := createFeedsManager(t, orm)
+
+	pendingID, err := orm.CreateJobProposal(ctx, &feeds.JobProposal{
+		RemoteUUID:     uuid.NewV4(),
+		Spec:           "",
+		Status:         feeds.JobProposalStatusPending,
+		FeedsManagerID: fmID,
+	})
+	require.NoError(t, err)
+
+	rejectedID, err := orm.CreateJobProposal(ctx, &feeds.JobProposal{
+		RemoteUUID:     uuid.NewV4(),
+		Spec:           "",
+		Status:         feeds.JobProposalStatusRejected,
+		FeedsManagerID: fmID,
+	})
+	require.NoError(t, err)
+
+	cancelledID, err := orm.CreateJobProposal(ctx, &feeds.JobProposal{
+		RemoteUUID:     uuid.NewV4(),
+		Spec:           "",
+		Status:         feeds.JobProposalStatusCancelled,
+		FeedsManagerID: fmID,
+	})
+	require.NoError(t, err)
+
+	// Pending proposals cannot be archived
+	err = orm.ArchiveJobProposal(ctx, pendingID)
+	require.Equal(t, sql.ErrNoRows, err)
+
+	err = orm.ArchiveJobProposal(ctx, rejectedID)
+	require.NoError(t, err)
+
+	err = orm.ArchiveJobProposal(ctx, cancelledID)
+	require.NoError(t, err)
+
+	// Archiving twice has no effect
+	err = orm.ArchiveJobProposal(ctx, rejectedID)
+	require.Equal(t, sql.ErrNoRows, err)
+
+	actual, err := orm.GetJobProposal(ctx, rejectedID)
+	require.NoError(t, err)
+	assert.True(t, actual.DeletedAt.Valid)
+
+	jps, err := orm.ListJobProposals(ctx)
+	require.NoError(t, err)
+	require.Len(t, jps, 1)
+	assert.Equal(t, pendingID, jps[0].ID)
+
+	jps, err = orm.ListArchivedJobProposals(ctx)
+	require.NoError(t, err)
+	require.Len(t, jps, 2)
+	assert.ElementsMatch(t, []int64{rejectedID, cancelledID}, []int64{jps[0].ID, jps[1].ID})
+
+	// The proposal was only just archived so it is retained
+	count, err := orm.PurgeArchivedJobProposals(ctx, time.Hour)
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), count)
+
+	count, err = orm.PurgeArchivedJobProposals(ctx, 0)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), count)
+
+	_, err = orm.GetJobProposal(ctx, rejectedID)
+	require.Equal(t, sql.ErrNoRows, err)
+
+	_, err = orm.GetJobProposal(ctx, pendingID)
+	require.NoError(t, err)
+}
+
+func Test_ORM_ChainConfigs(t *testing.T) {
+	t.Parallel()
+
+	orm := setupORM(t)
+	fmID := createFeedsManager(t, orm)
+
+	keyBundleID := models.MustSha256HashFromHex("f5bf259689b26f1374efb3c9a9868796953a0f814bb2d39b968d0e61b58620a5")
+	cfg := &feeds.ChainConfig{
+		FeedsManagerID: fmID,
+		ChainID:        42,
+		AccountAddress: cltest.NewAddress(),
+		OCRKeyBundleID: &keyBundleID,
+	}
+
+	id, err := orm.UpsertChainConfig(context.Background(), cfg)
+	require.NoError(t, err)
+
+	actual, err := orm.GetChainConfig(context.Background(), id)
+	require.NoError(t, err)
+	assert.Equal(t, fmID, actual.FeedsManagerID)
+	assert.Equal(t, cfg.ChainID, actual.ChainID)
+	assert.Equal(t, cfg.AccountAddress, actual.AccountAddress)
+	require.NotNil(t, actual.OCRKeyBundleID)
+	assert.Equal(t, keyBundleID, *actual.OCRKeyBundleID)
+
+	// Upserting a config for the same chain updates the existing config
+	cfg.AccountAddress = cltest.NewAddress()
+	cfg.OCRKeyBundleID = nil
+
+	upsertedID, err := orm.UpsertChainConfig(context.Background(), cfg)
+	require.NoError(t, err)
+	assert.Equal(t, id, upsertedID)
+
+	cfgs, err := orm.ListChainConfigsByManagerID(context.Background(), fmID)
+	require.NoError(t, err)
+	require.Len(t, cfgs, 1)
+	assert.Equal(t, cfg.AccountAddress, cfgs[0].AccountAddress)
+	assert.Nil(t, cfgs[0].OCRKeyBundleID)
+
+	err = orm.DeleteChainConfig(context.Background(), id)
+	require.NoError(t, err)
+
+	_, err = orm.GetChainConfig(context.Background(), id)
+	require.Error(t, err)
+
+	err = orm.DeleteChainConfig(context.Background(), id)
+	require.Error(t, err)
+}
+
 // createFeedsManager is a test helper to create a feeds manager
 func createFeedsManager(t *testing.T, orm feeds.ORM) int64 {
 	mgr := &feeds.FeedsManager{
@@ -5,6 +5,7 @@ import (
 
 	uuid "github.com/satori/go.uuid"
 	pb "github.com/smartcontractkit/chainlink/core/services/feeds/proto"
+	"gopkg.in/guregu/null.v4"
 )
 
 // RPCHandlers define handlers for RPC method calls from the Feeds Manager
@@ -21,7 +22,9 @@ func NewRPCHandlers(svc Service, feedsManagerID int64) *RPCHandlers {
 }
 
 // ProposeJob creates a new job proposal record for the feeds manager
-func (h *RPCHandlers) ProposeJob(ctx context.Context, req *pb.ProposeJobRequest) (*pb.ProposeJobResponse, error) {
+func (h *RPCHandlers) ProposeJob(ctx context.Context, req *pb.ProposeJobRequest) (res *pb.ProposeJobResponse, err error) {
+	defer func() { observeRPC(h.feedsManagerID, rpcMethodProposeJob, err) }()
+
 	remoteUUID, err := uuid.FromString(req.Id)
 	if err != nil {
 		return nil, err
@@ -33,6 +36,9 @@ func (h *RPCHandlers) ProposeJob(ctx context.Context, req *pb.ProposeJobRequest)
 		FeedsManagerID: h.feedsManagerID,
 		RemoteUUID:     remoteUUID,
 	}
+	if req.ChainId != 0 {
+		jp.ChainID = null.IntFrom(req.ChainId)
+	}
 
 	_, err = h.svc.CreateJobProposal(jp)
 	if err != nil {
@@ -8,6 +8,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/services/feeds"
 	pb "github.com/smartcontractkit/chainlink/core/services/feeds/proto"
 	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
 )
 
 func Test_RPCHandlers_ProposeJob(t *testing.T) {
@@ -17,21 +18,27 @@ func Test_RPCHandlers_ProposeJob(t *testing.T) {
 		jobID          = uuid.NewV4()
 		spec           = `some spec`
 		feedsManagerID = int64(1)
+		chainID        = int64(42)
 	)
 	h := feeds.NewRPCHandlers(svc, feedsManagerID)
 
+	svc.orm.
+		On("ListChainConfigsByManagerID", context.Background(), feedsManagerID).
+		Return([]feeds.ChainConfig{{FeedsManagerID: feedsManagerID, ChainID: chainID}}, nil)
 	svc.orm.
 		On("CreateJobProposal", context.Background(), &feeds.JobProposal{
 			Spec:           spec,
 			Status:         feeds.JobProposalStatusPending,
 			FeedsManagerID: feedsManagerID,
 			RemoteUUID:     jobID,
+			ChainID:        null.IntFrom(chainID),
 		}).
 		Return(int64(1), nil)
 
 	_, err := h.ProposeJob(context.Background(), &pb.ProposeJobRequest{
-		Id:   jobID.String(),
-		Spec: spec,
+		Id:      jobID.String(),
+		Spec:    spec,
+		ChainId: chainID,
 	})
 	require.NoError(t, err)
 }
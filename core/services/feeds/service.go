@@ -3,19 +3,27 @@ package feeds
 import (
 	"context"
 	"crypto/ed25519"
+	"fmt"
 	"sync"
+	"time"
 
 	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
 	"github.com/smartcontractkit/chainlink/core/gracefulpanic"
 	"github.com/smartcontractkit/chainlink/core/logger"
 	pb "github.com/smartcontractkit/chainlink/core/services/feeds/proto"
 	"github.com/smartcontractkit/chainlink/core/services/fluxmonitorv2"
 	"github.com/smartcontractkit/chainlink/core/services/job"
+	"github.com/smartcontractkit/chainlink/core/services/keeper"
 	"github.com/smartcontractkit/chainlink/core/services/keystore"
 	"github.com/smartcontractkit/chainlink/core/services/offchainreporting"
 	"github.com/smartcontractkit/chainlink/core/services/postgres"
+	"github.com/smartcontractkit/chainlink/core/services/webhook"
 	"github.com/smartcontractkit/chainlink/core/utils"
 	"github.com/smartcontractkit/wsrpc"
+	"github.com/smartcontractkit/wsrpc/connectivity"
+	"gopkg.in/guregu/null.v4"
 )
 
 //go:generate mockery --name Service --output ./mocks/ --case=underscore
@@ -23,17 +31,81 @@ import (
 
 var (
 	ErrOCRDisabled = errors.New("ocr is disabled")
+	// ErrBulkApprovalFailed is returned when a bulk approval could not be
+	// completed and none of the job proposals were approved.
+	ErrBulkApprovalFailed = errors.New("bulk approval failed")
+	// ErrChainNotConfigured is returned when a job proposal targets a chain
+	// which the feeds manager does not have a chain config for.
+	ErrChainNotConfigured = errors.New("chain is not configured for the feeds manager")
 )
 
+var (
+	promConnectionStatus = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "feeds_manager_connection_status",
+		Help: "Whether the node is connected to the feeds manager (1) or not (0)",
+	},
+		[]string{"feeds_manager_id"},
+	)
+	promRPCRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "feeds_rpc_requests",
+		Help: "The total number of RPC requests sent to or received from the feeds manager",
+	},
+		[]string{"feeds_manager_id", "method"},
+	)
+	promRPCErrors = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "feeds_rpc_errors",
+		Help: "The total number of failed RPC requests sent to or received from the feeds manager",
+	},
+		[]string{"feeds_manager_id", "method"},
+	)
+	promJobProposals = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "feeds_job_proposals",
+		Help: "The total number of job proposal lifecycle events by status",
+	},
+		[]string{"feeds_manager_id", "status"},
+	)
+	promJobProposalApprovalLatency = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "feeds_job_proposal_approval_latency_seconds",
+		Help:    "The time between receiving a job proposal and approving it",
+		Buckets: []float64{60, 300, 900, 3600, 4 * 3600, 24 * 3600, 7 * 24 * 3600},
+	},
+		[]string{"feeds_manager_id"},
+	)
+)
+
+// RPC method names used as metric labels
+const (
+	rpcMethodProposeJob  = "ProposeJob"
+	rpcMethodApprovedJob = "ApprovedJob"
+	rpcMethodRejectedJob = "RejectedJob"
+	rpcMethodUpdateNode  = "UpdateNode"
+)
+
+// observeRPC records an RPC request to or from the feeds manager and whether
+// it failed.
+func observeRPC(feedsManagerID int64, method string, err error) {
+	id := fmt.Sprintf("%d", feedsManagerID)
+	promRPCRequests.WithLabelValues(id, method).Inc()
+	if err != nil {
+		promRPCErrors.WithLabelValues(id, method).Inc()
+	}
+}
+
 type Service interface {
 	Start() error
 	Close() error
 
 	ApproveJobProposal(ctx context.Context, id int64) error
+	ArchiveJobProposal(ctx context.Context, id int64) error
+	ApproveJobProposals(ctx context.Context, ids []int64) ([]JobProposalApprovalResult, error)
 	CountManagers() (int64, error)
+	CreateChainConfig(cfg ChainConfig) (int64, error)
 	CreateJobProposal(jp *JobProposal) (int64, error)
+	DeleteChainConfig(id int64) error
+	GetChainConfig(id int64) (*ChainConfig, error)
 	GetJobProposal(id int64) (*JobProposal, error)
 	GetManager(id int64) (*FeedsManager, error)
+	ListChainConfigsByManagerID(mgrID int64) ([]ChainConfig, error)
 	ListManagers() ([]FeedsManager, error)
 	ListJobProposals() ([]JobProposal, error)
 	RegisterManager(ms *FeedsManager) (int64, error)
@@ -61,6 +133,7 @@ type service struct {
 	ethKeyStore keystore.EthKeyStoreInterface
 	fmsClient   pb.FeedsManagerClient
 	jobSpawner  job.Spawner
+	eiManager   webhook.ExternalInitiatorManager
 	cfg         Config
 	txm         postgres.TransactionManager
 }
@@ -72,6 +145,7 @@ func NewService(
 	jobSpawner job.Spawner,
 	csaKeyStore keystore.CSAKeystoreInterface,
 	ethKeyStore keystore.EthKeyStoreInterface,
+	eiManager webhook.ExternalInitiatorManager,
 	cfg Config,
 ) *service {
 	ctx, cancel := context.WithCancel(context.Background())
@@ -84,6 +158,7 @@ func NewService(
 		jobSpawner:    jobSpawner,
 		csaKeyStore:   csaKeyStore,
 		ethKeyStore:   ethKeyStore,
+		eiManager:     eiManager,
 		cfg:           cfg,
 	}
 
@@ -103,12 +178,14 @@ func (s *service) RegisterManager(mgr *FeedsManager) (int64, error) {
 		return 0, errors.New("only a single feeds manager is supported")
 	}
 
-	id, err := s.orm.CreateManager(context.Background(), mgr)
+	// Resolve the key before creating the manager so that a manager is not
+	// registered with a key it cannot connect with
+	privkey, err := s.getCSAPrivateKey(*mgr)
 	if err != nil {
 		return 0, err
 	}
 
-	privkey, err := s.getCSAPrivateKey()
+	id, err := s.orm.CreateManager(context.Background(), mgr)
 	if err != nil {
 		return 0, err
 	}
@@ -135,8 +212,12 @@ func (s *service) SyncNodeInfo(id int64) error {
 		switch jt {
 		case JobTypeFluxMonitor:
 			jobtypes = append(jobtypes, pb.JobType_JOB_TYPE_FLUX_MONITOR)
+		case JobTypeKeeper:
+			jobtypes = append(jobtypes, pb.JobType_JOB_TYPE_KEEPER)
 		case JobTypeOffchainReporting:
 			jobtypes = append(jobtypes, pb.JobType_JOB_TYPE_OCR)
+		case JobTypeWebhook:
+			jobtypes = append(jobtypes, pb.JobType_JOB_TYPE_WEBHOOK)
 		default:
 			// NOOP
 		}
@@ -152,6 +233,24 @@ func (s *service) SyncNodeInfo(id int64) error {
 		addresses = append(addresses, k.Address.String())
 	}
 
+	cfgs, err := s.orm.ListChainConfigsByManagerID(context.Background(), id)
+	if err != nil {
+		return err
+	}
+
+	chainConfigs := []*pb.ChainConfig{}
+	for _, cfg := range cfgs {
+		pbcfg := &pb.ChainConfig{
+			ChainId:        cfg.ChainID,
+			AccountAddress: cfg.AccountAddress.Hex(),
+		}
+		if cfg.OCRKeyBundleID != nil {
+			pbcfg.OcrKeyBundleId = cfg.OCRKeyBundleID.String()
+		}
+
+		chainConfigs = append(chainConfigs, pbcfg)
+	}
+
 	// Make the remote call to FMS
 	_, err = s.fmsClient.UpdateNode(context.Background(), &pb.UpdateNodeRequest{
 		JobTypes:           jobtypes,
@@ -159,7 +258,9 @@ func (s *service) SyncNodeInfo(id int64) error {
 		AccountAddresses:   addresses,
 		IsBootstrapPeer:    mgr.IsOCRBootstrapPeer,
 		BootstrapMultiaddr: mgr.OCRBootstrapPeerMultiaddr.ValueOrZero(),
+		ChainConfigs:       chainConfigs,
 	})
+	observeRPC(id, rpcMethodUpdateNode, err)
 	if err != nil {
 		return err
 	}
@@ -190,9 +291,119 @@ func (s *service) ListJobProposals() ([]JobProposal, error) {
 	return s.orm.ListJobProposals(context.Background())
 }
 
+// CreateChainConfig creates or updates the chain config of a feeds manager
+// and syncs the node's information with FMS.
+func (s *service) CreateChainConfig(cfg ChainConfig) (int64, error) {
+	if cfg.ChainID <= 0 {
+		return 0, errors.New("chain id must be greater than 0")
+	}
+
+	ok, err := s.ethKeyStore.HasSendingKeyWithAddress(cfg.AccountAddress)
+	if err != nil {
+		return 0, err
+	}
+	if !ok {
+		return 0, errors.Errorf("no sending key exists with address %s", cfg.AccountAddress.Hex())
+	}
+
+	id, err := s.orm.UpsertChainConfig(context.Background(), &cfg)
+	if err != nil {
+		return 0, errors.Wrap(err, "could not create chain config")
+	}
+
+	s.syncNodeInfoIfConnected(cfg.FeedsManagerID)
+
+	return id, nil
+}
+
+// ListChainConfigsByManagerID lists the chain configs of a feeds manager.
+func (s *service) ListChainConfigsByManagerID(mgrID int64) ([]ChainConfig, error) {
+	return s.orm.ListChainConfigsByManagerID(context.Background(), mgrID)
+}
+
+// GetChainConfig gets a chain config by id.
+func (s *service) GetChainConfig(id int64) (*ChainConfig, error) {
+	return s.orm.GetChainConfig(context.Background(), id)
+}
+
+// DeleteChainConfig deletes a chain config and syncs the node's information
+// with FMS.
+func (s *service) DeleteChainConfig(id int64) error {
+	cfg, err := s.orm.GetChainConfig(context.Background(), id)
+	if err != nil {
+		return err
+	}
+
+	if err = s.orm.DeleteChainConfig(context.Background(), id); err != nil {
+		return errors.Wrap(err, "could not delete chain config")
+	}
+
+	s.syncNodeInfoIfConnected(cfg.FeedsManagerID)
+
+	return nil
+}
+
+// syncNodeInfoIfConnected syncs the node's information with FMS when there
+// is a connection. A failure to sync is logged rather than returned, since
+// the node info is synced again on the next connection.
+func (s *service) syncNodeInfoIfConnected(mgrID int64) {
+	if s.fmsClient == nil {
+		return
+	}
+
+	if err := s.SyncNodeInfo(mgrID); err != nil {
+		logger.Infof("[Feeds] Error syncing node info: %v", err)
+	}
+}
+
 // CreateJobProposal creates a job proposal.
 func (s *service) CreateJobProposal(jp *JobProposal) (int64, error) {
-	return s.orm.CreateJobProposal(context.Background(), jp)
+	if err := s.resolveJobProposalChain(context.Background(), jp); err != nil {
+		return 0, err
+	}
+
+	id, err := s.orm.CreateJobProposal(context.Background(), jp)
+	if err != nil {
+		return id, err
+	}
+
+	promJobProposals.WithLabelValues(fmt.Sprintf("%d", jp.FeedsManagerID), string(JobProposalStatusPending)).Inc()
+
+	return id, nil
+}
+
+// resolveJobProposalChain validates the chain targeted by a job proposal
+// against the chain configs of its feeds manager. If the proposal does not
+// specify a chain and the feeds manager has a single chain config, the
+// proposal is targeted at that chain.
+func (s *service) resolveJobProposalChain(ctx context.Context, jp *JobProposal) error {
+	cfgs, err := s.orm.ListChainConfigsByManagerID(ctx, jp.FeedsManagerID)
+	if err != nil {
+		return errors.Wrap(err, "could not fetch chain configs")
+	}
+
+	// Feeds managers without any chain configs may propose jobs for any chain
+	if len(cfgs) == 0 {
+		return nil
+	}
+
+	if !jp.ChainID.Valid {
+		if len(cfgs) > 1 {
+			return errors.New("job proposal must specify a chain when the feeds manager has multiple chain configs")
+		}
+
+		jp.ChainID = null.IntFrom(cfgs[0].ChainID)
+
+		return nil
+	}
+
+	for _, cfg := range cfgs {
+		if cfg.ChainID == jp.ChainID.Int64 {
+			return nil
+		}
+	}
+
+	return errors.Wrapf(ErrChainNotConfigured, "chain %d", jp.ChainID.Int64)
 }
 
 // GetJobProposal gets a job proposal by id.
@@ -253,9 +464,11 @@ func (s *service) ApproveJobProposal(ctx context.Context, id int64) error {
 		}
 
 		// Send to FMS Client
-		if _, err = s.fmsClient.ApprovedJob(ctx, &pb.ApprovedJobRequest{
+		_, err = s.fmsClient.ApprovedJob(ctx, &pb.ApprovedJobRequest{
 			Uuid: jp.RemoteUUID.String(),
-		}); err != nil {
+		})
+		observeRPC(jp.FeedsManagerID, rpcMethodApprovedJob, err)
+		if err != nil {
 			return err
 		}
 
@@ -265,9 +478,134 @@ func (s *service) ApproveJobProposal(ctx context.Context, id int64) error {
 		return errors.Wrap(err, "could not approve job proposal")
 	}
 
+	mgrID := fmt.Sprintf("%d", jp.FeedsManagerID)
+	promJobProposals.WithLabelValues(mgrID, string(JobProposalStatusApproved)).Inc()
+	promJobProposalApprovalLatency.WithLabelValues(mgrID).Observe(time.Since(jp.CreatedAt).Seconds())
+
 	return nil
 }
 
+// JobProposalApprovalResult is the outcome of approving a single job
+// proposal as part of a bulk approval.
+type JobProposalApprovalResult struct {
+	ID int64
+	// Approved is true when the job was created and the proposal was marked
+	// as approved.
+	Approved bool
+	// Err contains the reason the proposal could not be approved, or the
+	// error returned when notifying FMS of an approved proposal.
+	Err error
+}
+
+// ApproveJobProposals approves multiple pending job proposals. All jobs are
+// created within a single database transaction, so if any of the proposals
+// fails validation or job creation, none of them are approved and
+// ErrBulkApprovalFailed is returned alongside the per proposal results.
+//
+// FMS is notified of each approval once the transaction has been committed.
+// A failed notification does not undo the approval, and is reported in the
+// result for that proposal.
+func (s *service) ApproveJobProposals(ctx context.Context, ids []int64) ([]JobProposalApprovalResult, error) {
+	if s.fmsClient == nil {
+		return nil, errors.New("fms rpc client is not connected")
+	}
+
+	if len(ids) == 0 {
+		return nil, errors.New("no job proposals provided")
+	}
+
+	var (
+		results = make([]JobProposalApprovalResult, len(ids))
+		jps     = make([]*JobProposal, len(ids))
+		jobs    = make([]*job.Job, len(ids))
+		seen    = make(map[int64]struct{}, len(ids))
+		invalid bool
+	)
+
+	// Validate all the proposals before opening the transaction
+	for i, id := range ids {
+		results[i].ID = id
+
+		if _, ok := seen[id]; ok {
+			results[i].Err = errors.New("duplicate job proposal")
+			invalid = true
+			continue
+		}
+		seen[id] = struct{}{}
+
+		jp, err := s.orm.GetJobProposal(ctx, id)
+		if err != nil {
+			results[i].Err = errors.Wrap(err, "job proposal does not exist")
+			invalid = true
+			continue
+		}
+
+		if jp.Status != JobProposalStatusPending {
+			results[i].Err = errors.New("must be a pending job proposal")
+			invalid = true
+			continue
+		}
+
+		j, err := s.generateJob(jp.Spec)
+		if err != nil {
+			results[i].Err = errors.Wrap(err, "could not generate job from spec")
+			invalid = true
+			continue
+		}
+
+		jps[i] = jp
+		jobs[i] = j
+	}
+	if invalid {
+		return results, ErrBulkApprovalFailed
+	}
+
+	txCtx, cancel := context.WithTimeout(ctx, postgres.DefaultQueryTimeout)
+	defer cancel()
+
+	err := s.txm.TransactWithContext(txCtx, func(txCtx context.Context) error {
+		for i, id := range ids {
+			j := jobs[i]
+
+			// Create the job
+			if _, err := s.jobSpawner.CreateJob(txCtx, *j, j.Name); err != nil {
+				results[i].Err = errors.Wrap(err, "could not create job")
+				return err
+			}
+
+			// Approve the job
+			if err := s.orm.ApproveJobProposal(txCtx, id, j.ExternalJobID, JobProposalStatusApproved); err != nil {
+				results[i].Err = errors.Wrap(err, "could not approve job proposal")
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return results, errors.Wrap(ErrBulkApprovalFailed, err.Error())
+	}
+
+	// Send to FMS Client
+	for i, jp := range jps {
+		results[i].Approved = true
+
+		mgrID := fmt.Sprintf("%d", jp.FeedsManagerID)
+		promJobProposals.WithLabelValues(mgrID, string(JobProposalStatusApproved)).Inc()
+		promJobProposalApprovalLatency.WithLabelValues(mgrID).Observe(time.Since(jp.CreatedAt).Seconds())
+
+		_, err = s.fmsClient.ApprovedJob(ctx, &pb.ApprovedJobRequest{
+			Uuid: jp.RemoteUUID.String(),
+		})
+		observeRPC(jp.FeedsManagerID, rpcMethodApprovedJob, err)
+		if err != nil {
+			results[i].Err = errors.Wrap(err, "could not notify FMS of approval")
+		}
+	}
+
+	return results, nil
+}
+
 func (s *service) RejectJobProposal(ctx context.Context, id int64) error {
 	if s.fmsClient == nil {
 		return errors.New("fms rpc client is not connected")
@@ -289,9 +627,11 @@ func (s *service) RejectJobProposal(ctx context.Context, id int64) error {
 			return err
 		}
 
-		if _, err = s.fmsClient.RejectedJob(ctx, &pb.RejectedJobRequest{
+		_, err = s.fmsClient.RejectedJob(ctx, &pb.RejectedJobRequest{
 			Uuid: jp.RemoteUUID.String(),
-		}); err != nil {
+		})
+		observeRPC(jp.FeedsManagerID, rpcMethodRejectedJob, err)
+		if err != nil {
 			return err
 		}
 
@@ -301,16 +641,68 @@ func (s *service) RejectJobProposal(ctx context.Context, id int64) error {
 		return errors.Wrap(err, "could not reject job proposal")
 	}
 
+	promJobProposals.WithLabelValues(fmt.Sprintf("%d", jp.FeedsManagerID), string(JobProposalStatusRejected)).Inc()
+
 	return nil
 }
 
-func (s *service) Start() error {
-	return s.StartOnce("FeedsService", func() error {
-		privkey, err := s.getCSAPrivateKey()
-		if err != nil {
-			return err
+// ArchiveJobProposal archives a rejected or cancelled job proposal. It is
+// purged once it has been archived for longer than the retention period.
+func (s *service) ArchiveJobProposal(ctx context.Context, id int64) error {
+	ctx, cancel := context.WithTimeout(ctx, postgres.DefaultQueryTimeout)
+	defer cancel()
+
+	return s.orm.ArchiveJobProposal(ctx, id)
+}
+
+// jobProposalPurgeInterval is how often the archived job proposals past their
+// retention period are purged
+const jobProposalPurgeInterval = time.Hour
+
+// runPurgeLoop purges the archived job proposals past their retention period
+// every jobProposalPurgeInterval, until the service closes
+func (s *service) runPurgeLoop() {
+	s.wgDone.Add(1)
+
+	go gracefulpanic.WrapRecover(func() {
+		defer s.wgDone.Done()
+
+		ticker := time.NewTicker(jobProposalPurgeInterval)
+		defer ticker.Stop()
+
+		for {
+			s.purgeArchivedJobProposals()
+
+			select {
+			case <-s.chDone:
+				return
+			case <-ticker.C:
+			}
 		}
+	})
+}
+
+func (s *service) purgeArchivedJobProposals() {
+	retention := s.cfg.FeedsJobProposalRetention()
+	if retention == 0 {
+		return
+	}
+
+	ctx, cancel := utils.CombinedContext(s.chDone, postgres.DefaultQueryTimeout)
+	defer cancel()
+
+	count, err := s.orm.PurgeArchivedJobProposals(ctx, retention)
+	if err != nil {
+		logger.Errorw("[Feeds] Failed to purge archived job proposals", "err", err)
+		return
+	}
+	if count > 0 {
+		logger.Infow("[Feeds] Purged archived job proposals", "count", count, "retention", retention)
+	}
+}
 
+func (s *service) Start() error {
+	return s.StartOnce("FeedsService", func() error {
 		// We only support a single feeds manager right now
 		mgrs, err := s.ListManagers()
 		if err != nil {
@@ -322,7 +714,13 @@ func (s *service) Start() error {
 
 		mgr := mgrs[0]
 
+		privkey, err := s.getCSAPrivateKey(mgr)
+		if err != nil {
+			return err
+		}
+
 		s.connect(mgr.URI, privkey, mgr.PublicKey, mgr.ID)
+		s.runPurgeLoop()
 
 		return nil
 	})
@@ -354,6 +752,9 @@ func (s *service) connect(uri string, privkey []byte, pubkey []byte, feedsManage
 		// Clean up context
 		defer s.connCtxCancel()
 
+		connStatus := promConnectionStatus.WithLabelValues(fmt.Sprintf("%d", feedsManagerID))
+		connStatus.Set(0)
+
 		conn, err := wsrpc.DialWithContext(s.connCtx, uri,
 			wsrpc.WithTransportCreds(privkey, ed25519.PublicKey(pubkey)),
 			wsrpc.WithBlock(),
@@ -369,6 +770,9 @@ func (s *service) connect(uri string, privkey []byte, pubkey []byte, feedsManage
 		}
 		defer conn.Close()
 
+		connStatus.Set(1)
+		defer connStatus.Set(0)
+
 		logger.Infow("[Feeds] Connected to Feeds Manager", "feedsManagerID", feedsManagerID)
 
 		// Initialize a new wsrpc client to make RPC calls
@@ -388,13 +792,67 @@ func (s *service) connect(uri string, privkey []byte, pubkey []byte, feedsManage
 			logger.Infof("[Feeds] Error syncing node info: %v", err)
 		}
 
-		// Wait for close
-		<-s.chDone
+		// Track disconnects and reconnects until close
+		watchConnState(s.connCtx, conn, connStatus, feedsManagerID)
 	})
 }
 
-// getCSAPrivateKey gets the server's CSA private key
-func (s *service) getCSAPrivateKey() (privkey []byte, err error) {
+// connStateWaiter is implemented by *wsrpc.ClientConn
+type connStateWaiter interface {
+	WaitForStateChange(ctx context.Context, sourceState connectivity.State) bool
+}
+
+// connStates are the states of a connection, the most likely first
+var connStates = []connectivity.State{
+	connectivity.Ready,
+	connectivity.Idle,
+	connectivity.Connecting,
+	connectivity.TransientFailure,
+	connectivity.Shutdown,
+}
+
+// connState returns the state of the connection. wsrpc does not expose it, so
+// it is found as the state WaitForStateChange does not report a change from
+// when called with a done context, which makes it return immediately.
+func connState(conn connStateWaiter) connectivity.State {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	for {
+		for _, state := range connStates {
+			if !conn.WaitForStateChange(ctx, state) {
+				return state
+			}
+		}
+		// The state changed while probing it, so it is probed again
+	}
+}
+
+// watchConnState sets the connection status gauge as the connection drops
+// and reconnects, until ctx is done or the connection shuts down
+func watchConnState(ctx context.Context, conn connStateWaiter, connStatus prometheus.Gauge, feedsManagerID int64) {
+	state := connState(conn)
+	for {
+		if state == connectivity.Ready {
+			connStatus.Set(1)
+		} else {
+			connStatus.Set(0)
+		}
+		if state == connectivity.Shutdown || !conn.WaitForStateChange(ctx, state) {
+			return
+		}
+		prev := state
+		state = connState(conn)
+		if prev == connectivity.Ready && state != connectivity.Ready {
+			logger.Warnw("[Feeds] Disconnected from Feeds Manager, reconnecting", "feedsManagerID", feedsManagerID, "state", state)
+		} else if prev != connectivity.Ready && state == connectivity.Ready {
+			logger.Infow("[Feeds] Reconnected to Feeds Manager", "feedsManagerID", feedsManagerID)
+		}
+	}
+}
+
+// getCSAPrivateKey gets the CSA private key which the server connects to mgr
+// with. This is the key pinned by mgr, or the oldest key if none is pinned.
+func (s *service) getCSAPrivateKey(mgr FeedsManager) (privkey []byte, err error) {
 	// Fetch the server's public key
 	keys, err := s.csaKeyStore.ListCSAKeys()
 	if err != nil {
@@ -404,7 +862,25 @@ func (s *service) getCSAPrivateKey() (privkey []byte, err error) {
 		return privkey, errors.New("CSA key does not exist")
 	}
 
-	privkey, err = s.csaKeyStore.Unsafe_GetUnlockedPrivateKey(keys[0].PublicKey)
+	key := keys[0]
+	if mgr.CSAKeyID.Valid {
+		found := false
+		for _, k := range keys {
+			if int64(k.ID) == mgr.CSAKeyID.Int64 {
+				key, found = k, true
+				break
+			}
+		}
+		if !found {
+			return privkey, errors.Errorf("CSA key %d does not exist", mgr.CSAKeyID.Int64)
+		}
+	}
+
+	ctx := keystore.WithRequester(context.Background(), keystore.Requester{
+		Service: "FeedsService",
+		Purpose: fmt.Sprintf("connect to feeds manager %d", mgr.ID),
+	})
+	privkey, err = s.csaKeyStore.Unsafe_GetUnlockedPrivateKey(ctx, key.PublicKey)
 	if err != nil {
 		return []byte{}, err
 	}
@@ -437,6 +913,10 @@ func (s *service) generateJob(spec string) (*job.Job, error) {
 		}
 	case job.FluxMonitor:
 		js, err = fluxmonitorv2.ValidatedFluxMonitorSpec(s.cfg, spec)
+	case job.Keeper:
+		js, err = keeper.ValidatedKeeperSpec(spec)
+	case job.Webhook:
+		js, err = webhook.ValidatedWebhookSpec(spec, s.eiManager)
 	default:
 		return nil, errors.Errorf("unknown job type: %s", jobType)
 
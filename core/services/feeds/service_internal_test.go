@@ -0,0 +1,106 @@
+package feeds
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/smartcontractkit/wsrpc/connectivity"
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeConn tracks its state like *wsrpc.ClientConn, notifying waiters of
+// changes by closing a channel
+type fakeConn struct {
+	mu       sync.Mutex
+	state    connectivity.State
+	chNotify chan struct{}
+}
+
+func newFakeConn(state connectivity.State) *fakeConn {
+	return &fakeConn{state: state, chNotify: make(chan struct{})}
+}
+
+func (c *fakeConn) setState(state connectivity.State) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.state = state
+	close(c.chNotify)
+	c.chNotify = make(chan struct{})
+}
+
+func (c *fakeConn) WaitForStateChange(ctx context.Context, sourceState connectivity.State) bool {
+	c.mu.Lock()
+	state, ch := c.state, c.chNotify
+	c.mu.Unlock()
+	if state != sourceState {
+		return true
+	}
+	select {
+	case <-ctx.Done():
+		return false
+	case <-ch:
+		return true
+	}
+}
+
+func TestConnState(t *testing.T) {
+	for _, state := range connStates {
+		assert.Equal(t, state, connState(newFakeConn(state)))
+	}
+}
+
+func TestWatchConnState_DropAndReconnect(t *testing.T) {
+	conn := newFakeConn(connectivity.Ready)
+	gauge := prometheus.NewGauge(prometheus.GaugeOpts{Name: "test_connection_status"})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		watchConnState(ctx, conn, gauge, 1)
+	}()
+
+	status := func() float64 { return testutil.ToFloat64(gauge) }
+	assert.Eventually(t, func() bool { return status() == 1 }, time.Second, 10*time.Millisecond)
+
+	// The transport closes
+	conn.setState(connectivity.Idle)
+	assert.Eventually(t, func() bool { return status() == 0 }, time.Second, 10*time.Millisecond)
+
+	conn.setState(connectivity.TransientFailure)
+	conn.setState(connectivity.Connecting)
+	assert.Never(t, func() bool { return status() == 1 }, 100*time.Millisecond, 10*time.Millisecond)
+
+	conn.setState(connectivity.Ready)
+	assert.Eventually(t, func() bool { return status() == 1 }, time.Second, 10*time.Millisecond)
+
+	cancel()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("watchConnState did not return once the context was done")
+	}
+}
+
+func TestWatchConnState_Shutdown(t *testing.T) {
+	conn := newFakeConn(connectivity.Ready)
+	gauge := prometheus.NewGauge(prometheus.GaugeOpts{Name: "test_connection_status"})
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		watchConnState(context.Background(), conn, gauge, 1)
+	}()
+
+	conn.setState(connectivity.Shutdown)
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("watchConnState did not return once the connection shut down")
+	}
+	assert.Equal(t, float64(0), testutil.ToFloat64(gauge))
+}
@@ -9,7 +9,9 @@ import (
 	"time"
 
 	"github.com/lib/pq"
+	"github.com/pkg/errors"
 	uuid "github.com/satori/go.uuid"
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
 	"github.com/smartcontractkit/chainlink/core/internal/testutils/keystest"
 	"github.com/smartcontractkit/chainlink/core/services/feeds"
 	"github.com/smartcontractkit/chainlink/core/services/feeds/mocks"
@@ -21,6 +23,7 @@ import (
 	ksmocks "github.com/smartcontractkit/chainlink/core/services/keystore/mocks"
 	"github.com/smartcontractkit/chainlink/core/services/postgres"
 	pgmocks "github.com/smartcontractkit/chainlink/core/services/postgres/mocks"
+	webhookmocks "github.com/smartcontractkit/chainlink/core/services/webhook/mocks"
 	"github.com/smartcontractkit/chainlink/core/store/models"
 	"github.com/smartcontractkit/chainlink/core/utils/crypto"
 	"github.com/stretchr/testify/assert"
@@ -37,6 +40,7 @@ type TestService struct {
 	fmsClient   *mocks.FeedsManagerClient
 	csaKeystore *ksmocks.CSAKeystoreInterface
 	ethKeystore *ksmocks.EthKeyStoreInterface
+	eiManager   *webhookmocks.ExternalInitiatorManager
 	cfg         *mocks.Config
 }
 
@@ -48,6 +52,7 @@ func setupTestService(t *testing.T) *TestService {
 		fmsClient   = &mocks.FeedsManagerClient{}
 		csaKeystore = &ksmocks.CSAKeystoreInterface{}
 		ethKeystore = &ksmocks.EthKeyStoreInterface{}
+		eiManager   = &webhookmocks.ExternalInitiatorManager{}
 		cfg         = &mocks.Config{}
 	)
 
@@ -59,11 +64,12 @@ func setupTestService(t *testing.T) *TestService {
 			fmsClient,
 			csaKeystore,
 			ethKeystore,
+			eiManager,
 			cfg,
 		)
 	})
 
-	svc := feeds.NewService(orm, txm, spawner, csaKeystore, ethKeystore, cfg)
+	svc := feeds.NewService(orm, txm, spawner, csaKeystore, ethKeystore, eiManager, cfg)
 	svc.SetFMSClient(fmsClient)
 
 	return &TestService{
@@ -74,6 +80,7 @@ func setupTestService(t *testing.T) *TestService {
 		fmsClient:   fmsClient,
 		csaKeystore: csaKeystore,
 		ethKeystore: ethKeystore,
+		eiManager:   eiManager,
 		cfg:         cfg,
 	}
 }
@@ -103,7 +110,7 @@ func Test_Service_RegisterManager(t *testing.T) {
 	svc.orm.On("CreateManager", context.Background(), &ms).
 		Return(id, nil)
 	svc.csaKeystore.On("ListCSAKeys").Return([]csakey.Key{key}, nil)
-	svc.csaKeystore.On("Unsafe_GetUnlockedPrivateKey", pubKey).Return([]byte(privkey), nil)
+	svc.csaKeystore.On("Unsafe_GetUnlockedPrivateKey", mock.Anything, pubKey).Return([]byte(privkey), nil)
 	// ListManagers runs in a goroutine so it might be called.
 	svc.orm.On("ListManagers", context.Background()).Return([]feeds.FeedsManager{ms}, nil).Maybe()
 
@@ -116,6 +123,52 @@ func Test_Service_RegisterManager(t *testing.T) {
 	assert.Equal(t, actual, id)
 }
 
+func Test_Service_RegisterManager_PinnedCSAKey(t *testing.T) {
+	t.Parallel()
+
+	keys := []csakey.Key{}
+	privkeys := [][]byte{}
+	for i := 1; i <= 2; i++ {
+		pubkey, privkey, err := ed25519.GenerateKey(nil)
+		require.NoError(t, err)
+		keys = append(keys, csakey.Key{ID: uint(i), PublicKey: crypto.PublicKey(pubkey)})
+		privkeys = append(privkeys, privkey)
+	}
+
+	t.Run("connects with the pinned key", func(t *testing.T) {
+		var (
+			id = int64(1)
+			ms = feeds.FeedsManager{CSAKeyID: null.IntFrom(2)}
+		)
+		svc := setupTestService(t)
+
+		svc.orm.On("CountManagers").Return(int64(0), nil)
+		svc.orm.On("CreateManager", context.Background(), &ms).Return(id, nil)
+		svc.csaKeystore.On("ListCSAKeys").Return(keys, nil)
+		svc.csaKeystore.On("Unsafe_GetUnlockedPrivateKey", mock.Anything, keys[1].PublicKey).Return(privkeys[1], nil)
+		// ListManagers runs in a goroutine so it might be called.
+		svc.orm.On("ListManagers", context.Background()).Return([]feeds.FeedsManager{ms}, nil).Maybe()
+
+		actual, err := svc.RegisterManager(&ms)
+		defer svc.Close()
+		require.NoError(t, err)
+		assert.Equal(t, id, actual)
+		svc.csaKeystore.AssertExpectations(t)
+	})
+
+	t.Run("rejects a key which does not exist", func(t *testing.T) {
+		ms := feeds.FeedsManager{CSAKeyID: null.IntFrom(3)}
+		svc := setupTestService(t)
+
+		svc.orm.On("CountManagers").Return(int64(0), nil)
+		svc.csaKeystore.On("ListCSAKeys").Return(keys, nil)
+
+		_, err := svc.RegisterManager(&ms)
+		require.EqualError(t, err, "CSA key 3 does not exist")
+		svc.orm.AssertNotCalled(t, "CreateManager", mock.Anything, mock.Anything)
+	})
+}
+
 func Test_Service_ListManagers(t *testing.T) {
 	t.Parallel()
 
@@ -161,6 +214,8 @@ func Test_Service_CreateJobProposal(t *testing.T) {
 	)
 	svc := setupTestService(t)
 
+	svc.orm.On("ListChainConfigsByManagerID", context.Background(), jp.FeedsManagerID).
+		Return([]feeds.ChainConfig{}, nil)
 	svc.orm.On("CreateJobProposal", context.Background(), &jp).
 		Return(id, nil)
 
@@ -170,6 +225,137 @@ func Test_Service_CreateJobProposal(t *testing.T) {
 	assert.Equal(t, actual, id)
 }
 
+func Test_Service_CreateJobProposal_TargetsChain(t *testing.T) {
+	t.Parallel()
+
+	var (
+		mgrID = int64(1)
+		cfg1  = feeds.ChainConfig{ID: 1, FeedsManagerID: mgrID, ChainID: 1}
+		cfg2  = feeds.ChainConfig{ID: 2, FeedsManagerID: mgrID, ChainID: 42}
+	)
+
+	testCases := []struct {
+		name        string
+		cfgs        []feeds.ChainConfig
+		chainID     null.Int
+		wantChainID null.Int
+		wantErr     string
+	}{
+		{
+			name:        "targets the only configured chain",
+			cfgs:        []feeds.ChainConfig{cfg1},
+			wantChainID: null.IntFrom(1),
+		},
+		{
+			name:        "accepts a configured chain",
+			cfgs:        []feeds.ChainConfig{cfg1, cfg2},
+			chainID:     null.IntFrom(42),
+			wantChainID: null.IntFrom(42),
+		},
+		{
+			name:    "requires a chain when multiple are configured",
+			cfgs:    []feeds.ChainConfig{cfg1, cfg2},
+			wantErr: "job proposal must specify a chain when the feeds manager has multiple chain configs",
+		},
+		{
+			name:    "rejects a chain which is not configured",
+			cfgs:    []feeds.ChainConfig{cfg1},
+			chainID: null.IntFrom(42),
+			wantErr: "chain 42: chain is not configured for the feeds manager",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			svc := setupTestService(t)
+
+			jp := feeds.JobProposal{
+				FeedsManagerID: mgrID,
+				ChainID:        tc.chainID,
+			}
+
+			svc.orm.On("ListChainConfigsByManagerID", context.Background(), mgrID).
+				Return(tc.cfgs, nil)
+
+			if tc.wantErr != "" {
+				_, err := svc.CreateJobProposal(&jp)
+				require.EqualError(t, err, tc.wantErr)
+
+				return
+			}
+
+			svc.orm.On("CreateJobProposal", context.Background(), &jp).
+				Return(int64(1), nil)
+
+			_, err := svc.CreateJobProposal(&jp)
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantChainID, jp.ChainID)
+		})
+	}
+}
+
+func Test_Service_CreateChainConfig(t *testing.T) {
+	rawKey, err := keystest.NewKey()
+	require.NoError(t, err)
+	var (
+		cfg = feeds.ChainConfig{
+			FeedsManagerID: 1,
+			ChainID:        42,
+			AccountAddress: rawKey.Address,
+		}
+		chainID    = big.NewInt(1)
+		sendingKey = ethkey.Key{
+			Address:   ethkey.EIP55AddressFromAddress(rawKey.Address),
+			IsFunding: false,
+		}
+	)
+
+	svc := setupTestService(t)
+
+	svc.ethKeystore.On("HasSendingKeyWithAddress", cfg.AccountAddress).Return(true, nil)
+	svc.orm.On("UpsertChainConfig", context.Background(), &cfg).Return(int64(1), nil)
+
+	// Syncs the node info with the new chain config
+	svc.orm.On("GetManager", context.Background(), cfg.FeedsManagerID).Return(&feeds.FeedsManager{ID: cfg.FeedsManagerID}, nil)
+	svc.ethKeystore.On("SendingKeys").Return([]ethkey.Key{sendingKey}, nil)
+	svc.cfg.On("ChainID").Return(chainID)
+	svc.orm.On("ListChainConfigsByManagerID", context.Background(), cfg.FeedsManagerID).
+		Return([]feeds.ChainConfig{cfg}, nil)
+	svc.fmsClient.On("UpdateNode", context.Background(), &proto.UpdateNodeRequest{
+		JobTypes:         []proto.JobType{},
+		ChainId:          chainID.Int64(),
+		AccountAddresses: []string{sendingKey.Address.String()},
+		ChainConfigs: []*proto.ChainConfig{
+			{ChainId: cfg.ChainID, AccountAddress: cfg.AccountAddress.Hex()},
+		},
+	}).Return(&proto.UpdateNodeResponse{}, nil)
+
+	id, err := svc.CreateChainConfig(cfg)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), id)
+}
+
+func Test_Service_CreateChainConfig_UnknownAddress(t *testing.T) {
+	t.Parallel()
+
+	cfg := feeds.ChainConfig{
+		FeedsManagerID: 1,
+		ChainID:        42,
+		AccountAddress: cltest.NewAddress(),
+	}
+
+	svc := setupTestService(t)
+
+	svc.ethKeystore.On("HasSendingKeyWithAddress", cfg.AccountAddress).Return(false, nil)
+
+	_, err := svc.CreateChainConfig(cfg)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "no sending key exists with address")
+}
+
 func Test_Service_SyncNodeInfo(t *testing.T) {
 	rawKey, err := keystest.NewKey()
 	require.NoError(t, err)
@@ -178,7 +364,7 @@ func Test_Service_SyncNodeInfo(t *testing.T) {
 		multiaddr = "/dns4/chain.link/tcp/1234/p2p/16Uiu2HAm58SP7UL8zsnpeuwHfytLocaqgnyaYKP8wu7qRdrixLju"
 		feedsMgr  = &feeds.FeedsManager{
 			ID:                        1,
-			JobTypes:                  pq.StringArray{feeds.JobTypeFluxMonitor},
+			JobTypes:                  pq.StringArray{feeds.JobTypeFluxMonitor, feeds.JobTypeKeeper, feeds.JobTypeWebhook},
 			IsOCRBootstrapPeer:        true,
 			OCRBootstrapPeerMultiaddr: null.StringFrom(multiaddr),
 		}
@@ -187,6 +373,14 @@ func Test_Service_SyncNodeInfo(t *testing.T) {
 			Address:   ethkey.EIP55AddressFromAddress(rawKey.Address),
 			IsFunding: false,
 		}
+		keyBundleID = models.MustSha256HashFromHex("f5bf259689b26f1374efb3c9a9868796953a0f814bb2d39b968d0e61b58620a5")
+		chainCfg    = feeds.ChainConfig{
+			ID:             1,
+			FeedsManagerID: 1,
+			ChainID:        1,
+			AccountAddress: rawKey.Address,
+			OCRKeyBundleID: &keyBundleID,
+		}
 	)
 
 	svc := setupTestService(t)
@@ -195,14 +389,26 @@ func Test_Service_SyncNodeInfo(t *testing.T) {
 	svc.orm.On("GetManager", ctx, feedsMgr.ID).Return(feedsMgr, nil)
 	svc.ethKeystore.On("SendingKeys").Return([]ethkey.Key{sendingKey}, nil)
 	svc.cfg.On("ChainID").Return(chainID)
+	svc.orm.On("ListChainConfigsByManagerID", ctx, feedsMgr.ID).Return([]feeds.ChainConfig{chainCfg}, nil)
 
 	// Mock the send
 	svc.fmsClient.On("UpdateNode", ctx, &proto.UpdateNodeRequest{
-		JobTypes:           []proto.JobType{proto.JobType_JOB_TYPE_FLUX_MONITOR},
+		JobTypes: []proto.JobType{
+			proto.JobType_JOB_TYPE_FLUX_MONITOR,
+			proto.JobType_JOB_TYPE_KEEPER,
+			proto.JobType_JOB_TYPE_WEBHOOK,
+		},
 		ChainId:            chainID.Int64(),
 		AccountAddresses:   []string{sendingKey.Address.String()},
 		IsBootstrapPeer:    true,
 		BootstrapMultiaddr: multiaddr,
+		ChainConfigs: []*proto.ChainConfig{
+			{
+				ChainId:        chainCfg.ChainID,
+				AccountAddress: sendingKey.Address.Hex(),
+				OcrKeyBundleId: chainCfg.OCRKeyBundleID.String(),
+			},
+		},
 	}).Return(&proto.UpdateNodeResponse{}, nil)
 
 	err = svc.SyncNodeInfo(feedsMgr.ID)
@@ -316,6 +522,211 @@ func Test_Service_ApproveJobProposal(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func Test_Service_ApproveJobProposal_NonOCRJobTypes(t *testing.T) {
+	externalJobID := uuid.Must(uuid.FromString("00000000-0000-0000-0000-000000000002"))
+
+	testCases := []struct {
+		name      string
+		spec      string
+		jobType   job.Type
+		setupMock func(svc *TestService)
+	}{
+		{
+			name: "keeper",
+			spec: `type = "keeper"
+			schemaVersion = 1
+			name = "example keeper spec"
+			contractAddress = "0x9E40733cC9df84636505f4e6Db28DCa0dC5D1bba"
+			fromAddress = "0xa8037A20989AFcBC51798de9762b351D63ff462e"
+			externalJobID = "00000000-0000-0000-0000-000000000002"
+			`,
+			jobType:   job.Keeper,
+			setupMock: func(svc *TestService) {},
+		},
+		{
+			name: "webhook",
+			spec: `type = "webhook"
+			schemaVersion = 1
+			name = "example webhook spec"
+			externalJobID = "00000000-0000-0000-0000-000000000002"
+			externalInitiators = [
+				{ name = "bitcoin", spec = '{"foo": "bar"}' }
+			]
+			observationSource = """
+			ds [type=http method=GET url="https://chain.link/ETH-USD"];
+			"""
+			`,
+			jobType: job.Webhook,
+			setupMock: func(svc *TestService) {
+				svc.eiManager.On("FindExternalInitiatorByName", "bitcoin").
+					Return(models.ExternalInitiator{ID: 1}, nil)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			var (
+				ctx = context.Background()
+				jp  = &feeds.JobProposal{
+					ID:         1,
+					RemoteUUID: uuid.NewV4(),
+					Status:     feeds.JobProposalStatusPending,
+					Spec:       tc.spec,
+				}
+			)
+
+			svc := setupTestService(t)
+			tc.setupMock(svc)
+
+			svc.orm.On("GetJobProposal", ctx, jp.ID).Return(jp, nil)
+			ctx = mockTransactWithContext(ctx, svc.txm)
+
+			svc.spawner.
+				On("CreateJob",
+					ctx,
+					mock.MatchedBy(func(j job.Job) bool {
+						return j.Type == tc.jobType
+					}),
+					mock.Anything,
+				).
+				Return(job.Job{ID: 1}, nil)
+			svc.orm.On("ApproveJobProposal",
+				mock.MatchedBy(func(ctx context.Context) bool { return true }),
+				jp.ID,
+				externalJobID,
+				feeds.JobProposalStatusApproved,
+			).Return(nil)
+			svc.fmsClient.On("ApprovedJob",
+				mock.MatchedBy(func(ctx context.Context) bool { return true }),
+				&proto.ApprovedJobRequest{
+					Uuid: jp.RemoteUUID.String(),
+				},
+			).Return(&proto.ApprovedJobResponse{}, nil)
+
+			err := svc.ApproveJobProposal(ctx, jp.ID)
+			require.NoError(t, err)
+		})
+	}
+}
+
+func Test_Service_ApproveJobProposals(t *testing.T) {
+	var (
+		ctx = context.Background()
+		jp  = &feeds.JobProposal{
+			ID:         1,
+			RemoteUUID: uuid.NewV4(),
+			Status:     feeds.JobProposalStatusPending,
+			Spec: `name = 'LINK / ETH | version 3 | contract 0x0000000000000000000000000000000000000000'
+			schemaVersion = 1
+			contractAddress = '0x0000000000000000000000000000000000000000'
+			type = 'fluxmonitor'
+			externalJobID = '00000000-0000-0000-0000-000000000001'
+			threshold = 1.0
+			idleTimerPeriod = '4h'
+			idleTimerDisabled = false
+			pollingTimerPeriod = '1m'
+			pollingTimerDisabled = false
+			observationSource = """
+			ds1 [type=bridge name="bridge-api0"];
+			ds1_parse [type=jsonparse path="result"];
+			ds1 -> ds1_parse -> answer1;
+
+			answer1 [type=median index=0];
+			"""
+			`,
+		}
+		approvedJP = &feeds.JobProposal{
+			ID:         2,
+			RemoteUUID: uuid.NewV4(),
+			Status:     feeds.JobProposalStatusApproved,
+		}
+		jb = job.Job{
+			ID: int32(1),
+		}
+	)
+
+	t.Run("approves all proposals", func(t *testing.T) {
+		svc := setupTestService(t)
+
+		svc.orm.On("GetJobProposal", ctx, jp.ID).Return(jp, nil)
+		txCtx := mockTransactWithContext(ctx, svc.txm)
+
+		svc.cfg.On("DefaultHTTPTimeout").Return(models.MakeDuration(1 * time.Minute))
+		svc.spawner.
+			On("CreateJob",
+				txCtx,
+				mock.MatchedBy(func(j job.Job) bool {
+					return true
+				}),
+				null.StringFrom("LINK / ETH | version 3 | contract 0x0000000000000000000000000000000000000000"),
+			).
+			Return(jb, nil)
+		svc.orm.On("ApproveJobProposal",
+			mock.MatchedBy(func(ctx context.Context) bool { return true }),
+			jp.ID,
+			uuid.Must(uuid.FromString("00000000-0000-0000-0000-000000000001")),
+			feeds.JobProposalStatusApproved,
+		).Return(nil)
+		svc.fmsClient.On("ApprovedJob",
+			mock.MatchedBy(func(ctx context.Context) bool { return true }),
+			&proto.ApprovedJobRequest{
+				Uuid: jp.RemoteUUID.String(),
+			},
+		).Return(&proto.ApprovedJobResponse{}, nil)
+
+		results, err := svc.ApproveJobProposals(ctx, []int64{jp.ID})
+		require.NoError(t, err)
+		require.Len(t, results, 1)
+		assert.Equal(t, jp.ID, results[0].ID)
+		assert.True(t, results[0].Approved)
+		assert.NoError(t, results[0].Err)
+	})
+
+	t.Run("approves none when a proposal is not pending", func(t *testing.T) {
+		svc := setupTestService(t)
+
+		svc.orm.On("GetJobProposal", ctx, jp.ID).Return(jp, nil)
+		svc.orm.On("GetJobProposal", ctx, approvedJP.ID).Return(approvedJP, nil)
+		svc.cfg.On("DefaultHTTPTimeout").Return(models.MakeDuration(1 * time.Minute))
+
+		results, err := svc.ApproveJobProposals(ctx, []int64{jp.ID, approvedJP.ID})
+		require.Error(t, err)
+		assert.True(t, errors.Is(err, feeds.ErrBulkApprovalFailed))
+		require.Len(t, results, 2)
+		assert.False(t, results[0].Approved)
+		assert.NoError(t, results[0].Err)
+		assert.False(t, results[1].Approved)
+		assert.EqualError(t, results[1].Err, "must be a pending job proposal")
+
+		svc.txm.AssertNotCalled(t, "TransactWithContext", mock.Anything, mock.Anything)
+		svc.spawner.AssertNotCalled(t, "CreateJob", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("rolls back when a job cannot be created", func(t *testing.T) {
+		svc := setupTestService(t)
+
+		svc.orm.On("GetJobProposal", ctx, jp.ID).Return(jp, nil)
+		txCtx := mockTransactWithContext(ctx, svc.txm)
+
+		svc.cfg.On("DefaultHTTPTimeout").Return(models.MakeDuration(1 * time.Minute))
+		svc.spawner.
+			On("CreateJob", txCtx, mock.Anything, mock.Anything).
+			Return(job.Job{}, errors.New("duplicate job"))
+
+		results, err := svc.ApproveJobProposals(ctx, []int64{jp.ID})
+		require.Error(t, err)
+		assert.True(t, errors.Is(err, feeds.ErrBulkApprovalFailed))
+		require.Len(t, results, 1)
+		assert.False(t, results[0].Approved)
+		assert.EqualError(t, results[0].Err, "could not create job: duplicate job")
+
+		svc.fmsClient.AssertNotCalled(t, "ApprovedJob", mock.Anything, mock.Anything)
+	})
+}
+
 func Test_Service_RejectJobProposal(t *testing.T) {
 	var (
 		ctx = context.Background()
@@ -390,8 +801,9 @@ func Test_Service_StartStop(t *testing.T) {
 	svc := setupTestService(t)
 
 	svc.csaKeystore.On("ListCSAKeys").Return([]csakey.Key{key}, nil)
-	svc.csaKeystore.On("Unsafe_GetUnlockedPrivateKey", pubKey).Return([]byte(privkey), nil)
+	svc.csaKeystore.On("Unsafe_GetUnlockedPrivateKey", mock.Anything, pubKey).Return([]byte(privkey), nil)
 	svc.orm.On("ListManagers", context.Background()).Return([]feeds.FeedsManager{ms}, nil)
+	svc.cfg.On("FeedsJobProposalRetention").Return(time.Duration(0))
 
 	err = svc.Start()
 	require.NoError(t, err)
@@ -399,6 +811,43 @@ func Test_Service_StartStop(t *testing.T) {
 	svc.Close()
 }
 
+func Test_Service_PurgesArchivedJobProposals(t *testing.T) {
+	_, privkey, err := ed25519.GenerateKey(nil)
+	require.NoError(t, err)
+
+	svc := setupTestService(t)
+
+	key := csakey.Key{PublicKey: crypto.PublicKey(privkey.Public().(ed25519.PublicKey))}
+	svc.csaKeystore.On("ListCSAKeys").Return([]csakey.Key{key}, nil)
+	svc.csaKeystore.On("Unsafe_GetUnlockedPrivateKey", mock.Anything, key.PublicKey).Return([]byte(privkey), nil)
+	svc.orm.On("ListManagers", context.Background()).Return([]feeds.FeedsManager{{}}, nil)
+	svc.cfg.On("FeedsJobProposalRetention").Return(24 * time.Hour)
+
+	purged := make(chan struct{})
+	svc.orm.On("PurgeArchivedJobProposals", mock.Anything, 24*time.Hour).
+		Return(int64(1), nil).
+		Once().
+		Run(func(mock.Arguments) { close(purged) })
+
+	require.NoError(t, svc.Start())
+	defer svc.Close()
+
+	select {
+	case <-purged:
+	case <-time.After(5 * time.Second):
+		t.Fatal("archived job proposals were not purged on start")
+	}
+}
+
+func Test_Service_ArchiveJobProposal(t *testing.T) {
+	svc := setupTestService(t)
+
+	svc.orm.On("ArchiveJobProposal", mock.Anything, int64(1)).Return(nil)
+
+	err := svc.ArchiveJobProposal(context.Background(), 1)
+	require.NoError(t, err)
+}
+
 func mockTransactWithContext(ctx context.Context, txm *pgmocks.TransactionManager) context.Context {
 	call := txm.On("TransactWithContext",
 		mock.MatchedBy(func(ctx context.Context) bool { return true }),
@@ -60,6 +60,15 @@ var (
 		[]string{},
 	)
 
+	SubmissionLatency = promauto.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "flux_monitor_submission_latency_seconds",
+			Help:    "Flux monitor's histogram of the time from the start of a round to queueing the node's answer for it",
+			Buckets: []float64{1, 2, 5, 10, 20, 30, 60, 120, 300, 600},
+		},
+		[]string{"job_id", "contract_address"},
+	)
+
 	ResponseSize = promauto.NewHistogram(
 		prometheus.HistogramOpts{
 			Name:    "flux_monitor_response_size_bytes",
@@ -0,0 +1,86 @@
+package fluxmonitorv2
+
+import (
+	"context"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/internal/gethwrappers/generated/flux_aggregator_wrapper"
+	"github.com/smartcontractkit/chainlink/core/services/eth"
+)
+
+//go:generate mockery --name AccessChecker --output ./mocks/ --case=underscore
+
+// AccessRecheckInterval is how often a flux monitor checks whether access to
+// its contract has been granted or revoked
+const AccessRecheckInterval = 5 * time.Minute
+
+const accessCheckTimeout = 30 * time.Second
+
+// ErrNotAuthorized is returned when the node's oracle address may not submit
+// to the contract
+var ErrNotAuthorized = errors.New("not authorized")
+
+// latestRoundDataCalldata is the read the flux monitor makes on every poll,
+// which access controlled aggregators only allow for granted addresses
+var latestRoundDataCalldata = crypto.Keccak256([]byte("latestRoundData()"))[:4]
+
+// AccessChecker checks whether the oracle address may submit to the contract
+type AccessChecker interface {
+	CheckAccess(oracleAddress common.Address) error
+}
+
+// ContractAccessChecker checks that the oracle address is one of the
+// contract's oracles and, if the contract is an AccessControlledAggregator,
+// that it has been granted read access.
+type ContractAccessChecker struct {
+	fluxAggregator flux_aggregator_wrapper.FluxAggregatorInterface
+	ethClient      eth.Client
+}
+
+var _ AccessChecker = (*ContractAccessChecker)(nil)
+
+// NewContractAccessChecker constructs a new ContractAccessChecker
+func NewContractAccessChecker(
+	fluxAggregator flux_aggregator_wrapper.FluxAggregatorInterface,
+	ethClient eth.Client,
+) *ContractAccessChecker {
+	return &ContractAccessChecker{
+		fluxAggregator: fluxAggregator,
+		ethClient:      ethClient,
+	}
+}
+
+// CheckAccess returns an error wrapping ErrNotAuthorized if the oracle address
+// may not submit to the contract, or any other error if the check itself
+// failed.
+func (c *ContractAccessChecker) CheckAccess(oracleAddress common.Address) error {
+	oracles, err := c.fluxAggregator.GetOracles(nil)
+	if err != nil {
+		return errors.Wrap(err, "failed to get list of oracles from FluxAggregator contract")
+	}
+	isOracle := false
+	for _, oracle := range oracles {
+		if oracle == oracleAddress {
+			isOracle = true
+			break
+		}
+	}
+	if !isOracle {
+		return errors.Wrapf(ErrNotAuthorized, "oracle address %s is not one of the contract's oracles", oracleAddress.Hex())
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), accessCheckTimeout)
+	defer cancel()
+	hasAccess, err := eth.HasAccess(ctx, c.ethClient, c.fluxAggregator.Address(), oracleAddress, latestRoundDataCalldata)
+	if err != nil {
+		return err
+	}
+	if !hasAccess {
+		return errors.Wrapf(ErrNotAuthorized, "oracle address %s has not been granted access by the contract's access controller", oracleAddress.Hex())
+	}
+	return nil
+}
@@ -0,0 +1,80 @@
+package fluxmonitorv2_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/internal/mocks"
+	"github.com/smartcontractkit/chainlink/core/services/fluxmonitorv2"
+)
+
+func TestContractAccessChecker_CheckAccess(t *testing.T) {
+	t.Parallel()
+
+	oracle := cltest.NewAddress()
+	contract := cltest.NewAddress()
+
+	accessControlledCode := []byte{0x60, 0x80, 0x63, 0x6b, 0x14, 0xda, 0xf8, 0x14}
+	uncontrolledCode := []byte{0x60, 0x80, 0x63, 0xfe, 0xaf, 0x96, 0x8c, 0x14}
+
+	testCases := []struct {
+		name           string
+		oracles        []common.Address
+		code           []byte
+		hasAccessOut   []byte
+		hasAccessErr   error
+		callsHasAccess bool
+		wantCause      error
+		wantErr        bool
+	}{
+		{"not an oracle", []common.Address{cltest.NewAddress()}, nil, nil, nil, false, fluxmonitorv2.ErrNotAuthorized, true},
+		{"access granted", []common.Address{oracle}, accessControlledCode, common.LeftPadBytes([]byte{1}, 32), nil, true, nil, false},
+		{"access not granted", []common.Address{oracle}, accessControlledCode, common.LeftPadBytes([]byte{0}, 32), nil, true, fluxmonitorv2.ErrNotAuthorized, true},
+		{"not access controlled", []common.Address{oracle}, uncontrolledCode, nil, nil, false, nil, false},
+		{"hasAccess reverted", []common.Address{oracle}, accessControlledCode, nil, errors.New("execution reverted"), true, fluxmonitorv2.ErrNotAuthorized, true},
+		{"hasAccess returned nothing", []common.Address{oracle}, accessControlledCode, []byte{}, nil, true, nil, true},
+		{"call failed", []common.Address{oracle}, accessControlledCode, nil, errors.New("connection refused"), true, nil, true},
+		{"no contract", []common.Address{oracle}, []byte{}, nil, nil, false, nil, true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			fluxAggregator := new(mocks.FluxAggregator)
+			ethClient := new(mocks.Client)
+
+			fluxAggregator.On("GetOracles", nilOpts).Return(tc.oracles, nil)
+			if tc.code != nil {
+				fluxAggregator.On("Address").Return(contract)
+				ethClient.On("CodeAt", mock.Anything, contract, (*big.Int)(nil)).Return(tc.code, nil)
+			}
+			if tc.callsHasAccess {
+				ethClient.On("CallContract", mock.Anything, mock.MatchedBy(func(msg ethereum.CallMsg) bool {
+					return *msg.To == contract
+				}), (*big.Int)(nil)).Return(tc.hasAccessOut, tc.hasAccessErr)
+			}
+
+			err := fluxmonitorv2.NewContractAccessChecker(fluxAggregator, ethClient).CheckAccess(oracle)
+			if tc.wantErr {
+				assert.Error(t, err)
+				if tc.wantCause != nil {
+					assert.True(t, errors.Is(err, tc.wantCause))
+				} else {
+					assert.False(t, errors.Is(err, fluxmonitorv2.ErrNotAuthorized))
+				}
+			} else {
+				assert.NoError(t, err)
+			}
+
+			fluxAggregator.AssertExpectations(t)
+			ethClient.AssertExpectations(t)
+		})
+	}
+}
@@ -73,7 +73,7 @@ func (d *Delegate) ServicesForSpec(spec job.Job) (services []job.Service, err er
 		NewORM(d.db, d.txm, strategy),
 		d.jobORM,
 		d.pipelineORM,
-		NewKeyStore(d.ethKeyStore),
+		NewKeyStore(d.ethKeyStore, spec.FluxMonitorSpec.ContractAddress.Address()),
 		d.ethClient,
 		d.logBroadcaster,
 		d.pipelineRunner,
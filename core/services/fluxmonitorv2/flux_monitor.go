@@ -14,6 +14,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/internal/gethwrappers/generated/flags_wrapper"
 	"github.com/smartcontractkit/chainlink/core/internal/gethwrappers/generated/flux_aggregator_wrapper"
 	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/bulletprooftxmanager"
 	"github.com/smartcontractkit/chainlink/core/services/eth"
 	"github.com/smartcontractkit/chainlink/core/services/fluxmonitor/promfm"
 	"github.com/smartcontractkit/chainlink/core/services/job"
@@ -67,6 +68,11 @@ type FluxMonitor struct {
 	flags             Flags
 	fluxAggregator    flux_aggregator_wrapper.FluxAggregatorInterface
 	logBroadcaster    log.Broadcaster
+	accessChecker     AccessChecker
+
+	// authorized is false while the access checker reports that the oracle
+	// address may not submit to the contract
+	authorized bool
 
 	logger *logger.Logger
 
@@ -97,6 +103,7 @@ func NewFluxMonitor(
 	flags Flags,
 	fluxAggregator flux_aggregator_wrapper.FluxAggregatorInterface,
 	logBroadcaster log.Broadcaster,
+	accessChecker AccessChecker,
 	fmLogger *logger.Logger,
 ) (*FluxMonitor, error) {
 	fm := &FluxMonitor{
@@ -117,6 +124,8 @@ func NewFluxMonitor(
 		flags:             flags,
 		logBroadcaster:    logBroadcaster,
 		fluxAggregator:    fluxAggregator,
+		accessChecker:     accessChecker,
+		authorized:        true,
 		logger:            fmLogger,
 		backlog: utils.NewBoundedPriorityQueue(map[uint]uint{
 			// We want reconnecting nodes to be able to submit to a round
@@ -248,6 +257,7 @@ func NewFromJobSpec(
 		*flags,
 		fluxAggregator,
 		logBroadcaster,
+		NewContractAccessChecker(fluxAggregator, ethClient),
 		fmLogger,
 	)
 }
@@ -389,6 +399,16 @@ func (fm *FluxMonitor) consume() {
 		defer unsubscribe()
 	}
 
+	// A nil channel never fires, so access is only rechecked with a checker
+	var accessRecheckTicks <-chan time.Time
+	if fm.accessChecker != nil {
+		fm.checkAccess()
+
+		ticker := time.NewTicker(AccessRecheckInterval)
+		defer ticker.Stop()
+		accessRecheckTicks = ticker.C
+	}
+
 	fm.pollManager.Start(fm.IsHibernating(), fm.initialRoundState())
 
 	tickLogger := fm.logger.With(
@@ -404,6 +424,9 @@ func (fm *FluxMonitor) consume() {
 		case <-fm.chProcessLogs:
 			fm.processLogs()
 
+		case <-accessRecheckTicks:
+			fm.recheckAccess()
+
 		case <-fm.pollManager.PollTickerTicks():
 			tickLogger.Debug("Poll ticker fired")
 			fm.pollIfEligible(PollRequestTypePoll, fm.deviationChecker, nil)
@@ -439,6 +462,44 @@ func (fm *FluxMonitor) consume() {
 	}
 }
 
+// checkAccess checks whether the oracle address may submit to the contract,
+// recording a job error when the node is found not to be authorized. Failed
+// checks leave the previous state as is.
+func (fm *FluxMonitor) checkAccess() {
+	err := fm.accessChecker.CheckAccess(fm.oracleAddress)
+	switch {
+	case err == nil:
+		if !fm.authorized {
+			fm.logger.Infow("Oracle address has been authorized to submit to the contract, resuming submissions", "oracleAddress", fm.oracleAddress.Hex())
+		}
+		fm.authorized = true
+	case errors.Is(err, ErrNotAuthorized):
+		if fm.authorized {
+			fm.logger.Errorw("Oracle address is not authorized to submit to the contract, pausing submissions until access is granted", "err", err)
+			fm.jobORM.RecordError(context.Background(), fm.spec.JobID, fmt.Sprintf("Not authorized: %v", err))
+		}
+		fm.authorized = false
+	default:
+		fm.logger.Warnw("Unable to check whether the oracle address is authorized to submit to the contract", "err", err)
+	}
+}
+
+// recheckAccess picks up access being granted or revoked on the contract,
+// polling straight away if the node has just been authorized
+func (fm *FluxMonitor) recheckAccess() {
+	wasAuthorized := fm.authorized
+	if !wasAuthorized {
+		// one of the node's other keys may have been added as an oracle
+		if err := fm.SetOracleAddress(); err != nil {
+			fm.logger.Warnw("unable to set oracle address", "err", err)
+		}
+	}
+	fm.checkAccess()
+	if !wasAuthorized && fm.authorized {
+		fm.pollIfEligible(PollRequestTypePoll, fm.deviationChecker, nil)
+	}
+}
+
 // SetOracleAddress sets the oracle address which matches the node's keys.
 // If none match, it uses the first available key
 func (fm *FluxMonitor) SetOracleAddress() error {
@@ -637,6 +698,11 @@ func (fm *FluxMonitor) respondToNewRoundLog(log flux_aggregator_wrapper.FluxAggr
 	// We always want to reset the idle timer upon receiving a NewRound log, so we do it before any `return` statements.
 	fm.pollManager.ResetIdleTimer(log.StartedAt.Uint64())
 
+	if !fm.authorized {
+		newRoundLogger.Warn("Ignoring new round request: oracle address is not authorized to submit to the contract")
+		return
+	}
+
 	mostRecentRoundID, err := fm.orm.MostRecentFluxMonitorRoundID(fm.contractAddress)
 	if err != nil && err != gorm.ErrRecordNotFound {
 		newRoundLogger.Errorf("error fetching Flux Monitor most recent round ID from DB: %v", err)
@@ -758,8 +824,10 @@ func (fm *FluxMonitor) respondToNewRoundLog(log flux_aggregator_wrapper.FluxAggr
 	markConsumed = false
 	if err != nil {
 		newRoundLogger.Errorf("unable to create job run: %v", err)
+		fm.recordSubmissionError(err)
 		return
 	}
+	fm.recordSubmissionLatency(roundState)
 }
 
 var (
@@ -807,6 +875,11 @@ func (fm *FluxMonitor) pollIfEligible(pollReq PollRequestType, deviationChecker
 		return
 	}
 
+	if !fm.authorized {
+		l.Warnw("FluxMonitor: oracle address is not authorized to submit to the contract, skipping poll")
+		return
+	}
+
 	//
 	// Poll ticker submission logic:
 	//   - We avoid saving on-chain state wherever possible.  Therefore, we do not know which round we should be
@@ -966,26 +1039,28 @@ func (fm *FluxMonitor) pollIfEligible(pollReq PollRequestType, deviationChecker
 	markConsumed = false
 	if err != nil {
 		l.Errorw("can't create job run", "err", err)
+		fm.recordSubmissionError(err)
 		return
 	}
 
 	promfm.SetDecimal(promfm.ReportedValue.WithLabelValues(jobID), answer)
 	promfm.SetUint32(promfm.ReportedRound.WithLabelValues(jobID), roundState.RoundId)
+	fm.recordSubmissionLatency(roundState)
 }
 
-// If the answer is outside the allowable range, log an error and don't submit.
+// If the answer is outside the allowable range, log a warning and don't submit.
 // to avoid an onchain reversion.
 func (fm *FluxMonitor) isValidSubmission(l *zap.SugaredLogger, answer decimal.Decimal, started time.Time) bool {
 	if fm.submissionChecker.IsValid(answer) {
 		return true
 	}
 
-	l.Errorw("answer is outside acceptable range",
+	l.Warnw("answer is outside acceptable range",
 		"min", fm.submissionChecker.Min,
 		"max", fm.submissionChecker.Max,
 		"answer", answer,
 	)
-	fm.jobORM.RecordError(context.Background(), fm.spec.JobID, "Answer is outside acceptable range")
+	fm.jobORM.RecordWarning(context.Background(), fm.spec.JobID, "Answer is outside acceptable range")
 
 	jobId := fm.spec.JobID
 	jobName := fm.spec.JobName
@@ -1064,6 +1139,29 @@ func (fm *FluxMonitor) queueTransactionForBPTXM(
 	return nil
 }
 
+// recordSubmissionLatency records how long after the start of the round the
+// answer was queued for submission. Rounds which have yet to start on-chain,
+// because this node is starting them, are not recorded.
+func (fm *FluxMonitor) recordSubmissionLatency(roundState flux_aggregator_wrapper.OracleRoundState) {
+	if roundState.StartedAt == 0 {
+		return
+	}
+	latency := time.Since(time.Unix(int64(roundState.StartedAt), 0))
+	if latency < 0 {
+		latency = 0
+	}
+	promfm.SubmissionLatency.WithLabelValues(fmt.Sprintf("%d", fm.spec.JobID), fm.contractAddress.Hex()).Observe(latency.Seconds())
+}
+
+// recordSubmissionError records a job error if the answer could not be queued
+// for submission because the sending address is underfunded. The run is rolled
+// back in this case, so it would otherwise only show up in the logs.
+func (fm *FluxMonitor) recordSubmissionError(err error) {
+	if errors.Is(err, bulletprooftxmanager.ErrInsufficientBalance) {
+		fm.jobORM.RecordError(context.Background(), fm.spec.JobID, fmt.Sprintf("Unable to submit answer: %v", err))
+	}
+}
+
 func (fm *FluxMonitor) statsAndStatusForRound(roundID uint32) (
 	FluxMonitorRoundStatsV2,
 	pipeline.RunStatus,
@@ -139,6 +139,7 @@ type setupOptions struct {
 	drumbeatSchedule    string
 	drumbeatRandomDelay time.Duration
 	orm                 fluxmonitorv2.ORM
+	accessChecker       fluxmonitorv2.AccessChecker
 }
 
 // setup sets up a Flux Monitor for testing, allowing the test to provide
@@ -191,6 +192,7 @@ func setup(t *testing.T, db *gorm.DB, optionFns ...func(*setupOptions)) (*fluxmo
 		fluxmonitorv2.Flags{},
 		tm.fluxAggregator,
 		tm.logBroadcaster,
+		options.accessChecker,
 		logger.Default,
 	)
 	require.NoError(t, err)
@@ -236,6 +238,14 @@ func withORM(orm fluxmonitorv2.ORM) func(*setupOptions) {
 	}
 }
 
+// withAccessChecker is an option to check whether the node is authorized to
+// submit to the contract
+func withAccessChecker(accessChecker fluxmonitorv2.AccessChecker) func(*setupOptions) {
+	return func(opts *setupOptions) {
+		opts.accessChecker = accessChecker
+	}
+}
+
 // setupStoreWithKey setups a new store and adds a key to the keystore
 func setupStoreWithKey(t *testing.T) (*store.Store, common.Address) {
 	store, cleanup := cltest.NewStore(t)
@@ -482,6 +492,47 @@ func TestFluxMonitor_PollIfEligible_Creates_JobErr(t *testing.T) {
 	tm.logBroadcaster.AssertExpectations(t)
 }
 
+func TestFluxMonitor_PollIfEligible_NotAuthorized(t *testing.T) {
+	store, nodeAddr := setupStoreWithKey(t)
+	oracles := []common.Address{nodeAddr, cltest.NewAddress()}
+
+	accessChecker := new(fmmocks.AccessChecker)
+	t.Cleanup(func() { accessChecker.AssertExpectations(t) })
+	fm, tm := setup(t, store.DB, withAccessChecker(accessChecker))
+
+	tm.keyStore.On("SendingKeys").Return([]ethkey.Key{{Address: ethkey.EIP55AddressFromAddress(nodeAddr)}}, nil).Once()
+	tm.fluxAggregator.On("GetOracles", nilOpts).Return(oracles, nil).Once()
+	require.NoError(t, fm.SetOracleAddress())
+
+	notAuthorized := errors.Wrap(fluxmonitorv2.ErrNotAuthorized, "oracle address is not one of the contract's oracles")
+	accessChecker.On("CheckAccess", nodeAddr).Return(notAuthorized).Twice()
+	// recorded once, not on every check
+	tm.jobORM.On("RecordError", context.Background(), pipelineSpec.JobID, "Not authorized: "+notAuthorized.Error()).Once()
+	fm.ExportedCheckAccess()
+	fm.ExportedCheckAccess()
+
+	// does not ask the contract for the round state to submit to
+	tm.logBroadcaster.On("IsConnected").Return(true).Once()
+	fm.ExportedPollIfEligible(1, 1)
+	tm.fluxAggregator.AssertExpectations(t)
+
+	// a failed check does not change the state
+	accessChecker.On("CheckAccess", nodeAddr).Return(errors.New("connection refused")).Once()
+	fm.ExportedCheckAccess()
+	tm.logBroadcaster.On("IsConnected").Return(true).Once()
+	fm.ExportedPollIfEligible(1, 1)
+
+	// polls again once access is granted
+	accessChecker.On("CheckAccess", nodeAddr).Return(nil).Once()
+	fm.ExportedCheckAccess()
+	tm.logBroadcaster.On("IsConnected").Return(true).Once()
+	tm.fluxAggregator.On("OracleRoundState", nilOpts, nodeAddr, uint32(0)).
+		Return(flux_aggregator_wrapper.OracleRoundState{}, errors.New("err")).
+		Once()
+	tm.jobORM.On("RecordError", context.Background(), pipelineSpec.JobID, "Unable to call roundState method on provided contract. Check contract address.").Once()
+	fm.ExportedPollIfEligible(1, 1)
+}
+
 func TestPollingDeviationChecker_BuffersLogs(t *testing.T) {
 	store, nodeAddr := setupStoreWithKey(t)
 	oracles := []common.Address{nodeAddr, cltest.NewAddress()}
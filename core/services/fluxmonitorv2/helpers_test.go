@@ -10,6 +10,10 @@ func (fm *FluxMonitor) ExportedPollIfEligible(threshold, absoluteThreshold float
 	fm.pollIfEligible(PollRequestTypePoll, NewDeviationChecker(threshold, absoluteThreshold), nil)
 }
 
+func (fm *FluxMonitor) ExportedCheckAccess() {
+	fm.checkAccess()
+}
+
 func (fm *FluxMonitor) ExportedProcessLogs() {
 	fm.processLogs()
 }
@@ -2,6 +2,8 @@ package fluxmonitorv2
 
 import (
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+	"github.com/smartcontractkit/chainlink/core/services/job"
 	"github.com/smartcontractkit/chainlink/core/services/keystore"
 	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
 )
@@ -14,12 +16,48 @@ type KeyStoreInterface interface {
 	GetRoundRobinAddress(...common.Address) (common.Address, error)
 }
 
-// KeyStore implements KeyStoreInterface
+// KeyStore implements KeyStoreInterface. It only uses the sending keys whose
+// usage policy allows flux monitor jobs to submit to the contract.
 type KeyStore struct {
 	keystore.EthKeyStoreInterface
+	contractAddress common.Address
 }
 
-// NewKeyStore initializes a new keystore
-func NewKeyStore(ks keystore.EthKeyStoreInterface) *KeyStore {
-	return &KeyStore{ks}
+// NewKeyStore initializes a new keystore for the flux monitor of the
+// contract at contractAddress
+func NewKeyStore(ks keystore.EthKeyStoreInterface, contractAddress common.Address) *KeyStore {
+	return &KeyStore{ks, contractAddress}
+}
+
+// SendingKeys returns the sending keys which may submit to the contract
+func (ks *KeyStore) SendingKeys() ([]ethkey.Key, error) {
+	return ks.SendingKeysForJob(job.FluxMonitor.String(), ks.contractAddress)
+}
+
+// GetRoundRobinAddress returns the least recently used of the sending keys
+// which may submit to the contract, and are in whitelist if it is not empty
+func (ks *KeyStore) GetRoundRobinAddress(whitelist ...common.Address) (common.Address, error) {
+	keys, err := ks.SendingKeys()
+	if err != nil {
+		return common.Address{}, err
+	}
+	var addresses []common.Address
+	for _, k := range keys {
+		if len(whitelist) == 0 || containsAddress(whitelist, k.Address.Address()) {
+			addresses = append(addresses, k.Address.Address())
+		}
+	}
+	if len(addresses) == 0 {
+		return common.Address{}, errors.New("no keys available")
+	}
+	return ks.EthKeyStoreInterface.GetRoundRobinAddress(addresses...)
+}
+
+func containsAddress(addresses []common.Address, address common.Address) bool {
+	for _, a := range addresses {
+		if a == address {
+			return true
+		}
+	}
+	return false
 }
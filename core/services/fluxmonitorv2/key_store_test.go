@@ -3,8 +3,10 @@ package fluxmonitorv2_test
 import (
 	"testing"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/smartcontractkit/chainlink/core/internal/cltest"
 	"github.com/smartcontractkit/chainlink/core/services/fluxmonitorv2"
+	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
 	"github.com/stretchr/testify/require"
 )
 
@@ -15,7 +17,7 @@ func TestKeyStore_SendingKeys(t *testing.T) {
 	t.Cleanup(cleanup)
 	ethKeyStore := cltest.NewKeyStore(t, s.DB).Eth()
 
-	ks := fluxmonitorv2.NewKeyStore(ethKeyStore)
+	ks := fluxmonitorv2.NewKeyStore(ethKeyStore, cltest.NewAddress())
 
 	ethKeyStore.Unlock(cltest.Password)
 	key, err := ethKeyStore.CreateNewKey()
@@ -37,10 +39,49 @@ func TestKeyStore_GetRoundRobinAddress(t *testing.T) {
 	cltest.MustAddRandomKeyToKeystore(t, ethKeyStore, 0, true)
 	_, k0Address := cltest.MustAddRandomKeyToKeystore(t, ethKeyStore, 0)
 
-	ks := fluxmonitorv2.NewKeyStore(ethKeyStore)
+	ks := fluxmonitorv2.NewKeyStore(ethKeyStore, cltest.NewAddress())
 
 	// Gets the only address in the keystore
 	addr, err := ks.GetRoundRobinAddress()
 	require.NoError(t, err)
 	require.Equal(t, k0Address, addr)
 }
+
+func TestKeyStore_KeyPolicies(t *testing.T) {
+	t.Parallel()
+
+	s, cleanup := cltest.NewStore(t)
+	t.Cleanup(cleanup)
+	ethKeyStore := cltest.NewKeyStore(t, s.DB).Eth()
+
+	_, vrfAddress := cltest.MustAddRandomKeyToKeystore(t, ethKeyStore, 0)
+	_, fundingAddress := cltest.MustAddRandomKeyToKeystore(t, ethKeyStore, 0)
+	_, otherContractAddress := cltest.MustAddRandomKeyToKeystore(t, ethKeyStore, 0)
+	_, fmAddress := cltest.MustAddRandomKeyToKeystore(t, ethKeyStore, 0)
+
+	contract := cltest.NewAddress()
+	_, err := ethKeyStore.SetKeyPolicy(vrfAddress, ethkey.Policy{AllowedJobTypes: []string{"vrf"}})
+	require.NoError(t, err)
+	_, err = ethKeyStore.SetKeyPolicy(fundingAddress, ethkey.Policy{FundingOnly: true})
+	require.NoError(t, err)
+	_, err = ethKeyStore.SetKeyPolicy(otherContractAddress, ethkey.Policy{AllowedToAddresses: []common.Address{cltest.NewAddress()}})
+	require.NoError(t, err)
+	_, err = ethKeyStore.SetKeyPolicy(fmAddress, ethkey.Policy{AllowedJobTypes: []string{"fluxmonitor"}, AllowedToAddresses: []common.Address{contract}})
+	require.NoError(t, err)
+
+	ks := fluxmonitorv2.NewKeyStore(ethKeyStore, contract)
+
+	keys, err := ks.SendingKeys()
+	require.NoError(t, err)
+	require.Len(t, keys, 1)
+	require.Equal(t, fmAddress, keys[0].Address.Address())
+
+	for i := 0; i < 3; i++ {
+		addr, err := ks.GetRoundRobinAddress()
+		require.NoError(t, err)
+		require.Equal(t, fmAddress, addr)
+	}
+
+	_, err = ks.GetRoundRobinAddress(vrfAddress)
+	require.Error(t, err)
+}
@@ -60,8 +60,10 @@ type (
 		ctx                 context.Context
 		ctxCancel           context.CancelFunc
 
-		gasPrice   *big.Int
-		gasPriceMu sync.RWMutex
+		gasPrice    *big.Int
+		percentiles map[int]*big.Int
+		blockNumber int64
+		gasPriceMu  sync.RWMutex
 
 		logger *logger.Logger
 	}
@@ -82,6 +84,8 @@ func NewBlockHistoryEstimator(ethClient eth.Client, config Config) Estimator {
 		ctx,
 		cancel,
 		nil,
+		nil,
+		0,
 		sync.RWMutex{},
 		logger.CreateLogger(logger.Default.With("id", "block_history_estimator")),
 	}
@@ -217,6 +221,19 @@ func (b *BlockHistoryEstimator) Recalculate(head models.Head) {
 	promBlockHistoryEstimatorSetGasPrice.WithLabelValues(fmt.Sprintf("%v%%", percentile)).Set(float64(percentileGasPrice.Int64()))
 }
 
+// CurrentEstimate returns the gas price set by the last recalculation along
+// with the percentiles of the sampled blocks
+func (b *BlockHistoryEstimator) CurrentEstimate() Estimate {
+	b.gasPriceMu.RLock()
+	defer b.gasPriceMu.RUnlock()
+	return Estimate{
+		Mode:        "BlockHistory",
+		GasPrice:    b.gasPrice,
+		Percentiles: b.percentiles,
+		BlockNumber: b.blockNumber,
+	}
+}
+
 func (b *BlockHistoryEstimator) FetchBlocks(ctx context.Context, head models.Head) error {
 	// HACK: blockDelay is the number of blocks that the block history estimator trails behind head.
 	// E.g. if this is set to 3, and we receive block 10, block history estimator will
@@ -354,10 +371,16 @@ func (b *BlockHistoryEstimator) percentileGasPrice(percentile int) (*big.Int, er
 	}
 	sort.Slice(gasPrices, func(i, j int) bool { return gasPrices[i].Cmp(gasPrices[j]) < 0 })
 	idx := ((len(gasPrices) - 1) * percentile) / 100
+	percentiles := make(map[int]*big.Int)
 	for i := 0; i <= 100; i += 5 {
 		jdx := ((len(gasPrices) - 1) * i) / 100
+		percentiles[i] = gasPrices[jdx]
 		promBlockHistoryEstimatorAllPercentiles.WithLabelValues(fmt.Sprintf("%v%%", i)).Set(float64(gasPrices[jdx].Int64()))
 	}
+	b.gasPriceMu.Lock()
+	b.percentiles = percentiles
+	b.blockNumber = b.rollingBlockHistory[len(b.rollingBlockHistory)-1].Number
+	b.gasPriceMu.Unlock()
 	return gasPrices[idx], nil
 }
 
@@ -376,6 +399,7 @@ func (b *BlockHistoryEstimator) setPercentileGasPrice(gasPrice *big.Int) {
 	} else {
 		b.gasPrice = gasPrice
 	}
+	recordGasPrice(b.config, "BlockHistory", "gas_price", b.gasPrice)
 }
 
 func (b *BlockHistoryEstimator) RollingBlockHistory() []Block {
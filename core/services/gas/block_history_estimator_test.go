@@ -394,6 +394,13 @@ func TestBlockHistoryEstimator_Recalculate(t *testing.T) {
 		price := gas.GetGasPrice(bhe)
 		require.Equal(t, maxGasPrice, price)
 
+		estimate := bhe.CurrentEstimate()
+		assert.Equal(t, "BlockHistory", estimate.Mode)
+		assert.Equal(t, maxGasPrice, estimate.GasPrice)
+		assert.Equal(t, int64(1), estimate.BlockNumber)
+		assert.Equal(t, big.NewInt(9001), estimate.Percentiles[0])
+		assert.Equal(t, big.NewInt(9002), estimate.Percentiles[100])
+
 		ethClient.AssertExpectations(t)
 		config.AssertExpectations(t)
 	})
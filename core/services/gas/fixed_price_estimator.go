@@ -17,7 +17,10 @@ func NewFixedPriceEstimator(config Config) Estimator {
 	return &fixedPriceEstimator{config}
 }
 
-func (f *fixedPriceEstimator) Start() error                                       { return nil }
+func (f *fixedPriceEstimator) Start() error {
+	recordGasPrice(f.config, "FixedPrice", "gas_price", f.config.EthGasPriceDefault())
+	return nil
+}
 func (f *fixedPriceEstimator) Close() error                                       { return nil }
 func (f *fixedPriceEstimator) OnNewLongestChain(_ context.Context, _ models.Head) {}
 
@@ -30,3 +33,7 @@ func (f *fixedPriceEstimator) EstimateGas(_ []byte, gasLimit uint64, _ ...Opt) (
 func (f *fixedPriceEstimator) BumpGas(originalGasPrice *big.Int, originalGasLimit uint64) (gasPrice *big.Int, gasLimit uint64, err error) {
 	return BumpGasPriceOnly(f.config, originalGasPrice, originalGasLimit)
 }
+
+func (f *fixedPriceEstimator) CurrentEstimate() Estimate {
+	return Estimate{Mode: "FixedPrice", GasPrice: f.config.EthGasPriceDefault()}
+}
@@ -29,6 +29,19 @@ func Test_FixedPriceEstimator(t *testing.T) {
 		config.AssertExpectations(t)
 	})
 
+	t.Run("CurrentEstimate returns EthGasPriceDefault from config", func(t *testing.T) {
+		config := new(mocks.Config)
+		f := gas.NewFixedPriceEstimator(config)
+
+		config.On("EthGasPriceDefault").Return(big.NewInt(42))
+		config.On("ChainID").Return(big.NewInt(100))
+
+		require.NoError(t, f.Start())
+		assert.Equal(t, gas.Estimate{Mode: "FixedPrice", GasPrice: big.NewInt(42)}, f.CurrentEstimate())
+
+		config.AssertExpectations(t)
+	})
+
 	t.Run("BumpGas calls BumpGasPriceOnly", func(t *testing.T) {
 		config := new(mocks.Config)
 		f := gas.NewFixedPriceEstimator(config)
@@ -0,0 +1,237 @@
+package gas
+
+import (
+	"context"
+	"math/big"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/store/models"
+	"github.com/smartcontractkit/chainlink/core/utils"
+	"github.com/tidwall/gjson"
+)
+
+const (
+	// gasOracleTimeout is the timeout of a single request to the gas oracle
+	gasOracleTimeout = 10 * time.Second
+	// gasOracleSizeLimit is the maximum size of a gas oracle response
+	gasOracleSizeLimit = 1 << 20
+)
+
+var _ Estimator = &gasOracleEstimator{}
+
+// gasOracleEstimator estimates gas prices using an external HTTP gas oracle.
+// The oracle is polled in the background and its last price is cached. When
+// the cached price is older than GAS_ORACLE_MAX_AGE, e.g. because the oracle
+// is unreachable or returned prices outside of the configured bounds, gas is
+// estimated by the fallback estimator instead.
+type gasOracleEstimator struct {
+	utils.StartStopOnce
+
+	config     Config
+	fallback   Estimator
+	url        *url.URL
+	pollPeriod time.Duration
+	maxAge     time.Duration
+
+	gasPriceMu sync.RWMutex
+	gasPrice   *big.Int
+	fetchedAt  time.Time
+
+	chInitialised chan struct{}
+	chStop        chan struct{}
+	chDone        chan struct{}
+}
+
+// NewGasOracleEstimator returns an estimator which uses the gas oracle at
+// GAS_ORACLE_URL, and fallback whenever the oracle's price is stale
+func NewGasOracleEstimator(config Config, fallback Estimator) Estimator {
+	return &gasOracleEstimator{
+		config:        config,
+		fallback:      fallback,
+		url:           config.GasOracleURL(),
+		pollPeriod:    config.GasOraclePollPeriod(),
+		maxAge:        config.GasOracleMaxAge(),
+		chInitialised: make(chan struct{}),
+		chStop:        make(chan struct{}),
+		chDone:        make(chan struct{}),
+	}
+}
+
+func (g *gasOracleEstimator) Start() error {
+	return g.StartOnce("GasOracleEstimator", func() error {
+		if err := g.fallback.Start(); err != nil {
+			return errors.Wrap(err, "failed to start fallback estimator")
+		}
+		go g.run()
+		<-g.chInitialised
+		return nil
+	})
+}
+
+func (g *gasOracleEstimator) Close() error {
+	return g.StopOnce("GasOracleEstimator", func() error {
+		close(g.chStop)
+		<-g.chDone
+		return g.fallback.Close()
+	})
+}
+
+func (g *gasOracleEstimator) run() {
+	defer close(g.chDone)
+
+	ctx, cancel := utils.ContextFromChan(g.chStop)
+	defer cancel()
+
+	g.refreshPrice(ctx)
+	close(g.chInitialised)
+
+	ticker := time.NewTicker(utils.WithJitter(g.pollPeriod))
+	defer ticker.Stop()
+	for {
+		select {
+		case <-g.chStop:
+			return
+		case <-ticker.C:
+			g.refreshPrice(ctx)
+		}
+	}
+}
+
+func (g *gasOracleEstimator) refreshPrice(ctx context.Context) {
+	gasPrice, err := g.fetchGasPrice(ctx)
+	if err != nil {
+		logger.Warnw("GasOracleEstimator: failed to refresh gas price, the last fetched price will be used until it is stale", "err", err, "url", g.url.Redacted())
+		return
+	}
+	if min := g.config.EthMinGasPriceWei(); gasPrice.Cmp(min) < 0 {
+		logger.Warnw("GasOracleEstimator: ignoring gas price below ETH_MIN_GAS_PRICE_WEI", "gasPrice", gasPrice, "min", min)
+		return
+	}
+	if max := g.config.EthMaxGasPriceWei(); gasPrice.Cmp(max) > 0 {
+		logger.Warnw("GasOracleEstimator: ignoring gas price above ETH_MAX_GAS_PRICE_WEI", "gasPrice", gasPrice, "max", max)
+		return
+	}
+
+	logger.Debugw("GasOracleEstimator#refreshPrice", "gasPrice", gasPrice)
+	recordGasPrice(g.config, "GasOracle", "gas_price", gasPrice)
+
+	g.gasPriceMu.Lock()
+	defer g.gasPriceMu.Unlock()
+	g.gasPrice = gasPrice
+	g.fetchedAt = time.Now()
+}
+
+func (g *gasOracleEstimator) fetchGasPrice(ctx context.Context) (*big.Int, error) {
+	request, err := http.NewRequest(http.MethodGet, g.url.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	httpRequest := utils.HTTPRequest{
+		Request: request,
+		Config: utils.HTTPRequestConfig{
+			Timeout:                        gasOracleTimeout,
+			MaxAttempts:                    1,
+			SizeLimit:                      gasOracleSizeLimit,
+			AllowUnrestrictedNetworkAccess: true,
+		},
+	}
+	body, statusCode, _, err := httpRequest.SendRequest(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if statusCode >= 400 {
+		return nil, errors.Errorf("gas oracle responded with status %d", statusCode)
+	}
+	return ParseGasOracleResponse(body, g.config.GasOracleGasPricePath(), g.config.GasOracleGasPriceUnit())
+}
+
+// ParseGasOracleResponse extracts the gas price in Wei from a gas oracle
+// response. path is a gjson path to the price, which may be a JSON number, a
+// decimal string or a hex string. unit is the unit of the price, either wei or
+// gwei.
+func ParseGasOracleResponse(body []byte, path, unit string) (*big.Int, error) {
+	res := gjson.GetBytes(body, path)
+	if !res.Exists() {
+		return nil, errors.Errorf("gas oracle response has no value at path %q", path)
+	}
+
+	var price decimal.Decimal
+	var err error
+	switch {
+	case res.Type == gjson.Number:
+		price, err = decimal.NewFromString(res.Raw)
+	case res.Type == gjson.String && strings.HasPrefix(res.Str, "0x"):
+		var b *big.Int
+		b, err = hexutil.DecodeBig(res.Str)
+		if err == nil {
+			price = decimal.NewFromBigInt(b, 0)
+		}
+	case res.Type == gjson.String:
+		price, err = decimal.NewFromString(res.Str)
+	default:
+		return nil, errors.Errorf("gas oracle response has non-numeric value %s at path %q", res.Raw, path)
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to parse gas oracle price %s", res.Raw)
+	}
+
+	switch unit {
+	case "wei":
+	case "gwei":
+		price = price.Shift(9)
+	default:
+		return nil, errors.Errorf("unknown gas oracle unit %q", unit)
+	}
+	if price.Sign() <= 0 {
+		return nil, errors.Errorf("gas oracle price must be positive, got %s", price)
+	}
+	return price.BigInt(), nil
+}
+
+// getGasPrice returns the cached gas price, or nil if it is stale
+func (g *gasOracleEstimator) getGasPrice() *big.Int {
+	g.gasPriceMu.RLock()
+	defer g.gasPriceMu.RUnlock()
+	if g.gasPrice == nil || time.Since(g.fetchedAt) > g.maxAge {
+		return nil
+	}
+	return g.gasPrice
+}
+
+func (g *gasOracleEstimator) OnNewLongestChain(ctx context.Context, head models.Head) {
+	g.fallback.OnNewLongestChain(ctx, head)
+}
+
+// EstimateGas uses the oracle's price if it is fresh. OptForceRefetch is
+// passed through to the fallback estimator, the oracle is not polled more
+// often than GAS_ORACLE_POLL_PERIOD.
+func (g *gasOracleEstimator) EstimateGas(calldata []byte, gasLimit uint64, opts ...Opt) (gasPrice *big.Int, chainSpecificGasLimit uint64, err error) {
+	gasPrice = g.getGasPrice()
+	if gasPrice == nil {
+		logger.Debugw("GasOracleEstimator: gas oracle price is stale, using fallback estimator")
+		return g.fallback.EstimateGas(calldata, gasLimit, opts...)
+	}
+	chainSpecificGasLimit = applyMultiplier(gasLimit, g.config.EthGasLimitMultiplier())
+	return gasPrice, chainSpecificGasLimit, nil
+}
+
+func (g *gasOracleEstimator) BumpGas(originalGasPrice *big.Int, originalGasLimit uint64) (gasPrice *big.Int, gasLimit uint64, err error) {
+	return g.fallback.BumpGas(originalGasPrice, originalGasLimit)
+}
+
+// CurrentEstimate is the fallback estimator's estimate while the oracle's
+// price is stale
+func (g *gasOracleEstimator) CurrentEstimate() Estimate {
+	if gasPrice := g.getGasPrice(); gasPrice != nil {
+		return Estimate{Mode: "GasOracle", GasPrice: gasPrice}
+	}
+	return g.fallback.CurrentEstimate()
+}
@@ -0,0 +1,143 @@
+package gas_test
+
+import (
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/smartcontractkit/chainlink/core/services/gas"
+	"github.com/smartcontractkit/chainlink/core/services/gas/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newGasOracleConfig(t *testing.T, oracleURL string) *mocks.Config {
+	u, err := url.Parse(oracleURL)
+	require.NoError(t, err)
+
+	config := new(mocks.Config)
+	config.On("ChainID").Return(big.NewInt(137))
+	config.On("GasOracleURL").Return(u)
+	config.On("GasOracleGasPricePath").Return("fast")
+	config.On("GasOracleGasPriceUnit").Return("gwei")
+	config.On("GasOraclePollPeriod").Return(time.Hour)
+	config.On("GasOracleMaxAge").Return(time.Hour)
+	config.On("EthMinGasPriceWei").Return(big.NewInt(1000000000))
+	config.On("EthMaxGasPriceWei").Return(big.NewInt(5000000000000))
+	config.On("EthGasLimitMultiplier").Return(float32(1))
+	return config
+}
+
+func Test_GasOracleEstimator(t *testing.T) {
+	t.Parallel()
+
+	t.Run("uses the oracle's gas price", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte(`{"safeLow": 30, "fast": 45.5}`))
+		}))
+		defer server.Close()
+
+		config := newGasOracleConfig(t, server.URL)
+		fallback := new(mocks.Estimator)
+		fallback.On("Start").Return(nil)
+		fallback.On("Close").Return(nil)
+
+		g := gas.NewGasOracleEstimator(config, fallback)
+		require.NoError(t, g.Start())
+
+		gasPrice, gasLimit, err := g.EstimateGas(nil, 21000)
+		require.NoError(t, err)
+		assert.Equal(t, big.NewInt(45500000000), gasPrice)
+		assert.Equal(t, uint64(21000), gasLimit)
+
+		estimate := g.CurrentEstimate()
+		assert.Equal(t, "GasOracle", estimate.Mode)
+		assert.Equal(t, big.NewInt(45500000000), estimate.GasPrice)
+
+		require.NoError(t, g.Close())
+		fallback.AssertExpectations(t)
+	})
+
+	t.Run("falls back to the internal estimator if the oracle is unreachable", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}))
+		defer server.Close()
+
+		config := newGasOracleConfig(t, server.URL)
+		fallback := new(mocks.Estimator)
+		fallback.On("Start").Return(nil)
+		fallback.On("Close").Return(nil)
+		fallback.On("EstimateGas", []byte(nil), uint64(21000)).Return(big.NewInt(42), uint64(21000), nil)
+		fallback.On("CurrentEstimate").Return(gas.Estimate{Mode: "BlockHistory", GasPrice: big.NewInt(42)})
+
+		g := gas.NewGasOracleEstimator(config, fallback)
+		require.NoError(t, g.Start())
+
+		gasPrice, _, err := g.EstimateGas(nil, 21000)
+		require.NoError(t, err)
+		assert.Equal(t, big.NewInt(42), gasPrice)
+		assert.Equal(t, "BlockHistory", g.CurrentEstimate().Mode)
+
+		require.NoError(t, g.Close())
+		fallback.AssertExpectations(t)
+	})
+
+	t.Run("ignores prices outside of the configured bounds", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte(`{"fast": 100000}`))
+		}))
+		defer server.Close()
+
+		config := newGasOracleConfig(t, server.URL)
+		fallback := new(mocks.Estimator)
+		fallback.On("Start").Return(nil)
+		fallback.On("Close").Return(nil)
+		fallback.On("EstimateGas", []byte(nil), uint64(21000)).Return(big.NewInt(42), uint64(21000), nil)
+
+		g := gas.NewGasOracleEstimator(config, fallback)
+		require.NoError(t, g.Start())
+
+		gasPrice, _, err := g.EstimateGas(nil, 21000)
+		require.NoError(t, err)
+		assert.Equal(t, big.NewInt(42), gasPrice)
+
+		require.NoError(t, g.Close())
+		fallback.AssertExpectations(t)
+	})
+}
+
+func Test_ParseGasOracleResponse(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		body     string
+		path     string
+		unit     string
+		expected *big.Int
+		err      string
+	}{
+		{"gwei number", `{"fast": 45.5}`, "fast", "gwei", big.NewInt(45500000000), ""},
+		{"gwei string", `{"result": {"ProposeGasPrice": "31"}}`, "result.ProposeGasPrice", "gwei", big.NewInt(31000000000), ""},
+		{"wei hex string", `{"gasPrice": "0x3b9aca00"}`, "gasPrice", "wei", big.NewInt(1000000000), ""},
+		{"missing path", `{"fast": 45}`, "fastest", "gwei", nil, `gas oracle response has no value at path "fastest"`},
+		{"non-numeric", `{"fast": {"price": 45}}`, "fast", "gwei", nil, `gas oracle response has non-numeric value {"price": 45} at path "fast"`},
+		{"zero", `{"fast": 0}`, "fast", "gwei", nil, "gas oracle price must be positive, got 0"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			price, err := gas.ParseGasOracleResponse([]byte(test.body), test.path, test.unit)
+			if test.err != "" {
+				assert.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, test.expected, price)
+			}
+		})
+	}
+}
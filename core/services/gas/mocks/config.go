@@ -6,6 +6,10 @@ import (
 	big "math/big"
 
 	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+
+	url "net/url"
 )
 
 // Config is an autogenerated mock type for the Config type
@@ -204,3 +208,75 @@ func (_m *Config) GasEstimatorMode() string {
 
 	return r0
 }
+
+// GasOracleGasPricePath provides a mock function with given fields:
+func (_m *Config) GasOracleGasPricePath() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// GasOracleGasPriceUnit provides a mock function with given fields:
+func (_m *Config) GasOracleGasPriceUnit() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// GasOracleMaxAge provides a mock function with given fields:
+func (_m *Config) GasOracleMaxAge() time.Duration {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
+// GasOraclePollPeriod provides a mock function with given fields:
+func (_m *Config) GasOraclePollPeriod() time.Duration {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
+// GasOracleURL provides a mock function with given fields:
+func (_m *Config) GasOracleURL() *url.URL {
+	ret := _m.Called()
+
+	var r0 *url.URL
+	if rf, ok := ret.Get(0).(func() *url.URL); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*url.URL)
+		}
+	}
+
+	return r0
+}
@@ -61,6 +61,20 @@ func (_m *Estimator) Close() error {
 	return r0
 }
 
+// CurrentEstimate provides a mock function with given fields:
+func (_m *Estimator) CurrentEstimate() gas.Estimate {
+	ret := _m.Called()
+
+	var r0 gas.Estimate
+	if rf, ok := ret.Get(0).(func() gas.Estimate); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(gas.Estimate)
+	}
+
+	return r0
+}
+
 // EstimateGas provides a mock function with given fields: calldata, gasLimit, opts
 func (_m *Estimator) EstimateGas(calldata []byte, gasLimit uint64, opts ...gas.Opt) (*big.Int, uint64, error) {
 	_va := make([]interface{}, len(opts))
@@ -4,6 +4,8 @@ import (
 	"context"
 	"encoding/json"
 	"math/big"
+	"net/url"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
@@ -27,9 +29,24 @@ var (
 		Name: "tx_manager_gas_bump_exceeds_limit",
 		Help: "Number of times gas bumping failed from exceeding the configured limit. Any counts of this type indicate a serious problem.",
 	})
+
+	promGasEstimatorGasPrice = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "gas_estimator_gas_price_wei",
+		Help: "The current gas price estimate of a chain (in Wei). On L2 chains, the l1 component is the price of the L1 data fee.",
+	},
+		[]string{"evm_chain_id", "estimator", "component"},
+	)
 )
 
 func NewEstimator(ethClient eth.Client, config Config) Estimator {
+	estimator := newInternalEstimator(ethClient, config)
+	if config.GasOracleURL() != nil {
+		return NewGasOracleEstimator(config, estimator)
+	}
+	return estimator
+}
+
+func newInternalEstimator(ethClient eth.Client, config Config) Estimator {
 	s := config.GasEstimatorMode()
 	switch s {
 	case "BlockHistory":
@@ -52,6 +69,32 @@ type Estimator interface {
 	Close() error
 	EstimateGas(calldata []byte, gasLimit uint64, opts ...Opt) (gasPrice *big.Int, chainSpecificGasLimit uint64, err error)
 	BumpGas(originalGasPrice *big.Int, gasLimit uint64) (bumpedGasPrice *big.Int, chainSpecificGasLimit uint64, err error)
+	CurrentEstimate() Estimate
+}
+
+// Estimate is the current state of an Estimator, as exposed via the API
+type Estimate struct {
+	// Mode is the GAS_ESTIMATOR_MODE of the estimator
+	Mode string
+	// GasPrice is nil if the estimator has not estimated a gas price yet
+	GasPrice *big.Int
+	// L1GasPrice is the price of the L1 data fee, on L2 chains which charge
+	// one
+	L1GasPrice *big.Int
+	// Percentiles are the gas prices of the sampled transactions in steps
+	// of 5%, for estimators which sample recent blocks
+	Percentiles map[int]*big.Int
+	// BlockNumber is the latest block sampled, or 0 if none was
+	BlockNumber int64
+}
+
+// recordGasPrice updates the gas price gauge of the chain
+func recordGasPrice(config Config, mode, component string, gasPrice *big.Int) {
+	if gasPrice == nil {
+		return
+	}
+	f, _ := new(big.Float).SetInt(gasPrice).Float64()
+	promGasEstimatorGasPrice.WithLabelValues(config.ChainID().String(), mode, component).Set(f)
 }
 
 // Opt is an option for a gas estimator
@@ -82,6 +125,11 @@ type Config interface {
 	EthMaxGasPriceWei() *big.Int
 	EthMinGasPriceWei() *big.Int
 	GasEstimatorMode() string
+	GasOracleGasPricePath() string
+	GasOracleGasPriceUnit() string
+	GasOracleMaxAge() time.Duration
+	GasOraclePollPeriod() time.Duration
+	GasOracleURL() *url.URL
 }
 
 // Int64ToHex converts an int64 into go-ethereum's hex representation
@@ -126,6 +126,9 @@ func (o *optimismEstimator) refreshPrices() (t *time.Timer) {
 
 	logger.Debugw("OptimismEstimator#refreshPrices", "l1GasPrice", res.L1GasPrice, "l2GasPrice", res.L2GasPrice)
 
+	recordGasPrice(o.config, "Optimism", "l1_gas_price", res.L1GasPrice)
+	recordGasPrice(o.config, "Optimism", "gas_price", res.L2GasPrice)
+
 	o.gasPriceMu.Lock()
 	defer o.gasPriceMu.Unlock()
 	o.l1GasPrice, o.l2GasPrice = res.L1GasPrice, res.L2GasPrice
@@ -193,3 +196,8 @@ func (o *optimismEstimator) getGasPrices() (l1GasPrice, l2GasPrice *big.Int) {
 	defer o.gasPriceMu.RUnlock()
 	return o.l1GasPrice, o.l2GasPrice
 }
+
+func (o *optimismEstimator) CurrentEstimate() Estimate {
+	l1GasPrice, l2GasPrice := o.getGasPrices()
+	return Estimate{Mode: "Optimism", GasPrice: l2GasPrice, L1GasPrice: l1GasPrice}
+}
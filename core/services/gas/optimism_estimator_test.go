@@ -34,12 +34,18 @@ func Test_OptimismEstimator(t *testing.T) {
 			res.L1GasPrice = big.NewInt(42)
 			res.L2GasPrice = big.NewInt(142)
 		})
+		config.On("ChainID").Return(big.NewInt(10))
 
 		require.NoError(t, o.Start())
 		gasPrice, chainSpecificGasLimit, err := o.EstimateGas(calldata, gasLimit)
 		require.NoError(t, err)
 		assert.Equal(t, big.NewInt(15000000), gasPrice)
 		assert.Equal(t, 10008, int(chainSpecificGasLimit))
+
+		estimate := o.CurrentEstimate()
+		assert.Equal(t, "Optimism", estimate.Mode)
+		assert.Equal(t, big.NewInt(142), estimate.GasPrice)
+		assert.Equal(t, big.NewInt(42), estimate.L1GasPrice)
 	})
 
 	t.Run("calling BumpGas always returns error", func(t *testing.T) {
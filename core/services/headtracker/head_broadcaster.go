@@ -8,6 +8,8 @@ import (
 	"sync"
 	"time"
 
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
 	"github.com/smartcontractkit/chainlink/core/logger"
 	httypes "github.com/smartcontractkit/chainlink/core/services/headtracker/types"
 	"github.com/smartcontractkit/chainlink/core/store/models"
@@ -16,9 +18,104 @@ import (
 
 const callbackTimeout = 2 * time.Second
 
+// slowCallbackStreak is the number of consecutive callbacks over or within
+// ETH_HEAD_BROADCASTER_CALLBACK_BUDGET after which a subscriber is
+// considered slow or recovered
+const slowCallbackStreak = 3
+
+var (
+	promCallbackDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "head_broadcaster_callback_duration_seconds",
+		Help:    "How long head subscribers take to process a head",
+		Buckets: []float64{0.01, 0.05, 0.1, 0.25, 0.5, 1, 2},
+	}, []string{"subscriber"})
+	promSlowSubscribers = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "head_broadcaster_slow_subscribers",
+		Help: "The number of head subscribers which consistently exceed ETH_HEAD_BROADCASTER_CALLBACK_BUDGET",
+	}, []string{"subscriber"})
+)
+
+// BroadcasterConfig is the config of the head broadcaster
+type BroadcasterConfig interface {
+	EthHeadBroadcasterAsyncSlowSubscribers() bool
+	EthHeadBroadcasterCallbackBudget() time.Duration
+}
+
 type callbackID [256]byte
 
-type callbackSet map[callbackID]httypes.HeadTrackable
+// subscription is a head callback and when it was last called
+type subscription struct {
+	callback httypes.HeadTrackable
+	sampling httypes.HeadSampling
+	last     lastSample
+
+	mu sync.Mutex
+	// overBudget and withinBudget are the current streaks of callbacks
+	// over and within budget
+	overBudget   int
+	withinBudget int
+	slow         bool
+	// async delivers heads to the subscriber while it is slow, if enabled
+	async *asyncDelivery
+}
+
+// asyncDelivery calls back a slow subscriber in its own goroutine. The heads
+// it is too slow to process are coalesced into the latest one.
+type asyncDelivery struct {
+	mailbox *utils.Mailbox
+	chStop  chan struct{}
+	chDone  chan struct{}
+}
+
+func (sub *subscription) name() string {
+	return reflect.TypeOf(sub.callback).String()
+}
+
+func (sub *subscription) isSlow() bool {
+	sub.mu.Lock()
+	defer sub.mu.Unlock()
+	return sub.slow
+}
+
+// observe records how long a callback took, and returns whether the
+// subscriber became slow or recovered
+func (sub *subscription) observe(elapsed, budget time.Duration) (becameSlow, recovered bool) {
+	sub.mu.Lock()
+	defer sub.mu.Unlock()
+	if elapsed > budget {
+		sub.overBudget++
+		sub.withinBudget = 0
+	} else {
+		sub.withinBudget++
+		sub.overBudget = 0
+	}
+	if !sub.slow && sub.overBudget >= slowCallbackStreak {
+		sub.slow = true
+		return true, false
+	} else if sub.slow && sub.withinBudget >= slowCallbackStreak {
+		sub.slow = false
+		return false, true
+	}
+	return false, false
+}
+
+// stopAsync stops the asynchronous delivery to the subscriber, if any. If
+// wait is set it waits for an ongoing callback to return.
+func (sub *subscription) stopAsync(wait bool) {
+	sub.mu.Lock()
+	async := sub.async
+	sub.async = nil
+	sub.mu.Unlock()
+	if async == nil {
+		return
+	}
+	close(async.chStop)
+	if wait {
+		<-async.chDone
+	}
+}
+
+type callbackSet map[callbackID]*subscription
 
 func (set callbackSet) clone() callbackSet {
 	cp := make(callbackSet)
@@ -28,28 +125,45 @@ func (set callbackSet) clone() callbackSet {
 	return cp
 }
 
+type finalizedCallbackSet map[callbackID]httypes.FinalizedHeadTrackable
+
+func (set finalizedCallbackSet) clone() finalizedCallbackSet {
+	cp := make(finalizedCallbackSet)
+	for id, callback := range set {
+		cp[id] = callback
+	}
+	return cp
+}
+
 // NewHeadBroadcaster creates a new HeadBroadcaster
-func NewHeadBroadcaster() httypes.HeadBroadcaster {
+func NewHeadBroadcaster(config BroadcasterConfig) httypes.HeadBroadcaster {
 	return &headBroadcaster{
-		callbacks:     make(callbackSet),
-		mailbox:       utils.NewMailbox(1),
-		mutex:         &sync.Mutex{},
-		chClose:       make(chan struct{}),
-		wgDone:        sync.WaitGroup{},
-		StartStopOnce: utils.StartStopOnce{},
+		config:             config,
+		callbacks:          make(callbackSet),
+		finalizedCallbacks: make(finalizedCallbackSet),
+		mailbox:            utils.NewMailbox(1),
+		finalizedMailbox:   utils.NewMailbox(1),
+		mutex:              &sync.Mutex{},
+		chClose:            make(chan struct{}),
+		wgDone:             sync.WaitGroup{},
+		StartStopOnce:      utils.StartStopOnce{},
 	}
 }
 
 // headBroadcaster relays heads from the head tracker to subscribed jobs, it is less robust against
 // congestion than the head tracker, and missed heads should be expected by consuming jobs
 type headBroadcaster struct {
-	callbacks callbackSet
-	mailbox   *utils.Mailbox
-	mutex     *sync.Mutex
-	chClose   chan struct{}
-	wgDone    sync.WaitGroup
+	config             BroadcasterConfig
+	callbacks          callbackSet
+	finalizedCallbacks finalizedCallbackSet
+	mailbox            *utils.Mailbox
+	finalizedMailbox   *utils.Mailbox
+	mutex              *sync.Mutex
+	chClose            chan struct{}
+	wgDone             sync.WaitGroup
 	utils.StartStopOnce
-	latest *models.Head
+	latest          *models.Head
+	latestFinalized *models.Head
 }
 
 var _ httypes.HeadTrackable = (*headBroadcaster)(nil)
@@ -65,8 +179,12 @@ func (hr *headBroadcaster) Start() error {
 func (hr *headBroadcaster) Close() error {
 	return hr.StopOnce("HeadBroadcaster", func() error {
 		hr.mutex.Lock()
+		for _, sub := range hr.callbacks {
+			sub.stopAsync(false)
+		}
 		// clear all callbacks
 		hr.callbacks = make(callbackSet)
+		hr.finalizedCallbacks = make(finalizedCallbackSet)
 		hr.mutex.Unlock()
 
 		close(hr.chClose)
@@ -82,8 +200,8 @@ func (hr *headBroadcaster) Connect(head *models.Head) error {
 	callbacks := hr.callbacks.clone()
 	hr.mutex.Unlock()
 
-	for i, callback := range callbacks {
-		err := callback.Connect(head)
+	for i, sub := range callbacks {
+		err := sub.callback.Connect(head)
 		if err != nil {
 			logger.Errorf("HeadBroadcaster: Failed Connect callback at index %v: %v", i, err)
 		}
@@ -99,6 +217,12 @@ func (hr *headBroadcaster) OnNewLongestChain(ctx context.Context, head models.He
 // Subscribe - Subscribes to OnNewLongestChain and Connect until HeadBroadcaster is closed,
 // or unsubscribe callback is called explicitly
 func (hr *headBroadcaster) Subscribe(callback httypes.HeadTrackable) (currentLongestChain *models.Head, unsubscribe func()) {
+	return hr.SubscribeWithSampling(callback, httypes.HeadSampling{})
+}
+
+// SubscribeWithSampling is Subscribe for subscribers which need to be called
+// back less often than every sampled head
+func (hr *headBroadcaster) SubscribeWithSampling(callback httypes.HeadTrackable, sampling httypes.HeadSampling) (currentLongestChain *models.Head, unsubscribe func()) {
 	hr.mutex.Lock()
 	defer hr.mutex.Unlock()
 	currentLongestChain = hr.latest
@@ -107,11 +231,39 @@ func (hr *headBroadcaster) Subscribe(callback httypes.HeadTrackable) (currentLon
 		logger.Errorf("HeadBroadcaster: Unable to create ID for head relayble callback: %v", err)
 		return
 	}
-	hr.callbacks[id] = callback
+	sub := &subscription{callback: callback, sampling: sampling}
+	hr.callbacks[id] = sub
 	unsubscribe = func() {
 		hr.mutex.Lock()
 		defer hr.mutex.Unlock()
 		delete(hr.callbacks, id)
+		sub.stopAsync(false)
+	}
+	return
+}
+
+// OnNewFinalizedHead relays a newly finalized head to the finalized head
+// subscribers
+func (hr *headBroadcaster) OnNewFinalizedHead(ctx context.Context, head models.Head) {
+	hr.finalizedMailbox.Deliver(head)
+}
+
+// SubscribeFinalized - Subscribes to OnNewFinalizedHead until HeadBroadcaster is closed,
+// or unsubscribe callback is called explicitly
+func (hr *headBroadcaster) SubscribeFinalized(callback httypes.FinalizedHeadTrackable) (currentFinalized *models.Head, unsubscribe func()) {
+	hr.mutex.Lock()
+	defer hr.mutex.Unlock()
+	currentFinalized = hr.latestFinalized
+	id, err := newID()
+	if err != nil {
+		logger.Errorf("HeadBroadcaster: Unable to create ID for finalized head callback: %v", err)
+		return
+	}
+	hr.finalizedCallbacks[id] = callback
+	unsubscribe = func() {
+		hr.mutex.Lock()
+		defer hr.mutex.Unlock()
+		delete(hr.finalizedCallbacks, id)
 	}
 	return
 }
@@ -124,6 +276,8 @@ func (hr *headBroadcaster) run() {
 			return
 		case <-hr.mailbox.Notify():
 			hr.executeCallbacks()
+		case <-hr.finalizedMailbox.Notify():
+			hr.executeFinalizedCallbacks()
 		}
 	}
 }
@@ -147,29 +301,156 @@ func (hr *headBroadcaster) executeCallbacks() {
 	hr.latest = &head
 	hr.mutex.Unlock()
 
+	// Subscriptions are only sampled here, in the run goroutine
+	now := time.Now()
+	var due []*subscription
+	for _, sub := range callbacks {
+		if sub.last.due(sub.sampling, head, now) {
+			sub.last.record(head, now)
+			due = append(due, sub)
+		}
+	}
+
 	logger.Debugw("HeadBroadcaster initiating callbacks",
 		"headNum", head.Number,
-		"numCallbacks", len(hr.callbacks),
+		"numCallbacks", len(due),
 	)
 
 	wg := sync.WaitGroup{}
-	wg.Add(len(hr.callbacks))
+	for _, sub := range due {
+		if hr.config.EthHeadBroadcasterAsyncSlowSubscribers() && sub.isSlow() {
+			hr.deliverAsync(sub, head)
+			continue
+		}
+		// Recovered subscribers are called back synchronously again
+		sub.stopAsync(true)
+
+		wg.Add(1)
+		go func(sub *subscription) {
+			defer wg.Done()
+			hr.callback(sub, head)
+		}(sub)
+	}
+
+	wg.Wait()
+}
+
+// callback calls back sub with head, and keeps track of whether it is slow
+func (hr *headBroadcaster) callback(sub *subscription, head models.Head) {
+	start := time.Now()
+	ctx, cancel := context.WithTimeout(context.Background(), callbackTimeout)
+	defer cancel()
+	sub.callback.OnNewLongestChain(ctx, head)
+	elapsed := time.Since(start)
+	logger.Debugw(fmt.Sprintf("HeadBroadcaster: finished callback in %s", elapsed), "callbackType", reflect.TypeOf(sub.callback), "blockNumber", head.Number, "time", elapsed, "id", "head_relayer")
+
+	name := sub.name()
+	promCallbackDuration.WithLabelValues(name).Observe(elapsed.Seconds())
+	budget := hr.config.EthHeadBroadcasterCallbackBudget()
+	becameSlow, recovered := sub.observe(elapsed, budget)
+	if becameSlow {
+		promSlowSubscribers.WithLabelValues(name).Inc()
+		logger.Warnw(fmt.Sprintf("HeadBroadcaster: subscriber %s consistently takes longer than %s to process a head", name, budget), "callbackType", name, "budget", budget, "time", elapsed, "async", hr.config.EthHeadBroadcasterAsyncSlowSubscribers())
+	} else if recovered {
+		promSlowSubscribers.WithLabelValues(name).Dec()
+		logger.Infow(fmt.Sprintf("HeadBroadcaster: subscriber %s processes heads within %s again", name, budget), "callbackType", name, "budget", budget)
+	}
+}
+
+// deliverAsync hands head to the asynchronous delivery of the slow
+// subscriber sub, starting it if needed
+func (hr *headBroadcaster) deliverAsync(sub *subscription, head models.Head) {
+	sub.mu.Lock()
+	async := sub.async
+	if async == nil {
+		async = &asyncDelivery{
+			mailbox: utils.NewMailbox(1),
+			chStop:  make(chan struct{}),
+			chDone:  make(chan struct{}),
+		}
+		sub.async = async
+		go hr.runAsync(sub, async)
+	}
+	sub.mu.Unlock()
+	async.mailbox.Deliver(head)
+}
+
+func (hr *headBroadcaster) runAsync(sub *subscription, async *asyncDelivery) {
+	defer close(async.chDone)
+	for {
+		select {
+		case <-async.chStop:
+			return
+		case <-hr.chClose:
+			return
+		case <-async.mailbox.Notify():
+			item, exists := async.mailbox.Retrieve()
+			if !exists {
+				continue
+			}
+			hr.callback(sub, item.(models.Head))
+		}
+	}
+}
+
+func (hr *headBroadcaster) executeFinalizedCallbacks() {
+	item, exists := hr.finalizedMailbox.Retrieve()
+	if !exists {
+		return
+	}
+	head, ok := item.(models.Head)
+	if !ok {
+		logger.Errorf("expected `models.Head`, got %T", item)
+		return
+	}
+	hr.mutex.Lock()
+	callbacks := hr.finalizedCallbacks.clone()
+	hr.latestFinalized = &head
+	hr.mutex.Unlock()
+
+	logger.Debugw("HeadBroadcaster initiating finalized head callbacks",
+		"headNum", head.Number,
+		"numCallbacks", len(callbacks),
+	)
+
+	wg := sync.WaitGroup{}
+	wg.Add(len(callbacks))
 
 	for _, callback := range callbacks {
-		go func(hr httypes.HeadTrackable) {
+		go func(ft httypes.FinalizedHeadTrackable) {
 			defer wg.Done()
-			start := time.Now()
 			ctx, cancel := context.WithTimeout(context.Background(), callbackTimeout)
 			defer cancel()
-			hr.OnNewLongestChain(ctx, head)
-			elapsed := time.Since(start)
-			logger.Debugw(fmt.Sprintf("HeadBroadcaster: finished callback in %s", elapsed), "callbackType", reflect.TypeOf(hr), "blockNumber", head.Number, "time", elapsed, "id", "head_relayer")
+			ft.OnNewFinalizedHead(ctx, head)
 		}(callback)
 	}
 
 	wg.Wait()
 }
 
+// lastSample is the head a sampled callback was last called with
+type lastSample struct {
+	number int64
+	at     time.Time
+	set    bool
+}
+
+// due returns whether a callback with the given sampling, last called back
+// with s, is due to be called back with head
+func (s lastSample) due(sampling httypes.HeadSampling, head models.Head, now time.Time) bool {
+	if !s.set || head.Number <= s.number {
+		return true
+	}
+	if now.Sub(s.at) < sampling.Interval {
+		return false
+	}
+	return head.Number-s.number >= int64(sampling.Blocks)
+}
+
+func (s *lastSample) record(head models.Head, now time.Time) {
+	*s = lastSample{number: head.Number, at: now, set: true}
+}
+
 func newID() (id callbackID, _ error) {
 	randBytes := make([]byte, 256)
 	_, err := rand.Read(randBytes)
@@ -182,12 +463,19 @@ func newID() (id callbackID, _ error) {
 
 type NullBroadcaster struct{}
 
-func (*NullBroadcaster) Start() error                                            { return nil }
-func (*NullBroadcaster) Close() error                                            { return nil }
-func (*NullBroadcaster) Connect(head *models.Head) error                         { return nil }
-func (*NullBroadcaster) OnNewLongestChain(ctx context.Context, head models.Head) {}
+func (*NullBroadcaster) Start() error                                             { return nil }
+func (*NullBroadcaster) Close() error                                             { return nil }
+func (*NullBroadcaster) Connect(head *models.Head) error                          { return nil }
+func (*NullBroadcaster) OnNewLongestChain(ctx context.Context, head models.Head)  {}
+func (*NullBroadcaster) OnNewFinalizedHead(ctx context.Context, head models.Head) {}
 func (*NullBroadcaster) Subscribe(callback httypes.HeadTrackable) (currentLongestChain *models.Head, unsubscribe func()) {
 	return nil, func() {}
 }
+func (*NullBroadcaster) SubscribeWithSampling(callback httypes.HeadTrackable, sampling httypes.HeadSampling) (currentLongestChain *models.Head, unsubscribe func()) {
+	return nil, func() {}
+}
+func (*NullBroadcaster) SubscribeFinalized(callback httypes.FinalizedHeadTrackable) (currentFinalized *models.Head, unsubscribe func()) {
+	return nil, func() {}
+}
 func (n *NullBroadcaster) Healthy() error { return nil }
 func (n *NullBroadcaster) Ready() error   { return nil }
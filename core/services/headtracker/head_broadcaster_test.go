@@ -1,12 +1,16 @@
 package headtracker_test
 
 import (
+	"context"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/onsi/gomega"
 	"github.com/smartcontractkit/chainlink/core/internal/cltest"
 	"github.com/smartcontractkit/chainlink/core/internal/mocks"
 	"github.com/smartcontractkit/chainlink/core/services/headtracker"
+	httypes "github.com/smartcontractkit/chainlink/core/services/headtracker/types"
 	"github.com/smartcontractkit/chainlink/core/store/models"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -39,7 +43,7 @@ func TestHeadBroadcaster_Subscribe(t *testing.T) {
 	checker1 := &cltest.MockHeadTrackable{}
 	checker2 := &cltest.MockHeadTrackable{}
 
-	hr := headtracker.NewHeadBroadcaster()
+	hr := headtracker.NewHeadBroadcaster(store.Config)
 	orm := headtracker.NewORM(store.DB)
 	ht := headtracker.NewHeadTracker(logger, ethClient, store.Config, orm, hr, cltest.NeverSleeper{})
 	require.NoError(t, hr.Start())
@@ -68,3 +72,108 @@ func TestHeadBroadcaster_Subscribe(t *testing.T) {
 
 	require.NoError(t, ht.Stop())
 }
+
+func TestHeadBroadcaster_SubscribeFinalized(t *testing.T) {
+	t.Parallel()
+	g := gomega.NewGomegaWithT(t)
+
+	checker1 := &cltest.MockHeadTrackable{}
+	checker2 := &cltest.MockHeadTrackable{}
+
+	hr := headtracker.NewHeadBroadcaster(cltest.NewTestConfig(t))
+	require.NoError(t, hr.Start())
+	defer hr.Close()
+
+	latest1, unsubscribe1 := hr.SubscribeFinalized(checker1)
+	// "latest finalized head" is nil here because we didn't receive any yet
+	assert.Equal(t, (*models.Head)(nil), latest1)
+
+	hr.OnNewFinalizedHead(context.Background(), models.Head{Number: 5})
+	g.Eventually(func() int32 { return checker1.OnNewFinalizedHeadCount() }).Should(gomega.Equal(int32(1)))
+	assert.Equal(t, int64(5), checker1.LatestFinalizedNumber())
+
+	latest2, _ := hr.SubscribeFinalized(checker2)
+	require.NotNil(t, latest2)
+	assert.Equal(t, int64(5), latest2.Number)
+
+	unsubscribe1()
+
+	hr.OnNewFinalizedHead(context.Background(), models.Head{Number: 6})
+	g.Eventually(func() int32 { return checker2.OnNewFinalizedHeadCount() }).Should(gomega.Equal(int32(1)))
+	assert.Equal(t, int32(1), checker1.OnNewFinalizedHeadCount())
+}
+
+func TestHeadBroadcaster_SubscribeWithSampling(t *testing.T) {
+	t.Parallel()
+	g := gomega.NewGomegaWithT(t)
+
+	checker1 := &cltest.MockHeadTrackable{}
+	checker2 := &cltest.MockHeadTrackable{}
+
+	hr := headtracker.NewHeadBroadcaster(cltest.NewTestConfig(t))
+	require.NoError(t, hr.Start())
+	defer hr.Close()
+
+	hr.Subscribe(checker1)
+	hr.SubscribeWithSampling(checker2, httypes.HeadSampling{Blocks: 3})
+
+	for n := int64(1); n <= 7; n++ {
+		hr.OnNewLongestChain(context.Background(), models.Head{Number: n})
+		g.Eventually(func() int32 { return checker1.OnNewLongestChainCount() }).Should(gomega.Equal(int32(n)))
+	}
+
+	// Called back with heads 1, 4 and 7
+	g.Eventually(func() int32 { return checker2.OnNewLongestChainCount() }).Should(gomega.Equal(int32(3)))
+	g.Consistently(func() int32 { return checker2.OnNewLongestChainCount() }).Should(gomega.Equal(int32(3)))
+}
+
+// sleepingHeadTrackable takes a while to process each head
+type sleepingHeadTrackable struct {
+	cltest.MockHeadTrackable
+	sleep  time.Duration
+	latest int64
+}
+
+func (s *sleepingHeadTrackable) OnNewLongestChain(ctx context.Context, head models.Head) {
+	time.Sleep(s.sleep)
+	atomic.StoreInt64(&s.latest, head.Number)
+	s.MockHeadTrackable.OnNewLongestChain(ctx, head)
+}
+
+func TestHeadBroadcaster_SlowSubscriber(t *testing.T) {
+	t.Parallel()
+	g := gomega.NewGomegaWithT(t)
+
+	config := cltest.NewTestConfig(t)
+	config.Set("ETH_HEAD_BROADCASTER_CALLBACK_BUDGET", "50ms")
+	config.Set("ETH_HEAD_BROADCASTER_ASYNC_SLOW_SUBSCRIBERS", true)
+
+	fast := &cltest.MockHeadTrackable{}
+	slow := &sleepingHeadTrackable{sleep: 200 * time.Millisecond}
+
+	hr := headtracker.NewHeadBroadcaster(config)
+	require.NoError(t, hr.Start())
+	defer hr.Close()
+
+	hr.Subscribe(fast)
+	hr.Subscribe(slow)
+
+	// The slow subscriber delays the others until it has exceeded the budget
+	// a few times in a row
+	for n := int64(1); n <= 3; n++ {
+		hr.OnNewLongestChain(context.Background(), models.Head{Number: n})
+		g.Eventually(func() int32 { return slow.OnNewLongestChainCount() }).Should(gomega.Equal(int32(n)))
+	}
+
+	// Then it is called back asynchronously
+	start := time.Now()
+	for n := int64(4); n <= 10; n++ {
+		hr.OnNewLongestChain(context.Background(), models.Head{Number: n})
+		g.Eventually(func() int32 { return fast.OnNewLongestChainCount() }).Should(gomega.Equal(int32(n)))
+	}
+	assert.Less(t, int64(time.Since(start)), int64(7*slow.sleep))
+
+	// with the heads it was too slow for coalesced into the latest one
+	g.Eventually(func() int64 { return atomic.LoadInt64(&slow.latest) }).Should(gomega.Equal(int64(10)))
+	assert.Less(t, slow.OnNewLongestChainCount(), int32(10))
+}
@@ -0,0 +1,64 @@
+package headtracker
+
+import (
+	"container/list"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/smartcontractkit/chainlink/core/store/models"
+)
+
+// headCache is an LRU cache of heads by hash, which holds at most size heads
+// so that its memory stays bounded however long the node runs. Heads are
+// cached without their parents, chains are linked on read.
+type headCache struct {
+	mu    sync.Mutex
+	size  int
+	order *list.List
+	heads map[common.Hash]*list.Element
+}
+
+func newHeadCache(size int) *headCache {
+	return &headCache{
+		size:  size,
+		order: list.New(),
+		heads: make(map[common.Hash]*list.Element),
+	}
+}
+
+// get returns the head with the given hash, if it is cached
+func (c *headCache) get(hash common.Hash) (models.Head, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	el, exists := c.heads[hash]
+	if !exists {
+		return models.Head{}, false
+	}
+	c.order.MoveToFront(el)
+	return el.Value.(models.Head), true
+}
+
+// add caches head, evicting the least recently used head if the cache is full
+func (c *headCache) add(head models.Head) {
+	head.Parent = nil
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if el, exists := c.heads[head.Hash]; exists {
+		el.Value = head
+		c.order.MoveToFront(el)
+		return
+	}
+	c.heads[head.Hash] = c.order.PushFront(head)
+	if c.order.Len() > c.size {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.heads, oldest.Value.(models.Head).Hash)
+	}
+}
+
+func (c *headCache) len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.order.Len()
+}
@@ -0,0 +1,38 @@
+package headtracker
+
+import (
+	"testing"
+
+	"github.com/smartcontractkit/chainlink/core/store/models"
+	"github.com/smartcontractkit/chainlink/core/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHeadCache_EvictsLeastRecentlyUsed(t *testing.T) {
+	t.Parallel()
+
+	cache := newHeadCache(2)
+	h1 := models.Head{Number: 1, Hash: utils.NewHash()}
+	h2 := models.Head{Number: 2, Hash: utils.NewHash(), Parent: &h1}
+	h3 := models.Head{Number: 3, Hash: utils.NewHash()}
+
+	cache.add(h1)
+	cache.add(h2)
+	_, exists := cache.get(h1.Hash)
+	assert.True(t, exists)
+
+	cache.add(h3)
+	assert.Equal(t, 2, cache.len())
+	_, exists = cache.get(h2.Hash)
+	assert.False(t, exists)
+	_, exists = cache.get(h1.Hash)
+	assert.True(t, exists)
+	h, exists := cache.get(h3.Hash)
+	assert.True(t, exists)
+	assert.Equal(t, int64(3), h.Number)
+
+	cache.add(h2)
+	h, exists = cache.get(h2.Hash)
+	assert.True(t, exists)
+	assert.Nil(t, h.Parent)
+}
@@ -30,19 +30,26 @@ var (
 )
 
 type Config interface {
+	BroadcasterConfig
 	ChainID() *big.Int
 	EnableLegacyJobPipeline() bool
 	EthHeadTrackerHistoryDepth() uint
 	EthHeadTrackerMaxBufferSize() uint
+	EthHeadTrackerMaxProviderLag() uint
+	EthHeadTrackerSamplingBlocks() uint
 	EthHeadTrackerSamplingInterval() time.Duration
 	BlockEmissionIdleWarningThreshold() time.Duration
 	EthereumURL() string
 	EthFinalityDepth() uint
+	EthUseFinalityTag() bool
 }
 
 type HeadListener struct {
-	config           Config
-	ethClient        eth.Client
+	config    Config
+	ethClient eth.Client
+	url       string
+	// dial is set if ethClient must be dialed before subscribing
+	dial             bool
 	headers          chan *models.Head
 	headSubscription ethereum.Subscription
 	connectedMutex   sync.RWMutex
@@ -59,6 +66,7 @@ type HeadListener struct {
 
 func NewHeadListener(l *logger.Logger,
 	ethClient eth.Client,
+	url string,
 	config Config,
 	chStop chan struct{},
 	wgDone *sync.WaitGroup,
@@ -73,6 +81,7 @@ func NewHeadListener(l *logger.Logger,
 	return &HeadListener{
 		config:    config,
 		ethClient: ethClient,
+		url:       url,
 		sleeper:   sleeper,
 		log:       l,
 		chStop:    chStop,
@@ -176,7 +185,7 @@ func (hl *HeadListener) subscribe(connected func()) bool {
 			return false
 		}
 
-		hl.logger().Info("HeadListener: Connecting to ethereum node ", hl.config.EthereumURL(), " in ", hl.sleeper.Duration())
+		hl.logger().Info("HeadListener: Connecting to ethereum node ", hl.url, " in ", hl.sleeper.Duration())
 		select {
 		case <-hl.chStop:
 			return false
@@ -184,9 +193,9 @@ func (hl *HeadListener) subscribe(connected func()) bool {
 			err := hl.subscribeToHead(connected)
 			if err != nil {
 				promEthConnectionErrors.Inc()
-				hl.logger().Warnw(fmt.Sprintf("HeadListener: Failed to connect to ethereum node %v", hl.config.EthereumURL()), "err", err)
+				hl.logger().Warnw(fmt.Sprintf("HeadListener: Failed to connect to ethereum node %v", hl.url), "err", err)
 			} else {
-				hl.logger().Info("HeadListener: Connected to ethereum node ", hl.config.EthereumURL())
+				hl.logger().Info("HeadListener: Connected to ethereum node ", hl.url)
 				return true
 			}
 		}
@@ -197,6 +206,15 @@ func (hl *HeadListener) subscribeToHead(connected func()) error {
 	hl.connectedMutex.Lock()
 	defer hl.connectedMutex.Unlock()
 
+	if hl.dial {
+		ctx, cancel := eth.DefaultQueryCtx()
+		defer cancel()
+		if err := hl.ethClient.Dial(ctx); err != nil {
+			return errors.Wrap(err, "EthClient#Dial")
+		}
+		hl.dial = false
+	}
+
 	hl.headers = make(chan *models.Head)
 
 	sub, err := hl.ethClient.SubscribeNewHead(context.Background(), hl.headers)
@@ -0,0 +1,228 @@
+package headtracker
+
+import (
+	"context"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/store/models"
+)
+
+var (
+	promProviderFlagged = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "head_tracker_provider_flagged",
+		Help: "Set to 1 if the eth node lags behind or diverges from the heads the majority of eth nodes agree on, 0 otherwise",
+	}, []string{"provider"})
+)
+
+// headProvider is an eth node which the head tracker receives heads from
+type headProvider struct {
+	name   string
+	latest *models.Head
+	// reported is the hash the provider last reported at each recent height
+	reported map[int64]common.Hash
+	// strikes is the number of consecutive heights at which the provider
+	// reported another hash than the majority
+	strikes int64
+	flagged bool
+}
+
+// headQuorum compares the heads of several eth nodes and only passes on the
+// heads which the majority of them agree on, protecting the head tracker from
+// a single bad eth node.
+//
+// A provider which lags more than maxLag blocks behind the latest agreed
+// head, or diverges from the majority at more than maxLag consecutive
+// heights, is flagged and no longer counts towards the majority until it
+// recovers. If the providers can't agree for more than maxLag blocks, the
+// quorum follows the primary provider, the eth node of ETH_URL.
+type headQuorum struct {
+	mu            sync.Mutex
+	logger        func() *logger.Logger
+	providers     []*headProvider
+	maxLag        int64
+	depth         int64
+	handleNewHead func(ctx context.Context, head models.Head) error
+
+	// agreed is the hash the majority agreed on at each recent height
+	agreed  map[int64]common.Hash
+	highest *models.Head
+	// primaryBase is the first head number of the primary provider, the
+	// stall baseline until the providers agree on a head
+	primaryBase *int64
+	// followingPrimary is set while the quorum follows the primary provider
+	// because the providers can't agree
+	followingPrimary bool
+}
+
+// newHeadQuorum returns a quorum of the named providers, the first of which
+// is the primary provider
+func newHeadQuorum(logger func() *logger.Logger, names []string, maxLag, depth uint, handleNewHead func(ctx context.Context, head models.Head) error) *headQuorum {
+	q := &headQuorum{
+		logger:        logger,
+		maxLag:        int64(maxLag),
+		depth:         int64(depth),
+		handleNewHead: handleNewHead,
+		agreed:        make(map[int64]common.Hash),
+	}
+	for _, name := range names {
+		q.providers = append(q.providers, &headProvider{name: name, reported: make(map[int64]common.Hash)})
+		promProviderFlagged.WithLabelValues(name).Set(0)
+	}
+	return q
+}
+
+// handlerFor returns the handler of the heads of the ith provider
+func (q *headQuorum) handlerFor(i int) func(ctx context.Context, head models.Head) error {
+	return func(ctx context.Context, head models.Head) error {
+		return q.onHead(ctx, i, head)
+	}
+}
+
+func (q *headQuorum) onHead(ctx context.Context, i int, head models.Head) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	p := q.providers[i]
+	if p.latest == nil || head.Number >= p.latest.Number {
+		p.latest = &head
+	}
+	p.reported[head.Number] = head.Hash
+	if hash, exists := q.agreed[head.Number]; exists {
+		q.strike(p, head.Number, hash)
+	}
+	if i == 0 && q.primaryBase == nil {
+		q.primaryBase = &head.Number
+	}
+
+	var err error
+	if q.agreed[head.Number] != head.Hash {
+		if q.hasMajority(head) {
+			q.followingPrimary = false
+			err = q.agree(ctx, head)
+		} else if q.stalled(i, head) {
+			err = q.agree(ctx, head)
+		}
+	}
+	q.updateFlags()
+	return err
+}
+
+// hasMajority returns whether the majority of the eligible providers
+// reported head at its height
+func (q *headQuorum) hasMajority(head models.Head) bool {
+	eligible := q.eligible()
+	votes := 0
+	for _, p := range eligible {
+		if p.reported[head.Number] == head.Hash {
+			votes++
+		}
+	}
+	return votes > len(eligible)/2
+}
+
+// stalled returns whether head is a head of the primary provider which must
+// be followed because the providers have not agreed on a head for more than
+// maxLag blocks. The quorum keeps following the primary provider until the
+// providers agree again, which they do once the diverging ones are flagged.
+func (q *headQuorum) stalled(i int, head models.Head) bool {
+	if i != 0 {
+		return false
+	}
+	if q.followingPrimary {
+		return true
+	}
+	base := *q.primaryBase
+	if q.highest != nil {
+		base = q.highest.Number
+	}
+	if head.Number-base <= q.maxLag {
+		return false
+	}
+	q.logger().Warnw("HeadTracker: eth nodes disagree on the chain, following the primary eth node", "blockNumber", head.Number, "blockHash", head.Hash)
+	q.followingPrimary = true
+	return true
+}
+
+// eligible returns the providers which count towards the majority, or only
+// the primary provider if all providers are flagged
+func (q *headQuorum) eligible() []*headProvider {
+	var eligible []*headProvider
+	for _, p := range q.providers {
+		if !p.flagged {
+			eligible = append(eligible, p)
+		}
+	}
+	if len(eligible) == 0 {
+		return q.providers[:1]
+	}
+	return eligible
+}
+
+func (q *headQuorum) agree(ctx context.Context, head models.Head) error {
+	q.agreed[head.Number] = head.Hash
+	if q.highest == nil || head.Number > q.highest.Number {
+		q.highest = &head
+		q.prune()
+	}
+	for _, p := range q.providers {
+		if _, exists := p.reported[head.Number]; exists {
+			q.strike(p, head.Number, head.Hash)
+		}
+	}
+	return q.handleNewHead(ctx, head)
+}
+
+// strike counts a divergence of p from the majority if it reported another
+// hash than agreed at the given height, and forgives its past divergences
+// otherwise
+func (q *headQuorum) strike(p *headProvider, number int64, agreed common.Hash) {
+	if p.reported[number] == agreed {
+		p.strikes = 0
+		return
+	}
+	p.strikes++
+}
+
+func (q *headQuorum) updateFlags() {
+	if q.highest == nil {
+		return
+	}
+	for _, p := range q.providers {
+		lagging := p.latest == nil || q.highest.Number-p.latest.Number > q.maxLag
+		diverging := p.strikes > q.maxLag
+		flagged := lagging || diverging
+		if flagged == p.flagged {
+			continue
+		}
+		p.flagged = flagged
+		if flagged {
+			promProviderFlagged.WithLabelValues(p.name).Set(1)
+			q.logger().Warnw("HeadTracker: flagged eth node which lags behind or diverges from the majority of eth nodes", "provider", p.name, "lagging", lagging, "diverging", diverging, "agreedHead", q.highest.Number)
+		} else {
+			promProviderFlagged.WithLabelValues(p.name).Set(0)
+			q.logger().Infow("HeadTracker: eth node agrees with the majority of eth nodes again", "provider", p.name, "agreedHead", q.highest.Number)
+		}
+	}
+}
+
+// prune forgets the heights which are more than depth blocks below the
+// highest agreed head
+func (q *headQuorum) prune() {
+	floor := q.highest.Number - q.depth
+	for n := range q.agreed {
+		if n < floor {
+			delete(q.agreed, n)
+		}
+	}
+	for _, p := range q.providers {
+		for n := range p.reported {
+			if n < floor {
+				delete(p.reported, n)
+			}
+		}
+	}
+}
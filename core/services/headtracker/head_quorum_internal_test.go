@@ -0,0 +1,132 @@
+package headtracker
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/store/models"
+	"github.com/smartcontractkit/chainlink/core/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestChain(from, to int64) []models.Head {
+	var chain []models.Head
+	parentHash := utils.NewHash()
+	for n := from; n <= to; n++ {
+		h := models.Head{Number: n, Hash: utils.NewHash(), ParentHash: parentHash}
+		chain = append(chain, h)
+		parentHash = h.Hash
+	}
+	return chain
+}
+
+func newTestQuorum(t *testing.T, providers int, maxLag uint) (*headQuorum, *[]models.Head) {
+	var forwarded []models.Head
+	names := []string{"eth-primary"}
+	for i := 1; i < providers; i++ {
+		names = append(names, fmt.Sprintf("%s-%d", t.Name(), i))
+	}
+	q := newHeadQuorum(func() *logger.Logger { return logger.Default }, names, maxLag, 50, func(_ context.Context, head models.Head) error {
+		forwarded = append(forwarded, head)
+		return nil
+	})
+	return q, &forwarded
+}
+
+func forwardedNumbers(heads []models.Head) []int64 {
+	var numbers []int64
+	for _, h := range heads {
+		numbers = append(numbers, h.Number)
+	}
+	return numbers
+}
+
+func TestHeadQuorum_FollowsMajority(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	q, forwarded := newTestQuorum(t, 3, 2)
+	chain := newTestChain(1, 6)
+	fork := newTestChain(1, 6)
+
+	for i, h := range chain {
+		require.NoError(t, q.onHead(ctx, 2, fork[i]))
+		require.NoError(t, q.onHead(ctx, 0, h))
+		// Only forwarded once a second provider agrees
+		assert.Len(t, *forwarded, i)
+		require.NoError(t, q.onHead(ctx, 1, h))
+		require.Len(t, *forwarded, i+1)
+		assert.Equal(t, h.Hash, (*forwarded)[i].Hash)
+		// Further reports of an agreed head are ignored
+		require.NoError(t, q.onHead(ctx, 0, h))
+		assert.Len(t, *forwarded, i+1)
+	}
+
+	// The diverging provider is flagged after diverging at more than maxLag
+	// consecutive heights
+	assert.False(t, q.providers[0].flagged)
+	assert.False(t, q.providers[1].flagged)
+	assert.True(t, q.providers[2].flagged)
+
+	// With the diverging provider flagged, the remaining two must agree
+	next := newTestChain(7, 7)[0]
+	require.NoError(t, q.onHead(ctx, 2, next))
+	require.NoError(t, q.onHead(ctx, 0, next))
+	assert.Len(t, *forwarded, len(chain))
+	require.NoError(t, q.onHead(ctx, 1, next))
+	assert.Len(t, *forwarded, len(chain)+1)
+}
+
+func TestHeadQuorum_FlagsLaggingProvider(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	q, forwarded := newTestQuorum(t, 3, 2)
+	chain := newTestChain(1, 10)
+
+	for _, h := range chain[:5] {
+		require.NoError(t, q.onHead(ctx, 0, h))
+		require.NoError(t, q.onHead(ctx, 1, h))
+	}
+	require.NoError(t, q.onHead(ctx, 2, chain[0]))
+	assert.Equal(t, []int64{1, 2, 3, 4, 5}, forwardedNumbers(*forwarded))
+	assert.True(t, q.providers[2].flagged)
+
+	// Catching up unflags the provider
+	for _, h := range chain[1:5] {
+		require.NoError(t, q.onHead(ctx, 2, h))
+	}
+	assert.False(t, q.providers[2].flagged)
+	assert.Len(t, *forwarded, 5)
+}
+
+func TestHeadQuorum_FollowsPrimaryIfProvidersDisagree(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	q, forwarded := newTestQuorum(t, 2, 2)
+	chain := newTestChain(1, 10)
+	fork := newTestChain(1, 10)
+
+	for i := 0; i < 3; i++ {
+		require.NoError(t, q.onHead(ctx, 0, chain[i]))
+		require.NoError(t, q.onHead(ctx, 1, fork[i]))
+	}
+	assert.Empty(t, *forwarded)
+
+	// More than maxLag blocks without agreement
+	for i := 3; i < len(chain); i++ {
+		require.NoError(t, q.onHead(ctx, 0, chain[i]))
+		require.NoError(t, q.onHead(ctx, 1, fork[i]))
+	}
+	assert.Equal(t, []int64{4, 5, 6, 7, 8, 9, 10}, forwardedNumbers(*forwarded))
+	for _, h := range *forwarded {
+		assert.Equal(t, chain[h.Number-1].Hash, h.Hash)
+	}
+	assert.False(t, q.providers[0].flagged)
+	assert.True(t, q.providers[1].flagged)
+	assert.False(t, q.followingPrimary)
+}
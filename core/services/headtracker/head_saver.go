@@ -5,26 +5,40 @@ import (
 	"sync"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
 	"github.com/smartcontractkit/chainlink/core/services/postgres"
 	"github.com/smartcontractkit/chainlink/core/store/models"
+	"gorm.io/gorm"
 )
 
-// TODO: Needs to be optimised to allow for in-memory reads and not hit the DB every time
-// See: https://app.clubhouse.io/chainlinklabs/story/13314/optimise-headsaver-to-not-hit-the-db-so-much
+// HeadSaver persists heads to the heads table, and caches the recent ones so
+// that chains are mostly read from memory
 type HeadSaver struct {
 	highestSeenHead *models.Head
 	orm             *ORM
 	config          Config
 	headMutex       sync.RWMutex
+	cache           *headCache
 }
 
 func NewHeadSaver(orm *ORM, config Config) *HeadSaver {
 	return &HeadSaver{
 		orm:    orm,
 		config: config,
+		// Leaves room for the forks of the persisted heads
+		cache: newHeadCache(2 * int(historyDepth(config))),
 	}
 }
 
+// historyDepth is the number of block heights to keep in the heads table,
+// which must cover at least ETH_FINALITY_DEPTH for chains to be complete
+func historyDepth(config Config) uint {
+	if config.EthFinalityDepth() > config.EthHeadTrackerHistoryDepth() {
+		return config.EthFinalityDepth()
+	}
+	return config.EthHeadTrackerHistoryDepth()
+}
+
 // Save updates the latest block number, if indeed the latest, and persists
 // this number in case of reboot. Thread safe.
 func (ht *HeadSaver) Save(ctx context.Context, h models.Head) error {
@@ -40,7 +54,8 @@ func (ht *HeadSaver) Save(ctx context.Context, h models.Head) error {
 	} else if err != nil {
 		return err
 	}
-	return ht.orm.TrimOldHeads(ctx, ht.config.EthHeadTrackerHistoryDepth())
+	ht.cache.add(h)
+	return ht.orm.TrimOldHeads(ctx, historyDepth(ht.config))
 }
 
 // HighestSeenHead returns the block header with the highest number that has been seen, or nil
@@ -56,7 +71,11 @@ func (ht *HeadSaver) HighestSeenHead() *models.Head {
 }
 
 func (ht *HeadSaver) IdempotentInsertHead(ctx context.Context, head models.Head) error {
-	return ht.orm.IdempotentInsertHead(ctx, head)
+	if err := ht.orm.IdempotentInsertHead(ctx, head); err != nil {
+		return err
+	}
+	ht.cache.add(head)
+	return nil
 }
 
 func (ht *HeadSaver) SetHighestSeenHeadFromDB() (*models.Head, error) {
@@ -76,10 +95,47 @@ func (ht *HeadSaver) HighestSeenHeadFromDB() (*models.Head, error) {
 	return ht.orm.LastHead(ctxQuery)
 }
 
+// Chain returns the chain of heads starting at hash and up to depth-1
+// parents. The cached part of the chain is read from memory, only the rest
+// is loaded from the database.
 func (ht *HeadSaver) Chain(ctx context.Context, hash common.Hash, depth uint) (models.Head, error) {
-	return ht.orm.Chain(ctx, hash, depth)
+	var heads []models.Head
+	for uint(len(heads)) < depth {
+		h, exists := ht.cache.get(hash)
+		if !exists {
+			break
+		}
+		heads = append(heads, h)
+		hash = h.ParentHash
+	}
+
+	if uint(len(heads)) < depth {
+		rest, err := ht.orm.Chain(ctx, hash, depth-uint(len(heads)))
+		if err == nil {
+			for h := &rest; h != nil; h = h.Parent {
+				ht.cache.add(*h)
+				heads = append(heads, *h)
+			}
+		} else if !errors.Is(err, gorm.ErrRecordNotFound) || len(heads) == 0 {
+			// Unless the chain just ends with the cached heads
+			return models.Head{}, err
+		}
+	}
+
+	for i := len(heads) - 2; i >= 0; i-- {
+		heads[i].Parent = &heads[i+1]
+	}
+	return heads[0], nil
 }
 
+// HeadByHash returns the head with the given hash, or nil if none exists
 func (ht *HeadSaver) HeadByHash(ctx context.Context, hash common.Hash) (*models.Head, error) {
-	return ht.orm.HeadByHash(ctx, hash)
+	if h, exists := ht.cache.get(hash); exists {
+		return &h, nil
+	}
+	h, err := ht.orm.HeadByHash(ctx, hash)
+	if err == nil && h != nil {
+		ht.cache.add(*h)
+	}
+	return h, err
 }
@@ -0,0 +1,56 @@
+package headtracker_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/services/headtracker"
+	"github.com/smartcontractkit/chainlink/core/store/models"
+	"github.com/smartcontractkit/chainlink/core/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHeadSaver_Chain(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+	orm := headtracker.NewORM(store.DB)
+	saver := headtracker.NewHeadSaver(orm, store.Config)
+
+	var chain []models.Head
+	parentHash := utils.NewHash()
+	for n := int64(0); n < 8; n++ {
+		h := models.Head{Number: n, Hash: utils.NewHash(), ParentHash: parentHash}
+		if n < 4 {
+			// Only in the database
+			require.NoError(t, orm.IdempotentInsertHead(context.Background(), h))
+		} else {
+			require.NoError(t, saver.Save(context.Background(), h))
+		}
+		chain = append(chain, h)
+		parentHash = h.Hash
+	}
+
+	head, err := saver.Chain(context.Background(), chain[7].Hash, 10)
+	require.NoError(t, err)
+	assert.Equal(t, uint32(8), head.ChainLength())
+	for h, n := &head, 7; h != nil; h, n = h.Parent, n-1 {
+		assert.Equal(t, chain[n].Hash, h.Hash)
+	}
+
+	head, err = saver.Chain(context.Background(), chain[7].Hash, 3)
+	require.NoError(t, err)
+	assert.Equal(t, uint32(3), head.ChainLength())
+	assert.Equal(t, chain[5].Hash, head.EarliestInChain().Hash)
+
+	existing, err := saver.HeadByHash(context.Background(), chain[2].Hash)
+	require.NoError(t, err)
+	require.NotNil(t, existing)
+	assert.Equal(t, int64(2), existing.Number)
+
+	_, err = saver.Chain(context.Background(), utils.NewHash(), 10)
+	require.Error(t, err)
+}
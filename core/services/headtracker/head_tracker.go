@@ -8,6 +8,7 @@ import (
 	"sync/atomic"
 	"time"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/pkg/errors"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
@@ -29,6 +30,28 @@ var (
 		Name: "head_tracker_very_old_head",
 		Help: "Counter is incremented every time we get a head that is much lower than the highest seen head ('much lower' is defined as a block that is ETH_FINALITY_DEPTH or greater below the highest seen head)",
 	})
+
+	promFinalizedHead = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "head_tracker_finalized_head",
+		Help: "The highest finalized head number",
+	})
+
+	promSafeHead = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "head_tracker_safe_head",
+		Help: "The highest safe head number, only set if the eth node supports the `safe` block tag",
+	})
+
+	promReorgDepth = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "head_tracker_reorg_depth",
+		Help:    "The depth of the reorgs observed by the head tracker, in blocks",
+		Buckets: []float64{1, 2, 3, 5, 10, 20, 50, 100},
+	}, []string{"evmChainID"})
+)
+
+// Block tags supported by post-merge eth nodes
+const (
+	blockTagFinalized = "finalized"
+	blockTagSafe      = "safe"
 )
 
 // HeadTracker holds and stores the latest block number experienced by this particular node
@@ -45,9 +68,17 @@ type HeadTracker struct {
 	muLogger     sync.RWMutex
 	headListener *HeadListener
 	headSaver    *HeadSaver
-	chStop       chan struct{}
-	wgDone       *sync.WaitGroup
+	orm          *ORM
+	// headProviders are the listeners of the eth nodes of
+	// ETH_HEAD_TRACKER_URLS
+	headProviders []*HeadListener
+	chStop        chan struct{}
+	wgDone        *sync.WaitGroup
 	utils.StartStopOnce
+
+	muFinality    sync.RWMutex
+	finalizedHead *models.Head
+	safeHead      *models.Head
 }
 
 // NewHeadTracker instantiates a new HeadTracker using the orm to persist new block numbers.
@@ -73,8 +104,9 @@ func NewHeadTracker(
 		samplingMB:      *utils.NewMailbox(1),
 		chStop:          chStop,
 		wgDone:          &wgDone,
-		headListener:    NewHeadListener(l, ethClient, config, chStop, &wgDone, sleepers...),
+		headListener:    NewHeadListener(l, ethClient, config.EthereumURL(), config, chStop, &wgDone, sleepers...),
 		headSaver:       NewHeadSaver(orm, config),
+		orm:             orm,
 	}
 }
 
@@ -84,6 +116,20 @@ func (ht *HeadTracker) SetLogger(logger *logger.Logger) {
 	defer ht.muLogger.Unlock()
 	ht.log = logger
 	ht.headListener.SetLogger(logger)
+	for _, hl := range ht.headProviders {
+		hl.SetLogger(logger)
+	}
+}
+
+// AddHeadProvider adds an eth node which the head tracker subscribes to heads
+// from. If any are added, the head tracker only follows the heads which the
+// majority of them and the eth node of ETH_URL agree on. The head tracker
+// dials ethClient itself, retrying until it connects, so that an unavailable
+// eth node does not prevent it from starting. Must be called before Start.
+func (ht *HeadTracker) AddHeadProvider(url string, ethClient eth.Client) {
+	hl := NewHeadListener(ht.logger(), ethClient, url, ht.config, ht.chStop, ht.wgDone)
+	hl.dial = true
+	ht.headProviders = append(ht.headProviders, hl)
 }
 
 func (ht *HeadTracker) logger() *logger.Logger {
@@ -131,8 +177,22 @@ func (ht *HeadTracker) Start() error {
 			}
 		}
 
+		handleNewHead := ht.handleNewHead
+		if len(ht.headProviders) > 0 {
+			names := []string{"eth-primary"}
+			for i := range ht.headProviders {
+				names = append(names, fmt.Sprintf("eth-head-tracker-%d", i))
+			}
+			quorum := newHeadQuorum(ht.logger, names, ht.config.EthHeadTrackerMaxProviderLag(), ht.config.EthFinalityDepth(), ht.handleNewHead)
+			handleNewHead = quorum.handlerFor(0)
+			for i, hl := range ht.headProviders {
+				ht.wgDone.Add(1)
+				go hl.ListenForNewHeads(quorum.handlerFor(i+1), func() {})
+			}
+		}
+
 		ht.wgDone.Add(3)
-		go ht.headListener.ListenForNewHeads(ht.handleNewHead, ht.handleConnected)
+		go ht.headListener.ListenForNewHeads(handleNewHead, ht.handleConnected)
 		go ht.backfiller()
 		go ht.headSampler()
 
@@ -161,6 +221,11 @@ func (ht *HeadTracker) Stop() error {
 		ht.logger().Info(fmt.Sprintf("HeadTracker disconnecting from %v", ht.config.EthereumURL()))
 		close(ht.chStop)
 		ht.wgDone.Wait()
+		for _, hl := range ht.headProviders {
+			if !hl.dial {
+				hl.ethClient.Close()
+			}
+		}
 		return nil
 	})
 }
@@ -201,6 +266,10 @@ func (ht *HeadTracker) headSampler() {
 	ctx, cancel := utils.ContextFromChan(ht.chStop)
 	defer cancel()
 
+	// The interval is sampled by the ticker
+	sampling := httypes.HeadSampling{Blocks: ht.config.EthHeadTrackerSamplingBlocks()}
+	var last lastSample
+
 	for {
 		select {
 		case <-ht.chStop:
@@ -215,9 +284,102 @@ func (ht *HeadTracker) headSampler() {
 				panic(fmt.Sprintf("expected `models.Head`, got %T", item))
 			}
 
-			ht.headBroadcaster.OnNewLongestChain(ctx, head)
+			if now := time.Now(); last.due(sampling, head, now) {
+				last.record(head, now)
+				ht.headBroadcaster.OnNewLongestChain(ctx, head)
+			}
+			ht.updateFinality(ctx, head)
+		}
+	}
+}
+
+// LatestFinalizedHead returns the highest head known to be finalized, or nil
+// if no head has been finalized yet.
+func (ht *HeadTracker) LatestFinalizedHead() *models.Head {
+	ht.muFinality.RLock()
+	defer ht.muFinality.RUnlock()
+	return ht.finalizedHead
+}
+
+// LatestSafeHead returns the highest head known to be safe, or nil if the eth
+// node does not support the `safe` block tag.
+func (ht *HeadTracker) LatestSafeHead() *models.Head {
+	ht.muFinality.RLock()
+	defer ht.muFinality.RUnlock()
+	return ht.safeHead
+}
+
+// updateFinality determines the finalized and safe heads for the given
+// longest chain and notifies finalized head subscribers if the finalized head
+// advanced.
+//
+// If ETH_USE_FINALITY_TAG is enabled the eth node is asked for the
+// `finalized` and `safe` blocks. Otherwise, or if the node does not support
+// the tags, the block ETH_FINALITY_DEPTH-1 below the head is considered final.
+func (ht *HeadTracker) updateFinality(ctx context.Context, headWithChain models.Head) {
+	var finalized, safe *models.Head
+	if ht.config.EthUseFinalityTag() {
+		var err error
+		finalized, err = ht.fetchTaggedHead(ctx, blockTagFinalized)
+		if ctx.Err() != nil {
+			return
+		} else if err != nil {
+			ht.logger().Warnw("HeadTracker: failed to fetch finalized head, falling back to ETH_FINALITY_DEPTH", "err", err)
+		}
+		safe, err = ht.fetchTaggedHead(ctx, blockTagSafe)
+		if ctx.Err() != nil {
+			return
+		} else if err != nil {
+			ht.logger().Debugw("HeadTracker: failed to fetch safe head", "err", err)
+		}
+	}
+	if finalized == nil {
+		finalized = finalizedByDepth(headWithChain, ht.config.EthFinalityDepth())
+	}
+
+	ht.muFinality.Lock()
+	if safe != nil && (ht.safeHead == nil || safe.Number > ht.safeHead.Number) {
+		ht.safeHead = safe
+		promSafeHead.Set(float64(safe.Number))
+	}
+	advanced := finalized != nil && (ht.finalizedHead == nil || finalized.Number > ht.finalizedHead.Number)
+	if advanced {
+		ht.finalizedHead = finalized
+		promFinalizedHead.Set(float64(finalized.Number))
+	}
+	ht.muFinality.Unlock()
+
+	if advanced {
+		ht.headBroadcaster.OnNewFinalizedHead(ctx, *finalized)
+	}
+}
+
+func (ht *HeadTracker) fetchTaggedHead(ctx context.Context, tag string) (head *models.Head, err error) {
+	ctx, cancel := eth.DefaultQueryCtx(ctx)
+	defer cancel()
+	err = ht.ethClient.CallContext(ctx, &head, "eth_getBlockByNumber", tag, false)
+	if err == nil && head == nil {
+		err = errors.Errorf("eth node returned no %s block", tag)
+	}
+	return head, err
+}
+
+// finalizedByDepth walks the chain to find the block which is depth-1 below
+// the head, matching the semantics of ETH_FINALITY_DEPTH. Returns nil if the
+// chain is not long enough.
+func finalizedByDepth(headWithChain models.Head, depth uint) *models.Head {
+	if depth == 0 {
+		return nil
+	}
+	target := headWithChain.Number - int64(depth-1)
+	for h := &headWithChain; h != nil; h = h.Parent {
+		if h.Number == target {
+			finalized := *h
+			finalized.Parent = nil
+			return &finalized
 		}
 	}
+	return nil
 }
 
 func (ht *HeadTracker) backfiller() {
@@ -361,6 +523,9 @@ func (ht *HeadTracker) handleNewHead(ctx context.Context, head models.Head) erro
 		} else if err != nil {
 			return errors.Wrap(err, "HeadTracker#handleNewHighestHead failed fetching chain")
 		}
+		if prevHead != nil {
+			ht.recordReorg(ctx, *prevHead, headWithChain)
+		}
 
 		ht.backfillMB.Deliver(headWithChain)
 		ht.samplingMB.Deliver(headWithChain)
@@ -382,6 +547,43 @@ func (ht *HeadTracker) handleNewHead(ctx context.Context, head models.Head) erro
 	return nil
 }
 
+// recordReorg records a reorg if the new longest chain replaced the previous
+// highest head. Reorgs are only detected once the new chain reaches back to
+// the height of the previous highest head, i.e. if the heads of the new
+// branch were received rather than backfilled.
+func (ht *HeadTracker) recordReorg(ctx context.Context, prevHead models.Head, headWithChain models.Head) {
+	if headWithChain.HashAtHeight(prevHead.Number) == (common.Hash{}) || headWithChain.IsInChain(prevHead.Hash) {
+		return
+	}
+	oldChain, err := ht.headSaver.Chain(ctx, prevHead.Hash, ht.config.EthFinalityDepth())
+	if ctx.Err() != nil {
+		return
+	} else if err != nil {
+		ht.logger().Warnw("HeadTracker: failed to load previous chain to determine reorg depth", "err", err)
+		return
+	}
+
+	chainID := ht.config.ChainID()
+	reorg := Reorg{
+		EVMChainID:  *utils.NewBig(chainID),
+		Depth:       reorgDepth(oldChain, headWithChain),
+		BlockNumber: headWithChain.Number,
+		OldHeadHash: prevHead.Hash,
+		NewHeadHash: headWithChain.Hash,
+		CreatedAt:   time.Now(),
+	}
+	promReorgDepth.WithLabelValues(chainID.String()).Observe(float64(reorg.Depth))
+	ht.logger().Infow(fmt.Sprintf("HeadTracker: reorg of depth %d", reorg.Depth),
+		"depth", reorg.Depth,
+		"blockHeight", headWithChain.Number,
+		"oldHeadHash", prevHead.Hash,
+		"newHeadHash", headWithChain.Hash,
+	)
+	if err := ht.orm.InsertReorg(ctx, &reorg); ctx.Err() == nil && err != nil {
+		ht.logger().Errorw("HeadTracker: failed to record reorg", "err", err)
+	}
+}
+
 func (ht *HeadTracker) Healthy() error {
 	if atomic.LoadInt32(&ht.headListener.receivesHeads) != 1 {
 		return errors.New("Heads are not being received")
@@ -220,6 +220,53 @@ func TestHeadTracker_CallsHeadTrackableCallbacks(t *testing.T) {
 	assert.Equal(t, int32(1), checker.OnNewLongestChainCount())
 }
 
+func TestHeadTracker_TracksFinalizedHeadByDepth(t *testing.T) {
+	t.Parallel()
+	g := gomega.NewGomegaWithT(t)
+
+	db := pgtest.NewGormDB(t)
+	config := cltest.NewTestConfig(t)
+	config.Set("ETH_FINALITY_DEPTH", 2)
+	config.Set("ETH_USE_FINALITY_TAG", false)
+	orm := headtracker.NewORM(db)
+
+	sub := new(mocks.Subscription)
+	ethClient := new(mocks.Client)
+
+	h1 := *cltest.Head(1)
+	h2 := *cltest.Head(2)
+	h2.ParentHash = h1.Hash
+	h3 := *cltest.Head(3)
+	h3.ParentHash = h2.Hash
+
+	chchHeaders := make(chan chan<- *models.Head, 1)
+	ethClient.On("ChainID", mock.Anything).Return(config.ChainID(), nil)
+	ethClient.On("SubscribeNewHead", mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) {
+			chchHeaders <- args.Get(1).(chan<- *models.Head)
+		}).
+		Return(sub, nil)
+	ethClient.On("HeadByNumber", mock.Anything, mock.Anything).Return(&h1, nil)
+
+	sub.On("Unsubscribe").Return()
+	sub.On("Err").Return(nil)
+
+	checker := &cltest.MockHeadTrackable{}
+	ht := createHeadTrackerWithChecker(ethClient, config, orm, checker)
+	ht.headBroadcaster.SubscribeFinalized(checker)
+
+	require.NoError(t, ht.Start())
+	defer ht.Stop()
+
+	headers := <-chchHeaders
+	headers <- &h2
+	headers <- &h3
+	g.Eventually(func() int64 { return checker.LatestFinalizedNumber() }, cltest.DBWaitTimeout).Should(gomega.Equal(int64(2)))
+	assert.Equal(t, h2.Hash, ht.headTracker.LatestFinalizedHead().Hash)
+	// safe head is only tracked when the eth node supports the block tag
+	assert.Nil(t, ht.headTracker.LatestSafeHead())
+}
+
 func TestHeadTracker_ReconnectOnError(t *testing.T) {
 	t.Parallel()
 	g := gomega.NewGomegaWithT(t)
@@ -491,6 +538,12 @@ func TestHeadTracker_SwitchesToLongestChain(t *testing.T) {
 		assert.Equal(t, c.Number, h.Number)
 	}
 
+	// Block 5 replaced blocks 2 to 4 of the previous longest chain
+	stats, err := orm.ChainReorgStats(context.TODO(), config.ChainID(), time.Time{})
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), stats.Count)
+	assert.Equal(t, int64(3), stats.MaxDepth)
+
 	checker.AssertExpectations(t)
 }
 
@@ -765,7 +818,7 @@ func TestHeadTracker_Backfill(t *testing.T) {
 }
 
 func createHeadTracker(ethClient eth.Client, config headtracker.Config, orm *headtracker.ORM) *headTrackerUniverse {
-	hb := headtracker.NewHeadBroadcaster()
+	hb := headtracker.NewHeadBroadcaster(config)
 	return &headTrackerUniverse{
 		headTracker:     headtracker.NewHeadTracker(logger.Default, ethClient, config, orm, hb),
 		headBroadcaster: hb,
@@ -773,7 +826,7 @@ func createHeadTracker(ethClient eth.Client, config headtracker.Config, orm *hea
 }
 
 func createHeadTrackerWithNeverSleeper(ethClient eth.Client, config headtracker.Config, orm *headtracker.ORM) *headTrackerUniverse {
-	hb := headtracker.NewHeadBroadcaster()
+	hb := headtracker.NewHeadBroadcaster(config)
 	return &headTrackerUniverse{
 		headTracker:     headtracker.NewHeadTracker(logger.Default, ethClient, config, orm, hb, cltest.NeverSleeper{}),
 		headBroadcaster: hb,
@@ -781,7 +834,7 @@ func createHeadTrackerWithNeverSleeper(ethClient eth.Client, config headtracker.
 }
 
 func createHeadTrackerWithChecker(ethClient eth.Client, config headtracker.Config, orm *headtracker.ORM, checker httypes.HeadTrackable) *headTrackerUniverse {
-	hb := headtracker.NewHeadBroadcaster()
+	hb := headtracker.NewHeadBroadcaster(config)
 	hb.Subscribe(checker)
 	hb.Start()
 	return &headTrackerUniverse{
@@ -58,6 +58,11 @@ func (_m *HeadBroadcaster) Healthy() error {
 	return r0
 }
 
+// OnNewFinalizedHead provides a mock function with given fields: ctx, head
+func (_m *HeadBroadcaster) OnNewFinalizedHead(ctx context.Context, head models.Head) {
+	_m.Called(ctx, head)
+}
+
 // OnNewLongestChain provides a mock function with given fields: ctx, head
 func (_m *HeadBroadcaster) OnNewLongestChain(ctx context.Context, head models.Head) {
 	_m.Called(ctx, head)
@@ -115,3 +120,53 @@ func (_m *HeadBroadcaster) Subscribe(callback types.HeadTrackable) (*models.Head
 
 	return r0, r1
 }
+
+// SubscribeFinalized provides a mock function with given fields: callback
+func (_m *HeadBroadcaster) SubscribeFinalized(callback types.FinalizedHeadTrackable) (*models.Head, func()) {
+	ret := _m.Called(callback)
+
+	var r0 *models.Head
+	if rf, ok := ret.Get(0).(func(types.FinalizedHeadTrackable) *models.Head); ok {
+		r0 = rf(callback)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Head)
+		}
+	}
+
+	var r1 func()
+	if rf, ok := ret.Get(1).(func(types.FinalizedHeadTrackable) func()); ok {
+		r1 = rf(callback)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(func())
+		}
+	}
+
+	return r0, r1
+}
+
+// SubscribeWithSampling provides a mock function with given fields: callback, sampling
+func (_m *HeadBroadcaster) SubscribeWithSampling(callback types.HeadTrackable, sampling types.HeadSampling) (*models.Head, func()) {
+	ret := _m.Called(callback, sampling)
+
+	var r0 *models.Head
+	if rf, ok := ret.Get(0).(func(types.HeadTrackable, types.HeadSampling) *models.Head); ok {
+		r0 = rf(callback, sampling)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Head)
+		}
+	}
+
+	var r1 func()
+	if rf, ok := ret.Get(1).(func(types.HeadTrackable, types.HeadSampling) func()); ok {
+		r1 = rf(callback, sampling)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(func())
+		}
+	}
+
+	return r0, r1
+}
@@ -2,7 +2,9 @@ package headtracker_test
 
 import (
 	"context"
+	"math/big"
 	"testing"
+	"time"
 
 	"github.com/smartcontractkit/chainlink/core/utils"
 
@@ -118,3 +120,59 @@ func TestORM_Heads_IdempotentInsertHead(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, head.Hash, foundHead.Hash)
 }
+
+func TestORM_ReorgStats(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewGormDB(t)
+	orm := headtracker.NewORM(db)
+	chainID := big.NewInt(1)
+	otherChainID := big.NewInt(42)
+	now := time.Now()
+
+	for _, r := range []struct {
+		chainID *big.Int
+		depth   int64
+		at      time.Time
+	}{
+		{chainID, 1, now.Add(-48 * time.Hour)},
+		{chainID, 1, now.Add(-time.Hour)},
+		{chainID, 1, now.Add(-time.Minute)},
+		{chainID, 3, now.Add(-time.Hour)},
+		{otherChainID, 2, now.Add(-time.Hour)},
+	} {
+		require.NoError(t, orm.InsertReorg(context.TODO(), &headtracker.Reorg{
+			EVMChainID:  *utils.NewBig(r.chainID),
+			Depth:       r.depth,
+			BlockNumber: 100,
+			OldHeadHash: utils.NewHash(),
+			NewHeadHash: utils.NewHash(),
+			CreatedAt:   r.at,
+		}))
+	}
+
+	stats, err := orm.ReorgStats(context.TODO(), time.Time{})
+	require.NoError(t, err)
+	require.Len(t, stats, 2)
+	assert.Equal(t, chainID, stats[0].EVMChainID.ToInt())
+	assert.Equal(t, int64(4), stats[0].Count)
+	assert.Equal(t, otherChainID, stats[1].EVMChainID.ToInt())
+	assert.Equal(t, int64(1), stats[1].Count)
+
+	s, err := orm.ChainReorgStats(context.TODO(), chainID, now.Add(-24*time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), s.Count)
+	assert.Equal(t, int64(3), s.MaxDepth)
+	require.Len(t, s.Depths, 2)
+	assert.Equal(t, int64(1), s.Depths[0].Depth)
+	assert.Equal(t, int64(2), s.Depths[0].Count)
+	assert.Equal(t, int64(3), s.Depths[1].Depth)
+	assert.Equal(t, int64(1), s.Depths[1].Count)
+	assert.Equal(t, int64(1), s.DepthPercentile(0.5))
+	assert.Equal(t, int64(3), s.DepthPercentile(0.99))
+
+	s, err = orm.ChainReorgStats(context.TODO(), big.NewInt(3), time.Time{})
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), s.Count)
+	assert.Equal(t, int64(0), s.DepthPercentile(0.99))
+}
@@ -0,0 +1,107 @@
+package headtracker
+
+import (
+	"context"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+
+	httypes "github.com/smartcontractkit/chainlink/core/services/headtracker/types"
+	"github.com/smartcontractkit/chainlink/core/store/models"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+var _ httypes.ReorgStatsReader = &ORM{}
+
+// Reorg is a reorg observed by the head tracker
+type Reorg struct {
+	ID         int64
+	EVMChainID utils.Big
+	// Depth is the number of blocks of the previous longest chain which were
+	// replaced
+	Depth int64
+	// BlockNumber is the number of the head which made the new chain the
+	// longest
+	BlockNumber int64
+	OldHeadHash common.Hash
+	NewHeadHash common.Hash
+	CreatedAt   time.Time
+}
+
+func (Reorg) TableName() string {
+	return "head_reorgs"
+}
+
+// reorgDepth returns the number of blocks of oldChain which newChain
+// replaced. If the chains have no common ancestor within their loaded length,
+// it returns the length of oldChain, which is a lower bound of the depth.
+func reorgDepth(oldChain, newChain models.Head) int64 {
+	for h := &oldChain; h != nil; h = h.Parent {
+		if newChain.IsInChain(h.Hash) {
+			return oldChain.Number - h.Number
+		}
+	}
+	return int64(oldChain.ChainLength())
+}
+
+// InsertReorg records an observed reorg
+func (orm *ORM) InsertReorg(ctx context.Context, reorg *Reorg) error {
+	return orm.db.WithContext(ctx).Create(reorg).Error
+}
+
+// ReorgStats returns the reorg statistics of every chain with reorgs since
+// the given time
+func (orm *ORM) ReorgStats(ctx context.Context, since time.Time) ([]httypes.ReorgStats, error) {
+	return orm.reorgStats(ctx, since, nil)
+}
+
+// ChainReorgStats returns the reorg statistics of one chain since the given
+// time
+func (orm *ORM) ChainReorgStats(ctx context.Context, evmChainID *big.Int, since time.Time) (httypes.ReorgStats, error) {
+	stats, err := orm.reorgStats(ctx, since, evmChainID)
+	if err != nil || len(stats) == 0 {
+		return httypes.ReorgStats{EVMChainID: *utils.NewBig(evmChainID)}, err
+	}
+	return stats[0], nil
+}
+
+func (orm *ORM) reorgStats(ctx context.Context, since time.Time, evmChainID *big.Int) ([]httypes.ReorgStats, error) {
+	var rows []struct {
+		EVMChainID utils.Big
+		Depth      int64
+		Count      int64
+		LastSeenAt time.Time
+	}
+	q := orm.db.WithContext(ctx).
+		Table("head_reorgs").
+		Select("evm_chain_id, depth, COUNT(*) AS count, MAX(created_at) AS last_seen_at").
+		Where("created_at >= ?", since)
+	if evmChainID != nil {
+		q = q.Where("evm_chain_id = ?", utils.NewBig(evmChainID))
+	}
+	if err := q.Group("evm_chain_id, depth").Order("evm_chain_id, depth").Scan(&rows).Error; err != nil {
+		return nil, errors.Wrap(err, "failed to load reorg stats")
+	}
+
+	stats := []httypes.ReorgStats{}
+	for _, row := range rows {
+		if len(stats) == 0 || stats[len(stats)-1].EVMChainID.ToInt().Cmp(row.EVMChainID.ToInt()) != 0 {
+			stats = append(stats, httypes.ReorgStats{EVMChainID: row.EVMChainID})
+		}
+		s := &stats[len(stats)-1]
+		s.Count += row.Count
+		// Rows are ordered by depth
+		s.MaxDepth = row.Depth
+		if row.LastSeenAt.After(s.LastReorgAt) {
+			s.LastReorgAt = row.LastSeenAt
+		}
+		s.Depths = append(s.Depths, httypes.ReorgDepthCount{
+			Depth:      row.Depth,
+			Count:      row.Count,
+			LastSeenAt: row.LastSeenAt,
+		})
+	}
+	return stats, nil
+}
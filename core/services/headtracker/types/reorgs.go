@@ -0,0 +1,58 @@
+package types
+
+import (
+	"context"
+	"math"
+	"math/big"
+	"time"
+
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+// ReorgStats summarises the reorgs observed on a chain
+type ReorgStats struct {
+	EVMChainID utils.Big
+	Count      int64
+	MaxDepth   int64
+	// LastReorgAt is the time of the latest reorg, zero if none was observed
+	LastReorgAt time.Time
+	// Depths is the number of reorgs of each observed depth, shallowest first
+	Depths []ReorgDepthCount
+}
+
+// ReorgDepthCount is the number of reorgs of a given depth
+type ReorgDepthCount struct {
+	Depth      int64
+	Count      int64
+	LastSeenAt time.Time
+}
+
+// DepthPercentile returns the smallest depth which at least the fraction p
+// of the reorgs did not exceed, e.g. DepthPercentile(0.99) is a number of
+// confirmations which would have outlasted 99% of the observed reorgs.
+// Returns 0 if no reorgs were observed.
+func (s ReorgStats) DepthPercentile(p float64) int64 {
+	if s.Count == 0 {
+		return 0
+	}
+	target := int64(math.Ceil(p * float64(s.Count)))
+	var seen int64
+	for _, d := range s.Depths {
+		seen += d.Count
+		if seen >= target {
+			return d.Depth
+		}
+	}
+	return s.MaxDepth
+}
+
+// ReorgStatsReader gives access to the reorg statistics collected by the head
+// tracker, for services which tune confirmations or finality to the chain
+type ReorgStatsReader interface {
+	// ReorgStats returns the statistics of every chain with reorgs since the
+	// given time
+	ReorgStats(ctx context.Context, since time.Time) ([]ReorgStats, error)
+	// ChainReorgStats returns the statistics of one chain since the given
+	// time, with a zero Count if it had no reorgs
+	ChainReorgStats(ctx context.Context, evmChainID *big.Int, since time.Time) (ReorgStats, error)
+}
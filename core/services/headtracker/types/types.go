@@ -2,6 +2,7 @@ package types
 
 import (
 	"context"
+	"time"
 
 	"github.com/smartcontractkit/chainlink/core/logger"
 	"github.com/smartcontractkit/chainlink/core/service"
@@ -25,8 +26,29 @@ type HeadTrackable interface {
 	OnNewLongestChain(ctx context.Context, head models.Head)
 }
 
+// FinalizedHeadTrackable represents any object that wishes to be notified
+// when the finalized head advances, after being subscribed to HeadBroadcaster
+type FinalizedHeadTrackable interface {
+	OnNewFinalizedHead(ctx context.Context, head models.Head)
+}
+
+// HeadSampling limits how often a subscriber is called back with heads. Heads
+// are already sampled by ETH_HEAD_TRACKER_SAMPLING_INTERVAL and
+// ETH_HEAD_TRACKER_SAMPLING_BLOCKS before they are broadcast, so a
+// subscriber's sampling can only make its callbacks rarer. Zero fields don't
+// limit callbacks.
+type HeadSampling struct {
+	// Interval is the minimum time between two callbacks
+	Interval time.Duration
+	// Blocks is the minimum number of blocks between the heads of two
+	// callbacks
+	Blocks uint
+}
+
 type HeadBroadcasterRegistry interface {
 	Subscribe(callback HeadTrackable) (currentLongestChain *models.Head, unsubscribe func())
+	SubscribeWithSampling(callback HeadTrackable, sampling HeadSampling) (currentLongestChain *models.Head, unsubscribe func())
+	SubscribeFinalized(callback FinalizedHeadTrackable) (currentFinalized *models.Head, unsubscribe func())
 }
 
 // HeadBroadcaster is the external interface of headBroadcaster
@@ -34,7 +56,10 @@ type HeadBroadcasterRegistry interface {
 type HeadBroadcaster interface {
 	service.Service
 	HeadTrackable
+	FinalizedHeadTrackable
 	Subscribe(callback HeadTrackable) (currentLongestChain *models.Head, unsubscribe func())
+	SubscribeWithSampling(callback HeadTrackable, sampling HeadSampling) (currentLongestChain *models.Head, unsubscribe func())
+	SubscribeFinalized(callback FinalizedHeadTrackable) (currentFinalized *models.Head, unsubscribe func())
 }
 
 // HeadTrackableCallback is a simple wrapper around an On Connect callback
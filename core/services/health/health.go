@@ -1,6 +1,8 @@
 package health
 
 import (
+	"fmt"
+	"sort"
 	"sync"
 	"time"
 
@@ -18,12 +20,24 @@ type Checkable interface {
 	Healthy() error
 }
 
+// Composite is implemented by services which run other services created at
+// runtime, such as the job spawner the services of its jobs. Their checks are
+// reported in the health tree as children of the service, but do not count
+// towards the health or readiness of the node.
+type Composite interface {
+	Checkable
+	// SubChecks returns the checks of the services run by the service, by
+	// name
+	SubChecks() map[string]Checkable
+}
+
 //go:generate mockery --name Checker --output ../../internal/mocks/ --case=underscore
 type (
 	// Checker provides a service which can be probed for system health.
 	Checker interface {
-		// Register a service for health checks.
-		Register(name string, service Checkable) error
+		// Register a service for health checks. It is reported in the health
+		// tree as a dependent of the services it depends on.
+		Register(name string, service Checkable, dependsOn ...string) error
 		// Unregister a service.
 		Unregister(name string) error
 		// IsReady returns the current readiness of the system.
@@ -32,6 +46,9 @@ type (
 		// IsHealthy returns the current health of the system.
 		// A system is considered healthy if all checks are passing (no errors)
 		IsHealthy() (healthy bool, errors map[string]error)
+		// Tree returns the health of the services as a tree, in which
+		// services are the children of the services they depend on.
+		Tree() []Node
 
 		Start() error
 		Close() error
@@ -40,8 +57,11 @@ type (
 	checker struct {
 		srvMutex   sync.RWMutex
 		services   map[string]Checkable
+		dependsOn  map[string][]string
 		stateMutex sync.RWMutex
 		state      map[string]State
+		// subStates are the states of the sub-checks of composite services
+		subStates map[string]map[string]State
 
 		chStop chan struct{}
 		chDone chan struct{}
@@ -52,6 +72,24 @@ type (
 	State struct {
 		ready   error
 		healthy error
+		// since is when the health status of the check last changed
+		since time.Time
+	}
+
+	// Node is a service in the health tree
+	Node struct {
+		Name string
+		// Status is failing if the check of the service, or of a service it
+		// depends on, fails
+		Status Status
+		// Output is the error of the check of the service itself
+		Output string
+		// Causes are the failing checks which make the service fail, its own
+		// and those of the services it depends on, down to the root causes
+		Causes []string
+		// Since is when the check of the service last changed status
+		Since    time.Time
+		Children []Node
 	}
 
 	Status string
@@ -60,8 +98,9 @@ type (
 var _ Checker = (*checker)(nil)
 
 const (
-	StatusPassing Status = "passing"
-	StatusFailing Status = "failing"
+	StatusPassing  Status = "passing"
+	StatusFailing  Status = "failing"
+	StatusDegraded Status = "degraded"
 
 	interval = 15 * time.Second
 )
@@ -76,12 +115,41 @@ var (
 	)
 )
 
+// Degraded is returned by the checks of services which work, but with reduced
+// functionality. It is reported without making the node unhealthy.
+type Degraded struct {
+	Reason string
+}
+
+func (d Degraded) Error() string {
+	return d.Reason
+}
+
+// IsDegraded returns true if the check's error is Degraded
+func IsDegraded(err error) bool {
+	var degraded Degraded
+	return errors.As(err, &degraded)
+}
+
+// StatusOf returns the status of a check given its error
+func StatusOf(err error) Status {
+	if err == nil {
+		return StatusPassing
+	}
+	if IsDegraded(err) {
+		return StatusDegraded
+	}
+	return StatusFailing
+}
+
 func NewChecker() Checker {
 	c := &checker{
-		services: make(map[string]Checkable, 10),
-		state:    make(map[string]State, 10),
-		chStop:   make(chan struct{}),
-		chDone:   make(chan struct{}),
+		services:  make(map[string]Checkable, 10),
+		dependsOn: make(map[string][]string, 10),
+		state:     make(map[string]State, 10),
+		subStates: make(map[string]map[string]State),
+		chStop:    make(chan struct{}),
+		chDone:    make(chan struct{}),
 	}
 
 	return c
@@ -134,11 +202,20 @@ func (c *checker) update() {
 	c.srvMutex.RUnlock()
 
 	// now, do all the checks
+	subStates := make(map[string]map[string]State)
 	for name, s := range services {
 		ready := s.Ready()
 		healthy := s.Healthy()
 
-		state[name] = State{ready, healthy}
+		state[name] = State{ready: ready, healthy: healthy}
+
+		if composite, ok := s.(Composite); ok {
+			subs := make(map[string]State)
+			for subName, sub := range composite.SubChecks() {
+				subs[subName] = State{ready: sub.Ready(), healthy: sub.Healthy()}
+			}
+			subStates[name] = subs
+		}
 	}
 
 	// we use a separate lock to avoid holding the lock over state while talking
@@ -146,11 +223,21 @@ func (c *checker) update() {
 	c.stateMutex.Lock()
 	defer c.stateMutex.Unlock()
 
+	now := time.Now()
+	for name, subs := range subStates {
+		prev := c.subStates[name]
+		for subName, sub := range subs {
+			subs[subName] = sub.sinceChange(prev, subName, now)
+		}
+		c.subStates[name] = subs
+	}
+
 	for name, state := range state {
+		state = state.sinceChange(c.state, name, now)
 		c.state[name] = state
 
 		value := 0
-		if state.healthy == nil {
+		if state.healthy == nil || IsDegraded(state.healthy) {
 			value = 1
 		}
 
@@ -159,7 +246,18 @@ func (c *checker) update() {
 	}
 }
 
-func (c *checker) Register(name string, service Checkable) error {
+// sinceChange sets when the health status of the check changed, given its
+// previous state in prev
+func (s State) sinceChange(prev map[string]State, name string, now time.Time) State {
+	if p, exists := prev[name]; exists && StatusOf(p.healthy) == StatusOf(s.healthy) {
+		s.since = p.since
+	} else {
+		s.since = now
+	}
+	return s
+}
+
+func (c *checker) Register(name string, service Checkable, dependsOn ...string) error {
 	if service == nil || name == "" {
 		return errors.Errorf("misconfigured check %#v for %v", name, service)
 	}
@@ -167,6 +265,7 @@ func (c *checker) Register(name string, service Checkable) error {
 	c.srvMutex.Lock()
 	defer c.srvMutex.Unlock()
 	c.services[name] = service
+	c.dependsOn[name] = dependsOn
 	return nil
 }
 
@@ -178,6 +277,7 @@ func (c *checker) Unregister(name string) error {
 	c.srvMutex.Lock()
 	defer c.srvMutex.Unlock()
 	delete(c.services, name)
+	delete(c.dependsOn, name)
 	metrics.DeleteLabelValues(name)
 	return nil
 }
@@ -192,7 +292,7 @@ func (c *checker) IsReady() (ready bool, errors map[string]error) {
 	for name, state := range c.state {
 		errors[name] = state.ready
 
-		if state.ready != nil {
+		if state.ready != nil && !IsDegraded(state.ready) {
 			ready = false
 		}
 	}
@@ -210,10 +310,174 @@ func (c *checker) IsHealthy() (healthy bool, errors map[string]error) {
 	for name, state := range c.state {
 		errors[name] = state.healthy
 
-		if state.healthy != nil {
+		if state.healthy != nil && !IsDegraded(state.healthy) {
 			healthy = false
 		}
 	}
 
 	return
 }
+
+// Tree returns the health of the services as a tree. Services which depend on
+// no registered service are the roots, and services which depend on several
+// appear under each of them. Only the services checked at least once are
+// reported.
+func (c *checker) Tree() []Node {
+	c.srvMutex.RLock()
+	dependsOn := make(map[string][]string, len(c.dependsOn))
+	for name, deps := range c.dependsOn {
+		dependsOn[name] = deps
+	}
+	c.srvMutex.RUnlock()
+
+	c.stateMutex.RLock()
+	defer c.stateMutex.RUnlock()
+
+	t := tree{
+		state:     c.state,
+		subStates: c.subStates,
+		dependsOn: make(map[string][]string),
+		children:  make(map[string][]string),
+		causes:    make(map[string][]string),
+	}
+	var roots []string
+	for name := range dependsOn {
+		if _, checked := c.state[name]; !checked {
+			continue
+		}
+		for _, dep := range dependsOn[name] {
+			if _, checked := c.state[dep]; checked {
+				t.dependsOn[name] = append(t.dependsOn[name], dep)
+				t.children[dep] = append(t.children[dep], name)
+			}
+		}
+		if len(t.dependsOn[name]) == 0 {
+			roots = append(roots, name)
+		}
+	}
+	for _, children := range t.children {
+		sort.Strings(children)
+	}
+	sort.Strings(roots)
+
+	visited := make(map[string]bool)
+	var nodes []Node
+	for _, name := range roots {
+		nodes = append(nodes, t.node(name, visited, map[string]bool{}))
+	}
+	// Services in a dependency cycle have no root, so they are reported as
+	// roots themselves
+	var unvisited []string
+	for name := range dependsOn {
+		if _, checked := c.state[name]; checked && !visited[name] {
+			unvisited = append(unvisited, name)
+		}
+	}
+	sort.Strings(unvisited)
+	for _, name := range unvisited {
+		if !visited[name] {
+			nodes = append(nodes, t.node(name, visited, map[string]bool{}))
+		}
+	}
+	return nodes
+}
+
+// tree builds the health tree from the states of the checks
+type tree struct {
+	state     map[string]State
+	subStates map[string]map[string]State
+	dependsOn map[string][]string
+	children  map[string][]string
+	causes    map[string][]string
+}
+
+func (t tree) node(name string, visited, path map[string]bool) Node {
+	visited[name] = true
+	path[name] = true
+	defer delete(path, name)
+
+	state := t.state[name]
+	depCauses := t.dependencyCauses(name, map[string]bool{})
+	n := newNode(name, state, depCauses)
+	for _, child := range t.children[name] {
+		if !path[child] {
+			n.Children = append(n.Children, t.node(child, visited, path))
+		}
+	}
+
+	// The sub-checks of a service depend on what the service depends on,
+	// but not on the service itself, whose check may already report them
+	subs := t.subStates[name]
+	subNames := make([]string, 0, len(subs))
+	for subName := range subs {
+		subNames = append(subNames, subName)
+	}
+	sort.Strings(subNames)
+	for _, subName := range subNames {
+		n.Children = append(n.Children, newNode(subName, subs[subName], depCauses))
+	}
+	return n
+}
+
+// rootCauses returns the failing checks which make the service fail
+func (t tree) rootCauses(name string, path map[string]bool) []string {
+	if causes, exists := t.causes[name]; exists {
+		return causes
+	}
+	var causes []string
+	if healthy := t.state[name].healthy; StatusOf(healthy) == StatusFailing {
+		causes = append(causes, fmt.Sprintf("%s: %v", name, healthy))
+	}
+	causes = appendUnique(causes, t.dependencyCauses(name, path)...)
+	t.causes[name] = causes
+	return causes
+}
+
+// dependencyCauses returns the failing checks of the services the service
+// depends on, down to the root causes
+func (t tree) dependencyCauses(name string, path map[string]bool) []string {
+	path[name] = true
+	defer delete(path, name)
+	var causes []string
+	for _, dep := range t.dependsOn[name] {
+		if !path[dep] {
+			causes = appendUnique(causes, t.rootCauses(dep, path)...)
+		}
+	}
+	return causes
+}
+
+func newNode(name string, state State, depCauses []string) Node {
+	n := Node{
+		Name:   name,
+		Status: StatusOf(state.healthy),
+		Since:  state.since,
+	}
+	if state.healthy != nil {
+		n.Output = state.healthy.Error()
+	}
+	if n.Status == StatusFailing {
+		n.Causes = append(n.Causes, fmt.Sprintf("%s: %v", name, state.healthy))
+	}
+	n.Causes = appendUnique(n.Causes, depCauses...)
+	if len(n.Causes) > 0 {
+		n.Status = StatusFailing
+	}
+	return n
+}
+
+func appendUnique(causes []string, more ...string) []string {
+	for _, cause := range more {
+		exists := false
+		for _, c := range causes {
+			if c == cause {
+				exists = true
+				break
+			}
+		}
+		if !exists {
+			causes = append(causes, cause)
+		}
+	}
+	return causes
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/pkg/errors"
 	"github.com/smartcontractkit/chainlink/core/services/health"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 var ErrUnhealthy = errors.New("Unhealthy")
@@ -60,3 +61,98 @@ func TestCheck(t *testing.T) {
 		assert.Equal(t, test.expected, results, "case %d", i)
 	}
 }
+
+type degradedCheck struct{}
+
+func (degradedCheck) Ready() error { return nil }
+
+func (degradedCheck) Healthy() error { return health.Degraded{Reason: "polling"} }
+
+func TestCheck_Degraded(t *testing.T) {
+	c := health.NewChecker()
+	c.Register("degraded", degradedCheck{})
+	c.Start()
+	defer c.Close()
+
+	healthy, results := c.IsHealthy()
+
+	assert.True(t, healthy)
+	assert.Equal(t, health.StatusDegraded, health.StatusOf(results["degraded"]))
+	assert.Equal(t, "polling", results["degraded"].Error())
+	assert.Equal(t, health.StatusPassing, health.StatusOf(nil))
+	assert.Equal(t, health.StatusFailing, health.StatusOf(ErrUnhealthy))
+}
+
+type compositeCheck struct {
+	boolCheck
+	subChecks map[string]health.Checkable
+}
+
+func (c compositeCheck) SubChecks() map[string]health.Checkable { return c.subChecks }
+
+func TestCheck_Tree(t *testing.T) {
+	c := health.NewChecker()
+	require.NoError(t, c.Register("eth", boolCheck(false)))
+	require.NoError(t, c.Register("heads", boolCheck(true), "eth"))
+	require.NoError(t, c.Register("logs", boolCheck(true), "heads", "unregistered"))
+	require.NoError(t, c.Register("spawner", compositeCheck{
+		boolCheck: boolCheck(true),
+		subChecks: map[string]health.Checkable{"job 1": boolCheck(true), "job 2": boolCheck(false)},
+	}, "logs"))
+	require.NoError(t, c.Register("other", degradedCheck{}))
+	require.NoError(t, c.Start())
+	defer c.Close()
+
+	tree := c.Tree()
+	require.Len(t, tree, 2)
+
+	eth := tree[0]
+	assert.Equal(t, "eth", eth.Name)
+	assert.Equal(t, health.StatusFailing, eth.Status)
+	assert.Equal(t, []string{"eth: Unhealthy"}, eth.Causes)
+	assert.False(t, eth.Since.IsZero())
+
+	require.Len(t, eth.Children, 1)
+	heads := eth.Children[0]
+	assert.Equal(t, "heads", heads.Name)
+	assert.Equal(t, health.StatusFailing, heads.Status)
+	assert.Empty(t, heads.Output)
+	assert.Equal(t, []string{"eth: Unhealthy"}, heads.Causes)
+
+	require.Len(t, heads.Children, 1)
+	logs := heads.Children[0]
+	require.Len(t, logs.Children, 1)
+	spawner := logs.Children[0]
+	assert.Equal(t, "spawner", spawner.Name)
+	assert.Equal(t, []string{"eth: Unhealthy"}, spawner.Causes)
+
+	require.Len(t, spawner.Children, 2)
+	assert.Equal(t, "job 1", spawner.Children[0].Name)
+	assert.Equal(t, []string{"eth: Unhealthy"}, spawner.Children[0].Causes)
+	assert.Equal(t, "job 2", spawner.Children[1].Name)
+	assert.Equal(t, []string{"job 2: Unhealthy", "eth: Unhealthy"}, spawner.Children[1].Causes)
+
+	other := tree[1]
+	assert.Equal(t, "other", other.Name)
+	assert.Equal(t, health.StatusDegraded, other.Status)
+	assert.Empty(t, other.Causes)
+
+	// Sub-checks do not count towards the health of the node
+	_, results := c.IsHealthy()
+	assert.NotContains(t, results, "job 2")
+}
+
+func TestCheck_Tree_Cycle(t *testing.T) {
+	c := health.NewChecker()
+	require.NoError(t, c.Register("a", boolCheck(false), "b"))
+	require.NoError(t, c.Register("b", boolCheck(true), "a"))
+	require.NoError(t, c.Start())
+	defer c.Close()
+
+	tree := c.Tree()
+	require.Len(t, tree, 1)
+	assert.Equal(t, "a", tree[0].Name)
+	require.Len(t, tree[0].Children, 1)
+	assert.Equal(t, "b", tree[0].Children[0].Name)
+	assert.Equal(t, []string{"a: Unhealthy"}, tree[0].Children[0].Causes)
+}
@@ -15,6 +15,8 @@ type Service interface {
 type Config interface {
 	DatabaseMaximumTxDuration() time.Duration
 	DatabaseURL() url.URL
+	JobMaxStartAttempts() uint32
+	JobStartTimeout() time.Duration
 	OCRBlockchainTimeout(time.Duration) time.Duration
 	OCRContractConfirmations(uint16) uint16
 	OCRContractPollInterval(time.Duration) time.Duration
@@ -20,10 +20,12 @@ import (
 	"github.com/smartcontractkit/chainlink/core/testdata/testspecs"
 
 	"github.com/pelletier/go-toml"
+	"github.com/pkg/errors"
 	uuid "github.com/satori/go.uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
 	gormpostgres "gorm.io/driver/postgres"
 	"gorm.io/gorm"
 )
@@ -266,6 +268,140 @@ func TestORM_CheckForDeletedJobs(t *testing.T) {
 
 }
 
+func TestORM_PausedJobs(t *testing.T) {
+	t.Parallel()
+
+	config, cleanup := cltest.NewConfig(t)
+	defer cleanup()
+	store, cleanup := cltest.NewStoreWithConfig(t, config)
+	defer cleanup()
+	db := store.DB
+
+	key := cltest.MustInsertRandomKey(t, db)
+	address := key.Address.Address()
+
+	_, bridge := cltest.NewBridgeType(t, "voter_turnout", "http://blah.com")
+	require.NoError(t, db.Create(bridge).Error)
+	_, bridge2 := cltest.NewBridgeType(t, "election_winner", "http://blah.com")
+	require.NoError(t, db.Create(bridge2).Error)
+
+	pipelineORM, eventBroadcaster, cleanupORM := cltest.NewPipelineORM(t, config, db)
+	defer cleanupORM()
+
+	orm := job.NewORM(db, config.Config, pipelineORM, eventBroadcaster, &postgres.NullAdvisoryLocker{})
+	defer orm.Close()
+
+	jobs := make([]job.Job, 2)
+	for i := range jobs {
+		dbSpec := makeOCRJobSpec(t, address)
+		if i == 0 {
+			dbSpec.Tags = []string{"feeds"}
+		}
+		jb, err := orm.CreateJob(context.Background(), dbSpec, dbSpec.Pipeline)
+		require.NoError(t, err)
+		jobs[i] = jb
+	}
+
+	ids, err := orm.FindJobIDsWithTag("feeds")
+	require.NoError(t, err)
+	assert.Equal(t, []int32{jobs[0].ID}, ids)
+
+	ids, err = orm.FindJobIDsWithContractAddress(jobs[1].OffchainreportingOracleSpec.ContractAddress)
+	require.NoError(t, err)
+	assert.Equal(t, []int32{jobs[1].ID}, ids)
+
+	// Either all jobs are paused or none
+	err = orm.SetJobsPaused(context.Background(), []int32{jobs[0].ID, 999999999}, true)
+	require.Equal(t, gorm.ErrRecordNotFound, errors.Cause(err))
+	require.NoError(t, orm.SetJobsPaused(context.Background(), []int32{jobs[0].ID}, true))
+
+	jb, err := orm.FindJob(context.Background(), jobs[0].ID)
+	require.NoError(t, err)
+	assert.True(t, jb.Paused)
+
+	// Paused jobs are not claimed, and are unloaded like deleted ones
+	claimed, err := orm.ClaimUnclaimedJobs(context.Background())
+	require.NoError(t, err)
+	require.Len(t, claimed, 1)
+	assert.Equal(t, jobs[1].ID, claimed[0].ID)
+
+	job.SetORMClaimedJobs(orm, jobs)
+	deletedJobIDs, err := orm.CheckForDeletedJobs(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, []int32{jobs[0].ID}, deletedJobIDs)
+}
+
+func TestORM_SpecErrors(t *testing.T) {
+	t.Parallel()
+
+	config, cleanup := cltest.NewConfig(t)
+	defer cleanup()
+	store, cleanup := cltest.NewStoreWithConfig(t, config)
+	defer cleanup()
+	db := store.DB
+
+	key := cltest.MustInsertRandomKey(t, db)
+	address := key.Address.Address()
+
+	_, bridge := cltest.NewBridgeType(t, "voter_turnout", "http://blah.com")
+	require.NoError(t, db.Create(bridge).Error)
+	_, bridge2 := cltest.NewBridgeType(t, "election_winner", "http://blah.com")
+	require.NoError(t, db.Create(bridge2).Error)
+
+	pipelineORM, eventBroadcaster, cleanupORM := cltest.NewPipelineORM(t, config, db)
+	defer cleanupORM()
+
+	orm := job.NewORM(db, config.Config, pipelineORM, eventBroadcaster, &postgres.NullAdvisoryLocker{})
+	defer orm.Close()
+
+	jobs := make([]job.Job, 2)
+	for i := range jobs {
+		dbSpec := makeOCRJobSpec(t, address)
+		jb, err := orm.CreateJob(context.Background(), dbSpec, dbSpec.Pipeline)
+		require.NoError(t, err)
+		jobs[i] = jb
+	}
+
+	ctx := context.Background()
+	for _, jb := range jobs {
+		orm.RecordError(ctx, jb.ID, "Error polling")
+		orm.RecordError(ctx, jb.ID, "Error polling")
+	}
+	orm.RecordWarning(ctx, jobs[0].ID, "Answer is outside acceptable range")
+
+	specErrors, count, err := orm.SpecErrors(job.SpecErrorFilter{}, 0, 10)
+	require.NoError(t, err)
+	assert.Equal(t, 3, count)
+	require.Len(t, specErrors, 3)
+	assert.Equal(t, job.SpecErrorSeverityWarning, specErrors[0].Severity)
+
+	specErrors, count, err = orm.SpecErrors(job.SpecErrorFilter{JobID: &jobs[1].ID, Severity: job.SpecErrorSeverityError, MinOccurrences: 2}, 0, 10)
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+	require.Len(t, specErrors, 1)
+	assert.Equal(t, jobs[1].ID, specErrors[0].JobID)
+
+	specErrors, _, err = orm.SpecErrors(job.SpecErrorFilter{Since: null.TimeFrom(time.Now().Add(time.Hour))}, 0, 10)
+	require.NoError(t, err)
+	assert.Empty(t, specErrors)
+
+	// Identical errors of several jobs are aggregated
+	summaries, err := orm.SpecErrorSummaries(job.SpecErrorFilter{Severity: job.SpecErrorSeverityError})
+	require.NoError(t, err)
+	require.Len(t, summaries, 1)
+	assert.Equal(t, "Error polling", summaries[0].Description)
+	assert.Equal(t, int64(4), summaries[0].Occurrences)
+	assert.Equal(t, int64(2), summaries[0].Jobs)
+
+	_, err = orm.DismissErrors(ctx, job.SpecErrorFilter{})
+	require.Error(t, err)
+
+	dismissed, err := orm.DismissErrors(ctx, job.SpecErrorFilter{Severity: job.SpecErrorSeverityError})
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), dismissed)
+	cltest.AssertCount(t, db, job.SpecError{}, 1)
+}
+
 func TestORM_UnclaimJob(t *testing.T) {
 	t.Parallel()
 
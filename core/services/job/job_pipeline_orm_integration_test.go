@@ -102,7 +102,7 @@ func TestPipelineORM_Integration(t *testing.T) {
 		p, err := pipeline.Parse(DotStr)
 		require.NoError(t, err)
 
-		specID, err = orm.CreateSpec(context.Background(), db, *p, models.Interval(0))
+		specID, err = orm.CreateSpec(context.Background(), db, *p, models.Interval(0), nil)
 		require.NoError(t, err)
 
 		var specs []pipeline.Spec
@@ -119,7 +119,7 @@ func TestPipelineORM_Integration(t *testing.T) {
 		clearJobsDb(t, db)
 		orm, eventBroadcaster, cleanup := cltest.NewPipelineORM(t, config, db)
 		defer cleanup()
-		runner := pipeline.NewRunner(orm, config, nil, nil, nil, nil)
+		runner := pipeline.NewRunner(orm, config, nil, nil, nil, nil, nil, nil)
 		defer runner.Close()
 		jobORM := job.NewORM(db, config.Config, orm, eventBroadcaster, &postgres.NullAdvisoryLocker{})
 		defer jobORM.Close()
@@ -0,0 +1,84 @@
+package job
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// Labels are free-form key value pairs set on a job, e.g. team=defi or
+// env=staging. Jobs and their runs can be listed by label, and the labels of
+// the jobs run by a node are exported to Prometheus.
+type Labels map[string]string
+
+// labelNameRegex matches the label names Prometheus accepts
+var labelNameRegex = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*$`)
+
+// Validate returns an error if a label name can't be used as a Prometheus
+// label name, or if a label value is empty
+func (l Labels) Validate() error {
+	for name, value := range l {
+		if !labelNameRegex.MatchString(name) {
+			return errors.Errorf("invalid label name %q, must match %s", name, labelNameRegex)
+		}
+		if value == "" {
+			return errors.Errorf("label %s has no value", name)
+		}
+	}
+	return nil
+}
+
+// String returns the labels as a selector which ParseLabels parses back,
+// ordered by name
+func (l Labels) String() string {
+	names := make([]string, 0, len(l))
+	for name := range l {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	pairs := make([]string, len(names))
+	for i, name := range names {
+		pairs[i] = name + "=" + l[name]
+	}
+	return strings.Join(pairs, ",")
+}
+
+// ParseLabels parses a comma separated list of name=value pairs, e.g.
+// team=defi,env=staging
+func ParseLabels(s string) (Labels, error) {
+	labels := Labels{}
+	for _, pair := range strings.Split(s, ",") {
+		if strings.TrimSpace(pair) == "" {
+			continue
+		}
+		parts := strings.SplitN(pair, "=", 2)
+		if len(parts) != 2 {
+			return nil, errors.Errorf("invalid label %q, must be name=value", pair)
+		}
+		labels[strings.TrimSpace(parts[0])] = strings.TrimSpace(parts[1])
+	}
+	return labels, labels.Validate()
+}
+
+func (l *Labels) Scan(value interface{}) error {
+	if value == nil {
+		*l = Labels{}
+		return nil
+	}
+	bytes, ok := value.([]byte)
+	if !ok {
+		return errors.Errorf("Labels#Scan received a value of type %T", value)
+	}
+	return json.Unmarshal(bytes, l)
+}
+
+func (l Labels) Value() (driver.Value, error) {
+	if l == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(l)
+}
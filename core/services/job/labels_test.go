@@ -0,0 +1,46 @@
+package job_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/services/job"
+)
+
+func TestParseLabels(t *testing.T) {
+	t.Parallel()
+
+	labels, err := job.ParseLabels("team=defi, env=staging,asset=ETH-USD")
+	require.NoError(t, err)
+	assert.Equal(t, job.Labels{"team": "defi", "env": "staging", "asset": "ETH-USD"}, labels)
+	assert.Equal(t, "asset=ETH-USD,env=staging,team=defi", labels.String())
+
+	labels, err = job.ParseLabels("")
+	require.NoError(t, err)
+	assert.Len(t, labels, 0)
+
+	_, err = job.ParseLabels("team")
+	assert.EqualError(t, err, `invalid label "team", must be name=value`)
+	_, err = job.ParseLabels("team=")
+	assert.EqualError(t, err, "label team has no value")
+	_, err = job.ParseLabels("asset-pair=ETH-USD")
+	assert.Error(t, err)
+}
+
+func TestLabels_ScanValue(t *testing.T) {
+	t.Parallel()
+
+	labels := job.Labels{"team": "defi"}
+	value, err := labels.Value()
+	require.NoError(t, err)
+
+	var scanned job.Labels
+	require.NoError(t, scanned.Scan(value))
+	assert.Equal(t, labels, scanned)
+
+	value, err = job.Labels(nil).Value()
+	require.NoError(t, err)
+	assert.Equal(t, []byte("{}"), value)
+}
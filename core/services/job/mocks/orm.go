@@ -5,6 +5,8 @@ package mocks
 import (
 	context "context"
 
+	ethkey "github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
+
 	job "github.com/smartcontractkit/chainlink/core/services/job"
 	mock "github.com/stretchr/testify/mock"
 
@@ -99,6 +101,20 @@ func (_m *ORM) CreateJob(ctx context.Context, jobSpec *job.Job, _a2 pipeline.Pip
 	return r0, r1
 }
 
+// CreateTemplate provides a mock function with given fields: t
+func (_m *ORM) CreateTemplate(t *job.Template) error {
+	ret := _m.Called(t)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*job.Template) error); ok {
+		r0 = rf(t)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // DeleteJob provides a mock function with given fields: ctx, id
 func (_m *ORM) DeleteJob(ctx context.Context, id int32) error {
 	ret := _m.Called(ctx, id)
@@ -113,6 +129,34 @@ func (_m *ORM) DeleteJob(ctx context.Context, id int32) error {
 	return r0
 }
 
+// DeleteJobs provides a mock function with given fields: ctx, ids
+func (_m *ORM) DeleteJobs(ctx context.Context, ids []int32) error {
+	ret := _m.Called(ctx, ids)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []int32) error); ok {
+		r0 = rf(ctx, ids)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteTemplate provides a mock function with given fields: ctx, name
+func (_m *ORM) DeleteTemplate(ctx context.Context, name string) error {
+	ret := _m.Called(ctx, name)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, name)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // DismissError provides a mock function with given fields: ctx, errorID
 func (_m *ORM) DismissError(ctx context.Context, errorID int32) error {
 	ret := _m.Called(ctx, errorID)
@@ -127,6 +171,48 @@ func (_m *ORM) DismissError(ctx context.Context, errorID int32) error {
 	return r0
 }
 
+// DismissErrors provides a mock function with given fields: ctx, filter
+func (_m *ORM) DismissErrors(ctx context.Context, filter job.SpecErrorFilter) (int64, error) {
+	ret := _m.Called(ctx, filter)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(context.Context, job.SpecErrorFilter) int64); ok {
+		r0 = rf(ctx, filter)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, job.SpecErrorFilter) error); ok {
+		r1 = rf(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ExportSnapshot provides a mock function with given fields: jobID
+func (_m *ORM) ExportSnapshot(jobID int32) (job.Snapshot, error) {
+	ret := _m.Called(jobID)
+
+	var r0 job.Snapshot
+	if rf, ok := ret.Get(0).(func(int32) job.Snapshot); ok {
+		r0 = rf(jobID)
+	} else {
+		r0 = ret.Get(0).(job.Snapshot)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(int32) error); ok {
+		r1 = rf(jobID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // FindJob provides a mock function with given fields: ctx, id
 func (_m *ORM) FindJob(ctx context.Context, id int32) (job.Job, error) {
 	ret := _m.Called(ctx, id)
@@ -171,6 +257,52 @@ func (_m *ORM) FindJobIDsWithBridge(name string) ([]int32, error) {
 	return r0, r1
 }
 
+// FindJobIDsWithContractAddress provides a mock function with given fields: address
+func (_m *ORM) FindJobIDsWithContractAddress(address ethkey.EIP55Address) ([]int32, error) {
+	ret := _m.Called(address)
+
+	var r0 []int32
+	if rf, ok := ret.Get(0).(func(ethkey.EIP55Address) []int32); ok {
+		r0 = rf(address)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]int32)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(ethkey.EIP55Address) error); ok {
+		r1 = rf(address)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindJobIDsWithTag provides a mock function with given fields: tag
+func (_m *ORM) FindJobIDsWithTag(tag string) ([]int32, error) {
+	ret := _m.Called(tag)
+
+	var r0 []int32
+	if rf, ok := ret.Get(0).(func(string) []int32); ok {
+		r0 = rf(tag)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]int32)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(tag)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // FindJobTx provides a mock function with given fields: id
 func (_m *ORM) FindJobTx(id int32) (job.Job, error) {
 	ret := _m.Called(id)
@@ -192,6 +324,92 @@ func (_m *ORM) FindJobTx(id int32) (job.Job, error) {
 	return r0, r1
 }
 
+// FindQuarantinedJobIDs provides a mock function with given fields: ctx
+func (_m *ORM) FindQuarantinedJobIDs(ctx context.Context) ([]int32, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []int32
+	if rf, ok := ret.Get(0).(func(context.Context) []int32); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]int32)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindTemplate provides a mock function with given fields: name
+func (_m *ORM) FindTemplate(name string) (job.Template, error) {
+	ret := _m.Called(name)
+
+	var r0 job.Template
+	if rf, ok := ret.Get(0).(func(string) job.Template); ok {
+		r0 = rf(name)
+	} else {
+		r0 = ret.Get(0).(job.Template)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ImportSnapshot provides a mock function with given fields: ctx, snapshot
+func (_m *ORM) ImportSnapshot(ctx context.Context, snapshot job.Snapshot) (job.Job, error) {
+	ret := _m.Called(ctx, snapshot)
+
+	var r0 job.Job
+	if rf, ok := ret.Get(0).(func(context.Context, job.Snapshot) job.Job); ok {
+		r0 = rf(ctx, snapshot)
+	} else {
+		r0 = ret.Get(0).(job.Job)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, job.Snapshot) error); ok {
+		r1 = rf(ctx, snapshot)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IncrementStartAttempts provides a mock function with given fields: ctx, id
+func (_m *ORM) IncrementStartAttempts(ctx context.Context, id int32) (int32, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 int32
+	if rf, ok := ret.Get(0).(func(context.Context, int32) int32); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(int32)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int32) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // JobsV2 provides a mock function with given fields: offset, limit
 func (_m *ORM) JobsV2(offset int, limit int) ([]job.Job, int, error) {
 	ret := _m.Called(offset, limit)
@@ -222,6 +440,36 @@ func (_m *ORM) JobsV2(offset int, limit int) ([]job.Job, int, error) {
 	return r0, r1, r2
 }
 
+// JobsV2WithLabels provides a mock function with given fields: labels, offset, limit
+func (_m *ORM) JobsV2WithLabels(labels job.Labels, offset int, limit int) ([]job.Job, int, error) {
+	ret := _m.Called(labels, offset, limit)
+
+	var r0 []job.Job
+	if rf, ok := ret.Get(0).(func(job.Labels, int, int) []job.Job); ok {
+		r0 = rf(labels, offset, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]job.Job)
+		}
+	}
+
+	var r1 int
+	if rf, ok := ret.Get(1).(func(job.Labels, int, int) int); ok {
+		r1 = rf(labels, offset, limit)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(job.Labels, int, int) error); ok {
+		r2 = rf(labels, offset, limit)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
 // ListenForDeletedJobs provides a mock function with given fields:
 func (_m *ORM) ListenForDeletedJobs() (postgres.Subscription, error) {
 	ret := _m.Called()
@@ -328,11 +576,201 @@ func (_m *ORM) PipelineRunsByJobID(jobID int32, offset int, size int) ([]pipelin
 	return r0, r1, r2
 }
 
+// QuarantineJob provides a mock function with given fields: ctx, id
+func (_m *ORM) QuarantineJob(ctx context.Context, id int32) error {
+	ret := _m.Called(ctx, id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ReactivateJob provides a mock function with given fields: ctx, id
+func (_m *ORM) ReactivateJob(ctx context.Context, id int32) error {
+	ret := _m.Called(ctx, id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // RecordError provides a mock function with given fields: ctx, jobID, description
 func (_m *ORM) RecordError(ctx context.Context, jobID int32, description string) {
 	_m.Called(ctx, jobID, description)
 }
 
+// RecordWarning provides a mock function with given fields: ctx, jobID, description
+func (_m *ORM) RecordWarning(ctx context.Context, jobID int32, description string) {
+	_m.Called(ctx, jobID, description)
+}
+
+// ResetStartAttempts provides a mock function with given fields: ctx, id
+func (_m *ORM) ResetStartAttempts(ctx context.Context, id int32) error {
+	ret := _m.Called(ctx, id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SearchPipelineRuns provides a mock function with given fields: filter, after, limit
+func (_m *ORM) SearchPipelineRuns(filter job.PipelineRunsFilter, after *job.PipelineRunsCursor, limit int) ([]pipeline.Run, *job.PipelineRunsCursor, error) {
+	ret := _m.Called(filter, after, limit)
+
+	var r0 []pipeline.Run
+	if rf, ok := ret.Get(0).(func(job.PipelineRunsFilter, *job.PipelineRunsCursor, int) []pipeline.Run); ok {
+		r0 = rf(filter, after, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]pipeline.Run)
+		}
+	}
+
+	var r1 *job.PipelineRunsCursor
+	if rf, ok := ret.Get(1).(func(job.PipelineRunsFilter, *job.PipelineRunsCursor, int) *job.PipelineRunsCursor); ok {
+		r1 = rf(filter, after, limit)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*job.PipelineRunsCursor)
+		}
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(job.PipelineRunsFilter, *job.PipelineRunsCursor, int) error); ok {
+		r2 = rf(filter, after, limit)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// SetJobLabels provides a mock function with given fields: ctx, id, labels
+func (_m *ORM) SetJobLabels(ctx context.Context, id int32, labels job.Labels) error {
+	ret := _m.Called(ctx, id, labels)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32, job.Labels) error); ok {
+		r0 = rf(ctx, id, labels)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SetJobsPaused provides a mock function with given fields: ctx, ids, paused
+func (_m *ORM) SetJobsPaused(ctx context.Context, ids []int32, paused bool) error {
+	ret := _m.Called(ctx, ids, paused)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []int32, bool) error); ok {
+		r0 = rf(ctx, ids, paused)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SpecErrorSummaries provides a mock function with given fields: filter
+func (_m *ORM) SpecErrorSummaries(filter job.SpecErrorFilter) ([]job.SpecErrorSummary, error) {
+	ret := _m.Called(filter)
+
+	var r0 []job.SpecErrorSummary
+	if rf, ok := ret.Get(0).(func(job.SpecErrorFilter) []job.SpecErrorSummary); ok {
+		r0 = rf(filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]job.SpecErrorSummary)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(job.SpecErrorFilter) error); ok {
+		r1 = rf(filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SpecErrors provides a mock function with given fields: filter, offset, limit
+func (_m *ORM) SpecErrors(filter job.SpecErrorFilter, offset int, limit int) ([]job.SpecError, int, error) {
+	ret := _m.Called(filter, offset, limit)
+
+	var r0 []job.SpecError
+	if rf, ok := ret.Get(0).(func(job.SpecErrorFilter, int, int) []job.SpecError); ok {
+		r0 = rf(filter, offset, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]job.SpecError)
+		}
+	}
+
+	var r1 int
+	if rf, ok := ret.Get(1).(func(job.SpecErrorFilter, int, int) int); ok {
+		r1 = rf(filter, offset, limit)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(job.SpecErrorFilter, int, int) error); ok {
+		r2 = rf(filter, offset, limit)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// Templates provides a mock function with given fields: offset, limit
+func (_m *ORM) Templates(offset int, limit int) ([]job.Template, int, error) {
+	ret := _m.Called(offset, limit)
+
+	var r0 []job.Template
+	if rf, ok := ret.Get(0).(func(int, int) []job.Template); ok {
+		r0 = rf(offset, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]job.Template)
+		}
+	}
+
+	var r1 int
+	if rf, ok := ret.Get(1).(func(int, int) int); ok {
+		r1 = rf(offset, limit)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(int, int) error); ok {
+		r2 = rf(offset, limit)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
 // UnclaimJob provides a mock function with given fields: ctx, id
 func (_m *ORM) UnclaimJob(ctx context.Context, id int32) error {
 	ret := _m.Called(ctx, id)
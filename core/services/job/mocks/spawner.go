@@ -67,6 +67,29 @@ func (_m *Spawner) CreateJob(ctx context.Context, spec job.Job, name null.String
 	return r0, r1
 }
 
+// CreateJobs provides a mock function with given fields: ctx, specs
+func (_m *Spawner) CreateJobs(ctx context.Context, specs []job.Job) ([]job.Job, error) {
+	ret := _m.Called(ctx, specs)
+
+	var r0 []job.Job
+	if rf, ok := ret.Get(0).(func(context.Context, []job.Job) []job.Job); ok {
+		r0 = rf(ctx, specs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]job.Job)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, []job.Job) error); ok {
+		r1 = rf(ctx, specs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // DeleteJob provides a mock function with given fields: ctx, jobID
 func (_m *Spawner) DeleteJob(ctx context.Context, jobID int32) error {
 	ret := _m.Called(ctx, jobID)
@@ -81,6 +104,34 @@ func (_m *Spawner) DeleteJob(ctx context.Context, jobID int32) error {
 	return r0
 }
 
+// DeleteJobs provides a mock function with given fields: ctx, jobIDs
+func (_m *Spawner) DeleteJobs(ctx context.Context, jobIDs []int32) error {
+	ret := _m.Called(ctx, jobIDs)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []int32) error); ok {
+		r0 = rf(ctx, jobIDs)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Drain provides a mock function with given fields: ctx
+func (_m *Spawner) Drain(ctx context.Context) error {
+	ret := _m.Called(ctx)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Healthy provides a mock function with given fields:
 func (_m *Spawner) Healthy() error {
 	ret := _m.Called()
@@ -95,6 +146,34 @@ func (_m *Spawner) Healthy() error {
 	return r0
 }
 
+// PauseJobs provides a mock function with given fields: ctx, jobIDs
+func (_m *Spawner) PauseJobs(ctx context.Context, jobIDs []int32) error {
+	ret := _m.Called(ctx, jobIDs)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []int32) error); ok {
+		r0 = rf(ctx, jobIDs)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ReactivateJob provides a mock function with given fields: ctx, jobID
+func (_m *Spawner) ReactivateJob(ctx context.Context, jobID int32) error {
+	ret := _m.Called(ctx, jobID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32) error); ok {
+		r0 = rf(ctx, jobID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Ready provides a mock function with given fields:
 func (_m *Spawner) Ready() error {
 	ret := _m.Called()
@@ -109,6 +188,48 @@ func (_m *Spawner) Ready() error {
 	return r0
 }
 
+// RestartJob provides a mock function with given fields: ctx, jobID
+func (_m *Spawner) RestartJob(ctx context.Context, jobID int32) error {
+	ret := _m.Called(ctx, jobID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32) error); ok {
+		r0 = rf(ctx, jobID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ResumeJobs provides a mock function with given fields: ctx, jobIDs
+func (_m *Spawner) ResumeJobs(ctx context.Context, jobIDs []int32) error {
+	ret := _m.Called(ctx, jobIDs)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []int32) error); ok {
+		r0 = rf(ctx, jobIDs)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SetJobLabels provides a mock function with given fields: ctx, jobID, labels
+func (_m *Spawner) SetJobLabels(ctx context.Context, jobID int32, labels job.Labels) error {
+	ret := _m.Called(ctx, jobID, labels)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32, job.Labels) error); ok {
+		r0 = rf(ctx, jobID, labels)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Start provides a mock function with given fields:
 func (_m *Spawner) Start() error {
 	ret := _m.Called()
@@ -122,3 +243,19 @@ func (_m *Spawner) Start() error {
 
 	return r0
 }
+
+// StartupStates provides a mock function with given fields:
+func (_m *Spawner) StartupStates() map[int32]job.StartupState {
+	ret := _m.Called()
+
+	var r0 map[int32]job.StartupState
+	if rf, ok := ret.Get(0).(func() map[int32]job.StartupState); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[int32]job.StartupState)
+		}
+	}
+
+	return r0
+}
@@ -1,6 +1,9 @@
 package job
 
 import (
+	"database/sql/driver"
+	"encoding/base64"
+	"encoding/json"
 	"fmt"
 	"strconv"
 	"strings"
@@ -10,6 +13,7 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/lib/pq"
+	"github.com/pkg/errors"
 	uuid "github.com/satori/go.uuid"
 	"github.com/smartcontractkit/chainlink/core/assets"
 	clnull "github.com/smartcontractkit/chainlink/core/null"
@@ -47,6 +51,12 @@ func (t Type) SupportsAsync() bool {
 	return supportsAsync[t]
 }
 
+// IsValid is false for unknown job types
+func (t Type) IsValid() bool {
+	_, ok := requiresPipelineSpec[t]
+	return ok
+}
+
 var (
 	requiresPipelineSpec = map[Type]bool{
 		Cron:              true,
@@ -91,8 +101,16 @@ type Job struct {
 	Type                          Type
 	SchemaVersion                 uint32
 	Name                          null.String
+	Tags                          pq.StringArray    `toml:"tags" gorm:"type:text[]"`
+	Labels                        Labels            `toml:"labels" gorm:"type:jsonb"`
+	TemplateID                    *int32            `toml:"-"`
+	TemplateVariables             TemplateVariables `toml:"-" gorm:"type:jsonb"`
+	Paused                        bool              `toml:"-"`
+	StartAttempts                 int32             `toml:"-"`
+	QuarantinedAt                 *time.Time        `toml:"-"`
 	MaxTaskDuration               models.Interval
-	Pipeline                      pipeline.Pipeline `toml:"observationSource" gorm:"-"`
+	InputSchema                   pipeline.VarsSchema `toml:"inputSchema" gorm:"-"`
+	Pipeline                      pipeline.Pipeline   `toml:"observationSource" gorm:"-" json:"-"`
 }
 
 // The external job ID (UUID) can be encoded into a log topic (32 bytes)
@@ -127,6 +145,7 @@ type SpecError struct {
 	ID          int64 `gorm:"primary_key"`
 	JobID       int32
 	Description string
+	Severity    SpecErrorSeverity
 	Occurrences uint
 	CreatedAt   time.Time
 	UpdatedAt   time.Time
@@ -136,6 +155,97 @@ func (SpecError) TableName() string {
 	return "job_spec_errors_v2"
 }
 
+// SpecErrorSeverity is how serious a job spec error is
+type SpecErrorSeverity string
+
+const (
+	SpecErrorSeverityError   SpecErrorSeverity = "error"
+	SpecErrorSeverityWarning SpecErrorSeverity = "warning"
+)
+
+// IsValid is false for unknown severities
+func (s SpecErrorSeverity) IsValid() bool {
+	return s == SpecErrorSeverityError || s == SpecErrorSeverityWarning
+}
+
+// SpecErrorFilter selects job spec errors. Zero fields match any error.
+type SpecErrorFilter struct {
+	IDs      []int64
+	JobID    *int32
+	Severity SpecErrorSeverity
+	// Since matches the errors which last occurred at or after it
+	Since null.Time
+	// Until matches the errors which first occurred before it
+	Until          null.Time
+	MinOccurrences uint
+}
+
+// IsEmpty is true if the filter matches any error
+func (f SpecErrorFilter) IsEmpty() bool {
+	return len(f.IDs) == 0 && f.JobID == nil && f.Severity == "" && !f.Since.Valid && !f.Until.Valid && f.MinOccurrences == 0
+}
+
+// SpecErrorSummary aggregates the identical errors of all jobs
+type SpecErrorSummary struct {
+	Description string
+	Severity    SpecErrorSeverity
+	Occurrences int64
+	Jobs        int64
+	FirstSeenAt time.Time
+	LastSeenAt  time.Time
+}
+
+// PipelineRunsFilter selects pipeline runs. Zero fields match any run.
+type PipelineRunsFilter struct {
+	JobID  int32
+	States []pipeline.RunStatus
+	// Since and Until match the runs created at or after and before them
+	Since           null.Time
+	Until           null.Time
+	JobType         Type
+	ContractAddress *ethkey.EIP55Address
+	// ErrorContains matches the runs with an error containing it, ignoring
+	// case
+	ErrorContains string
+	// Labels match the runs of the jobs with all of them
+	Labels Labels
+}
+
+// PipelineRunsCursor is the position of a run in the runs ordered by
+// creation, the most recent first. Pages of runs continue after the cursor of
+// the last run of the previous page, which stays stable as runs are added,
+// unlike an offset.
+type PipelineRunsCursor struct {
+	CreatedAt time.Time
+	ID        int64
+}
+
+// String encodes the cursor as an opaque token for the web API
+func (c PipelineRunsCursor) String() string {
+	s := fmt.Sprintf("%s,%d", c.CreatedAt.UTC().Format(time.RFC3339Nano), c.ID)
+	return base64.RawURLEncoding.EncodeToString([]byte(s))
+}
+
+// ParsePipelineRunsCursor decodes a cursor encoded by String
+func ParsePipelineRunsCursor(s string) (PipelineRunsCursor, error) {
+	var c PipelineRunsCursor
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return c, errors.New("invalid cursor")
+	}
+	parts := strings.SplitN(string(b), ",", 2)
+	if len(parts) != 2 {
+		return c, errors.New("invalid cursor")
+	}
+	if c.CreatedAt, err = time.Parse(time.RFC3339Nano, parts[0]); err != nil {
+		return c, errors.New("invalid cursor")
+	}
+	if c.ID, err = strconv.ParseInt(parts[1], 10, 64); err != nil {
+		return c, errors.New("invalid cursor")
+	}
+	return c, nil
+}
+
 type PipelineRun struct {
 	ID int64 `json:"-" gorm:"primary_key"`
 }
@@ -211,8 +321,9 @@ type ExternalInitiatorWebhookSpec struct {
 type WebhookSpec struct {
 	ID                            int32 `toml:"-" gorm:"primary_key"`
 	ExternalInitiatorWebhookSpecs []ExternalInitiatorWebhookSpec
-	CreatedAt                     time.Time `json:"createdAt" toml:"-"`
-	UpdatedAt                     time.Time `json:"updatedAt" toml:"-"`
+	OverlapPolicy                 OverlapPolicy `json:"overlapPolicy" toml:"overlapPolicy"`
+	CreatedAt                     time.Time     `json:"createdAt" toml:"-"`
+	UpdatedAt                     time.Time     `json:"updatedAt" toml:"-"`
 }
 
 func (w WebhookSpec) GetID() string {
@@ -236,19 +347,111 @@ type DirectRequestSpec struct {
 	ID                       int32               `toml:"-" gorm:"primary_key"`
 	ContractAddress          ethkey.EIP55Address `toml:"contractAddress"`
 	MinIncomingConfirmations clnull.Uint32       `toml:"minIncomingConfirmations"`
-	CreatedAt                time.Time           `toml:"-"`
-	UpdatedAt                time.Time           `toml:"-"`
+	// Requesters, if any, are the only addresses whose requests are served
+	Requesters       models.AddressCollection `toml:"requesters" gorm:"type:text"`
+	DeniedRequesters models.AddressCollection `toml:"deniedRequesters" gorm:"type:text"`
+	// MinContractPayment overrides MINIMUM_CONTRACT_PAYMENT_LINK_JUELS for the job
+	MinContractPayment *assets.Link `toml:"minContractPaymentLinkJuels" gorm:"type:numeric"`
+	CreatedAt          time.Time    `toml:"-"`
+	UpdatedAt          time.Time    `toml:"-"`
 }
 
 func (DirectRequestSpec) TableName() string {
 	return "direct_request_specs"
 }
 
+// OverlapPolicy is what a cron or webhook job does when it is due to run
+// while its previous run is still in progress
+type OverlapPolicy string
+
+const (
+	// OverlapParallel starts the run alongside the previous one. It is
+	// the default.
+	OverlapParallel OverlapPolicy = "parallel"
+	// OverlapSkip skips the run, which for a webhook job is rejected
+	OverlapSkip OverlapPolicy = "skip"
+	// OverlapQueue starts the run once the previous one finished
+	OverlapQueue OverlapPolicy = "queue"
+)
+
+// ValidateOverlapPolicy checks that the policy is known, defaulting an unset
+// policy to OverlapParallel
+func ValidateOverlapPolicy(p *OverlapPolicy) error {
+	switch *p {
+	case "":
+		*p = OverlapParallel
+	case OverlapParallel, OverlapSkip, OverlapQueue:
+	default:
+		return errors.Errorf("invalid overlapPolicy '%v', must be one of %v, %v or %v", *p, OverlapParallel, OverlapSkip, OverlapQueue)
+	}
+	return nil
+}
+
 type CronSpec struct {
-	ID           int32     `toml:"-" gorm:"primary_key"`
-	CronSchedule string    `toml:"schedule"`
-	CreatedAt    time.Time `toml:"-"`
-	UpdatedAt    time.Time `toml:"-"`
+	ID           int32  `toml:"-" gorm:"primary_key"`
+	CronSchedule string `toml:"schedule"`
+	// RunAt is when a one-shot job runs, instead of on a schedule
+	RunAt *time.Time `toml:"runAt"`
+	// Timezone is the IANA name of the timezone the schedule is in, unless
+	// the schedule specifies one with CRON_TZ
+	Timezone      string        `toml:"timezone"`
+	OverlapPolicy OverlapPolicy `toml:"overlapPolicy"`
+	// MaxCatchUpRuns is the number of runs missed since LastRunAt, e.g.
+	// while the node was down, which are executed on startup. The most
+	// recent ones are executed if more were missed. 0 skips missed runs.
+	MaxCatchUpRuns uint32     `toml:"maxCatchUpRuns"`
+	LastRunAt      *time.Time `toml:"-"`
+	CreatedAt      time.Time  `toml:"-"`
+	UpdatedAt      time.Time  `toml:"-"`
+}
+
+// Schedule returns the cron schedule of the job, in its timezone
+func (s CronSpec) Schedule() string {
+	if s.Timezone == "" || strings.HasPrefix(s.CronSchedule, "CRON_TZ=") || strings.HasPrefix(s.CronSchedule, "@every ") {
+		return s.CronSchedule
+	}
+	return fmt.Sprintf("CRON_TZ=%s %s", s.Timezone, s.CronSchedule)
+}
+
+// NextRunAt returns when the job is next scheduled to run after the given
+// time, or nil if it is not, e.g. once a one-shot job ran
+func (s CronSpec) NextRunAt(after time.Time) *time.Time {
+	if s.RunAt != nil {
+		if s.LastRunAt != nil {
+			return nil
+		}
+		return s.RunAt
+	}
+	schedule, err := utils.ParseCronSchedule(s.Schedule())
+	if err != nil {
+		return nil
+	}
+	next := schedule.Next(after)
+	if next.IsZero() {
+		return nil
+	}
+	return &next
+}
+
+// MissedRuns returns when the job was scheduled to run between its last run
+// and now, up to MaxCatchUpRuns of the most recent ones, along with the number
+// of runs which were missed beyond those
+func (s CronSpec) MissedRuns(now time.Time) (runs []time.Time, skipped int) {
+	if s.RunAt != nil || s.LastRunAt == nil || s.MaxCatchUpRuns == 0 {
+		return nil, 0
+	}
+	schedule, err := utils.ParseCronSchedule(s.Schedule())
+	if err != nil {
+		return nil, 0
+	}
+	for next := schedule.Next(*s.LastRunAt); !next.IsZero() && !next.After(now); next = schedule.Next(next) {
+		runs = append(runs, next)
+		if len(runs) > int(s.MaxCatchUpRuns) {
+			runs = runs[1:]
+			skipped++
+		}
+	}
+	return runs, skipped
 }
 
 func (s CronSpec) GetID() string {
@@ -303,8 +506,84 @@ type KeeperSpec struct {
 	ID              int32               `toml:"-" gorm:"primary_key"`
 	ContractAddress ethkey.EIP55Address `toml:"contractAddress"`
 	FromAddress     ethkey.EIP55Address `toml:"fromAddress"`
-	CreatedAt       time.Time           `toml:"-"`
-	UpdatedAt       time.Time           `toml:"-"`
+	// EligibilityStrategy overrides KEEPER_ELIGIBILITY_STRATEGY for the
+	// registry
+	EligibilityStrategy string `toml:"eligibilityStrategy"`
+	// Registries are further registries the job monitors, e.g. while upkeeps
+	// migrate from one registry to another
+	Registries KeeperRegistrySpecs `toml:"registries" gorm:"type:jsonb"`
+	CreatedAt  time.Time           `toml:"-"`
+	UpdatedAt  time.Time           `toml:"-"`
+}
+
+// KeeperRegistrySpec configures one of the registries a keeper job monitors.
+// Its FromAddress and EligibilityStrategy default to those of the job, and its
+// SyncInterval to KEEPER_REGISTRY_SYNC_INTERVAL.
+type KeeperRegistrySpec struct {
+	ContractAddress     ethkey.EIP55Address `toml:"contractAddress" json:"contractAddress"`
+	FromAddress         ethkey.EIP55Address `toml:"fromAddress" json:"fromAddress,omitempty"`
+	SyncInterval        models.Interval     `toml:"syncInterval" json:"syncInterval,omitempty"`
+	EligibilityStrategy string              `toml:"eligibilityStrategy" json:"eligibilityStrategy,omitempty"`
+	// UpkeepIDs restricts the upkeeps of the registry which are performed. All
+	// upkeeps are performed if it is empty
+	UpkeepIDs []int64 `toml:"upkeepIDs" json:"upkeepIDs,omitempty"`
+}
+
+// HasUpkeep returns true if the upkeep is performed for the registry
+func (s KeeperRegistrySpec) HasUpkeep(upkeepID int64) bool {
+	if len(s.UpkeepIDs) == 0 {
+		return true
+	}
+	for _, id := range s.UpkeepIDs {
+		if id == upkeepID {
+			return true
+		}
+	}
+	return false
+}
+
+// RegistrySpecs returns the specs of every registry the job monitors, starting
+// with the registry at ContractAddress. An entry of Registries with the job's
+// ContractAddress configures that registry instead of adding another one.
+func (s KeeperSpec) RegistrySpecs() []KeeperRegistrySpec {
+	specs := []KeeperRegistrySpec{{ContractAddress: s.ContractAddress}}
+	for _, r := range s.Registries {
+		if r.ContractAddress == s.ContractAddress {
+			specs[0] = r
+		} else {
+			specs = append(specs, r)
+		}
+	}
+	for i := range specs {
+		if specs[i].FromAddress == "" {
+			specs[i].FromAddress = s.FromAddress
+		}
+		if specs[i].EligibilityStrategy == "" {
+			specs[i].EligibilityStrategy = s.EligibilityStrategy
+		}
+	}
+	return specs
+}
+
+type KeeperRegistrySpecs []KeeperRegistrySpec
+
+func (r *KeeperRegistrySpecs) Scan(value interface{}) error {
+	if value == nil {
+		*r = nil
+		return nil
+	}
+	bytes, ok := value.([]byte)
+	if !ok {
+		return errors.Errorf("KeeperRegistrySpecs#Scan received a value of type %T", value)
+	}
+	return json.Unmarshal(bytes, r)
+}
+
+func (r KeeperRegistrySpecs) Value() (driver.Value, error) {
+	if r == nil {
+		return []byte("[]"), nil
+	}
+	return json.Marshal(r)
 }
 
 type VRFSpec struct {
@@ -312,6 +591,34 @@ type VRFSpec struct {
 	CoordinatorAddress ethkey.EIP55Address `toml:"coordinatorAddress"`
 	PublicKey          secp256k1.PublicKey `toml:"publicKey"`
 	Confirmations      uint32              `toml:"confirmations"`
-	CreatedAt          time.Time           `toml:"-"`
-	UpdatedAt          time.Time           `toml:"-"`
+	// CoordinatorVersion is 2 for a VRFCoordinatorV2, whose requests are paid
+	// for by subscriptions and fulfilled by the job without a pipeline, from
+	// FromAddress
+	CoordinatorVersion uint32               `toml:"coordinatorVersion"`
+	FromAddress        *ethkey.EIP55Address `toml:"fromAddress"`
+	// BatchFulfillmentEnabled fulfills the requests which are confirmed at the
+	// same time in as few transactions to the BatchCoordinatorAddress as
+	// BatchFulfillmentGasLimit allows
+	BatchCoordinatorAddress  *ethkey.EIP55Address `toml:"batchCoordinatorAddress"`
+	BatchFulfillmentEnabled  bool                 `toml:"batchFulfillmentEnabled"`
+	BatchFulfillmentGasLimit uint64               `toml:"batchFulfillmentGasLimit"`
+	// MinSubscriptionBalance is the balance under which a warning is logged
+	// for a subscription with requests
+	MinSubscriptionBalance *assets.Link `toml:"minSubscriptionBalanceLinkJuels" gorm:"type:numeric"`
+	// While the gas price is above MaxGasPrice, fulfillments are deferred
+	// until the gas price falls or FulfillmentDeadlineBlocks have passed
+	// since the request
+	MaxGasPrice               *utils.Big `toml:"maxGasPriceWei" gorm:"type:numeric"`
+	FulfillmentDeadlineBlocks uint64     `toml:"fulfillmentDeadlineBlocks"`
+	CreatedAt                 time.Time  `toml:"-"`
+	UpdatedAt                 time.Time  `toml:"-"`
+}
+
+// VRFCoordinatorV2 is the CoordinatorVersion of VRF jobs which fulfill
+// requests of a VRFCoordinatorV2
+const VRFCoordinatorV2 = 2
+
+// IsV2 returns true if the job fulfills requests of a VRFCoordinatorV2
+func (s VRFSpec) IsV2() bool {
+	return s.CoordinatorVersion == VRFCoordinatorV2
 }
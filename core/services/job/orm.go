@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
 	"github.com/smartcontractkit/chainlink/core/services/pipeline"
 	"github.com/smartcontractkit/chainlink/core/services/postgres"
 	"github.com/smartcontractkit/chainlink/core/store/models"
@@ -39,17 +40,39 @@ type ORM interface {
 	ClaimUnclaimedJobs(ctx context.Context) ([]Job, error)
 	CreateJob(ctx context.Context, jobSpec *Job, pipeline pipeline.Pipeline) (Job, error)
 	JobsV2(offset, limit int) ([]Job, int, error)
+	JobsV2WithLabels(labels Labels, offset, limit int) ([]Job, int, error)
 	FindJobTx(id int32) (Job, error)
 	FindJob(ctx context.Context, id int32) (Job, error)
 	FindJobIDsWithBridge(name string) ([]int32, error)
+	FindJobIDsWithTag(tag string) ([]int32, error)
+	FindJobIDsWithContractAddress(address ethkey.EIP55Address) ([]int32, error)
 	DeleteJob(ctx context.Context, id int32) error
+	DeleteJobs(ctx context.Context, ids []int32) error
+	SetJobsPaused(ctx context.Context, ids []int32, paused bool) error
+	SetJobLabels(ctx context.Context, id int32, labels Labels) error
+	IncrementStartAttempts(ctx context.Context, id int32) (int32, error)
+	ResetStartAttempts(ctx context.Context, id int32) error
+	QuarantineJob(ctx context.Context, id int32) error
+	ReactivateJob(ctx context.Context, id int32) error
+	FindQuarantinedJobIDs(ctx context.Context) ([]int32, error)
 	RecordError(ctx context.Context, jobID int32, description string)
+	RecordWarning(ctx context.Context, jobID int32, description string)
 	DismissError(ctx context.Context, errorID int32) error
+	DismissErrors(ctx context.Context, filter SpecErrorFilter) (int64, error)
+	SpecErrors(filter SpecErrorFilter, offset, limit int) ([]SpecError, int, error)
+	SpecErrorSummaries(filter SpecErrorFilter) ([]SpecErrorSummary, error)
 	UnclaimJob(ctx context.Context, id int32) error
 	CheckForDeletedJobs(ctx context.Context) (deletedJobIDs []int32, err error)
 	Close() error
 	PipelineRuns(offset, size int) ([]pipeline.Run, int, error)
 	PipelineRunsByJobID(jobID int32, offset, size int) ([]pipeline.Run, int, error)
+	SearchPipelineRuns(filter PipelineRunsFilter, after *PipelineRunsCursor, limit int) ([]pipeline.Run, *PipelineRunsCursor, error)
+	ExportSnapshot(jobID int32) (Snapshot, error)
+	ImportSnapshot(ctx context.Context, snapshot Snapshot) (Job, error)
+	CreateTemplate(t *Template) error
+	FindTemplate(name string) (Template, error)
+	Templates(offset, limit int) ([]Template, int, error)
+	DeleteTemplate(ctx context.Context, name string) error
 }
 
 type orm struct {
@@ -103,7 +126,8 @@ func (o *orm) ListenForDeletedJobs() (postgres.Subscription, error) {
 	return o.eventBroadcaster.Subscribe(postgres.ChannelJobDeleted, "")
 }
 
-// ClaimUnclaimedJobs locks all currently unlocked jobs and returns all jobs locked by this process
+// ClaimUnclaimedJobs locks all currently unlocked jobs which are neither paused
+// nor quarantined and returns all jobs locked by this process
 func (o *orm) ClaimUnclaimedJobs(ctx context.Context) ([]Job, error) {
 	o.claimedJobsMu.Lock()
 	defer o.claimedJobsMu.Unlock()
@@ -119,7 +143,7 @@ func (o *orm) ClaimUnclaimedJobs(ctx context.Context) ([]Job, error) {
 		join = `
             INNER JOIN (
                 SELECT not_claimed_by_us.id, pg_try_advisory_lock(?::integer, not_claimed_by_us.id) AS locked
-                FROM (SELECT id FROM jobs WHERE NOT (id = ANY(?)) OFFSET 0) not_claimed_by_us
+                FROM (SELECT id FROM jobs WHERE NOT paused AND quarantined_at IS NULL AND NOT (id = ANY(?)) OFFSET 0) not_claimed_by_us
             ) claimed_jobs ON jobs.id = claimed_jobs.id AND claimed_jobs.locked
         `
 		args = []interface{}{o.advisoryLockClassID, pq.Array(claimedJobIDs)}
@@ -127,7 +151,7 @@ func (o *orm) ClaimUnclaimedJobs(ctx context.Context) ([]Job, error) {
 		join = `
             INNER JOIN (
                 SELECT not_claimed_by_us.id, pg_try_advisory_lock(?::integer, not_claimed_by_us.id) AS locked
-                FROM jobs not_claimed_by_us
+                FROM (SELECT id FROM jobs WHERE NOT paused AND quarantined_at IS NULL OFFSET 0) not_claimed_by_us
             ) claimed_jobs ON jobs.id = claimed_jobs.id AND claimed_jobs.locked
         `
 		args = []interface{}{o.advisoryLockClassID}
@@ -186,6 +210,9 @@ func (o *orm) CreateJob(ctx context.Context, jobSpec *Job, p pipeline.Pipeline)
 	if jobSpec.ExternalJobID == (uuid.UUID{}) {
 		jobSpec.ExternalJobID = uuid.NewV4()
 	}
+	if jobSpec.Tags == nil {
+		jobSpec.Tags = pq.StringArray{}
+	}
 
 	switch jobSpec.Type {
 	case DirectRequest:
@@ -261,7 +288,7 @@ func (o *orm) CreateJob(ctx context.Context, jobSpec *Job, p pipeline.Pipeline)
 		logger.Fatalf("Unsupported jobSpec.Type: %v", jobSpec.Type)
 	}
 
-	pipelineSpecID, err := o.pipelineORM.CreateSpec(ctx, tx, p, jobSpec.MaxTaskDuration)
+	pipelineSpecID, err := o.pipelineORM.CreateSpec(ctx, tx, p, jobSpec.MaxTaskDuration, jobSpec.InputSchema)
 	if err != nil {
 		return jb, errors.Wrap(err, "failed to create pipeline spec")
 	}
@@ -276,12 +303,18 @@ func (o *orm) CreateJob(ctx context.Context, jobSpec *Job, p pipeline.Pipeline)
 
 // DeleteJob removes a job that is claimed by this orm
 func (o *orm) DeleteJob(ctx context.Context, id int32) error {
+	return o.DeleteJobs(ctx, []int32{id})
+}
+
+// DeleteJobs removes several jobs at once, in a single statement. IDs of jobs
+// which don't exist are ignored.
+func (o *orm) DeleteJobs(ctx context.Context, ids []int32) error {
 	o.claimedJobsMu.Lock()
 	defer o.claimedJobsMu.Unlock()
 
 	err := o.db.Exec(`
 		WITH deleted_jobs AS (
-			DELETE FROM jobs WHERE id = ? RETURNING
+			DELETE FROM jobs WHERE id = ANY(?) RETURNING
 				pipeline_spec_id,
 				offchainreporting_oracle_spec_id,
 				keeper_spec_id,
@@ -313,24 +346,111 @@ func (o *orm) DeleteJob(ctx context.Context, id int32) error {
 			DELETE FROM direct_request_specs WHERE id IN (SELECT direct_request_spec_id FROM deleted_jobs)
 		)
 		DELETE FROM pipeline_specs WHERE id IN (SELECT pipeline_spec_id FROM deleted_jobs)
-	`, id).Error
+	`, pq.Array(ids)).Error
 	if err != nil {
 		return errors.Wrap(err, "DeleteJob failed to delete job")
 	}
 
-	if err := o.unclaimJob(ctx, id); err != nil {
-		return errors.Wrap(err, "DeleteJob failed to unclaim job")
+	for _, id := range ids {
+		if err := o.unclaimJob(ctx, id); err != nil {
+			return errors.Wrap(err, "DeleteJob failed to unclaim job")
+		}
 	}
 
 	return nil
 }
 
+// SetJobsPaused pauses or resumes several jobs at once, either all of them or
+// none if any of them doesn't exist. Nodes stop running paused jobs the next
+// time they check for deleted jobs.
+func (o *orm) SetJobsPaused(ctx context.Context, ids []int32, paused bool) error {
+	return postgres.GormTransaction(ctx, o.db, func(tx *gorm.DB) error {
+		result := tx.Exec(`UPDATE jobs SET paused = ? WHERE id = ANY(?)`, paused, pq.Array(ids))
+		if result.Error != nil {
+			return errors.Wrap(result.Error, "SetJobsPaused failed to update jobs")
+		}
+		if result.RowsAffected != int64(len(ids)) {
+			return gorm.ErrRecordNotFound
+		}
+		return nil
+	})
+}
+
+// SetJobLabels replaces the labels of a job
+func (o *orm) SetJobLabels(ctx context.Context, id int32, labels Labels) error {
+	if err := labels.Validate(); err != nil {
+		return err
+	}
+	result := postgres.TxFromContext(ctx, o.db).Exec(`UPDATE jobs SET labels = ? WHERE id = ?`, labels, id)
+	if result.Error != nil {
+		return errors.Wrap(result.Error, "SetJobLabels failed to update job")
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+// IncrementStartAttempts counts an attempt to start the services of a job, and
+// returns the number of attempts since the job last ran steadily
+func (o *orm) IncrementStartAttempts(ctx context.Context, id int32) (int32, error) {
+	var attempts int32
+	result := postgres.TxFromContext(ctx, o.db).Raw(`UPDATE jobs SET start_attempts = start_attempts + 1 WHERE id = ? RETURNING start_attempts`, id).Scan(&attempts)
+	if result.Error != nil {
+		return 0, errors.Wrap(result.Error, "IncrementStartAttempts failed to update job")
+	}
+	if result.RowsAffected == 0 {
+		return 0, gorm.ErrRecordNotFound
+	}
+	return attempts, nil
+}
+
+// ResetStartAttempts clears the start attempts of a job which runs steadily
+func (o *orm) ResetStartAttempts(ctx context.Context, id int32) error {
+	err := postgres.TxFromContext(ctx, o.db).Exec(`UPDATE jobs SET start_attempts = 0 WHERE id = ?`, id).Error
+	return errors.Wrap(err, "ResetStartAttempts failed to update job")
+}
+
+// QuarantineJob quarantines a job, so that no node claims it until it is
+// reactivated
+func (o *orm) QuarantineJob(ctx context.Context, id int32) error {
+	result := postgres.TxFromContext(ctx, o.db).Exec(`UPDATE jobs SET quarantined_at = NOW() WHERE id = ?`, id)
+	if result.Error != nil {
+		return errors.Wrap(result.Error, "QuarantineJob failed to update job")
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+// ReactivateJob lifts the quarantine of a job and clears its start attempts,
+// so that it is claimed again
+func (o *orm) ReactivateJob(ctx context.Context, id int32) error {
+	result := postgres.TxFromContext(ctx, o.db).Exec(`UPDATE jobs SET quarantined_at = NULL, start_attempts = 0 WHERE id = ?`, id)
+	if result.Error != nil {
+		return errors.Wrap(result.Error, "ReactivateJob failed to update job")
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+// FindQuarantinedJobIDs returns the IDs of the quarantined jobs
+func (o *orm) FindQuarantinedJobIDs(ctx context.Context) ([]int32, error) {
+	var ids []int32
+	err := postgres.TxFromContext(ctx, o.db).Raw(`SELECT id FROM jobs WHERE quarantined_at IS NOT NULL ORDER BY id`).Scan(&ids).Error
+	return ids, errors.Wrap(err, "FindQuarantinedJobIDs failed")
+}
+
 func (o *orm) CheckForDeletedJobs(ctx context.Context) (deletedJobIDs []int32, err error) {
 	o.claimedJobsMu.RLock()
 	defer o.claimedJobsMu.RUnlock()
 	var claimedJobIDs = o.claimedJobIDs()
 
-	rows, err := o.db.Raw(`SELECT id FROM jobs WHERE id = ANY(?)`, pq.Array(claimedJobIDs)).Rows()
+	// Paused and quarantined jobs are unloaded like deleted ones
+	rows, err := o.db.Raw(`SELECT id FROM jobs WHERE id = ANY(?) AND NOT paused AND quarantined_at IS NULL`, pq.Array(claimedJobIDs)).Rows()
 	if err != nil {
 		return nil, errors.Wrap(err, "could not query for jobs")
 	}
@@ -371,12 +491,25 @@ func (o *orm) unclaimJob(ctx context.Context, id int32) error {
 }
 
 func (o *orm) RecordError(ctx context.Context, jobID int32, description string) {
-	pse := SpecError{JobID: jobID, Description: description, Occurrences: 1}
+	o.recordSpecError(ctx, jobID, description, SpecErrorSeverityError)
+}
+
+// RecordWarning records a job spec error which doesn't keep the job from
+// running, e.g. an answer it refused to submit
+func (o *orm) RecordWarning(ctx context.Context, jobID int32, description string) {
+	o.recordSpecError(ctx, jobID, description, SpecErrorSeverityWarning)
+}
+
+// recordSpecError records an occurrence of an error. Identical errors of a job
+// are counted rather than recorded again.
+func (o *orm) recordSpecError(ctx context.Context, jobID int32, description string, severity SpecErrorSeverity) {
+	pse := SpecError{JobID: jobID, Description: description, Severity: severity, Occurrences: 1}
 	err := o.db.WithContext(ctx).
 		Clauses(clause.OnConflict{
 			Columns: []clause.Column{{Name: "job_id"}, {Name: "description"}},
 			DoUpdates: clause.Assignments(map[string]interface{}{
 				"occurrences": gorm.Expr("job_spec_errors_v2.occurrences + 1"),
+				"severity":    gorm.Expr("excluded.severity"),
 				"updated_at":  gorm.Expr("excluded.updated_at"),
 			}),
 		}).
@@ -399,12 +532,93 @@ func (o *orm) DismissError(ctx context.Context, ID int32) error {
 	return nil
 }
 
+// DismissErrors deletes the job spec errors matched by a non-empty filter, and
+// returns how many were deleted
+func (o *orm) DismissErrors(ctx context.Context, filter SpecErrorFilter) (int64, error) {
+	if filter.IsEmpty() {
+		return 0, errors.New("DismissErrors requires a non-empty filter")
+	}
+	result := filterSpecErrors(o.db.WithContext(ctx), filter).Delete(&SpecError{})
+	return result.RowsAffected, errors.Wrap(result.Error, "DismissErrors failed")
+}
+
+// SpecErrors returns the job spec errors of all jobs matched by filter, the
+// most recent first
+func (o *orm) SpecErrors(filter SpecErrorFilter, offset, limit int) ([]SpecError, int, error) {
+	var count int64
+	var specErrors []SpecError
+	err := postgres.GormTransactionWithDefaultContext(o.db, func(tx *gorm.DB) error {
+		err := filterSpecErrors(tx.Model(SpecError{}), filter).
+			Count(&count).
+			Error
+		if err != nil {
+			return err
+		}
+
+		return filterSpecErrors(tx, filter).
+			Limit(limit).
+			Offset(offset).
+			Order("updated_at DESC, id DESC").
+			Find(&specErrors).
+			Error
+	})
+	return specErrors, int(count), errors.Wrap(err, "SpecErrors failed")
+}
+
+// SpecErrorSummaries aggregates the job spec errors matched by filter by
+// description, the most recent first
+func (o *orm) SpecErrorSummaries(filter SpecErrorFilter) ([]SpecErrorSummary, error) {
+	summaries := []SpecErrorSummary{}
+	err := filterSpecErrors(o.db.Model(SpecError{}), filter).
+		Select(`description, severity, SUM(occurrences) AS occurrences, COUNT(DISTINCT job_id) AS jobs,
+			MIN(created_at) AS first_seen_at, MAX(updated_at) AS last_seen_at`).
+		Group("description, severity").
+		Order("last_seen_at DESC, description").
+		Scan(&summaries).
+		Error
+	return summaries, errors.Wrap(err, "SpecErrorSummaries failed")
+}
+
+func filterSpecErrors(db *gorm.DB, filter SpecErrorFilter) *gorm.DB {
+	if len(filter.IDs) > 0 {
+		db = db.Where("id = ANY(?)", pq.Array(filter.IDs))
+	}
+	if filter.JobID != nil {
+		db = db.Where("job_id = ?", *filter.JobID)
+	}
+	if filter.Severity != "" {
+		db = db.Where("severity = ?", filter.Severity)
+	}
+	if filter.Since.Valid {
+		db = db.Where("updated_at >= ?", filter.Since.Time)
+	}
+	if filter.Until.Valid {
+		db = db.Where("created_at < ?", filter.Until.Time)
+	}
+	if filter.MinOccurrences > 0 {
+		db = db.Where("occurrences >= ?", filter.MinOccurrences)
+	}
+	return db
+}
+
 func (o *orm) JobsV2(offset, limit int) ([]Job, int, error) {
+	return o.JobsV2WithLabels(nil, offset, limit)
+}
+
+// JobsV2WithLabels returns a page of the jobs which have all the given labels
+func (o *orm) JobsV2WithLabels(labels Labels, offset, limit int) ([]Job, int, error) {
 	var count int64
 	var jobs []Job
 	err := postgres.GormTransactionWithDefaultContext(o.db, func(tx *gorm.DB) error {
+		withLabels := func(db *gorm.DB) *gorm.DB {
+			if len(labels) > 0 {
+				return db.Where("jobs.labels @> ?", labels)
+			}
+			return db
+		}
 		err := tx.
 			Model(Job{}).
+			Scopes(withLabels).
 			Count(&count).
 			Error
 
@@ -413,6 +627,7 @@ func (o *orm) JobsV2(offset, limit int) ([]Job, int, error) {
 		}
 
 		err = PreloadAllJobTypes(tx).
+			Scopes(withLabels).
 			Preload("JobSpecErrors").
 			Limit(limit).
 			Offset(offset).
@@ -504,6 +719,39 @@ func (o *orm) FindJobIDsWithBridge(name string) ([]int32, error) {
 	return jids, nil
 }
 
+// FindJobIDsWithTag returns the IDs of the jobs with the given tag
+func (o *orm) FindJobIDsWithTag(tag string) ([]int32, error) {
+	var ids []int32
+	err := o.db.Raw(`SELECT id FROM jobs WHERE tags @> ARRAY[?]::text[] ORDER BY id`, tag).Scan(&ids).Error
+	return ids, errors.Wrap(err, "FindJobIDsWithTag failed")
+}
+
+// jobIDsWithContractAddressSQL selects the IDs of the jobs which watch or
+// transmit to the contract at the address given by its single parameter
+const jobIDsWithContractAddressSQL = `
+	SELECT jobs.id FROM jobs
+	LEFT JOIN offchainreporting_oracle_specs ON offchainreporting_oracle_specs.id = jobs.offchainreporting_oracle_spec_id
+	LEFT JOIN flux_monitor_specs ON flux_monitor_specs.id = jobs.flux_monitor_spec_id
+	LEFT JOIN direct_request_specs ON direct_request_specs.id = jobs.direct_request_spec_id
+	LEFT JOIN keeper_specs ON keeper_specs.id = jobs.keeper_spec_id
+	LEFT JOIN vrf_specs ON vrf_specs.id = jobs.vrf_spec_id
+	WHERE ? IN (
+		offchainreporting_oracle_specs.contract_address,
+		flux_monitor_specs.contract_address,
+		direct_request_specs.contract_address,
+		keeper_specs.contract_address,
+		vrf_specs.coordinator_address
+	)
+`
+
+// FindJobIDsWithContractAddress returns the IDs of the jobs which watch or
+// transmit to the contract at the given address
+func (o *orm) FindJobIDsWithContractAddress(address ethkey.EIP55Address) ([]int32, error) {
+	var ids []int32
+	err := o.db.Raw(jobIDsWithContractAddressSQL+" ORDER BY jobs.id", address).Scan(&ids).Error
+	return ids, errors.Wrap(err, "FindJobIDsWithContractAddress failed")
+}
+
 // PipelineRunsByJobID returns all pipeline runs
 func (o *orm) PipelineRuns(offset, size int) ([]pipeline.Run, int, error) {
 	var pipelineRuns []pipeline.Run
@@ -563,3 +811,67 @@ func (o *orm) PipelineRunsByJobID(jobID int32, offset, size int) ([]pipeline.Run
 
 	return pipelineRuns, int(count), err
 }
+
+// SearchPipelineRuns returns up to limit pipeline runs matched by filter, the
+// most recent first, starting after the given cursor if it is not nil. It
+// also returns the cursor of the next page, or nil if this is the last page.
+func (o *orm) SearchPipelineRuns(filter PipelineRunsFilter, after *PipelineRunsCursor, limit int) ([]pipeline.Run, *PipelineRunsCursor, error) {
+	q := o.db.
+		Preload("PipelineSpec").
+		Preload("PipelineTaskRuns", func(db *gorm.DB) *gorm.DB {
+			return db.
+				Order("created_at ASC, id ASC")
+		})
+	if filter.JobID != 0 {
+		q = q.Where("pipeline_runs.pipeline_spec_id IN (SELECT pipeline_spec_id FROM jobs WHERE id = ?)", filter.JobID)
+	}
+	if filter.JobType != "" {
+		q = q.Where("pipeline_runs.pipeline_spec_id IN (SELECT pipeline_spec_id FROM jobs WHERE type = ?)", filter.JobType)
+	}
+	if filter.ContractAddress != nil {
+		q = q.Where("pipeline_runs.pipeline_spec_id IN (SELECT pipeline_spec_id FROM jobs WHERE id IN ("+jobIDsWithContractAddressSQL+"))", *filter.ContractAddress)
+	}
+	if len(filter.Labels) > 0 {
+		q = q.Where("pipeline_runs.pipeline_spec_id IN (SELECT pipeline_spec_id FROM jobs WHERE labels @> ?)", filter.Labels)
+	}
+	if len(filter.States) > 0 {
+		var states []string
+		for _, state := range filter.States {
+			states = append(states, string(state))
+		}
+		q = q.Where("pipeline_runs.state IN ?", states)
+	}
+	if filter.Since.Valid {
+		q = q.Where("pipeline_runs.created_at >= ?", filter.Since.Time)
+	}
+	if filter.Until.Valid {
+		q = q.Where("pipeline_runs.created_at < ?", filter.Until.Time)
+	}
+	if filter.ErrorContains != "" {
+		q = q.Where("EXISTS (SELECT 1 FROM jsonb_array_elements_text(pipeline_runs.errors) AS e WHERE e ILIKE ?)", "%"+escapeLike(filter.ErrorContains)+"%")
+	}
+	if after != nil {
+		q = q.Where("(pipeline_runs.created_at, pipeline_runs.id) < (?, ?)", after.CreatedAt, after.ID)
+	}
+
+	var runs []pipeline.Run
+	err := q.
+		Order("pipeline_runs.created_at DESC, pipeline_runs.id DESC").
+		Limit(limit + 1).
+		Find(&runs).
+		Error
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "SearchPipelineRuns failed")
+	}
+	if len(runs) <= limit {
+		return runs, nil, nil
+	}
+	runs = runs[:limit]
+	last := runs[limit-1]
+	return runs, &PipelineRunsCursor{CreatedAt: last.CreatedAt, ID: last.ID}, nil
+}
+
+// escapeLike escapes the wildcards of a LIKE pattern
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(s)
+}
@@ -0,0 +1,265 @@
+package job
+
+import (
+	"context"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+	"github.com/smartcontractkit/chainlink/core/services/postgres"
+	"github.com/smartcontractkit/chainlink/core/store/models"
+)
+
+// SnapshotVersion is the version of the snapshot format written by
+// ExportSnapshot. Snapshots of other versions cannot be imported.
+const SnapshotVersion = 1
+
+// ErrUnsupportedSnapshotVersion is returned when importing a snapshot written
+// in another format
+var ErrUnsupportedSnapshotVersion = errors.New("unsupported snapshot version")
+
+// Snapshot is the state of a job, which can be exported from a node and
+// imported into another one to migrate the job without copying the whole
+// database
+type Snapshot struct {
+	Version    int       `json:"version"`
+	ExportedAt time.Time `json:"exportedAt"`
+	// Job is the job with its type specific spec and its pipeline spec
+	Job Job `json:"job"`
+	// ExternalInitiators are the names of the external initiators of webhook
+	// jobs and their specs. They are looked up by name when importing, as
+	// their secrets are not exported.
+	ExternalInitiators    []SnapshotExternalInitiator `json:"externalInitiators"`
+	PipelineRuns          []SnapshotRun               `json:"pipelineRuns"`
+	FluxMonitorRoundStats []SnapshotRoundStats        `json:"fluxMonitorRoundStats"`
+	LogBroadcasts         []SnapshotLogBroadcast      `json:"logBroadcasts"`
+}
+
+// SnapshotExternalInitiator is an external initiator of a webhook job
+type SnapshotExternalInitiator struct {
+	Name string      `json:"name"`
+	Spec models.JSON `json:"spec"`
+}
+
+// SnapshotRun is the metadata of a finished pipeline run, without its task
+// runs. Runs which are not finished are not exported, as they could not be
+// resumed without their task runs.
+type SnapshotRun struct {
+	ID            int64                     `json:"id"`
+	Meta          pipeline.JSONSerializable `json:"meta"`
+	Errors        pipeline.RunErrors        `json:"errors"`
+	Inputs        pipeline.JSONSerializable `json:"inputs"`
+	Outputs       pipeline.JSONSerializable `json:"outputs"`
+	NumericAnswer decimal.NullDecimal       `json:"numericAnswer"`
+	BridgeCredits decimal.NullDecimal       `json:"bridgeCredits"`
+	State         pipeline.RunStatus        `json:"state"`
+	CreatedAt     time.Time                 `json:"createdAt"`
+	FinishedAt    null.Time                 `json:"finishedAt"`
+}
+
+// SnapshotRoundStats are the submission stats of a round of a flux monitor
+// job. PipelineRunID is the ID of the run in the snapshot, if it was exported.
+type SnapshotRoundStats struct {
+	Aggregator      common.Address `json:"aggregator"`
+	RoundID         uint32         `json:"roundID"`
+	PipelineRunID   null.Int       `json:"pipelineRunID"`
+	NumNewRoundLogs uint64         `json:"numNewRoundLogs"`
+	NumSubmissions  uint64         `json:"numSubmissions"`
+}
+
+// SnapshotLogBroadcast records whether the job consumed a log, so that logs
+// already handled are not handled again by the node the job is imported into
+type SnapshotLogBroadcast struct {
+	BlockHash   common.Hash `json:"blockHash"`
+	BlockNumber null.Int    `json:"blockNumber"`
+	LogIndex    uint        `json:"logIndex"`
+	Consumed    bool        `json:"consumed"`
+	CreatedAt   time.Time   `json:"createdAt"`
+}
+
+// ExportSnapshot returns the state of the job
+func (o *orm) ExportSnapshot(jobID int32) (s Snapshot, err error) {
+	s.Version = SnapshotVersion
+	s.ExportedAt = time.Now()
+
+	err = PreloadAllJobTypes(o.db).
+		Preload("WebhookSpec.ExternalInitiatorWebhookSpecs.ExternalInitiator").
+		First(&s.Job, "jobs.id = ?", jobID).
+		Error
+	if err != nil {
+		return s, errors.Wrapf(err, "failed to load job %d", jobID)
+	}
+	if s.Job.WebhookSpec != nil {
+		for _, eiWS := range s.Job.WebhookSpec.ExternalInitiatorWebhookSpecs {
+			s.ExternalInitiators = append(s.ExternalInitiators, SnapshotExternalInitiator{
+				Name: eiWS.ExternalInitiator.Name,
+				Spec: eiWS.Spec,
+			})
+		}
+		s.Job.WebhookSpec.ExternalInitiatorWebhookSpecs = nil
+	}
+
+	err = o.db.Raw(`
+		SELECT id, meta, errors, inputs, outputs, numeric_answer, bridge_credits, state, created_at, finished_at
+		FROM pipeline_runs
+		WHERE pipeline_spec_id = ? AND finished_at IS NOT NULL
+		ORDER BY id ASC
+	`, s.Job.PipelineSpecID).Scan(&s.PipelineRuns).Error
+	if err != nil {
+		return s, errors.Wrap(err, "failed to load pipeline runs")
+	}
+
+	if s.Job.FluxMonitorSpec != nil {
+		err = o.db.Raw(`
+			SELECT aggregator, round_id, pipeline_run_id, num_new_round_logs, num_submissions
+			FROM flux_monitor_round_stats_v2
+			WHERE aggregator = ?
+			ORDER BY round_id ASC
+		`, s.Job.FluxMonitorSpec.ContractAddress.Address()).Scan(&s.FluxMonitorRoundStats).Error
+		if err != nil {
+			return s, errors.Wrap(err, "failed to load flux monitor round stats")
+		}
+	}
+
+	err = o.db.Raw(`
+		SELECT block_hash, block_number, log_index, consumed, created_at
+		FROM log_broadcasts
+		WHERE job_id_v2 = ?
+		ORDER BY block_number ASC, log_index ASC
+	`, jobID).Scan(&s.LogBroadcasts).Error
+	if err != nil {
+		return s, errors.Wrap(err, "failed to load log broadcasts")
+	}
+
+	return s, nil
+}
+
+// ImportSnapshot creates the job of the snapshot with its state. The job
+// keeps its external job ID, so it must not exist on this node yet.
+//
+// NOTE: Like CreateJob, this is not wrapped in a db transaction, use
+// postgres.TransactionManager to create the transaction in the context.
+func (o *orm) ImportSnapshot(ctx context.Context, s Snapshot) (Job, error) {
+	if s.Version != SnapshotVersion {
+		return Job{}, errors.Wrapf(ErrUnsupportedSnapshotVersion, "got version %d, expected %d", s.Version, SnapshotVersion)
+	}
+	if s.Job.PipelineSpec == nil {
+		return Job{}, errors.New("snapshot has no pipeline spec")
+	}
+	tx := postgres.TxFromContext(ctx, o.db)
+
+	jb := s.Job
+	p, err := jb.PipelineSpec.Pipeline()
+	if err != nil {
+		return Job{}, errors.Wrap(err, "failed to parse pipeline spec")
+	}
+	jb.InputSchema = jb.PipelineSpec.InputSchema
+	jb.ID = 0
+	jb.PipelineSpecID = 0
+	jb.PipelineSpec = nil
+	jb.JobSpecErrors = nil
+	resetSpecIDs(&jb)
+	if jb.WebhookSpec != nil {
+		for _, ei := range s.ExternalInitiators {
+			var found models.ExternalInitiator
+			if err = tx.First(&found, "name = ?", ei.Name).Error; err != nil {
+				return Job{}, errors.Wrapf(err, "failed to find external initiator %s", ei.Name)
+			}
+			jb.WebhookSpec.ExternalInitiatorWebhookSpecs = append(jb.WebhookSpec.ExternalInitiatorWebhookSpecs, ExternalInitiatorWebhookSpec{
+				ExternalInitiatorID: found.ID,
+				Spec:                ei.Spec,
+			})
+		}
+	}
+
+	created, err := o.CreateJob(ctx, &jb, *p)
+	if err != nil {
+		return Job{}, err
+	}
+
+	// Runs get new IDs on this node, which the round stats referencing them
+	// are updated to
+	runIDs := make(map[int64]int64, len(s.PipelineRuns))
+	for _, run := range s.PipelineRuns {
+		var id int64
+		err = tx.Raw(`
+			INSERT INTO pipeline_runs (pipeline_spec_id, meta, errors, inputs, outputs, numeric_answer, bridge_credits, state, created_at, finished_at)
+			VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+			RETURNING id
+		`, created.PipelineSpecID, run.Meta, run.Errors, run.Inputs, run.Outputs, run.NumericAnswer, run.BridgeCredits, run.State, run.CreatedAt, run.FinishedAt).Row().Scan(&id)
+		if err != nil {
+			return Job{}, errors.Wrapf(err, "failed to import pipeline run %d", run.ID)
+		}
+		runIDs[run.ID] = id
+	}
+
+	for _, stats := range s.FluxMonitorRoundStats {
+		runID := null.Int{}
+		if id, ok := runIDs[stats.PipelineRunID.Int64]; stats.PipelineRunID.Valid && ok {
+			runID = null.IntFrom(id)
+		}
+		err = tx.Exec(`
+			INSERT INTO flux_monitor_round_stats_v2 (aggregator, round_id, pipeline_run_id, num_new_round_logs, num_submissions)
+			VALUES (?, ?, ?, ?, ?)
+			ON CONFLICT (aggregator, round_id) DO NOTHING
+		`, stats.Aggregator, stats.RoundID, runID, stats.NumNewRoundLogs, stats.NumSubmissions).Error
+		if err != nil {
+			return Job{}, errors.Wrapf(err, "failed to import flux monitor round stats of round %d", stats.RoundID)
+		}
+	}
+
+	for _, lb := range s.LogBroadcasts {
+		err = tx.Exec(`
+			INSERT INTO log_broadcasts (block_hash, block_number, log_index, job_id_v2, consumed, created_at)
+			VALUES (?, ?, ?, ?, ?, ?)
+			ON CONFLICT DO NOTHING
+		`, lb.BlockHash, lb.BlockNumber, lb.LogIndex, created.ID, lb.Consumed, lb.CreatedAt).Error
+		if err != nil {
+			return Job{}, errors.Wrapf(err, "failed to import log broadcast %s:%d", lb.BlockHash.Hex(), lb.LogIndex)
+		}
+	}
+
+	return created, nil
+}
+
+// resetSpecIDs clears the IDs of the type specific spec of the job, so that
+// it is created with new ones
+func resetSpecIDs(jb *Job) {
+	// The template, if any, is not exported with the job
+	jb.TemplateID = nil
+	// The job starts afresh on the node it is imported to
+	jb.StartAttempts = 0
+	jb.QuarantinedAt = nil
+	jb.OffchainreportingOracleSpecID = nil
+	jb.CronSpecID = nil
+	jb.DirectRequestSpecID = nil
+	jb.FluxMonitorSpecID = nil
+	jb.KeeperSpecID = nil
+	jb.VRFSpecID = nil
+	jb.WebhookSpecID = nil
+	if jb.OffchainreportingOracleSpec != nil {
+		jb.OffchainreportingOracleSpec.ID = 0
+	}
+	if jb.CronSpec != nil {
+		jb.CronSpec.ID = 0
+	}
+	if jb.DirectRequestSpec != nil {
+		jb.DirectRequestSpec.ID = 0
+	}
+	if jb.FluxMonitorSpec != nil {
+		jb.FluxMonitorSpec.ID = 0
+	}
+	if jb.KeeperSpec != nil {
+		jb.KeeperSpec.ID = 0
+	}
+	if jb.VRFSpec != nil {
+		jb.VRFSpec.ID = 0
+	}
+	if jb.WebhookSpec != nil {
+		jb.WebhookSpec.ID = 0
+	}
+}
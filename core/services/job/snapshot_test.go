@@ -0,0 +1,108 @@
+package job_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+	uuid "github.com/satori/go.uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/services/job"
+	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+	"github.com/smartcontractkit/chainlink/core/store/models"
+)
+
+func TestSnapshot_JSONRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	transmitter := ethkey.EIP55AddressFromAddress(cltest.NewAddress())
+	bundleID := models.MustSha256HashFromHex("f5bf259689b26f1374efb3c9a9868796953a0f814bb2d39b968d0e61b58620a5")
+	s := job.Snapshot{
+		Version:    job.SnapshotVersion,
+		ExportedAt: time.Now().UTC().Truncate(time.Second),
+		Job: job.Job{
+			ID:            7,
+			ExternalJobID: uuid.NewV4(),
+			Type:          job.OffchainReporting,
+			SchemaVersion: 1,
+			Name:          null.StringFrom("eth/usd"),
+			Tags:          pq.StringArray{"feeds"},
+			OffchainreportingOracleSpec: &job.OffchainReportingOracleSpec{
+				ID:                      3,
+				ContractAddress:         ethkey.EIP55AddressFromAddress(cltest.NewAddress()),
+				P2PBootstrapPeers:       pq.StringArray{"/dns4/chain.link/tcp/1234/p2p/16Uiu2HAm58SP7UL8zsnpeuwHfytLocaqgnyaYKP8wu7qRdrixLju"},
+				EncryptedOCRKeyBundleID: &bundleID,
+				TransmitterAddress:      &transmitter,
+				ObservationTimeout:      models.Interval(10 * time.Second),
+			},
+			PipelineSpec: &pipeline.Spec{
+				ID:           5,
+				DotDagSource: `ds1 [type=http method=GET url="https://chain.link/eth"];`,
+			},
+		},
+		PipelineRuns: []job.SnapshotRun{{
+			ID:            42,
+			Errors:        pipeline.RunErrors{null.String{}},
+			Outputs:       pipeline.JSONSerializable{Val: []interface{}{"1234.5"}, Null: false},
+			NumericAnswer: decimal.NullDecimal{Decimal: decimal.RequireFromString("1234.5"), Valid: true},
+			State:         pipeline.RunStatusCompleted,
+			CreatedAt:     time.Now().UTC().Truncate(time.Second),
+			FinishedAt:    null.TimeFrom(time.Now().UTC().Truncate(time.Second)),
+		}},
+		FluxMonitorRoundStats: []job.SnapshotRoundStats{{
+			Aggregator:     cltest.NewAddress(),
+			RoundID:        9,
+			PipelineRunID:  null.IntFrom(42),
+			NumSubmissions: 1,
+		}},
+		LogBroadcasts: []job.SnapshotLogBroadcast{{
+			BlockHash:   common.HexToHash("0x1234"),
+			BlockNumber: null.IntFrom(100),
+			LogIndex:    2,
+			Consumed:    true,
+		}},
+	}
+
+	b, err := json.Marshal(s)
+	require.NoError(t, err)
+
+	var decoded job.Snapshot
+	require.NoError(t, json.Unmarshal(b, &decoded))
+
+	assert.Equal(t, s.Job.ExternalJobID, decoded.Job.ExternalJobID)
+	assert.Equal(t, s.Job.Name, decoded.Job.Name)
+	assert.Equal(t, s.Job.Tags, decoded.Job.Tags)
+	require.NotNil(t, decoded.Job.OffchainreportingOracleSpec)
+	assert.Equal(t, *s.Job.OffchainreportingOracleSpec.EncryptedOCRKeyBundleID, *decoded.Job.OffchainreportingOracleSpec.EncryptedOCRKeyBundleID)
+	assert.Equal(t, *s.Job.OffchainreportingOracleSpec.TransmitterAddress, *decoded.Job.OffchainreportingOracleSpec.TransmitterAddress)
+	assert.Equal(t, s.Job.OffchainreportingOracleSpec.ObservationTimeout, decoded.Job.OffchainreportingOracleSpec.ObservationTimeout)
+	require.NotNil(t, decoded.Job.PipelineSpec)
+	assert.Equal(t, s.Job.PipelineSpec.DotDagSource, decoded.Job.PipelineSpec.DotDagSource)
+
+	require.Len(t, decoded.PipelineRuns, 1)
+	assert.Equal(t, s.PipelineRuns[0].ID, decoded.PipelineRuns[0].ID)
+	assert.Equal(t, s.PipelineRuns[0].State, decoded.PipelineRuns[0].State)
+	assert.True(t, s.PipelineRuns[0].NumericAnswer.Decimal.Equal(decoded.PipelineRuns[0].NumericAnswer.Decimal))
+	assert.Equal(t, s.FluxMonitorRoundStats, decoded.FluxMonitorRoundStats)
+	assert.Equal(t, s.LogBroadcasts[0].BlockHash, decoded.LogBroadcasts[0].BlockHash)
+	assert.Equal(t, s.LogBroadcasts[0].BlockNumber, decoded.LogBroadcasts[0].BlockNumber)
+}
+
+func TestORM_ImportSnapshot_RejectsOtherVersions(t *testing.T) {
+	t.Parallel()
+
+	orm := job.NewORM(nil, nil, nil, nil, nil)
+	_, err := orm.ImportSnapshot(context.Background(), job.Snapshot{Version: job.SnapshotVersion + 1})
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, job.ErrUnsupportedSnapshotVersion))
+}
@@ -2,16 +2,22 @@ package job
 
 import (
 	"context"
+	"fmt"
 	"reflect"
+	"runtime/debug"
 	"strconv"
 	"sync"
 	"time"
 
 	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"go.uber.org/multierr"
 	"gopkg.in/guregu/null.v4"
 
 	"github.com/smartcontractkit/chainlink/core/logger"
 	"github.com/smartcontractkit/chainlink/core/service"
+	"github.com/smartcontractkit/chainlink/core/services/health"
 	"github.com/smartcontractkit/chainlink/core/services/postgres"
 	"github.com/smartcontractkit/chainlink/core/utils"
 )
@@ -26,17 +32,28 @@ type (
 	Spawner interface {
 		service.Service
 		CreateJob(ctx context.Context, spec Job, name null.String) (Job, error)
+		CreateJobs(ctx context.Context, specs []Job) ([]Job, error)
 		DeleteJob(ctx context.Context, jobID int32) error
+		DeleteJobs(ctx context.Context, jobIDs []int32) error
+		PauseJobs(ctx context.Context, jobIDs []int32) error
+		ResumeJobs(ctx context.Context, jobIDs []int32) error
+		RestartJob(ctx context.Context, jobID int32) error
+		ReactivateJob(ctx context.Context, jobID int32) error
+		SetJobLabels(ctx context.Context, jobID int32, labels Labels) error
+		Drain(ctx context.Context) error
 		ActiveJobs() map[int32]Job
+		StartupStates() map[int32]StartupState
 	}
 
 	spawner struct {
 		orm                          ORM
 		config                       Config
 		jobTypeDelegates             map[Type]Delegate
+		dependencies                 map[Type][]Dependency
 		startUnclaimedServicesWorker utils.SleeperTask
 		activeJobs                   map[int32]activeJob
 		activeJobsMu                 sync.RWMutex
+		draining                     bool
 		chStopJob                    chan int32
 		txm                          postgres.TransactionManager
 
@@ -57,22 +74,75 @@ type (
 		BeforeJobDeleted(spec Job)
 	}
 
+	// Dependency is a subsystem of the node, such as the log broadcaster,
+	// which must be ready before the services of a job type can start
+	Dependency struct {
+		Name  string
+		Ready func() error
+	}
+
+	// StartupState reports how far the services of a job claimed by this node
+	// got in starting
+	StartupState struct {
+		State     string    `json:"state"`
+		Detail    string    `json:"detail,omitempty"`
+		UpdatedAt time.Time `json:"updatedAt"`
+	}
+
 	activeJob struct {
-		delegate Delegate
-		spec     Job
-		services []Service
+		delegate      Delegate
+		spec          Job
+		services      []Service
+		startup       StartupState
+		startAttempts int32
 	}
 )
 
-const checkForDeletedJobsPollInterval = 5 * time.Minute
+const (
+	// StartupStateWaiting is the state of a job whose dependencies are not
+	// ready yet. Its services are started once they are.
+	StartupStateWaiting = "waiting_for_dependencies"
+	// StartupStateRunning is the state of a job whose services all started
+	StartupStateRunning = "running"
+	// StartupStateFailed is the state of a job whose services could not be
+	// created, or of which a service failed, panicked or timed out to start.
+	// Starting it is attempted again after a backoff.
+	StartupStateFailed = "failed"
+	// StartupStateQuarantined is the state of a job whose services failed to
+	// start JobMaxStartAttempts times in a row. It is released, and claimed by
+	// no node until it is reactivated.
+	StartupStateQuarantined = "quarantined"
+)
+
+var promJobLabels = promauto.NewGaugeVec(prometheus.GaugeOpts{
+	Name: "job_labels",
+	Help: "The labels of the jobs run by this node, with one series of value 1 per label. Join on job_id to label the other job metrics.",
+},
+	[]string{"job_id", "job_name", "label", "value"},
+)
+
+const (
+	checkForDeletedJobsPollInterval = 5 * time.Minute
+	checkDependenciesInterval       = 5 * time.Second
+	// maxStartRetryDelay caps the backoff between attempts to start a job
+	maxStartRetryDelay = 5 * time.Minute
+	// steadyRunningPeriod is how long the services of a job must run after
+	// starting for its start attempts to be cleared. A job which crashes the
+	// node soon after starting is thereby quarantined too.
+	steadyRunningPeriod = time.Minute
+)
 
 var _ Spawner = (*spawner)(nil)
 
-func NewSpawner(orm ORM, config Config, jobTypeDelegates map[Type]Delegate, txm postgres.TransactionManager) *spawner {
+// NewSpawner returns a spawner running jobs with the delegate of their type.
+// The services of a job are started only once all the dependencies of its
+// type are ready.
+func NewSpawner(orm ORM, config Config, jobTypeDelegates map[Type]Delegate, dependencies map[Type][]Dependency, txm postgres.TransactionManager) *spawner {
 	s := &spawner{
 		orm:              orm,
 		config:           config,
 		jobTypeDelegates: jobTypeDelegates,
+		dependencies:     dependencies,
 		txm:              txm,
 		activeJobs:       make(map[int32]activeJob),
 		chStopJob:        make(chan int32),
@@ -143,6 +213,10 @@ func (js *spawner) runLoop() {
 	deletedPollTicker := time.NewTicker(checkForDeletedJobsPollInterval)
 	defer deletedPollTicker.Stop()
 
+	// Initialize the poll that starts the jobs waiting for their dependencies
+	dependenciesTicker := time.NewTicker(checkDependenciesInterval)
+	defer dependenciesTicker.Stop()
+
 	ctx, cancel := utils.CombinedContext(js.chStop)
 	defer cancel()
 
@@ -155,6 +229,11 @@ func (js *spawner) runLoop() {
 		case <-dbPollTicker.C:
 			js.startUnclaimedServicesWorker.WakeUp()
 
+		case <-dependenciesTicker.C:
+			if js.hasPendingJobs() {
+				js.startUnclaimedServicesWorker.WakeUp()
+			}
+
 		case jobID := <-js.chStopJob:
 			js.stopService(jobID)
 
@@ -171,6 +250,10 @@ func (js *spawner) runLoop() {
 }
 
 func (js *spawner) startUnclaimedServices() {
+	if js.isDraining() {
+		return
+	}
+
 	ctx, cancel := utils.CombinedContext(js.chStop, 5*time.Second)
 	defer cancel()
 
@@ -183,6 +266,16 @@ func (js *spawner) startUnclaimedServices() {
 	js.activeJobsMu.Lock()
 	defer js.activeJobsMu.Unlock()
 
+	if js.draining {
+		// The node started draining while the jobs were being claimed
+		for _, spec := range specs {
+			if err := js.orm.UnclaimJob(ctx, spec.ID); err != nil {
+				logger.Errorw("Error unclaiming job", "jobID", spec.ID, "error", err)
+			}
+		}
+		return
+	}
+
 	for _, spec := range specs {
 		if _, exists := js.activeJobs[spec.ID]; exists {
 			logger.Warnw("Job spawner ORM attempted to claim locally-claimed job, skipping", "jobID", spec.ID)
@@ -194,28 +287,253 @@ func (js *spawner) startUnclaimedServices() {
 			logger.Errorw("Job type has not been registered with job.Spawner", "type", spec.Type, "jobID", spec.ID)
 			continue
 		}
-		services, err := delegate.ServicesForSpec(spec)
-		if err != nil {
-			logger.Errorw("Error creating services for job", "jobID", spec.ID, "error", err)
-			js.orm.RecordError(ctx, spec.ID, err.Error())
+		js.activeJobs[spec.ID] = activeJob{delegate: delegate, spec: spec, startup: newStartupState(StartupStateWaiting, "")}
+		setPromJobLabels(spec)
+	}
+
+	now := time.Now()
+	var running, waiting int
+	var quarantined []int32
+	for jobID, aj := range js.activeJobs {
+		if aj.startup.State == StartupStateRunning {
+			if aj.isSteady(now) {
+				js.clearStartAttempts(ctx, &aj)
+				js.activeJobs[jobID] = aj
+			}
+			continue
+		} else if aj.startup.State == StartupStateFailed && !aj.isRetryDue(now) {
 			continue
 		}
+		js.startServices(ctx, &aj)
+		js.activeJobs[jobID] = aj
+		switch aj.startup.State {
+		case StartupStateWaiting:
+			waiting++
+		case StartupStateQuarantined:
+			quarantined = append(quarantined, jobID)
+		default:
+			running++
+		}
+	}
 
-		logger.Debugw("JobSpawner: Starting services for job", "jobID", spec.ID, "count", len(services))
+	for _, jobID := range quarantined {
+		// Quarantined jobs are released, so that no node claims them until
+		// they are reactivated
+		deletePromJobLabels(js.activeJobs[jobID].spec)
+		delete(js.activeJobs, jobID)
+		if err := js.orm.UnclaimJob(ctx, jobID); err != nil {
+			logger.Errorw("Error unclaiming quarantined job", "jobID", jobID, "error", err)
+		}
+	}
 
-		aj := activeJob{delegate: delegate, spec: spec}
-		for _, service := range services {
-			err := service.Start()
-			if err != nil {
-				logger.Errorw("Error creating service for job", "jobID", spec.ID, "error", err)
-				continue
-			}
-			aj.services = append(aj.services, service)
+	if waiting > 0 {
+		logger.Infow("JobSpawner: started jobs, others are waiting for their dependencies", "count", running, "waiting", waiting)
+	} else {
+		logger.Infow("JobSpawner: all jobs running", "count", running)
+	}
+}
+
+// startServices starts the services of a claimed job once the dependencies of
+// its type are ready. A job which fails to start JobMaxStartAttempts times in
+// a row is quarantined. activeJobsMu must be held.
+func (js *spawner) startServices(ctx context.Context, aj *activeJob) {
+	spec := aj.spec
+	if err := js.checkDependencies(spec.Type); err != nil {
+		if aj.startup.Detail != err.Error() {
+			logger.Infow("JobSpawner: job is waiting for its dependencies", "jobID", spec.ID, "reason", err)
+		}
+		aj.startup = newStartupState(StartupStateWaiting, err.Error())
+		return
+	}
+
+	// The attempt is counted before starting, so that the attempts which
+	// crashed the node are counted too
+	attempts, err := js.orm.IncrementStartAttempts(ctx, spec.ID)
+	if err != nil {
+		logger.Errorw("Error counting start attempt of job", "jobID", spec.ID, "error", err)
+	} else {
+		aj.startAttempts = attempts
+	}
+	maxAttempts := int32(js.config.JobMaxStartAttempts())
+	if maxAttempts > 0 && aj.startAttempts > maxAttempts {
+		js.quarantine(ctx, aj, fmt.Sprintf("the last %d attempts to start it did not complete", aj.startAttempts-1))
+		return
+	}
+
+	detail := js.tryStartServices(ctx, aj)
+	if detail == "" {
+		aj.startup = newStartupState(StartupStateRunning, "")
+		return
+	}
+
+	// Stop the services which did start, so that the job is started afresh
+	// on the next attempt
+	js.closeServices(aj)
+	if maxAttempts > 0 && aj.startAttempts >= maxAttempts {
+		js.quarantine(ctx, aj, fmt.Sprintf("%d attempts to start it failed, the last one with: %s", aj.startAttempts, detail))
+		return
+	}
+	aj.startup = newStartupState(StartupStateFailed, detail)
+}
+
+// tryStartServices creates and starts the services of a job, and returns why
+// it failed to, if it did
+func (js *spawner) tryStartServices(ctx context.Context, aj *activeJob) string {
+	spec := aj.spec
+	var services []Service
+	err := recoverPanic(func() (err error) {
+		services, err = aj.delegate.ServicesForSpec(spec)
+		return err
+	})
+	if err != nil {
+		logger.Errorw("Error creating services for job", "jobID", spec.ID, "error", err)
+		js.orm.RecordError(ctx, spec.ID, err.Error())
+		return err.Error()
+	}
+
+	logger.Debugw("JobSpawner: Starting services for job", "jobID", spec.ID, "count", len(services))
+
+	for i, service := range services {
+		err := js.startService(service)
+		if errors.Is(err, errServiceStartTimeout) {
+			// Later services usually depend on this one, so leave them
+			detail := fmt.Sprintf("service %d (%T) did not start within %v", i, service, js.config.JobStartTimeout())
+			logger.Errorw("Timed out starting service for job", "jobID", spec.ID, "subservice", i, "serviceType", reflect.TypeOf(service))
+			js.orm.RecordError(ctx, spec.ID, detail)
+			return detail
+		} else if err != nil {
+			logger.Errorw("Error starting service for job", "jobID", spec.ID, "error", err, "subservice", i, "serviceType", reflect.TypeOf(service))
+			js.orm.RecordError(ctx, spec.ID, err.Error())
+			return err.Error()
 		}
-		js.activeJobs[spec.ID] = aj
+		aj.services = append(aj.services, service)
 	}
+	return ""
+}
 
-	logger.Infow("JobSpawner: all jobs running", "count", len(specs))
+// quarantine quarantines a job which keeps failing to start. activeJobsMu
+// must be held.
+func (js *spawner) quarantine(ctx context.Context, aj *activeJob, reason string) {
+	spec := aj.spec
+	detail := "job quarantined: " + reason
+	logger.Errorw("JobSpawner: quarantining job which keeps failing to start", "jobID", spec.ID, "attempts", aj.startAttempts, "reason", reason)
+	if err := js.orm.QuarantineJob(ctx, spec.ID); err != nil {
+		// The job is still released, and attempted again by the next node
+		// which claims it
+		logger.Errorw("Error quarantining job", "jobID", spec.ID, "error", err)
+	}
+	js.orm.RecordError(ctx, spec.ID, detail)
+	aj.startup = newStartupState(StartupStateQuarantined, detail)
+}
+
+// clearStartAttempts clears the start attempts of a job which runs steadily.
+// activeJobsMu must be held.
+func (js *spawner) clearStartAttempts(ctx context.Context, aj *activeJob) {
+	if err := js.orm.ResetStartAttempts(ctx, aj.spec.ID); err != nil {
+		logger.Errorw("Error clearing start attempts of job", "jobID", aj.spec.ID, "error", err)
+		return
+	}
+	aj.startAttempts = 0
+}
+
+// isRetryDue returns whether starting a failed job should be attempted again.
+// The delay between attempts doubles with each of them.
+func (aj activeJob) isRetryDue(now time.Time) bool {
+	delay := checkDependenciesInterval
+	for i := int32(1); i < aj.startAttempts && delay < maxStartRetryDelay; i++ {
+		delay *= 2
+	}
+	if delay > maxStartRetryDelay {
+		delay = maxStartRetryDelay
+	}
+	return !now.Before(aj.startup.UpdatedAt.Add(delay))
+}
+
+// isSteady returns whether a running job with start attempts has run long
+// enough for them to be cleared
+func (aj activeJob) isSteady(now time.Time) bool {
+	return aj.startAttempts > 0 && now.Sub(aj.startup.UpdatedAt) >= steadyRunningPeriod
+}
+
+// recoverPanic calls f, and returns the panic it raises, if any, as an error
+func recoverPanic(f func() error) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			logger.Errorw("JobSpawner: recovered from panic", "panic", r, "stacktrace", string(debug.Stack()))
+			err = errors.Errorf("panic: %v", r)
+		}
+	}()
+	return f()
+}
+
+// checkDependencies returns why one of the dependencies of the job type is not
+// ready, if any
+func (js *spawner) checkDependencies(jobType Type) error {
+	for _, dependency := range js.dependencies[jobType] {
+		if err := dependency.Ready(); err != nil {
+			return errors.Wrapf(err, "%s is not ready", dependency.Name)
+		}
+	}
+	return nil
+}
+
+var errServiceStartTimeout = errors.New("timed out starting service")
+
+// startService starts a service of a job, giving up after JobStartTimeout. A
+// service which starts after the timeout is closed right away.
+func (js *spawner) startService(service Service) error {
+	timeout := js.config.JobStartTimeout()
+	if timeout <= 0 {
+		return recoverPanic(service.Start)
+	}
+
+	chStarted := make(chan error, 1)
+	go func() {
+		chStarted <- recoverPanic(service.Start)
+	}()
+
+	select {
+	case err := <-chStarted:
+		return err
+	case <-time.After(timeout):
+	case <-js.chStop:
+	}
+
+	go func() {
+		if err := <-chStarted; err == nil {
+			if err = service.Close(); err != nil {
+				logger.Errorw("Error stopping job service which started late", "error", err, "serviceType", reflect.TypeOf(service))
+			}
+		}
+	}()
+	return errServiceStartTimeout
+}
+
+func newStartupState(state, detail string) StartupState {
+	return StartupState{State: state, Detail: detail, UpdatedAt: time.Now()}
+}
+
+// hasPendingJobs returns whether a job is waiting for its dependencies, due to
+// be started again or due to have its start attempts cleared
+func (js *spawner) hasPendingJobs() bool {
+	js.activeJobsMu.RLock()
+	defer js.activeJobsMu.RUnlock()
+	now := time.Now()
+	for _, aj := range js.activeJobs {
+		switch aj.startup.State {
+		case StartupStateWaiting:
+			return true
+		case StartupStateFailed:
+			if aj.isRetryDue(now) {
+				return true
+			}
+		case StartupStateRunning:
+			if aj.isSteady(now) {
+				return true
+			}
+		}
+	}
+	return false
 }
 
 func (js *spawner) stopAllServices() {
@@ -238,8 +556,19 @@ func (js *spawner) stopService(jobID int32) {
 	js.activeJobsMu.Lock()
 	defer js.activeJobsMu.Unlock()
 
-	aj := js.activeJobs[jobID]
+	aj, exists := js.activeJobs[jobID]
+	if exists {
+		deletePromJobLabels(aj.spec)
+	}
+
+	js.closeServices(&aj)
+	delete(js.activeJobs, jobID)
+}
 
+// closeServices stops the services of a job in the reverse order they were
+// started in
+func (js *spawner) closeServices(aj *activeJob) {
+	jobID := aj.spec.ID
 	for i := len(aj.services) - 1; i >= 0; i-- {
 		service := aj.services[i]
 		err := service.Close()
@@ -249,7 +578,7 @@ func (js *spawner) stopService(jobID int32) {
 			logger.Infow("Stopped job service", "jobID", jobID, "subservice", i, "serviceType", reflect.TypeOf(service))
 		}
 	}
-	delete(js.activeJobs, jobID)
+	aj.services = nil
 }
 
 func (js *spawner) checkForDeletedJobs(ctx context.Context) {
@@ -259,12 +588,13 @@ func (js *spawner) checkForDeletedJobs(ctx context.Context) {
 		return
 	}
 	for _, jobID := range jobIDs {
-		js.unloadDeletedJob(ctx, jobID)
+		js.unloadJob(ctx, jobID)
 	}
 }
 
-func (js *spawner) unloadDeletedJob(ctx context.Context, jobID int32) {
-	logger.Infow("Unloading deleted job", "jobID", jobID)
+// unloadJob stops a deleted or paused job and releases it
+func (js *spawner) unloadJob(ctx context.Context, jobID int32) {
+	logger.Infow("Unloading job", "jobID", jobID)
 
 	js.stopService(jobID)
 
@@ -283,7 +613,7 @@ func (js *spawner) handlePGDeleteEvent(ctx context.Context, ev postgres.Event) {
 		logger.Errorw("Unexpected error decoding deleted job event payload, expected 32-bit integer", "payload", jobIDString, "channel", ev.Channel)
 	}
 	jobID := int32(jobID64)
-	js.unloadDeletedJob(ctx, jobID)
+	js.unloadJob(ctx, jobID)
 }
 
 func (js *spawner) CreateJob(ctx context.Context, spec Job, name null.String) (Job, error) {
@@ -322,11 +652,89 @@ func (js *spawner) CreateJob(ctx context.Context, spec Job, name null.String) (J
 	return jb, err
 }
 
+// CreateJobs creates several jobs in a single transaction, so that either all
+// of them are created or none
+func (js *spawner) CreateJobs(ctx context.Context, specs []Job) ([]Job, error) {
+	delegates := make([]Delegate, len(specs))
+	for i, spec := range specs {
+		delegate, exists := js.jobTypeDelegates[spec.Type]
+		if !exists {
+			return nil, errors.Errorf("job type '%s' has not been registered with the job.Spawner", spec.Type)
+		}
+		delegates[i] = delegate
+	}
+
+	ctx, cancel := utils.CombinedContext(js.chStop, ctx)
+	defer cancel()
+
+	ctx, cancel = context.WithTimeout(ctx, postgres.DefaultQueryTimeout)
+	defer cancel()
+	jbs := make([]Job, len(specs))
+	err := js.txm.TransactWithContext(ctx, func(ctx context.Context) error {
+		for i := range specs {
+			jb, err := js.orm.CreateJob(ctx, &specs[i], specs[i].Pipeline)
+			if err != nil {
+				logger.Errorw("Error creating job", "type", specs[i].Type, "error", err)
+				return errors.Wrapf(err, "failed to create job %d", i)
+			}
+			jbs[i] = jb
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for i, jb := range jbs {
+		delegates[i].AfterJobCreated(jb)
+		logger.Infow("Created job", "type", jb.Type, "jobID", jb.ID)
+	}
+	return jbs, nil
+}
+
 func (js *spawner) DeleteJob(ctx context.Context, jobID int32) error {
-	if jobID == 0 {
-		return errors.New("will not delete job with 0 ID")
+	return js.DeleteJobs(ctx, []int32{jobID})
+}
+
+// DeleteJobs deletes several jobs at once. Each of them must either be run by
+// this node or be paused, otherwise none is deleted.
+func (js *spawner) DeleteJobs(ctx context.Context, jobIDs []int32) error {
+	ctx, cancel := utils.CombinedContext(js.chStop, ctx)
+	defer cancel()
+
+	var ajs []activeJob
+	for _, jobID := range jobIDs {
+		if jobID == 0 {
+			return errors.New("will not delete job with 0 ID")
+		}
+		aj, err := js.deletableJob(ctx, jobID)
+		if err != nil {
+			return err
+		}
+		ajs = append(ajs, aj)
 	}
 
+	for _, aj := range ajs {
+		// Stop the service if we own the job.
+		js.stopService(aj.spec.ID)
+
+		aj.delegate.BeforeJobDeleted(aj.spec)
+	}
+
+	err := js.orm.DeleteJobs(ctx, jobIDs)
+	if err != nil {
+		logger.Errorw("Error deleting jobs", "jobIDs", jobIDs, "error", err)
+		return err
+	}
+
+	logger.Infow("Deleted jobs", "jobIDs", jobIDs)
+
+	return nil
+}
+
+// deletableJob returns the job with the given ID if this node runs it, or if
+// it is paused and so run by no node
+func (js *spawner) deletableJob(ctx context.Context, jobID int32) (activeJob, error) {
 	var aj activeJob
 	var exists bool
 	func() {
@@ -334,28 +742,176 @@ func (js *spawner) DeleteJob(ctx context.Context, jobID int32) error {
 		defer js.activeJobsMu.RUnlock()
 		aj, exists = js.activeJobs[jobID]
 	}()
+	if exists {
+		return aj, nil
+	}
+
+	spec, err := js.orm.FindJob(ctx, jobID)
+	if err != nil || !spec.Paused {
+		return aj, errors.Errorf("job not found (id: %v)", jobID)
+	}
+	delegate, exists := js.jobTypeDelegates[spec.Type]
+	if !exists {
+		return aj, errors.Errorf("job type '%s' has not been registered with the job.Spawner", spec.Type)
+	}
+	return activeJob{delegate: delegate, spec: spec}, nil
+}
+
+// PauseJobs pauses several jobs at once, and stops those this node runs. Other
+// nodes stop them the next time they check for deleted jobs.
+func (js *spawner) PauseJobs(ctx context.Context, jobIDs []int32) error {
+	ctx, cancel := utils.CombinedContext(js.chStop, ctx)
+	defer cancel()
+	if err := js.orm.SetJobsPaused(ctx, jobIDs, true); err != nil {
+		logger.Errorw("Error pausing jobs", "jobIDs", jobIDs, "error", err)
+		return err
+	}
+
+	for _, jobID := range jobIDs {
+		var exists bool
+		func() {
+			js.activeJobsMu.RLock()
+			defer js.activeJobsMu.RUnlock()
+			_, exists = js.activeJobs[jobID]
+		}()
+		if exists {
+			js.unloadJob(ctx, jobID)
+		}
+	}
+
+	logger.Infow("Paused jobs", "jobIDs", jobIDs)
+	return nil
+}
+
+// ResumeJobs resumes several paused jobs at once. They are started by the
+// first node which claims them.
+func (js *spawner) ResumeJobs(ctx context.Context, jobIDs []int32) error {
+	ctx, cancel := utils.CombinedContext(js.chStop, ctx)
+	defer cancel()
+	if err := js.orm.SetJobsPaused(ctx, jobIDs, false); err != nil {
+		logger.Errorw("Error resuming jobs", "jobIDs", jobIDs, "error", err)
+		return err
+	}
+	js.startUnclaimedServicesWorker.WakeUp()
+
+	logger.Infow("Resumed jobs", "jobIDs", jobIDs)
+	return nil
+}
+
+// RestartJob stops the services of a job which this node owns, and starts
+// them again from its current spec, e.g. after its spec was updated in the DB
+func (js *spawner) RestartJob(ctx context.Context, jobID int32) error {
+	var exists bool
+	func() {
+		js.activeJobsMu.RLock()
+		defer js.activeJobsMu.RUnlock()
+		_, exists = js.activeJobs[jobID]
+	}()
 	if !exists {
 		return errors.Errorf("job not found (id: %v)", jobID)
 	}
 
-	// Stop the service if we own the job.
 	js.stopService(jobID)
 
-	aj.delegate.BeforeJobDeleted(aj.spec)
+	ctx, cancel := utils.CombinedContext(js.chStop, ctx)
+	defer cancel()
+	if err := js.orm.UnclaimJob(ctx, jobID); err != nil {
+		logger.Errorw("Error unclaiming job", "jobID", jobID, "error", err)
+		return err
+	}
+	js.startUnclaimedServicesWorker.WakeUp()
+
+	logger.Infow("Restarted job", "jobID", jobID)
+	return nil
+}
 
+// ReactivateJob lifts the quarantine of a job which kept failing to start, e.g.
+// once what made it fail was fixed. It is started by the first node which
+// claims it.
+func (js *spawner) ReactivateJob(ctx context.Context, jobID int32) error {
 	ctx, cancel := utils.CombinedContext(js.chStop, ctx)
 	defer cancel()
-	err := js.orm.DeleteJob(ctx, jobID)
-	if err != nil {
-		logger.Errorw("Error deleting job", "jobID", jobID, "error", err)
+	if err := js.orm.ReactivateJob(ctx, jobID); err != nil {
+		logger.Errorw("Error reactivating job", "jobID", jobID, "error", err)
 		return err
 	}
+	js.startUnclaimedServicesWorker.WakeUp()
 
-	logger.Infow("Deleted job", "jobID", jobID)
+	logger.Infow("Reactivated job", "jobID", jobID)
+	return nil
+}
+
+// SetJobLabels replaces the labels of a job, and the labels exported to
+// Prometheus if this node runs it
+func (js *spawner) SetJobLabels(ctx context.Context, jobID int32, labels Labels) error {
+	ctx, cancel := utils.CombinedContext(js.chStop, ctx)
+	defer cancel()
+	if err := js.orm.SetJobLabels(ctx, jobID, labels); err != nil {
+		logger.Errorw("Error setting job labels", "jobID", jobID, "error", err)
+		return err
+	}
 
+	js.activeJobsMu.Lock()
+	defer js.activeJobsMu.Unlock()
+	if aj, exists := js.activeJobs[jobID]; exists {
+		deletePromJobLabels(aj.spec)
+		aj.spec.Labels = labels
+		setPromJobLabels(aj.spec)
+		js.activeJobs[jobID] = aj
+	}
+
+	logger.Infow("Set job labels", "jobID", jobID, "labels", labels.String())
 	return nil
 }
 
+func setPromJobLabels(spec Job) {
+	for name, value := range spec.Labels {
+		promJobLabels.WithLabelValues(fmt.Sprintf("%d", spec.ID), spec.Name.ValueOrZero(), name, value).Set(1)
+	}
+}
+
+func deletePromJobLabels(spec Job) {
+	for name, value := range spec.Labels {
+		promJobLabels.DeleteLabelValues(fmt.Sprintf("%d", spec.ID), spec.Name.ValueOrZero(), name, value)
+	}
+}
+
+// Drain stops the services of all the jobs this node runs and releases them
+// to the other nodes sharing the database, e.g. before it is shut down for a
+// deploy. Job services finish their in-flight runs as they stop. The node
+// claims no further jobs once it is draining.
+func (js *spawner) Drain(ctx context.Context) error {
+	var jobIDs []int32
+	func() {
+		js.activeJobsMu.Lock()
+		defer js.activeJobsMu.Unlock()
+		js.draining = true
+		for jobID := range js.activeJobs {
+			jobIDs = append(jobIDs, jobID)
+		}
+	}()
+
+	ctx, cancel := utils.CombinedContext(js.chStop, ctx)
+	defer cancel()
+	var merr error
+	for _, jobID := range jobIDs {
+		js.stopService(jobID)
+		if err := js.orm.UnclaimJob(ctx, jobID); err != nil {
+			logger.Errorw("Error unclaiming job", "jobID", jobID, "error", err)
+			merr = multierr.Append(merr, err)
+		}
+	}
+
+	logger.Infow("JobSpawner: drained jobs", "jobIDs", jobIDs)
+	return merr
+}
+
+func (js *spawner) isDraining() bool {
+	js.activeJobsMu.RLock()
+	defer js.activeJobsMu.RUnlock()
+	return js.draining
+}
+
 func (js *spawner) ActiveJobs() map[int32]Job {
 	js.activeJobsMu.RLock()
 	defer js.activeJobsMu.RUnlock()
@@ -367,6 +923,85 @@ func (js *spawner) ActiveJobs() map[int32]Job {
 	return m
 }
 
+// StartupStates returns how far the services of each job claimed by this node
+// got in starting
+func (js *spawner) StartupStates() map[int32]StartupState {
+	js.activeJobsMu.RLock()
+	defer js.activeJobsMu.RUnlock()
+
+	m := make(map[int32]StartupState, len(js.activeJobs))
+	for jobID, aj := range js.activeJobs {
+		m[jobID] = aj.startup
+	}
+	return m
+}
+
+// Healthy reports the quarantined jobs, which need an operator to look into
+// why they fail to start and reactivate them
+func (js *spawner) Healthy() error {
+	if err := js.StartStopOnce.Healthy(); err != nil {
+		return err
+	}
+	ctx, cancel := utils.CombinedContext(js.chStop, postgres.DefaultQueryTimeout)
+	defer cancel()
+	jobIDs, err := js.orm.FindQuarantinedJobIDs(ctx)
+	if err != nil {
+		return err
+	}
+	if len(jobIDs) > 0 {
+		return errors.Errorf("jobs quarantined after failing to start: %v", jobIDs)
+	}
+	return nil
+}
+
+// SubChecks returns the health checks of the active jobs, so that they are
+// reported under the spawner in the health tree
+func (js *spawner) SubChecks() map[string]health.Checkable {
+	js.activeJobsMu.RLock()
+	defer js.activeJobsMu.RUnlock()
+
+	checks := make(map[string]health.Checkable, len(js.activeJobs))
+	for jobID, aj := range js.activeJobs {
+		name := fmt.Sprintf("job %d", jobID)
+		if aj.spec.Name.Valid && aj.spec.Name.String != "" {
+			name = fmt.Sprintf("job %d (%s)", jobID, aj.spec.Name.String)
+		}
+		checks[name] = jobCheck{startup: aj.startup, services: aj.services}
+	}
+	return checks
+}
+
+var _ health.Composite = (*spawner)(nil)
+
+// jobCheck reports the health of a job from how far its services got in
+// starting, and from the checks of those of its services which have any
+type jobCheck struct {
+	startup  StartupState
+	services []Service
+}
+
+func (c jobCheck) Ready() error {
+	if c.startup.State != StartupStateRunning {
+		if c.startup.Detail == "" {
+			return errors.Errorf("job is %s", c.startup.State)
+		}
+		return errors.Errorf("job is %s: %s", c.startup.State, c.startup.Detail)
+	}
+	return nil
+}
+
+func (c jobCheck) Healthy() (merr error) {
+	if err := c.Ready(); err != nil {
+		return err
+	}
+	for _, service := range c.services {
+		if checkable, ok := service.(health.Checkable); ok {
+			merr = multierr.Append(merr, checkable.Healthy())
+		}
+	}
+	return merr
+}
+
 var _ Delegate = &NullDelegate{}
 
 type NullDelegate struct {
@@ -2,12 +2,15 @@ package job_test
 
 import (
 	"context"
+	"fmt"
 	"testing"
 	"time"
 
 	"github.com/jackc/pgtype"
 	"github.com/onsi/gomega"
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
+	"go.uber.org/atomic"
 
 	"github.com/smartcontractkit/chainlink/core/store/models"
 
@@ -96,7 +99,7 @@ func TestSpawner_CreateJobDeleteJob(t *testing.T) {
 		spawner := job.NewSpawner(orm, config, map[job.Type]job.Delegate{
 			jobSpecA.Type: delegateA,
 			jobSpecB.Type: delegateB,
-		}, txm)
+		}, nil, txm)
 		spawner.Start()
 		jobA, err := spawner.CreateJob(context.Background(), *jobSpecA, null.String{})
 		require.NoError(t, err)
@@ -150,7 +153,7 @@ func TestSpawner_CreateJobDeleteJob(t *testing.T) {
 		delegateA := &delegate{jobSpecA.Type, []job.Service{serviceA1, serviceA2}, 0, nil, offchainreporting.NewDelegate(nil, nil, orm, nil, nil, nil, ethClient, nil, nil, monitoringEndpoint, nil, nil)}
 		spawner := job.NewSpawner(orm, config, map[job.Type]job.Delegate{
 			jobSpecA.Type: delegateA,
-		}, txm)
+		}, nil, txm)
 
 		jobA, err := spawner.CreateJob(context.Background(), *jobSpecA, null.String{})
 		require.NoError(t, err)
@@ -179,7 +182,7 @@ func TestSpawner_CreateJobDeleteJob(t *testing.T) {
 		delegateA := &delegate{jobSpecA.Type, []job.Service{serviceA1, serviceA2}, 0, nil, offchainreporting.NewDelegate(nil, nil, orm, nil, nil, nil, ethClient, nil, nil, monitoringEndpoint, nil, nil)}
 		spawner := job.NewSpawner(orm, config, map[job.Type]job.Delegate{
 			jobSpecA.Type: delegateA,
-		}, txm)
+		}, nil, txm)
 
 		serviceA1.On("Start").Return(nil).Once()
 		serviceA2.On("Start").Return(nil).Once().Run(func(mock.Arguments) { eventually.ItHappened() })
@@ -202,6 +205,187 @@ func TestSpawner_CreateJobDeleteJob(t *testing.T) {
 
 	clearDB(t, db)
 
+	t.Run("stops and unclaims job services when .Drain() is called", func(t *testing.T) {
+		jobSpecA := makeOCRJobSpec(t, address)
+
+		eventually := cltest.NewAwaiter()
+		serviceA1 := new(mocks.Service)
+		serviceA2 := new(mocks.Service)
+		orm := job.NewORM(db, config.Config, pipeline.NewORM(db), eventBroadcaster, &postgres.NullAdvisoryLocker{})
+		defer orm.Close()
+		delegateA := &delegate{jobSpecA.Type, []job.Service{serviceA1, serviceA2}, 0, nil, offchainreporting.NewDelegate(nil, nil, orm, nil, nil, nil, ethClient, nil, nil, monitoringEndpoint, nil, nil)}
+		spawner := job.NewSpawner(orm, config, map[job.Type]job.Delegate{
+			jobSpecA.Type: delegateA,
+		}, nil, txm)
+
+		serviceA1.On("Start").Return(nil).Once()
+		serviceA2.On("Start").Return(nil).Once().Run(func(mock.Arguments) { eventually.ItHappened() })
+		jobA, err := spawner.CreateJob(context.Background(), *jobSpecA, null.String{})
+		require.NoError(t, err)
+		delegateA.jobID = jobA.ID
+
+		spawner.Start()
+		defer spawner.Close()
+
+		eventually.AwaitOrFail(t)
+
+		serviceA1.On("Close").Return(nil).Once()
+		serviceA2.On("Close").Return(nil).Once()
+
+		require.NoError(t, spawner.Drain(context.Background()))
+
+		mock.AssertExpectationsForObjects(t, serviceA1, serviceA2)
+		assert.Len(t, spawner.ActiveJobs(), 0)
+		assert.Len(t, job.GetORMClaimedJobs(orm), 0)
+
+		// a draining node claims no further jobs
+		jobSpecB := makeOCRJobSpec(t, address)
+		_, err = spawner.CreateJob(context.Background(), *jobSpecB, null.String{})
+		require.NoError(t, err)
+		gomega.NewGomegaWithT(t).Consistently(func() int {
+			return len(spawner.ActiveJobs())
+		}).Should(gomega.Equal(0))
+	})
+
+	clearDB(t, db)
+
+	t.Run("waits for the dependencies of a job before starting its services", func(t *testing.T) {
+		jobSpecA := makeOCRJobSpec(t, address)
+
+		eventually := cltest.NewAwaiter()
+		serviceA1 := new(mocks.Service)
+		serviceA1.On("Start").Return(nil).Once().Run(func(mock.Arguments) { eventually.ItHappened() })
+
+		orm := job.NewORM(db, config.Config, pipeline.NewORM(db), eventBroadcaster, &postgres.NullAdvisoryLocker{})
+		defer orm.Close()
+		delegateA := &delegate{jobSpecA.Type, []job.Service{serviceA1}, 0, nil, offchainreporting.NewDelegate(nil, nil, orm, nil, nil, nil, ethClient, nil, nil, monitoringEndpoint, nil, nil)}
+		var ready atomic.Bool
+		spawner := job.NewSpawner(orm, config, map[job.Type]job.Delegate{
+			jobSpecA.Type: delegateA,
+		}, map[job.Type][]job.Dependency{
+			jobSpecA.Type: {{Name: "log broadcaster", Ready: func() error {
+				if ready.Load() {
+					return nil
+				}
+				return errors.New("not started")
+			}}},
+		}, txm)
+
+		jobA, err := spawner.CreateJob(context.Background(), *jobSpecA, null.String{})
+		require.NoError(t, err)
+
+		spawner.Start()
+		defer spawner.Close()
+
+		gomega.NewGomegaWithT(t).Eventually(func() string {
+			return spawner.StartupStates()[jobA.ID].State
+		}).Should(gomega.Equal(job.StartupStateWaiting))
+		assert.Equal(t, "log broadcaster is not ready: not started", spawner.StartupStates()[jobA.ID].Detail)
+		serviceA1.AssertNotCalled(t, "Start")
+
+		ready.Store(true)
+
+		eventually.AwaitOrFail(t)
+		gomega.NewGomegaWithT(t).Eventually(func() string {
+			return spawner.StartupStates()[jobA.ID].State
+		}).Should(gomega.Equal(job.StartupStateRunning))
+
+		serviceA1.On("Close").Return(nil).Once()
+	})
+
+	clearDB(t, db)
+
+	t.Run("reports a job whose service does not start in time as failed", func(t *testing.T) {
+		config.Set("JOB_START_TIMEOUT", "100ms")
+		defer config.Set("JOB_START_TIMEOUT", "30s")
+		jobSpecA := makeOCRJobSpec(t, address)
+
+		chUnblock := make(chan struct{})
+		serviceA1 := new(mocks.Service)
+		serviceA2 := new(mocks.Service)
+		serviceA1.On("Start").Return(nil).Once().Run(func(mock.Arguments) { <-chUnblock })
+		// The service is closed once it eventually starts
+		serviceA1.On("Close").Return(nil).Once()
+
+		orm := job.NewORM(db, config.Config, pipeline.NewORM(db), eventBroadcaster, &postgres.NullAdvisoryLocker{})
+		defer orm.Close()
+		delegateA := &delegate{jobSpecA.Type, []job.Service{serviceA1, serviceA2}, 0, nil, offchainreporting.NewDelegate(nil, nil, orm, nil, nil, nil, ethClient, nil, nil, monitoringEndpoint, nil, nil)}
+		spawner := job.NewSpawner(orm, config, map[job.Type]job.Delegate{
+			jobSpecA.Type: delegateA,
+		}, nil, txm)
+
+		jobA, err := spawner.CreateJob(context.Background(), *jobSpecA, null.String{})
+		require.NoError(t, err)
+
+		spawner.Start()
+		defer spawner.Close()
+
+		gomega.NewGomegaWithT(t).Eventually(func() string {
+			return spawner.StartupStates()[jobA.ID].State
+		}).Should(gomega.Equal(job.StartupStateFailed))
+		assert.Contains(t, spawner.StartupStates()[jobA.ID].Detail, "did not start within 100ms")
+		serviceA2.AssertNotCalled(t, "Start")
+
+		close(chUnblock)
+		gomega.NewGomegaWithT(t).Eventually(func() bool {
+			return serviceA1.AssertExpectations(new(testing.T))
+		}).Should(gomega.BeTrue())
+	})
+
+	clearDB(t, db)
+
+	t.Run("quarantines a job whose services keep failing to start until it is reactivated", func(t *testing.T) {
+		config.Set("JOB_MAX_START_ATTEMPTS", "1")
+		defer config.Set("JOB_MAX_START_ATTEMPTS", "5")
+		jobSpecA := makeOCRJobSpec(t, address)
+
+		serviceA1 := new(mocks.Service)
+		serviceA1.On("Start").Return(errors.New("boom")).Once()
+
+		orm := job.NewORM(db, config.Config, pipeline.NewORM(db), eventBroadcaster, &postgres.NullAdvisoryLocker{})
+		defer orm.Close()
+		delegateA := &delegate{jobSpecA.Type, []job.Service{serviceA1}, 0, nil, offchainreporting.NewDelegate(nil, nil, orm, nil, nil, nil, ethClient, nil, nil, monitoringEndpoint, nil, nil)}
+		spawner := job.NewSpawner(orm, config, map[job.Type]job.Delegate{
+			jobSpecA.Type: delegateA,
+		}, nil, txm)
+
+		jobA, err := spawner.CreateJob(context.Background(), *jobSpecA, null.String{})
+		require.NoError(t, err)
+
+		spawner.Start()
+		defer spawner.Close()
+
+		gomega.NewGomegaWithT(t).Eventually(func() *time.Time {
+			jb, err := orm.FindJob(context.Background(), jobA.ID)
+			require.NoError(t, err)
+			return jb.QuarantinedAt
+		}).ShouldNot(gomega.BeNil())
+		gomega.NewGomegaWithT(t).Eventually(func() int {
+			return len(spawner.ActiveJobs())
+		}).Should(gomega.Equal(0))
+		require.Error(t, spawner.Healthy())
+		assert.Contains(t, spawner.Healthy().Error(), fmt.Sprintf("[%d]", jobA.ID))
+
+		// a quarantined job is not claimed again
+		gomega.NewGomegaWithT(t).Consistently(func() int {
+			return len(spawner.ActiveJobs())
+		}).Should(gomega.Equal(0))
+
+		eventually := cltest.NewAwaiter()
+		serviceA1.On("Start").Return(nil).Once().Run(func(mock.Arguments) { eventually.ItHappened() })
+		require.NoError(t, spawner.ReactivateJob(context.Background(), jobA.ID))
+
+		eventually.AwaitOrFail(t)
+		gomega.NewGomegaWithT(t).Eventually(func() string {
+			return spawner.StartupStates()[jobA.ID].State
+		}).Should(gomega.Equal(job.StartupStateRunning))
+		require.NoError(t, spawner.Healthy())
+
+		serviceA1.On("Close").Return(nil).Once()
+	})
+
+	clearDB(t, db)
+
 	t.Run("closes job services on 'delete_from_jobs' postgres event", func(t *testing.T) {
 		jobSpecA := makeOCRJobSpec(t, address)
 
@@ -216,7 +400,7 @@ func TestSpawner_CreateJobDeleteJob(t *testing.T) {
 		delegateA := &delegate{jobSpecA.Type, []job.Service{serviceA1, serviceA2}, 0, nil, offchainreporting.NewDelegate(nil, nil, nil, nil, nil, nil, ethClient, nil, nil, monitoringEndpoint, nil, nil)}
 		spawner := job.NewSpawner(orm, config, map[job.Type]job.Delegate{
 			jobSpecA.Type: delegateA,
-		}, txm)
+		}, nil, txm)
 
 		jobA, err := spawner.CreateJob(context.Background(), *jobSpecA, null.String{})
 		require.NoError(t, err)
@@ -0,0 +1,226 @@
+package job
+
+import (
+	"context"
+	"database/sql/driver"
+	"encoding/json"
+	"regexp"
+	"sort"
+	"strings"
+	"text/template"
+	"text/template/parse"
+	"time"
+
+	"github.com/jackc/pgconn"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+
+	"github.com/smartcontractkit/chainlink/core/services/postgres"
+)
+
+// Template is a job spec in which {{.Var}} placeholders are substituted with
+// variables when a job is created from it, so that similar jobs, e.g. the
+// feeds of a fleet, share one spec
+type Template struct {
+	ID        int32  `gorm:"primary_key"`
+	Name      string `gorm:"uniqueIndex"`
+	TOML      string `gorm:"column:toml"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// TableName sets the table of templates
+func (Template) TableName() string {
+	return "job_templates"
+}
+
+// TemplateVariables are the values of the placeholders of a template
+type TemplateVariables map[string]string
+
+var templateNameRegex = regexp.MustCompile(`^[a-zA-Z0-9_.-]+$`)
+
+// ErrTemplateExists is returned when creating a template with the name of
+// another one
+var ErrTemplateExists = errors.New("a template with this name already exists")
+
+// ValidateTemplate returns an error if the template has no valid name, or
+// its TOML can't be parsed as a template
+func ValidateTemplate(t Template) error {
+	if !templateNameRegex.MatchString(t.Name) {
+		return errors.Errorf("invalid template name %q, must match %s", t.Name, templateNameRegex)
+	}
+	_, err := t.parse()
+	return err
+}
+
+func (t Template) parse() (*template.Template, error) {
+	tmpl, err := template.New(t.Name).Option("missingkey=error").Parse(t.TOML)
+	return tmpl, errors.Wrap(err, "invalid template")
+}
+
+// Variables returns the names of the variables the template refers to, in
+// alphabetical order
+func (t Template) Variables() ([]string, error) {
+	tmpl, err := t.parse()
+	if err != nil {
+		return nil, err
+	}
+	names := map[string]struct{}{}
+	if tmpl.Tree != nil {
+		collectTemplateVariables(tmpl.Tree.Root, names)
+	}
+	variables := make([]string, 0, len(names))
+	for name := range names {
+		variables = append(variables, name)
+	}
+	sort.Strings(variables)
+	return variables, nil
+}
+
+func collectTemplateVariables(node parse.Node, names map[string]struct{}) {
+	switch n := node.(type) {
+	case *parse.ListNode:
+		if n == nil {
+			return
+		}
+		for _, child := range n.Nodes {
+			collectTemplateVariables(child, names)
+		}
+	case *parse.ActionNode:
+		collectTemplateVariables(n.Pipe, names)
+	case *parse.PipeNode:
+		if n == nil {
+			return
+		}
+		for _, cmd := range n.Cmds {
+			for _, arg := range cmd.Args {
+				collectTemplateVariables(arg, names)
+			}
+		}
+	case *parse.FieldNode:
+		names[n.Ident[0]] = struct{}{}
+	case *parse.IfNode:
+		collectBranchVariables(&n.BranchNode, names)
+	case *parse.RangeNode:
+		collectBranchVariables(&n.BranchNode, names)
+	case *parse.WithNode:
+		collectBranchVariables(&n.BranchNode, names)
+	case *parse.TemplateNode:
+		collectTemplateVariables(n.Pipe, names)
+	}
+}
+
+func collectBranchVariables(n *parse.BranchNode, names map[string]struct{}) {
+	collectTemplateVariables(n.Pipe, names)
+	collectTemplateVariables(n.List, names)
+	collectTemplateVariables(n.ElseList, names)
+}
+
+// Render substitutes the variables in the template, and returns the TOML
+// spec of the job. Every variable the template refers to must be given, and
+// every given variable must be referred to.
+func (t Template) Render(variables TemplateVariables) (string, error) {
+	tmpl, err := t.parse()
+	if err != nil {
+		return "", err
+	}
+	names, err := t.Variables()
+	if err != nil {
+		return "", err
+	}
+	var missing []string
+	referred := make(map[string]struct{}, len(names))
+	for _, name := range names {
+		referred[name] = struct{}{}
+		if _, ok := variables[name]; !ok {
+			missing = append(missing, name)
+		}
+	}
+	if len(missing) > 0 {
+		return "", errors.Errorf("missing template variables: %s", strings.Join(missing, ", "))
+	}
+	var unused []string
+	for name := range variables {
+		if _, ok := referred[name]; !ok {
+			unused = append(unused, name)
+		}
+	}
+	if len(unused) > 0 {
+		sort.Strings(unused)
+		return "", errors.Errorf("template %s does not refer to variables: %s", t.Name, strings.Join(unused, ", "))
+	}
+
+	var b strings.Builder
+	if err := tmpl.Execute(&b, map[string]string(variables)); err != nil {
+		return "", errors.Wrap(err, "failed to render template")
+	}
+	return b.String(), nil
+}
+
+func (v *TemplateVariables) Scan(value interface{}) error {
+	if value == nil {
+		*v = nil
+		return nil
+	}
+	bytes, ok := value.([]byte)
+	if !ok {
+		return errors.Errorf("TemplateVariables#Scan received a value of type %T", value)
+	}
+	return json.Unmarshal(bytes, v)
+}
+
+func (v TemplateVariables) Value() (driver.Value, error) {
+	if v == nil {
+		return nil, nil
+	}
+	return json.Marshal(v)
+}
+
+// CreateTemplate saves a new template
+func (o *orm) CreateTemplate(t *Template) error {
+	if err := ValidateTemplate(*t); err != nil {
+		return err
+	}
+	ctx, cancel := postgres.DefaultQueryCtx()
+	defer cancel()
+	err := o.db.WithContext(ctx).Create(t).Error
+	if pqErr, ok := err.(*pgconn.PgError); ok && pqErr.ConstraintName == "job_templates_name_key" {
+		return ErrTemplateExists
+	}
+	return errors.Wrap(err, "CreateTemplate failed")
+}
+
+// FindTemplate returns the template with the given name
+func (o *orm) FindTemplate(name string) (Template, error) {
+	var t Template
+	ctx, cancel := postgres.DefaultQueryCtx()
+	defer cancel()
+	err := o.db.WithContext(ctx).Where("name = ?", name).First(&t).Error
+	return t, err
+}
+
+// Templates returns a page of the templates, ordered by name
+func (o *orm) Templates(offset, limit int) ([]Template, int, error) {
+	var count int64
+	var templates []Template
+	err := postgres.GormTransactionWithDefaultContext(o.db, func(tx *gorm.DB) error {
+		if err := tx.Model(Template{}).Count(&count).Error; err != nil {
+			return err
+		}
+		return tx.Order("name ASC").Limit(limit).Offset(offset).Find(&templates).Error
+	})
+	return templates, int(count), errors.Wrap(err, "Templates failed")
+}
+
+// DeleteTemplate deletes a template. The jobs created from it are kept, and
+// no longer refer to a template.
+func (o *orm) DeleteTemplate(ctx context.Context, name string) error {
+	result := postgres.TxFromContext(ctx, o.db).Exec(`DELETE FROM job_templates WHERE name = ?`, name)
+	if result.Error != nil {
+		return errors.Wrap(result.Error, "DeleteTemplate failed")
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
@@ -0,0 +1,63 @@
+package job_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/services/job"
+)
+
+const fluxMonitorTemplate = `
+type              = "fluxmonitor"
+schemaVersion     = 1
+name              = "{{.Pair}} feed"
+contractAddress   = "{{.Contract}}"
+threshold         = 0.5
+{{if .Heartbeat}}idleTimerPeriod = "{{.Heartbeat}}"{{end}}
+observationSource = """
+ds [type=http method=GET url="https://prices.example.com/{{.Pair}}"];
+"""
+`
+
+func TestTemplate_Variables(t *testing.T) {
+	t.Parallel()
+
+	variables, err := job.Template{Name: "feed", TOML: fluxMonitorTemplate}.Variables()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"Contract", "Heartbeat", "Pair"}, variables)
+
+	_, err = job.Template{Name: "feed", TOML: `name = "{{.Pair"`}.Variables()
+	assert.Error(t, err)
+}
+
+func TestTemplate_Render(t *testing.T) {
+	t.Parallel()
+
+	tmpl := job.Template{Name: "feed", TOML: fluxMonitorTemplate}
+	toml, err := tmpl.Render(job.TemplateVariables{
+		"Pair":      "ETH-USD",
+		"Contract":  "0x3cCad4715152693fE3BC4460591e3D3Fbd071b42",
+		"Heartbeat": "1h",
+	})
+	require.NoError(t, err)
+	assert.Contains(t, toml, `name              = "ETH-USD feed"`)
+	assert.Contains(t, toml, `contractAddress   = "0x3cCad4715152693fE3BC4460591e3D3Fbd071b42"`)
+	assert.Contains(t, toml, `idleTimerPeriod = "1h"`)
+	assert.Contains(t, toml, `url="https://prices.example.com/ETH-USD"`)
+
+	_, err = tmpl.Render(job.TemplateVariables{"Pair": "ETH-USD"})
+	assert.EqualError(t, err, "missing template variables: Contract, Heartbeat")
+
+	_, err = tmpl.Render(job.TemplateVariables{"Pair": "ETH-USD", "Contract": "0x3cCad4715152693fE3BC4460591e3D3Fbd071b42", "Heartbeat": "", "Threshold": "1"})
+	assert.EqualError(t, err, "template feed does not refer to variables: Threshold")
+}
+
+func TestValidateTemplate(t *testing.T) {
+	t.Parallel()
+
+	assert.NoError(t, job.ValidateTemplate(job.Template{Name: "eth-usd.v2", TOML: fluxMonitorTemplate}))
+	assert.Error(t, job.ValidateTemplate(job.Template{Name: "eth usd", TOML: fluxMonitorTemplate}))
+	assert.Error(t, job.ValidateTemplate(job.Template{Name: "feed", TOML: `{{if .Pair}}`}))
+}
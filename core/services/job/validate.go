@@ -48,6 +48,9 @@ func ValidateSpec(ts string) (Type, error) {
 	if jb.SchemaVersion != 1 {
 		return "", ErrInvalidSchemaVersion
 	}
+	if err = jb.Labels.Validate(); err != nil {
+		return "", err
+	}
 	if jb.Type.RequiresPipelineSpec() && (jb.Pipeline.Source == "") {
 		return "", ErrNoPipelineSpec
 	}
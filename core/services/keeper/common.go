@@ -10,10 +10,14 @@ import (
 var RegistryABI = eth.MustGetABI(keeper_registry_wrapper.KeeperRegistryABI)
 
 type Config interface {
+	KeeperBatchPerformUpkeep() bool
 	KeeperDefaultTransactionQueueDepth() uint32
+	KeeperEligibilityStrategy() string
 	KeeperMaximumGracePeriod() int64
 	KeeperMinimumRequiredConfirmations() uint64
 	KeeperRegistryCheckGasOverhead() uint64
 	KeeperRegistryPerformGasOverhead() uint64
 	KeeperRegistrySyncInterval() time.Duration
+	KeeperSimulationMaxConsecutiveReverts() uint32
+	KeeperSimulationParkDuration() time.Duration
 }
@@ -10,11 +10,12 @@ import (
 	"github.com/smartcontractkit/chainlink/core/services/job"
 	"github.com/smartcontractkit/chainlink/core/services/log"
 	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+	"github.com/smartcontractkit/chainlink/core/store/models"
 	"gorm.io/gorm"
 )
 
 type transmitter interface {
-	CreateEthTransaction(db *gorm.DB, fromAddress, toAddress common.Address, payload []byte, gasLimit uint64, meta interface{}, strategy bulletprooftxmanager.TxStrategy) (etx bulletprooftxmanager.EthTx, err error)
+	CreateEthTransaction(db *gorm.DB, fromAddress, toAddress common.Address, payload []byte, gasLimit uint64, meta interface{}, strategy bulletprooftxmanager.TxStrategy, urgency bulletprooftxmanager.EthTxUrgency, expiry bulletprooftxmanager.EthTxExpiry, simulate bool) (etx bulletprooftxmanager.EthTx, err error)
 }
 
 type Delegate struct {
@@ -68,38 +69,56 @@ func (d *Delegate) ServicesForSpec(spec job.Job) (services []job.Service, err er
 		return nil, errors.Errorf("Delegate expects a *job.KeeperSpec to be present, got %v", spec)
 	}
 
-	contractAddress := spec.KeeperSpec.ContractAddress
-	contract, err := keeper_registry_wrapper.NewKeeperRegistry(
-		contractAddress.Address(),
-		d.ethClient,
-	)
-	if err != nil {
-		return nil, errors.Wrap(err, "unable to create keeper registry contract wrapper")
-	}
 	strategy := bulletprooftxmanager.NewQueueingTxStrategy(spec.ExternalJobID, d.config.KeeperDefaultTransactionQueueDepth())
+	if d.config.KeeperBatchPerformUpkeep() {
+		strategy = bulletprooftxmanager.NewBatchingStrategy(strategy)
+	}
 
 	orm := NewORM(d.db, d.txm, d.config, strategy)
 
-	registrySynchronizer := NewRegistrySynchronizer(
-		spec,
-		contract,
-		orm,
-		d.jrm,
-		d.logBroadcaster,
-		d.config.KeeperRegistrySyncInterval(),
-		d.config.KeeperMinimumRequiredConfirmations(),
-	)
-	upkeepExecuter := NewUpkeepExecuter(
-		spec,
-		orm,
-		d.pr,
-		d.ethClient,
-		d.headBroadcaster,
-		d.config,
-	)
+	// Every registry of the job is synced and performed independently
+	for _, registrySpec := range spec.KeeperSpec.RegistrySpecs() {
+		contract, err := keeper_registry_wrapper.NewKeeperRegistry(
+			registrySpec.ContractAddress.Address(),
+			d.ethClient,
+		)
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to create keeper registry contract wrapper")
+		}
+
+		if registrySpec.SyncInterval.IsZero() {
+			registrySpec.SyncInterval = models.Interval(d.config.KeeperRegistrySyncInterval())
+		}
+		if registrySpec.EligibilityStrategy == "" {
+			registrySpec.EligibilityStrategy = d.config.KeeperEligibilityStrategy()
+		}
+		eligibilityStrategy, err := NewEligibilityStrategy(registrySpec.EligibilityStrategy, d.ethClient)
+		if err != nil {
+			return nil, err
+		}
+
+		registrySynchronizer := NewRegistrySynchronizer(
+			spec,
+			registrySpec,
+			contract,
+			orm,
+			d.jrm,
+			d.ethClient,
+			d.logBroadcaster,
+			d.config.KeeperMinimumRequiredConfirmations(),
+		)
+		upkeepExecuter := NewUpkeepExecuter(
+			spec,
+			registrySpec.ContractAddress,
+			orm,
+			d.pr,
+			d.ethClient,
+			d.headBroadcaster,
+			eligibilityStrategy,
+			d.config,
+		)
+		services = append(services, registrySynchronizer, upkeepExecuter)
+	}
 
-	return []job.Service{
-		registrySynchronizer,
-		upkeepExecuter,
-	}, nil
+	return services, nil
 }
@@ -0,0 +1,148 @@
+package keeper
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/smartcontractkit/chainlink/core/services/eth"
+	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
+	"github.com/smartcontractkit/chainlink/core/store/models"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+const (
+	// EligibilityStrategyPositioning rotates the keepers of an upkeep in a
+	// fixed order, offset by the upkeep's positioning constant
+	EligibilityStrategyPositioning = "positioning"
+	// EligibilityStrategyBlockHash picks the keeper of an upkeep for each turn
+	// from the hash of the block the turn starts at
+	EligibilityStrategyBlockHash = "blockhash"
+)
+
+var (
+	promKeeperTurnsWon = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "keeper_turns_won",
+		Help: "The number of upkeeps performed by this node",
+	},
+		[]string{"job_id", "registry_address"},
+	)
+	promKeeperTurnsMissed = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "keeper_turns_missed",
+		Help: "The number of upkeeps this node tried to perform, but which were performed by another keeper first",
+	},
+		[]string{"job_id", "registry_address"},
+	)
+	promKeeperPerformLatency = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "keeper_perform_latency_blocks",
+		Help:    "The number of blocks from the head at which this node queued an upkeep's perform to the block it was performed in",
+		Buckets: prometheus.ExponentialBuckets(1, 2, 10),
+	},
+		[]string{"job_id", "registry_address"},
+	)
+)
+
+// EligibilityStrategy decides which upkeeps it is this node's turn to perform
+type EligibilityStrategy interface {
+	Name() string
+	EligibleUpkeeps(ctx context.Context, orm ORM, registryAddress ethkey.EIP55Address, head models.Head, gracePeriod int64) ([]UpkeepRegistration, error)
+}
+
+// NewEligibilityStrategy returns the eligibility strategy with the given name
+func NewEligibilityStrategy(name string, ethClient eth.Client) (EligibilityStrategy, error) {
+	switch name {
+	case EligibilityStrategyPositioning:
+		return PositioningStrategy{}, nil
+	case EligibilityStrategyBlockHash:
+		return NewBlockHashStrategy(ethClient), nil
+	default:
+		return nil, errors.Errorf("unknown eligibility strategy %q, must be one of %s or %s", name, EligibilityStrategyPositioning, EligibilityStrategyBlockHash)
+	}
+}
+
+// ValidateEligibilityStrategy returns an error if there is no eligibility
+// strategy with the given name
+func ValidateEligibilityStrategy(name string) error {
+	_, err := NewEligibilityStrategy(name, nil)
+	return err
+}
+
+// PositioningStrategy makes it the turn of keeper
+// (positioningConstant + turn) % numKeepers
+type PositioningStrategy struct{}
+
+var _ EligibilityStrategy = PositioningStrategy{}
+
+func (PositioningStrategy) Name() string { return EligibilityStrategyPositioning }
+
+func (PositioningStrategy) EligibleUpkeeps(ctx context.Context, orm ORM, registryAddress ethkey.EIP55Address, head models.Head, gracePeriod int64) ([]UpkeepRegistration, error) {
+	return orm.EligibleUpkeepsForRegistry(ctx, registryAddress, head.Number, gracePeriod)
+}
+
+// BlockHashStrategy makes it the turn of keeper
+// keccak256(abi.encode(turnBlockHash, upkeepID)) % numKeepers, where turnBlockHash is the
+// hash of the block the turn starts at. Unlike the positioning strategy, the
+// order in which keepers take turns cannot be predicted in advance.
+type BlockHashStrategy struct {
+	ethClient eth.Client
+}
+
+var _ EligibilityStrategy = BlockHashStrategy{}
+
+func NewBlockHashStrategy(ethClient eth.Client) BlockHashStrategy {
+	return BlockHashStrategy{ethClient: ethClient}
+}
+
+func (BlockHashStrategy) Name() string { return EligibilityStrategyBlockHash }
+
+func (s BlockHashStrategy) EligibleUpkeeps(ctx context.Context, orm ORM, registryAddress ethkey.EIP55Address, head models.Head, gracePeriod int64) ([]UpkeepRegistration, error) {
+	upkeeps, err := orm.DueUpkeepsForRegistry(ctx, registryAddress, head.Number, gracePeriod)
+	if err != nil || len(upkeeps) == 0 {
+		return nil, err
+	}
+
+	// Every upkeep of a registry shares its turns
+	registry := upkeeps[0].Registry
+	turnStart := head.Number - (head.Number % int64(registry.BlockCountPerTurn))
+	turnHash, err := s.blockHash(ctx, head, turnStart)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get hash of turn block %v", turnStart)
+	}
+
+	var eligible []UpkeepRegistration
+	for _, upkeep := range upkeeps {
+		if BlockHashTurnKeeper(turnHash, upkeep.UpkeepID, registry.NumKeepers) == registry.KeeperIndex {
+			eligible = append(eligible, upkeep)
+		}
+	}
+	return eligible, nil
+}
+
+func (s BlockHashStrategy) blockHash(ctx context.Context, head models.Head, number int64) (common.Hash, error) {
+	if hash := head.HashAtHeight(number); hash != (common.Hash{}) {
+		return hash, nil
+	}
+	h, err := s.ethClient.HeadByNumber(ctx, big.NewInt(number))
+	if err != nil {
+		return common.Hash{}, err
+	}
+	if h == nil {
+		return common.Hash{}, errors.New("block not found")
+	}
+	return h.Hash, nil
+}
+
+// BlockHashTurnKeeper returns the index of the keeper whose turn it is to
+// perform the upkeep, in the turn starting at the block with the given hash
+func BlockHashTurnKeeper(turnHash common.Hash, upkeepID int64, numKeepers int32) int32 {
+	if numKeepers <= 0 {
+		return -1
+	}
+	seed := new(big.Int).SetBytes(crypto.Keccak256(turnHash.Bytes(), utils.EVMWordUint64(uint64(upkeepID))))
+	return int32(seed.Mod(seed, big.NewInt(int64(numKeepers))).Int64())
+}
@@ -0,0 +1,82 @@
+package keeper_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/internal/mocks"
+	"github.com/smartcontractkit/chainlink/core/services/keeper"
+	"github.com/smartcontractkit/chainlink/core/store/models"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+func TestBlockHashTurnKeeper(t *testing.T) {
+	t.Parallel()
+
+	hash := utils.NewHash()
+	for upkeepID := int64(0); upkeepID < 10; upkeepID++ {
+		keeperIndex := keeper.BlockHashTurnKeeper(hash, upkeepID, 3)
+		assert.GreaterOrEqual(t, keeperIndex, int32(0))
+		assert.Less(t, keeperIndex, int32(3))
+		assert.Equal(t, keeperIndex, keeper.BlockHashTurnKeeper(hash, upkeepID, 3))
+	}
+	assert.Equal(t, int32(-1), keeper.BlockHashTurnKeeper(hash, 1, 0))
+}
+
+func TestBlockHashStrategy_EligibleUpkeeps(t *testing.T) {
+	t.Parallel()
+	store, orm, cleanup := setupKeeperDB(t)
+	defer cleanup()
+	ethKeyStore := cltest.NewKeyStore(t, store.DB).Eth()
+	ctx := context.Background()
+
+	registry, _ := cltest.MustInsertKeeperRegistry(t, store, ethKeyStore)
+	registry.NumKeepers = 3
+	registry.KeeperIndex = 1
+	require.NoError(t, store.DB.Save(&registry).Error)
+	for i := 0; i < 10; i++ {
+		cltest.MustInsertUpkeepForRegistry(t, store, registry)
+	}
+
+	// The turn of head 45 starts at block 40
+	turnHead := models.NewHead(big.NewInt(40), utils.NewHash(), utils.NewHash(), 1000)
+	head := models.NewHead(big.NewInt(45), utils.NewHash(), utils.NewHash(), 1000)
+
+	var expected []int64
+	for upkeepID := int64(0); upkeepID < 10; upkeepID++ {
+		if keeper.BlockHashTurnKeeper(turnHead.Hash, upkeepID, 3) == 1 {
+			expected = append(expected, upkeepID)
+		}
+	}
+
+	t.Run("uses the turn block of the head's chain", func(t *testing.T) {
+		head := head
+		head.Parent = &turnHead
+		strategy := keeper.NewBlockHashStrategy(new(mocks.Client))
+
+		upkeeps, err := strategy.EligibleUpkeeps(ctx, orm, registry.ContractAddress, head, 0)
+		require.NoError(t, err)
+		var upkeepIDs []int64
+		for _, upkeep := range upkeeps {
+			upkeepIDs = append(upkeepIDs, upkeep.UpkeepID)
+		}
+		assert.Equal(t, expected, upkeepIDs)
+	})
+
+	t.Run("fetches the turn block if it is not in the head's chain", func(t *testing.T) {
+		ethClient := new(mocks.Client)
+		ethClient.On("HeadByNumber", ctx, big.NewInt(40)).Return(&turnHead, nil)
+		strategy := keeper.NewBlockHashStrategy(ethClient)
+
+		upkeeps, err := strategy.EligibleUpkeeps(ctx, orm, registry.ContractAddress, head, 0)
+		require.NoError(t, err)
+		assert.Len(t, upkeeps, len(expected))
+
+		ethClient.AssertExpectations(t)
+	})
+}
@@ -1,6 +1,13 @@
 package keeper
 
-import "github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
+import (
+	"time"
+
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
 
 type Registry struct {
 	ID                int32 `gorm:"primary_key"`
@@ -35,3 +42,47 @@ type UpkeepRegistration struct {
 	UpkeepID            int64
 	PositioningConstant int32
 }
+
+// UpkeepSimulation is the latest simulation of an upkeep's performUpkeep
+// before it was sent. Upkeeps whose simulations keep reverting are parked
+// until ParkedUntil.
+type UpkeepSimulation struct {
+	UpkeepRegistrationID int32 `gorm:"primary_key"`
+	UpkeepRegistration   UpkeepRegistration
+	BlockNumber          int64
+	PerformGasEstimate   null.Int
+	RevertReason         null.String
+	ConsecutiveReverts   int32
+	ParkedUntil          *time.Time
+	SimulatedAt          time.Time
+}
+
+// Reverted returns true if the simulated performUpkeep reverted
+func (s UpkeepSimulation) Reverted() bool {
+	return s.RevertReason.Valid
+}
+
+// Parked returns true if the upkeep is skipped at the given time
+func (s UpkeepSimulation) Parked(at time.Time) bool {
+	return s.ParkedUntil != nil && s.ParkedUntil.After(at)
+}
+
+// UpkeepStat is the spend and performance accounting of an upkeep. Only the
+// performUpkeep transactions sent by this node are accounted for.
+type UpkeepStat struct {
+	UpkeepRegistrationID     int32 `gorm:"primary_key"`
+	GasUsed                  uint64
+	GasSpent                 utils.Big
+	LinkEarned               utils.Big
+	PerformSuccessCount      int64
+	PerformFailureCount      int64
+	LastPerformedBlockNumber null.Int
+	UpdatedAt                time.Time
+}
+
+// UpkeepWithStat is an upkeep along with its spend and performance
+// accounting, which is zero if the upkeep was never performed by this node
+type UpkeepWithStat struct {
+	UpkeepRegistration
+	Stat UpkeepStat
+}
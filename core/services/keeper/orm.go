@@ -2,9 +2,14 @@ package keeper
 
 import (
 	"context"
+	"math/big"
+	"time"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/smartcontractkit/chainlink/core/services/bulletprooftxmanager"
 	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
+	"github.com/smartcontractkit/chainlink/core/services/postgres"
+	"github.com/smartcontractkit/chainlink/core/utils"
 
 	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
@@ -34,10 +39,10 @@ func (korm ORM) Registries(ctx context.Context) (registries []Registry, _ error)
 	return registries, err
 }
 
-func (korm ORM) RegistryForJob(ctx context.Context, jobID int32) (registry Registry, _ error) {
+func (korm ORM) RegistryForJob(ctx context.Context, jobID int32, contractAddress ethkey.EIP55Address) (registry Registry, _ error) {
 	err := korm.DB.
 		WithContext(ctx).
-		First(&registry, "job_id = ?", jobID).
+		First(&registry, "job_id = ? AND contract_address = ?", jobID, contractAddress).
 		Error
 	return registry, err
 }
@@ -46,7 +51,7 @@ func (korm ORM) UpsertRegistry(ctx context.Context, registry *Registry) error {
 	return korm.DB.
 		WithContext(ctx).
 		Clauses(clause.OnConflict{
-			Columns: []clause.Column{{Name: "job_id"}},
+			Columns: []clause.Column{{Name: "job_id"}, {Name: "contract_address"}},
 			DoUpdates: clause.AssignmentColumns(
 				[]string{"keeper_index", "check_gas", "block_count_per_turn", "num_keepers"},
 			),
@@ -66,13 +71,11 @@ func (korm ORM) UpsertUpkeep(ctx context.Context, registration *UpkeepRegistrati
 		Error
 }
 
-func (korm ORM) BatchDeleteUpkeepsForJob(ctx context.Context, jobID int32, upkeedIDs []int64) (int64, error) {
+func (korm ORM) BatchDeleteUpkeepsForRegistry(ctx context.Context, registryID int32, upkeedIDs []int64) (int64, error) {
 	exec := korm.DB.
 		WithContext(ctx).Exec(
-		`DELETE FROM upkeep_registrations WHERE registry_id = (
-			SELECT id from keeper_registries where job_id = ?
-		) AND upkeep_id IN (?)`,
-		jobID,
+		`DELETE FROM upkeep_registrations WHERE registry_id = ? AND upkeep_id IN (?)`,
+		registryID,
 		upkeedIDs,
 	)
 	return exec.RowsAffected, exec.Error
@@ -98,6 +101,11 @@ func (korm ORM) EligibleUpkeepsForRegistry(
 					upkeep_registrations.last_run_block_height < (? - (? % keeper_registries.block_count_per_turn))
 				)
 			) AND
+			NOT EXISTS (
+				SELECT 1 FROM upkeep_simulations
+				WHERE upkeep_simulations.upkeep_registration_id = upkeep_registrations.id AND
+				upkeep_simulations.parked_until > now()
+			) AND
 			keeper_registries.keeper_index = (
 				upkeep_registrations.positioning_constant + ((? - (? % keeper_registries.block_count_per_turn)) / keeper_registries.block_count_per_turn)
 			) % keeper_registries.num_keepers
@@ -108,6 +116,41 @@ func (korm ORM) EligibleUpkeepsForRegistry(
 	return upkeeps, err
 }
 
+// DueUpkeepsForRegistry returns the upkeeps of the registry which are
+// neither parked nor within their grace period, irrespective of whose turn it
+// is to perform them
+func (korm ORM) DueUpkeepsForRegistry(
+	ctx context.Context,
+	registryAddress ethkey.EIP55Address,
+	blockNumber int64,
+	gracePeriod int64,
+) (upkeeps []UpkeepRegistration, _ error) {
+	err := korm.DB.
+		WithContext(ctx).
+		Preload("Registry").
+		Order("upkeep_registrations.id ASC, upkeep_registrations.upkeep_id ASC").
+		Joins("INNER JOIN keeper_registries ON keeper_registries.id = upkeep_registrations.registry_id").
+		Where(`
+			keeper_registries.contract_address = ? AND
+			keeper_registries.num_keepers > 0 AND
+			(
+				upkeep_registrations.last_run_block_height = 0 OR (
+					upkeep_registrations.last_run_block_height + ? < ? AND
+					upkeep_registrations.last_run_block_height < (? - (? % keeper_registries.block_count_per_turn))
+				)
+			) AND
+			NOT EXISTS (
+				SELECT 1 FROM upkeep_simulations
+				WHERE upkeep_simulations.upkeep_registration_id = upkeep_registrations.id AND
+				upkeep_simulations.parked_until > now()
+			)
+		`, registryAddress, gracePeriod, blockNumber, blockNumber, blockNumber).
+		Find(&upkeeps).
+		Error
+
+	return upkeeps, err
+}
+
 // LowestUnsyncedID returns the largest upkeepID + 1, indicating the expected next upkeepID
 // to sync from the contract
 func (korm ORM) LowestUnsyncedID(ctx context.Context, reg Registry) (nextID int64, err error) {
@@ -121,23 +164,180 @@ func (korm ORM) LowestUnsyncedID(ctx context.Context, reg Registry) (nextID int6
 	return nextID, err
 }
 
-func (korm ORM) SetLastRunHeightForUpkeepOnJob(db *gorm.DB, jobID int32, upkeepID int64, height int64) error {
+// LastRunHeightForUpkeep returns the block height the upkeep was last
+// performed at, or 0 if no perform is in flight
+func (korm ORM) LastRunHeightForUpkeep(ctx context.Context, registryID int32, upkeepID int64) (height int64, err error) {
+	err = korm.DB.
+		WithContext(ctx).
+		Raw(`SELECT last_run_block_height FROM upkeep_registrations
+		WHERE upkeep_id = ? AND registry_id = ?;`,
+			upkeepID,
+			registryID,
+		).
+		Row().
+		Scan(&height)
+	return height, err
+}
+
+func (korm ORM) SetLastRunHeightForUpkeep(db *gorm.DB, registryID int32, upkeepID int64, height int64) error {
 	return db.
 		Exec(`UPDATE upkeep_registrations
 		SET last_run_block_height = ?
-		WHERE upkeep_id = ? AND
-		registry_id = (
-			SELECT id FROM keeper_registries WHERE job_id = ?
-		);`,
+		WHERE upkeep_id = ? AND registry_id = ?;`,
 			height,
 			upkeepID,
-			jobID,
+			registryID,
+		).Error
+}
+
+// RecordUpkeepSimulation saves the latest performUpkeep simulation of an
+// upkeep. Reverted simulations increment the upkeep's consecutive reverts and,
+// once they reach maxReverts, park the upkeep for parkFor. A successful
+// simulation resets both.
+func (korm ORM) RecordUpkeepSimulation(ctx context.Context, sim *UpkeepSimulation, maxReverts uint32, parkFor time.Duration) error {
+	return postgres.GormTransaction(ctx, korm.DB, func(tx *gorm.DB) error {
+		err := tx.Raw(`
+			INSERT INTO upkeep_simulations AS s (upkeep_registration_id, block_number, perform_gas_estimate, revert_reason, consecutive_reverts, simulated_at)
+			VALUES (?, ?, ?, ?, CASE WHEN ?::text IS NULL THEN 0 ELSE 1 END, now())
+			ON CONFLICT (upkeep_registration_id) DO UPDATE SET
+				block_number = EXCLUDED.block_number,
+				perform_gas_estimate = EXCLUDED.perform_gas_estimate,
+				revert_reason = EXCLUDED.revert_reason,
+				consecutive_reverts = CASE WHEN EXCLUDED.revert_reason IS NULL THEN 0 ELSE s.consecutive_reverts + 1 END,
+				parked_until = CASE WHEN EXCLUDED.revert_reason IS NULL THEN NULL ELSE s.parked_until END,
+				simulated_at = EXCLUDED.simulated_at
+			RETURNING consecutive_reverts, parked_until, simulated_at
+		`, sim.UpkeepRegistrationID, sim.BlockNumber, sim.PerformGasEstimate, sim.RevertReason, sim.RevertReason).
+			Row().
+			Scan(&sim.ConsecutiveReverts, &sim.ParkedUntil, &sim.SimulatedAt)
+		if err != nil {
+			return err
+		}
+		if !sim.Reverted() || maxReverts == 0 || uint32(sim.ConsecutiveReverts) < maxReverts {
+			return nil
+		}
+		parkedUntil := time.Now().Add(parkFor)
+		sim.ParkedUntil = &parkedUntil
+		return tx.Exec(
+			`UPDATE upkeep_simulations SET parked_until = ? WHERE upkeep_registration_id = ?`,
+			parkedUntil, sim.UpkeepRegistrationID,
 		).Error
+	})
+}
+
+// RecordUpkeepPerformed adds a single performUpkeep sent by this node, whose
+// amounts and counts are given by perform, to the upkeep's accounting
+func (korm ORM) RecordUpkeepPerformed(ctx context.Context, registryID int32, upkeepID int64, perform UpkeepStat) error {
+	return korm.DB.WithContext(ctx).Exec(`
+		INSERT INTO upkeep_stats AS s (upkeep_registration_id, gas_used, gas_spent, link_earned, perform_success_count, perform_failure_count, last_performed_block_number, updated_at)
+		SELECT id, ?, ?, ?, ?, ?, ?, now() FROM upkeep_registrations WHERE registry_id = ? AND upkeep_id = ?
+		ON CONFLICT (upkeep_registration_id) DO UPDATE SET
+			gas_used = s.gas_used + EXCLUDED.gas_used,
+			gas_spent = s.gas_spent + EXCLUDED.gas_spent,
+			link_earned = s.link_earned + EXCLUDED.link_earned,
+			perform_success_count = s.perform_success_count + EXCLUDED.perform_success_count,
+			perform_failure_count = s.perform_failure_count + EXCLUDED.perform_failure_count,
+			last_performed_block_number = GREATEST(s.last_performed_block_number, EXCLUDED.last_performed_block_number),
+			updated_at = EXCLUDED.updated_at
+	`,
+		perform.GasUsed, perform.GasSpent, perform.LinkEarned,
+		perform.PerformSuccessCount, perform.PerformFailureCount, perform.LastPerformedBlockNumber,
+		registryID, upkeepID,
+	).Error
+}
+
+// GasPriceForTxAttempt returns the gas price of the transaction attempt with
+// the given hash
+func (korm ORM) GasPriceForTxAttempt(ctx context.Context, hash common.Hash) (*big.Int, error) {
+	var gasPrice utils.Big
+	err := korm.DB.WithContext(ctx).
+		Raw(`SELECT gas_price FROM eth_tx_attempts WHERE hash = ?`, hash).
+		Row().
+		Scan(&gasPrice)
+	return gasPrice.ToInt(), err
 }
 
 func (korm ORM) CreateEthTransactionForUpkeep(tx *gorm.DB, upkeep UpkeepRegistration, payload []byte) (bulletprooftxmanager.EthTx, error) {
 	from := upkeep.Registry.FromAddress.Address()
 	to := upkeep.Registry.ContractAddress.Address()
 	gasLimit := upkeep.ExecuteGas + korm.config.KeeperRegistryPerformGasOverhead()
-	return korm.txm.CreateEthTransaction(tx, from, to, payload, gasLimit, nil, korm.strategy)
+	return korm.txm.CreateEthTransaction(tx, from, to, payload, gasLimit, nil, korm.strategy, bulletprooftxmanager.EthTxUrgencyNormal, bulletprooftxmanager.EthTxExpiry{}, true)
+}
+
+// FindUpkeepSimulations returns the latest simulation of every simulated
+// upkeep, optionally only of the upkeeps which are currently parked
+func FindUpkeepSimulations(ctx context.Context, db *gorm.DB, parkedOnly bool) (sims []UpkeepSimulation, err error) {
+	q := db.WithContext(ctx).
+		Preload("UpkeepRegistration.Registry").
+		Order("upkeep_registration_id ASC")
+	if parkedOnly {
+		q = q.Where("parked_until > now()")
+	}
+	err = q.Find(&sims).Error
+	return sims, err
+}
+
+// RegistryWithUpkeepCount is a registry along with the number of its upkeeps
+// which are synced
+type RegistryWithUpkeepCount struct {
+	Registry    `gorm:"embedded"`
+	UpkeepCount int64
+}
+
+// FindRegistriesWithUpkeepCounts returns every registry of a keeper job, or of
+// all keeper jobs if jobID is nil, along with its number of upkeeps
+func FindRegistriesWithUpkeepCounts(ctx context.Context, db *gorm.DB, jobID *int32) (registries []RegistryWithUpkeepCount, err error) {
+	q := db.WithContext(ctx).
+		Model(&Registry{}).
+		Select(`keeper_registries.*, (
+			SELECT count(*) FROM upkeep_registrations WHERE upkeep_registrations.registry_id = keeper_registries.id
+		) AS upkeep_count`).
+		Order("keeper_registries.id ASC")
+	if jobID != nil {
+		q = q.Where("keeper_registries.job_id = ?", *jobID)
+	}
+	err = q.Scan(&registries).Error
+	return registries, err
+}
+
+// FindUpkeepsWithStats returns every upkeep of a keeper job, or of all keeper
+// jobs if jobID is nil, along with its spend and performance accounting
+func FindUpkeepsWithStats(ctx context.Context, db *gorm.DB, jobID *int32) ([]UpkeepWithStat, error) {
+	var upkeeps []UpkeepRegistration
+	q := db.WithContext(ctx).
+		Preload("Registry").
+		Joins("INNER JOIN keeper_registries ON keeper_registries.id = upkeep_registrations.registry_id").
+		Order("upkeep_registrations.id ASC")
+	if jobID != nil {
+		q = q.Where("keeper_registries.job_id = ?", *jobID)
+	}
+	if err := q.Find(&upkeeps).Error; err != nil {
+		return nil, err
+	}
+	if len(upkeeps) == 0 {
+		return nil, nil
+	}
+
+	ids := make([]int32, len(upkeeps))
+	for i, upkeep := range upkeeps {
+		ids[i] = upkeep.ID
+	}
+	var stats []UpkeepStat
+	if err := db.WithContext(ctx).Where("upkeep_registration_id IN (?)", ids).Find(&stats).Error; err != nil {
+		return nil, err
+	}
+	statsByID := make(map[int32]UpkeepStat, len(stats))
+	for _, stat := range stats {
+		statsByID[stat.UpkeepRegistrationID] = stat
+	}
+
+	withStats := make([]UpkeepWithStat, len(upkeeps))
+	for i, upkeep := range upkeeps {
+		stat, exists := statsByID[upkeep.ID]
+		if !exists {
+			stat = UpkeepStat{UpkeepRegistrationID: upkeep.ID}
+		}
+		withStats[i] = UpkeepWithStat{UpkeepRegistration: upkeep, Stat: stat}
+	}
+	return withStats, nil
 }
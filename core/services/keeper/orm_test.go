@@ -3,6 +3,7 @@ package keeper_test
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/smartcontractkit/chainlink/core/services/bulletprooftxmanager"
 	bptxmmocks "github.com/smartcontractkit/chainlink/core/services/bulletprooftxmanager/mocks"
@@ -13,8 +14,10 @@ import (
 	"github.com/smartcontractkit/chainlink/core/internal/cltest"
 	"github.com/smartcontractkit/chainlink/core/services/keeper"
 	"github.com/smartcontractkit/chainlink/core/store"
+	"github.com/smartcontractkit/chainlink/core/utils"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
 )
 
 var checkData = common.Hex2Bytes("ABC123")
@@ -56,6 +59,32 @@ func TestKeeperDB_Registries(t *testing.T) {
 	require.Equal(t, 2, len(existingRegistries))
 }
 
+func TestKeeperDB_FindRegistriesWithUpkeepCounts(t *testing.T) {
+	t.Parallel()
+	store, _, cleanup := setupKeeperDB(t)
+	defer cleanup()
+	ethKeyStore := cltest.NewKeyStore(t, store.DB).Eth()
+
+	registry, j := cltest.MustInsertKeeperRegistry(t, store, ethKeyStore)
+	other, _ := cltest.MustInsertKeeperRegistry(t, store, ethKeyStore)
+	for i := 0; i < 2; i++ {
+		cltest.MustInsertUpkeepForRegistry(t, store, registry)
+	}
+
+	registries, err := keeper.FindRegistriesWithUpkeepCounts(context.Background(), store.DB, nil)
+	require.NoError(t, err)
+	require.Len(t, registries, 2)
+	require.Equal(t, registry.ID, registries[0].ID)
+	require.Equal(t, int64(2), registries[0].UpkeepCount)
+	require.Equal(t, other.ID, registries[1].ID)
+	require.Equal(t, int64(0), registries[1].UpkeepCount)
+
+	registries, err = keeper.FindRegistriesWithUpkeepCounts(context.Background(), store.DB, &j.ID)
+	require.NoError(t, err)
+	require.Len(t, registries, 1)
+	require.Equal(t, registry.ContractAddress, registries[0].ContractAddress)
+}
+
 func TestKeeperDB_UpsertUpkeep(t *testing.T) {
 	t.Parallel()
 	store, orm, cleanup := setupKeeperDB(t)
@@ -96,14 +125,14 @@ func TestKeeperDB_UpsertUpkeep(t *testing.T) {
 	require.Equal(t, int64(1), upkeepFromDB.LastRunBlockHeight) // shouldn't change on upsert
 }
 
-func TestKeeperDB_BatchDeleteUpkeepsForJob(t *testing.T) {
+func TestKeeperDB_BatchDeleteUpkeepsForRegistry(t *testing.T) {
 	t.Parallel()
 	store, orm, cleanup := setupKeeperDB(t)
 	defer cleanup()
 	db := store.DB
 	ethKeyStore := cltest.NewKeyStore(t, store.DB).Eth()
 
-	registry, job := cltest.MustInsertKeeperRegistry(t, store, ethKeyStore)
+	registry, _ := cltest.MustInsertKeeperRegistry(t, store, ethKeyStore)
 
 	for i := int64(0); i < 3; i++ {
 		cltest.MustInsertUpkeepForRegistry(t, store, registry)
@@ -111,7 +140,7 @@ func TestKeeperDB_BatchDeleteUpkeepsForJob(t *testing.T) {
 
 	cltest.AssertCount(t, db, &keeper.UpkeepRegistration{}, 3)
 
-	_, err := orm.BatchDeleteUpkeepsForJob(context.Background(), job.ID, []int64{0, 2})
+	_, err := orm.BatchDeleteUpkeepsForRegistry(context.Background(), registry.ID, []int64{0, 2})
 	require.NoError(t, err)
 	cltest.AssertCount(t, db, &keeper.UpkeepRegistration{}, 1)
 
@@ -327,18 +356,18 @@ func TestKeeperDB_NextUpkeepID(t *testing.T) {
 	require.Equal(t, int64(4), nextID)
 }
 
-func TestKeeperDB_SetLastRunHeightForUpkeepOnJob(t *testing.T) {
+func TestKeeperDB_SetLastRunHeightForUpkeep(t *testing.T) {
 	t.Parallel()
 	store, orm, cleanup := setupKeeperDB(t)
 	defer cleanup()
 	ethKeyStore := cltest.NewKeyStore(t, store.DB).Eth()
 
-	registry, j := cltest.MustInsertKeeperRegistry(t, store, ethKeyStore)
+	registry, _ := cltest.MustInsertKeeperRegistry(t, store, ethKeyStore)
 	upkeep := cltest.MustInsertUpkeepForRegistry(t, store, registry)
 
-	orm.SetLastRunHeightForUpkeepOnJob(orm.DB, j.ID, upkeep.UpkeepID, 100)
+	orm.SetLastRunHeightForUpkeep(orm.DB, registry.ID, upkeep.UpkeepID, 100)
 	assertLastRunHeight(t, store, upkeep, 100)
-	orm.SetLastRunHeightForUpkeepOnJob(orm.DB, j.ID, upkeep.UpkeepID, 0)
+	orm.SetLastRunHeightForUpkeep(orm.DB, registry.ID, upkeep.UpkeepID, 0)
 	assertLastRunHeight(t, store, upkeep, 0)
 }
 
@@ -365,7 +394,7 @@ func TestKeeperDB_CreateEthTransactionForUpkeep(t *testing.T) {
 	defer cancel()
 	gasLimit := upkeep.ExecuteGas + store.Config.KeeperRegistryPerformGasOverhead()
 	err = postgres.GormTransaction(ctx, orm.DB, func(tx *gorm.DB) error {
-		txm.On("CreateEthTransaction", tx, fromAddress, toAddress, payload, gasLimit, nil, bulletprooftxmanager.SendEveryStrategy{}).Once().Return(bulletprooftxmanager.EthTx{
+		txm.On("CreateEthTransaction", tx, fromAddress, toAddress, payload, gasLimit, nil, bulletprooftxmanager.SendEveryStrategy{}, bulletprooftxmanager.EthTxUrgencyNormal, bulletprooftxmanager.EthTxExpiry{}, true).Once().Return(bulletprooftxmanager.EthTx{
 			FromAddress:    fromAddress,
 			ToAddress:      toAddress,
 			EncodedPayload: payload,
@@ -383,3 +412,108 @@ func TestKeeperDB_CreateEthTransactionForUpkeep(t *testing.T) {
 
 	txm.AssertExpectations(t)
 }
+
+func TestKeeperDB_RecordUpkeepSimulation(t *testing.T) {
+	t.Parallel()
+	store, orm, cleanup := setupKeeperDB(t)
+	defer cleanup()
+	ethKeyStore := cltest.NewKeyStore(t, store.DB).Eth()
+	ctx := context.Background()
+
+	registry, _ := cltest.MustInsertKeeperRegistry(t, store, ethKeyStore)
+	upkeep := cltest.MustInsertUpkeepForRegistry(t, store, registry)
+
+	revert := func(blockNumber int64) keeper.UpkeepSimulation {
+		sim := keeper.UpkeepSimulation{
+			UpkeepRegistrationID: upkeep.ID,
+			BlockNumber:          blockNumber,
+			RevertReason:         null.StringFrom("upkeep not needed"),
+		}
+		require.NoError(t, orm.RecordUpkeepSimulation(ctx, &sim, 2, time.Hour))
+		return sim
+	}
+
+	sim := revert(20)
+	assert.Equal(t, int32(1), sim.ConsecutiveReverts)
+	assert.Nil(t, sim.ParkedUntil)
+	eligibleUpkeeps, err := orm.EligibleUpkeepsForRegistry(ctx, registry.ContractAddress, 21, 0)
+	require.NoError(t, err)
+	assert.Len(t, eligibleUpkeeps, 1)
+
+	sim = revert(21)
+	assert.Equal(t, int32(2), sim.ConsecutiveReverts)
+	require.NotNil(t, sim.ParkedUntil)
+	eligibleUpkeeps, err = orm.EligibleUpkeepsForRegistry(ctx, registry.ContractAddress, 22, 0)
+	require.NoError(t, err)
+	assert.Len(t, eligibleUpkeeps, 0)
+
+	sims, err := keeper.FindUpkeepSimulations(ctx, store.DB, true)
+	require.NoError(t, err)
+	require.Len(t, sims, 1)
+	assert.Equal(t, upkeep.UpkeepID, sims[0].UpkeepRegistration.UpkeepID)
+	assert.Equal(t, registry.ContractAddress, sims[0].UpkeepRegistration.Registry.ContractAddress)
+	assert.Equal(t, "upkeep not needed", sims[0].RevertReason.String)
+
+	sim = keeper.UpkeepSimulation{
+		UpkeepRegistrationID: upkeep.ID,
+		BlockNumber:          22,
+		PerformGasEstimate:   null.IntFrom(123_456),
+	}
+	require.NoError(t, orm.RecordUpkeepSimulation(ctx, &sim, 2, time.Hour))
+	assert.Equal(t, int32(0), sim.ConsecutiveReverts)
+	assert.Nil(t, sim.ParkedUntil)
+
+	sims, err = keeper.FindUpkeepSimulations(ctx, store.DB, false)
+	require.NoError(t, err)
+	require.Len(t, sims, 1)
+	assert.Equal(t, int64(123_456), sims[0].PerformGasEstimate.Int64)
+	assert.False(t, sims[0].RevertReason.Valid)
+	assert.False(t, sims[0].Parked(time.Now()))
+}
+
+func TestKeeperDB_RecordUpkeepPerformed(t *testing.T) {
+	t.Parallel()
+	store, orm, cleanup := setupKeeperDB(t)
+	defer cleanup()
+	ethKeyStore := cltest.NewKeyStore(t, store.DB).Eth()
+	ctx := context.Background()
+
+	registry, j := cltest.MustInsertKeeperRegistry(t, store, ethKeyStore)
+	upkeep := cltest.MustInsertUpkeepForRegistry(t, store, registry)
+	idle := cltest.MustInsertUpkeepForRegistry(t, store, registry)
+
+	err := orm.RecordUpkeepPerformed(ctx, registry.ID, upkeep.UpkeepID, keeper.UpkeepStat{
+		GasUsed:                  100,
+		GasSpent:                 *utils.NewBigI(1000),
+		LinkEarned:               *utils.NewBigI(5),
+		PerformSuccessCount:      1,
+		LastPerformedBlockNumber: null.IntFrom(20),
+	})
+	require.NoError(t, err)
+	err = orm.RecordUpkeepPerformed(ctx, registry.ID, upkeep.UpkeepID, keeper.UpkeepStat{
+		GasUsed:                  50,
+		GasSpent:                 *utils.NewBigI(500),
+		LinkEarned:               *utils.NewBigI(1),
+		PerformFailureCount:      1,
+		LastPerformedBlockNumber: null.IntFrom(10),
+	})
+	require.NoError(t, err)
+
+	upkeeps, err := keeper.FindUpkeepsWithStats(ctx, store.DB, &j.ID)
+	require.NoError(t, err)
+	require.Len(t, upkeeps, 2)
+
+	require.Equal(t, upkeep.ID, upkeeps[0].ID)
+	require.Equal(t, registry.ContractAddress, upkeeps[0].Registry.ContractAddress)
+	stat := upkeeps[0].Stat
+	require.Equal(t, uint64(150), stat.GasUsed)
+	require.Equal(t, "1500", stat.GasSpent.String())
+	require.Equal(t, "6", stat.LinkEarned.String())
+	require.Equal(t, int64(1), stat.PerformSuccessCount)
+	require.Equal(t, int64(1), stat.PerformFailureCount)
+	require.Equal(t, int64(20), stat.LastPerformedBlockNumber.Int64)
+
+	require.Equal(t, idle.ID, upkeeps[1].ID)
+	require.Equal(t, int64(0), upkeeps[1].Stat.PerformSuccessCount)
+	require.False(t, upkeeps[1].Stat.LastPerformedBlockNumber.Valid)
+}
@@ -1,12 +1,14 @@
 package keeper
 
 import (
+	"context"
 	"sync"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 
 	"github.com/smartcontractkit/chainlink/core/internal/gethwrappers/generated/keeper_registry_wrapper"
+	"github.com/smartcontractkit/chainlink/core/services/eth"
 	"github.com/smartcontractkit/chainlink/core/services/job"
 	"github.com/smartcontractkit/chainlink/core/services/log"
 	"github.com/smartcontractkit/chainlink/core/utils"
@@ -20,13 +22,17 @@ type MailRoom struct {
 	mbUpkeepRegistered *utils.Mailbox
 }
 
+// NewRegistrySynchronizer returns a RegistrySynchronizer which syncs the
+// registry of the job configured by registrySpec, which must have a
+// SyncInterval
 func NewRegistrySynchronizer(
 	job job.Job,
+	registrySpec job.KeeperRegistrySpec,
 	contract *keeper_registry_wrapper.KeeperRegistry,
 	orm ORM,
 	jrm job.ORM,
+	ethClient eth.Client,
 	logBroadcaster log.Broadcaster,
-	syncInterval time.Duration,
 	minConfirmations uint64,
 ) *RegistrySynchronizer {
 	mailRoom := MailRoom{
@@ -38,8 +44,10 @@ func NewRegistrySynchronizer(
 	return &RegistrySynchronizer{
 		chStop:           make(chan struct{}),
 		contract:         contract,
-		interval:         syncInterval,
+		ethClient:        ethClient,
+		interval:         time.Duration(registrySpec.SyncInterval),
 		job:              job,
+		registrySpec:     registrySpec,
 		jrm:              jrm,
 		logBroadcaster:   logBroadcaster,
 		mailRoom:         mailRoom,
@@ -57,6 +65,7 @@ var _ log.Listener = (*RegistrySynchronizer)(nil)
 type RegistrySynchronizer struct {
 	chStop           chan struct{}
 	contract         *keeper_registry_wrapper.KeeperRegistry
+	ethClient        eth.Client
 	interval         time.Duration
 	job              job.Job
 	jrm              job.ORM
@@ -64,10 +73,16 @@ type RegistrySynchronizer struct {
 	mailRoom         MailRoom
 	minConfirmations uint64
 	orm              ORM
+	registrySpec     job.KeeperRegistrySpec
 	wgDone           sync.WaitGroup
 	utils.StartStopOnce
 }
 
+// registry returns the synced registry
+func (rs *RegistrySynchronizer) registry(ctx context.Context) (Registry, error) {
+	return rs.orm.RegistryForJob(ctx, rs.job.ID, rs.registrySpec.ContractAddress)
+}
+
 func (rs *RegistrySynchronizer) Start() error {
 	return rs.StartOnce("RegistrySynchronizer", func() error {
 		rs.wgDone.Add(2)
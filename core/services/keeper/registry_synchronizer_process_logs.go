@@ -1,14 +1,20 @@
 package keeper
 
 import (
+	"context"
+	"database/sql"
 	"fmt"
+	"math/big"
 	"sync"
 
 	"github.com/pkg/errors"
+	"gopkg.in/guregu/null.v4"
+
 	"github.com/smartcontractkit/chainlink/core/internal/gethwrappers/generated/keeper_registry_wrapper"
 	"github.com/smartcontractkit/chainlink/core/logger"
 	"github.com/smartcontractkit/chainlink/core/services/log"
 	"github.com/smartcontractkit/chainlink/core/services/postgres"
+	"github.com/smartcontractkit/chainlink/core/utils"
 )
 
 func (rs *RegistrySynchronizer) processLogs() {
@@ -87,7 +93,12 @@ func (rs *RegistrySynchronizer) handleUpkeepCancelled(broadcast log.Broadcast) {
 	}
 	ctx, cancel := postgres.DefaultQueryCtx()
 	defer cancel()
-	affected, err := rs.orm.BatchDeleteUpkeepsForJob(ctx, rs.job.ID, []int64{log.Id.Int64()})
+	registry, err := rs.registry(ctx)
+	if err != nil {
+		logger.Error(errors.Wrapf(err, "RegistrySynchronizer: unable to find registry for job, jobID: %d", rs.job.ID))
+		return
+	}
+	affected, err := rs.orm.BatchDeleteUpkeepsForRegistry(ctx, registry.ID, []int64{log.Id.Int64()})
 	if err != nil {
 		logger.Error(errors.Wrapf(err, "RegistrySynchronizer: unable to batch delete upkeeps, jobID: %d", rs.job.ID))
 		return
@@ -104,7 +115,7 @@ func (rs *RegistrySynchronizer) handleUpkeepRegisteredLogs(done func()) {
 	defer done()
 	ctx, cancel := postgres.DefaultQueryCtx()
 	defer cancel()
-	registry, err := rs.orm.RegistryForJob(ctx, rs.job.ID)
+	registry, err := rs.registry(ctx)
 	if err != nil {
 		logger.Error(errors.Wrapf(err, "RegistrySynchronizer: unable to find registry for job, jobID: %d", rs.job.ID))
 		return
@@ -184,9 +195,19 @@ func (rs *RegistrySynchronizer) handleUpkeepPerformed(broadcast log.Broadcast) {
 	}
 	ctx, cancel := postgres.DefaultQueryCtx()
 	defer cancel()
+	registry, err := rs.registry(ctx)
+	if err != nil {
+		logger.Error(errors.Wrapf(err, "RegistrySynchronizer: unable to find registry for job, jobID: %d", rs.job.ID))
+		return
+	}
+	rs.recordTurn(ctx, registry, log)
+	if log.From == registry.FromAddress.Address() {
+		rs.recordUpkeepPerformed(ctx, registry, log)
+	}
+
 	db := rs.orm.DB.WithContext(ctx)
 	// set last run to 0 so that keeper can resume checkUpkeep()
-	err = rs.orm.SetLastRunHeightForUpkeepOnJob(db, rs.job.ID, log.Id.Int64(), 0)
+	err = rs.orm.SetLastRunHeightForUpkeep(db, registry.ID, log.Id.Int64(), 0)
 	if err != nil {
 		logger.Error(err)
 		return
@@ -196,3 +217,64 @@ func (rs *RegistrySynchronizer) handleUpkeepPerformed(broadcast log.Broadcast) {
 	err = rs.logBroadcaster.MarkConsumed(rs.orm.DB.WithContext(ctx), broadcast)
 	logger.ErrorIf(errors.Wrapf(err, "RegistrySynchronizer: unable to mark KeeperRegistryUpkeepPerformed log as consumed, jobID: %d, log: %v", rs.job.ID, broadcast.String()))
 }
+
+// recordTurn counts the performed upkeep as a won turn if this node performed
+// it, or as a missed turn if this node was still trying to perform it. Won
+// turns also record the perform latency, the number of blocks from the head
+// at which this node queued the perform to the block it was performed in.
+func (rs *RegistrySynchronizer) recordTurn(ctx context.Context, registry Registry, log *keeper_registry_wrapper.KeeperRegistryUpkeepPerformed) {
+	jobID := fmt.Sprintf("%d", rs.job.ID)
+	registryAddress := registry.ContractAddress.Hex()
+	won := log.From == registry.FromAddress.Address()
+	if won {
+		promKeeperTurnsWon.WithLabelValues(jobID, registryAddress).Inc()
+	}
+	lastRunHeight, err := rs.orm.LastRunHeightForUpkeep(ctx, registry.ID, log.Id.Int64())
+	if errors.Is(err, sql.ErrNoRows) {
+		return
+	} else if err != nil {
+		logger.Error(errors.Wrapf(err, "RegistrySynchronizer: unable to get last run height of upkeep, jobID: %d", rs.job.ID))
+		return
+	}
+	if lastRunHeight <= 0 {
+		return
+	}
+	if !won {
+		promKeeperTurnsMissed.WithLabelValues(jobID, registryAddress).Inc()
+	} else if latency := int64(log.Raw.BlockNumber) - lastRunHeight; latency >= 0 {
+		promKeeperPerformLatency.WithLabelValues(jobID, registryAddress).Observe(float64(latency))
+	}
+}
+
+// recordUpkeepPerformed adds an upkeep performed by this node to the upkeep's
+// accounting. The gas spent is the gas used by the transaction at the gas price
+// of its attempt.
+func (rs *RegistrySynchronizer) recordUpkeepPerformed(ctx context.Context, registry Registry, log *keeper_registry_wrapper.KeeperRegistryUpkeepPerformed) {
+	perform := UpkeepStat{
+		GasSpent:                 *utils.NewBigI(0),
+		LinkEarned:               *utils.NewBig(log.Payment),
+		LastPerformedBlockNumber: null.IntFrom(int64(log.Raw.BlockNumber)),
+	}
+	if log.Success {
+		perform.PerformSuccessCount = 1
+	} else {
+		perform.PerformFailureCount = 1
+	}
+
+	txHash := log.Raw.TxHash
+	receipt, err := rs.ethClient.TransactionReceipt(ctx, txHash)
+	if err != nil {
+		logger.Warn(errors.Wrapf(err, "RegistrySynchronizer: unable to get receipt of performUpkeep, gas spent is not accounted for, jobID: %d, txHash: %s", rs.job.ID, txHash.Hex()))
+	} else {
+		perform.GasUsed = receipt.GasUsed
+		gasPrice, err := rs.orm.GasPriceForTxAttempt(ctx, txHash)
+		if err != nil {
+			logger.Warn(errors.Wrapf(err, "RegistrySynchronizer: unable to get gas price of performUpkeep, gas spent is not accounted for, jobID: %d, txHash: %s", rs.job.ID, txHash.Hex()))
+		} else {
+			perform.GasSpent = *utils.NewBig(new(big.Int).Mul(gasPrice, new(big.Int).SetUint64(receipt.GasUsed)))
+		}
+	}
+
+	err = rs.orm.RecordUpkeepPerformed(ctx, registry.ID, log.Id.Int64(), perform)
+	logger.ErrorIf(errors.Wrapf(err, "RegistrySynchronizer: unable to record performed upkeep, jobID: %d", rs.job.ID))
+}
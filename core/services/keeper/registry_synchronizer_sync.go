@@ -17,7 +17,7 @@ import (
 const syncUpkeepQueueSize = 10
 
 func (rs *RegistrySynchronizer) fullSync() {
-	contractAddress := rs.job.KeeperSpec.ContractAddress
+	contractAddress := rs.registrySpec.ContractAddress
 	logger.Debugf("fullSyncing registry %s", contractAddress.Hex())
 
 	var err error
@@ -101,6 +101,9 @@ func (rs *RegistrySynchronizer) syncUpkeepWithCallback(registry Registry, upkeep
 }
 
 func (rs *RegistrySynchronizer) syncUpkeep(registry Registry, upkeepID int64) error {
+	if !rs.registrySpec.HasUpkeep(upkeepID) {
+		return nil
+	}
 	upkeepConfig, err := rs.contract.GetUpkeep(nil, big.NewInt(upkeepID))
 	if err != nil {
 		return err
@@ -132,14 +135,14 @@ func (rs *RegistrySynchronizer) deleteCanceledUpkeeps(reg Registry) error {
 	}
 	ctx, cancel := postgres.DefaultQueryCtx()
 	defer cancel()
-	_, err = rs.orm.BatchDeleteUpkeepsForJob(ctx, rs.job.ID, canceled)
+	_, err = rs.orm.BatchDeleteUpkeepsForRegistry(ctx, reg.ID, canceled)
 	return err
 }
 
 // newRegistryFromChain returns a Registry stuct with fields synched from those on chain
 func (rs *RegistrySynchronizer) newRegistryFromChain() (Registry, error) {
-	fromAddress := rs.job.KeeperSpec.FromAddress
-	contractAddress := rs.job.KeeperSpec.ContractAddress
+	fromAddress := rs.registrySpec.FromAddress
+	contractAddress := rs.registrySpec.ContractAddress
 	config, err := rs.contract.GetConfig(nil)
 	if err != nil {
 		ctx, cancel := postgres.DefaultQueryCtx()
@@ -17,6 +17,8 @@ import (
 	"github.com/smartcontractkit/chainlink/core/services/log"
 	logmocks "github.com/smartcontractkit/chainlink/core/services/log/mocks"
 	"github.com/smartcontractkit/chainlink/core/store"
+	"github.com/smartcontractkit/chainlink/core/store/models"
+	"github.com/smartcontractkit/chainlink/core/utils"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 )
@@ -70,7 +72,9 @@ func setupRegistrySync(t *testing.T) (
 	lbMock.On("IsConnected").Return(true).Maybe()
 
 	orm := keeper.NewORM(store.DB, nil, store.Config, bulletprooftxmanager.SendEveryStrategy{})
-	synchronizer := keeper.NewRegistrySynchronizer(j, contract, orm, jpv2.Jrm, lbMock, syncInterval, 1)
+	registrySpec := j.KeeperSpec.RegistrySpecs()[0]
+	registrySpec.SyncInterval = models.Interval(syncInterval)
+	synchronizer := keeper.NewRegistrySynchronizer(j, registrySpec, contract, orm, jpv2.Jrm, ethMock, lbMock, 1)
 	return store, synchronizer, ethMock, lbMock, j
 }
 
@@ -377,3 +381,57 @@ func Test_RegistrySynchronizer_UpkeepPerformedLog(t *testing.T) {
 	ethMock.AssertExpectations(t)
 	logBroadcast.AssertExpectations(t)
 }
+
+func Test_RegistrySynchronizer_UpkeepPerformedLog_RecordsStats(t *testing.T) {
+	store, synchronizer, ethMock, lb, job := setupRegistrySync(t)
+
+	contractAddress := job.KeeperSpec.ContractAddress.Address()
+	fromAddress := job.KeeperSpec.FromAddress.Address()
+
+	registryMock := cltest.NewContractMockReceiver(t, ethMock, keeper.RegistryABI, contractAddress)
+	registryMock.MockResponse("getConfig", registryConfig).Once()
+	registryMock.MockResponse("getKeeperList", []common.Address{fromAddress}).Once()
+	registryMock.MockResponse("getCanceledUpkeepList", []*big.Int{}).Once()
+	registryMock.MockResponse("getUpkeepCount", big.NewInt(1)).Once()
+	registryMock.MockResponse("getUpkeep", upkeepConfig).Once()
+
+	require.NoError(t, synchronizer.Start())
+	defer synchronizer.Close()
+	cltest.WaitForCount(t, store, keeper.Registry{}, 1)
+	cltest.WaitForCount(t, store, keeper.UpkeepRegistration{}, 1)
+
+	txHash := utils.NewHash()
+	rawLog := types.Log{BlockNumber: 42, TxHash: txHash}
+	log := keeper_registry_wrapper.KeeperRegistryUpkeepPerformed{
+		Id:      big.NewInt(0),
+		Success: true,
+		From:    fromAddress,
+		Payment: big.NewInt(1000),
+	}
+	logBroadcast := new(logmocks.Broadcast)
+	logBroadcast.On("DecodedLog").Return(&log)
+	logBroadcast.On("RawLog").Return(rawLog)
+	logBroadcast.On("String").Maybe().Return("")
+	lb.On("MarkConsumed", mock.Anything, mock.Anything).Return(nil)
+	lb.On("WasAlreadyConsumed", mock.Anything, mock.Anything).Return(false, nil)
+	ethMock.On("TransactionReceipt", mock.Anything, txHash).Return(&types.Receipt{GasUsed: 21000}, nil).Once()
+
+	// Do the thing
+	synchronizer.HandleLog(logBroadcast)
+	synchronizer.ExportedProcessLogs()
+
+	cltest.WaitForCount(t, store, keeper.UpkeepStat{}, 1)
+	var stat keeper.UpkeepStat
+	require.NoError(t, store.DB.First(&stat).Error)
+	require.Equal(t, uint64(21000), stat.GasUsed)
+	// the transaction was not sent by this node's transaction manager, so the
+	// gas price and therefore the gas spent is unknown
+	require.Equal(t, "0", stat.GasSpent.String())
+	require.Equal(t, "1000", stat.LinkEarned.String())
+	require.Equal(t, int64(1), stat.PerformSuccessCount)
+	require.Equal(t, int64(0), stat.PerformFailureCount)
+	require.Equal(t, int64(42), stat.LastPerformedBlockNumber.Int64)
+
+	ethMock.AssertExpectations(t)
+	logBroadcast.AssertExpectations(t)
+}
@@ -20,6 +20,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/logger"
 	"github.com/smartcontractkit/chainlink/core/services/eth"
 	"github.com/smartcontractkit/chainlink/core/services/job"
+	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
 	"github.com/smartcontractkit/chainlink/core/services/postgres"
 	"github.com/smartcontractkit/chainlink/core/store/models"
 	"github.com/smartcontractkit/chainlink/core/utils"
@@ -32,12 +33,16 @@ const (
 	queuedEthTransaction = "successfully queued performUpkeep eth transaction"
 )
 
+// pendingBlock makes eth_calls run against the pending block
+var pendingBlock = big.NewInt(-1)
+
 // UpkeepExecuter fulfills Service and HeadBroadcastable interfaces
 var _ job.Service = (*UpkeepExecuter)(nil)
 var _ httypes.HeadTrackable = (*UpkeepExecuter)(nil)
 
 type UpkeepExecuter struct {
 	chStop          chan struct{}
+	contractAddress ethkey.EIP55Address
 	ethClient       eth.Client
 	config          Config
 	executionQueue  chan struct{}
@@ -46,20 +51,26 @@ type UpkeepExecuter struct {
 	mailbox         *utils.Mailbox
 	orm             ORM
 	pr              pipeline.Runner
+	strategy        EligibilityStrategy
 	wgDone          sync.WaitGroup
 	utils.StartStopOnce
 }
 
+// NewUpkeepExecuter returns an UpkeepExecuter which performs the upkeeps of
+// the job's registry at contractAddress
 func NewUpkeepExecuter(
 	job job.Job,
+	contractAddress ethkey.EIP55Address,
 	orm ORM,
 	pr pipeline.Runner,
 	ethClient eth.Client,
 	headBroadcaster httypes.HeadBroadcaster,
+	strategy EligibilityStrategy,
 	config Config,
 ) *UpkeepExecuter {
 	return &UpkeepExecuter{
 		chStop:          make(chan struct{}),
+		contractAddress: contractAddress,
 		ethClient:       ethClient,
 		executionQueue:  make(chan struct{}, executionQueueSize),
 		headBroadcaster: headBroadcaster,
@@ -68,6 +79,7 @@ func NewUpkeepExecuter(
 		config:          config,
 		orm:             orm,
 		pr:              pr,
+		strategy:        strategy,
 		wgDone:          sync.WaitGroup{},
 		StartStopOnce:   utils.StartStopOnce{},
 	}
@@ -131,15 +143,16 @@ func (executer *UpkeepExecuter) processActiveUpkeeps() {
 		return
 	}
 
-	logger.Debugw("UpkeepExecuter: checking active upkeeps", "blockheight", head.Number, "jobID", executer.job.ID)
+	logger.Debugw("UpkeepExecuter: checking active upkeeps", "blockheight", head.Number, "jobID", executer.job.ID, "strategy", executer.strategy.Name())
 
 	ctx, cancel := postgres.DefaultQueryCtx()
 	defer cancel()
 
-	activeUpkeeps, err := executer.orm.EligibleUpkeepsForRegistry(
+	activeUpkeeps, err := executer.strategy.EligibleUpkeeps(
 		ctx,
-		executer.job.KeeperSpec.ContractAddress,
-		head.Number,
+		executer.orm,
+		executer.contractAddress,
+		head,
 		executer.config.KeeperMaximumGracePeriod(),
 	)
 	if err != nil {
@@ -181,13 +194,9 @@ func (executer *UpkeepExecuter) execute(upkeep UpkeepRegistration, headNumber in
 	ctxService, cancel := utils.ContextFromChan(executer.chStop)
 	defer cancel()
 
-	checkUpkeepResult, err := executer.ethClient.CallContract(ctxService, msg, nil)
+	checkUpkeepResult, err := executer.ethClient.CallContract(ctxService, msg, pendingBlock)
 	if err != nil {
-		revertReason, err2 := eth.ExtractRevertReasonFromRPCError(err)
-		if err2 != nil {
-			revertReason = fmt.Sprintf("unknown revert reason: error during extraction: %v", err2)
-		}
-		logArgs = append(logArgs, "revertReason", revertReason)
+		logArgs = append(logArgs, "revertReason", revertReason(err))
 		logger.Debugw(fmt.Sprintf("UpkeepExecuter: checkUpkeep failed: %v", err), logArgs...)
 		return
 	}
@@ -198,6 +207,10 @@ func (executer *UpkeepExecuter) execute(upkeep UpkeepRegistration, headNumber in
 		return
 	}
 
+	if !executer.simulatePerformUpkeep(ctxService, upkeep, performTxData, headNumber, logArgs) {
+		return
+	}
+
 	logger.Debugw("UpkeepExecuter: performing upkeep", logArgs...)
 
 	// Save a run indicating we performed an upkeep.
@@ -221,7 +234,7 @@ func (executer *UpkeepExecuter) execute(upkeep UpkeepRegistration, headNumber in
 		// NOTE: this is the block that initiated the run, not the block height when broadcast nor the block
 		// that the tx gets confirmed in. This is fine because this grace period is just used as a fallback
 		// in case we miss the UpkeepPerformed log or the tx errors. It does not need to be exact.
-		err = executer.orm.SetLastRunHeightForUpkeepOnJob(dbtx, executer.job.ID, upkeep.UpkeepID, headNumber)
+		err = executer.orm.SetLastRunHeightForUpkeep(dbtx, upkeep.RegistryID, upkeep.UpkeepID, headNumber)
 		if err != nil {
 			return errors.Wrap(err, "failed to set last run height for upkeep")
 		}
@@ -263,6 +276,60 @@ func (executer *UpkeepExecuter) execute(upkeep UpkeepRegistration, headNumber in
 	pipeline.PromPipelineTasksTotalFinished.WithLabelValues(fmt.Sprintf("%d", executer.job.ID), executer.job.Name.String, "", job.Keeper.String(), status).Inc()
 }
 
+// simulatePerformUpkeep simulates performUpkeep against the pending block,
+// estimates its gas and records the outcome. It returns false if the
+// simulation reverted, in which case the upkeep is not performed.
+func (executer *UpkeepExecuter) simulatePerformUpkeep(ctx context.Context, upkeep UpkeepRegistration, performTxData []byte, headNumber int64, logArgs []interface{}) bool {
+	to := upkeep.Registry.ContractAddress.Address()
+	msg := ethereum.CallMsg{
+		From: upkeep.Registry.FromAddress.Address(),
+		To:   &to,
+		Gas:  upkeep.ExecuteGas + executer.config.KeeperRegistryPerformGasOverhead(),
+		Data: performTxData,
+	}
+
+	sim := UpkeepSimulation{
+		UpkeepRegistrationID: upkeep.ID,
+		BlockNumber:          headNumber,
+	}
+	if _, err := executer.ethClient.CallContract(ctx, msg, pendingBlock); err != nil {
+		sim.RevertReason = null.StringFrom(revertReason(err))
+	} else if gas, err := executer.ethClient.EstimateGas(ctx, msg); err != nil {
+		logger.Debugw(fmt.Sprintf("UpkeepExecuter: failed to estimate performUpkeep gas: %v", err), logArgs...)
+	} else {
+		sim.PerformGasEstimate = null.IntFrom(int64(gas))
+	}
+	if ctx.Err() != nil {
+		// The executer is stopping, so the simulation was cut short
+		return false
+	}
+
+	err := executer.orm.RecordUpkeepSimulation(ctx, &sim, executer.config.KeeperSimulationMaxConsecutiveReverts(), executer.config.KeeperSimulationParkDuration())
+	if err != nil {
+		logger.Errorw("UpkeepExecuter: failed to record performUpkeep simulation", append(logArgs, "err", err)...)
+	}
+
+	if !sim.Reverted() {
+		return true
+	}
+	logArgs = append(logArgs, "revertReason", sim.RevertReason.String, "consecutiveReverts", sim.ConsecutiveReverts)
+	if sim.ParkedUntil != nil {
+		logger.Warnw("UpkeepExecuter: performUpkeep keeps reverting in simulation, parking upkeep", append(logArgs, "parkedUntil", sim.ParkedUntil)...)
+	} else {
+		logger.Debugw("UpkeepExecuter: performUpkeep reverted in simulation, skipping upkeep", logArgs...)
+	}
+	return false
+}
+
+// revertReason extracts the revert reason from the error of a reverted call
+func revertReason(err error) string {
+	reason, err2 := eth.ExtractRevertReasonFromRPCError(err)
+	if err2 != nil {
+		return fmt.Sprintf("unknown revert reason: error during extraction: %v", err2)
+	}
+	return reason
+}
+
 func (executer *UpkeepExecuter) constructCheckUpkeepCallMsg(upkeep UpkeepRegistration) (ethereum.CallMsg, error) {
 	checkPayload, err := RegistryABI.Pack(
 		checkUpkeep,
@@ -46,10 +46,10 @@ func setup(t *testing.T) (
 	cfg, cleanup := cltest.NewConfig(t)
 	t.Cleanup(cleanup)
 	jpv2 := cltest.NewJobPipelineV2(t, cfg, store.DB, nil, nil, nil)
-	headBroadcaster := headtracker.NewHeadBroadcaster()
+	headBroadcaster := headtracker.NewHeadBroadcaster(cfg)
 	txm := new(bptxmmocks.TxManager)
 	orm := keeper.NewORM(store.DB, txm, store.Config, bulletprooftxmanager.SendEveryStrategy{})
-	executer := keeper.NewUpkeepExecuter(job, orm, jpv2.Pr, ethMock, headBroadcaster, store.Config)
+	executer := keeper.NewUpkeepExecuter(job, registry.ContractAddress, orm, jpv2.Pr, ethMock, headBroadcaster, keeper.PositioningStrategy{}, store.Config)
 	upkeep := cltest.MustInsertUpkeepForRegistry(t, store, registry)
 	err := executer.Start()
 	t.Cleanup(func() { executer.Close() })
@@ -86,13 +86,15 @@ func Test_UpkeepExecuter_PerformsUpkeep_Happy(t *testing.T) {
 
 		gasLimit := upkeep.ExecuteGas + store.Config.KeeperRegistryPerformGasOverhead()
 		ethTxCreated := cltest.NewAwaiter()
-		txm.On("CreateEthTransaction", mock.Anything, mock.Anything, mock.Anything, mock.Anything, gasLimit, nil, mock.Anything).
+		txm.On("CreateEthTransaction", mock.Anything, mock.Anything, mock.Anything, mock.Anything, gasLimit, nil, mock.Anything, bulletprooftxmanager.EthTxUrgencyNormal, bulletprooftxmanager.EthTxExpiry{}, true).
 			Once().
 			Return(bulletprooftxmanager.EthTx{}, nil).
 			Run(func(mock.Arguments) { ethTxCreated.ItHappened() })
 
 		registryMock := cltest.NewContractMockReceiver(t, ethMock, keeper.RegistryABI, registry.ContractAddress.Address())
 		registryMock.MockResponse("checkUpkeep", checkUpkeepResponse)
+		registryMock.MockResponse("performUpkeep", true)
+		ethMock.On("EstimateGas", mock.Anything, mock.Anything).Return(uint64(90_000), nil)
 
 		head := models.NewHead(big.NewInt(20), utils.NewHash(), utils.NewHash(), 1000)
 		executer.OnNewLongestChain(context.Background(), head)
@@ -115,13 +117,15 @@ func Test_UpkeepExecuter_PerformsUpkeep_Happy(t *testing.T) {
 			cltest.NewAwaiter(),
 		}
 		gasLimit := upkeep.ExecuteGas + store.Config.KeeperRegistryPerformGasOverhead()
-		txm.On("CreateEthTransaction", mock.Anything, mock.Anything, mock.Anything, mock.Anything, gasLimit, nil, mock.Anything).
+		txm.On("CreateEthTransaction", mock.Anything, mock.Anything, mock.Anything, mock.Anything, gasLimit, nil, mock.Anything, bulletprooftxmanager.EthTxUrgencyNormal, bulletprooftxmanager.EthTxExpiry{}, true).
 			Once().
 			Return(bulletprooftxmanager.EthTx{}, nil).
 			Run(func(mock.Arguments) { etxs[0].ItHappened() })
 
 		registryMock := cltest.NewContractMockReceiver(t, ethMock, keeper.RegistryABI, registry.ContractAddress.Address())
 		registryMock.MockResponse("checkUpkeep", checkUpkeepResponse)
+		registryMock.MockResponse("performUpkeep", true)
+		ethMock.On("EstimateGas", mock.Anything, mock.Anything).Return(uint64(90_000), nil)
 
 		// turn falls somewhere between 20-39 (blockCountPerTurn=20)
 		// heads 20 thru 35 were skipped (e.g. due to node reboot)
@@ -144,7 +148,7 @@ func Test_UpkeepExecuter_PerformsUpkeep_Happy(t *testing.T) {
 		// head 40 triggers a new run
 		head = *cltest.Head(40)
 
-		txm.On("CreateEthTransaction", mock.Anything, mock.Anything, mock.Anything, mock.Anything, gasLimit, nil, mock.Anything).
+		txm.On("CreateEthTransaction", mock.Anything, mock.Anything, mock.Anything, mock.Anything, gasLimit, nil, mock.Anything, bulletprooftxmanager.EthTxUrgencyNormal, bulletprooftxmanager.EthTxExpiry{}, true).
 			Once().
 			Return(bulletprooftxmanager.EthTx{}, nil).
 			Run(func(mock.Arguments) { etxs[1].ItHappened() })
@@ -182,6 +186,36 @@ func Test_UpkeepExecuter_PerformsUpkeep_Error(t *testing.T) {
 	ethMock.AssertExpectations(t)
 }
 
+func Test_UpkeepExecuter_PerformUpkeepSimulationReverts(t *testing.T) {
+	t.Parallel()
+	g := gomega.NewGomegaWithT(t)
+
+	store, ethMock, executer, registry, upkeep, _, _, _ := setup(t)
+
+	registryMock := cltest.NewContractMockReceiver(t, ethMock, keeper.RegistryABI, registry.ContractAddress.Address())
+	registryMock.MockResponse("checkUpkeep", checkUpkeepResponse)
+	registryMock.MockRevertResponse("performUpkeep")
+
+	head := models.NewHead(big.NewInt(20), utils.NewHash(), utils.NewHash(), 1000)
+	executer.OnNewLongestChain(context.TODO(), head)
+
+	g.Eventually(func() []keeper.UpkeepSimulation {
+		sims, err := keeper.FindUpkeepSimulations(context.Background(), store.DB, false)
+		require.NoError(t, err)
+		return sims
+	}, cltest.DBWaitTimeout, cltest.DBPollingInterval).Should(gomega.HaveLen(1))
+
+	sims, err := keeper.FindUpkeepSimulations(context.Background(), store.DB, false)
+	require.NoError(t, err)
+	assert.Equal(t, upkeep.ID, sims[0].UpkeepRegistrationID)
+	assert.Equal(t, int64(20), sims[0].BlockNumber)
+	assert.True(t, sims[0].Reverted())
+	assert.Equal(t, int32(1), sims[0].ConsecutiveReverts)
+	assertLastRunHeight(t, store, upkeep, 0)
+	cltest.AssertCountStays(t, store, bulletprooftxmanager.EthTx{}, 0)
+	ethMock.AssertNotCalled(t, "EstimateGas", mock.Anything, mock.Anything)
+}
+
 func Test_UpkeepExecuter_ConstructCheckUpkeepCallMsg(t *testing.T) {
 	store, _, executer, registry, upkeep, _, _, _ := setup(t)
 	msg, err := executer.ExportedConstructCheckUpkeepCallMsg(upkeep)
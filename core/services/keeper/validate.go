@@ -5,6 +5,7 @@ import (
 	"github.com/pkg/errors"
 	uuid "github.com/satori/go.uuid"
 	"github.com/smartcontractkit/chainlink/core/services/job"
+	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
 )
 
 func ValidatedKeeperSpec(tomlString string) (job.Job, error) {
@@ -29,5 +30,40 @@ func ValidatedKeeperSpec(tomlString string) (job.Job, error) {
 	if j.Type != job.Keeper {
 		return j, errors.Errorf("unsupported type %s", j.Type)
 	}
+	if spec.EligibilityStrategy != "" {
+		if err := ValidateEligibilityStrategy(spec.EligibilityStrategy); err != nil {
+			return j, err
+		}
+	}
+	if err := validateRegistrySpecs(spec.Registries); err != nil {
+		return j, err
+	}
 	return j, nil
 }
+
+func validateRegistrySpecs(registries []job.KeeperRegistrySpec) error {
+	seen := make(map[ethkey.EIP55Address]struct{})
+	for _, r := range registries {
+		if r.ContractAddress == "" {
+			return errors.New("registries: contractAddress is required")
+		}
+		if _, exists := seen[r.ContractAddress]; exists {
+			return errors.Errorf("registries: registry %s is listed more than once", r.ContractAddress)
+		}
+		seen[r.ContractAddress] = struct{}{}
+		if r.SyncInterval < 0 {
+			return errors.Errorf("registries: syncInterval of registry %s must not be negative", r.ContractAddress)
+		}
+		if r.EligibilityStrategy != "" {
+			if err := ValidateEligibilityStrategy(r.EligibilityStrategy); err != nil {
+				return errors.Wrapf(err, "registries: registry %s", r.ContractAddress)
+			}
+		}
+		for _, id := range r.UpkeepIDs {
+			if id < 0 {
+				return errors.Errorf("registries: upkeepIDs of registry %s must not be negative", r.ContractAddress)
+			}
+		}
+	}
+	return nil
+}
@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"fmt"
 	"testing"
 	"time"
 
@@ -26,3 +27,56 @@ func TestValidatedKeeperSpec(t *testing.T) {
 	require.Equal(t, time.Time{}, s.KeeperSpec.CreatedAt)
 	require.Equal(t, time.Time{}, s.KeeperSpec.UpdatedAt)
 }
+
+func TestValidatedKeeperSpec_EligibilityStrategy(t *testing.T) {
+	t.Parallel()
+	toml := `
+		type                = "keeper"
+		schemaVersion       = 1
+		contractAddress     = "0x9E40733cC9df84636505f4e6Db28DCa0dC5D1bba"
+		fromAddress         = "0xa8037A20989AFcBC51798de9762b351D63ff462e"
+		eligibilityStrategy = "%s"
+	`
+
+	s, err := ValidatedKeeperSpec(fmt.Sprintf(toml, EligibilityStrategyBlockHash))
+	require.NoError(t, err)
+	require.Equal(t, EligibilityStrategyBlockHash, s.KeeperSpec.EligibilityStrategy)
+
+	_, err = ValidatedKeeperSpec(fmt.Sprintf(toml, "firstcomefirstserved"))
+	require.EqualError(t, err, `unknown eligibility strategy "firstcomefirstserved", must be one of positioning or blockhash`)
+}
+
+func TestValidatedKeeperSpec_Registries(t *testing.T) {
+	t.Parallel()
+	toml := `
+		type                = "keeper"
+		schemaVersion       = 1
+		contractAddress     = "0x9E40733cC9df84636505f4e6Db28DCa0dC5D1bba"
+		fromAddress         = "0xa8037A20989AFcBC51798de9762b351D63ff462e"
+
+		[[registries]]
+		contractAddress     = "0x613a38AC1659769640aaE063C651F48E0250454C"
+		syncInterval        = "5m"
+		upkeepIDs           = [1, 3]
+
+		[[registries]]
+		contractAddress     = "%s"
+	`
+
+	s, err := ValidatedKeeperSpec(fmt.Sprintf(toml, "0x3cCad4715152693fE3BC4460591e3D3Fbd071b42"))
+	require.NoError(t, err)
+	require.Len(t, s.KeeperSpec.Registries, 2)
+
+	registries := s.KeeperSpec.RegistrySpecs()
+	require.Len(t, registries, 3)
+	require.Equal(t, "0x9E40733cC9df84636505f4e6Db28DCa0dC5D1bba", registries[0].ContractAddress.Hex())
+	require.Equal(t, "0x613a38AC1659769640aaE063C651F48E0250454C", registries[1].ContractAddress.Hex())
+	require.Equal(t, "0xa8037A20989AFcBC51798de9762b351D63ff462e", registries[1].FromAddress.Hex())
+	require.Equal(t, 5*time.Minute, time.Duration(registries[1].SyncInterval))
+	require.True(t, registries[1].HasUpkeep(3))
+	require.False(t, registries[1].HasUpkeep(2))
+	require.True(t, registries[2].HasUpkeep(2))
+
+	_, err = ValidatedKeeperSpec(fmt.Sprintf(toml, "0x613a38AC1659769640aaE063C651F48E0250454C"))
+	require.EqualError(t, err, "registries: registry 0x613a38AC1659769640aaE063C651F48E0250454C is listed more than once")
+}
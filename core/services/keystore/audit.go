@@ -0,0 +1,135 @@
+package keystore
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	uuid "github.com/satori/go.uuid"
+	"gopkg.in/guregu/null.v4"
+	"gorm.io/gorm"
+)
+
+// KeyType is the kind of key of a KeyAccess
+type KeyType string
+
+const (
+	KeyTypeCSA KeyType = "csa"
+	KeyTypeEth KeyType = "eth"
+	KeyTypeOCR KeyType = "ocr"
+	KeyTypeP2P KeyType = "p2p"
+	KeyTypeVRF KeyType = "vrf"
+)
+
+// KeyOperation is what a KeyAccess used the key for
+type KeyOperation string
+
+const (
+	KeyOperationSignTx           KeyOperation = "sign_tx"
+	KeyOperationSignMessage      KeyOperation = "sign_message"
+	KeyOperationSignOffChain     KeyOperation = "sign_offchain"
+	KeyOperationVRFProof         KeyOperation = "vrf_proof"
+	KeyOperationExport           KeyOperation = "export"
+	KeyOperationPrivateKeyAccess KeyOperation = "private_key_access"
+)
+
+// KeyAccess is an entry of the audit log of key material access and signing
+// operations. The log is append-only, entries can't be updated or deleted.
+type KeyAccess struct {
+	ID        int64
+	KeyType   KeyType
+	KeyID     string
+	Operation KeyOperation
+	// Service is the part of the node which accessed the key
+	Service string
+	// JobID is the job on behalf of which the key was accessed, if any
+	JobID     null.Int
+	Purpose   string
+	CreatedAt time.Time
+}
+
+// Requester identifies who accesses a key, and why, for the audit log
+type Requester struct {
+	Service string
+	JobID   null.Int
+	// ExternalJobID identifies the job by its external ID instead of JobID,
+	// for services which only know that one
+	ExternalJobID uuid.NullUUID
+	Purpose       string
+}
+
+type requesterKey struct{}
+
+// WithRequester returns a copy of ctx carrying r, which is recorded in the
+// audit log by every key access made with it.
+func WithRequester(ctx context.Context, r Requester) context.Context {
+	return context.WithValue(ctx, requesterKey{}, r)
+}
+
+// RequesterFromContext returns the requester carried by ctx. Key accesses
+// with a ctx without one are recorded as made by an unknown service.
+func RequesterFromContext(ctx context.Context) Requester {
+	r, ok := ctx.Value(requesterKey{}).(Requester)
+	if !ok || r.Service == "" {
+		r.Service = "unknown"
+	}
+	return r
+}
+
+// KeyAccessFilter selects audit log entries, zero fields match any entry
+type KeyAccessFilter struct {
+	KeyType   KeyType
+	KeyID     string
+	Operation KeyOperation
+	JobID     null.Int
+	Since     null.Time
+	Until     null.Time
+}
+
+type auditLog struct {
+	db *gorm.DB
+}
+
+// record appends a key access to the audit log. Keys are only used once
+// their access has been recorded, so that nothing escapes the log.
+func (a auditLog) record(ctx context.Context, keyType KeyType, keyID string, operation KeyOperation) error {
+	r := RequesterFromContext(ctx)
+	err := a.db.Exec(`
+INSERT INTO key_accesses (key_type, key_id, operation, service, job_id, purpose, created_at)
+VALUES (?, ?, ?, ?, COALESCE(?, (SELECT id FROM jobs WHERE external_job_id = ?)), ?, NOW())
+`, keyType, keyID, operation, r.Service, r.JobID, r.ExternalJobID, r.Purpose).Error
+	return errors.Wrapf(err, "failed to record %s of %s key %s in audit log", operation, keyType, keyID)
+}
+
+// KeyAccesses returns the page of audit log entries matching filter, newest
+// first, and the total count of matching entries
+func (m *Master) KeyAccesses(filter KeyAccessFilter, offset, limit int) (accesses []KeyAccess, count int, err error) {
+	filtered := func(db *gorm.DB) *gorm.DB {
+		if filter.KeyType != "" {
+			db = db.Where("key_type = ?", filter.KeyType)
+		}
+		if filter.KeyID != "" {
+			db = db.Where("key_id = ?", filter.KeyID)
+		}
+		if filter.Operation != "" {
+			db = db.Where("operation = ?", filter.Operation)
+		}
+		if filter.JobID.Valid {
+			db = db.Where("job_id = ?", filter.JobID)
+		}
+		if filter.Since.Valid {
+			db = db.Where("created_at >= ?", filter.Since)
+		}
+		if filter.Until.Valid {
+			db = db.Where("created_at < ?", filter.Until)
+		}
+		return db
+	}
+
+	var total int64
+	if err = m.db.Model(&KeyAccess{}).Scopes(filtered).Count(&total).Error; err != nil {
+		return nil, 0, errors.Wrap(err, "KeyAccesses failed to count entries")
+	}
+	err = m.db.Scopes(filtered).Order("id DESC").Offset(offset).Limit(limit).Find(&accesses).Error
+	return accesses, int(total), errors.Wrap(err, "KeyAccesses failed to load entries")
+}
@@ -0,0 +1,97 @@
+package keystore_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	uuid "github.com/satori/go.uuid"
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/services/keystore"
+	"github.com/smartcontractkit/chainlink/core/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
+)
+
+func Test_KeyAccesses(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+	db := store.DB
+	ks := cltest.NewKeyStore(t, db)
+	require.NoError(t, ks.Eth().Unlock(cltest.Password))
+	require.NoError(t, ks.CSA().Unlock(cltest.Password))
+
+	job := cltest.MustInsertKeeperJob(t, store, cltest.NewEIP55Address(), cltest.NewEIP55Address())
+	ethKey, address := cltest.MustAddRandomKeyToKeystore(t, ks.Eth())
+	csaKey, err := ks.CSA().CreateCSAKey()
+	require.NoError(t, err)
+
+	ctx := keystore.WithRequester(context.Background(), keystore.Requester{
+		Service: "test",
+		JobID:   null.IntFrom(int64(job.ID)),
+		Purpose: "sign a message",
+	})
+	_, err = ks.Eth().SignMessage(ctx, address, []byte("hello"))
+	require.NoError(t, err)
+	ctx = keystore.WithRequester(context.Background(), keystore.Requester{
+		Service:       "test",
+		ExternalJobID: uuid.NullUUID{UUID: job.ExternalJobID, Valid: true},
+	})
+	_, err = ks.Eth().SignMessage(ctx, address, []byte("hello again"))
+	require.NoError(t, err)
+	_, err = ks.CSA().ExportCSAKey(context.Background(), csaKey.ID, "password", utils.FastScryptParams)
+	require.NoError(t, err)
+
+	t.Run("lists all accesses newest first", func(t *testing.T) {
+		accesses, count, err := ks.KeyAccesses(keystore.KeyAccessFilter{}, 0, 10)
+		require.NoError(t, err)
+		require.Equal(t, 3, count)
+		require.Len(t, accesses, 3)
+
+		assert.Equal(t, keystore.KeyTypeCSA, accesses[0].KeyType)
+		assert.Equal(t, csaKey.PublicKey.String(), accesses[0].KeyID)
+		assert.Equal(t, keystore.KeyOperationExport, accesses[0].Operation)
+		assert.Equal(t, "unknown", accesses[0].Service)
+		assert.False(t, accesses[0].JobID.Valid)
+
+		assert.Equal(t, keystore.KeyTypeEth, accesses[2].KeyType)
+		assert.Equal(t, ethKey.Address.Hex(), accesses[2].KeyID)
+		assert.Equal(t, keystore.KeyOperationSignMessage, accesses[2].Operation)
+		assert.Equal(t, "test", accesses[2].Service)
+		assert.Equal(t, null.IntFrom(int64(job.ID)), accesses[2].JobID)
+		assert.Equal(t, "sign a message", accesses[2].Purpose)
+	})
+
+	t.Run("resolves the job of an external job ID", func(t *testing.T) {
+		accesses, _, err := ks.KeyAccesses(keystore.KeyAccessFilter{KeyType: keystore.KeyTypeEth}, 0, 1)
+		require.NoError(t, err)
+		require.Len(t, accesses, 1)
+		assert.Equal(t, null.IntFrom(int64(job.ID)), accesses[0].JobID)
+	})
+
+	t.Run("filters accesses", func(t *testing.T) {
+		_, count, err := ks.KeyAccesses(keystore.KeyAccessFilter{JobID: null.IntFrom(int64(job.ID))}, 0, 10)
+		require.NoError(t, err)
+		assert.Equal(t, 2, count)
+
+		_, count, err = ks.KeyAccesses(keystore.KeyAccessFilter{Operation: keystore.KeyOperationExport}, 0, 10)
+		require.NoError(t, err)
+		assert.Equal(t, 1, count)
+
+		_, count, err = ks.KeyAccesses(keystore.KeyAccessFilter{Since: null.TimeFrom(time.Now().Add(time.Hour))}, 0, 10)
+		require.NoError(t, err)
+		assert.Equal(t, 0, count)
+	})
+
+	t.Run("is append-only", func(t *testing.T) {
+		for _, stmt := range []string{`UPDATE key_accesses SET service = 'tampered'`, `DELETE FROM key_accesses`} {
+			// The test runs in one transaction, which must survive the error
+			require.NoError(t, db.Exec(`SAVEPOINT append_only`).Error)
+			require.Error(t, db.Exec(stmt).Error)
+			require.NoError(t, db.Exec(`ROLLBACK TO SAVEPOINT append_only`).Error)
+		}
+	})
+}
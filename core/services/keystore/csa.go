@@ -1,7 +1,9 @@
 package keystore
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"sync"
 
 	"github.com/pkg/errors"
@@ -14,7 +16,7 @@ import (
 )
 
 var (
-	ErrCSAKeyExists = errors.New("a csa key already exists")
+	ErrCSAKeyExists = errors.New("csa key already exists")
 )
 
 //go:generate mockery --name CSAKeystoreInterface --output mocks/ --case=underscore
@@ -22,7 +24,7 @@ var (
 type CSAKeystoreInterface interface {
 	CreateCSAKey() (*csakey.Key, error)
 	ListCSAKeys() ([]csakey.Key, error)
-	Unsafe_GetUnlockedPrivateKey(pubkey crypto.PublicKey) ([]byte, error)
+	Unsafe_GetUnlockedPrivateKey(ctx context.Context, pubkey crypto.PublicKey) ([]byte, error)
 }
 
 type CSA struct {
@@ -31,6 +33,7 @@ type CSA struct {
 	password     string
 	keys         map[string]*csakey.Key // Maps the public key hex value to the CSA Key
 	scryptParams utils.ScryptParams
+	audit        auditLog
 }
 
 func newCSAKeyStore(db *gorm.DB, scryptParams utils.ScryptParams) *CSA {
@@ -39,49 +42,100 @@ func newCSAKeyStore(db *gorm.DB, scryptParams utils.ScryptParams) *CSA {
 		keys:         make(map[string]*csakey.Key),
 		scryptParams: scryptParams,
 		mu:           new(sync.RWMutex),
+		audit:        auditLog{db},
 	}
 }
 
 // CreateCSAKey creates a new CSA key
 func (ks *CSA) CreateCSAKey() (*csakey.Key, error) {
-	// Ensure you can only have one CSA at a time. This is a temporary
-	// restriction until we are able to handle multiple CSA keys in the
-	// communication channel
 	ks.mu.Lock()
 	defer ks.mu.Unlock()
 
-	count, err := ks.orm.CountCSAKeys()
+	key, err := csakey.New(ks.password, ks.scryptParams)
 	if err != nil {
 		return nil, err
 	}
 
-	if count >= 1 {
-		return nil, ErrCSAKeyExists
+	id, err := ks.orm.CreateCSAKey(context.Background(), key)
+	if err != nil {
+		return nil, err
 	}
 
-	key, err := csakey.New(ks.password, ks.scryptParams)
+	key, err = ks.orm.GetCSAKey(context.Background(), id)
 	if err != nil {
 		return nil, err
 	}
 
-	id, err := ks.orm.CreateCSAKey(context.Background(), key)
+	err = ks.unlockAndAddKey(key, ks.password)
 	if err != nil {
 		return nil, err
 	}
 
-	key, err = ks.orm.GetCSAKey(context.Background(), id)
+	return key, nil
+}
+
+// ImportCSAKey imports a CSA key exported with ExportCSAKey, decrypting it
+// with oldPassword and re-encrypting it with the keystore's password. It
+// returns ErrCSAKeyExists if the key is already in the keystore.
+func (ks *CSA) ImportCSAKey(keyJSON []byte, oldPassword string) (*csakey.Key, error) {
+	ks.mu.Lock()
+	defer ks.mu.Unlock()
+
+	var export csakey.EncryptedCSAKeyExport
+	if err := json.Unmarshal(keyJSON, &export); err != nil {
+		return nil, errors.Wrap(err, "CSAKeyStore#ImportCSAKey failed to unmarshal key")
+	}
+	if _, exists := ks.keys[export.PublicKey.String()]; exists {
+		return nil, ErrCSAKeyExists
+	}
+	key, err := export.DecryptPrivateKey(oldPassword)
+	if err != nil {
+		return nil, errors.Wrap(err, "CSAKeyStore#ImportCSAKey failed to decrypt key")
+	}
+	privkey, err := key.Unsafe_GetPrivateKey()
 	if err != nil {
 		return nil, err
 	}
-
-	err = ks.unlockAndAddKey(key, ks.password)
+	encPrivkey, err := crypto.NewEncryptedPrivateKey(privkey, ks.password, ks.scryptParams)
 	if err != nil {
 		return nil, err
 	}
+	key.EncryptedPrivateKey = *encPrivkey
 
+	id, err := ks.orm.CreateCSAKey(context.Background(), key)
+	if err != nil {
+		return nil, errors.Wrap(err, "CSAKeyStore#ImportCSAKey failed to save key")
+	}
+	key, err = ks.orm.GetCSAKey(context.Background(), id)
+	if err != nil {
+		return nil, err
+	}
+	if err = ks.unlockAndAddKey(key, ks.password); err != nil {
+		return nil, err
+	}
 	return key, nil
 }
 
+// ExportCSAKey exports the CSA key with id, encrypted with newPassword using
+// scryptParams. The export is recorded in the audit log.
+func (ks *CSA) ExportCSAKey(ctx context.Context, id uint, newPassword string, scryptParams utils.ScryptParams) ([]byte, error) {
+	ks.mu.RLock()
+	defer ks.mu.RUnlock()
+
+	key, err := ks.orm.GetCSAKey(context.Background(), id)
+	if err != nil {
+		return nil, errors.Wrapf(err, "CSAKeyStore#ExportCSAKey failed to load key %d", id)
+	}
+	unlocked, ok := ks.keys[key.PublicKey.String()]
+	if !ok {
+		return nil, errors.Errorf("CSAKeyStore#ExportCSAKey key %d has not been unlocked", id)
+	}
+	if err = ks.audit.record(ctx, KeyTypeCSA, key.PublicKey.String(), KeyOperationExport); err != nil {
+		return nil, err
+	}
+	return unlocked.ToEncryptedExport(newPassword, scryptParams)
+}
+
 // ListCSAKeys lists all CSA keys.
 func (ks *CSA) ListCSAKeys() ([]csakey.Key, error) {
 	return ks.orm.ListCSAKeys(context.Background())
@@ -98,8 +152,20 @@ func (ks *CSA) CountCSAKeys() (int64, error) {
 // however we need to pass this priv key to the wsrpc library in order to dial
 // the server. When wsrpc is updated to allow an interface to be passed in, we
 // can implement that interface here to provide the private key.
-func (ks *CSA) Unsafe_GetUnlockedPrivateKey(pubkey crypto.PublicKey) ([]byte, error) {
-	return ks.keys[pubkey.String()].Unsafe_GetPrivateKey()
+//
+// The access is recorded in the audit log as made by the requester of ctx.
+func (ks *CSA) Unsafe_GetUnlockedPrivateKey(ctx context.Context, pubkey crypto.PublicKey) ([]byte, error) {
+	ks.mu.RLock()
+	defer ks.mu.RUnlock()
+
+	key, exists := ks.keys[pubkey.String()]
+	if !exists {
+		return nil, errors.Errorf("csa key %s has not been unlocked", pubkey)
+	}
+	if err := ks.audit.record(ctx, KeyTypeCSA, pubkey.String(), KeyOperationPrivateKeyAccess); err != nil {
+		return nil, err
+	}
+	return key.Unsafe_GetPrivateKey()
 }
 
 func (ks *CSA) Unlock(password string) error {
@@ -133,3 +199,40 @@ func (ks *CSA) unlockAndAddKey(key *csakey.Key, password string) error {
 	ks.keys[key.PublicKey.String()] = key
 	return nil
 }
+
+// reencrypt re-encrypts all keys from oldPassword to newPassword via tx.
+// apply updates the keystore once tx has been committed. Caller must hold
+// ks.mu.
+func (ks *CSA) reencrypt(tx *gorm.DB, oldPassword, newPassword string) (apply func(), err error) {
+	keys, err := NewCSAORM(tx).ListCSAKeys(context.Background())
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to load csa keys")
+	}
+	reencrypted := make(map[string]crypto.EncryptedPrivateKey, len(keys))
+	for _, k := range keys {
+		privkey, err := k.EncryptedPrivateKey.Decrypt(oldPassword)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to decrypt csa key %s", k.PublicKey)
+		}
+		encPrivkey, err := crypto.NewEncryptedPrivateKey(privkey, newPassword, ks.scryptParams)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to encrypt csa key %s", k.PublicKey)
+		}
+		if verified, err := encPrivkey.Decrypt(newPassword); err != nil || !bytes.Equal(verified, privkey) {
+			return nil, errors.Errorf("failed to verify re-encrypted csa key %s", k.PublicKey)
+		}
+		if err = tx.Exec(`UPDATE csa_keys SET encrypted_private_key = ?, updated_at = NOW() WHERE id = ?`, encPrivkey, k.ID).Error; err != nil {
+			return nil, errors.Wrapf(err, "failed to update csa key %s", k.PublicKey)
+		}
+		reencrypted[k.PublicKey.String()] = *encPrivkey
+	}
+
+	return func() {
+		for pubkey, encPrivkey := range reencrypted {
+			if key, exists := ks.keys[pubkey]; exists {
+				key.EncryptedPrivateKey = encPrivkey
+			}
+		}
+		ks.password = newPassword
+	}, nil
+}
@@ -42,7 +42,8 @@ func (o csaORM) ListCSAKeys(ctx context.Context) ([]csakey.Key, error) {
 	keys := []csakey.Key{}
 	stmt := `
 		SELECT id, public_key, encrypted_private_key, created_at, updated_at
-		FROM csa_keys;
+		FROM csa_keys
+		ORDER BY id ASC;
 	`
 
 	err := o.db.Raw(stmt).Scan(&keys).Error
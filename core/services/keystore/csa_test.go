@@ -1,9 +1,12 @@
 package keystore_test
 
 import (
+	"context"
 	"testing"
 
 	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/services/keystore"
+	"github.com/smartcontractkit/chainlink/core/utils"
 	"github.com/stretchr/testify/require"
 )
 
@@ -34,12 +37,46 @@ func Test_CSAKeyStore(t *testing.T) {
 		require.Equal(t, int64(1), count)
 	})
 
-	t.Run("it won't allow more than one key", func(tt *testing.T) {
+	t.Run("it can create more than one key", func(tt *testing.T) {
 		_, err := ks.CreateCSAKey()
+		require.NoError(t, err)
+
+		keys, err := ks.ListCSAKeys()
+		require.NoError(t, err)
+		require.Len(t, keys, 2)
+		require.Less(t, keys[0].ID, keys[1].ID)
+		require.NotEqual(t, keys[0].Fingerprint(), keys[1].Fingerprint())
+	})
+
+	t.Run("it can export and import a key", func(tt *testing.T) {
+		keys, err := ks.ListCSAKeys()
+		require.NoError(t, err)
+		require.Len(t, keys, 2)
+		key := keys[0]
+
+		exported, err := ks.ExportCSAKey(context.Background(), key.ID, "new password", utils.FastScryptParams)
+		require.NoError(t, err)
+
+		// The same key can't be imported twice
+		_, err = ks.ImportCSAKey(exported, "new password")
+		require.Equal(t, keystore.ErrCSAKeyExists, err)
+
+		otherStore, otherCleanup := cltest.NewStore(t)
+		defer otherCleanup()
+		otherKs := cltest.NewKeyStore(t, otherStore.DB).CSA()
+		otherKs.Unlock(cltest.Password)
+
+		_, err = otherKs.ImportCSAKey(exported, "wrong password")
 		require.Error(t, err)
 
-		count, err := ks.CountCSAKeys()
+		imported, err := otherKs.ImportCSAKey(exported, "new password")
 		require.NoError(t, err)
-		require.Equal(t, int64(1), count)
+		require.Equal(t, key.PublicKey, imported.PublicKey)
+
+		privkey, err := ks.Unsafe_GetUnlockedPrivateKey(context.Background(), key.PublicKey)
+		require.NoError(t, err)
+		importedPrivkey, err := otherKs.Unsafe_GetUnlockedPrivateKey(context.Background(), imported.PublicKey)
+		require.NoError(t, err)
+		require.Equal(t, privkey, importedPrivkey)
 	})
 }
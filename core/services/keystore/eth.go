@@ -1,6 +1,7 @@
 package keystore
 
 import (
+	"context"
 	"crypto/ecdsa"
 	crand "crypto/rand"
 	"fmt"
@@ -8,6 +9,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/ethereum/go-ethereum/accounts"
 	"github.com/ethereum/go-ethereum/accounts/keystore"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
@@ -37,15 +39,18 @@ type EthKeyStoreInterface interface {
 	CreateNewKey() (ethkey.Key, error)
 	EnsureFundingKey() (key ethkey.Key, didExist bool, err error)
 	ImportKey(keyJSON []byte, oldPassword string) (ethkey.Key, error)
-	ExportKey(address common.Address, newPassword string) ([]byte, error)
+	ExportKey(ctx context.Context, address common.Address, newPassword string, scryptParams utils.ScryptParams) ([]byte, error)
 	AddKey(key *ethkey.Key) error
 	RemoveKey(address common.Address, hardDelete bool) (deletedKey ethkey.Key, err error)
+	SetKeyPolicy(address common.Address, policy ethkey.Policy) (ethkey.Key, error)
 	SubscribeToKeyChanges() (ch chan struct{}, unsub func())
 
-	SignTx(fromAddress common.Address, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error)
+	SignTx(ctx context.Context, fromAddress common.Address, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error)
+	SignMessage(ctx context.Context, address common.Address, msg []byte) ([]byte, error)
 
 	AllKeys() (keys []ethkey.Key, err error)
 	SendingKeys() (keys []ethkey.Key, err error)
+	SendingKeysForJob(jobType string, toAddress common.Address) (keys []ethkey.Key, err error)
 	FundingKeys() (keys []ethkey.Key, err error)
 	KeyByAddress(address common.Address) (ethkey.Key, error)
 	HasSendingKeyWithAddress(address common.Address) (bool, error)
@@ -72,13 +77,14 @@ type Eth struct {
 	scryptParams utils.ScryptParams
 	keys         []combinedKey
 	mu           *sync.RWMutex
+	audit        auditLog
 
 	subscribers   [](chan struct{})
 	subscribersMu *sync.RWMutex
 }
 
 func newEthKeyStore(db *gorm.DB, scryptParams utils.ScryptParams) *Eth {
-	return &Eth{db, "", scryptParams, make([]combinedKey, 0), new(sync.RWMutex), make([](chan struct{}), 0), new(sync.RWMutex)}
+	return &Eth{db, "", scryptParams, make([]combinedKey, 0), new(sync.RWMutex), auditLog{db}, make([](chan struct{}), 0), new(sync.RWMutex)}
 }
 
 // Unlock loads keys from the database, and uses the given password to try to
@@ -187,8 +193,9 @@ func (ks *Eth) getFundingKey() (*ethkey.Key, error) {
 	return nil, nil
 }
 
-// SignTx uses the unlocked account to sign the given transaction.
-func (ks *Eth) SignTx(fromAddress common.Address, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error) {
+// SignTx uses the unlocked account to sign the given transaction. The
+// signature is recorded in the audit log as made by the requester of ctx.
+func (ks *Eth) SignTx(ctx context.Context, fromAddress common.Address, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error) {
 	if ks.isLocked() {
 		return nil, ErrKeyStoreLocked
 	}
@@ -198,10 +205,32 @@ func (ks *Eth) SignTx(fromAddress common.Address, tx *types.Transaction, chainID
 	if dKey == nil {
 		return nil, newNoKeyError(fromAddress)
 	}
+	if err := ks.audit.record(ctx, KeyTypeEth, fromAddress.Hex(), KeyOperationSignTx); err != nil {
+		return nil, err
+	}
 
 	return types.SignTx(tx, signer, dKey.PrivateKey)
 }
 
+// SignMessage signs the message with the unlocked account, prefixing it as
+// described in EIP-191 so the signature can be verified with ecrecover. The
+// signature is recorded in the audit log as made by the requester of ctx.
+func (ks *Eth) SignMessage(ctx context.Context, address common.Address, msg []byte) ([]byte, error) {
+	if ks.isLocked() {
+		return nil, ErrKeyStoreLocked
+	}
+
+	dKey := ks.getDecryptedKeyForAddress(address)
+	if dKey == nil {
+		return nil, newNoKeyError(address)
+	}
+	if err := ks.audit.record(ctx, KeyTypeEth, address.Hex(), KeyOperationSignMessage); err != nil {
+		return nil, err
+	}
+
+	return crypto.Sign(accounts.TextHash(msg), dKey.PrivateKey)
+}
+
 func (ks *Eth) getDecryptedKeyForAddress(addr common.Address) *keystore.Key {
 	ks.mu.RLock()
 	defer ks.mu.RUnlock()
@@ -221,7 +250,7 @@ func (ks *Eth) HasSendingKeyWithAddress(address common.Address) (bool, error) {
 	ks.mu.RLock()
 	defer ks.mu.RUnlock()
 	for _, cKey := range ks.keys {
-		if !cKey.DBKey.IsFunding && cKey.DecryptedKey.Address == address {
+		if isSendingKey(cKey.DBKey) && cKey.DecryptedKey.Address == address {
 			return true, nil
 		}
 	}
@@ -272,8 +301,9 @@ func (ks *Eth) ImportKey(keyJSON []byte, oldPassword string) (key ethkey.Key, er
 	return key, nil
 }
 
-// ExportKey exports as a JSON key, encrypted with newPassword
-func (ks *Eth) ExportKey(address common.Address, newPassword string) ([]byte, error) {
+// ExportKey exports as a JSON key, encrypted with newPassword using
+// scryptParams. The export is recorded in the audit log.
+func (ks *Eth) ExportKey(ctx context.Context, address common.Address, newPassword string, scryptParams utils.ScryptParams) ([]byte, error) {
 	if ks.isLocked() {
 		return nil, ErrKeyStoreLocked
 	}
@@ -288,7 +318,10 @@ func (ks *Eth) ExportKey(address common.Address, newPassword string) ([]byte, er
 	if dKey.Address == utils.ZeroAddress {
 		return nil, newNoKeyError(address)
 	}
-	return ks.encryptKey(&dKey, newPassword)
+	if err := ks.audit.record(ctx, KeyTypeEth, address.Hex(), KeyOperationExport); err != nil {
+		return nil, err
+	}
+	return keystore.EncryptKey(&dKey, newPassword, scryptParams.N, scryptParams.P)
 }
 
 // AddKey inserts the key to the database and adds it to the keystore's memory keys
@@ -390,7 +423,8 @@ func (ks *Eth) AllKeys() (keys []ethkey.Key, err error) {
 	return keys, nil
 }
 
-// SendingKeys will return only the keys that are is_funding=false
+// SendingKeys will return only the keys that are is_funding=false and not
+// funding-only
 func (ks *Eth) SendingKeys() (keys []ethkey.Key, err error) {
 	if ks.isLocked() {
 		return nil, ErrKeyStoreLocked
@@ -398,13 +432,66 @@ func (ks *Eth) SendingKeys() (keys []ethkey.Key, err error) {
 	ks.mu.RLock()
 	defer ks.mu.RUnlock()
 	for _, cKey := range ks.keys {
-		if !cKey.DBKey.IsFunding {
+		if isSendingKey(cKey.DBKey) {
 			keys = append(keys, cKey.DBKey)
 		}
 	}
 	return keys, nil
 }
 
+// SendingKeysForJob returns the sending keys whose usage policy allows a job
+// of jobType to send transactions to toAddress
+func (ks *Eth) SendingKeysForJob(jobType string, toAddress common.Address) (keys []ethkey.Key, err error) {
+	sendingKeys, err := ks.SendingKeys()
+	if err != nil {
+		return nil, err
+	}
+	for _, k := range sendingKeys {
+		if k.AllowsJob(jobType, toAddress) {
+			keys = append(keys, k)
+		}
+	}
+	return keys, nil
+}
+
+// SetKeyPolicy replaces the usage policy of the key with address
+func (ks *Eth) SetKeyPolicy(address common.Address, policy ethkey.Policy) (ethkey.Key, error) {
+	if ks.isLocked() {
+		return ethkey.Key{}, ErrKeyStoreLocked
+	}
+	ks.mu.Lock()
+	defer ks.mu.Unlock()
+	for i, cKey := range ks.keys {
+		if cKey.DecryptedKey.Address != address {
+			continue
+		}
+		if policy.FundingOnly && (len(policy.AllowedJobTypes) > 0 || len(policy.AllowedToAddresses) > 0) {
+			return ethkey.Key{}, errors.New("a funding-only key cannot also be restricted to job types or contract addresses")
+		}
+		key := cKey.DBKey
+		key.FundingOnly = policy.FundingOnly
+		key.AllowedJobTypes = nil
+		if len(policy.AllowedJobTypes) > 0 {
+			key.AllowedJobTypes = policy.AllowedJobTypes
+		}
+		key.AllowedToAddresses = nil
+		if len(policy.AllowedToAddresses) > 0 {
+			key.AllowedToAddresses = policy.AllowedToAddresses
+		}
+		err := postgres.DBWithDefaultContext(ks.db, func(db *gorm.DB) error {
+			return db.Exec(`UPDATE keys SET funding_only = ?, allowed_job_types = ?, allowed_to_addresses = ?, updated_at = NOW() WHERE address = ?`,
+				key.FundingOnly, key.AllowedJobTypes, key.AllowedToAddresses, address).Error
+		})
+		if err != nil {
+			return ethkey.Key{}, errors.Wrap(err, "EthKeyStore#SetKeyPolicy failed to update key")
+		}
+		ks.keys[i].DBKey = key
+		ks.notify()
+		return key, nil
+	}
+	return ethkey.Key{}, newNoKeyError(address)
+}
+
 // FundingKeys will return only the keys that are is_funding=true
 func (ks *Eth) FundingKeys() (keys []ethkey.Key, err error) {
 	if ks.isLocked() {
@@ -448,7 +535,7 @@ func (ks *Eth) GetRoundRobinAddress(whitelist ...common.Address) (address common
 
 	var keys []combinedKey
 	for _, cKey := range ks.keys {
-		if !cKey.DBKey.IsFunding {
+		if isSendingKey(cKey.DBKey) {
 			if len(whitelist) == 0 {
 				keys = append(keys, cKey)
 			} else {
@@ -502,6 +589,43 @@ func (ks *Eth) ImportKeyFileToDB(keyPath string) (k ethkey.Key, err error) {
 	return
 }
 
+// reencrypt re-encrypts all keys, including archived ones, from oldPassword
+// to newPassword via tx. apply updates the keystore once tx has been
+// committed. Caller must hold ks.mu.
+func (ks *Eth) reencrypt(tx *gorm.DB, oldPassword, newPassword string) (apply func(), err error) {
+	var keys []ethkey.Key
+	if err = tx.Unscoped().Order("id ASC").Find(&keys).Error; err != nil {
+		return nil, errors.Wrap(err, "failed to load eth keys")
+	}
+	reencrypted := make(map[common.Address]datatypes.JSON, len(keys))
+	for _, k := range keys {
+		dKey, err := keystore.DecryptKey(k.JSON, oldPassword)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to decrypt eth key %s", k.Address.Hex())
+		}
+		keyJSON, err := ks.encryptKey(dKey, newPassword)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to encrypt eth key %s", k.Address.Hex())
+		}
+		if verified, err := keystore.DecryptKey(keyJSON, newPassword); err != nil || verified.Address != k.Address.Address() {
+			return nil, errors.Errorf("failed to verify re-encrypted eth key %s", k.Address.Hex())
+		}
+		if err = tx.Exec(`UPDATE keys SET json = ?, updated_at = NOW() WHERE id = ?`, datatypes.JSON(keyJSON), k.ID).Error; err != nil {
+			return nil, errors.Wrapf(err, "failed to update eth key %s", k.Address.Hex())
+		}
+		reencrypted[k.Address.Address()] = keyJSON
+	}
+
+	return func() {
+		for i, cKey := range ks.keys {
+			if keyJSON, exists := reencrypted[cKey.DecryptedKey.Address]; exists {
+				ks.keys[i].DBKey.JSON = keyJSON
+			}
+		}
+		ks.password = newPassword
+	}, nil
+}
+
 // loadDBKeys returns a map of all of the keys saved in the database
 // including the funding key.
 func (ks *Eth) loadDBKeys() (keys []ethkey.Key, err error) {
@@ -524,6 +648,12 @@ func (ks *Eth) insertKeyIfNotExists(k *ethkey.Key) error {
 	return errors.Wrap(err, "insertKeyIfNotExists failed")
 }
 
+// isSendingKey is false for the funding key and keys whose policy is
+// funding-only
+func isSendingKey(k ethkey.Key) bool {
+	return !k.IsFunding && !k.FundingOnly
+}
+
 // newKey pulled from geth (sadly not exported)
 func newKey() (dKey keystore.Key, err error) {
 	privateKeyECDSA, err := ecdsa.GenerateKey(crypto.S256(), crand.Reader)
@@ -1,15 +1,18 @@
 package keystore_test
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"math/big"
 	"testing"
 	"time"
 
+	"github.com/ethereum/go-ethereum/accounts"
 	gethkeystore "github.com/ethereum/go-ethereum/accounts/keystore"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/smartcontractkit/chainlink/core/internal/cltest"
 	"github.com/smartcontractkit/chainlink/core/services/eth"
 	"github.com/smartcontractkit/chainlink/core/services/keystore"
@@ -134,7 +137,7 @@ func Test_EthKeyStore_ExportKey(t *testing.T) {
 
 	k := cltest.MustInsertRandomKey(t, store.DB)
 
-	_, err := ethKeyStore.ExportKey(cltest.NewAddress(), "some password")
+	_, err := ethKeyStore.ExportKey(context.Background(), cltest.NewAddress(), "some password", utils.FastScryptParams)
 	require.EqualError(t, err, keystore.ErrKeyStoreLocked.Error())
 
 	err = ethKeyStore.Unlock(cltest.Password)
@@ -144,7 +147,7 @@ func Test_EthKeyStore_ExportKey(t *testing.T) {
 	require.NoError(t, err)
 	require.Len(t, keys, 1)
 
-	bytes, err := ethKeyStore.ExportKey(k.Address.Address(), "new password")
+	bytes, err := ethKeyStore.ExportKey(context.Background(), k.Address.Address(), "new password", utils.FastScryptParams)
 	require.NoError(t, err)
 
 	var addr struct {
@@ -266,22 +269,48 @@ func Test_EthKeyStore_SignTx(t *testing.T) {
 	chainID := big.NewInt(eth.NullClientChainID)
 	tx := types.NewTransaction(0, cltest.NewAddress(), big.NewInt(53), 21000, big.NewInt(1000000000), []byte{1, 2, 3, 4})
 
-	_, err := ethKeyStore.SignTx(cltest.NewAddress(), tx, chainID)
+	_, err := ethKeyStore.SignTx(context.Background(), cltest.NewAddress(), tx, chainID)
 	require.EqualError(t, err, keystore.ErrKeyStoreLocked.Error())
 
 	err = ethKeyStore.Unlock(cltest.Password)
 	require.NoError(t, err)
 
 	randomAddress := cltest.NewAddress()
-	_, err = ethKeyStore.SignTx(randomAddress, tx, chainID)
+	_, err = ethKeyStore.SignTx(context.Background(), randomAddress, tx, chainID)
 	require.EqualError(t, err, fmt.Sprintf("address %s not in keystore", randomAddress.Hex()))
 
-	signed, err := ethKeyStore.SignTx(k.Address.Address(), tx, chainID)
+	signed, err := ethKeyStore.SignTx(context.Background(), k.Address.Address(), tx, chainID)
 	require.NoError(t, err)
 
 	assert.NotEqual(t, tx, signed)
 }
 
+func Test_EthKeyStore_SignMessage(t *testing.T) {
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+	ethKeyStore := cltest.NewKeyStore(t, store.DB).Eth()
+
+	k := cltest.MustInsertRandomKey(t, store.DB)
+	msg := []byte("hello world")
+
+	_, err := ethKeyStore.SignMessage(context.Background(), k.Address.Address(), msg)
+	require.EqualError(t, err, keystore.ErrKeyStoreLocked.Error())
+
+	err = ethKeyStore.Unlock(cltest.Password)
+	require.NoError(t, err)
+
+	randomAddress := cltest.NewAddress()
+	_, err = ethKeyStore.SignMessage(context.Background(), randomAddress, msg)
+	require.EqualError(t, err, fmt.Sprintf("address %s not in keystore", randomAddress.Hex()))
+
+	signature, err := ethKeyStore.SignMessage(context.Background(), k.Address.Address(), msg)
+	require.NoError(t, err)
+
+	pubKey, err := crypto.SigToPub(accounts.TextHash(msg), signature)
+	require.NoError(t, err)
+	assert.Equal(t, k.Address.Address(), crypto.PubkeyToAddress(*pubKey))
+}
+
 func Test_EthKeyStore_AllKeys_SendingKeys_FundingKeys_HasSendingKeyWithAddress_GetKeyByAddress(t *testing.T) {
 	store, cleanup := cltest.NewStore(t)
 	defer cleanup()
@@ -392,6 +421,53 @@ func Test_EthKeyStore_GetRoundRobinAddress(t *testing.T) {
 }
 
 // Does not require Unlock
+func Test_EthKeyStore_SetKeyPolicy(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+	ethKeyStore := cltest.NewKeyStore(t, store.DB).Eth()
+
+	_, address := cltest.MustAddRandomKeyToKeystore(t, ethKeyStore)
+	contract := cltest.NewAddress()
+
+	_, err := ethKeyStore.SetKeyPolicy(cltest.NewAddress(), ethkey.Policy{})
+	require.Error(t, err)
+
+	_, err = ethKeyStore.SetKeyPolicy(address, ethkey.Policy{FundingOnly: true, AllowedJobTypes: []string{"vrf"}})
+	require.Error(t, err)
+
+	key, err := ethKeyStore.SetKeyPolicy(address, ethkey.Policy{AllowedJobTypes: []string{"vrf"}, AllowedToAddresses: []common.Address{contract}})
+	require.NoError(t, err)
+	assert.True(t, key.AllowsJob("vrf", contract))
+	assert.False(t, key.AllowsJob("vrf", cltest.NewAddress()))
+	assert.False(t, key.AllowsJob("fluxmonitor", contract))
+
+	keys, err := ethKeyStore.SendingKeysForJob("vrf", contract)
+	require.NoError(t, err)
+	require.Len(t, keys, 1)
+	keys, err = ethKeyStore.SendingKeysForJob("fluxmonitor", contract)
+	require.NoError(t, err)
+	require.Len(t, keys, 0)
+
+	// The policy is persisted
+	var dbKey ethkey.Key
+	require.NoError(t, store.DB.First(&dbKey, "address = ?", address).Error)
+	assert.Equal(t, key.Policy(), dbKey.Policy())
+
+	// Funding-only keys are not sending keys
+	_, err = ethKeyStore.SetKeyPolicy(address, ethkey.Policy{FundingOnly: true})
+	require.NoError(t, err)
+	keys, err = ethKeyStore.SendingKeys()
+	require.NoError(t, err)
+	require.Len(t, keys, 0)
+	_, err = ethKeyStore.GetRoundRobinAddress()
+	require.Error(t, err)
+	hasSendingKey, err := ethKeyStore.HasSendingKeyWithAddress(address)
+	require.NoError(t, err)
+	assert.False(t, hasSendingKey)
+}
+
 func Test_EthKeyStore_HasDBSendingKeys(t *testing.T) {
 	t.Parallel()
 	store, cleanup := cltest.NewStore(t)
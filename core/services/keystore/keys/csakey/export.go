@@ -0,0 +1,56 @@
+package csakey
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"encoding/json"
+
+	keystore "github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/pkg/errors"
+	"github.com/smartcontractkit/chainlink/core/utils"
+	"github.com/smartcontractkit/chainlink/core/utils/crypto"
+)
+
+// EncryptedCSAKeyExport represents the structure of CSA keys exported and
+// imported to/from the disk
+type EncryptedCSAKeyExport struct {
+	PublicKey crypto.PublicKey    `json:"publicKey"`
+	Crypto    keystore.CryptoJSON `json:"crypto"`
+}
+
+// ToEncryptedExport encrypts the unlocked key with auth for export
+func (k *Key) ToEncryptedExport(auth string, scryptParams utils.ScryptParams) (export []byte, err error) {
+	privkey, err := k.Unsafe_GetPrivateKey()
+	if err != nil {
+		return export, err
+	}
+	cryptoJSON, err := keystore.EncryptDataV3(privkey, []byte(auth), scryptParams.N, scryptParams.P)
+	if err != nil {
+		return export, errors.Wrapf(err, "could not encrypt csa key")
+	}
+
+	encryptedCSAKExport := EncryptedCSAKeyExport{
+		PublicKey: k.PublicKey,
+		Crypto:    cryptoJSON,
+	}
+	return json.Marshal(encryptedCSAKExport)
+}
+
+// DecryptPrivateKey returns the Key in export, decrypted via auth, or an error
+func (export EncryptedCSAKeyExport) DecryptPrivateKey(auth string) (k *Key, err error) {
+	privkey, err := keystore.DecryptDataV3(export.Crypto, auth)
+	if err != nil {
+		return k, errors.Wrapf(err, "could not decrypt key %s", export.PublicKey)
+	}
+	if len(privkey) != ed25519.PrivateKeySize {
+		return k, errors.Errorf("could not decode private key for %s", export.PublicKey)
+	}
+	pubkey := ed25519.PrivateKey(privkey).Public().(ed25519.PublicKey)
+	if !bytes.Equal(export.PublicKey, pubkey) {
+		return k, errors.Errorf("private key does not match public key %s", export.PublicKey)
+	}
+	return &Key{
+		PublicKey:  crypto.PublicKey(pubkey),
+		privateKey: privkey,
+	}, nil
+}
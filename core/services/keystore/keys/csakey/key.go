@@ -2,6 +2,8 @@ package csakey
 
 import (
 	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/base64"
 	"errors"
 	"time"
 
@@ -54,3 +56,10 @@ func (k *Key) Unsafe_GetPrivateKey() ([]byte, error) {
 
 	return k.privateKey, nil
 }
+
+// Fingerprint returns the SHA256 fingerprint of the public key, in the format
+// used by OpenSSH, so that keys can be told apart at a glance
+func (k Key) Fingerprint() string {
+	sum := sha256.Sum256(k.PublicKey)
+	return "SHA256:" + base64.RawStdEncoding.EncodeToString(sum[:])
+}
@@ -2,6 +2,7 @@ package csakey
 
 import (
 	"crypto/ed25519"
+	"encoding/json"
 	"testing"
 
 	"github.com/smartcontractkit/chainlink/core/utils"
@@ -44,3 +45,35 @@ func Test_GetPrivateKey(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, key.privateKey, privkey)
 }
+
+func Test_EncryptedExport(t *testing.T) {
+	passphrase := "passphrase"
+	key, err := New(passphrase, utils.FastScryptParams)
+	require.NoError(t, err)
+
+	exported, err := key.ToEncryptedExport("newpassphrase", utils.FastScryptParams)
+	require.NoError(t, err)
+
+	var export EncryptedCSAKeyExport
+	require.NoError(t, json.Unmarshal(exported, &export))
+	assert.Equal(t, key.PublicKey, export.PublicKey)
+
+	_, err = export.DecryptPrivateKey(passphrase)
+	assert.Error(t, err)
+
+	imported, err := export.DecryptPrivateKey("newpassphrase")
+	require.NoError(t, err)
+	assert.Equal(t, key.PublicKey, imported.PublicKey)
+	assert.Equal(t, key.privateKey, imported.privateKey)
+}
+
+func Test_Fingerprint(t *testing.T) {
+	key, err := New("passphrase", utils.FastScryptParams)
+	require.NoError(t, err)
+	other, err := New("passphrase", utils.FastScryptParams)
+	require.NoError(t, err)
+
+	assert.Regexp(t, `^SHA256:[A-Za-z0-9+/]{43}$`, key.Fingerprint())
+	assert.Equal(t, key.Fingerprint(), key.Fingerprint())
+	assert.NotEqual(t, key.Fingerprint(), other.Fingerprint())
+}
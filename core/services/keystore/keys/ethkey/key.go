@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/lib/pq"
 	"github.com/tidwall/gjson"
 	"go.uber.org/multierr"
 	"gorm.io/datatypes"
@@ -33,6 +34,11 @@ type Key struct {
 	// IsFunding marks the address as being used for rescuing the  node and the pending transactions
 	// Only one key can be IsFunding=true at a time.
 	IsFunding bool
+	// FundingOnly, AllowedJobTypes and AllowedToAddresses are the key's usage
+	// Policy
+	FundingOnly        bool
+	AllowedJobTypes    pq.StringArray `gorm:"type:text[]"`
+	AllowedToAddresses AddressArray   `gorm:"type:bytea[]"`
 }
 
 // Type returns type of key
@@ -0,0 +1,103 @@
+package ethkey
+
+import (
+	"database/sql/driver"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/lib/pq"
+)
+
+// Policy restricts what a key can be used for. The zero value does not
+// restrict the key.
+type Policy struct {
+	// FundingOnly keys are never used by jobs, only to send ETH out of the
+	// node
+	FundingOnly bool `json:"fundingOnly"`
+	// AllowedJobTypes restricts the key to jobs of these types
+	AllowedJobTypes []string `json:"allowedJobTypes"`
+	// AllowedToAddresses restricts the key to transactions to these contracts
+	AllowedToAddresses []common.Address `json:"allowedToAddresses"`
+}
+
+// Policy returns the usage policy of the key
+func (k Key) Policy() Policy {
+	return Policy{
+		FundingOnly:        k.FundingOnly,
+		AllowedJobTypes:    k.AllowedJobTypes,
+		AllowedToAddresses: k.AllowedToAddresses,
+	}
+}
+
+// AllowsJob reports whether the key's policy allows a job of jobType to send
+// transactions to toAddress from it
+func (k Key) AllowsJob(jobType string, toAddress common.Address) bool {
+	return k.Policy().AllowsJob(jobType, toAddress)
+}
+
+// AllowsJob reports whether p allows a job of jobType to send transactions to
+// toAddress. An empty jobType is a transaction which was not sent by a job.
+func (p Policy) AllowsJob(jobType string, toAddress common.Address) bool {
+	if p.FundingOnly {
+		return false
+	}
+	return p.allowsJobType(jobType) && p.AllowsToAddress(toAddress)
+}
+
+func (p Policy) allowsJobType(jobType string) bool {
+	if len(p.AllowedJobTypes) == 0 {
+		return true
+	}
+	for _, allowed := range p.AllowedJobTypes {
+		if allowed == jobType {
+			return true
+		}
+	}
+	return false
+}
+
+// AllowsToAddress reports whether p allows transactions to toAddress
+func (p Policy) AllowsToAddress(toAddress common.Address) bool {
+	if len(p.AllowedToAddresses) == 0 {
+		return true
+	}
+	for _, allowed := range p.AllowedToAddresses {
+		if allowed == toAddress {
+			return true
+		}
+	}
+	return false
+}
+
+// AddressArray is a list of addresses which is stored as a bytea[]. A nil
+// AddressArray is stored as NULL.
+type AddressArray []common.Address
+
+// Value returns this instance serialized for database storage.
+func (a AddressArray) Value() (driver.Value, error) {
+	if a == nil {
+		return nil, nil
+	}
+	arr := make(pq.ByteaArray, len(a))
+	for i, address := range a {
+		arr[i] = address.Bytes()
+	}
+	return arr.Value()
+}
+
+// Scan reads the database value and returns an instance.
+func (a *AddressArray) Scan(value interface{}) error {
+	var arr pq.ByteaArray
+	if err := arr.Scan(value); err != nil {
+		return err
+	}
+	if arr == nil {
+		*a = nil
+		return nil
+	}
+	addresses := make(AddressArray, len(arr))
+	for i, b := range arr {
+		addresses[i] = common.BytesToAddress(b)
+	}
+	*a = addresses
+	return nil
+}
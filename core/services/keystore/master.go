@@ -1,24 +1,60 @@
 package keystore
 
 import (
-	"github.com/smartcontractkit/chainlink/core/utils"
+	"context"
+	"crypto/subtle"
+	"time"
+
+	"github.com/pkg/errors"
 	"gorm.io/gorm"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/postgres"
+	"github.com/smartcontractkit/chainlink/core/utils"
 )
 
+// ErrWrongPassword is returned when changing the keystore password with a
+// password other than the one it was unlocked with
+var ErrWrongPassword = errors.New("old password does not match the keystore password")
+
+// ErrWrongVRFPassword is returned when changing the VRF password with a
+// password other than the one the VRF keys were unlocked with
+var ErrWrongVRFPassword = errors.New("old VRF password does not match the VRF password")
+
+// ErrVRFPasswordRequired is returned when changing the keystore password
+// without the VRF passwords while VRF keys exist, which would otherwise be
+// left encrypted with a password the operator may no longer expect
+var ErrVRFPasswordRequired = errors.New("VRF keys exist, the old and new VRF passwords must be given to change the keystore password")
+
+// VRFPasswords are the current and new passwords of the VRF keys, which are
+// encrypted separately from the rest of the keystore
+type VRFPasswords struct {
+	Old string
+	New string
+}
+
+// changePasswordTimeout bounds the transaction of ChangePassword, which runs
+// scrypt several times for every key
+const changePasswordTimeout = 10 * time.Minute
+
 func New(db *gorm.DB, scryptParams utils.ScryptParams) *Master {
 	return &Master{
-		eth: newEthKeyStore(db, scryptParams),
-		csa: newCSAKeyStore(db, scryptParams),
-		ocr: newOCRKeyStore(db, scryptParams),
-		vrf: newVRFKeyStore(db, scryptParams),
+		db:      db,
+		eth:     newEthKeyStore(db, scryptParams),
+		csa:     newCSAKeyStore(db, scryptParams),
+		ocr:     newOCRKeyStore(db, scryptParams),
+		vrf:     newVRFKeyStore(db, scryptParams),
+		secrets: newSecretsKeyStore(db, scryptParams),
 	}
 }
 
 type Master struct {
-	eth *Eth
-	csa *CSA
-	ocr *OCR
-	vrf *VRF
+	db      *gorm.DB
+	eth     *Eth
+	csa     *CSA
+	ocr     *OCR
+	vrf     *VRF
+	secrets *Secrets
 }
 
 func (m *Master) Eth() *Eth {
@@ -36,3 +72,95 @@ func (m *Master) OCR() *OCR {
 func (m *Master) VRF() *VRF {
 	return m.vrf
 }
+
+func (m *Master) Secrets() *Secrets {
+	return m.secrets
+}
+
+// ChangePassword re-encrypts every key and secret of the keystore, including
+// archived keys, from oldPassword to newPassword in one database transaction.
+// Each re-encrypted key is verified to decrypt with newPassword before it is
+// saved. If any key fails, nothing is changed.
+//
+// VRF keys are encrypted with the separate VRF password, which is changed
+// from vrfPasswords.Old to vrfPasswords.New in the same transaction. If
+// vrfPasswords is nil, the change fails with ErrVRFPasswordRequired when any
+// VRF key exists.
+//
+// The keystore must have been unlocked with oldPassword. The node must be
+// started with newPassword, and the new VRF password, from then on.
+func (m *Master) ChangePassword(ctx context.Context, oldPassword, newPassword string, vrfPasswords *VRFPasswords) error {
+	if newPassword == "" {
+		return errors.New("new password must not be empty")
+	}
+	if vrfPasswords != nil && vrfPasswords.New == "" {
+		return errors.New("new VRF password must not be empty")
+	}
+
+	// No keys may be created or changed under the old password meanwhile
+	m.eth.mu.Lock()
+	defer m.eth.mu.Unlock()
+	m.csa.mu.Lock()
+	defer m.csa.mu.Unlock()
+	m.ocr.mu.Lock()
+	defer m.ocr.mu.Unlock()
+	m.secrets.mu.Lock()
+	defer m.secrets.mu.Unlock()
+	m.vrf.lock.Lock()
+	defer m.vrf.lock.Unlock()
+
+	if m.eth.password == "" {
+		return ErrKeyStoreLocked
+	}
+	if subtle.ConstantTimeCompare([]byte(oldPassword), []byte(m.eth.password)) != 1 {
+		return ErrWrongPassword
+	}
+	if vrfPasswords != nil && m.vrf.password != "" &&
+		subtle.ConstantTimeCompare([]byte(vrfPasswords.Old), []byte(m.vrf.password)) != 1 {
+		return ErrWrongVRFPassword
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, changePasswordTimeout)
+	defer cancel()
+	var applies []func()
+	err := postgres.GormTransaction(ctx, m.db, func(tx *gorm.DB) error {
+		for _, reencrypt := range []func(tx *gorm.DB, oldPassword, newPassword string) (func(), error){
+			m.eth.reencrypt,
+			m.csa.reencrypt,
+			m.ocr.reencrypt,
+			m.secrets.reencrypt,
+		} {
+			apply, err := reencrypt(tx, oldPassword, newPassword)
+			if err != nil {
+				return err
+			}
+			applies = append(applies, apply)
+		}
+
+		if vrfPasswords == nil {
+			keys, err := NewVRFORM(tx).FindEncryptedSecretVRFKeysIncludingArchived()
+			if err != nil {
+				return errors.Wrap(err, "failed to load vrf keys")
+			}
+			if len(keys) > 0 {
+				return ErrVRFPasswordRequired
+			}
+			return nil
+		}
+		apply, err := m.vrf.reencrypt(tx, vrfPasswords.Old, vrfPasswords.New)
+		if err != nil {
+			return err
+		}
+		applies = append(applies, apply)
+		return nil
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to change keystore password, no keys were changed")
+	}
+
+	for _, apply := range applies {
+		apply()
+	}
+	logger.Info("Changed keystore password")
+	return nil
+}
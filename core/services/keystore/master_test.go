@@ -0,0 +1,99 @@
+package keystore_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/services/keystore"
+	"github.com/smartcontractkit/chainlink/core/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Master_ChangePassword(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+	db := store.DB
+	const newPassword = "n3w-p4ssw0rd"
+	const newVRFPassword = "n3w-vrf-p4ssw0rd"
+
+	ks := cltest.NewKeyStore(t, db)
+	require.NoError(t, ks.Eth().Unlock(cltest.Password))
+	require.NoError(t, ks.CSA().Unlock(cltest.Password))
+	require.NoError(t, ks.OCR().Unlock(cltest.Password))
+	_, err := ks.VRF().Unlock(cltest.Password)
+	require.NoError(t, err)
+	require.NoError(t, ks.Secrets().Unlock(cltest.Password))
+
+	ethKey, err := ks.Eth().CreateNewKey()
+	require.NoError(t, err)
+	archivedKey, err := ks.Eth().CreateNewKey()
+	require.NoError(t, err)
+	_, err = ks.Eth().RemoveKey(archivedKey.Address.Address(), false)
+	require.NoError(t, err)
+	_, err = ks.CSA().CreateCSAKey()
+	require.NoError(t, err)
+	_, _, err = ks.OCR().GenerateEncryptedP2PKey()
+	require.NoError(t, err)
+	_, _, err = ks.OCR().GenerateEncryptedOCRKeyBundle()
+	require.NoError(t, err)
+	_, err = ks.VRF().CreateKey()
+	require.NoError(t, err)
+	_, err = ks.Secrets().Set("API_KEY", "hunter2")
+	require.NoError(t, err)
+
+	t.Run("rejects a wrong old password", func(t *testing.T) {
+		err := ks.ChangePassword(context.Background(), "wrong", newPassword, &keystore.VRFPasswords{Old: cltest.Password, New: newVRFPassword})
+		require.ErrorIs(t, err, keystore.ErrWrongPassword)
+	})
+
+	t.Run("rejects a wrong old VRF password", func(t *testing.T) {
+		err := ks.ChangePassword(context.Background(), cltest.Password, newPassword, &keystore.VRFPasswords{Old: "wrong", New: newVRFPassword})
+		require.ErrorIs(t, err, keystore.ErrWrongVRFPassword)
+	})
+
+	t.Run("requires the VRF passwords while VRF keys exist", func(t *testing.T) {
+		err := ks.ChangePassword(context.Background(), cltest.Password, newPassword, nil)
+		require.ErrorIs(t, err, keystore.ErrVRFPasswordRequired)
+		require.NoError(t, cltest.NewKeyStore(t, db).Eth().Unlock(cltest.Password))
+	})
+
+	require.NoError(t, ks.ChangePassword(context.Background(), cltest.Password, newPassword, &keystore.VRFPasswords{Old: cltest.Password, New: newVRFPassword}))
+
+	t.Run("keeps working with the new password", func(t *testing.T) {
+		_, err := ks.Eth().ExportKey(context.Background(), ethKey.Address.Address(), "export", utils.FastScryptParams)
+		require.NoError(t, err)
+		_, err = ks.Eth().CreateNewKey()
+		require.NoError(t, err)
+	})
+
+	t.Run("unlocks with the new password only", func(t *testing.T) {
+		reloaded := cltest.NewKeyStore(t, db)
+		require.Error(t, reloaded.Eth().Unlock(cltest.Password))
+
+		reloaded = cltest.NewKeyStore(t, db)
+		require.NoError(t, reloaded.Eth().Unlock(newPassword))
+		require.NoError(t, reloaded.CSA().Unlock(newPassword))
+		require.NoError(t, reloaded.OCR().Unlock(newPassword))
+		require.NoError(t, reloaded.Secrets().Unlock(newPassword))
+
+		value, err := reloaded.Secrets().Get("API_KEY")
+		require.NoError(t, err)
+		assert.Equal(t, "hunter2", value)
+		assert.Len(t, reloaded.OCR().DecryptedP2PKeys(), 1)
+	})
+
+	t.Run("re-encrypts VRF keys with the new VRF password", func(t *testing.T) {
+		reloaded := cltest.NewKeyStore(t, db)
+		_, err := reloaded.VRF().Unlock(cltest.Password)
+		require.Error(t, err)
+
+		reloaded = cltest.NewKeyStore(t, db)
+		keys, err := reloaded.VRF().Unlock(newVRFPassword)
+		require.NoError(t, err)
+		assert.Len(t, keys, 1)
+	})
+}
@@ -3,6 +3,8 @@
 package mocks
 
 import (
+	context "context"
+
 	csakey "github.com/smartcontractkit/chainlink/core/services/keystore/keys/csakey"
 	crypto "github.com/smartcontractkit/chainlink/core/utils/crypto"
 
@@ -60,13 +62,13 @@ func (_m *CSAKeystoreInterface) ListCSAKeys() ([]csakey.Key, error) {
 	return r0, r1
 }
 
-// Unsafe_GetUnlockedPrivateKey provides a mock function with given fields: pubkey
-func (_m *CSAKeystoreInterface) Unsafe_GetUnlockedPrivateKey(pubkey crypto.PublicKey) ([]byte, error) {
-	ret := _m.Called(pubkey)
+// Unsafe_GetUnlockedPrivateKey provides a mock function with given fields: ctx, pubkey
+func (_m *CSAKeystoreInterface) Unsafe_GetUnlockedPrivateKey(ctx context.Context, pubkey crypto.PublicKey) ([]byte, error) {
+	ret := _m.Called(ctx, pubkey)
 
 	var r0 []byte
-	if rf, ok := ret.Get(0).(func(crypto.PublicKey) []byte); ok {
-		r0 = rf(pubkey)
+	if rf, ok := ret.Get(0).(func(context.Context, crypto.PublicKey) []byte); ok {
+		r0 = rf(ctx, pubkey)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]byte)
@@ -74,8 +76,8 @@ func (_m *CSAKeystoreInterface) Unsafe_GetUnlockedPrivateKey(pubkey crypto.Publi
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(crypto.PublicKey) error); ok {
-		r1 = rf(pubkey)
+	if rf, ok := ret.Get(1).(func(context.Context, crypto.PublicKey) error); ok {
+		r1 = rf(ctx, pubkey)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -3,6 +3,8 @@
 package mocks
 
 import (
+	context "context"
+
 	big "math/big"
 
 	common "github.com/ethereum/go-ethereum/common"
@@ -11,6 +13,8 @@ import (
 	mock "github.com/stretchr/testify/mock"
 
 	types "github.com/ethereum/go-ethereum/core/types"
+
+	utils "github.com/smartcontractkit/chainlink/core/utils"
 )
 
 // EthKeyStoreInterface is an autogenerated mock type for the EthKeyStoreInterface type
@@ -104,13 +108,13 @@ func (_m *EthKeyStoreInterface) EnsureFundingKey() (ethkey.Key, bool, error) {
 	return r0, r1, r2
 }
 
-// ExportKey provides a mock function with given fields: address, newPassword
-func (_m *EthKeyStoreInterface) ExportKey(address common.Address, newPassword string) ([]byte, error) {
-	ret := _m.Called(address, newPassword)
+// ExportKey provides a mock function with given fields: ctx, address, newPassword, scryptParams
+func (_m *EthKeyStoreInterface) ExportKey(ctx context.Context, address common.Address, newPassword string, scryptParams utils.ScryptParams) ([]byte, error) {
+	ret := _m.Called(ctx, address, newPassword, scryptParams)
 
 	var r0 []byte
-	if rf, ok := ret.Get(0).(func(common.Address, string) []byte); ok {
-		r0 = rf(address, newPassword)
+	if rf, ok := ret.Get(0).(func(context.Context, common.Address, string, utils.ScryptParams) []byte); ok {
+		r0 = rf(ctx, address, newPassword, scryptParams)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]byte)
@@ -118,8 +122,8 @@ func (_m *EthKeyStoreInterface) ExportKey(address common.Address, newPassword st
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(common.Address, string) error); ok {
-		r1 = rf(address, newPassword)
+	if rf, ok := ret.Get(1).(func(context.Context, common.Address, string, utils.ScryptParams) error); ok {
+		r1 = rf(ctx, address, newPassword, scryptParams)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -328,13 +332,80 @@ func (_m *EthKeyStoreInterface) SendingKeys() ([]ethkey.Key, error) {
 	return r0, r1
 }
 
-// SignTx provides a mock function with given fields: fromAddress, tx, chainID
-func (_m *EthKeyStoreInterface) SignTx(fromAddress common.Address, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error) {
-	ret := _m.Called(fromAddress, tx, chainID)
+// SendingKeysForJob provides a mock function with given fields: jobType, toAddress
+func (_m *EthKeyStoreInterface) SendingKeysForJob(jobType string, toAddress common.Address) ([]ethkey.Key, error) {
+	ret := _m.Called(jobType, toAddress)
+
+	var r0 []ethkey.Key
+	if rf, ok := ret.Get(0).(func(string, common.Address) []ethkey.Key); ok {
+		r0 = rf(jobType, toAddress)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]ethkey.Key)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, common.Address) error); ok {
+		r1 = rf(jobType, toAddress)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SetKeyPolicy provides a mock function with given fields: address, policy
+func (_m *EthKeyStoreInterface) SetKeyPolicy(address common.Address, policy ethkey.Policy) (ethkey.Key, error) {
+	ret := _m.Called(address, policy)
+
+	var r0 ethkey.Key
+	if rf, ok := ret.Get(0).(func(common.Address, ethkey.Policy) ethkey.Key); ok {
+		r0 = rf(address, policy)
+	} else {
+		r0 = ret.Get(0).(ethkey.Key)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(common.Address, ethkey.Policy) error); ok {
+		r1 = rf(address, policy)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SignMessage provides a mock function with given fields: ctx, address, msg
+func (_m *EthKeyStoreInterface) SignMessage(ctx context.Context, address common.Address, msg []byte) ([]byte, error) {
+	ret := _m.Called(ctx, address, msg)
+
+	var r0 []byte
+	if rf, ok := ret.Get(0).(func(context.Context, common.Address, []byte) []byte); ok {
+		r0 = rf(ctx, address, msg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]byte)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, common.Address, []byte) error); ok {
+		r1 = rf(ctx, address, msg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SignTx provides a mock function with given fields: ctx, fromAddress, tx, chainID
+func (_m *EthKeyStoreInterface) SignTx(ctx context.Context, fromAddress common.Address, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error) {
+	ret := _m.Called(ctx, fromAddress, tx, chainID)
 
 	var r0 *types.Transaction
-	if rf, ok := ret.Get(0).(func(common.Address, *types.Transaction, *big.Int) *types.Transaction); ok {
-		r0 = rf(fromAddress, tx, chainID)
+	if rf, ok := ret.Get(0).(func(context.Context, common.Address, *types.Transaction, *big.Int) *types.Transaction); ok {
+		r0 = rf(ctx, fromAddress, tx, chainID)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*types.Transaction)
@@ -342,8 +413,8 @@ func (_m *EthKeyStoreInterface) SignTx(fromAddress common.Address, tx *types.Tra
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(common.Address, *types.Transaction, *big.Int) error); ok {
-		r1 = rf(fromAddress, tx, chainID)
+	if rf, ok := ret.Get(1).(func(context.Context, common.Address, *types.Transaction, *big.Int) error); ok {
+		r1 = rf(ctx, fromAddress, tx, chainID)
 	} else {
 		r1 = ret.Error(1)
 	}
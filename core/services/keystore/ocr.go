@@ -1,6 +1,7 @@
 package keystore
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"sync"
@@ -27,6 +28,7 @@ type OCR struct {
 	ocrkeys      map[models.Sha256Hash]ocrkey.KeyBundle
 	scryptParams utils.ScryptParams
 	mu           *sync.RWMutex
+	audit        auditLog
 }
 
 func newOCRKeyStore(db *gorm.DB, scryptParams utils.ScryptParams) *OCR {
@@ -36,6 +38,7 @@ func newOCRKeyStore(db *gorm.DB, scryptParams utils.ScryptParams) *OCR {
 		ocrkeys:      make(map[models.Sha256Hash]ocrkey.KeyBundle),
 		scryptParams: scryptParams,
 		mu:           new(sync.RWMutex),
+		audit:        auditLog{db},
 	}
 }
 
@@ -101,6 +104,29 @@ func (ks OCR) DecryptedOCRKey(hash models.Sha256Hash) (ocrkey.KeyBundle, bool) {
 	return k, exists
 }
 
+// OffChainSigningDomain is prepended to every payload signed by SignOffChain.
+//
+// OCR signs its own messages with the same off-chain key, and each of those
+// starts with the 32 byte DomainSeparationTag of libocr, whose first 11 bytes
+// are zero. Since the domain starts with 0x19, no signed payload can be
+// passed off as an OCR message.
+const OffChainSigningDomain = "\x19Chainlink Signed Off-chain Payload:\n"
+
+// SignOffChain signs payload with the off-chain key of the OCR key bundle
+// with id. The Ed25519 signature is made over OffChainSigningDomain followed
+// by payload, and is recorded in the audit log as made by the requester of
+// ctx.
+func (ks OCR) SignOffChain(ctx context.Context, id models.Sha256Hash, payload []byte) ([]byte, error) {
+	key, exists := ks.DecryptedOCRKey(id)
+	if !exists {
+		return nil, errors.Errorf("no OCR key bundle with ID %s", id)
+	}
+	if err := ks.audit.record(ctx, KeyTypeOCR, id.String(), KeyOperationSignOffChain); err != nil {
+		return nil, err
+	}
+	return key.SignOffChain(append([]byte(OffChainSigningDomain), payload...))
+}
+
 func (ks OCR) GenerateEncryptedP2PKey() (p2pkey.Key, p2pkey.EncryptedP2PKey, error) {
 	key, err := p2pkey.CreateKey()
 	if err != nil {
@@ -278,8 +304,9 @@ func (ks OCR) ImportP2PKey(keyJSON []byte, oldPassword string) (*p2pkey.Encrypte
 	return &encryptedKey, nil
 }
 
-// ExportP2PKey exports a p2p key from the database
-func (ks OCR) ExportP2PKey(ID int32, newPassword string) ([]byte, error) {
+// ExportP2PKey exports a p2p key from the database, encrypted with
+// newPassword using scryptParams. The export is recorded in the audit log.
+func (ks OCR) ExportP2PKey(ctx context.Context, ID int32, newPassword string, scryptParams utils.ScryptParams) ([]byte, error) {
 	ks.mu.Lock()
 	defer ks.mu.Unlock()
 
@@ -288,11 +315,14 @@ func (ks OCR) ExportP2PKey(ID int32, newPassword string) ([]byte, error) {
 	if err != nil {
 		return emptyExport, errors.Wrap(err, "unable to find p2p key with given ID")
 	}
+	if err = ks.audit.record(ctx, KeyTypeP2P, encryptedP2PKey.PeerID.String(), KeyOperationExport); err != nil {
+		return emptyExport, err
+	}
 	decryptedP2PKey, err := encryptedP2PKey.Decrypt(ks.password)
 	if err != nil {
 		return emptyExport, errors.Wrap(err, "unable to decrypt p2p key with given keystore password")
 	}
-	encryptedExport, err := decryptedP2PKey.ToEncryptedExport(newPassword, utils.DefaultScryptParams)
+	encryptedExport, err := decryptedP2PKey.ToEncryptedExport(newPassword, scryptParams)
 	if err != nil {
 		return emptyExport, errors.Wrap(err, "unable to encrypt p2p key for export with provided password")
 	}
@@ -327,8 +357,10 @@ func (ks OCR) ImportOCRKeyBundle(keyJSON []byte, oldPassword string) (*ocrkey.En
 	return encryptedKey, nil
 }
 
-// ExportOCRKeyBundle exports an OCR key bundle from the database
-func (ks OCR) ExportOCRKeyBundle(id models.Sha256Hash, newPassword string) ([]byte, error) {
+// ExportOCRKeyBundle exports an OCR key bundle from the database, encrypted
+// with newPassword using scryptParams. The export is recorded in the audit
+// log.
+func (ks OCR) ExportOCRKeyBundle(ctx context.Context, id models.Sha256Hash, newPassword string, scryptParams utils.ScryptParams) ([]byte, error) {
 	ks.mu.Lock()
 	defer ks.mu.Unlock()
 
@@ -337,14 +369,69 @@ func (ks OCR) ExportOCRKeyBundle(id models.Sha256Hash, newPassword string) ([]by
 	if err != nil {
 		return emptyExport, errors.Wrap(err, "unable to find OCR key with given ID")
 	}
+	if err = ks.audit.record(ctx, KeyTypeOCR, id.String(), KeyOperationExport); err != nil {
+		return emptyExport, err
+	}
 	decryptedP2PKey, err := encryptedP2PKey.Decrypt(ks.password)
 	if err != nil {
 		return emptyExport, errors.Wrap(err, "unable to decrypt p2p key with given keystore password")
 	}
-	encryptedExport, err := decryptedP2PKey.ToEncryptedExport(newPassword, utils.DefaultScryptParams)
+	encryptedExport, err := decryptedP2PKey.ToEncryptedExport(newPassword, scryptParams)
 	if err != nil {
 		return emptyExport, errors.Wrap(err, "unable to encrypt p2p key for export with provided password")
 	}
 
 	return encryptedExport, nil
 }
+
+// reencrypt re-encrypts all P2P keys and OCR key bundles, including archived
+// ones, from oldPassword to newPassword via tx. apply updates the keystore
+// once tx has been committed. Caller must hold ks.mu.
+func (ks *OCR) reencrypt(tx *gorm.DB, oldPassword, newPassword string) (apply func(), err error) {
+	var p2pkeys []p2pkey.EncryptedP2PKey
+	if err = tx.Unscoped().Order("id ASC").Find(&p2pkeys).Error; err != nil {
+		return nil, errors.Wrap(err, "failed to load p2p keys")
+	}
+	for _, ek := range p2pkeys {
+		k, err := ek.Decrypt(oldPassword)
+		if err != nil {
+			return nil, err
+		}
+		enc, err := k.ToEncryptedP2PKey(newPassword, ks.scryptParams)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to encrypt p2p key %s", ek.PeerID)
+		}
+		if verified, err := enc.Decrypt(newPassword); err != nil || verified.MustGetPeerID() != ek.PeerID {
+			return nil, errors.Errorf("failed to verify re-encrypted p2p key %s", ek.PeerID)
+		}
+		if err = tx.Exec(`UPDATE encrypted_p2p_keys SET encrypted_priv_key = ?, updated_at = NOW() WHERE id = ?`, enc.EncryptedPrivKey, ek.ID).Error; err != nil {
+			return nil, errors.Wrapf(err, "failed to update p2p key %s", ek.PeerID)
+		}
+	}
+
+	var ocrkeys []ocrkey.EncryptedKeyBundle
+	if err = tx.Unscoped().Order("created_at ASC, id ASC").Find(&ocrkeys).Error; err != nil {
+		return nil, errors.Wrap(err, "failed to load ocr key bundles")
+	}
+	for i := range ocrkeys {
+		ek := &ocrkeys[i]
+		k, err := ek.Decrypt(oldPassword)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to decrypt ocr key bundle %s", ek.ID)
+		}
+		enc, err := k.Encrypt(newPassword, ks.scryptParams)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to encrypt ocr key bundle %s", ek.ID)
+		}
+		if verified, err := enc.Decrypt(newPassword); err != nil || verified.ID != ek.ID {
+			return nil, errors.Errorf("failed to verify re-encrypted ocr key bundle %s", ek.ID)
+		}
+		if err = tx.Exec(`UPDATE encrypted_ocr_key_bundles SET encrypted_private_keys = ?, updated_at = NOW() WHERE id = ?`, enc.EncryptedPrivateKeys, ek.ID).Error; err != nil {
+			return nil, errors.Wrapf(err, "failed to update ocr key bundle %s", ek.ID)
+		}
+	}
+
+	return func() {
+		ks.password = newPassword
+	}, nil
+}
@@ -0,0 +1,51 @@
+package keystore_test
+
+import (
+	"context"
+	"crypto/ed25519"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/services/keystore"
+)
+
+func Test_OCRKeyStore_SignOffChain(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+	ks := cltest.NewKeyStore(t, store.DB).OCR()
+	require.NoError(t, ks.Unlock(cltest.Password))
+	key, _, err := ks.GenerateEncryptedOCRKeyBundle()
+	require.NoError(t, err)
+	publicKey := ed25519.PublicKey(key.PublicKeyOffChain())
+
+	t.Run("signs the payload prefixed with the signing domain", func(t *testing.T) {
+		payload := []byte("hello world")
+		signature, err := ks.SignOffChain(context.Background(), key.ID, payload)
+		require.NoError(t, err)
+
+		assert.True(t, ed25519.Verify(publicKey, append([]byte(keystore.OffChainSigningDomain), payload...), signature))
+		assert.False(t, ed25519.Verify(publicKey, payload, signature))
+	})
+
+	t.Run("cannot sign an OCR message", func(t *testing.T) {
+		// An OCR observation is signed over its DomainSeparationTag, 11 zero
+		// bytes followed by the config digest, epoch and round, and the
+		// observation itself
+		message := make([]byte, 32, 64)
+		copy(message[11:], "config digest...")
+		message = append(message, []byte("observation")...)
+
+		signature, err := ks.SignOffChain(context.Background(), key.ID, message)
+		require.NoError(t, err)
+		assert.False(t, ed25519.Verify(publicKey, message, signature))
+
+		// Whatever the payload, the signed bytes start with the domain, never
+		// with the zero padding of an OCR message
+		assert.NotZero(t, keystore.OffChainSigningDomain[0])
+	})
+}
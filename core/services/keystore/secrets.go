@@ -0,0 +1,157 @@
+package keystore
+
+import (
+	"bytes"
+	"regexp"
+	"sync"
+
+	"github.com/pkg/errors"
+	"github.com/smartcontractkit/chainlink/core/utils"
+	"github.com/smartcontractkit/chainlink/core/utils/crypto"
+	"gorm.io/gorm"
+)
+
+var (
+	ErrSecretNotFound    = errors.New("secret not found")
+	ErrSecretsLocked     = errors.New("secrets keystore is locked")
+	ErrInvalidSecretName = errors.New("secret names must start with a letter or underscore, and contain only letters, digits and underscores")
+
+	secretNameRegexp = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+)
+
+// Secrets stores values such as API keys encrypted with the keystore
+// password, so that they can be referenced from job specs rather than
+// written in them. Values are decrypted into memory when the keystore is
+// unlocked.
+type Secrets struct {
+	mu           *sync.RWMutex
+	orm          secretsORM
+	password     string
+	values       map[string]string
+	scryptParams utils.ScryptParams
+}
+
+func newSecretsKeyStore(db *gorm.DB, scryptParams utils.ScryptParams) *Secrets {
+	return &Secrets{
+		mu:           new(sync.RWMutex),
+		orm:          newSecretsORM(db),
+		values:       make(map[string]string),
+		scryptParams: scryptParams,
+	}
+}
+
+// Unlock decrypts all secrets with password, which is then used to encrypt
+// new secrets
+func (ks *Secrets) Unlock(password string) error {
+	ks.mu.Lock()
+	defer ks.mu.Unlock()
+
+	secrets, err := ks.orm.ListSecrets()
+	if err != nil {
+		return errors.Wrap(err, "failed to list secrets")
+	}
+	values := make(map[string]string, len(secrets))
+	for _, secret := range secrets {
+		value, err := secret.EncryptedValue.Decrypt(password)
+		if err != nil {
+			return errors.Wrapf(err, "failed to decrypt secret %s", secret.Name)
+		}
+		values[secret.Name] = string(value)
+	}
+
+	ks.values = values
+	ks.password = password
+	return nil
+}
+
+// Set encrypts and stores the secret, replacing any existing value
+func (ks *Secrets) Set(name, value string) (Secret, error) {
+	if !secretNameRegexp.MatchString(name) {
+		return Secret{}, ErrInvalidSecretName
+	}
+
+	ks.mu.Lock()
+	defer ks.mu.Unlock()
+	if ks.password == "" {
+		return Secret{}, ErrSecretsLocked
+	}
+
+	encrypted, err := crypto.NewEncryptedPrivateKey([]byte(value), ks.password, ks.scryptParams)
+	if err != nil {
+		return Secret{}, errors.Wrapf(err, "failed to encrypt secret %s", name)
+	}
+	secret := Secret{Name: name, EncryptedValue: *encrypted}
+	if err = ks.orm.UpsertSecret(&secret); err != nil {
+		return Secret{}, errors.Wrapf(err, "failed to save secret %s", name)
+	}
+
+	ks.values[name] = value
+	return secret, nil
+}
+
+// Get returns the decrypted value of the secret
+func (ks *Secrets) Get(name string) (string, error) {
+	ks.mu.RLock()
+	defer ks.mu.RUnlock()
+	if ks.password == "" {
+		return "", ErrSecretsLocked
+	}
+
+	value, exists := ks.values[name]
+	if !exists {
+		return "", errors.Wrapf(ErrSecretNotFound, "%s", name)
+	}
+	return value, nil
+}
+
+// List returns all secrets, ordered by name. Values are only available
+// encrypted.
+func (ks *Secrets) List() ([]Secret, error) {
+	return ks.orm.ListSecrets()
+}
+
+// Delete removes the secret
+func (ks *Secrets) Delete(name string) error {
+	ks.mu.Lock()
+	defer ks.mu.Unlock()
+
+	deleted, err := ks.orm.DeleteSecret(name)
+	if err != nil {
+		return errors.Wrapf(err, "failed to delete secret %s", name)
+	} else if !deleted {
+		return errors.Wrapf(ErrSecretNotFound, "%s", name)
+	}
+
+	delete(ks.values, name)
+	return nil
+}
+
+// reencrypt re-encrypts all secrets from oldPassword to newPassword via tx.
+// apply updates the keystore once tx has been committed. Caller must hold
+// ks.mu.
+func (ks *Secrets) reencrypt(tx *gorm.DB, oldPassword, newPassword string) (apply func(), err error) {
+	secrets, err := newSecretsORM(tx).ListSecrets()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list secrets")
+	}
+	for _, secret := range secrets {
+		value, err := secret.EncryptedValue.Decrypt(oldPassword)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to decrypt secret %s", secret.Name)
+		}
+		encrypted, err := crypto.NewEncryptedPrivateKey(value, newPassword, ks.scryptParams)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to encrypt secret %s", secret.Name)
+		}
+		if verified, err := encrypted.Decrypt(newPassword); err != nil || !bytes.Equal(verified, value) {
+			return nil, errors.Errorf("failed to verify re-encrypted secret %s", secret.Name)
+		}
+		if err = tx.Exec(`UPDATE secrets SET encrypted_value = ?, updated_at = NOW() WHERE id = ?`, encrypted, secret.ID).Error; err != nil {
+			return nil, errors.Wrapf(err, "failed to update secret %s", secret.Name)
+		}
+	}
+
+	return func() {
+		ks.password = newPassword
+	}, nil
+}
@@ -0,0 +1,56 @@
+package keystore
+
+import (
+	"time"
+
+	"github.com/smartcontractkit/chainlink/core/utils/crypto"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// Secret is a named value, such as an API key, encrypted with the keystore
+// password
+type Secret struct {
+	ID             int64
+	Name           string
+	EncryptedValue crypto.EncryptedPrivateKey
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+func (Secret) TableName() string {
+	return "secrets"
+}
+
+type secretsORM struct {
+	db *gorm.DB
+}
+
+func newSecretsORM(db *gorm.DB) secretsORM {
+	return secretsORM{db}
+}
+
+// UpsertSecret creates the secret, or replaces the value of the secret with
+// the same name
+func (o secretsORM) UpsertSecret(secret *Secret) error {
+	now := time.Now()
+	secret.CreatedAt = now
+	secret.UpdatedAt = now
+	return o.db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "name"}},
+		DoUpdates: clause.AssignmentColumns([]string{"encrypted_value", "updated_at"}),
+	}).Create(secret).Error
+}
+
+// ListSecrets lists all secrets, ordered by name
+func (o secretsORM) ListSecrets() ([]Secret, error) {
+	var secrets []Secret
+	err := o.db.Order("name ASC").Find(&secrets).Error
+	return secrets, err
+}
+
+// DeleteSecret deletes the secret, returning false if it does not exist
+func (o secretsORM) DeleteSecret(name string) (bool, error) {
+	result := o.db.Where("name = ?", name).Delete(&Secret{})
+	return result.RowsAffected > 0, result.Error
+}
@@ -0,0 +1,66 @@
+package keystore_test
+
+import (
+	"testing"
+
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/services/keystore"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_SecretsKeyStore(t *testing.T) {
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+	ks := cltest.NewKeyStore(t, store.DB).Secrets()
+
+	t.Run("it won't set secrets while locked", func(t *testing.T) {
+		_, err := ks.Set("API_KEY", "hunter2")
+		require.ErrorIs(t, err, keystore.ErrSecretsLocked)
+	})
+
+	require.NoError(t, ks.Unlock(cltest.Password))
+
+	t.Run("it rejects invalid names", func(t *testing.T) {
+		_, err := ks.Set("api-key", "hunter2")
+		require.ErrorIs(t, err, keystore.ErrInvalidSecretName)
+	})
+
+	t.Run("it can set and get secrets", func(t *testing.T) {
+		secret, err := ks.Set("API_KEY", "hunter2")
+		require.NoError(t, err)
+		assert.Equal(t, "API_KEY", secret.Name)
+		assert.NotEmpty(t, secret.EncryptedValue.CipherText)
+
+		value, err := ks.Get("API_KEY")
+		require.NoError(t, err)
+		assert.Equal(t, "hunter2", value)
+
+		_, err = ks.Set("API_KEY", "correct horse")
+		require.NoError(t, err)
+		value, err = ks.Get("API_KEY")
+		require.NoError(t, err)
+		assert.Equal(t, "correct horse", value)
+
+		secrets, err := ks.List()
+		require.NoError(t, err)
+		require.Len(t, secrets, 1)
+	})
+
+	t.Run("it decrypts stored secrets when unlocked", func(t *testing.T) {
+		reopened := cltest.NewKeyStore(t, store.DB).Secrets()
+		require.Error(t, reopened.Unlock("wrong password"))
+		require.NoError(t, reopened.Unlock(cltest.Password))
+
+		value, err := reopened.Get("API_KEY")
+		require.NoError(t, err)
+		assert.Equal(t, "correct horse", value)
+	})
+
+	t.Run("it can delete secrets", func(t *testing.T) {
+		require.NoError(t, ks.Delete("API_KEY"))
+		_, err := ks.Get("API_KEY")
+		require.ErrorIs(t, err, keystore.ErrSecretNotFound)
+		require.ErrorIs(t, ks.Delete("API_KEY"), keystore.ErrSecretNotFound)
+	})
+}
@@ -1,6 +1,7 @@
 package keystore
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"math/big"
@@ -35,6 +36,7 @@ type VRF struct {
 	lock         sync.RWMutex
 	keys         InMemoryKeyStore
 	orm          VRFORM
+	audit        auditLog
 	scryptParams utils.ScryptParams
 	// We store this upon first unlock to allow us
 	// to create additional VRF keys via the remote CLI.
@@ -49,6 +51,7 @@ func newVRFKeyStore(db *gorm.DB, sp utils.ScryptParams) *VRF {
 		lock:         sync.RWMutex{},
 		keys:         make(InMemoryKeyStore),
 		orm:          NewVRFORM(db),
+		audit:        auditLog{db},
 		scryptParams: sp,
 	}
 }
@@ -57,8 +60,9 @@ func newVRFKeyStore(db *gorm.DB, sp utils.ScryptParams) *VRF {
 // computed from the SeedData
 //
 // Key must have already been unlocked in ks, as constructing the VRF proof
-// requires the secret key.
-func (ks *VRF) GenerateProof(k secp256k1.PublicKey, seed *big.Int) (
+// requires the secret key. The proof is recorded in the audit log as made by
+// the requester of ctx.
+func (ks *VRF) GenerateProof(ctx context.Context, k secp256k1.PublicKey, seed *big.Int) (
 	vrfkey.Proof, error) {
 	ks.lock.RLock()
 	defer ks.lock.RUnlock()
@@ -67,6 +71,9 @@ func (ks *VRF) GenerateProof(k secp256k1.PublicKey, seed *big.Int) (
 		return vrfkey.Proof{}, fmt.Errorf(
 			"key %s has not been unlocked", k)
 	}
+	if err := ks.audit.record(ctx, KeyTypeVRF, k.String(), KeyOperationVRFProof); err != nil {
+		return vrfkey.Proof{}, err
+	}
 	return privateKey.GenerateProof(seed)
 }
 
@@ -234,16 +241,21 @@ func (ks *VRF) Import(keyjson []byte, auth string) (vrfkey.EncryptedVRFKey, erro
 	return enckey, nil
 }
 
-func (ks *VRF) Export(pk secp256k1.PublicKey, newPassword string) ([]byte, error) {
+// Export exports the key with public key pk, encrypted with newPassword
+// using scryptParams. The export is recorded in the audit log.
+func (ks *VRF) Export(ctx context.Context, pk secp256k1.PublicKey, newPassword string, scryptParams utils.ScryptParams) ([]byte, error) {
 	keys, err := ks.get(pk)
 	if err != nil {
 		return nil, err
 	}
+	if err = ks.audit.record(ctx, KeyTypeVRF, pk.String(), KeyOperationExport); err != nil {
+		return nil, err
+	}
 	privateKey, err := vrfkey.Decrypt(keys[0], ks.password)
 	if err != nil {
 		return nil, err
 	}
-	encKey, err := privateKey.Encrypt(newPassword, ks.scryptParams)
+	encKey, err := privateKey.Encrypt(newPassword, scryptParams)
 	if err != nil {
 		return nil, err
 	}
@@ -320,3 +332,31 @@ func (ks *VRF) ListKeysIncludingArchived() (publicKeys []*secp256k1.PublicKey, e
 	}
 	return publicKeys, nil
 }
+
+// reencrypt re-encrypts all keys, including archived ones, from oldPassword
+// to newPassword via tx. apply updates the keystore once tx has been
+// committed. Caller must hold ks.lock.
+func (ks *VRF) reencrypt(tx *gorm.DB, oldPassword, newPassword string) (apply func(), err error) {
+	keys, err := NewVRFORM(tx).FindEncryptedSecretVRFKeysIncludingArchived()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to load vrf keys")
+	}
+	for _, ek := range keys {
+		k, err := vrfkey.Decrypt(ek, oldPassword)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to decrypt vrf key %s", ek.PublicKey)
+		}
+		// Encrypt verifies that the encrypted key decrypts to k
+		enc, err := k.Encrypt(newPassword, ks.scryptParams)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to encrypt vrf key %s", ek.PublicKey)
+		}
+		if err = tx.Exec(`UPDATE encrypted_vrf_keys SET vrf_key = ?, updated_at = NOW() WHERE public_key = ?`, enc.VRFKey, ek.PublicKey).Error; err != nil {
+			return nil, errors.Wrapf(err, "failed to update vrf key %s", ek.PublicKey)
+		}
+	}
+
+	return func() {
+		ks.password = newPassword
+	}, nil
+}
@@ -2,6 +2,7 @@ package keystore_test
 
 import (
 	"bytes"
+	"context"
 	"math/big"
 	"testing"
 
@@ -80,12 +81,12 @@ func TestKeyStoreEndToEnd(t *testing.T) {
 	preSeed := big.NewInt(10)
 	seed := proof2.TestXXXSeedData(t, preSeed, blockHash, blockNum)
 
-	proof, err := proof2.GenerateProofResponse(ks, key, seed)
+	proof, err := proof2.GenerateProofResponse(context.Background(), ks, key, seed)
 	require.NoError(t, err, "failed to generate proof response")
 
 	// ...but only for unlocked keys
 	randomKey := vrfkey.CreateKey()
-	_, err = proof2.GenerateProofResponse(ks, randomKey.PublicKey, seed)
+	_, err = proof2.GenerateProofResponse(context.Background(), ks, randomKey.PublicKey, seed)
 	require.Error(t, err, "should not be able to generate VRF proofs unless key has been unlocked")
 	require.Contains(t, err.Error(), "has not been unlocked", "complaint when attempting to generate VRF proof with unclocked key should be that it's locked")
 
@@ -109,7 +110,7 @@ func TestKeyStoreEndToEnd(t *testing.T) {
 	err = ks.Delete(key)
 	require.NoError(t, err, "failed to delete VRF key")
 
-	_, err = proof2.GenerateProofResponse(ks, key, seed)
+	_, err = proof2.GenerateProofResponse(context.Background(), ks, key, seed)
 	require.Error(t, err, "should not be able to generate VRF proofs with a deleted key")
 	require.Contains(t, err.Error(), "has not been unlocked", "complaint when trying to prove with deleted key should be that it's locked")
 
@@ -126,6 +127,6 @@ func TestKeyStoreEndToEnd(t *testing.T) {
 	_, err = ks.Import(keyjson, phrase)
 	require.Equal(t, keystore.ErrMatchingVRFKey, err, "should be prevented from importing a key with a public key already present in the DB")
 
-	_, err = proof2.GenerateProofResponse(ks, key, seed)
+	_, err = proof2.GenerateProofResponse(context.Background(), ks, key, seed)
 	require.NoError(t, err, "should be able to generate proof with unlocked key")
 }
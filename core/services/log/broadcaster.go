@@ -44,10 +44,14 @@ type (
 		utils.DependentAwaiter
 		service.Service
 		httypes.HeadTrackable
+		httypes.FinalizedHeadTrackable
 		ReplayFromBlock(number int64)
 
 		IsConnected() bool
 		Register(listener Listener, opts ListenerOpts) (unsubscribe func())
+		// OnReconnect calls fn each time the broadcaster has reconnected to
+		// the eth node after losing its subscription. fn must not block.
+		OnReconnect(fn func()) (unsubscribe func())
 
 		WasAlreadyConsumed(db *gorm.DB, lb Broadcast) (bool, error)
 		MarkConsumed(db *gorm.DB, lb Broadcast) error
@@ -85,6 +89,13 @@ type (
 		replayChannel         chan int64
 		highestSavedHead      *models.Head
 		lastSeenHeadNumber    int64
+		// finalizedHeadNumber is the highest finalized block reported by the
+		// head tracker, or 0 if unknown
+		finalizedHeadNumber int64
+
+		reconnectCallbacksMu sync.RWMutex
+		nextReconnectID      int64
+		reconnectCallbacks   map[int64]func()
 	}
 
 	Config interface {
@@ -138,6 +149,8 @@ func NewBroadcaster(orm ORM, ethClient eth.Client, config Config, highestSavedHe
 		chStop:           chStop,
 		highestSavedHead: highestSavedHead,
 		replayChannel:    make(chan int64, 1),
+
+		reconnectCallbacks: make(map[int64]func()),
 	}
 }
 
@@ -220,6 +233,13 @@ func (b *broadcaster) OnNewLongestChain(ctx context.Context, head models.Head) {
 	}
 }
 
+// OnNewFinalizedHead conforms to FinalizedHeadTrackable. Once a finalized head
+// is known, logs are pruned from the pool as soon as they are finalized (and
+// sent to all subscribers) rather than after ETH_FINALITY_DEPTH blocks.
+func (b *broadcaster) OnNewFinalizedHead(ctx context.Context, head models.Head) {
+	atomic.StoreInt64(&b.finalizedHeadNumber, head.Number)
+}
+
 func (b *broadcaster) IsConnected() bool {
 	return b.connected.IsSet()
 }
@@ -238,6 +258,7 @@ func (b *broadcaster) startResubscribeLoop() {
 	defer func() { subscription.Unsubscribe() }()
 
 	var chRawLogs chan types.Log
+	var reconnecting bool
 	for {
 		logger.Debug("LogBroadcaster: Resubscribing and backfilling logs...")
 		addresses, topics := b.registrations.addressesAndTopics()
@@ -294,6 +315,10 @@ func (b *broadcaster) startResubscribeLoop() {
 		subscription = newSubscription
 
 		b.connected.Set()
+		if reconnecting {
+			reconnecting = false
+			b.onReconnect()
+		}
 
 		atomic.StoreUint32(&b.trackedAddressesCount, uint32(len(addresses)))
 
@@ -301,6 +326,7 @@ func (b *broadcaster) startResubscribeLoop() {
 		if err != nil {
 			logger.Warnw("LogBroadcaster: Error in the event loop - will reconnect", "err", err)
 			b.connected.UnSet()
+			reconnecting = true
 			continue
 		} else if !shouldResubscribe {
 			b.connected.UnSet()
@@ -309,6 +335,30 @@ func (b *broadcaster) startResubscribeLoop() {
 	}
 }
 
+// OnReconnect calls fn each time the broadcaster resubscribes after an error
+// of its subscription, e.g. because the eth node dropped the connection
+func (b *broadcaster) OnReconnect(fn func()) (unsubscribe func()) {
+	b.reconnectCallbacksMu.Lock()
+	defer b.reconnectCallbacksMu.Unlock()
+	b.nextReconnectID++
+	id := b.nextReconnectID
+	b.reconnectCallbacks[id] = fn
+	return func() {
+		b.reconnectCallbacksMu.Lock()
+		defer b.reconnectCallbacksMu.Unlock()
+		delete(b.reconnectCallbacks, id)
+	}
+}
+
+func (b *broadcaster) onReconnect() {
+	logger.Info("LogBroadcaster: Reconnected to the eth node")
+	b.reconnectCallbacksMu.RLock()
+	defer b.reconnectCallbacksMu.RUnlock()
+	for _, fn := range b.reconnectCallbacks {
+		fn()
+	}
+}
+
 func (b *broadcaster) eventLoop(chRawLogs <-chan types.Log, chErr <-chan error) (shouldResubscribe bool, _ error) {
 	// We debounce requests to subscribe and unsubscribe to avoid making too many
 	// RPC calls to the Ethereum node, particularly on startup.
@@ -401,6 +451,12 @@ func (b *broadcaster) onNewHeads() {
 
 		latestBlockNum := latestHead.Number
 		keptDepth := latestBlockNum - int64(keptLogsDepth)
+		if finalized := atomic.LoadInt64(&b.finalizedHeadNumber); finalized > 0 {
+			keptDepth = latestBlockNum - int64(b.registrations.highestNumConfirmations)
+			if finalized < keptDepth {
+				keptDepth = finalized
+			}
+		}
 		if keptDepth < 0 {
 			keptDepth = 0
 		}
@@ -537,6 +593,10 @@ func (n *NullBroadcaster) Register(listener Listener, opts ListenerOpts) (unsubs
 	return func() {}
 }
 
+func (n *NullBroadcaster) OnReconnect(fn func()) (unsubscribe func()) {
+	return func() {}
+}
+
 func (n *NullBroadcaster) ReplayFromBlock(number int64) {
 }
 
@@ -559,10 +619,11 @@ func (n *NullBroadcaster) AwaitDependents() <-chan struct{} {
 	close(ch)
 	return ch
 }
-func (n *NullBroadcaster) DependentReady()                                {}
-func (n *NullBroadcaster) Start() error                                   { return nil }
-func (n *NullBroadcaster) Close() error                                   { return nil }
-func (n *NullBroadcaster) Healthy() error                                 { return nil }
-func (n *NullBroadcaster) Ready() error                                   { return nil }
-func (n *NullBroadcaster) Connect(*models.Head) error                     { return nil }
-func (n *NullBroadcaster) OnNewLongestChain(context.Context, models.Head) {}
+func (n *NullBroadcaster) DependentReady()                                 {}
+func (n *NullBroadcaster) Start() error                                    { return nil }
+func (n *NullBroadcaster) Close() error                                    { return nil }
+func (n *NullBroadcaster) Healthy() error                                  { return nil }
+func (n *NullBroadcaster) Ready() error                                    { return nil }
+func (n *NullBroadcaster) Connect(*models.Head) error                      { return nil }
+func (n *NullBroadcaster) OnNewLongestChain(context.Context, models.Head)  {}
+func (n *NullBroadcaster) OnNewFinalizedHead(context.Context, models.Head) {}
@@ -130,6 +130,11 @@ func (_m *Broadcaster) MarkConsumed(db *gorm.DB, lb log.Broadcast) error {
 	return r0
 }
 
+// OnNewFinalizedHead provides a mock function with given fields: ctx, head
+func (_m *Broadcaster) OnNewFinalizedHead(ctx context.Context, head models.Head) {
+	_m.Called(ctx, head)
+}
+
 // OnNewLongestChain provides a mock function with given fields: ctx, head
 func (_m *Broadcaster) OnNewLongestChain(ctx context.Context, head models.Head) {
 	_m.Called(ctx, head)
@@ -149,6 +154,22 @@ func (_m *Broadcaster) Ready() error {
 	return r0
 }
 
+// OnReconnect provides a mock function with given fields: fn
+func (_m *Broadcaster) OnReconnect(fn func()) func() {
+	ret := _m.Called(fn)
+
+	var r0 func()
+	if rf, ok := ret.Get(0).(func(func()) func()); ok {
+		r0 = rf(fn)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(func())
+		}
+	}
+
+	return r0
+}
+
 // Register provides a mock function with given fields: listener, opts
 func (_m *Broadcaster) Register(listener log.Listener, opts log.ListenerOpts) func() {
 	ret := _m.Called(listener, opts)
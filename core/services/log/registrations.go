@@ -30,16 +30,24 @@ import (
 // The registrations' methods are NOT thread-safe.
 type (
 	registrations struct {
-		subscribers map[uint64]*subscribers
-		decoders    map[common.Address]ParseLogFunc
-
+		// Listeners are indexed by contract address and the log's first topic (the event signature), so that
+		// dispatching a log only visits the listeners registered for it, however many jobs there are.
+		// The topic value filters of each listener are then applied per matched log.
+		handlers map[addressTopic]map[uint64]map[Listener]*listenerMetadata // (contractAddress, logTopic) => NumConfirmations => Listener
+		// number of (address, topic) keys in handlers per contract address
+		addresses map[common.Address]uint
+		decoders  map[common.Address]ParseLogFunc
+
+		// number of registered (listener, topic) pairs per NumConfirmations value, used to keep highestNumConfirmations up to date
+		numConfirmations map[uint64]uint
 		// highest 'NumConfirmations' per all listeners, used to decide about deleting older logs if it's higher than EthFinalityDepth
 		// it's: max(listeners.map(l => l.num_confirmations)
 		highestNumConfirmations uint64
 	}
 
-	subscribers struct {
-		handlers map[common.Address]map[common.Hash]map[Listener]*listenerMetadata // contractAddress => logTopic => Listener
+	addressTopic struct {
+		address common.Address
+		topic   common.Hash
 	}
 
 	// The Listener responds to log events through HandleLog.
@@ -59,8 +67,10 @@ type (
 
 func newRegistrations() *registrations {
 	return &registrations{
-		subscribers: make(map[uint64]*subscribers),
-		decoders:    make(map[common.Address]ParseLogFunc),
+		handlers:         make(map[addressTopic]map[uint64]map[Listener]*listenerMetadata),
+		addresses:        make(map[common.Address]uint),
+		decoders:         make(map[common.Address]ParseLogFunc),
+		numConfirmations: make(map[uint64]uint),
 	}
 }
 
@@ -68,32 +78,73 @@ func (r *registrations) addSubscriber(reg registration) (needsResubscribe bool)
 	addr := reg.opts.Contract
 	r.decoders[addr] = reg.opts.ParseLog
 
-	if _, exists := r.subscribers[reg.opts.NumConfirmations]; !exists {
-		r.subscribers[reg.opts.NumConfirmations] = newSubscribers()
+	numConfirmations := reg.opts.NumConfirmations
+	if reg.opts.NumConfirmations <= 0 {
+		reg.opts.NumConfirmations = 1
 	}
 
-	needsResubscribe = r.subscribers[reg.opts.NumConfirmations].addSubscriber(reg)
+	for topic, topicValueFilters := range reg.opts.LogsWithTopics {
+		key := addressTopic{addr, topic}
+		if _, exists := r.handlers[key]; !exists {
+			r.handlers[key] = make(map[uint64]map[Listener]*listenerMetadata)
+			r.addresses[addr]++
+			needsResubscribe = true
+		}
+		if _, exists := r.handlers[key][numConfirmations]; !exists {
+			r.handlers[key][numConfirmations] = make(map[Listener]*listenerMetadata)
+		}
+		if _, exists := r.handlers[key][numConfirmations][reg.listener]; !exists {
+			r.numConfirmations[numConfirmations]++
+		}
+
+		r.handlers[key][numConfirmations][reg.listener] = &listenerMetadata{
+			opts:    reg.opts,
+			filters: topicValueFilters,
+		}
+	}
 
 	// increase the variable for highest number of confirmations among all subscribers,
 	// if the new subscriber has a higher value
-	if reg.opts.NumConfirmations > r.highestNumConfirmations {
-		r.highestNumConfirmations = reg.opts.NumConfirmations
+	if numConfirmations > r.highestNumConfirmations {
+		r.highestNumConfirmations = numConfirmations
 	}
 	return
 }
 
 func (r *registrations) removeSubscriber(reg registration) (needsResubscribe bool) {
-	subscribers, exists := r.subscribers[reg.opts.NumConfirmations]
-	if !exists {
-		return
-	}
+	addr := reg.opts.Contract
+	numConfirmations := reg.opts.NumConfirmations
+
+	for topic := range reg.opts.LogsWithTopics {
+		key := addressTopic{addr, topic}
+		listeners, exists := r.handlers[key][numConfirmations]
+		if !exists {
+			continue
+		}
+		if _, exists := listeners[reg.listener]; !exists {
+			continue
+		}
 
-	needsResubscribe = subscribers.removeSubscriber(reg)
+		delete(listeners, reg.listener)
+		r.numConfirmations[numConfirmations]--
+		if r.numConfirmations[numConfirmations] == 0 {
+			delete(r.numConfirmations, numConfirmations)
+		}
 
-	if len(r.subscribers[reg.opts.NumConfirmations].handlers) == 0 {
-		delete(r.subscribers, reg.opts.NumConfirmations)
-		r.resetHighestNumConfirmationsValue()
+		if len(listeners) == 0 {
+			delete(r.handlers[key], numConfirmations)
+		}
+		if len(r.handlers[key]) == 0 {
+			needsResubscribe = true
+			delete(r.handlers, key)
+			r.addresses[addr]--
+			if r.addresses[addr] == 0 {
+				delete(r.addresses, addr)
+			}
+		}
 	}
+
+	r.resetHighestNumConfirmationsValue()
 	return
 }
 
@@ -101,7 +152,7 @@ func (r *registrations) removeSubscriber(reg registration) (needsResubscribe boo
 func (r *registrations) resetHighestNumConfirmationsValue() {
 	highestNumConfirmations := uint64(0)
 
-	for numConfirmations := range r.subscribers {
+	for numConfirmations := range r.numConfirmations {
 		if numConfirmations > highestNumConfirmations {
 			highestNumConfirmations = numConfirmations
 		}
@@ -110,23 +161,20 @@ func (r *registrations) resetHighestNumConfirmationsValue() {
 }
 
 func (r *registrations) addressesAndTopics() ([]common.Address, []common.Hash) {
-	var addresses []common.Address
-	var topics []common.Hash
-	for _, sub := range r.subscribers {
-		add, t := sub.addressesAndTopics()
-		addresses = append(addresses, add...)
-		topics = append(topics, t...)
+	addresses := make([]common.Address, 0, len(r.addresses))
+	for addr := range r.addresses {
+		addresses = append(addresses, addr)
+	}
+	topics := make([]common.Hash, 0, len(r.handlers))
+	for key := range r.handlers {
+		topics = append(topics, key.topic)
 	}
 	return addresses, topics
 }
 
 func (r *registrations) isAddressRegistered(address common.Address) bool {
-	for _, sub := range r.subscribers {
-		if sub.isAddressRegistered(address) {
-			return true
-		}
-	}
-	return false
+	_, exists := r.addresses[address]
+	return exists
 }
 
 func (r *registrations) sendLogs(logsToSend []logsOnBlock, latestHead models.Head, broadcasts []LogBroadcast) {
@@ -139,22 +187,25 @@ func (r *registrations) sendLogs(logsToSend []logsOnBlock, latestHead models.Hea
 	latestBlockNumber := uint64(latestHead.Number)
 
 	for _, logsPerBlock := range logsToSend {
-		for numConfirmations, subscribers := range r.subscribers {
-
-			if numConfirmations != 0 && latestBlockNumber < numConfirmations {
-				// Skipping send because the block is definitely too young
+		for _, log := range logsPerBlock.Logs {
+			if len(log.Topics) == 0 {
 				continue
 			}
 
-			// We attempt the send multiple times per log
-			// so here we need to see if this particular listener actually should receive it at this depth
-			isOldEnough := numConfirmations == 0 || (logsPerBlock.BlockNumber+numConfirmations-1) <= latestBlockNumber
-			if !isOldEnough {
-				continue
-			}
+			for numConfirmations, listeners := range r.handlers[addressTopic{log.Address, log.Topics[0]}] {
+				if numConfirmations != 0 && latestBlockNumber < numConfirmations {
+					// Skipping send because the block is definitely too young
+					continue
+				}
+
+				// We attempt the send multiple times per log
+				// so here we need to see if this particular listener actually should receive it at this depth
+				isOldEnough := numConfirmations == 0 || (logsPerBlock.BlockNumber+numConfirmations-1) <= latestBlockNumber
+				if !isOldEnough {
+					continue
+				}
 
-			for _, log := range logsPerBlock.Logs {
-				subscribers.sendLog(log, latestHead, broadcastsExisting, r.decoders)
+				r.sendLog(log, listeners, latestHead, broadcastsExisting)
 			}
 		}
 	}
@@ -178,83 +229,10 @@ func filtersContainValues(topicValues []common.Hash, filters [][]Topic) bool {
 	return true
 }
 
-func newSubscribers() *subscribers {
-	return &subscribers{
-		handlers: make(map[common.Address]map[common.Hash]map[Listener]*listenerMetadata),
-	}
-}
-
-func (r *subscribers) addSubscriber(reg registration) (needsResubscribe bool) {
-	addr := reg.opts.Contract
-
-	if reg.opts.NumConfirmations <= 0 {
-		reg.opts.NumConfirmations = 1
-	}
-
-	if _, exists := r.handlers[addr]; !exists {
-		r.handlers[addr] = make(map[common.Hash]map[Listener]*listenerMetadata)
-	}
-
-	for topic, topicValueFilters := range reg.opts.LogsWithTopics {
-		if _, exists := r.handlers[addr][topic]; !exists {
-			r.handlers[addr][topic] = make(map[Listener]*listenerMetadata)
-			needsResubscribe = true
-		}
-
-		r.handlers[addr][topic][reg.listener] = &listenerMetadata{
-			opts:    reg.opts,
-			filters: topicValueFilters,
-		}
-	}
-	return
-}
-
-func (r *subscribers) removeSubscriber(reg registration) (needsResubscribe bool) {
-	addr := reg.opts.Contract
-
-	if _, exists := r.handlers[addr]; !exists {
-		return
-	}
-	for topic := range reg.opts.LogsWithTopics {
-		topicMap, exists := r.handlers[addr][topic]
-		if !exists {
-			continue
-		}
-
-		delete(topicMap, reg.listener)
-
-		if len(topicMap) == 0 {
-			needsResubscribe = true
-			delete(r.handlers[addr], topic)
-		}
-		if len(r.handlers[addr]) == 0 {
-			delete(r.handlers, addr)
-		}
-	}
-	return
-}
-
-func (r *subscribers) addressesAndTopics() ([]common.Address, []common.Hash) {
-	var addresses []common.Address
-	var topics []common.Hash
-	for addr := range r.handlers {
-		addresses = append(addresses, addr)
-		for topic := range r.handlers[addr] {
-			topics = append(topics, topic)
-		}
-	}
-	return addresses, topics
-}
-
-func (r *subscribers) isAddressRegistered(address common.Address) bool {
-	_, exists := r.handlers[address]
-	return exists
-}
-
-func (r *subscribers) sendLog(log types.Log, latestHead models.Head, broadcasts map[LogBroadcastAsKey]struct{}, decoders map[common.Address]ParseLogFunc) {
+func (r *registrations) sendLog(log types.Log, listeners map[Listener]*listenerMetadata, latestHead models.Head, broadcasts map[LogBroadcastAsKey]struct{}) {
 	latestBlockNumber := uint64(latestHead.Number)
 	var wg sync.WaitGroup
-	for listener, metadata := range r.handlers[log.Address][log.Topics[0]] {
+	for listener, metadata := range listeners {
 		listener := listener
 
 		currentBroadcast := NewLogBroadcastAsKey(log, listener)
@@ -274,7 +252,7 @@ func (r *subscribers) sendLog(log types.Log, latestHead models.Head, broadcasts
 
 		var decodedLog generated.AbigenLog
 		var err error
-		if parseLog := decoders[log.Address]; parseLog != nil {
+		if parseLog := r.decoders[log.Address]; parseLog != nil {
 			decodedLog, err = parseLog(logCopy)
 			if err != nil {
 				logger.Errorw("Could not parse contract log", "error", err)
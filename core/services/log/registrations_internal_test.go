@@ -0,0 +1,141 @@
+package log
+
+import (
+	"fmt"
+	"math/big"
+	"sync/atomic"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/smartcontractkit/chainlink/core/store/models"
+)
+
+// test helpers of their own, as cltest imports this package
+var testCounter int64
+
+func newTestAddress() common.Address {
+	return common.BigToAddress(big.NewInt(atomic.AddInt64(&testCounter, 1)))
+}
+
+func newTestHash() common.Hash {
+	return common.BigToHash(big.NewInt(atomic.AddInt64(&testCounter, 1)))
+}
+
+type countingListener struct {
+	jobID    int32
+	received int32
+}
+
+func (l *countingListener) HandleLog(Broadcast) { atomic.AddInt32(&l.received, 1) }
+func (l *countingListener) JobID() models.JobID { return models.NilJobID }
+func (l *countingListener) JobIDV2() int32      { return l.jobID }
+func (l *countingListener) IsV2Job() bool       { return true }
+func (l *countingListener) count() int32        { return atomic.LoadInt32(&l.received) }
+
+func newTestRegistration(listener Listener, contract common.Address, topic common.Hash, filters [][]Topic, numConfirmations uint64) registration {
+	return registration{
+		listener: listener,
+		opts: ListenerOpts{
+			Contract:         contract,
+			LogsWithTopics:   map[common.Hash][][]Topic{topic: filters},
+			NumConfirmations: numConfirmations,
+		},
+	}
+}
+
+func newTestLog(contract common.Address, blockNumber uint64, topics ...common.Hash) types.Log {
+	return types.Log{
+		Address:     contract,
+		Topics:      topics,
+		BlockNumber: blockNumber,
+		BlockHash:   newTestHash(),
+	}
+}
+
+func TestRegistrations_AddRemoveSubscriber(t *testing.T) {
+	r := newRegistrations()
+	contract := newTestAddress()
+	topic := newTestHash()
+	l1 := &countingListener{jobID: 1}
+	l2 := &countingListener{jobID: 2}
+
+	assert.True(t, r.addSubscriber(newTestRegistration(l1, contract, topic, nil, 3)))
+	// same address and topic, only the confirmations differ
+	assert.False(t, r.addSubscriber(newTestRegistration(l2, contract, topic, nil, 10)))
+	assert.Equal(t, uint64(10), r.highestNumConfirmations)
+	assert.True(t, r.isAddressRegistered(contract))
+
+	addresses, topics := r.addressesAndTopics()
+	assert.Equal(t, []common.Address{contract}, addresses)
+	assert.Equal(t, []common.Hash{topic}, topics)
+
+	assert.False(t, r.removeSubscriber(newTestRegistration(l2, contract, topic, nil, 10)))
+	assert.Equal(t, uint64(3), r.highestNumConfirmations)
+
+	assert.True(t, r.removeSubscriber(newTestRegistration(l1, contract, topic, nil, 3)))
+	assert.Equal(t, uint64(0), r.highestNumConfirmations)
+	assert.False(t, r.isAddressRegistered(contract))
+	assert.Empty(t, r.handlers)
+}
+
+func TestRegistrations_SendLogs(t *testing.T) {
+	r := newRegistrations()
+	contract := newTestAddress()
+	topic := newTestHash()
+	value := newTestHash()
+
+	matching := &countingListener{jobID: 1}
+	filtered := &countingListener{jobID: 2}
+	otherTopic := &countingListener{jobID: 3}
+	otherContract := &countingListener{jobID: 4}
+	tooYoung := &countingListener{jobID: 5}
+
+	r.addSubscriber(newTestRegistration(matching, contract, topic, [][]Topic{{Topic(value)}}, 1))
+	r.addSubscriber(newTestRegistration(filtered, contract, topic, [][]Topic{{Topic(newTestHash())}}, 1))
+	r.addSubscriber(newTestRegistration(otherTopic, contract, newTestHash(), nil, 1))
+	r.addSubscriber(newTestRegistration(otherContract, newTestAddress(), topic, nil, 1))
+	r.addSubscriber(newTestRegistration(tooYoung, contract, topic, nil, 5))
+
+	logs := []logsOnBlock{{
+		BlockNumber: 10,
+		Logs: []types.Log{
+			newTestLog(contract, 10, topic, value),
+			newTestLog(contract, 10),
+		},
+	}}
+	r.sendLogs(logs, models.Head{Number: 12}, nil)
+
+	assert.Equal(t, int32(1), matching.count())
+	assert.Equal(t, int32(0), filtered.count())
+	assert.Equal(t, int32(0), otherTopic.count())
+	assert.Equal(t, int32(0), otherContract.count())
+	assert.Equal(t, int32(0), tooYoung.count())
+
+	r.sendLogs(logs, models.Head{Number: 14}, nil)
+	assert.Equal(t, int32(1), tooYoung.count())
+}
+
+func BenchmarkRegistrations_SendLogs(b *testing.B) {
+	for _, numListeners := range []int{10, 100, 1000, 10000} {
+		b.Run(fmt.Sprintf("%d listeners", numListeners), func(b *testing.B) {
+			r := newRegistrations()
+			topic := newTestHash()
+			var contract common.Address
+			for i := 0; i < numListeners; i++ {
+				contract = newTestAddress()
+				r.addSubscriber(newTestRegistration(&countingListener{jobID: int32(i)}, contract, topic, nil, uint64(i%50)))
+			}
+			// the log matches the last registered listener only
+			logs := []logsOnBlock{{BlockNumber: 1, Logs: []types.Log{newTestLog(contract, 1, topic)}}}
+			head := models.Head{Number: 100}
+
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				r.sendLogs(logs, head, nil)
+			}
+		})
+	}
+}
@@ -0,0 +1,181 @@
+package offchainreporting
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+	ocrtypes "github.com/smartcontractkit/libocr/offchainreporting/types"
+
+	"github.com/smartcontractkit/chainlink/core/gracefulpanic"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/job"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+// ErrNotInConfig is returned when the node's signing or transmitter address
+// is not part of the OCR contract's current config.
+var ErrNotInConfig = errors.New("not in config")
+
+// ConfigTracker reads the current config of an OCR contract
+type ConfigTracker interface {
+	LatestConfigDetails(ctx context.Context) (changedInBlock uint64, configDigest ocrtypes.ConfigDigest, err error)
+	ConfigFromLogs(ctx context.Context, changedInBlock uint64) (ocrtypes.ContractConfig, error)
+}
+
+// CheckConfigMembership verifies that the signer and transmitter belong to
+// the same oracle in the contract config.
+func CheckConfigMembership(config ocrtypes.ContractConfig, signer, transmitter common.Address) error {
+	for i, s := range config.Signers {
+		if s != signer {
+			continue
+		}
+		if i >= len(config.Transmitters) || config.Transmitters[i] != transmitter {
+			return errors.Wrapf(ErrNotInConfig, "transmitter address %s does not match the transmitter configured for signing address %s", transmitter.Hex(), signer.Hex())
+		}
+		return nil
+	}
+	return errors.Wrapf(ErrNotInConfig, "signing address %s is not one of the configured signers", signer.Hex())
+}
+
+// ConfigMembershipRecheckInterval is how often the ConfigMembershipChecker
+// checks the contract config again until the node is part of it
+const ConfigMembershipRecheckInterval = 5 * time.Minute
+
+// ConfigMembershipChecker checks on start that the node's OCR key bundle and
+// transmitter address are part of the contract's current config. An oracle
+// which is not in the config is not authorized to transmit and can never
+// contribute to a report, so the mismatch is recorded as a job error instead
+// of failing silently.
+//
+// The check does not prevent the job from running, since the contract may be
+// reconfigured to include the node later. Until it is, the config is checked
+// again every ConfigMembershipRecheckInterval.
+type ConfigMembershipChecker struct {
+	utils.StartStopOnce
+
+	// notInConfig is set once the node has been found not to be in the
+	// config, so the job error is only recorded once
+	notInConfig bool
+
+	tracker     ConfigTracker
+	jobORM      job.ORM
+	jobID       int32
+	signer      common.Address
+	transmitter common.Address
+	timeout     time.Duration
+	logger      logger.Logger
+
+	chStop chan struct{}
+	wg     sync.WaitGroup
+}
+
+var _ job.Service = (*ConfigMembershipChecker)(nil)
+
+// NewConfigMembershipChecker constructs a new ConfigMembershipChecker
+func NewConfigMembershipChecker(
+	tracker ConfigTracker,
+	jobORM job.ORM,
+	jobID int32,
+	signer common.Address,
+	transmitter common.Address,
+	timeout time.Duration,
+	logger logger.Logger,
+) *ConfigMembershipChecker {
+	return &ConfigMembershipChecker{
+		tracker:     tracker,
+		jobORM:      jobORM,
+		jobID:       jobID,
+		signer:      signer,
+		transmitter: transmitter,
+		timeout:     timeout,
+		logger:      logger,
+		chStop:      make(chan struct{}),
+	}
+}
+
+// Start runs the checks in the background so a slow eth node does not block
+// the job from starting.
+func (c *ConfigMembershipChecker) Start() error {
+	return c.StartOnce("OCRConfigMembershipChecker", func() error {
+		c.wg.Add(1)
+		go gracefulpanic.WrapRecover(func() {
+			defer c.wg.Done()
+
+			ctx, cancel := utils.ContextFromChan(c.chStop)
+			defer cancel()
+			if c.Check(ctx) {
+				return
+			}
+
+			ticker := time.NewTicker(ConfigMembershipRecheckInterval)
+			defer ticker.Stop()
+			for {
+				select {
+				case <-c.chStop:
+					return
+				case <-ticker.C:
+					if c.Check(ctx) {
+						return
+					}
+				}
+			}
+		})
+		return nil
+	})
+}
+
+// Close stops any check which is still in progress
+func (c *ConfigMembershipChecker) Close() error {
+	return c.StopOnce("OCRConfigMembershipChecker", func() error {
+		close(c.chStop)
+		c.wg.Wait()
+		return nil
+	})
+}
+
+// Check fetches the current contract config and records a job error if the
+// node is not part of it. It returns true once the node is found to be in the
+// config.
+func (c *ConfigMembershipChecker) Check(ctx context.Context) (inConfig bool) {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	changedInBlock, _, err := c.tracker.LatestConfigDetails(ctx)
+	if err != nil {
+		c.logger.Warnw("OCRConfigMembershipChecker: could not fetch latest config details", "err", err)
+		return false
+	}
+	if changedInBlock == 0 {
+		// The contract has not been configured yet
+		c.logger.Infow("OCRConfigMembershipChecker: contract has no config yet, skipping check")
+		return false
+	}
+
+	config, err := c.tracker.ConfigFromLogs(ctx, changedInBlock)
+	if err != nil {
+		c.logger.Warnw("OCRConfigMembershipChecker: could not fetch latest config", "err", err)
+		return false
+	}
+
+	if err = CheckConfigMembership(config, c.signer, c.transmitter); err != nil {
+		if !c.notInConfig {
+			c.logger.Errorw("OCRConfigMembershipChecker: node is not authorized by the current OCR contract config and will not contribute to reports",
+				"err", err,
+				"configDigest", config.ConfigDigest.Hex(),
+			)
+			c.jobORM.RecordError(context.Background(), c.jobID, fmt.Sprintf("Not authorized: %v (config digest %s)", err, config.ConfigDigest.Hex()))
+		}
+		c.notInConfig = true
+		return false
+	}
+
+	if c.notInConfig {
+		c.logger.Infow("OCRConfigMembershipChecker: node has been added to the OCR contract config", "configDigest", config.ConfigDigest.Hex())
+	}
+	c.notInConfig = false
+	return true
+}
@@ -0,0 +1,167 @@
+package offchainreporting_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	gethCommon "github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	jobmocks "github.com/smartcontractkit/chainlink/core/services/job/mocks"
+	"github.com/smartcontractkit/chainlink/core/services/offchainreporting"
+	ocrtypes "github.com/smartcontractkit/libocr/offchainreporting/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeConfigTracker struct {
+	changedInBlock uint64
+	config         ocrtypes.ContractConfig
+}
+
+func (f fakeConfigTracker) LatestConfigDetails(context.Context) (uint64, ocrtypes.ConfigDigest, error) {
+	return f.changedInBlock, f.config.ConfigDigest, nil
+}
+
+func (f fakeConfigTracker) ConfigFromLogs(context.Context, uint64) (ocrtypes.ContractConfig, error) {
+	return f.config, nil
+}
+
+func Test_CheckConfigMembership(t *testing.T) {
+	t.Parallel()
+
+	var (
+		signer      = cltest.NewAddress()
+		transmitter = cltest.NewAddress()
+		other       = cltest.NewAddress()
+	)
+
+	testCases := []struct {
+		name   string
+		config ocrtypes.ContractConfig
+		valid  bool
+	}{
+		{
+			name: "in config",
+			config: ocrtypes.ContractConfig{
+				Signers:      []gethCommon.Address{other, signer},
+				Transmitters: []gethCommon.Address{other, transmitter},
+			},
+			valid: true,
+		},
+		{
+			name: "signer missing",
+			config: ocrtypes.ContractConfig{
+				Signers:      []gethCommon.Address{other},
+				Transmitters: []gethCommon.Address{transmitter},
+			},
+		},
+		{
+			name: "transmitter belongs to another oracle",
+			config: ocrtypes.ContractConfig{
+				Signers:      []gethCommon.Address{signer, other},
+				Transmitters: []gethCommon.Address{other, transmitter},
+			},
+		},
+		{
+			name:   "empty config",
+			config: ocrtypes.ContractConfig{},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			err := offchainreporting.CheckConfigMembership(tc.config, signer, transmitter)
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+				assert.Equal(t, offchainreporting.ErrNotInConfig, errors.Cause(err))
+			}
+		})
+	}
+}
+
+func Test_ConfigMembershipChecker_Check(t *testing.T) {
+	t.Parallel()
+
+	var (
+		jobID       = int32(1)
+		signer      = cltest.NewAddress()
+		transmitter = cltest.NewAddress()
+	)
+
+	t.Run("records a job error when not in config", func(t *testing.T) {
+		jobORM := &jobmocks.ORM{}
+		tracker := fakeConfigTracker{
+			changedInBlock: 1,
+			config: ocrtypes.ContractConfig{
+				Signers:      []gethCommon.Address{cltest.NewAddress()},
+				Transmitters: []gethCommon.Address{transmitter},
+			},
+		}
+		jobORM.On("RecordError", mock.Anything, jobID, mock.MatchedBy(func(description string) bool {
+			return strings.HasPrefix(description, "Not authorized: ") && strings.Contains(description, "not in config")
+		})).Once()
+
+		checker := offchainreporting.NewConfigMembershipChecker(tracker, jobORM, jobID, signer, transmitter, time.Second, *logger.Default)
+		assert.False(t, checker.Check(context.Background()))
+		// rechecks do not record the error again
+		assert.False(t, checker.Check(context.Background()))
+
+		jobORM.AssertExpectations(t)
+	})
+
+	t.Run("does nothing when in config", func(t *testing.T) {
+		jobORM := &jobmocks.ORM{}
+		tracker := fakeConfigTracker{
+			changedInBlock: 1,
+			config: ocrtypes.ContractConfig{
+				Signers:      []gethCommon.Address{signer},
+				Transmitters: []gethCommon.Address{transmitter},
+			},
+		}
+
+		checker := offchainreporting.NewConfigMembershipChecker(tracker, jobORM, jobID, signer, transmitter, time.Second, *logger.Default)
+		assert.True(t, checker.Check(context.Background()))
+
+		jobORM.AssertExpectations(t)
+	})
+
+	t.Run("recovers once added to the config", func(t *testing.T) {
+		jobORM := &jobmocks.ORM{}
+		tracker := &fakeConfigTracker{
+			changedInBlock: 1,
+			config: ocrtypes.ContractConfig{
+				Signers:      []gethCommon.Address{cltest.NewAddress()},
+				Transmitters: []gethCommon.Address{transmitter},
+			},
+		}
+		jobORM.On("RecordError", mock.Anything, jobID, mock.Anything).Once()
+
+		checker := offchainreporting.NewConfigMembershipChecker(tracker, jobORM, jobID, signer, transmitter, time.Second, *logger.Default)
+		assert.False(t, checker.Check(context.Background()))
+
+		tracker.changedInBlock = 2
+		tracker.config.Signers = []gethCommon.Address{signer}
+		assert.True(t, checker.Check(context.Background()))
+
+		jobORM.AssertExpectations(t)
+	})
+
+	t.Run("does nothing when the contract is not configured", func(t *testing.T) {
+		jobORM := &jobmocks.ORM{}
+		tracker := fakeConfigTracker{}
+
+		checker := offchainreporting.NewConfigMembershipChecker(tracker, jobORM, jobID, signer, transmitter, time.Second, *logger.Default)
+		assert.False(t, checker.Check(context.Background()))
+
+		jobORM.AssertExpectations(t)
+	})
+}
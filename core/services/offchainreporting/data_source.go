@@ -2,6 +2,7 @@ package offchainreporting
 
 import (
 	"context"
+	"fmt"
 	"math/big"
 	"time"
 
@@ -25,6 +26,7 @@ type dataSource struct {
 	ocrLogger             logger.Logger
 	runResults            chan<- pipeline.RunWithResults
 	currentBridgeMetadata models.BridgeMetaData
+	observations          observationWindow
 }
 
 var _ ocrtypes.DataSource = (*dataSource)(nil)
@@ -32,6 +34,28 @@ var _ ocrtypes.DataSource = (*dataSource)(nil)
 // The context passed in here has a timeout of (ObservationTimeout + ObservationGracePeriod).
 // Upon context cancellation, its expected that we return any usable values within ObservationGracePeriod.
 func (ds *dataSource) Observe(ctx context.Context) (ocrtypes.Observation, error) {
+	observation, err := ds.observe(ctx)
+	ds.recordObservation(err == nil)
+	return observation, err
+}
+
+// recordObservation exports the outcome of an observation to the
+// observation metrics of the job
+func (ds *dataSource) recordObservation(success bool) {
+	jobID := fmt.Sprintf("%d", ds.jobSpec.ID)
+	var contractAddress string
+	if ds.jobSpec.OffchainreportingOracleSpec != nil {
+		contractAddress = ds.jobSpec.OffchainreportingOracleSpec.ContractAddress.Hex()
+	}
+	status := "errored"
+	if success {
+		status = "succeeded"
+	}
+	promObservations.WithLabelValues(jobID, contractAddress, status).Inc()
+	promObservationSuccessRatio.WithLabelValues(jobID, contractAddress).Set(ds.observations.record(success))
+}
+
+func (ds *dataSource) observe(ctx context.Context) (ocrtypes.Observation, error) {
 	var observation ocrtypes.Observation
 	md, err := models.MarshalBridgeMetaData(ds.currentBridgeMetadata.LatestAnswer, ds.currentBridgeMetadata.UpdatedAt)
 	if err != nil {
@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/pkg/errors"
 	"gorm.io/gorm"
 
@@ -230,6 +231,16 @@ func (d Delegate) ServicesForSpec(jobSpec job.Job) (services []job.Service, err
 			return nil, err
 		}
 
+		services = append(services, NewConfigMembershipChecker(
+			tracker,
+			d.jobORM,
+			jobSpec.ID,
+			common.Address(ocrkey.PublicKeyAddressOnChain()),
+			ta.Address(),
+			lc.BlockchainTimeout,
+			*loggerWith,
+		))
+
 		strategy := bulletprooftxmanager.NewQueueingTxStrategy(jobSpec.ExternalJobID, d.config.OCRDefaultTransactionQueueDepth())
 
 		contractTransmitter := NewOCRContractTransmitter(
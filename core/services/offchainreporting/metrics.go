@@ -0,0 +1,58 @@
+package offchainreporting
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+// observationWindowSize is the number of latest observations of a job over
+// which its observation success ratio is computed
+const observationWindowSize = 100
+
+var (
+	promObservations = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "ocr_observations_total",
+		Help: "The number of observations made for OCR jobs, by status",
+	},
+		[]string{"job_id", "contract_address", "status"},
+	)
+	promObservationSuccessRatio = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "ocr_observation_success_ratio",
+		Help: fmt.Sprintf("The ratio of the latest %d observations of OCR jobs which succeeded", observationWindowSize),
+	},
+		[]string{"job_id", "contract_address"},
+	)
+)
+
+// observationWindow tracks the outcome of the latest observations of a job,
+// to compute its observation success ratio
+type observationWindow struct {
+	mu        sync.Mutex
+	outcomes  [observationWindowSize]bool
+	next      int
+	count     int
+	succeeded int
+}
+
+// record adds the outcome of an observation to the window and returns the
+// success ratio of the observations in it
+func (w *observationWindow) record(success bool) float64 {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.count == observationWindowSize {
+		if w.outcomes[w.next] {
+			w.succeeded--
+		}
+	} else {
+		w.count++
+	}
+	w.outcomes[w.next] = success
+	if success {
+		w.succeeded++
+	}
+	w.next = (w.next + 1) % observationWindowSize
+	return float64(w.succeeded) / float64(w.count)
+}
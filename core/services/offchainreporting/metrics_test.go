@@ -0,0 +1,24 @@
+package offchainreporting
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestObservationWindow(t *testing.T) {
+	t.Parallel()
+
+	var w observationWindow
+	assert.Equal(t, 1.0, w.record(true))
+	assert.Equal(t, 0.5, w.record(false))
+
+	for i := 0; i < observationWindowSize-2; i++ {
+		w.record(true)
+	}
+	assert.Equal(t, float64(observationWindowSize-1)/observationWindowSize, w.record(true))
+
+	// The failed observation drops out of the window
+	assert.Equal(t, float64(observationWindowSize-1)/observationWindowSize, w.record(false))
+	assert.Equal(t, float64(observationWindowSize-1)/observationWindowSize, w.record(true))
+}
@@ -27,7 +27,7 @@ func Test_Transmitter_CreateEthTransaction(t *testing.T) {
 
 	transmitter := offchainreporting.NewTransmitter(txm, store.DB, fromAddress, gasLimit, strategy)
 
-	txm.On("CreateEthTransaction", mock.Anything, fromAddress, toAddress, payload, gasLimit, nil, strategy).Return(bulletprooftxmanager.EthTx{}, nil).Once()
+	txm.On("CreateEthTransaction", mock.Anything, fromAddress, toAddress, payload, gasLimit, nil, strategy, bulletprooftxmanager.EthTxUrgencyHigh, bulletprooftxmanager.EthTxExpiry{}, true).Return(bulletprooftxmanager.EthTx{}, nil).Once()
 	require.NoError(t, transmitter.CreateEthTransaction(context.Background(), toAddress, payload))
 
 	txm.AssertExpectations(t)
@@ -0,0 +1,157 @@
+package pipeline
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/shopspring/decimal"
+	"golang.org/x/time/rate"
+	"gorm.io/gorm"
+
+	"github.com/smartcontractkit/chainlink/core/store/models"
+)
+
+// bridgeCacheTTL is how long the runner memoizes bridges, so that bridge tasks
+// needn't query the database before every request. Changes to a bridge take
+// up to this long to apply to new runs.
+const bridgeCacheTTL = 10 * time.Second
+
+var (
+	promBridgeRequestsQueued = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "pipeline_bridge_requests_queued",
+		Help: "The number of bridge requests waiting for the bridge's concurrency or rate limit",
+	},
+		[]string{"bridge_name"},
+	)
+	promBridgeRequestsDropped = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "pipeline_bridge_requests_dropped",
+		Help: "The number of bridge requests which timed out waiting for the bridge's concurrency or rate limit",
+	},
+		[]string{"bridge_name"},
+	)
+)
+
+// bridges memoizes bridges by name, and limits the requests made to each
+// bridge according to its MaxConcurrency and RateLimit
+type bridges struct {
+	mu       sync.Mutex
+	cached   map[string]cachedBridge
+	limiters map[models.TaskType]*bridgeLimiter
+}
+
+type cachedBridge struct {
+	bridge    models.BridgeType
+	fetchedAt time.Time
+}
+
+func newBridges() *bridges {
+	return &bridges{
+		cached:   make(map[string]cachedBridge),
+		limiters: make(map[models.TaskType]*bridgeLimiter),
+	}
+}
+
+// find returns the bridge named name, querying db unless it was memoized less
+// than bridgeCacheTTL ago
+func (b *bridges) find(db *gorm.DB, name string) (models.BridgeType, error) {
+	b.mu.Lock()
+	cached, exists := b.cached[name]
+	b.mu.Unlock()
+	if exists && time.Since(cached.fetchedAt) < bridgeCacheTTL {
+		return cached.bridge, nil
+	}
+
+	var bt models.BridgeType
+	if err := db.First(&bt, "name = ?", name).Error; err != nil {
+		return bt, errors.Wrapf(err, "could not find bridge with name '%s'", name)
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.cached[name] = cachedBridge{bridge: bt, fetchedAt: time.Now()}
+	return bt, nil
+}
+
+// limiter returns the limiter of bt, replacing it if bt's limits have changed,
+// or nil if bt is unlimited
+func (b *bridges) limiter(bt models.BridgeType) *bridgeLimiter {
+	if bt.MaxConcurrency == 0 && !bt.RateLimit.IsPositive() {
+		return nil
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	l, exists := b.limiters[bt.Name]
+	if !exists || l.maxConcurrency != bt.MaxConcurrency || !l.rateLimit.Equal(bt.RateLimit) {
+		// Requests in flight release the limiter they acquired, so the old
+		// limiter can simply be dropped
+		l = newBridgeLimiter(bt)
+		b.limiters[bt.Name] = l
+	}
+	return l
+}
+
+// bridgeLimiter caps the requests in flight to a bridge, and the rate at which
+// they are sent. Requests wait in acquire until both allow them.
+type bridgeLimiter struct {
+	name           string
+	maxConcurrency uint32
+	rateLimit      decimal.Decimal
+
+	slots   chan struct{}
+	limiter *rate.Limiter
+}
+
+func newBridgeLimiter(bt models.BridgeType) *bridgeLimiter {
+	l := &bridgeLimiter{
+		name:           bt.Name.String(),
+		maxConcurrency: bt.MaxConcurrency,
+		rateLimit:      bt.RateLimit,
+	}
+	if bt.MaxConcurrency > 0 {
+		l.slots = make(chan struct{}, bt.MaxConcurrency)
+	}
+	if bt.RateLimit.IsPositive() {
+		perSecond, _ := bt.RateLimit.Float64()
+		l.limiter = rate.NewLimiter(rate.Limit(perSecond), 1)
+	}
+	return l
+}
+
+// acquire blocks until the bridge may be sent a request, or returns an error
+// if ctx is done, or will be before then. It is a no-op on a nil limiter.
+func (l *bridgeLimiter) acquire(ctx context.Context) error {
+	if l == nil {
+		return nil
+	}
+	queued := promBridgeRequestsQueued.WithLabelValues(l.name)
+	queued.Inc()
+	defer queued.Dec()
+
+	if l.limiter != nil {
+		if err := l.limiter.Wait(ctx); err != nil {
+			promBridgeRequestsDropped.WithLabelValues(l.name).Inc()
+			return errors.Wrapf(err, "timed out waiting for the rate limit of bridge %s", l.name)
+		}
+	}
+	if l.slots != nil {
+		select {
+		case l.slots <- struct{}{}:
+		case <-ctx.Done():
+			promBridgeRequestsDropped.WithLabelValues(l.name).Inc()
+			return errors.Wrapf(ctx.Err(), "timed out waiting for the concurrency limit of bridge %s", l.name)
+		}
+	}
+	return nil
+}
+
+func (l *bridgeLimiter) release() {
+	if l == nil || l.slots == nil {
+		return
+	}
+	<-l.slots
+}
@@ -0,0 +1,44 @@
+package pipeline_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+	"github.com/smartcontractkit/chainlink/core/store/models"
+)
+
+func TestBridgeLimiter_MaxConcurrency(t *testing.T) {
+	t.Parallel()
+
+	l := pipeline.NewBridgeLimiter(models.BridgeType{Name: models.MustNewTaskType("limited"), MaxConcurrency: 1})
+
+	require.NoError(t, l.Acquire(context.Background()))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	assert.Error(t, l.Acquire(ctx))
+
+	l.Release()
+	require.NoError(t, l.Acquire(context.Background()))
+	l.Release()
+}
+
+func TestBridgeLimiter_RateLimit(t *testing.T) {
+	t.Parallel()
+
+	l := pipeline.NewBridgeLimiter(models.BridgeType{Name: models.MustNewTaskType("limited"), RateLimit: decimal.NewFromFloat(0.1)})
+
+	require.NoError(t, l.Acquire(context.Background()))
+	l.Release()
+
+	// The next request is not allowed for another 10 seconds
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	assert.Error(t, l.Acquire(ctx))
+}
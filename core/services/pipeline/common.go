@@ -44,11 +44,17 @@ type (
 		DefaultMaxHTTPAttempts() uint
 		DefaultHTTPAllowUnrestrictedNetworkAccess() bool
 		EthGasLimitDefault() uint64
+		EthGasLimitEstimateMultiplier() float32
 		EthMaxQueuedTransactions() uint64
 		TriggerFallbackDBPollInterval() time.Duration
+		JobPipelineMaxNodeTaskConcurrency() uint64
 		JobPipelineMaxRunDuration() time.Duration
+		JobPipelineMaxRunTaskConcurrency() uint64
 		JobPipelineReaperInterval() time.Duration
 		JobPipelineReaperThreshold() time.Duration
+		JobPipelineScriptTaskMaxDuration() time.Duration
+		JobPipelineScriptTaskMaxMemory() uint64
+		JobPipelineTraceHeaders() []string
 	}
 )
 
@@ -58,6 +64,7 @@ var (
 	ErrInputTaskErrored      = errors.New("input task errored")
 	ErrParameterEmpty        = errors.New("parameter is empty")
 	ErrTooManyErrors         = errors.New("too many errors")
+	ErrTooFewAnswers         = errors.New("too few answers")
 	ErrTimeout               = errors.New("timeout")
 	ErrTaskRunFailed         = errors.New("task run failed")
 )
@@ -86,6 +93,19 @@ func (result Result) ErrorDB() null.String {
 	return errString
 }
 
+// ErrorCategoryDB dumps the category and retryability of a single result
+// error for a pipeline_task_run
+func (result Result) ErrorCategoryDB() (category null.String, retryable bool) {
+	if result.Error == nil {
+		return category, false
+	}
+	var taskErr TaskError
+	if !errors.As(result.Error, &taskErr) {
+		return null.StringFrom(string(ErrorCategoryUnknown)), false
+	}
+	return null.StringFrom(string(taskErr.Category)), taskErr.Retryable
+}
+
 // FinalResult is the result of a Run
 type FinalResult struct {
 	Values []interface{}
@@ -121,6 +141,8 @@ type TaskRunResult struct {
 	Result     Result
 	CreatedAt  time.Time
 	FinishedAt null.Time
+	// Attempts is how many times the task was executed, including retries
+	Attempts uint32
 }
 
 func (result *TaskRunResult) IsPending() bool {
@@ -131,6 +153,25 @@ func (result *TaskRunResult) IsTerminal() bool {
 	return len(result.Task.Outputs()) == 0
 }
 
+func newTaskRunFromResult(run *Run, result TaskRunResult) TaskRun {
+	output := result.Result.OutputDB()
+	errorCategory, errorRetryable := result.Result.ErrorCategoryDB()
+	return TaskRun{
+		ID:             result.ID,
+		PipelineRunID:  run.ID,
+		Type:           result.Task.Type(),
+		Index:          result.Task.OutputIndex(),
+		Output:         &output,
+		Error:          result.Result.ErrorDB(),
+		ErrorCategory:  errorCategory,
+		ErrorRetryable: errorRetryable,
+		DotID:          result.Task.DotID(),
+		CreatedAt:      result.CreatedAt,
+		FinishedAt:     result.FinishedAt,
+		task:           result.Task,
+	}
+}
+
 // TaskRunResults represents a collection of results for all task runs for one pipeline run
 type TaskRunResults []TaskRunResult
 
@@ -224,6 +265,10 @@ const (
 	TaskTypeSum             TaskType = "sum"
 	TaskTypeMultiply        TaskType = "multiply"
 	TaskTypeDivide          TaskType = "divide"
+	TaskTypeAbs             TaskType = "abs"
+	TaskTypeRound           TaskType = "round"
+	TaskTypeFloor           TaskType = "floor"
+	TaskTypeCeil            TaskType = "ceil"
 	TaskTypeJSONParse       TaskType = "jsonparse"
 	TaskTypeCBORParse       TaskType = "cborparse"
 	TaskTypeAny             TaskType = "any"
@@ -233,6 +278,13 @@ const (
 	TaskTypeETHABIEncode    TaskType = "ethabiencode"
 	TaskTypeETHABIDecode    TaskType = "ethabidecode"
 	TaskTypeETHABIDecodeLog TaskType = "ethabidecodelog"
+	TaskTypeWebhookNotify   TaskType = "webhook_notify"
+	TaskTypeScript          TaskType = "script"
+	TaskTypeLowercase       TaskType = "lowercase"
+	TaskTypeUppercase       TaskType = "uppercase"
+	TaskTypeConcat          TaskType = "concat"
+	TaskTypeSubstring       TaskType = "substring"
+	TaskTypeSign            TaskType = "sign"
 
 	// Testing only.
 	TaskTypePanic TaskType = "panic"
@@ -243,6 +295,7 @@ var (
 	bytesType   = reflect.TypeOf([]byte(nil))
 	bytes20Type = reflect.TypeOf([20]byte{})
 	int32Type   = reflect.TypeOf(int32(0))
+	uint32Type  = reflect.TypeOf(uint32(0))
 )
 
 func UnmarshalTaskFromMap(taskType TaskType, taskMap interface{}, ID int, dotID string) (_ Task, err error) {
@@ -280,6 +333,14 @@ func UnmarshalTaskFromMap(taskType TaskType, taskMap interface{}, ID int, dotID
 		task = &MultiplyTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
 	case TaskTypeDivide:
 		task = &DivideTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
+	case TaskTypeAbs:
+		task = &AbsTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
+	case TaskTypeRound:
+		task = &RoundTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
+	case TaskTypeFloor:
+		task = &FloorTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
+	case TaskTypeCeil:
+		task = &CeilTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
 	case TaskTypeVRF:
 		task = &VRFTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
 	case TaskTypeETHCall:
@@ -294,6 +355,20 @@ func UnmarshalTaskFromMap(taskType TaskType, taskMap interface{}, ID int, dotID
 		task = &ETHABIDecodeLogTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
 	case TaskTypeCBORParse:
 		task = &CBORParseTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
+	case TaskTypeWebhookNotify:
+		task = &WebhookNotifyTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
+	case TaskTypeScript:
+		task = &ScriptTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
+	case TaskTypeLowercase:
+		task = &LowercaseTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
+	case TaskTypeUppercase:
+		task = &UppercaseTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
+	case TaskTypeConcat:
+		task = &ConcatTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
+	case TaskTypeSubstring:
+		task = &SubstringTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
+	case TaskTypeSign:
+		task = &SignTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
 	default:
 		return nil, errors.Errorf(`unknown task type: "%v"`, taskType)
 	}
@@ -309,6 +384,9 @@ func UnmarshalTaskFromMap(taskType TaskType, taskMap interface{}, ID int, dotID
 					case int32Type:
 						i, err2 := strconv.ParseInt(data.(string), 10, 32)
 						return int32(i), err2
+					case uint32Type:
+						i, err2 := strconv.ParseUint(data.(string), 10, 32)
+						return uint32(i), err2
 					}
 				}
 				return data, nil
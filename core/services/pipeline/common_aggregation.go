@@ -0,0 +1,52 @@
+package pipeline
+
+import (
+	"sort"
+
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+)
+
+// checkMinAnswers fails if fewer than minAnswers of the inputs to an
+// aggregation task succeeded
+func checkMinAnswers(minAnswers MaybeUint64Param, answers int, taskType TaskType) error {
+	if min, isSet := minAnswers.Uint64(); isSet && uint64(answers) < min {
+		return errors.Wrapf(ErrTooFewAnswers, "Number of successful inputs %v to %s task < minAnswers %v", answers, taskType, min)
+	}
+	return nil
+}
+
+// trimOutliers discards the lowest and highest values, according to trim,
+// and returns the indexes of the remaining values in their original order
+func trimOutliers(values []decimal.Decimal, trim TrimParam) ([]int, error) {
+	indexes := make([]int, len(values))
+	for i := range indexes {
+		indexes[i] = i
+	}
+	n := trim.Count(len(values))
+	if n == 0 {
+		return indexes, nil
+	} else if 2*n >= len(values) {
+		return nil, errors.Wrapf(ErrWrongInputCardinality, "cannot trim %v outliers from each end of %v values", n, len(values))
+	}
+
+	sort.SliceStable(indexes, func(i, j int) bool {
+		return values[indexes[i]].LessThan(values[indexes[j]])
+	})
+	kept := indexes[n : len(indexes)-n]
+	sort.Ints(kept)
+	return kept, nil
+}
+
+// trimDecimalOutliers discards the lowest and highest values, according to trim
+func trimDecimalOutliers(values []decimal.Decimal, trim TrimParam) ([]decimal.Decimal, error) {
+	kept, err := trimOutliers(values, trim)
+	if err != nil {
+		return nil, err
+	}
+	trimmed := make([]decimal.Decimal, len(kept))
+	for i, index := range kept {
+		trimmed[i] = values[index]
+	}
+	return trimmed, nil
+}
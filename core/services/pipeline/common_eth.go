@@ -16,6 +16,7 @@ import (
 
 var (
 	ethABIRegex     = regexp.MustCompile(`\A\s*([a-zA-Z0-9_]+)?\s*\(\s*([a-zA-Z0-9\[\]_\s,]+\s*)?\)`)
+	ethReturnsRegex = regexp.MustCompile(`\)\s*returns\s*\(\s*([a-zA-Z0-9\[\]_\s,]+\s*)?\)\s*\z`)
 	indexedKeyword  = []byte("indexed")
 	calldataKeyword = []byte("calldata")
 	memoryKeyword   = []byte("memory")
@@ -100,6 +101,54 @@ func parseETHABIString(theABI []byte, isLog bool) (name string, args abi.Argumen
 	return name, args, indexedArgs, err
 }
 
+// parseETHABIFunction parses either a JSON ABI or a function signature of the
+// form `name(type1 arg1, ...) returns (type1 out1, ...)`. A JSON ABI may
+// contain several functions, in which case methodName selects one of them.
+func parseETHABIFunction(theABI []byte, methodName string) (abi.Method, error) {
+	theABI = bytes.TrimSpace(theABI)
+	if len(theABI) > 0 && (theABI[0] == '[' || theABI[0] == '{') {
+		if theABI[0] == '{' {
+			theABI = append(append([]byte("["), theABI...), ']')
+		}
+		parsed, err := abi.JSON(bytes.NewReader(theABI))
+		if err != nil {
+			return abi.Method{}, errors.Errorf("bad ABI specification: %v", err)
+		}
+		if methodName != "" {
+			method, exists := parsed.Methods[methodName]
+			if !exists {
+				return abi.Method{}, errors.Errorf("bad ABI specification, no function named '%v'", methodName)
+			}
+			return method, nil
+		}
+		if len(parsed.Methods) != 1 {
+			return abi.Method{}, errors.Errorf("bad ABI specification, expected exactly one function but got %v", len(parsed.Methods))
+		}
+		for _, method := range parsed.Methods {
+			return method, nil
+		}
+	}
+
+	var outputs abi.Arguments
+	if loc := ethReturnsRegex.FindSubmatchIndex(theABI); loc != nil {
+		var err error
+		if loc[2] >= 0 {
+			outputs, _, err = parseETHABIArgsString(theABI[loc[2]:loc[3]], false)
+			if err != nil {
+				return abi.Method{}, err
+			}
+		}
+		theABI = theABI[:loc[0]+1]
+	}
+	name, inputs, _, err := parseETHABIString(theABI, false)
+	if err != nil {
+		return abi.Method{}, err
+	} else if name == "" {
+		return abi.Method{}, errors.Errorf("bad ABI specification, missing function name: %v", string(theABI))
+	}
+	return abi.NewMethod(name, name, abi.Function, "view", false, false, inputs, outputs), nil
+}
+
 func convertToETHABIType(val interface{}, abiType abi.Type) (interface{}, error) {
 	srcVal := reflect.ValueOf(val)
 
@@ -147,11 +196,15 @@ func convertToETHABIType(val interface{}, abiType abi.Type) (interface{}, error)
 			if err != nil {
 				return nil, err
 			}
-			bs, ok := maybeBytes.([20]byte)
-			if !ok {
+			switch bs := maybeBytes.(type) {
+			case [20]byte:
+				return common.Address(bs), nil
+			case []byte:
+				// Hex strings are decoded to a slice of the expected length
+				return common.BytesToAddress(bs), nil
+			default:
 				panic("impossible")
 			}
-			return common.Address(bs), nil
 		}
 
 	case abi.BoolTy:
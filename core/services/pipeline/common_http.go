@@ -4,20 +4,116 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"fmt"
 	"io"
 	"net/http"
+	"strconv"
 	"time"
 
 	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"gopkg.in/guregu/null.v4"
 
+	"github.com/smartcontractkit/chainlink/core/services/keystore"
 	"github.com/smartcontractkit/chainlink/core/utils"
 )
 
+// promHTTPRequestDuration records the HTTP requests of http tasks. Those of
+// bridge tasks are recorded once, by bridge name, in promBridgeLatency.
+var promHTTPRequestDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+	Name:    "pipeline_task_http_request_duration_seconds",
+	Help:    "How long the HTTP requests of http tasks took, by response status code",
+	Buckets: prometheus.ExponentialBuckets(0.001, 4, 10),
+},
+	[]string{"job_id", "job_name", "task_id", "task_type", "status_code"},
+)
+
+type traceIDKey struct{}
+
+// ContextWithTraceID returns a copy of ctx carrying the trace ID of a pipeline
+// run.
+func ContextWithTraceID(ctx context.Context, traceID string) context.Context {
+	return context.WithValue(ctx, traceIDKey{}, traceID)
+}
+
+// TraceIDFromContext returns the trace ID of the pipeline run carried by ctx,
+// if any.
+func TraceIDFromContext(ctx context.Context) (string, bool) {
+	traceID, ok := ctx.Value(traceIDKey{}).(string)
+	return traceID, ok && traceID != ""
+}
+
+type taskMetricsLabelsKey struct{}
+
+// taskMetricsLabels identify the job and task being executed, so that tasks
+// can label their own metrics
+type taskMetricsLabels struct {
+	jobID    string
+	jobName  string
+	taskID   string
+	taskType string
+	// bridgeName is set by bridge tasks, see contextWithBridgeName
+	bridgeName string
+}
+
+func contextWithTaskMetricsLabels(ctx context.Context, spec Spec, task Task) context.Context {
+	return context.WithValue(ctx, taskMetricsLabelsKey{}, taskMetricsLabels{
+		jobID:    strconv.Itoa(int(spec.JobID)),
+		jobName:  spec.JobName,
+		taskID:   task.DotID(),
+		taskType: string(task.Type()),
+	})
+}
+
+// contextWithBridgeName returns a copy of ctx whose task metrics labels
+// carry the name of the bridge called by a bridge task
+func contextWithBridgeName(ctx context.Context, name string) context.Context {
+	labels := taskMetricsLabelsFromContext(ctx)
+	labels.bridgeName = name
+	return context.WithValue(ctx, taskMetricsLabelsKey{}, labels)
+}
+
+// taskMetricsLabelsFromContext returns the labels carried by ctx, or empty
+// labels if the task is not being executed by the runner
+func taskMetricsLabelsFromContext(ctx context.Context) taskMetricsLabels {
+	labels, _ := ctx.Value(taskMetricsLabelsKey{}).(taskMetricsLabels)
+	return labels
+}
+
+// keyStoreContext returns a copy of ctx identifying the job and task being
+// executed as the requester of key accesses in the keystore audit log
+func keyStoreContext(ctx context.Context) context.Context {
+	labels := taskMetricsLabelsFromContext(ctx)
+	requester := keystore.Requester{Service: "pipeline"}
+	if jobID, err := strconv.ParseInt(labels.jobID, 10, 32); err == nil {
+		requester.JobID = null.IntFrom(jobID)
+	}
+	if labels.taskID != "" {
+		requester.Purpose = fmt.Sprintf("%s task %s", labels.taskType, labels.taskID)
+	}
+	return keystore.WithRequester(ctx, requester)
+}
+
+// setTraceHeaders sets each of the configured trace headers to the trace ID
+// of the pipeline run, so that requests can be correlated with the node's
+// run logs by the receiver.
+func setTraceHeaders(ctx context.Context, request *http.Request, cfg Config) {
+	traceID, ok := TraceIDFromContext(ctx)
+	if !ok {
+		return
+	}
+	for _, header := range cfg.JobPipelineTraceHeaders() {
+		request.Header.Set(header, traceID)
+	}
+}
+
 func makeHTTPRequest(
 	ctx context.Context,
 	method StringParam,
 	url URLParam,
 	requestData MapParam,
+	requestHeaders map[string]string,
 	allowUnrestrictedNetworkAccess BoolParam,
 	cfg Config,
 ) ([]byte, http.Header, time.Duration, error) {
@@ -36,6 +132,10 @@ func makeHTTPRequest(
 		return nil, nil, 0, errors.Wrap(err, "failed to create http.Request")
 	}
 	request.Header.Set("Content-Type", "application/json")
+	for name, value := range requestHeaders {
+		request.Header.Set(name, value)
+	}
+	setTraceHeaders(ctx, request, cfg)
 
 	config := utils.HTTPRequestConfig{
 		Timeout:                        cfg.DefaultHTTPTimeout().Duration(),
@@ -51,9 +151,19 @@ func makeHTTPRequest(
 
 	start := time.Now()
 	responseBytes, statusCode, headers, err := httpRequest.SendRequest(ctx)
+	labels := taskMetricsLabelsFromContext(ctx)
+	status := strconv.Itoa(statusCode)
+	if err != nil {
+		status = "error"
+	}
+	if labels.bridgeName != "" {
+		promBridgeLatency.WithLabelValues(labels.jobID, labels.jobName, labels.taskID, labels.bridgeName, status).Observe(time.Since(start).Seconds())
+	} else {
+		promHTTPRequestDuration.WithLabelValues(labels.jobID, labels.jobName, labels.taskID, labels.taskType, status).Observe(time.Since(start).Seconds())
+	}
 	if err != nil {
 		if ctx.Err() != nil {
-			return nil, nil, 0, errors.New("http request timed out or interrupted")
+			return nil, nil, 0, NewTaskError(ErrorCategoryTimeout, true, errors.New("http request timed out or interrupted"))
 		}
 		return nil, nil, 0, errors.Wrapf(err, "error making http request")
 	}
@@ -61,7 +171,9 @@ func makeHTTPRequest(
 
 	if statusCode >= 400 {
 		maybeErr := bestEffortExtractError(responseBytes)
-		return nil, headers, 0, errors.Errorf("got error from %s: (status code %v) %s", url.String(), statusCode, maybeErr)
+		// Only server errors and rate limiting may be resolved by retrying
+		retryable := statusCode >= 500 || statusCode == http.StatusTooManyRequests
+		return nil, headers, 0, NewTaskError(ErrorCategoryNetwork, retryable, errors.Errorf("got error from %s: (status code %v) %s", url.String(), statusCode, maybeErr))
 	}
 	return responseBytes, headers, elapsed, nil
 }
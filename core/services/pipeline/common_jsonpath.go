@@ -0,0 +1,533 @@
+package pipeline
+
+import (
+	"encoding/json"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// jsonPath is a compiled JSONPath expression, such as
+// `$.data[?(@.symbol == "ETH")].price`. Supported are:
+//
+//	$            the root value
+//	.key ['key'] child keys, also as a union: ['a','b']
+//	..key        recursive descent
+//	* [*]        all children
+//	[0] [-1]     array indexes, also as a union: [0,2]
+//	[1:3] [::2]  array slices, with an optional step
+//	[?(expr)]    children matching a filter, where expr compares a path
+//	             relative to the child (@) with a JSON literal using ==, !=,
+//	             <, <=, > or >=, or checks the path exists, and comparisons
+//	             may be combined with && and ||
+type jsonPath struct {
+	segments []jsonPathSegment
+	// definite is true when the expression selects at most one value, in
+	// which case the value itself is the result rather than a list
+	definite bool
+}
+
+type jsonPathSegment struct {
+	recursive bool
+	selector  jsonPathSelector
+}
+
+type jsonPathSelector interface {
+	selectFrom(node interface{}, out []interface{}) []interface{}
+	definite() bool
+}
+
+// isJSONPathExpression tells JSONPath expressions apart from the simple key
+// paths accepted by jsonparse
+func isJSONPathExpression(path string) bool {
+	return path == "$" || strings.HasPrefix(path, "$.") || strings.HasPrefix(path, "$[")
+}
+
+func parseJSONPath(expr string) (*jsonPath, error) {
+	expr = strings.TrimSpace(expr)
+	if expr == "" || (expr[0] != '$' && expr[0] != '@') {
+		return nil, errors.Errorf("JSONPath expression must start with $ or @, got %q", expr)
+	}
+
+	path := &jsonPath{definite: true}
+	for i := 1; i < len(expr); {
+		var (
+			segment jsonPathSegment
+			n       int
+			err     error
+		)
+		switch expr[i] {
+		case '.':
+			if strings.HasPrefix(expr[i:], "..") {
+				segment.recursive = true
+				i += 2
+			} else {
+				i++
+			}
+			if segment.recursive && i < len(expr) && expr[i] == '[' {
+				segment.selector, n, err = parseJSONPathBracket(expr[i:])
+				break
+			}
+			n = strings.IndexAny(expr[i:], ".[")
+			if n == -1 {
+				n = len(expr) - i
+			}
+			name := expr[i : i+n]
+			if name == "" {
+				return nil, errors.Errorf("missing key at position %d of %q", i, expr)
+			} else if name == "*" {
+				segment.selector = jsonPathWildcard{}
+			} else {
+				segment.selector = jsonPathKeys{name}
+			}
+		case '[':
+			segment.selector, n, err = parseJSONPathBracket(expr[i:])
+		default:
+			return nil, errors.Errorf("unexpected %q at position %d of %q", expr[i], i, expr)
+		}
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid JSONPath expression %q", expr)
+		}
+		i += n
+
+		if segment.recursive || !segment.selector.definite() {
+			path.definite = false
+		}
+		path.segments = append(path.segments, segment)
+	}
+	return path, nil
+}
+
+// parseJSONPathBracket parses the bracketed selector at the start of s,
+// returning the number of bytes consumed
+func parseJSONPathBracket(s string) (jsonPathSelector, int, error) {
+	if strings.HasPrefix(s, "[?(") {
+		end := indexOutsideQuotes(s, ")]", 3)
+		if end == -1 {
+			return nil, 0, errors.New("unterminated filter")
+		}
+		filter, err := parseJSONPathFilter(s[3:end])
+		if err != nil {
+			return nil, 0, err
+		}
+		return jsonPathFilterSelector{filter}, end + 2, nil
+	}
+
+	end := indexOutsideQuotes(s, "]", 1)
+	if end == -1 {
+		return nil, 0, errors.New("unterminated [")
+	}
+	content := strings.TrimSpace(s[1:end])
+	n := end + 1
+
+	switch {
+	case content == "":
+		return nil, 0, errors.New("empty []")
+
+	case content == "*":
+		return jsonPathWildcard{}, n, nil
+
+	case content[0] == '\'' || content[0] == '"':
+		var keys jsonPathKeys
+		for _, part := range splitOutsideQuotes(content, ",") {
+			key, err := unquoteJSONPathString(strings.TrimSpace(part))
+			if err != nil {
+				return nil, 0, err
+			}
+			keys = append(keys, key)
+		}
+		return keys, n, nil
+
+	case strings.Contains(content, ":"):
+		parts := strings.Split(content, ":")
+		if len(parts) > 3 {
+			return nil, 0, errors.Errorf("invalid slice [%s]", content)
+		}
+		var bounds [3]*int
+		for i, part := range parts {
+			part = strings.TrimSpace(part)
+			if part == "" {
+				continue
+			}
+			v, err := strconv.Atoi(part)
+			if err != nil {
+				return nil, 0, errors.Errorf("invalid slice [%s]", content)
+			}
+			bounds[i] = &v
+		}
+		if bounds[2] != nil && *bounds[2] == 0 {
+			return nil, 0, errors.New("slice step cannot be 0")
+		}
+		return jsonPathSlice{start: bounds[0], end: bounds[1], step: bounds[2]}, n, nil
+
+	default:
+		var indexes jsonPathIndexes
+		for _, part := range strings.Split(content, ",") {
+			index, err := strconv.Atoi(strings.TrimSpace(part))
+			if err != nil {
+				return nil, 0, errors.Errorf("invalid array index [%s]", content)
+			}
+			indexes = append(indexes, index)
+		}
+		return indexes, n, nil
+	}
+}
+
+// evaluate returns the values selected by the expression from root, in
+// document order
+func (p *jsonPath) evaluate(root interface{}) []interface{} {
+	nodes := []interface{}{root}
+	for _, segment := range p.segments {
+		var next []interface{}
+		for _, node := range nodes {
+			if segment.recursive {
+				for _, descendant := range jsonPathDescendants(node, nil) {
+					next = segment.selector.selectFrom(descendant, next)
+				}
+			} else {
+				next = segment.selector.selectFrom(node, next)
+			}
+		}
+		nodes = next
+	}
+	return nodes
+}
+
+// jsonPathDescendants returns node and everything nested in it
+func jsonPathDescendants(node interface{}, out []interface{}) []interface{} {
+	out = append(out, node)
+	switch n := node.(type) {
+	case map[string]interface{}:
+		for _, key := range sortedKeys(n) {
+			out = jsonPathDescendants(n[key], out)
+		}
+	case []interface{}:
+		for _, child := range n {
+			out = jsonPathDescendants(child, out)
+		}
+	}
+	return out
+}
+
+func sortedKeys(m map[string]interface{}) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+type jsonPathKeys []string
+
+func (s jsonPathKeys) selectFrom(node interface{}, out []interface{}) []interface{} {
+	if m, ok := node.(map[string]interface{}); ok {
+		for _, key := range s {
+			if value, exists := m[key]; exists {
+				out = append(out, value)
+			}
+		}
+	}
+	return out
+}
+
+func (s jsonPathKeys) definite() bool { return len(s) == 1 }
+
+type jsonPathWildcard struct{}
+
+func (jsonPathWildcard) selectFrom(node interface{}, out []interface{}) []interface{} {
+	switch n := node.(type) {
+	case map[string]interface{}:
+		for _, key := range sortedKeys(n) {
+			out = append(out, n[key])
+		}
+	case []interface{}:
+		out = append(out, n...)
+	}
+	return out
+}
+
+func (jsonPathWildcard) definite() bool { return false }
+
+type jsonPathIndexes []int
+
+func (s jsonPathIndexes) selectFrom(node interface{}, out []interface{}) []interface{} {
+	if a, ok := node.([]interface{}); ok {
+		for _, index := range s {
+			if index < 0 {
+				index += len(a)
+			}
+			if index >= 0 && index < len(a) {
+				out = append(out, a[index])
+			}
+		}
+	}
+	return out
+}
+
+func (s jsonPathIndexes) definite() bool { return len(s) == 1 }
+
+// jsonPathSlice selects array elements like a Python slice
+type jsonPathSlice struct {
+	start, end, step *int
+}
+
+func (s jsonPathSlice) selectFrom(node interface{}, out []interface{}) []interface{} {
+	a, ok := node.([]interface{})
+	if !ok {
+		return out
+	}
+	length := len(a)
+	step := 1
+	if s.step != nil {
+		step = *s.step
+	}
+	clamp := func(bound *int, def int) int {
+		if bound == nil {
+			return def
+		}
+		v := *bound
+		if v < 0 {
+			v += length
+		}
+		if step > 0 {
+			if v < 0 {
+				return 0
+			} else if v > length {
+				return length
+			}
+		} else {
+			if v < -1 {
+				return -1
+			} else if v >= length {
+				return length - 1
+			}
+		}
+		return v
+	}
+	if step > 0 {
+		for i := clamp(s.start, 0); i < clamp(s.end, length); i += step {
+			out = append(out, a[i])
+		}
+	} else {
+		for i := clamp(s.start, length-1); i > clamp(s.end, -1); i += step {
+			out = append(out, a[i])
+		}
+	}
+	return out
+}
+
+func (jsonPathSlice) definite() bool { return false }
+
+type jsonPathFilterSelector struct {
+	filter jsonPathFilter
+}
+
+func (s jsonPathFilterSelector) selectFrom(node interface{}, out []interface{}) []interface{} {
+	switch n := node.(type) {
+	case map[string]interface{}:
+		for _, key := range sortedKeys(n) {
+			if s.filter.matches(n[key]) {
+				out = append(out, n[key])
+			}
+		}
+	case []interface{}:
+		for _, child := range n {
+			if s.filter.matches(child) {
+				out = append(out, child)
+			}
+		}
+	}
+	return out
+}
+
+func (jsonPathFilterSelector) definite() bool { return false }
+
+type jsonPathFilter interface {
+	matches(node interface{}) bool
+}
+
+type jsonPathOr []jsonPathFilter
+
+func (f jsonPathOr) matches(node interface{}) bool {
+	for _, filter := range f {
+		if filter.matches(node) {
+			return true
+		}
+	}
+	return false
+}
+
+type jsonPathAnd []jsonPathFilter
+
+func (f jsonPathAnd) matches(node interface{}) bool {
+	for _, filter := range f {
+		if !filter.matches(node) {
+			return false
+		}
+	}
+	return true
+}
+
+// jsonPathComparison compares the value at path with a literal, or checks
+// that path exists if there is no operator
+type jsonPathComparison struct {
+	path  *jsonPath
+	op    string
+	value interface{}
+}
+
+func (f jsonPathComparison) matches(node interface{}) bool {
+	results := f.path.evaluate(node)
+	if f.op == "" {
+		return len(results) > 0
+	}
+	if len(results) != 1 {
+		return false
+	}
+	return compareJSONValues(results[0], f.op, f.value)
+}
+
+var jsonPathOperators = []string{"==", "!=", "<=", ">=", "<", ">"}
+
+func parseJSONPathFilter(expr string) (jsonPathFilter, error) {
+	var or jsonPathOr
+	for _, disjunct := range splitOutsideQuotes(expr, "||") {
+		var and jsonPathAnd
+		for _, conjunct := range splitOutsideQuotes(disjunct, "&&") {
+			comparison, err := parseJSONPathComparison(strings.TrimSpace(conjunct))
+			if err != nil {
+				return nil, err
+			}
+			and = append(and, comparison)
+		}
+		or = append(or, and)
+	}
+	return or, nil
+}
+
+func parseJSONPathComparison(expr string) (jsonPathComparison, error) {
+	var comparison jsonPathComparison
+
+	left := expr
+	for _, op := range jsonPathOperators {
+		if i := indexOutsideQuotes(expr, op, 0); i != -1 {
+			comparison.op = op
+			left = strings.TrimSpace(expr[:i])
+			literal := strings.TrimSpace(expr[i+len(op):])
+			if strings.HasPrefix(literal, "'") {
+				s, err := unquoteJSONPathString(literal)
+				if err != nil {
+					return comparison, err
+				}
+				comparison.value = s
+			} else if err := json.Unmarshal([]byte(literal), &comparison.value); err != nil {
+				return comparison, errors.Errorf("invalid filter value %s", literal)
+			}
+			break
+		}
+	}
+	if !strings.HasPrefix(left, "@") {
+		return comparison, errors.Errorf("filter must compare a path starting with @, got %q", expr)
+	}
+
+	path, err := parseJSONPath(left)
+	if err != nil {
+		return comparison, err
+	}
+	comparison.path = path
+	return comparison, nil
+}
+
+func compareJSONValues(a interface{}, op string, b interface{}) bool {
+	switch av := a.(type) {
+	case float64:
+		if bv, ok := b.(float64); ok {
+			switch op {
+			case "==":
+				return av == bv
+			case "!=":
+				return av != bv
+			case "<":
+				return av < bv
+			case "<=":
+				return av <= bv
+			case ">":
+				return av > bv
+			case ">=":
+				return av >= bv
+			}
+		}
+	case string:
+		if bv, ok := b.(string); ok {
+			switch op {
+			case "==":
+				return av == bv
+			case "!=":
+				return av != bv
+			case "<":
+				return av < bv
+			case "<=":
+				return av <= bv
+			case ">":
+				return av > bv
+			case ">=":
+				return av >= bv
+			}
+		}
+	}
+	switch op {
+	case "==":
+		return reflect.DeepEqual(a, b)
+	case "!=":
+		return !reflect.DeepEqual(a, b)
+	}
+	return false
+}
+
+func unquoteJSONPathString(s string) (string, error) {
+	if len(s) >= 2 && s[0] == '\'' && s[len(s)-1] == '\'' {
+		return strings.ReplaceAll(s[1:len(s)-1], `\'`, `'`), nil
+	}
+	unquoted, err := strconv.Unquote(s)
+	if err != nil {
+		return "", errors.Errorf("invalid quoted key %s", s)
+	}
+	return unquoted, nil
+}
+
+// indexOutsideQuotes returns the index of the first occurrence of sep in s at
+// or after from which is not inside a quoted string, or -1
+func indexOutsideQuotes(s, sep string, from int) int {
+	var quote byte
+	for i := from; i < len(s); i++ {
+		switch {
+		case quote != 0:
+			if s[i] == '\\' {
+				i++
+			} else if s[i] == quote {
+				quote = 0
+			}
+		case s[i] == '\'' || s[i] == '"':
+			quote = s[i]
+		case strings.HasPrefix(s[i:], sep):
+			return i
+		}
+	}
+	return -1
+}
+
+func splitOutsideQuotes(s, sep string) []string {
+	var parts []string
+	for {
+		i := indexOutsideQuotes(s, sep, 0)
+		if i == -1 {
+			return append(parts, s)
+		}
+		parts = append(parts, s[:i])
+		s = s[i+len(sep):]
+	}
+}
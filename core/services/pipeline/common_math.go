@@ -0,0 +1,82 @@
+package pipeline
+
+import (
+	"github.com/shopspring/decimal"
+)
+
+// RoundingMode selects how a decimal is rounded to a given precision
+type RoundingMode string
+
+const (
+	// RoundingModeHalfUp rounds to the nearest value, and half way values
+	// away from zero. This is the default.
+	RoundingModeHalfUp RoundingMode = "half_up"
+	// RoundingModeHalfEven rounds to the nearest value, and half way values
+	// to the nearest even value (banker's rounding).
+	RoundingModeHalfEven RoundingMode = "half_even"
+	// RoundingModeUp rounds away from zero
+	RoundingModeUp RoundingMode = "up"
+	// RoundingModeDown rounds towards zero, i.e. truncates
+	RoundingModeDown RoundingMode = "down"
+	// RoundingModeCeil rounds towards positive infinity
+	RoundingModeCeil RoundingMode = "ceil"
+	// RoundingModeFloor rounds towards negative infinity
+	RoundingModeFloor RoundingMode = "floor"
+)
+
+var decimalOne = decimal.New(1, 0)
+
+// roundDecimal rounds d to an integer multiple of 10^(-precision)
+func roundDecimal(d decimal.Decimal, precision int32, mode RoundingMode) decimal.Decimal {
+	return divRound(d, decimalOne, precision, mode)
+}
+
+// divRound divides a by b and rounds the exact quotient to an integer multiple
+// of 10^(-precision). Unlike rounding the result of Div, this never rounds
+// twice, since Div itself rounds to decimal.DivisionPrecision digits.
+func divRound(a, b decimal.Decimal, precision int32, mode RoundingMode) decimal.Decimal {
+	// q is the quotient truncated towards zero and a = b*q + r
+	q, r := a.QuoRem(b, precision)
+	if r.IsZero() {
+		return q
+	}
+
+	sign := int64(a.Sign() * b.Sign())
+	awayFromZero := q.Add(decimal.New(sign, -precision))
+
+	switch mode {
+	case RoundingModeDown:
+		return q
+	case RoundingModeUp:
+		return awayFromZero
+	case RoundingModeCeil:
+		if sign > 0 {
+			return awayFromZero
+		}
+		return q
+	case RoundingModeFloor:
+		if sign < 0 {
+			return awayFromZero
+		}
+		return q
+	}
+
+	// Compare the remainder to half of the unit at the precision, i.e.
+	// 2|r| against |b|*10^(-precision)
+	half := r.Abs().Mul(decimal.New(2, 0)).Cmp(b.Abs().Mul(decimal.New(1, -precision)))
+	switch {
+	case half > 0:
+		return awayFromZero
+	case half < 0:
+		return q
+	case mode == RoundingModeHalfEven:
+		// q is an integer multiple of 10^(-precision), so it is even if its
+		// last digit at that precision is
+		if q.Shift(precision).BigInt().Bit(0) == 0 {
+			return q
+		}
+		return awayFromZero
+	default:
+		return awayFromZero
+	}
+}
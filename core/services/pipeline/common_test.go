@@ -29,6 +29,26 @@ func TestTimeoutAttribute(t *testing.T) {
 	assert.Equal(t, false, set)
 }
 
+func TestRetryAttributes(t *testing.T) {
+	t.Parallel()
+
+	a := `ds1 [type=http method=GET url="https://chain.link/voter_turnout/USA-2020" retries=3 minBackoff="1s" maxBackoff="30s"];`
+	p, err := pipeline.Parse(a)
+	require.NoError(t, err)
+	assert.Equal(t, uint32(3), p.Tasks[0].Base().Retries)
+	assert.Equal(t, time.Second, p.Tasks[0].Base().MinBackoff)
+	assert.Equal(t, 30*time.Second, p.Tasks[0].Base().MaxBackoff)
+
+	a = `ds1 [type=http method=GET url="https://chain.link/voter_turnout/USA-2020"];`
+	p, err = pipeline.Parse(a)
+	require.NoError(t, err)
+	assert.Equal(t, uint32(0), p.Tasks[0].Base().Retries)
+
+	a = `ds1 [type=http method=GET url="https://chain.link/voter_turnout/USA-2020" retries=-1];`
+	_, err = pipeline.Parse(a)
+	require.Error(t, err)
+}
+
 func Test_TaskHTTPUnmarshal(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,103 @@
+package pipeline
+
+import (
+	"encoding/json"
+	"math/big"
+	"sort"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+// DailyCost is the estimated cost of running a pipeline spec over one UTC day.
+// Gas is attributed to the day on which the run that created the transaction
+// started, and only counts transactions that have been mined.
+type DailyCost struct {
+	Day           time.Time
+	Runs          int64
+	BridgeCredits decimal.Decimal
+	GasUsed       uint64
+	// GasFee is the fee paid for the transactions in wei, i.e. the gas used
+	// multiplied by the gas price of the attempt that was mined.
+	GasFee *big.Int
+}
+
+// FindDailyCosts returns the costs of the runs of a pipeline spec created at
+// or after since, aggregated per UTC day, oldest first.
+func (o *orm) FindDailyCosts(pipelineSpecID int32, since time.Time) ([]DailyCost, error) {
+	var runRows []struct {
+		Day           time.Time
+		Runs          int64
+		BridgeCredits decimal.Decimal
+	}
+	err := o.db.Raw(`
+		SELECT date_trunc('day', created_at AT TIME ZONE 'UTC') AS day, count(*) AS runs, COALESCE(sum(bridge_credits), 0) AS bridge_credits
+		FROM pipeline_runs
+		WHERE pipeline_spec_id = ? AND created_at >= ?
+		GROUP BY day
+	`, pipelineSpecID, since).Scan(&runRows).Error
+	if err != nil {
+		return nil, errors.Wrap(err, "FindDailyCosts failed to load runs")
+	}
+
+	// A transaction may have several attempts but only one of them can be
+	// mined, so each receipt is counted once, against the latest block it
+	// was seen in.
+	var gasRows []struct {
+		Day      time.Time
+		GasPrice utils.Big
+		Receipt  []byte
+	}
+	err = o.db.Raw(`
+		SELECT DISTINCT ON (eth_receipts.tx_hash) date_trunc('day', pipeline_runs.created_at AT TIME ZONE 'UTC') AS day, eth_tx_attempts.gas_price, eth_receipts.receipt
+		FROM pipeline_runs
+		JOIN eth_txes ON eth_txes.pipeline_run_id = pipeline_runs.id
+		JOIN eth_tx_attempts ON eth_tx_attempts.eth_tx_id = eth_txes.id
+		JOIN eth_receipts ON eth_receipts.tx_hash = eth_tx_attempts.hash
+		WHERE pipeline_runs.pipeline_spec_id = ? AND pipeline_runs.created_at >= ?
+		ORDER BY eth_receipts.tx_hash, eth_receipts.block_number DESC
+	`, pipelineSpecID, since).Scan(&gasRows).Error
+	if err != nil {
+		return nil, errors.Wrap(err, "FindDailyCosts failed to load transactions")
+	}
+
+	byDay := make(map[time.Time]*DailyCost, len(runRows))
+	for _, row := range runRows {
+		day := row.Day.UTC()
+		byDay[day] = &DailyCost{
+			Day:           day,
+			Runs:          row.Runs,
+			BridgeCredits: row.BridgeCredits,
+			GasFee:        big.NewInt(0),
+		}
+	}
+	for _, row := range gasRows {
+		var receipt struct {
+			GasUsed hexutil.Uint64 `json:"gasUsed"`
+		}
+		if err = json.Unmarshal(row.Receipt, &receipt); err != nil {
+			return nil, errors.Wrap(err, "FindDailyCosts failed to decode receipt")
+		}
+		cost, exists := byDay[row.Day.UTC()]
+		if !exists {
+			// Cannot happen since every transaction belongs to a run
+			continue
+		}
+		cost.GasUsed += uint64(receipt.GasUsed)
+		fee := new(big.Int).Mul(new(big.Int).SetUint64(uint64(receipt.GasUsed)), row.GasPrice.ToInt())
+		cost.GasFee.Add(cost.GasFee, fee)
+	}
+
+	costs := make([]DailyCost, 0, len(byDay))
+	for _, cost := range byDay {
+		costs = append(costs, *cost)
+	}
+	sort.Slice(costs, func(i, j int) bool {
+		return costs[i].Day.Before(costs[j].Day)
+	})
+	return costs, nil
+}
@@ -1,6 +1,8 @@
 package pipeline
 
 import (
+	"context"
+
 	uuid "github.com/satori/go.uuid"
 	"gorm.io/gorm"
 
@@ -9,6 +11,12 @@ import (
 
 var (
 	NewKeypathFromString = newKeypathFromString
+	NewBridgeLimiter     = newBridgeLimiter
+	ClassifyTaskError    = classifyTaskError
+
+	PromHTTPRequestDuration      = promHTTPRequestDuration
+	PromBridgeLatency            = promBridgeLatency
+	ContextWithTaskMetricsLabels = contextWithTaskMetricsLabels
 )
 
 const (
@@ -37,6 +45,9 @@ func (t *BridgeTask) HelperSetDependencies(config Config, db *gorm.DB, id uuid.U
 	t.id = id
 }
 
+func (l *bridgeLimiter) Acquire(ctx context.Context) error { return l.acquire(ctx) }
+func (l *bridgeLimiter) Release()                          { l.release() }
+
 func (t *HTTPTask) HelperSetDependencies(config Config) {
 	t.config = config
 }
@@ -51,3 +62,21 @@ func (t *ETHTxTask) HelperSetDependencies(db *gorm.DB, config Config, keyStore E
 	t.keyStore = keyStore
 	t.txManager = txManager
 }
+
+func (t *ETHTxTask) HelperSetEthClient(client eth.Client) {
+	t.ethClient = client
+}
+
+func (t *WebhookNotifyTask) HelperSetDependencies(config Config, keyStore ETHKeyStore) {
+	t.config = config
+	t.keyStore = keyStore
+}
+
+func (t *ScriptTask) HelperSetDependencies(config Config) {
+	t.config = config
+}
+
+func (t *SignTask) HelperSetDependencies(ethKeyStore ETHKeyStore, ocrKeyStore OCRKeyStore) {
+	t.ethKeyStore = ethKeyStore
+	t.ocrKeyStore = ocrKeyStore
+}
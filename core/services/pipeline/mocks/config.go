@@ -130,6 +130,20 @@ func (_m *Config) EthGasLimitDefault() uint64 {
 	return r0
 }
 
+// EthGasLimitEstimateMultiplier provides a mock function with given fields:
+func (_m *Config) EthGasLimitEstimateMultiplier() float32 {
+	ret := _m.Called()
+
+	var r0 float32
+	if rf, ok := ret.Get(0).(func() float32); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(float32)
+	}
+
+	return r0
+}
+
 // EthMaxQueuedTransactions provides a mock function with given fields:
 func (_m *Config) EthMaxQueuedTransactions() uint64 {
 	ret := _m.Called()
@@ -144,6 +158,20 @@ func (_m *Config) EthMaxQueuedTransactions() uint64 {
 	return r0
 }
 
+// JobPipelineMaxNodeTaskConcurrency provides a mock function with given fields:
+func (_m *Config) JobPipelineMaxNodeTaskConcurrency() uint64 {
+	ret := _m.Called()
+
+	var r0 uint64
+	if rf, ok := ret.Get(0).(func() uint64); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint64)
+	}
+
+	return r0
+}
+
 // JobPipelineMaxRunDuration provides a mock function with given fields:
 func (_m *Config) JobPipelineMaxRunDuration() time.Duration {
 	ret := _m.Called()
@@ -158,6 +186,20 @@ func (_m *Config) JobPipelineMaxRunDuration() time.Duration {
 	return r0
 }
 
+// JobPipelineMaxRunTaskConcurrency provides a mock function with given fields:
+func (_m *Config) JobPipelineMaxRunTaskConcurrency() uint64 {
+	ret := _m.Called()
+
+	var r0 uint64
+	if rf, ok := ret.Get(0).(func() uint64); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint64)
+	}
+
+	return r0
+}
+
 // JobPipelineReaperInterval provides a mock function with given fields:
 func (_m *Config) JobPipelineReaperInterval() time.Duration {
 	ret := _m.Called()
@@ -186,6 +228,50 @@ func (_m *Config) JobPipelineReaperThreshold() time.Duration {
 	return r0
 }
 
+// JobPipelineScriptTaskMaxDuration provides a mock function with given fields:
+func (_m *Config) JobPipelineScriptTaskMaxDuration() time.Duration {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
+// JobPipelineScriptTaskMaxMemory provides a mock function with given fields:
+func (_m *Config) JobPipelineScriptTaskMaxMemory() uint64 {
+	ret := _m.Called()
+
+	var r0 uint64
+	if rf, ok := ret.Get(0).(func() uint64); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint64)
+	}
+
+	return r0
+}
+
+// JobPipelineTraceHeaders provides a mock function with given fields:
+func (_m *Config) JobPipelineTraceHeaders() []string {
+	ret := _m.Called()
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func() []string); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	return r0
+}
+
 // TriggerFallbackDBPollInterval provides a mock function with given fields:
 func (_m *Config) TriggerFallbackDBPollInterval() time.Duration {
 	ret := _m.Called()
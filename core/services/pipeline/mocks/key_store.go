@@ -3,6 +3,8 @@
 package mocks
 
 import (
+	context "context"
+
 	common "github.com/ethereum/go-ethereum/common"
 	mock "github.com/stretchr/testify/mock"
 )
@@ -40,3 +42,26 @@ func (_m *KeyStore) GetRoundRobinAddress(addrs ...common.Address) (common.Addres
 
 	return r0, r1
 }
+
+// SignMessage provides a mock function with given fields: ctx, address, msg
+func (_m *KeyStore) SignMessage(ctx context.Context, address common.Address, msg []byte) ([]byte, error) {
+	ret := _m.Called(ctx, address, msg)
+
+	var r0 []byte
+	if rf, ok := ret.Get(0).(func(context.Context, common.Address, []byte) []byte); ok {
+		r0 = rf(ctx, address, msg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]byte)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, common.Address, []byte) error); ok {
+		r1 = rf(ctx, address, msg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
@@ -39,20 +39,20 @@ func (_m *ORM) CreateRun(db *gorm.DB, run *pipeline.Run) error {
 	return r0
 }
 
-// CreateSpec provides a mock function with given fields: ctx, tx, _a2, maxTaskTimeout
-func (_m *ORM) CreateSpec(ctx context.Context, tx *gorm.DB, _a2 pipeline.Pipeline, maxTaskTimeout models.Interval) (int32, error) {
-	ret := _m.Called(ctx, tx, _a2, maxTaskTimeout)
+// CreateSpec provides a mock function with given fields: ctx, tx, _a2, maxTaskTimeout, inputSchema
+func (_m *ORM) CreateSpec(ctx context.Context, tx *gorm.DB, _a2 pipeline.Pipeline, maxTaskTimeout models.Interval, inputSchema pipeline.VarsSchema) (int32, error) {
+	ret := _m.Called(ctx, tx, _a2, maxTaskTimeout, inputSchema)
 
 	var r0 int32
-	if rf, ok := ret.Get(0).(func(context.Context, *gorm.DB, pipeline.Pipeline, models.Interval) int32); ok {
-		r0 = rf(ctx, tx, _a2, maxTaskTimeout)
+	if rf, ok := ret.Get(0).(func(context.Context, *gorm.DB, pipeline.Pipeline, models.Interval, pipeline.VarsSchema) int32); ok {
+		r0 = rf(ctx, tx, _a2, maxTaskTimeout, inputSchema)
 	} else {
 		r0 = ret.Get(0).(int32)
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *gorm.DB, pipeline.Pipeline, models.Interval) error); ok {
-		r1 = rf(ctx, tx, _a2, maxTaskTimeout)
+	if rf, ok := ret.Get(1).(func(context.Context, *gorm.DB, pipeline.Pipeline, models.Interval, pipeline.VarsSchema) error); ok {
+		r1 = rf(ctx, tx, _a2, maxTaskTimeout, inputSchema)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -90,6 +90,73 @@ func (_m *ORM) DeleteRunsOlderThan(threshold time.Duration) error {
 	return r0
 }
 
+// FindDailyCosts provides a mock function with given fields: pipelineSpecID, since
+func (_m *ORM) FindDailyCosts(pipelineSpecID int32, since time.Time) ([]pipeline.DailyCost, error) {
+	ret := _m.Called(pipelineSpecID, since)
+
+	var r0 []pipeline.DailyCost
+	if rf, ok := ret.Get(0).(func(int32, time.Time) []pipeline.DailyCost); ok {
+		r0 = rf(pipelineSpecID, since)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]pipeline.DailyCost)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(int32, time.Time) error); ok {
+		r1 = rf(pipelineSpecID, since)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindNumericAnswers provides a mock function with given fields: pipelineSpecID, limit
+func (_m *ORM) FindNumericAnswers(pipelineSpecID int32, limit int) ([]pipeline.Run, error) {
+	ret := _m.Called(pipelineSpecID, limit)
+
+	var r0 []pipeline.Run
+	if rf, ok := ret.Get(0).(func(int32, int) []pipeline.Run); ok {
+		r0 = rf(pipelineSpecID, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]pipeline.Run)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(int32, int) error); ok {
+		r1 = rf(pipelineSpecID, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindPendingTaskRun provides a mock function with given fields: taskID
+func (_m *ORM) FindPendingTaskRun(taskID uuid.UUID) (pipeline.TaskRun, error) {
+	ret := _m.Called(taskID)
+
+	var r0 pipeline.TaskRun
+	if rf, ok := ret.Get(0).(func(uuid.UUID) pipeline.TaskRun); ok {
+		r0 = rf(taskID)
+	} else {
+		r0 = ret.Get(0).(pipeline.TaskRun)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(uuid.UUID) error); ok {
+		r1 = rf(taskID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // FindRun provides a mock function with given fields: id
 func (_m *ORM) FindRun(id int64) (pipeline.Run, error) {
 	ret := _m.Called(id)
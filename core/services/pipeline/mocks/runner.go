@@ -105,6 +105,20 @@ func (_m *Runner) Healthy() error {
 	return r0
 }
 
+// InFlightRuns provides a mock function with given fields:
+func (_m *Runner) InFlightRuns() int64 {
+	ret := _m.Called()
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func() int64); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	return r0
+}
+
 // InsertFinishedRun provides a mock function with given fields: db, run, trrs, saveSuccessfulTaskRuns
 func (_m *Runner) InsertFinishedRun(db *gorm.DB, run pipeline.Run, trrs pipeline.TaskRunResults, saveSuccessfulTaskRuns bool) (int64, error) {
 	ret := _m.Called(db, run, trrs, saveSuccessfulTaskRuns)
@@ -175,6 +189,22 @@ func (_m *Runner) Start() error {
 	return r0
 }
 
+// SubscribeToRunUpdates provides a mock function with given fields: filter
+func (_m *Runner) SubscribeToRunUpdates(filter pipeline.RunUpdatesFilter) pipeline.RunUpdatesSubscription {
+	ret := _m.Called(filter)
+
+	var r0 pipeline.RunUpdatesSubscription
+	if rf, ok := ret.Get(0).(func(pipeline.RunUpdatesFilter) pipeline.RunUpdatesSubscription); ok {
+		r0 = rf(filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(pipeline.RunUpdatesSubscription)
+		}
+	}
+
+	return r0
+}
+
 // TestInsertFinishedRun provides a mock function with given fields: db, jobID, jobName, jobType, specID
 func (_m *Runner) TestInsertFinishedRun(db *gorm.DB, jobID int32, jobName string, jobType string, specID int32) (int64, error) {
 	ret := _m.Called(db, jobID, jobName, jobType, specID)
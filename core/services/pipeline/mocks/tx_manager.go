@@ -3,6 +3,8 @@
 package mocks
 
 import (
+	big "math/big"
+
 	common "github.com/ethereum/go-ethereum/common"
 	bulletprooftxmanager "github.com/smartcontractkit/chainlink/core/services/bulletprooftxmanager"
 
@@ -16,20 +18,43 @@ type TxManager struct {
 	mock.Mock
 }
 
-// CreateEthTransaction provides a mock function with given fields: db, fromAddress, toAddress, payload, gasLimit, meta, strategy
-func (_m *TxManager) CreateEthTransaction(db *gorm.DB, fromAddress common.Address, toAddress common.Address, payload []byte, gasLimit uint64, meta interface{}, strategy bulletprooftxmanager.TxStrategy) (bulletprooftxmanager.EthTx, error) {
-	ret := _m.Called(db, fromAddress, toAddress, payload, gasLimit, meta, strategy)
+// CreateEthTransaction provides a mock function with given fields: db, fromAddress, toAddress, payload, gasLimit, meta, strategy, urgency, expiry
+func (_m *TxManager) CreateEthTransaction(db *gorm.DB, fromAddress common.Address, toAddress common.Address, payload []byte, gasLimit uint64, meta interface{}, strategy bulletprooftxmanager.TxStrategy, urgency bulletprooftxmanager.EthTxUrgency, expiry bulletprooftxmanager.EthTxExpiry, simulate bool) (bulletprooftxmanager.EthTx, error) {
+	ret := _m.Called(db, fromAddress, toAddress, payload, gasLimit, meta, strategy, urgency, expiry, simulate)
 
 	var r0 bulletprooftxmanager.EthTx
-	if rf, ok := ret.Get(0).(func(*gorm.DB, common.Address, common.Address, []byte, uint64, interface{}, bulletprooftxmanager.TxStrategy) bulletprooftxmanager.EthTx); ok {
-		r0 = rf(db, fromAddress, toAddress, payload, gasLimit, meta, strategy)
+	if rf, ok := ret.Get(0).(func(*gorm.DB, common.Address, common.Address, []byte, uint64, interface{}, bulletprooftxmanager.TxStrategy, bulletprooftxmanager.EthTxUrgency, bulletprooftxmanager.EthTxExpiry, bool) bulletprooftxmanager.EthTx); ok {
+		r0 = rf(db, fromAddress, toAddress, payload, gasLimit, meta, strategy, urgency, expiry, simulate)
 	} else {
 		r0 = ret.Get(0).(bulletprooftxmanager.EthTx)
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*gorm.DB, common.Address, common.Address, []byte, uint64, interface{}, bulletprooftxmanager.TxStrategy) error); ok {
-		r1 = rf(db, fromAddress, toAddress, payload, gasLimit, meta, strategy)
+	if rf, ok := ret.Get(1).(func(*gorm.DB, common.Address, common.Address, []byte, uint64, interface{}, bulletprooftxmanager.TxStrategy, bulletprooftxmanager.EthTxUrgency, bulletprooftxmanager.EthTxExpiry, bool) error); ok {
+		r1 = rf(db, fromAddress, toAddress, payload, gasLimit, meta, strategy, urgency, expiry, simulate)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ForChain provides a mock function with given fields: chainID
+func (_m *TxManager) ForChain(chainID *big.Int) (bulletprooftxmanager.TxManager, error) {
+	ret := _m.Called(chainID)
+
+	var r0 bulletprooftxmanager.TxManager
+	if rf, ok := ret.Get(0).(func(*big.Int) bulletprooftxmanager.TxManager); ok {
+		r0 = rf(chainID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(bulletprooftxmanager.TxManager)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*big.Int) error); ok {
+		r1 = rf(chainID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -9,8 +9,10 @@ import (
 
 	"github.com/pkg/errors"
 	uuid "github.com/satori/go.uuid"
+	"github.com/shopspring/decimal"
 
 	"github.com/smartcontractkit/chainlink/core/store/models"
+	"github.com/smartcontractkit/chainlink/core/utils"
 
 	"gopkg.in/guregu/null.v4"
 )
@@ -20,6 +22,7 @@ type Spec struct {
 	DotDagSource    string          `json:"dotDagSource"`
 	CreatedAt       time.Time       `json:"-"`
 	MaxTaskDuration models.Interval `json:"-"`
+	InputSchema     VarsSchema      `json:"inputSchema"`
 
 	JobID   int32  `gorm:"-" json:"-"`
 	JobName string `gorm:"-" json:"-"`
@@ -44,15 +47,28 @@ type Run struct {
 	Inputs JSONSerializable `json:"inputs" gorm:"type:jsonb"`
 	// Its expected that Output.Val is of type []interface{}.
 	// DB example: [1234, {"a": 10}, null]
-	Outputs          JSONSerializable `json:"outputs" gorm:"type:jsonb"`
-	CreatedAt        time.Time        `json:"createdAt"`
-	FinishedAt       null.Time        `json:"finishedAt"`
-	PipelineTaskRuns []TaskRun        `json:"taskRuns" gorm:"foreignkey:PipelineRunID;->"`
-	State            RunStatus        `json:"state"`
+	Outputs JSONSerializable `json:"outputs" gorm:"type:jsonb"`
+	// NumericAnswer holds the single terminal output of a successful run when
+	// it can be represented as a number, e.g. the answer of an FM/OCR job.
+	// It is stored in a typed column so answer history can be queried
+	// without parsing the JSON outputs.
+	NumericAnswer decimal.NullDecimal `json:"numericAnswer"`
+	// BridgeCredits is the estimated cost of the run in external adapter
+	// credits, summed over the credit costs of the bridges it called. It is
+	// null for runs without any bridge tasks.
+	BridgeCredits    decimal.NullDecimal `json:"bridgeCredits"`
+	CreatedAt        time.Time           `json:"createdAt"`
+	FinishedAt       null.Time           `json:"finishedAt"`
+	PipelineTaskRuns []TaskRun           `json:"taskRuns" gorm:"foreignkey:PipelineRunID;->"`
+	State            RunStatus           `json:"state"`
 
 	Async     bool `gorm:"-"`
 	Pending   bool `gorm:"-"`
 	FailEarly bool `gorm:"-"`
+
+	// ethTxIDs are the eth_txes created by the run, which are linked to the
+	// run when it is saved.
+	ethTxIDs []int64
 }
 
 func (Run) TableName() string {
@@ -92,6 +108,43 @@ func (r *Run) Status() RunStatus {
 	return RunStatusRunning
 }
 
+// ComputeNumericAnswer derives the numeric answer from the run outputs. A run
+// only has a numeric answer if it finished without errors and produced
+// exactly one output that can be converted to a decimal.
+func (r Run) ComputeNumericAnswer() decimal.NullDecimal {
+	if !r.FinishedAt.Valid || r.HasErrors() {
+		return decimal.NullDecimal{}
+	}
+	outputs, ok := r.Outputs.Val.([]interface{})
+	if !ok || len(outputs) != 1 || outputs[0] == nil {
+		return decimal.NullDecimal{}
+	}
+	answer, err := utils.ToDecimal(outputs[0])
+	if err != nil {
+		return decimal.NullDecimal{}
+	}
+	return decimal.NullDecimal{Decimal: answer, Valid: true}
+}
+
+// attributeCosts adds the costs incurred by tasks executed in this pass of the
+// run to any costs already recorded on it, e.g. before the run was suspended.
+// Results loaded from previously executed task runs carry no cost.
+func (r *Run) attributeCosts(results TaskRunResults) {
+	for _, result := range results {
+		switch task := result.Task.(type) {
+		case *BridgeTask:
+			r.BridgeCredits = decimal.NullDecimal{
+				Decimal: r.BridgeCredits.Decimal.Add(task.creditCost),
+				Valid:   true,
+			}
+		case *ETHTxTask:
+			if task.ethTxID != 0 {
+				r.ethTxIDs = append(r.ethTxIDs, task.ethTxID)
+			}
+		}
+	}
+}
+
 func (r *Run) ByDotID(id string) *TaskRun {
 	for i, run := range r.PipelineTaskRuns {
 		if run.DotID == id {
@@ -137,10 +190,13 @@ type TaskRun struct {
 	PipelineRunID int64             `json:"-"`
 	Output        *JSONSerializable `json:"output" gorm:"type:jsonb"`
 	Error         null.String       `json:"error"`
-	CreatedAt     time.Time         `json:"createdAt"`
-	FinishedAt    null.Time         `json:"finishedAt"`
-	Index         int32             `json:"index"`
-	DotID         string            `json:"dotId"`
+	// ErrorCategory and ErrorRetryable classify Error, see TaskError
+	ErrorCategory  null.String `json:"errorCategory"`
+	ErrorRetryable bool        `json:"errorRetryable"`
+	CreatedAt      time.Time   `json:"createdAt"`
+	FinishedAt     null.Time   `json:"finishedAt"`
+	Index          int32       `json:"index"`
+	DotID          string      `json:"dotId"`
 
 	// Used internally for sorting completed results
 	task Task
@@ -170,17 +226,35 @@ func (tr TaskRun) GetDotID() string {
 func (tr TaskRun) Result() Result {
 	var result Result
 	if !tr.Error.IsZero() {
-		result.Error = errors.New(tr.Error.ValueOrZero())
+		result.Error = tr.resultError()
 	} else if tr.Output != nil && tr.Output.Val != nil {
 		result.Value = tr.Output.Val
 	}
 	return result
 }
 
+func (tr TaskRun) resultError() error {
+	return taskErrorFromDB(tr.DotID, tr.Error, tr.ErrorCategory, tr.ErrorRetryable)
+}
+
 func (tr *TaskRun) IsPending() bool {
 	return !tr.FinishedAt.Valid && tr.Output.Empty() && tr.Error.IsZero()
 }
 
+// BridgeName returns the name of the bridge called by a bridge task run. The
+// task run's PipelineRun.PipelineSpec must be loaded.
+func (tr TaskRun) BridgeName() (string, error) {
+	p, err := Parse(tr.PipelineRun.PipelineSpec.DotDagSource)
+	if err != nil {
+		return "", err
+	}
+	task, is := p.ByDotID(tr.DotID).(*BridgeTask)
+	if !is {
+		return "", errors.Errorf("task %s is not a bridge task", tr.DotID)
+	}
+	return task.Name, nil
+}
+
 // RunStatus represents the status of a run
 type RunStatus string
 
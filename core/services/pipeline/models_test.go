@@ -5,6 +5,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/shopspring/decimal"
 	"github.com/smartcontractkit/chainlink/core/services/pipeline"
 	"github.com/stretchr/testify/assert"
 	"gopkg.in/guregu/null.v4"
@@ -70,3 +71,79 @@ func TestRun_Status(t *testing.T) {
 		})
 	}
 }
+
+func TestRun_ComputeNumericAnswer(t *testing.T) {
+	now := null.TimeFrom(time.Now())
+
+	testCases := []struct {
+		name  string
+		run   pipeline.Run
+		valid bool
+		want  decimal.Decimal
+	}{
+		{
+			name: "unfinished",
+			run: pipeline.Run{
+				Outputs: pipeline.JSONSerializable{Val: []interface{}{10}},
+				Errors:  pipeline.RunErrors{null.String{}},
+			},
+		},
+		{
+			name: "errored",
+			run: pipeline.Run{
+				Outputs:    pipeline.JSONSerializable{Val: []interface{}{nil}},
+				Errors:     pipeline.RunErrors{null.StringFrom("fail")},
+				FinishedAt: now,
+			},
+		},
+		{
+			name: "multiple outputs",
+			run: pipeline.Run{
+				Outputs:    pipeline.JSONSerializable{Val: []interface{}{10, 10}},
+				Errors:     pipeline.RunErrors{null.String{}, null.String{}},
+				FinishedAt: now,
+			},
+		},
+		{
+			name: "non-numeric output",
+			run: pipeline.Run{
+				Outputs:    pipeline.JSONSerializable{Val: []interface{}{"queued eth transaction"}},
+				Errors:     pipeline.RunErrors{null.String{}},
+				FinishedAt: now,
+			},
+		},
+		{
+			name: "decimal output",
+			run: pipeline.Run{
+				Outputs:    pipeline.JSONSerializable{Val: []interface{}{decimal.RequireFromString("1234.5678")}},
+				Errors:     pipeline.RunErrors{null.String{}},
+				FinishedAt: now,
+			},
+			valid: true,
+			want:  decimal.RequireFromString("1234.5678"),
+		},
+		{
+			name: "numeric string output",
+			run: pipeline.Run{
+				Outputs:    pipeline.JSONSerializable{Val: []interface{}{"42"}},
+				Errors:     pipeline.RunErrors{null.String{}},
+				FinishedAt: now,
+			},
+			valid: true,
+			want:  decimal.NewFromInt(42),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			answer := tc.run.ComputeNumericAnswer()
+			assert.Equal(t, tc.valid, answer.Valid)
+			if tc.valid {
+				assert.True(t, tc.want.Equal(answer.Decimal), "expected %s, got %s", tc.want, answer.Decimal)
+			}
+		})
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
 	"github.com/pkg/errors"
 	uuid "github.com/satori/go.uuid"
 	"gorm.io/gorm"
@@ -23,14 +24,17 @@ var (
 //go:generate mockery --name ORM --output ./mocks/ --case=underscore
 
 type ORM interface {
-	CreateSpec(ctx context.Context, tx *gorm.DB, pipeline Pipeline, maxTaskTimeout models.Interval) (int32, error)
+	CreateSpec(ctx context.Context, tx *gorm.DB, pipeline Pipeline, maxTaskTimeout models.Interval, inputSchema VarsSchema) (int32, error)
 	CreateRun(db *gorm.DB, run *Run) (err error)
 	StoreRun(db *sql.DB, run *Run) (restart bool, err error)
 	UpdateTaskRunResult(db *sql.DB, taskID uuid.UUID, result interface{}) (run Run, start bool, err error)
+	FindPendingTaskRun(taskID uuid.UUID) (TaskRun, error)
 	InsertFinishedRun(db *gorm.DB, run Run, trrs []TaskRunResult, saveSuccessfulTaskRuns bool) (runID int64, err error)
 	DeleteRunsOlderThan(threshold time.Duration) error
 	FindRun(id int64) (Run, error)
 	GetAllRuns() ([]Run, error)
+	FindNumericAnswers(pipelineSpecID int32, limit int) ([]Run, error)
+	FindDailyCosts(pipelineSpecID int32, since time.Time) ([]DailyCost, error)
 	GetUnfinishedRuns(now time.Time, fn func(run Run) error) error
 	DB() *gorm.DB
 }
@@ -46,10 +50,11 @@ func NewORM(db *gorm.DB) *orm {
 }
 
 // The tx argument must be an already started transaction.
-func (o *orm) CreateSpec(ctx context.Context, tx *gorm.DB, pipeline Pipeline, maxTaskDuration models.Interval) (int32, error) {
+func (o *orm) CreateSpec(ctx context.Context, tx *gorm.DB, pipeline Pipeline, maxTaskDuration models.Interval, inputSchema VarsSchema) (int32, error) {
 	spec := Spec{
 		DotDagSource:    pipeline.Source,
 		MaxTaskDuration: maxTaskDuration,
+		InputSchema:     inputSchema,
 	}
 	err := tx.Create(&spec).Error
 	if err != nil {
@@ -109,7 +114,7 @@ func (o *orm) StoreRun(db *sql.DB, run *Run) (restart bool, err error) {
 
 			// Suspend the run
 			run.State = RunStatusSuspended
-			if _, err = tx.NamedExec(`UPDATE pipeline_runs SET state = :state`, run); err != nil {
+			if _, err = tx.NamedExec(`UPDATE pipeline_runs SET state = :state, bridge_credits = :bridge_credits WHERE id = :id`, run); err != nil {
 				return err
 			}
 		} else {
@@ -117,17 +122,25 @@ func (o *orm) StoreRun(db *sql.DB, run *Run) (restart bool, err error) {
 			if run.Outputs.Val == nil || len(run.Errors) == 0 {
 				return errors.Errorf("run must have both Outputs and Errors, got Outputs: %#v, Errors: %#v", run.Outputs.Val, run.Errors)
 			}
-			sql := `UPDATE pipeline_runs SET state = :state, finished_at = :finished_at, errors= :errors, outputs = :outputs WHERE id = :id`
+			sql := `UPDATE pipeline_runs SET state = :state, finished_at = :finished_at, errors= :errors, outputs = :outputs, numeric_answer = :numeric_answer, bridge_credits = :bridge_credits WHERE id = :id`
 			if _, err = tx.NamedExec(sql, run); err != nil {
 				return err
 			}
 		}
 
+		// Link any transactions created by the run so their gas can be
+		// attributed to it
+		if len(run.ethTxIDs) > 0 {
+			if _, err = tx.Exec(`UPDATE eth_txes SET pipeline_run_id = $1 WHERE id = ANY($2)`, run.ID, pq.Array(run.ethTxIDs)); err != nil {
+				return errors.Wrap(err, "error linking eth_txes to pipeline_run")
+			}
+		}
+
 		sql := `
-		INSERT INTO pipeline_task_runs (pipeline_run_id, id, type, index, output, error, dot_id, created_at, finished_at)
-		VALUES (:pipeline_run_id, :id, :type, :index, :output, :error, :dot_id, :created_at, :finished_at)
+		INSERT INTO pipeline_task_runs (pipeline_run_id, id, type, index, output, error, error_category, error_retryable, dot_id, created_at, finished_at)
+		VALUES (:pipeline_run_id, :id, :type, :index, :output, :error, :error_category, :error_retryable, :dot_id, :created_at, :finished_at)
 		ON CONFLICT (pipeline_run_id, dot_id) DO UPDATE SET
-		output = EXCLUDED.output, error = EXCLUDED.error, finished_at = EXCLUDED.finished_at
+		output = EXCLUDED.output, error = EXCLUDED.error, error_category = EXCLUDED.error_category, error_retryable = EXCLUDED.error_retryable, finished_at = EXCLUDED.finished_at
 		RETURNING *;
 		`
 
@@ -156,7 +169,7 @@ func (o *orm) UpdateTaskRunResult(db *sql.DB, taskID uuid.UUID, result interface
 		FROM pipeline_runs
 		JOIN pipeline_task_runs ON (pipeline_task_runs.pipeline_run_id = pipeline_runs.id)
 		JOIN pipeline_specs ON (pipeline_specs.id = pipeline_runs.pipeline_spec_id)
-		WHERE pipeline_task_runs.id = $1 AND pipeline_runs.state in ('running', 'suspended')
+		WHERE pipeline_task_runs.id = $1 AND pipeline_task_runs.finished_at IS NULL AND pipeline_runs.state in ('running', 'suspended')
 		FOR UPDATE`
 		if err = tx.Get(&run, sql, taskID); err != nil {
 			return err
@@ -189,6 +202,17 @@ func (o *orm) UpdateTaskRunResult(db *sql.DB, taskID uuid.UUID, result interface
 	return run, start, err
 }
 
+// FindPendingTaskRun finds an async task run which is waiting for its result,
+// along with its run and pipeline spec
+func (o *orm) FindPendingTaskRun(taskID uuid.UUID) (TaskRun, error) {
+	var taskRun TaskRun
+	err := o.db.
+		Preload("PipelineRun.PipelineSpec").
+		Where("id = ? AND finished_at IS NULL", taskID).
+		First(&taskRun).Error
+	return taskRun, err
+}
+
 // If saveSuccessfulTaskRuns = false, we only save errored runs.
 // That way if the job is run frequently (such as OCR) we avoid saving a large number of successful task runs
 // which do not provide much value.
@@ -211,19 +235,28 @@ func (o *orm) InsertFinishedRun(db *gorm.DB, run Run, trrs []TaskRunResult, save
 			return errors.Wrap(err, "error inserting finished pipeline_run")
 		}
 
+		// Link any transactions created by the run so their gas can be
+		// attributed to it
+		if len(run.ethTxIDs) > 0 {
+			if err = tx.Exec(`UPDATE eth_txes SET pipeline_run_id = ? WHERE id = ANY(?)`, run.ID, pq.Array(run.ethTxIDs)).Error; err != nil {
+				return errors.Wrap(err, "error linking eth_txes to pipeline_run")
+			}
+		}
+
 		if !saveSuccessfulTaskRuns && !run.HasErrors() {
 			return nil
 		}
 
 		sql := `
-		INSERT INTO pipeline_task_runs (pipeline_run_id, id, type, index, output, error, dot_id, created_at, finished_at)
+		INSERT INTO pipeline_task_runs (pipeline_run_id, id, type, index, output, error, error_category, error_retryable, dot_id, created_at, finished_at)
 		VALUES %s
 		`
 		valueStrings := []string{}
 		valueArgs := []interface{}{}
 		for _, trr := range trrs {
-			valueStrings = append(valueStrings, "(?,?,?,?,?,?,?,?,?)")
-			valueArgs = append(valueArgs, run.ID, trr.ID, trr.Task.Type(), trr.Task.OutputIndex(), trr.Result.OutputDB(), trr.Result.ErrorDB(), trr.Task.DotID(), trr.CreatedAt, trr.FinishedAt)
+			errorCategory, errorRetryable := trr.Result.ErrorCategoryDB()
+			valueStrings = append(valueStrings, "(?,?,?,?,?,?,?,?,?,?,?)")
+			valueArgs = append(valueArgs, run.ID, trr.ID, trr.Task.Type(), trr.Task.OutputIndex(), trr.Result.OutputDB(), trr.Result.ErrorDB(), errorCategory, errorRetryable, trr.Task.DotID(), trr.CreatedAt, trr.FinishedAt)
 		}
 
 		/* #nosec G201 */
@@ -265,6 +298,20 @@ func (o *orm) GetAllRuns() ([]Run, error) {
 	return runs, err
 }
 
+// FindNumericAnswers returns the most recent runs of a pipeline spec that
+// produced a numeric answer, newest first. Only the columns required for
+// answer history are loaded.
+func (o *orm) FindNumericAnswers(pipelineSpecID int32, limit int) ([]Run, error) {
+	var runs []Run
+	err := o.db.
+		Select("id, pipeline_spec_id, numeric_answer, state, created_at, finished_at").
+		Where("pipeline_spec_id = ? AND numeric_answer IS NOT NULL", pipelineSpecID).
+		Order("created_at DESC, id DESC").
+		Limit(limit).
+		Find(&runs).Error
+	return runs, errors.Wrap(err, "FindNumericAnswers failed")
+}
+
 func (o *orm) GetUnfinishedRuns(now time.Time, fn func(run Run) error) error {
 	return postgres.Batch(func(offset, limit uint) (count uint, err error) {
 		var runs []Run
@@ -7,10 +7,14 @@ import (
 
 	"github.com/bmizerany/assert"
 	uuid "github.com/satori/go.uuid"
+	"github.com/shopspring/decimal"
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
 	"github.com/smartcontractkit/chainlink/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/core/services/bulletprooftxmanager"
 	"github.com/smartcontractkit/chainlink/core/services/pipeline"
 	"github.com/smartcontractkit/chainlink/core/services/postgres"
 	"github.com/smartcontractkit/chainlink/core/store/models"
+	"github.com/smartcontractkit/chainlink/core/utils"
 	"github.com/stretchr/testify/require"
 	"gopkg.in/guregu/null.v4"
 	"gorm.io/gorm"
@@ -28,7 +32,7 @@ func Test_PipelineORM_CreateSpec(t *testing.T) {
 		Source: source,
 	}
 
-	id, err := orm.CreateSpec(context.Background(), db, p, maxTaskDuration)
+	id, err := orm.CreateSpec(context.Background(), db, p, maxTaskDuration, nil)
 	require.NoError(t, err)
 
 	actual := pipeline.Spec{}
@@ -91,7 +95,7 @@ answer2 [type=bridge name=election_winner index=1];
 	require.NotNil(t, p)
 
 	maxTaskDuration := models.Interval(1 * time.Minute)
-	specID, err := orm.CreateSpec(context.Background(), db, *p, maxTaskDuration)
+	specID, err := orm.CreateSpec(context.Background(), db, *p, maxTaskDuration, nil)
 	require.NoError(t, err)
 
 	run := &pipeline.Run{
@@ -315,3 +319,101 @@ func Test_PipelineORM_StoreRun_UpdateTaskRunResult(t *testing.T) {
 	require.True(t, task.FinishedAt.Valid)
 	require.Equal(t, &pipeline.JSONSerializable{Val: "foo"}, task.Output)
 }
+
+func Test_PipelineORM_FindNumericAnswers(t *testing.T) {
+	db, orm := setupORM(t)
+
+	p, err := pipeline.Parse(`answer [type=sum values=<[1, 2]>];`)
+	require.NoError(t, err)
+	specID, err := orm.CreateSpec(context.Background(), db, *p, models.Interval(1*time.Minute), nil)
+	require.NoError(t, err)
+
+	now := time.Now()
+	for i, output := range []interface{}{"1.5", "not a number", "2.5"} {
+		run := pipeline.Run{
+			PipelineSpecID: specID,
+			State:          pipeline.RunStatusCompleted,
+			Outputs:        pipeline.JSONSerializable{Val: []interface{}{output}},
+			Errors:         pipeline.RunErrors{null.String{}},
+			CreatedAt:      now.Add(time.Duration(i) * time.Second),
+			FinishedAt:     null.TimeFrom(now.Add(time.Duration(i) * time.Second)),
+		}
+		run.NumericAnswer = run.ComputeNumericAnswer()
+		_, err = orm.InsertFinishedRun(db, run, nil, false)
+		require.NoError(t, err)
+	}
+
+	runs, err := orm.FindNumericAnswers(specID, 10)
+	require.NoError(t, err)
+	require.Len(t, runs, 2)
+	require.True(t, runs[0].NumericAnswer.Decimal.Equal(decimal.RequireFromString("2.5")))
+	require.True(t, runs[1].NumericAnswer.Decimal.Equal(decimal.RequireFromString("1.5")))
+
+	runs, err = orm.FindNumericAnswers(specID, 1)
+	require.NoError(t, err)
+	require.Len(t, runs, 1)
+}
+
+func Test_PipelineORM_FindDailyCosts(t *testing.T) {
+	db, orm := setupORM(t)
+
+	p, err := pipeline.Parse(`answer [type=sum values=<[1, 2]>];`)
+	require.NoError(t, err)
+	specID, err := orm.CreateSpec(context.Background(), db, *p, models.Interval(1*time.Minute), nil)
+	require.NoError(t, err)
+
+	now := time.Now().UTC()
+	yesterday := now.AddDate(0, 0, -1)
+	var runIDs []int64
+	for _, r := range []struct {
+		createdAt     time.Time
+		bridgeCredits decimal.NullDecimal
+	}{
+		{yesterday, decimal.NullDecimal{Decimal: decimal.RequireFromString("1.5"), Valid: true}},
+		{now, decimal.NullDecimal{Decimal: decimal.RequireFromString("2"), Valid: true}},
+		{now, decimal.NullDecimal{}},
+	} {
+		run := pipeline.Run{
+			PipelineSpecID: specID,
+			State:          pipeline.RunStatusCompleted,
+			Outputs:        pipeline.JSONSerializable{Val: []interface{}{"3"}},
+			Errors:         pipeline.RunErrors{null.String{}},
+			BridgeCredits:  r.bridgeCredits,
+			CreatedAt:      r.createdAt,
+			FinishedAt:     null.TimeFrom(r.createdAt),
+		}
+		runID, err := orm.InsertFinishedRun(db, run, nil, false)
+		require.NoError(t, err)
+		runIDs = append(runIDs, runID)
+	}
+
+	key := cltest.MustInsertRandomKey(t, db)
+	etx := cltest.MustInsertConfirmedEthTxWithAttempt(t, db, 0, 1, key.Address.Address())
+	require.NoError(t, db.Exec(`UPDATE eth_tx_attempts SET gas_price = 2 WHERE id = ?`, etx.EthTxAttempts[0].ID).Error)
+	receipt := bulletprooftxmanager.EthReceipt{
+		BlockNumber: 1,
+		BlockHash:   utils.NewHash(),
+		TxHash:      etx.EthTxAttempts[0].Hash,
+		Receipt:     []byte(`{"gasUsed":"0x5208"}`),
+	}
+	require.NoError(t, db.Create(&receipt).Error)
+	require.NoError(t, db.Exec(`UPDATE eth_txes SET pipeline_run_id = ? WHERE id = ?`, runIDs[1], etx.ID).Error)
+
+	costs, err := orm.FindDailyCosts(specID, now.AddDate(0, 0, -2))
+	require.NoError(t, err)
+	require.Len(t, costs, 2)
+
+	require.Equal(t, int64(1), costs[0].Runs)
+	require.True(t, costs[0].BridgeCredits.Equal(decimal.RequireFromString("1.5")))
+	require.Equal(t, uint64(0), costs[0].GasUsed)
+	require.Equal(t, "0", costs[0].GasFee.String())
+
+	require.Equal(t, int64(2), costs[1].Runs)
+	require.True(t, costs[1].BridgeCredits.Equal(decimal.RequireFromString("2")))
+	require.Equal(t, uint64(21000), costs[1].GasUsed)
+	require.Equal(t, "42000", costs[1].GasFee.String())
+
+	costs, err = orm.FindDailyCosts(specID, now.Add(time.Hour))
+	require.NoError(t, err)
+	require.Len(t, costs, 0)
+}
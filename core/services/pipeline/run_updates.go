@@ -0,0 +1,141 @@
+package pipeline
+
+import (
+	"sync"
+
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+)
+
+// runUpdatesBufferSize is how many updates a subscriber may fall behind by
+// before further updates are dropped for it
+const runUpdatesBufferSize = 100
+
+// RunUpdate is published as each task of a pipeline run finishes (TaskRun is
+// set), and once more when the run is saved (TaskRun is nil).
+type RunUpdate struct {
+	// RunID is 0 for task updates of runs which are only saved once they
+	// finish, i.e. runs without async tasks
+	RunID      int64
+	JobID      int32
+	State      RunStatus
+	TaskRun    *TaskRun
+	FinishedAt null.Time
+}
+
+// RunUpdatesFilter selects the updates of a subscription. Zero fields match
+// any run or job.
+type RunUpdatesFilter struct {
+	RunID int64
+	JobID int32
+}
+
+func (f RunUpdatesFilter) matches(update RunUpdate) bool {
+	return (f.RunID == 0 || f.RunID == update.RunID) &&
+		(f.JobID == 0 || f.JobID == update.JobID)
+}
+
+// RunUpdatesSubscription receives the updates of pipeline runs as they are
+// executed by the runner. Updates are dropped if the subscriber falls too far
+// behind, so subscribers should fall back to reading the run from the
+// database when it is finished.
+type RunUpdatesSubscription interface {
+	Updates() <-chan RunUpdate
+	Close()
+}
+
+type runUpdatesSubscription struct {
+	filter      RunUpdatesFilter
+	broadcaster *runUpdatesBroadcaster
+	chUpdates   chan RunUpdate
+}
+
+var _ RunUpdatesSubscription = (*runUpdatesSubscription)(nil)
+
+func (sub *runUpdatesSubscription) Updates() <-chan RunUpdate {
+	return sub.chUpdates
+}
+
+func (sub *runUpdatesSubscription) Close() {
+	sub.broadcaster.unsubscribe(sub)
+}
+
+type runUpdatesBroadcaster struct {
+	mu            sync.RWMutex
+	subscriptions map[*runUpdatesSubscription]struct{}
+}
+
+func newRunUpdatesBroadcaster() *runUpdatesBroadcaster {
+	return &runUpdatesBroadcaster{
+		subscriptions: make(map[*runUpdatesSubscription]struct{}),
+	}
+}
+
+func (b *runUpdatesBroadcaster) subscribe(filter RunUpdatesFilter) *runUpdatesSubscription {
+	sub := &runUpdatesSubscription{
+		filter:      filter,
+		broadcaster: b,
+		chUpdates:   make(chan RunUpdate, runUpdatesBufferSize),
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.subscriptions[sub] = struct{}{}
+	return sub
+}
+
+func (b *runUpdatesBroadcaster) unsubscribe(sub *runUpdatesSubscription) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if _, exists := b.subscriptions[sub]; exists {
+		delete(b.subscriptions, sub)
+		close(sub.chUpdates)
+	}
+}
+
+// publish never blocks the runner on a slow subscriber
+func (b *runUpdatesBroadcaster) publish(update RunUpdate) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	for sub := range b.subscriptions {
+		if !sub.filter.matches(update) {
+			continue
+		}
+		select {
+		case sub.chUpdates <- update:
+		default:
+			logger.Warnw("Pipeline run updates subscriber is not keeping up, dropping update", "runID", update.RunID, "jobID", update.JobID)
+		}
+	}
+}
+
+func (b *runUpdatesBroadcaster) publishTaskRun(run *Run, result TaskRunResult) {
+	if !b.hasSubscribers() {
+		return
+	}
+	taskRun := newTaskRunFromResult(run, result)
+	b.publish(RunUpdate{
+		RunID:   run.ID,
+		JobID:   run.PipelineSpec.JobID,
+		State:   RunStatusRunning,
+		TaskRun: &taskRun,
+	})
+}
+
+func (b *runUpdatesBroadcaster) publishRun(run Run) {
+	if !b.hasSubscribers() {
+		return
+	}
+	b.publish(RunUpdate{
+		RunID:      run.ID,
+		JobID:      run.PipelineSpec.JobID,
+		State:      run.State,
+		FinishedAt: run.FinishedAt,
+	})
+}
+
+func (b *runUpdatesBroadcaster) hasSubscribers() bool {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	return len(b.subscriptions) > 0
+}
@@ -7,6 +7,7 @@ import (
 	"runtime/debug"
 	"sort"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/pkg/errors"
@@ -46,6 +47,13 @@ type Runner interface {
 
 	// Test method for inserting completed non-pipeline job runs
 	TestInsertFinishedRun(db *gorm.DB, jobID int32, jobName string, jobType string, specID int32) (int64, error)
+
+	// SubscribeToRunUpdates streams the results of tasks as they finish, and
+	// the state of runs as they are saved, for runs matching filter.
+	SubscribeToRunUpdates(filter RunUpdatesFilter) RunUpdatesSubscription
+
+	// InFlightRuns returns the number of runs currently being executed
+	InFlightRuns() int64
 }
 
 type runner struct {
@@ -54,8 +62,15 @@ type runner struct {
 	ethClient       eth.Client
 	ethKeyStore     ETHKeyStore
 	vrfKeyStore     VRFKeyStore
+	ocrKeyStore     OCRKeyStore
 	txManager       TxManager
+	secrets         SecretStore
 	runReaperWorker utils.SleeperTask
+	taskLimiter     *taskLimiter
+	resultCache     *taskResultCache
+	bridges         *bridges
+	runUpdates      *runUpdatesBroadcaster
+	inFlightRuns    int64
 
 	utils.StartStopOnce
 	chStop chan struct{}
@@ -90,16 +105,49 @@ var (
 	},
 		[]string{"job_id", "job_name", "task_id", "task_type", "status"},
 	)
+	PromPipelineTasksQueued = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "pipeline_tasks_queued",
+		Help: "The number of pipeline tasks which are ready to run but waiting for a free worker or node task slot",
+	},
+		[]string{"job_id", "job_name"},
+	)
+	PromPipelineTaskQueueTime = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "pipeline_task_queue_time_seconds",
+		Help:    "How long pipeline tasks waited to start executing after becoming ready to run",
+		Buckets: prometheus.ExponentialBuckets(0.001, 4, 10),
+	},
+		[]string{"job_id", "job_name"},
+	)
+	PromPipelineTaskDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "pipeline_task_duration_seconds",
+		Help:    "How long pipeline tasks took to execute, including any retries",
+		Buckets: prometheus.ExponentialBuckets(0.001, 4, 10),
+	},
+		[]string{"job_id", "job_name", "task_id", "task_type", "status"},
+	)
+	PromPipelineRunDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "pipeline_run_duration_seconds",
+		Help:    "How long pipeline runs took to finish (from the moment they were created, including any time suspended)",
+		Buckets: prometheus.ExponentialBuckets(0.01, 4, 10),
+	},
+		[]string{"job_id", "job_name", "status"},
+	)
 )
 
-func NewRunner(orm ORM, config Config, ethClient eth.Client, ethks ETHKeyStore, vrfks VRFKeyStore, txManager TxManager) *runner {
+func NewRunner(orm ORM, config Config, ethClient eth.Client, ethks ETHKeyStore, vrfks VRFKeyStore, ocrks OCRKeyStore, txManager TxManager, secrets SecretStore) *runner {
 	r := &runner{
 		orm:         orm,
 		config:      config,
 		ethClient:   ethClient,
 		ethKeyStore: ethks,
 		vrfKeyStore: vrfks,
+		ocrKeyStore: ocrks,
 		txManager:   txManager,
+		secrets:     secrets,
+		taskLimiter: newTaskLimiter(config.JobPipelineMaxNodeTaskConcurrency()),
+		resultCache: newTaskResultCache(),
+		bridges:     newBridges(),
+		runUpdates:  newRunUpdatesBroadcaster(),
 		chStop:      make(chan struct{}),
 		wgDone:      sync.WaitGroup{},
 	}
@@ -150,9 +198,10 @@ func (r *runner) runReaperLoop() {
 }
 
 type memoryTaskRun struct {
-	task   Task
-	inputs []Result // sorted by input index
-	vars   Vars
+	task        Task
+	inputs      []Result // sorted by input index
+	vars        Vars
+	scheduledAt time.Time
 }
 
 // When a task panics, we catch the panic and wrap it in an error for reporting to the scheduler.
@@ -185,6 +234,10 @@ func (r *runner) ExecuteRun(
 
 	run := NewRun(spec, vars)
 
+	if err := spec.InputSchema.ValidateVars(vars); err != nil {
+		return run, nil, err
+	}
+
 	taskRunResults, err := r.run(ctx, &run, vars, l)
 	if err != nil {
 		return run, nil, err
@@ -213,6 +266,13 @@ func (r *runner) run(
 	vars Vars,
 	l logger.Logger,
 ) (TaskRunResults, error) {
+	atomic.AddInt64(&r.inFlightRuns, 1)
+	defer atomic.AddInt64(&r.inFlightRuns, -1)
+
+	traceID := uuid.NewV4().String()
+	ctx = ContextWithTraceID(ctx, traceID)
+	l.SugaredLogger = l.With("traceID", traceID)
+
 	l.Debugw("Initiating tasks for pipeline run of spec", "job ID", run.PipelineSpec.JobID, "job name", run.PipelineSpec.JobName)
 
 	pipeline, err := Parse(run.PipelineSpec.DotDagSource)
@@ -222,11 +282,17 @@ func (r *runner) run(
 
 	// initialize certain task params
 	for _, task := range pipeline.Tasks {
+		if err = interpolateSecrets(task, r.secrets); err != nil {
+			return nil, err
+		}
 		switch task.Type() {
 		case TaskTypeHTTP:
 			task.(*HTTPTask).config = r.config
+			task.(*HTTPTask).cache = r.resultCache
 		case TaskTypeBridge:
 			task.(*BridgeTask).config = r.config
+			task.(*BridgeTask).cache = r.resultCache
+			task.(*BridgeTask).bridges = r.bridges
 			task.(*BridgeTask).db = r.orm.DB()
 			task.(*BridgeTask).id = uuid.NewV4()
 		case TaskTypeETHCall:
@@ -238,6 +304,15 @@ func (r *runner) run(
 			task.(*ETHTxTask).config = r.config
 			task.(*ETHTxTask).keyStore = r.ethKeyStore
 			task.(*ETHTxTask).txManager = r.txManager
+			task.(*ETHTxTask).ethClient = r.ethClient
+		case TaskTypeWebhookNotify:
+			task.(*WebhookNotifyTask).config = r.config
+			task.(*WebhookNotifyTask).keyStore = r.ethKeyStore
+		case TaskTypeScript:
+			task.(*ScriptTask).config = r.config
+		case TaskTypeSign:
+			task.(*SignTask).ethKeyStore = r.ethKeyStore
+			task.(*SignTask).ocrKeyStore = r.ocrKeyStore
 		default:
 		}
 	}
@@ -256,29 +331,55 @@ func (r *runner) run(
 	scheduler := newScheduler(todo, pipeline, run, vars)
 	go scheduler.Run()
 
-	for taskRun := range scheduler.taskCh {
-		// execute
-		go func(taskRun *memoryTaskRun) {
-			defer func() {
-				if err := recover(); err != nil {
-					logger.Default.Errorw("goroutine panicked executing run", "panic", err, "stacktrace", string(debug.Stack()))
-
-					t := time.Now()
-					scheduler.report(todo, TaskRunResult{
-						ID:         uuid.NewV4(),
-						Task:       taskRun.task,
-						Result:     Result{Error: ErrRunPanicked{err}},
-						FinishedAt: null.TimeFrom(t),
-						CreatedAt:  t, // TODO: more accurate start time
-					})
-				}
-			}()
-			result := r.executeTaskRun(ctx, run.PipelineSpec, taskRun, l)
+	execute := func(taskRun *memoryTaskRun) {
+		defer func() {
+			if err := recover(); err != nil {
+				logger.Default.Errorw("goroutine panicked executing run", "panic", err, "stacktrace", string(debug.Stack()))
+
+				t := time.Now()
+				scheduler.report(todo, TaskRunResult{
+					ID:         uuid.NewV4(),
+					Task:       taskRun.task,
+					Result:     Result{Error: classifyTaskError(taskRun.task, ErrRunPanicked{err})},
+					FinishedAt: null.TimeFrom(t),
+					CreatedAt:  t, // TODO: more accurate start time
+				})
+			}
+		}()
+		result := r.executeQueuedTaskRun(ctx, run.PipelineSpec, taskRun, l)
 
-			logTaskRunToPrometheus(result, run.PipelineSpec)
+		logTaskRunToPrometheus(result, run.PipelineSpec)
+		r.runUpdates.publishTaskRun(run, result)
 
-			scheduler.report(todo, result)
-		}(taskRun)
+		scheduler.report(todo, result)
+	}
+
+	if maxConcurrency := r.config.JobPipelineMaxRunTaskConcurrency(); maxConcurrency > 0 {
+		// a fixed pool of workers executes the tasks as they become ready,
+		// the rest wait in the scheduler's queue
+		workers := int(maxConcurrency)
+		if workers > len(pipeline.Tasks) {
+			workers = len(pipeline.Tasks)
+		}
+		if workers == 0 {
+			// still drain the queue until the scheduler finishes
+			workers = 1
+		}
+		var wg sync.WaitGroup
+		wg.Add(workers)
+		for i := 0; i < workers; i++ {
+			go func() {
+				defer wg.Done()
+				for taskRun := range scheduler.taskCh {
+					execute(taskRun)
+				}
+			}()
+		}
+		wg.Wait()
+	} else {
+		for taskRun := range scheduler.taskCh {
+			go execute(taskRun)
+		}
 	}
 
 	// if the run is suspended, awaiting resumption
@@ -298,19 +399,7 @@ func (r *runner) run(
 	// Update run results
 	run.PipelineTaskRuns = nil
 	for _, result := range scheduler.results {
-		output := result.Result.OutputDB()
-		run.PipelineTaskRuns = append(run.PipelineTaskRuns, TaskRun{
-			ID:            result.ID,
-			PipelineRunID: run.ID,
-			Type:          result.Task.Type(),
-			Index:         result.Task.OutputIndex(),
-			Output:        &output,
-			Error:         result.Result.ErrorDB(),
-			DotID:         result.Task.DotID(),
-			CreatedAt:     result.CreatedAt,
-			FinishedAt:    result.FinishedAt,
-			task:          result.Task,
-		})
+		run.PipelineTaskRuns = append(run.PipelineTaskRuns, newTaskRunFromResult(run, result))
 
 		sort.Slice(run.PipelineTaskRuns, func(i, j int) bool {
 			return run.PipelineTaskRuns[i].task.OutputIndex() < run.PipelineTaskRuns[j].task.OutputIndex()
@@ -331,12 +420,14 @@ func (r *runner) run(
 		}
 		run.Errors = errors
 		run.Outputs = JSONSerializable{Val: outputs, Null: false}
+		run.NumericAnswer = run.ComputeNumericAnswer()
 
 		if run.HasErrors() {
 			run.State = RunStatusErrored
 		} else {
 			run.State = RunStatusCompleted
 		}
+		PromPipelineRunDuration.WithLabelValues(fmt.Sprintf("%d", run.PipelineSpec.JobID), run.PipelineSpec.JobName, string(run.State)).Observe(run.FinishedAt.Time.Sub(run.CreatedAt).Seconds())
 	}
 
 	// TODO: drop this once we stop using TaskRunResults
@@ -345,6 +436,8 @@ func (r *runner) run(
 		taskRunResults = append(taskRunResults, result)
 	}
 
+	run.attributeCosts(taskRunResults)
+
 	return taskRunResults, err
 }
 
@@ -370,7 +463,9 @@ func (r *runner) executeTaskRun(ctx context.Context, spec Spec, taskRun *memoryT
 		defer cancel()
 	}
 
+	ctx = contextWithTaskMetricsLabels(ctx, spec, taskRun.task)
 	result := taskRun.task.Run(ctx, taskRun.vars, taskRun.inputs)
+	result.Error = taskRun.task.Base().redactSecrets(classifyTaskError(taskRun.task, result.Error))
 	loggerFields = append(loggerFields, "resultValue", result.Value)
 	loggerFields = append(loggerFields, "resultError", result.Error)
 	loggerFields = append(loggerFields, "resultType", fmt.Sprintf("%T", result.Value))
@@ -399,6 +494,75 @@ func (r *runner) executeTaskRun(ctx context.Context, spec Spec, taskRun *memoryT
 	}
 }
 
+// executeQueuedTaskRun waits for a node wide task slot, if the node limits
+// task concurrency, before executing the task run. A failed task is retried
+// according to its retry policy, without holding the slot while backing off,
+// unless its TaskError is not retryable.
+func (r *runner) executeQueuedTaskRun(ctx context.Context, spec Spec, taskRun *memoryTaskRun, l logger.Logger) TaskRunResult {
+	err := r.taskLimiter.acquire(ctx)
+
+	jobID := fmt.Sprintf("%d", spec.JobID)
+	PromPipelineTasksQueued.WithLabelValues(jobID, spec.JobName).Dec()
+	PromPipelineTaskQueueTime.WithLabelValues(jobID, spec.JobName).Observe(time.Since(taskRun.scheduledAt).Seconds())
+
+	if err != nil {
+		now := time.Now()
+		return TaskRunResult{
+			ID:         uuid.NewV4(),
+			Task:       taskRun.task,
+			Result:     Result{Error: classifyTaskError(taskRun.task, errors.Wrap(err, "timed out waiting for a free task slot"))},
+			CreatedAt:  now,
+			FinishedAt: null.TimeFrom(now),
+		}
+	}
+
+	result := func() TaskRunResult {
+		defer r.taskLimiter.release()
+		return r.executeTaskRun(ctx, spec, taskRun, l)
+	}()
+	result.Attempts = 1
+
+	base := taskRun.task.Base()
+	if base.Retries == 0 {
+		return result
+	}
+	b := base.retryBackoff()
+	createdAt := result.CreatedAt
+
+	// async tasks report ErrPending while waiting to be resumed, which is
+	// not a failure
+	for result.Result.Error != nil && result.Result.Error != ErrPending && isRetryable(result.Result.Error) && result.Attempts <= base.Retries {
+		delay := b.Duration()
+		l.Debugw("Pipeline task failed, retrying",
+			"taskName", taskRun.task.DotID(),
+			"taskType", taskRun.task.Type(),
+			"attempt", result.Attempts,
+			"retryIn", delay,
+			"err", result.Result.Error,
+		)
+
+		select {
+		case <-time.After(delay):
+		case <-ctx.Done():
+			return result
+		case <-r.chStop:
+			return result
+		}
+		if err := r.taskLimiter.acquire(ctx); err != nil {
+			return result
+		}
+
+		attempts := result.Attempts
+		result = func() TaskRunResult {
+			defer r.taskLimiter.release()
+			return r.executeTaskRun(ctx, spec, taskRun, l)
+		}()
+		result.Attempts = attempts + 1
+		result.CreatedAt = createdAt
+	}
+	return result
+}
+
 func logTaskRunToPrometheus(trr TaskRunResult, spec Spec) {
 	elapsed := trr.FinishedAt.Time.Sub(trr.CreatedAt)
 
@@ -409,6 +573,7 @@ func logTaskRunToPrometheus(trr TaskRunResult, spec Spec) {
 	} else {
 		status = "completed"
 	}
+	PromPipelineTaskDuration.WithLabelValues(fmt.Sprintf("%d", spec.JobID), spec.JobName, trr.Task.DotID(), string(trr.Task.Type()), status).Observe(elapsed.Seconds())
 	PromPipelineTasksTotalFinished.WithLabelValues(fmt.Sprintf("%d", spec.JobID), spec.JobName, trr.Task.DotID(), string(trr.Task.Type()), status).Inc()
 }
 
@@ -423,10 +588,11 @@ func (r *runner) ExecuteAndInsertFinishedRun(ctx context.Context, spec Spec, var
 
 	// don't insert if we exited early
 	if run.FailEarly {
+		r.runUpdates.publishRun(run)
 		return 0, finalResult, nil
 	}
 
-	if runID, err = r.orm.InsertFinishedRun(r.orm.DB(), run, trrs, saveSuccessfulTaskRuns); err != nil {
+	if runID, err = r.InsertFinishedRun(r.orm.DB(), run, trrs, saveSuccessfulTaskRuns); err != nil {
 		return runID, finalResult, errors.Wrapf(err, "error inserting finished results for spec ID %v", spec.ID)
 	}
 	return runID, finalResult, nil
@@ -434,6 +600,14 @@ func (r *runner) ExecuteAndInsertFinishedRun(ctx context.Context, spec Spec, var
 }
 
 func (r *runner) Run(ctx context.Context, run *Run, l logger.Logger, saveSuccessfulTaskRuns bool) (incomplete bool, err error) {
+	// Runs being resumed were validated when they started, and their vars
+	// have since been round tripped through the database
+	if run.ID == 0 {
+		if err = run.PipelineSpec.InputSchema.ValidateVars(NewVarsFrom(run.Inputs.Val.(map[string]interface{}))); err != nil {
+			return false, err
+		}
+	}
+
 	for {
 		trrs, err := r.run(ctx, run, NewVarsFrom(run.Inputs.Val.(map[string]interface{})), l)
 		if err != nil {
@@ -458,15 +632,17 @@ func (r *runner) Run(ctx context.Context, run *Run, l logger.Logger, saveSuccess
 				// instant restart: new data is already available in the database
 				continue
 			}
+			r.runUpdates.publishRun(*run)
 		} else {
 			if run.Pending {
 				return false, errors.Wrapf(err, "a run without async returned as pending")
 			}
 			// don't insert if we exited early
 			if run.FailEarly {
+				r.runUpdates.publishRun(*run)
 				return false, nil
 			}
-			if _, err = r.orm.InsertFinishedRun(r.orm.DB(), *run, trrs, saveSuccessfulTaskRuns); err != nil {
+			if run.ID, err = r.InsertFinishedRun(r.orm.DB(), *run, trrs, saveSuccessfulTaskRuns); err != nil {
 				return false, errors.Wrapf(err, "error storing run for spec ID %v", run.PipelineSpec.ID)
 			}
 		}
@@ -476,7 +652,21 @@ func (r *runner) Run(ctx context.Context, run *Run, l logger.Logger, saveSuccess
 }
 
 func (r *runner) InsertFinishedRun(db *gorm.DB, run Run, trrs TaskRunResults, saveSuccessfulTaskRuns bool) (int64, error) {
-	return r.orm.InsertFinishedRun(db, run, trrs, saveSuccessfulTaskRuns)
+	runID, err := r.orm.InsertFinishedRun(db, run, trrs, saveSuccessfulTaskRuns)
+	if err != nil {
+		return runID, err
+	}
+	run.ID = runID
+	r.runUpdates.publishRun(run)
+	return runID, nil
+}
+
+func (r *runner) SubscribeToRunUpdates(filter RunUpdatesFilter) RunUpdatesSubscription {
+	return r.runUpdates.subscribe(filter)
+}
+
+func (r *runner) InFlightRuns() int64 {
+	return atomic.LoadInt64(&r.inFlightRuns)
 }
 
 func (r *runner) TestInsertFinishedRun(db *gorm.DB, jobID int32, jobName string, jobType string, specID int32) (int64, error) {
@@ -9,6 +9,8 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"net/url"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -24,6 +26,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/store/models"
 	"github.com/smartcontractkit/chainlink/core/utils"
 	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
 )
 
 func Test_PipelineRunner_ExecuteTaskRuns(t *testing.T) {
@@ -56,7 +59,7 @@ func Test_PipelineRunner_ExecuteTaskRuns(t *testing.T) {
 	orm := new(mocks.ORM)
 	orm.On("DB").Return(store.DB)
 
-	r := pipeline.NewRunner(orm, store.Config, nil, nil, nil, nil)
+	r := pipeline.NewRunner(orm, store.Config, nil, nil, nil, nil, nil, nil)
 
 	s := fmt.Sprintf(`
 ds1 [type=bridge name="example-bridge" timeout=0 requestData=<{"data": {"coin": "BTC", "market": "USD"}}>]
@@ -233,7 +236,7 @@ func Test_PipelineRunner_ExecuteTaskRunsWithVars(t *testing.T) {
 			orm := new(mocks.ORM)
 			orm.On("DB").Return(store.DB)
 
-			runner := pipeline.NewRunner(orm, store.Config, nil, nil, nil, nil)
+			runner := pipeline.NewRunner(orm, store.Config, nil, nil, nil, nil, nil, nil)
 			specStr := fmt.Sprintf(specTemplate, ds2.URL, ds4.URL, test.includeInputAtKey)
 			p, err := pipeline.Parse(specStr)
 			require.NoError(t, err)
@@ -309,7 +312,7 @@ ds2 -> ds2_parse -> ds2_multiply -> answer1;
 answer1 [type=median                      index=0];
 `, m1.URL, m2.URL)
 
-	r := pipeline.NewRunner(orm, store.Config, nil, nil, nil, nil)
+	r := pipeline.NewRunner(orm, store.Config, nil, nil, nil, nil, nil, nil)
 
 	// If we cancel before an API is finished, we should still get a median.
 	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
@@ -332,7 +335,7 @@ func Test_PipelineRunner_MultipleOutputs(t *testing.T) {
 	defer cleanup()
 	orm := new(mocks.ORM)
 	orm.On("DB").Return(store.DB)
-	r := pipeline.NewRunner(orm, store.Config, nil, nil, nil, nil)
+	r := pipeline.NewRunner(orm, store.Config, nil, nil, nil, nil, nil, nil)
 	input := map[string]interface{}{"val": 2}
 	_, trrs, err := r.ExecuteRun(context.Background(), pipeline.Spec{
 		DotDagSource: `
@@ -361,7 +364,7 @@ func Test_PipelineRunner_MultipleTerminatingOutputs(t *testing.T) {
 	defer cleanup()
 	orm := new(mocks.ORM)
 	orm.On("DB").Return(store.DB)
-	r := pipeline.NewRunner(orm, store.Config, nil, nil, nil, nil)
+	r := pipeline.NewRunner(orm, store.Config, nil, nil, nil, nil, nil, nil)
 	input := map[string]interface{}{"val": 2}
 	_, trrs, err := r.ExecuteRun(context.Background(), pipeline.Spec{
 		DotDagSource: `
@@ -389,7 +392,7 @@ func Test_PipelineRunner_PanicTask_Run(t *testing.T) {
 		res.WriteHeader(http.StatusOK)
 		res.Write([]byte(`{"result":10}`))
 	}))
-	r := pipeline.NewRunner(orm, store.Config, nil, nil, nil, nil)
+	r := pipeline.NewRunner(orm, store.Config, nil, nil, nil, nil, nil, nil)
 	spec := pipeline.Spec{
 		DotDagSource: fmt.Sprintf(`
 ds1 [type=http url="%s"]
@@ -453,7 +456,7 @@ func Test_PipelineRunner_AsyncJob_Basic(t *testing.T) {
 	orm := new(mocks.ORM)
 	orm.On("DB").Return(store.DB)
 
-	r := pipeline.NewRunner(orm, store.Config, nil, nil, nil, nil)
+	r := pipeline.NewRunner(orm, store.Config, nil, nil, nil, nil, nil, nil)
 
 	s := fmt.Sprintf(`
 ds1 [type=bridge async=true name="example-bridge" timeout=0 requestData=<{"data": {"coin": "BTC", "market": "USD"}}>]
@@ -550,7 +553,7 @@ func Test_PipelineRunner_AsyncJob_InstantRestart(t *testing.T) {
 		defer r.Body.Close()
 		err = json.Unmarshal(payload, &reqBody)
 		require.NoError(t, err)
-		require.Contains(t, reqBody.ResponseURL, "http://localhost:6688/v2/resume/")
+		require.Contains(t, reqBody.ResponseURL, "http://localhost:6688/v2/pipeline/runs/")
 		w.Header().Set("Content-Type", "application/json")
 		w.Header().Set("X-Chainlink-Pending", "true")
 		response := map[string]interface{}{}
@@ -582,7 +585,7 @@ func Test_PipelineRunner_AsyncJob_InstantRestart(t *testing.T) {
 	orm := new(mocks.ORM)
 	orm.On("DB").Return(store.DB)
 
-	r := pipeline.NewRunner(orm, store.Config, nil, nil, nil, nil)
+	r := pipeline.NewRunner(orm, store.Config, nil, nil, nil, nil, nil, nil)
 
 	s := fmt.Sprintf(`
 ds1 [type=bridge async=true name="example-bridge" timeout=0 requestData=<{"data": {"coin": "BTC", "market": "USD"}}>]
@@ -663,7 +666,7 @@ func Test_PipelineRunner_FailEarly(t *testing.T) {
 	s := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
 		require.Fail(t, "ds1 shouldn't have been called")
 	}))
-	r := pipeline.NewRunner(orm, store.Config, nil, nil, nil, nil)
+	r := pipeline.NewRunner(orm, store.Config, nil, nil, nil, nil, nil, nil)
 	spec := pipeline.Spec{
 		DotDagSource: fmt.Sprintf(`
 ds_panic [type=panic msg="oh no" failEarly=true]
@@ -680,3 +683,408 @@ ds_panic->ds1->ds_parse->ds_multiply;`, s.URL),
 	require.Equal(t, 1, len(trrs))
 	assert.IsType(t, pipeline.ErrRunPanicked{}, trrs[0].Result.Error)
 }
+
+func Test_PipelineRunner_TaskConcurrencyLimits(t *testing.T) {
+	tests := []struct {
+		name    string
+		envVar  string
+		limit   int
+		runners int
+	}{
+		{"per run", "JOB_PIPELINE_MAX_RUN_TASK_CONCURRENCY", 2, 1},
+		{"per node", "JOB_PIPELINE_MAX_NODE_TASK_CONCURRENCY", 3, 2},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			store, cleanup := cltest.NewStore(t)
+			defer cleanup()
+			store.Config.Set(test.envVar, test.limit)
+
+			var inFlight, maxInFlight int32
+			s := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+				n := atomic.AddInt32(&inFlight, 1)
+				defer atomic.AddInt32(&inFlight, -1)
+				for {
+					max := atomic.LoadInt32(&maxInFlight)
+					if n <= max || atomic.CompareAndSwapInt32(&maxInFlight, max, n) {
+						break
+					}
+				}
+				time.Sleep(50 * time.Millisecond)
+				res.WriteHeader(http.StatusOK)
+				_, _ = res.Write([]byte(`{"result": 1}`))
+			}))
+			defer s.Close()
+
+			orm := new(mocks.ORM)
+			orm.On("DB").Return(store.DB)
+			r := pipeline.NewRunner(orm, store.Config, nil, nil, nil, nil, nil, nil)
+
+			var dag string
+			for i := 0; i < 6; i++ {
+				dag += fmt.Sprintf("ds%d [type=http method=GET url=\"%s\"];\nds%d_parse [type=jsonparse path=\"result\"];\nds%d->ds%d_parse->median;\n", i, s.URL, i, i, i)
+			}
+			dag += "median [type=median];\n"
+			spec := pipeline.Spec{DotDagSource: dag}
+
+			var wg sync.WaitGroup
+			wg.Add(test.runners)
+			for i := 0; i < test.runners; i++ {
+				go func() {
+					defer wg.Done()
+					_, trrs, err := r.ExecuteRun(context.Background(), spec, pipeline.NewVarsFrom(nil), *logger.Default)
+					require.NoError(t, err)
+					finalResult := trrs.FinalResult()
+					assert.False(t, finalResult.HasErrors())
+					assert.Equal(t, "1", finalResult.Values[0].(decimal.Decimal).String())
+				}()
+			}
+			wg.Wait()
+
+			assert.LessOrEqual(t, atomic.LoadInt32(&maxInFlight), int32(test.limit))
+			assert.Greater(t, atomic.LoadInt32(&maxInFlight), int32(1))
+		})
+	}
+}
+
+func Test_PipelineRunner_TaskCache(t *testing.T) {
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+
+	var requests int32
+	s := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		n := atomic.AddInt32(&requests, 1)
+		res.WriteHeader(http.StatusOK)
+		_, _ = res.Write([]byte(fmt.Sprintf(`{"result": %d}`, n)))
+	}))
+	defer s.Close()
+
+	orm := new(mocks.ORM)
+	orm.On("DB").Return(store.DB)
+	r := pipeline.NewRunner(orm, store.Config, nil, nil, nil, nil, nil, nil)
+
+	execute := func(dag string) interface{} {
+		_, trrs, err := r.ExecuteRun(context.Background(), pipeline.Spec{DotDagSource: dag}, pipeline.NewVarsFrom(nil), *logger.Default)
+		require.NoError(t, err)
+		finalResult := trrs.FinalResult()
+		require.False(t, finalResult.HasErrors())
+		return finalResult.Values[0]
+	}
+
+	cached := fmt.Sprintf(`ds [type=http method=GET url="%s" cache="30s"];`, s.URL)
+	assert.Equal(t, `{"result": 1}`, execute(cached))
+	assert.Equal(t, `{"result": 1}`, execute(cached))
+	assert.Equal(t, int32(1), atomic.LoadInt32(&requests))
+
+	// tasks without a cache duration always send the request
+	uncached := fmt.Sprintf(`ds [type=http method=GET url="%s"];`, s.URL)
+	assert.Equal(t, `{"result": 2}`, execute(uncached))
+
+	// a shorter cache duration does not accept older results
+	shortCache := fmt.Sprintf(`ds [type=http method=GET url="%s" cache="1ms"];`, s.URL)
+	time.Sleep(5 * time.Millisecond)
+	assert.Equal(t, `{"result": 3}`, execute(shortCache))
+
+	// the request is part of the key
+	otherRequest := fmt.Sprintf(`ds [type=http method=POST url="%s" cache="30s"];`, s.URL)
+	assert.Equal(t, `{"result": 4}`, execute(otherRequest))
+	assert.Equal(t, int32(4), atomic.LoadInt32(&requests))
+}
+
+func Test_PipelineRunner_SubscribeToRunUpdates(t *testing.T) {
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+
+	orm := new(mocks.ORM)
+	orm.On("DB").Return(store.DB)
+	orm.On("InsertFinishedRun", mock.Anything, mock.Anything, mock.Anything, false).Return(int64(42), nil)
+	r := pipeline.NewRunner(orm, store.Config, nil, nil, nil, nil, nil, nil)
+
+	jobSub := r.SubscribeToRunUpdates(pipeline.RunUpdatesFilter{JobID: 1})
+	defer jobSub.Close()
+	otherJobSub := r.SubscribeToRunUpdates(pipeline.RunUpdatesFilter{JobID: 2})
+	defer otherJobSub.Close()
+
+	spec := pipeline.Spec{
+		JobID: 1,
+		DotDagSource: `
+a [type=multiply input=2 times=3];
+b [type=multiply times=2];
+a->b;
+`,
+	}
+	runID, _, err := r.ExecuteAndInsertFinishedRun(context.Background(), spec, pipeline.NewVarsFrom(nil), *logger.Default, false)
+	require.NoError(t, err)
+	require.Equal(t, int64(42), runID)
+
+	var updates []pipeline.RunUpdate
+	for i := 0; i < 3; i++ {
+		select {
+		case update := <-jobSub.Updates():
+			updates = append(updates, update)
+		case <-time.After(cltest.DBWaitTimeout):
+			t.Fatal("timed out waiting for run update")
+		}
+	}
+
+	require.NotNil(t, updates[0].TaskRun)
+	assert.Equal(t, "a", updates[0].TaskRun.DotID)
+	assert.Equal(t, "6", updates[0].TaskRun.Output.Val.(decimal.Decimal).String())
+	require.NotNil(t, updates[1].TaskRun)
+	assert.Equal(t, "b", updates[1].TaskRun.DotID)
+	assert.Equal(t, "12", updates[1].TaskRun.Output.Val.(decimal.Decimal).String())
+
+	assert.Nil(t, updates[2].TaskRun)
+	assert.Equal(t, int64(42), updates[2].RunID)
+	assert.Equal(t, int32(1), updates[2].JobID)
+	assert.Equal(t, pipeline.RunStatusCompleted, updates[2].State)
+	assert.True(t, updates[2].FinishedAt.Valid)
+
+	assert.Len(t, otherJobSub.Updates(), 0)
+
+	jobSub.Close()
+	_, open := <-jobSub.Updates()
+	assert.False(t, open)
+}
+
+func Test_PipelineRunner_TaskRetries(t *testing.T) {
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+
+	orm := new(mocks.ORM)
+	orm.On("DB").Return(store.DB)
+	r := pipeline.NewRunner(orm, store.Config, nil, nil, nil, nil, nil, nil)
+
+	tests := []struct {
+		name         string
+		failures     int32
+		status       int
+		retries      int
+		wantAttempts uint32
+		wantError    bool
+	}{
+		{"succeeds first time", 0, http.StatusTooManyRequests, 3, 1, false},
+		{"succeeds after retrying", 2, http.StatusTooManyRequests, 3, 3, false},
+		{"fails after exhausting retries", 5, http.StatusTooManyRequests, 2, 3, true},
+		{"fails without retries", 1, http.StatusTooManyRequests, 0, 1, true},
+		{"does not retry errors which are not retryable", 1, http.StatusBadRequest, 3, 1, true},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			var requests int32
+			s := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+				if atomic.AddInt32(&requests, 1) <= test.failures {
+					res.WriteHeader(test.status)
+					return
+				}
+				res.WriteHeader(http.StatusOK)
+				_, _ = res.Write([]byte(`{"result": 1}`))
+			}))
+			defer s.Close()
+
+			spec := pipeline.Spec{
+				DotDagSource: fmt.Sprintf(`
+ds [type=http method=GET url="%s" retries=%d minBackoff="10ms" maxBackoff="20ms"];
+ds_parse [type=jsonparse path="result"];
+ds->ds_parse;
+`, s.URL, test.retries),
+			}
+			_, trrs, err := r.ExecuteRun(context.Background(), spec, pipeline.NewVarsFrom(nil), *logger.Default)
+			require.NoError(t, err)
+			require.Len(t, trrs, 2)
+
+			for _, trr := range trrs {
+				if trr.Task.DotID() != "ds" {
+					continue
+				}
+				assert.Equal(t, test.wantAttempts, trr.Attempts)
+				assert.Equal(t, int32(test.wantAttempts), atomic.LoadInt32(&requests))
+				if test.wantError {
+					assert.Error(t, trr.Result.Error)
+				} else {
+					assert.NoError(t, trr.Result.Error)
+				}
+			}
+		})
+	}
+}
+
+func Test_PipelineRunner_Metrics(t *testing.T) {
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+
+	orm := new(mocks.ORM)
+	orm.On("DB").Return(store.DB)
+	r := pipeline.NewRunner(orm, store.Config, nil, nil, nil, nil, nil, nil)
+
+	s := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		res.WriteHeader(http.StatusOK)
+		_, _ = res.Write([]byte(`{"result": 1}`))
+	}))
+	defer s.Close()
+
+	spec := pipeline.Spec{
+		JobID:   43,
+		JobName: "runner metrics",
+		DotDagSource: fmt.Sprintf(`
+ds [type=http method=GET url="%s"];
+ds_parse [type=jsonparse path="result"];
+ds->ds_parse;
+`, s.URL),
+	}
+	_, trrs, err := r.ExecuteRun(context.Background(), spec, pipeline.NewVarsFrom(nil), *logger.Default)
+	require.NoError(t, err)
+	require.Len(t, trrs, 2)
+
+	for _, task := range []struct{ id, taskType string }{{"ds", "http"}, {"ds_parse", "jsonparse"}} {
+		_, samples := histogramSamples(t, pipeline.PromPipelineTaskDuration, "43", "runner metrics", task.id, task.taskType, "completed")
+		assert.Equal(t, uint64(1), samples, "task %s is labelled by job and task", task.id)
+	}
+	_, samples := histogramSamples(t, pipeline.PromPipelineTaskQueueTime, "43", "runner metrics")
+	assert.Equal(t, uint64(2), samples)
+	_, samples = histogramSamples(t, pipeline.PromHTTPRequestDuration, "43", "runner metrics", "ds", "http", "200")
+	assert.Equal(t, uint64(1), samples)
+}
+
+type secretStore map[string]string
+
+func (s secretStore) Get(name string) (string, error) {
+	value, exists := s[name]
+	if !exists {
+		return "", fmt.Errorf("secret not found: %s", name)
+	}
+	return value, nil
+}
+
+func Test_PipelineRunner_Secrets(t *testing.T) {
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+
+	orm := new(mocks.ORM)
+	orm.On("DB").Return(store.DB)
+	r := pipeline.NewRunner(orm, store.Config, nil, nil, nil, nil, nil, secretStore{"API_KEY": "hunter2"})
+
+	s := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		authorized := req.URL.Query().Get("apiKey") == "hunter2" || req.Header.Get("Authorization") == "Bearer hunter2"
+		if !authorized || req.URL.Query().Get("fail") != "" {
+			res.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		res.WriteHeader(http.StatusOK)
+		_, _ = res.Write([]byte(`{"result": 1}`))
+	}))
+	defer s.Close()
+
+	t.Run("interpolates secrets into task attributes", func(t *testing.T) {
+		spec := pipeline.Spec{
+			DotDagSource: fmt.Sprintf(`ds [type=http method=GET url="%s?apiKey=${secret.API_KEY}"];`, s.URL),
+		}
+		_, trrs, err := r.ExecuteRun(context.Background(), spec, pipeline.NewVarsFrom(nil), *logger.Default)
+		require.NoError(t, err)
+		require.Len(t, trrs, 1)
+		require.NoError(t, trrs[0].Result.Error)
+		assert.Equal(t, `{"result": 1}`, trrs[0].Result.Value)
+	})
+
+	t.Run("interpolates secrets into headers", func(t *testing.T) {
+		spec := pipeline.Spec{
+			DotDagSource: fmt.Sprintf(`ds [type=http method=GET url="%s" headers="{\"Authorization\": \"Bearer ${secret.API_KEY}\"}"];`, s.URL),
+		}
+		_, trrs, err := r.ExecuteRun(context.Background(), spec, pipeline.NewVarsFrom(nil), *logger.Default)
+		require.NoError(t, err)
+		require.Len(t, trrs, 1)
+		require.NoError(t, trrs[0].Result.Error)
+		assert.Equal(t, `{"result": 1}`, trrs[0].Result.Value)
+	})
+
+	t.Run("redacts secrets from debug logs", func(t *testing.T) {
+		previousLogger := logger.Default
+		logger.SetLogger(logger.CreateMemoryTestLogger(zapcore.DebugLevel))
+		defer logger.SetLogger(previousLogger)
+
+		spec := pipeline.Spec{
+			DotDagSource: fmt.Sprintf(`ds [type=http method=GET url="%s?apiKey=${secret.API_KEY}"];`, s.URL),
+		}
+		_, trrs, err := r.ExecuteRun(context.Background(), spec, pipeline.NewVarsFrom(nil), *logger.Default)
+		require.NoError(t, err)
+		require.Len(t, trrs, 1)
+		require.NoError(t, trrs[0].Result.Error)
+
+		logs := logger.MemoryLogTestingOnly().String()
+		assert.Contains(t, logs, "apiKey=[redacted]")
+		assert.NotContains(t, logs, "hunter2")
+	})
+
+	t.Run("redacts secrets from task errors", func(t *testing.T) {
+		spec := pipeline.Spec{
+			DotDagSource: fmt.Sprintf(`ds [type=http method=GET url="%s?apiKey=${secret.API_KEY}&fail=true"];`, s.URL),
+		}
+		_, trrs, err := r.ExecuteRun(context.Background(), spec, pipeline.NewVarsFrom(nil), *logger.Default)
+		require.NoError(t, err)
+		require.Len(t, trrs, 1)
+		require.Error(t, trrs[0].Result.Error)
+		assert.NotContains(t, trrs[0].Result.Error.Error(), "hunter2")
+		assert.Contains(t, trrs[0].Result.Error.Error(), "apiKey=[redacted]")
+
+		var taskErr pipeline.TaskError
+		require.True(t, errors.As(trrs[0].Result.Error, &taskErr))
+		assert.Equal(t, pipeline.ErrorCategoryNetwork, taskErr.Category)
+		assert.False(t, taskErr.Retryable)
+		assert.Equal(t, "ds", taskErr.DotID)
+	})
+
+	t.Run("fails runs referencing unknown secrets", func(t *testing.T) {
+		spec := pipeline.Spec{
+			DotDagSource: fmt.Sprintf(`ds [type=http method=GET url="%s?apiKey=${secret.MISSING}"];`, s.URL),
+		}
+		_, _, err := r.ExecuteRun(context.Background(), spec, pipeline.NewVarsFrom(nil), *logger.Default)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "MISSING")
+	})
+}
+
+func Test_PipelineRunner_InputSchema(t *testing.T) {
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+
+	orm := new(mocks.ORM)
+	orm.On("DB").Return(store.DB)
+	r := pipeline.NewRunner(orm, store.Config, nil, nil, nil, nil, nil, nil)
+
+	spec := pipeline.Spec{
+		DotDagSource: `
+ds_parse [type=jsonparse data="$(jobRun.requestBody)" path="result"];
+`,
+		InputSchema: pipeline.VarsSchema{"jobRun.requestBody": "string"},
+	}
+
+	t.Run("runs with valid vars", func(t *testing.T) {
+		vars := pipeline.NewVarsFrom(map[string]interface{}{
+			"jobRun": map[string]interface{}{"requestBody": `{"result": 1}`},
+		})
+		_, trrs, err := r.ExecuteRun(context.Background(), spec, vars, *logger.Default)
+		require.NoError(t, err)
+		require.Len(t, trrs, 1)
+		require.NoError(t, trrs[0].Result.Error)
+	})
+
+	t.Run("rejects invalid vars before running any tasks", func(t *testing.T) {
+		vars := pipeline.NewVarsFrom(map[string]interface{}{
+			"jobRun": map[string]interface{}{"requestBody": map[string]interface{}{"result": 1}},
+		})
+		_, trrs, err := r.ExecuteRun(context.Background(), spec, vars, *logger.Default)
+		require.Error(t, err)
+		assert.True(t, errors.Is(err, pipeline.ErrInvalidVars))
+		assert.Contains(t, err.Error(), "jobRun.requestBody: expected string, got map[string]interface {}")
+		assert.Empty(t, trrs)
+
+		run := pipeline.NewRun(spec, vars)
+		_, err = r.Run(context.Background(), &run, *logger.Default, true)
+		require.Error(t, err)
+		assert.True(t, errors.Is(err, pipeline.ErrInvalidVars))
+	})
+}
@@ -2,16 +2,16 @@ package pipeline
 
 import (
 	"context"
+	"fmt"
 	"sort"
 	"time"
 
-	"github.com/pkg/errors"
 	"github.com/smartcontractkit/chainlink/core/logger"
 	"gopkg.in/guregu/null.v4"
 )
 
 func (s *scheduler) newMemoryTaskRun(task Task) *memoryTaskRun {
-	run := &memoryTaskRun{task: task, vars: s.vars.Copy()}
+	run := &memoryTaskRun{task: task, vars: s.vars.Copy(), scheduledAt: time.Now()}
 
 	// fill in the inputs, fast path for no inputs
 	if len(task.Inputs()) != 0 {
@@ -90,15 +90,21 @@ func newScheduler(ctx context.Context, p *Pipeline, run *Run, vars Vars) *schedu
 			continue
 		}
 
-		run := s.newMemoryTaskRun(task)
-
-		s.taskCh <- run
-		s.waiting++
+		s.schedule(task)
 	}
 
 	return s
 }
 
+// schedule queues the task for execution by the runner
+func (s *scheduler) schedule(task Task) {
+	run := s.newMemoryTaskRun(task)
+
+	PromPipelineTasksQueued.WithLabelValues(fmt.Sprintf("%d", s.run.PipelineSpec.JobID), s.run.PipelineSpec.JobName).Inc()
+	s.taskCh <- run
+	s.waiting++
+}
+
 func (s *scheduler) reconstructResults() {
 	// if there's results already present on Run, then this is a resumption. Loop over them and fill results table
 	for _, r := range s.run.PipelineTaskRuns {
@@ -115,7 +121,7 @@ func (s *scheduler) reconstructResults() {
 		result := Result{}
 
 		if r.Error.Valid {
-			result.Error = errors.New(r.Error.String)
+			result.Error = r.resultError()
 		}
 
 		if !r.Output.Null {
@@ -160,7 +166,7 @@ Loop:
 				if _, ok := s.results[task.ID()]; !ok {
 					s.results[task.ID()] = TaskRunResult{
 						Task:       task,
-						Result:     Result{Error: ErrTimeout},
+						Result:     Result{Error: classifyTaskError(task, ErrTimeout)},
 						CreatedAt:  now, // TODO: more accurate start time
 						FinishedAt: null.TimeFrom(now),
 					}
@@ -213,11 +219,7 @@ Loop:
 
 			// if all dependencies are done, schedule task run
 			if s.dependencies[id] == 0 {
-				task := s.pipeline.Tasks[id]
-				run := s.newMemoryTaskRun(task)
-
-				s.taskCh <- run
-				s.waiting++
+				s.schedule(s.pipeline.Tasks[id])
 			}
 		}
 
@@ -233,3 +235,38 @@ func (s *scheduler) report(ctx context.Context, result TaskRunResult) {
 		logger.Errorw("pipeline.scheduler: timed out reporting result", "result", result)
 	}
 }
+
+// taskLimiter caps the number of pipeline tasks executing at once across all
+// runs on the node. Tasks wait in acquire until a slot frees up.
+type taskLimiter struct {
+	slots chan struct{}
+}
+
+// newTaskLimiter returns a limiter of size slots, or nil (unlimited) if size is 0
+func newTaskLimiter(size uint64) *taskLimiter {
+	if size == 0 {
+		return nil
+	}
+	return &taskLimiter{slots: make(chan struct{}, size)}
+}
+
+// acquire blocks until a slot is available or ctx is done. It is a no-op on a
+// nil limiter.
+func (l *taskLimiter) acquire(ctx context.Context) error {
+	if l == nil {
+		return nil
+	}
+	select {
+	case l.slots <- struct{}{}:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (l *taskLimiter) release() {
+	if l == nil {
+		return
+	}
+	<-l.slots
+}
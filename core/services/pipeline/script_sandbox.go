@@ -0,0 +1,261 @@
+package pipeline
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"os"
+	"os/exec"
+	"runtime"
+	"runtime/debug"
+	"runtime/metrics"
+	"strings"
+	"time"
+
+	"github.com/dop251/goja"
+	"github.com/pkg/errors"
+)
+
+// scriptSandboxEnv is set in the environment of sandboxes, which run the
+// script they are given instead of the node
+const scriptSandboxEnv = "CHAINLINK_SCRIPT_SANDBOX"
+
+const (
+	// scriptMemorySampleInterval is how often a sandbox samples its heap to
+	// enforce the memory limit of its script
+	scriptMemorySampleInterval = 10 * time.Millisecond
+	// scriptMaxStack bounds the Go stack of a sandbox, on which a script
+	// recurses when it calls itself through native functions, e.g.
+	// Array.prototype.map
+	scriptMaxStack = 16 * 1024 * 1024
+	// scriptHeapSettleTimeout bounds how long a sandbox waits for its heap to
+	// settle before it starts the script, see settledHeap
+	scriptHeapSettleTimeout = 500 * time.Millisecond
+	// scriptSandboxGracePeriod is how long a sandbox is given past the time
+	// limit of its script to report the timeout, after which it is killed
+	scriptSandboxGracePeriod = time.Second
+)
+
+// The kinds of errors a sandbox reports, besides errors thrown by the script
+const (
+	scriptErrorBadInput = "bad_input"
+	scriptErrorTimeout  = "timeout"
+	scriptErrorMemory   = "memory"
+)
+
+func init() {
+	if os.Getenv(scriptSandboxEnv) != "" {
+		os.Exit(runScriptSandbox(os.Stdin, os.Stdout))
+	}
+}
+
+type scriptRequest struct {
+	Name        string
+	Script      string
+	Input       interface{}
+	Vars        interface{}
+	MaxDuration time.Duration
+	MaxMemory   uint64
+}
+
+type scriptResponse struct {
+	// Value is the JSON encoding of the script's result
+	Value json.RawMessage `json:",omitempty"`
+	Error string          `json:",omitempty"`
+	// ErrorKind is one of the scriptError* kinds, or empty for errors thrown
+	// by the script
+	ErrorKind string `json:",omitempty"`
+}
+
+// runScriptInSandbox runs the script of req in a sandbox and returns the JSON
+// encoding of its result. A sandbox is a process of the node's own executable
+// started with scriptSandboxEnv set, which reads the scriptRequest from stdin,
+// runs the script and writes the scriptResponse to stdout, as the last line of
+// its output.
+//
+// Since the sandbox runs nothing but the script, the growth of its heap is
+// the script's alone, which is what the memory limit is enforced on. The
+// sandbox's address space is capped too where the platform allows it, see
+// limitScriptSandboxMemory, so that a script which allocates faster than the
+// heap is sampled is stopped by the kernel. A sandbox which crashes, e.g.
+// because its script recursed past scriptMaxStack, fails only its task.
+func runScriptInSandbox(ctx context.Context, req scriptRequest) (json.RawMessage, error) {
+	executable, err := os.Executable()
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to start script sandbox")
+	}
+	request, err := json.Marshal(req)
+	if err != nil {
+		return nil, errors.Wrapf(ErrBadInput, "script input is not JSON serializable: %v", err)
+	}
+
+	sandboxCtx, cancel := context.WithTimeout(ctx, req.MaxDuration+scriptSandboxGracePeriod)
+	defer cancel()
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(sandboxCtx, executable)
+	cmd.Env = append(os.Environ(), scriptSandboxEnv+"=1", "GOTRACEBACK=none")
+	cmd.Stdin = bytes.NewReader(request)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	runErr := cmd.Run()
+
+	if ctx.Err() != nil {
+		return nil, ctx.Err()
+	}
+	var resp scriptResponse
+	if err = json.Unmarshal(lastLine(stdout.Bytes()), &resp); err != nil {
+		return nil, sandboxFailure(sandboxCtx, runErr, stderr.String())
+	}
+	switch {
+	case resp.ErrorKind == scriptErrorBadInput:
+		return nil, errors.Wrap(ErrBadInput, resp.Error)
+	case resp.ErrorKind == scriptErrorTimeout:
+		return nil, ErrScriptTimeout
+	case resp.ErrorKind == scriptErrorMemory:
+		return nil, ErrScriptMemoryLimited
+	case resp.Error != "":
+		return nil, errors.New(resp.Error)
+	}
+	return resp.Value, nil
+}
+
+// sandboxFailure returns the error of a sandbox which exited without a
+// response
+func sandboxFailure(ctx context.Context, runErr error, stderr string) error {
+	switch {
+	case ctx.Err() == context.DeadlineExceeded:
+		return ErrScriptTimeout
+	case strings.Contains(stderr, "out of memory"):
+		return ErrScriptMemoryLimited
+	case strings.Contains(stderr, "stack overflow"):
+		return ErrScriptCallStackLimited
+	}
+	return errors.Errorf("script sandbox failed: %v: %s", runErr, lastLine([]byte(stderr)))
+}
+
+func lastLine(output []byte) []byte {
+	output = bytes.TrimSpace(output)
+	return output[bytes.LastIndexByte(output, '\n')+1:]
+}
+
+// runScriptSandbox is the sandbox's main function
+func runScriptSandbox(in io.Reader, out io.Writer) int {
+	var req scriptRequest
+	var resp scriptResponse
+	if err := json.NewDecoder(in).Decode(&req); err != nil {
+		resp = scriptResponse{Error: errors.Wrap(err, "script request").Error()}
+	} else {
+		debug.SetMaxStack(scriptMaxStack)
+		if req.MaxMemory != 0 {
+			if err = limitScriptSandboxMemory(req.MaxMemory); err != nil {
+				resp = scriptResponse{Error: errors.Wrap(err, "unable to limit script memory").Error()}
+			}
+		}
+		if resp.Error == "" {
+			resp = runScript(req)
+		}
+	}
+
+	bs, err := json.Marshal(resp)
+	if err != nil {
+		return 1
+	}
+	if _, err = out.Write(append([]byte("\n"), bs...)); err != nil {
+		return 1
+	}
+	return 0
+}
+
+func runScript(req scriptRequest) scriptResponse {
+	program, err := goja.Compile(req.Name, req.Script, true)
+	if err != nil {
+		return scriptResponse{Error: "script: " + err.Error(), ErrorKind: scriptErrorBadInput}
+	}
+
+	vm := goja.New()
+	vm.Set("input", req.Input)
+	vm.Set("vars", req.Vars)
+
+	baseline := settledHeap()
+	ctx, cancel := context.WithTimeout(context.Background(), req.MaxDuration)
+	defer cancel()
+	go interruptScript(ctx, vm, req.MaxMemory, baseline)
+
+	value, err := vm.RunProgram(program)
+	if err != nil {
+		var interrupted *goja.InterruptedError
+		if errors.As(err, &interrupted) {
+			switch interrupted.Value() {
+			case ErrScriptTimeout:
+				return scriptResponse{Error: err.Error(), ErrorKind: scriptErrorTimeout}
+			case ErrScriptMemoryLimited:
+				return scriptResponse{Error: err.Error(), ErrorKind: scriptErrorMemory}
+			}
+		}
+		return scriptResponse{Error: "script: " + err.Error()}
+	}
+
+	// The result is returned as JSON, which rejects e.g. functions
+	bs, err := json.Marshal(value.Export())
+	if err != nil {
+		return scriptResponse{Error: "script result is not JSON serializable: " + err.Error(), ErrorKind: scriptErrorBadInput}
+	}
+	return scriptResponse{Value: bs}
+}
+
+// interruptScript stops vm once ctx is done, or once the live heap of the
+// sandbox has grown by more than maxMemory bytes from baseline. The heap is
+// collected before it is found to exceed the limit, so that garbage left by
+// the script does not count towards it.
+func interruptScript(ctx context.Context, vm *goja.Runtime, maxMemory, baseline uint64) {
+	exceeded := func() bool {
+		heap := heapObjects()
+		return heap > baseline && heap-baseline > maxMemory
+	}
+
+	ticker := time.NewTicker(scriptMemorySampleInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			vm.Interrupt(ErrScriptTimeout)
+			return
+		case <-ticker.C:
+			if maxMemory == 0 || !exceeded() {
+				continue
+			}
+			runtime.GC()
+			if exceeded() {
+				vm.Interrupt(ErrScriptMemoryLimited)
+				return
+			}
+		}
+	}
+}
+
+// settledHeap returns the live heap of the sandbox once it stops growing.
+// Some of the node's packages build caches in the background when they are
+// initialized, e.g. libp2p's table of autonomous systems, which would
+// otherwise count towards the memory limit of the script.
+func settledHeap() uint64 {
+	deadline := time.Now().Add(scriptHeapSettleTimeout)
+	runtime.GC()
+	last := heapObjects()
+	for time.Now().Before(deadline) {
+		time.Sleep(scriptMemorySampleInterval)
+		runtime.GC()
+		current := heapObjects()
+		if current <= last+last/100 {
+			return current
+		}
+		last = current
+	}
+	return last
+}
+
+func heapObjects() uint64 {
+	sample := []metrics.Sample{{Name: "/memory/classes/heap/objects:bytes"}}
+	metrics.Read(sample)
+	return sample[0].Value.Uint64()
+}
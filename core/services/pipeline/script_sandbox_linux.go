@@ -0,0 +1,30 @@
+// +build linux
+
+package pipeline
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"syscall"
+)
+
+// scriptSandboxMemorySlack is the address space a sandbox is given on top of
+// twice the memory limit of its script, which leaves room for the garbage
+// collector, for the Go runtime and for the script's call stack
+const scriptSandboxMemorySlack = 256 * 1024 * 1024
+
+// limitScriptSandboxMemory caps the address space of the sandbox, past which
+// its allocations fail and it crashes
+func limitScriptSandboxMemory(maxMemory uint64) error {
+	statm, err := ioutil.ReadFile("/proc/self/statm")
+	if err != nil {
+		return err
+	}
+	var pages uint64
+	if _, err = fmt.Sscan(string(statm), &pages); err != nil {
+		return err
+	}
+	limit := pages*uint64(os.Getpagesize()) + 2*maxMemory + scriptSandboxMemorySlack
+	return syscall.Setrlimit(syscall.RLIMIT_AS, &syscall.Rlimit{Cur: limit, Max: limit})
+}
@@ -0,0 +1,91 @@
+package pipeline
+
+import (
+	"reflect"
+	"regexp"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// SecretStore resolves the `${secret.NAME}` references in task attributes
+type SecretStore interface {
+	Get(name string) (string, error)
+}
+
+const redactedSecret = "[redacted]"
+
+var secretRefRegexp = regexp.MustCompile(`\$\{secret\.([A-Za-z_][A-Za-z0-9_]*)\}`)
+
+// interpolateSecrets replaces `${secret.NAME}` references in the string
+// attributes of task with the values from secrets, remembering the values
+// used so that they can be redacted from the task's errors.
+func interpolateSecrets(task Task, secrets SecretStore) error {
+	v := reflect.ValueOf(task).Elem()
+	var used []string
+	for i := 0; i < v.NumField(); i++ {
+		field := v.Field(i)
+		if field.Kind() != reflect.String || !field.CanSet() {
+			continue
+		}
+		s := field.String()
+		if !strings.Contains(s, "${secret.") {
+			continue
+		}
+
+		var err error
+		interpolated := secretRefRegexp.ReplaceAllStringFunc(s, func(ref string) string {
+			if err != nil {
+				return ref
+			}
+			name := secretRefRegexp.FindStringSubmatch(ref)[1]
+			if secrets == nil {
+				err = errors.Errorf("task %s references secret %s, but this node has no secrets store", task.DotID(), name)
+				return ref
+			}
+			value, getErr := secrets.Get(name)
+			if getErr != nil {
+				err = errors.Wrapf(getErr, "task %s", task.DotID())
+				return ref
+			}
+			if value != "" {
+				used = append(used, value)
+			}
+			return value
+		})
+		if err != nil {
+			return err
+		}
+		field.SetString(interpolated)
+	}
+	task.Base().secretValues = used
+	return nil
+}
+
+// redactSecrets removes any secret values interpolated into the task from
+// err, since task errors are saved and shown in the Operator UI
+func (t BaseTask) redactSecrets(err error) error {
+	if err == nil || len(t.secretValues) == 0 {
+		return err
+	}
+	msg := err.Error()
+	redacted := t.redactString(msg)
+	if redacted == msg {
+		return err
+	}
+	var taskErr TaskError
+	if errors.As(err, &taskErr) {
+		taskErr.Err = errors.New(redacted)
+		return taskErr
+	}
+	return errors.New(redacted)
+}
+
+// redactString removes any secret values interpolated into the task from s,
+// such as the URL or request data of the task before they are logged
+func (t BaseTask) redactString(s string) string {
+	for _, value := range t.secretValues {
+		s = strings.ReplaceAll(s, value, redactedSecret)
+	}
+	return s
+}
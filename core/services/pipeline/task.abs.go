@@ -0,0 +1,38 @@
+package pipeline
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+)
+
+// AbsTask returns the absolute value of its input.
+//
+// Return types:
+//
+//	*decimal.Decimal
+type AbsTask struct {
+	BaseTask `mapstructure:",squash"`
+	Input    string `json:"input"`
+}
+
+var _ Task = (*AbsTask)(nil)
+
+func (t *AbsTask) Type() TaskType {
+	return TaskTypeAbs
+}
+
+func (t *AbsTask) Run(_ context.Context, vars Vars, inputs []Result) (result Result) {
+	_, err := CheckInputs(inputs, 0, 1, 0)
+	if err != nil {
+		return Result{Error: errors.Wrap(err, "task inputs")}
+	}
+
+	var a DecimalParam
+	err = errors.Wrap(ResolveParam(&a, From(VarExpr(t.Input, vars), Input(inputs, 0))), "input")
+	if err != nil {
+		return Result{Error: err}
+	}
+
+	return Result{Value: a.Decimal().Abs()}
+}
@@ -0,0 +1,51 @@
+package pipeline_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+)
+
+func TestAbsTask(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		input    interface{}
+		expected string
+	}{
+		{"positive", "1.23", "1.23"},
+		{"negative", "-1.23", "1.23"},
+		{"zero", "0", "0"},
+		{"int", int64(-42), "42"},
+		{"float", float64(-0.5), "0.5"},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			task := pipeline.AbsTask{BaseTask: pipeline.NewBaseTask(0, "task", nil, nil, 0)}
+			result := task.Run(context.Background(), pipeline.NewVarsFrom(nil), []pipeline.Result{{Value: test.input}})
+			require.NoError(t, result.Error)
+			require.Equal(t, test.expected, result.Value.(decimal.Decimal).String())
+		})
+	}
+
+	t.Run("with pipeline.Vars", func(t *testing.T) {
+		vars := pipeline.NewVarsFrom(map[string]interface{}{"foo": "-3.5"})
+		task := pipeline.AbsTask{BaseTask: pipeline.NewBaseTask(0, "task", nil, nil, 0), Input: "$(foo)"}
+		result := task.Run(context.Background(), vars, []pipeline.Result{})
+		require.NoError(t, result.Error)
+		require.Equal(t, "3.5", result.Value.(decimal.Decimal).String())
+	})
+
+	t.Run("bad input", func(t *testing.T) {
+		task := pipeline.AbsTask{BaseTask: pipeline.NewBaseTask(0, "task", nil, nil, 0)}
+		result := task.Run(context.Background(), pipeline.NewVarsFrom(nil), []pipeline.Result{{Value: "foo"}})
+		require.Error(t, result.Error)
+	})
+}
@@ -1,6 +1,15 @@
 package pipeline
 
-import "time"
+import (
+	"time"
+
+	"github.com/jpillora/backoff"
+)
+
+const (
+	defaultTaskMinBackoff = 1 * time.Second
+	defaultTaskMaxBackoff = 30 * time.Second
+)
 
 type BaseTask struct {
 	outputs []Task
@@ -11,6 +20,16 @@ type BaseTask struct {
 	Index     int32         `mapstructure:"index" json:"-" `
 	Timeout   time.Duration `mapstructure:"timeout"`
 	FailEarly string        `mapstructure:"failEarly"`
+
+	// Retries is how many times the task is re-executed after failing,
+	// waiting an exponentially increasing backoff between MinBackoff and
+	// MaxBackoff before each retry
+	Retries    uint32        `mapstructure:"retries"`
+	MinBackoff time.Duration `mapstructure:"minBackoff"`
+	MaxBackoff time.Duration `mapstructure:"maxBackoff"`
+
+	// secretValues are the values interpolated into the task's attributes
+	secretValues []string
 }
 
 func NewBaseTask(id int, dotID string, inputs, outputs []Task, index int32) BaseTask {
@@ -47,3 +66,24 @@ func (t BaseTask) TaskTimeout() (time.Duration, bool) {
 	}
 	return t.Timeout, true
 }
+
+// retryBackoff returns the backoff between retries, defaulting to 1s
+// increasing to 30s
+func (t BaseTask) retryBackoff() backoff.Backoff {
+	b := backoff.Backoff{
+		Min:    t.MinBackoff,
+		Max:    t.MaxBackoff,
+		Factor: 2,
+		Jitter: true,
+	}
+	if b.Min == 0 {
+		b.Min = defaultTaskMinBackoff
+	}
+	if b.Max == 0 {
+		b.Max = defaultTaskMaxBackoff
+	}
+	if b.Max < b.Min {
+		b.Max = b.Min
+	}
+	return b
+}
@@ -3,11 +3,15 @@ package pipeline
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"net/url"
 	"path"
 
 	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
 	uuid "github.com/satori/go.uuid"
+	"github.com/shopspring/decimal"
 	"go.uber.org/multierr"
 	"gorm.io/gorm"
 
@@ -26,16 +30,30 @@ type BridgeTask struct {
 	RequestData       string `json:"requestData"`
 	IncludeInputAtKey string `json:"includeInputAtKey"`
 	Async             string `json:"async"`
-
-	db     *gorm.DB
-	config Config
-	id     uuid.UUID
+	Cache             string `json:"cache"`
+
+	db      *gorm.DB
+	config  Config
+	cache   *taskResultCache
+	bridges *bridges
+	id      uuid.UUID
+	// creditCost is the credit cost of the bridge, recorded once a request
+	// has been sent to it.
+	creditCost decimal.Decimal
 }
 
 var _ Task = (*BridgeTask)(nil)
 
 var zeroURL = new(url.URL)
 
+var promBridgeLatency = promauto.NewHistogramVec(prometheus.HistogramOpts{
+	Name:    "pipeline_bridge_latency_seconds",
+	Help:    "How long bridges took to respond to bridge tasks, by response status code",
+	Buckets: prometheus.ExponentialBuckets(0.001, 4, 10),
+},
+	[]string{"job_id", "job_name", "task_id", "bridge_name", "status_code"},
+)
+
 func (t *BridgeTask) Type() TaskType {
 	return TaskTypeBridge
 }
@@ -52,20 +70,23 @@ func (t *BridgeTask) Run(ctx context.Context, vars Vars, inputs []Result) Result
 		name              StringParam
 		requestData       MapParam
 		includeInputAtKey StringParam
+		cacheTTL          DurationParam
 	)
 	err = multierr.Combine(
 		errors.Wrap(ResolveParam(&name, From(NonemptyString(t.Name))), "name"),
 		errors.Wrap(ResolveParam(&requestData, From(VarExpr(t.RequestData, vars), JSONWithVarExprs(t.RequestData, vars, false), nil)), "requestData"),
 		errors.Wrap(ResolveParam(&includeInputAtKey, From(t.IncludeInputAtKey)), "includeInputAtKey"),
+		errors.Wrap(ResolveParam(&cacheTTL, From(NonemptyString(t.Cache), "0s")), "cache"),
 	)
 	if err != nil {
 		return Result{Error: err}
 	}
 
-	url, err := t.getBridgeURLFromName(name)
+	bt, err := t.getBridgeTypeFromName(name)
 	if err != nil {
 		return Result{Error: err}
 	}
+	url := URLParam(bt.URL)
 
 	var metaMap MapParam
 
@@ -91,7 +112,7 @@ func (t *BridgeTask) Run(ctx context.Context, vars Vars, inputs []Result) Result
 	if t.Async == "true" {
 		responseURL := t.config.BridgeResponseURL()
 		if *responseURL != *zeroURL {
-			responseURL.Path = path.Join(responseURL.Path, "/v2/resume/", t.id.String())
+			responseURL.Path = path.Join(responseURL.Path, "/v2/pipeline/runs/", t.id.String(), "resume")
 		}
 		requestData["responseURL"] = responseURL.String()
 	}
@@ -104,15 +125,41 @@ func (t *BridgeTask) Run(ctx context.Context, vars Vars, inputs []Result) Result
 	if err != nil {
 		return Result{Error: err}
 	}
+
+	// Async responses arrive out of band, so they are never cached
+	if t.Async == "true" {
+		cacheTTL = 0
+	}
+	cacheKey := fmt.Sprintf("bridge|%s|%s", name, requestDataJSON)
+	if cacheTTL > 0 {
+		if value, cached := t.cache.get(cacheKey, cacheTTL.Duration()); cached {
+			logger.Debugw("Bridge task: using cached response",
+				"url", t.redactString(url.String()),
+				"dotID", t.DotID(),
+			)
+			return Result{Value: value}
+		}
+	}
+
 	logger.Debugw("Bridge task: sending request",
-		"requestData", string(requestDataJSON),
-		"url", url.String(),
+		"requestData", t.redactString(string(requestDataJSON)),
+		"url", t.redactString(url.String()),
 	)
 
-	responseBytes, headers, elapsed, err := makeHTTPRequest(ctx, "POST", URLParam(url), requestData, allowUnrestrictedNetworkAccess, t.config)
+	var limiter *bridgeLimiter
+	if t.bridges != nil {
+		limiter = t.bridges.limiter(bt)
+	}
+	if err = limiter.acquire(ctx); err != nil {
+		return Result{Error: err}
+	}
+	// The request is recorded in promBridgeLatency, by bridge name
+	responseBytes, headers, elapsed, err := makeHTTPRequest(contextWithBridgeName(ctx, string(name)), "POST", url, requestData, nil, allowUnrestrictedNetworkAccess, t.config)
+	limiter.release()
 	if err != nil {
 		return Result{Error: err}
 	}
+	t.creditCost = bt.CreditCost
 
 	if t.Async == "true" {
 		// Look for a `pending` flag. This check is case-insensitive because http.Header normalizes header names
@@ -133,25 +180,29 @@ func (t *BridgeTask) Run(ctx context.Context, vars Vars, inputs []Result) Result
 	// flag such as  "BinaryMode: true" which passes through raw binary as the
 	// value instead.
 	result := Result{Value: string(responseBytes)}
+	t.cache.set(cacheKey, result.Value, cacheTTL.Duration())
 
 	promHTTPFetchTime.WithLabelValues(t.DotID()).Set(float64(elapsed))
 	promHTTPResponseBodySize.WithLabelValues(t.DotID()).Set(float64(len(responseBytes)))
 
 	logger.Debugw("Bridge task: fetched answer",
-		"answer", result.Value,
-		"url", url.String(),
+		"answer", t.redactString(string(responseBytes)),
+		"url", t.redactString(url.String()),
 		"dotID", t.DotID(),
 	)
 	return result
 }
 
-func (t BridgeTask) getBridgeURLFromName(name StringParam) (URLParam, error) {
+func (t BridgeTask) getBridgeTypeFromName(name StringParam) (models.BridgeType, error) {
+	if t.bridges != nil {
+		return t.bridges.find(t.db, string(name))
+	}
 	var bt models.BridgeType
 	err := t.db.First(&bt, "name = ?", string(name)).Error
 	if err != nil {
-		return URLParam{}, errors.Wrapf(err, "could not find bridge with name '%s'", name)
+		return bt, errors.Wrapf(err, "could not find bridge with name '%s'", name)
 	}
-	return URLParam(bt.URL), nil
+	return bt, nil
 }
 
 func withMeta(request MapParam, meta MapParam) MapParam {
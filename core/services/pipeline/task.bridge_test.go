@@ -12,8 +12,10 @@ import (
 	"testing"
 
 	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	uuid "github.com/satori/go.uuid"
 	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"gopkg.in/guregu/null.v4"
 
@@ -147,6 +149,40 @@ func TestBridgeTask_Happy(t *testing.T) {
 	require.Equal(t, decimal.NewFromInt(9700), x.Data.Result)
 }
 
+func TestBridgeTask_Metrics(t *testing.T) {
+	// Not parallel: the series of the shared histograms are counted
+
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+
+	s1 := httptest.NewServer(fakeStringResponder(t, `{"data": {"result": 1}}`))
+	defer s1.Close()
+	feedURL, err := url.ParseRequestURI(s1.URL)
+	require.NoError(t, err)
+
+	task := pipeline.BridgeTask{
+		BaseTask: pipeline.NewBaseTask(0, "ds", nil, nil, 0),
+		Name:     "metrics",
+	}
+	task.HelperSetDependencies(store.Config, store.DB, uuid.UUID{})
+	_, bridge := cltest.NewBridgeType(t, task.Name)
+	bridge.URL = models.WebURL(*feedURL)
+	require.NoError(t, store.ORM.DB.Create(&bridge).Error)
+
+	labels := []string{"42", "bridge metrics", "ds", "metrics", "200"}
+	bridgeSeries, _ := histogramSamples(t, pipeline.PromBridgeLatency, labels...)
+	httpSeries := testutil.CollectAndCount(pipeline.PromHTTPRequestDuration)
+
+	ctx := pipeline.ContextWithTaskMetricsLabels(context.Background(), pipeline.Spec{JobID: 42, JobName: "bridge metrics"}, &task)
+	result := task.Run(ctx, pipeline.NewVarsFrom(nil), nil)
+	require.NoError(t, result.Error)
+
+	series, samples := histogramSamples(t, pipeline.PromBridgeLatency, labels...)
+	assert.Equal(t, bridgeSeries+1, series)
+	assert.Equal(t, uint64(1), samples, "the call is labelled by job, task, bridge name and status code")
+	assert.Equal(t, httpSeries, testutil.CollectAndCount(pipeline.PromHTTPRequestDuration), "the call is recorded once")
+}
+
 func TestBridgeTask_AsyncJobPendingState(t *testing.T) {
 	t.Parallel()
 
@@ -165,7 +201,7 @@ func TestBridgeTask_AsyncJobPendingState(t *testing.T) {
 
 		err = json.Unmarshal(payload, &reqBody)
 		require.NoError(t, err)
-		require.Equal(t, fmt.Sprintf("https://chain.link/v2/resume/%v", id.String()), reqBody.ResponseURL)
+		require.Equal(t, fmt.Sprintf("https://chain.link/v2/pipeline/runs/%v/resume", id.String()), reqBody.ResponseURL)
 		w.Header().Set("Content-Type", "application/json")
 
 		// w.Header().Set("X-Chainlink-Pending", "true")
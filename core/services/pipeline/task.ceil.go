@@ -0,0 +1,48 @@
+package pipeline
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"go.uber.org/multierr"
+)
+
+// CeilTask rounds its input towards positive infinity to precision decimal
+// places (0 by default).
+//
+// Return types:
+//
+//	*decimal.Decimal
+type CeilTask struct {
+	BaseTask  `mapstructure:",squash"`
+	Input     string `json:"input"`
+	Precision string `json:"precision"`
+}
+
+var _ Task = (*CeilTask)(nil)
+
+func (t *CeilTask) Type() TaskType {
+	return TaskTypeCeil
+}
+
+func (t *CeilTask) Run(_ context.Context, vars Vars, inputs []Result) (result Result) {
+	_, err := CheckInputs(inputs, 0, 1, 0)
+	if err != nil {
+		return Result{Error: errors.Wrap(err, "task inputs")}
+	}
+
+	var (
+		a              DecimalParam
+		maybePrecision MaybeInt32Param
+	)
+	err = multierr.Combine(
+		errors.Wrap(ResolveParam(&a, From(VarExpr(t.Input, vars), Input(inputs, 0))), "input"),
+		errors.Wrap(ResolveParam(&maybePrecision, From(VarExpr(t.Precision, vars), t.Precision)), "precision"),
+	)
+	if err != nil {
+		return Result{Error: err}
+	}
+
+	precision, _ := maybePrecision.Int32()
+	return Result{Value: roundDecimal(a.Decimal(), precision, RoundingModeCeil)}
+}
@@ -0,0 +1,48 @@
+package pipeline_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+)
+
+func TestCeilTask(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		input     interface{}
+		precision string
+		expected  string
+	}{
+		{"positive", "2.1", "", "3"},
+		{"negative", "-2.7", "", "-2"},
+		{"integer", "5", "", "5"},
+		{"precision", "3.14159", "3", "3.142"},
+		{"negative with precision", "-3.14159", "3", "-3.141"},
+		{"negative precision", "1201", "-2", "1300"},
+		{"float", float64(0.5), "0", "1"},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			task := pipeline.CeilTask{BaseTask: pipeline.NewBaseTask(0, "task", nil, nil, 0), Precision: test.precision}
+			result := task.Run(context.Background(), pipeline.NewVarsFrom(nil), []pipeline.Result{{Value: test.input}})
+			require.NoError(t, result.Error)
+			require.Equal(t, test.expected, result.Value.(decimal.Decimal).String())
+		})
+	}
+
+	t.Run("with pipeline.Vars", func(t *testing.T) {
+		vars := pipeline.NewVarsFrom(map[string]interface{}{"foo": "9.91", "prec": "1"})
+		task := pipeline.CeilTask{BaseTask: pipeline.NewBaseTask(0, "task", nil, nil, 0), Input: "$(foo)", Precision: "$(prec)"}
+		result := task.Run(context.Background(), vars, []pipeline.Result{})
+		require.NoError(t, result.Error)
+		require.Equal(t, "10", result.Value.(decimal.Decimal).String())
+	})
+}
@@ -0,0 +1,78 @@
+package pipeline
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+	"go.uber.org/multierr"
+)
+
+// ConcatTask joins its values, or its inputs if no values are given, into a
+// single string with an optional separator. Numbers are written in decimal.
+//
+// Return types:
+//
+//	string
+type ConcatTask struct {
+	BaseTask  `mapstructure:",squash"`
+	Values    string `json:"values"`
+	Separator string `json:"separator"`
+}
+
+var _ Task = (*ConcatTask)(nil)
+
+func (t *ConcatTask) Type() TaskType {
+	return TaskTypeConcat
+}
+
+func (t *ConcatTask) Run(_ context.Context, vars Vars, inputs []Result) (result Result) {
+	var (
+		values    SliceParam
+		separator StringParam
+	)
+	err := multierr.Combine(
+		errors.Wrap(ResolveParam(&values, From(VarExpr(t.Values, vars), JSONWithVarExprs(t.Values, vars, false), Inputs(inputs))), "values"),
+		errors.Wrap(ResolveParam(&separator, From(VarExpr(t.Separator, vars), t.Separator)), "separator"),
+	)
+	if err != nil {
+		return Result{Error: err}
+	}
+
+	strs := make([]string, len(values))
+	for i, value := range values {
+		strs[i], err = concatString(value)
+		if err != nil {
+			return Result{Error: errors.Wrapf(err, "values[%d]", i)}
+		}
+	}
+	return Result{Value: strings.Join(strs, string(separator))}
+}
+
+func concatString(value interface{}) (string, error) {
+	switch v := value.(type) {
+	case string:
+		return v, nil
+	case []byte:
+		return string(v), nil
+	case decimal.Decimal:
+		return v.String(), nil
+	case *decimal.Decimal:
+		return v.String(), nil
+	case *big.Int:
+		return v.String(), nil
+	case float64:
+		return decimal.NewFromFloat(v).String(), nil
+	case float32:
+		return decimal.NewFromFloat32(v).String(), nil
+	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64, bool:
+		return fmt.Sprint(v), nil
+	case error:
+		return "", v
+	default:
+		return "", errors.Wrapf(ErrBadInput, "cannot concatenate a %T", value)
+	}
+}
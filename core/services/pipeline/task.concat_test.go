@@ -0,0 +1,66 @@
+package pipeline_test
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+)
+
+func TestConcatTask(t *testing.T) {
+	t.Parallel()
+
+	t.Run("inputs", func(t *testing.T) {
+		task := pipeline.ConcatTask{BaseTask: pipeline.NewBaseTask(0, "task", nil, nil, 0)}
+		result := task.Run(context.Background(), pipeline.NewVarsFrom(nil), []pipeline.Result{
+			{Value: "foo"},
+			{Value: []byte("bar")},
+			{Value: decimal.RequireFromString("1.5")},
+			{Value: big.NewInt(42)},
+			{Value: float64(0.25)},
+			{Value: int64(-7)},
+			{Value: true},
+		})
+		require.NoError(t, result.Error)
+		require.Equal(t, "foobar1.5420.25-7true", result.Value)
+	})
+
+	t.Run("values with separator and pipeline.Vars", func(t *testing.T) {
+		vars := pipeline.NewVarsFrom(map[string]interface{}{
+			"foo": map[string]interface{}{"bar": "ETH", "baz": "USD"},
+		})
+		task := pipeline.ConcatTask{
+			BaseTask:  pipeline.NewBaseTask(0, "task", nil, nil, 0),
+			Values:    `[ $(foo.bar), $(foo.baz) ]`,
+			Separator: "/",
+		}
+		result := task.Run(context.Background(), vars, []pipeline.Result{{Value: "ignored"}})
+		require.NoError(t, result.Error)
+		require.Equal(t, "ETH/USD", result.Value)
+	})
+
+	t.Run("no values", func(t *testing.T) {
+		task := pipeline.ConcatTask{BaseTask: pipeline.NewBaseTask(0, "task", nil, nil, 0)}
+		result := task.Run(context.Background(), pipeline.NewVarsFrom(nil), []pipeline.Result{})
+		require.NoError(t, result.Error)
+		require.Equal(t, "", result.Value)
+	})
+
+	t.Run("errored input", func(t *testing.T) {
+		task := pipeline.ConcatTask{BaseTask: pipeline.NewBaseTask(0, "task", nil, nil, 0)}
+		result := task.Run(context.Background(), pipeline.NewVarsFrom(nil), []pipeline.Result{{Value: "foo"}, {Error: errors.New("boom")}})
+		require.Error(t, result.Error)
+		require.Contains(t, result.Error.Error(), "boom")
+	})
+
+	t.Run("bad input", func(t *testing.T) {
+		task := pipeline.ConcatTask{BaseTask: pipeline.NewBaseTask(0, "task", nil, nil, 0)}
+		result := task.Run(context.Background(), pipeline.NewVarsFrom(nil), []pipeline.Result{{Value: map[string]interface{}{"foo": "bar"}}})
+		require.ErrorIs(t, result.Error, pipeline.ErrBadInput)
+	})
+}
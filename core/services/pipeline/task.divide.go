@@ -12,10 +12,11 @@ import (
 //    *decimal.Decimal
 //
 type DivideTask struct {
-	BaseTask  `mapstructure:",squash"`
-	Input     string `json:"input"`
-	Divisor   string `json:"divisor"`
-	Precision string `json:"precision"`
+	BaseTask     `mapstructure:",squash"`
+	Input        string `json:"input"`
+	Divisor      string `json:"divisor"`
+	Precision    string `json:"precision"`
+	RoundingMode string `json:"roundingMode"`
 }
 
 var _ Task = (*DivideTask)(nil)
@@ -34,18 +35,25 @@ func (t *DivideTask) Run(_ context.Context, vars Vars, inputs []Result) (result
 		a              DecimalParam
 		b              DecimalParam
 		maybePrecision MaybeInt32Param
+		roundingMode   RoundingModeParam
 	)
 	err = multierr.Combine(
 		errors.Wrap(ResolveParam(&a, From(VarExpr(t.Input, vars), Input(inputs, 0))), "input"),
 		errors.Wrap(ResolveParam(&b, From(VarExpr(t.Divisor, vars), NonemptyString(t.Divisor))), "divisor"),
 		errors.Wrap(ResolveParam(&maybePrecision, From(VarExpr(t.Precision, vars), t.Precision)), "precision"),
+		errors.Wrap(ResolveParam(&roundingMode, From(VarExpr(t.RoundingMode, vars), t.RoundingMode)), "roundingMode"),
 	)
 	if err != nil {
 		return Result{Error: err}
 	}
+	if b.Decimal().IsZero() {
+		return Result{Error: errors.Wrap(ErrBadInput, "divide by zero")}
+	}
 
 	if precision, isSet := maybePrecision.Int32(); isSet {
-		return Result{Value: a.Decimal().DivRound(b.Decimal(), precision)}
+		return Result{Value: divRound(a.Decimal(), b.Decimal(), precision, roundingMode.RoundingMode())}
+	} else if t.RoundingMode != "" {
+		return Result{Error: errors.Wrap(ErrBadInput, "roundingMode requires precision to be set")}
 	}
 	// Note that decimal library defaults to rounding to 16 precision
 	// https://github.com/shopspring/decimal/blob/2568a29459476f824f35433dfbef158d6ad8618c/decimal.go#L44
@@ -135,6 +135,7 @@ func TestDivideTask_Unhappy(t *testing.T) {
 		{"input as missing var", "100", "$(foo)", nil, pipeline.NewVarsFrom(nil), pipeline.ErrKeypathNotFound, "input"},
 		{"divisor as missing var", "$(foo)", "", []pipeline.Result{{Value: "123"}}, pipeline.NewVarsFrom(nil), pipeline.ErrKeypathNotFound, "divisor"},
 		{"errored inputs", "1000", "", []pipeline.Result{{Error: errors.New("uh oh")}}, pipeline.NewVarsFrom(nil), pipeline.ErrTooManyErrors, "task inputs"},
+		{"divide by zero", "0", "", []pipeline.Result{{Value: "123"}}, pipeline.NewVarsFrom(nil), pipeline.ErrBadInput, "divide by zero"},
 	}
 
 	for _, tt := range tests {
@@ -155,3 +156,64 @@ func TestDivideTask_Unhappy(t *testing.T) {
 		})
 	}
 }
+
+func TestDivideTask_RoundingMode(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		input        string
+		divisor      string
+		precision    string
+		roundingMode string
+		expected     string
+	}{
+		{"default", "5", "2", "0", "", "3"},
+		{"half_up", "-5", "2", "0", "half_up", "-3"},
+		{"half_even", "5", "2", "0", "half_even", "2"},
+		{"half_even, odd", "7", "2", "0", "half_even", "4"},
+		{"up", "10", "3", "2", "up", "3.34"},
+		{"down", "20", "3", "2", "down", "6.66"},
+		{"ceil", "-20", "3", "2", "ceil", "-6.66"},
+		{"floor", "-10", "3", "2", "floor", "-3.34"},
+		// 1/3 + 10^-30 must round up even though Div would truncate it
+		{"ceil beyond division precision", "1.000000000000000000000000000003", "3", "2", "ceil", "0.34"},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			task := pipeline.DivideTask{
+				BaseTask:     pipeline.NewBaseTask(0, "task", nil, nil, 0),
+				Divisor:      test.divisor,
+				Precision:    test.precision,
+				RoundingMode: test.roundingMode,
+			}
+			result := task.Run(context.Background(), pipeline.NewVarsFrom(nil), []pipeline.Result{{Value: test.input}})
+			require.NoError(t, result.Error)
+			require.Equal(t, test.expected, result.Value.(decimal.Decimal).String())
+		})
+	}
+
+	t.Run("rounding mode without precision", func(t *testing.T) {
+		task := pipeline.DivideTask{
+			BaseTask:     pipeline.NewBaseTask(0, "task", nil, nil, 0),
+			Divisor:      "3",
+			RoundingMode: "floor",
+		}
+		result := task.Run(context.Background(), pipeline.NewVarsFrom(nil), []pipeline.Result{{Value: "10"}})
+		require.Equal(t, pipeline.ErrBadInput, errors.Cause(result.Error))
+	})
+
+	t.Run("unknown rounding mode", func(t *testing.T) {
+		task := pipeline.DivideTask{
+			BaseTask:     pipeline.NewBaseTask(0, "task", nil, nil, 0),
+			Divisor:      "3",
+			Precision:    "2",
+			RoundingMode: "sideways",
+		}
+		result := task.Run(context.Background(), pipeline.NewVarsFrom(nil), []pipeline.Result{{Value: "10"}})
+		require.Equal(t, pipeline.ErrBadInput, errors.Cause(result.Error))
+		require.Contains(t, result.Error.Error(), "roundingMode")
+	})
+}
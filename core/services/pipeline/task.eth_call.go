@@ -2,8 +2,10 @@ package pipeline
 
 import (
 	"context"
+	"math/big"
 
 	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
 
 	"github.com/pkg/errors"
@@ -12,14 +14,24 @@ import (
 	"github.com/smartcontractkit/chainlink/core/services/eth"
 )
 
+// ETHCallTask calls a contract with either raw calldata or, if abi is set,
+// calldata encoded from args. The abi is a function signature such as
+// `balanceOf(address account) returns (uint256 balance)` or a JSON ABI, in
+// which case method selects the function if the ABI contains more than one.
+// The call is made against the latest block unless block is set.
 //
 // Return types:
 //     []byte
+//     map[string]interface{} with any geth/abigen value type, if abi has outputs
 //
 type ETHCallTask struct {
 	BaseTask `mapstructure:",squash"`
 	Contract string `json:"contract"`
 	Data     string `json:"data"`
+	ABI      string `json:"abi"`
+	Method   string `json:"method"`
+	Args     string `json:"args"`
+	Block    string `json:"block"`
 
 	ethClient eth.Client
 }
@@ -39,15 +51,37 @@ func (t *ETHCallTask) Run(ctx context.Context, vars Vars, inputs []Result) (resu
 	var (
 		contractAddr AddressParam
 		data         BytesParam
+		block        MaybeUint64Param
 	)
 	err = multierr.Combine(
 		errors.Wrap(ResolveParam(&contractAddr, From(NonemptyString(t.Contract))), "contract"),
-		errors.Wrap(ResolveParam(&data, From(VarExpr(t.Data, vars), JSONWithVarExprs(t.Data, vars, false))), "data"),
+		errors.Wrap(ResolveParam(&block, From(VarExpr(t.Block, vars), t.Block)), "block"),
 	)
 	if err != nil {
 		return Result{Error: err}
-	} else if len(data) == 0 {
-		return Result{Error: errors.Wrapf(ErrBadInput, "data param must not be empty")}
+	}
+
+	var method *abi.Method
+	if t.ABI != "" {
+		if t.Data != "" {
+			return Result{Error: errors.Wrap(ErrBadInput, "only one of data and abi may be set")}
+		}
+		m, err2 := parseETHABIFunction([]byte(t.ABI), t.Method)
+		if err2 != nil {
+			return Result{Error: errors.Wrapf(ErrBadInput, "abi: %v", err2)}
+		}
+		method = &m
+		data, err = t.encodeArgs(method, vars)
+		if err != nil {
+			return Result{Error: errors.Wrap(err, "args")}
+		}
+	} else {
+		err = errors.Wrap(ResolveParam(&data, From(VarExpr(t.Data, vars), JSONWithVarExprs(t.Data, vars, false))), "data")
+		if err != nil {
+			return Result{Error: err}
+		} else if len(data) == 0 {
+			return Result{Error: errors.Wrapf(ErrBadInput, "data param must not be empty")}
+		}
 	}
 
 	call := ethereum.CallMsg{
@@ -55,9 +89,49 @@ func (t *ETHCallTask) Run(ctx context.Context, vars Vars, inputs []Result) (resu
 		Data: []byte(data),
 	}
 
-	resp, err := t.ethClient.CallContract(ctx, call, nil)
+	var blockNumber *big.Int
+	if n, isSet := block.Uint64(); isSet {
+		blockNumber = new(big.Int).SetUint64(n)
+	}
+
+	resp, err := t.ethClient.CallContract(ctx, call, blockNumber)
 	if err != nil {
 		return Result{Error: err}
 	}
-	return Result{Value: resp}
+	if method == nil || len(method.Outputs) == 0 {
+		return Result{Value: resp}
+	}
+
+	out := make(map[string]interface{})
+	if err := method.Outputs.UnpackIntoMap(out, resp); err != nil {
+		return Result{Error: errors.Wrap(err, "failed to decode call result")}
+	}
+	return Result{Value: out}
+}
+
+func (t *ETHCallTask) encodeArgs(method *abi.Method, vars Vars) (BytesParam, error) {
+	var argValues MapParam
+	err := ResolveParam(&argValues, From(VarExpr(t.Args, vars), JSONWithVarExprs(t.Args, vars, false), nil))
+	if err != nil {
+		return nil, err
+	}
+
+	var vals []interface{}
+	for _, arg := range method.Inputs {
+		val, exists := argValues[arg.Name]
+		if !exists {
+			return nil, errors.Wrapf(ErrBadInput, "argument '%v' is missing", arg.Name)
+		}
+		val, err = convertToETHABIType(val, arg.Type)
+		if err != nil {
+			return nil, errors.Wrapf(ErrBadInput, "while converting argument '%v' from %T to %v: %v", arg.Name, val, arg.Type, err)
+		}
+		vals = append(vals, val)
+	}
+
+	argsEncoded, err := method.Inputs.Pack(vals...)
+	if err != nil {
+		return nil, errors.Wrapf(ErrBadInput, "could not ABI encode values: %v", err)
+	}
+	return append(method.ID, argsEncoded...), nil
 }
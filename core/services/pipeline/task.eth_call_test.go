@@ -7,6 +7,7 @@ import (
 
 	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
@@ -117,3 +118,84 @@ func TestETHCallTask(t *testing.T) {
 		})
 	}
 }
+
+func TestETHCallTask_ABI(t *testing.T) {
+	contractAddr := common.HexToAddress("0xDeaDbeefdEAdbeefdEadbEEFdeadbeEFdEaDbeeF")
+	account := common.HexToAddress("0x1111111111111111111111111111111111111111")
+	calldata := append(hexutil.MustDecode("0x70a08231"), common.LeftPadBytes(account.Bytes(), 32)...)
+	resp := common.LeftPadBytes(big.NewInt(42).Bytes(), 32)
+
+	tests := []struct {
+		name        string
+		abi         string
+		method      string
+		block       string
+		blockNumber *big.Int
+	}{
+		{
+			"function signature",
+			"balanceOf(address account) returns (uint256 balance)",
+			"",
+			"",
+			nil,
+		},
+		{
+			"JSON ABI",
+			`[{"type":"function","name":"balanceOf","stateMutability":"view","inputs":[{"name":"account","type":"address"}],"outputs":[{"name":"balance","type":"uint256"}]},
+			  {"type":"function","name":"totalSupply","stateMutability":"view","inputs":[],"outputs":[{"name":"supply","type":"uint256"}]}]`,
+			"balanceOf",
+			"",
+			nil,
+		},
+		{
+			"pinned block",
+			"balanceOf(address account) returns (uint256 balance)",
+			"",
+			"$(blockNumber)",
+			big.NewInt(1234),
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			task := pipeline.ETHCallTask{
+				BaseTask: pipeline.NewBaseTask(0, "ethcall", nil, nil, 0),
+				Contract: contractAddr.Hex(),
+				ABI:      test.abi,
+				Method:   test.method,
+				Args:     `{"account": $(account)}`,
+				Block:    test.block,
+			}
+
+			ethClient := new(ethmocks.Client)
+			ethClient.
+				On("CallContract", mock.Anything, ethereum.CallMsg{To: &contractAddr, Data: calldata}, test.blockNumber).
+				Return(resp, nil)
+			task.HelperSetDependencies(ethClient)
+
+			vars := pipeline.NewVarsFrom(map[string]interface{}{
+				"account":     account.Hex(),
+				"blockNumber": uint64(1234),
+			})
+			result := task.Run(context.Background(), vars, nil)
+			require.NoError(t, result.Error)
+			require.Equal(t, map[string]interface{}{"balance": big.NewInt(42)}, result.Value)
+			ethClient.AssertExpectations(t)
+		})
+	}
+
+	t.Run("missing argument", func(t *testing.T) {
+		task := pipeline.ETHCallTask{
+			BaseTask: pipeline.NewBaseTask(0, "ethcall", nil, nil, 0),
+			Contract: contractAddr.Hex(),
+			ABI:      "balanceOf(address account) returns (uint256 balance)",
+			Args:     `{}`,
+		}
+		task.HelperSetDependencies(new(ethmocks.Client))
+
+		result := task.Run(context.Background(), pipeline.NewVarsFrom(nil), nil)
+		require.Equal(t, pipeline.ErrBadInput, errors.Cause(result.Error))
+		require.Contains(t, result.Error.Error(), "account")
+	})
+}
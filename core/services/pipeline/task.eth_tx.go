@@ -2,18 +2,26 @@ package pipeline
 
 import (
 	"context"
+	"math/big"
 	"reflect"
 	"strconv"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/mitchellh/mapstructure"
 	"github.com/pkg/errors"
+	uuid "github.com/satori/go.uuid"
+	"github.com/shopspring/decimal"
 	"go.uber.org/multierr"
+	"gopkg.in/guregu/null.v4"
 	"gorm.io/gorm"
 
 	"github.com/smartcontractkit/chainlink/core/logger"
 	"github.com/smartcontractkit/chainlink/core/services/bulletprooftxmanager"
+	"github.com/smartcontractkit/chainlink/core/services/eth"
 	"github.com/smartcontractkit/chainlink/core/store/models"
+	"github.com/smartcontractkit/chainlink/core/utils"
 )
 
 //
@@ -27,11 +35,39 @@ type ETHTxTask struct {
 	Data     string `json:"data"`
 	GasLimit string `json:"gasLimit"`
 	TxMeta   string `json:"txMeta"`
+	// Urgency is low, normal (the default) or high, and selects how
+	// aggressively the transaction's gas is bumped
+	Urgency string `json:"urgency"`
+	// ExpiresAfter and ExpiresAtBlock cancel the transaction if it is still
+	// unconfirmed after the given duration or at the given block number
+	ExpiresAfter   string `json:"expiresAfter"`
+	ExpiresAtBlock string `json:"expiresAtBlock"`
+	// Simulate can be set to false to opt the transaction out of being
+	// simulated before broadcast, if ETH_TX_SIMULATE_BEFORE_BROADCAST is on
+	Simulate string `json:"simulate"`
+	// EVMChainID sends the transaction on one of ETH_ADDITIONAL_CHAINS
+	// instead of the node's primary chain
+	EVMChainID string `json:"evmChainID"`
+	// ForwarderAddress routes the transaction through an allowlisted
+	// forwarder contract, which relays it to To
+	ForwarderAddress string `json:"forwarderAddress"`
+	// EstimateGasLimit replaces the gas limit with an eth_estimateGas
+	// estimate of the transaction, padded by ETH_GAS_LIMIT_ESTIMATE_MULTIPLIER.
+	// GasLimit then acts as a ceiling: if the estimate exceeds it, no
+	// transaction is created
+	EstimateGasLimit string `json:"estimateGasLimit"`
+	// EstimateGasBlock is the block whose state the estimate runs against,
+	// e.g. the block of the request being fulfilled. Defaults to latest
+	EstimateGasBlock string `json:"estimateGasBlock"`
 
 	db        *gorm.DB
 	config    Config
 	keyStore  ETHKeyStore
 	txManager TxManager
+	ethClient eth.Client
+	// ethTxID is the ID of the eth_tx created by the task, which is linked
+	// to the pipeline run once it has been saved.
+	ethTxID int64
 }
 
 //go:generate mockery --name ETHKeyStore --output ./mocks/ --case=underscore
@@ -39,10 +75,12 @@ type ETHTxTask struct {
 
 type ETHKeyStore interface {
 	GetRoundRobinAddress(addrs ...common.Address) (common.Address, error)
+	SignMessage(ctx context.Context, address common.Address, msg []byte) ([]byte, error)
 }
 
 type TxManager interface {
-	CreateEthTransaction(db *gorm.DB, fromAddress, toAddress common.Address, payload []byte, gasLimit uint64, meta interface{}, strategy bulletprooftxmanager.TxStrategy) (etx bulletprooftxmanager.EthTx, err error)
+	CreateEthTransaction(db *gorm.DB, fromAddress, toAddress common.Address, payload []byte, gasLimit uint64, meta interface{}, strategy bulletprooftxmanager.TxStrategy, urgency bulletprooftxmanager.EthTxUrgency, expiry bulletprooftxmanager.EthTxExpiry, simulate bool) (etx bulletprooftxmanager.EthTx, err error)
+	ForChain(chainID *big.Int) (bulletprooftxmanager.TxManager, error)
 }
 
 var _ Task = (*ETHTxTask)(nil)
@@ -51,18 +89,26 @@ func (t *ETHTxTask) Type() TaskType {
 	return TaskTypeETHTx
 }
 
-func (t *ETHTxTask) Run(_ context.Context, vars Vars, inputs []Result) (result Result) {
+func (t *ETHTxTask) Run(ctx context.Context, vars Vars, inputs []Result) (result Result) {
 	_, err := CheckInputs(inputs, -1, -1, 0)
 	if err != nil {
 		return Result{Error: errors.Wrap(err, "task inputs")}
 	}
 
 	var (
-		fromAddrs AddressSliceParam
-		toAddr    AddressParam
-		data      BytesParam
-		gasLimit  Uint64Param
-		txMetaMap MapParam
+		fromAddrs      AddressSliceParam
+		toAddr         AddressParam
+		data           BytesParam
+		gasLimit       Uint64Param
+		txMetaMap      MapParam
+		urgency        StringParam
+		expiresAfter   DurationParam
+		expiresAtBlock MaybeUint64Param
+		simulate       BoolParam
+		evmChainID     MaybeUint64Param
+		forwarderAddr  AddressParam
+		estimate       BoolParam
+		estimateBlock  MaybeUint64Param
 	)
 	err = multierr.Combine(
 		errors.Wrap(ResolveParam(&fromAddrs, From(VarExpr(t.From, vars), JSONWithVarExprs(t.From, vars, false), NonemptyString(t.From), nil)), "from"),
@@ -70,10 +116,34 @@ func (t *ETHTxTask) Run(_ context.Context, vars Vars, inputs []Result) (result R
 		errors.Wrap(ResolveParam(&data, From(VarExpr(t.Data, vars), NonemptyString(t.Data))), "data"),
 		errors.Wrap(ResolveParam(&gasLimit, From(VarExpr(t.GasLimit, vars), NonemptyString(t.GasLimit), t.config.EthGasLimitDefault())), "gasLimit"),
 		errors.Wrap(ResolveParam(&txMetaMap, From(VarExpr(t.TxMeta, vars), JSONWithVarExprs(t.TxMeta, vars, false), MapParam{})), "txMeta"),
+		errors.Wrap(ResolveParam(&urgency, From(VarExpr(t.Urgency, vars), NonemptyString(t.Urgency), "")), "urgency"),
+		errors.Wrap(ResolveParam(&expiresAfter, From(VarExpr(t.ExpiresAfter, vars), NonemptyString(t.ExpiresAfter), "0s")), "expiresAfter"),
+		errors.Wrap(ResolveParam(&expiresAtBlock, From(VarExpr(t.ExpiresAtBlock, vars), t.ExpiresAtBlock)), "expiresAtBlock"),
+		errors.Wrap(ResolveParam(&simulate, From(VarExpr(t.Simulate, vars), NonemptyString(t.Simulate), true)), "simulate"),
+		errors.Wrap(ResolveParam(&evmChainID, From(VarExpr(t.EVMChainID, vars), t.EVMChainID)), "evmChainID"),
+		errors.Wrap(ResolveParam(&forwarderAddr, From(VarExpr(t.ForwarderAddress, vars), NonemptyString(t.ForwarderAddress), utils.ZeroAddress)), "forwarderAddress"),
+		errors.Wrap(ResolveParam(&estimate, From(VarExpr(t.EstimateGasLimit, vars), NonemptyString(t.EstimateGasLimit), false)), "estimateGasLimit"),
+		errors.Wrap(ResolveParam(&estimateBlock, From(VarExpr(t.EstimateGasBlock, vars), t.EstimateGasBlock)), "estimateGasBlock"),
 	)
 	if err != nil {
 		return Result{Error: err}
 	}
+	if _, isSet := evmChainID.Uint64(); isSet && bool(estimate) {
+		return Result{Error: errors.Wrap(ErrBadInput, "estimateGasLimit is not supported with evmChainID")}
+	}
+
+	txUrgency, err := bulletprooftxmanager.ParseEthTxUrgency(string(urgency))
+	if err != nil {
+		return Result{Error: errors.Wrapf(ErrBadInput, "urgency: %v", err)}
+	}
+
+	var expiry bulletprooftxmanager.EthTxExpiry
+	if d := expiresAfter.Duration(); d > 0 {
+		expiry.Time = null.TimeFrom(time.Now().Add(d))
+	}
+	if block, isSet := expiresAtBlock.Uint64(); isSet {
+		expiry.Block = null.IntFrom(int64(block))
+	}
 
 	var txMeta models.EthTxMetaV2
 
@@ -112,12 +182,95 @@ func (t *ETHTxTask) Run(_ context.Context, vars Vars, inputs []Result) (result R
 
 	// NOTE: This can be easily adjusted later to allow job specs to specify the details of which strategy they would like
 	strategy := bulletprooftxmanager.SendEveryStrategy{}
+	// Transactions are attributed to their job, e.g. for spend budgets
+	if jobID, ok := externalJobID(vars); ok {
+		strategy = bulletprooftxmanager.NewSendEveryStrategy(jobID)
+	}
+
+	var txManager TxManager = t.txManager
+	var chainID *utils.Big
+	if id, isSet := evmChainID.Uint64(); isSet {
+		chainID = utils.NewBig(new(big.Int).SetUint64(id))
+		txManager, err = t.txManager.ForChain(chainID.ToInt())
+		if err != nil {
+			return Result{Error: errors.Wrapf(ErrBadInput, "evmChainID: %v", err)}
+		}
+	}
 
-	_, err = t.txManager.CreateEthTransaction(t.db, fromAddr, common.Address(toAddr), []byte(data), uint64(gasLimit), &txMeta, strategy)
+	to, payload := common.Address(toAddr), []byte(data)
+	if forwarder := common.Address(forwarderAddr); forwarder != utils.ZeroAddress {
+		payload, err = bulletprooftxmanager.ForwardPayload(t.db, chainID, forwarder, to, payload)
+		if errors.Is(err, bulletprooftxmanager.ErrForwarderNotAllowed) {
+			return Result{Error: errors.Wrapf(ErrBadInput, "forwarderAddress: %v", err)}
+		} else if err != nil {
+			return Result{Error: errors.Wrapf(ErrTaskRunFailed, "while forwarding transaction: %v", err)}
+		}
+		to = forwarder
+	}
+
+	if estimate {
+		var estimated uint64
+		estimated, err = t.estimateGasLimit(ctx, fromAddr, to, payload, estimateBlock)
+		if err != nil {
+			return Result{Error: errors.Wrapf(ErrTaskRunFailed, "while estimating gas limit: %v", err)}
+		}
+		if estimated > uint64(gasLimit) {
+			return Result{Error: errors.Wrapf(ErrTaskRunFailed, "estimated gas limit of %v exceeds the ceiling of %v", estimated, uint64(gasLimit))}
+		}
+		gasLimit = Uint64Param(estimated)
+	}
+
+	etx, err := txManager.CreateEthTransaction(t.db, fromAddr, to, payload, uint64(gasLimit), &txMeta, strategy, txUrgency, expiry, bool(simulate))
 	if err != nil {
 		return Result{Error: errors.Wrapf(ErrTaskRunFailed, "while creating transaction: %v", err)}
 	}
+	t.ethTxID = etx.ID
 	// TODO(spook): once @archseer's "async jobs" work is merged, return the tx hash of
 	// the successful EthTxAttempt
 	return Result{Value: nil}
 }
+
+// estimateGasLimit estimates the gas the transaction needs at the given
+// block and pads the estimate. eth_estimateGas finds the lowest limit at which
+// the transaction does not revert, but an oracle fulfillment does not revert
+// when its consumer callback runs out of gas, and the callback is only
+// forwarded 63/64 of the remaining gas (EIP-150), so the estimate is first
+// scaled up by 64/63 and then by ETH_GAS_LIMIT_ESTIMATE_MULTIPLIER.
+func (t *ETHTxTask) estimateGasLimit(ctx context.Context, from, to common.Address, payload []byte, block MaybeUint64Param) (uint64, error) {
+	if t.ethClient == nil {
+		return 0, errors.New("no eth client available")
+	}
+	blockNumber := "latest"
+	if n, isSet := block.Uint64(); isSet {
+		blockNumber = hexutil.EncodeUint64(n)
+	}
+	args := map[string]interface{}{
+		"from": from,
+		"to":   to,
+		"data": hexutil.Bytes(payload),
+	}
+	var estimate hexutil.Uint64
+	if err := t.ethClient.CallContext(ctx, &estimate, "eth_estimateGas", args, blockNumber); err != nil {
+		return 0, err
+	}
+	padded := (uint64(estimate)*64 + 62) / 63
+	return uint64(decimal.NewFromBigInt(new(big.Int).SetUint64(padded), 0).Mul(decimal.NewFromFloat32(t.config.EthGasLimitEstimateMultiplier())).IntPart()), nil
+}
+
+// externalJobID returns the external ID of the job the run belongs to, if the
+// run was started with jobSpec vars
+func externalJobID(vars Vars) (uuid.UUID, bool) {
+	v, err := vars.Get("jobSpec.externalJobID")
+	if err != nil {
+		return uuid.UUID{}, false
+	}
+	switch id := v.(type) {
+	case uuid.UUID:
+		return id, true
+	case string:
+		parsed, err := uuid.FromString(id)
+		return parsed, err == nil
+	default:
+		return uuid.UUID{}, false
+	}
+}
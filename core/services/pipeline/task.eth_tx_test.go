@@ -3,14 +3,19 @@ package pipeline_test
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/pkg/errors"
+	uuid "github.com/satori/go.uuid"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
 
 	"github.com/smartcontractkit/chainlink/core/internal/cltest"
 	"github.com/smartcontractkit/chainlink/core/services/bulletprooftxmanager"
+	ethmocks "github.com/smartcontractkit/chainlink/core/services/eth/mocks"
 	"github.com/smartcontractkit/chainlink/core/services/pipeline"
 	pipelinemocks "github.com/smartcontractkit/chainlink/core/services/pipeline/mocks"
 	"github.com/smartcontractkit/chainlink/core/store/models"
@@ -48,7 +53,7 @@ func TestETHTxTask(t *testing.T) {
 				gasLimit := uint64(12345)
 				txMeta := &models.EthTxMetaV2{JobID: 321, RequestID: common.HexToHash("0x5198616554d738d9485d1a7cf53b2f33e09c3bbc8fe9ac0020bd672cd2bc15d2"), RequestTxHash: common.HexToHash("0xc524fafafcaec40652b1f84fca09c231185437d008d195fccf2f51e64b7062f8")}
 				keyStore.On("GetRoundRobinAddress", from).Return(from, nil)
-				txManager.On("CreateEthTransaction", mock.Anything, from, to, data, gasLimit, txMeta, bulletprooftxmanager.SendEveryStrategy{}).Return(bulletprooftxmanager.EthTx{}, nil)
+				txManager.On("CreateEthTransaction", mock.Anything, from, to, data, gasLimit, txMeta, bulletprooftxmanager.SendEveryStrategy{}, bulletprooftxmanager.EthTxUrgencyNormal, bulletprooftxmanager.EthTxExpiry{}, true).Return(bulletprooftxmanager.EthTx{}, nil)
 			},
 			nil, nil, "",
 		},
@@ -77,7 +82,7 @@ func TestETHTxTask(t *testing.T) {
 				gasLimit := uint64(12345)
 				txMeta := &models.EthTxMetaV2{JobID: 321, RequestID: common.HexToHash("0x5198616554d738d9485d1a7cf53b2f33e09c3bbc8fe9ac0020bd672cd2bc15d2"), RequestTxHash: common.HexToHash("0xc524fafafcaec40652b1f84fca09c231185437d008d195fccf2f51e64b7062f8")}
 				keyStore.On("GetRoundRobinAddress", from).Return(from, nil)
-				txManager.On("CreateEthTransaction", mock.Anything, from, to, data, gasLimit, txMeta, bulletprooftxmanager.SendEveryStrategy{}).Return(bulletprooftxmanager.EthTx{}, nil)
+				txManager.On("CreateEthTransaction", mock.Anything, from, to, data, gasLimit, txMeta, bulletprooftxmanager.SendEveryStrategy{}, bulletprooftxmanager.EthTxUrgencyNormal, bulletprooftxmanager.EthTxExpiry{}, true).Return(bulletprooftxmanager.EthTx{}, nil)
 			},
 			nil, nil, "",
 		},
@@ -108,7 +113,30 @@ func TestETHTxTask(t *testing.T) {
 				gasLimit := uint64(12345)
 				txMeta := &models.EthTxMetaV2{JobID: 321, RequestID: common.HexToHash("0x5198616554d738d9485d1a7cf53b2f33e09c3bbc8fe9ac0020bd672cd2bc15d2"), RequestTxHash: common.HexToHash("0xc524fafafcaec40652b1f84fca09c231185437d008d195fccf2f51e64b7062f8")}
 				keyStore.On("GetRoundRobinAddress", from).Return(from, nil)
-				txManager.On("CreateEthTransaction", mock.Anything, from, to, data, gasLimit, txMeta, bulletprooftxmanager.SendEveryStrategy{}).Return(bulletprooftxmanager.EthTx{}, nil)
+				txManager.On("CreateEthTransaction", mock.Anything, from, to, data, gasLimit, txMeta, bulletprooftxmanager.SendEveryStrategy{}, bulletprooftxmanager.EthTxUrgencyNormal, bulletprooftxmanager.EthTxExpiry{}, true).Return(bulletprooftxmanager.EthTx{}, nil)
+			},
+			nil, nil, "",
+		},
+		{
+			"happy (attributed to the job)",
+			`[ "0x882969652440ccf14a5dbb9bd53eb21cb1e11e5c" ]`,
+			"0xDeaDbeefdEAdbeefdEadbEEFdeadbeEFdEaDbeeF",
+			"foobar",
+			"12345",
+			`{}`,
+			pipeline.NewVarsFrom(map[string]interface{}{
+				"jobSpec": map[string]interface{}{
+					"externalJobID": "0eec7e1d-d0d2-476c-a1a8-72dfb6633f46",
+				},
+			}),
+			nil,
+			func(config *pipelinemocks.Config, keyStore *pipelinemocks.KeyStore, txManager *pipelinemocks.TxManager) {
+				config.On("EthGasLimitDefault").Return(uint64(999))
+				from := common.HexToAddress("0x882969652440ccf14a5dbb9bd53eb21cb1e11e5c")
+				to := common.HexToAddress("0xDeaDbeefdEAdbeefdEadbEEFdeadbeEFdEaDbeeF")
+				strategy := bulletprooftxmanager.NewSendEveryStrategy(uuid.FromStringOrNil("0eec7e1d-d0d2-476c-a1a8-72dfb6633f46"))
+				keyStore.On("GetRoundRobinAddress", from).Return(from, nil)
+				txManager.On("CreateEthTransaction", mock.Anything, from, to, []byte("foobar"), uint64(12345), &models.EthTxMetaV2{}, strategy, bulletprooftxmanager.EthTxUrgencyNormal, bulletprooftxmanager.EthTxExpiry{}, true).Return(bulletprooftxmanager.EthTx{}, nil)
 			},
 			nil, nil, "",
 		},
@@ -139,7 +167,7 @@ func TestETHTxTask(t *testing.T) {
 				gasLimit := uint64(12345)
 				txMeta := &models.EthTxMetaV2{JobID: 321, RequestID: common.HexToHash("0x5198616554d738d9485d1a7cf53b2f33e09c3bbc8fe9ac0020bd672cd2bc15d2"), RequestTxHash: common.HexToHash("0xc524fafafcaec40652b1f84fca09c231185437d008d195fccf2f51e64b7062f8")}
 				keyStore.On("GetRoundRobinAddress").Return(from, nil)
-				txManager.On("CreateEthTransaction", mock.Anything, from, to, data, gasLimit, txMeta, bulletprooftxmanager.SendEveryStrategy{}).Return(bulletprooftxmanager.EthTx{}, nil)
+				txManager.On("CreateEthTransaction", mock.Anything, from, to, data, gasLimit, txMeta, bulletprooftxmanager.SendEveryStrategy{}, bulletprooftxmanager.EthTxUrgencyNormal, bulletprooftxmanager.EthTxExpiry{}, true).Return(bulletprooftxmanager.EthTx{}, nil)
 			},
 			nil, nil, "",
 		},
@@ -160,7 +188,7 @@ func TestETHTxTask(t *testing.T) {
 				gasLimit := uint64(12345)
 				txMeta := &models.EthTxMetaV2{}
 				keyStore.On("GetRoundRobinAddress", from).Return(from, nil)
-				txManager.On("CreateEthTransaction", mock.Anything, from, to, data, gasLimit, txMeta, bulletprooftxmanager.SendEveryStrategy{}).Return(bulletprooftxmanager.EthTx{}, nil)
+				txManager.On("CreateEthTransaction", mock.Anything, from, to, data, gasLimit, txMeta, bulletprooftxmanager.SendEveryStrategy{}, bulletprooftxmanager.EthTxUrgencyNormal, bulletprooftxmanager.EthTxExpiry{}, true).Return(bulletprooftxmanager.EthTx{}, nil)
 			},
 			nil, nil, "",
 		},
@@ -181,7 +209,7 @@ func TestETHTxTask(t *testing.T) {
 				gasLimit := uint64(999)
 				txMeta := &models.EthTxMetaV2{JobID: 321, RequestID: common.HexToHash("0x5198616554d738d9485d1a7cf53b2f33e09c3bbc8fe9ac0020bd672cd2bc15d2"), RequestTxHash: common.HexToHash("0xc524fafafcaec40652b1f84fca09c231185437d008d195fccf2f51e64b7062f8")}
 				keyStore.On("GetRoundRobinAddress", from).Return(from, nil)
-				txManager.On("CreateEthTransaction", mock.Anything, from, to, data, gasLimit, txMeta, bulletprooftxmanager.SendEveryStrategy{}).Return(bulletprooftxmanager.EthTx{}, nil)
+				txManager.On("CreateEthTransaction", mock.Anything, from, to, data, gasLimit, txMeta, bulletprooftxmanager.SendEveryStrategy{}, bulletprooftxmanager.EthTxUrgencyNormal, bulletprooftxmanager.EthTxExpiry{}, true).Return(bulletprooftxmanager.EthTx{}, nil)
 			},
 			nil, nil, "",
 		},
@@ -227,7 +255,7 @@ func TestETHTxTask(t *testing.T) {
 				gasLimit := uint64(12345)
 				txMeta := &models.EthTxMetaV2{JobID: 321, RequestID: common.HexToHash("0x5198616554d738d9485d1a7cf53b2f33e09c3bbc8fe9ac0020bd672cd2bc15d2"), RequestTxHash: common.HexToHash("0xc524fafafcaec40652b1f84fca09c231185437d008d195fccf2f51e64b7062f8")}
 				keyStore.On("GetRoundRobinAddress", from).Return(from, nil)
-				txManager.On("CreateEthTransaction", mock.Anything, from, to, data, gasLimit, txMeta, bulletprooftxmanager.SendEveryStrategy{}).Return(bulletprooftxmanager.EthTx{}, errors.New("uh oh"))
+				txManager.On("CreateEthTransaction", mock.Anything, from, to, data, gasLimit, txMeta, bulletprooftxmanager.SendEveryStrategy{}, bulletprooftxmanager.EthTxUrgencyNormal, bulletprooftxmanager.EthTxExpiry{}, true).Return(bulletprooftxmanager.EthTx{}, errors.New("uh oh"))
 			},
 			nil, pipeline.ErrTaskRunFailed, "while creating transaction",
 		},
@@ -330,3 +358,261 @@ func TestETHTxTask(t *testing.T) {
 		})
 	}
 }
+
+func TestETHTxTask_Urgency(t *testing.T) {
+	t.Parallel()
+
+	from := common.HexToAddress("0x882969652440ccf14a5dbb9bd53eb21cb1e11e5c")
+	to := common.HexToAddress("0xDeaDbeefdEAdbeefdEadbEEFdeadbeEFdEaDbeeF")
+
+	newTask := func(urgency string) pipeline.ETHTxTask {
+		return pipeline.ETHTxTask{
+			BaseTask: pipeline.NewBaseTask(0, "ethtx", nil, nil, 0),
+			From:     from.Hex(),
+			To:       to.Hex(),
+			Data:     "foobar",
+			GasLimit: "12345",
+			Urgency:  urgency,
+		}
+	}
+
+	t.Run("creates the transaction with the given urgency", func(t *testing.T) {
+		config := new(pipelinemocks.Config)
+		keyStore := new(pipelinemocks.KeyStore)
+		txManager := new(pipelinemocks.TxManager)
+		config.On("EthGasLimitDefault").Return(uint64(999))
+		keyStore.On("GetRoundRobinAddress", from).Return(from, nil)
+		txManager.On("CreateEthTransaction", mock.Anything, from, to, []byte("foobar"), uint64(12345), &models.EthTxMetaV2{}, bulletprooftxmanager.SendEveryStrategy{}, bulletprooftxmanager.EthTxUrgencyHigh, bulletprooftxmanager.EthTxExpiry{}, true).Return(bulletprooftxmanager.EthTx{}, nil)
+
+		task := newTask("high")
+		task.HelperSetDependencies(nil, config, keyStore, txManager)
+		result := task.Run(context.Background(), pipeline.NewVarsFrom(nil), nil)
+		require.NoError(t, result.Error)
+
+		txManager.AssertExpectations(t)
+	})
+
+	t.Run("rejects unknown urgencies", func(t *testing.T) {
+		config := new(pipelinemocks.Config)
+		keyStore := new(pipelinemocks.KeyStore)
+		txManager := new(pipelinemocks.TxManager)
+		config.On("EthGasLimitDefault").Return(uint64(999))
+
+		task := newTask("urgent")
+		task.HelperSetDependencies(nil, config, keyStore, txManager)
+		result := task.Run(context.Background(), pipeline.NewVarsFrom(nil), nil)
+		require.Equal(t, pipeline.ErrBadInput, errors.Cause(result.Error))
+		require.Contains(t, result.Error.Error(), `unknown urgency "urgent"`)
+
+		txManager.AssertNotCalled(t, "CreateEthTransaction")
+	})
+}
+
+func TestETHTxTask_Expiry(t *testing.T) {
+	t.Parallel()
+
+	from := common.HexToAddress("0x882969652440ccf14a5dbb9bd53eb21cb1e11e5c")
+	to := common.HexToAddress("0xDeaDbeefdEAdbeefdEadbEEFdeadbeEFdEaDbeeF")
+
+	config := new(pipelinemocks.Config)
+	keyStore := new(pipelinemocks.KeyStore)
+	txManager := new(pipelinemocks.TxManager)
+	config.On("EthGasLimitDefault").Return(uint64(999))
+	keyStore.On("GetRoundRobinAddress", from).Return(from, nil)
+	txManager.On("CreateEthTransaction", mock.Anything, from, to, []byte("foobar"), uint64(12345), &models.EthTxMetaV2{}, bulletprooftxmanager.SendEveryStrategy{}, bulletprooftxmanager.EthTxUrgencyNormal, mock.MatchedBy(func(expiry bulletprooftxmanager.EthTxExpiry) bool {
+		return expiry.Block == null.IntFrom(1000) &&
+			expiry.Time.Valid && time.Until(expiry.Time.Time) > 9*time.Minute && time.Until(expiry.Time.Time) <= 10*time.Minute
+	}), true).Return(bulletprooftxmanager.EthTx{}, nil)
+
+	task := pipeline.ETHTxTask{
+		BaseTask:       pipeline.NewBaseTask(0, "ethtx", nil, nil, 0),
+		From:           from.Hex(),
+		To:             to.Hex(),
+		Data:           "foobar",
+		GasLimit:       "12345",
+		ExpiresAfter:   "10m",
+		ExpiresAtBlock: "1000",
+	}
+	task.HelperSetDependencies(nil, config, keyStore, txManager)
+	result := task.Run(context.Background(), pipeline.NewVarsFrom(nil), nil)
+	require.NoError(t, result.Error)
+
+	txManager.AssertExpectations(t)
+}
+
+func TestETHTxTask_SimulateOptOut(t *testing.T) {
+	t.Parallel()
+
+	from := common.HexToAddress("0x882969652440ccf14a5dbb9bd53eb21cb1e11e5c")
+	to := common.HexToAddress("0xDeaDbeefdEAdbeefdEadbEEFdeadbeEFdEaDbeeF")
+
+	config := new(pipelinemocks.Config)
+	keyStore := new(pipelinemocks.KeyStore)
+	txManager := new(pipelinemocks.TxManager)
+	config.On("EthGasLimitDefault").Return(uint64(999))
+	keyStore.On("GetRoundRobinAddress", from).Return(from, nil)
+	txManager.On("CreateEthTransaction", mock.Anything, from, to, []byte("foobar"), uint64(12345), &models.EthTxMetaV2{}, bulletprooftxmanager.SendEveryStrategy{}, bulletprooftxmanager.EthTxUrgencyNormal, bulletprooftxmanager.EthTxExpiry{}, false).Return(bulletprooftxmanager.EthTx{}, nil)
+
+	task := pipeline.ETHTxTask{
+		BaseTask: pipeline.NewBaseTask(0, "ethtx", nil, nil, 0),
+		From:     from.Hex(),
+		To:       to.Hex(),
+		Data:     "foobar",
+		GasLimit: "12345",
+		Simulate: "false",
+	}
+	task.HelperSetDependencies(nil, config, keyStore, txManager)
+	result := task.Run(context.Background(), pipeline.NewVarsFrom(nil), nil)
+	require.NoError(t, result.Error)
+
+	txManager.AssertExpectations(t)
+}
+
+func TestETHTxTask_Forwarder(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+	db := store.DB
+
+	from := common.HexToAddress("0x882969652440ccf14a5dbb9bd53eb21cb1e11e5c")
+	to := common.HexToAddress("0xDeaDbeefdEAdbeefdEadbEEFdeadbeEFdEaDbeeF")
+	forwarder := cltest.NewAddress()
+
+	newTask := func() pipeline.ETHTxTask {
+		return pipeline.ETHTxTask{
+			BaseTask:         pipeline.NewBaseTask(0, "ethtx", nil, nil, 0),
+			From:             from.Hex(),
+			To:               to.Hex(),
+			Data:             "foobar",
+			GasLimit:         "12345",
+			ForwarderAddress: forwarder.Hex(),
+		}
+	}
+
+	t.Run("rejects forwarders which are not allowlisted", func(t *testing.T) {
+		config := new(pipelinemocks.Config)
+		keyStore := new(pipelinemocks.KeyStore)
+		txManager := new(pipelinemocks.TxManager)
+		config.On("EthGasLimitDefault").Return(uint64(999))
+		keyStore.On("GetRoundRobinAddress", from).Return(from, nil)
+
+		task := newTask()
+		task.HelperSetDependencies(db, config, keyStore, txManager)
+		result := task.Run(context.Background(), pipeline.NewVarsFrom(nil), nil)
+		require.Equal(t, pipeline.ErrBadInput, errors.Cause(result.Error))
+		require.Contains(t, result.Error.Error(), "forwarder is not on the allowlist")
+
+		txManager.AssertNotCalled(t, "CreateEthTransaction")
+	})
+
+	t.Run("sends the transaction to an allowlisted forwarder", func(t *testing.T) {
+		_, err := bulletprooftxmanager.CreateEthForwarder(db, forwarder, nil)
+		require.NoError(t, err)
+		payload, err := bulletprooftxmanager.EncodeForwardedPayload(to, []byte("foobar"))
+		require.NoError(t, err)
+
+		config := new(pipelinemocks.Config)
+		keyStore := new(pipelinemocks.KeyStore)
+		txManager := new(pipelinemocks.TxManager)
+		config.On("EthGasLimitDefault").Return(uint64(999))
+		keyStore.On("GetRoundRobinAddress", from).Return(from, nil)
+		txManager.On("CreateEthTransaction", mock.Anything, from, forwarder, payload, uint64(12345), &models.EthTxMetaV2{}, bulletprooftxmanager.SendEveryStrategy{}, bulletprooftxmanager.EthTxUrgencyNormal, bulletprooftxmanager.EthTxExpiry{}, true).Return(bulletprooftxmanager.EthTx{}, nil)
+
+		task := newTask()
+		task.HelperSetDependencies(db, config, keyStore, txManager)
+		result := task.Run(context.Background(), pipeline.NewVarsFrom(nil), nil)
+		require.NoError(t, result.Error)
+
+		txManager.AssertExpectations(t)
+	})
+}
+
+func TestETHTxTask_EstimateGasLimit(t *testing.T) {
+	t.Parallel()
+
+	from := common.HexToAddress("0x882969652440ccf14a5dbb9bd53eb21cb1e11e5c")
+	to := common.HexToAddress("0xDeaDbeefdEAdbeefdEadbEEFdeadbeEFdEaDbeeF")
+
+	newTask := func(gasLimit string) pipeline.ETHTxTask {
+		return pipeline.ETHTxTask{
+			BaseTask:         pipeline.NewBaseTask(0, "ethtx", nil, nil, 0),
+			From:             `[ "0x882969652440ccf14a5dbb9bd53eb21cb1e11e5c" ]`,
+			To:               to.Hex(),
+			Data:             "foobar",
+			GasLimit:         gasLimit,
+			EstimateGasLimit: "true",
+			EstimateGasBlock: "$(jobRun.logBlockNumber)",
+		}
+	}
+	vars := pipeline.NewVarsFrom(map[string]interface{}{
+		"jobRun": map[string]interface{}{"logBlockNumber": uint64(42)},
+	})
+	estimateGas := func(ethClient *ethmocks.Client) {
+		ethClient.On("CallContext", mock.Anything, mock.Anything, "eth_estimateGas", mock.Anything, "0x2a").
+			Run(func(args mock.Arguments) {
+				*args.Get(1).(*hexutil.Uint64) = 63000
+			}).
+			Return(nil)
+	}
+
+	t.Run("creates the transaction with the padded estimate", func(t *testing.T) {
+		config := new(pipelinemocks.Config)
+		keyStore := new(pipelinemocks.KeyStore)
+		txManager := new(pipelinemocks.TxManager)
+		ethClient := new(ethmocks.Client)
+		config.On("EthGasLimitDefault").Return(uint64(999))
+		config.On("EthGasLimitEstimateMultiplier").Return(float32(1.5))
+		keyStore.On("GetRoundRobinAddress", from).Return(from, nil)
+		estimateGas(ethClient)
+		txManager.On("CreateEthTransaction", mock.Anything, from, to, []byte("foobar"), uint64(96000), &models.EthTxMetaV2{}, bulletprooftxmanager.SendEveryStrategy{}, bulletprooftxmanager.EthTxUrgencyNormal, bulletprooftxmanager.EthTxExpiry{}, true).Return(bulletprooftxmanager.EthTx{}, nil)
+
+		task := newTask("100000")
+		task.HelperSetDependencies(nil, config, keyStore, txManager)
+		task.HelperSetEthClient(ethClient)
+		result := task.Run(context.Background(), vars, nil)
+		require.NoError(t, result.Error)
+
+		ethClient.AssertExpectations(t)
+		txManager.AssertExpectations(t)
+	})
+
+	t.Run("refuses estimates over the gas limit ceiling", func(t *testing.T) {
+		config := new(pipelinemocks.Config)
+		keyStore := new(pipelinemocks.KeyStore)
+		txManager := new(pipelinemocks.TxManager)
+		ethClient := new(ethmocks.Client)
+		config.On("EthGasLimitDefault").Return(uint64(999))
+		config.On("EthGasLimitEstimateMultiplier").Return(float32(1.5))
+		keyStore.On("GetRoundRobinAddress", from).Return(from, nil)
+		estimateGas(ethClient)
+
+		task := newTask("90000")
+		task.HelperSetDependencies(nil, config, keyStore, txManager)
+		task.HelperSetEthClient(ethClient)
+		result := task.Run(context.Background(), vars, nil)
+		require.Equal(t, pipeline.ErrTaskRunFailed, errors.Cause(result.Error))
+		require.Contains(t, result.Error.Error(), "estimated gas limit of 96000 exceeds the ceiling of 90000")
+
+		txManager.AssertNotCalled(t, "CreateEthTransaction")
+	})
+
+	t.Run("refuses transactions whose estimate fails", func(t *testing.T) {
+		config := new(pipelinemocks.Config)
+		keyStore := new(pipelinemocks.KeyStore)
+		txManager := new(pipelinemocks.TxManager)
+		ethClient := new(ethmocks.Client)
+		config.On("EthGasLimitDefault").Return(uint64(999))
+		keyStore.On("GetRoundRobinAddress", from).Return(from, nil)
+		ethClient.On("CallContext", mock.Anything, mock.Anything, "eth_estimateGas", mock.Anything, "0x2a").Return(errors.New("execution reverted"))
+
+		task := newTask("100000")
+		task.HelperSetDependencies(nil, config, keyStore, txManager)
+		task.HelperSetEthClient(ethClient)
+		result := task.Run(context.Background(), vars, nil)
+		require.Equal(t, pipeline.ErrTaskRunFailed, errors.Cause(result.Error))
+		require.Contains(t, result.Error.Error(), "execution reverted")
+
+		txManager.AssertNotCalled(t, "CreateEthTransaction")
+	})
+}
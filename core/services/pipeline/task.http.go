@@ -3,6 +3,7 @@ package pipeline
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 
 	"go.uber.org/multierr"
 
@@ -21,9 +22,12 @@ type HTTPTask struct {
 	Method                         string
 	URL                            string
 	RequestData                    string `json:"requestData"`
+	Headers                        string `json:"headers"`
 	AllowUnrestrictedNetworkAccess string
+	Cache                          string `json:"cache"`
 
 	config Config
+	cache  *taskResultCache
 }
 
 var _ Task = (*HTTPTask)(nil)
@@ -57,13 +61,17 @@ func (t *HTTPTask) Run(ctx context.Context, vars Vars, inputs []Result) Result {
 		method                         StringParam
 		url                            URLParam
 		requestData                    MapParam
+		headers                        MapParam
 		allowUnrestrictedNetworkAccess BoolParam
+		cacheTTL                       DurationParam
 	)
 	err = multierr.Combine(
 		errors.Wrap(ResolveParam(&method, From(NonemptyString(t.Method), "GET")), "method"),
 		errors.Wrap(ResolveParam(&url, From(VarExpr(t.URL, vars), NonemptyString(t.URL))), "url"),
 		errors.Wrap(ResolveParam(&requestData, From(VarExpr(t.RequestData, vars), JSONWithVarExprs(t.RequestData, vars, false), nil)), "requestData"),
+		errors.Wrap(ResolveParam(&headers, From(VarExpr(t.Headers, vars), JSONWithVarExprs(t.Headers, vars, false), nil)), "headers"),
 		errors.Wrap(ResolveParam(&allowUnrestrictedNetworkAccess, From(NonemptyString(t.AllowUnrestrictedNetworkAccess), !variableRegexp.MatchString(t.URL))), "allowUnrestrictedNetworkAccess"),
+		errors.Wrap(ResolveParam(&cacheTTL, From(NonemptyString(t.Cache), "0s")), "cache"),
 	)
 	if err != nil {
 		return Result{Error: err}
@@ -73,21 +81,52 @@ func (t *HTTPTask) Run(ctx context.Context, vars Vars, inputs []Result) Result {
 	if err != nil {
 		return Result{Error: err}
 	}
+
+	requestHeaders := make(map[string]string, len(headers))
+	for name, value := range headers {
+		s, isString := value.(string)
+		if !isString {
+			return Result{Error: errors.Wrapf(ErrBadInput, "headers: value of header %s must be a string", name)}
+		}
+		requestHeaders[name] = s
+	}
+	headersJSON, err := json.Marshal(requestHeaders)
+	if err != nil {
+		return Result{Error: err}
+	}
+
+	cacheKey := fmt.Sprintf("http|%s|%s|%s|%s", method, url.String(), requestDataJSON, headersJSON)
+	if cacheTTL > 0 {
+		if value, cached := t.cache.get(cacheKey, cacheTTL.Duration()); cached {
+			logger.Debugw("HTTP task: using cached response",
+				"url", t.redactString(url.String()),
+				"dotID", t.DotID(),
+			)
+			return Result{Value: value}
+		}
+	}
+
+	// Header values are not logged, since they usually hold credentials
+	headerNames := make([]string, 0, len(requestHeaders))
+	for name := range requestHeaders {
+		headerNames = append(headerNames, name)
+	}
 	logger.Debugw("HTTP task: sending request",
-		"requestData", string(requestDataJSON),
-		"url", url.String(),
+		"requestData", t.redactString(string(requestDataJSON)),
+		"headers", headerNames,
+		"url", t.redactString(url.String()),
 		"method", method,
 		"allowUnrestrictedNetworkAccess", allowUnrestrictedNetworkAccess,
 	)
 
-	responseBytes, _, elapsed, err := makeHTTPRequest(ctx, method, url, requestData, allowUnrestrictedNetworkAccess, t.config)
+	responseBytes, _, elapsed, err := makeHTTPRequest(ctx, method, url, requestData, requestHeaders, allowUnrestrictedNetworkAccess, t.config)
 	if err != nil {
 		return Result{Error: err}
 	}
 
 	logger.Debugw("HTTP task got response",
-		"response", string(responseBytes),
-		"url", url.String(),
+		"response", t.redactString(string(responseBytes)),
+		"url", t.redactString(url.String()),
 		"dotID", t.DotID(),
 	)
 
@@ -98,5 +137,6 @@ func (t *HTTPTask) Run(ctx context.Context, vars Vars, inputs []Result) Result {
 	// If a binary response is required we might consider adding an adapter
 	// flag such as  "BinaryMode: true" which passes through raw binary as the
 	// value instead.
+	t.cache.set(cacheKey, string(responseBytes), cacheTTL.Duration())
 	return Result{Value: string(responseBytes)}
 }
@@ -9,8 +9,10 @@ import (
 	"testing"
 
 	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	uuid "github.com/satori/go.uuid"
 	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"gopkg.in/guregu/null.v4"
 
@@ -53,6 +55,36 @@ func TestHTTPTask_Happy(t *testing.T) {
 	require.Equal(t, decimal.NewFromInt(9700), x.Data.Result)
 }
 
+func TestHTTPTask_Metrics(t *testing.T) {
+	// Not parallel: the series of the shared histograms are counted
+
+	config, cleanup := cltest.NewConfig(t)
+	defer cleanup()
+
+	s1 := httptest.NewServer(fakeStringResponder(t, `{"result": 1}`))
+	defer s1.Close()
+
+	task := pipeline.HTTPTask{
+		BaseTask: pipeline.NewBaseTask(0, "ds", nil, nil, 0),
+		Method:   "GET",
+		URL:      s1.URL,
+	}
+	task.HelperSetDependencies(config)
+
+	labels := []string{"42", "http metrics", "ds", "http", "200"}
+	httpSeries, _ := histogramSamples(t, pipeline.PromHTTPRequestDuration, labels...)
+	bridgeSeries := testutil.CollectAndCount(pipeline.PromBridgeLatency)
+
+	ctx := pipeline.ContextWithTaskMetricsLabels(context.Background(), pipeline.Spec{JobID: 42, JobName: "http metrics"}, &task)
+	result := task.Run(ctx, pipeline.NewVarsFrom(nil), nil)
+	require.NoError(t, result.Error)
+
+	series, samples := histogramSamples(t, pipeline.PromHTTPRequestDuration, labels...)
+	assert.Equal(t, httpSeries+1, series)
+	assert.Equal(t, uint64(1), samples, "the request is labelled by job, task and status code")
+	assert.Equal(t, bridgeSeries, testutil.CollectAndCount(pipeline.PromBridgeLatency))
+}
+
 func TestHTTPTask_Variables(t *testing.T) {
 	t.Parallel()
 
@@ -290,3 +322,87 @@ func TestHTTPTask_OnlyErrorMessage(t *testing.T) {
 	require.Contains(t, result.Error.Error(), "RequestId")
 	require.Nil(t, result.Value)
 }
+
+func TestHTTPTask_TraceHeaders(t *testing.T) {
+	t.Parallel()
+
+	config, cleanup := cltest.NewConfig(t)
+	defer cleanup()
+	config.Set("JOB_PIPELINE_TRACE_HEADERS", []string{"X-Request-ID", "X-Correlation-ID"})
+
+	var headers http.Header
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		headers = r.Header
+		w.Header().Set("Content-Type", "application/json")
+		_, err := w.Write([]byte(`{}`))
+		require.NoError(t, err)
+	})
+
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	task := pipeline.HTTPTask{
+		Method: "GET",
+		URL:    server.URL,
+	}
+	task.HelperSetDependencies(config)
+
+	t.Run("sets the configured headers to the run's trace ID", func(t *testing.T) {
+		ctx := pipeline.ContextWithTraceID(context.Background(), "some-trace-id")
+		result := task.Run(ctx, pipeline.NewVarsFrom(nil), nil)
+		require.NoError(t, result.Error)
+		require.Equal(t, "some-trace-id", headers.Get("X-Request-ID"))
+		require.Equal(t, "some-trace-id", headers.Get("X-Correlation-ID"))
+	})
+
+	t.Run("does not set headers without a trace ID", func(t *testing.T) {
+		result := task.Run(context.Background(), pipeline.NewVarsFrom(nil), nil)
+		require.NoError(t, result.Error)
+		require.Empty(t, headers.Get("X-Request-ID"))
+	})
+}
+
+func TestHTTPTask_Headers(t *testing.T) {
+	t.Parallel()
+
+	config, cleanup := cltest.NewConfig(t)
+	defer cleanup()
+
+	var headers http.Header
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		headers = r.Header
+		w.Header().Set("Content-Type", "application/json")
+		_, err := w.Write([]byte(`{}`))
+		require.NoError(t, err)
+	}))
+	defer server.Close()
+
+	t.Run("sets the headers", func(t *testing.T) {
+		task := pipeline.HTTPTask{
+			BaseTask: pipeline.NewBaseTask(0, "http", nil, nil, 0),
+			Method:   "GET",
+			URL:      server.URL,
+			Headers:  `{"Authorization": $(token), "X-Api-Key": "foo"}`,
+		}
+		task.HelperSetDependencies(config)
+
+		result := task.Run(context.Background(), pipeline.NewVarsFrom(map[string]interface{}{"token": "Bearer bar"}), nil)
+		require.NoError(t, result.Error)
+		require.Equal(t, "Bearer bar", headers.Get("Authorization"))
+		require.Equal(t, "foo", headers.Get("X-Api-Key"))
+	})
+
+	t.Run("rejects non string header values", func(t *testing.T) {
+		task := pipeline.HTTPTask{
+			BaseTask: pipeline.NewBaseTask(0, "http", nil, nil, 0),
+			Method:   "GET",
+			URL:      server.URL,
+			Headers:  `{"X-Api-Key": 1}`,
+		}
+		task.HelperSetDependencies(config)
+
+		result := task.Run(context.Background(), pipeline.NewVarsFrom(nil), nil)
+		require.Error(t, result.Error)
+		require.True(t, errors.Is(result.Error, pipeline.ErrBadInput))
+	})
+}
@@ -10,6 +10,12 @@ import (
 	"go.uber.org/multierr"
 )
 
+//
+// Path is either a list of keys and array indexes separated by Separator
+// (a comma by default), or a JSONPath expression starting with $, e.g.
+// `$.data[?(@.symbol == "ETH")].price`. A JSONPath expression which may select
+// several values (with wildcards, slices, filters or recursive descent)
+// returns them as a list.
 //
 // Return types:
 //     float64
@@ -20,9 +26,10 @@ import (
 //     nil
 //
 type JSONParseTask struct {
-	BaseTask `mapstructure:",squash"`
-	Path     string `json:"path"`
-	Data     string `json:"data"`
+	BaseTask  `mapstructure:",squash"`
+	Path      string `json:"path"`
+	Data      string `json:"data"`
+	Separator string `json:"separator"`
 	// Lax when disabled will return an error if the path does not exist
 	// Lax when enabled will return nil with no error if the path does not exist
 	Lax string
@@ -41,10 +48,13 @@ func (t *JSONParseTask) Run(_ context.Context, vars Vars, inputs []Result) (resu
 	}
 
 	var (
-		path JSONPathParam
+		path = jsonPathWithSeparatorParam{separator: ","}
 		data StringParam
 		lax  BoolParam
 	)
+	if t.Separator != "" {
+		path.separator = t.Separator
+	}
 	err = multierr.Combine(
 		errors.Wrap(ResolveParam(&path, From(VarExpr(t.Path, vars), t.Path)), "path"),
 		errors.Wrap(ResolveParam(&data, From(VarExpr(t.Data, vars), Input(inputs, 0))), "data"),
@@ -60,7 +70,11 @@ func (t *JSONParseTask) Run(_ context.Context, vars Vars, inputs []Result) (resu
 		return Result{Error: err}
 	}
 
-	for _, part := range path {
+	if len(path.keys) == 1 && isJSONPathExpression(path.keys[0]) {
+		return t.evaluateJSONPath(path.keys[0], decoded, data, bool(lax))
+	}
+
+	for _, part := range path.keys {
 		switch d := decoded.(type) {
 		case map[string]interface{}:
 			var exists bool
@@ -69,7 +83,7 @@ func (t *JSONParseTask) Run(_ context.Context, vars Vars, inputs []Result) (resu
 				decoded = nil
 				break
 			} else if !exists {
-				return Result{Error: errors.Wrapf(ErrKeypathNotFound, `could not resolve path ["%v"] in %s`, strings.Join(path, `","`), data)}
+				return Result{Error: errors.Wrapf(ErrKeypathNotFound, `could not resolve path ["%v"] in %s`, strings.Join(path.keys, `","`), data)}
 			}
 
 		case []interface{}:
@@ -81,7 +95,7 @@ func (t *JSONParseTask) Run(_ context.Context, vars Vars, inputs []Result) (resu
 					decoded = nil
 					break
 				}
-				return Result{Error: errors.Wrapf(ErrKeypathNotFound, `could not resolve path ["%v"] in %s`, strings.Join(path, `","`), data)}
+				return Result{Error: errors.Wrapf(ErrKeypathNotFound, `could not resolve path ["%v"] in %s`, strings.Join(path.keys, `","`), data)}
 			}
 			index := int(bigindex.Int64())
 			if index < 0 {
@@ -93,13 +107,45 @@ func (t *JSONParseTask) Run(_ context.Context, vars Vars, inputs []Result) (resu
 				decoded = nil
 				break
 			} else if !exists {
-				return Result{Error: errors.Wrapf(ErrKeypathNotFound, `could not resolve path ["%v"] in %s`, strings.Join(path, `","`), data)}
+				return Result{Error: errors.Wrapf(ErrKeypathNotFound, `could not resolve path ["%v"] in %s`, strings.Join(path.keys, `","`), data)}
 			}
 			decoded = d[index]
 
 		default:
-			return Result{Error: errors.Wrapf(ErrKeypathNotFound, `could not resolve path ["%v"] in %s`, strings.Join(path, `","`), data)}
+			return Result{Error: errors.Wrapf(ErrKeypathNotFound, `could not resolve path ["%v"] in %s`, strings.Join(path.keys, `","`), data)}
 		}
 	}
 	return Result{Value: decoded}
 }
+
+func (t *JSONParseTask) evaluateJSONPath(expr string, decoded interface{}, data StringParam, lax bool) Result {
+	jsonPath, err := parseJSONPath(expr)
+	if err != nil {
+		return Result{Error: errors.Wrap(ErrBadInput, err.Error())}
+	}
+	values := jsonPath.evaluate(decoded)
+	if !jsonPath.definite {
+		if values == nil {
+			values = []interface{}{}
+		}
+		return Result{Value: values}
+	}
+	if len(values) == 0 {
+		if lax {
+			return Result{Value: nil}
+		}
+		return Result{Error: errors.Wrapf(ErrKeypathNotFound, "could not resolve path %s in %s", expr, data)}
+	}
+	return Result{Value: values[0]}
+}
+
+// jsonPathWithSeparatorParam is a JSONPathParam whose string form is split on
+// a custom separator
+type jsonPathWithSeparatorParam struct {
+	separator string
+	keys      JSONPathParam
+}
+
+func (p *jsonPathWithSeparatorParam) UnmarshalPipelineParam(val interface{}) error {
+	return p.keys.unmarshalWithSeparator(val, p.separator)
+}
@@ -340,3 +340,90 @@ func TestJSONParseTask(t *testing.T) {
 		})
 	}
 }
+
+func TestJSONParseTask_JSONPath(t *testing.T) {
+	t.Parallel()
+
+	const data = `{
+		"symbol": "ETH-USD",
+		"data": {
+			"prices": [
+				{"exchange": "a", "symbol": "ETH", "price": 3000.5, "volume": 10},
+				{"exchange": "b", "symbol": "BTC", "price": 45000, "volume": 2},
+				{"exchange": "c", "symbol": "ETH", "price": 3001.5, "volume": 30},
+				{"exchange": "d", "symbol": "ETH", "price": null}
+			],
+			"matrix": [[1, 2], [3, 4]]
+		}
+	}`
+
+	tests := []struct {
+		name              string
+		path              string
+		separator         string
+		lax               string
+		wantData          interface{}
+		wantErrorCause    error
+		wantErrorContains string
+	}{
+		{"root", "$", "", "", nil, nil, ""},
+		{"child key", "$.symbol", "", "", "ETH-USD", nil, ""},
+		{"bracketed key", "$['data']['prices'][0]['price']", "", "", 3000.5, nil, ""},
+		{"array index", "$.data.prices[1].price", "", "", float64(45000), nil, ""},
+		{"negative array index", "$.data.prices[-1].exchange", "", "", "d", nil, ""},
+		{"nested arrays", "$.data.matrix[1][0]", "", "", float64(3), nil, ""},
+		{"wildcard", "$.data.prices[*].exchange", "", "", []interface{}{"a", "b", "c", "d"}, nil, ""},
+		{"dot wildcard", "$.data.matrix.*[1]", "", "", []interface{}{float64(2), float64(4)}, nil, ""},
+		{"slice", "$.data.prices[1:3].exchange", "", "", []interface{}{"b", "c"}, nil, ""},
+		{"open slice", "$.data.prices[-2:].exchange", "", "", []interface{}{"c", "d"}, nil, ""},
+		{"slice with step", "$.data.prices[::2].exchange", "", "", []interface{}{"a", "c"}, nil, ""},
+		{"reverse slice", "$.data.prices[::-1].exchange", "", "", []interface{}{"d", "c", "b", "a"}, nil, ""},
+		{"index union", "$.data.prices[0,2].volume", "", "", []interface{}{float64(10), float64(30)}, nil, ""},
+		{"key union", "$.data.prices[0]['exchange','symbol']", "", "", []interface{}{"a", "ETH"}, nil, ""},
+		{"recursive descent", "$..volume", "", "", []interface{}{float64(10), float64(2), float64(30)}, nil, ""},
+		{"filter equals string", `$.data.prices[?(@.symbol == "ETH")].exchange`, "", "", []interface{}{"a", "c", "d"}, nil, ""},
+		{"filter single quoted string", `$.data.prices[?(@.symbol != 'ETH')].exchange`, "", "", []interface{}{"b"}, nil, ""},
+		{"filter number comparison", `$.data.prices[?(@.price > 3000.5)].price`, "", "", []interface{}{float64(45000), 3001.5}, nil, ""},
+		{"filter and", `$.data.prices[?(@.symbol == "ETH" && @.volume >= 30)].exchange`, "", "", []interface{}{"c"}, nil, ""},
+		{"filter or", `$.data.prices[?(@.exchange == "a" || @.exchange == "b")].exchange`, "", "", []interface{}{"a", "b"}, nil, ""},
+		{"filter null", `$.data.prices[?(@.price == null)].exchange`, "", "", []interface{}{"d"}, nil, ""},
+		{"filter exists", `$.data.prices[?(@.volume)].exchange`, "", "", []interface{}{"a", "b", "c"}, nil, ""},
+		{"filter without matches", `$.data.prices[?(@.symbol == "LINK")]`, "", "", []interface{}{}, nil, ""},
+		{"missing key", "$.data.missing", "", "", nil, pipeline.ErrKeypathNotFound, "$.data.missing"},
+		{"missing key lax", "$.data.missing", "", "true", nil, nil, ""},
+		{"index out of range", "$.data.prices[10]", "", "", nil, pipeline.ErrKeypathNotFound, "prices[10]"},
+		{"unterminated bracket", "$.data.prices[0", "", "", nil, pipeline.ErrBadInput, "unterminated"},
+		{"invalid index", "$.data.prices[x]", "", "", nil, pipeline.ErrBadInput, "invalid array index"},
+		{"zero slice step", "$.data.prices[::0]", "", "", nil, pipeline.ErrBadInput, "step"},
+		{"filter without @", `$.data.prices[?(symbol == "ETH")]`, "", "", nil, pipeline.ErrBadInput, "@"},
+		{"expression is not split on separator", `$.data.prices[?(@.symbol == "ETH")]['exchange','volume']`, "", "", []interface{}{"a", float64(10), "c", float64(30), "d"}, nil, ""},
+		{"custom separator", "data.prices.2.exchange", ".", "", "c", nil, ""},
+		{"multi-character separator", "data->matrix->0->1", "->", "", float64(2), nil, ""},
+		{"custom separator does not split on commas", "data,prices", ".", "", nil, pipeline.ErrKeypathNotFound, ""},
+	}
+
+	for _, tt := range tests {
+		test := tt
+		t.Run(test.name, func(t *testing.T) {
+			task := pipeline.JSONParseTask{
+				BaseTask:  pipeline.NewBaseTask(0, "json", nil, nil, 0),
+				Path:      test.path,
+				Separator: test.separator,
+				Lax:       test.lax,
+			}
+			result := task.Run(context.Background(), pipeline.NewVarsFrom(nil), []pipeline.Result{{Value: data}})
+
+			if test.wantErrorCause != nil {
+				require.Equal(t, test.wantErrorCause, errors.Cause(result.Error))
+				require.Contains(t, result.Error.Error(), test.wantErrorContains)
+				require.Nil(t, result.Value)
+			} else if test.path == "$" {
+				require.NoError(t, result.Error)
+				require.IsType(t, map[string]interface{}{}, result.Value)
+			} else {
+				require.NoError(t, result.Error)
+				require.Equal(t, test.wantData, result.Value)
+			}
+		})
+	}
+}
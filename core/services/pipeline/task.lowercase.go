@@ -0,0 +1,39 @@
+package pipeline
+
+import (
+	"context"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// LowercaseTask returns its input converted to lowercase.
+//
+// Return types:
+//
+//	string
+type LowercaseTask struct {
+	BaseTask `mapstructure:",squash"`
+	Input    string `json:"input"`
+}
+
+var _ Task = (*LowercaseTask)(nil)
+
+func (t *LowercaseTask) Type() TaskType {
+	return TaskTypeLowercase
+}
+
+func (t *LowercaseTask) Run(_ context.Context, vars Vars, inputs []Result) (result Result) {
+	_, err := CheckInputs(inputs, 0, 1, 0)
+	if err != nil {
+		return Result{Error: errors.Wrap(err, "task inputs")}
+	}
+
+	var input StringParam
+	err = errors.Wrap(ResolveParam(&input, From(VarExpr(t.Input, vars), Input(inputs, 0))), "input")
+	if err != nil {
+		return Result{Error: err}
+	}
+
+	return Result{Value: strings.ToLower(string(input))}
+}
@@ -0,0 +1,49 @@
+package pipeline_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+)
+
+func TestLowercaseTask(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		input    interface{}
+		expected string
+	}{
+		{"ascii", "HeLLo World", "hello world"},
+		{"unicode", "ÄÖÜ", "äöü"},
+		{"empty", "", ""},
+		{"bytes", []byte("HeLLo World"), "hello world"},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			task := pipeline.LowercaseTask{BaseTask: pipeline.NewBaseTask(0, "task", nil, nil, 0)}
+			result := task.Run(context.Background(), pipeline.NewVarsFrom(nil), []pipeline.Result{{Value: test.input}})
+			require.NoError(t, result.Error)
+			require.Equal(t, test.expected, result.Value)
+		})
+	}
+
+	t.Run("with pipeline.Vars", func(t *testing.T) {
+		vars := pipeline.NewVarsFrom(map[string]interface{}{"foo": "HeLLo World"})
+		task := pipeline.LowercaseTask{BaseTask: pipeline.NewBaseTask(0, "task", nil, nil, 0), Input: "$(foo)"}
+		result := task.Run(context.Background(), vars, []pipeline.Result{})
+		require.NoError(t, result.Error)
+		require.Equal(t, "hello world", result.Value)
+	})
+
+	t.Run("bad input", func(t *testing.T) {
+		task := pipeline.LowercaseTask{BaseTask: pipeline.NewBaseTask(0, "task", nil, nil, 0)}
+		result := task.Run(context.Background(), pipeline.NewVarsFrom(nil), []pipeline.Result{{Value: 42}})
+		require.Error(t, result.Error)
+	})
+}
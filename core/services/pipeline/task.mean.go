@@ -16,6 +16,8 @@ type MeanTask struct {
 	BaseTask      `mapstructure:",squash"`
 	Values        string `json:"values"`
 	AllowedFaults string `json:"allowedFaults"`
+	MinAnswers    string `json:"minAnswers"`
+	Trim          string `json:"trim"`
 	Precision     string `json:"precision"`
 }
 
@@ -28,6 +30,8 @@ func (t *MeanTask) Type() TaskType {
 func (t *MeanTask) Run(_ context.Context, vars Vars, inputs []Result) (result Result) {
 	var (
 		maybeAllowedFaults MaybeUint64Param
+		minAnswers         MaybeUint64Param
+		trim               TrimParam
 		maybePrecision     MaybeInt32Param
 		valuesAndErrs      SliceParam
 		decimalValues      DecimalSliceParam
@@ -36,6 +40,8 @@ func (t *MeanTask) Run(_ context.Context, vars Vars, inputs []Result) (result Re
 	)
 	err := multierr.Combine(
 		errors.Wrap(ResolveParam(&maybeAllowedFaults, From(t.AllowedFaults)), "allowedFaults"),
+		errors.Wrap(ResolveParam(&minAnswers, From(VarExpr(t.MinAnswers, vars), t.MinAnswers)), "minAnswers"),
+		errors.Wrap(ResolveParam(&trim, From(VarExpr(t.Trim, vars), t.Trim)), "trim"),
 		errors.Wrap(ResolveParam(&maybePrecision, From(VarExpr(t.Precision, vars), t.Precision)), "precision"),
 		errors.Wrap(ResolveParam(&valuesAndErrs, From(VarExpr(t.Values, vars), JSONWithVarExprs(t.Values, vars, true), Inputs(inputs))), "values"),
 	)
@@ -54,12 +60,18 @@ func (t *MeanTask) Run(_ context.Context, vars Vars, inputs []Result) (result Re
 		return Result{Error: errors.Wrapf(ErrTooManyErrors, "Number of faulty inputs %v to mean task > number allowed faults %v", faults, allowedFaults)}
 	} else if len(values) == 0 {
 		return Result{Error: errors.Wrap(ErrWrongInputCardinality, "values")}
+	} else if err = checkMinAnswers(minAnswers, len(values), t.Type()); err != nil {
+		return Result{Error: err}
 	}
 
 	err = decimalValues.UnmarshalPipelineParam(values)
 	if err != nil {
 		return Result{Error: errors.Wrapf(ErrBadInput, "values: %v", err)}
 	}
+	decimalValues, err = trimDecimalOutliers(decimalValues, trim)
+	if err != nil {
+		return Result{Error: err}
+	}
 
 	total := decimal.NewFromInt(0)
 	for _, val := range decimalValues {
@@ -217,3 +217,48 @@ func TestMeanTask(t *testing.T) {
 		})
 	}
 }
+
+func TestMeanTask_OutlierRejection(t *testing.T) {
+	t.Parallel()
+
+	inputs := []pipeline.Result{
+		{Value: mustDecimal(t, "100")},
+		{Value: mustDecimal(t, "2")},
+		{Value: mustDecimal(t, "3")},
+		{Value: mustDecimal(t, "4")},
+		{Value: mustDecimal(t, "-50")},
+	}
+
+	tests := []struct {
+		name       string
+		trim       string
+		minAnswers string
+		want       pipeline.Result
+	}{
+		{"no trimming", "", "", pipeline.Result{Value: mustDecimal(t, "11.8")}},
+		{"trim a count", "1", "", pipeline.Result{Value: mustDecimal(t, "3")}},
+		{"trim a percentage", "20%", "", pipeline.Result{Value: mustDecimal(t, "3")}},
+		{"too few answers", "1", "6", pipeline.Result{Error: pipeline.ErrTooFewAnswers}},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			task := pipeline.MeanTask{
+				BaseTask:   pipeline.NewBaseTask(0, "task", nil, nil, 0),
+				Trim:       test.trim,
+				MinAnswers: test.minAnswers,
+			}
+			output := task.Run(context.Background(), pipeline.NewVarsFrom(nil), inputs)
+			if test.want.Error != nil {
+				require.Equal(t, test.want.Error, errors.Cause(output.Error))
+				require.Nil(t, output.Value)
+			} else {
+				require.NoError(t, output.Error)
+				require.Equal(t, test.want.Value.(*decimal.Decimal).String(), output.Value.(decimal.Decimal).String())
+			}
+		})
+	}
+}
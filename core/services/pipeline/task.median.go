@@ -17,6 +17,8 @@ type MedianTask struct {
 	BaseTask      `mapstructure:",squash"`
 	Values        string `json:"values"`
 	AllowedFaults string `json:"allowedFaults"`
+	MinAnswers    string `json:"minAnswers"`
+	Trim          string `json:"trim"`
 }
 
 var _ Task = (*MedianTask)(nil)
@@ -28,6 +30,8 @@ func (t *MedianTask) Type() TaskType {
 func (t *MedianTask) Run(_ context.Context, vars Vars, inputs []Result) (result Result) {
 	var (
 		maybeAllowedFaults MaybeUint64Param
+		minAnswers         MaybeUint64Param
+		trim               TrimParam
 		valuesAndErrs      SliceParam
 		decimalValues      DecimalSliceParam
 		allowedFaults      int
@@ -35,6 +39,8 @@ func (t *MedianTask) Run(_ context.Context, vars Vars, inputs []Result) (result
 	)
 	err := multierr.Combine(
 		errors.Wrap(ResolveParam(&maybeAllowedFaults, From(t.AllowedFaults)), "allowedFaults"),
+		errors.Wrap(ResolveParam(&minAnswers, From(VarExpr(t.MinAnswers, vars), t.MinAnswers)), "minAnswers"),
+		errors.Wrap(ResolveParam(&trim, From(VarExpr(t.Trim, vars), t.Trim)), "trim"),
 		errors.Wrap(ResolveParam(&valuesAndErrs, From(VarExpr(t.Values, vars), JSONWithVarExprs(t.Values, vars, true), Inputs(inputs))), "values"),
 	)
 	if err != nil {
@@ -52,12 +58,18 @@ func (t *MedianTask) Run(_ context.Context, vars Vars, inputs []Result) (result
 		return Result{Error: errors.Wrapf(ErrTooManyErrors, "Number of faulty inputs %v to median task > number allowed faults %v", faults, allowedFaults)}
 	} else if len(values) == 0 {
 		return Result{Error: errors.Wrap(ErrWrongInputCardinality, "no values to medianize")}
+	} else if err = checkMinAnswers(minAnswers, len(values), t.Type()); err != nil {
+		return Result{Error: err}
 	}
 
 	err = decimalValues.UnmarshalPipelineParam(values)
 	if err != nil {
 		return Result{Error: err}
 	}
+	decimalValues, err = trimDecimalOutliers(decimalValues, trim)
+	if err != nil {
+		return Result{Error: err}
+	}
 
 	sort.Slice(decimalValues, func(i, j int) bool {
 		return decimalValues[i].LessThan(decimalValues[j])
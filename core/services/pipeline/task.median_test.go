@@ -206,3 +206,53 @@ func TestMedian_AllowedFaults_Unmarshal(t *testing.T) {
 		}
 	}
 }
+
+func TestMedian_OutlierRejection(t *testing.T) {
+	t.Parallel()
+
+	inputs := []pipeline.Result{
+		{Value: mustDecimal(t, "1")},
+		{Value: mustDecimal(t, "100")},
+		{Value: mustDecimal(t, "2")},
+		{Value: mustDecimal(t, "3")},
+		{Value: mustDecimal(t, "4")},
+		{Error: errors.New("")},
+	}
+
+	tests := []struct {
+		name       string
+		trim       string
+		minAnswers string
+		want       pipeline.Result
+	}{
+		{"no trimming", "", "", pipeline.Result{Value: mustDecimal(t, "3")}},
+		{"trim a count", "1", "", pipeline.Result{Value: mustDecimal(t, "3")}},
+		{"trim a percentage", "20%", "", pipeline.Result{Value: mustDecimal(t, "3")}},
+		{"trim a percentage rounding down", "10%", "", pipeline.Result{Value: mustDecimal(t, "3")}},
+		{"trim too many", "3", "", pipeline.Result{Error: pipeline.ErrWrongInputCardinality}},
+		{"trim an invalid percentage", "50%", "", pipeline.Result{Error: pipeline.ErrBadInput}},
+		{"enough answers", "", "5", pipeline.Result{Value: mustDecimal(t, "3")}},
+		{"too few answers", "", "6", pipeline.Result{Error: pipeline.ErrTooFewAnswers}},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			task := pipeline.MedianTask{
+				BaseTask:   pipeline.NewBaseTask(0, "task", nil, nil, 0),
+				Trim:       test.trim,
+				MinAnswers: test.minAnswers,
+			}
+			output := task.Run(context.Background(), pipeline.NewVarsFrom(nil), inputs)
+			if test.want.Error != nil {
+				require.Equal(t, test.want.Error, errors.Cause(output.Error))
+				require.Nil(t, output.Value)
+			} else {
+				require.NoError(t, output.Error)
+				require.Equal(t, test.want.Value.(*decimal.Decimal).String(), output.Value.(decimal.Decimal).String())
+			}
+		})
+	}
+}
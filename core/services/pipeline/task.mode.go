@@ -22,6 +22,8 @@ type ModeTask struct {
 	BaseTask      `mapstructure:",squash"`
 	Values        string `json:"values"`
 	AllowedFaults string `json:"allowedFaults"`
+	MinAnswers    string `json:"minAnswers"`
+	Trim          string `json:"trim"`
 }
 
 var _ Task = (*ModeTask)(nil)
@@ -33,12 +35,16 @@ func (t *ModeTask) Type() TaskType {
 func (t *ModeTask) Run(_ context.Context, vars Vars, inputs []Result) (result Result) {
 	var (
 		maybeAllowedFaults MaybeUint64Param
+		minAnswers         MaybeUint64Param
+		trim               TrimParam
 		valuesAndErrs      SliceParam
 		allowedFaults      int
 		faults             int
 	)
 	err := multierr.Combine(
 		errors.Wrap(ResolveParam(&maybeAllowedFaults, From(t.AllowedFaults)), "allowedFaults"),
+		errors.Wrap(ResolveParam(&minAnswers, From(VarExpr(t.MinAnswers, vars), t.MinAnswers)), "minAnswers"),
+		errors.Wrap(ResolveParam(&trim, From(VarExpr(t.Trim, vars), t.Trim)), "trim"),
 		errors.Wrap(ResolveParam(&valuesAndErrs, From(VarExpr(t.Values, vars), JSONWithVarExprs(t.Values, vars, true), Inputs(inputs))), "values"),
 	)
 	if err != nil {
@@ -56,6 +62,25 @@ func (t *ModeTask) Run(_ context.Context, vars Vars, inputs []Result) (result Re
 		return Result{Error: errors.Wrapf(ErrTooManyErrors, "Number of faulty inputs %v to mode task > number allowed faults %v", faults, allowedFaults)}
 	} else if len(values) == 0 {
 		return Result{Error: errors.Wrap(ErrWrongInputCardinality, "values")}
+	} else if err = checkMinAnswers(minAnswers, len(values), t.Type()); err != nil {
+		return Result{Error: err}
+	}
+
+	// Outliers can only be trimmed from numeric values
+	if trim.Count(len(values)) > 0 {
+		var decimalValues DecimalSliceParam
+		if err = decimalValues.UnmarshalPipelineParam(values); err != nil {
+			return Result{Error: errors.Wrapf(ErrBadInput, "values must be numeric to trim outliers: %v", err)}
+		}
+		kept, err := trimOutliers(decimalValues, trim)
+		if err != nil {
+			return Result{Error: err}
+		}
+		trimmed := make([]interface{}, len(kept))
+		for i, index := range kept {
+			trimmed[i] = values[index]
+		}
+		values = trimmed
 	}
 
 	type entry struct {
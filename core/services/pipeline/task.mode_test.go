@@ -196,3 +196,70 @@ func TestModeTask(t *testing.T) {
 		})
 	}
 }
+
+func TestModeTask_OutlierRejection(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name            string
+		inputs          []pipeline.Result
+		trim            string
+		minAnswers      string
+		wantResults     []interface{}
+		wantOccurrences uint64
+		wantErrorCause  error
+	}{
+		{
+			"no trimming",
+			[]pipeline.Result{{Value: 100}, {Value: 100}, {Value: 3}, {Value: 1}, {Value: 3}, {Value: 2}},
+			"",
+			"",
+			[]interface{}{100, 3}, 2, nil,
+		},
+		{
+			"trim a count",
+			[]pipeline.Result{{Value: 100}, {Value: 100}, {Value: 3}, {Value: 1}, {Value: 3}, {Value: 2}},
+			"2",
+			"",
+			[]interface{}{3}, 2, nil,
+		},
+		{
+			"trim non-numeric values",
+			[]pipeline.Result{{Value: "foo"}, {Value: "bar"}, {Value: "foo"}},
+			"1",
+			"",
+			nil, 0, pipeline.ErrBadInput,
+		},
+		{
+			"too few answers",
+			[]pipeline.Result{{Value: "foo"}, {Error: errors.New("")}, {Value: "foo"}},
+			"",
+			"3",
+			nil, 0, pipeline.ErrTooFewAnswers,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			task := pipeline.ModeTask{
+				BaseTask:   pipeline.NewBaseTask(0, "mode", nil, nil, 0),
+				Trim:       test.trim,
+				MinAnswers: test.minAnswers,
+			}
+			output := task.Run(context.Background(), pipeline.NewVarsFrom(nil), test.inputs)
+			if test.wantErrorCause != nil {
+				require.Equal(t, test.wantErrorCause, errors.Cause(output.Error))
+				require.Nil(t, output.Value)
+			} else {
+				require.NoError(t, output.Error)
+				require.Equal(t, map[string]interface{}{
+					"results":     test.wantResults,
+					"occurrences": test.wantOccurrences,
+				}, output.Value)
+			}
+		})
+	}
+}
@@ -12,9 +12,11 @@ import (
 //    *decimal.Decimal
 //
 type MultiplyTask struct {
-	BaseTask `mapstructure:",squash"`
-	Input    string `json:"input"`
-	Times    string `json:"times"`
+	BaseTask     `mapstructure:",squash"`
+	Input        string `json:"input"`
+	Times        string `json:"times"`
+	Precision    string `json:"precision"`
+	RoundingMode string `json:"roundingMode"`
 }
 
 var _ Task = (*MultiplyTask)(nil)
@@ -30,17 +32,26 @@ func (t *MultiplyTask) Run(_ context.Context, vars Vars, inputs []Result) (resul
 	}
 
 	var (
-		a DecimalParam
-		b DecimalParam
+		a              DecimalParam
+		b              DecimalParam
+		maybePrecision MaybeInt32Param
+		roundingMode   RoundingModeParam
 	)
 	err = multierr.Combine(
 		errors.Wrap(ResolveParam(&a, From(VarExpr(t.Input, vars), Input(inputs, 0))), "input"),
 		errors.Wrap(ResolveParam(&b, From(VarExpr(t.Times, vars), NonemptyString(t.Times))), "times"),
+		errors.Wrap(ResolveParam(&maybePrecision, From(VarExpr(t.Precision, vars), t.Precision)), "precision"),
+		errors.Wrap(ResolveParam(&roundingMode, From(VarExpr(t.RoundingMode, vars), t.RoundingMode)), "roundingMode"),
 	)
 	if err != nil {
 		return Result{Error: err}
 	}
 
 	value := a.Decimal().Mul(b.Decimal())
+	if precision, isSet := maybePrecision.Int32(); isSet {
+		value = roundDecimal(value, precision, roundingMode.RoundingMode())
+	} else if t.RoundingMode != "" {
+		return Result{Error: errors.Wrap(ErrBadInput, "roundingMode requires precision to be set")}
+	}
 	return Result{Value: value}
 }
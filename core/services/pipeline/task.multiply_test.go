@@ -173,3 +173,37 @@ func TestMultiplyTask_Unhappy(t *testing.T) {
 		})
 	}
 }
+
+func TestMultiplyTask_Precision(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		input        string
+		times        string
+		precision    string
+		roundingMode string
+		expected     string
+	}{
+		{"no precision", "1.2345", "3", "", "", "3.7035"},
+		{"default rounding", "1.2345", "3", "2", "", "3.7"},
+		{"half_even", "0.125", "1", "2", "half_even", "0.12"},
+		{"floor", "-1.2345", "3", "3", "floor", "-3.704"},
+		{"negative precision", "1234", "1", "-2", "down", "1200"},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			task := pipeline.MultiplyTask{
+				BaseTask:     pipeline.NewBaseTask(0, "task", nil, nil, 0),
+				Times:        test.times,
+				Precision:    test.precision,
+				RoundingMode: test.roundingMode,
+			}
+			result := task.Run(context.Background(), pipeline.NewVarsFrom(nil), []pipeline.Result{{Value: test.input}})
+			require.NoError(t, result.Error)
+			require.Equal(t, test.expected, result.Value.(decimal.Decimal).String())
+		})
+	}
+}
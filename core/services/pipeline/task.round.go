@@ -0,0 +1,51 @@
+package pipeline
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"go.uber.org/multierr"
+)
+
+// RoundTask rounds its input to precision decimal places (0 by default),
+// half way values away from zero unless another roundingMode is given.
+//
+// Return types:
+//
+//	*decimal.Decimal
+type RoundTask struct {
+	BaseTask     `mapstructure:",squash"`
+	Input        string `json:"input"`
+	Precision    string `json:"precision"`
+	RoundingMode string `json:"roundingMode"`
+}
+
+var _ Task = (*RoundTask)(nil)
+
+func (t *RoundTask) Type() TaskType {
+	return TaskTypeRound
+}
+
+func (t *RoundTask) Run(_ context.Context, vars Vars, inputs []Result) (result Result) {
+	_, err := CheckInputs(inputs, 0, 1, 0)
+	if err != nil {
+		return Result{Error: errors.Wrap(err, "task inputs")}
+	}
+
+	var (
+		a              DecimalParam
+		maybePrecision MaybeInt32Param
+		roundingMode   RoundingModeParam
+	)
+	err = multierr.Combine(
+		errors.Wrap(ResolveParam(&a, From(VarExpr(t.Input, vars), Input(inputs, 0))), "input"),
+		errors.Wrap(ResolveParam(&maybePrecision, From(VarExpr(t.Precision, vars), t.Precision)), "precision"),
+		errors.Wrap(ResolveParam(&roundingMode, From(VarExpr(t.RoundingMode, vars), t.RoundingMode)), "roundingMode"),
+	)
+	if err != nil {
+		return Result{Error: err}
+	}
+
+	precision, _ := maybePrecision.Int32()
+	return Result{Value: roundDecimal(a.Decimal(), precision, roundingMode.RoundingMode())}
+}
@@ -0,0 +1,78 @@
+package pipeline_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+)
+
+func TestRoundTask(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		input        interface{}
+		precision    string
+		roundingMode string
+		expected     string
+	}{
+		{"default precision", "2.5", "", "", "3"},
+		{"default rounding, negative", "-2.5", "", "", "-3"},
+		{"precision", "3.14159", "2", "", "3.14"},
+		{"half_up", "1.005", "2", "half_up", "1.01"},
+		{"half_even down", "2.5", "0", "half_even", "2"},
+		{"half_even up", "3.5", "0", "half_even", "4"},
+		{"up", "1.001", "2", "up", "1.01"},
+		{"down", "-1.009", "2", "down", "-1"},
+		{"ceil", "-1.009", "2", "ceil", "-1"},
+		{"floor", "-1.001", "2", "floor", "-1.01"},
+		{"negative precision", "1250", "-2", "half_even", "1200"},
+		{"mixed case mode", "2.5", "0", " Half_Even ", "2"},
+		{"int", int64(7), "0", "", "7"},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			task := pipeline.RoundTask{
+				BaseTask:     pipeline.NewBaseTask(0, "task", nil, nil, 0),
+				Precision:    test.precision,
+				RoundingMode: test.roundingMode,
+			}
+			result := task.Run(context.Background(), pipeline.NewVarsFrom(nil), []pipeline.Result{{Value: test.input}})
+			require.NoError(t, result.Error)
+			require.Equal(t, test.expected, result.Value.(decimal.Decimal).String())
+		})
+	}
+
+	t.Run("with pipeline.Vars", func(t *testing.T) {
+		vars := pipeline.NewVarsFrom(map[string]interface{}{
+			"foo":  "12.345",
+			"prec": "1",
+			"mode": "floor",
+		})
+		task := pipeline.RoundTask{
+			BaseTask:     pipeline.NewBaseTask(0, "task", nil, nil, 0),
+			Input:        "$(foo)",
+			Precision:    "$(prec)",
+			RoundingMode: "$(mode)",
+		}
+		result := task.Run(context.Background(), vars, []pipeline.Result{})
+		require.NoError(t, result.Error)
+		require.Equal(t, "12.3", result.Value.(decimal.Decimal).String())
+	})
+
+	t.Run("unknown rounding mode", func(t *testing.T) {
+		task := pipeline.RoundTask{
+			BaseTask:     pipeline.NewBaseTask(0, "task", nil, nil, 0),
+			RoundingMode: "sideways",
+		}
+		result := task.Run(context.Background(), pipeline.NewVarsFrom(nil), []pipeline.Result{{Value: "1.5"}})
+		require.Equal(t, pipeline.ErrBadInput, errors.Cause(result.Error))
+	})
+}
@@ -0,0 +1,167 @@
+package pipeline
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+
+	"github.com/dop251/goja"
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+	"go.uber.org/multierr"
+)
+
+// ScriptTask runs a JavaScript program over its input and the pipeline vars,
+// which are available to the program as the `input` and `vars` globals. The
+// program has no access to the network, the filesystem or the node, and is
+// interrupted if it exceeds the node's time or memory limits for scripts.
+// The result of the task is the value of the program's last statement.
+//
+// Each program runs in a sandbox process of its own, see runScriptInSandbox,
+// so that the memory limit applies to the program alone and a program which
+// exhausts its memory or call stack does not take the node down with it.
+//
+// Numbers are passed to the program, and returned by it, as 64-bit floats.
+// The results of errored tasks are null in `vars`.
+//
+// Return types:
+//
+//	nil, bool, float64, string, []interface{} or map[string]interface{}
+type ScriptTask struct {
+	BaseTask `mapstructure:",squash"`
+	Script   string `json:"script"`
+	Input    string `json:"input"`
+
+	config Config
+}
+
+var _ Task = (*ScriptTask)(nil)
+
+var (
+	ErrScriptTimeout          = errors.New("script exceeded its time limit")
+	ErrScriptMemoryLimited    = errors.New("script exceeded its memory limit")
+	ErrScriptCallStackLimited = errors.New("script exceeded its call stack limit")
+)
+
+func (t *ScriptTask) Type() TaskType {
+	return TaskTypeScript
+}
+
+func (t *ScriptTask) Run(ctx context.Context, vars Vars, inputs []Result) Result {
+	_, err := CheckInputs(inputs, 0, 1, 0)
+	if err != nil {
+		return Result{Error: errors.Wrap(err, "task inputs")}
+	}
+
+	var (
+		script StringParam
+		input  scriptValueParam
+	)
+	err = multierr.Combine(
+		errors.Wrap(ResolveParam(&script, From(NonemptyString(t.Script))), "script"),
+		errors.Wrap(ResolveParam(&input, From(VarExpr(t.Input, vars), JSONWithVarExprs(t.Input, vars, false), Input(inputs, 0), nil)), "input"),
+	)
+	if err != nil {
+		return Result{Error: err}
+	}
+
+	// Syntax errors are reported without starting a sandbox
+	if _, err = goja.Compile(t.DotID(), string(script), true); err != nil {
+		return Result{Error: errors.Wrapf(ErrBadInput, "script: %v", err)}
+	}
+
+	var varsValue scriptValueParam
+	if err = varsValue.UnmarshalPipelineParam(vars.Copy().vars); err != nil {
+		return Result{Error: errors.Wrap(err, "vars")}
+	}
+
+	value, err := runScriptInSandbox(ctx, scriptRequest{
+		Name:        t.DotID(),
+		Script:      string(script),
+		Input:       input.value,
+		Vars:        varsValue.value,
+		MaxDuration: t.config.JobPipelineScriptTaskMaxDuration(),
+		MaxMemory:   t.config.JobPipelineScriptTaskMaxMemory(),
+	})
+	if err != nil {
+		return Result{Error: err}
+	}
+
+	var result interface{}
+	if err = json.Unmarshal(value, &result); err != nil {
+		return Result{Error: errors.Wrap(err, "script result")}
+	}
+	return Result{Value: result}
+}
+
+// scriptValueParam converts a pipeline value into one which the script can
+// use natively, e.g. decimals become numbers rather than Go objects
+type scriptValueParam struct {
+	value interface{}
+}
+
+func (p *scriptValueParam) UnmarshalPipelineParam(val interface{}) error {
+	value, err := toScriptValue(val)
+	if err != nil {
+		return errors.Wrap(ErrBadInput, err.Error())
+	}
+	*p = scriptValueParam{value}
+	return nil
+}
+
+func toScriptValue(val interface{}) (interface{}, error) {
+	switch v := val.(type) {
+	case nil, bool, string, float64, float32, int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64:
+		return v, nil
+	case []byte:
+		return string(v), nil
+	case decimal.Decimal:
+		f, _ := v.Float64()
+		return f, nil
+	case *decimal.Decimal:
+		if v == nil {
+			return nil, nil
+		}
+		f, _ := v.Float64()
+		return f, nil
+	case *big.Int:
+		if v == nil {
+			return nil, nil
+		}
+		f, _ := new(big.Float).SetInt(v).Float64()
+		return f, nil
+	case error:
+		return nil, nil
+	case map[string]interface{}:
+		m := make(map[string]interface{}, len(v))
+		for key, elem := range v {
+			converted, err := toScriptValue(elem)
+			if err != nil {
+				return nil, err
+			}
+			m[key] = converted
+		}
+		return m, nil
+	case []interface{}:
+		s := make([]interface{}, len(v))
+		for i, elem := range v {
+			converted, err := toScriptValue(elem)
+			if err != nil {
+				return nil, err
+			}
+			s[i] = converted
+		}
+		return s, nil
+	default:
+		// e.g. addresses and hashes, which are passed as their JSON encoding
+		bs, err := json.Marshal(v)
+		if err != nil {
+			return nil, err
+		}
+		var converted interface{}
+		if err = json.Unmarshal(bs, &converted); err != nil {
+			return nil, err
+		}
+		return converted, nil
+	}
+}
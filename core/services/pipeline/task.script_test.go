@@ -0,0 +1,214 @@
+package pipeline_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline/mocks"
+)
+
+func TestScriptTask(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		script         string
+		input          string
+		vars           pipeline.Vars
+		inputs         []pipeline.Result
+		want           interface{}
+		wantErrorCause error
+	}{
+		{
+			"transforms the task input",
+			`input * 2`,
+			"",
+			pipeline.NewVarsFrom(nil),
+			[]pipeline.Result{{Value: mustDecimal(t, "1.5")}},
+			float64(3),
+			nil,
+		},
+		{
+			"transforms the input param",
+			`input.prices.map(function (p) { return p.usd; })`,
+			"$(foo)",
+			pipeline.NewVarsFrom(map[string]interface{}{"foo": map[string]interface{}{"prices": []interface{}{map[string]interface{}{"usd": 1}, map[string]interface{}{"usd": 2}}}}),
+			nil,
+			[]interface{}{float64(1), float64(2)},
+			nil,
+		},
+		{
+			"reads the pipeline vars",
+			`var result = { total: vars.a + vars.b, errored: vars.c === null }; result`,
+			"",
+			pipeline.NewVarsFrom(map[string]interface{}{"a": mustDecimal(t, "1"), "b": []byte("2"), "c": errors.New("oops")}),
+			nil,
+			map[string]interface{}{"total": "12", "errored": true},
+			nil,
+		},
+		{
+			"without a result",
+			`var x = 1;`,
+			"",
+			pipeline.NewVarsFrom(nil),
+			nil,
+			nil,
+			nil,
+		},
+		{
+			"syntax error",
+			`input +`,
+			"",
+			pipeline.NewVarsFrom(nil),
+			nil,
+			nil,
+			pipeline.ErrBadInput,
+		},
+		{
+			"returns a function",
+			`(function () {})`,
+			"",
+			pipeline.NewVarsFrom(nil),
+			nil,
+			nil,
+			pipeline.ErrBadInput,
+		},
+		{
+			"runs for too long",
+			`while (true) {}`,
+			"",
+			pipeline.NewVarsFrom(nil),
+			nil,
+			nil,
+			pipeline.ErrScriptTimeout,
+		},
+		{
+			"allocates too much memory",
+			`var a = []; while (true) { a.push("some string " + a.length); }`,
+			"",
+			pipeline.NewVarsFrom(nil),
+			nil,
+			nil,
+			pipeline.ErrScriptMemoryLimited,
+		},
+		{
+			"recurses too deeply",
+			`var a = [1]; function f() { a.forEach(f); } f()`,
+			"",
+			pipeline.NewVarsFrom(nil),
+			nil,
+			nil,
+			pipeline.ErrScriptCallStackLimited,
+		},
+		{
+			"errored input",
+			`input`,
+			"",
+			pipeline.NewVarsFrom(nil),
+			[]pipeline.Result{{Error: errors.New("oops")}},
+			nil,
+			pipeline.ErrTooManyErrors,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			config := new(mocks.Config)
+			config.On("JobPipelineScriptTaskMaxDuration").Return(time.Second)
+			config.On("JobPipelineScriptTaskMaxMemory").Return(uint64(4 * 1024 * 1024))
+
+			task := pipeline.ScriptTask{
+				BaseTask: pipeline.NewBaseTask(0, "script", nil, nil, 0),
+				Script:   test.script,
+				Input:    test.input,
+			}
+			task.HelperSetDependencies(config)
+
+			result := task.Run(context.Background(), test.vars, test.inputs)
+			if test.wantErrorCause != nil {
+				require.Error(t, result.Error)
+				assert.Equal(t, test.wantErrorCause, errors.Cause(result.Error))
+				assert.Nil(t, result.Value)
+			} else {
+				require.NoError(t, result.Error)
+				assert.Equal(t, test.want, result.Value)
+			}
+		})
+	}
+}
+
+func TestScriptTask_ConcurrentMemoryLimit(t *testing.T) {
+	t.Parallel()
+
+	config := new(mocks.Config)
+	config.On("JobPipelineScriptTaskMaxDuration").Return(time.Second)
+	config.On("JobPipelineScriptTaskMaxMemory").Return(uint64(4 * 1024 * 1024))
+
+	newTask := func(script string) pipeline.ScriptTask {
+		task := pipeline.ScriptTask{
+			BaseTask: pipeline.NewBaseTask(0, "script", nil, nil, 0),
+			Script:   script,
+		}
+		task.HelperSetDependencies(config)
+		return task
+	}
+
+	hog := `var a = []; while (true) { a.push("some string " + a.length); }`
+	tests := []struct {
+		name    string
+		scripts []string
+		want    []pipeline.Result
+	}{
+		{
+			"a script under the limit alongside one over it",
+			[]string{hog, `var x = 0; for (var i = 0; i < 1000; i++) { x += i; } x`},
+			[]pipeline.Result{{Error: pipeline.ErrScriptMemoryLimited}, {Value: float64(499500)}},
+		},
+		{
+			"two scripts over the limit",
+			[]string{hog, hog},
+			[]pipeline.Result{{Error: pipeline.ErrScriptMemoryLimited}, {Error: pipeline.ErrScriptMemoryLimited}},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			results := make([]pipeline.Result, len(test.scripts))
+			start := make(chan struct{})
+			var wg sync.WaitGroup
+			for i, script := range test.scripts {
+				i, task := i, newTask(script)
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					<-start
+					results[i] = task.Run(context.Background(), pipeline.NewVarsFrom(nil), nil)
+				}()
+			}
+			close(start)
+			wg.Wait()
+
+			for i, want := range test.want {
+				if want.Error != nil {
+					require.Error(t, results[i].Error)
+					assert.Equal(t, want.Error, errors.Cause(results[i].Error))
+					assert.Nil(t, results[i].Value)
+				} else {
+					require.NoError(t, results[i].Error)
+					assert.Equal(t, want.Value, results[i].Value)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,114 @@
+package pipeline
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/store/models"
+)
+
+// SignTask signs its payload with a key of the node, either an eth key
+// (an EIP-191 signature, which can be verified with ecrecover) or the
+// offchain key of an OCR key bundle (an Ed25519 signature of the payload
+// prefixed with keystore.OffChainSigningDomain, so that it can't be mistaken
+// for an OCR message).
+//
+// The payload is a variable expression or JSON, defaulting to the task's
+// input. Strings are signed as they are, unless they are 0x prefixed hex, and
+// objects and arrays are signed as their JSON encoding.
+//
+// Return types:
+//
+//	string (the 0x prefixed hex encoded signature)
+type SignTask struct {
+	BaseTask       `mapstructure:",squash"`
+	Payload        string `json:"payload"`
+	SigningAddress string `json:"signingAddress"`
+	KeyBundleID    string `json:"keyBundleID"`
+
+	ethKeyStore ETHKeyStore
+	ocrKeyStore OCRKeyStore
+}
+
+//go:generate mockery --name OCRKeyStore --output ./mocks/ --case=underscore
+
+type OCRKeyStore interface {
+	SignOffChain(ctx context.Context, id models.Sha256Hash, payload []byte) ([]byte, error)
+}
+
+var _ Task = (*SignTask)(nil)
+
+func (t *SignTask) Type() TaskType {
+	return TaskTypeSign
+}
+
+func (t *SignTask) Run(ctx context.Context, vars Vars, inputs []Result) Result {
+	_, err := CheckInputs(inputs, 0, 1, 0)
+	if err != nil {
+		return Result{Error: errors.Wrap(err, "task inputs")}
+	}
+
+	var (
+		payload        signPayloadParam
+		signingAddress AddressParam
+		keyBundleID    StringParam
+	)
+	err = errors.Wrap(ResolveParam(&payload, From(VarExpr(t.Payload, vars), JSONWithVarExprs(t.Payload, vars, false), Input(inputs, 0))), "payload")
+	if err != nil {
+		return Result{Error: err}
+	}
+
+	switch {
+	case t.SigningAddress != "" && t.KeyBundleID != "":
+		return Result{Error: errors.Wrap(ErrBadInput, "only one of signingAddress and keyBundleID may be set")}
+	case t.SigningAddress != "":
+		if err = ResolveParam(&signingAddress, From(VarExpr(t.SigningAddress, vars), NonemptyString(t.SigningAddress))); err != nil {
+			return Result{Error: errors.Wrap(err, "signingAddress")}
+		}
+		signature, err2 := t.ethKeyStore.SignMessage(keyStoreContext(ctx), common.Address(signingAddress), payload)
+		if err2 != nil {
+			return Result{Error: errors.Wrap(err2, "failed to sign payload")}
+		}
+		return Result{Value: hexutil.Encode(signature)}
+	case t.KeyBundleID != "":
+		if err = ResolveParam(&keyBundleID, From(VarExpr(t.KeyBundleID, vars), NonemptyString(t.KeyBundleID))); err != nil {
+			return Result{Error: errors.Wrap(err, "keyBundleID")}
+		}
+		id, err2 := models.Sha256HashFromHex(string(keyBundleID))
+		if err2 != nil {
+			return Result{Error: errors.Wrapf(ErrBadInput, "keyBundleID: %v", err2)}
+		}
+		signature, err2 := t.ocrKeyStore.SignOffChain(keyStoreContext(ctx), id, payload)
+		if err2 != nil {
+			return Result{Error: errors.Wrap(err2, "failed to sign payload")}
+		}
+		return Result{Value: hexutil.Encode(signature)}
+	default:
+		return Result{Error: errors.Wrap(ErrBadInput, "one of signingAddress or keyBundleID must be set")}
+	}
+}
+
+// signPayloadParam is the bytes of a payload to sign
+type signPayloadParam []byte
+
+func (p *signPayloadParam) UnmarshalPipelineParam(val interface{}) error {
+	switch val.(type) {
+	case map[string]interface{}, []interface{}:
+		bs, err := json.Marshal(val)
+		if err != nil {
+			return errors.Wrap(ErrBadInput, err.Error())
+		}
+		*p = bs
+		return nil
+	}
+	var bs BytesParam
+	if err := bs.UnmarshalPipelineParam(val); err != nil {
+		return err
+	}
+	*p = signPayloadParam(bs)
+	return nil
+}
@@ -0,0 +1,125 @@
+package pipeline_test
+
+import (
+	"context"
+	"crypto/ed25519"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ocrkey"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline/mocks"
+)
+
+func TestSignTask(t *testing.T) {
+	t.Parallel()
+
+	address := common.HexToAddress("0x2aB9a2DC53736B361B72D900cDF9f78F9406FbbB")
+	key, err := ocrkey.NewKeyBundle()
+	require.NoError(t, err)
+	keyBundleID := key.ID
+
+	vars := pipeline.NewVarsFrom(map[string]interface{}{
+		"foo": map[string]interface{}{"bar": "baz"},
+	})
+
+	tests := []struct {
+		name            string
+		payload         string
+		input           interface{}
+		expectedPayload []byte
+	}{
+		{"input string", "", "hello", []byte("hello")},
+		{"input hex", "", "0x0102", []byte{1, 2}},
+		{"input object", "", map[string]interface{}{"price": 100}, []byte(`{"price":100}`)},
+		{"variable expression", "$(foo.bar)", nil, []byte("baz")},
+		{"JSON with variables", `{"foo": $(foo.bar)}`, nil, []byte(`{"foo":"baz"}`)},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name+" with eth key", func(t *testing.T) {
+			ethKeyStore := new(mocks.ETHKeyStore)
+			ethKeyStore.On("SignMessage", mock.Anything, address, test.expectedPayload).Return([]byte{0xab, 0xcd}, nil).Once()
+			defer ethKeyStore.AssertExpectations(t)
+
+			task := pipeline.SignTask{
+				BaseTask:       pipeline.NewBaseTask(0, "sign", nil, nil, 0),
+				Payload:        test.payload,
+				SigningAddress: address.Hex(),
+			}
+			task.HelperSetDependencies(ethKeyStore, nil)
+			result := task.Run(context.Background(), vars, inputsFor(test.input))
+			require.NoError(t, result.Error)
+			assert.Equal(t, "0xabcd", result.Value)
+		})
+
+		t.Run(test.name+" with OCR key", func(t *testing.T) {
+			signature, err := key.SignOffChain(test.expectedPayload)
+			require.NoError(t, err)
+			ocrKeyStore := new(mocks.OCRKeyStore)
+			ocrKeyStore.On("SignOffChain", mock.Anything, keyBundleID, test.expectedPayload).Return(signature, nil).Once()
+			defer ocrKeyStore.AssertExpectations(t)
+
+			task := pipeline.SignTask{
+				BaseTask:    pipeline.NewBaseTask(0, "sign", nil, nil, 0),
+				Payload:     test.payload,
+				KeyBundleID: keyBundleID.String(),
+			}
+			task.HelperSetDependencies(nil, ocrKeyStore)
+			result := task.Run(context.Background(), vars, inputsFor(test.input))
+			require.NoError(t, result.Error)
+
+			signature, err = hexutil.Decode(result.Value.(string))
+			require.NoError(t, err)
+			assert.True(t, ed25519.Verify(ed25519.PublicKey(key.PublicKeyOffChain()), test.expectedPayload, signature))
+		})
+	}
+
+	t.Run("errors if the OCR key bundle does not exist", func(t *testing.T) {
+		ocrKeyStore := new(mocks.OCRKeyStore)
+		ocrKeyStore.On("SignOffChain", mock.Anything, keyBundleID, mock.Anything).Return(nil, errors.Errorf("no OCR key bundle with ID %s", keyBundleID))
+
+		task := pipeline.SignTask{
+			BaseTask:    pipeline.NewBaseTask(0, "sign", nil, nil, 0),
+			KeyBundleID: keyBundleID.String(),
+		}
+		task.HelperSetDependencies(nil, ocrKeyStore)
+		result := task.Run(context.Background(), vars, inputsFor("hello"))
+		require.Error(t, result.Error)
+		assert.Contains(t, result.Error.Error(), "no OCR key bundle")
+	})
+
+	t.Run("errors unless exactly one key is given", func(t *testing.T) {
+		for _, task := range []pipeline.SignTask{
+			{BaseTask: pipeline.NewBaseTask(0, "sign", nil, nil, 0)},
+			{BaseTask: pipeline.NewBaseTask(0, "sign", nil, nil, 0), SigningAddress: address.Hex(), KeyBundleID: keyBundleID.String()},
+		} {
+			result := task.Run(context.Background(), vars, inputsFor("hello"))
+			require.ErrorIs(t, result.Error, pipeline.ErrBadInput)
+		}
+	})
+
+	t.Run("errors on a bad keyBundleID", func(t *testing.T) {
+		task := pipeline.SignTask{
+			BaseTask:    pipeline.NewBaseTask(0, "sign", nil, nil, 0),
+			KeyBundleID: "foo",
+		}
+		result := task.Run(context.Background(), vars, inputsFor("hello"))
+		require.ErrorIs(t, result.Error, pipeline.ErrBadInput)
+	})
+}
+
+func inputsFor(value interface{}) []pipeline.Result {
+	if value == nil {
+		return nil
+	}
+	return []pipeline.Result{{Value: value}}
+}
@@ -0,0 +1,77 @@
+package pipeline
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"go.uber.org/multierr"
+)
+
+// SubstringTask returns the characters of its input from start up to, but
+// not including, end. Negative indexes count back from the end of the input,
+// and indexes beyond either end of the input are clamped to it, as with
+// JavaScript's String.prototype.slice.
+//
+// Return types:
+//
+//	string
+type SubstringTask struct {
+	BaseTask `mapstructure:",squash"`
+	Input    string `json:"input"`
+	Start    string `json:"start"`
+	End      string `json:"end"`
+}
+
+var _ Task = (*SubstringTask)(nil)
+
+func (t *SubstringTask) Type() TaskType {
+	return TaskTypeSubstring
+}
+
+func (t *SubstringTask) Run(_ context.Context, vars Vars, inputs []Result) (result Result) {
+	_, err := CheckInputs(inputs, 0, 1, 0)
+	if err != nil {
+		return Result{Error: errors.Wrap(err, "task inputs")}
+	}
+
+	var (
+		input StringParam
+		start MaybeInt32Param
+		end   MaybeInt32Param
+	)
+	err = multierr.Combine(
+		errors.Wrap(ResolveParam(&input, From(VarExpr(t.Input, vars), Input(inputs, 0))), "input"),
+		errors.Wrap(ResolveParam(&start, From(VarExpr(t.Start, vars), t.Start)), "start"),
+		errors.Wrap(ResolveParam(&end, From(VarExpr(t.End, vars), t.End)), "end"),
+	)
+	if err != nil {
+		return Result{Error: err}
+	}
+
+	// Index characters rather than bytes, so that multibyte characters are
+	// never split
+	chars := []rune(string(input))
+	from := substringIndex(start, 0, len(chars))
+	to := substringIndex(end, len(chars), len(chars))
+	if to < from {
+		to = from
+	}
+	return Result{Value: string(chars[from:to])}
+}
+
+func substringIndex(p MaybeInt32Param, defaultIndex int, length int) int {
+	n, isSet := p.Int32()
+	if !isSet {
+		return defaultIndex
+	}
+	idx := int(n)
+	if idx < 0 {
+		idx += length
+	}
+	if idx < 0 {
+		return 0
+	} else if idx > length {
+		return length
+	}
+	return idx
+}
@@ -0,0 +1,68 @@
+package pipeline_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+)
+
+func TestSubstringTask(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		input    string
+		start    string
+		end      string
+		expected string
+	}{
+		{"start and end", "chainlink", "0", "5", "chain"},
+		{"start only", "chainlink", "5", "", "link"},
+		{"end only", "chainlink", "", "5", "chain"},
+		{"neither", "chainlink", "", "", "chainlink"},
+		{"negative start", "chainlink", "-4", "", "link"},
+		{"negative end", "chainlink", "0", "-4", "chain"},
+		{"end beyond input", "chainlink", "5", "100", "link"},
+		{"start beyond input", "chainlink", "100", "", ""},
+		{"end before start", "chainlink", "5", "2", ""},
+		{"multibyte characters", "€100", "0", "1", "€"},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			task := pipeline.SubstringTask{
+				BaseTask: pipeline.NewBaseTask(0, "task", nil, nil, 0),
+				Start:    test.start,
+				End:      test.end,
+			}
+			result := task.Run(context.Background(), pipeline.NewVarsFrom(nil), []pipeline.Result{{Value: test.input}})
+			require.NoError(t, result.Error)
+			require.Equal(t, test.expected, result.Value)
+		})
+	}
+
+	t.Run("with pipeline.Vars", func(t *testing.T) {
+		vars := pipeline.NewVarsFrom(map[string]interface{}{
+			"foo":   map[string]interface{}{"bar": "chainlink"},
+			"start": 5,
+		})
+		task := pipeline.SubstringTask{
+			BaseTask: pipeline.NewBaseTask(0, "task", nil, nil, 0),
+			Input:    "$(foo.bar)",
+			Start:    "$(start)",
+		}
+		result := task.Run(context.Background(), vars, []pipeline.Result{})
+		require.NoError(t, result.Error)
+		require.Equal(t, "link", result.Value)
+	})
+
+	t.Run("bad start", func(t *testing.T) {
+		task := pipeline.SubstringTask{BaseTask: pipeline.NewBaseTask(0, "task", nil, nil, 0), Start: "foo"}
+		result := task.Run(context.Background(), pipeline.NewVarsFrom(nil), []pipeline.Result{{Value: "chainlink"}})
+		require.Error(t, result.Error)
+	})
+}
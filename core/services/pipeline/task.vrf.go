@@ -29,7 +29,7 @@ type VRFTask struct {
 }
 
 type VRFKeyStore interface {
-	GenerateProof(k secp256k1.PublicKey, seed *big.Int) (vrfkey.Proof, error)
+	GenerateProof(ctx context.Context, k secp256k1.PublicKey, seed *big.Int) (vrfkey.Proof, error)
 }
 
 var _ Task = (*VRFTask)(nil)
@@ -38,7 +38,7 @@ func (t *VRFTask) Type() TaskType {
 	return TaskTypeVRF
 }
 
-func (t *VRFTask) Run(_ context.Context, vars Vars, inputs []Result) (result Result) {
+func (t *VRFTask) Run(ctx context.Context, vars Vars, inputs []Result) (result Result) {
 	if len(inputs) != 1 {
 		return Result{Error: ErrWrongInputCardinality}
 	}
@@ -97,7 +97,7 @@ func (t *VRFTask) Run(_ context.Context, vars Vars, inputs []Result) (result Res
 		BlockNum:  uint64(requestBlockNumber),
 	}
 	finalSeed := proof.FinalSeed(preSeedData)
-	p, err := t.keyStore.GenerateProof(pk, finalSeed)
+	p, err := t.keyStore.GenerateProof(keyStoreContext(ctx), pk, finalSeed)
 	if err != nil {
 		return Result{Error: err}
 	}
@@ -0,0 +1,158 @@
+package pipeline
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/json"
+	"net/http"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+const (
+	// WebhookNotifySignatureHeader contains the hex encoded signature of the
+	// request body.
+	WebhookNotifySignatureHeader = "X-Chainlink-Signature"
+	// WebhookNotifySignerHeader contains the address of the node key which
+	// signed the request body.
+	WebhookNotifySignerHeader = "X-Chainlink-Signer"
+)
+
+// WebhookNotifyTask POSTs the run result to an external URL so it can be
+// notified of job results without polling the runs API.
+//
+// The request body is the requestData if set, otherwise a JSON object with the
+// value of the single input under "result". The body is signed with either an
+// HMAC-SHA256 secret or an eth key of the node (an EIP-191 signature).
+//
+// Return types:
+//
+//	string
+type WebhookNotifyTask struct {
+	BaseTask                       `mapstructure:",squash"`
+	URL                            string
+	RequestData                    string `json:"requestData"`
+	Secret                         string
+	SigningAddress                 string
+	MaxAttempts                    string
+	AllowUnrestrictedNetworkAccess string
+
+	config   Config
+	keyStore ETHKeyStore
+}
+
+var _ Task = (*WebhookNotifyTask)(nil)
+
+func (t *WebhookNotifyTask) Type() TaskType {
+	return TaskTypeWebhookNotify
+}
+
+func (t *WebhookNotifyTask) Run(ctx context.Context, vars Vars, inputs []Result) Result {
+	inputValues, err := CheckInputs(inputs, 0, 1, 0)
+	if err != nil {
+		return Result{Error: errors.Wrap(err, "task inputs")}
+	}
+
+	var (
+		url                            URLParam
+		requestData                    MapParam
+		secret                         StringParam
+		signingAddress                 AddressParam
+		maxAttempts                    MaybeUint64Param
+		allowUnrestrictedNetworkAccess BoolParam
+	)
+	err = multierr.Combine(
+		errors.Wrap(ResolveParam(&url, From(VarExpr(t.URL, vars), NonemptyString(t.URL))), "url"),
+		errors.Wrap(ResolveParam(&requestData, From(VarExpr(t.RequestData, vars), JSONWithVarExprs(t.RequestData, vars, false), nil)), "requestData"),
+		errors.Wrap(ResolveParam(&secret, From(VarExpr(t.Secret, vars), t.Secret)), "secret"),
+		errors.Wrap(ResolveParam(&maxAttempts, From(t.MaxAttempts)), "maxAttempts"),
+		errors.Wrap(ResolveParam(&allowUnrestrictedNetworkAccess, From(NonemptyString(t.AllowUnrestrictedNetworkAccess), !variableRegexp.MatchString(t.URL))), "allowUnrestrictedNetworkAccess"),
+	)
+	if err != nil {
+		return Result{Error: err}
+	}
+	signWithKey := t.SigningAddress != ""
+	if signWithKey {
+		if err = ResolveParam(&signingAddress, From(VarExpr(t.SigningAddress, vars), NonemptyString(t.SigningAddress))); err != nil {
+			return Result{Error: errors.Wrap(err, "signingAddress")}
+		}
+	}
+	if secret != "" && signWithKey {
+		return Result{Error: errors.Wrap(ErrBadInput, "only one of secret and signingAddress may be set")}
+	}
+
+	if requestData == nil {
+		var result interface{}
+		if len(inputValues) > 0 {
+			result = inputValues[0]
+		}
+		requestData = MapParam{"result": result}
+	}
+	body, err := json.Marshal(requestData)
+	if err != nil {
+		return Result{Error: errors.Wrap(err, "failed to encode request body as JSON")}
+	}
+
+	request, err := http.NewRequest(http.MethodPost, url.String(), bytes.NewReader(body))
+	if err != nil {
+		return Result{Error: errors.Wrap(err, "failed to create http.Request")}
+	}
+	request.Header.Set("Content-Type", "application/json")
+	setTraceHeaders(ctx, request, t.config)
+
+	switch {
+	case secret != "":
+		mac := hmac.New(sha256.New, []byte(secret))
+		mac.Write(body)
+		request.Header.Set(WebhookNotifySignatureHeader, hexutil.Encode(mac.Sum(nil)))
+	case signWithKey:
+		address := common.Address(signingAddress)
+		signature, err2 := t.keyStore.SignMessage(keyStoreContext(ctx), address, body)
+		if err2 != nil {
+			return Result{Error: errors.Wrap(err2, "failed to sign request body")}
+		}
+		request.Header.Set(WebhookNotifySignatureHeader, hexutil.Encode(signature))
+		request.Header.Set(WebhookNotifySignerHeader, address.Hex())
+	}
+
+	attempts := t.config.DefaultMaxHTTPAttempts()
+	if n, isSet := maxAttempts.Uint64(); isSet {
+		attempts = uint(n)
+	}
+	httpRequest := utils.HTTPRequest{
+		Request: request,
+		Config: utils.HTTPRequestConfig{
+			Timeout:                        t.config.DefaultHTTPTimeout().Duration(),
+			MaxAttempts:                    attempts,
+			SizeLimit:                      t.config.DefaultHTTPLimit(),
+			AllowUnrestrictedNetworkAccess: bool(allowUnrestrictedNetworkAccess),
+		},
+	}
+
+	logger.Debugw("Webhook notify task: sending request",
+		"url", t.redactString(url.String()),
+		"dotID", t.DotID(),
+	)
+
+	responseBytes, statusCode, _, err := httpRequest.SendRequest(ctx)
+	if err != nil {
+		if ctx.Err() != nil {
+			return Result{Error: NewTaskError(ErrorCategoryTimeout, true, errors.New("http request timed out or interrupted"))}
+		}
+		return Result{Error: errors.Wrap(err, "error making http request")}
+	}
+	if statusCode >= 400 {
+		maybeErr := bestEffortExtractError(responseBytes)
+		return Result{Error: errors.Errorf("got error from %s: (status code %v) %s", url.String(), statusCode, maybeErr)}
+	}
+
+	return Result{Value: string(responseBytes)}
+}
@@ -0,0 +1,131 @@
+package pipeline_test
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline/mocks"
+)
+
+func TestWebhookNotifyTask_HMAC(t *testing.T) {
+	t.Parallel()
+
+	config, cleanup := cltest.NewConfig(t)
+	defer cleanup()
+
+	var body []byte
+	var signature string
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+		var err error
+		body, err = ioutil.ReadAll(r.Body)
+		require.NoError(t, err)
+		signature = r.Header.Get(pipeline.WebhookNotifySignatureHeader)
+		w.Write([]byte(`{"ok":true}`))
+	}))
+	defer s.Close()
+
+	task := pipeline.WebhookNotifyTask{
+		BaseTask: pipeline.NewBaseTask(0, "notify", nil, nil, 0),
+		URL:      s.URL,
+		Secret:   "secret",
+	}
+	task.HelperSetDependencies(config, nil)
+
+	result := task.Run(context.Background(), pipeline.NewVarsFrom(nil), []pipeline.Result{{Value: "123.45"}})
+	require.NoError(t, result.Error)
+	assert.Equal(t, `{"ok":true}`, result.Value)
+
+	var payload map[string]interface{}
+	require.NoError(t, json.Unmarshal(body, &payload))
+	assert.Equal(t, map[string]interface{}{"result": "123.45"}, payload)
+
+	mac := hmac.New(sha256.New, []byte("secret"))
+	mac.Write(body)
+	assert.Equal(t, hexutil.Encode(mac.Sum(nil)), signature)
+}
+
+func TestWebhookNotifyTask_SigningAddress(t *testing.T) {
+	t.Parallel()
+
+	config, cleanup := cltest.NewConfig(t)
+	defer cleanup()
+
+	address := cltest.NewAddress()
+	signature := []byte{1, 2, 3}
+	keyStore := new(mocks.ETHKeyStore)
+	keyStore.On("SignMessage", mock.Anything, address, []byte(`{"foo":"bar"}`)).Return(signature, nil).Once()
+
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, hexutil.Encode(signature), r.Header.Get(pipeline.WebhookNotifySignatureHeader))
+		assert.Equal(t, address.Hex(), r.Header.Get(pipeline.WebhookNotifySignerHeader))
+	}))
+	defer s.Close()
+
+	task := pipeline.WebhookNotifyTask{
+		BaseTask:       pipeline.NewBaseTask(0, "notify", nil, nil, 0),
+		URL:            s.URL,
+		RequestData:    `{"foo": $(foo)}`,
+		SigningAddress: address.Hex(),
+	}
+	task.HelperSetDependencies(config, keyStore)
+
+	result := task.Run(context.Background(), pipeline.NewVarsFrom(map[string]interface{}{"foo": "bar"}), nil)
+	require.NoError(t, result.Error)
+
+	keyStore.AssertExpectations(t)
+}
+
+func TestWebhookNotifyTask_Errors(t *testing.T) {
+	t.Parallel()
+
+	config, cleanup := cltest.NewConfig(t)
+	defer cleanup()
+
+	var attempts int
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer s.Close()
+
+	t.Run("retries failed requests", func(t *testing.T) {
+		task := pipeline.WebhookNotifyTask{
+			BaseTask:    pipeline.NewBaseTask(0, "notify", nil, nil, 0),
+			URL:         s.URL,
+			MaxAttempts: "2",
+		}
+		task.HelperSetDependencies(config, nil)
+
+		result := task.Run(context.Background(), pipeline.NewVarsFrom(nil), nil)
+		require.Error(t, result.Error)
+		assert.Equal(t, 2, attempts)
+	})
+
+	t.Run("rejects both a secret and a signing address", func(t *testing.T) {
+		task := pipeline.WebhookNotifyTask{
+			BaseTask:       pipeline.NewBaseTask(0, "notify", nil, nil, 0),
+			URL:            s.URL,
+			Secret:         "secret",
+			SigningAddress: cltest.NewAddress().Hex(),
+		}
+		task.HelperSetDependencies(config, nil)
+
+		result := task.Run(context.Background(), pipeline.NewVarsFrom(nil), nil)
+		require.Error(t, result.Error)
+		assert.Contains(t, result.Error.Error(), "only one of secret and signingAddress")
+	})
+}
@@ -0,0 +1,75 @@
+package pipeline
+
+import (
+	"sync"
+	"time"
+)
+
+// taskResultCachePurgeInterval is the minimum time between sweeps of expired
+// entries from a taskResultCache
+const taskResultCachePurgeInterval = time.Minute
+
+// taskResultCache memoizes the results of http and bridge tasks which set a
+// cache duration, keyed by the task's resolved request. It is shared by all
+// runs on the node, so identical requests made by different runs, or by
+// different jobs hitting the same source, are only sent once per TTL.
+//
+// A nil *taskResultCache caches nothing.
+type taskResultCache struct {
+	mu        sync.Mutex
+	entries   map[string]taskResultCacheEntry
+	lastPurge time.Time
+}
+
+type taskResultCacheEntry struct {
+	value     interface{}
+	cachedAt  time.Time
+	expiresAt time.Time
+}
+
+func newTaskResultCache() *taskResultCache {
+	return &taskResultCache{
+		entries:   make(map[string]taskResultCacheEntry),
+		lastPurge: time.Now(),
+	}
+}
+
+// get returns the cached value for key if it has not expired and is younger
+// than maxAge, since tasks sharing a request may set different durations
+func (c *taskResultCache) get(key string, maxAge time.Duration) (interface{}, bool) {
+	if c == nil {
+		return nil, false
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, exists := c.entries[key]
+	if !exists {
+		return nil, false
+	}
+	now := time.Now()
+	if !now.Before(entry.expiresAt) || now.Sub(entry.cachedAt) >= maxAge {
+		return nil, false
+	}
+	return entry.value, true
+}
+
+// set caches value for key for ttl. Values must not be mutated once cached.
+func (c *taskResultCache) set(key string, value interface{}, ttl time.Duration) {
+	if c == nil || ttl <= 0 {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := time.Now()
+	if now.Sub(c.lastPurge) >= taskResultCachePurgeInterval {
+		for k, entry := range c.entries {
+			if !now.Before(entry.expiresAt) {
+				delete(c.entries, k)
+			}
+		}
+		c.lastPurge = now
+	}
+	c.entries[key] = taskResultCacheEntry{value: value, cachedAt: now, expiresAt: now.Add(ttl)}
+}
@@ -0,0 +1,112 @@
+package pipeline
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"strconv"
+
+	"github.com/pkg/errors"
+	"gopkg.in/guregu/null.v4"
+)
+
+// ErrorCategory groups task errors by their cause, so that failures can be
+// aggregated and alerted on without parsing error messages
+type ErrorCategory string
+
+const (
+	// ErrorCategoryNetwork is used for failed requests to HTTP endpoints,
+	// bridges and eth nodes
+	ErrorCategoryNetwork ErrorCategory = "network"
+	// ErrorCategoryParse is used for malformed JSON, numbers, etc.
+	ErrorCategoryParse ErrorCategory = "parse"
+	// ErrorCategoryValidation is used for task params, inputs and vars which
+	// are missing or invalid
+	ErrorCategoryValidation ErrorCategory = "validation"
+	// ErrorCategoryTimeout is used for tasks which exceeded their timeout
+	ErrorCategoryTimeout ErrorCategory = "timeout"
+	// ErrorCategoryUnknown is used for all other errors
+	ErrorCategoryUnknown ErrorCategory = "unknown"
+)
+
+// TaskError is a task error with its category, whether a retry could
+// succeed, and the dot ID of the task which returned it. Tasks may return a
+// TaskError themselves; any other error is classified by the runner.
+type TaskError struct {
+	Category  ErrorCategory
+	Retryable bool
+	DotID     string
+	Err       error
+}
+
+func NewTaskError(category ErrorCategory, retryable bool, err error) TaskError {
+	return TaskError{Category: category, Retryable: retryable, Err: err}
+}
+
+func (e TaskError) Error() string {
+	return e.Err.Error()
+}
+
+func (e TaskError) Unwrap() error {
+	return e.Err
+}
+
+// isRetryable reports whether a retry of the task which failed with err
+// could succeed, i.e. err is not a TaskError which is not retryable
+func isRetryable(err error) bool {
+	var taskErr TaskError
+	return !errors.As(err, &taskErr) || taskErr.Retryable
+}
+
+// classifyTaskError returns err as a TaskError of task, or err unchanged if it
+// is nil or ErrPending, which isn't a failure
+func classifyTaskError(task Task, err error) error {
+	if err == nil || err == ErrPending {
+		return err
+	}
+	var taskErr TaskError
+	if !errors.As(err, &taskErr) {
+		category, retryable := categorizeError(err)
+		taskErr = NewTaskError(category, retryable, err)
+	}
+	// Keep the full message, in case the TaskError was wrapped
+	taskErr.Err = err
+	taskErr.DotID = task.DotID()
+	return taskErr
+}
+
+func categorizeError(err error) (category ErrorCategory, retryable bool) {
+	var (
+		netErr       net.Error
+		syntaxErr    *json.SyntaxError
+		unmarshalErr *json.UnmarshalTypeError
+		numErr       *strconv.NumError
+	)
+	switch {
+	case errors.Is(err, context.DeadlineExceeded), errors.Is(err, ErrTimeout), errors.Is(err, ErrScriptTimeout):
+		return ErrorCategoryTimeout, true
+	case errors.As(err, &netErr):
+		if netErr.Timeout() {
+			return ErrorCategoryTimeout, true
+		}
+		return ErrorCategoryNetwork, true
+	case errors.As(err, &syntaxErr), errors.As(err, &unmarshalErr), errors.As(err, &numErr):
+		return ErrorCategoryParse, false
+	case errors.Is(err, ErrBadInput), errors.Is(err, ErrWrongInputCardinality), errors.Is(err, ErrParameterEmpty),
+		errors.Is(err, ErrInputTaskErrored), errors.Is(err, ErrInvalidVars), errors.Is(err, ErrKeypathNotFound):
+		return ErrorCategoryValidation, false
+	}
+	return ErrorCategoryUnknown, false
+}
+
+// taskErrorFromDB restores the TaskError of a task run saved to the database
+func taskErrorFromDB(dotID string, message null.String, category null.String, retryable bool) error {
+	if !message.Valid {
+		return nil
+	}
+	err := errors.New(message.String)
+	if !category.Valid {
+		return err
+	}
+	return TaskError{Category: ErrorCategory(category.String), Retryable: retryable, DotID: dotID, Err: err}
+}
@@ -0,0 +1,61 @@
+package pipeline_test
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"net/url"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+)
+
+func TestClassifyTaskError(t *testing.T) {
+	t.Parallel()
+
+	var syntaxErr error = json.Unmarshal([]byte("{"), new(interface{}))
+	urlErr := &url.Error{Op: "Post", URL: "http://localhost:8001", Err: &net.OpError{Op: "dial", Net: "tcp", Err: errors.New("connection refused")}}
+
+	tests := []struct {
+		name      string
+		err       error
+		category  pipeline.ErrorCategory
+		retryable bool
+	}{
+		{"deadline exceeded", errors.Wrap(context.DeadlineExceeded, "fetching"), pipeline.ErrorCategoryTimeout, true},
+		{"run timeout", pipeline.ErrTimeout, pipeline.ErrorCategoryTimeout, true},
+		{"connection refused", errors.Wrap(urlErr, "error making http request"), pipeline.ErrorCategoryNetwork, true},
+		{"json syntax", errors.Wrap(syntaxErr, "parsing"), pipeline.ErrorCategoryParse, false},
+		{"bad input", errors.Wrap(pipeline.ErrBadInput, "times"), pipeline.ErrorCategoryValidation, false},
+		{"wrong cardinality", pipeline.ErrWrongInputCardinality, pipeline.ErrorCategoryValidation, false},
+		{"explicit", errors.Wrap(pipeline.NewTaskError(pipeline.ErrorCategoryNetwork, false, errors.New("got error from bridge: (status code 400)")), "bridge"), pipeline.ErrorCategoryNetwork, false},
+		{"other", errors.New("something went wrong"), pipeline.ErrorCategoryUnknown, false},
+	}
+
+	task := &pipeline.HTTPTask{BaseTask: pipeline.NewBaseTask(0, "ds1", nil, nil, 0)}
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := pipeline.ClassifyTaskError(task, test.err)
+
+			var taskErr pipeline.TaskError
+			require.True(t, errors.As(err, &taskErr))
+			assert.Equal(t, test.category, taskErr.Category)
+			assert.Equal(t, test.retryable, taskErr.Retryable)
+			assert.Equal(t, "ds1", taskErr.DotID)
+			assert.Equal(t, test.err.Error(), err.Error())
+			assert.True(t, errors.Is(err, errors.Cause(test.err)))
+		})
+	}
+
+	t.Run("nil and pending errors are not classified", func(t *testing.T) {
+		assert.NoError(t, pipeline.ClassifyTaskError(task, nil))
+		assert.Equal(t, pipeline.ErrPending, pipeline.ClassifyTaskError(task, pipeline.ErrPending))
+	})
+}
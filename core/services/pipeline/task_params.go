@@ -9,6 +9,7 @@ import (
 	"net/url"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/pkg/errors"
@@ -73,7 +74,7 @@ func VarExpr(s string, vars Vars) GetterFunc {
 		if len(trimmed) == 0 {
 			return nil, ErrParameterEmpty
 		}
-		isVariableExpr := strings.Count(trimmed, "$") == 1 && trimmed[:2] == "$(" && trimmed[len(trimmed)-1] == ')'
+		isVariableExpr := strings.Count(trimmed, "$") == 1 && strings.HasPrefix(trimmed, "$(") && strings.HasSuffix(trimmed, ")")
 		if !isVariableExpr {
 			return nil, ErrParameterEmpty
 		}
@@ -324,6 +325,61 @@ func (p MaybeUint64Param) Uint64() (uint64, bool) {
 	return p.n, p.isSet
 }
 
+// TrimParam is how many outliers to discard from each end of a set of values,
+// either as a count ("2") or as a percentage of the values ("10%")
+type TrimParam struct {
+	count     uint64
+	percent   decimal.Decimal
+	isPercent bool
+}
+
+func (p *TrimParam) UnmarshalPipelineParam(val interface{}) error {
+	var s string
+	switch v := val.(type) {
+	case string:
+		s = v
+	case []byte:
+		s = string(v)
+	default:
+		var count MaybeUint64Param
+		if err := count.UnmarshalPipelineParam(val); err != nil {
+			return err
+		}
+		n, _ := count.Uint64()
+		*p = TrimParam{count: n}
+		return nil
+	}
+
+	s = strings.TrimSpace(s)
+	if !strings.HasSuffix(s, "%") {
+		var count MaybeUint64Param
+		if err := count.UnmarshalPipelineParam(s); err != nil {
+			return err
+		}
+		n, _ := count.Uint64()
+		*p = TrimParam{count: n}
+		return nil
+	}
+
+	percent, err := decimal.NewFromString(strings.TrimSpace(strings.TrimSuffix(s, "%")))
+	if err != nil {
+		return errors.Wrap(ErrBadInput, err.Error())
+	} else if percent.IsNegative() || percent.GreaterThanOrEqual(decimal.NewFromInt(50)) {
+		return errors.Wrapf(ErrBadInput, "trim percentage must be at least 0%% and less than 50%%, got %s", s)
+	}
+	*p = TrimParam{percent: percent, isPercent: true}
+	return nil
+}
+
+// Count returns how many of n values to discard from each end. Percentages
+// are rounded down.
+func (p TrimParam) Count(n int) int {
+	if p.isPercent {
+		return int(p.percent.Mul(decimal.NewFromInt(int64(n))).Div(decimal.NewFromInt(100)).IntPart())
+	}
+	return int(p.count)
+}
+
 type MaybeInt32Param struct {
 	n     int32
 	isSet bool
@@ -390,6 +446,33 @@ func (p MaybeInt32Param) Int32() (int32, bool) {
 	return p.n, p.isSet
 }
 
+type DurationParam time.Duration
+
+func (p *DurationParam) UnmarshalPipelineParam(val interface{}) error {
+	var d time.Duration
+	switch v := val.(type) {
+	case time.Duration:
+		d = v
+	case string:
+		var err error
+		d, err = time.ParseDuration(v)
+		if err != nil {
+			return errors.Wrap(ErrBadInput, err.Error())
+		}
+	default:
+		return ErrBadInput
+	}
+	if d < 0 {
+		return errors.Wrap(ErrBadInput, "duration must not be negative")
+	}
+	*p = DurationParam(d)
+	return nil
+}
+
+func (p DurationParam) Duration() time.Duration {
+	return time.Duration(p)
+}
+
 type BoolParam bool
 
 func (b *BoolParam) UnmarshalPipelineParam(val interface{}) error {
@@ -424,6 +507,33 @@ func (d DecimalParam) Decimal() decimal.Decimal {
 	return decimal.Decimal(d)
 }
 
+type RoundingModeParam RoundingMode
+
+func (m *RoundingModeParam) UnmarshalPipelineParam(val interface{}) error {
+	var s string
+	switch v := val.(type) {
+	case string:
+		s = v
+	case []byte:
+		s = string(v)
+	default:
+		return ErrBadInput
+	}
+	switch mode := RoundingMode(strings.ToLower(strings.TrimSpace(s))); mode {
+	case RoundingModeHalfUp, RoundingModeHalfEven, RoundingModeUp, RoundingModeDown, RoundingModeCeil, RoundingModeFloor:
+		*m = RoundingModeParam(mode)
+	case "":
+		*m = RoundingModeParam(RoundingModeHalfUp)
+	default:
+		return errors.Wrapf(ErrBadInput, "unknown rounding mode %q", s)
+	}
+	return nil
+}
+
+func (m RoundingModeParam) RoundingMode() RoundingMode {
+	return RoundingMode(m)
+}
+
 type URLParam url.URL
 
 func (u *URLParam) UnmarshalPipelineParam(val interface{}) error {
@@ -637,9 +747,16 @@ func (s *AddressSliceParam) UnmarshalPipelineParam(val interface{}) error {
 	return nil
 }
 
+// JSONPathParam is a list of keys, given as a slice or as a string of keys
+// separated by commas. A string JSONPath expression (starting with $) is
+// never split, and is returned as the only element.
 type JSONPathParam []string
 
 func (p *JSONPathParam) UnmarshalPipelineParam(val interface{}) error {
+	return p.unmarshalWithSeparator(val, ",")
+}
+
+func (p *JSONPathParam) unmarshalWithSeparator(val interface{}, separator string) error {
 	var ssp JSONPathParam
 	switch v := val.(type) {
 	case nil:
@@ -658,15 +775,22 @@ func (p *JSONPathParam) UnmarshalPipelineParam(val interface{}) error {
 		if len(v) == 0 {
 			return nil
 		}
-		ssp = strings.Split(v, ",")
+		ssp = splitJSONPath(v, separator)
 	case []byte:
 		if len(v) == 0 {
 			return nil
 		}
-		ssp = strings.Split(string(v), ",")
+		ssp = splitJSONPath(string(v), separator)
 	default:
 		return ErrBadInput
 	}
 	*p = ssp
 	return nil
 }
+
+func splitJSONPath(path, separator string) []string {
+	if isJSONPathExpression(path) {
+		return []string{path}
+	}
+	return strings.Split(path, separator)
+}
@@ -3,6 +3,7 @@ package pipeline_test
 import (
 	"net/url"
 	"testing"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 
@@ -219,6 +220,36 @@ func TestBoolParam_UnmarshalPipelineParam(t *testing.T) {
 	}
 }
 
+func TestDurationParam_UnmarshalPipelineParam(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		input    interface{}
+		expected interface{}
+		err      error
+	}{
+		{"string", "30s", pipeline.DurationParam(30 * time.Second), nil},
+		{"duration", time.Minute, pipeline.DurationParam(time.Minute), nil},
+		{"zero", "0s", pipeline.DurationParam(0), nil},
+		{"bad string", "soon", pipeline.DurationParam(0), pipeline.ErrBadInput},
+		{"negative", "-1s", pipeline.DurationParam(0), pipeline.ErrBadInput},
+		{"int", 30, pipeline.DurationParam(0), pipeline.ErrBadInput},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			var p pipeline.DurationParam
+			err := p.UnmarshalPipelineParam(test.input)
+			require.Equal(t, test.err, errors.Cause(err))
+			require.Equal(t, test.expected, p)
+		})
+	}
+}
+
 func TestDecimalParam_UnmarshalPipelineParam(t *testing.T) {
 	t.Parallel()
 
@@ -9,6 +9,9 @@ import (
 	"net/url"
 	"testing"
 
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	dto "github.com/prometheus/client_model/go"
 	"github.com/stretchr/testify/require"
 
 	"github.com/smartcontractkit/chainlink/core/internal/cltest"
@@ -50,3 +53,19 @@ func makeBridge(t *testing.T, store *store.Store, name string, expectedRequest,
 	require.NoError(t, store.ORM.DB.Create(&bridge).Error)
 	return server
 }
+
+// histogramSamples returns how many series h has, and how many observations
+// the series with labels has
+func histogramSamples(t *testing.T, h *prometheus.HistogramVec, labels ...string) (series int, samples uint64) {
+	t.Helper()
+	series = testutil.CollectAndCount(h)
+	observer, err := h.GetMetricWithLabelValues(labels...)
+	require.NoError(t, err)
+	var m dto.Metric
+	require.NoError(t, observer.(prometheus.Histogram).Write(&m))
+	if m.GetHistogram().GetSampleCount() == 0 {
+		// The series did not exist, drop it again
+		h.DeleteLabelValues(labels...)
+	}
+	return series, m.GetHistogram().GetSampleCount()
+}
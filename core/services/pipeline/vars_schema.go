@@ -0,0 +1,225 @@
+package pipeline
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+	"go.uber.org/multierr"
+)
+
+// VarType is the declared type of a jobRun or jobSpec variable
+type VarType string
+
+const (
+	VarTypeAny     VarType = "any"
+	VarTypeString  VarType = "string"
+	VarTypeBytes   VarType = "bytes"
+	VarTypeBool    VarType = "bool"
+	VarTypeInt     VarType = "int"
+	VarTypeDecimal VarType = "decimal"
+	VarTypeAddress VarType = "address"
+	VarTypeHash    VarType = "hash"
+	VarTypeMap     VarType = "map"
+	VarTypeArray   VarType = "array"
+)
+
+// optionalVarSuffix marks a variable which may be missing or null, e.g. "int?"
+const optionalVarSuffix = "?"
+
+var ErrInvalidVars = errors.New("invalid pipeline vars")
+
+// varSchemaRoots are the variables which can be declared in a VarsSchema. The
+// results of tasks are typed by the tasks themselves.
+var varSchemaRoots = map[string]bool{
+	"jobRun":  true,
+	"jobSpec": true,
+}
+
+// VarsSchema declares the types of the jobRun and jobSpec variables a pipeline
+// expects, keyed by keypath, e.g.
+//
+//	[inputSchema]
+//	"jobRun.requestBody" = "string"
+//	"jobRun.meta" = "map?"
+//
+// Runs are rejected before any task is executed if their vars do not match.
+type VarsSchema map[string]string
+
+// UnmarshalTOML implements toml.Unmarshaler
+func (s *VarsSchema) UnmarshalTOML(val interface{}) error {
+	m, ok := val.(map[string]interface{})
+	if !ok {
+		return errors.Errorf("inputSchema must be a table, got %T", val)
+	}
+	schema := make(VarsSchema, len(m))
+	for keypath, typ := range m {
+		str, is := typ.(string)
+		if !is {
+			return errors.Errorf("inputSchema: type of %s must be a string, got %T", keypath, typ)
+		}
+		schema[keypath] = str
+	}
+	if err := schema.Validate(); err != nil {
+		return err
+	}
+	*s = schema
+	return nil
+}
+
+// Validate checks that the schema only declares known types for jobRun and
+// jobSpec variables
+func (s VarsSchema) Validate() (err error) {
+	for _, keypathStr := range s.keypaths() {
+		keypath, kpErr := newKeypathFromString(keypathStr)
+		if kpErr != nil {
+			err = multierr.Append(err, errors.Wrap(kpErr, "inputSchema"))
+			continue
+		}
+		if keypath.NumParts() == 0 || !varSchemaRoots[string(keypath[0])] {
+			err = multierr.Append(err, errors.Errorf("inputSchema: %s is not a jobRun or jobSpec variable", keypathStr))
+			continue
+		}
+		typ, _ := parseVarType(s[keypathStr])
+		switch typ {
+		case VarTypeAny, VarTypeString, VarTypeBytes, VarTypeBool, VarTypeInt, VarTypeDecimal,
+			VarTypeAddress, VarTypeHash, VarTypeMap, VarTypeArray:
+		default:
+			err = multierr.Append(err, errors.Errorf("inputSchema: %s has unknown type %s", keypathStr, s[keypathStr]))
+		}
+	}
+	return err
+}
+
+// ValidateVars checks vars against the schema, returning an ErrInvalidVars
+// for each variable which is missing or has the wrong type
+func (s VarsSchema) ValidateVars(vars Vars) (err error) {
+	for _, keypath := range s.keypaths() {
+		typ, optional := parseVarType(s[keypath])
+		val, getErr := vars.Get(keypath)
+		if errors.Is(getErr, ErrKeypathNotFound) || (getErr == nil && val == nil) {
+			if !optional {
+				err = multierr.Append(err, errors.Wrapf(ErrInvalidVars, "%s: missing %s", keypath, typ))
+			}
+			continue
+		} else if getErr != nil {
+			err = multierr.Append(err, errors.Wrapf(ErrInvalidVars, "%s: %v", keypath, getErr))
+			continue
+		}
+		if !isVarType(val, typ) {
+			err = multierr.Append(err, errors.Wrapf(ErrInvalidVars, "%s: expected %s, got %T", keypath, typ, val))
+		}
+	}
+	return err
+}
+
+func (s VarsSchema) keypaths() []string {
+	keypaths := make([]string, 0, len(s))
+	for keypath := range s {
+		keypaths = append(keypaths, keypath)
+	}
+	sort.Strings(keypaths)
+	return keypaths
+}
+
+func (s *VarsSchema) Scan(value interface{}) error {
+	if value == nil {
+		*s = nil
+		return nil
+	}
+	bytes, ok := value.([]byte)
+	if !ok {
+		return errors.Errorf("VarsSchema#Scan received a value of type %T", value)
+	}
+	return json.Unmarshal(bytes, s)
+}
+
+func (s VarsSchema) Value() (driver.Value, error) {
+	if len(s) == 0 {
+		return nil, nil
+	}
+	return json.Marshal(s)
+}
+
+func parseVarType(s string) (typ VarType, optional bool) {
+	s = strings.TrimSpace(s)
+	if strings.HasSuffix(s, optionalVarSuffix) {
+		return VarType(strings.TrimSpace(strings.TrimSuffix(s, optionalVarSuffix))), true
+	}
+	return VarType(s), false
+}
+
+func isVarType(val interface{}, typ VarType) bool {
+	switch typ {
+	case VarTypeAny:
+		return true
+	case VarTypeString:
+		_, is := val.(string)
+		return is
+	case VarTypeBytes:
+		_, is := val.([]byte)
+		return is
+	case VarTypeBool:
+		_, is := val.(bool)
+		return is
+	case VarTypeInt:
+		return isIntVar(val)
+	case VarTypeDecimal:
+		if isIntVar(val) {
+			return true
+		}
+		switch v := val.(type) {
+		case float32, float64, decimal.Decimal, *decimal.Decimal:
+			return true
+		case json.Number:
+			_, err := decimal.NewFromString(v.String())
+			return err == nil
+		}
+		return false
+	case VarTypeAddress:
+		switch v := val.(type) {
+		case common.Address:
+			return true
+		case string:
+			return common.IsHexAddress(v)
+		}
+		return false
+	case VarTypeHash:
+		_, is := val.(common.Hash)
+		return is
+	case VarTypeMap:
+		_, is := val.(map[string]interface{})
+		return is
+	case VarTypeArray:
+		// e.g. []interface{} or []common.Hash, but not bytes, hashes or
+		// addresses
+		_, isBytes := val.([]byte)
+		return reflect.TypeOf(val).Kind() == reflect.Slice && !isBytes
+	default:
+		panic(fmt.Sprintf("unknown VarType %s", typ))
+	}
+}
+
+func isIntVar(val interface{}) bool {
+	switch v := val.(type) {
+	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64, *big.Int:
+		return true
+	case float64:
+		return v == float64(int64(v))
+	case decimal.Decimal:
+		return v.Equal(v.Truncate(0))
+	case *decimal.Decimal:
+		return v != nil && v.Equal(v.Truncate(0))
+	case json.Number:
+		_, err := v.Int64()
+		return err == nil
+	}
+	return false
+}
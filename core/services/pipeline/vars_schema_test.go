@@ -0,0 +1,139 @@
+package pipeline_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pelletier/go-toml"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+)
+
+func TestVarsSchema_UnmarshalTOML(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		toml     string
+		expected pipeline.VarsSchema
+		err      string
+	}{
+		{"none", ``, nil, ""},
+		{"types", `
+[inputSchema]
+"jobRun.requestBody" = "string"
+"jobRun.meta" = "map?"
+"jobSpec.databaseID" = "int"
+`, pipeline.VarsSchema{"jobRun.requestBody": "string", "jobRun.meta": "map?", "jobSpec.databaseID": "int"}, ""},
+		{"unknown type", `
+[inputSchema]
+"jobRun.requestBody" = "text"
+`, nil, "jobRun.requestBody has unknown type text"},
+		{"task result", `
+[inputSchema]
+"ds.result" = "string"
+`, nil, "ds.result is not a jobRun or jobSpec variable"},
+		{"too deep", `
+[inputSchema]
+"jobRun.meta.foo" = "string"
+`, nil, "keypath too deep"},
+		{"not a string", `
+[inputSchema]
+"jobRun.requestBody" = 1
+`, nil, "type of jobRun.requestBody must be a string"},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			var spec struct {
+				InputSchema pipeline.VarsSchema `toml:"inputSchema"`
+			}
+			err := toml.Unmarshal([]byte(test.toml), &spec)
+			if test.err != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), test.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, spec.InputSchema)
+		})
+	}
+}
+
+func TestVarsSchema_ValidateVars(t *testing.T) {
+	t.Parallel()
+
+	addr := common.HexToAddress("0x2aB9a2DC53736B361B72D900cDF9f78F9406FbbB")
+	vars := pipeline.NewVarsFrom(map[string]interface{}{
+		"jobSpec": map[string]interface{}{
+			"databaseID": int32(1),
+			"name":       "job",
+		},
+		"jobRun": map[string]interface{}{
+			"requestBody":    `{"foo": 1}`,
+			"meta":           map[string]interface{}{"latestAnswer": float64(3)},
+			"logBlockHash":   common.HexToHash("0x1"),
+			"logBlockNumber": uint64(10),
+			"logAddress":     addr,
+			"logTopics":      []common.Hash{common.HexToHash("0x2")},
+			"logData":        []byte{1, 2, 3},
+			"price":          decimal.RequireFromString("1.5"),
+			"amount":         big.NewInt(100),
+			"count":          float64(2),
+			"flag":           true,
+			"sender":         addr.Hex(),
+			"nothing":        nil,
+		},
+	})
+
+	tests := []struct {
+		name   string
+		schema pipeline.VarsSchema
+		err    string
+	}{
+		{"no schema", nil, ""},
+		{"matching types", pipeline.VarsSchema{
+			"jobSpec.databaseID":    "int",
+			"jobSpec.name":          "string",
+			"jobRun.requestBody":    "string",
+			"jobRun.meta":           "map",
+			"jobRun.logBlockHash":   "hash",
+			"jobRun.logBlockNumber": "int",
+			"jobRun.logAddress":     "address",
+			"jobRun.logTopics":      "array",
+			"jobRun.logData":        "bytes",
+			"jobRun.price":          "decimal",
+			"jobRun.amount":         "decimal",
+			"jobRun.count":          "int",
+			"jobRun.flag":           "bool",
+			"jobRun.sender":         "address",
+			"jobRun.nothing":        "any?",
+			"jobRun.missing":        "string?",
+		}, ""},
+		{"missing", pipeline.VarsSchema{"jobRun.missing": "string"}, "jobRun.missing: missing string"},
+		{"null", pipeline.VarsSchema{"jobRun.nothing": "any"}, "jobRun.nothing: missing any"},
+		{"wrong type", pipeline.VarsSchema{"jobRun.requestBody": "map"}, "jobRun.requestBody: expected map, got string"},
+		{"non-integer", pipeline.VarsSchema{"jobRun.price": "int"}, "jobRun.price: expected int, got decimal.Decimal"},
+		{"hash is not an array", pipeline.VarsSchema{"jobRun.logBlockHash": "array"}, "expected array, got common.Hash"},
+		{"not an address", pipeline.VarsSchema{"jobRun.requestBody": "address"}, "expected address, got string"},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			err := test.schema.ValidateVars(vars)
+			if test.err != "" {
+				require.Error(t, err)
+				assert.ErrorIs(t, err, pipeline.ErrInvalidVars)
+				assert.Contains(t, err.Error(), test.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -8,4 +8,10 @@ const (
 
 	// Postgres channel to listen for new eth_txes
 	ChannelInsertOnEthTx = "insert_on_eth_txes"
+	// Postgres channel to listen for confirmed eth_txes, with a JSON payload
+	// of the eth_tx's id and fromAddress
+	ChannelEthTxConfirmed = "eth_tx_confirmed"
+	// Postgres channel to listen for recorded job spec errors, with a JSON
+	// payload of the error's id, jobID, severity and occurrences
+	ChannelJobSpecErrorRecorded = "job_spec_error_recorded"
 )
@@ -74,6 +74,12 @@ func ValidateBridgeType(bt *models.BridgeTypeRequest, store *store.Store) error
 		bt.MinimumContractPayment.Cmp(assets.NewLink(0)) < 0 {
 		fe.Add("MinimumContractPayment must be positive")
 	}
+	if bt.CreditCost.IsNegative() {
+		fe.Add("CreditCost must be positive")
+	}
+	if bt.RateLimit.IsNegative() {
+		fe.Add("RateLimit must be positive")
+	}
 	ts := models.TaskSpec{Type: bt.Name}
 	if a := adapters.FindNativeAdapterFor(ts, nil); a != nil {
 		fe.Add(fmt.Sprintf("Bridge Type %v is a native adapter", bt.Name))
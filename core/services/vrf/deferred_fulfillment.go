@@ -0,0 +1,90 @@
+package vrf
+
+import (
+	"context"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"gorm.io/gorm"
+
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+const (
+	// DefaultFulfillmentDeadlineBlocks is the number of blocks after a request
+	// by which it is fulfilled regardless of the gas price, for jobs which set
+	// maxGasPriceWei but not fulfillmentDeadlineBlocks
+	DefaultFulfillmentDeadlineBlocks uint64 = 200
+)
+
+// DeferredFulfillment is a request of a VRF v2 job whose fulfillment was
+// deferred because the gas price was above the maxGasPriceWei of the job
+type DeferredFulfillment struct {
+	ID                  int64
+	JobID               int32
+	RequestID           common.Hash
+	SubID               uint64
+	RequestTxHash       common.Hash
+	RequestBlockNumber  uint64
+	DeadlineBlockNumber uint64
+	// GasPrice is the gas price as of the last time the fulfillment was
+	// deferred
+	GasPrice  utils.Big
+	Attempts  int64
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+func (DeferredFulfillment) TableName() string {
+	return "vrf_deferred_fulfillments"
+}
+
+// shouldDefer returns true if a fulfillment should wait for the gas price to
+// fall below maxGasPrice. Fulfillments are never deferred past their deadline,
+// nor when the gas price is unknown.
+func shouldDefer(gasPrice, maxGasPrice *big.Int, deadlineBlockNumber, latestHead uint64) bool {
+	if maxGasPrice == nil || gasPrice == nil {
+		return false
+	}
+	if latestHead >= deadlineBlockNumber {
+		return false
+	}
+	return gasPrice.Cmp(maxGasPrice) > 0
+}
+
+// recordDeferredFulfillment saves a deferred fulfillment, counting the
+// attempts if it was deferred before
+func recordDeferredFulfillment(ctx context.Context, db *gorm.DB, d DeferredFulfillment) error {
+	return db.WithContext(ctx).Exec(`
+		INSERT INTO vrf_deferred_fulfillments AS d (job_id, request_id, sub_id, request_tx_hash, request_block_number, deadline_block_number, gas_price, attempts, created_at, updated_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, 1, now(), now())
+		ON CONFLICT (job_id, request_id) DO UPDATE SET
+			gas_price = EXCLUDED.gas_price,
+			attempts = d.attempts + 1,
+			updated_at = EXCLUDED.updated_at
+	`,
+		d.JobID, d.RequestID, d.SubID, d.RequestTxHash, d.RequestBlockNumber, d.DeadlineBlockNumber, d.GasPrice,
+	).Error
+}
+
+// deleteDeferredFulfillments removes the requests of a job from the deferred
+// fulfillments, once they are fulfilled
+func deleteDeferredFulfillments(db *gorm.DB, jobID int32, requestIDs []common.Hash) error {
+	if len(requestIDs) == 0 {
+		return nil
+	}
+	return db.Exec(`DELETE FROM vrf_deferred_fulfillments WHERE job_id = ? AND request_id IN (?)`, jobID, requestIDs).Error
+}
+
+// FindDeferredFulfillments returns the deferred fulfillments of a VRF job, or
+// of all VRF jobs if jobID is nil, oldest first
+func FindDeferredFulfillments(ctx context.Context, db *gorm.DB, jobID *int32) ([]DeferredFulfillment, error) {
+	var deferred []DeferredFulfillment
+	q := db.WithContext(ctx).Order("id ASC")
+	if jobID != nil {
+		q = q.Where("job_id = ?", *jobID)
+	}
+	err := q.Find(&deferred).Error
+	return deferred, err
+}
@@ -22,7 +22,9 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/pkg/errors"
 	"github.com/smartcontractkit/chainlink/core/gracefulpanic"
+	"github.com/smartcontractkit/chainlink/core/internal/gethwrappers/generated/batch_vrf_coordinator_v2"
 	"github.com/smartcontractkit/chainlink/core/internal/gethwrappers/generated/solidity_vrf_coordinator_interface"
+	"github.com/smartcontractkit/chainlink/core/internal/gethwrappers/generated/vrf_coordinator_v2"
 	"github.com/smartcontractkit/chainlink/core/logger"
 	"github.com/smartcontractkit/chainlink/core/services/eth"
 	"github.com/smartcontractkit/chainlink/core/services/job"
@@ -56,6 +58,8 @@ type GethKeyStore interface {
 type Config interface {
 	MinIncomingConfirmations() uint32
 	EthGasLimitDefault() uint64
+	VRFProofWorkers() uint64
+	EthFinalityDepth() uint
 }
 
 func NewDelegate(
@@ -92,6 +96,9 @@ func (d *Delegate) ServicesForSpec(jb job.Job) ([]job.Service, error) {
 	if jb.VRFSpec == nil {
 		return nil, errors.Errorf("vrf.Delegate expects a *job.VRFSpec to be present, got %+v", jb)
 	}
+	if jb.VRFSpec.IsV2() {
+		return d.servicesForSpecV2(jb)
+	}
 	coordinator, err := solidity_vrf_coordinator_interface.NewVRFCoordinator(jb.VRFSpec.CoordinatorAddress.Address(), d.ec)
 	if err != nil {
 		return nil, err
@@ -131,6 +138,50 @@ func (d *Delegate) ServicesForSpec(jb job.Job) ([]job.Service, error) {
 	return []job.Service{logListener}, nil
 }
 
+func (d *Delegate) servicesForSpecV2(jb job.Job) ([]job.Service, error) {
+	coordinator, err := vrf_coordinator_v2.NewVRFCoordinatorV2(jb.VRFSpec.CoordinatorAddress.Address(), d.ec)
+	if err != nil {
+		return nil, err
+	}
+	var batchCoordinator *batch_vrf_coordinator_v2.BatchVRFCoordinatorV2
+	if jb.VRFSpec.BatchFulfillmentEnabled {
+		batchCoordinator, err = batch_vrf_coordinator_v2.NewBatchVRFCoordinatorV2(jb.VRFSpec.BatchCoordinatorAddress.Address(), d.ec)
+		if err != nil {
+			return nil, err
+		}
+	}
+	l := logger.CreateLogger(logger.Default.SugaredLogger.With(
+		"jobID", jb.ID,
+		"externalJobID", jb.ExternalJobID,
+		"coordinatorAddress", jb.VRFSpec.CoordinatorAddress,
+		"coordinatorVersion", jb.VRFSpec.CoordinatorVersion,
+	))
+
+	return []job.Service{&listenerV2{
+		cfg:              d.cfg,
+		l:                *l,
+		abi:              eth.MustGetABI(vrf_coordinator_v2.VRFCoordinatorV2ABI),
+		batchABI:         eth.MustGetABI(batch_vrf_coordinator_v2.BatchVRFCoordinatorV2ABI),
+		logBroadcaster:   d.lb,
+		coordinator:      coordinator,
+		ethClient:        d.ec,
+		batchCoordinator: batchCoordinator,
+		job:              jb,
+		db:               d.db,
+		headBroadcaster:  d.hb,
+		txm:              d.txm,
+		vrfks:            d.ks.VRF(),
+		gethks:           d.ks.Eth(),
+		proofs:           newProofWorkerPool(d.cfg.VRFProofWorkers(), fmt.Sprintf("%d", jb.ID)),
+		reqLogs:          utils.NewMailbox(1000),
+		chStop:           make(chan struct{}),
+		waitOnStop:       make(chan struct{}),
+		newHead:          make(chan struct{}, 1),
+		reqAdded:         func() {},
+		sent:             newSentFulfillmentsV2(),
+	}}, nil
+}
+
 func getStartingResponseCounts(db *gorm.DB, l *logger.Logger) map[[32]byte]uint64 {
 	respCounts := make(map[[32]byte]uint64)
 	var counts []struct {
@@ -62,7 +62,7 @@ func buildVrfUni(t *testing.T, db *gorm.DB, cfg *config.Config) vrfUniverse {
 	// Mock all chain interactions
 	lb := new(log_mocks.Broadcaster)
 	ec := new(eth_mocks.Client)
-	hb := headtracker.NewHeadBroadcaster()
+	hb := headtracker.NewHeadBroadcaster(cfg)
 
 	// Don't mock db interactions
 	eb := postgres.NewEventBroadcaster(cfg.DatabaseURL(), 0, 0)
@@ -74,7 +74,7 @@ func buildVrfUni(t *testing.T, db *gorm.DB, cfg *config.Config) vrfUniverse {
 	ks := keystore.New(db, utils.FastScryptParams)
 	txm := new(bptxmmocks.TxManager)
 	t.Cleanup(func() { txm.AssertExpectations(t) })
-	pr := pipeline.NewRunner(prm, cfg, ec, ks.Eth(), ks.VRF(), txm)
+	pr := pipeline.NewRunner(prm, cfg, ec, ks.Eth(), ks.VRF(), ks.OCR(), txm, nil)
 	require.NoError(t, ks.Eth().Unlock("blah"))
 	_, err = ks.Eth().CreateNewKey()
 	require.NoError(t, err)
@@ -419,7 +419,7 @@ func TestDelegate_ValidLog(t *testing.T) {
 		// Linked to  requestID
 		vuni.txm.On("CreateEthTransaction", mock.AnythingOfType("*gorm.DB"), vuni.submitter, common.HexToAddress(jb.VRFSpec.CoordinatorAddress.String()), mock.Anything, uint64(500000), mock.MatchedBy(func(meta *models.EthTxMetaV2) bool {
 			return meta.JobID > 0 && meta.RequestID == tc.reqID && meta.RequestTxHash == txHash
-		}), bulletprooftxmanager.SendEveryStrategy{}).Once().Return(bulletprooftxmanager.EthTx{}, nil)
+		}), bulletprooftxmanager.SendEveryStrategy{}, bulletprooftxmanager.EthTxUrgencyNormal, bulletprooftxmanager.EthTxExpiry{}, true).Once().Return(bulletprooftxmanager.EthTx{}, nil)
 
 		listener.HandleLog(log.NewLogBroadcast(tc.log, nil))
 		// Wait until the log is present
@@ -436,7 +436,7 @@ func TestDelegate_ValidLog(t *testing.T) {
 		// Should have 4 tasks all completed
 		assert.Len(t, runs[0].PipelineTaskRuns, 4)
 
-		p, err := vuni.ks.VRF().GenerateProof(pk, utils.MustHash(string(bytes.Join([][]byte{preSeed, bh.Bytes()}, []byte{}))).Big())
+		p, err := vuni.ks.VRF().GenerateProof(context.Background(), pk, utils.MustHash(string(bytes.Join([][]byte{preSeed, bh.Bytes()}, []byte{}))).Big())
 		require.NoError(t, err)
 		vuni.lb.On("WasAlreadyConsumed", mock.Anything, mock.Anything).Return(false, nil)
 		vuni.lb.On("MarkConsumed", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
@@ -3,6 +3,7 @@ package vrf_test
 import (
 	"context"
 	"encoding/hex"
+	"fmt"
 	"math/big"
 	"testing"
 	"time"
@@ -89,7 +90,7 @@ func TestIntegration_VRFV2(t *testing.T) {
 
 	// Ensure the eth transaction gets confirmed on chain.
 	gomega.NewGomegaWithT(t).Eventually(func() bool {
-		uc, err2 := bulletprooftxmanager.CountUnconfirmedTransactions(app.Store.DB, key.Address.Address())
+		uc, err2 := bulletprooftxmanager.CountUnconfirmedTransactions(app.Store.DB, nil, key.Address.Address())
 		require.NoError(t, err2)
 		return uc == 0
 	}, 5*time.Second, 100*time.Millisecond).Should(gomega.BeTrue())
@@ -105,3 +106,80 @@ func TestIntegration_VRFV2(t *testing.T) {
 		return len(rf) == 1
 	}, 5*time.Second, 500*time.Millisecond).Should(gomega.BeTrue())
 }
+
+func TestIntegration_VRFV2_Subscription(t *testing.T) {
+	config, _, cleanupDB := heavyweight.FullTestORM(t, "vrf_v2_subscription", true)
+	defer cleanupDB()
+	key := cltest.MustGenerateRandomKey(t)
+	cu := newVRFCoordinatorUniverse(t, key)
+	app, cleanup := cltest.NewApplicationWithConfigAndKeyOnSimulatedBlockchain(t, config, cu.backend, key)
+	defer cleanup()
+	require.NoError(t, app.StartAndConnect())
+
+	_, err := app.KeyStore.VRF().Unlock(cltest.Password)
+	require.NoError(t, err)
+	vrfkey, err := app.KeyStore.VRF().CreateKey()
+	require.NoError(t, err)
+	p, err := vrfkey.Point()
+	require.NoError(t, err)
+	_, err = cu.rootContractV2.RegisterProvingKey(cu.neil, key.Address.Address(), pair(secp256k1.Coordinates(p)))
+	require.NoError(t, err)
+	cu.backend.Commit()
+
+	incomingConfs := 2
+	s := fmt.Sprintf(`
+type = "vrf"
+schemaVersion = 1
+name = "vrf-v2"
+coordinatorAddress = "%s"
+coordinatorVersion = 2
+batchCoordinatorAddress = "%s"
+batchFulfillmentEnabled = true
+fromAddress = "%s"
+confirmations = %d
+publicKey = "%s"
+`, cu.rootContractAddressV2, cu.batchCoordinatorAddress, key.Address, incomingConfs, vrfkey)
+	jb, err := vrf.ValidatedVRFSpec(s)
+	require.NoError(t, err)
+	_, err = app.JobORM().CreateJob(context.Background(), &jb, jb.Pipeline)
+	require.NoError(t, err)
+
+	fulfillments := func() int64 {
+		n, err2 := cu.consumerContractV2.SFulfillments(nil)
+		require.NoError(t, err2)
+		return n.Int64()
+	}
+	ethTxs := func() (txs []bulletprooftxmanager.EthTx) {
+		require.NoError(t, app.Store.DB.Where("from_address = ?", key.Address).Order("id").Find(&txs).Error)
+		return txs
+	}
+
+	// The requests of an unfunded subscription are requeued until it is
+	// funded, and then fulfilled together by the batch coordinator
+	createSubscriptionV2(t, cu, big.NewInt(0))
+	requestRandomWordsV2(t, cu, vrfkey.MustHash(), 1)
+	requestRandomWordsV2(t, cu, vrfkey.MustHash(), 1)
+	gomega.NewGomegaWithT(t).Consistently(func() int {
+		cu.backend.Commit()
+		return len(ethTxs())
+	}, 3*time.Second, 500*time.Millisecond).Should(gomega.Equal(0))
+
+	fundSubscriptionV2(t, cu, oneEth)
+	gomega.NewGomegaWithT(t).Eventually(func() int64 {
+		cu.backend.Commit()
+		return fulfillments()
+	}, 10*time.Second, 500*time.Millisecond).Should(gomega.Equal(int64(2)))
+	txs := ethTxs()
+	require.Len(t, txs, 1)
+	assert.Equal(t, cu.batchCoordinatorAddress, txs[0].ToAddress)
+
+	// A lone request is fulfilled by the coordinator itself
+	requestRandomWordsV2(t, cu, vrfkey.MustHash(), 1)
+	gomega.NewGomegaWithT(t).Eventually(func() int64 {
+		cu.backend.Commit()
+		return fulfillments()
+	}, 10*time.Second, 500*time.Millisecond).Should(gomega.Equal(int64(3)))
+	txs = ethTxs()
+	require.Len(t, txs, 2)
+	assert.Equal(t, cu.rootContractAddressV2, txs[1].ToAddress)
+}
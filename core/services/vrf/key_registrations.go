@@ -0,0 +1,90 @@
+package vrf
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+
+	"github.com/smartcontractkit/chainlink/core/internal/gethwrappers/generated/solidity_vrf_coordinator_interface"
+	"github.com/smartcontractkit/chainlink/core/internal/gethwrappers/generated/vrf_coordinator_v2"
+	"github.com/smartcontractkit/chainlink/core/services/eth"
+	"github.com/smartcontractkit/chainlink/core/services/job"
+	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
+	"github.com/smartcontractkit/chainlink/core/services/signatures/secp256k1"
+)
+
+// KeyRegistration is a coordinator of a VRF job which has the job's proving
+// key registered, and so may still have requests for it
+type KeyRegistration struct {
+	JobID              int32
+	CoordinatorAddress ethkey.EIP55Address
+	CoordinatorVersion uint32
+}
+
+// FindKeyRegistrations returns the coordinators of the VRF jobs using the key
+// which still have it registered. Deleting such a key would leave the requests
+// for it unfulfilled.
+func FindKeyRegistrations(ctx context.Context, db *gorm.DB, ethClient eth.Client, pk secp256k1.PublicKey) ([]KeyRegistration, error) {
+	var coordinators []KeyRegistration
+	err := db.WithContext(ctx).Raw(`
+		SELECT jobs.id AS job_id, vrf_specs.coordinator_address, vrf_specs.coordinator_version
+		FROM jobs
+		INNER JOIN vrf_specs ON vrf_specs.id = jobs.vrf_spec_id
+		WHERE vrf_specs.public_key = ?
+		ORDER BY jobs.id ASC
+	`, pk).Scan(&coordinators).Error
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to find the VRF jobs of the key")
+	}
+
+	keyHash, err := pk.Hash()
+	if err != nil {
+		return nil, err
+	}
+	var registrations []KeyRegistration
+	for _, c := range coordinators {
+		registered, err := isKeyRegistered(ctx, ethClient, c.CoordinatorVersion, c.CoordinatorAddress.Address(), keyHash)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to check if the key is registered with coordinator %s", c.CoordinatorAddress)
+		}
+		if registered {
+			registrations = append(registrations, c)
+		}
+	}
+	return registrations, nil
+}
+
+// isKeyRegistered returns true if the key with the given hash is registered
+// with the coordinator
+func isKeyRegistered(ctx context.Context, ethClient eth.Client, coordinatorVersion uint32, coordinatorAddress common.Address, keyHash common.Hash) (bool, error) {
+	opts := &bind.CallOpts{Context: ctx}
+	if coordinatorVersion == job.VRFCoordinatorV2 {
+		coordinator, err := vrf_coordinator_v2.NewVRFCoordinatorV2(coordinatorAddress, ethClient)
+		if err != nil {
+			return false, err
+		}
+		_, _, keyHashes, err := coordinator.GetRequestConfig(opts)
+		if err != nil {
+			return false, err
+		}
+		for _, h := range keyHashes {
+			if h == keyHash {
+				return true, nil
+			}
+		}
+		return false, nil
+	}
+
+	coordinator, err := solidity_vrf_coordinator_interface.NewVRFCoordinator(coordinatorAddress, ethClient)
+	if err != nil {
+		return false, err
+	}
+	agreement, err := coordinator.ServiceAgreements(opts, keyHash)
+	if err != nil {
+		return false, err
+	}
+	return agreement.VRFOracle != (common.Address{}), nil
+}
@@ -0,0 +1,64 @@
+package vrf
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/internal/gethwrappers/generated/solidity_vrf_coordinator_interface"
+	"github.com/smartcontractkit/chainlink/core/internal/gethwrappers/generated/vrf_coordinator_v2"
+	"github.com/smartcontractkit/chainlink/core/services/eth"
+	eth_mocks "github.com/smartcontractkit/chainlink/core/services/eth/mocks"
+	"github.com/smartcontractkit/chainlink/core/services/job"
+)
+
+func TestIsKeyRegistered(t *testing.T) {
+	t.Parallel()
+
+	var (
+		coordinator = common.HexToAddress("0xB3b7874F13387D44a3398D298B075B7A3505D8d4")
+		keyHash     = common.HexToHash("0x9926c5f19ec3b3ce005e1c183612f05cfc042966fcdd82ec6e78bf128d91695a")
+		otherHash   = common.HexToHash("0x1")
+	)
+	v2Outputs := eth.MustGetABI(vrf_coordinator_v2.VRFCoordinatorV2ABI).Methods["getRequestConfig"].Outputs
+	v1Outputs := eth.MustGetABI(solidity_vrf_coordinator_interface.VRFCoordinatorABI).Methods["serviceAgreements"].Outputs
+
+	tests := []struct {
+		name       string
+		version    uint32
+		output     func() ([]byte, error)
+		registered bool
+	}{
+		{"v2 registered", job.VRFCoordinatorV2, func() ([]byte, error) {
+			return v2Outputs.Pack(uint16(3), uint32(2_500_000), [][32]byte{otherHash, keyHash})
+		}, true},
+		{"v2 not registered", job.VRFCoordinatorV2, func() ([]byte, error) {
+			return v2Outputs.Pack(uint16(3), uint32(2_500_000), [][32]byte{otherHash})
+		}, false},
+		{"v1 registered", 1, func() ([]byte, error) {
+			return v1Outputs.Pack(common.HexToAddress("0x2"), big.NewInt(1), [32]byte{})
+		}, true},
+		{"v1 not registered", 1, func() ([]byte, error) {
+			return v1Outputs.Pack(common.Address{}, big.NewInt(0), [32]byte{})
+		}, false},
+	}
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			output, err := tc.output()
+			require.NoError(t, err)
+			ethClient := new(eth_mocks.Client)
+			ethClient.On("CallContract", mock.Anything, mock.Anything, mock.Anything).Return(output, nil)
+
+			registered, err := isKeyRegistered(context.Background(), ethClient, tc.version, coordinator, keyHash)
+			require.NoError(t, err)
+			assert.Equal(t, tc.registered, registered)
+			ethClient.AssertExpectations(t)
+		})
+	}
+}
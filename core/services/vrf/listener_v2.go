@@ -0,0 +1,732 @@
+package vrf
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"gorm.io/gorm"
+
+	"github.com/smartcontractkit/chainlink/core/gracefulpanic"
+	"github.com/smartcontractkit/chainlink/core/internal/gethwrappers/generated/batch_vrf_coordinator_v2"
+	"github.com/smartcontractkit/chainlink/core/internal/gethwrappers/generated/vrf_coordinator_v2"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/bulletprooftxmanager"
+	"github.com/smartcontractkit/chainlink/core/services/eth"
+	httypes "github.com/smartcontractkit/chainlink/core/services/headtracker/types"
+	"github.com/smartcontractkit/chainlink/core/services/job"
+	"github.com/smartcontractkit/chainlink/core/services/keystore"
+	"github.com/smartcontractkit/chainlink/core/services/log"
+	"github.com/smartcontractkit/chainlink/core/services/postgres"
+	"github.com/smartcontractkit/chainlink/core/services/vrf/proof"
+	"github.com/smartcontractkit/chainlink/core/store/models"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+const (
+	// GasProofVerification is an upper bound on the gas a VRFCoordinatorV2
+	// uses to verify a proof and pay for the fulfillment, on top of the
+	// callback gas limit of the request
+	GasProofVerification uint64 = 200_000
+	// DefaultBatchFulfillmentGasLimit is the gas limit of batch fulfillment
+	// transactions of jobs which do not set batchFulfillmentGasLimit
+	DefaultBatchFulfillmentGasLimit uint64 = 2_500_000
+)
+
+var (
+	promSubscriptionBalance = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "vrf_v2_subscription_balance",
+		Help: "The LINK balance, in juels, of a VRF v2 subscription with requests for the job",
+	},
+		[]string{"job_id", "sub_id"},
+	)
+	promSubscriptionRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "vrf_v2_subscription_requests",
+		Help: "The number of requests of a VRF v2 subscription received by the job",
+	},
+		[]string{"job_id", "sub_id"},
+	)
+	promSubscriptionPendingRequests = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "vrf_v2_subscription_pending_requests",
+		Help: "The number of requests of a VRF v2 subscription which the job has yet to fulfill",
+	},
+		[]string{"job_id", "sub_id"},
+	)
+	promReorgedRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "vrf_v2_reorged_requests",
+		Help: "The number of confirmed requests which were no longer on the canonical chain, and so were not fulfilled",
+	},
+		[]string{"job_id"},
+	)
+	promRefulfilledRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "vrf_v2_refulfilled_requests",
+		Help: "The number of requests fulfilled again because their fulfillment was reorged out",
+	},
+		[]string{"job_id"},
+	)
+	promFulfillmentLatency = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "vrf_v2_fulfillment_latency_blocks",
+		Help:    "The number of blocks from a request to the block its fulfillment by the job landed in",
+		Buckets: prometheus.ExponentialBuckets(1, 2, 10),
+	},
+		[]string{"job_id", "contract_address"},
+	)
+)
+
+var (
+	_ log.Listener = &listenerV2{}
+	_ job.Service  = &listenerV2{}
+)
+
+type pendingRequestV2 struct {
+	confirmedAtBlock uint64
+	req              *vrf_coordinator_v2.VRFCoordinatorV2RandomWordsRequested
+	lb               log.Broadcast
+	proof            *pendingProof
+	// refulfill is true if the fulfillment of the request was reorged out,
+	// in which case its log was already consumed
+	refulfill bool
+}
+
+// pendingProof is the proof of a request, which is generated as soon as the
+// request is received so that it is usually ready once the request is
+// confirmed
+type pendingProof struct {
+	done  chan struct{}
+	proof vrf_coordinator_v2.VRFProof
+	err   error
+}
+
+// fulfillmentV2 is the proof and commitment which fulfill a request
+type fulfillmentV2 struct {
+	req   pendingRequestV2
+	proof vrf_coordinator_v2.VRFProof
+	rc    vrf_coordinator_v2.VRFCoordinatorV2RequestCommitment
+}
+
+// gasLimit is the gas limit of a transaction fulfilling only f
+func (f fulfillmentV2) gasLimit() uint64 {
+	return uint64(f.rc.CallbackGasLimit) + GasProofVerification
+}
+
+func (f fulfillmentV2) requestID() common.Hash {
+	return common.BigToHash(f.req.req.RequestId)
+}
+
+// listenerV2 fulfills the requests of a VRFCoordinatorV2, which are paid for by
+// subscriptions instead of by the requests themselves
+type listenerV2 struct {
+	utils.StartStopOnce
+
+	cfg              Config
+	l                logger.Logger
+	abi              abi.ABI
+	batchABI         abi.ABI
+	logBroadcaster   log.Broadcaster
+	ethClient        eth.Client
+	coordinator      *vrf_coordinator_v2.VRFCoordinatorV2
+	batchCoordinator *batch_vrf_coordinator_v2.BatchVRFCoordinatorV2
+	job              job.Job
+	db               *gorm.DB
+	headBroadcaster  httypes.HeadBroadcasterRegistry
+	txm              bulletprooftxmanager.TxManager
+	vrfks            *keystore.VRF
+	gethks           GethKeyStore
+	proofs           *proofWorkerPool
+	reqLogs          *utils.Mailbox
+	chStop           chan struct{}
+	waitOnStop       chan struct{}
+	newHead          chan struct{}
+	latestHead       uint64
+	latestHeadMu     sync.RWMutex
+	// Requests are kept in memory until they are confirmed, since their logs
+	// are only marked consumed once their fulfillments are sent.
+	reqsMu   sync.Mutex
+	reqs     []pendingRequestV2
+	reqAdded func() // A simple debug helper
+	// pendingSubs are the subscriptions with pending requests, as of the
+	// last time they were reported
+	pendingSubs map[uint64]struct{}
+	sent        *sentFulfillmentsV2
+}
+
+func (lsn *listenerV2) Connect(head *models.Head) error {
+	return nil
+}
+
+// OnNewLongestChain complies with httypes.HeadTrackable
+func (lsn *listenerV2) OnNewLongestChain(_ context.Context, head models.Head) {
+	lsn.latestHeadMu.Lock()
+	if num := uint64(head.Number); num > lsn.latestHead {
+		lsn.latestHead = num
+	}
+	lsn.latestHeadMu.Unlock()
+	select {
+	case lsn.newHead <- struct{}{}:
+	default:
+	}
+}
+
+func (lsn *listenerV2) getLatestHead() uint64 {
+	lsn.latestHeadMu.RLock()
+	defer lsn.latestHeadMu.RUnlock()
+	return lsn.latestHead
+}
+
+func (lsn *listenerV2) minConfirmations() uint32 {
+	minConfs := lsn.cfg.MinIncomingConfirmations()
+	if lsn.job.VRFSpec.Confirmations > minConfs {
+		minConfs = lsn.job.VRFSpec.Confirmations
+	}
+	return minConfs
+}
+
+// Start complies with job.Service
+func (lsn *listenerV2) Start() error {
+	return lsn.StartOnce("VRFListenerV2", func() error {
+		keyHash := lsn.job.VRFSpec.PublicKey.MustHash()
+		unsubscribeLogs := lsn.logBroadcaster.Register(lsn, log.ListenerOpts{
+			Contract: lsn.coordinator.Address(),
+			ParseLog: lsn.coordinator.ParseLog,
+			LogsWithTopics: map[common.Hash][][]log.Topic{
+				vrf_coordinator_v2.VRFCoordinatorV2RandomWordsRequested{}.Topic(): {
+					{log.Topic(keyHash)},
+				},
+				vrf_coordinator_v2.VRFCoordinatorV2RandomWordsFulfilled{}.Topic(): {},
+			},
+			// As with the VRFCoordinator, logs are received one block early so
+			// that they are pending by the time they are confirmed.
+			NumConfirmations: uint64(lsn.minConfirmations() - 1),
+		})
+		lsn.proofs.start()
+		latestHead, unsubscribeHeadBroadcaster := lsn.headBroadcaster.Subscribe(lsn)
+		if latestHead != nil {
+			lsn.OnNewLongestChain(context.Background(), *latestHead)
+		}
+		go gracefulpanic.WrapRecover(func() {
+			lsn.runLogListener(unsubscribeLogs)
+		})
+		go gracefulpanic.WrapRecover(func() {
+			lsn.runHeadListener(unsubscribeHeadBroadcaster)
+		})
+		return nil
+	})
+}
+
+func (lsn *listenerV2) runLogListener(unsubscribe func()) {
+	lsn.l.Infow("VRFListenerV2: listening for run requests", "minConfs", lsn.minConfirmations())
+	for {
+		select {
+		case <-lsn.chStop:
+			unsubscribe()
+			lsn.waitOnStop <- struct{}{}
+			return
+		case <-lsn.reqLogs.Notify():
+			for {
+				i, exists := lsn.reqLogs.Retrieve()
+				if !exists {
+					break
+				}
+				lb, ok := i.(log.Broadcast)
+				if !ok {
+					panic(fmt.Sprintf("VRFListenerV2: invariant violated, expected log.Broadcast got %T", i))
+				}
+				lsn.handleLog(lb)
+			}
+		}
+	}
+}
+
+func (lsn *listenerV2) runHeadListener(unsubscribe func()) {
+	for {
+		select {
+		case <-lsn.chStop:
+			unsubscribe()
+			lsn.waitOnStop <- struct{}{}
+			return
+		case <-lsn.newHead:
+			reqs := lsn.extractConfirmedRequests()
+			reqs = append(reqs, lsn.reorgedFulfillments()...)
+			lsn.processRequests(reqs)
+		}
+	}
+}
+
+func (lsn *listenerV2) handleLog(lb log.Broadcast) {
+	if v, ok := lb.DecodedLog().(*vrf_coordinator_v2.VRFCoordinatorV2RandomWordsFulfilled); ok {
+		if !lsn.shouldProcessLog(lb) {
+			return
+		}
+		lsn.l.Debugw("VRFListenerV2: request fulfilled", "reqID", v.RequestId, "success", v.Success, "txHash", v.Raw.TxHash)
+		lsn.markLogAsConsumed(lb)
+		if r, first := lsn.sent.fulfilled(common.BigToHash(v.RequestId), v.Raw.BlockNumber, v.Raw.BlockHash); first && v.Raw.BlockNumber >= r.req.Raw.BlockNumber {
+			promFulfillmentLatency.WithLabelValues(lsn.jobIDLabel(), lsn.coordinator.Address().Hex()).Observe(float64(v.Raw.BlockNumber - r.req.Raw.BlockNumber))
+		}
+		return
+	}
+
+	req, err := lsn.coordinator.ParseRandomWordsRequested(lb.RawLog())
+	if err != nil {
+		lsn.l.Errorw("VRFListenerV2: failed to parse log", "err", err, "txHash", lb.RawLog().TxHash)
+		if !lsn.shouldProcessLog(lb) {
+			return
+		}
+		lsn.markLogAsConsumed(lb)
+		return
+	}
+
+	// The request is confirmed once both the job and the requester consider
+	// it confirmed
+	confs := uint64(lsn.minConfirmations())
+	if reqConfs := uint64(req.MinimumRequestConfirmations); reqConfs > confs {
+		confs = reqConfs
+	}
+	promSubscriptionRequests.WithLabelValues(lsn.jobIDLabel(), subIDLabel(req.SubId)).Inc()
+	confirmedAtBlock := req.Raw.BlockNumber + confs
+	p := lsn.pregenerateProof(req, confirmedAtBlock)
+	lsn.reqsMu.Lock()
+	lsn.reqs = append(lsn.reqs, pendingRequestV2{
+		confirmedAtBlock: confirmedAtBlock,
+		req:              req,
+		lb:               lb,
+		proof:            p,
+	})
+	lsn.reqAdded()
+	lsn.reqsMu.Unlock()
+}
+
+// extractConfirmedRequests removes and returns the confirmed requests from the
+// pending requests
+func (lsn *listenerV2) extractConfirmedRequests() []pendingRequestV2 {
+	lsn.reqsMu.Lock()
+	defer lsn.reqsMu.Unlock()
+	var toProcess, toKeep []pendingRequestV2
+	latestHead := lsn.getLatestHead()
+	for _, r := range lsn.reqs {
+		if r.confirmedAtBlock <= latestHead {
+			toProcess = append(toProcess, r)
+		} else {
+			toKeep = append(toKeep, r)
+		}
+	}
+	lsn.reqs = toKeep
+	return toProcess
+}
+
+// requeue puts back requests which could not be fulfilled yet, so that they
+// are retried on the next head
+func (lsn *listenerV2) requeue(reqs []pendingRequestV2) {
+	if len(reqs) == 0 {
+		return
+	}
+	lsn.reqsMu.Lock()
+	defer lsn.reqsMu.Unlock()
+	lsn.reqs = append(lsn.reqs, reqs...)
+}
+
+// processRequests fulfills the confirmed requests, either one transaction per
+// request or in batches
+func (lsn *listenerV2) processRequests(reqs []pendingRequestV2) {
+	defer lsn.updatePendingRequests()
+	if len(reqs) == 0 {
+		return
+	}
+
+	ctx, cancel := utils.ContextFromChan(lsn.chStop)
+	defer cancel()
+
+	var fulfillments []fulfillmentV2
+	var unfunded, deferred []pendingRequestV2
+	balances := make(map[uint64]*big.Int)
+	gasPrice := lsn.currentGasPrice()
+	isCanonical := lsn.canonicalChecker(ctx)
+	for _, r := range reqs {
+		if !r.refulfill && !lsn.shouldProcessLog(r.lb) {
+			continue
+		}
+		// The request is re-validated at its confirmation depth, since the
+		// proof is only valid for the block hash of the request
+		if !isCanonical(r.req.Raw.BlockNumber, r.req.Raw.BlockHash) {
+			lsn.l.Warnw("VRFListenerV2: request is no longer on the canonical chain, not fulfilling it",
+				"reqID", r.req.RequestId, "txHash", r.req.Raw.TxHash, "blockNumber", r.req.Raw.BlockNumber, "blockHash", r.req.Raw.BlockHash)
+			promReorgedRequests.WithLabelValues(lsn.jobIDLabel()).Inc()
+			continue
+		}
+		// As with the VRFCoordinator, the commitment is only checked once the
+		// request is confirmed so that a reorged fulfillment is not missed.
+		// The commitment is deleted when the request is fulfilled.
+		commitment, err := lsn.coordinator.GetCommitment(nil, r.req.RequestId)
+		if err != nil {
+			lsn.l.Errorw("VRFListenerV2: unable to check if already fulfilled, processing anyways", "err", err, "txHash", r.req.Raw.TxHash)
+		} else if utils.IsEmpty(commitment[:]) {
+			lsn.l.Infow("VRFListenerV2: request already fulfilled", "txHash", r.req.Raw.TxHash, "reqID", r.req.RequestId)
+			if !r.refulfill {
+				lsn.markLogAsConsumed(r.lb)
+			}
+			lsn.forgetDeferredFulfillment(r)
+			continue
+		}
+
+		balance, exists := balances[r.req.SubId]
+		if !exists {
+			balance = lsn.checkSubscriptionBalance(r.req.SubId)
+			balances[r.req.SubId] = balance
+		}
+		if balance != nil && balance.Sign() == 0 {
+			lsn.l.Warnw("VRFListenerV2: subscription has no balance, not fulfilling request until it is funded", "subID", r.req.SubId, "reqID", r.req.RequestId)
+			unfunded = append(unfunded, r)
+			continue
+		}
+
+		if lsn.deferFulfillment(ctx, r, gasPrice) {
+			deferred = append(deferred, r)
+			continue
+		}
+
+		f, err := lsn.awaitFulfillment(ctx, r)
+		if err != nil {
+			// The log is left unconsumed, so the request is retried once the
+			// log is broadcast again
+			lsn.l.Errorw("VRFListenerV2: unable to generate proof", "err", err, "txHash", r.req.Raw.TxHash, "reqID", r.req.RequestId)
+			continue
+		}
+		fulfillments = append(fulfillments, f)
+	}
+	lsn.requeue(unfunded)
+	lsn.requeue(deferred)
+
+	if !lsn.job.VRFSpec.BatchFulfillmentEnabled {
+		for _, f := range fulfillments {
+			lsn.sendFulfillments([]fulfillmentV2{f})
+		}
+		return
+	}
+	for _, batch := range batchFulfillments(fulfillments, lsn.job.VRFSpec.BatchFulfillmentGasLimit) {
+		lsn.sendFulfillments(batch)
+	}
+}
+
+// checkSubscriptionBalance returns the balance of the subscription and reports
+// it, or nil if it could not be fetched
+func (lsn *listenerV2) checkSubscriptionBalance(subID uint64) *big.Int {
+	sub, err := lsn.coordinator.GetSubscription(nil, subID)
+	if err != nil {
+		lsn.l.Errorw("VRFListenerV2: unable to get subscription", "err", err, "subID", subID)
+		return nil
+	}
+	balance, _ := new(big.Float).SetInt(sub.Balance).Float64()
+	promSubscriptionBalance.WithLabelValues(lsn.jobIDLabel(), subIDLabel(subID)).Set(balance)
+	if min := lsn.job.VRFSpec.MinSubscriptionBalance; min != nil && sub.Balance.Cmp(min.ToInt()) < 0 {
+		lsn.l.Warnw("VRFListenerV2: subscription balance is below minSubscriptionBalanceLinkJuels",
+			"subID", subID, "balance", sub.Balance, "minSubscriptionBalance", min, "owner", sub.Owner)
+	}
+	return sub.Balance
+}
+
+// reorgedFulfillments returns the requests whose fulfillments were reorged
+// out while the requests were not, so that they are fulfilled again
+func (lsn *listenerV2) reorgedFulfillments() []pendingRequestV2 {
+	ctx, cancel := utils.ContextFromChan(lsn.chStop)
+	defer cancel()
+	reorged := lsn.sent.reorged(lsn.getLatestHead(), uint64(lsn.cfg.EthFinalityDepth()), lsn.canonicalChecker(ctx))
+	for i := range reorged {
+		lsn.l.Warnw("VRFListenerV2: fulfillment was reorged out, fulfilling request again",
+			"reqID", reorged[i].req.RequestId, "txHash", reorged[i].req.Raw.TxHash)
+		promRefulfilledRequests.WithLabelValues(lsn.jobIDLabel()).Inc()
+		reorged[i].refulfill = true
+	}
+	return reorged
+}
+
+// canonicalChecker returns a function reporting if the block of the given
+// number and hash is on the canonical chain. Blocks whose canonical hash
+// cannot be fetched are assumed to be canonical.
+func (lsn *listenerV2) canonicalChecker(ctx context.Context) func(number uint64, hash common.Hash) bool {
+	hashes := make(map[uint64]common.Hash)
+	return func(number uint64, hash common.Hash) bool {
+		canonical, exists := hashes[number]
+		if !exists {
+			head, err := lsn.ethClient.HeadByNumber(ctx, new(big.Int).SetUint64(number))
+			if err != nil || head == nil {
+				lsn.l.Errorw("VRFListenerV2: unable to get canonical block, assuming it is unchanged", "err", err, "blockNumber", number)
+				return true
+			}
+			canonical = head.Hash
+			hashes[number] = canonical
+		}
+		return canonical == hash
+	}
+}
+
+// currentGasPrice returns the gas price fulfillments would be sent at, or nil
+// if the job does not defer fulfillments or the gas price is not known yet
+func (lsn *listenerV2) currentGasPrice() *big.Int {
+	if lsn.job.VRFSpec.MaxGasPrice == nil {
+		return nil
+	}
+	return lsn.txm.GetGasEstimator().CurrentEstimate().GasPrice
+}
+
+// deferFulfillment returns true if the fulfillment of the request should wait
+// for the gas price to fall, in which case the request is recorded as deferred
+func (lsn *listenerV2) deferFulfillment(ctx context.Context, r pendingRequestV2, gasPrice *big.Int) bool {
+	spec := lsn.job.VRFSpec
+	deadline := r.req.Raw.BlockNumber + spec.FulfillmentDeadlineBlocks
+	if !shouldDefer(gasPrice, spec.MaxGasPrice.ToInt(), deadline, lsn.getLatestHead()) {
+		return false
+	}
+	lsn.l.Debugw("VRFListenerV2: gas price is above maxGasPriceWei, deferring fulfillment",
+		"reqID", r.req.RequestId, "gasPrice", gasPrice, "maxGasPrice", spec.MaxGasPrice, "deadlineBlockNumber", deadline)
+	err := recordDeferredFulfillment(ctx, lsn.db, DeferredFulfillment{
+		JobID:               lsn.job.ID,
+		RequestID:           common.BigToHash(r.req.RequestId),
+		SubID:               r.req.SubId,
+		RequestTxHash:       r.req.Raw.TxHash,
+		RequestBlockNumber:  r.req.Raw.BlockNumber,
+		DeadlineBlockNumber: deadline,
+		GasPrice:            *utils.NewBig(gasPrice),
+	})
+	lsn.l.ErrorIf(errors.Wrap(err, "VRFListenerV2: unable to record deferred fulfillment"))
+	return true
+}
+
+// forgetDeferredFulfillment removes a request which was fulfilled by someone
+// else from the deferred fulfillments
+func (lsn *listenerV2) forgetDeferredFulfillment(r pendingRequestV2) {
+	if lsn.job.VRFSpec.MaxGasPrice == nil {
+		return
+	}
+	ctx, cancel := postgres.DefaultQueryCtx()
+	defer cancel()
+	err := deleteDeferredFulfillments(lsn.db.WithContext(ctx), lsn.job.ID, []common.Hash{common.BigToHash(r.req.RequestId)})
+	lsn.l.ErrorIf(errors.Wrap(err, "VRFListenerV2: unable to remove deferred fulfillment"))
+}
+
+// pregenerateProof queues the generation of the proof of a request, the
+// requests which are confirmed first being proven first
+func (lsn *listenerV2) pregenerateProof(req *vrf_coordinator_v2.VRFCoordinatorV2RandomWordsRequested, confirmedAtBlock uint64) *pendingProof {
+	p := &pendingProof{done: make(chan struct{})}
+	lsn.proofs.submit(confirmedAtBlock, func() {
+		defer close(p.done)
+		ctx, cancel := utils.ContextFromChan(lsn.chStop)
+		defer cancel()
+		p.proof, p.err = lsn.generateProof(ctx, req)
+	})
+	return p
+}
+
+func (lsn *listenerV2) generateProof(ctx context.Context, req *vrf_coordinator_v2.VRFCoordinatorV2RandomWordsRequested) (vrf_coordinator_v2.VRFProof, error) {
+	preSeed, err := proof.BigToSeed(req.PreSeed)
+	if err != nil {
+		return vrf_coordinator_v2.VRFProof{}, errors.Wrap(err, "unable to parse preSeed")
+	}
+	return proof.GenerateProofResponseV2(ctx, lsn.vrfks, lsn.job.VRFSpec.PublicKey, proof.PreSeedData{
+		PreSeed:   preSeed,
+		BlockHash: req.Raw.BlockHash,
+		BlockNum:  req.Raw.BlockNumber,
+	})
+}
+
+// awaitFulfillment waits for the proof of a request to be generated
+func (lsn *listenerV2) awaitFulfillment(ctx context.Context, r pendingRequestV2) (fulfillmentV2, error) {
+	select {
+	case <-r.proof.done:
+	case <-ctx.Done():
+		return fulfillmentV2{}, ctx.Err()
+	}
+	if r.proof.err != nil {
+		return fulfillmentV2{}, r.proof.err
+	}
+	return fulfillmentV2{
+		req:   r,
+		proof: r.proof.proof,
+		rc: vrf_coordinator_v2.VRFCoordinatorV2RequestCommitment{
+			BlockNum:         r.req.Raw.BlockNumber,
+			SubId:            r.req.SubId,
+			CallbackGasLimit: r.req.CallbackGasLimit,
+			NumWords:         r.req.NumWords,
+			Sender:           r.req.Sender,
+		},
+	}, nil
+}
+
+// batchFulfillments splits the fulfillments into batches whose gas limits add
+// up to at most gasLimit. A fulfillment which exceeds gasLimit on its own is
+// put in a batch of its own.
+func batchFulfillments(fulfillments []fulfillmentV2, gasLimit uint64) [][]fulfillmentV2 {
+	var batches [][]fulfillmentV2
+	var batch []fulfillmentV2
+	var batchGas uint64
+	for _, f := range fulfillments {
+		if len(batch) > 0 && batchGas+f.gasLimit() > gasLimit {
+			batches = append(batches, batch)
+			batch, batchGas = nil, 0
+		}
+		batch = append(batch, f)
+		batchGas += f.gasLimit()
+	}
+	if len(batch) > 0 {
+		batches = append(batches, batch)
+	}
+	return batches
+}
+
+// sendFulfillments queues a transaction fulfilling the requests, to the
+// coordinator if there is only one and to the batch coordinator otherwise, and
+// marks their logs consumed along with it
+func (lsn *listenerV2) sendFulfillments(fulfillments []fulfillmentV2) {
+	var (
+		to       common.Address
+		payload  []byte
+		gasLimit uint64
+		err      error
+		meta     = models.EthTxMetaV2{JobID: lsn.job.ID}
+	)
+	if len(fulfillments) == 1 {
+		f := fulfillments[0]
+		to = lsn.coordinator.Address()
+		payload, err = lsn.abi.Pack("fulfillRandomWords", f.proof, f.rc)
+		gasLimit = f.gasLimit()
+		meta.RequestID = f.requestID()
+		meta.RequestTxHash = f.req.req.Raw.TxHash
+	} else {
+		proofs := make([]batch_vrf_coordinator_v2.VRFProof, len(fulfillments))
+		rcs := make([]batch_vrf_coordinator_v2.VRFCoordinatorV2RequestCommitment, len(fulfillments))
+		for i, f := range fulfillments {
+			proofs[i] = batch_vrf_coordinator_v2.VRFProof(f.proof)
+			rcs[i] = batch_vrf_coordinator_v2.VRFCoordinatorV2RequestCommitment(f.rc)
+			gasLimit += f.gasLimit()
+			meta.RequestIDs = append(meta.RequestIDs, f.requestID())
+		}
+		to = lsn.batchCoordinator.Address()
+		payload, err = lsn.batchABI.Pack("fulfillRandomWords", proofs, rcs)
+	}
+	if err != nil {
+		lsn.l.Errorw("VRFListenerV2: unable to pack fulfillment", "err", err, "numRequests", len(fulfillments))
+		return
+	}
+
+	from, err := lsn.gethks.GetRoundRobinAddress(lsn.job.VRFSpec.FromAddress.Address())
+	if err != nil {
+		lsn.l.Errorw("VRFListenerV2: unable to get fromAddress", "err", err)
+		return
+	}
+	strategy := bulletprooftxmanager.NewSendEveryStrategy(lsn.job.ExternalJobID)
+	err = postgres.GormTransactionWithDefaultContext(lsn.db, func(tx *gorm.DB) error {
+		etx, err := lsn.txm.CreateEthTransaction(tx, from, to, payload, gasLimit, &meta, strategy, bulletprooftxmanager.EthTxUrgencyNormal, bulletprooftxmanager.EthTxExpiry{}, true)
+		if err != nil {
+			return errors.Wrap(err, "VRFListenerV2: failed to create fulfillment transaction")
+		}
+		lsn.l.Infow("VRFListenerV2: queued fulfillment", "ethTxID", etx.ID, "numRequests", len(fulfillments), "gasLimit", gasLimit)
+		requestIDs := make([]common.Hash, len(fulfillments))
+		for i, f := range fulfillments {
+			requestIDs[i] = f.requestID()
+			if f.req.refulfill {
+				continue
+			}
+			if err = lsn.logBroadcaster.MarkConsumed(tx, f.req.lb); err != nil {
+				return err
+			}
+		}
+		if lsn.job.VRFSpec.MaxGasPrice == nil {
+			return nil
+		}
+		return deleteDeferredFulfillments(tx, lsn.job.ID, requestIDs)
+	})
+	if err != nil {
+		lsn.l.Errorw("VRFListenerV2: failed to send fulfillment", "err", err, "numRequests", len(fulfillments))
+		return
+	}
+	for _, f := range fulfillments {
+		lsn.sent.add(f.req)
+	}
+}
+
+// updatePendingRequests reports the number of pending requests of every
+// subscription
+func (lsn *listenerV2) updatePendingRequests() {
+	lsn.reqsMu.Lock()
+	defer lsn.reqsMu.Unlock()
+	pending := make(map[uint64]int)
+	for _, r := range lsn.reqs {
+		pending[r.req.SubId]++
+	}
+	// Subscriptions whose requests were all fulfilled are reported as having
+	// none pending
+	for subID := range lsn.pendingSubs {
+		if _, exists := pending[subID]; !exists {
+			promSubscriptionPendingRequests.WithLabelValues(lsn.jobIDLabel(), subIDLabel(subID)).Set(0)
+		}
+	}
+	lsn.pendingSubs = make(map[uint64]struct{}, len(pending))
+	for subID, count := range pending {
+		promSubscriptionPendingRequests.WithLabelValues(lsn.jobIDLabel(), subIDLabel(subID)).Set(float64(count))
+		lsn.pendingSubs[subID] = struct{}{}
+	}
+}
+
+func (lsn *listenerV2) shouldProcessLog(lb log.Broadcast) bool {
+	ctx, cancel := postgres.DefaultQueryCtx()
+	defer cancel()
+	consumed, err := lsn.logBroadcaster.WasAlreadyConsumed(lsn.db.WithContext(ctx), lb)
+	if err != nil {
+		lsn.l.Errorw("VRFListenerV2: could not determine if log was already consumed", "error", err, "txHash", lb.RawLog().TxHash)
+		return false
+	}
+	return !consumed
+}
+
+func (lsn *listenerV2) markLogAsConsumed(lb log.Broadcast) {
+	ctx, cancel := postgres.DefaultQueryCtx()
+	defer cancel()
+	err := lsn.logBroadcaster.MarkConsumed(lsn.db.WithContext(ctx), lb)
+	lsn.l.ErrorIf(errors.Wrapf(err, "VRFListenerV2: unable to mark log %v as consumed", lb.String()))
+}
+
+func (lsn *listenerV2) jobIDLabel() string {
+	return fmt.Sprintf("%d", lsn.job.ID)
+}
+
+func subIDLabel(subID uint64) string {
+	return fmt.Sprintf("%d", subID)
+}
+
+// Close complies with job.Service
+func (lsn *listenerV2) Close() error {
+	return lsn.StopOnce("VRFListenerV2", func() error {
+		close(lsn.chStop)
+		<-lsn.waitOnStop // Log listener
+		<-lsn.waitOnStop // Head listener
+		lsn.proofs.stop()
+		return nil
+	})
+}
+
+// HandleLog complies with log.Listener
+func (lsn *listenerV2) HandleLog(lb log.Broadcast) {
+	wasOverCapacity := lsn.reqLogs.Deliver(lb)
+	if wasOverCapacity {
+		lsn.l.Error("VRFListenerV2: log mailbox is over capacity - dropped the oldest log")
+	}
+}
+
+// JobID complies with log.Listener
+func (*listenerV2) JobID() models.JobID {
+	return models.NilJobID
+}
+
+// JobIDV2 complies with log.Listener
+func (lsn *listenerV2) JobIDV2() int32 {
+	return lsn.job.ID
+}
+
+// IsV2Job complies with log.Listener
+func (*listenerV2) IsV2Job() bool {
+	return true
+}
@@ -0,0 +1,74 @@
+package vrf
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/smartcontractkit/chainlink/core/internal/gethwrappers/generated/vrf_coordinator_v2"
+)
+
+func fulfillmentWithCallbackGasLimit(callbackGasLimit uint32) fulfillmentV2 {
+	return fulfillmentV2{rc: vrf_coordinator_v2.VRFCoordinatorV2RequestCommitment{CallbackGasLimit: callbackGasLimit}}
+}
+
+func callbackGasLimits(batches [][]fulfillmentV2) (limits [][]uint32) {
+	for _, batch := range batches {
+		var batchLimits []uint32
+		for _, f := range batch {
+			batchLimits = append(batchLimits, f.rc.CallbackGasLimit)
+		}
+		limits = append(limits, batchLimits)
+	}
+	return limits
+}
+
+func TestBatchFulfillments(t *testing.T) {
+	t.Parallel()
+
+	fulfillments := []fulfillmentV2{
+		fulfillmentWithCallbackGasLimit(100_000),
+		fulfillmentWithCallbackGasLimit(300_000),
+		fulfillmentWithCallbackGasLimit(200_000),
+		fulfillmentWithCallbackGasLimit(2_000_000),
+		fulfillmentWithCallbackGasLimit(50_000),
+	}
+
+	// 300k + 500k + 400k fit in 1.2M, the 2.2M fulfillment exceeds the limit
+	// on its own
+	batches := batchFulfillments(fulfillments, 1_200_000)
+	assert.Equal(t, [][]uint32{{100_000, 300_000, 200_000}, {2_000_000}, {50_000}}, callbackGasLimits(batches))
+
+	batches = batchFulfillments(fulfillments, 0)
+	assert.Len(t, batches, len(fulfillments))
+
+	assert.Empty(t, batchFulfillments(nil, DefaultBatchFulfillmentGasLimit))
+}
+
+func TestShouldDefer(t *testing.T) {
+	t.Parallel()
+
+	maxGasPrice := big.NewInt(100)
+	tests := []struct {
+		name        string
+		gasPrice    *big.Int
+		maxGasPrice *big.Int
+		latestHead  uint64
+		deferred    bool
+	}{
+		{"gas price above max", big.NewInt(101), maxGasPrice, 10, true},
+		{"gas price at max", big.NewInt(100), maxGasPrice, 10, false},
+		{"gas price below max", big.NewInt(50), maxGasPrice, 10, false},
+		{"deadline reached", big.NewInt(101), maxGasPrice, 20, false},
+		{"deadline passed", big.NewInt(101), maxGasPrice, 30, false},
+		{"gas price unknown", nil, maxGasPrice, 10, false},
+		{"deferral disabled", big.NewInt(101), nil, 10, false},
+	}
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.deferred, shouldDefer(tc.gasPrice, tc.maxGasPrice, 20, tc.latestHead))
+		})
+	}
+}
@@ -4,6 +4,8 @@ package proof
 // block in which a VRF request appeared
 
 import (
+	"context"
+
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/pkg/errors"
 	"github.com/smartcontractkit/chainlink/core/services/keystore"
@@ -96,10 +98,10 @@ func GenerateProofResponseFromProof(proof vrfkey.Proof, s PreSeedData) (Marshale
 	return rv, nil
 }
 
-func GenerateProofResponse(keystore *keystore.VRF, key secp256k1.PublicKey, s PreSeedData) (
+func GenerateProofResponse(ctx context.Context, keystore *keystore.VRF, key secp256k1.PublicKey, s PreSeedData) (
 	MarshaledOnChainResponse, error) {
 	seed := FinalSeed(s)
-	proof, err := keystore.GenerateProof(key, seed)
+	proof, err := keystore.GenerateProof(ctx, key, seed)
 	if err != nil {
 		return MarshaledOnChainResponse{}, err
 	}
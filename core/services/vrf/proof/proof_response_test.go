@@ -1,6 +1,7 @@
 package proof_test
 
 import (
+	"context"
 	"fmt"
 	"math/big"
 	"testing"
@@ -31,7 +32,7 @@ func TestMarshaledProof(t *testing.T) {
 	blockNum := 0
 	preSeed := big.NewInt(1)
 	s := proof2.TestXXXSeedData(t, preSeed, blockHash, blockNum)
-	proofResponse, err := proof2.GenerateProofResponse(keyStore.VRF(), key.PublicKey, s)
+	proofResponse, err := proof2.GenerateProofResponse(context.Background(), keyStore.VRF(), key.PublicKey, s)
 	require.NoError(t, err)
 	goProof, err := proof2.UnmarshalProofResponse(proofResponse)
 	require.NoError(t, err)
@@ -0,0 +1,53 @@
+package proof
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/pkg/errors"
+	"github.com/smartcontractkit/chainlink/core/internal/gethwrappers/generated/vrf_coordinator_v2"
+	"github.com/smartcontractkit/chainlink/core/services/keystore"
+	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/vrfkey"
+	"github.com/smartcontractkit/chainlink/core/services/signatures/secp256k1"
+	"go.dedis.ch/kyber/v3"
+)
+
+// GenerateProofResponseFromProofV2 returns proof in the format expected by the
+// fulfillRandomWords method of the VRFCoordinatorV2. As with the
+// VRFCoordinator, the seed of the proof is replaced with the pre-seed, from
+// which the VRFCoordinatorV2 computes the final seed itself.
+func GenerateProofResponseFromProofV2(proof vrfkey.Proof, s PreSeedData) (vrf_coordinator_v2.VRFProof, error) {
+	solidityProof, err := SolidityPrecalculations(&proof)
+	if err != nil {
+		return vrf_coordinator_v2.VRFProof{}, errors.Wrap(err,
+			"while marshaling proof for VRFCoordinatorV2")
+	}
+	return vrf_coordinator_v2.VRFProof{
+		Pk:            coordinates(proof.PublicKey),
+		Gamma:         coordinates(proof.Gamma),
+		C:             proof.C,
+		S:             proof.S,
+		Seed:          s.PreSeed.Big(),
+		UWitness:      solidityProof.UWitness,
+		CGammaWitness: coordinates(solidityProof.CGammaWitness),
+		SHashWitness:  coordinates(solidityProof.SHashWitness),
+		ZInv:          solidityProof.ZInv,
+	}, nil
+}
+
+// GenerateProofResponseV2 generates the proof of the request with the given
+// pre-seed data, in the format expected by the VRFCoordinatorV2
+func GenerateProofResponseV2(ctx context.Context, keystore *keystore.VRF, key secp256k1.PublicKey, s PreSeedData) (
+	vrf_coordinator_v2.VRFProof, error) {
+	seed := FinalSeed(s)
+	proof, err := keystore.GenerateProof(ctx, key, seed)
+	if err != nil {
+		return vrf_coordinator_v2.VRFProof{}, err
+	}
+	return GenerateProofResponseFromProofV2(proof, s)
+}
+
+func coordinates(p kyber.Point) [2]*big.Int {
+	x, y := secp256k1.Coordinates(p)
+	return [2]*big.Int{x, y}
+}
@@ -0,0 +1,38 @@
+package proof_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/vrfkey"
+	proof2 "github.com/smartcontractkit/chainlink/core/services/vrf/proof"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+func TestGenerateProofResponseFromProofV2(t *testing.T) {
+	key := vrfkey.NewPrivateKeyXXXTestingOnly(big.NewInt(1))
+	s := proof2.TestXXXSeedData(t, big.NewInt(1), common.HexToHash("0x1234"), 10)
+	p, err := key.GenerateProof(proof2.FinalSeed(s))
+	require.NoError(t, err)
+
+	v2, err := proof2.GenerateProofResponseFromProofV2(p, s)
+	require.NoError(t, err)
+	require.Equal(t, s.PreSeed.Big(), v2.Seed)
+
+	// The fields of the v2 proof are the words of the v1 proof, in order
+	v1, err := proof2.GenerateProofResponseFromProof(p, s)
+	require.NoError(t, err)
+	var words []byte
+	for _, w := range []*big.Int{
+		v2.Pk[0], v2.Pk[1], v2.Gamma[0], v2.Gamma[1], v2.C, v2.S, v2.Seed,
+		new(big.Int).SetBytes(v2.UWitness.Bytes()),
+		v2.CGammaWitness[0], v2.CGammaWitness[1], v2.SHashWitness[0], v2.SHashWitness[1],
+		v2.ZInv,
+	} {
+		words = append(words, utils.Uint256ToBytes32(w)...)
+	}
+	require.Equal(t, v1[:proof2.ProofLength], words)
+}
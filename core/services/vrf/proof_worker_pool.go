@@ -0,0 +1,149 @@
+package vrf
+
+import (
+	"container/heap"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/smartcontractkit/chainlink/core/gracefulpanic"
+)
+
+var (
+	promProofQueueTime = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "vrf_proof_queue_time_seconds",
+		Help:    "How long VRF proofs waited for a free worker before being generated",
+		Buckets: prometheus.ExponentialBuckets(0.001, 4, 10),
+	},
+		[]string{"job_id"},
+	)
+	promProofGenerationTime = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "vrf_proof_generation_time_seconds",
+		Help:    "How long VRF proofs took to generate",
+		Buckets: prometheus.ExponentialBuckets(0.001, 4, 10),
+	},
+		[]string{"job_id"},
+	)
+)
+
+// proofTask is a proof waiting to be generated. Tasks with the earliest
+// deadline are generated first, in the order they were submitted.
+type proofTask struct {
+	deadline    uint64
+	seq         uint64
+	submittedAt time.Time
+	generate    func()
+}
+
+type proofTaskHeap []proofTask
+
+func (h proofTaskHeap) Len() int { return len(h) }
+func (h proofTaskHeap) Less(i, j int) bool {
+	if h[i].deadline != h[j].deadline {
+		return h[i].deadline < h[j].deadline
+	}
+	return h[i].seq < h[j].seq
+}
+func (h proofTaskHeap) Swap(i, j int)       { h[i], h[j] = h[j], h[i] }
+func (h *proofTaskHeap) Push(x interface{}) { *h = append(*h, x.(proofTask)) }
+func (h *proofTaskHeap) Pop() interface{} {
+	old := *h
+	n := len(old)
+	t := old[n-1]
+	*h = old[:n-1]
+	return t
+}
+
+// proofWorkerPool generates proofs on a fixed number of workers, so that
+// proof generation happens in parallel and off the goroutines handling logs
+// and heads
+type proofWorkerPool struct {
+	jobIDLabel string
+	workers    int
+
+	mu    sync.Mutex
+	tasks proofTaskHeap
+	seq   uint64
+
+	wake   chan struct{}
+	chStop chan struct{}
+	wg     sync.WaitGroup
+}
+
+func newProofWorkerPool(workers uint64, jobIDLabel string) *proofWorkerPool {
+	if workers == 0 {
+		workers = 1
+	}
+	return &proofWorkerPool{
+		jobIDLabel: jobIDLabel,
+		workers:    int(workers),
+		wake:       make(chan struct{}, workers),
+		chStop:     make(chan struct{}),
+	}
+}
+
+func (p *proofWorkerPool) start() {
+	p.wg.Add(p.workers)
+	for i := 0; i < p.workers; i++ {
+		go gracefulpanic.WrapRecover(p.runWorker)
+	}
+}
+
+// stop waits for the proofs being generated, the queued ones are dropped
+func (p *proofWorkerPool) stop() {
+	close(p.chStop)
+	p.wg.Wait()
+}
+
+// submit queues generate to run on the first free worker, before the tasks
+// with a later deadline
+func (p *proofWorkerPool) submit(deadline uint64, generate func()) {
+	p.mu.Lock()
+	p.seq++
+	heap.Push(&p.tasks, proofTask{
+		deadline:    deadline,
+		seq:         p.seq,
+		submittedAt: time.Now(),
+		generate:    generate,
+	})
+	p.mu.Unlock()
+	select {
+	case p.wake <- struct{}{}:
+	default:
+	}
+}
+
+func (p *proofWorkerPool) next() (proofTask, bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.tasks.Len() == 0 {
+		return proofTask{}, false
+	}
+	return heap.Pop(&p.tasks).(proofTask), true
+}
+
+func (p *proofWorkerPool) runWorker() {
+	defer p.wg.Done()
+	for {
+		select {
+		case <-p.chStop:
+			return
+		default:
+		}
+		task, exists := p.next()
+		if !exists {
+			select {
+			case <-p.chStop:
+				return
+			case <-p.wake:
+			}
+			continue
+		}
+		start := time.Now()
+		promProofQueueTime.WithLabelValues(p.jobIDLabel).Observe(start.Sub(task.submittedAt).Seconds())
+		task.generate()
+		promProofGenerationTime.WithLabelValues(p.jobIDLabel).Observe(time.Since(start).Seconds())
+	}
+}
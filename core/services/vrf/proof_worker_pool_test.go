@@ -0,0 +1,74 @@
+package vrf
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProofWorkerPool_GeneratesEarliestDeadlineFirst(t *testing.T) {
+	t.Parallel()
+
+	pool := newProofWorkerPool(1, "1")
+	pool.start()
+	defer pool.stop()
+
+	// Occupy the only worker so that the other tasks queue up
+	release := make(chan struct{})
+	started := make(chan struct{})
+	pool.submit(0, func() {
+		close(started)
+		<-release
+	})
+	<-started
+
+	var mu sync.Mutex
+	var order []uint64
+	var wg sync.WaitGroup
+	for _, deadline := range []uint64{30, 10, 20, 10} {
+		deadline := deadline
+		wg.Add(1)
+		pool.submit(deadline, func() {
+			defer wg.Done()
+			mu.Lock()
+			defer mu.Unlock()
+			order = append(order, deadline)
+		})
+	}
+	close(release)
+	wg.Wait()
+
+	assert.Equal(t, []uint64{10, 10, 20, 30}, order)
+}
+
+func TestProofWorkerPool_GeneratesInParallel(t *testing.T) {
+	t.Parallel()
+
+	const workers = 3
+	pool := newProofWorkerPool(workers, "1")
+	pool.start()
+	defer pool.stop()
+
+	// Every task waits for all of them to be running, which only happens if
+	// they run on separate workers
+	var running sync.WaitGroup
+	running.Add(workers)
+	done := make(chan struct{}, workers)
+	for i := 0; i < workers; i++ {
+		pool.submit(uint64(i), func() {
+			running.Done()
+			running.Wait()
+			done <- struct{}{}
+		})
+	}
+	for i := 0; i < workers; i++ {
+		select {
+		case <-done:
+		case <-time.After(5 * time.Second):
+			require.FailNow(t, "proofs were not generated in parallel")
+		}
+	}
+}
@@ -0,0 +1,103 @@
+package vrf
+
+import (
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// blockhashWindow is the number of most recent blocks whose hashes are
+// available to solidity, beyond which a request can no longer be proven
+const blockhashWindow = 256
+
+// sentFulfillmentV2 is a request whose fulfillment was sent by the job, along
+// with the block the fulfillment landed in once its log is received
+type sentFulfillmentV2 struct {
+	req                  pendingRequestV2
+	fulfilledBlockNumber uint64
+	fulfilledBlockHash   common.Hash
+}
+
+func (s sentFulfillmentV2) isFulfilled() bool {
+	return s.fulfilledBlockHash != (common.Hash{})
+}
+
+// sentFulfillmentsV2 tracks the fulfillments sent by a job until they are
+// final, so that requests whose fulfillments are reorged out while the
+// requests themselves are not can be fulfilled again
+type sentFulfillmentsV2 struct {
+	mu   sync.Mutex
+	sent map[common.Hash]sentFulfillmentV2
+}
+
+func newSentFulfillmentsV2() *sentFulfillmentsV2 {
+	return &sentFulfillmentsV2{sent: make(map[common.Hash]sentFulfillmentV2)}
+}
+
+// add tracks a request whose fulfillment was sent
+func (s *sentFulfillmentsV2) add(r pendingRequestV2) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.sent[common.BigToHash(r.req.RequestId)] = sentFulfillmentV2{req: r}
+}
+
+// fulfilled records the block the fulfillment of a tracked request landed in.
+// It returns the request, or false if the request is not tracked or its
+// fulfillment was already recorded.
+func (s *sentFulfillmentsV2) fulfilled(requestID common.Hash, blockNumber uint64, blockHash common.Hash) (pendingRequestV2, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	f, exists := s.sent[requestID]
+	if !exists {
+		return pendingRequestV2{}, false
+	}
+	first := !f.isFulfilled()
+	f.fulfilledBlockNumber, f.fulfilledBlockHash = blockNumber, blockHash
+	s.sent[requestID] = f
+	return f.req, first
+}
+
+// reorged stops tracking the fulfillments which are final as of latestHead,
+// or which can no longer land, and returns the requests whose fulfillments
+// were reorged out while the requests were not. Requests which were reorged
+// out as well are no longer tracked, their logs are broadcast again if they
+// reappear. isCanonical reports if the block of the given number and hash is
+// on the canonical chain.
+func (s *sentFulfillmentsV2) reorged(latestHead uint64, finalityDepth uint64, isCanonical func(number uint64, hash common.Hash) bool) (reorged []pendingRequestV2) {
+	// The canonical chain is checked without holding the lock, since it may
+	// take a call to the eth node
+	var toCheck []sentFulfillmentV2
+	s.mu.Lock()
+	for requestID, f := range s.sent {
+		if !f.isFulfilled() {
+			if latestHead >= f.req.req.Raw.BlockNumber+blockhashWindow+finalityDepth {
+				delete(s.sent, requestID)
+			}
+			continue
+		}
+		if latestHead >= f.fulfilledBlockNumber+finalityDepth {
+			delete(s.sent, requestID)
+			continue
+		}
+		toCheck = append(toCheck, f)
+	}
+	s.mu.Unlock()
+
+	var forget []common.Hash
+	for _, f := range toCheck {
+		if isCanonical(f.fulfilledBlockNumber, f.fulfilledBlockHash) {
+			continue
+		}
+		forget = append(forget, common.BigToHash(f.req.req.RequestId))
+		if isCanonical(f.req.req.Raw.BlockNumber, f.req.req.Raw.BlockHash) {
+			reorged = append(reorged, f.req)
+		}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, requestID := range forget {
+		delete(s.sent, requestID)
+	}
+	return reorged
+}
@@ -0,0 +1,85 @@
+package vrf
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/internal/gethwrappers/generated/vrf_coordinator_v2"
+)
+
+func requestInBlock(requestID int64, blockNumber uint64, blockHash common.Hash) pendingRequestV2 {
+	return pendingRequestV2{req: &vrf_coordinator_v2.VRFCoordinatorV2RandomWordsRequested{
+		RequestId: big.NewInt(requestID),
+		Raw:       types.Log{BlockNumber: blockNumber, BlockHash: blockHash},
+	}}
+}
+
+func TestSentFulfillmentsV2_Reorged(t *testing.T) {
+	t.Parallel()
+
+	var (
+		requestBlock   = common.HexToHash("0x1")
+		fulfilledBlock = common.HexToHash("0x2")
+		reorgedBlock   = common.HexToHash("0x3")
+		finalityDepth  = uint64(50)
+	)
+	canonical := map[uint64]common.Hash{10: requestBlock, 20: fulfilledBlock}
+	isCanonical := func(number uint64, hash common.Hash) bool {
+		return canonical[number] == hash
+	}
+
+	t.Run("keeps fulfillments on the canonical chain until they are final", func(t *testing.T) {
+		sent := newSentFulfillmentsV2()
+		sent.add(requestInBlock(1, 10, requestBlock))
+		r, first := sent.fulfilled(common.BigToHash(big.NewInt(1)), 20, fulfilledBlock)
+		require.True(t, first)
+		assert.Equal(t, uint64(10), r.req.Raw.BlockNumber)
+		_, first = sent.fulfilled(common.BigToHash(big.NewInt(1)), 20, fulfilledBlock)
+		assert.False(t, first)
+		_, first = sent.fulfilled(common.BigToHash(big.NewInt(2)), 20, fulfilledBlock)
+		assert.False(t, first)
+
+		assert.Empty(t, sent.reorged(30, finalityDepth, isCanonical))
+		assert.Len(t, sent.sent, 1)
+
+		assert.Empty(t, sent.reorged(20+finalityDepth, finalityDepth, isCanonical))
+		assert.Empty(t, sent.sent)
+	})
+
+	t.Run("returns requests whose fulfillment was reorged out", func(t *testing.T) {
+		sent := newSentFulfillmentsV2()
+		sent.add(requestInBlock(1, 10, requestBlock))
+		sent.fulfilled(common.BigToHash(big.NewInt(1)), 20, reorgedBlock)
+
+		reorged := sent.reorged(30, finalityDepth, isCanonical)
+		require.Len(t, reorged, 1)
+		assert.Equal(t, big.NewInt(1), reorged[0].req.RequestId)
+		assert.Empty(t, sent.sent)
+	})
+
+	t.Run("forgets requests which were reorged out along with their fulfillment", func(t *testing.T) {
+		sent := newSentFulfillmentsV2()
+		sent.add(requestInBlock(1, 10, reorgedBlock))
+		sent.fulfilled(common.BigToHash(big.NewInt(1)), 20, reorgedBlock)
+
+		assert.Empty(t, sent.reorged(30, finalityDepth, isCanonical))
+		assert.Empty(t, sent.sent)
+	})
+
+	t.Run("forgets unfulfilled requests once they can no longer be fulfilled", func(t *testing.T) {
+		sent := newSentFulfillmentsV2()
+		sent.add(requestInBlock(1, 10, requestBlock))
+		sent.fulfilled(common.BigToHash(big.NewInt(2)), 20, fulfilledBlock)
+
+		assert.Empty(t, sent.reorged(30, finalityDepth, isCanonical))
+		assert.Len(t, sent.sent, 1)
+
+		assert.Empty(t, sent.reorged(10+blockhashWindow+finalityDepth, finalityDepth, isCanonical))
+		assert.Empty(t, sent.sent)
+	})
+}
@@ -48,8 +48,62 @@ func ValidatedVRFSpec(tomlString string) (job.Job, error) {
 	if spec.CoordinatorAddress.String() == "" {
 		return jb, errors.Wrap(ErrKeyNotSet, "coordinatorAddress")
 	}
+	if err = validateCoordinatorVersion(&spec); err != nil {
+		return jb, err
+	}
 
 	jb.VRFSpec = &spec
 
 	return jb, nil
 }
+
+// validateCoordinatorVersion checks the fields which only apply to jobs of
+// a VRFCoordinatorV2, and defaults the coordinator version to 1
+func validateCoordinatorVersion(spec *job.VRFSpec) error {
+	switch spec.CoordinatorVersion {
+	case 0, 1:
+		spec.CoordinatorVersion = 1
+		if spec.FromAddress != nil || spec.BatchCoordinatorAddress != nil || spec.BatchFulfillmentEnabled || spec.BatchFulfillmentGasLimit != 0 || spec.MinSubscriptionBalance != nil ||
+			spec.MaxGasPrice != nil || spec.FulfillmentDeadlineBlocks != 0 {
+			return errors.New("fromAddress, batchCoordinatorAddress, batchFulfillmentEnabled, batchFulfillmentGasLimit, minSubscriptionBalanceLinkJuels, maxGasPriceWei and fulfillmentDeadlineBlocks require coordinatorVersion 2")
+		}
+		return nil
+	case job.VRFCoordinatorV2:
+	default:
+		return errors.Errorf("unsupported coordinatorVersion %d, must be 1 or 2", spec.CoordinatorVersion)
+	}
+
+	if spec.FromAddress == nil {
+		return errors.Wrap(ErrKeyNotSet, "fromAddress")
+	}
+	if spec.BatchFulfillmentEnabled && spec.BatchCoordinatorAddress == nil {
+		return errors.Wrap(ErrKeyNotSet, "batchCoordinatorAddress")
+	}
+	if spec.BatchFulfillmentEnabled && spec.BatchFulfillmentGasLimit == 0 {
+		spec.BatchFulfillmentGasLimit = DefaultBatchFulfillmentGasLimit
+	}
+	return validateDeferral(spec)
+}
+
+// validateDeferral checks the fields which defer fulfillments while gas
+// prices are high, and defaults the fulfillment deadline
+func validateDeferral(spec *job.VRFSpec) error {
+	if spec.MaxGasPrice == nil {
+		if spec.FulfillmentDeadlineBlocks != 0 {
+			return errors.Wrap(ErrKeyNotSet, "maxGasPriceWei")
+		}
+		return nil
+	}
+	if spec.MaxGasPrice.ToInt().Sign() <= 0 {
+		return errors.New("maxGasPriceWei must be positive")
+	}
+	if spec.FulfillmentDeadlineBlocks == 0 {
+		spec.FulfillmentDeadlineBlocks = DefaultFulfillmentDeadlineBlocks
+	}
+	// The proof is generated from the hash of the request block, which is
+	// only available to the coordinator for the most recent 256 blocks
+	if spec.FulfillmentDeadlineBlocks >= 256 {
+		return errors.New("fulfillmentDeadlineBlocks must be less than 256")
+	}
+	return nil
+}
@@ -31,6 +31,7 @@ coordinatorAddress = "0xB3b7874F13387D44a3398D298B075B7A3505D8d4"
 				assert.Equal(t, uint32(10), s.VRFSpec.Confirmations)
 				assert.Equal(t, "0xB3b7874F13387D44a3398D298B075B7A3505D8d4", s.VRFSpec.CoordinatorAddress.String())
 				assert.Equal(t, "0x79be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f8179800", s.VRFSpec.PublicKey.String())
+				assert.Equal(t, uint32(1), s.VRFSpec.CoordinatorVersion)
 			},
 		},
 		{
@@ -74,6 +75,141 @@ externalJobID = "0eec7e1d-d0d2-476c-a1a8-72dfb6633f46"
 				assert.Equal(t, s.ExternalJobID.String(), "0eec7e1d-d0d2-476c-a1a8-72dfb6633f46")
 			},
 		},
+		{
+			name: "v2 spec",
+			toml: `
+type            = "vrf"
+schemaVersion   = 1
+confirmations = 10
+publicKey = "0x79BE667EF9DCBBAC55A06295CE870B07029BFCDB2DCE28D959F2815B16F8179800"
+coordinatorAddress = "0xB3b7874F13387D44a3398D298B075B7A3505D8d4"
+coordinatorVersion = 2
+fromAddress = "0xa8037A20989AFcBC51798de9762b351D63ff462e"
+batchCoordinatorAddress = "0x613a38AC1659769640aaE063C651F48E0250454C"
+batchFulfillmentEnabled = true
+minSubscriptionBalanceLinkJuels = "1000000000000000000"
+`,
+			assertion: func(t *testing.T, s job.Job, err error) {
+				require.NoError(t, err)
+				require.True(t, s.VRFSpec.IsV2())
+				assert.Equal(t, "0xa8037A20989AFcBC51798de9762b351D63ff462e", s.VRFSpec.FromAddress.String())
+				assert.Equal(t, "0x613a38AC1659769640aaE063C651F48E0250454C", s.VRFSpec.BatchCoordinatorAddress.String())
+				assert.Equal(t, DefaultBatchFulfillmentGasLimit, s.VRFSpec.BatchFulfillmentGasLimit)
+				assert.Equal(t, "1000000000000000000", s.VRFSpec.MinSubscriptionBalance.String())
+			},
+		},
+		{
+			name: "v2 spec missing fromAddress",
+			toml: `
+type            = "vrf"
+schemaVersion   = 1
+confirmations = 10
+publicKey = "0x79BE667EF9DCBBAC55A06295CE870B07029BFCDB2DCE28D959F2815B16F8179800"
+coordinatorAddress = "0xB3b7874F13387D44a3398D298B075B7A3505D8d4"
+coordinatorVersion = 2
+`,
+			assertion: func(t *testing.T, s job.Job, err error) {
+				require.Error(t, err)
+				require.True(t, ErrKeyNotSet == errors.Cause(err))
+			},
+		},
+		{
+			name: "v2 spec batching without batch coordinator",
+			toml: `
+type            = "vrf"
+schemaVersion   = 1
+confirmations = 10
+publicKey = "0x79BE667EF9DCBBAC55A06295CE870B07029BFCDB2DCE28D959F2815B16F8179800"
+coordinatorAddress = "0xB3b7874F13387D44a3398D298B075B7A3505D8d4"
+coordinatorVersion = 2
+fromAddress = "0xa8037A20989AFcBC51798de9762b351D63ff462e"
+batchFulfillmentEnabled = true
+`,
+			assertion: func(t *testing.T, s job.Job, err error) {
+				require.Error(t, err)
+				require.True(t, ErrKeyNotSet == errors.Cause(err))
+			},
+		},
+		{
+			name: "v2 fields in v1 spec",
+			toml: `
+type            = "vrf"
+schemaVersion   = 1
+confirmations = 10
+publicKey = "0x79BE667EF9DCBBAC55A06295CE870B07029BFCDB2DCE28D959F2815B16F8179800"
+coordinatorAddress = "0xB3b7874F13387D44a3398D298B075B7A3505D8d4"
+fromAddress = "0xa8037A20989AFcBC51798de9762b351D63ff462e"
+`,
+			assertion: func(t *testing.T, s job.Job, err error) {
+				require.Error(t, err)
+			},
+		},
+		{
+			name: "v2 spec with max gas price",
+			toml: `
+type            = "vrf"
+schemaVersion   = 1
+confirmations = 10
+publicKey = "0x79BE667EF9DCBBAC55A06295CE870B07029BFCDB2DCE28D959F2815B16F8179800"
+coordinatorAddress = "0xB3b7874F13387D44a3398D298B075B7A3505D8d4"
+coordinatorVersion = 2
+fromAddress = "0xa8037A20989AFcBC51798de9762b351D63ff462e"
+maxGasPriceWei = "100000000000"
+`,
+			assertion: func(t *testing.T, s job.Job, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, "100000000000", s.VRFSpec.MaxGasPrice.String())
+				assert.Equal(t, DefaultFulfillmentDeadlineBlocks, s.VRFSpec.FulfillmentDeadlineBlocks)
+			},
+		},
+		{
+			name: "v2 spec with fulfillment deadline beyond blockhash window",
+			toml: `
+type            = "vrf"
+schemaVersion   = 1
+confirmations = 10
+publicKey = "0x79BE667EF9DCBBAC55A06295CE870B07029BFCDB2DCE28D959F2815B16F8179800"
+coordinatorAddress = "0xB3b7874F13387D44a3398D298B075B7A3505D8d4"
+coordinatorVersion = 2
+fromAddress = "0xa8037A20989AFcBC51798de9762b351D63ff462e"
+maxGasPriceWei = "100000000000"
+fulfillmentDeadlineBlocks = 300
+`,
+			assertion: func(t *testing.T, s job.Job, err error) {
+				require.EqualError(t, err, "fulfillmentDeadlineBlocks must be less than 256")
+			},
+		},
+		{
+			name: "v2 spec with fulfillment deadline without max gas price",
+			toml: `
+type            = "vrf"
+schemaVersion   = 1
+confirmations = 10
+publicKey = "0x79BE667EF9DCBBAC55A06295CE870B07029BFCDB2DCE28D959F2815B16F8179800"
+coordinatorAddress = "0xB3b7874F13387D44a3398D298B075B7A3505D8d4"
+coordinatorVersion = 2
+fromAddress = "0xa8037A20989AFcBC51798de9762b351D63ff462e"
+fulfillmentDeadlineBlocks = 100
+`,
+			assertion: func(t *testing.T, s job.Job, err error) {
+				require.Error(t, err)
+				require.True(t, ErrKeyNotSet == errors.Cause(err))
+			},
+		},
+		{
+			name: "unsupported coordinator version",
+			toml: `
+type            = "vrf"
+schemaVersion   = 1
+confirmations = 10
+publicKey = "0x79BE667EF9DCBBAC55A06295CE870B07029BFCDB2DCE28D959F2815B16F8179800"
+coordinatorAddress = "0xB3b7874F13387D44a3398D298B075B7A3505D8d4"
+coordinatorVersion = 3
+`,
+			assertion: func(t *testing.T, s job.Job, err error) {
+				require.EqualError(t, err, "unsupported coordinatorVersion 3, must be 1 or 2")
+			},
+		},
 	}
 	for _, tc := range tt {
 		t.Run(tc.name, func(t *testing.T) {
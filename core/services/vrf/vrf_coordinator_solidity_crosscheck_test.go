@@ -31,10 +31,14 @@ import (
 	"github.com/smartcontractkit/chainlink/core/utils"
 
 	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/internal/gethwrappers/generated/batch_vrf_coordinator_v2"
 	"github.com/smartcontractkit/chainlink/core/internal/gethwrappers/generated/link_token_interface"
+	"github.com/smartcontractkit/chainlink/core/internal/gethwrappers/generated/mock_v3_aggregator_contract"
 	"github.com/smartcontractkit/chainlink/core/internal/gethwrappers/generated/solidity_vrf_consumer_interface"
 	"github.com/smartcontractkit/chainlink/core/internal/gethwrappers/generated/solidity_vrf_coordinator_interface"
 	"github.com/smartcontractkit/chainlink/core/internal/gethwrappers/generated/solidity_vrf_request_id"
+	"github.com/smartcontractkit/chainlink/core/internal/gethwrappers/generated/vrf_consumer_v2"
+	"github.com/smartcontractkit/chainlink/core/internal/gethwrappers/generated/vrf_coordinator_v2"
 )
 
 // coordinatorUniverse represents the universe in which a randomness request occurs and
@@ -51,6 +55,13 @@ type coordinatorUniverse struct {
 	consumerContractAddress    common.Address
 	consumerContractAddressV08 common.Address
 	linkContractAddress        common.Address
+	// VRF v2
+	rootContractV2            *vrf_coordinator_v2.VRFCoordinatorV2
+	batchCoordinatorContract  *batch_vrf_coordinator_v2.BatchVRFCoordinatorV2
+	consumerContractV2        *vrf_consumer_v2.VRFConsumerV2
+	rootContractAddressV2     common.Address
+	batchCoordinatorAddress   common.Address
+	consumerContractAddressV2 common.Address
 	// Abstraction representation of the ethereum blockchain
 	backend        *backends.SimulatedBackend
 	coordinatorABI *abi.ABI
@@ -135,21 +146,54 @@ func newVRFCoordinatorUniverse(t *testing.T, key ethkey.Key) coordinatorUniverse
 	_, err = linkContract.Transfer(sergey, consumerContractAddress, oneEth) // Actually, LINK
 	require.NoError(t, err, "failed to send LINK to VRFConsumer contract on simulated ethereum blockchain")
 	backend.Commit()
+
+	// 0.01 ETH per LINK, which is what fulfillments of the VRFCoordinatorV2 are
+	// charged at
+	linkEthFeedAddress, _, _, err := mock_v3_aggregator_contract.DeployMockV3AggregatorContract(
+		sergey, backend, 18, big.NewInt(1e16))
+	require.NoError(t, err, "failed to deploy LINK/ETH feed to simulated ethereum blockchain")
+	coordinatorV2Address, _, coordinatorV2Contract, err :=
+		vrf_coordinator_v2.DeployVRFCoordinatorV2(
+			neil, backend, linkAddress, common.Address{} /* BlockHashStore address */, linkEthFeedAddress)
+	require.NoError(t, err, "failed to deploy VRFCoordinatorV2 contract to simulated ethereum blockchain")
+	batchCoordinatorAddress, _, batchCoordinatorContract, err :=
+		batch_vrf_coordinator_v2.DeployBatchVRFCoordinatorV2(neil, backend, coordinatorV2Address)
+	require.NoError(t, err, "failed to deploy BatchVRFCoordinatorV2 contract to simulated ethereum blockchain")
+	consumerContractAddressV2, _, consumerContractV2, err :=
+		vrf_consumer_v2.DeployVRFConsumerV2(carol, backend, coordinatorV2Address, linkAddress)
+	require.NoError(t, err, "failed to deploy VRFConsumerV2 contract to simulated ethereum blockchain")
+	_, err = linkContract.Transfer(sergey, consumerContractAddressV2, oneEth) // Actually, LINK
+	require.NoError(t, err, "failed to send LINK to VRFConsumerV2 contract on simulated ethereum blockchain")
+	backend.Commit()
+	_, err = coordinatorV2Contract.SetConfig(neil,
+		1,      // minimumRequestConfirmations
+		1e6,    // maxGasLimit
+		50_000, // gasAfterPaymentCalculation
+		500,    // fulfillmentFlatFeeLinkPPM
+	)
+	require.NoError(t, err, "failed to configure VRFCoordinatorV2 contract on simulated ethereum blockchain")
+	backend.Commit()
 	return coordinatorUniverse{
-		rootContract:            coordinatorContract,
-		rootContractAddress:     coordinatorAddress,
-		linkContract:            linkContract,
-		linkContractAddress:     linkAddress,
-		consumerContract:        consumerContract,
-		requestIDBase:           requestIDBase,
-		consumerContractAddress: consumerContractAddress,
-		backend:                 backend,
-		coordinatorABI:          &coordinatorABI,
-		consumerABI:             &consumerABI,
-		sergey:                  sergey,
-		neil:                    neil,
-		ned:                     ned,
-		carol:                   carol,
+		rootContractV2:            coordinatorV2Contract,
+		rootContractAddressV2:     coordinatorV2Address,
+		batchCoordinatorContract:  batchCoordinatorContract,
+		batchCoordinatorAddress:   batchCoordinatorAddress,
+		consumerContractV2:        consumerContractV2,
+		consumerContractAddressV2: consumerContractAddressV2,
+		rootContract:              coordinatorContract,
+		rootContractAddress:       coordinatorAddress,
+		linkContract:              linkContract,
+		linkContractAddress:       linkAddress,
+		consumerContract:          consumerContract,
+		requestIDBase:             requestIDBase,
+		consumerContractAddress:   consumerContractAddress,
+		backend:                   backend,
+		coordinatorABI:            &coordinatorABI,
+		consumerABI:               &consumerABI,
+		sergey:                    sergey,
+		neil:                      neil,
+		ned:                       ned,
+		carol:                     carol,
 	}
 }
 
@@ -0,0 +1,230 @@
+package vrf_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/internal/gethwrappers/generated/batch_vrf_coordinator_v2"
+	"github.com/smartcontractkit/chainlink/core/internal/gethwrappers/generated/vrf_coordinator_v2"
+	"github.com/smartcontractkit/chainlink/core/services/signatures/secp256k1"
+	"github.com/smartcontractkit/chainlink/core/services/vrf"
+	proof2 "github.com/smartcontractkit/chainlink/core/services/vrf/proof"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+const callbackGasLimitV2 = uint32(500_000)
+
+// registerProvingKeyV2 registers publicKey to neil on the VRFCoordinatorV2
+func registerProvingKeyV2(t *testing.T, cu coordinatorUniverse) (keyHash [32]byte) {
+	_, err := cu.rootContractV2.RegisterProvingKey(cu.neil, cu.neil.From, pair(secp256k1.Coordinates(publicKey)))
+	require.NoError(t, err, "failed to register VRF proving key on VRFCoordinatorV2 contract")
+	cu.backend.Commit()
+	keyHash = utils.MustHash(string(secp256k1.LongMarshal(publicKey)))
+	onChainKeyHash, err := cu.rootContractV2.HashOfKey(nil, pair(secp256k1.Coordinates(publicKey)))
+	require.NoError(t, err)
+	require.Equal(t, keyHash, onChainKeyHash, "VRFCoordinatorV2 hashes keys differently from golang code!")
+	return keyHash
+}
+
+// createSubscriptionV2 creates a subscription for the VRFConsumerV2 and funds
+// it with the given amount of LINK, if any
+func createSubscriptionV2(t *testing.T, cu coordinatorUniverse, amount *big.Int) uint64 {
+	_, err := cu.consumerContractV2.TestCreateSubscription(cu.carol)
+	require.NoError(t, err, "failed to create subscription")
+	cu.backend.Commit()
+	subID, err := cu.consumerContractV2.SSubId(nil)
+	require.NoError(t, err)
+	if amount.Sign() > 0 {
+		fundSubscriptionV2(t, cu, amount)
+	}
+	return subID
+}
+
+func fundSubscriptionV2(t *testing.T, cu coordinatorUniverse, amount *big.Int) {
+	_, err := cu.consumerContractV2.TestFundSubscription(cu.carol, amount)
+	require.NoError(t, err, "failed to fund subscription")
+	cu.backend.Commit()
+}
+
+// requestRandomWordsV2 has the VRFConsumerV2 request numWords random words,
+// and returns the logged request
+func requestRandomWordsV2(t *testing.T, cu coordinatorUniverse, keyHash [32]byte, numWords uint32) *vrf_coordinator_v2.VRFCoordinatorV2RandomWordsRequested {
+	tx, err := cu.consumerContractV2.TestRequestRandomness(cu.carol, keyHash, 1, callbackGasLimitV2, numWords)
+	require.NoError(t, err, "failed to request random words")
+	cu.backend.Commit()
+	receipt := mustSucceedV2(t, cu, tx)
+	for _, lg := range receipt.Logs {
+		req, err := cu.rootContractV2.ParseRandomWordsRequested(*lg)
+		if err == nil {
+			assert.Equal(t, keyHash, req.KeyHash)
+			assert.Equal(t, cu.consumerContractAddressV2, req.Sender)
+			return req
+		}
+	}
+	t.Fatal("VRFCoordinatorV2 did not log a RandomWordsRequested")
+	return nil
+}
+
+// fulfillmentV2 returns the proof and commitment fulfilling req, along with the
+// VRF output they prove
+func fulfillmentV2(t *testing.T, req *vrf_coordinator_v2.VRFCoordinatorV2RandomWordsRequested) (
+	vrf_coordinator_v2.VRFProof, vrf_coordinator_v2.VRFCoordinatorV2RequestCommitment, *big.Int) {
+	preSeed, err := proof2.BigToSeed(req.PreSeed)
+	require.NoError(t, err, "pre-seed %x out of range", req.PreSeed)
+	s := proof2.PreSeedData{
+		PreSeed:   preSeed,
+		BlockHash: req.Raw.BlockHash,
+		BlockNum:  req.Raw.BlockNumber,
+	}
+	proof, err := secretKey.GenerateProofWithNonce(proof2.FinalSeed(s), big.NewInt(1) /* nonce */)
+	require.NoError(t, err)
+	proofV2, err := proof2.GenerateProofResponseFromProofV2(proof, s)
+	require.NoError(t, err, "could not generate VRF proof!")
+	rc := vrf_coordinator_v2.VRFCoordinatorV2RequestCommitment{
+		BlockNum:         req.Raw.BlockNumber,
+		SubId:            req.SubId,
+		CallbackGasLimit: req.CallbackGasLimit,
+		NumWords:         req.NumWords,
+		Sender:           req.Sender,
+	}
+	return proofV2, rc, proof.Output
+}
+
+// fulfillerOpts returns neil's transaction options with the gas limit the
+// listener sends fulfillments of numRequests requests with
+func fulfillerOpts(cu coordinatorUniverse, numRequests int) *bind.TransactOpts {
+	neil := *cu.neil
+	neil.GasLimit = uint64(numRequests) * (uint64(callbackGasLimitV2) + vrf.GasProofVerification)
+	return &neil
+}
+
+func mustSucceedV2(t *testing.T, cu coordinatorUniverse, tx *types.Transaction) *types.Receipt {
+	receipt, err := cu.backend.TransactionReceipt(context.Background(), tx.Hash())
+	require.NoError(t, err)
+	require.Equal(t, types.ReceiptStatusSuccessful, receipt.Status, "transaction reverted")
+	return receipt
+}
+
+func assertRandomWordsV2(t *testing.T, cu coordinatorUniverse, req *vrf_coordinator_v2.VRFCoordinatorV2RandomWordsRequested, output *big.Int) {
+	requestID, err := cu.consumerContractV2.SRequestId(nil)
+	require.NoError(t, err)
+	assert.Equal(t, req.RequestId, requestID, "VRFConsumerV2 has a different request ID than logged from the request!")
+	for i := uint32(0); i < req.NumWords; i++ {
+		word, err := cu.consumerContractV2.SRandomWords(nil, big.NewInt(int64(i)))
+		require.NoError(t, err)
+		expected := crypto.Keccak256Hash(common.BigToHash(output).Bytes(), common.BigToHash(big.NewInt(int64(i))).Bytes())
+		assert.Equal(t, expected.Big(), word, "random word %d differs from the VRF output expanded by golang code", i)
+	}
+	commitment, err := cu.rootContractV2.GetCommitment(nil, req.RequestId)
+	require.NoError(t, err)
+	assert.Equal(t, [32]byte{}, commitment, "VRFCoordinatorV2 kept the commitment of a fulfilled request")
+}
+
+func TestVRFCoordinatorV2_FulfillRandomWords(t *testing.T) {
+	cu := newVRFCoordinatorUniverse(t, cltest.MustGenerateRandomKey(t))
+	keyHash := registerProvingKeyV2(t, cu)
+	subID := createSubscriptionV2(t, cu, oneEth)
+	req := requestRandomWordsV2(t, cu, keyHash, 3)
+	assert.Equal(t, subID, req.SubId)
+	assert.Equal(t, callbackGasLimitV2, req.CallbackGasLimit)
+
+	proof, rc, output := fulfillmentV2(t, req)
+	tx, err := cu.rootContractV2.FulfillRandomWords(fulfillerOpts(cu, 1), proof, rc)
+	require.NoError(t, err, "failed to fulfill random words")
+	cu.backend.Commit()
+	receipt := mustSucceedV2(t, cu, tx)
+	t.Log("fulfillment gas used", receipt.GasUsed)
+	assertRandomWordsV2(t, cu, req, output)
+
+	fulfilled, err := cu.rootContractV2.ParseRandomWordsFulfilled(*receipt.Logs[len(receipt.Logs)-1])
+	require.NoError(t, err)
+	assert.Equal(t, req.RequestId, fulfilled.RequestId)
+	assert.Equal(t, output, fulfilled.OutputSeed)
+	assert.True(t, fulfilled.Success, "callback of VRFConsumerV2 failed")
+	sub, err := cu.rootContractV2.GetSubscription(nil, subID)
+	require.NoError(t, err)
+	assert.Equal(t, new(big.Int).Sub(oneEth, fulfilled.Payment), sub.Balance)
+	assert.Equal(t, uint64(1), sub.ReqCount)
+
+	// The request can only be fulfilled once
+	tx, err = cu.rootContractV2.FulfillRandomWords(fulfillerOpts(cu, 1), proof, rc)
+	require.NoError(t, err)
+	cu.backend.Commit()
+	receipt, err = cu.backend.TransactionReceipt(context.Background(), tx.Hash())
+	require.NoError(t, err)
+	assert.Equal(t, types.ReceiptStatusFailed, receipt.Status)
+}
+
+func TestVRFCoordinatorV2_UnfundedSubscription(t *testing.T) {
+	cu := newVRFCoordinatorUniverse(t, cltest.MustGenerateRandomKey(t))
+	keyHash := registerProvingKeyV2(t, cu)
+	subID := createSubscriptionV2(t, cu, big.NewInt(0))
+	req := requestRandomWordsV2(t, cu, keyHash, 1)
+
+	// The fulfillment reverts while the subscription cannot pay for it, which
+	// is why the listener waits for it to be funded
+	proof, rc, output := fulfillmentV2(t, req)
+	tx, err := cu.rootContractV2.FulfillRandomWords(fulfillerOpts(cu, 1), proof, rc)
+	require.NoError(t, err)
+	cu.backend.Commit()
+	receipt, err := cu.backend.TransactionReceipt(context.Background(), tx.Hash())
+	require.NoError(t, err)
+	assert.Equal(t, types.ReceiptStatusFailed, receipt.Status)
+
+	fundSubscriptionV2(t, cu, oneEth)
+	sub, err := cu.rootContractV2.GetSubscription(nil, subID)
+	require.NoError(t, err)
+	assert.Equal(t, oneEth, sub.Balance)
+	tx, err = cu.rootContractV2.FulfillRandomWords(fulfillerOpts(cu, 1), proof, rc)
+	require.NoError(t, err)
+	cu.backend.Commit()
+	mustSucceedV2(t, cu, tx)
+	assertRandomWordsV2(t, cu, req, output)
+}
+
+func TestBatchVRFCoordinatorV2_FulfillRandomWords(t *testing.T) {
+	cu := newVRFCoordinatorUniverse(t, cltest.MustGenerateRandomKey(t))
+	keyHash := registerProvingKeyV2(t, cu)
+	createSubscriptionV2(t, cu, oneEth)
+	req1 := requestRandomWordsV2(t, cu, keyHash, 1)
+	req2 := requestRandomWordsV2(t, cu, keyHash, 2)
+
+	var proofs []batch_vrf_coordinator_v2.VRFProof
+	var rcs []batch_vrf_coordinator_v2.VRFCoordinatorV2RequestCommitment
+	var output2 *big.Int
+	// Fulfilling req1 a second time fails without failing the batch
+	for _, req := range []*vrf_coordinator_v2.VRFCoordinatorV2RandomWordsRequested{req1, req2, req1} {
+		proof, rc, output := fulfillmentV2(t, req)
+		proofs = append(proofs, batch_vrf_coordinator_v2.VRFProof(proof))
+		rcs = append(rcs, batch_vrf_coordinator_v2.VRFCoordinatorV2RequestCommitment(rc))
+		if req == req2 {
+			output2 = output
+		}
+	}
+
+	tx, err := cu.batchCoordinatorContract.FulfillRandomWords(fulfillerOpts(cu, len(proofs)), proofs, rcs)
+	require.NoError(t, err, "failed to batch fulfill random words")
+	cu.backend.Commit()
+	receipt := mustSucceedV2(t, cu, tx)
+	t.Log("batch fulfillment gas used", receipt.GasUsed)
+	assertRandomWordsV2(t, cu, req2, output2)
+
+	fulfillments, err := cu.consumerContractV2.SFulfillments(nil)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), fulfillments.Int64())
+	errs, err := cu.batchCoordinatorContract.FilterErrorReturned(nil, nil)
+	require.NoError(t, err)
+	require.True(t, errs.Next(), "BatchVRFCoordinatorV2 did not log the failed fulfillment")
+	assert.Equal(t, req1.RequestId, errs.Event.RequestId)
+	assert.Equal(t, "no corresponding request", errs.Event.Reason)
+	assert.False(t, errs.Next())
+}
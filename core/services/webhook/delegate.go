@@ -2,6 +2,7 @@ package webhook
 
 import (
 	"context"
+	"encoding/json"
 	"sync"
 
 	uuid "github.com/satori/go.uuid"
@@ -102,6 +103,9 @@ type webhookJobRunner struct {
 type registeredJob struct {
 	job.Job
 	chRemove chan struct{}
+	// chRunning holds a value while a run is in progress, for the jobs
+	// whose overlap policy limits them to one run at a time
+	chRunning chan struct{}
 }
 
 func (r *webhookJobRunner) addSpec(spec job.Job) error {
@@ -111,7 +115,7 @@ func (r *webhookJobRunner) addSpec(spec job.Job) error {
 	if exists {
 		return errors.Errorf("a webhook job with that UUID already exists (uuid: %v)", spec.ExternalJobID)
 	}
-	r.specsByUUID[spec.ExternalJobID] = registeredJob{spec, make(chan struct{})}
+	r.specsByUUID[spec.ExternalJobID] = registeredJob{spec, make(chan struct{}), make(chan struct{}, 1)}
 	return nil
 }
 
@@ -132,7 +136,37 @@ func (r *webhookJobRunner) spec(externalJobID uuid.UUID) (registeredJob, bool) {
 	return spec, exists
 }
 
-var ErrJobNotExists = errors.New("job does not exist")
+var (
+	ErrJobNotExists  = errors.New("job does not exist")
+	ErrRunInProgress = errors.New("job is already running and its overlap policy is skip")
+)
+
+// acquireRun waits for the job to be allowed to start a run according to its
+// overlap policy, and returns a func to call once the run is finished
+func (j registeredJob) acquireRun(ctx context.Context) (release func(), err error) {
+	if j.WebhookSpec == nil {
+		return func() {}, nil
+	}
+	release = func() { <-j.chRunning }
+	switch j.WebhookSpec.OverlapPolicy {
+	case job.OverlapSkip:
+		select {
+		case j.chRunning <- struct{}{}:
+			return release, nil
+		default:
+			return nil, ErrRunInProgress
+		}
+	case job.OverlapQueue:
+		select {
+		case j.chRunning <- struct{}{}:
+			return release, nil
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	default:
+		return func() {}, nil
+	}
+}
 
 func (r *webhookJobRunner) RunJob(ctx context.Context, jobUUID uuid.UUID, requestBody string, meta pipeline.JSONSerializable) (int64, error) {
 	spec, exists := r.spec(jobUUID)
@@ -150,6 +184,12 @@ func (r *webhookJobRunner) RunJob(ctx context.Context, jobUUID uuid.UUID, reques
 	ctx, cancel := utils.CombinedContext(ctx, spec.chRemove)
 	defer cancel()
 
+	release, err := spec.acquireRun(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer release()
+
 	vars := pipeline.NewVarsFrom(map[string]interface{}{
 		"jobSpec": map[string]interface{}{
 			"databaseID":    spec.ID,
@@ -158,16 +198,28 @@ func (r *webhookJobRunner) RunJob(ctx context.Context, jobUUID uuid.UUID, reques
 		},
 		"jobRun": map[string]interface{}{
 			"requestBody": requestBody,
+			"requestData": parseRequestData(requestBody),
 			"meta":        meta.Val,
 		},
 	})
 
 	run := pipeline.NewRun(*spec.PipelineSpec, vars)
 
-	_, err := r.runner.Run(ctx, &run, *logger, true)
+	_, err = r.runner.Run(ctx, &run, *logger, true)
 	if err != nil {
 		logger.Errorw("Error running pipeline for webhook job", "error", err)
 		return 0, err
 	}
 	return run.ID, nil
 }
+
+// parseRequestData returns the request body decoded as JSON, as the jsonparse
+// task would, for pipelines to use its values as typed vars, e.g.
+// $(jobRun.requestData.amount). It is nil if the body is not JSON.
+func parseRequestData(requestBody string) interface{} {
+	var data interface{}
+	if err := json.Unmarshal([]byte(requestBody), &data); err != nil {
+		return nil
+	}
+	return data
+}
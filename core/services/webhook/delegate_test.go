@@ -40,6 +40,7 @@ func TestWebhookDelegate(t *testing.T) {
 			},
 			"jobRun": map[string]interface{}{
 				"requestBody": requestBody,
+				"requestData": nil,
 				"meta":        meta.Val,
 			},
 		}
@@ -75,6 +76,21 @@ func TestWebhookDelegate(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, int64(123), runID)
 
+	// Should pass JSON request bodies as typed vars
+	runner.On("Run", mock.Anything, mock.AnythingOfType("*pipeline.Run"), mock.Anything, mock.Anything).
+		Return(false, nil).
+		Run(func(args mock.Arguments) {
+			run := args.Get(1).(*pipeline.Run)
+			run.ID = int64(124)
+
+			jobRun := run.Inputs.Val.(map[string]interface{})["jobRun"].(map[string]interface{})
+			require.Equal(t, map[string]interface{}{"amount": float64(42), "to": "0xabc", "urgent": true}, jobRun["requestData"])
+		}).Once()
+
+	runID, err = delegate.WebhookJobRunner().RunJob(context.Background(), spec.ExternalJobID, `{"amount":42,"to":"0xabc","urgent":true}`, meta)
+	require.NoError(t, err)
+	require.Equal(t, int64(124), runID)
+
 	// Should error after service is started upon a failed run
 	expectedErr := errors.New("foo bar")
 
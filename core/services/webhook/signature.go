@@ -0,0 +1,99 @@
+package webhook
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+
+	"github.com/smartcontractkit/chainlink/core/static"
+	"github.com/smartcontractkit/chainlink/core/store/models"
+)
+
+// SignatureTolerance is how far the signing time of a webhook trigger may be
+// from the time of the node. Nonces are remembered for twice as long, so that
+// a trigger can't be replayed while its signature is valid.
+const SignatureTolerance = 5 * time.Minute
+
+var (
+	ErrMissingSignature = errors.New("webhook trigger is not signed")
+	ErrInvalidSignature = errors.New("invalid webhook trigger signature")
+	ErrStaleSignature   = errors.New("webhook trigger signature has expired")
+	ErrReplayedNonce    = errors.New("webhook trigger nonce has already been used")
+)
+
+// Sign returns the hex encoded HMAC-SHA256 of a webhook trigger payload,
+// which external initiators send in the X-Chainlink-EA-Signature header
+// along with the timestamp and nonce it was signed with
+func Sign(secret string, timestamp int64, nonce string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write([]byte(nonce))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// SignatureVerifier verifies the webhook trigger payloads of external
+// initiators, and that each of them is only run once
+type SignatureVerifier struct {
+	db *gorm.DB
+}
+
+func NewSignatureVerifier(db *gorm.DB) *SignatureVerifier {
+	return &SignatureVerifier{db}
+}
+
+// Verify checks the signature of a webhook trigger of ei against its signing
+// secret and records its nonce. Triggers of external initiators without a
+// signing secret are not verified.
+func (v *SignatureVerifier) Verify(ctx context.Context, ei models.ExternalInitiator, header http.Header, body []byte) error {
+	if ei.SigningSecret == "" {
+		return nil
+	}
+	signature := header.Get(static.ExternalInitiatorSignatureHeader)
+	timestampStr := header.Get(static.ExternalInitiatorTimestampHeader)
+	nonce := header.Get(static.ExternalInitiatorNonceHeader)
+	if signature == "" || timestampStr == "" || nonce == "" {
+		return ErrMissingSignature
+	}
+	timestamp, err := strconv.ParseInt(timestampStr, 10, 64)
+	if err != nil {
+		return errors.Wrapf(ErrInvalidSignature, "invalid timestamp %q", timestampStr)
+	}
+	signedAt := time.Unix(timestamp, 0)
+	if age := time.Since(signedAt); age > SignatureTolerance || age < -SignatureTolerance {
+		return ErrStaleSignature
+	}
+	expected := Sign(ei.SigningSecret, timestamp, nonce, body)
+	if !hmac.Equal([]byte(expected), []byte(signature)) {
+		return ErrInvalidSignature
+	}
+	return v.recordNonce(ctx, ei, nonce)
+}
+
+func (v *SignatureVerifier) recordNonce(ctx context.Context, ei models.ExternalInitiator, nonce string) error {
+	db := v.db.WithContext(ctx)
+	err := db.Exec(`DELETE FROM external_initiator_nonces WHERE created_at < ?`, time.Now().Add(-2*SignatureTolerance)).Error
+	if err != nil {
+		return errors.Wrap(err, "failed to prune webhook trigger nonces")
+	}
+	result := db.Exec(`
+INSERT INTO external_initiator_nonces (external_initiator_id, nonce, created_at)
+VALUES (?, ?, NOW())
+ON CONFLICT DO NOTHING
+`, ei.ID, nonce)
+	if result.Error != nil {
+		return errors.Wrap(result.Error, "failed to record webhook trigger nonce")
+	} else if result.RowsAffected == 0 {
+		return ErrReplayedNonce
+	}
+	return nil
+}
@@ -0,0 +1,74 @@
+package webhook_test
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/core/services/webhook"
+	"github.com/smartcontractkit/chainlink/core/static"
+)
+
+func signedHeader(secret string, signedAt time.Time, nonce string, body []byte) http.Header {
+	header := make(http.Header)
+	header.Set(static.ExternalInitiatorSignatureHeader, webhook.Sign(secret, signedAt.Unix(), nonce, body))
+	header.Set(static.ExternalInitiatorTimestampHeader, strconv.FormatInt(signedAt.Unix(), 10))
+	header.Set(static.ExternalInitiatorNonceHeader, nonce)
+	return header
+}
+
+func Test_SignatureVerifier(t *testing.T) {
+	db := pgtest.NewGormDB(t)
+	ctx := context.Background()
+	verifier := webhook.NewSignatureVerifier(db)
+
+	const secret = "signing secret"
+	ei := cltest.MustInsertExternalInitiatorWithOpts(t, db, cltest.ExternalInitiatorOpts{SigningSecret: secret})
+	otherEI := cltest.MustInsertExternalInitiatorWithOpts(t, db, cltest.ExternalInitiatorOpts{SigningSecret: secret})
+	unsignedEI := cltest.MustInsertExternalInitiator(t, db)
+	body := []byte(`{"amount":42}`)
+
+	t.Run("accepts a signed trigger once", func(t *testing.T) {
+		header := signedHeader(secret, time.Now(), "nonce-1", body)
+		require.NoError(t, verifier.Verify(ctx, ei, header, body))
+		assert.ErrorIs(t, verifier.Verify(ctx, ei, header, body), webhook.ErrReplayedNonce)
+
+		// nonces are per external initiator
+		require.NoError(t, verifier.Verify(ctx, otherEI, header, body))
+	})
+
+	t.Run("rejects unsigned triggers", func(t *testing.T) {
+		assert.ErrorIs(t, verifier.Verify(ctx, ei, make(http.Header), body), webhook.ErrMissingSignature)
+	})
+
+	t.Run("rejects tampered triggers", func(t *testing.T) {
+		header := signedHeader(secret, time.Now(), "nonce-2", body)
+		assert.ErrorIs(t, verifier.Verify(ctx, ei, header, []byte(`{"amount":43}`)), webhook.ErrInvalidSignature)
+
+		header = signedHeader("wrong secret", time.Now(), "nonce-3", body)
+		assert.ErrorIs(t, verifier.Verify(ctx, ei, header, body), webhook.ErrInvalidSignature)
+
+		header = signedHeader(secret, time.Now(), "nonce-4", body)
+		header.Set(static.ExternalInitiatorNonceHeader, "nonce-5")
+		assert.ErrorIs(t, verifier.Verify(ctx, ei, header, body), webhook.ErrInvalidSignature)
+	})
+
+	t.Run("rejects stale triggers", func(t *testing.T) {
+		header := signedHeader(secret, time.Now().Add(-2*webhook.SignatureTolerance), "nonce-6", body)
+		assert.ErrorIs(t, verifier.Verify(ctx, ei, header, body), webhook.ErrStaleSignature)
+
+		header = signedHeader(secret, time.Now().Add(2*webhook.SignatureTolerance), "nonce-7", body)
+		assert.ErrorIs(t, verifier.Verify(ctx, ei, header, body), webhook.ErrStaleSignature)
+	})
+
+	t.Run("does not verify external initiators without a signing secret", func(t *testing.T) {
+		require.NoError(t, verifier.Verify(ctx, unsignedEI, make(http.Header), body))
+	})
+}
@@ -18,6 +18,7 @@ type TOMLWebhookSpecExternalInitiator struct {
 
 type TOMLWebhookSpec struct {
 	ExternalInitiators []TOMLWebhookSpecExternalInitiator `toml:"externalInitiators"`
+	OverlapPolicy      job.OverlapPolicy                  `toml:"overlapPolicy"`
 }
 
 func ValidatedWebhookSpec(tomlString string, externalInitiatorManager ExternalInitiatorManager) (jb job.Job, err error) {
@@ -39,6 +40,9 @@ func ValidatedWebhookSpec(tomlString string, externalInitiatorManager ExternalIn
 	if err != nil {
 		return jb, err
 	}
+	if err = job.ValidateOverlapPolicy(&tomlSpec.OverlapPolicy); err != nil {
+		return jb, err
+	}
 
 	var externalInitiatorWebhookSpecs []job.ExternalInitiatorWebhookSpec
 	for _, eiSpec := range tomlSpec.ExternalInitiators {
@@ -61,6 +65,7 @@ func ValidatedWebhookSpec(tomlString string, externalInitiatorManager ExternalIn
 
 	jb.WebhookSpec = &job.WebhookSpec{
 		ExternalInitiatorWebhookSpecs: externalInitiatorWebhookSpecs,
+		OverlapPolicy:                 tomlSpec.OverlapPolicy,
 	}
 
 	return jb, nil
@@ -45,6 +45,41 @@ func TestValidatedWebJobSpec(t *testing.T) {
 				require.Equal(t, "0eec7e1d-d0d2-476c-a1a8-72dfb6633f46", s.ExternalJobID.String())
 			},
 		},
+		{
+			name: "overlap policy",
+			toml: `
+			type            = "webhook"
+			schemaVersion   = 1
+			overlapPolicy   = "queue"
+			observationSource   = """
+				ds          [type=http method=GET url="https://chain.link/ETH-USD"];
+				ds_parse    [type=jsonparse path="data,price"];
+				ds -> ds_parse;
+			"""
+			`,
+			assertion: func(t *testing.T, s job.Job, err error) {
+				require.NoError(t, err)
+				require.NotNil(t, s.WebhookSpec)
+				assert.Equal(t, job.OverlapQueue, s.WebhookSpec.OverlapPolicy)
+			},
+		},
+		{
+			name: "invalid overlap policy",
+			toml: `
+			type            = "webhook"
+			schemaVersion   = 1
+			overlapPolicy   = "wait"
+			observationSource   = """
+				ds          [type=http method=GET url="https://chain.link/ETH-USD"];
+				ds_parse    [type=jsonparse path="data,price"];
+				ds -> ds_parse;
+			"""
+			`,
+			assertion: func(t *testing.T, s job.Job, err error) {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), "invalid overlapPolicy")
+			},
+		},
 		{
 			name: "invalid job name",
 			toml: `
@@ -26,6 +26,15 @@ const (
 	// ExternalInitiatorSecretHeader is the header name for the secret used by
 	// external initiators to authenticate
 	ExternalInitiatorSecretHeader = "X-Chainlink-EA-Secret"
+	// ExternalInitiatorSignatureHeader is the header name for the HMAC of the
+	// webhook trigger payloads of external initiators
+	ExternalInitiatorSignatureHeader = "X-Chainlink-EA-Signature"
+	// ExternalInitiatorTimestampHeader is the header name for the unix time
+	// at which external initiators signed a webhook trigger payload
+	ExternalInitiatorTimestampHeader = "X-Chainlink-EA-Timestamp"
+	// ExternalInitiatorNonceHeader is the header name for the unique nonce of
+	// the webhook triggers of external initiators, against replays
+	ExternalInitiatorNonceHeader = "X-Chainlink-EA-Nonce"
 )
 
 func init() {
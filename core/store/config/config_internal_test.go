@@ -5,6 +5,7 @@ import (
 	"net/url"
 	"os"
 	"path"
+	"reflect"
 	"testing"
 	"time"
 
@@ -94,6 +95,27 @@ func TestConfig_EthereumSecondaryURLs(t *testing.T) {
 	}
 }
 
+func TestConfig_EthMaxInFlightTransactionsForKey(t *testing.T) {
+	t.Parallel()
+	config := NewConfig()
+
+	limited := common.HexToAddress("0x0000000000000000000000000000000000000001")
+	unlimited := common.HexToAddress("0x0000000000000000000000000000000000000002")
+	other := common.HexToAddress("0x0000000000000000000000000000000000000003")
+
+	config.Set("ETH_MAX_IN_FLIGHT_TRANSACTIONS", 16)
+	config.Set("ETH_MAX_IN_FLIGHT_TRANSACTIONS_PER_KEY", limited.Hex()+":4 "+unlimited.Hex()+":0")
+
+	assert.Equal(t, uint32(4), config.EthMaxInFlightTransactionsForKey(limited))
+	assert.Equal(t, uint32(0), config.EthMaxInFlightTransactionsForKey(unlimited))
+	assert.Equal(t, uint32(16), config.EthMaxInFlightTransactionsForKey(other))
+
+	config.Set("ETH_MAX_IN_FLIGHT_TRANSACTIONS_PER_KEY", limited.Hex()+"=4")
+	_, err := config.EthMaxInFlightTransactionsPerKey()
+	assert.EqualError(t, err, `invalid ETH_MAX_IN_FLIGHT_TRANSACTIONS_PER_KEY entry "`+limited.Hex()+`=4", must be address:limit`)
+	assert.Equal(t, uint32(16), config.EthMaxInFlightTransactionsForKey(limited))
+}
+
 func TestConfig_ChainSpecificConfig(t *testing.T) {
 	t.Parallel()
 
@@ -163,6 +185,48 @@ func TestConfig_ChainSpecificConfig(t *testing.T) {
 	})
 }
 
+func TestConfig_ChainOverrides(t *testing.T) {
+	t.Parallel()
+
+	chainID := big.NewInt(4040404)
+	depth := uint32(7)
+	backfill := uint64(11)
+	chains.SetOverrides(chainID, chains.ChainOverrides{EthFinalityDepth: &depth, BlockBackfillDepth: &backfill})
+	defer chains.ResetOverrides(chainID)
+
+	config := NewConfig()
+	config.Set("ETH_FINALITY_DEPTH", "42")
+	config.Set("MIN_INCOMING_CONFIRMATIONS", "42")
+
+	t.Run("chain overrides take precedence over env vars", func(t *testing.T) {
+		cfg := config.ForChain(chainID)
+
+		assert.Equal(t, uint(7), cfg.EthFinalityDepth())
+		assert.Equal(t, uint64(11), cfg.BlockBackfillDepth())
+		assert.Equal(t, uint32(42), cfg.MinIncomingConfirmations())
+		assert.Equal(t, chains.FallbackConfig.EthGasBumpThreshold, cfg.EthGasBumpThreshold())
+	})
+
+	t.Run("other chains are not overridden", func(t *testing.T) {
+		cfg := config.ForChain(big.NewInt(80001))
+
+		assert.Equal(t, uint(42), cfg.EthFinalityDepth())
+		assert.Equal(t, uint64(10), cfg.BlockBackfillDepth())
+	})
+
+	t.Run("reports the chain as the source of overridden values", func(t *testing.T) {
+		values, err := config.ForChain(chainID).Values()
+		require.NoError(t, err)
+		byName := make(map[string]Value)
+		for _, value := range values {
+			byName[value.Name] = value
+		}
+
+		assert.Equal(t, Value{Name: "ETH_FINALITY_DEPTH", Value: "7", Source: ValueSourceChain}, byName["ETH_FINALITY_DEPTH"])
+		assert.Equal(t, Value{Name: "BLOCK_BACKFILL_DEPTH", Value: "11", Source: ValueSourceChain}, byName["BLOCK_BACKFILL_DEPTH"])
+	})
+}
+
 func TestConfig_readFromFile(t *testing.T) {
 	v := viper.New()
 	v.Set("ROOT", "../../../tools/clroot/")
@@ -175,6 +239,42 @@ func TestConfig_readFromFile(t *testing.T) {
 	assert.Equal(t, config.TLSPort(), uint16(0))
 }
 
+func TestConfig_Values(t *testing.T) {
+	os.Setenv("ETH_URL", "ws://user:password@localhost:8546")
+	os.Setenv("EXPLORER_SECRET", "s3cret")
+	os.Setenv("ETH_HTTP_URL", "https://mainnet.infura.io/v3/4p1k3y")
+	os.Setenv("GAS_ORACLE_URL", "https://gasoracle.example.com/api?apikey=4p1k3y")
+	os.Setenv("ETH_PRIMARY_URLS", "wss://mainnet.infura.io/ws/v3/4p1k3y,ws://localhost:8546")
+	defer os.Unsetenv("ETH_URL")
+	defer os.Unsetenv("ETH_HTTP_URL")
+	defer os.Unsetenv("GAS_ORACLE_URL")
+	defer os.Unsetenv("ETH_PRIMARY_URLS")
+	defer os.Unsetenv("EXPLORER_SECRET")
+
+	v := viper.New()
+	v.Set("ROOT", "../../../tools/clroot/")
+	config := newConfigWithViper(v)
+
+	values, err := config.Values()
+	require.NoError(t, err)
+	require.Len(t, values, reflect.TypeOf(ConfigSchema{}).NumField())
+	byName := make(map[string]Value)
+	for _, value := range values {
+		byName[value.Name] = value
+	}
+
+	assert.Equal(t, Value{Name: "MIN_OUTGOING_CONFIRMATIONS", Value: "2", Source: ValueSourceFile}, byName["MIN_OUTGOING_CONFIRMATIONS"])
+	assert.Equal(t, Value{Name: "ETH_URL", Value: "ws://user:xxxxx@localhost:8546", Source: ValueSourceEnv, Redacted: true}, byName["ETH_URL"])
+	assert.Equal(t, Value{Name: "ETH_HTTP_URL", Value: "https://mainnet.infura.io/xxxxx", Source: ValueSourceEnv, Redacted: true}, byName["ETH_HTTP_URL"])
+	assert.Equal(t, Value{Name: "ETH_PRIMARY_URLS", Value: "wss://mainnet.infura.io/xxxxx,ws://localhost:8546", Source: ValueSourceEnv, Redacted: true}, byName["ETH_PRIMARY_URLS"])
+	assert.Equal(t, Value{Name: "GAS_ORACLE_URL", Value: "https://gasoracle.example.com/xxxxx?xxxxx", Source: ValueSourceEnv, Redacted: true}, byName["GAS_ORACLE_URL"])
+	assert.Equal(t, Value{Name: "EXPLORER_SECRET", Value: "xxxxx", Source: ValueSourceEnv, Redacted: true}, byName["EXPLORER_SECRET"])
+	assert.Equal(t, Value{Name: "EXPLORER_ACCESS_KEY", Value: "", Source: ValueSourceDefault, Redacted: true}, byName["EXPLORER_ACCESS_KEY"])
+	assert.Equal(t, Value{Name: "ALLOW_ORIGINS", Value: "http://localhost:3000,http://localhost:6688", Source: ValueSourceDefault}, byName["ALLOW_ORIGINS"])
+	assert.Equal(t, Value{Name: "ETH_GAS_PRICE_DEFAULT", Value: "20000000000", Source: ValueSourceDefault}, byName["ETH_GAS_PRICE_DEFAULT"])
+	assert.Equal(t, Value{Name: "ROOT", Value: "../../../tools/clroot/", Source: ValueSourceRuntime}, byName["ROOT"])
+}
+
 func TestStore_addressParser(t *testing.T) {
 	zero := &common.Address{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}
 	fifteen := &common.Address{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 15}
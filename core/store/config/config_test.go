@@ -9,6 +9,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/internal/cltest"
 	"github.com/smartcontractkit/chainlink/core/store/config"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestConfig_SetEthGasPriceDefault(t *testing.T) {
@@ -101,3 +102,78 @@ func TestConfig_MinimumContractPayment(t *testing.T) {
 	cfg = config.NewConfig()
 	assert.Equal(t, assets.NewLink(4937), cfg.MinimumContractPayment())
 }
+
+func TestConfig_EthAdditionalChains(t *testing.T) {
+	cfg := config.NewConfig()
+
+	evmChains, err := cfg.EthAdditionalChains()
+	require.NoError(t, err)
+	assert.Len(t, evmChains, 0)
+
+	cfg.Set("ETH_ADDITIONAL_CHAINS", "56=wss://bsc.example 137=wss://matic.example")
+	evmChains, err = cfg.EthAdditionalChains()
+	require.NoError(t, err)
+	require.Len(t, evmChains, 2)
+	assert.Equal(t, big.NewInt(56), evmChains[0].ID)
+	assert.Equal(t, "wss://bsc.example", evmChains[0].URL)
+	assert.Equal(t, big.NewInt(137), evmChains[1].ID)
+	assert.Equal(t, "wss://matic.example", evmChains[1].URL)
+
+	cfg.Set("ETH_ADDITIONAL_CHAINS", "bsc=wss://bsc.example")
+	_, err = cfg.EthAdditionalChains()
+	assert.EqualError(t, err, `invalid ETH_ADDITIONAL_CHAINS entry "bsc=wss://bsc.example", chain ID must be a positive integer`)
+
+	cfg.Set("ETH_ADDITIONAL_CHAINS", "56")
+	_, err = cfg.EthAdditionalChains()
+	assert.EqualError(t, err, `invalid ETH_ADDITIONAL_CHAINS entry "56", must be chainID=url`)
+}
+
+func TestConfig_ForChain(t *testing.T) {
+	cfg := config.NewConfig()
+	cfg.Set("ETH_CHAIN_ID", "1")
+
+	optimismCfg := cfg.ForChain(big.NewInt(10))
+	assert.Equal(t, big.NewInt(10), optimismCfg.ChainID())
+	assert.Equal(t, uint(1), optimismCfg.EthFinalityDepth())
+	assert.Equal(t, big.NewInt(1), cfg.ChainID())
+	assert.Equal(t, uint(50), cfg.EthFinalityDepth())
+}
+
+func TestConfig_TrustedProxies(t *testing.T) {
+	cfg := config.NewConfig()
+
+	proxies, err := cfg.TrustedProxies()
+	require.NoError(t, err)
+	assert.Len(t, proxies, 0)
+
+	cfg.Set("TRUSTED_PROXIES", "10.0.0.0/8 192.168.1.1 ::1")
+	proxies, err = cfg.TrustedProxies()
+	require.NoError(t, err)
+	require.Len(t, proxies, 3)
+	assert.Equal(t, "10.0.0.0/8", proxies[0].String())
+	assert.Equal(t, "192.168.1.1/32", proxies[1].String())
+	assert.Equal(t, "::1/128", proxies[2].String())
+
+	cfg.Set("TRUSTED_PROXIES", "proxy.example")
+	_, err = cfg.TrustedProxies()
+	assert.EqualError(t, err, `invalid TRUSTED_PROXIES entry "proxy.example", must be an IP address or CIDR range`)
+}
+
+func TestConfig_SessionCookieSameSite(t *testing.T) {
+	cfg := config.NewConfig()
+	assert.Equal(t, "lax", cfg.SessionCookieSameSite())
+	require.NoError(t, cfg.Validate())
+
+	cfg.Set("SESSION_COOKIE_SAME_SITE", "Strict")
+	assert.Equal(t, "strict", cfg.SessionCookieSameSite())
+	require.NoError(t, cfg.Validate())
+
+	cfg.Set("SESSION_COOKIE_SAME_SITE", "none")
+	cfg.Set("SECURE_COOKIES", false)
+	assert.EqualError(t, cfg.Validate(), "SESSION_COOKIE_SAME_SITE of none requires SECURE_COOKIES, browsers reject insecure cookies without SameSite")
+	cfg.Set("SECURE_COOKIES", true)
+	require.NoError(t, cfg.Validate())
+
+	cfg.Set("SESSION_COOKIE_SAME_SITE", "sometimes")
+	assert.EqualError(t, cfg.Validate(), `SESSION_COOKIE_SAME_SITE must be strict, lax or none, got "sometimes"`)
+}
@@ -18,7 +18,14 @@ import (
 // ConfigSchema records the schema of configuration at the type level
 type ConfigSchema struct {
 	AdminCredentialsFile                       string                        `env:"ADMIN_CREDENTIALS_FILE" default:"$ROOT/apicredentials"`
+	AlertsEmailFrom                            string                        `env:"ALERTS_EMAIL_FROM"`
+	AlertsEvaluationInterval                   time.Duration                 `env:"ALERTS_EVALUATION_INTERVAL" default:"30s"`
+	AlertsSMTPURL                              *url.URL                      `env:"ALERTS_SMTP_URL" secret:"true"`
 	AllowOrigins                               string                        `env:"ALLOW_ORIGINS" default:"http://localhost:3000,http://localhost:6688"`
+	AuthFailureLimit                           uint32                        `env:"AUTH_FAILURE_LIMIT" default:"10"`
+	AuthFailureWindow                          time.Duration                 `env:"AUTH_FAILURE_WINDOW" default:"10m"`
+	AuthLockoutDuration                        time.Duration                 `env:"AUTH_LOCKOUT_DURATION" default:"1m"`
+	AuthLockoutMaxDuration                     time.Duration                 `env:"AUTH_LOCKOUT_MAX_DURATION" default:"1h"`
 	AuthenticatedRateLimit                     int64                         `env:"AUTHENTICATED_RATE_LIMIT" default:"1000"`
 	AuthenticatedRateLimitPeriod               time.Duration                 `env:"AUTHENTICATED_RATE_LIMIT_PERIOD" default:"1m"`
 	BalanceMonitorEnabled                      bool                          `env:"BALANCE_MONITOR_ENABLED" default:"true"`
@@ -27,19 +34,19 @@ type ConfigSchema struct {
 	BlockHistoryEstimatorBatchSize             uint32                        `env:"BLOCK_HISTORY_ESTIMATOR_BATCH_SIZE"`
 	BlockHistoryEstimatorBlockDelay            uint16                        `env:"BLOCK_HISTORY_ESTIMATOR_BLOCK_DELAY"`
 	BlockHistoryEstimatorBlockHistorySize      uint16                        `env:"BLOCK_HISTORY_ESTIMATOR_BLOCK_HISTORY_SIZE"`
-	BlockHistoryEstimatorTransactionPercentile uint16                        `env:"BLOCK_HISTORY_ESTIMATOR_TRANSACTION_PERCENTILE" default:"60"`
+	BlockHistoryEstimatorTransactionPercentile uint16                        `env:"BLOCK_HISTORY_ESTIMATOR_TRANSACTION_PERCENTILE"`
 	BridgeResponseURL                          url.URL                       `env:"BRIDGE_RESPONSE_URL"`
 	ChainID                                    big.Int                       `env:"ETH_CHAIN_ID" default:"1"`
 	ClientNodeURL                              string                        `env:"CLIENT_NODE_URL" default:"http://localhost:6688"`
 	DatabaseBackupDir                          string                        `env:"DATABASE_BACKUP_DIR" default:""`
 	DatabaseBackupFrequency                    time.Duration                 `env:"DATABASE_BACKUP_FREQUENCY" default:"1h"`
 	DatabaseBackupMode                         string                        `env:"DATABASE_BACKUP_MODE" default:"none"`
-	DatabaseBackupURL                          *url.URL                      `env:"DATABASE_BACKUP_URL" default:""`
+	DatabaseBackupURL                          *url.URL                      `env:"DATABASE_BACKUP_URL" default:"" secret:"true"`
 	DatabaseListenerMaxReconnectDuration       time.Duration                 `env:"DATABASE_LISTENER_MAX_RECONNECT_DURATION" default:"10m"`
 	DatabaseListenerMinReconnectInterval       time.Duration                 `env:"DATABASE_LISTENER_MIN_RECONNECT_INTERVAL" default:"1m"`
 	DatabaseMaximumTxDuration                  time.Duration                 `env:"DATABASE_MAXIMUM_TX_DURATION" default:"30m"`
 	DatabaseTimeout                            models.Duration               `env:"DATABASE_TIMEOUT" default:"0"`
-	DatabaseURL                                string                        `env:"DATABASE_URL"`
+	DatabaseURL                                string                        `env:"DATABASE_URL" secret:"true"`
 	DefaultHTTPAllowUnrestrictedNetworkAccess  bool                          `env:"DEFAULT_HTTP_ALLOW_UNRESTRICTED_NETWORK_ACCESS" default:"false"`
 	DefaultHTTPLimit                           int64                         `env:"DEFAULT_HTTP_LIMIT" default:"32768"`
 	DefaultHTTPTimeout                         models.Duration               `env:"DEFAULT_HTTP_TIMEOUT" default:"15s"`
@@ -47,36 +54,62 @@ type ConfigSchema struct {
 	Dev                                        bool                          `env:"CHAINLINK_DEV" default:"false"`
 	EnableExperimentalAdapters                 bool                          `env:"ENABLE_EXPERIMENTAL_ADAPTERS" default:"false"`
 	EnableLegacyJobPipeline                    bool                          `env:"ENABLE_LEGACY_JOB_PIPELINE" default:"true"`
+	EthAdditionalChains                        []string                      `env:"ETH_ADDITIONAL_CHAINS"`
 	EthBalanceMonitorBlockDelay                uint16                        `env:"ETH_BALANCE_MONITOR_BLOCK_DELAY"`
 	EthFinalityDepth                           uint                          `env:"ETH_FINALITY_DEPTH"`
 	EthGasBumpPercent                          uint16                        `env:"ETH_GAS_BUMP_PERCENT" default:"20"`
+	EthGasBumpPercentHighUrgency               uint16                        `env:"ETH_GAS_BUMP_PERCENT_HIGH_URGENCY" default:"50"`
 	EthGasBumpThreshold                        uint64                        `env:"ETH_GAS_BUMP_THRESHOLD"`
+	EthGasBumpThresholdHighUrgency             uint64                        `env:"ETH_GAS_BUMP_THRESHOLD_HIGH_URGENCY"`
+	EthGasBumpThresholdLowUrgency              uint64                        `env:"ETH_GAS_BUMP_THRESHOLD_LOW_URGENCY"`
 	EthGasBumpTxDepth                          uint16                        `env:"ETH_GAS_BUMP_TX_DEPTH" default:"10"`
 	EthGasBumpWei                              big.Int                       `env:"ETH_GAS_BUMP_WEI"`
 	EthGasLimitDefault                         uint64                        `env:"ETH_GAS_LIMIT_DEFAULT"`
+	EthGasLimitEstimateMultiplier              float32                       `env:"ETH_GAS_LIMIT_ESTIMATE_MULTIPLIER" default:"1.2"`
 	EthGasLimitMultiplier                      float32                       `env:"ETH_GAS_LIMIT_MULTIPLIER" default:"1.0"`
 	EthGasLimitTransfer                        uint64                        `env:"ETH_GAS_LIMIT_TRANSFER"`
 	EthGasPriceDefault                         big.Int                       `env:"ETH_GAS_PRICE_DEFAULT"`
+	EthHeadBroadcasterAsyncSlowSubscribers     bool                          `env:"ETH_HEAD_BROADCASTER_ASYNC_SLOW_SUBSCRIBERS" default:"true"`
+	EthHeadBroadcasterCallbackBudget           time.Duration                 `env:"ETH_HEAD_BROADCASTER_CALLBACK_BUDGET" default:"1s"`
 	EthHeadTrackerHistoryDepth                 uint                          `env:"ETH_HEAD_TRACKER_HISTORY_DEPTH"`
 	EthHeadTrackerMaxBufferSize                uint                          `env:"ETH_HEAD_TRACKER_MAX_BUFFER_SIZE" default:"3"`
+	EthHeadTrackerMaxProviderLag               uint                          `env:"ETH_HEAD_TRACKER_MAX_PROVIDER_LAG" default:"5"`
+	EthHeadTrackerSamplingBlocks               uint                          `env:"ETH_HEAD_TRACKER_SAMPLING_BLOCKS"`
 	EthHeadTrackerSamplingInterval             time.Duration                 `env:"ETH_HEAD_TRACKER_SAMPLING_INTERVAL" default:"1s"`
+	EthHeadTrackerURLs                         string                        `env:"ETH_HEAD_TRACKER_URLS" default:"" secret:"url"`
 	EthLogBackfillBatchSize                    uint32                        `env:"ETH_LOG_BACKFILL_BATCH_SIZE" default:"100"`
 	EthMaxGasPriceWei                          big.Int                       `env:"ETH_MAX_GAS_PRICE_WEI"`
+	EthMaxGasPriceWeiLowUrgency                big.Int                       `env:"ETH_MAX_GAS_PRICE_WEI_LOW_URGENCY"`
 	EthMaxInFlightTransactions                 uint64                        `env:"ETH_MAX_IN_FLIGHT_TRANSACTIONS"`
+	EthMaxInFlightTransactionsPerKey           []string                      `env:"ETH_MAX_IN_FLIGHT_TRANSACTIONS_PER_KEY"`
 	EthMaxQueuedTransactions                   uint64                        `env:"ETH_MAX_QUEUED_TRANSACTIONS"`
 	EthMinGasPriceWei                          big.Int                       `env:"ETH_MIN_GAS_PRICE_WEI"`
+	EthNodePollInterval                        time.Duration                 `env:"ETH_NODE_POLL_INTERVAL" default:"10s"`
 	EthNonceAutoSync                           bool                          `env:"ETH_NONCE_AUTO_SYNC" default:"true"`
+	EthNonceReconciliationInterval             time.Duration                 `env:"ETH_NONCE_RECONCILIATION_INTERVAL" default:"0s"`
+	EthRPCCacheSize                            uint32                        `env:"ETH_RPC_CACHE_SIZE" default:"1000"`
+	EthRPCCacheTTL                             time.Duration                 `env:"ETH_RPC_CACHE_TTL" default:"1h"`
 	EthRPCDefaultBatchSize                     uint32                        `env:"ETH_RPC_DEFAULT_BATCH_SIZE" default:"100"`
+	EthRPCDetectCapabilities                   bool                          `env:"ETH_RPC_DETECT_CAPABILITIES" default:"true"`
+	EthSendToAllNodes                          bool                          `env:"ETH_SEND_TO_ALL_NODES" default:"false"`
+	EthTxDailyBudgetWei                        big.Int                       `env:"ETH_TX_DAILY_BUDGET_WEI"`
+	EthTxJobDailyBudgetWei                     big.Int                       `env:"ETH_TX_JOB_DAILY_BUDGET_WEI"`
 	EthTxReaperInterval                        time.Duration                 `env:"ETH_TX_REAPER_INTERVAL" default:"1h"`
 	EthTxReaperThreshold                       time.Duration                 `env:"ETH_TX_REAPER_THRESHOLD" default:"168h"`
+	EthTxMulticallAddress                      string                        `env:"ETH_TX_MULTICALL_ADDRESS"`
+	EthTxMulticallMaxBatchSize                 uint32                        `env:"ETH_TX_MULTICALL_MAX_BATCH_SIZE" default:"20"`
+	EthTxMulticallWindow                       time.Duration                 `env:"ETH_TX_MULTICALL_WINDOW" default:"10s"`
 	EthTxResendAfterThreshold                  time.Duration                 `env:"ETH_TX_RESEND_AFTER_THRESHOLD"`
+	EthTxSimulateBeforeBroadcast               bool                          `env:"ETH_TX_SIMULATE_BEFORE_BROADCAST" default:"false"`
+	EthUseFinalityTag                          bool                          `env:"ETH_USE_FINALITY_TAG"`
 	EthereumDisabled                           bool                          `env:"ETH_DISABLED" default:"false"`
-	EthereumHTTPURL                            string                        `env:"ETH_HTTP_URL"`
-	EthereumSecondaryURL                       string                        `env:"ETH_SECONDARY_URL" default:""`
-	EthereumSecondaryURLs                      string                        `env:"ETH_SECONDARY_URLS" default:""`
-	EthereumURL                                string                        `env:"ETH_URL" default:"ws://localhost:8546"`
-	ExplorerAccessKey                          string                        `env:"EXPLORER_ACCESS_KEY"`
-	ExplorerSecret                             string                        `env:"EXPLORER_SECRET"`
+	EthereumHTTPURL                            string                        `env:"ETH_HTTP_URL" secret:"url"`
+	EthereumPrimaryURLs                        string                        `env:"ETH_PRIMARY_URLS" default:"" secret:"url"`
+	EthereumSecondaryURL                       string                        `env:"ETH_SECONDARY_URL" default:"" secret:"url"`
+	EthereumSecondaryURLs                      string                        `env:"ETH_SECONDARY_URLS" default:"" secret:"url"`
+	EthereumURL                                string                        `env:"ETH_URL" default:"ws://localhost:8546" secret:"url"`
+	ExplorerAccessKey                          string                        `env:"EXPLORER_ACCESS_KEY" secret:"true"`
+	ExplorerSecret                             string                        `env:"EXPLORER_SECRET" secret:"true"`
 	ExplorerURL                                *url.URL                      `env:"EXPLORER_URL"`
 	FMDefaultTransactionQueueDepth             uint32                        `env:"FM_DEFAULT_TRANSACTION_QUEUE_DEPTH" default:"1"`
 	FeatureCronV2                              bool                          `env:"FEATURE_CRON_V2" default:"true"`
@@ -85,8 +118,14 @@ type ConfigSchema struct {
 	FeatureFluxMonitorV2                       bool                          `env:"FEATURE_FLUX_MONITOR_V2" default:"true"`
 	FeatureOffchainReporting                   bool                          `env:"FEATURE_OFFCHAIN_REPORTING" default:"false"`
 	FeatureWebhookV2                           bool                          `env:"FEATURE_WEBHOOK_V2" default:"false"`
+	FeedsJobProposalRetention                  time.Duration                 `env:"FEEDS_JOB_PROPOSAL_RETENTION" default:"720h"`
 	FlagsContractAddress                       string                        `env:"FLAGS_CONTRACT_ADDRESS"`
 	GasEstimatorMode                           string                        `env:"GAS_ESTIMATOR_MODE"`
+	GasOracleGasPricePath                      string                        `env:"GAS_ORACLE_GAS_PRICE_PATH" default:"fast"`
+	GasOracleGasPriceUnit                      string                        `env:"GAS_ORACLE_GAS_PRICE_UNIT" default:"gwei"`
+	GasOracleMaxAge                            time.Duration                 `env:"GAS_ORACLE_MAX_AGE" default:"1m"`
+	GasOraclePollPeriod                        time.Duration                 `env:"GAS_ORACLE_POLL_PERIOD" default:"15s"`
+	GasOracleURL                               *url.URL                      `env:"GAS_ORACLE_URL" secret:"url"`
 	GasUpdaterBatchSize                        uint32                        `env:"GAS_UPDATER_BATCH_SIZE"`
 	GasUpdaterBlockDelay                       uint16                        `env:"GAS_UPDATER_BLOCK_DELAY"`
 	GasUpdaterBlockHistorySize                 uint16                        `env:"GAS_UPDATER_BLOCK_HISTORY_SIZE"`
@@ -97,16 +136,27 @@ type ConfigSchema struct {
 	InsecureFastScrypt                         bool                          `env:"INSECURE_FAST_SCRYPT" default:"false"`
 	InsecureSkipVerify                         bool                          `env:"INSECURE_SKIP_VERIFY" default:"false"`
 	JSONConsole                                bool                          `env:"JSON_CONSOLE" default:"false"`
+	JobPipelineMaxNodeTaskConcurrency          uint64                        `env:"JOB_PIPELINE_MAX_NODE_TASK_CONCURRENCY" default:"0"`
 	JobPipelineMaxRunDuration                  time.Duration                 `env:"JOB_PIPELINE_MAX_RUN_DURATION" default:"10m"`
+	JobPipelineMaxRunTaskConcurrency           uint64                        `env:"JOB_PIPELINE_MAX_RUN_TASK_CONCURRENCY" default:"0"`
 	JobPipelineReaperInterval                  time.Duration                 `env:"JOB_PIPELINE_REAPER_INTERVAL" default:"1h"`
 	JobPipelineReaperThreshold                 time.Duration                 `env:"JOB_PIPELINE_REAPER_THRESHOLD" default:"24h"`
 	JobPipelineResultWriteQueueDepth           uint64                        `env:"JOB_PIPELINE_RESULT_WRITE_QUEUE_DEPTH" default:"100"`
+	JobPipelineScriptTaskMaxDuration           time.Duration                 `env:"JOB_PIPELINE_SCRIPT_TASK_MAX_DURATION" default:"1s"`
+	JobPipelineScriptTaskMaxMemory             uint64                        `env:"JOB_PIPELINE_SCRIPT_TASK_MAX_MEMORY" default:"16777216"`
+	JobPipelineTraceHeaders                    []string                      `env:"JOB_PIPELINE_TRACE_HEADERS"`
+	JobMaxStartAttempts                        uint32                        `env:"JOB_MAX_START_ATTEMPTS" default:"5"`
+	JobStartTimeout                            time.Duration                 `env:"JOB_START_TIMEOUT" default:"30s"`
+	KeeperBatchPerformUpkeep                   bool                          `env:"KEEPER_BATCH_PERFORM_UPKEEP" default:"false"`
 	KeeperDefaultTransactionQueueDepth         uint32                        `env:"KEEPER_DEFAULT_TRANSACTION_QUEUE_DEPTH" default:"1"`
+	KeeperEligibilityStrategy                  string                        `env:"KEEPER_ELIGIBILITY_STRATEGY" default:"positioning"`
 	KeeperMaximumGracePeriod                   int64                         `env:"KEEPER_MAXIMUM_GRACE_PERIOD" default:"100"`
 	KeeperMinimumRequiredConfirmations         uint64                        `env:"KEEPER_MINIMUM_REQUIRED_CONFIRMATIONS" default:"12"`
 	KeeperRegistryCheckGasOverhead             uint64                        `env:"KEEPER_REGISTRY_CHECK_GAS_OVERHEAD" default:"200000"`
 	KeeperRegistryPerformGasOverhead           uint64                        `env:"KEEPER_REGISTRY_PERFORM_GAS_OVERHEAD" default:"150000"`
 	KeeperRegistrySyncInterval                 time.Duration                 `env:"KEEPER_REGISTRY_SYNC_INTERVAL" default:"30m"`
+	KeeperSimulationMaxConsecutiveReverts      uint32                        `env:"KEEPER_SIMULATION_MAX_CONSECUTIVE_REVERTS" default:"3"`
+	KeeperSimulationParkDuration               time.Duration                 `env:"KEEPER_SIMULATION_PARK_DURATION" default:"1h"`
 	LinkContractAddress                        string                        `env:"LINK_CONTRACT_ADDRESS"`
 	LogLevel                                   LogLevel                      `env:"LOG_LEVEL" default:"info"`
 	LogSQLMigrations                           bool                          `env:"LOG_SQL_MIGRATIONS" default:"true"`
@@ -159,6 +209,7 @@ type ConfigSchema struct {
 	ReplayFromBlock                            int64                         `env:"REPLAY_FROM_BLOCK" default:"-1"`
 	RootDir                                    string                        `env:"ROOT" default:"~/.chainlink"`
 	SecureCookies                              bool                          `env:"SECURE_COOKIES" default:"true"`
+	SessionCookieSameSite                      string                        `env:"SESSION_COOKIE_SAME_SITE" default:"lax"`
 	SessionTimeout                             models.Duration               `env:"SESSION_TIMEOUT" default:"15m"`
 	StatsPusherLogging                         string                        `env:"STATS_PUSHER_LOGGING" default:"false"`
 	TLSCertPath                                string                        `env:"TLS_CERT_PATH" `
@@ -167,8 +218,10 @@ type ConfigSchema struct {
 	TLSPort                                    uint16                        `env:"CHAINLINK_TLS_PORT" default:"6689"`
 	TLSRedirect                                bool                          `env:"CHAINLINK_TLS_REDIRECT" default:"false"`
 	TriggerFallbackDBPollInterval              time.Duration                 `env:"TRIGGER_FALLBACK_DB_POLL_INTERVAL" default:"30s"`
+	TrustedProxies                             []string                      `env:"TRUSTED_PROXIES"`
 	UnAuthenticatedRateLimit                   int64                         `env:"UNAUTHENTICATED_RATE_LIMIT" default:"5"`
 	UnAuthenticatedRateLimitPeriod             time.Duration                 `env:"UNAUTHENTICATED_RATE_LIMIT_PERIOD" default:"20s"`
+	VRFProofWorkers                            uint64                        `env:"VRF_PROOF_WORKERS" default:"4"`
 }
 
 // EnvVarName gets the environment variable name for a config schema field
@@ -11,7 +11,14 @@ import (
 func TestConfigSchema(t *testing.T) {
 	items := map[string]string{
 		"AdminCredentialsFile":                       "ADMIN_CREDENTIALS_FILE",
+		"AlertsEmailFrom":                            "ALERTS_EMAIL_FROM",
+		"AlertsEvaluationInterval":                   "ALERTS_EVALUATION_INTERVAL",
+		"AlertsSMTPURL":                              "ALERTS_SMTP_URL",
 		"AllowOrigins":                               "ALLOW_ORIGINS",
+		"AuthFailureLimit":                           "AUTH_FAILURE_LIMIT",
+		"AuthFailureWindow":                          "AUTH_FAILURE_WINDOW",
+		"AuthLockoutDuration":                        "AUTH_LOCKOUT_DURATION",
+		"AuthLockoutMaxDuration":                     "AUTH_LOCKOUT_MAX_DURATION",
 		"AuthenticatedRateLimit":                     "AUTHENTICATED_RATE_LIMIT",
 		"AuthenticatedRateLimitPeriod":               "AUTHENTICATED_RATE_LIMIT_PERIOD",
 		"BalanceMonitorEnabled":                      "BALANCE_MONITOR_ENABLED",
@@ -40,32 +47,58 @@ func TestConfigSchema(t *testing.T) {
 		"Dev":                                        "CHAINLINK_DEV",
 		"EnableExperimentalAdapters":                 "ENABLE_EXPERIMENTAL_ADAPTERS",
 		"EnableLegacyJobPipeline":                    "ENABLE_LEGACY_JOB_PIPELINE",
+		"EthAdditionalChains":                        "ETH_ADDITIONAL_CHAINS",
 		"EthBalanceMonitorBlockDelay":                "ETH_BALANCE_MONITOR_BLOCK_DELAY",
 		"EthFinalityDepth":                           "ETH_FINALITY_DEPTH",
 		"EthGasBumpPercent":                          "ETH_GAS_BUMP_PERCENT",
+		"EthGasBumpPercentHighUrgency":               "ETH_GAS_BUMP_PERCENT_HIGH_URGENCY",
 		"EthGasBumpThreshold":                        "ETH_GAS_BUMP_THRESHOLD",
+		"EthGasBumpThresholdHighUrgency":             "ETH_GAS_BUMP_THRESHOLD_HIGH_URGENCY",
+		"EthGasBumpThresholdLowUrgency":              "ETH_GAS_BUMP_THRESHOLD_LOW_URGENCY",
 		"EthGasBumpTxDepth":                          "ETH_GAS_BUMP_TX_DEPTH",
 		"EthGasBumpWei":                              "ETH_GAS_BUMP_WEI",
 		"EthGasLimitDefault":                         "ETH_GAS_LIMIT_DEFAULT",
+		"EthGasLimitEstimateMultiplier":              "ETH_GAS_LIMIT_ESTIMATE_MULTIPLIER",
 		"EthGasLimitMultiplier":                      "ETH_GAS_LIMIT_MULTIPLIER",
 		"EthGasLimitTransfer":                        "ETH_GAS_LIMIT_TRANSFER",
 		"EthGasPriceDefault":                         "ETH_GAS_PRICE_DEFAULT",
+		"EthHeadBroadcasterAsyncSlowSubscribers":     "ETH_HEAD_BROADCASTER_ASYNC_SLOW_SUBSCRIBERS",
+		"EthHeadBroadcasterCallbackBudget":           "ETH_HEAD_BROADCASTER_CALLBACK_BUDGET",
 		"EthHeadTrackerHistoryDepth":                 "ETH_HEAD_TRACKER_HISTORY_DEPTH",
 		"EthHeadTrackerMaxBufferSize":                "ETH_HEAD_TRACKER_MAX_BUFFER_SIZE",
+		"EthHeadTrackerMaxProviderLag":               "ETH_HEAD_TRACKER_MAX_PROVIDER_LAG",
+		"EthHeadTrackerSamplingBlocks":               "ETH_HEAD_TRACKER_SAMPLING_BLOCKS",
 		"EthHeadTrackerSamplingInterval":             "ETH_HEAD_TRACKER_SAMPLING_INTERVAL",
+		"EthHeadTrackerURLs":                         "ETH_HEAD_TRACKER_URLS",
 		"EthLogBackfillBatchSize":                    "ETH_LOG_BACKFILL_BATCH_SIZE",
 		"EthMaxGasPriceWei":                          "ETH_MAX_GAS_PRICE_WEI",
+		"EthMaxGasPriceWeiLowUrgency":                "ETH_MAX_GAS_PRICE_WEI_LOW_URGENCY",
 		"EthMaxInFlightTransactions":                 "ETH_MAX_IN_FLIGHT_TRANSACTIONS",
+		"EthMaxInFlightTransactionsPerKey":           "ETH_MAX_IN_FLIGHT_TRANSACTIONS_PER_KEY",
 		"EthMaxQueuedTransactions":                   "ETH_MAX_QUEUED_TRANSACTIONS",
 		"EthMinGasPriceWei":                          "ETH_MIN_GAS_PRICE_WEI",
+		"EthNodePollInterval":                        "ETH_NODE_POLL_INTERVAL",
 		"EthNonceAutoSync":                           "ETH_NONCE_AUTO_SYNC",
+		"EthNonceReconciliationInterval":             "ETH_NONCE_RECONCILIATION_INTERVAL",
+		"EthRPCCacheSize":                            "ETH_RPC_CACHE_SIZE",
+		"EthRPCCacheTTL":                             "ETH_RPC_CACHE_TTL",
 		"EthRPCDefaultBatchSize":                     "ETH_RPC_DEFAULT_BATCH_SIZE",
+		"EthRPCDetectCapabilities":                   "ETH_RPC_DETECT_CAPABILITIES",
+		"EthSendToAllNodes":                          "ETH_SEND_TO_ALL_NODES",
+		"EthTxDailyBudgetWei":                        "ETH_TX_DAILY_BUDGET_WEI",
+		"EthTxJobDailyBudgetWei":                     "ETH_TX_JOB_DAILY_BUDGET_WEI",
 		"EthTxReaperInterval":                        "ETH_TX_REAPER_INTERVAL",
 		"EthTxReaperThreshold":                       "ETH_TX_REAPER_THRESHOLD",
+		"EthTxMulticallAddress":                      "ETH_TX_MULTICALL_ADDRESS",
+		"EthTxMulticallMaxBatchSize":                 "ETH_TX_MULTICALL_MAX_BATCH_SIZE",
+		"EthTxMulticallWindow":                       "ETH_TX_MULTICALL_WINDOW",
 		"EthTxResendAfterThreshold":                  "ETH_TX_RESEND_AFTER_THRESHOLD",
+		"EthTxSimulateBeforeBroadcast":               "ETH_TX_SIMULATE_BEFORE_BROADCAST",
+		"EthUseFinalityTag":                          "ETH_USE_FINALITY_TAG",
 		"EthereumDisabled":                           "ETH_DISABLED",
 		"EthereumHTTPURL":                            "ETH_HTTP_URL",
 		"EthereumSecondaryURL":                       "ETH_SECONDARY_URL",
+		"EthereumPrimaryURLs":                        "ETH_PRIMARY_URLS",
 		"EthereumSecondaryURLs":                      "ETH_SECONDARY_URLS",
 		"EthereumURL":                                "ETH_URL",
 		"ExplorerAccessKey":                          "EXPLORER_ACCESS_KEY",
@@ -78,8 +111,14 @@ func TestConfigSchema(t *testing.T) {
 		"FeatureFluxMonitorV2":                       "FEATURE_FLUX_MONITOR_V2",
 		"FeatureOffchainReporting":                   "FEATURE_OFFCHAIN_REPORTING",
 		"FeatureWebhookV2":                           "FEATURE_WEBHOOK_V2",
+		"FeedsJobProposalRetention":                  "FEEDS_JOB_PROPOSAL_RETENTION",
 		"FlagsContractAddress":                       "FLAGS_CONTRACT_ADDRESS",
 		"GasEstimatorMode":                           "GAS_ESTIMATOR_MODE",
+		"GasOracleGasPricePath":                      "GAS_ORACLE_GAS_PRICE_PATH",
+		"GasOracleGasPriceUnit":                      "GAS_ORACLE_GAS_PRICE_UNIT",
+		"GasOracleMaxAge":                            "GAS_ORACLE_MAX_AGE",
+		"GasOraclePollPeriod":                        "GAS_ORACLE_POLL_PERIOD",
+		"GasOracleURL":                               "GAS_ORACLE_URL",
 		"GasUpdaterBatchSize":                        "GAS_UPDATER_BATCH_SIZE",
 		"GasUpdaterBlockDelay":                       "GAS_UPDATER_BLOCK_DELAY",
 		"GasUpdaterBlockHistorySize":                 "GAS_UPDATER_BLOCK_HISTORY_SIZE",
@@ -90,16 +129,27 @@ func TestConfigSchema(t *testing.T) {
 		"InsecureFastScrypt":                         "INSECURE_FAST_SCRYPT",
 		"InsecureSkipVerify":                         "INSECURE_SKIP_VERIFY",
 		"JSONConsole":                                "JSON_CONSOLE",
+		"JobPipelineMaxNodeTaskConcurrency":          "JOB_PIPELINE_MAX_NODE_TASK_CONCURRENCY",
 		"JobPipelineMaxRunDuration":                  "JOB_PIPELINE_MAX_RUN_DURATION",
+		"JobPipelineMaxRunTaskConcurrency":           "JOB_PIPELINE_MAX_RUN_TASK_CONCURRENCY",
 		"JobPipelineReaperInterval":                  "JOB_PIPELINE_REAPER_INTERVAL",
 		"JobPipelineReaperThreshold":                 "JOB_PIPELINE_REAPER_THRESHOLD",
 		"JobPipelineResultWriteQueueDepth":           "JOB_PIPELINE_RESULT_WRITE_QUEUE_DEPTH",
+		"JobPipelineScriptTaskMaxDuration":           "JOB_PIPELINE_SCRIPT_TASK_MAX_DURATION",
+		"JobPipelineScriptTaskMaxMemory":             "JOB_PIPELINE_SCRIPT_TASK_MAX_MEMORY",
+		"JobPipelineTraceHeaders":                    "JOB_PIPELINE_TRACE_HEADERS",
+		"JobMaxStartAttempts":                        "JOB_MAX_START_ATTEMPTS",
+		"JobStartTimeout":                            "JOB_START_TIMEOUT",
+		"KeeperBatchPerformUpkeep":                   "KEEPER_BATCH_PERFORM_UPKEEP",
 		"KeeperDefaultTransactionQueueDepth":         "KEEPER_DEFAULT_TRANSACTION_QUEUE_DEPTH",
+		"KeeperEligibilityStrategy":                  "KEEPER_ELIGIBILITY_STRATEGY",
 		"KeeperMaximumGracePeriod":                   "KEEPER_MAXIMUM_GRACE_PERIOD",
 		"KeeperMinimumRequiredConfirmations":         "KEEPER_MINIMUM_REQUIRED_CONFIRMATIONS",
 		"KeeperRegistryCheckGasOverhead":             "KEEPER_REGISTRY_CHECK_GAS_OVERHEAD",
 		"KeeperRegistryPerformGasOverhead":           "KEEPER_REGISTRY_PERFORM_GAS_OVERHEAD",
 		"KeeperRegistrySyncInterval":                 "KEEPER_REGISTRY_SYNC_INTERVAL",
+		"KeeperSimulationMaxConsecutiveReverts":      "KEEPER_SIMULATION_MAX_CONSECUTIVE_REVERTS",
+		"KeeperSimulationParkDuration":               "KEEPER_SIMULATION_PARK_DURATION",
 		"LinkContractAddress":                        "LINK_CONTRACT_ADDRESS",
 		"LogLevel":                                   "LOG_LEVEL",
 		"LogSQLMigrations":                           "LOG_SQL_MIGRATIONS",
@@ -154,6 +204,7 @@ func TestConfigSchema(t *testing.T) {
 		"ReplayFromBlock":                            "REPLAY_FROM_BLOCK",
 		"RootDir":                                    "ROOT",
 		"SecureCookies":                              "SECURE_COOKIES",
+		"SessionCookieSameSite":                      "SESSION_COOKIE_SAME_SITE",
 		"SessionTimeout":                             "SESSION_TIMEOUT",
 		"StatsPusherLogging":                         "STATS_PUSHER_LOGGING",
 		"TLSCertPath":                                "TLS_CERT_PATH",
@@ -162,8 +213,10 @@ func TestConfigSchema(t *testing.T) {
 		"TLSPort":                                    "CHAINLINK_TLS_PORT",
 		"TLSRedirect":                                "CHAINLINK_TLS_REDIRECT",
 		"TriggerFallbackDBPollInterval":              "TRIGGER_FALLBACK_DB_POLL_INTERVAL",
+		"TrustedProxies":                             "TRUSTED_PROXIES",
 		"UnAuthenticatedRateLimit":                   "UNAUTHENTICATED_RATE_LIMIT",
 		"UnAuthenticatedRateLimitPeriod":             "UNAUTHENTICATED_RATE_LIMIT_PERIOD",
+		"VRFProofWorkers":                            "VRF_PROOF_WORKERS",
 	}
 
 	schemaT := reflect.TypeOf(ConfigSchema{})
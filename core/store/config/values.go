@@ -0,0 +1,204 @@
+package config
+
+import (
+	"fmt"
+	"net/url"
+	"os"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+
+	"github.com/smartcontractkit/chainlink/core/store/models"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+// ValueSource is where the effective value of a configuration variable comes
+// from
+type ValueSource string
+
+const (
+	// ValueSourceDatabase is a runtime override saved in the database, e.g.
+	// by PATCH /v2/config
+	ValueSourceDatabase = ValueSource("database")
+	// ValueSourceChain is the config of the chain in the chain registry,
+	// which takes precedence over the environment
+	ValueSourceChain = ValueSource("chain")
+	// ValueSourceRuntime is a value set by the node itself, e.g. the P2P
+	// peer ID of its only key
+	ValueSourceRuntime = ValueSource("runtime")
+	// ValueSourceEnv is an environment variable
+	ValueSourceEnv = ValueSource("env")
+	// ValueSourceFile is the chainlink config file in the root directory
+	ValueSourceFile = ValueSource("file")
+	// ValueSourceDefault is the default of the schema or of the chain
+	ValueSourceDefault = ValueSource("default")
+)
+
+// redactedValue replaces the values of secrets and URL passwords, paths and
+// queries
+const redactedValue = "xxxxx"
+
+// databaseOverridable are the fields whose getters read a runtime override
+// from the database before anything else
+var databaseOverridable = map[string]bool{
+	"EthGasPriceDefault": true,
+	"LogLevel":           true,
+	"LogSQLStatements":   true,
+}
+
+// Value is the effective value of a configuration variable and where it comes
+// from
+type Value struct {
+	// Name is the environment variable of the configuration variable
+	Name     string
+	Value    string
+	Source   ValueSource
+	Redacted bool
+}
+
+// Values returns the effective value of every configuration variable of the
+// schema, ordered by name, for operators to find out which value the node is
+// actually using. Secrets and URL passwords are redacted, as are the paths and
+// queries of URLs tagged secret:"url", which often carry API keys. The chain
+// specific values are those of the config's chain, see ForChain.
+func (c Config) Values() ([]Value, error) {
+	overrides := make(map[string]bool)
+	if c.ORM != nil {
+		var names []string
+		if err := c.ORM.db.Model(&models.Configuration{}).Pluck("name", &names).Error; err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.Wrap(err, "failed to load config overrides")
+		}
+		for _, name := range names {
+			overrides[name] = true
+		}
+	}
+
+	chainOverridden := reflect.ValueOf(chainOverrides(c))
+
+	schemaT := reflect.TypeOf(ConfigSchema{})
+	values := make([]Value, 0, schemaT.NumField())
+	for index := 0; index < schemaT.NumField(); index++ {
+		item := schemaT.Field(index)
+		name := item.Tag.Get("env")
+		value := Value{
+			Name:   name,
+			Value:  c.effectiveValue(item.Name, name),
+			Source: c.valueSource(item, overrides[name], isChainOverridden(chainOverridden, item.Name)),
+		}
+		switch item.Tag.Get("secret") {
+		case "true":
+			if value.Value != "" {
+				value.Value = redactedValue
+			}
+			value.Redacted = true
+		case "url":
+			if redacted := redactURLs(value.Value); redacted != value.Value {
+				value.Value = redacted
+				value.Redacted = true
+			}
+		default:
+			if redacted := redactURLPasswords(value.Value); redacted != value.Value {
+				value.Value = redacted
+				value.Redacted = true
+			}
+		}
+		values = append(values, value)
+	}
+	sort.Slice(values, func(i, j int) bool { return values[i].Name < values[j].Name })
+	return values, nil
+}
+
+// effectiveValue returns the value of the field's getter, called without any
+// of the overrides some getters take, or the raw value of fields without a
+// getter, which are deprecated
+func (c Config) effectiveValue(field, name string) string {
+	getter := reflect.ValueOf(c).MethodByName(field)
+	if !getter.IsValid() || getter.Type().NumOut() == 0 {
+		return c.viper.GetString(name)
+	}
+	args := make([]reflect.Value, getter.Type().NumIn())
+	for i := range args {
+		args[i] = reflect.Zero(getter.Type().In(i))
+	}
+	return formatValue(getter.Call(args)[0])
+}
+
+// isChainOverridden returns true if the chain registry sets the field of the
+// same name in the overrides of the chain
+func isChainOverridden(overrides reflect.Value, field string) bool {
+	v := overrides.FieldByName(field)
+	return v.IsValid() && !v.IsNil()
+}
+
+func (c Config) valueSource(item reflect.StructField, overridden, chainOverridden bool) ValueSource {
+	name := item.Tag.Get("env")
+	if overridden && databaseOverridable[item.Name] {
+		return ValueSourceDatabase
+	}
+	if chainOverridden {
+		return ValueSourceChain
+	}
+	raw := c.viper.GetString(name)
+	if env, exists := os.LookupEnv(name); exists && env != "" && env == raw {
+		return ValueSourceEnv
+	}
+	if c.viper.InConfig(strings.ToLower(name)) {
+		return ValueSourceFile
+	}
+	if def, _ := item.Tag.Lookup("default"); raw == "" || raw == def {
+		return ValueSourceDefault
+	}
+	return ValueSourceRuntime
+}
+
+func formatValue(v reflect.Value) string {
+	if (v.Kind() == reflect.Ptr || v.Kind() == reflect.Map || v.Kind() == reflect.Slice || v.Kind() == reflect.Interface) && v.IsNil() {
+		return ""
+	}
+	if v.Kind() == reflect.Slice && v.Type().Elem().Kind() != reflect.Uint8 {
+		elems := make([]string, v.Len())
+		for i := range elems {
+			elems[i] = formatValue(v.Index(i))
+		}
+		return strings.Join(elems, ",")
+	}
+	if u, ok := v.Interface().(url.URL); ok {
+		return u.String()
+	}
+	return fmt.Sprint(v.Interface())
+}
+
+// redactURLPasswords masks the passwords of a URL or comma separated list of
+// URLs, e.g. of eth nodes with basic auth
+func redactURLPasswords(s string) string {
+	if !strings.Contains(s, "://") {
+		return s
+	}
+	parts := strings.Split(s, ",")
+	for i, part := range parts {
+		u, err := url.Parse(part)
+		if err != nil || u.User == nil {
+			continue
+		}
+		if _, hasPassword := u.User.Password(); hasPassword {
+			parts[i] = u.Redacted()
+		}
+	}
+	return strings.Join(parts, ",")
+}
+
+// redactURLs masks the passwords, paths and queries of a URL or comma
+// separated list of URLs, e.g. of eth nodes with an API key in the path
+func redactURLs(s string) string {
+	if s == "" {
+		return s
+	}
+	parts := strings.Split(s, ",")
+	for i, part := range parts {
+		parts[i] = utils.RedactURL(strings.TrimSpace(part))
+	}
+	return strings.Join(parts, ",")
+}
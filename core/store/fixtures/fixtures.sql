@@ -25,10 +25,11 @@ VALUES
     E'2021-01-22 02:59:40.085609+00'
 );
 
-INSERT INTO users (email, hashed_password, token_secret, created_at, updated_at) VALUES (
+INSERT INTO users (email, hashed_password, token_secret, created_at, updated_at, admin) VALUES (
     'apiuser@chainlink.test',
     '$2a$10$Ee8YjCtcBgflgR7NWmii.u5kwOuWNF1bniacRf/sqobB5YaQv.Lm.', -- hash of literal string 'p4SsW0rD1!@#_'
     '1eCP/w0llVkchejFaoBpfIGaLRxZK54lTXBCT22YLW+pdzE4Fafy/XO5LoJ2uwHi',
     '2019-01-01',
-    '2019-01-01'
+    '2019-01-01',
+    true
 );
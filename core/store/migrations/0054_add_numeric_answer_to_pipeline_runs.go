@@ -0,0 +1,27 @@
+package migrations
+
+import (
+	"gorm.io/gorm"
+)
+
+const up54 = `
+	ALTER TABLE pipeline_runs ADD COLUMN numeric_answer numeric;
+	CREATE INDEX idx_pipeline_runs_pipeline_spec_id_numeric_answer ON pipeline_runs (pipeline_spec_id, created_at DESC) WHERE numeric_answer IS NOT NULL;
+`
+
+const down54 = `
+	DROP INDEX IF EXISTS idx_pipeline_runs_pipeline_spec_id_numeric_answer;
+	ALTER TABLE pipeline_runs DROP COLUMN numeric_answer;
+`
+
+func init() {
+	Migrations = append(Migrations, &Migration{
+		ID: "0054_add_numeric_answer_to_pipeline_runs",
+		Migrate: func(db *gorm.DB) error {
+			return db.Exec(up54).Error
+		},
+		Rollback: func(db *gorm.DB) error {
+			return db.Exec(down54).Error
+		},
+	})
+}
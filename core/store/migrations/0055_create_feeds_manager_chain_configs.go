@@ -0,0 +1,38 @@
+package migrations
+
+import (
+	"gorm.io/gorm"
+)
+
+const up55 = `
+CREATE TABLE feeds_manager_chain_configs (
+	id BIGSERIAL PRIMARY KEY,
+	feeds_manager_id bigint NOT NULL REFERENCES feeds_managers (id) ON DELETE CASCADE,
+	chain_id bigint NOT NULL,
+	account_address bytea NOT NULL,
+	ocr_key_bundle_id bytea,
+	created_at timestamp with time zone NOT NULL,
+	updated_at timestamp with time zone NOT NULL,
+	CONSTRAINT chk_account_address_length CHECK (octet_length(account_address) = 20)
+);
+CREATE UNIQUE INDEX idx_feeds_manager_chain_configs_feeds_manager_id_chain_id ON feeds_manager_chain_configs (feeds_manager_id, chain_id);
+
+ALTER TABLE job_proposals ADD COLUMN chain_id bigint;
+`
+
+const down55 = `
+	ALTER TABLE job_proposals DROP COLUMN chain_id;
+	DROP TABLE feeds_manager_chain_configs;
+`
+
+func init() {
+	Migrations = append(Migrations, &Migration{
+		ID: "0055_create_feeds_manager_chain_configs",
+		Migrate: func(db *gorm.DB) error {
+			return db.Exec(up55).Error
+		},
+		Rollback: func(db *gorm.DB) error {
+			return db.Exec(down55).Error
+		},
+	})
+}
@@ -0,0 +1,31 @@
+package migrations
+
+import (
+	"gorm.io/gorm"
+)
+
+const up56 = `
+	ALTER TABLE bridge_types ADD COLUMN credit_cost numeric NOT NULL DEFAULT 0;
+	ALTER TABLE pipeline_runs ADD COLUMN bridge_credits numeric;
+	ALTER TABLE eth_txes ADD COLUMN pipeline_run_id bigint;
+	CREATE INDEX idx_eth_txes_pipeline_run_id ON eth_txes (pipeline_run_id) WHERE pipeline_run_id IS NOT NULL;
+`
+
+const down56 = `
+	DROP INDEX IF EXISTS idx_eth_txes_pipeline_run_id;
+	ALTER TABLE eth_txes DROP COLUMN pipeline_run_id;
+	ALTER TABLE pipeline_runs DROP COLUMN bridge_credits;
+	ALTER TABLE bridge_types DROP COLUMN credit_cost;
+`
+
+func init() {
+	Migrations = append(Migrations, &Migration{
+		ID: "0056_add_cost_attribution",
+		Migrate: func(db *gorm.DB) error {
+			return db.Exec(up56).Error
+		},
+		Rollback: func(db *gorm.DB) error {
+			return db.Exec(down56).Error
+		},
+	})
+}
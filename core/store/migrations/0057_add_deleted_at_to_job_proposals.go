@@ -0,0 +1,29 @@
+package migrations
+
+import (
+	"gorm.io/gorm"
+)
+
+const up57 = `
+ALTER TABLE job_proposals ADD COLUMN deleted_at timestamptz;
+
+CREATE INDEX idx_job_proposals_deleted_at ON job_proposals (deleted_at) WHERE deleted_at IS NOT NULL;
+`
+
+const down57 = `
+DROP INDEX idx_job_proposals_deleted_at;
+
+ALTER TABLE job_proposals DROP COLUMN deleted_at;
+`
+
+func init() {
+	Migrations = append(Migrations, &Migration{
+		ID: "0057_add_deleted_at_to_job_proposals",
+		Migrate: func(db *gorm.DB) error {
+			return db.Exec(up57).Error
+		},
+		Rollback: func(db *gorm.DB) error {
+			return db.Exec(down57).Error
+		},
+	})
+}
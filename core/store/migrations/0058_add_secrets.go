@@ -0,0 +1,34 @@
+package migrations
+
+import (
+	"gorm.io/gorm"
+)
+
+const up58 = `
+CREATE TABLE secrets (
+	id BIGSERIAL PRIMARY KEY,
+	name TEXT NOT NULL,
+	encrypted_value JSONB NOT NULL,
+	created_at timestamptz NOT NULL,
+	updated_at timestamptz NOT NULL,
+	CONSTRAINT chk_name CHECK (name ~ '^[A-Za-z_][A-Za-z0-9_]*$')
+);
+
+CREATE UNIQUE INDEX idx_secrets_name ON secrets (name);
+`
+
+const down58 = `
+DROP TABLE secrets;
+`
+
+func init() {
+	Migrations = append(Migrations, &Migration{
+		ID: "0058_add_secrets",
+		Migrate: func(db *gorm.DB) error {
+			return db.Exec(up58).Error
+		},
+		Rollback: func(db *gorm.DB) error {
+			return db.Exec(down58).Error
+		},
+	})
+}
@@ -0,0 +1,25 @@
+package migrations
+
+import (
+	"gorm.io/gorm"
+)
+
+const up59 = `
+ALTER TABLE pipeline_specs ADD COLUMN input_schema JSONB;
+`
+
+const down59 = `
+ALTER TABLE pipeline_specs DROP COLUMN input_schema;
+`
+
+func init() {
+	Migrations = append(Migrations, &Migration{
+		ID: "0059_add_pipeline_spec_input_schema",
+		Migrate: func(db *gorm.DB) error {
+			return db.Exec(up59).Error
+		},
+		Rollback: func(db *gorm.DB) error {
+			return db.Exec(down59).Error
+		},
+	})
+}
@@ -0,0 +1,27 @@
+package migrations
+
+import (
+	"gorm.io/gorm"
+)
+
+const up60 = `
+	ALTER TABLE bridge_types ADD COLUMN max_concurrency bigint NOT NULL DEFAULT 0 CHECK (max_concurrency >= 0);
+	ALTER TABLE bridge_types ADD COLUMN rate_limit numeric NOT NULL DEFAULT 0 CHECK (rate_limit >= 0);
+`
+
+const down60 = `
+	ALTER TABLE bridge_types DROP COLUMN rate_limit;
+	ALTER TABLE bridge_types DROP COLUMN max_concurrency;
+`
+
+func init() {
+	Migrations = append(Migrations, &Migration{
+		ID: "0060_add_bridge_rate_limits",
+		Migrate: func(db *gorm.DB) error {
+			return db.Exec(up60).Error
+		},
+		Rollback: func(db *gorm.DB) error {
+			return db.Exec(down60).Error
+		},
+	})
+}
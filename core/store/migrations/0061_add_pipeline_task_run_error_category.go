@@ -0,0 +1,28 @@
+package migrations
+
+import (
+	"gorm.io/gorm"
+)
+
+const up61 = `
+	ALTER TABLE pipeline_task_runs ADD COLUMN error_category text;
+	ALTER TABLE pipeline_task_runs ADD COLUMN error_retryable boolean NOT NULL DEFAULT false;
+	CREATE INDEX idx_pipeline_task_runs_error_category ON pipeline_task_runs (error_category) WHERE error_category IS NOT NULL;
+`
+
+const down61 = `
+	ALTER TABLE pipeline_task_runs DROP COLUMN error_retryable;
+	ALTER TABLE pipeline_task_runs DROP COLUMN error_category;
+`
+
+func init() {
+	Migrations = append(Migrations, &Migration{
+		ID: "0061_add_pipeline_task_run_error_category",
+		Migrate: func(db *gorm.DB) error {
+			return db.Exec(up61).Error
+		},
+		Rollback: func(db *gorm.DB) error {
+			return db.Exec(down61).Error
+		},
+	})
+}
@@ -0,0 +1,25 @@
+package migrations
+
+import (
+	"gorm.io/gorm"
+)
+
+const up62 = `
+	ALTER TABLE eth_txes ADD COLUMN urgency text NOT NULL DEFAULT 'normal' CHECK (urgency IN ('low', 'normal', 'high'));
+`
+
+const down62 = `
+	ALTER TABLE eth_txes DROP COLUMN urgency;
+`
+
+func init() {
+	Migrations = append(Migrations, &Migration{
+		ID: "0062_add_eth_tx_urgency",
+		Migrate: func(db *gorm.DB) error {
+			return db.Exec(up62).Error
+		},
+		Rollback: func(db *gorm.DB) error {
+			return db.Exec(down62).Error
+		},
+	})
+}
@@ -0,0 +1,27 @@
+package migrations
+
+import (
+	"gorm.io/gorm"
+)
+
+const up63 = `
+	ALTER TABLE eth_txes ADD COLUMN expires_at timestamptz, ADD COLUMN expires_at_block bigint, ADD COLUMN cancelled_at timestamptz;
+	CREATE INDEX idx_eth_txes_expiring ON eth_txes (from_address, state) WHERE (expires_at IS NOT NULL OR expires_at_block IS NOT NULL) AND cancelled_at IS NULL;
+`
+
+const down63 = `
+	DROP INDEX idx_eth_txes_expiring;
+	ALTER TABLE eth_txes DROP COLUMN expires_at, DROP COLUMN expires_at_block, DROP COLUMN cancelled_at;
+`
+
+func init() {
+	Migrations = append(Migrations, &Migration{
+		ID: "0063_add_eth_tx_expiry",
+		Migrate: func(db *gorm.DB) error {
+			return db.Exec(up63).Error
+		},
+		Rollback: func(db *gorm.DB) error {
+			return db.Exec(down63).Error
+		},
+	})
+}
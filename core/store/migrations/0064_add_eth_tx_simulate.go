@@ -0,0 +1,25 @@
+package migrations
+
+import (
+	"gorm.io/gorm"
+)
+
+const up64 = `
+	ALTER TABLE eth_txes ADD COLUMN simulate boolean NOT NULL DEFAULT TRUE;
+`
+
+const down64 = `
+	ALTER TABLE eth_txes DROP COLUMN simulate;
+`
+
+func init() {
+	Migrations = append(Migrations, &Migration{
+		ID: "0064_add_eth_tx_simulate",
+		Migrate: func(db *gorm.DB) error {
+			return db.Exec(up64).Error
+		},
+		Rollback: func(db *gorm.DB) error {
+			return db.Exec(down64).Error
+		},
+	})
+}
@@ -0,0 +1,36 @@
+package migrations
+
+import (
+	"gorm.io/gorm"
+)
+
+const up65 = `
+	ALTER TABLE eth_txes ADD COLUMN evm_chain_id numeric(78,0);
+	CREATE INDEX idx_eth_txes_evm_chain_id_from_address_state ON eth_txes (evm_chain_id, from_address, state) WHERE evm_chain_id IS NOT NULL;
+
+	CREATE TABLE eth_key_states (
+		address bytea NOT NULL REFERENCES keys (address) ON DELETE CASCADE,
+		evm_chain_id numeric(78,0) NOT NULL,
+		next_nonce bigint NOT NULL DEFAULT 0,
+		created_at timestamptz NOT NULL,
+		updated_at timestamptz NOT NULL,
+		PRIMARY KEY (address, evm_chain_id)
+	);
+`
+
+const down65 = `
+	DROP TABLE eth_key_states;
+	ALTER TABLE eth_txes DROP COLUMN evm_chain_id;
+`
+
+func init() {
+	Migrations = append(Migrations, &Migration{
+		ID: "0065_add_eth_tx_evm_chain_id",
+		Migrate: func(db *gorm.DB) error {
+			return db.Exec(up65).Error
+		},
+		Rollback: func(db *gorm.DB) error {
+			return db.Exec(down65).Error
+		},
+	})
+}
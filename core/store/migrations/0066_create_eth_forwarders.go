@@ -0,0 +1,33 @@
+package migrations
+
+import (
+	"gorm.io/gorm"
+)
+
+const up66 = `
+	CREATE TABLE eth_forwarders (
+		id BIGSERIAL PRIMARY KEY,
+		address bytea NOT NULL,
+		evm_chain_id numeric(78,0),
+		created_at timestamptz NOT NULL,
+		updated_at timestamptz NOT NULL,
+		CONSTRAINT chk_address_length CHECK (octet_length(address) = 20)
+	);
+	CREATE UNIQUE INDEX idx_eth_forwarders_address_evm_chain_id ON eth_forwarders (address, COALESCE(evm_chain_id, -1));
+`
+
+const down66 = `
+	DROP TABLE eth_forwarders;
+`
+
+func init() {
+	Migrations = append(Migrations, &Migration{
+		ID: "0066_create_eth_forwarders",
+		Migrate: func(db *gorm.DB) error {
+			return db.Exec(up66).Error
+		},
+		Rollback: func(db *gorm.DB) error {
+			return db.Exec(down66).Error
+		},
+	})
+}
@@ -0,0 +1,75 @@
+package migrations
+
+import (
+	"gorm.io/gorm"
+)
+
+// A new enum value cannot be used in the transaction which adds it, so this
+// migration runs without a transaction, one statement at a time
+const up67AddState = `ALTER TYPE eth_txes_state ADD VALUE IF NOT EXISTS 'batched'`
+
+const up67 = `
+	ALTER TABLE eth_txes
+		ADD COLUMN batchable boolean NOT NULL DEFAULT false,
+		ADD COLUMN batch_eth_tx_id bigint REFERENCES eth_txes (id) ON DELETE CASCADE,
+		ADD COLUMN batch_index integer;
+	CREATE INDEX idx_eth_txes_batch_eth_tx_id ON eth_txes (batch_eth_tx_id) WHERE batch_eth_tx_id IS NOT NULL;
+
+	ALTER TABLE eth_txes DROP CONSTRAINT chk_eth_txes_fsm;
+	ALTER TABLE eth_txes ADD CONSTRAINT chk_eth_txes_fsm CHECK (
+		state = 'unstarted'::eth_txes_state AND nonce IS NULL AND error IS NULL AND broadcast_at IS NULL
+		OR
+		state = 'in_progress'::eth_txes_state AND nonce IS NOT NULL AND error IS NULL AND broadcast_at IS NULL
+		OR
+		state = 'fatal_error'::eth_txes_state AND nonce IS NULL AND error IS NOT NULL AND broadcast_at IS NULL
+		OR
+		state = 'unconfirmed'::eth_txes_state AND nonce IS NOT NULL AND error IS NULL AND broadcast_at IS NOT NULL
+		OR
+		state = 'confirmed'::eth_txes_state AND nonce IS NOT NULL AND error IS NULL AND broadcast_at IS NOT NULL
+		OR
+		state = 'confirmed_missing_receipt'::eth_txes_state AND nonce IS NOT NULL AND error IS NULL AND broadcast_at IS NOT NULL
+		OR
+		state = 'batched'::eth_txes_state AND nonce IS NULL AND error IS NULL AND broadcast_at IS NULL
+	);
+	ALTER TABLE eth_txes ADD CONSTRAINT chk_eth_txes_batch CHECK (
+		(state = 'batched'::eth_txes_state) = (batch_eth_tx_id IS NOT NULL AND batch_index IS NOT NULL)
+	);
+`
+
+// The batched enum value cannot be removed, but is unused once this is rolled
+// back
+const down67 = `
+	DELETE FROM eth_txes WHERE state = 'batched';
+	ALTER TABLE eth_txes DROP CONSTRAINT chk_eth_txes_batch;
+	ALTER TABLE eth_txes DROP CONSTRAINT chk_eth_txes_fsm;
+	ALTER TABLE eth_txes ADD CONSTRAINT chk_eth_txes_fsm CHECK (
+		state = 'unstarted'::eth_txes_state AND nonce IS NULL AND error IS NULL AND broadcast_at IS NULL
+		OR
+		state = 'in_progress'::eth_txes_state AND nonce IS NOT NULL AND error IS NULL AND broadcast_at IS NULL
+		OR
+		state = 'fatal_error'::eth_txes_state AND nonce IS NULL AND error IS NOT NULL AND broadcast_at IS NULL
+		OR
+		state = 'unconfirmed'::eth_txes_state AND nonce IS NOT NULL AND error IS NULL AND broadcast_at IS NOT NULL
+		OR
+		state = 'confirmed'::eth_txes_state AND nonce IS NOT NULL AND error IS NULL AND broadcast_at IS NOT NULL
+		OR
+		state = 'confirmed_missing_receipt'::eth_txes_state AND nonce IS NOT NULL AND error IS NULL AND broadcast_at IS NOT NULL
+	);
+	ALTER TABLE eth_txes DROP COLUMN batch_index, DROP COLUMN batch_eth_tx_id, DROP COLUMN batchable;
+`
+
+func init() {
+	Migrations = append(Migrations, &Migration{
+		ID: "0067_add_eth_tx_batches",
+		Migrate: func(db *gorm.DB) error {
+			if err := db.Exec(up67AddState).Error; err != nil {
+				return err
+			}
+			return db.Exec(up67).Error
+		},
+		Rollback: func(db *gorm.DB) error {
+			return db.Exec(down67).Error
+		},
+		DisableTransaction: true,
+	})
+}
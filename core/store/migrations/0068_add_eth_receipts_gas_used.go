@@ -0,0 +1,28 @@
+package migrations
+
+import (
+	"gorm.io/gorm"
+)
+
+// gas_used is backfilled from the hex encoded gasUsed of existing receipts
+const up68 = `
+	ALTER TABLE eth_receipts ADD COLUMN gas_used bigint;
+	UPDATE eth_receipts SET gas_used = ('x' || lpad(substring(receipt->>'gasUsed' from 3), 16, '0'))::bit(64)::bigint
+	WHERE receipt->>'gasUsed' LIKE '0x%';
+`
+
+const down68 = `
+	ALTER TABLE eth_receipts DROP COLUMN gas_used;
+`
+
+func init() {
+	Migrations = append(Migrations, &Migration{
+		ID: "0068_add_eth_receipts_gas_used",
+		Migrate: func(db *gorm.DB) error {
+			return db.Exec(up68).Error
+		},
+		Rollback: func(db *gorm.DB) error {
+			return db.Exec(down68).Error
+		},
+	})
+}
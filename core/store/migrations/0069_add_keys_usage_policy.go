@@ -0,0 +1,29 @@
+package migrations
+
+import (
+	"gorm.io/gorm"
+)
+
+// A NULL allowed_job_types or allowed_to_addresses does not restrict the key
+const up69 = `
+	ALTER TABLE keys
+		ADD COLUMN funding_only boolean NOT NULL DEFAULT false,
+		ADD COLUMN allowed_job_types text[],
+		ADD COLUMN allowed_to_addresses bytea[];
+`
+
+const down69 = `
+	ALTER TABLE keys DROP COLUMN allowed_to_addresses, DROP COLUMN allowed_job_types, DROP COLUMN funding_only;
+`
+
+func init() {
+	Migrations = append(Migrations, &Migration{
+		ID: "0069_add_keys_usage_policy",
+		Migrate: func(db *gorm.DB) error {
+			return db.Exec(up69).Error
+		},
+		Rollback: func(db *gorm.DB) error {
+			return db.Exec(down69).Error
+		},
+	})
+}
@@ -0,0 +1,35 @@
+package migrations
+
+import (
+	"gorm.io/gorm"
+)
+
+// key_rotations is the audit log of eth key rotations
+const up70 = `
+	CREATE TABLE key_rotations (
+		id BIGSERIAL PRIMARY KEY,
+		old_address bytea NOT NULL CHECK (octet_length(old_address) = 20),
+		new_address bytea NOT NULL CHECK (octet_length(new_address) = 20),
+		sweep_eth_tx_id bigint REFERENCES eth_txes (id) ON DELETE SET NULL,
+		sweep_value numeric(78,0) NOT NULL DEFAULT 0,
+		job_ids integer[] NOT NULL DEFAULT '{}',
+		created_at timestamptz NOT NULL
+	);
+	CREATE INDEX idx_key_rotations_old_address ON key_rotations (old_address);
+`
+
+const down70 = `
+	DROP TABLE key_rotations;
+`
+
+func init() {
+	Migrations = append(Migrations, &Migration{
+		ID: "0070_add_key_rotations",
+		Migrate: func(db *gorm.DB) error {
+			return db.Exec(up70).Error
+		},
+		Rollback: func(db *gorm.DB) error {
+			return db.Exec(down70).Error
+		},
+	})
+}
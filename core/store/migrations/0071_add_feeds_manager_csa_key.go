@@ -0,0 +1,28 @@
+package migrations
+
+import (
+	"gorm.io/gorm"
+)
+
+// csa_key_id pins the CSA key a feeds manager connects with. Existing
+// managers keep using the oldest key, which they connected with until now.
+const up71 = `
+	ALTER TABLE feeds_managers ADD COLUMN csa_key_id bigint REFERENCES csa_keys (id) ON DELETE SET NULL;
+	UPDATE feeds_managers SET csa_key_id = (SELECT id FROM csa_keys ORDER BY id ASC LIMIT 1);
+`
+
+const down71 = `
+	ALTER TABLE feeds_managers DROP COLUMN csa_key_id;
+`
+
+func init() {
+	Migrations = append(Migrations, &Migration{
+		ID: "0071_add_feeds_manager_csa_key",
+		Migrate: func(db *gorm.DB) error {
+			return db.Exec(up71).Error
+		},
+		Rollback: func(db *gorm.DB) error {
+			return db.Exec(down71).Error
+		},
+	})
+}
@@ -0,0 +1,49 @@
+package migrations
+
+import (
+	"gorm.io/gorm"
+)
+
+// key_accesses is the append-only audit log of key material access and
+// signing. job_id has no foreign key so that entries outlive their jobs.
+const up72 = `
+	CREATE TABLE key_accesses (
+		id BIGSERIAL PRIMARY KEY,
+		key_type text NOT NULL,
+		key_id text NOT NULL,
+		operation text NOT NULL,
+		service text NOT NULL,
+		job_id integer,
+		purpose text NOT NULL DEFAULT '',
+		created_at timestamptz NOT NULL
+	);
+	CREATE INDEX idx_key_accesses_key ON key_accesses (key_type, key_id);
+	CREATE INDEX idx_key_accesses_job_id ON key_accesses (job_id) WHERE job_id IS NOT NULL;
+	CREATE INDEX idx_key_accesses_created_at ON key_accesses (created_at);
+
+	CREATE FUNCTION reject_key_access_changes() RETURNS TRIGGER AS $$
+	BEGIN
+		RAISE EXCEPTION 'key_accesses is append-only';
+	END;
+	$$ LANGUAGE plpgsql;
+
+	CREATE TRIGGER key_accesses_append_only BEFORE UPDATE OR DELETE ON key_accesses
+	FOR EACH ROW EXECUTE PROCEDURE reject_key_access_changes();
+`
+
+const down72 = `
+	DROP TABLE key_accesses;
+	DROP FUNCTION reject_key_access_changes;
+`
+
+func init() {
+	Migrations = append(Migrations, &Migration{
+		ID: "0072_add_key_accesses",
+		Migrate: func(db *gorm.DB) error {
+			return db.Exec(up72).Error
+		},
+		Rollback: func(db *gorm.DB) error {
+			return db.Exec(down72).Error
+		},
+	})
+}
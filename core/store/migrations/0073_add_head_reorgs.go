@@ -0,0 +1,36 @@
+package migrations
+
+import (
+	"gorm.io/gorm"
+)
+
+// head_reorgs records every reorg observed by the head tracker. Unlike heads,
+// it is not trimmed, so that reorg statistics cover the node's whole history.
+const up73 = `
+	CREATE TABLE head_reorgs (
+		id BIGSERIAL PRIMARY KEY,
+		evm_chain_id numeric(78,0) NOT NULL,
+		depth bigint NOT NULL CHECK (depth > 0),
+		block_number bigint NOT NULL,
+		old_head_hash bytea NOT NULL,
+		new_head_hash bytea NOT NULL,
+		created_at timestamptz NOT NULL
+	);
+	CREATE INDEX idx_head_reorgs_evm_chain_id_created_at ON head_reorgs (evm_chain_id, created_at);
+`
+
+const down73 = `
+	DROP TABLE head_reorgs;
+`
+
+func init() {
+	Migrations = append(Migrations, &Migration{
+		ID: "0073_add_head_reorgs",
+		Migrate: func(db *gorm.DB) error {
+			return db.Exec(up73).Error
+		},
+		Rollback: func(db *gorm.DB) error {
+			return db.Exec(down73).Error
+		},
+	})
+}
@@ -0,0 +1,33 @@
+package migrations
+
+import (
+	"gorm.io/gorm"
+)
+
+// Tags let operators address a group of jobs, e.g. all the feeds of a
+// product, and paused jobs are not started by any node until resumed.
+const up74 = `
+	ALTER TABLE jobs
+		ADD COLUMN tags text[] NOT NULL DEFAULT '{}',
+		ADD COLUMN paused boolean NOT NULL DEFAULT false;
+	CREATE INDEX idx_jobs_tags ON jobs USING GIN (tags);
+`
+
+const down74 = `
+	DROP INDEX idx_jobs_tags;
+	ALTER TABLE jobs
+		DROP COLUMN tags,
+		DROP COLUMN paused;
+`
+
+func init() {
+	Migrations = append(Migrations, &Migration{
+		ID: "0074_add_job_tags_and_paused",
+		Migrate: func(db *gorm.DB) error {
+			return db.Exec(up74).Error
+		},
+		Rollback: func(db *gorm.DB) error {
+			return db.Exec(down74).Error
+		},
+	})
+}
@@ -0,0 +1,27 @@
+package migrations
+
+import (
+	"gorm.io/gorm"
+)
+
+const up75 = `
+	ALTER TABLE job_spec_errors_v2 ADD COLUMN severity text NOT NULL DEFAULT 'error' CHECK (severity IN ('error', 'warning'));
+	CREATE INDEX idx_job_spec_errors_v2_updated_at ON job_spec_errors_v2 (updated_at);
+`
+
+const down75 = `
+	DROP INDEX idx_job_spec_errors_v2_updated_at;
+	ALTER TABLE job_spec_errors_v2 DROP COLUMN severity;
+`
+
+func init() {
+	Migrations = append(Migrations, &Migration{
+		ID: "0075_add_job_spec_error_severity",
+		Migrate: func(db *gorm.DB) error {
+			return db.Exec(up75).Error
+		},
+		Rollback: func(db *gorm.DB) error {
+			return db.Exec(down75).Error
+		},
+	})
+}
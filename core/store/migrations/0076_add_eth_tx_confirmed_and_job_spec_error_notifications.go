@@ -0,0 +1,61 @@
+package migrations
+
+import (
+	"gorm.io/gorm"
+)
+
+// Notifies when an eth_tx is confirmed and when a job spec error is recorded,
+// for the node events stream
+const up76 = `
+CREATE OR REPLACE FUNCTION public.notifyethtxconfirmed() RETURNS trigger
+    LANGUAGE plpgsql
+    AS $$
+        BEGIN
+		PERFORM pg_notify('eth_tx_confirmed'::text, json_build_object(
+			'id', NEW.id,
+			'fromAddress', '0x' || encode(NEW.from_address, 'hex')
+		)::text);
+		RETURN NULL;
+        END
+        $$;
+
+CREATE TRIGGER notify_eth_tx_confirmed AFTER UPDATE OF state ON public.eth_txes FOR EACH ROW
+	WHEN (NEW.state = 'confirmed' AND OLD.state IS DISTINCT FROM 'confirmed')
+	EXECUTE PROCEDURE public.notifyethtxconfirmed();
+
+CREATE OR REPLACE FUNCTION public.notifyjobspecerrorrecorded() RETURNS trigger
+    LANGUAGE plpgsql
+    AS $$
+        BEGIN
+		PERFORM pg_notify('job_spec_error_recorded'::text, json_build_object(
+			'id', NEW.id,
+			'jobID', NEW.job_id,
+			'severity', NEW.severity,
+			'occurrences', NEW.occurrences
+		)::text);
+		RETURN NULL;
+        END
+        $$;
+
+CREATE TRIGGER notify_job_spec_error_recorded AFTER INSERT OR UPDATE OF occurrences ON public.job_spec_errors_v2 FOR EACH ROW
+	EXECUTE PROCEDURE public.notifyjobspecerrorrecorded();
+`
+
+const down76 = `
+DROP TRIGGER notify_eth_tx_confirmed ON public.eth_txes;
+DROP FUNCTION public.notifyethtxconfirmed();
+DROP TRIGGER notify_job_spec_error_recorded ON public.job_spec_errors_v2;
+DROP FUNCTION public.notifyjobspecerrorrecorded();
+`
+
+func init() {
+	Migrations = append(Migrations, &Migration{
+		ID: "0076_add_eth_tx_confirmed_and_job_spec_error_notifications",
+		Migrate: func(db *gorm.DB) error {
+			return db.Exec(up76).Error
+		},
+		Rollback: func(db *gorm.DB) error {
+			return db.Exec(down76).Error
+		},
+	})
+}
@@ -0,0 +1,33 @@
+package migrations
+
+import (
+	"gorm.io/gorm"
+)
+
+// Supports paging through runs by cursor, the most recent first, overall,
+// per pipeline spec and per state
+const up77 = `
+	CREATE INDEX idx_pipeline_runs_created_at_id ON pipeline_runs (created_at DESC, id DESC);
+	CREATE INDEX idx_pipeline_runs_pipeline_spec_id_created_at_id ON pipeline_runs (pipeline_spec_id, created_at DESC, id DESC);
+	CREATE INDEX idx_pipeline_runs_state_created_at_id ON pipeline_runs (state, created_at DESC, id DESC);
+	CREATE INDEX idx_jobs_type ON jobs (type);
+`
+
+const down77 = `
+	DROP INDEX idx_pipeline_runs_created_at_id;
+	DROP INDEX idx_pipeline_runs_pipeline_spec_id_created_at_id;
+	DROP INDEX idx_pipeline_runs_state_created_at_id;
+	DROP INDEX idx_jobs_type;
+`
+
+func init() {
+	Migrations = append(Migrations, &Migration{
+		ID: "0077_add_pipeline_runs_search_indexes",
+		Migrate: func(db *gorm.DB) error {
+			return db.Exec(up77).Error
+		},
+		Rollback: func(db *gorm.DB) error {
+			return db.Exec(down77).Error
+		},
+	})
+}
@@ -0,0 +1,51 @@
+package migrations
+
+import (
+	"gorm.io/gorm"
+)
+
+// api_audit_log_entries is the append-only log of the mutating calls made to
+// the authenticated web API, and of their outcome
+const up78 = `
+	CREATE TABLE api_audit_log_entries (
+		id BIGSERIAL PRIMARY KEY,
+		user_email text NOT NULL,
+		method text NOT NULL,
+		route text NOT NULL,
+		path text NOT NULL,
+		payload text NOT NULL DEFAULT '',
+		status_code integer NOT NULL,
+		error text,
+		remote_addr text NOT NULL DEFAULT '',
+		created_at timestamptz NOT NULL
+	);
+	CREATE INDEX idx_api_audit_log_entries_user_email ON api_audit_log_entries (user_email);
+	CREATE INDEX idx_api_audit_log_entries_route ON api_audit_log_entries (route);
+	CREATE INDEX idx_api_audit_log_entries_created_at ON api_audit_log_entries (created_at);
+
+	CREATE FUNCTION reject_api_audit_log_changes() RETURNS TRIGGER AS $$
+	BEGIN
+		RAISE EXCEPTION 'api_audit_log_entries is append-only';
+	END;
+	$$ LANGUAGE plpgsql;
+
+	CREATE TRIGGER api_audit_log_entries_append_only BEFORE UPDATE OR DELETE ON api_audit_log_entries
+	FOR EACH ROW EXECUTE PROCEDURE reject_api_audit_log_changes();
+`
+
+const down78 = `
+	DROP TABLE api_audit_log_entries;
+	DROP FUNCTION reject_api_audit_log_changes;
+`
+
+func init() {
+	Migrations = append(Migrations, &Migration{
+		ID: "0078_add_api_audit_log",
+		Migrate: func(db *gorm.DB) error {
+			return db.Exec(up78).Error
+		},
+		Rollback: func(db *gorm.DB) error {
+			return db.Exec(down78).Error
+		},
+	})
+}
@@ -0,0 +1,37 @@
+package migrations
+
+import (
+	"gorm.io/gorm"
+)
+
+// Webhook trigger payloads of external initiators are signed with their own
+// secret. The nonces of the signed triggers are kept for as long as their
+// signatures are valid, to reject replays.
+const up79 = `
+	ALTER TABLE external_initiators ADD COLUMN signing_secret text NOT NULL DEFAULT '';
+
+	CREATE TABLE external_initiator_nonces (
+		external_initiator_id bigint NOT NULL REFERENCES external_initiators (id) ON DELETE CASCADE,
+		nonce text NOT NULL,
+		created_at timestamptz NOT NULL,
+		PRIMARY KEY (external_initiator_id, nonce)
+	);
+	CREATE INDEX idx_external_initiator_nonces_created_at ON external_initiator_nonces (created_at);
+`
+
+const down79 = `
+	DROP TABLE external_initiator_nonces;
+	ALTER TABLE external_initiators DROP COLUMN signing_secret;
+`
+
+func init() {
+	Migrations = append(Migrations, &Migration{
+		ID: "0079_add_external_initiator_signing_secrets",
+		Migrate: func(db *gorm.DB) error {
+			return db.Exec(up79).Error
+		},
+		Rollback: func(db *gorm.DB) error {
+			return db.Exec(down79).Error
+		},
+	})
+}
@@ -0,0 +1,40 @@
+package migrations
+
+import (
+	"gorm.io/gorm"
+)
+
+// Sessions belong to one of the users, instead of the only one. Existing
+// sessions are those of the most recent user, which was the only one to
+// authenticate. Tokens identify their user, so their keys must be unique.
+const up80 = `
+	ALTER TABLE users ADD COLUMN totp_secret text NOT NULL DEFAULT '';
+	ALTER TABLE users ADD COLUMN totp_enabled boolean NOT NULL DEFAULT false;
+
+	ALTER TABLE sessions ADD COLUMN user_email text REFERENCES users (email) ON DELETE CASCADE;
+	UPDATE sessions SET user_email = (SELECT email FROM users ORDER BY created_at DESC LIMIT 1);
+	DELETE FROM sessions WHERE user_email IS NULL;
+	ALTER TABLE sessions ALTER COLUMN user_email SET NOT NULL;
+	CREATE INDEX idx_sessions_user_email ON sessions (user_email);
+
+	CREATE UNIQUE INDEX idx_users_token_key ON users (token_key) WHERE token_key IS NOT NULL AND token_key <> '';
+`
+
+const down80 = `
+	DROP INDEX idx_users_token_key;
+	ALTER TABLE sessions DROP COLUMN user_email;
+	ALTER TABLE users DROP COLUMN totp_enabled;
+	ALTER TABLE users DROP COLUMN totp_secret;
+`
+
+func init() {
+	Migrations = append(Migrations, &Migration{
+		ID: "0080_add_multi_user_sessions",
+		Migrate: func(db *gorm.DB) error {
+			return db.Exec(up80).Error
+		},
+		Rollback: func(db *gorm.DB) error {
+			return db.Exec(down80).Error
+		},
+	})
+}
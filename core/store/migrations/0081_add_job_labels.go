@@ -0,0 +1,29 @@
+package migrations
+
+import (
+	"gorm.io/gorm"
+)
+
+// Labels are name value pairs, e.g. team=defi, by which jobs and their runs
+// can be listed
+const up81 = `
+	ALTER TABLE jobs ADD COLUMN labels jsonb NOT NULL DEFAULT '{}';
+	CREATE INDEX idx_jobs_labels ON jobs USING GIN (labels);
+`
+
+const down81 = `
+	DROP INDEX idx_jobs_labels;
+	ALTER TABLE jobs DROP COLUMN labels;
+`
+
+func init() {
+	Migrations = append(Migrations, &Migration{
+		ID: "0081_add_job_labels",
+		Migrate: func(db *gorm.DB) error {
+			return db.Exec(up81).Error
+		},
+		Rollback: func(db *gorm.DB) error {
+			return db.Exec(down81).Error
+		},
+	})
+}
@@ -0,0 +1,40 @@
+package migrations
+
+import (
+	"gorm.io/gorm"
+)
+
+// Templates are job specs with {{.Var}} placeholders. Jobs created from a
+// template keep the variables they were rendered with.
+const up82 = `
+	CREATE TABLE job_templates (
+		id serial PRIMARY KEY,
+		name text NOT NULL UNIQUE,
+		toml text NOT NULL,
+		created_at timestamptz NOT NULL,
+		updated_at timestamptz NOT NULL
+	);
+	ALTER TABLE jobs
+		ADD COLUMN template_id int REFERENCES job_templates (id) ON DELETE SET NULL,
+		ADD COLUMN template_variables jsonb;
+	CREATE INDEX idx_jobs_template_id ON jobs (template_id);
+`
+
+const down82 = `
+	ALTER TABLE jobs
+		DROP COLUMN template_id,
+		DROP COLUMN template_variables;
+	DROP TABLE job_templates;
+`
+
+func init() {
+	Migrations = append(Migrations, &Migration{
+		ID: "0082_add_job_templates",
+		Migrate: func(db *gorm.DB) error {
+			return db.Exec(up82).Error
+		},
+		Rollback: func(db *gorm.DB) error {
+			return db.Exec(down82).Error
+		},
+	})
+}
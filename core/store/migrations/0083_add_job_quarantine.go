@@ -0,0 +1,29 @@
+package migrations
+
+import (
+	"gorm.io/gorm"
+)
+
+// Jobs whose services failed to start JOB_MAX_START_ATTEMPTS times in a row
+// are quarantined, and claimed by no node until they are reactivated
+const up83 = `
+	ALTER TABLE jobs ADD COLUMN start_attempts int NOT NULL DEFAULT 0;
+	ALTER TABLE jobs ADD COLUMN quarantined_at timestamptz;
+`
+
+const down83 = `
+	ALTER TABLE jobs DROP COLUMN quarantined_at;
+	ALTER TABLE jobs DROP COLUMN start_attempts;
+`
+
+func init() {
+	Migrations = append(Migrations, &Migration{
+		ID: "0083_add_job_quarantine",
+		Migrate: func(db *gorm.DB) error {
+			return db.Exec(up83).Error
+		},
+		Rollback: func(db *gorm.DB) error {
+			return db.Exec(down83).Error
+		},
+	})
+}
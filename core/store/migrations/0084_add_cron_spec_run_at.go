@@ -0,0 +1,33 @@
+package migrations
+
+import (
+	"gorm.io/gorm"
+)
+
+// Cron jobs can run once at run_at instead of on a schedule, and have the
+// time they last ran recorded
+const up84 = `
+	ALTER TABLE cron_specs ADD COLUMN run_at timestamptz;
+	ALTER TABLE cron_specs ADD COLUMN timezone text NOT NULL DEFAULT '';
+	ALTER TABLE cron_specs ADD COLUMN overlap_policy text NOT NULL DEFAULT '';
+	ALTER TABLE cron_specs ADD COLUMN last_run_at timestamptz;
+`
+
+const down84 = `
+	ALTER TABLE cron_specs DROP COLUMN last_run_at;
+	ALTER TABLE cron_specs DROP COLUMN overlap_policy;
+	ALTER TABLE cron_specs DROP COLUMN timezone;
+	ALTER TABLE cron_specs DROP COLUMN run_at;
+`
+
+func init() {
+	Migrations = append(Migrations, &Migration{
+		ID: "0084_add_cron_spec_run_at",
+		Migrate: func(db *gorm.DB) error {
+			return db.Exec(up84).Error
+		},
+		Rollback: func(db *gorm.DB) error {
+			return db.Exec(down84).Error
+		},
+	})
+}
@@ -0,0 +1,31 @@
+package migrations
+
+import (
+	"gorm.io/gorm"
+)
+
+// Direct request jobs can restrict which requesters they serve, and require a
+// higher payment than the node wide minimum
+const up85 = `
+	ALTER TABLE direct_request_specs ADD COLUMN requesters text NOT NULL DEFAULT '';
+	ALTER TABLE direct_request_specs ADD COLUMN denied_requesters text NOT NULL DEFAULT '';
+	ALTER TABLE direct_request_specs ADD COLUMN min_contract_payment numeric(78,0);
+`
+
+const down85 = `
+	ALTER TABLE direct_request_specs DROP COLUMN min_contract_payment;
+	ALTER TABLE direct_request_specs DROP COLUMN denied_requesters;
+	ALTER TABLE direct_request_specs DROP COLUMN requesters;
+`
+
+func init() {
+	Migrations = append(Migrations, &Migration{
+		ID: "0085_add_direct_request_requesters",
+		Migrate: func(db *gorm.DB) error {
+			return db.Exec(up85).Error
+		},
+		Rollback: func(db *gorm.DB) error {
+			return db.Exec(down85).Error
+		},
+	})
+}
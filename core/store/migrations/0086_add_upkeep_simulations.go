@@ -0,0 +1,37 @@
+package migrations
+
+import (
+	"gorm.io/gorm"
+)
+
+// Keepers record the latest performUpkeep simulation of each upkeep, and park
+// upkeeps whose simulations keep reverting
+const up86 = `
+	CREATE TABLE upkeep_simulations (
+		upkeep_registration_id bigint PRIMARY KEY REFERENCES upkeep_registrations(id) ON DELETE CASCADE DEFERRABLE INITIALLY IMMEDIATE,
+		block_number bigint NOT NULL,
+		perform_gas_estimate bigint,
+		revert_reason text,
+		consecutive_reverts int NOT NULL DEFAULT 0,
+		parked_until timestamptz,
+		simulated_at timestamptz NOT NULL
+	);
+
+	CREATE INDEX idx_upkeep_simulations_parked_until ON upkeep_simulations(parked_until) WHERE parked_until IS NOT NULL;
+`
+
+const down86 = `
+	DROP TABLE upkeep_simulations;
+`
+
+func init() {
+	Migrations = append(Migrations, &Migration{
+		ID: "0086_add_upkeep_simulations",
+		Migrate: func(db *gorm.DB) error {
+			return db.Exec(up86).Error
+		},
+		Rollback: func(db *gorm.DB) error {
+			return db.Exec(down86).Error
+		},
+	})
+}
@@ -0,0 +1,26 @@
+package migrations
+
+import (
+	"gorm.io/gorm"
+)
+
+// Keeper jobs can override the node's eligibility strategy
+const up87 = `
+	ALTER TABLE keeper_specs ADD COLUMN eligibility_strategy text NOT NULL DEFAULT '';
+`
+
+const down87 = `
+	ALTER TABLE keeper_specs DROP COLUMN eligibility_strategy;
+`
+
+func init() {
+	Migrations = append(Migrations, &Migration{
+		ID: "0087_add_keeper_eligibility_strategy",
+		Migrate: func(db *gorm.DB) error {
+			return db.Exec(up87).Error
+		},
+		Rollback: func(db *gorm.DB) error {
+			return db.Exec(down87).Error
+		},
+	})
+}
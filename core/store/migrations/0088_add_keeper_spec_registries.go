@@ -0,0 +1,39 @@
+package migrations
+
+import (
+	"gorm.io/gorm"
+)
+
+// Keeper jobs can monitor several registries
+const up88 = `
+	ALTER TABLE keeper_specs ADD COLUMN registries jsonb NOT NULL DEFAULT '[]';
+
+	ALTER TABLE keeper_registries DROP CONSTRAINT keeper_registries_job_id_key;
+	CREATE UNIQUE INDEX idx_keeper_registries_job_id_contract_address ON keeper_registries(job_id, contract_address);
+`
+
+const down88 = `
+	DELETE FROM keeper_registries WHERE id IN (
+		SELECT keeper_registries.id FROM keeper_registries
+		INNER JOIN jobs ON jobs.id = keeper_registries.job_id
+		INNER JOIN keeper_specs ON keeper_specs.id = jobs.keeper_spec_id
+		WHERE keeper_registries.contract_address <> keeper_specs.contract_address
+	);
+
+	DROP INDEX idx_keeper_registries_job_id_contract_address;
+	ALTER TABLE keeper_registries ADD CONSTRAINT keeper_registries_job_id_key UNIQUE (job_id);
+
+	ALTER TABLE keeper_specs DROP COLUMN registries;
+`
+
+func init() {
+	Migrations = append(Migrations, &Migration{
+		ID: "0088_add_keeper_spec_registries",
+		Migrate: func(db *gorm.DB) error {
+			return db.Exec(up88).Error
+		},
+		Rollback: func(db *gorm.DB) error {
+			return db.Exec(down88).Error
+		},
+	})
+}
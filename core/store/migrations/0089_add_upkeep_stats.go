@@ -0,0 +1,36 @@
+package migrations
+
+import (
+	"gorm.io/gorm"
+)
+
+// Keepers account the gas spent on, LINK earned by and outcome of the upkeeps
+// they perform
+const up89 = `
+	CREATE TABLE upkeep_stats (
+		upkeep_registration_id bigint PRIMARY KEY REFERENCES upkeep_registrations(id) ON DELETE CASCADE DEFERRABLE INITIALLY IMMEDIATE,
+		gas_used bigint NOT NULL DEFAULT 0,
+		gas_spent numeric(78,0) NOT NULL DEFAULT 0,
+		link_earned numeric(78,0) NOT NULL DEFAULT 0,
+		perform_success_count bigint NOT NULL DEFAULT 0,
+		perform_failure_count bigint NOT NULL DEFAULT 0,
+		last_performed_block_number bigint,
+		updated_at timestamptz NOT NULL
+	);
+`
+
+const down89 = `
+	DROP TABLE upkeep_stats;
+`
+
+func init() {
+	Migrations = append(Migrations, &Migration{
+		ID: "0089_add_upkeep_stats",
+		Migrate: func(db *gorm.DB) error {
+			return db.Exec(up89).Error
+		},
+		Rollback: func(db *gorm.DB) error {
+			return db.Exec(down89).Error
+		},
+	})
+}
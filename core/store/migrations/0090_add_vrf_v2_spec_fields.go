@@ -0,0 +1,39 @@
+package migrations
+
+import (
+	"gorm.io/gorm"
+)
+
+// VRF jobs can fulfill the subscription based requests of a VRFCoordinatorV2,
+// optionally in batches
+const up90 = `
+	ALTER TABLE vrf_specs
+		ADD COLUMN coordinator_version int NOT NULL DEFAULT 1,
+		ADD COLUMN from_address bytea,
+		ADD COLUMN batch_coordinator_address bytea,
+		ADD COLUMN batch_fulfillment_enabled bool NOT NULL DEFAULT false,
+		ADD COLUMN batch_fulfillment_gas_limit bigint NOT NULL DEFAULT 0,
+		ADD COLUMN min_subscription_balance numeric(78,0);
+`
+
+const down90 = `
+	ALTER TABLE vrf_specs
+		DROP COLUMN coordinator_version,
+		DROP COLUMN from_address,
+		DROP COLUMN batch_coordinator_address,
+		DROP COLUMN batch_fulfillment_enabled,
+		DROP COLUMN batch_fulfillment_gas_limit,
+		DROP COLUMN min_subscription_balance;
+`
+
+func init() {
+	Migrations = append(Migrations, &Migration{
+		ID: "0090_add_vrf_v2_spec_fields",
+		Migrate: func(db *gorm.DB) error {
+			return db.Exec(up90).Error
+		},
+		Rollback: func(db *gorm.DB) error {
+			return db.Exec(down90).Error
+		},
+	})
+}
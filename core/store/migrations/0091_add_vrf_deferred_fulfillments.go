@@ -0,0 +1,48 @@
+package migrations
+
+import (
+	"gorm.io/gorm"
+)
+
+// VRF v2 jobs can defer fulfillments while gas prices are above a maximum,
+// keeping track of the deferred requests so that they can be inspected
+const up91 = `
+	ALTER TABLE vrf_specs
+		ADD COLUMN max_gas_price numeric(78,0),
+		ADD COLUMN fulfillment_deadline_blocks bigint NOT NULL DEFAULT 0;
+
+	CREATE TABLE vrf_deferred_fulfillments (
+		id BIGSERIAL PRIMARY KEY,
+		job_id int NOT NULL REFERENCES jobs(id) ON DELETE CASCADE,
+		request_id bytea NOT NULL,
+		sub_id bigint NOT NULL,
+		request_tx_hash bytea NOT NULL,
+		request_block_number bigint NOT NULL,
+		deadline_block_number bigint NOT NULL,
+		gas_price numeric(78,0) NOT NULL,
+		attempts bigint NOT NULL DEFAULT 1,
+		created_at timestamptz NOT NULL,
+		updated_at timestamptz NOT NULL,
+		CONSTRAINT vrf_deferred_fulfillments_job_id_request_id_key UNIQUE (job_id, request_id)
+	);
+`
+
+const down91 = `
+	DROP TABLE vrf_deferred_fulfillments;
+
+	ALTER TABLE vrf_specs
+		DROP COLUMN max_gas_price,
+		DROP COLUMN fulfillment_deadline_blocks;
+`
+
+func init() {
+	Migrations = append(Migrations, &Migration{
+		ID: "0091_add_vrf_deferred_fulfillments",
+		Migrate: func(db *gorm.DB) error {
+			return db.Exec(up91).Error
+		},
+		Rollback: func(db *gorm.DB) error {
+			return db.Exec(down91).Error
+		},
+	})
+}
@@ -0,0 +1,29 @@
+package migrations
+
+import (
+	"gorm.io/gorm"
+)
+
+// Cron jobs can execute the runs missed while the node was down, and webhook
+// jobs can limit their runs to one at a time
+const up92 = `
+	ALTER TABLE cron_specs ADD COLUMN max_catch_up_runs bigint NOT NULL DEFAULT 0;
+	ALTER TABLE webhook_specs ADD COLUMN overlap_policy text NOT NULL DEFAULT '';
+`
+
+const down92 = `
+	ALTER TABLE cron_specs DROP COLUMN max_catch_up_runs;
+	ALTER TABLE webhook_specs DROP COLUMN overlap_policy;
+`
+
+func init() {
+	Migrations = append(Migrations, &Migration{
+		ID: "0092_add_overlap_and_catch_up_policies",
+		Migrate: func(db *gorm.DB) error {
+			return db.Exec(up92).Error
+		},
+		Rollback: func(db *gorm.DB) error {
+			return db.Exec(down92).Error
+		},
+	})
+}
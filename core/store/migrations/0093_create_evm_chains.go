@@ -0,0 +1,32 @@
+package migrations
+
+import (
+	"gorm.io/gorm"
+)
+
+// The chain registry configures chains at runtime, overriding their built-in
+// config
+const up93 = `
+	CREATE TABLE evm_chains (
+		id numeric(78,0) PRIMARY KEY,
+		cfg jsonb NOT NULL DEFAULT '{}',
+		created_at timestamptz NOT NULL,
+		updated_at timestamptz NOT NULL
+	);
+`
+
+const down93 = `
+	DROP TABLE evm_chains;
+`
+
+func init() {
+	Migrations = append(Migrations, &Migration{
+		ID: "0093_create_evm_chains",
+		Migrate: func(db *gorm.DB) error {
+			return db.Exec(up93).Error
+		},
+		Rollback: func(db *gorm.DB) error {
+			return db.Exec(down93).Error
+		},
+	})
+}
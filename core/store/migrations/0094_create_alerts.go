@@ -0,0 +1,75 @@
+package migrations
+
+import (
+	"gorm.io/gorm"
+)
+
+// Alert rules are evaluated by the node, which notifies their channels when
+// they fire or resolve unless silenced, and records each of these in the
+// alert history
+const up94 = `
+	CREATE TABLE alert_channels (
+		id BIGSERIAL PRIMARY KEY,
+		name text NOT NULL UNIQUE,
+		type text NOT NULL,
+		url text NOT NULL DEFAULT '',
+		recipients text[] NOT NULL DEFAULT '{}',
+		created_at timestamptz NOT NULL,
+		updated_at timestamptz NOT NULL,
+		CONSTRAINT chk_alert_channel_type CHECK (type IN ('webhook', 'slack', 'email'))
+	);
+
+	CREATE TABLE alert_rules (
+		id BIGSERIAL PRIMARY KEY,
+		name text NOT NULL UNIQUE,
+		type text NOT NULL,
+		params jsonb NOT NULL DEFAULT '{}',
+		channel_ids bigint[] NOT NULL DEFAULT '{}',
+		created_at timestamptz NOT NULL,
+		updated_at timestamptz NOT NULL,
+		CONSTRAINT chk_alert_rule_type CHECK (type IN ('key_balance_low', 'job_errors', 'no_heads'))
+	);
+
+	CREATE TABLE alert_silences (
+		id BIGSERIAL PRIMARY KEY,
+		rule_id bigint REFERENCES alert_rules (id) ON DELETE CASCADE,
+		reason text NOT NULL DEFAULT '',
+		ends_at timestamptz NOT NULL,
+		created_at timestamptz NOT NULL
+	);
+
+	CREATE INDEX idx_alert_silences_ends_at ON alert_silences (ends_at);
+
+	CREATE TABLE alerts (
+		id BIGSERIAL PRIMARY KEY,
+		rule_id bigint REFERENCES alert_rules (id) ON DELETE SET NULL,
+		rule_name text NOT NULL,
+		state text NOT NULL,
+		message text NOT NULL,
+		silenced bool NOT NULL DEFAULT FALSE,
+		delivery_error text,
+		created_at timestamptz NOT NULL,
+		CONSTRAINT chk_alert_state CHECK (state IN ('firing', 'resolved'))
+	);
+
+	CREATE INDEX idx_alerts_created_at ON alerts (created_at);
+`
+
+const down94 = `
+	DROP TABLE alerts;
+	DROP TABLE alert_silences;
+	DROP TABLE alert_rules;
+	DROP TABLE alert_channels;
+`
+
+func init() {
+	Migrations = append(Migrations, &Migration{
+		ID: "0094_create_alerts",
+		Migrate: func(db *gorm.DB) error {
+			return db.Exec(up94).Error
+		},
+		Rollback: func(db *gorm.DB) error {
+			return db.Exec(down94).Error
+		},
+	})
+}
@@ -0,0 +1,47 @@
+package migrations
+
+import (
+	"gorm.io/gorm"
+)
+
+// A new enum value cannot be used in the transaction which adds it, so this
+// migration runs without a transaction, one statement at a time
+const up95AddStatus = `ALTER TYPE job_proposal_status ADD VALUE IF NOT EXISTS 'cancelled'`
+
+const up95 = `
+ALTER TABLE job_proposals DROP CONSTRAINT chk_job_proposals_status_fsm;
+ALTER TABLE job_proposals ADD CONSTRAINT chk_job_proposals_status_fsm CHECK (
+	(status = 'pending' AND external_job_id IS NULL) OR
+	(status = 'approved' AND external_job_id IS NOT NULL) OR
+	(status = 'rejected' AND external_job_id IS NULL) OR
+	(status = 'cancelled' AND external_job_id IS NULL)
+);
+`
+
+// The cancelled enum value cannot be removed, but is unused once this is
+// rolled back
+const down95 = `
+DELETE FROM job_proposals WHERE status = 'cancelled';
+ALTER TABLE job_proposals DROP CONSTRAINT chk_job_proposals_status_fsm;
+ALTER TABLE job_proposals ADD CONSTRAINT chk_job_proposals_status_fsm CHECK (
+	(status = 'pending' AND external_job_id IS NULL) OR
+	(status = 'approved' AND external_job_id IS NOT NULL) OR
+	(status = 'rejected' AND external_job_id IS NULL)
+);
+`
+
+func init() {
+	Migrations = append(Migrations, &Migration{
+		ID: "0095_add_cancelled_job_proposal_status",
+		Migrate: func(db *gorm.DB) error {
+			if err := db.Exec(up95AddStatus).Error; err != nil {
+				return err
+			}
+			return db.Exec(up95).Error
+		},
+		Rollback: func(db *gorm.DB) error {
+			return db.Exec(down95).Error
+		},
+		DisableTransaction: true,
+	})
+}
@@ -0,0 +1,31 @@
+package migrations
+
+import (
+	"gorm.io/gorm"
+)
+
+// Nonces are per chain, so the same key may send at the same nonce on each
+// chain. Legacy transactions without a chain keep the old uniqueness.
+const up96 = `
+	DROP INDEX idx_eth_txes_nonce_from_address;
+	CREATE UNIQUE INDEX idx_eth_txes_evm_chain_id_from_address_nonce ON eth_txes (evm_chain_id, from_address, nonce) WHERE evm_chain_id IS NOT NULL;
+	CREATE UNIQUE INDEX idx_eth_txes_from_address_nonce_without_chain ON eth_txes (from_address, nonce) WHERE evm_chain_id IS NULL;
+`
+
+const down96 = `
+	DROP INDEX idx_eth_txes_from_address_nonce_without_chain;
+	DROP INDEX idx_eth_txes_evm_chain_id_from_address_nonce;
+	CREATE UNIQUE INDEX idx_eth_txes_nonce_from_address ON eth_txes (nonce, from_address);
+`
+
+func init() {
+	Migrations = append(Migrations, &Migration{
+		ID: "0096_scope_eth_txes_nonce_index_by_chain",
+		Migrate: func(db *gorm.DB) error {
+			return db.Exec(up96).Error
+		},
+		Rollback: func(db *gorm.DB) error {
+			return db.Exec(down96).Error
+		},
+	})
+}
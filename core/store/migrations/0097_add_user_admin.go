@@ -0,0 +1,28 @@
+package migrations
+
+import (
+	"gorm.io/gorm"
+)
+
+// Only admins may add and remove users. Existing users could do so before, so
+// they are all admins.
+const up97 = `
+	ALTER TABLE users ADD COLUMN admin boolean NOT NULL DEFAULT false;
+	UPDATE users SET admin = true;
+`
+
+const down97 = `
+	ALTER TABLE users DROP COLUMN admin;
+`
+
+func init() {
+	Migrations = append(Migrations, &Migration{
+		ID: "0097_add_user_admin",
+		Migrate: func(db *gorm.DB) error {
+			return db.Exec(up97).Error
+		},
+		Rollback: func(db *gorm.DB) error {
+			return db.Exec(down97).Error
+		},
+	})
+}
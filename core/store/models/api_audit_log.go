@@ -0,0 +1,42 @@
+package models
+
+import (
+	"time"
+
+	"gopkg.in/guregu/null.v4"
+)
+
+// APIAuditLogEntry records a mutating call made to the authenticated web API,
+// who made it and its outcome. The log is append-only, entries can't be
+// updated or deleted.
+type APIAuditLogEntry struct {
+	ID        int64
+	UserEmail string
+	Method    string
+	// Route is the route template of the endpoint, e.g. /v2/jobs/:ID, and
+	// Path the requested path
+	Route string
+	Path  string
+	// Payload is a summary of the request body, with secrets redacted
+	Payload    string
+	StatusCode int
+	// Error is the error returned by the endpoint, if any
+	Error      null.String
+	RemoteAddr string
+	CreatedAt  time.Time
+}
+
+// TableName returns the table of API audit log entries
+func (APIAuditLogEntry) TableName() string {
+	return "api_audit_log_entries"
+}
+
+// APIAuditLogFilter selects API audit log entries, zero fields match any
+// entry
+type APIAuditLogFilter struct {
+	UserEmail string
+	Method    string
+	Route     string
+	Since     null.Time
+	Until     null.Time
+}
@@ -7,6 +7,7 @@ import (
 	"math/big"
 	"time"
 
+	"github.com/shopspring/decimal"
 	"github.com/smartcontractkit/chainlink/core/assets"
 	"github.com/smartcontractkit/chainlink/core/utils"
 )
@@ -17,6 +18,13 @@ type BridgeTypeRequest struct {
 	URL                    WebURL       `json:"url"`
 	Confirmations          uint32       `json:"confirmations"`
 	MinimumContractPayment *assets.Link `json:"minimumContractPayment"`
+	// CreditCost is the estimated cost, in the external adapter's own
+	// credits, of a single request to the bridge.
+	CreditCost decimal.Decimal `json:"creditCost"`
+	// MaxConcurrency is the most requests the node sends to the bridge at
+	// once, and RateLimit the most requests per second. Zero is unlimited.
+	MaxConcurrency uint32          `json:"maxConcurrency"`
+	RateLimit      decimal.Decimal `json:"rateLimit"`
 }
 
 // GetID returns the ID of this structure for jsonapi serialization.
@@ -44,6 +52,9 @@ type BridgeTypeAuthentication struct {
 	IncomingToken          string
 	OutgoingToken          string
 	MinimumContractPayment *assets.Link
+	CreditCost             decimal.Decimal
+	MaxConcurrency         uint32
+	RateLimit              decimal.Decimal
 }
 
 // BridgeType is used for external adapters and has fields for
@@ -56,6 +67,9 @@ type BridgeType struct {
 	Salt                   string
 	OutgoingToken          string
 	MinimumContractPayment *assets.Link `gorm:"type:varchar(255)"`
+	CreditCost             decimal.Decimal
+	MaxConcurrency         uint32
+	RateLimit              decimal.Decimal
 	CreatedAt              time.Time
 	UpdatedAt              time.Time
 }
@@ -80,6 +94,9 @@ func NewBridgeType(btr *BridgeTypeRequest) (*BridgeTypeAuthentication,
 			IncomingToken:          incomingToken,
 			OutgoingToken:          outgoingToken,
 			MinimumContractPayment: btr.MinimumContractPayment,
+			CreditCost:             btr.CreditCost,
+			MaxConcurrency:         btr.MaxConcurrency,
+			RateLimit:              btr.RateLimit,
 		}, &BridgeType{
 			Name:                   btr.Name,
 			URL:                    btr.URL,
@@ -88,6 +105,9 @@ func NewBridgeType(btr *BridgeTypeRequest) (*BridgeTypeAuthentication,
 			Salt:                   salt,
 			OutgoingToken:          outgoingToken,
 			MinimumContractPayment: btr.MinimumContractPayment,
+			CreditCost:             btr.CreditCost,
+			MaxConcurrency:         btr.MaxConcurrency,
+			RateLimit:              btr.RateLimit,
 		}, nil
 }
 
@@ -28,6 +28,8 @@ type EthTxMetaV2 struct {
 	JobID         int32
 	RequestID     common.Hash
 	RequestTxHash common.Hash
+	// RequestIDs are the requests fulfilled by a batch transaction
+	RequestIDs []common.Hash `json:",omitempty"`
 }
 
 // Head represents a BlockNumber, BlockHash.
@@ -27,6 +27,10 @@ type ExternalInitiator struct {
 	HashedSecret   string  `gorm:"not null"`
 	OutgoingSecret string  `gorm:"not null"`
 	OutgoingToken  string  `gorm:"not null"`
+	// SigningSecret is the key of the HMAC of the webhook trigger payloads
+	// of the external initiator. Payloads are not verified if it is empty,
+	// as for external initiators created before payloads were signed.
+	SigningSecret string `gorm:"not null"`
 
 	CreatedAt time.Time
 	UpdatedAt time.Time
@@ -52,6 +56,7 @@ func NewExternalInitiator(
 		Salt:           salt,
 		OutgoingToken:  utils.NewSecret(utils.DefaultSecretSize),
 		OutgoingSecret: utils.NewSecret(utils.DefaultSecretSize),
+		SigningSecret:  utils.NewSecret(utils.DefaultSecretSize),
 	}, nil
 }
 
@@ -4,7 +4,9 @@ import (
 	"crypto/subtle"
 	"fmt"
 	"regexp"
+	"strings"
 	"time"
+	"unicode"
 
 	"github.com/smartcontractkit/chainlink/core/auth"
 	"github.com/smartcontractkit/chainlink/core/utils"
@@ -21,6 +23,14 @@ type User struct {
 	TokenSalt         string
 	TokenHashedSecret string
 	UpdatedAt         time.Time
+	// TOTPSecret is the secret of the TOTP codes required to log in and
+	// for destructive operations once TOTPEnabled, after the user has
+	// confirmed they can generate them
+	TOTPSecret  string `gorm:"column:totp_secret"`
+	TOTPEnabled bool   `gorm:"column:totp_enabled"`
+	// Admin is whether the user may add and remove users. The users created
+	// from the command line are admins.
+	Admin bool
 }
 
 // https://davidcel.is/posts/stop-validating-email-addresses-with-regex/
@@ -31,6 +41,53 @@ const (
 	MaxBcryptPasswordLength = 50
 )
 
+// MinPasswordLength is the minimum length of the passwords set through the
+// API, which must also mix lower and upper case letters, digits and symbols
+const MinPasswordLength = 12
+
+// ValidatePasswordComplexity returns an error listing the requirements of the
+// password policy that password does not meet. It is enforced when users are
+// created or change their password through the API.
+func ValidatePasswordComplexity(email, password string) error {
+	var lower, upper, digit, symbol bool
+	for _, r := range password {
+		switch {
+		case unicode.IsLower(r):
+			lower = true
+		case unicode.IsUpper(r):
+			upper = true
+		case unicode.IsDigit(r):
+			digit = true
+		default:
+			symbol = true
+		}
+	}
+
+	var unmet []string
+	if len(password) < MinPasswordLength || len(password) > MaxBcryptPasswordLength {
+		unmet = append(unmet, fmt.Sprintf("be %d - %d characters long", MinPasswordLength, MaxBcryptPasswordLength))
+	}
+	if !lower {
+		unmet = append(unmet, "contain a lowercase letter")
+	}
+	if !upper {
+		unmet = append(unmet, "contain an uppercase letter")
+	}
+	if !digit {
+		unmet = append(unmet, "contain a digit")
+	}
+	if !symbol {
+		unmet = append(unmet, "contain a symbol")
+	}
+	if name := strings.Split(email, "@")[0]; len(name) >= 3 && strings.Contains(strings.ToLower(password), strings.ToLower(name)) {
+		unmet = append(unmet, "not contain the email of the user")
+	}
+	if len(unmet) > 0 {
+		return errors.Errorf("password must %s", strings.Join(unmet, ", "))
+	}
+	return nil
+}
+
 // NewUser creates a new user by hashing the passed plainPwd with bcrypt.
 func NewUser(email, plainPwd string) (User, error) {
 	if len(email) == 0 {
@@ -61,11 +118,14 @@ func NewUser(email, plainPwd string) (User, error) {
 type SessionRequest struct {
 	Email    string `json:"email"`
 	Password string `json:"password"`
+	// TOTPCode is required if the user has enabled TOTP
+	TOTPCode string `json:"totpCode,omitempty"`
 }
 
 // Session holds the unique id for the authenticated session.
 type Session struct {
 	ID        string    `json:"id" gorm:"primary_key"`
+	UserEmail string    `json:"userEmail"`
 	LastUsed  time.Time `json:"lastUsed" gorm:"index"`
 	CreatedAt time.Time `json:"createdAt" gorm:"index"`
 }
@@ -43,6 +43,35 @@ func TestNewUser(t *testing.T) {
 	}
 }
 
+func TestValidatePasswordComplexity(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name, email, pwd string
+		wantError        bool
+	}{
+		{"complex", "good@email.com", "p4SsW0rD1!@#_", false},
+		{"too short", "good@email.com", "p4SsW0!", true},
+		{"too long", "good@email.com", "p4SsW0rD1!@#_p4SsW0rD1!@#_p4SsW0rD1!@#_p4SsW0rD1!@#_", true},
+		{"no lowercase", "good@email.com", "P4SSW0RD1!@#_", true},
+		{"no uppercase", "good@email.com", "p4ssw0rd1!@#_", true},
+		{"no digit", "good@email.com", "pASsWoRDx!@#_", true},
+		{"no symbol", "good@email.com", "p4SsW0rD1xyzA", true},
+		{"contains email", "admin@email.com", "Admin!p4SsW0rD1", true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := models.ValidatePasswordComplexity(test.email, test.pwd)
+			if test.wantError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
 func TestUserGenerateAuthToken(t *testing.T) {
 	var user models.User
 	token, err := user.GenerateAuthToken()
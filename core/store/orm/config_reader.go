@@ -2,6 +2,7 @@ package orm
 
 import (
 	"math/big"
+	"net"
 	"net/url"
 	"time"
 
@@ -17,6 +18,10 @@ import (
 // ConfigReader represents just the read side of the config
 type ConfigReader interface {
 	AllowOrigins() string
+	AuthFailureLimit() uint32
+	AuthFailureWindow() time.Duration
+	AuthLockoutDuration() time.Duration
+	AuthLockoutMaxDuration() time.Duration
 	BlockBackfillDepth() uint64
 	BridgeResponseURL() *url.URL
 	CertFile() string
@@ -39,6 +44,7 @@ type ConfigReader interface {
 	EthGasBumpTxDepth() uint16
 	EthGasBumpWei() *big.Int
 	EthGasLimitDefault() uint64
+	EthGasLimitEstimateMultiplier() float32
 	EthGasLimitMultiplier() float32
 	EthGasPriceDefault() *big.Int
 	EthHeadTrackerHistoryDepth() uint
@@ -50,6 +56,7 @@ type ConfigReader interface {
 	EthTxReaperInterval() time.Duration
 	EthTxReaperThreshold() time.Duration
 	EthTxResendAfterThreshold() time.Duration
+	EthUseFinalityTag() bool
 	EthereumSecondaryURLs() []url.URL
 	EthereumURL() string
 	ExplorerAccessKey() string
@@ -67,7 +74,10 @@ type ConfigReader interface {
 	KeeperRegistryCheckGasOverhead() uint64
 	KeeperRegistryPerformGasOverhead() uint64
 	KeeperRegistrySyncInterval() time.Duration
+	KeeperSimulationMaxConsecutiveReverts() uint32
+	KeeperSimulationParkDuration() time.Duration
 	KeeperMinimumRequiredConfirmations() uint64
+	KeeperEligibilityStrategy() string
 	KeeperMaximumGracePeriod() int64
 	KeyFile() string
 	LinkContractAddress() string
@@ -87,6 +97,7 @@ type ConfigReader interface {
 	ReaperExpiration() models.Duration
 	RootDir() string
 	SecureCookies() bool
+	SessionCookieSameSite() string
 	SessionOptions() sessions.Options
 	SessionSecret() ([]byte, error)
 	SessionTimeout() models.Duration
@@ -98,4 +109,5 @@ type ConfigReader interface {
 	TLSPort() uint16
 	TLSRedirect() bool
 	TriggerFallbackDBPollInterval() time.Duration
+	TrustedProxies() ([]*net.IPNet, error)
 }
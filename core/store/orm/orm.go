@@ -55,6 +55,14 @@ var (
 	// because another update occurred while the model was in memory and the
 	// differences must be reconciled.
 	ErrOptimisticUpdateConflict = errors.New("conflict while updating record")
+	// ErrUserExists is returned when creating an API user whose email is
+	// already taken.
+	ErrUserExists = errors.New("user already exists")
+	// ErrTOTPRequired is returned when logging in without a TOTP code as a
+	// user who enabled TOTP.
+	ErrTOTPRequired = errors.New("TOTP code required")
+	// ErrInvalidTOTP is returned when a TOTP code is wrong or expired.
+	ErrInvalidTOTP = errors.New("Invalid TOTP code")
 )
 
 // ORM contains the database object used by Chainlink.
@@ -995,8 +1003,50 @@ func findUser(db *gorm.DB) (user models.User, err error) {
 	return user, db.Preload(clause.Associations).Order("created_at desc").First(&user).Error
 }
 
-// AuthorizedUserWithSession will return the one API user if the Session ID exists
-// and hasn't expired, and update session's LastUsed field.
+// FindUserByEmail returns the API user with the given email, regardless of
+// its case
+func (orm *ORM) FindUserByEmail(email string) (user models.User, err error) {
+	return user, orm.DB.First(&user, "lower(email) = lower(?)", email).Error
+}
+
+// FindUserByAPIToken returns the API user whose API token has the given
+// access key
+func (orm *ORM) FindUserByAPIToken(accessKey string) (user models.User, err error) {
+	if accessKey == "" {
+		return user, ErrorNotFound
+	}
+	return user, orm.DB.First(&user, "token_key = ?", accessKey).Error
+}
+
+// Users returns all the API users, the oldest first
+func (orm *ORM) Users() (users []models.User, err error) {
+	return users, orm.DB.Order("created_at asc").Find(&users).Error
+}
+
+// CreateUser adds an API user, failing if one with the same email exists
+func (orm *ORM) CreateUser(user *models.User) error {
+	if _, err := orm.FindUserByEmail(user.Email); err == nil {
+		return ErrUserExists
+	} else if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return err
+	}
+	return orm.DB.Create(user).Error
+}
+
+// DeleteUserByEmail removes the API user with the given email, along with its
+// sessions
+func (orm *ORM) DeleteUserByEmail(email string) error {
+	result := orm.DB.Exec("DELETE FROM users WHERE lower(email) = lower(?)", email)
+	if result.Error != nil {
+		return result.Error
+	} else if result.RowsAffected == 0 {
+		return ErrorNotFound
+	}
+	return nil
+}
+
+// AuthorizedUserWithSession will return the API user of the Session ID if it
+// exists and hasn't expired, and update session's LastUsed field.
 func (orm *ORM) AuthorizedUserWithSession(sessionID string, sessionDuration time.Duration) (models.User, error) {
 	if len(sessionID) == 0 {
 		return models.User{}, errors.New("Session ID cannot be empty")
@@ -1015,7 +1065,7 @@ func (orm *ORM) AuthorizedUserWithSession(sessionID string, sessionDuration time
 	if err := orm.DB.Save(&session).Error; err != nil {
 		return models.User{}, err
 	}
-	return orm.FindUser()
+	return orm.FindUserByEmail(session.UserEmail)
 }
 
 // DeleteUser will delete the API User in the db.
@@ -1026,19 +1076,71 @@ func (orm *ORM) DeleteUser() error {
 			return err
 		}
 
-		if err = dbtx.Delete(&user).Error; err != nil {
+		if err = dbtx.Exec("DELETE FROM sessions WHERE user_email = ?", user.Email).Error; err != nil {
 			return err
 		}
 
-		return dbtx.Exec("DELETE FROM sessions").Error
+		return dbtx.Delete(&user).Error
 	})
 }
 
-// DeleteUserSession will erase the session ID for the sole API User.
+// DeleteUserSession will erase the session ID.
 func (orm *ORM) DeleteUserSession(sessionID string) error {
 	return orm.DB.Delete(models.Session{ID: sessionID}).Error
 }
 
+// UserSessions returns the sessions of the API user with the given email, the
+// most recently used first
+func (orm *ORM) UserSessions(email string) (sessions []models.Session, err error) {
+	return sessions, orm.DB.Where("user_email = ?", email).Order("last_used DESC").Find(&sessions).Error
+}
+
+// RevokeUserSession erases a session of the API user with the given email
+func (orm *ORM) RevokeUserSession(email, sessionID string) error {
+	result := orm.DB.Exec("DELETE FROM sessions WHERE id = ? AND user_email = ?", sessionID, email)
+	if result.Error != nil {
+		return result.Error
+	} else if result.RowsAffected == 0 {
+		return ErrorNotFound
+	}
+	return nil
+}
+
+// CreateAPIAuditLogEntry appends a call to the web API to the audit log
+func (orm *ORM) CreateAPIAuditLogEntry(entry *models.APIAuditLogEntry) error {
+	return errors.Wrap(orm.DB.Create(entry).Error, "failed to record API call in audit log")
+}
+
+// APIAuditLogEntries returns the page of API audit log entries matching
+// filter, newest first, and the total count of matching entries
+func (orm *ORM) APIAuditLogEntries(filter models.APIAuditLogFilter, offset, limit int) (entries []models.APIAuditLogEntry, count int, err error) {
+	filtered := func(db *gorm.DB) *gorm.DB {
+		if filter.UserEmail != "" {
+			db = db.Where("user_email = ?", filter.UserEmail)
+		}
+		if filter.Method != "" {
+			db = db.Where("method = ?", strings.ToUpper(filter.Method))
+		}
+		if filter.Route != "" {
+			db = db.Where("route = ?", filter.Route)
+		}
+		if filter.Since.Valid {
+			db = db.Where("created_at >= ?", filter.Since)
+		}
+		if filter.Until.Valid {
+			db = db.Where("created_at < ?", filter.Until)
+		}
+		return db
+	}
+
+	var total int64
+	if err = orm.DB.Model(&models.APIAuditLogEntry{}).Scopes(filtered).Count(&total).Error; err != nil {
+		return nil, 0, errors.Wrap(err, "APIAuditLogEntries failed to count entries")
+	}
+	err = orm.DB.Scopes(filtered).Order("id DESC").Offset(offset).Limit(limit).Find(&entries).Error
+	return entries, int(total), errors.Wrap(err, "APIAuditLogEntries failed to load entries")
+}
+
 // DeleteBridgeType removes the bridge type
 func (orm *ORM) DeleteBridgeType(bt *models.BridgeType) error {
 	if err := orm.MustEnsureAdvisoryLock(); err != nil {
@@ -1048,22 +1150,34 @@ func (orm *ORM) DeleteBridgeType(bt *models.BridgeType) error {
 }
 
 // CreateSession will check the password in the SessionRequest against
-// the hashed API User password in the db.
+// the hashed password of its API User in the db, and its TOTP code if the
+// user has enabled TOTP.
 func (orm *ORM) CreateSession(sr models.SessionRequest) (string, error) {
-	user, err := orm.FindUser()
-	if err != nil {
+	user, err := orm.FindUserByEmail(sr.Email)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return "", errors.New("Invalid email")
+	} else if err != nil {
 		return "", err
 	}
 
-	if !constantTimeEmailCompare(sr.Email, user.Email) {
+	if !constantTimeEmailCompare(strings.ToLower(sr.Email), strings.ToLower(user.Email)) {
 		return "", errors.New("Invalid email")
 	}
 
-	if utils.CheckPasswordHash(sr.Password, user.HashedPassword) {
-		session := models.NewSession()
-		return session.ID, orm.DB.Save(&session).Error
+	if !utils.CheckPasswordHash(sr.Password, user.HashedPassword) {
+		return "", errors.New("Invalid password")
 	}
-	return "", errors.New("Invalid password")
+	if user.TOTPEnabled {
+		if sr.TOTPCode == "" {
+			return "", ErrTOTPRequired
+		}
+		if !auth.ValidateTOTP(user.TOTPSecret, sr.TOTPCode, time.Now()) {
+			return "", ErrInvalidTOTP
+		}
+	}
+	session := models.NewSession()
+	session.UserEmail = user.Email
+	return session.ID, orm.DB.Save(&session).Error
 }
 
 const constantTimeEmailLength = 256
@@ -1077,9 +1191,12 @@ func constantTimeEmailCompare(left, right string) bool {
 	return subtle.ConstantTimeCompare(leftBytes, rightBytes) == 1
 }
 
-// ClearNonCurrentSessions removes all sessions but the id passed in.
+// ClearNonCurrentSessions removes all sessions of the user of the session
+// passed in but that one.
 func (orm *ORM) ClearNonCurrentSessions(sessionID string) error {
-	return orm.DB.Delete(&models.Session{}, "id != ?", sessionID).Error
+	return orm.DB.Exec(`
+DELETE FROM sessions WHERE id != ? AND user_email = (SELECT user_email FROM sessions WHERE id = ?)
+`, sessionID, sessionID).Error
 }
 
 // JobsSorted returns many JobSpecs sorted by CreatedAt from the store adhering
@@ -1183,6 +1300,9 @@ func (orm *ORM) UpdateBridgeType(bt *models.BridgeType, btr *models.BridgeTypeRe
 	bt.URL = btr.URL
 	bt.Confirmations = btr.Confirmations
 	bt.MinimumContractPayment = btr.MinimumContractPayment
+	bt.CreditCost = btr.CreditCost
+	bt.MaxConcurrency = btr.MaxConcurrency
+	bt.RateLimit = btr.RateLimit
 	return orm.DB.Save(bt).Error
 }
 
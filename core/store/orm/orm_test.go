@@ -955,6 +955,7 @@ func TestORM_AuthorizedUserWithSession(t *testing.T) {
 			require.NoError(t, store.SaveUser(&user))
 
 			prevSession := cltest.NewSession("correctID")
+			prevSession.UserEmail = user.Email
 			prevSession.LastUsed = time.Now().Add(-cltest.MustParseDuration(t, "2m"))
 			require.NoError(t, store.DB.Save(&prevSession).Error)
 
@@ -998,7 +999,7 @@ func TestORM_DeleteUserSession(t *testing.T) {
 	store, cleanup := cltest.NewStore(t)
 	defer cleanup()
 
-	session := models.NewSession()
+	session := cltest.NewSession()
 	require.NoError(t, store.DB.Save(&session).Error)
 
 	err := store.DeleteUserSession(session.ID)
@@ -1012,6 +1013,42 @@ func TestORM_DeleteUserSession(t *testing.T) {
 	require.Empty(t, sessions)
 }
 
+func TestORM_APIAuditLogEntries(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+
+	now := time.Now()
+	for _, entry := range []models.APIAuditLogEntry{
+		{UserEmail: "a@chainlink.test", Method: "POST", Route: "/v2/jobs", Path: "/v2/jobs", StatusCode: 200, CreatedAt: now.Add(-time.Hour)},
+		{UserEmail: "a@chainlink.test", Method: "DELETE", Route: "/v2/jobs/:ID", Path: "/v2/jobs/1", StatusCode: 204, CreatedAt: now},
+		{UserEmail: "b@chainlink.test", Method: "POST", Route: "/v2/job_proposals/:id/approve", Path: "/v2/job_proposals/1/approve", StatusCode: 500, Error: null.StringFrom("boom"), CreatedAt: now},
+	} {
+		entry := entry
+		require.NoError(t, store.CreateAPIAuditLogEntry(&entry))
+	}
+
+	entries, count, err := store.APIAuditLogEntries(models.APIAuditLogFilter{}, 0, 2)
+	require.NoError(t, err)
+	assert.Equal(t, 3, count)
+	require.Len(t, entries, 2)
+	assert.Equal(t, "/v2/job_proposals/:id/approve", entries[0].Route)
+	assert.Equal(t, null.StringFrom("boom"), entries[0].Error)
+	assert.Equal(t, "/v2/jobs/:ID", entries[1].Route)
+
+	_, count, err = store.APIAuditLogEntries(models.APIAuditLogFilter{UserEmail: "a@chainlink.test", Method: "post"}, 0, 10)
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+
+	_, count, err = store.APIAuditLogEntries(models.APIAuditLogFilter{Since: null.TimeFrom(now.Add(-time.Minute))}, 0, 10)
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+
+	err = store.DB.Exec(`DELETE FROM api_audit_log_entries`).Error
+	require.Error(t, err)
+}
+
 func TestORM_CreateSession(t *testing.T) {
 	t.Parallel()
 
@@ -1052,6 +1089,109 @@ func TestORM_CreateSession(t *testing.T) {
 	}
 }
 
+func TestORM_CreateSession_TOTP(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+
+	user := cltest.MustRandomUser()
+	secret, err := auth.NewTOTPSecret()
+	require.NoError(t, err)
+	user.TOTPSecret = secret
+	user.TOTPEnabled = true
+	require.NoError(t, store.SaveUser(&user))
+
+	code, err := auth.TOTPCode(secret, time.Now())
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		code    string
+		wantErr error
+	}{
+		{"missing code", "", orm.ErrTOTPRequired},
+		{"wrong code", "abcdef", orm.ErrInvalidTOTP},
+		{"correct code", code, nil},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			sessionID, err := store.CreateSession(models.SessionRequest{
+				Email:    user.Email,
+				Password: cltest.Password,
+				TOTPCode: test.code,
+			})
+			if test.wantErr != nil {
+				require.Equal(t, test.wantErr, err)
+				assert.Empty(t, sessionID)
+			} else {
+				require.NoError(t, err)
+				assert.NotEmpty(t, sessionID)
+			}
+		})
+	}
+}
+
+func TestORM_MultipleUsers(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+
+	other := cltest.MustNewUser(t, "other@chainlink.test", cltest.Password)
+	require.NoError(t, store.CreateUser(&other))
+	duplicate := cltest.MustNewUser(t, "Other@chainlink.test", cltest.Password)
+	require.Equal(t, orm.ErrUserExists, store.CreateUser(&duplicate))
+
+	users, err := store.Users()
+	require.NoError(t, err)
+	require.Len(t, users, 2)
+	assert.Equal(t, cltest.APIEmail, users[0].Email)
+	assert.Equal(t, other.Email, users[1].Email)
+
+	token := auth.NewToken()
+	require.NoError(t, other.SetAuthToken(token))
+	require.NoError(t, store.SaveUser(&other))
+	found, err := store.FindUserByAPIToken(token.AccessKey)
+	require.NoError(t, err)
+	assert.Equal(t, other.Email, found.Email)
+	_, err = store.FindUserByAPIToken("")
+	require.Equal(t, orm.ErrorNotFound, err)
+
+	apiSessionID, err := store.CreateSession(models.SessionRequest{Email: cltest.APIEmail, Password: cltest.Password})
+	require.NoError(t, err)
+	otherSessionID, err := store.CreateSession(models.SessionRequest{Email: other.Email, Password: cltest.Password})
+	require.NoError(t, err)
+	otherSession2ID, err := store.CreateSession(models.SessionRequest{Email: other.Email, Password: cltest.Password})
+	require.NoError(t, err)
+
+	user, err := store.ORM.AuthorizedUserWithSession(otherSessionID, time.Hour)
+	require.NoError(t, err)
+	assert.Equal(t, other.Email, user.Email)
+
+	sessions, err := store.UserSessions(other.Email)
+	require.NoError(t, err)
+	require.Len(t, sessions, 2)
+
+	require.Equal(t, orm.ErrorNotFound, store.RevokeUserSession(other.Email, apiSessionID))
+	require.NoError(t, store.ClearNonCurrentSessions(otherSessionID))
+	sessions, err = store.UserSessions(other.Email)
+	require.NoError(t, err)
+	require.Len(t, sessions, 1)
+	assert.Equal(t, otherSessionID, sessions[0].ID)
+	_, err = store.ORM.AuthorizedUserWithSession(otherSession2ID, time.Hour)
+	require.Error(t, err)
+	_, err = store.ORM.AuthorizedUserWithSession(apiSessionID, time.Hour)
+	require.NoError(t, err)
+
+	require.NoError(t, store.DeleteUserByEmail(other.Email))
+	require.Equal(t, orm.ErrorNotFound, store.DeleteUserByEmail(other.Email))
+	sessions, err = store.UserSessions(other.Email)
+	require.NoError(t, err)
+	require.Empty(t, sessions)
+}
+
 func TestORM_AllSyncEvents(t *testing.T) {
 	store, cleanup := cltest.NewStore(t)
 	defer cleanup()
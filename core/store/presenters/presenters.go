@@ -35,7 +35,13 @@ type ConfigPrinter struct {
 
 // EnvPrinter contains the supported environment variables
 type EnvPrinter struct {
+	AlertsEmailFrom                            string          `json:"ALERTS_EMAIL_FROM"`
+	AlertsEvaluationInterval                   time.Duration   `json:"ALERTS_EVALUATION_INTERVAL"`
 	AllowOrigins                               string          `json:"ALLOW_ORIGINS"`
+	AuthFailureLimit                           uint32          `json:"AUTH_FAILURE_LIMIT"`
+	AuthFailureWindow                          time.Duration   `json:"AUTH_FAILURE_WINDOW"`
+	AuthLockoutDuration                        time.Duration   `json:"AUTH_LOCKOUT_DURATION"`
+	AuthLockoutMaxDuration                     time.Duration   `json:"AUTH_LOCKOUT_MAX_DURATION"`
 	BalanceMonitorEnabled                      bool            `json:"BALANCE_MONITOR_ENABLED"`
 	BlockBackfillDepth                         uint64          `json:"BLOCK_BACKFILL_DEPTH"`
 	BlockBackfillSkip                          bool            `json:"BLOCK_BACKFILL_SKIP"`
@@ -56,17 +62,33 @@ type EnvPrinter struct {
 	EnableLegacyJobPipeline                    bool            `json:"ENABLE_LEGACY_JOB_PIPELINE"`
 	EthBalanceMonitorBlockDelay                uint16          `json:"ETH_BALANCE_MONITOR_BLOCK_DELAY"`
 	EthFinalityDepth                           uint            `json:"ETH_FINALITY_DEPTH"`
+	EthGasBumpPercentHighUrgency               uint16          `json:"ETH_GAS_BUMP_PERCENT_HIGH_URGENCY"`
 	EthGasBumpThreshold                        uint64          `json:"ETH_GAS_BUMP_THRESHOLD"`
+	EthGasBumpThresholdHighUrgency             uint64          `json:"ETH_GAS_BUMP_THRESHOLD_HIGH_URGENCY"`
+	EthGasBumpThresholdLowUrgency              uint64          `json:"ETH_GAS_BUMP_THRESHOLD_LOW_URGENCY"`
 	EthGasBumpTxDepth                          uint16          `json:"ETH_GAS_BUMP_TX_DEPTH"`
 	EthGasBumpWei                              *big.Int        `json:"ETH_GAS_BUMP_WEI"`
 	EthGasLimitDefault                         uint64          `json:"ETH_GAS_LIMIT_DEFAULT"`
+	EthGasLimitEstimateMultiplier              float32         `json:"ETH_GAS_LIMIT_ESTIMATE_MULTIPLIER"`
 	EthGasLimitTransfer                        uint64          `json:"ETH_GAS_LIMIT_TRANSFER"`
 	EthGasPriceDefault                         *big.Int        `json:"ETH_GAS_PRICE_DEFAULT"`
+	EthHeadBroadcasterAsyncSlowSubscribers     bool            `json:"ETH_HEAD_BROADCASTER_ASYNC_SLOW_SUBSCRIBERS"`
+	EthHeadBroadcasterCallbackBudget           time.Duration   `json:"ETH_HEAD_BROADCASTER_CALLBACK_BUDGET"`
 	EthHeadTrackerHistoryDepth                 uint            `json:"ETH_HEAD_TRACKER_HISTORY_DEPTH"`
 	EthHeadTrackerMaxBufferSize                uint            `json:"ETH_HEAD_TRACKER_MAX_BUFFER_SIZE"`
+	EthHeadTrackerMaxProviderLag               uint            `json:"ETH_HEAD_TRACKER_MAX_PROVIDER_LAG"`
+	EthHeadTrackerURLs                         []string        `json:"ETH_HEAD_TRACKER_URLS"`
 	EthMaxGasPriceWei                          *big.Int        `json:"ETH_MAX_GAS_PRICE_WEI"`
+	EthMaxGasPriceWeiLowUrgency                *big.Int        `json:"ETH_MAX_GAS_PRICE_WEI_LOW_URGENCY"`
+	EthNodePollInterval                        time.Duration   `json:"ETH_NODE_POLL_INTERVAL"`
+	EthRPCCacheSize                            uint32          `json:"ETH_RPC_CACHE_SIZE"`
+	EthRPCCacheTTL                             time.Duration   `json:"ETH_RPC_CACHE_TTL"`
+	EthRPCDetectCapabilities                   bool            `json:"ETH_RPC_DETECT_CAPABILITIES"`
+	EthSendToAllNodes                          bool            `json:"ETH_SEND_TO_ALL_NODES"`
+	EthUseFinalityTag                          bool            `json:"ETH_USE_FINALITY_TAG"`
 	EthereumDisabled                           bool            `json:"ETH_DISABLED"`
 	EthereumHTTPURL                            string          `json:"ETH_HTTP_URL"`
+	EthereumPrimaryURLs                        []string        `json:"ETH_PRIMARY_URLS"`
 	EthereumSecondaryURLs                      []string        `json:"ETH_SECONDARY_URLS"`
 	EthereumURL                                string          `json:"ETH_URL"`
 	ExplorerURL                                string          `json:"EXPLORER_URL"`
@@ -74,13 +96,24 @@ type EnvPrinter struct {
 	FeatureExternalInitiators                  bool            `json:"FEATURE_EXTERNAL_INITIATORS"`
 	FeatureFluxMonitor                         bool            `json:"FEATURE_FLUX_MONITOR"`
 	FeatureOffchainReporting                   bool            `json:"FEATURE_OFFCHAIN_REPORTING"`
+	FeedsJobProposalRetention                  time.Duration   `json:"FEEDS_JOB_PROPOSAL_RETENTION"`
 	FlagsContractAddress                       string          `json:"FLAGS_CONTRACT_ADDRESS"`
 	GasEstimatorMode                           string          `json:"GAS_ESTIMATOR_MODE"`
 	InsecureFastScrypt                         bool            `json:"INSECURE_FAST_SCRYPT"`
 	JSONConsole                                bool            `json:"JSON_CONSOLE"`
+	JobPipelineMaxNodeTaskConcurrency          uint64          `json:"JOB_PIPELINE_MAX_NODE_TASK_CONCURRENCY"`
+	JobPipelineMaxRunTaskConcurrency           uint64          `json:"JOB_PIPELINE_MAX_RUN_TASK_CONCURRENCY"`
 	JobPipelineReaperInterval                  time.Duration   `json:"JOB_PIPELINE_REAPER_INTERVAL"`
 	JobPipelineReaperThreshold                 time.Duration   `json:"JOB_PIPELINE_REAPER_THRESHOLD"`
+	JobPipelineScriptTaskMaxDuration           time.Duration   `json:"JOB_PIPELINE_SCRIPT_TASK_MAX_DURATION"`
+	JobPipelineScriptTaskMaxMemory             uint64          `json:"JOB_PIPELINE_SCRIPT_TASK_MAX_MEMORY"`
+	JobPipelineTraceHeaders                    []string        `json:"JOB_PIPELINE_TRACE_HEADERS"`
+	JobMaxStartAttempts                        uint32          `json:"JOB_MAX_START_ATTEMPTS"`
+	JobStartTimeout                            time.Duration   `json:"JOB_START_TIMEOUT"`
 	KeeperDefaultTransactionQueueDepth         uint32          `json:"KEEPER_DEFAULT_TRANSACTION_QUEUE_DEPTH"`
+	KeeperEligibilityStrategy                  string          `json:"KEEPER_ELIGIBILITY_STRATEGY"`
+	KeeperSimulationMaxConsecutiveReverts      uint32          `json:"KEEPER_SIMULATION_MAX_CONSECUTIVE_REVERTS"`
+	KeeperSimulationParkDuration               time.Duration   `json:"KEEPER_SIMULATION_PARK_DURATION"`
 	LinkContractAddress                        string          `json:"LINK_CONTRACT_ADDRESS"`
 	LogLevel                                   config.LogLevel `json:"LOG_LEVEL"`
 	LogSQLMigrations                           bool            `json:"LOG_SQL_MIGRATIONS"`
@@ -118,10 +151,13 @@ type EnvPrinter struct {
 	ReplayFromBlock                            int64           `json:"REPLAY_FROM_BLOCK"`
 	RootDir                                    string          `json:"ROOT"`
 	SecureCookies                              bool            `json:"SECURE_COOKIES"`
+	SessionCookieSameSite                      string          `json:"SESSION_COOKIE_SAME_SITE"`
 	SessionTimeout                             models.Duration `json:"SESSION_TIMEOUT"`
 	TLSHost                                    string          `json:"CHAINLINK_TLS_HOST"`
 	TLSPort                                    uint16          `json:"CHAINLINK_TLS_PORT"`
 	TLSRedirect                                bool            `json:"CHAINLINK_TLS_REDIRECT"`
+	TrustedProxies                             []string        `json:"TRUSTED_PROXIES"`
+	VRFProofWorkers                            uint64          `json:"VRF_PROOF_WORKERS"`
 }
 
 // NewConfigPrinter creates an instance of ConfigPrinter
@@ -133,13 +169,24 @@ func NewConfigPrinter(store *store.Store) (ConfigPrinter, error) {
 		explorerURL = config.ExplorerURL().String()
 	}
 	p2pBootstrapPeers, _ := config.P2PBootstrapPeers(nil)
+	trustedProxies, _ := config.TrustedProxies()
+	trustedProxyRanges := make([]string, len(trustedProxies))
+	for i, proxy := range trustedProxies {
+		trustedProxyRanges[i] = proxy.String()
+	}
 	ethereumHTTPURL := ""
 	if config.EthereumHTTPURL() != nil {
 		ethereumHTTPURL = config.EthereumHTTPURL().String()
 	}
 	return ConfigPrinter{
 		EnvPrinter: EnvPrinter{
+			AlertsEmailFrom:                            config.AlertsEmailFrom(),
+			AlertsEvaluationInterval:                   config.AlertsEvaluationInterval(),
 			AllowOrigins:                               config.AllowOrigins(),
+			AuthFailureLimit:                           config.AuthFailureLimit(),
+			AuthFailureWindow:                          config.AuthFailureWindow(),
+			AuthLockoutDuration:                        config.AuthLockoutDuration(),
+			AuthLockoutMaxDuration:                     config.AuthLockoutMaxDuration(),
 			BalanceMonitorEnabled:                      config.BalanceMonitorEnabled(),
 			BlockBackfillDepth:                         config.BlockBackfillDepth(),
 			BlockBackfillSkip:                          config.BlockBackfillSkip(),
@@ -160,17 +207,33 @@ func NewConfigPrinter(store *store.Store) (ConfigPrinter, error) {
 			EnableLegacyJobPipeline:                    config.EnableLegacyJobPipeline(),
 			EthBalanceMonitorBlockDelay:                config.EthBalanceMonitorBlockDelay(),
 			EthFinalityDepth:                           config.EthFinalityDepth(),
+			EthGasBumpPercentHighUrgency:               config.EthGasBumpPercentHighUrgency(),
 			EthGasBumpThreshold:                        config.EthGasBumpThreshold(),
+			EthGasBumpThresholdHighUrgency:             config.EthGasBumpThresholdHighUrgency(),
+			EthGasBumpThresholdLowUrgency:              config.EthGasBumpThresholdLowUrgency(),
 			EthGasBumpTxDepth:                          config.EthGasBumpTxDepth(),
 			EthGasBumpWei:                              config.EthGasBumpWei(),
 			EthGasLimitDefault:                         config.EthGasLimitDefault(),
+			EthGasLimitEstimateMultiplier:              config.EthGasLimitEstimateMultiplier(),
 			EthGasLimitTransfer:                        config.EthGasLimitTransfer(),
 			EthGasPriceDefault:                         config.EthGasPriceDefault(),
+			EthHeadBroadcasterAsyncSlowSubscribers:     config.EthHeadBroadcasterAsyncSlowSubscribers(),
+			EthHeadBroadcasterCallbackBudget:           config.EthHeadBroadcasterCallbackBudget(),
 			EthHeadTrackerHistoryDepth:                 config.EthHeadTrackerHistoryDepth(),
 			EthHeadTrackerMaxBufferSize:                config.EthHeadTrackerMaxBufferSize(),
+			EthHeadTrackerMaxProviderLag:               config.EthHeadTrackerMaxProviderLag(),
+			EthHeadTrackerURLs:                         mapToStringA(config.EthHeadTrackerURLs()),
 			EthMaxGasPriceWei:                          config.EthMaxGasPriceWei(),
+			EthMaxGasPriceWeiLowUrgency:                config.EthMaxGasPriceWeiLowUrgency(),
+			EthNodePollInterval:                        config.EthNodePollInterval(),
+			EthRPCCacheSize:                            config.EthRPCCacheSize(),
+			EthRPCCacheTTL:                             config.EthRPCCacheTTL(),
+			EthRPCDetectCapabilities:                   config.EthRPCDetectCapabilities(),
+			EthSendToAllNodes:                          config.EthSendToAllNodes(),
+			EthUseFinalityTag:                          config.EthUseFinalityTag(),
 			EthereumDisabled:                           config.EthereumDisabled(),
 			EthereumHTTPURL:                            ethereumHTTPURL,
+			EthereumPrimaryURLs:                        mapToStringA(config.EthereumPrimaryURLs()),
 			EthereumSecondaryURLs:                      mapToStringA(config.EthereumSecondaryURLs()),
 			EthereumURL:                                config.EthereumURL(),
 			ExplorerURL:                                explorerURL,
@@ -178,13 +241,24 @@ func NewConfigPrinter(store *store.Store) (ConfigPrinter, error) {
 			FeatureExternalInitiators:                  config.FeatureExternalInitiators(),
 			FeatureFluxMonitor:                         config.FeatureFluxMonitor(),
 			FeatureOffchainReporting:                   config.FeatureOffchainReporting(),
+			FeedsJobProposalRetention:                  config.FeedsJobProposalRetention(),
 			FlagsContractAddress:                       config.FlagsContractAddress(),
 			GasEstimatorMode:                           config.GasEstimatorMode(),
 			InsecureFastScrypt:                         config.InsecureFastScrypt(),
 			JSONConsole:                                config.JSONConsole(),
+			JobPipelineMaxNodeTaskConcurrency:          config.JobPipelineMaxNodeTaskConcurrency(),
+			JobPipelineMaxRunTaskConcurrency:           config.JobPipelineMaxRunTaskConcurrency(),
 			JobPipelineReaperInterval:                  config.JobPipelineReaperInterval(),
 			JobPipelineReaperThreshold:                 config.JobPipelineReaperThreshold(),
+			JobPipelineScriptTaskMaxDuration:           config.JobPipelineScriptTaskMaxDuration(),
+			JobPipelineScriptTaskMaxMemory:             config.JobPipelineScriptTaskMaxMemory(),
+			JobPipelineTraceHeaders:                    config.JobPipelineTraceHeaders(),
+			JobMaxStartAttempts:                        config.JobMaxStartAttempts(),
+			JobStartTimeout:                            config.JobStartTimeout(),
 			KeeperDefaultTransactionQueueDepth:         config.KeeperDefaultTransactionQueueDepth(),
+			KeeperEligibilityStrategy:                  config.KeeperEligibilityStrategy(),
+			KeeperSimulationMaxConsecutiveReverts:      config.KeeperSimulationMaxConsecutiveReverts(),
+			KeeperSimulationParkDuration:               config.KeeperSimulationParkDuration(),
 			LinkContractAddress:                        config.LinkContractAddress(),
 			LogLevel:                                   config.LogLevel(),
 			LogSQLMigrations:                           config.LogSQLMigrations(),
@@ -222,10 +296,13 @@ func NewConfigPrinter(store *store.Store) (ConfigPrinter, error) {
 			ReplayFromBlock:                            config.ReplayFromBlock(),
 			RootDir:                                    config.RootDir(),
 			SecureCookies:                              config.SecureCookies(),
+			SessionCookieSameSite:                      config.SessionCookieSameSite(),
 			SessionTimeout:                             config.SessionTimeout(),
 			TLSHost:                                    config.TLSHost(),
 			TLSPort:                                    config.TLSPort(),
 			TLSRedirect:                                config.TLSRedirect(),
+			TrustedProxies:                             trustedProxyRanges,
+			VRFProofWorkers:                            config.VRFProofWorkers(),
 		},
 	}, nil
 }
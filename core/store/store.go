@@ -123,7 +123,7 @@ func (s *Store) Unscoped() *Store {
 	return &cpy
 }
 
-// AuthorizedUserWithSession will return the one API user if the Session ID exists
+// AuthorizedUserWithSession will return the API user of the Session ID if it exists
 // and hasn't expired, and update session's LastUsed field.
 func (s *Store) AuthorizedUserWithSession(sessionID string) (models.User, error) {
 	return s.ORM.AuthorizedUserWithSession(
@@ -2,6 +2,7 @@ package utils
 
 import (
 	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/pkg/errors"
 )
 
 const (
@@ -30,6 +31,23 @@ var DefaultScryptParams = ScryptParams{N: keystore.StandardScryptN, P: keystore.
 // encrypted keys will be easy to brute-force!
 var FastScryptParams = ScryptParams{N: FastN, P: FastP}
 
+// LightScryptParams uses geth's light level of encryption. It is much cheaper
+// to decode than DefaultScryptParams, but also easier to brute-force.
+var LightScryptParams = ScryptParams{N: keystore.LightScryptN, P: keystore.LightScryptP}
+
+// ScryptParamsForStrength returns the ScryptParams of a named strength, either
+// "standard" for DefaultScryptParams or "light" for LightScryptParams
+func ScryptParamsForStrength(strength string) (ScryptParams, error) {
+	switch strength {
+	case "standard":
+		return DefaultScryptParams, nil
+	case "light":
+		return LightScryptParams, nil
+	default:
+		return ScryptParams{}, errors.Errorf("unknown scrypt strength %q, must be standard or light", strength)
+	}
+}
+
 // GetScryptParams fetches ScryptParams from a ScryptConfigReader
 func GetScryptParams(config ScryptConfigReader) ScryptParams {
 	if config.InsecureFastScrypt() {
@@ -10,6 +10,7 @@ import (
 	"fmt"
 	"math/big"
 	mrand "math/rand"
+	"net/url"
 	"reflect"
 	"runtime"
 	"sort"
@@ -856,9 +857,16 @@ func ValidateCronSchedule(schedule string) error {
 	if !(strings.HasPrefix(schedule, "CRON_TZ=") || strings.HasPrefix(schedule, "@every ")) {
 		return errors.New("cron schedule must specify a time zone using CRON_TZ, e.g. 'CRON_TZ=UTC 5 * * * *', or use the @every syntax, e.g. '@every 1h30m'")
 	}
+	_, err := ParseCronSchedule(schedule)
+	return err
+}
+
+// ParseCronSchedule parses a cron schedule, of which the seconds field is
+// optional
+func ParseCronSchedule(schedule string) (cron.Schedule, error) {
 	parser := cron.NewParser(cron.SecondOptional | cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow | cron.Descriptor)
-	_, err := parser.Parse(schedule)
-	return errors.Wrapf(err, "invalid cron schedule '%v'", schedule)
+	s, err := parser.Parse(schedule)
+	return s, errors.Wrapf(err, "invalid cron schedule '%v'", schedule)
 }
 
 // ResettableTimer stores a timer
@@ -1087,3 +1095,26 @@ func Example_boxOutput() {
 	// →             ←
 	// ↗↑↑↑↑↑↑↑↑↑↑↑↑↑↖
 }
+
+// RedactURL masks the parts of URL s which may hold credentials, its
+// password, path and query, e.g. the token in the path of a webhook URL or
+// an API key query param. The scheme, username and host are kept to
+// identify the URL. Strings which are not absolute URLs are masked entirely.
+func RedactURL(s string) string {
+	const redacted = "xxxxx"
+	u, err := url.Parse(s)
+	if err != nil || u.Host == "" {
+		return redacted
+	}
+	r := url.URL{Scheme: u.Scheme, User: u.User, Host: u.Host}
+	if _, hasPassword := u.User.Password(); hasPassword {
+		r.User = url.UserPassword(u.User.Username(), redacted)
+	}
+	if u.Path != "" && u.Path != "/" {
+		r.Path = "/" + redacted
+	}
+	if u.RawQuery != "" {
+		r.RawQuery = redacted
+	}
+	return r.String()
+}
@@ -0,0 +1,96 @@
+package web
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+
+	"github.com/smartcontractkit/chainlink/core/services/alerts"
+	"github.com/smartcontractkit/chainlink/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+// AlertChannelsController manages the channels alerts are delivered to
+type AlertChannelsController struct {
+	App chainlink.Application
+}
+
+// CreateAlertChannelRequest is the request to add an alert channel
+type CreateAlertChannelRequest struct {
+	Name       string             `json:"name"`
+	Type       alerts.ChannelType `json:"type"`
+	URL        string             `json:"url"`
+	Recipients []string           `json:"recipients"`
+}
+
+// Index lists the alert channels
+// Example:
+// "GET <application>/alerts/channels"
+func (acc *AlertChannelsController) Index(c *gin.Context) {
+	channels, err := alerts.NewORM(acc.App.GetStore().DB).ListChannels(c.Request.Context())
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	resources := []presenters.AlertChannelResource{}
+	for _, channel := range channels {
+		resources = append(resources, presenters.NewAlertChannelResource(channel))
+	}
+
+	jsonAPIResponse(c, resources, "alertChannels")
+}
+
+// Create adds an alert channel
+// Example:
+// "POST <application>/alerts/channels"
+func (acc *AlertChannelsController) Create(c *gin.Context) {
+	request := &CreateAlertChannelRequest{}
+	if err := c.ShouldBindJSON(request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	channel := alerts.Channel{
+		Name:       request.Name,
+		Type:       request.Type,
+		URL:        request.URL,
+		Recipients: request.Recipients,
+	}
+	if err := channel.Validate(); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	if err := alerts.NewORM(acc.App.GetStore().DB).CreateChannel(c.Request.Context(), &channel); err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponseWithStatus(c, presenters.NewAlertChannelResource(channel), "alertChannels", http.StatusCreated)
+}
+
+// Delete removes an alert channel
+// Example:
+// "DELETE <application>/alerts/channels/:ID"
+func (acc *AlertChannelsController) Delete(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("ID"), 10, 64)
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	err = alerts.NewORM(acc.App.GetStore().DB).DeleteChannel(c.Request.Context(), id)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		jsonAPIError(c, http.StatusNotFound, errors.New("alert channel not found"))
+		return
+	}
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponseWithStatus(c, nil, "alertChannels", http.StatusNoContent)
+}
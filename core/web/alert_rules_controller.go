@@ -0,0 +1,118 @@
+package web
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+
+	"github.com/smartcontractkit/chainlink/core/services/alerts"
+	"github.com/smartcontractkit/chainlink/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+// AlertRulesController manages the alert rules
+type AlertRulesController struct {
+	App chainlink.Application
+}
+
+// CreateAlertRuleRequest is the request to add an alert rule
+type CreateAlertRuleRequest struct {
+	Name       string            `json:"name"`
+	Type       alerts.RuleType   `json:"type"`
+	Params     alerts.RuleParams `json:"params"`
+	ChannelIDs []int64           `json:"channelIDs"`
+}
+
+// Index lists the alert rules
+// Example:
+// "GET <application>/alerts/rules"
+func (arc *AlertRulesController) Index(c *gin.Context) {
+	rules, err := alerts.NewORM(arc.App.GetStore().DB).ListRules(c.Request.Context())
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	resources := []presenters.AlertRuleResource{}
+	for _, rule := range rules {
+		resources = append(resources, presenters.NewAlertRuleResource(rule))
+	}
+
+	jsonAPIResponse(c, resources, "alertRules")
+}
+
+// Create adds an alert rule, which is evaluated from the next evaluation on
+// Example:
+// "POST <application>/alerts/rules"
+func (arc *AlertRulesController) Create(c *gin.Context) {
+	request := &CreateAlertRuleRequest{}
+	if err := c.ShouldBindJSON(request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	rule := alerts.Rule{
+		Name:       request.Name,
+		Type:       request.Type,
+		Params:     request.Params,
+		ChannelIDs: request.ChannelIDs,
+	}
+	if err := rule.Validate(); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	orm := alerts.NewORM(arc.App.GetStore().DB)
+	channels, err := orm.ListChannels(c.Request.Context())
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	for _, id := range request.ChannelIDs {
+		if !hasAlertChannel(channels, id) {
+			jsonAPIError(c, http.StatusUnprocessableEntity, errors.Errorf("alert channel %d not found", id))
+			return
+		}
+	}
+
+	if err := orm.CreateRule(c.Request.Context(), &rule); err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponseWithStatus(c, presenters.NewAlertRuleResource(rule), "alertRules", http.StatusCreated)
+}
+
+// Delete removes an alert rule and its silences
+// Example:
+// "DELETE <application>/alerts/rules/:ID"
+func (arc *AlertRulesController) Delete(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("ID"), 10, 64)
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	err = alerts.NewORM(arc.App.GetStore().DB).DeleteRule(c.Request.Context(), id)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		jsonAPIError(c, http.StatusNotFound, errors.New("alert rule not found"))
+		return
+	}
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponseWithStatus(c, nil, "alertRules", http.StatusNoContent)
+}
+
+func hasAlertChannel(channels []alerts.Channel, id int64) bool {
+	for _, channel := range channels {
+		if channel.ID == id {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,125 @@
+package web
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+	"gopkg.in/guregu/null.v4"
+	"gorm.io/gorm"
+
+	"github.com/smartcontractkit/chainlink/core/services/alerts"
+	"github.com/smartcontractkit/chainlink/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/core/store/models"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+// AlertSilencesController manages the silences of alert rules
+type AlertSilencesController struct {
+	App chainlink.Application
+}
+
+// CreateAlertSilenceRequest is the request to silence an alert rule, or
+// every rule if RuleID is not set, for Duration
+type CreateAlertSilenceRequest struct {
+	RuleID   null.Int        `json:"ruleID"`
+	Reason   string          `json:"reason"`
+	Duration models.Duration `json:"duration"`
+}
+
+// Index lists the alert silences which have not ended, or every silence if
+// the all query param is true
+// Example:
+// "GET <application>/alerts/silences"
+func (asc *AlertSilencesController) Index(c *gin.Context) {
+	activeAt := time.Now()
+	if c.Query("all") == "true" {
+		activeAt = time.Time{}
+	}
+	silences, err := alerts.NewORM(asc.App.GetStore().DB).ListSilences(c.Request.Context(), activeAt)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	resources := []presenters.AlertSilenceResource{}
+	for _, silence := range silences {
+		resources = append(resources, presenters.NewAlertSilenceResource(silence))
+	}
+
+	jsonAPIResponse(c, resources, "alertSilences")
+}
+
+// Create silences an alert rule, or every rule
+// Example:
+// "POST <application>/alerts/silences"
+func (asc *AlertSilencesController) Create(c *gin.Context) {
+	request := &CreateAlertSilenceRequest{}
+	if err := c.ShouldBindJSON(request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	if request.Duration.Duration() <= 0 {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.New("a positive duration is required"))
+		return
+	}
+
+	orm := alerts.NewORM(asc.App.GetStore().DB)
+	if request.RuleID.Valid {
+		rules, err := orm.ListRules(c.Request.Context())
+		if err != nil {
+			jsonAPIError(c, http.StatusInternalServerError, err)
+			return
+		}
+		if !hasAlertRule(rules, request.RuleID.Int64) {
+			jsonAPIError(c, http.StatusUnprocessableEntity, errors.Errorf("alert rule %d not found", request.RuleID.Int64))
+			return
+		}
+	}
+
+	silence := alerts.Silence{
+		RuleID: request.RuleID,
+		Reason: request.Reason,
+		EndsAt: time.Now().Add(request.Duration.Duration()),
+	}
+	if err := orm.CreateSilence(c.Request.Context(), &silence); err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponseWithStatus(c, presenters.NewAlertSilenceResource(silence), "alertSilences", http.StatusCreated)
+}
+
+// Delete ends an alert silence early
+// Example:
+// "DELETE <application>/alerts/silences/:ID"
+func (asc *AlertSilencesController) Delete(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("ID"), 10, 64)
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	err = alerts.NewORM(asc.App.GetStore().DB).DeleteSilence(c.Request.Context(), id)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		jsonAPIError(c, http.StatusNotFound, errors.New("alert silence not found"))
+		return
+	}
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponseWithStatus(c, nil, "alertSilences", http.StatusNoContent)
+}
+
+func hasAlertRule(rules []alerts.Rule, id int64) bool {
+	for _, rule := range rules {
+		if rule.ID == id {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,29 @@
+package web
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"github.com/smartcontractkit/chainlink/core/services/alerts"
+	"github.com/smartcontractkit/chainlink/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+// AlertsController lists the alert history
+type AlertsController struct {
+	App chainlink.Application
+}
+
+// Index lists the alerts raised by the alert rules firing and resolving,
+// newest first
+// Example:
+// "GET <application>/alerts"
+func (ac *AlertsController) Index(c *gin.Context, size, page, offset int) {
+	history, count, err := alerts.NewORM(ac.App.GetStore().DB).ListAlerts(c.Request.Context(), offset, size)
+
+	resources := []presenters.AlertResource{}
+	for _, alert := range history {
+		resources = append(resources, presenters.NewAlertResource(alert))
+	}
+
+	paginatedResponse(c, "alerts", size, page, resources, count, err)
+}
@@ -0,0 +1,184 @@
+package web_test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/services/alerts"
+	"github.com/smartcontractkit/chainlink/core/store/models"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+func setupAlertsControllerTest(t *testing.T) (cltest.HTTPClientCleaner, alerts.ORM) {
+	ethClient, _, assertMocksCalled := cltest.NewEthMocksWithStartupAssertions(t)
+	t.Cleanup(assertMocksCalled)
+	app, cleanup := cltest.NewApplicationWithKey(t,
+		ethClient,
+	)
+	t.Cleanup(cleanup)
+	require.NoError(t, app.Start())
+
+	return app.NewHTTPClient(), alerts.NewORM(app.GetStore().DB)
+}
+
+func TestAlertChannelsController(t *testing.T) {
+	t.Parallel()
+
+	client, _ := setupAlertsControllerTest(t)
+
+	resp, cleanup := client.Post("/v2/alerts/channels", bytes.NewBufferString(`{"name":"ops","type":"email"}`))
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusUnprocessableEntity)
+
+	resp, cleanup = client.Post("/v2/alerts/channels", bytes.NewBufferString(`{"name":"ops","type":"webhook","url":"http://localhost:8080/alerts"}`))
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusCreated)
+	var channel presenters.AlertChannelResource
+	require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &channel))
+	assert.Equal(t, "ops", channel.Name)
+	assert.Equal(t, alerts.ChannelTypeWebhook, channel.Type)
+
+	resp, cleanup = client.Get("/v2/alerts/channels")
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+	var channels []presenters.AlertChannelResource
+	require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &channels))
+	require.Len(t, channels, 1)
+	assert.Equal(t, "http://localhost:8080/xxxxx", channels[0].URL, "the URL is redacted")
+
+	resp, cleanup = client.Delete("/v2/alerts/channels/" + channel.ID)
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusNoContent)
+
+	resp, cleanup = client.Delete("/v2/alerts/channels/" + channel.ID)
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusNotFound)
+}
+
+func TestAlertRulesController(t *testing.T) {
+	t.Parallel()
+
+	client, orm := setupAlertsControllerTest(t)
+
+	channel := alerts.Channel{Name: "ops", Type: alerts.ChannelTypeSlack, URL: "http://localhost:8080/slack"}
+	require.NoError(t, orm.CreateChannel(context.Background(), &channel))
+
+	tests := []struct {
+		name       string
+		body       string
+		wantStatus int
+	}{
+		{"invalid type", `{"name":"rule","type":"unknown"}`, http.StatusUnprocessableEntity},
+		{"missing params", `{"name":"rule","type":"no_heads"}`, http.StatusUnprocessableEntity},
+		{"unknown channel", `{"name":"rule","type":"no_heads","params":{"duration":"5m"},"channelIDs":[9999]}`, http.StatusUnprocessableEntity},
+		{"valid", fmt.Sprintf(`{"name":"rule","type":"no_heads","params":{"duration":"5m"},"channelIDs":[%d]}`, channel.ID), http.StatusCreated},
+	}
+	for _, test := range tests {
+		resp, cleanup := client.Post("/v2/alerts/rules", bytes.NewBufferString(test.body))
+		defer cleanup()
+		cltest.AssertServerResponse(t, resp, test.wantStatus)
+	}
+
+	resp, cleanup := client.Get("/v2/alerts/rules")
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+	var rules []presenters.AlertRuleResource
+	require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &rules))
+	require.Len(t, rules, 1)
+	assert.Equal(t, alerts.RuleTypeNoHeads, rules[0].Type)
+	assert.Equal(t, 5*time.Minute, rules[0].Params.Duration.Duration())
+	assert.Equal(t, []int64{channel.ID}, rules[0].ChannelIDs)
+
+	resp, cleanup = client.Delete("/v2/alerts/rules/" + rules[0].ID)
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusNoContent)
+
+	resp, cleanup = client.Delete("/v2/alerts/rules/" + rules[0].ID)
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusNotFound)
+}
+
+func TestAlertSilencesController(t *testing.T) {
+	t.Parallel()
+
+	client, orm := setupAlertsControllerTest(t)
+
+	duration := models.MustMakeDuration(time.Minute)
+	rule := alerts.Rule{Name: "rule", Type: alerts.RuleTypeNoHeads, Params: alerts.RuleParams{Duration: &duration}}
+	require.NoError(t, orm.CreateRule(context.Background(), &rule))
+
+	resp, cleanup := client.Post("/v2/alerts/silences", bytes.NewBufferString(`{"reason":"maintenance","duration":"0s"}`))
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusUnprocessableEntity)
+
+	resp, cleanup = client.Post("/v2/alerts/silences", bytes.NewBufferString(`{"ruleID":9999,"reason":"maintenance","duration":"1h"}`))
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusUnprocessableEntity)
+
+	resp, cleanup = client.Post("/v2/alerts/silences", bytes.NewBufferString(fmt.Sprintf(`{"ruleID":%d,"reason":"maintenance","duration":"1h"}`, rule.ID)))
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusCreated)
+	var silence presenters.AlertSilenceResource
+	require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &silence))
+	assert.Equal(t, null.IntFrom(rule.ID), silence.RuleID)
+	assert.True(t, silence.EndsAt.After(time.Now()))
+
+	// Ended silences are only listed with all
+	ended := alerts.Silence{Reason: "ended", EndsAt: time.Now().Add(-time.Minute)}
+	require.NoError(t, orm.CreateSilence(context.Background(), &ended))
+
+	resp, cleanup = client.Get("/v2/alerts/silences")
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+	var silences []presenters.AlertSilenceResource
+	require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &silences))
+	require.Len(t, silences, 1)
+	assert.Equal(t, "maintenance", silences[0].Reason)
+
+	resp, cleanup = client.Get("/v2/alerts/silences?all=true")
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+	silences = nil
+	require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &silences))
+	assert.Len(t, silences, 2)
+
+	resp, cleanup = client.Delete("/v2/alerts/silences/" + silence.ID)
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusNoContent)
+}
+
+func TestAlertsController_Index(t *testing.T) {
+	t.Parallel()
+
+	client, orm := setupAlertsControllerTest(t)
+
+	for _, state := range []alerts.AlertState{alerts.AlertStateFiring, alerts.AlertStateResolved} {
+		alert := alerts.Alert{RuleName: "rule", State: state, Message: string(state), CreatedAt: time.Now()}
+		require.NoError(t, orm.CreateAlert(context.Background(), &alert))
+	}
+
+	resp, cleanup := client.Get("/v2/alerts?size=1")
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+	var history []presenters.AlertResource
+	meta, err := cltest.ParseJSONAPIResponseMetaCount(cltest.ParseResponseBody(t, resp))
+	require.NoError(t, err)
+	assert.Equal(t, 2, meta)
+
+	resp, cleanup = client.Get("/v2/alerts")
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+	require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &history))
+	require.Len(t, history, 2)
+	assert.Equal(t, alerts.AlertStateResolved, history[0].State, "newest first")
+	assert.Equal(t, alerts.AlertStateFiring, history[1].State)
+}
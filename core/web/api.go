@@ -99,6 +99,27 @@ func NewPaginatedResponse(url url.URL, size, page, count int, resource interface
 	return json.Marshal(document)
 }
 
+// NewCursorPaginatedResponse returns a jsonapi.Document with a link to the
+// next page of the collection, which continues after nextCursor. An empty
+// nextCursor means this is the last page.
+func NewCursorPaginatedResponse(url url.URL, resource interface{}, nextCursor string) ([]byte, error) {
+	document, err := jsonapi.MarshalToStruct(resource, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal resource to struct: %+v", err)
+	}
+
+	document.Meta = make(jsonapi.Meta)
+	document.Links = make(jsonapi.Links)
+	if nextCursor != "" {
+		document.Meta["nextCursor"] = nextCursor
+		query := url.Query()
+		query.Set("cursor", nextCursor)
+		url.RawQuery = query.Encode()
+		document.Links[KeyNextLink] = jsonapi.Link{Href: url.String()}
+	}
+	return json.Marshal(document)
+}
+
 func getPaginatedResponseDoc(url url.URL, size, page, count int, resource interface{}) (*jsonapi.Document, error) {
 	document, err := jsonapi.MarshalToStruct(resource, nil)
 	if err != nil {
@@ -0,0 +1,138 @@
+package web
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/store/models"
+)
+
+// maxAuditPayloadLength is the length the payload summaries of the audit log
+// are truncated to
+const maxAuditPayloadLength = 1024
+
+// auditRedactedKeys are the substrings of the JSON keys whose values are
+// redacted from the payload summaries of the audit log
+var auditRedactedKeys = []string{"password", "secret", "token", "privatekey", "crypto", "seed"}
+
+// auditOmittedPayloadRoutes are the routes whose payloads are only summarized
+// by their size in the audit log, as the whole payload is secret
+var auditOmittedPayloadRoutes = map[string]bool{
+	"/v2/secrets": true,
+}
+
+// APIAuditLogger is where the audit log of API calls is recorded
+type APIAuditLogger interface {
+	CreateAPIAuditLogEntry(entry *models.APIAuditLogEntry) error
+}
+
+// auditLog records the mutating calls made by the authenticated user in the
+// audit log once they have been handled. It must come after the
+// authentication of the routes.
+func auditLog(auditLogger APIAuditLogger) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		switch c.Request.Method {
+		case http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete:
+		default:
+			c.Next()
+			return
+		}
+
+		var body []byte
+		if c.Request.Body != nil {
+			var err error
+			if body, err = ioutil.ReadAll(c.Request.Body); err != nil {
+				jsonAPIError(c, http.StatusBadRequest, err)
+				c.Abort()
+				return
+			}
+			c.Request.Body = ioutil.NopCloser(bytes.NewReader(body))
+		}
+
+		c.Next()
+
+		payload := summarizePayload(c.ContentType(), body)
+		if auditOmittedPayloadRoutes[c.FullPath()] && len(body) > 0 {
+			payload = fmt.Sprintf("<%d bytes omitted>", len(body))
+		}
+		entry := models.APIAuditLogEntry{
+			Method:     c.Request.Method,
+			Route:      c.FullPath(),
+			Path:       c.Request.URL.Path,
+			Payload:    payload,
+			StatusCode: c.Writer.Status(),
+			RemoteAddr: c.ClientIP(),
+			CreatedAt:  time.Now(),
+		}
+		if user, ok := authenticatedUser(c); ok {
+			entry.UserEmail = user.Email
+		}
+		if err := c.Errors.Last(); err != nil {
+			entry.Error = null.StringFrom(err.Error())
+		}
+		if err := auditLogger.CreateAPIAuditLogEntry(&entry); err != nil {
+			logger.Errorw("Failed to record API call in audit log", "method", entry.Method, "path", entry.Path, "user", entry.UserEmail, "err", err)
+		}
+	}
+}
+
+// summarizePayload returns the request body with the values of secret JSON
+// fields redacted, truncated to maxAuditPayloadLength. Bodies which are not
+// JSON are only summarized by their size, as they may be key files.
+func summarizePayload(contentType string, body []byte) string {
+	if len(body) == 0 {
+		return ""
+	}
+	var payload interface{}
+	if err := json.Unmarshal(body, &payload); err != nil {
+		if contentType == "" {
+			contentType = "unknown content type"
+		}
+		return fmt.Sprintf("<%d bytes of %s>", len(body), contentType)
+	}
+	summary, err := json.Marshal(redactPayload(payload))
+	if err != nil {
+		return fmt.Sprintf("<%d bytes>", len(body))
+	}
+	if len(summary) > maxAuditPayloadLength {
+		return string(summary[:maxAuditPayloadLength]) + "..."
+	}
+	return string(summary)
+}
+
+func redactPayload(payload interface{}) interface{} {
+	switch v := payload.(type) {
+	case map[string]interface{}:
+		for key, value := range v {
+			if isRedactedKey(key) {
+				v[key] = "xxxxx"
+			} else {
+				v[key] = redactPayload(value)
+			}
+		}
+	case []interface{}:
+		for i, value := range v {
+			v[i] = redactPayload(value)
+		}
+	}
+	return payload
+}
+
+func isRedactedKey(key string) bool {
+	key = strings.ToLower(key)
+	for _, redacted := range auditRedactedKeys {
+		if strings.Contains(key, redacted) {
+			return true
+		}
+	}
+	return false
+}
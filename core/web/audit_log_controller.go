@@ -0,0 +1,41 @@
+package web
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/smartcontractkit/chainlink/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/core/store/models"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+// AuditLogController lists the mutating calls made to the web API
+type AuditLogController struct {
+	App chainlink.Application
+}
+
+// Index lists the entries of the API audit log, newest first. They can be
+// filtered by user, method, route and time range.
+// Example:
+//
+//	"<application>/audit_log?route=/v2/job_proposals/:id/approve&since=2021-06-01T00:00:00Z"
+func (alc *AuditLogController) Index(c *gin.Context, size, page, offset int) {
+	filter := models.APIAuditLogFilter{
+		UserEmail: c.Query("user"),
+		Method:    c.Query("method"),
+		Route:     c.Query("route"),
+	}
+	var err error
+	if filter.Since, err = queryTime(c, "since"); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	if filter.Until, err = queryTime(c, "until"); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	entries, count, err := alc.App.GetStore().APIAuditLogEntries(filter, offset, size)
+	paginatedResponse(c, "auditLogEntries", size, page, presenters.NewAPIAuditLogEntryResources(entries), count, err)
+}
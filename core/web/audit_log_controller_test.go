@@ -0,0 +1,89 @@
+package web_test
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+func TestAuditLogController_Index(t *testing.T) {
+	t.Parallel()
+
+	ethClient, _, assertMocksCalled := cltest.NewEthMocksWithStartupAssertions(t)
+	defer assertMocksCalled()
+	app, cleanup := cltest.NewApplicationWithKey(t,
+		ethClient,
+	)
+	defer cleanup()
+	require.NoError(t, app.Start())
+	client := app.NewHTTPClient()
+
+	resp, cleanup := client.Post("/v2/bridge_types", bytes.NewBufferString(`{"name":"auditedbridge","url":"http://localhost:8080"}`))
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+	resp, cleanup = client.Patch("/v2/user/password", bytes.NewBufferString(`{"oldPassword": "wrong password", "newPassword": "n3wP4sSw0rD"}`))
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusConflict)
+
+	// reads are not audited
+	resp, cleanup = client.Get("/v2/bridge_types")
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+	resp, cleanup = client.Get("/v2/audit_log")
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+	var entries []presenters.APIAuditLogEntryResource
+	require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &entries))
+	require.Len(t, entries, 2)
+
+	assert.Equal(t, cltest.APIEmail, entries[0].UserEmail)
+	assert.Equal(t, http.MethodPatch, entries[0].Method)
+	assert.Equal(t, "/v2/user/password", entries[0].Route)
+	assert.Equal(t, http.StatusConflict, entries[0].StatusCode)
+	assert.Equal(t, "old password does not match", entries[0].Error.String)
+	assert.Equal(t, `{"newPassword":"xxxxx","oldPassword":"xxxxx"}`, entries[0].Payload)
+
+	assert.Equal(t, cltest.APIEmail, entries[1].UserEmail)
+	assert.Equal(t, http.MethodPost, entries[1].Method)
+	assert.Equal(t, "/v2/bridge_types", entries[1].Route)
+	assert.Equal(t, http.StatusOK, entries[1].StatusCode)
+	assert.False(t, entries[1].Error.Valid)
+	assert.Contains(t, entries[1].Payload, "auditedbridge")
+
+	resp, cleanup = client.Get(fmt.Sprintf("/v2/audit_log?method=post&user=%s", cltest.APIEmail))
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+	entries = nil
+	require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &entries))
+	require.Len(t, entries, 1)
+	assert.Equal(t, "/v2/bridge_types", entries[0].Route)
+
+	t.Run("omits the payloads of secrets", func(t *testing.T) {
+		resp, cleanup := client.Post("/v2/secrets", bytes.NewBufferString(`{"name":"API_KEY","value":"hunter2"}`))
+		defer cleanup()
+		cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+		resp, cleanup = client.Get("/v2/audit_log?method=post")
+		defer cleanup()
+		cltest.AssertServerResponse(t, resp, http.StatusOK)
+		var entries []presenters.APIAuditLogEntryResource
+		require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &entries))
+		require.NotEmpty(t, entries)
+		assert.Equal(t, "/v2/secrets", entries[0].Route)
+		assert.Equal(t, "<36 bytes omitted>", entries[0].Payload)
+		assert.NotContains(t, entries[0].Payload, "hunter2")
+	})
+
+	resp, cleanup = client.Get("/v2/audit_log?since=yesterday")
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusUnprocessableEntity)
+}
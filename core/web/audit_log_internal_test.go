@@ -0,0 +1,33 @@
+package web
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSummarizePayload(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		contentType string
+		body        string
+		expected    string
+	}{
+		{"empty", "application/json", "", ""},
+		{"not redacted", "application/json", `{"name":"bridge","url":"http://localhost"}`, `{"name":"bridge","url":"http://localhost"}`},
+		{"redacted", "application/json", `{"oldPassword":"a","NewPassword":"b","value":{"apiSecret":"c"},"keys":[{"privateKey":"d"}]}`, `{"NewPassword":"xxxxx","keys":[{"privateKey":"xxxxx"}],"oldPassword":"xxxxx","value":{"apiSecret":"xxxxx"}}`},
+		{"not json", "text/plain", "type = \"cron\"", "<13 bytes of text/plain>"},
+		{"truncated", "application/json", `"` + strings.Repeat("a", 2000) + `"`, `"` + strings.Repeat("a", maxAuditPayloadLength-1) + "..."},
+	}
+
+	for _, tt := range tests {
+		test := tt
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, test.expected, summarizePayload(test.contentType, []byte(test.body)))
+		})
+	}
+}
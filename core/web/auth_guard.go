@@ -0,0 +1,233 @@
+package web
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	promclient "github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/store/orm"
+)
+
+var (
+	promAuthFailures = promauto.NewCounterVec(promclient.CounterOpts{
+		Name: "web_auth_failures_total",
+		Help: "The total number of failed logins and API token authentications",
+	},
+		[]string{"method"},
+	)
+	promAuthLockouts = promauto.NewCounterVec(promclient.CounterOpts{
+		Name: "web_auth_lockouts_total",
+		Help: "The total number of client IPs and users locked out after too many failed authentications",
+	},
+		[]string{"method", "scope"},
+	)
+	promAuthRejections = promauto.NewCounterVec(promclient.CounterOpts{
+		Name: "web_auth_lockout_rejections_total",
+		Help: "The total number of requests rejected because their client IP or user is locked out",
+	},
+		[]string{"method"},
+	)
+)
+
+const (
+	authMethodSession = "session"
+	authMethodToken   = "token"
+)
+
+// authGuard locks out the client IPs and users with too many failed
+// authentications within a window, so that credentials cannot be brute
+// forced. Each lockout of a key shortly after its previous one doubles, up to
+// a maximum.
+type authGuard struct {
+	limit      uint32
+	window     time.Duration
+	lockout    time.Duration
+	maxLockout time.Duration
+	now        func() time.Time
+
+	mu        sync.Mutex
+	records   map[string]*authFailures
+	lastSweep time.Time
+}
+
+// authFailures are the recent failed authentications of a key, e.g. a client
+// IP, and its lockouts
+type authFailures struct {
+	failures    []time.Time
+	lockouts    uint
+	lockedAt    time.Time
+	lockedUntil time.Time
+}
+
+func newAuthGuard(config orm.ConfigReader) *authGuard {
+	return &authGuard{
+		limit:      config.AuthFailureLimit(),
+		window:     config.AuthFailureWindow(),
+		lockout:    config.AuthLockoutDuration(),
+		maxLockout: config.AuthLockoutMaxDuration(),
+		now:        time.Now,
+		records:    make(map[string]*authFailures),
+	}
+}
+
+// lockedOut returns how long the longest locked out of the keys remains so
+func (g *authGuard) lockedOut(keys ...string) time.Duration {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	now := g.now()
+	var remaining time.Duration
+	for _, key := range keys {
+		if r, exists := g.records[key]; exists && r.lockedUntil.Sub(now) > remaining {
+			remaining = r.lockedUntil.Sub(now)
+		}
+	}
+	return remaining
+}
+
+// fail records a failed authentication of the keys, locking out those which
+// reached the limit
+func (g *authGuard) fail(method string, keys ...string) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	now := g.now()
+	g.sweep(now)
+	for _, key := range keys {
+		r, exists := g.records[key]
+		if !exists {
+			r = &authFailures{}
+			g.records[key] = r
+		}
+		r.failures = append(recentFailures(r.failures, now.Add(-g.window)), now)
+		if uint32(len(r.failures)) < g.limit {
+			continue
+		}
+
+		if r.lockouts > 0 && now.Sub(r.lockedAt) <= g.maxLockout {
+			r.lockouts++
+		} else {
+			r.lockouts = 1
+		}
+		lockout := g.maxLockout
+		if r.lockouts <= 32 && g.lockout<<(r.lockouts-1) < g.maxLockout {
+			lockout = g.lockout << (r.lockouts - 1)
+		}
+		r.failures = nil
+		r.lockedAt = now
+		r.lockedUntil = now.Add(lockout)
+
+		scope := strings.SplitN(key, ":", 2)[0]
+		promAuthLockouts.WithLabelValues(method, scope).Inc()
+		logger.Warnw("Locked out after too many failed authentications", "method", method, "key", key, "lockout", lockout)
+	}
+}
+
+// succeed forgets the failed authentications of the keys
+func (g *authGuard) succeed(keys ...string) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	for _, key := range keys {
+		if r, exists := g.records[key]; exists && !r.lockedUntil.After(g.now()) {
+			r.failures = nil
+		}
+	}
+}
+
+// sweep drops the records which no longer affect authentications, at most
+// once per window
+func (g *authGuard) sweep(now time.Time) {
+	if now.Sub(g.lastSweep) < g.window {
+		return
+	}
+	g.lastSweep = now
+	for key, r := range g.records {
+		if len(recentFailures(r.failures, now.Add(-g.window))) == 0 && now.Sub(r.lockedAt) > g.maxLockout && !r.lockedUntil.After(now) {
+			delete(g.records, key)
+		}
+	}
+}
+
+func recentFailures(failures []time.Time, since time.Time) []time.Time {
+	for i, t := range failures {
+		if t.After(since) {
+			return failures[i:]
+		}
+	}
+	return nil
+}
+
+// protect rejects the requests of locked out keys with 429 Too Many
+// Requests, and records the 401 Unauthorized responses of the handlers that
+// follow as failed authentications. keys returns the keys of a request, or
+// none if it is not authenticated by the method.
+func (g *authGuard) protect(method string, keys func(c *gin.Context) []string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if g.limit == 0 {
+			c.Next()
+			return
+		}
+		ks := keys(c)
+		if len(ks) == 0 {
+			c.Next()
+			return
+		}
+		if remaining := g.lockedOut(ks...); remaining > 0 {
+			promAuthRejections.WithLabelValues(method).Inc()
+			c.Header("Retry-After", strconv.Itoa(int(math.Ceil(remaining.Seconds()))))
+			c.Abort()
+			jsonAPIError(c, http.StatusTooManyRequests, fmt.Errorf("too many failed authentications, retry in %s", remaining.Round(time.Second)))
+			return
+		}
+
+		c.Next()
+
+		if status := c.Writer.Status(); status == http.StatusUnauthorized {
+			promAuthFailures.WithLabelValues(method).Inc()
+			g.fail(method, ks...)
+		} else if status < http.StatusBadRequest {
+			g.succeed(ks...)
+		}
+	}
+}
+
+// sessionAuthKeys are the client IP and the email of a login
+func sessionAuthKeys(c *gin.Context) []string {
+	keys := []string{"ip:" + c.ClientIP()}
+	if c.Request.Body == nil {
+		return keys
+	}
+	body, err := ioutil.ReadAll(c.Request.Body)
+	if err != nil {
+		return keys
+	}
+	c.Request.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+	var request struct {
+		Email string `json:"email"`
+	}
+	if err := json.Unmarshal(body, &request); err == nil && request.Email != "" {
+		keys = append(keys, "user:"+strings.ToLower(request.Email))
+	}
+	return keys
+}
+
+// tokenAuthKeys are the client IP and the access key of requests
+// authenticated by API token
+func tokenAuthKeys(c *gin.Context) []string {
+	accessKey := c.GetHeader(APIKey)
+	if accessKey == "" {
+		return nil
+	}
+	return []string{"ip:" + c.ClientIP(), "token:" + accessKey}
+}
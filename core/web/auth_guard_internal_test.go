@@ -0,0 +1,121 @@
+package web
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestAuthGuard(now *time.Time) *authGuard {
+	return &authGuard{
+		limit:      3,
+		window:     time.Minute,
+		lockout:    time.Minute,
+		maxLockout: 5 * time.Minute,
+		now:        func() time.Time { return *now },
+		records:    make(map[string]*authFailures),
+	}
+}
+
+func TestAuthGuard_Lockout(t *testing.T) {
+	t.Parallel()
+
+	now := time.Unix(1600000000, 0)
+	g := newTestAuthGuard(&now)
+
+	g.fail(authMethodSession, "ip:1.2.3.4")
+	g.fail(authMethodSession, "ip:1.2.3.4")
+	assert.Zero(t, g.lockedOut("ip:1.2.3.4"))
+
+	// Failures outside the window are forgotten
+	now = now.Add(2 * time.Minute)
+	g.fail(authMethodSession, "ip:1.2.3.4")
+	g.fail(authMethodSession, "ip:1.2.3.4")
+	assert.Zero(t, g.lockedOut("ip:1.2.3.4"))
+
+	g.fail(authMethodSession, "ip:1.2.3.4", "user:a@b.c")
+	assert.Equal(t, time.Minute, g.lockedOut("ip:1.2.3.4"))
+	assert.Equal(t, time.Minute, g.lockedOut("ip:5.6.7.8", "ip:1.2.3.4"))
+	assert.Zero(t, g.lockedOut("user:a@b.c"))
+
+	// Repeated lockouts double, up to the maximum
+	for _, expected := range []time.Duration{2 * time.Minute, 4 * time.Minute, 5 * time.Minute} {
+		now = now.Add(g.lockedOut("ip:1.2.3.4"))
+		for i := 0; i < 3; i++ {
+			g.fail(authMethodSession, "ip:1.2.3.4")
+		}
+		assert.Equal(t, expected, g.lockedOut("ip:1.2.3.4"))
+	}
+
+	// And are reset once the key behaves for long enough
+	now = now.Add(time.Hour)
+	assert.Zero(t, g.lockedOut("ip:1.2.3.4"))
+	for i := 0; i < 3; i++ {
+		g.fail(authMethodSession, "ip:1.2.3.4")
+	}
+	assert.Equal(t, time.Minute, g.lockedOut("ip:1.2.3.4"))
+}
+
+func TestAuthGuard_Succeed(t *testing.T) {
+	t.Parallel()
+
+	now := time.Unix(1600000000, 0)
+	g := newTestAuthGuard(&now)
+
+	g.fail(authMethodToken, "token:abc")
+	g.fail(authMethodToken, "token:abc")
+	g.succeed("token:abc")
+	g.fail(authMethodToken, "token:abc")
+	g.fail(authMethodToken, "token:abc")
+	assert.Zero(t, g.lockedOut("token:abc"))
+}
+
+func TestAuthGuard_Protect(t *testing.T) {
+	t.Parallel()
+
+	now := time.Unix(1600000000, 0)
+	g := newTestAuthGuard(&now)
+
+	router := gin.New()
+	router.POST("/sessions", g.protect(authMethodSession, sessionAuthKeys), func(c *gin.Context) {
+		var request struct {
+			Email    string `json:"email"`
+			Password string `json:"password"`
+		}
+		require.NoError(t, c.ShouldBindJSON(&request))
+		if request.Password != "correct" {
+			c.Status(http.StatusUnauthorized)
+			return
+		}
+		c.Status(http.StatusOK)
+	})
+
+	login := func(remoteAddr, email, password string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		body := bytes.NewBufferString(`{"email": "` + email + `", "password": "` + password + `"}`)
+		req := httptest.NewRequest(http.MethodPost, "/sessions", body)
+		req.RemoteAddr = remoteAddr
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	for i := 0; i < 3; i++ {
+		assert.Equal(t, http.StatusUnauthorized, login("1.2.3.4:1234", "a@b.c", "wrong").Code)
+	}
+	w := login("1.2.3.4:1234", "a@b.c", "correct")
+	assert.Equal(t, http.StatusTooManyRequests, w.Code)
+	assert.Equal(t, "60", w.Header().Get("Retry-After"))
+
+	// The user is locked out from other client IPs too
+	assert.Equal(t, http.StatusTooManyRequests, login("5.6.7.8:1234", "A@b.c", "correct").Code)
+	assert.Equal(t, http.StatusOK, login("5.6.7.8:1234", "d@e.f", "correct").Code)
+
+	now = now.Add(time.Minute)
+	assert.Equal(t, http.StatusOK, login("1.2.3.4:1234", "a@b.c", "correct").Code)
+}
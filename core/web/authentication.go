@@ -23,7 +23,7 @@ const (
 type AuthStorer interface {
 	AuthorizedUserWithSession(sessionID string) (models.User, error)
 	FindExternalInitiator(eia *auth.Token) (*models.ExternalInitiator, error)
-	FindUser() (models.User, error)
+	FindUserByAPIToken(accessKey string) (models.User, error)
 }
 
 type authType func(store AuthStorer, ctx *gin.Context) error
@@ -79,7 +79,7 @@ func AuthenticateByToken(store AuthStorer, c *gin.Context) error {
 		Secret:    c.GetHeader(APISecret),
 	}
 
-	user, err := store.FindUser()
+	user, err := store.FindUserByAPIToken(token.AccessKey)
 	if errors.Cause(err) == orm.ErrorNotFound {
 		return auth.ErrorAuthFailed
 	} else if err != nil {
@@ -132,3 +132,17 @@ func RequireAuth(store AuthStorer, methods ...authType) gin.HandlerFunc {
 		}
 	}
 }
+
+// requireAdmin rejects the requests of users who are not admins, guarding the
+// routes which manage users, move funds or expose keys. It must come after the
+// authentication of the routes.
+func requireAdmin() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if user, ok := authenticatedUser(c); !ok || !user.Admin {
+			c.Abort()
+			jsonAPIError(c, http.StatusForbidden, errors.New("only admins may perform this operation"))
+			return
+		}
+		c.Next()
+	}
+}
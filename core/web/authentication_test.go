@@ -34,7 +34,7 @@ type userFindFailer struct {
 	err error
 }
 
-func (u userFindFailer) FindUser() (models.User, error) {
+func (u userFindFailer) FindUserByAPIToken(string) (models.User, error) {
 	return models.User{}, u.err
 }
 
@@ -43,7 +43,7 @@ type userFindSuccesser struct {
 	user models.User
 }
 
-func (u userFindSuccesser) FindUser() (models.User, error) {
+func (u userFindSuccesser) FindUserByAPIToken(string) (models.User, error) {
 	return u.user, nil
 }
 
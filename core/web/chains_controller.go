@@ -0,0 +1,131 @@
+package web
+
+import (
+	"math/big"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+
+	"github.com/smartcontractkit/chainlink/core/chains"
+	"github.com/smartcontractkit/chainlink/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/core/utils"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+// ChainsController manages the chain registry
+type ChainsController struct {
+	App chainlink.Application
+}
+
+// CreateChainRequest is the request to add a chain to the registry
+type CreateChainRequest struct {
+	ID     *utils.Big            `json:"chainID"`
+	Config chains.ChainOverrides `json:"config"`
+}
+
+// UpdateChainRequest is the request to configure a chain of the registry
+type UpdateChainRequest struct {
+	Config chains.ChainOverrides `json:"config"`
+}
+
+// Index lists the chains of the registry
+// Example:
+// "GET <application>/chains/evm"
+func (cc *ChainsController) Index(c *gin.Context) {
+	registered, err := chains.FindChains(cc.App.GetStore().DB)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	resources := []presenters.ChainResource{}
+	for _, chain := range registered {
+		resources = append(resources, presenters.NewChainResource(chain))
+	}
+
+	jsonAPIResponse(c, resources, "chain")
+}
+
+// Create adds a chain to the registry
+// Example:
+// "POST <application>/chains/evm"
+func (cc *ChainsController) Create(c *gin.Context) {
+	request := &CreateChainRequest{}
+	if err := c.ShouldBindJSON(request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	if request.ID == nil || request.ID.ToInt().Sign() <= 0 {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.New("chainID is required"))
+		return
+	}
+	if err := request.Config.Validate(); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	chain, err := chains.CreateChain(cc.App.GetStore().DB, request.ID.ToInt(), request.Config)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponseWithStatus(c, presenters.NewChainResource(chain), "chain", http.StatusCreated)
+}
+
+// Update replaces the config of a chain of the registry
+// Example:
+// "PATCH <application>/chains/evm/:ID"
+func (cc *ChainsController) Update(c *gin.Context) {
+	id, ok := new(big.Int).SetString(c.Param("ID"), 10)
+	if !ok {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.Errorf("invalid chain ID %q", c.Param("ID")))
+		return
+	}
+	request := &UpdateChainRequest{}
+	if err := c.ShouldBindJSON(request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	if err := request.Config.Validate(); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	chain, err := chains.UpdateChain(cc.App.GetStore().DB, id, request.Config)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		jsonAPIError(c, http.StatusNotFound, errors.New("chain not found"))
+		return
+	}
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewChainResource(chain), "chain")
+}
+
+// Delete removes a chain from the registry, restoring its built-in config
+// Example:
+// "DELETE <application>/chains/evm/:ID"
+func (cc *ChainsController) Delete(c *gin.Context) {
+	id, ok := new(big.Int).SetString(c.Param("ID"), 10)
+	if !ok {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.Errorf("invalid chain ID %q", c.Param("ID")))
+		return
+	}
+
+	err := chains.DeleteChain(cc.App.GetStore().DB, id)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		jsonAPIError(c, http.StatusNotFound, errors.New("chain not found"))
+		return
+	}
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponseWithStatus(c, nil, "chain", http.StatusNoContent)
+}
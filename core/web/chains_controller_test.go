@@ -0,0 +1,148 @@
+package web_test
+
+import (
+	"bytes"
+	"math/big"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/chains"
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+func setupChainsControllerTest(t *testing.T) (cltest.HTTPClientCleaner, *cltest.TestApplication) {
+	ethClient, _, assertMocksCalled := cltest.NewEthMocksWithStartupAssertions(t)
+	t.Cleanup(assertMocksCalled)
+	app, cleanup := cltest.NewApplicationWithKey(t,
+		ethClient,
+	)
+	t.Cleanup(cleanup)
+	require.NoError(t, app.Start())
+
+	return app.NewHTTPClient(), app
+}
+
+func TestChainsController_Create(t *testing.T) {
+	t.Parallel()
+
+	client, app := setupChainsControllerTest(t)
+	t.Cleanup(func() { chains.ResetOverrides(big.NewInt(440901)) })
+
+	resp, cleanup := client.Post("/v2/chains/evm", bytes.NewBufferString(`{"chainID":"440901","config":{"ethFinalityDepth":12,"l2Type":"optimism"}}`))
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusCreated)
+	var chain presenters.ChainResource
+	require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &chain))
+	assert.Equal(t, "440901", chain.ID)
+	require.NotNil(t, chain.Config.EthFinalityDepth)
+	assert.Equal(t, uint32(12), *chain.Config.EthFinalityDepth)
+
+	registered, err := chains.FindChains(app.GetStore().DB)
+	require.NoError(t, err)
+	require.Len(t, registered, 1)
+	assert.Equal(t, int64(440901), registered[0].ID.ToInt().Int64())
+	assert.Equal(t, uint(12), chains.ChainFromID(big.NewInt(440901)).Config().EthFinalityDepth, "the chain is configured")
+
+	resp, cleanup = client.Get("/v2/chains/evm")
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+	var chainsList []presenters.ChainResource
+	require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &chainsList))
+	require.Len(t, chainsList, 1)
+	assert.Equal(t, "440901", chainsList[0].ID)
+}
+
+func TestChainsController_Create_ValidationErrors(t *testing.T) {
+	t.Parallel()
+
+	client, app := setupChainsControllerTest(t)
+
+	tests := []struct {
+		name string
+		body string
+	}{
+		{"malformed JSON", `{"chainID":`},
+		{"missing chain ID", `{"config":{}}`},
+		{"zero chain ID", `{"chainID":"0","config":{}}`},
+		{"invalid l2Type", `{"chainID":"440902","config":{"l2Type":"zksync"}}`},
+		{"invalid gasEstimatorMode", `{"chainID":"440902","config":{"gasEstimatorMode":"Guess"}}`},
+		{"invalid linkContractAddress", `{"chainID":"440902","config":{"linkContractAddress":"0xabc"}}`},
+		{"zero ethFinalityDepth", `{"chainID":"440902","config":{"ethFinalityDepth":0}}`},
+		{"min gas price above max", `{"chainID":"440902","config":{"ethMinGasPriceWei":"2000","ethMaxGasPriceWei":"1000"}}`},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			resp, cleanup := client.Post("/v2/chains/evm", bytes.NewBufferString(test.body))
+			defer cleanup()
+			cltest.AssertServerResponse(t, resp, http.StatusUnprocessableEntity)
+		})
+	}
+
+	registered, err := chains.FindChains(app.GetStore().DB)
+	require.NoError(t, err)
+	assert.Empty(t, registered, "no chain is added")
+}
+
+func TestChainsController_Update(t *testing.T) {
+	t.Parallel()
+
+	client, app := setupChainsControllerTest(t)
+	id := big.NewInt(440903)
+	t.Cleanup(func() { chains.ResetOverrides(id) })
+	finalityDepth := uint32(12)
+	_, err := chains.CreateChain(app.GetStore().DB, id, chains.ChainOverrides{EthFinalityDepth: &finalityDepth})
+	require.NoError(t, err)
+
+	resp, cleanup := client.Patch("/v2/chains/evm/440903", bytes.NewBufferString(`{"config":{"ethFinalityDepth":0}}`))
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusUnprocessableEntity)
+
+	resp, cleanup = client.Patch("/v2/chains/evm/x", bytes.NewBufferString(`{"config":{}}`))
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusUnprocessableEntity)
+
+	resp, cleanup = client.Patch("/v2/chains/evm/440999", bytes.NewBufferString(`{"config":{}}`))
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusNotFound)
+
+	resp, cleanup = client.Patch("/v2/chains/evm/440903", bytes.NewBufferString(`{"config":{"ethFinalityDepth":20}}`))
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+	var chain presenters.ChainResource
+	require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &chain))
+	require.NotNil(t, chain.Config.EthFinalityDepth)
+	assert.Equal(t, uint32(20), *chain.Config.EthFinalityDepth)
+	assert.Equal(t, uint(20), chains.ChainFromID(id).Config().EthFinalityDepth, "the chain is reconfigured")
+}
+
+func TestChainsController_Delete(t *testing.T) {
+	t.Parallel()
+
+	client, app := setupChainsControllerTest(t)
+	id := big.NewInt(440904)
+	t.Cleanup(func() { chains.ResetOverrides(id) })
+	finalityDepth := uint32(12)
+	_, err := chains.CreateChain(app.GetStore().DB, id, chains.ChainOverrides{EthFinalityDepth: &finalityDepth})
+	require.NoError(t, err)
+
+	resp, cleanup := client.Delete("/v2/chains/evm/x")
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusUnprocessableEntity)
+
+	resp, cleanup = client.Delete("/v2/chains/evm/440904")
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusNoContent)
+	assert.Nil(t, chains.ChainFromID(id).Overrides().EthFinalityDepth, "the built-in config is restored")
+
+	registered, err := chains.FindChains(app.GetStore().DB)
+	require.NoError(t, err)
+	assert.Empty(t, registered)
+
+	resp, cleanup = client.Delete("/v2/chains/evm/440904")
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusNotFound)
+}
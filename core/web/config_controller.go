@@ -2,11 +2,13 @@ package web
 
 import (
 	"fmt"
+	"math/big"
 	"net/http"
 
 	"github.com/smartcontractkit/chainlink/core/services/chainlink"
 	"github.com/smartcontractkit/chainlink/core/store/presenters"
 	"github.com/smartcontractkit/chainlink/core/utils"
+	webpresenters "github.com/smartcontractkit/chainlink/core/web/presenters"
 
 	"github.com/gin-gonic/gin"
 )
@@ -29,6 +31,35 @@ func (cc *ConfigController) Show(c *gin.Context) {
 	jsonAPIResponse(c, cw, "config")
 }
 
+// ShowV2 returns the effective value of every config variable along with
+// where it comes from: the database, the chain registry, the environment, the
+// config file, the defaults or the node itself. Secrets and URL passwords are
+// redacted. The chain specific values are those of ETH_CHAIN_ID, or of the
+// evmChainID query parameter.
+// Example:
+//
+//	"<application>/config/v2"
+//	"<application>/config/v2?evmChainID=10"
+func (cc *ConfigController) ShowV2(c *gin.Context) {
+	cfg := cc.App.GetConfig()
+	if param := c.Query("evmChainID"); param != "" {
+		chainID, ok := new(big.Int).SetString(param, 10)
+		if !ok || chainID.Sign() <= 0 {
+			jsonAPIError(c, http.StatusUnprocessableEntity, fmt.Errorf("invalid evmChainID %q", param))
+			return
+		}
+		cfg = cfg.ForChain(chainID)
+	}
+
+	values, err := cfg.Values()
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, fmt.Errorf("failed to build config values: %+v", err))
+		return
+	}
+
+	jsonAPIResponse(c, webpresenters.NewConfigValueResources(values), "configValues")
+}
+
 type configPatchRequest struct {
 	EthGasPriceDefault *utils.Big `json:"ethGasPriceDefault"`
 }
@@ -1,6 +1,7 @@
 package web_test
 
 import (
+	"bytes"
 	"math/big"
 	"net/http"
 	"testing"
@@ -11,6 +12,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/services/eth"
 	"github.com/smartcontractkit/chainlink/core/store/config"
 	"github.com/smartcontractkit/chainlink/core/store/presenters"
+	webpresenters "github.com/smartcontractkit/chainlink/core/web/presenters"
 	"github.com/stretchr/testify/assert"
 
 	"github.com/smartcontractkit/chainlink/core/internal/cltest"
@@ -54,3 +56,40 @@ func TestConfigController_Show(t *testing.T) {
 	assert.Equal(t, common.Address{}, cp.OperatorContractAddress)
 	assert.Equal(t, time.Second*5, cp.DatabaseTimeout.Duration())
 }
+
+func TestConfigController_ShowV2(t *testing.T) {
+	t.Parallel()
+
+	ethClient, _, assertMocksCalled := cltest.NewEthMocksWithStartupAssertions(t)
+	defer assertMocksCalled()
+	app, cleanup := cltest.NewApplicationWithKey(t,
+		ethClient,
+	)
+	defer cleanup()
+	require.NoError(t, app.Start())
+	client := app.NewHTTPClient()
+
+	resp, cleanup := client.Patch("/v2/config", bytes.NewBufferString(`{"ethGasPriceDefault":"42000000000"}`))
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+	resp, cleanup = client.Get("/v2/config/v2")
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+	values := []webpresenters.ConfigValueResource{}
+	require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &values))
+	byName := make(map[string]webpresenters.ConfigValueResource)
+	for _, value := range values {
+		byName[value.ID] = value
+	}
+
+	gasPrice := byName["ETH_GAS_PRICE_DEFAULT"]
+	assert.Equal(t, "42000000000", gasPrice.Value)
+	assert.Equal(t, config.ValueSourceDatabase, gasPrice.Source)
+	assert.False(t, gasPrice.Redacted)
+
+	databaseURL := byName["DATABASE_URL"]
+	assert.Equal(t, "xxxxx", databaseURL.Value)
+	assert.True(t, databaseURL.Redacted)
+}
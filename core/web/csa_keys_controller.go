@@ -2,9 +2,12 @@ package web
 
 import (
 	"errors"
+	"io/ioutil"
 	"net/http"
+	"strconv"
 
 	"github.com/gin-gonic/gin"
+	"github.com/smartcontractkit/chainlink/core/logger"
 	"github.com/smartcontractkit/chainlink/core/services/chainlink"
 	"github.com/smartcontractkit/chainlink/core/services/keystore"
 	"github.com/smartcontractkit/chainlink/core/web/presenters"
@@ -32,6 +35,26 @@ func (ctrl *CSAKeysController) Index(c *gin.Context) {
 // "POST <application>/keys/csa"
 func (ctrl *CSAKeysController) Create(c *gin.Context) {
 	key, err := ctrl.App.GetKeyStore().CSA().CreateCSAKey()
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	jsonAPIResponse(c, presenters.NewCSAKeyResource(*key), "csaKeys")
+}
+
+// Import imports a CSA key
+// Example:
+// "Post <application>/keys/csa/import"
+func (ctrl *CSAKeysController) Import(c *gin.Context) {
+	defer logger.ErrorIfCalling(c.Request.Body.Close)
+
+	bytes, err := ioutil.ReadAll(c.Request.Body)
+	if err != nil {
+		jsonAPIError(c, http.StatusBadRequest, err)
+		return
+	}
+	oldPassword := c.Query("oldpassword")
+	key, err := ctrl.App.GetKeyStore().CSA().ImportCSAKey(bytes, oldPassword)
 	if err != nil {
 		if errors.Is(err, keystore.ErrCSAKeyExists) {
 			jsonAPIError(c, http.StatusBadRequest, err)
@@ -41,5 +64,32 @@ func (ctrl *CSAKeysController) Create(c *gin.Context) {
 		jsonAPIError(c, http.StatusInternalServerError, err)
 		return
 	}
+
 	jsonAPIResponse(c, presenters.NewCSAKeyResource(*key), "csaKeys")
 }
+
+// Export exports a CSA key
+// Example:
+// "Post <application>/keys/csa/export/:ID"
+func (ctrl *CSAKeysController) Export(c *gin.Context) {
+	defer logger.ErrorIfCalling(c.Request.Body.Close)
+
+	id, err := strconv.ParseUint(c.Param("ID"), 10, 32)
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.New("invalid key ID"))
+		return
+	}
+	newPassword := c.Query("newpassword")
+	scryptParams, err := exportScryptParams(c, ctrl.App.GetConfig())
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	bytes, err := ctrl.App.GetKeyStore().CSA().ExportCSAKey(keyStoreContext(c, "export"), uint(id), newPassword, scryptParams)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	c.Data(http.StatusOK, MediaType, bytes)
+}
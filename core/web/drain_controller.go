@@ -0,0 +1,80 @@
+package web
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/core/store/models"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+// defaultDrainTimeout is how long a draining node waits for its work to
+// finish before it exits anyway, unless the request says otherwise
+const defaultDrainTimeout = 10 * time.Minute
+
+// DrainController puts the node into drain mode before it is shut down
+type DrainController struct {
+	App chainlink.Application
+}
+
+// DrainRequest is the body of a request to drain the node
+type DrainRequest struct {
+	// Timeout is how long to wait for the in-flight work to finish before
+	// exiting anyway, e.g. "5m"
+	Timeout *models.Duration `json:"timeout"`
+}
+
+// Create puts the node into drain mode: it stops initiating new runs, waits
+// for the in-flight runs and transactions to finish, then exits.
+// Example:
+//
+//	"POST <application>/drain"
+func (dc *DrainController) Create(c *gin.Context) {
+	request := DrainRequest{}
+	if c.Request.ContentLength != 0 {
+		if err := c.ShouldBindJSON(&request); err != nil {
+			jsonAPIError(c, http.StatusUnprocessableEntity, err)
+			return
+		}
+	}
+	timeout := defaultDrainTimeout
+	if request.Timeout != nil {
+		timeout = request.Timeout.Duration()
+	}
+	if timeout <= 0 {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.New("timeout must be positive"))
+		return
+	}
+
+	if err := dc.App.Drain(timeout); errors.Is(err, chainlink.ErrAlreadyDraining) {
+		jsonAPIError(c, http.StatusConflict, err)
+		return
+	} else if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	status, err := dc.App.DrainStatus()
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	jsonAPIResponseWithStatus(c, presenters.NewDrainResource(status), "drain", http.StatusAccepted)
+}
+
+// Show returns the progress of draining the node
+// Example:
+//
+//	"GET <application>/drain"
+func (dc *DrainController) Show(c *gin.Context) {
+	status, err := dc.App.DrainStatus()
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	jsonAPIResponse(c, presenters.NewDrainResource(status), "drain")
+}
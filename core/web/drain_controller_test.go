@@ -0,0 +1,85 @@
+package web_test
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+func TestDrainController_Create(t *testing.T) {
+	t.Parallel()
+
+	ethClient, _, assertMocksCalled := cltest.NewEthMocksWithStartupAssertions(t)
+	defer assertMocksCalled()
+	app, cleanup := cltest.NewApplicationWithKey(t,
+		ethClient,
+	)
+	defer cleanup()
+	chExit := make(chan int, 1)
+	app.Exiter = func(code int) { chExit <- code }
+	require.NoError(t, app.Start())
+	client := app.NewHTTPClient()
+
+	resp, cleanup := client.Get("/v2/drain")
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+	status := presenters.DrainResource{}
+	require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &status))
+	assert.False(t, status.Draining)
+	assert.False(t, status.Drained)
+
+	resp, cleanup = client.Post("/v2/drain", bytes.NewBufferString(`{"timeout":"1m"}`))
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusAccepted)
+	status = presenters.DrainResource{}
+	require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &status))
+	assert.True(t, status.Draining)
+	require.NotNil(t, status.StartedAt)
+	require.NotNil(t, status.Deadline)
+	assert.Equal(t, "1m0s", status.Deadline.Sub(*status.StartedAt).String())
+
+	resp, cleanup = client.Post("/v2/drain", nil)
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusConflict)
+
+	_, err := app.RunJobV2(context.Background(), 1, nil)
+	assert.ErrorIs(t, err, chainlink.ErrDraining)
+
+	// the node has no work left so it exits straight away
+	select {
+	case code := <-chExit:
+		assert.Equal(t, 0, code)
+	case <-time.After(cltest.DBWaitTimeout):
+		t.Fatal("timed out waiting for the node to exit")
+	}
+}
+
+func TestDrainController_Create_InvalidTimeout(t *testing.T) {
+	t.Parallel()
+
+	ethClient, _, assertMocksCalled := cltest.NewEthMocksWithStartupAssertions(t)
+	defer assertMocksCalled()
+	app, cleanup := cltest.NewApplicationWithKey(t,
+		ethClient,
+	)
+	defer cleanup()
+	require.NoError(t, app.Start())
+	client := app.NewHTTPClient()
+
+	resp, cleanup := client.Post("/v2/drain", bytes.NewBufferString(`{"timeout":"forever"}`))
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusUnprocessableEntity)
+
+	resp, cleanup = client.Post("/v2/drain", bytes.NewBufferString(`{"timeout":"0s"}`))
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusUnprocessableEntity)
+}
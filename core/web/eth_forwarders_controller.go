@@ -0,0 +1,118 @@
+package web
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+
+	"github.com/smartcontractkit/chainlink/core/services/bulletprooftxmanager"
+	"github.com/smartcontractkit/chainlink/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/core/utils"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+// EthForwardersController manages the allowlist of forwarder contracts
+type EthForwardersController struct {
+	App chainlink.Application
+}
+
+// CreateEthForwarderRequest is the request to allowlist a forwarder
+type CreateEthForwarderRequest struct {
+	Address common.Address `json:"address"`
+	// EVMChainID is empty for forwarders on the node's primary chain
+	EVMChainID *utils.Big `json:"evmChainID"`
+}
+
+// Index lists the allowlisted forwarders
+// Example:
+// "GET <application>/forwarders"
+func (fc *EthForwardersController) Index(c *gin.Context) {
+	fwds, err := bulletprooftxmanager.FindEthForwarders(fc.App.GetStore().DB)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	resources := []presenters.EthForwarderResource{}
+	for _, fwd := range fwds {
+		resources = append(resources, presenters.NewEthForwarderResource(fwd))
+	}
+
+	jsonAPIResponse(c, resources, "ethForwarders")
+}
+
+// Create allowlists a forwarder
+// Example:
+// "POST <application>/forwarders"
+func (fc *EthForwardersController) Create(c *gin.Context) {
+	request := &CreateEthForwarderRequest{}
+	if err := c.ShouldBindJSON(request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	if request.Address == utils.ZeroAddress {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.New("address is required"))
+		return
+	}
+
+	evmChainID, err := fc.forwarderChainID(request.EVMChainID)
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	fwd, err := bulletprooftxmanager.CreateEthForwarder(fc.App.GetStore().DB, request.Address, evmChainID)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponseWithStatus(c, presenters.NewEthForwarderResource(fwd), "ethForwarder", http.StatusCreated)
+}
+
+// forwarderChainID checks that the forwarder's chain is configured.
+// Forwarders on the primary chain are stored without a chain ID, the same as
+// the transactions of the primary chain.
+func (fc *EthForwardersController) forwarderChainID(evmChainID *utils.Big) (*utils.Big, error) {
+	config := fc.App.GetConfig()
+	if evmChainID == nil || evmChainID.ToInt().Cmp(config.ChainID()) == 0 {
+		return nil, nil
+	}
+	chains, err := config.EthAdditionalChains()
+	if err != nil {
+		return nil, err
+	}
+	for _, chain := range chains {
+		if chain.ID.Cmp(evmChainID.ToInt()) == 0 {
+			return evmChainID, nil
+		}
+	}
+	return nil, errors.Errorf("chain %s is not configured, it must be ETH_CHAIN_ID or one of ETH_ADDITIONAL_CHAINS", evmChainID)
+}
+
+// Delete removes a forwarder from the allowlist
+// Example:
+// "DELETE <application>/forwarders/:fwdID"
+func (fc *EthForwardersController) Delete(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("fwdID"), 10, 64)
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	err = bulletprooftxmanager.DeleteEthForwarder(fc.App.GetStore().DB, id)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		jsonAPIError(c, http.StatusNotFound, errors.New("forwarder not found"))
+		return
+	}
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponseWithStatus(c, nil, "ethForwarder", http.StatusNoContent)
+}
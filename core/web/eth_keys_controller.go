@@ -8,7 +8,10 @@ import (
 
 	"github.com/smartcontractkit/chainlink/core/assets"
 	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/bulletprooftxmanager"
 	"github.com/smartcontractkit/chainlink/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/core/services/job"
+	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
 	"github.com/smartcontractkit/chainlink/core/web/presenters"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -157,8 +160,13 @@ func (ekc *ETHKeysController) Export(c *gin.Context) {
 	addressStr := c.Param("address")
 	address := common.HexToAddress(addressStr)
 	newPassword := c.Query("newpassword")
+	scryptParams, err := exportScryptParams(c, ekc.App.GetConfig())
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
 
-	bytes, err := ekc.App.GetKeyStore().Eth().ExportKey(address, newPassword)
+	bytes, err := ekc.App.GetKeyStore().Eth().ExportKey(keyStoreContext(c, "export"), address, newPassword, scryptParams)
 	if err != nil {
 		jsonAPIError(c, http.StatusInternalServerError, err)
 		return
@@ -166,6 +174,140 @@ func (ekc *ETHKeysController) Export(c *gin.Context) {
 	c.Data(http.StatusOK, MediaType, bytes)
 }
 
+// UpdatePolicy replaces the usage policy of an ETH key, which can mark it as
+// funding-only, or restrict it to job types and contract addresses
+// Example:
+// "PATCH <application>/keys/eth/policy/:address"
+func (ekc *ETHKeysController) UpdatePolicy(c *gin.Context) {
+	if !common.IsHexAddress(c.Param("address")) {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.New("invalid address"))
+		return
+	}
+	address := common.HexToAddress(c.Param("address"))
+
+	var policy ethkey.Policy
+	if err := c.ShouldBindJSON(&policy); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	for _, jobType := range policy.AllowedJobTypes {
+		if !job.Type(jobType).IsValid() {
+			jsonAPIError(c, http.StatusUnprocessableEntity, errors.Errorf("unknown job type %q", jobType))
+			return
+		}
+	}
+
+	ethKeyStore := ekc.App.GetKeyStore().Eth()
+	if _, err := ethKeyStore.KeyByAddress(address); err != nil {
+		jsonAPIError(c, http.StatusNotFound, err)
+		return
+	}
+	key, err := ethKeyStore.SetKeyPolicy(address, policy)
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	r, err := presenters.NewETHKeyResource(key,
+		ekc.setEthBalance(c.Request.Context(), key.Address.Address()),
+		ekc.setLinkBalance(key.Address.Address()),
+	)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponse(c, r, "account")
+}
+
+// ReconcileNonce reconciles the nonces of an ETH key with the chain, for
+// keys which have also been used outside the node
+// Example:
+// "POST <application>/keys/eth/reconcile_nonce/:address"
+func (ekc *ETHKeysController) ReconcileNonce(c *gin.Context) {
+	if !common.IsHexAddress(c.Param("address")) {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.New("invalid address"))
+		return
+	}
+	address := common.HexToAddress(c.Param("address"))
+
+	if _, err := ekc.App.GetKeyStore().Eth().KeyByAddress(address); err != nil {
+		jsonAPIError(c, http.StatusNotFound, err)
+		return
+	}
+
+	reconciler := bulletprooftxmanager.NewNonceReconciler(ekc.App.GetStore().DB, ekc.App.GetEthClient())
+	report, err := reconciler.Reconcile(c.Request.Context(), address)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewNonceReconciliationResource(report), "nonceReconciliation")
+}
+
+// Diagnose analyzes the unconfirmed transactions of an ETH key and explains
+// why they are stuck
+// Example:
+// "GET <application>/keys/eth/diagnose/:address"
+func (ekc *ETHKeysController) Diagnose(c *gin.Context) {
+	if !common.IsHexAddress(c.Param("address")) {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.New("invalid address"))
+		return
+	}
+	address := common.HexToAddress(c.Param("address"))
+
+	if _, err := ekc.App.GetKeyStore().Eth().KeyByAddress(address); err != nil {
+		jsonAPIError(c, http.StatusNotFound, err)
+		return
+	}
+
+	diagnoser := bulletprooftxmanager.NewStuckTxDiagnoser(ekc.App.GetStore().DB, ekc.App.GetEthClient(), ekc.App.GetTxManager().GetGasEstimator(), ekc.App.GetConfig())
+	diagnosis, err := diagnoser.Diagnose(c.Request.Context(), address)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewKeyDiagnosisResource(diagnosis), "keyDiagnosis")
+}
+
+// Rotate replaces a sending ETH key with a new one. The residual funds of the
+// old key are swept to the new key, and the OCR and keeper jobs which send
+// from it are re-pointed at the new key and restarted.
+// Example:
+// "POST <application>/keys/eth/rotate/:address"
+func (ekc *ETHKeysController) Rotate(c *gin.Context) {
+	if !common.IsHexAddress(c.Param("address")) {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.New("invalid address"))
+		return
+	}
+	address := common.HexToAddress(c.Param("address"))
+
+	ethKeyStore := ekc.App.GetKeyStore().Eth()
+	if _, err := ethKeyStore.KeyByAddress(address); err != nil {
+		jsonAPIError(c, http.StatusNotFound, err)
+		return
+	}
+
+	store := ekc.App.GetStore()
+	rotator := bulletprooftxmanager.NewKeyRotator(store.DB, ekc.App.GetEthClient(), ethKeyStore, ekc.App.GetConfig())
+	rotation, err := rotator.Rotate(c.Request.Context(), address, store.Config.EthGasLimitTransfer())
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	for _, jobID := range rotation.JobIDs {
+		// Jobs which are not running on this node pick up the new key when
+		// they are next started
+		if err := ekc.App.JobSpawner().RestartJob(c.Request.Context(), jobID); err != nil {
+			logger.Warnw("ETHKeysController: failed to restart job after key rotation", "jobID", jobID, "err", err)
+		}
+	}
+
+	jsonAPIResponse(c, presenters.NewKeyRotationResource(rotation), "keyRotation")
+}
+
 // setEthBalance is a custom functional option for NewEthKeyResource which
 // queries the EthClient for the ETH balance at the address and sets it on the
 // resource.
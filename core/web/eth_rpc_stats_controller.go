@@ -0,0 +1,23 @@
+package web
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"github.com/smartcontractkit/chainlink/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/core/services/eth"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+// EthRPCStatsController exposes the latency and error statistics of the
+// calls to the eth nodes
+type EthRPCStatsController struct {
+	App chainlink.Application
+}
+
+// Index lists the statistics of the calls of every method to every eth node
+// endpoint since the node started
+// Example:
+// "GET <application>/eth/rpc_stats"
+func (ersc *EthRPCStatsController) Index(c *gin.Context) {
+	jsonAPIResponse(c, presenters.NewEthRPCStatsResources(eth.GetRPCStats()), "ethRPCStats")
+}
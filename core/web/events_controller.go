@@ -0,0 +1,55 @@
+package web
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/core/services/events"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+// EventsController streams the structured events of the node
+type EventsController struct {
+	App chainlink.Application
+}
+
+// Stream upgrades to a WebSocket and sends the events of the node as they
+// happen, optionally only those of the comma separated types.
+// Example:
+// "GET <application>/events/stream"
+// "GET <application>/events/stream?types=run_completed,spec_error_recorded"
+func (ec *EventsController) Stream(c *gin.Context) {
+	types, err := events.ParseTypes(c.Query("types"))
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	sub := ec.App.NodeEvents().Subscribe(events.Filter{Types: types})
+	defer sub.Close()
+
+	stream, err := newWebsocketStream(c)
+	if err != nil {
+		logger.Debugw("EventsController: failed to upgrade to a websocket", "err", err)
+		return
+	}
+	defer logger.ErrorIfCalling(stream.Close)
+
+	for {
+		select {
+		case event, ok := <-sub.Events():
+			if !ok {
+				return
+			}
+			if err := stream.Send(presenters.NewNodeEventResource(event)); err != nil {
+				logger.Debugw("EventsController: failed to send node event", "err", err)
+				return
+			}
+		case <-stream.Closed():
+			return
+		}
+	}
+}
@@ -0,0 +1,49 @@
+package web_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/services/events"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+func TestEventsController_Stream(t *testing.T) {
+	_, app, jobID, _, cleanup := setupPipelineRunsControllerTests(t)
+	defer cleanup()
+
+	conn := dialPipelineRunsStream(t, app, fmt.Sprintf("/v2/events/stream?types=%s", events.TypeRunCompleted))
+	defer conn.Close()
+
+	// events of other types are not sent
+	app.NodeEvents().Publish(events.Event{Type: events.TypeLogBroadcasterReconnected, Time: time.Now()})
+
+	runID, err := app.RunJobV2(context.Background(), jobID, nil)
+	require.NoError(t, err)
+
+	var event presenters.NodeEventResource
+	require.NoError(t, conn.SetReadDeadline(time.Now().Add(cltest.DBWaitTimeout)))
+	require.NoError(t, conn.ReadJSON(&event))
+	assert.Equal(t, events.TypeRunCompleted, event.Type)
+	assert.Equal(t, strconv.Itoa(int(jobID)), event.JobID)
+	assert.Equal(t, strconv.Itoa(int(runID)), event.RunID)
+	assert.Equal(t, pipeline.RunStatusCompleted, event.RunState)
+}
+
+func TestEventsController_Stream_InvalidTypes(t *testing.T) {
+	client, _, _, _, cleanup := setupPipelineRunsControllerTests(t)
+	defer cleanup()
+
+	response, cleanup := client.Get("/v2/events/stream?types=run_completed,bogus")
+	defer cleanup()
+	cltest.AssertServerResponse(t, response, http.StatusUnprocessableEntity)
+}
@@ -0,0 +1,107 @@
+package web
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/core/services/export"
+)
+
+// ExportCursorTrailer is the HTTP trailer containing the cursor to pass as
+// after to fetch the rows following an export
+const ExportCursorTrailer = "X-Export-Cursor"
+
+// ExportsController streams node data for loading into data warehouses
+type ExportsController struct {
+	App chainlink.Application
+}
+
+// Show streams the rows of a dataset as CSV or NDJSON, starting after the row
+// with the id given by the cursor in the after param. The rows can be
+// filtered by creation time, job, state and address, where the dataset has
+// them.
+// Example:
+//
+//	"<application>/exports/pipeline_runs?after=1234&limit=10000&format=ndjson&since=2021-06-01T00:00:00Z"
+func (ec *ExportsController) Show(c *gin.Context) {
+	dataset, err := export.ParseDataset(c.Param("dataset"))
+	if err != nil {
+		jsonAPIError(c, http.StatusNotFound, err)
+		return
+	}
+
+	opts, err := queryExportOptions(c)
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	if err = export.CheckFilter(dataset, opts.Filter); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	c.Header("Content-Type", opts.Format.ContentType())
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%s.%s", dataset, opts.Format))
+	c.Header("Trailer", ExportCursorTrailer)
+	c.Status(http.StatusOK)
+
+	cursor, err := export.Write(c.Request.Context(), ec.App.GetStore().DB, dataset, opts, c.Writer)
+	if err != nil {
+		// The status has already been sent, so the client can only detect a
+		// failed export by the missing trailer
+		logger.Errorw("ExportsController: failed to export dataset", "dataset", dataset, "err", err)
+		return
+	}
+	c.Writer.Header().Set(ExportCursorTrailer, strconv.FormatInt(cursor, 10))
+}
+
+func queryExportOptions(c *gin.Context) (opts export.Options, err error) {
+	if opts.Format, err = export.ParseFormat(c.DefaultQuery("format", string(export.FormatCSV))); err != nil {
+		return opts, err
+	}
+
+	if param := c.Query("after"); param != "" {
+		opts.After, err = strconv.ParseInt(param, 10, 64)
+		if err != nil || opts.After < 0 {
+			return opts, errors.New("after must be a non-negative integer")
+		}
+	}
+
+	if param := c.Query("limit"); param != "" {
+		opts.Limit, err = strconv.Atoi(param)
+		if err != nil || opts.Limit < 0 {
+			return opts, errors.New("limit must be a non-negative integer")
+		}
+	}
+
+	if opts.Filter.Since, err = queryTime(c, "since"); err != nil {
+		return opts, err
+	}
+	if opts.Filter.Until, err = queryTime(c, "until"); err != nil {
+		return opts, err
+	}
+	if param := c.Query("jobID"); param != "" {
+		jobID, err := strconv.ParseInt(param, 10, 32)
+		if err != nil || jobID <= 0 {
+			return opts, errors.New("jobID must be a positive integer")
+		}
+		opts.Filter.JobID = int32(jobID)
+	}
+	opts.Filter.State = c.Query("state")
+	if param := c.Query("address"); param != "" {
+		if !common.IsHexAddress(param) {
+			return opts, errors.Errorf("invalid address %s", param)
+		}
+		address := common.HexToAddress(param)
+		opts.Filter.Address = &address
+	}
+	return opts, nil
+}
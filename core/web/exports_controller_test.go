@@ -0,0 +1,82 @@
+package web_test
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+	"github.com/smartcontractkit/chainlink/core/web"
+)
+
+func TestExportsController_Show(t *testing.T) {
+	app, client, _, _, _, jobID := setupJobSpecsControllerTestsWithJobs(t)
+
+	jb, err := app.JobORM().FindJobTx(jobID)
+	require.NoError(t, err)
+
+	run := pipeline.Run{
+		PipelineSpecID: jb.PipelineSpecID,
+		State:          pipeline.RunStatusCompleted,
+		Outputs:        pipeline.JSONSerializable{Val: []interface{}{"1"}},
+		Errors:         pipeline.RunErrors{null.String{}},
+	}
+	runID, err := app.PipelineORM().InsertFinishedRun(app.Store.DB, run, nil, false)
+	require.NoError(t, err)
+
+	response, cleanup := client.Get("/v2/exports/pipeline_runs")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, response, http.StatusOK)
+	assert.Equal(t, "text/csv", response.Header.Get("Content-Type"))
+
+	records, err := csv.NewReader(bytes.NewReader(cltest.ParseResponseBody(t, response))).ReadAll()
+	require.NoError(t, err)
+	require.Len(t, records, 2)
+	assert.Equal(t, strconv.FormatInt(runID, 10), records[1][0])
+	assert.Equal(t, strconv.FormatInt(runID, 10), response.Trailer.Get(web.ExportCursorTrailer))
+
+	response, cleanup = client.Get(fmt.Sprintf("/v2/exports/pipeline_runs?format=ndjson&jobID=%d&state=completed", jobID))
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, response, http.StatusOK)
+	assert.Equal(t, "application/x-ndjson", response.Header.Get("Content-Type"))
+	var record map[string]interface{}
+	require.NoError(t, json.Unmarshal(cltest.ParseResponseBody(t, response), &record))
+	assert.Equal(t, float64(runID), record["id"])
+	assert.Equal(t, float64(jobID), record["job_id"])
+
+	response, cleanup = client.Get("/v2/exports/pipeline_runs?state=errored")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, response, http.StatusOK)
+	records, err = csv.NewReader(bytes.NewReader(cltest.ParseResponseBody(t, response))).ReadAll()
+	require.NoError(t, err)
+	require.Len(t, records, 1)
+
+	response, cleanup = client.Get("/v2/exports/pipeline_runs?address=0x0000000000000000000000000000000000000001")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, response, http.StatusUnprocessableEntity)
+
+	response, cleanup = client.Get("/v2/exports/eth_txes?since=yesterday")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, response, http.StatusUnprocessableEntity)
+
+	response, cleanup = client.Get("/v2/exports/pipeline_runs?format=parquet")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, response, http.StatusUnprocessableEntity)
+
+	response, cleanup = client.Get("/v2/exports/pipeline_runs?after=-1")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, response, http.StatusUnprocessableEntity)
+
+	response, cleanup = client.Get("/v2/exports/users")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, response, http.StatusNotFound)
+}
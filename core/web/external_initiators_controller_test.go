@@ -106,6 +106,7 @@ func TestExternalInitiatorsController_Create_success(t *testing.T) {
 	assert.NotEmpty(t, ei.Secret)
 	assert.NotEmpty(t, ei.OutgoingToken)
 	assert.NotEmpty(t, ei.OutgoingSecret)
+	assert.NotEmpty(t, ei.SigningSecret)
 }
 
 func TestExternalInitiatorsController_Create_without_URL(t *testing.T) {
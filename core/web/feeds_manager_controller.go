@@ -6,9 +6,11 @@ import (
 	"net/http"
 	"strconv"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/gin-gonic/gin"
 	"github.com/smartcontractkit/chainlink/core/services/chainlink"
 	"github.com/smartcontractkit/chainlink/core/services/feeds"
+	"github.com/smartcontractkit/chainlink/core/store/models"
 	"github.com/smartcontractkit/chainlink/core/utils/crypto"
 	"github.com/smartcontractkit/chainlink/core/web/presenters"
 	"gopkg.in/guregu/null.v4"
@@ -28,6 +30,7 @@ type CreateFeedsManagerRequest struct {
 	PublicKey              crypto.PublicKey `json:"publicKey"`
 	IsBootstrapPeer        bool             `json:"isBootstrapPeer"`
 	BootstrapPeerMultiaddr null.String      `json:"bootstrapPeerMultiaddr"`
+	CSAKeyID               null.Int         `json:"csaKeyID"`
 }
 
 // Create registers a new feeds manager.
@@ -47,6 +50,7 @@ func (fmc *FeedsManagerController) Create(c *gin.Context) {
 		JobTypes:                  request.JobTypes,
 		IsOCRBootstrapPeer:        request.IsBootstrapPeer,
 		OCRBootstrapPeerMultiaddr: request.BootstrapPeerMultiaddr,
+		CSAKeyID:                  request.CSAKeyID,
 	}
 
 	feedsService := fmc.App.GetFeedsService()
@@ -106,3 +110,126 @@ func (fmc *FeedsManagerController) Show(c *gin.Context) {
 
 	jsonAPIResponse(c, presenters.NewFeedsManagerResource(*ms), "feeds_managers")
 }
+
+// CreateChainConfigRequest represents a JSONAPI request for creating a chain
+// config for a feeds manager
+type CreateChainConfigRequest struct {
+	ChainID        int64              `json:"chainID"`
+	AccountAddress common.Address     `json:"accountAddress"`
+	OCRKeyBundleID *models.Sha256Hash `json:"ocrKeyBundleID"`
+}
+
+// ListChainConfigs lists the chain configs of a feeds manager
+// Example:
+// "GET <application>/feeds_managers/<id>/chain_configs"
+func (fmc *FeedsManagerController) ListChainConfigs(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 32)
+	if err != nil {
+		jsonAPIError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	cfgs, err := fmc.App.GetFeedsService().ListChainConfigsByManagerID(id)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewChainConfigResources(cfgs), "chain_configs")
+}
+
+// CreateChainConfig creates or updates the chain config of a feeds manager
+// for a chain.
+// Example:
+// "POST <application>/feeds_managers/<id>/chain_configs"
+func (fmc *FeedsManagerController) CreateChainConfig(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 32)
+	if err != nil {
+		jsonAPIError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	request := CreateChainConfigRequest{}
+	if err = c.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	feedsService := fmc.App.GetFeedsService()
+
+	if _, err = feedsService.GetManager(id); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			jsonAPIError(c, http.StatusNotFound, errors.New("feeds Manager not found"))
+			return
+		}
+
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	cfgID, err := feedsService.CreateChainConfig(feeds.ChainConfig{
+		FeedsManagerID: id,
+		ChainID:        request.ChainID,
+		AccountAddress: request.AccountAddress,
+		OCRKeyBundleID: request.OCRKeyBundleID,
+	})
+	if err != nil {
+		jsonAPIError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	cfg, err := feedsService.GetChainConfig(cfgID)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponseWithStatus(c,
+		presenters.NewChainConfigResource(*cfg),
+		"chain_configs",
+		http.StatusCreated,
+	)
+}
+
+// DeleteChainConfig deletes a chain config of a feeds manager
+// Example:
+// "DELETE <application>/feeds_managers/<id>/chain_configs/<chainConfigID>"
+func (fmc *FeedsManagerController) DeleteChainConfig(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 32)
+	if err != nil {
+		jsonAPIError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	cfgID, err := strconv.ParseInt(c.Param("chainConfigID"), 10, 64)
+	if err != nil {
+		jsonAPIError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	feedsService := fmc.App.GetFeedsService()
+
+	cfg, err := feedsService.GetChainConfig(cfgID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			jsonAPIError(c, http.StatusNotFound, errors.New("chain config not found"))
+			return
+		}
+
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	// The chain config must belong to the feeds manager in the path
+	if cfg.FeedsManagerID != id {
+		jsonAPIError(c, http.StatusNotFound, errors.New("chain config not found"))
+		return
+	}
+
+	if err = feedsService.DeleteChainConfig(cfgID); err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponseWithStatus(c, nil, "chain_configs", http.StatusNoContent)
+}
@@ -186,6 +186,78 @@ func Test_FeedsManagersController_Show(t *testing.T) {
 	}
 }
 
+func Test_FeedsManagersController_ChainConfigs(t *testing.T) {
+	t.Parallel()
+
+	app, client := setupFeedsManagerTest(t)
+
+	pubKey, err := crypto.PublicKeyFromHex("3b0f149627adb7b6fafe1497a9dfc357f22295a5440786c3bc566dfdb0176808")
+	require.NoError(t, err)
+
+	// Seed a feed manager
+	fsvc := app.GetFeedsService()
+	ms := feeds.FeedsManager{
+		Name:      "Chainlink FM",
+		URI:       "wss://127.0.0.1:2000",
+		JobTypes:  []string{"fluxmonitor"},
+		PublicKey: *pubKey,
+	}
+	msID, err := fsvc.RegisterManager(&ms)
+	require.NoError(t, err)
+
+	key, err := app.KeyStore.Eth().CreateNewKey()
+	require.NoError(t, err)
+
+	// Create
+	body, err := json.Marshal(web.CreateChainConfigRequest{
+		ChainID:        42,
+		AccountAddress: key.Address.Address(),
+	})
+	require.NoError(t, err)
+
+	resp, cleanup := client.Post(fmt.Sprintf("/v2/feeds_managers/%d/chain_configs", msID), bytes.NewReader(body))
+	t.Cleanup(cleanup)
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+
+	created := presenters.ChainConfigResource{}
+	err = web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, resp), &created)
+	require.NoError(t, err)
+	assert.Equal(t, int64(42), created.ChainID)
+	assert.Equal(t, key.Address.Address(), created.AccountAddress)
+	assert.Equal(t, strconv.Itoa(int(msID)), created.FeedsManagerID)
+
+	// An address without a sending key is rejected
+	body, err = json.Marshal(web.CreateChainConfigRequest{
+		ChainID:        43,
+		AccountAddress: cltest.NewAddress(),
+	})
+	require.NoError(t, err)
+
+	resp, cleanup = client.Post(fmt.Sprintf("/v2/feeds_managers/%d/chain_configs", msID), bytes.NewReader(body))
+	t.Cleanup(cleanup)
+	require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+
+	// List
+	resp, cleanup = client.Get(fmt.Sprintf("/v2/feeds_managers/%d/chain_configs", msID))
+	t.Cleanup(cleanup)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	resources := []presenters.ChainConfigResource{}
+	err = web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, resp), &resources)
+	require.NoError(t, err)
+	require.Len(t, resources, 1)
+	assert.Equal(t, created.ID, resources[0].ID)
+
+	// Delete
+	resp, cleanup = client.Delete(fmt.Sprintf("/v2/feeds_managers/%d/chain_configs/%s", msID, created.ID))
+	t.Cleanup(cleanup)
+	require.Equal(t, http.StatusNoContent, resp.StatusCode)
+
+	cfgs, err := fsvc.ListChainConfigsByManagerID(msID)
+	require.NoError(t, err)
+	assert.Len(t, cfgs, 0)
+}
+
 func setupFeedsManagerTest(t *testing.T) (*cltest.TestApplication, cltest.HTTPClientCleaner) {
 	app, cleanup := cltest.NewApplication(t)
 	t.Cleanup(cleanup)
@@ -0,0 +1,54 @@
+package web
+
+import (
+	"math/big"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/smartcontractkit/chainlink/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+// GasEstimatesController exposes the gas estimators of the node's chains
+type GasEstimatesController struct {
+	App chainlink.Application
+}
+
+// Index lists the current gas price estimate of the primary chain and of each
+// of ETH_ADDITIONAL_CHAINS
+// Example:
+// "GET <application>/gas_estimates"
+func (gc *GasEstimatesController) Index(c *gin.Context) {
+	config := gc.App.GetConfig()
+	resources := []presenters.GasEstimateResource{}
+	if config.EthereumDisabled() {
+		jsonAPIResponse(c, resources, "gasEstimates")
+		return
+	}
+
+	chainIDs := []*big.Int{config.ChainID()}
+	chains, err := config.EthAdditionalChains()
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	for _, chain := range chains {
+		chainIDs = append(chainIDs, chain.ID)
+	}
+
+	for _, chainID := range chainIDs {
+		txManager, err := gc.App.GetTxManager().ForChain(chainID)
+		if err != nil {
+			jsonAPIError(c, http.StatusInternalServerError, err)
+			return
+		}
+		estimator := txManager.GetGasEstimator()
+		if estimator == nil {
+			continue
+		}
+		resources = append(resources, presenters.NewGasEstimateResource(chainID, estimator.CurrentEstimate()))
+	}
+
+	jsonAPIResponse(c, resources, "gasEstimates")
+}
@@ -2,6 +2,7 @@ package web
 
 import (
 	"net/http"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/smartcontractkit/chainlink/core/services/chainlink"
@@ -37,11 +38,10 @@ func (hc *HealthController) Readyz(c *gin.Context) {
 	checks := make([]presenters.Check, 0, len(errors))
 
 	for name, err := range errors {
-		status := health.StatusPassing
+		status := health.StatusOf(err)
 		var output string
 
 		if err != nil {
-			status = health.StatusFailing
 			output = err.Error()
 		}
 
@@ -57,12 +57,15 @@ func (hc *HealthController) Readyz(c *gin.Context) {
 	jsonAPIResponse(c, checks, "checks")
 }
 
+// Health returns the services as a tree, in which services are the children
+// of the services they depend on, with the root causes of their failures and
+// how long they have been in their status
 func (hc *HealthController) Health(c *gin.Context) {
 	status := http.StatusOK
 
 	checker := hc.App.GetHealthChecker()
 
-	healthy, errors := checker.IsHealthy()
+	healthy, _ := checker.IsHealthy()
 
 	if !healthy {
 		status = http.StatusServiceUnavailable
@@ -70,25 +73,8 @@ func (hc *HealthController) Health(c *gin.Context) {
 
 	c.Status(status)
 
-	checks := make([]presenters.Check, 0, len(errors))
+	checks := presenters.NewCheckTree(checker.Tree(), time.Now())
 
-	for name, err := range errors {
-		status := health.StatusPassing
-		var output string
-
-		if err != nil {
-			status = health.StatusFailing
-			output = err.Error()
-		}
-
-		checks = append(checks, presenters.Check{
-			JAID:   presenters.NewJAID(name),
-			Name:   name,
-			Status: status,
-			Output: output,
-		})
-	}
-
-	// return a json description of all the checks
+	// return a json description of the tree of checks
 	jsonAPIResponse(c, checks, "checks")
 }
@@ -1,14 +1,17 @@
 package web
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 
 	"github.com/gin-gonic/gin"
 	"github.com/manyminds/api2go/jsonapi"
 	"github.com/pkg/errors"
+	"github.com/smartcontractkit/chainlink/core/services/keystore"
 	"github.com/smartcontractkit/chainlink/core/store/models"
 	"github.com/smartcontractkit/chainlink/core/store/orm"
+	"github.com/smartcontractkit/chainlink/core/utils"
 )
 
 // StatusCodeForError returns an http status code for an error type.
@@ -78,6 +81,19 @@ func paginatedResponse(
 	}
 }
 
+func cursorPaginatedResponse(
+	c *gin.Context,
+	name string,
+	resource interface{},
+	nextCursor string,
+) {
+	if buffer, err := NewCursorPaginatedResponse(*c.Request.URL, resource, nextCursor); err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, fmt.Errorf("failed to marshal %s document: %+v", name, err))
+	} else {
+		c.Data(http.StatusOK, MediaType, buffer)
+	}
+}
+
 func paginatedRequest(action func(*gin.Context, int, int, int)) func(*gin.Context) {
 	return func(c *gin.Context) {
 		size, page, offset, err := ParsePaginatedRequest(c.Query("size"), c.Query("page"))
@@ -89,6 +105,27 @@ func paginatedRequest(action func(*gin.Context, int, int, int)) func(*gin.Contex
 	}
 }
 
+// exportScryptParams returns the ScryptParams which a key export is encrypted
+// with, given by the scryptStrength query param or the node's own if it is
+// not set
+func exportScryptParams(c *gin.Context, config utils.ScryptConfigReader) (utils.ScryptParams, error) {
+	strength := c.Query("scryptStrength")
+	if strength == "" {
+		return utils.GetScryptParams(config), nil
+	}
+	return utils.ScryptParamsForStrength(strength)
+}
+
+// keyStoreContext returns the context of the request, identifying its user
+// as the requester of key accesses in the keystore audit log
+func keyStoreContext(c *gin.Context, purpose string) context.Context {
+	requester := keystore.Requester{Service: "web", Purpose: purpose}
+	if user, ok := authenticatedUser(c); ok {
+		requester.Purpose = fmt.Sprintf("%s requested by %s", purpose, user.Email)
+	}
+	return keystore.WithRequester(c.Request.Context(), requester)
+}
+
 func jsonAPIResponseWithStatus(c *gin.Context, resource interface{}, name string, status int) {
 	json, err := jsonapi.Marshal(resource)
 	if err != nil {
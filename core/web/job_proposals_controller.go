@@ -8,6 +8,7 @@ import (
 
 	"github.com/gin-gonic/gin"
 	"github.com/smartcontractkit/chainlink/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/core/services/feeds"
 	"github.com/smartcontractkit/chainlink/core/web/presenters"
 )
 
@@ -94,6 +95,52 @@ func (jpc *JobProposalsController) Approve(c *gin.Context) {
 	)
 }
 
+// BulkApproveRequest is the request body for approving multiple job
+// proposals.
+type BulkApproveRequest struct {
+	IDs []int64 `json:"ids"`
+}
+
+// BulkApprove approves multiple job proposals. The jobs are created in a
+// single transaction, so if any proposal cannot be approved, none are.
+// Example:
+// "POST <application>/bulk_approve_job_proposals"
+func (jpc *JobProposalsController) BulkApprove(c *gin.Context) {
+	request := BulkApproveRequest{}
+	if err := c.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	if len(request.IDs) == 0 {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.New("must provide at least one job proposal id"))
+		return
+	}
+
+	feedsSvc := jpc.App.GetFeedsService()
+
+	results, err := feedsSvc.ApproveJobProposals(c.Request.Context(), request.IDs)
+	if err != nil {
+		if errors.Is(err, feeds.ErrBulkApprovalFailed) {
+			jsonAPIResponseWithStatus(c,
+				presenters.NewJobProposalApprovalResources(results),
+				"job_proposal_approvals",
+				http.StatusUnprocessableEntity,
+			)
+			return
+		}
+
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponseWithStatus(c,
+		presenters.NewJobProposalApprovalResources(results),
+		"job_proposal_approvals",
+		http.StatusOK,
+	)
+}
+
 // Reject rejects a job proposal.
 // Example:
 // "POST <application>/job_proposals/<id>/reject"
@@ -135,6 +182,32 @@ func (jpc *JobProposalsController) Reject(c *gin.Context) {
 	)
 }
 
+// Archive archives a rejected or cancelled job proposal.
+// Example:
+// "POST <application>/job_proposals/<id>/archive"
+func (jpc *JobProposalsController) Archive(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		jsonAPIError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	feedsSvc := jpc.App.GetFeedsService()
+
+	err = feedsSvc.ArchiveJobProposal(c.Request.Context(), id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			jsonAPIError(c, http.StatusNotFound, errors.New("no rejected or cancelled job proposal found"))
+			return
+		}
+
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponseWithStatus(c, nil, "job_proposals", http.StatusNoContent)
+}
+
 type UpdateSpecRequest struct {
 	Spec string `json:"spec"`
 }
@@ -3,6 +3,7 @@ package web_test
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"strconv"
@@ -242,6 +243,113 @@ func Test_JobProposalsController_Approve(t *testing.T) {
 	}
 }
 
+func Test_JobProposalsController_BulkApprove(t *testing.T) {
+	t.Parallel()
+
+	var (
+		spec = string(cltest.MustReadFile(t, "../testdata/tomlspecs/flux-monitor-spec.toml"))
+		jp1  = feeds.JobProposal{
+			ID:             1,
+			RemoteUUID:     uuid.NewV4(),
+			Spec:           spec,
+			Status:         feeds.JobProposalStatusPending,
+			ExternalJobID:  uuid.NullUUID{},
+			FeedsManagerID: 10,
+		}
+	)
+
+	testCases := []struct {
+		name           string
+		before         func(t *testing.T, app *cltest.TestApplication, ids *[]int64, rpcClient *pbMocks.FeedsManagerClient)
+		wantApproved   bool
+		wantStatusCode int
+	}{
+		{
+			name: "success",
+			before: func(t *testing.T, app *cltest.TestApplication, ids *[]int64, rpcClient *pbMocks.FeedsManagerClient) {
+				fsvc := app.GetFeedsService()
+
+				jp1ID, err := fsvc.CreateJobProposal(&jp1)
+				require.NoError(t, err)
+
+				*ids = []int64{jp1ID}
+
+				rpcClient.On("ApprovedJob", mock.MatchedBy(func(c context.Context) bool { return true }), &pb.ApprovedJobRequest{
+					Uuid: jp1.RemoteUUID.String(),
+				}).Return(&pb.ApprovedJobResponse{}, nil)
+			},
+			wantApproved:   true,
+			wantStatusCode: http.StatusOK,
+		},
+		{
+			name: "rolls back when a proposal is not found",
+			before: func(t *testing.T, app *cltest.TestApplication, ids *[]int64, rpcClient *pbMocks.FeedsManagerClient) {
+				fsvc := app.GetFeedsService()
+
+				jp1ID, err := fsvc.CreateJobProposal(&jp1)
+				require.NoError(t, err)
+
+				*ids = []int64{jp1ID, 999999999}
+			},
+			wantApproved:   false,
+			wantStatusCode: http.StatusUnprocessableEntity,
+		},
+		{
+			name:           "no ids",
+			wantStatusCode: http.StatusUnprocessableEntity,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			app, client := setupJobProposalsTest(t)
+			rpcClient := &pbMocks.FeedsManagerClient{}
+			app.FeedsService.Unsafe_SetFMSClient(rpcClient)
+
+			// Defer the FK requirement of a feeds manager.
+			require.NoError(t, app.Store.DB.Exec(
+				`SET CONSTRAINTS fk_feeds_manager DEFERRED`,
+			).Error)
+
+			var ids []int64
+			if tc.before != nil {
+				tc.before(t, app, &ids, rpcClient)
+			}
+
+			body, err := json.Marshal(web.BulkApproveRequest{IDs: ids})
+			require.NoError(t, err)
+
+			resp, cleanup := client.Post("/v2/bulk_approve_job_proposals", bytes.NewReader(body))
+			t.Cleanup(cleanup)
+			require.Equal(t, tc.wantStatusCode, resp.StatusCode)
+
+			if len(ids) == 0 {
+				return
+			}
+
+			resources := []presenters.JobProposalApprovalResource{}
+			err = web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, resp), &resources)
+			require.NoError(t, err)
+			require.Len(t, resources, len(ids))
+
+			for _, r := range resources {
+				assert.Equal(t, tc.wantApproved, r.Approved)
+			}
+
+			jp, err := app.GetFeedsService().GetJobProposal(ids[0])
+			require.NoError(t, err)
+			if tc.wantApproved {
+				assert.Equal(t, feeds.JobProposalStatusApproved, jp.Status)
+			} else {
+				assert.Equal(t, feeds.JobProposalStatusPending, jp.Status)
+			}
+		})
+	}
+}
+
 func Test_JobProposalsController_Reject(t *testing.T) {
 	t.Parallel()
 
@@ -333,6 +441,98 @@ func Test_JobProposalsController_Reject(t *testing.T) {
 	}
 }
 
+func Test_JobProposalsController_Archive(t *testing.T) {
+	t.Parallel()
+
+	var (
+		pending = feeds.JobProposal{
+			RemoteUUID:     uuid.NewV4(),
+			Spec:           "some spec",
+			Status:         feeds.JobProposalStatusPending,
+			FeedsManagerID: 10,
+		}
+		rejected = feeds.JobProposal{
+			RemoteUUID:     uuid.NewV4(),
+			Spec:           "some spec",
+			Status:         feeds.JobProposalStatusRejected,
+			FeedsManagerID: 10,
+		}
+	)
+
+	testCases := []struct {
+		name           string
+		before         func(t *testing.T, app *cltest.TestApplication, id *string)
+		wantStatusCode int
+	}{
+		{
+			name: "success",
+			before: func(t *testing.T, app *cltest.TestApplication, id *string) {
+				jpID, err := app.GetFeedsService().CreateJobProposal(&rejected)
+				require.NoError(t, err)
+
+				*id = strconv.Itoa(int(jpID))
+			},
+			wantStatusCode: http.StatusNoContent,
+		},
+		{
+			name: "pending proposal",
+			before: func(t *testing.T, app *cltest.TestApplication, id *string) {
+				jpID, err := app.GetFeedsService().CreateJobProposal(&pending)
+				require.NoError(t, err)
+
+				*id = strconv.Itoa(int(jpID))
+			},
+			wantStatusCode: http.StatusNotFound,
+		},
+		{
+			name: "invalid id",
+			before: func(t *testing.T, app *cltest.TestApplication, id *string) {
+				*id = "notanint"
+			},
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name: "not found",
+			before: func(t *testing.T, app *cltest.TestApplication, id *string) {
+				*id = "999999999"
+			},
+			wantStatusCode: http.StatusNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			app, client := setupJobProposalsTest(t)
+
+			// Defer the FK requirement of a feeds manager.
+			require.NoError(t, app.Store.DB.Exec(
+				`SET CONSTRAINTS fk_feeds_manager DEFERRED`,
+			).Error)
+
+			var id string
+			tc.before(t, app, &id)
+
+			resp, cleanup := client.Post(fmt.Sprintf("/v2/job_proposals/%s/archive", id), bytes.NewReader([]byte{}))
+			t.Cleanup(cleanup)
+			require.Equal(t, tc.wantStatusCode, resp.StatusCode)
+
+			if tc.wantStatusCode == http.StatusNoContent {
+				jpID, err := strconv.ParseInt(id, 10, 64)
+				require.NoError(t, err)
+
+				jps, err := app.GetFeedsService().ListJobProposals()
+				require.NoError(t, err)
+				for _, jp := range jps {
+					assert.NotEqual(t, jpID, jp.ID)
+				}
+			}
+		})
+	}
+}
+
 func Test_JobProposalsController_UpdateSpec(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,101 @@
+package web
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+
+	"github.com/smartcontractkit/chainlink/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/core/services/job"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+// JobTemplatesController manages the templates which jobs are created from
+type JobTemplatesController struct {
+	App chainlink.Application
+}
+
+// CreateJobTemplateRequest is the request body for creating a template. The
+// TOML is a job spec with {{.Var}} placeholders.
+type CreateJobTemplateRequest struct {
+	Name string `json:"name"`
+	TOML string `json:"toml"`
+}
+
+// Index lists the templates, one page at a time.
+// Example:
+// "GET <application>/job_templates"
+func (jtc *JobTemplatesController) Index(c *gin.Context, size, page, offset int) {
+	templates, count, err := jtc.App.JobORM().Templates(offset, size)
+
+	var resources []presenters.JobTemplateResource
+	for _, t := range templates {
+		resources = append(resources, *presenters.NewJobTemplateResource(t))
+	}
+
+	paginatedResponse(c, "job_templates", size, page, resources, count, err)
+}
+
+// Create saves a new template.
+// Example:
+// "POST <application>/job_templates"
+func (jtc *JobTemplatesController) Create(c *gin.Context) {
+	request := CreateJobTemplateRequest{}
+	if err := c.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	t := job.Template{Name: request.Name, TOML: request.TOML}
+	if err := job.ValidateTemplate(t); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	err := jtc.App.JobORM().CreateTemplate(&t)
+	if errors.Is(err, job.ErrTemplateExists) {
+		jsonAPIError(c, http.StatusConflict, err)
+		return
+	}
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponseWithStatus(c, presenters.NewJobTemplateResource(t), "job_templates", http.StatusCreated)
+}
+
+// Show returns a template and the variables it refers to.
+// Example:
+// "GET <application>/job_templates/:Name"
+func (jtc *JobTemplatesController) Show(c *gin.Context) {
+	t, err := jtc.App.JobORM().FindTemplate(c.Param("Name"))
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		jsonAPIError(c, http.StatusNotFound, errors.New("template not found"))
+		return
+	}
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewJobTemplateResource(t), "job_templates")
+}
+
+// Destroy deletes a template. The jobs created from it are kept.
+// Example:
+// "DELETE <application>/job_templates/:Name"
+func (jtc *JobTemplatesController) Destroy(c *gin.Context) {
+	err := jtc.App.JobORM().DeleteTemplate(c.Request.Context(), c.Param("Name"))
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		jsonAPIError(c, http.StatusNotFound, errors.New("template not found"))
+		return
+	}
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponseWithStatus(c, nil, "job_templates", http.StatusNoContent)
+}
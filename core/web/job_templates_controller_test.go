@@ -0,0 +1,81 @@
+package web_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/services/job"
+	"github.com/smartcontractkit/chainlink/core/web"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+const cronTemplate = `
+type              = "cron"
+schemaVersion     = 1
+name              = "{{.Pair}}"
+schedule          = "CRON_TZ=UTC {{.Schedule}}"
+observationSource = """
+ds [type=http method=GET url="https://chain.link/{{.Pair}}"];
+"""
+`
+
+func TestJobTemplatesController(t *testing.T) {
+	_, client := setupJobsControllerTests(t)
+
+	post := func(path string, request interface{}, status int, resource interface{}) {
+		body, err := json.Marshal(request)
+		require.NoError(t, err)
+		response, cleanup := client.Post(path, bytes.NewReader(body))
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, response, status)
+		if resource != nil {
+			require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, response), resource))
+		}
+	}
+
+	var tmpl presenters.JobTemplateResource
+	post("/v2/job_templates", web.CreateJobTemplateRequest{Name: "prices", TOML: cronTemplate}, http.StatusCreated, &tmpl)
+	assert.Equal(t, "prices", tmpl.Name)
+	assert.Equal(t, []string{"Pair", "Schedule"}, tmpl.Variables)
+
+	post("/v2/job_templates", web.CreateJobTemplateRequest{Name: "prices", TOML: cronTemplate}, http.StatusConflict, nil)
+	post("/v2/job_templates", web.CreateJobTemplateRequest{Name: "broken", TOML: "{{.Pair"}, http.StatusUnprocessableEntity, nil)
+
+	response, cleanup := client.Get("/v2/job_templates/prices")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, response, http.StatusOK)
+
+	var created presenters.JobResource
+	post("/v2/jobs", web.CreateJobRequest{Template: "prices", Variables: job.TemplateVariables{"Pair": "ETH-USD", "Schedule": "0 0 1 1 *"}}, http.StatusOK, &created)
+	assert.Equal(t, "ETH-USD", created.Name)
+	require.NotNil(t, created.TemplateID)
+	assert.Equal(t, map[string]string{"Pair": "ETH-USD", "Schedule": "0 0 1 1 *"}, created.TemplateVariables)
+	assert.Contains(t, created.PipelineSpec.DotDAGSource, "https://chain.link/ETH-USD")
+
+	// A fleet of jobs is created from one template at once
+	bulk := []presenters.JobResource{}
+	post("/v2/bulk_create_jobs", web.BulkCreateJobsRequest{Template: "prices", Variables: []job.TemplateVariables{
+		{"Pair": "BTC-USD", "Schedule": "0 0 1 1 *"},
+		{"Pair": "LINK-USD", "Schedule": "0 0 1 1 *"},
+	}}, http.StatusOK, &bulk)
+	require.Len(t, bulk, 2)
+	assert.Equal(t, "LINK-USD", bulk[1].Name)
+
+	post("/v2/jobs", web.CreateJobRequest{Template: "prices", Variables: job.TemplateVariables{"Pair": "ETH-USD"}}, http.StatusUnprocessableEntity, nil)
+	post("/v2/jobs", web.CreateJobRequest{Template: "unknown"}, http.StatusUnprocessableEntity, nil)
+	post("/v2/jobs", web.CreateJobRequest{Template: "prices", TOML: cronTemplate}, http.StatusUnprocessableEntity, nil)
+
+	response, cleanup = client.Delete("/v2/job_templates/prices")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, response, http.StatusNoContent)
+
+	response, cleanup = client.Get("/v2/job_templates/prices")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, response, http.StatusNotFound)
+}
@@ -1,7 +1,10 @@
 package web
 
 import (
+	"context"
 	"net/http"
+	"strconv"
+	"time"
 
 	"github.com/smartcontractkit/chainlink/core/services/chainlink"
 	"github.com/smartcontractkit/chainlink/core/services/cron"
@@ -9,14 +12,17 @@ import (
 	"github.com/smartcontractkit/chainlink/core/services/fluxmonitorv2"
 	"github.com/smartcontractkit/chainlink/core/services/job"
 	"github.com/smartcontractkit/chainlink/core/services/keeper"
+	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
 	"github.com/smartcontractkit/chainlink/core/services/offchainreporting"
 	"github.com/smartcontractkit/chainlink/core/services/vrf"
 	"github.com/smartcontractkit/chainlink/core/services/webhook"
+	"github.com/smartcontractkit/chainlink/core/store/config"
 	"github.com/smartcontractkit/chainlink/core/store/orm"
 	"github.com/smartcontractkit/chainlink/core/web/presenters"
 
 	"github.com/gin-gonic/gin"
 	"github.com/pkg/errors"
+	"gorm.io/gorm"
 )
 
 // JobsController manages jobs
@@ -24,28 +30,43 @@ type JobsController struct {
 	App chainlink.Application
 }
 
-// Index lists all jobs
+// Index lists all jobs, or those with all the comma separated name=value
+// labels of the labels param
 // Example:
 // "GET <application>/jobs"
+// "GET <application>/jobs?labels=team=defi,env=staging"
 func (jc *JobsController) Index(c *gin.Context, size, page, offset int) {
 	// Temporary: if no size is passed in, use a large page size. Remove once frontend can handle pagination
 	if c.Query("size") == "" {
 		size = 1000
 	}
 
-	jobs, count, err := jc.App.JobORM().JobsV2(offset, size)
+	labels, err := job.ParseLabels(c.Query("labels"))
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	jobs, count, err := jc.App.JobORM().JobsV2WithLabels(labels, offset, size)
 	if err != nil {
 		jsonAPIError(c, http.StatusInternalServerError, err)
 		return
 	}
 	var resources []presenters.JobResource
 	for _, job := range jobs {
-		resources = append(resources, *presenters.NewJobResource(job))
+		resources = append(resources, *withNextRunAt(presenters.NewJobResource(job), job))
 	}
 
 	paginatedResponse(c, "jobs", size, page, resources, count, err)
 }
 
+// withNextRunAt adds when a cron job is next scheduled to run to its resource
+func withNextRunAt(resource *presenters.JobResource, jb job.Job) *presenters.JobResource {
+	if resource.CronSpec != nil && jb.CronSpec != nil {
+		resource.CronSpec.NextRunAt = jb.CronSpec.NextRunAt(time.Now())
+	}
+	return resource
+}
+
 // Show returns the details of a job
 // Example:
 // "GET <application>/jobs/:ID"
@@ -68,12 +89,22 @@ func (jc *JobsController) Show(c *gin.Context) {
 		return
 	}
 
-	jsonAPIResponse(c, presenters.NewJobResource(jobSpec), "jobs")
+	resource := withNextRunAt(presenters.NewJobResource(jobSpec), jobSpec)
+	// Only the node which claimed the job knows how far its services got in
+	// starting
+	if state, ok := jc.App.JobSpawner().StartupStates()[jobSpec.ID]; ok {
+		resource.StartupState = &state
+	}
+	jsonAPIResponse(c, resource, "jobs")
 }
 
-// CreateJobRequest represents a request to create and start a job (V2).
+// CreateJobRequest represents a request to create and start a job (V2). The
+// spec of the job is either its TOML, or the name of a template rendered with
+// the variables.
 type CreateJobRequest struct {
-	TOML string `json:"toml"`
+	TOML      string                `json:"toml"`
+	Template  string                `json:"template"`
+	Variables job.TemplateVariables `json:"variables"`
 }
 
 // Create validates, saves and starts a new job.
@@ -86,51 +117,370 @@ func (jc *JobsController) Create(c *gin.Context) {
 		return
 	}
 
-	jobType, err := job.ValidateSpec(request.TOML)
+	var jb job.Job
+	var status int
+	var err error
+	if request.Template != "" {
+		if request.TOML != "" {
+			jsonAPIError(c, http.StatusUnprocessableEntity, errors.New("must provide either toml or template, not both"))
+			return
+		}
+		var tmpl job.Template
+		if tmpl, status, err = jc.findTemplate(request.Template); err == nil {
+			jb, status, err = jc.validateFromTemplate(tmpl, request.Variables)
+		}
+	} else {
+		jb, status, err = jc.validate(request.TOML)
+	}
 	if err != nil {
-		jsonAPIError(c, http.StatusUnprocessableEntity, errors.Wrap(err, "failed to parse V2 job TOML. HINT: If you are trying to add a V1 job spec (json) via the CLI, try `job_specs create` instead"))
+		jsonAPIError(c, status, err)
+		return
+	}
+
+	jb, err = jc.App.AddJobV2(c.Request.Context(), jb, jb.Name)
+	if err != nil {
+		jsonAPIError(c, createJobErrorStatus(err), err)
+		return
+	}
+
+	jsonAPIResponse(c, withNextRunAt(presenters.NewJobResource(jb), jb), jb.Type.String())
+}
+
+// validate parses and validates the TOML spec of a new job. If it is invalid,
+// it also returns the status to respond with.
+func (jc *JobsController) validate(toml string) (job.Job, int, error) {
+	jobType, err := job.ValidateSpec(toml)
+	if err != nil {
+		return job.Job{}, http.StatusUnprocessableEntity, errors.Wrap(err, "failed to parse V2 job TOML. HINT: If you are trying to add a V1 job spec (json) via the CLI, try `job_specs create` instead")
 	}
 
-	var jb job.Job
 	config := jc.App.GetStore().Config
+	if jobType == job.OffchainReporting && !config.Dev() && !config.FeatureOffchainReporting() {
+		return job.Job{}, http.StatusNotImplemented, errors.New("The Offchain Reporting feature is disabled by configuration")
+	}
+	jb, err := ValidatedJobSpec(config, jc.App.GetExternalInitiatorManager(), jobType, toml)
+	if err != nil {
+		return jb, http.StatusBadRequest, err
+	}
+	return jb, http.StatusOK, nil
+}
+
+// findTemplate returns the template with the given name. If there is none, it
+// also returns the status to respond with.
+func (jc *JobsController) findTemplate(name string) (job.Template, int, error) {
+	tmpl, err := jc.App.JobORM().FindTemplate(name)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return tmpl, http.StatusUnprocessableEntity, errors.Errorf("template %s not found", name)
+	}
+	if err != nil {
+		return tmpl, http.StatusInternalServerError, err
+	}
+	return tmpl, http.StatusOK, nil
+}
+
+// validateFromTemplate renders the template with the variables, and validates
+// the resulting spec of a new job, which refers to the template
+func (jc *JobsController) validateFromTemplate(tmpl job.Template, variables job.TemplateVariables) (job.Job, int, error) {
+	toml, err := tmpl.Render(variables)
+	if err != nil {
+		return job.Job{}, http.StatusUnprocessableEntity, err
+	}
+	jb, status, err := jc.validate(toml)
+	if err != nil {
+		return jb, status, errors.Wrapf(err, "template %s", tmpl.Name)
+	}
+	jb.TemplateID = &tmpl.ID
+	jb.TemplateVariables = variables
+	return jb, status, nil
+}
+
+// ValidatedJobSpec validates the TOML spec of a new job of the given type
+// with the validator of the type, and returns the job it specifies
+func ValidatedJobSpec(config *config.Config, eiManager webhook.ExternalInitiatorManager, jobType job.Type, toml string) (job.Job, error) {
 	switch jobType {
 	case job.OffchainReporting:
-		jb, err = offchainreporting.ValidatedOracleSpecToml(jc.App.GetStore().Config, request.TOML)
-		if !config.Dev() && !config.FeatureOffchainReporting() {
-			jsonAPIError(c, http.StatusNotImplemented, errors.New("The Offchain Reporting feature is disabled by configuration"))
-			return
-		}
+		return offchainreporting.ValidatedOracleSpecToml(config, toml)
 	case job.DirectRequest:
-		jb, err = directrequest.ValidatedDirectRequestSpec(request.TOML)
+		return directrequest.ValidatedDirectRequestSpec(toml)
 	case job.FluxMonitor:
-		jb, err = fluxmonitorv2.ValidatedFluxMonitorSpec(jc.App.GetStore().Config, request.TOML)
+		return fluxmonitorv2.ValidatedFluxMonitorSpec(config, toml)
 	case job.Keeper:
-		jb, err = keeper.ValidatedKeeperSpec(request.TOML)
+		return keeper.ValidatedKeeperSpec(toml)
 	case job.Cron:
-		jb, err = cron.ValidatedCronSpec(request.TOML)
+		return cron.ValidatedCronSpec(toml)
 	case job.VRF:
-		jb, err = vrf.ValidatedVRFSpec(request.TOML)
+		return vrf.ValidatedVRFSpec(toml)
 	case job.Webhook:
-		jb, err = webhook.ValidatedWebhookSpec(request.TOML, jc.App.GetExternalInitiatorManager())
+		return webhook.ValidatedWebhookSpec(toml, eiManager)
 	default:
-		jsonAPIError(c, http.StatusUnprocessableEntity, errors.Errorf("unknown job type: %s", jobType))
+		return job.Job{}, errors.Errorf("unknown job type: %s", jobType)
+	}
+}
+
+// createJobErrorStatus returns the status to respond with when a valid job
+// could not be created
+func createJobErrorStatus(err error) int {
+	if errors.Cause(err) == job.ErrNoSuchKeyBundle || errors.Cause(err) == job.ErrNoSuchPeerID || errors.Cause(err) == job.ErrNoSuchTransmitterAddress {
+		return http.StatusBadRequest
 	}
+	return http.StatusInternalServerError
+}
+
+// BulkCreateJobsRequest is the request body for creating multiple jobs, from
+// their TOML specs or from a template rendered with each set of variables.
+type BulkCreateJobsRequest struct {
+	TOMLs     []string                `json:"tomls"`
+	Template  string                  `json:"template"`
+	Variables []job.TemplateVariables `json:"variables"`
+}
+
+// BulkCreate validates, saves and starts multiple jobs. The jobs are created
+// in a single transaction, so if any of them is invalid or cannot be created,
+// none are.
+// Example:
+// "POST <application>/bulk_create_jobs"
+func (jc *JobsController) BulkCreate(c *gin.Context) {
+	request := BulkCreateJobsRequest{}
+	if err := c.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	if len(request.TOMLs) == 0 && len(request.Variables) == 0 {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.New("must provide at least one job spec"))
+		return
+	}
+
+	if request.Template == "" && len(request.Variables) > 0 {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.New("must provide the template of the variables"))
+		return
+	}
+
+	var jbs []job.Job
+	if request.Template != "" {
+		if len(request.TOMLs) > 0 {
+			jsonAPIError(c, http.StatusUnprocessableEntity, errors.New("must provide either tomls or template, not both"))
+			return
+		}
+		tmpl, status, err := jc.findTemplate(request.Template)
+		if err != nil {
+			jsonAPIError(c, status, err)
+			return
+		}
+		for i, variables := range request.Variables {
+			jb, status, err := jc.validateFromTemplate(tmpl, variables)
+			if err != nil {
+				jsonAPIError(c, status, errors.Wrapf(err, "job spec %d", i))
+				return
+			}
+			jbs = append(jbs, jb)
+		}
+	} else {
+		for i, toml := range request.TOMLs {
+			jb, status, err := jc.validate(toml)
+			if err != nil {
+				jsonAPIError(c, status, errors.Wrapf(err, "job spec %d", i))
+				return
+			}
+			jbs = append(jbs, jb)
+		}
+	}
+
+	jbs, err := jc.App.JobSpawner().CreateJobs(c.Request.Context(), jbs)
 	if err != nil {
-		jsonAPIError(c, http.StatusBadRequest, err)
+		jsonAPIError(c, createJobErrorStatus(err), err)
 		return
 	}
 
-	jb, err = jc.App.AddJobV2(c.Request.Context(), jb, jb.Name)
+	jsonAPIResponse(c, presenters.NewJobResources(jbs), "jobs")
+}
+
+// BulkDeleteJobsRequest is the request body for deleting multiple jobs.
+type BulkDeleteJobsRequest struct {
+	IDs []int32 `json:"ids"`
+}
+
+// BulkDelete hard deletes multiple jobs. If any of them cannot be deleted,
+// none are.
+// Example:
+// "POST <application>/bulk_delete_jobs"
+func (jc *JobsController) BulkDelete(c *gin.Context) {
+	request := BulkDeleteJobsRequest{}
+	if err := c.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	if len(request.IDs) == 0 {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.New("must provide at least one job id"))
+		return
+	}
+
+	err := jc.App.JobSpawner().DeleteJobs(c.Request.Context(), request.IDs)
+	if errors.Cause(err) == orm.ErrorNotFound {
+		jsonAPIError(c, http.StatusNotFound, errors.New("JobSpec not found"))
+		return
+	}
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponseWithStatus(c, nil, "job", http.StatusNoContent)
+}
+
+// BulkToggleJobsRequest is the request body for pausing or resuming multiple
+// jobs. The jobs are selected by exactly one of their IDs, a tag or the
+// address of the contract they watch or transmit to.
+type BulkToggleJobsRequest struct {
+	IDs             []int32              `json:"ids"`
+	Tag             string               `json:"tag"`
+	ContractAddress *ethkey.EIP55Address `json:"contractAddress"`
+}
+
+// BulkPause pauses multiple jobs, which are not run by any node until
+// resumed. It responds with the paused jobs.
+// Example:
+// "POST <application>/bulk_pause_jobs"
+func (jc *JobsController) BulkPause(c *gin.Context) {
+	jc.bulkToggle(c, jc.App.JobSpawner().PauseJobs)
+}
+
+// BulkResume resumes multiple paused jobs. It responds with the resumed jobs.
+// Example:
+// "POST <application>/bulk_resume_jobs"
+func (jc *JobsController) BulkResume(c *gin.Context) {
+	jc.bulkToggle(c, jc.App.JobSpawner().ResumeJobs)
+}
+
+func (jc *JobsController) bulkToggle(c *gin.Context, toggle func(ctx context.Context, jobIDs []int32) error) {
+	request := BulkToggleJobsRequest{}
+	if err := c.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	ids, err := jc.selectJobIDs(request)
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	err = toggle(c.Request.Context(), ids)
+	if errors.Cause(err) == orm.ErrorNotFound {
+		jsonAPIError(c, http.StatusNotFound, errors.New("job not found"))
+		return
+	}
 	if err != nil {
-		if errors.Cause(err) == job.ErrNoSuchKeyBundle || errors.Cause(err) == job.ErrNoSuchPeerID || errors.Cause(err) == job.ErrNoSuchTransmitterAddress {
-			jsonAPIError(c, http.StatusBadRequest, err)
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jbs := []job.Job{}
+	for _, id := range ids {
+		jb, err := jc.App.JobORM().FindJobTx(id)
+		if err != nil {
+			jsonAPIError(c, http.StatusInternalServerError, err)
 			return
 		}
+		jbs = append(jbs, jb)
+	}
+
+	jsonAPIResponse(c, presenters.NewJobResources(jbs), "jobs")
+}
+
+func (jc *JobsController) selectJobIDs(request BulkToggleJobsRequest) ([]int32, error) {
+	selectors := 0
+	if len(request.IDs) > 0 {
+		selectors++
+	}
+	if request.Tag != "" {
+		selectors++
+	}
+	if request.ContractAddress != nil {
+		selectors++
+	}
+	if selectors != 1 {
+		return nil, errors.New("must provide exactly one of ids, tag or contractAddress")
+	}
+
+	switch {
+	case request.Tag != "":
+		return jc.App.JobORM().FindJobIDsWithTag(request.Tag)
+	case request.ContractAddress != nil:
+		return jc.App.JobORM().FindJobIDsWithContractAddress(*request.ContractAddress)
+	default:
+		return request.IDs, nil
+	}
+}
+
+// UpdateJobLabelsRequest is the request body for replacing the labels of a
+// job
+type UpdateJobLabelsRequest struct {
+	Labels job.Labels `json:"labels"`
+}
+
+// UpdateLabels replaces the labels of a job, and responds with the job.
+// Example:
+// "PATCH <application>/jobs/:ID/labels"
+func (jc *JobsController) UpdateLabels(c *gin.Context) {
+	jobSpec := job.Job{}
+	if err := jobSpec.SetID(c.Param("ID")); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	request := UpdateJobLabelsRequest{}
+	if err := c.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	if err := request.Labels.Validate(); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	err := jc.App.JobSpawner().SetJobLabels(c.Request.Context(), jobSpec.ID, request.Labels)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		jsonAPIError(c, http.StatusNotFound, errors.New("job not found"))
+		return
+	}
+	if err != nil {
 		jsonAPIError(c, http.StatusInternalServerError, err)
 		return
 	}
 
-	jsonAPIResponse(c, presenters.NewJobResource(jb), jb.Type.String())
+	jobSpec, err = jc.App.JobORM().FindJobTx(jobSpec.ID)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	jsonAPIResponse(c, presenters.NewJobResource(jobSpec), "jobs")
+}
+
+// Reactivate lifts the quarantine of a job which kept failing to start
+// Example:
+// "POST <application>/jobs/:ID/reactivate"
+func (jc *JobsController) Reactivate(c *gin.Context) {
+	jobSpec := job.Job{}
+	if err := jobSpec.SetID(c.Param("ID")); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	err := jc.App.JobSpawner().ReactivateJob(c.Request.Context(), jobSpec.ID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		jsonAPIError(c, http.StatusNotFound, errors.New("job not found"))
+		return
+	}
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jobSpec, err = jc.App.JobORM().FindJobTx(jobSpec.ID)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	jsonAPIResponse(c, presenters.NewJobResource(jobSpec), "jobs")
 }
 
 // Delete hard deletes a job spec.
@@ -156,3 +506,46 @@ func (jc *JobsController) Delete(c *gin.Context) {
 
 	jsonAPIResponseWithStatus(c, nil, "job", http.StatusNoContent)
 }
+
+// Costs returns the estimated costs of running a job, aggregated per UTC day.
+// The number of days covered defaults to 30 and can be set with the days query
+// parameter.
+// Example:
+// "GET <application>/jobs/:ID/costs?days=7"
+func (jc *JobsController) Costs(c *gin.Context) {
+	jobSpec := job.Job{}
+	err := jobSpec.SetID(c.Param("ID"))
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	days := 30
+	if param := c.Query("days"); param != "" {
+		days, err = strconv.Atoi(param)
+		if err != nil || days < 1 {
+			jsonAPIError(c, http.StatusUnprocessableEntity, errors.New("days must be a positive integer"))
+			return
+		}
+	}
+
+	jobSpec, err = jc.App.JobORM().FindJobTx(jobSpec.ID)
+	if errors.Cause(err) == orm.ErrorNotFound {
+		jsonAPIError(c, http.StatusNotFound, errors.New("job not found"))
+		return
+	}
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	today := time.Now().UTC().Truncate(24 * time.Hour)
+	since := today.AddDate(0, 0, 1-days)
+	costs, err := jc.App.PipelineORM().FindDailyCosts(jobSpec.PipelineSpecID, since)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewJobCostResources(costs), "job_costs")
+}
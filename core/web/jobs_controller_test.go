@@ -7,17 +7,21 @@ import (
 	"fmt"
 	"io/ioutil"
 	"net/http"
+	"net/url"
+	"strconv"
 	"testing"
 	"time"
 
 	"github.com/smartcontractkit/chainlink/core/testdata/testspecs"
 
 	"github.com/pelletier/go-toml"
+	"github.com/shopspring/decimal"
 	"github.com/smartcontractkit/chainlink/core/internal/cltest"
 	"github.com/smartcontractkit/chainlink/core/services/directrequest"
 	"github.com/smartcontractkit/chainlink/core/services/job"
 	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
 	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/p2pkey"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
 	"github.com/smartcontractkit/chainlink/core/store/models"
 	"github.com/smartcontractkit/chainlink/core/web"
 	"github.com/smartcontractkit/chainlink/core/web/presenters"
@@ -164,6 +168,8 @@ func TestJobController_Create_HappyPath(t *testing.T) {
 				assert.NoError(t, err)
 				assert.NotNil(t, resource.PipelineSpec.DotDAGSource)
 				require.Equal(t, "CRON_TZ=UTC * 0 0 1 1 *", jb.CronSpec.CronSchedule)
+				require.NotNil(t, resource.CronSpec.NextRunAt)
+				assert.Equal(t, time.January, resource.CronSpec.NextRunAt.Month())
 			},
 		},
 		{
@@ -326,6 +332,159 @@ func TestJobsController_Show_NonExistentID(t *testing.T) {
 	cltest.AssertServerResponse(t, response, http.StatusNotFound)
 }
 
+func TestJobsController_Costs(t *testing.T) {
+	app, client, _, _, _, jobID := setupJobSpecsControllerTestsWithJobs(t)
+
+	jb, err := app.JobORM().FindJobTx(jobID)
+	require.NoError(t, err)
+
+	now := time.Now()
+	run := pipeline.Run{
+		PipelineSpecID: jb.PipelineSpecID,
+		State:          pipeline.RunStatusCompleted,
+		Outputs:        pipeline.JSONSerializable{Val: []interface{}{"1"}},
+		Errors:         pipeline.RunErrors{null.String{}},
+		BridgeCredits:  decimal.NullDecimal{Decimal: decimal.RequireFromString("0.25"), Valid: true},
+		CreatedAt:      now,
+		FinishedAt:     null.TimeFrom(now),
+	}
+	_, err = app.PipelineORM().InsertFinishedRun(app.Store.DB, run, nil, false)
+	require.NoError(t, err)
+
+	response, cleanup := client.Get(fmt.Sprintf("/v2/jobs/%v/costs?days=7", jobID))
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, response, http.StatusOK)
+
+	var resources []presenters.JobCostResource
+	err = web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, response), &resources)
+	require.NoError(t, err)
+	require.Len(t, resources, 1)
+	assert.Equal(t, now.UTC().Format("2006-01-02"), resources[0].ID)
+	assert.Equal(t, int64(1), resources[0].Runs)
+	assert.Equal(t, "0.25", resources[0].BridgeCredits)
+	assert.Equal(t, "0", resources[0].GasFeeWei)
+
+	response, cleanup = client.Get(fmt.Sprintf("/v2/jobs/%v/costs?days=0", jobID))
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, response, http.StatusUnprocessableEntity)
+
+	response, cleanup = client.Get("/v2/jobs/999999999/costs")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, response, http.StatusNotFound)
+}
+
+func TestJobsController_BulkManagement(t *testing.T) {
+	app, client := setupJobsControllerTests(t)
+
+	drTOML := "tags = [\"feeds\"]\n" + string(cltest.MustReadFile(t, "../testdata/tomlspecs/direct-request-spec.toml"))
+	cronTOML := string(cltest.MustReadFile(t, "../testdata/tomlspecs/cron-spec.toml"))
+
+	postJobs := func(path string, request interface{}, status int) []presenters.JobResource {
+		body, err := json.Marshal(request)
+		require.NoError(t, err)
+		response, cleanup := client.Post(path, bytes.NewReader(body))
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, response, status)
+		if status != http.StatusOK {
+			return nil
+		}
+		resources := []presenters.JobResource{}
+		require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, response), &resources))
+		return resources
+	}
+	countJobs := func() int64 {
+		var count int64
+		require.NoError(t, app.Store.DB.Model(job.Job{}).Count(&count).Error)
+		return count
+	}
+
+	// Either all jobs are created or none
+	postJobs("/v2/bulk_create_jobs", web.BulkCreateJobsRequest{TOMLs: []string{drTOML, "type = \"unknown\""}}, http.StatusUnprocessableEntity)
+	assert.Equal(t, int64(0), countJobs())
+
+	created := postJobs("/v2/bulk_create_jobs", web.BulkCreateJobsRequest{TOMLs: []string{drTOML, cronTOML}}, http.StatusOK)
+	require.Len(t, created, 2)
+	assert.Equal(t, []string{"feeds"}, created[0].Tags)
+	assert.Equal(t, int64(2), countJobs())
+
+	paused := postJobs("/v2/bulk_pause_jobs", web.BulkToggleJobsRequest{Tag: "feeds"}, http.StatusOK)
+	require.Len(t, paused, 1)
+	assert.Equal(t, created[0].ID, paused[0].ID)
+	assert.True(t, paused[0].Paused)
+
+	address := ethkey.EIP55Address("0x613a38AC1659769640aaE063C651F48E0250454C")
+	resumed := postJobs("/v2/bulk_resume_jobs", web.BulkToggleJobsRequest{ContractAddress: &address}, http.StatusOK)
+	require.Len(t, resumed, 1)
+	assert.Equal(t, created[0].ID, resumed[0].ID)
+	assert.False(t, resumed[0].Paused)
+
+	postJobs("/v2/bulk_pause_jobs", web.BulkToggleJobsRequest{Tag: "feeds", IDs: []int32{1}}, http.StatusUnprocessableEntity)
+	postJobs("/v2/bulk_pause_jobs", web.BulkToggleJobsRequest{IDs: []int32{999999999}}, http.StatusNotFound)
+
+	var ids []int32
+	for _, r := range created {
+		id, err := strconv.ParseInt(r.ID, 10, 32)
+		require.NoError(t, err)
+		ids = append(ids, int32(id))
+	}
+	paused = postJobs("/v2/bulk_pause_jobs", web.BulkToggleJobsRequest{IDs: ids}, http.StatusOK)
+	require.Len(t, paused, 2)
+
+	// Paused jobs are deleted whichever node last ran them
+	postJobs("/v2/bulk_delete_jobs", web.BulkDeleteJobsRequest{IDs: ids}, http.StatusNoContent)
+	assert.Equal(t, int64(0), countJobs())
+}
+
+func TestJobsController_Labels(t *testing.T) {
+	_, client := setupJobsControllerTests(t)
+
+	drTOML := "labels = { team = \"defi\", env = \"staging\" }\n" + string(cltest.MustReadFile(t, "../testdata/tomlspecs/direct-request-spec.toml"))
+	cronTOML := string(cltest.MustReadFile(t, "../testdata/tomlspecs/cron-spec.toml"))
+	body, err := json.Marshal(web.BulkCreateJobsRequest{TOMLs: []string{drTOML, cronTOML}})
+	require.NoError(t, err)
+	response, cleanup := client.Post("/v2/bulk_create_jobs", bytes.NewReader(body))
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, response, http.StatusOK)
+	created := []presenters.JobResource{}
+	require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, response), &created))
+	require.Len(t, created, 2)
+	assert.Equal(t, map[string]string{"team": "defi", "env": "staging"}, created[0].Labels)
+	assert.Equal(t, map[string]string{}, created[1].Labels)
+
+	listJobs := func(labels string) []presenters.JobResource {
+		response, cleanup := client.Get("/v2/jobs?labels=" + url.QueryEscape(labels))
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, response, http.StatusOK)
+		resources := []presenters.JobResource{}
+		require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, response), &resources))
+		return resources
+	}
+	assert.Len(t, listJobs(""), 2)
+	listed := listJobs("team=defi")
+	require.Len(t, listed, 1)
+	assert.Equal(t, created[0].ID, listed[0].ID)
+	assert.Len(t, listJobs("team=defi,env=production"), 0)
+
+	patchLabels := func(id string, labels job.Labels, status int) presenters.JobResource {
+		body, err := json.Marshal(web.UpdateJobLabelsRequest{Labels: labels})
+		require.NoError(t, err)
+		response, cleanup := client.Patch("/v2/jobs/"+id+"/labels", bytes.NewReader(body))
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, response, status)
+		resource := presenters.JobResource{}
+		if status == http.StatusOK {
+			require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, response), &resource))
+		}
+		return resource
+	}
+	updated := patchLabels(created[1].ID, job.Labels{"team": "defi"}, http.StatusOK)
+	assert.Equal(t, map[string]string{"team": "defi"}, updated.Labels)
+	assert.Len(t, listJobs("team=defi"), 2)
+
+	patchLabels(created[1].ID, job.Labels{"asset-pair": "ETH-USD"}, http.StatusUnprocessableEntity)
+	patchLabels("999999999", job.Labels{"team": "defi"}, http.StatusNotFound)
+}
+
 func runOCRJobSpecAssertions(t *testing.T, ocrJobSpecFromFileDB job.Job, ocrJobSpecFromServer presenters.JobResource) {
 	ocrJobSpecFromFile := ocrJobSpecFromFileDB.OffchainreportingOracleSpec
 	assert.Equal(t, ocrJobSpecFromFile.ContractAddress, ocrJobSpecFromServer.OffChainReportingSpec.ContractAddress)
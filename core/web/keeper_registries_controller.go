@@ -0,0 +1,43 @@
+package web
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/core/services/keeper"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+// KeeperRegistriesController exposes the registries monitored by keeper jobs
+type KeeperRegistriesController struct {
+	App chainlink.Application
+}
+
+// Index lists the registries of every keeper job along with their number of
+// upkeeps, optionally only those of the job with the `jobID` query param
+// Example:
+// "GET <application>/keeper/registries?jobID=1"
+func (krc *KeeperRegistriesController) Index(c *gin.Context) {
+	var jobID *int32
+	if param := c.Query("jobID"); param != "" {
+		id, err := strconv.ParseInt(param, 10, 32)
+		if err != nil || id <= 0 {
+			jsonAPIError(c, http.StatusUnprocessableEntity, errors.New("jobID must be a positive integer"))
+			return
+		}
+		id32 := int32(id)
+		jobID = &id32
+	}
+
+	registries, err := keeper.FindRegistriesWithUpkeepCounts(c.Request.Context(), krc.App.GetStore().DB, jobID)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewKeeperRegistryResources(registries), "keeperRegistries")
+}
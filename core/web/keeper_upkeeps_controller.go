@@ -0,0 +1,45 @@
+package web
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/core/services/keeper"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+// KeeperUpkeepsController exposes the spend and performance accounting of the
+// upkeeps of keeper jobs
+type KeeperUpkeepsController struct {
+	App chainlink.Application
+}
+
+// Index lists the upkeeps of every keeper job along with the gas spent on and
+// LINK earned by performing them, optionally only those of the job with the
+// `jobID` query param
+// Example:
+// "GET <application>/keeper/upkeeps?jobID=1"
+func (kuc *KeeperUpkeepsController) Index(c *gin.Context) {
+	var jobID *int32
+	if param := c.Query("jobID"); param != "" {
+		id, err := strconv.ParseInt(param, 10, 32)
+		if err != nil || id <= 0 {
+			jsonAPIError(c, http.StatusUnprocessableEntity, errors.New("jobID must be a positive integer"))
+			return
+		}
+		id32 := int32(id)
+		jobID = &id32
+	}
+
+	upkeeps, err := keeper.FindUpkeepsWithStats(c.Request.Context(), kuc.App.GetStore().DB, jobID)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewKeeperUpkeepResources(upkeeps), "keeperUpkeeps")
+}
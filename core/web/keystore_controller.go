@@ -0,0 +1,115 @@
+package web
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/core/services/keystore"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+// KeyStoreController manages the keystore as a whole
+type KeyStoreController struct {
+	App chainlink.Application
+}
+
+// ChangeKeyStorePasswordRequest defines the request to change the keystore
+// password. The VRF passwords may be left out as long as no VRF keys exist.
+type ChangeKeyStorePasswordRequest struct {
+	OldPassword    string `json:"oldPassword"`
+	NewPassword    string `json:"newPassword"`
+	OldVRFPassword string `json:"oldVRFPassword,omitempty"`
+	NewVRFPassword string `json:"newVRFPassword,omitempty"`
+}
+
+// ChangePassword re-encrypts all keys and secrets of the keystore with a new
+// password, and VRF keys with a new VRF password, which the node must be
+// started with from then on
+// Example:
+// "PATCH <application>/keys/password"
+func (ksc *KeyStoreController) ChangePassword(c *gin.Context) {
+	var request ChangeKeyStorePasswordRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	if request.NewPassword == "" {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.New("new password must not be empty"))
+		return
+	}
+	var vrfPasswords *keystore.VRFPasswords
+	if request.OldVRFPassword != "" || request.NewVRFPassword != "" {
+		if request.OldVRFPassword == "" || request.NewVRFPassword == "" {
+			jsonAPIError(c, http.StatusUnprocessableEntity, errors.New("both the old and new VRF passwords must be given"))
+			return
+		}
+		vrfPasswords = &keystore.VRFPasswords{Old: request.OldVRFPassword, New: request.NewVRFPassword}
+	}
+
+	err := ksc.App.GetKeyStore().ChangePassword(c.Request.Context(), request.OldPassword, request.NewPassword, vrfPasswords)
+	if errors.Is(err, keystore.ErrWrongPassword) || errors.Is(err, keystore.ErrWrongVRFPassword) {
+		jsonAPIError(c, http.StatusConflict, err)
+		return
+	} else if errors.Is(err, keystore.ErrVRFPasswordRequired) {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	} else if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponseWithStatus(c, nil, "keystore", http.StatusNoContent)
+}
+
+// AuditLog returns the audit log of key material access and signing
+// operations, newest first. It can be filtered by the keyType, keyID,
+// operation and jobID query params, and by time with since and until in
+// RFC3339 format.
+// Example:
+// "GET <application>/keys/audit?keyType=eth&since=2021-01-01T00:00:00Z"
+func (ksc *KeyStoreController) AuditLog(c *gin.Context, size, page, offset int) {
+	filter := keystore.KeyAccessFilter{
+		KeyType:   keystore.KeyType(c.Query("keyType")),
+		KeyID:     c.Query("keyID"),
+		Operation: keystore.KeyOperation(c.Query("operation")),
+	}
+	if jobID := c.Query("jobID"); jobID != "" {
+		id, err := strconv.ParseInt(jobID, 10, 32)
+		if err != nil {
+			jsonAPIError(c, http.StatusUnprocessableEntity, errors.Wrap(err, "invalid jobID"))
+			return
+		}
+		filter.JobID = null.IntFrom(id)
+	}
+	var err error
+	if filter.Since, err = queryTime(c, "since"); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	if filter.Until, err = queryTime(c, "until"); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	accesses, count, err := ksc.App.GetKeyStore().KeyAccesses(filter, offset, size)
+	paginatedResponse(c, "keyAccesses", size, page, presenters.NewKeyAccessResources(accesses), count, err)
+}
+
+// queryTime parses the RFC3339 time of the query param name, if it is set
+func queryTime(c *gin.Context, name string) (null.Time, error) {
+	value := c.Query(name)
+	if value == "" {
+		return null.Time{}, nil
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return null.Time{}, errors.Wrapf(err, "invalid %s", name)
+	}
+	return null.TimeFrom(t), nil
+}
@@ -113,7 +113,12 @@ func (ocrkc *OCRKeysController) Export(c *gin.Context) {
 		return
 	}
 	newPassword := c.Query("newpassword")
-	bytes, err := ocrkc.App.GetKeyStore().OCR().ExportOCRKeyBundle(id, newPassword)
+	scryptParams, err := exportScryptParams(c, ocrkc.App.GetConfig())
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	bytes, err := ocrkc.App.GetKeyStore().OCR().ExportOCRKeyBundle(keyStoreContext(c, "export"), id, newPassword, scryptParams)
 	if err != nil {
 		jsonAPIError(c, http.StatusInternalServerError, err)
 		return
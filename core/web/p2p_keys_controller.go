@@ -115,7 +115,12 @@ func (p2pkc *P2PKeysController) Export(c *gin.Context) {
 	}
 	id := int32(id64)
 	newPassword := c.Query("newpassword")
-	bytes, err := p2pkc.App.GetKeyStore().OCR().ExportP2PKey(id, newPassword)
+	scryptParams, err := exportScryptParams(c, p2pkc.App.GetConfig())
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	bytes, err := p2pkc.App.GetKeyStore().OCR().ExportP2PKey(keyStoreContext(c, "export"), id, newPassword, scryptParams)
 	if err != nil {
 		jsonAPIError(c, http.StatusInternalServerError, err)
 		return
@@ -3,12 +3,15 @@ package web
 import (
 	"context"
 	"net/http"
+	"strconv"
 
 	"github.com/gin-gonic/gin"
 	"github.com/pkg/errors"
 	"github.com/smartcontractkit/chainlink/core/services/chainlink"
 	"github.com/smartcontractkit/chainlink/core/services/job"
 	"github.com/smartcontractkit/chainlink/core/store/orm"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+	"gopkg.in/guregu/null.v4"
 )
 
 // PipelineJobSpecErrorsController manages PipelineJobSpecError requests
@@ -16,6 +19,132 @@ type PipelineJobSpecErrorsController struct {
 	App chainlink.Application
 }
 
+// Index lists the errors of all jobs, the most recent first. They can be
+// filtered with the query params:
+//
+//	jobID: the ID of the job
+//	severity: error or warning
+//	since: the RFC3339 time at or after which the errors last occurred
+//	until: the RFC3339 time before which the errors first occurred
+//	minOccurrences: the minimum number of occurrences
+//
+// Example:
+// "GET <application>/pipeline/job_spec_errors?severity=error&minOccurrences=10"
+func (psec *PipelineJobSpecErrorsController) Index(c *gin.Context, size, page, offset int) {
+	filter, err := querySpecErrorFilter(c)
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	specErrors, count, err := psec.App.JobORM().SpecErrors(filter, offset, size)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	paginatedResponse(c, "jobSpecErrors", size, page, presenters.NewJobSpecErrorResources(specErrors), count, err)
+}
+
+// Summaries aggregates the errors of all jobs by description, so that an
+// error of many jobs is listed once. It takes the same filters as Index.
+// Example:
+// "GET <application>/pipeline/job_spec_error_summaries?since=2021-09-01T00:00:00Z"
+func (psec *PipelineJobSpecErrorsController) Summaries(c *gin.Context) {
+	filter, err := querySpecErrorFilter(c)
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	summaries, err := psec.App.JobORM().SpecErrorSummaries(filter)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewJobSpecErrorSummaryResources(summaries), "jobSpecErrorSummaries")
+}
+
+// BulkDismissJobSpecErrorsRequest is the request body for dismissing multiple
+// job spec errors. The errors are selected by IDs and/or the same filters as
+// Index, at least one of which must be set.
+type BulkDismissJobSpecErrorsRequest struct {
+	IDs            []int64   `json:"ids"`
+	JobID          *int32    `json:"jobID"`
+	Severity       string    `json:"severity"`
+	Since          null.Time `json:"since"`
+	Until          null.Time `json:"until"`
+	MinOccurrences uint      `json:"minOccurrences"`
+}
+
+// BulkDismiss deletes multiple PipelineJobSpecError records from the
+// database, effectively silencing the error notifications
+// Example:
+// "POST <application>/pipeline/bulk_dismiss_job_spec_errors"
+func (psec *PipelineJobSpecErrorsController) BulkDismiss(c *gin.Context) {
+	request := BulkDismissJobSpecErrorsRequest{}
+	if err := c.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	filter := job.SpecErrorFilter{
+		IDs:            request.IDs,
+		JobID:          request.JobID,
+		Severity:       job.SpecErrorSeverity(request.Severity),
+		Since:          request.Since,
+		Until:          request.Until,
+		MinOccurrences: request.MinOccurrences,
+	}
+	if filter.Severity != "" && !filter.Severity.IsValid() {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.Errorf("invalid severity %s", filter.Severity))
+		return
+	}
+	if filter.IsEmpty() {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.New("must select the job spec errors to dismiss"))
+		return
+	}
+
+	if _, err := psec.App.JobORM().DismissErrors(c.Request.Context(), filter); err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponseWithStatus(c, nil, "job", http.StatusNoContent)
+}
+
+func querySpecErrorFilter(c *gin.Context) (filter job.SpecErrorFilter, err error) {
+	if value := c.Query("jobID"); value != "" {
+		jobID, err := strconv.ParseInt(value, 10, 32)
+		if err != nil {
+			return filter, errors.Wrap(err, "invalid jobID")
+		}
+		id := int32(jobID)
+		filter.JobID = &id
+	}
+	if value := c.Query("severity"); value != "" {
+		filter.Severity = job.SpecErrorSeverity(value)
+		if !filter.Severity.IsValid() {
+			return filter, errors.Errorf("invalid severity %s", value)
+		}
+	}
+	if filter.Since, err = queryTime(c, "since"); err != nil {
+		return filter, err
+	}
+	if filter.Until, err = queryTime(c, "until"); err != nil {
+		return filter, err
+	}
+	if value := c.Query("minOccurrences"); value != "" {
+		minOccurrences, err := strconv.ParseUint(value, 10, 32)
+		if err != nil {
+			return filter, errors.Wrap(err, "invalid minOccurrences")
+		}
+		filter.MinOccurrences = uint(minOccurrences)
+	}
+	return filter, nil
+}
+
 // Destroy deletes a PipelineJobSpecError record from the database, effectively
 // silencing the error notification
 func (psec *PipelineJobSpecErrorsController) Destroy(c *gin.Context) {
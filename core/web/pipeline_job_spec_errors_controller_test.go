@@ -1,12 +1,17 @@
 package web_test
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"testing"
 
 	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/services/job"
+	"github.com/smartcontractkit/chainlink/core/web"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -42,3 +47,49 @@ func TestPipelineJobSpecErrorsController_Delete_NotFound(t *testing.T) {
 
 	assert.Equal(t, http.StatusNotFound, resp.StatusCode, "Response should be not found")
 }
+
+func TestPipelineJobSpecErrorsController_IndexAndBulkDismiss(t *testing.T) {
+	app, client, _, jID, _, jID2 := setupJobSpecsControllerTestsWithJobs(t)
+
+	ctx := context.Background()
+	app.JobORM().RecordError(ctx, jID, "Error polling")
+	app.JobORM().RecordError(ctx, jID2, "Error polling")
+	app.JobORM().RecordWarning(ctx, jID, "Answer is outside acceptable range")
+
+	resp, cleanup := client.Get(fmt.Sprintf("/v2/pipeline/job_spec_errors?jobID=%d&severity=error", jID))
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+	var specErrors []presenters.JobSpecErrorResource
+	require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, resp), &specErrors))
+	require.Len(t, specErrors, 1)
+	assert.Equal(t, "Error polling", specErrors[0].Description)
+
+	resp, cleanup = client.Get("/v2/pipeline/job_spec_errors?severity=fatal")
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusUnprocessableEntity)
+
+	resp, cleanup = client.Get("/v2/pipeline/job_spec_error_summaries?severity=error")
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+	var summaries []presenters.JobSpecErrorSummaryResource
+	require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, resp), &summaries))
+	require.Len(t, summaries, 1)
+	assert.Equal(t, int64(2), summaries[0].Jobs)
+
+	body, err := json.Marshal(web.BulkDismissJobSpecErrorsRequest{})
+	require.NoError(t, err)
+	resp, cleanup = client.Post("/v2/pipeline/bulk_dismiss_job_spec_errors", bytes.NewReader(body))
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusUnprocessableEntity)
+
+	body, err = json.Marshal(web.BulkDismissJobSpecErrorsRequest{Severity: "error"})
+	require.NoError(t, err)
+	resp, cleanup = client.Post("/v2/pipeline/bulk_dismiss_job_spec_errors", bytes.NewReader(body))
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusNoContent)
+
+	remaining, count, err := app.JobORM().SpecErrors(job.SpecErrorFilter{}, 0, 10)
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+	assert.Equal(t, job.SpecErrorSeverityWarning, remaining[0].Severity)
+}
@@ -2,22 +2,29 @@ package web
 
 import (
 	"context"
+	"database/sql"
 	"errors"
+	"fmt"
 	"io/ioutil"
 	"net/http"
 	"strconv"
+	"strings"
 
 	"github.com/smartcontractkit/chainlink/core/web/presenters"
 
 	uuid "github.com/satori/go.uuid"
 
 	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
 
 	"github.com/smartcontractkit/chainlink/core/logger"
 	"github.com/smartcontractkit/chainlink/core/services/chainlink"
 	"github.com/smartcontractkit/chainlink/core/services/job"
+	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
 	"github.com/smartcontractkit/chainlink/core/services/pipeline"
 	"github.com/smartcontractkit/chainlink/core/services/webhook"
+	"github.com/smartcontractkit/chainlink/core/store/models"
+	"github.com/smartcontractkit/chainlink/core/utils"
 )
 
 // PipelineRunsController manages V2 job run requests.
@@ -25,34 +32,83 @@ type PipelineRunsController struct {
 	App chainlink.Application
 }
 
-// Index returns all pipeline runs for a job.
+// pipelineRunsSearchParams are the query params of a runs search, which
+// pages by cursor
+var pipelineRunsSearchParams = []string{"cursor", "state", "since", "until", "jobType", "contractAddress", "error", "labels"}
+
+// Index returns the pipeline runs of all jobs or of a job, the most recent
+// first. Runs are paged by cursor, and may be filtered by:
+//
+//	state: comma separated run states
+//	since: the RFC3339 time at or after which the runs were created
+//	until: the RFC3339 time before which the runs were created
+//	jobType: the type of the runs' jobs
+//	contractAddress: the contract which the runs' jobs watch or transmit to
+//	error: a substring of an error of the runs, ignoring case
+//	labels: comma separated name=value labels which the runs' jobs all have
+//
+// The cursor of the next page is returned in the meta of the response. Paging
+// by offset with the page param is deprecated, as it times out on large runs
+// tables, and can't be combined with the filters.
 // Example:
 // "GET <application>/jobs/:ID/runs"
+// "GET <application>/pipeline/runs?state=errored&jobType=fluxmonitor&error=timeout"
 func (prc *PipelineRunsController) Index(c *gin.Context, size, page, offset int) {
-	id := c.Param("ID")
+	var filter job.PipelineRunsFilter
+	if c.Param("ID") != "" {
+		jobSpec := job.Job{}
+		if err := jobSpec.SetID(c.Param("ID")); err != nil {
+			jsonAPIError(c, http.StatusUnprocessableEntity, err)
+			return
+		}
+		filter.JobID = jobSpec.ID
+	}
 
 	// Temporary: if no size is passed in, use a large page size. Remove once frontend can handle pagination
 	if c.Query("size") == "" {
 		size = 1000
 	}
 
+	if c.Query("page") != "" {
+		prc.indexByOffset(c, filter.JobID, size, page, offset)
+		return
+	}
+
+	filter, after, err := queryPipelineRunsFilter(c, filter)
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	pipelineRuns, next, err := prc.App.JobORM().SearchPipelineRuns(filter, after, size)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	var nextCursor string
+	if next != nil {
+		nextCursor = next.String()
+	}
+	cursorPaginatedResponse(c, "pipelineRun", presenters.NewPipelineRunResources(pipelineRuns), nextCursor)
+}
+
+func (prc *PipelineRunsController) indexByOffset(c *gin.Context, jobID int32, size, page, offset int) {
+	for _, param := range pipelineRunsSearchParams {
+		if c.Query(param) != "" {
+			jsonAPIError(c, http.StatusUnprocessableEntity, fmt.Errorf("%s can't be combined with page, use cursor pagination instead", param))
+			return
+		}
+	}
+
 	var pipelineRuns []pipeline.Run
 	var count int
 	var err error
-
-	if id == "" {
+	if jobID == 0 {
 		pipelineRuns, count, err = prc.App.JobORM().PipelineRuns(offset, size)
 	} else {
-		jobSpec := job.Job{}
-		err = jobSpec.SetID(c.Param("ID"))
-		if err != nil {
-			jsonAPIError(c, http.StatusUnprocessableEntity, err)
-			return
-		}
-
-		pipelineRuns, count, err = prc.App.JobORM().PipelineRunsByJobID(jobSpec.ID, offset, size)
+		pipelineRuns, count, err = prc.App.JobORM().PipelineRunsByJobID(jobID, offset, size)
 	}
-
 	if err != nil {
 		jsonAPIError(c, http.StatusInternalServerError, err)
 		return
@@ -61,6 +117,52 @@ func (prc *PipelineRunsController) Index(c *gin.Context, size, page, offset int)
 	paginatedResponse(c, "pipelineRun", size, page, presenters.NewPipelineRunResources(pipelineRuns), count, err)
 }
 
+func queryPipelineRunsFilter(c *gin.Context, filter job.PipelineRunsFilter) (_ job.PipelineRunsFilter, after *job.PipelineRunsCursor, err error) {
+	if value := c.Query("cursor"); value != "" {
+		cursor, err := job.ParsePipelineRunsCursor(value)
+		if err != nil {
+			return filter, nil, err
+		}
+		after = &cursor
+	}
+	if value := c.Query("state"); value != "" {
+		for _, state := range strings.Split(value, ",") {
+			switch status := pipeline.RunStatus(strings.TrimSpace(state)); status {
+			case pipeline.RunStatusRunning, pipeline.RunStatusSuspended, pipeline.RunStatusErrored, pipeline.RunStatusCompleted:
+				filter.States = append(filter.States, status)
+			default:
+				return filter, nil, fmt.Errorf("invalid state %s", state)
+			}
+		}
+	}
+	if filter.Since, err = queryTime(c, "since"); err != nil {
+		return filter, nil, err
+	}
+	if filter.Until, err = queryTime(c, "until"); err != nil {
+		return filter, nil, err
+	}
+	if value := c.Query("jobType"); value != "" {
+		filter.JobType = job.Type(value)
+		if !filter.JobType.IsValid() {
+			return filter, nil, fmt.Errorf("invalid jobType %s", value)
+		}
+	}
+	if value := c.Query("contractAddress"); value != "" {
+		address, err := ethkey.NewEIP55Address(value)
+		if err != nil {
+			return filter, nil, err
+		}
+		filter.ContractAddress = &address
+	}
+	filter.ErrorContains = c.Query("error")
+	if value := c.Query("labels"); value != "" {
+		if filter.Labels, err = job.ParseLabels(value); err != nil {
+			return filter, nil, err
+		}
+	}
+	return filter, after, nil
+}
+
 // Show returns a specified pipeline run.
 // Example:
 // "GET <application>/jobs/:ID/runs/:runID"
@@ -113,11 +215,24 @@ func (prc *PipelineRunsController) Create(c *gin.Context) {
 			jsonAPIError(c, http.StatusInternalServerError, err2)
 			return
 		}
+		if canRun && !isUser && ei != nil {
+			err2 = webhook.NewSignatureVerifier(prc.App.GetStore().DB).Verify(c.Request.Context(), *ei, c.Request.Header, bodyBytes)
+			if err2 != nil {
+				jsonAPIError(c, http.StatusUnauthorized, err2)
+				return
+			}
+		}
 		if canRun {
 			jobRunID, err3 := prc.App.RunWebhookJobV2(c.Request.Context(), jobUUID, string(bodyBytes), pipeline.JSONSerializable{Null: true})
 			if errors.Is(err3, webhook.ErrJobNotExists) {
 				jsonAPIError(c, http.StatusNotFound, err3)
 				return
+			} else if errors.Is(err3, webhook.ErrRunInProgress) {
+				jsonAPIError(c, http.StatusConflict, err3)
+				return
+			} else if errors.Is(err3, chainlink.ErrDraining) {
+				jsonAPIError(c, http.StatusServiceUnavailable, err3)
+				return
 			} else if err3 != nil {
 				jsonAPIError(c, http.StatusInternalServerError, err3)
 				return
@@ -137,7 +252,10 @@ func (prc *PipelineRunsController) Create(c *gin.Context) {
 		if err == nil {
 			jobID = int32(jobID64)
 			jobRunID, err := prc.App.RunJobV2(c.Request.Context(), jobID, nil)
-			if err != nil {
+			if errors.Is(err, chainlink.ErrDraining) {
+				jsonAPIError(c, http.StatusServiceUnavailable, err)
+				return
+			} else if err != nil {
 				jsonAPIError(c, http.StatusInternalServerError, err)
 				return
 			}
@@ -149,16 +267,112 @@ func (prc *PipelineRunsController) Create(c *gin.Context) {
 	jsonAPIError(c, http.StatusUnprocessableEntity, errors.New("bad job ID"))
 }
 
-// Resume finishes a task and resumes the pipeline run.
+// PipelinePreviewRequest is the body of a request to preview a pipeline
+type PipelinePreviewRequest struct {
+	DotDagSource string                 `json:"dotDagSource"`
+	Vars         map[string]interface{} `json:"vars"`
+	InputSchema  pipeline.VarsSchema    `json:"inputSchema"`
+}
+
+// Preview executes a pipeline with the given vars without saving the run,
+// returning the results of all of its tasks. Tasks which would submit
+// transactions or wait for async bridges are rejected.
 // Example:
-// "PATCH <application>/jobs/:ID/runs/:runID"
-func (prc *PipelineRunsController) Resume(c *gin.Context) {
+// "POST <application>/pipeline/preview"
+func (prc *PipelineRunsController) Preview(c *gin.Context) {
+	var request PipelinePreviewRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(c, http.StatusBadRequest, err)
+		return
+	}
+	if err := request.InputSchema.Validate(); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	p, err := pipeline.Parse(request.DotDagSource)
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	for _, task := range p.Tasks {
+		switch t := task.(type) {
+		case *pipeline.ETHTxTask:
+			jsonAPIError(c, http.StatusUnprocessableEntity, fmt.Errorf("task %s: %s tasks cannot be previewed", t.DotID(), t.Type()))
+			return
+		case *pipeline.BridgeTask:
+			if t.Async == "true" {
+				jsonAPIError(c, http.StatusUnprocessableEntity, fmt.Errorf("task %s: async bridge tasks cannot be previewed", t.DotID()))
+				return
+			}
+		}
+	}
+
+	spec := pipeline.Spec{
+		DotDagSource: request.DotDagSource,
+		InputSchema:  request.InputSchema,
+	}
+	run, _, err := prc.App.PipelineRunner().ExecuteRun(c.Request.Context(), spec, pipeline.NewVarsFrom(request.Vars), *logger.Default)
+	if errors.Is(err, pipeline.ErrInvalidVars) {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	} else if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewPipelineRunResource(run), "pipelineRun")
+}
+
+// ResumeBridgeTask finishes a pending async bridge task with the result in
+// the request body, and resumes the pipeline run from it. runID is the ID of
+// the task run, as given in the responseURL sent to the bridge. The bridge
+// authenticates with its incoming token, as when resuming v1 job runs.
+// Example:
+// "PATCH <application>/pipeline/runs/:runID/resume"
+// "PATCH <application>/resume/:runID", for bridges which were sent its URL
+// before upgrading
+func (prc *PipelineRunsController) ResumeBridgeTask(c *gin.Context) {
 	taskID, err := uuid.FromString(c.Param("runID"))
 	if err != nil {
 		jsonAPIError(c, http.StatusUnprocessableEntity, err)
 		return
 	}
 
+	taskRun, err := prc.App.PipelineORM().FindPendingTaskRun(taskID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		jsonAPIError(c, http.StatusNotFound, errors.New("pending task run not found"))
+		return
+	} else if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	bridgeName, err := taskRun.BridgeName()
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	taskType, err := models.NewTaskType(bridgeName)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	bt, err := prc.App.GetStore().FindBridge(taskType)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	ok, err := models.AuthenticateBridgeType(&bt, utils.StripBearer(c.Request.Header.Get("Authorization")))
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	} else if !ok {
+		c.AbortWithStatus(http.StatusUnauthorized)
+		return
+	}
+
 	bodyBytes, err := ioutil.ReadAll(c.Request.Body)
 	if err != nil {
 		jsonAPIError(c, http.StatusUnprocessableEntity, err)
@@ -172,8 +386,10 @@ func (prc *PipelineRunsController) Resume(c *gin.Context) {
 	}
 
 	run, start, err := prc.App.PipelineORM().UpdateTaskRunResult(sqlDB, taskID, bodyBytes)
-
-	if err != nil {
+	if errors.Is(err, sql.ErrNoRows) {
+		jsonAPIError(c, http.StatusNotFound, errors.New("pending task run not found"))
+		return
+	} else if err != nil {
 		jsonAPIError(c, http.StatusInternalServerError, err)
 		return
 	}
@@ -189,3 +405,100 @@ func (prc *PipelineRunsController) Resume(c *gin.Context) {
 
 	c.Status(http.StatusOK)
 }
+
+// Stream upgrades to a WebSocket and sends the result of each task as it
+// finishes, for all runs of a job or for a single run, followed by the state
+// of each run once it is saved. A single run's stream is closed once the run
+// has finished.
+// Example:
+// "GET <application>/jobs/:ID/runs/stream"
+// "GET <application>/jobs/:ID/runs/:runID/stream"
+func (prc *PipelineRunsController) Stream(c *gin.Context) {
+	jobSpec := job.Job{}
+	if err := jobSpec.SetID(c.Param("ID")); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	filter := pipeline.RunUpdatesFilter{JobID: jobSpec.ID}
+	pipelineRun := pipeline.Run{}
+	if c.Param("runID") != "" {
+		if err := pipelineRun.SetID(c.Param("runID")); err != nil {
+			jsonAPIError(c, http.StatusUnprocessableEntity, err)
+			return
+		}
+		filter.RunID = pipelineRun.ID
+	}
+
+	// subscribe before reading the run so that no updates are missed
+	sub := prc.App.PipelineRunner().SubscribeToRunUpdates(filter)
+	defer sub.Close()
+
+	// updates already made to a single run are sent first
+	var initial []pipeline.RunUpdate
+	if filter.RunID != 0 {
+		var err error
+		pipelineRun, err = prc.App.PipelineORM().FindRun(pipelineRun.ID)
+		if errors.Is(err, gorm.ErrRecordNotFound) || (err == nil && pipelineRun.PipelineSpec.JobID != jobSpec.ID) {
+			jsonAPIError(c, http.StatusNotFound, errors.New("pipeline run not found"))
+			return
+		} else if err != nil {
+			jsonAPIError(c, http.StatusInternalServerError, err)
+			return
+		}
+		for i := range pipelineRun.PipelineTaskRuns {
+			if pipelineRun.PipelineTaskRuns[i].FinishedAt.Valid {
+				initial = append(initial, pipeline.RunUpdate{
+					RunID:   pipelineRun.ID,
+					JobID:   jobSpec.ID,
+					State:   pipeline.RunStatusRunning,
+					TaskRun: &pipelineRun.PipelineTaskRuns[i],
+				})
+			}
+		}
+		if pipelineRun.FinishedAt.Valid {
+			initial = append(initial, pipeline.RunUpdate{
+				RunID:      pipelineRun.ID,
+				JobID:      jobSpec.ID,
+				State:      pipelineRun.State,
+				FinishedAt: pipelineRun.FinishedAt,
+			})
+		}
+	}
+
+	stream, err := newWebsocketStream(c)
+	if err != nil {
+		logger.Debugw("PipelineRunsController: failed to upgrade to a websocket", "err", err)
+		return
+	}
+	defer logger.ErrorIfCalling(stream.Close)
+
+	// send returns false once the stream is over
+	send := func(update pipeline.RunUpdate) bool {
+		if err := stream.Send(presenters.NewPipelineRunUpdateResource(update)); err != nil {
+			logger.Debugw("PipelineRunsController: failed to send pipeline run update", "err", err)
+			return false
+		}
+		if filter.RunID != 0 && update.TaskRun == nil && update.FinishedAt.Valid {
+			_ = stream.Finish("pipeline run finished")
+			return false
+		}
+		return true
+	}
+
+	for _, update := range initial {
+		if !send(update) {
+			return
+		}
+	}
+
+	for {
+		select {
+		case update, ok := <-sub.Updates():
+			if !ok || !send(update) {
+				return
+			}
+		case <-stream.Closed():
+			return
+		}
+	}
+}
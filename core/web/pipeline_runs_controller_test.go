@@ -1,11 +1,14 @@
 package web_test
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
 	"net/http"
+	"net/http/httptest"
+	"net/url"
 	"strconv"
 	"strings"
 	"testing"
@@ -13,15 +16,23 @@ import (
 
 	"github.com/smartcontractkit/chainlink/core/web/presenters"
 
+	"github.com/gorilla/websocket"
+	"github.com/manyminds/api2go/jsonapi"
+	"github.com/onsi/gomega"
 	"github.com/pelletier/go-toml"
 	uuid "github.com/satori/go.uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"gopkg.in/guregu/null.v4"
 
+	"github.com/smartcontractkit/chainlink/core/auth"
 	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/logger"
 	"github.com/smartcontractkit/chainlink/core/services/job"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
 	"github.com/smartcontractkit/chainlink/core/services/webhook"
+	"github.com/smartcontractkit/chainlink/core/static"
+	"github.com/smartcontractkit/chainlink/core/store/models"
 	"github.com/smartcontractkit/chainlink/core/web"
 )
 
@@ -162,8 +173,165 @@ func TestPipelineRunsController_CreateNoBody_HappyPath(t *testing.T) {
 	}
 }
 
+func TestPipelineRunsController_Create_SignedByExternalInitiator(t *testing.T) {
+	t.Parallel()
+
+	ethClient, _, assertMocksCalled := cltest.NewEthMocksWithStartupAssertions(t)
+	defer assertMocksCalled()
+	app, cleanup := cltest.NewApplication(t,
+		ethClient,
+	)
+	defer cleanup()
+	app.Config.Set("FEATURE_EXTERNAL_INITIATORS", true)
+	app.Config.Set("TRIGGER_FALLBACK_DB_POLL_INTERVAL", "10ms")
+	require.NoError(t, app.Start())
+
+	eia := auth.NewToken()
+	ei, err := models.NewExternalInitiator(eia, &models.ExternalInitiatorRequest{Name: "signer"})
+	require.NoError(t, err)
+	require.NoError(t, app.Store.CreateExternalInitiator(ei))
+
+	jb, err := webhook.ValidatedWebhookSpec(fmt.Sprintf(`
+type               = "webhook"
+schemaVersion      = 1
+externalInitiators = [{ name = "%s", spec = '{}' }]
+observationSource  = """
+    multiply [type=multiply input="$(jobRun.requestData.amount)" times=100];
+"""
+`, ei.Name), app.GetExternalInitiatorManager())
+	require.NoError(t, err)
+	jb, err = app.AddJobV2(context.Background(), jb, null.String{})
+	require.NoError(t, err)
+
+	// Give the job.Spawner ample time to discover the job and start its service
+	time.Sleep(3 * time.Second)
+
+	url := app.Config.ClientNodeURL() + "/v2/jobs/" + jb.ExternalJobID.String() + "/runs"
+	body := `{"amount":"1.5"}`
+	headers := map[string]string{
+		static.ExternalInitiatorAccessKeyHeader: eia.AccessKey,
+		static.ExternalInitiatorSecretHeader:    eia.Secret,
+	}
+
+	t.Run("rejects unsigned triggers", func(t *testing.T) {
+		resp, cleanup := cltest.UnauthenticatedPost(t, url, bytes.NewBufferString(body), headers)
+		defer cleanup()
+		assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+	})
+
+	signedAt := time.Now().Unix()
+	headers[static.ExternalInitiatorTimestampHeader] = strconv.FormatInt(signedAt, 10)
+	headers[static.ExternalInitiatorNonceHeader] = "nonce"
+	headers[static.ExternalInitiatorSignatureHeader] = webhook.Sign(ei.SigningSecret, signedAt, "nonce", []byte(body))
+
+	t.Run("runs signed triggers with the payload as vars", func(t *testing.T) {
+		resp, cleanup := cltest.UnauthenticatedPost(t, url, bytes.NewBufferString(body), headers)
+		defer cleanup()
+		cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+		var run presenters.PipelineRunResource
+		require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, resp), &run))
+		require.Len(t, run.Outputs, 1)
+		require.NotNil(t, run.Outputs[0])
+		assert.Equal(t, "150", *run.Outputs[0])
+	})
+
+	t.Run("rejects replayed triggers", func(t *testing.T) {
+		resp, cleanup := cltest.UnauthenticatedPost(t, url, bytes.NewBufferString(body), headers)
+		defer cleanup()
+		assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+	})
+}
+
+func TestPipelineRunsController_ResumeBridgeTask(t *testing.T) {
+	t.Parallel()
+
+	ethClient, _, assertMocksCalled := cltest.NewEthMocksWithStartupAssertions(t)
+	defer assertMocksCalled()
+	app, cleanup := cltest.NewApplication(t,
+		ethClient,
+	)
+	defer cleanup()
+	app.Config.Set("BRIDGE_RESPONSE_URL", app.Config.ClientNodeURL())
+	require.NoError(t, app.Start())
+
+	// Setup an async bridge which responds that the result is pending
+	var responseURL string
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var request struct {
+			ResponseURL string `json:"responseURL"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&request))
+		responseURL = request.ResponseURL
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"pending": true}`))
+	}))
+	defer mockServer.Close()
+	bta, bridge := cltest.NewBridgeType(t, "async_bridge", mockServer.URL)
+	require.NoError(t, app.Store.DB.Create(bridge).Error)
+
+	spec := `
+	type            = "webhook"
+	schemaVersion   = 1
+	externalJobID   = "0EEC7E1D-D0D2-476C-A1A8-72DFB6633F54"
+	observationSource   = """
+		ds       [type=bridge async=true name="async_bridge"];
+		ds_parse [type=jsonparse path="data,result"];
+		ds -> ds_parse;
+	"""
+	`
+	jb, err := webhook.ValidatedWebhookSpec(spec, app.GetExternalInitiatorManager())
+	require.NoError(t, err)
+	jb, err = app.AddJobV2(context.Background(), jb, null.String{})
+	require.NoError(t, err)
+
+	run := pipeline.NewRun(*jb.PipelineSpec, pipeline.NewVarsFrom(nil))
+	incomplete, err := app.PipelineRunner().Run(context.Background(), &run, *logger.Default, true)
+	require.NoError(t, err)
+	require.True(t, incomplete)
+
+	taskRun := run.ByDotID("ds")
+	require.NotNil(t, taskRun)
+	path := "/v2/pipeline/runs/" + taskRun.ID.String() + "/resume"
+	require.Equal(t, app.Config.ClientNodeURL()+path, responseURL)
+	body := `{"data":{"result":"123.45"}}`
+
+	t.Run("without the bridge's incoming token", func(t *testing.T) {
+		headers := map[string]string{"Authorization": "Bearer wrong"}
+		resp, cleanup := cltest.UnauthenticatedPatch(t, app.Config.ClientNodeURL()+path, strings.NewReader(body), headers)
+		defer cleanup()
+		assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+	})
+
+	t.Run("at the deprecated path without the bridge's incoming token", func(t *testing.T) {
+		resp, cleanup := cltest.UnauthenticatedPatch(t, app.Config.ClientNodeURL()+"/v2/resume/"+taskRun.ID.String(), strings.NewReader(body), nil)
+		defer cleanup()
+		assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+	})
+
+	t.Run("with the bridge's incoming token", func(t *testing.T) {
+		headers := map[string]string{"Authorization": "Bearer " + bta.IncomingToken}
+		resp, cleanup := cltest.UnauthenticatedPatch(t, app.Config.ClientNodeURL()+path, strings.NewReader(body), headers)
+		defer cleanup()
+		cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+		gomega.NewGomegaWithT(t).Eventually(func() pipeline.RunStatus {
+			run, err := app.PipelineORM().FindRun(run.ID)
+			require.NoError(t, err)
+			return run.State
+		}, cltest.DBWaitTimeout, cltest.DBPollingInterval).Should(gomega.Equal(pipeline.RunStatusCompleted))
+	})
+
+	t.Run("once the task is no longer pending", func(t *testing.T) {
+		headers := map[string]string{"Authorization": "Bearer " + bta.IncomingToken}
+		resp, cleanup := cltest.UnauthenticatedPatch(t, app.Config.ClientNodeURL()+path, strings.NewReader(body), headers)
+		defer cleanup()
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	})
+}
+
 func TestPipelineRunsController_Index_GlobalHappyPath(t *testing.T) {
-	client, _, runIDs, cleanup := setupPipelineRunsControllerTests(t)
+	client, _, _, runIDs, cleanup := setupPipelineRunsControllerTests(t)
 	defer cleanup()
 
 	response, cleanup := client.Get("/v2/pipeline/runs")
@@ -186,7 +354,7 @@ func TestPipelineRunsController_Index_GlobalHappyPath(t *testing.T) {
 }
 
 func TestPipelineRunsController_Index_HappyPath(t *testing.T) {
-	client, jobID, runIDs, cleanup := setupPipelineRunsControllerTests(t)
+	client, _, jobID, runIDs, cleanup := setupPipelineRunsControllerTests(t)
 	defer cleanup()
 
 	response, cleanup := client.Get("/v2/jobs/" + fmt.Sprintf("%v", jobID) + "/runs")
@@ -209,7 +377,7 @@ func TestPipelineRunsController_Index_HappyPath(t *testing.T) {
 }
 
 func TestPipelineRunsController_Index_Pagination(t *testing.T) {
-	client, jobID, runIDs, cleanup := setupPipelineRunsControllerTests(t)
+	client, _, jobID, runIDs, cleanup := setupPipelineRunsControllerTests(t)
 	defer cleanup()
 
 	response, cleanup := client.Get("/v2/jobs/" + fmt.Sprintf("%v", jobID) + "/runs?page=1&size=1")
@@ -231,8 +399,76 @@ func TestPipelineRunsController_Index_Pagination(t *testing.T) {
 	require.Len(t, parsedResponse[0].TaskRuns, 0)
 }
 
+func TestPipelineRunsController_Index_Search(t *testing.T) {
+	client, app, jobID, runIDs, cleanup := setupPipelineRunsControllerTests(t)
+	defer cleanup()
+
+	search := func(t *testing.T, path string) ([]presenters.PipelineRunResource, jsonapi.Links, jsonapi.Meta) {
+		response, cleanup := client.Get(path)
+		defer cleanup()
+		cltest.AssertServerResponse(t, response, http.StatusOK)
+
+		var runs []presenters.PipelineRunResource
+		var links jsonapi.Links
+		var meta jsonapi.Meta
+		require.NoError(t, web.ParsePaginatedResponseWithMeta(cltest.ParseResponseBody(t, response), &runs, &links, &meta))
+		return runs, links, meta
+	}
+	runIDsOf := func(runs []presenters.PipelineRunResource) []string {
+		ids := []string{}
+		for _, run := range runs {
+			ids = append(ids, run.ID)
+		}
+		return ids
+	}
+
+	t.Run("pages by cursor", func(t *testing.T) {
+		runs, links, meta := search(t, fmt.Sprintf("/v2/jobs/%v/runs?size=1", jobID))
+		assert.Equal(t, []string{strconv.Itoa(int(runIDs[1]))}, runIDsOf(runs))
+		require.Contains(t, links, web.KeyNextLink)
+		assert.NotEmpty(t, meta["nextCursor"])
+
+		runs, links, meta = search(t, links[web.KeyNextLink].Href)
+		assert.Equal(t, []string{strconv.Itoa(int(runIDs[0]))}, runIDsOf(runs))
+		assert.NotContains(t, links, web.KeyNextLink)
+		assert.NotContains(t, meta, "nextCursor")
+	})
+
+	t.Run("filters", func(t *testing.T) {
+		jb, err := app.JobORM().FindJob(context.Background(), jobID)
+		require.NoError(t, err)
+		all := []string{strconv.Itoa(int(runIDs[1])), strconv.Itoa(int(runIDs[0]))}
+
+		for _, test := range []struct {
+			query    string
+			expected []string
+		}{
+			{"state=completed,errored", all},
+			{"state=errored", []string{}},
+			{"jobType=offchainreporting", all},
+			{"jobType=fluxmonitor", []string{}},
+			{"contractAddress=" + jb.OffchainreportingOracleSpec.ContractAddress.String(), all},
+			{"contractAddress=" + cltest.NewAddress().Hex(), []string{}},
+			{"since=" + time.Now().Add(-time.Hour).Format(time.RFC3339), all},
+			{"until=" + time.Now().Add(-time.Hour).Format(time.RFC3339), []string{}},
+			{"error=timeout", []string{}},
+		} {
+			runs, _, _ := search(t, "/v2/pipeline/runs?"+test.query)
+			assert.Equal(t, test.expected, runIDsOf(runs), test.query)
+		}
+	})
+
+	t.Run("rejects invalid params", func(t *testing.T) {
+		for _, query := range []string{"state=bogus", "jobType=bogus", "contractAddress=0xbogus", "cursor=bogus", "page=1&state=errored"} {
+			response, cleanup := client.Get("/v2/pipeline/runs?" + query)
+			cltest.AssertServerResponse(t, response, http.StatusUnprocessableEntity)
+			cleanup()
+		}
+	})
+}
+
 func TestPipelineRunsController_Show_HappyPath(t *testing.T) {
-	client, jobID, runIDs, cleanup := setupPipelineRunsControllerTests(t)
+	client, _, jobID, runIDs, cleanup := setupPipelineRunsControllerTests(t)
 	defer cleanup()
 
 	response, cleanup := client.Get("/v2/jobs/" + fmt.Sprintf("%v", jobID) + "/runs/" + fmt.Sprintf("%v", runIDs[0]))
@@ -251,6 +487,149 @@ func TestPipelineRunsController_Show_HappyPath(t *testing.T) {
 	require.Len(t, parsedResponse.TaskRuns, 0)
 }
 
+func TestPipelineRunsController_Stream_FinishedRun(t *testing.T) {
+	_, app, jobID, runIDs, cleanup := setupPipelineRunsControllerTests(t)
+	defer cleanup()
+
+	conn := dialPipelineRunsStream(t, app, fmt.Sprintf("/v2/jobs/%v/runs/%v/stream", jobID, runIDs[0]))
+	defer conn.Close()
+
+	var update presenters.PipelineRunUpdateResource
+	require.NoError(t, conn.ReadJSON(&update))
+	assert.Equal(t, strconv.Itoa(int(runIDs[0])), update.RunID)
+	assert.Equal(t, strconv.Itoa(int(jobID)), update.JobID)
+	assert.Equal(t, pipeline.RunStatusCompleted, update.State)
+	assert.Nil(t, update.TaskRun)
+	assert.NotNil(t, update.FinishedAt)
+
+	// the stream is closed once the run has finished
+	_, _, err := conn.ReadMessage()
+	assert.True(t, websocket.IsCloseError(err, websocket.CloseNormalClosure))
+}
+
+func TestPipelineRunsController_Stream_Job(t *testing.T) {
+	_, app, jobID, _, cleanup := setupPipelineRunsControllerTests(t)
+	defer cleanup()
+
+	conn := dialPipelineRunsStream(t, app, fmt.Sprintf("/v2/jobs/%v/runs/stream", jobID))
+	defer conn.Close()
+
+	runID, err := app.RunJobV2(context.Background(), jobID, nil)
+	require.NoError(t, err)
+
+	dotIDs := make(map[string]bool)
+	for {
+		var update presenters.PipelineRunUpdateResource
+		require.NoError(t, conn.SetReadDeadline(time.Now().Add(cltest.DBWaitTimeout)))
+		require.NoError(t, conn.ReadJSON(&update))
+		assert.Equal(t, strconv.Itoa(int(jobID)), update.JobID)
+		if update.TaskRun == nil {
+			assert.Equal(t, strconv.Itoa(int(runID)), update.RunID)
+			assert.Equal(t, pipeline.RunStatusCompleted, update.State)
+			break
+		}
+		dotIDs[update.TaskRun.DotID] = true
+	}
+	assert.Equal(t, map[string]bool{"ds": true, "ds_parse": true, "ds_multiply": true, "answer": true}, dotIDs)
+}
+
+func TestPipelineRunsController_Stream_NotFound(t *testing.T) {
+	client, _, jobID, _, cleanup := setupPipelineRunsControllerTests(t)
+	defer cleanup()
+
+	response, cleanup := client.Get(fmt.Sprintf("/v2/jobs/%v/runs/999999/stream", jobID))
+	defer cleanup()
+	cltest.AssertServerResponse(t, response, http.StatusNotFound)
+}
+
+func dialPipelineRunsStream(t *testing.T, app *cltest.TestApplication, path string) *websocket.Conn {
+	u, err := url.Parse(app.Config.ClientNodeURL() + path)
+	require.NoError(t, err)
+	u.Scheme = "ws"
+
+	header := http.Header{}
+	header.Add("Cookie", cltest.MustGenerateSessionCookie(app.MustSeedNewSession()).String())
+	conn, response, err := websocket.DefaultDialer.Dial(u.String(), header)
+	require.NoError(t, err)
+	require.NoError(t, response.Body.Close())
+	return conn
+}
+
+func TestPipelineRunsController_Preview(t *testing.T) {
+	t.Parallel()
+	ethClient, _, assertMocksCalled := cltest.NewEthMocksWithStartupAssertions(t)
+	defer assertMocksCalled()
+	app, cleanup := cltest.NewApplication(t,
+		ethClient,
+	)
+	defer cleanup()
+	require.NoError(t, app.Start())
+	client := app.NewHTTPClient()
+
+	mockHTTP, cleanupHTTP := cltest.NewHTTPMockServer(t, http.StatusOK, "POST", `{"USD": 2}`)
+	defer cleanupHTTP()
+
+	preview := func(t *testing.T, request web.PipelinePreviewRequest, expectedStatus int) []byte {
+		body, err := json.Marshal(request)
+		require.NoError(t, err)
+		response, cleanup := client.Post("/v2/pipeline/preview", bytes.NewReader(body))
+		defer cleanup()
+		cltest.AssertServerResponse(t, response, expectedStatus)
+		return cltest.ParseResponseBody(t, response)
+	}
+
+	dotDagSource := fmt.Sprintf(`
+		ds          [type=http method=POST url="%s" requestData="$(jobRun.requestBody)"];
+		ds_parse    [type=jsonparse path="USD"];
+		ds_multiply [type=multiply times="$(jobRun.times)"];
+
+		ds -> ds_parse -> ds_multiply;
+	`, mockHTTP.URL)
+
+	t.Run("returns the results of all tasks without saving the run", func(t *testing.T) {
+		responseBytes := preview(t, web.PipelinePreviewRequest{
+			DotDagSource: dotDagSource,
+			Vars: map[string]interface{}{
+				"jobRun": map[string]interface{}{"requestBody": `{"foo": "bar"}`, "times": 3},
+			},
+		}, http.StatusOK)
+
+		var parsedResponse presenters.PipelineRunResource
+		require.NoError(t, web.ParseJSONAPIResponse(responseBytes, &parsedResponse))
+		require.Len(t, parsedResponse.TaskRuns, 3)
+		require.Len(t, parsedResponse.Outputs, 1)
+		assert.Equal(t, "6", *parsedResponse.Outputs[0])
+		for _, tr := range parsedResponse.TaskRuns {
+			assert.Nil(t, tr.Error)
+			assert.NotNil(t, tr.Output)
+		}
+
+		var count int64
+		require.NoError(t, app.Store.DB.Model(&pipeline.Run{}).Count(&count).Error)
+		assert.Zero(t, count)
+	})
+
+	t.Run("rejects vars which do not match the input schema", func(t *testing.T) {
+		preview(t, web.PipelinePreviewRequest{
+			DotDagSource: dotDagSource,
+			Vars: map[string]interface{}{
+				"jobRun": map[string]interface{}{"requestBody": `{"foo": "bar"}`, "times": "three"},
+			},
+			InputSchema: pipeline.VarsSchema{"jobRun.times": "int"},
+		}, http.StatusUnprocessableEntity)
+	})
+
+	t.Run("rejects tasks which would submit transactions", func(t *testing.T) {
+		preview(t, web.PipelinePreviewRequest{
+			DotDagSource: `tx [type=ethtx to="0x0000000000000000000000000000000000000001" data="0x"];`,
+		}, http.StatusUnprocessableEntity)
+	})
+
+	t.Run("rejects invalid DOT", func(t *testing.T) {
+		preview(t, web.PipelinePreviewRequest{DotDagSource: `ds [type=nope];`}, http.StatusUnprocessableEntity)
+	})
+}
+
 func TestPipelineRunsController_ShowRun_InvalidID(t *testing.T) {
 	t.Parallel()
 	ethClient, _, assertMocksCalled := cltest.NewEthMocksWithStartupAssertions(t)
@@ -267,7 +646,7 @@ func TestPipelineRunsController_ShowRun_InvalidID(t *testing.T) {
 	cltest.AssertServerResponse(t, response, http.StatusUnprocessableEntity)
 }
 
-func setupPipelineRunsControllerTests(t *testing.T) (cltest.HTTPClientCleaner, int32, []int64, func()) {
+func setupPipelineRunsControllerTests(t *testing.T) (cltest.HTTPClientCleaner, *cltest.TestApplication, int32, []int64, func()) {
 	t.Parallel()
 	ethClient, _, assertMocksCalled := cltest.NewEthMocksWithStartupAssertions(t)
 	defer assertMocksCalled()
@@ -321,7 +700,7 @@ func setupPipelineRunsControllerTests(t *testing.T) (cltest.HTTPClientCleaner, i
 	secondRunID, err := app.RunJobV2(context.Background(), jb.ID, nil)
 	require.NoError(t, err)
 
-	return client, jb.ID, []int64{firstRunID, secondRunID}, func() {
+	return client, app, jb.ID, []int64{firstRunID, secondRunID}, func() {
 		cleanup()
 		cleanupHTTP()
 	}
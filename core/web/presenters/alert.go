@@ -0,0 +1,134 @@
+package presenters
+
+import (
+	"strconv"
+	"time"
+
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/core/services/alerts"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+// AlertRuleResource represents an alert rule JSONAPI resource
+type AlertRuleResource struct {
+	JAID
+	Name       string            `json:"name"`
+	Type       alerts.RuleType   `json:"type"`
+	Params     alerts.RuleParams `json:"params"`
+	ChannelIDs []int64           `json:"channelIDs"`
+	CreatedAt  time.Time         `json:"createdAt"`
+	UpdatedAt  time.Time         `json:"updatedAt"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r AlertRuleResource) GetName() string {
+	return "alertRules"
+}
+
+// NewAlertRuleResource constructs a new AlertRuleResource
+func NewAlertRuleResource(rule alerts.Rule) AlertRuleResource {
+	channelIDs := []int64{}
+	channelIDs = append(channelIDs, rule.ChannelIDs...)
+	return AlertRuleResource{
+		JAID:       NewJAID(strconv.FormatInt(rule.ID, 10)),
+		Name:       rule.Name,
+		Type:       rule.Type,
+		Params:     rule.Params,
+		ChannelIDs: channelIDs,
+		CreatedAt:  rule.CreatedAt,
+		UpdatedAt:  rule.UpdatedAt,
+	}
+}
+
+// AlertChannelResource represents an alert channel JSONAPI resource
+type AlertChannelResource struct {
+	JAID
+	Name       string             `json:"name"`
+	Type       alerts.ChannelType `json:"type"`
+	URL        string             `json:"url"`
+	Recipients []string           `json:"recipients"`
+	CreatedAt  time.Time          `json:"createdAt"`
+	UpdatedAt  time.Time          `json:"updatedAt"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r AlertChannelResource) GetName() string {
+	return "alertChannels"
+}
+
+// NewAlertChannelResource constructs a new AlertChannelResource. The URL is
+// redacted, since webhook URLs usually carry the token to post to them.
+func NewAlertChannelResource(channel alerts.Channel) AlertChannelResource {
+	recipients := []string{}
+	recipients = append(recipients, channel.Recipients...)
+	var channelURL string
+	if channel.URL != "" {
+		channelURL = utils.RedactURL(channel.URL)
+	}
+	return AlertChannelResource{
+		JAID:       NewJAID(strconv.FormatInt(channel.ID, 10)),
+		Name:       channel.Name,
+		Type:       channel.Type,
+		URL:        channelURL,
+		Recipients: recipients,
+		CreatedAt:  channel.CreatedAt,
+		UpdatedAt:  channel.UpdatedAt,
+	}
+}
+
+// AlertSilenceResource represents an alert silence JSONAPI resource
+type AlertSilenceResource struct {
+	JAID
+	RuleID    null.Int  `json:"ruleID"`
+	Reason    string    `json:"reason"`
+	EndsAt    time.Time `json:"endsAt"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r AlertSilenceResource) GetName() string {
+	return "alertSilences"
+}
+
+// NewAlertSilenceResource constructs a new AlertSilenceResource
+func NewAlertSilenceResource(silence alerts.Silence) AlertSilenceResource {
+	return AlertSilenceResource{
+		JAID:      NewJAID(strconv.FormatInt(silence.ID, 10)),
+		RuleID:    silence.RuleID,
+		Reason:    silence.Reason,
+		EndsAt:    silence.EndsAt,
+		CreatedAt: silence.CreatedAt,
+	}
+}
+
+// AlertResource represents an entry of the alert history JSONAPI resource
+type AlertResource struct {
+	JAID
+	RuleID        null.Int          `json:"ruleID"`
+	RuleName      string            `json:"ruleName"`
+	State         alerts.AlertState `json:"state"`
+	Message       string            `json:"message"`
+	Silenced      bool              `json:"silenced"`
+	DeliveryError null.String       `json:"deliveryError"`
+	CreatedAt     time.Time         `json:"createdAt"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r AlertResource) GetName() string {
+	return "alerts"
+}
+
+// NewAlertResource constructs a new AlertResource
+func NewAlertResource(alert alerts.Alert) AlertResource {
+	return AlertResource{
+		JAID:          NewJAID(strconv.FormatInt(alert.ID, 10)),
+		RuleID:        alert.RuleID,
+		RuleName:      alert.RuleName,
+		State:         alert.State,
+		Message:       alert.Message,
+		Silenced:      alert.Silenced,
+		DeliveryError: alert.DeliveryError,
+		CreatedAt:     alert.CreatedAt,
+	}
+}
@@ -0,0 +1,56 @@
+package presenters
+
+import (
+	"time"
+
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/core/store/models"
+)
+
+// APIAuditLogEntryResource represents an entry of the API audit log JSONAPI
+// resource.
+type APIAuditLogEntryResource struct {
+	JAID
+	UserEmail  string      `json:"userEmail"`
+	Method     string      `json:"method"`
+	Route      string      `json:"route"`
+	Path       string      `json:"path"`
+	Payload    string      `json:"payload"`
+	StatusCode int         `json:"statusCode"`
+	Error      null.String `json:"error"`
+	RemoteAddr string      `json:"remoteAddr"`
+	CreatedAt  time.Time   `json:"createdAt"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (APIAuditLogEntryResource) GetName() string {
+	return "auditLogEntries"
+}
+
+// NewAPIAuditLogEntryResource constructs a new APIAuditLogEntryResource.
+func NewAPIAuditLogEntryResource(entry models.APIAuditLogEntry) *APIAuditLogEntryResource {
+	return &APIAuditLogEntryResource{
+		JAID:       NewJAIDInt64(entry.ID),
+		UserEmail:  entry.UserEmail,
+		Method:     entry.Method,
+		Route:      entry.Route,
+		Path:       entry.Path,
+		Payload:    entry.Payload,
+		StatusCode: entry.StatusCode,
+		Error:      entry.Error,
+		RemoteAddr: entry.RemoteAddr,
+		CreatedAt:  entry.CreatedAt,
+	}
+}
+
+// NewAPIAuditLogEntryResources initializes a slice of JSONAPI API audit log
+// resources
+func NewAPIAuditLogEntryResources(entries []models.APIAuditLogEntry) []APIAuditLogEntryResource {
+	rs := []APIAuditLogEntryResource{}
+	for _, entry := range entries {
+		rs = append(rs, *NewAPIAuditLogEntryResource(entry))
+	}
+
+	return rs
+}
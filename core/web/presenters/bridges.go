@@ -3,6 +3,7 @@ package presenters
 import (
 	"time"
 
+	"github.com/shopspring/decimal"
 	"github.com/smartcontractkit/chainlink/core/assets"
 	"github.com/smartcontractkit/chainlink/core/store/models"
 )
@@ -14,10 +15,13 @@ type BridgeResource struct {
 	URL           string `json:"url"`
 	Confirmations uint32 `json:"confirmations"`
 	// The IncomingToken is only provided when creating a Bridge
-	IncomingToken          string       `json:"incomingToken,omitempty"`
-	OutgoingToken          string       `json:"outgoingToken"`
-	MinimumContractPayment *assets.Link `json:"minimumContractPayment"`
-	CreatedAt              time.Time    `json:"createdAt"`
+	IncomingToken          string          `json:"incomingToken,omitempty"`
+	OutgoingToken          string          `json:"outgoingToken"`
+	MinimumContractPayment *assets.Link    `json:"minimumContractPayment"`
+	CreditCost             decimal.Decimal `json:"creditCost"`
+	MaxConcurrency         uint32          `json:"maxConcurrency"`
+	RateLimit              decimal.Decimal `json:"rateLimit"`
+	CreatedAt              time.Time       `json:"createdAt"`
 }
 
 // GetName implements the api2go EntityNamer interface
@@ -35,6 +39,9 @@ func NewBridgeResource(b models.BridgeType) *BridgeResource {
 		Confirmations:          b.Confirmations,
 		OutgoingToken:          b.OutgoingToken,
 		MinimumContractPayment: b.MinimumContractPayment,
+		CreditCost:             b.CreditCost,
+		MaxConcurrency:         b.MaxConcurrency,
+		RateLimit:              b.RateLimit,
 		CreatedAt:              b.CreatedAt,
 	}
 }
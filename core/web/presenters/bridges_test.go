@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/manyminds/api2go/jsonapi"
+	"github.com/shopspring/decimal"
 	"github.com/smartcontractkit/chainlink/core/assets"
 	"github.com/smartcontractkit/chainlink/core/store/models"
 	"github.com/stretchr/testify/assert"
@@ -25,6 +26,9 @@ func TestBridgeResource(t *testing.T) {
 		Confirmations:          1,
 		OutgoingToken:          "vjNL7X8Ea6GFJoa6PBsvK2ECzNK3b8IZ",
 		MinimumContractPayment: assets.NewLink(1),
+		CreditCost:             decimal.RequireFromString("0.5"),
+		MaxConcurrency:         2,
+		RateLimit:              decimal.RequireFromString("1.5"),
 		CreatedAt:              timestamp,
 	}
 
@@ -44,6 +48,9 @@ func TestBridgeResource(t *testing.T) {
 			"confirmations":1,
 			"outgoingToken":"vjNL7X8Ea6GFJoa6PBsvK2ECzNK3b8IZ",
 			"minimumContractPayment":"1",
+			"creditCost":"0.5",
+			"maxConcurrency":2,
+			"rateLimit":"1.5",
 			"createdAt":"2000-01-01T00:00:00Z"
 		}
 	}
@@ -69,6 +76,9 @@ func TestBridgeResource(t *testing.T) {
 			"incomingToken": "cd+OfGXy3UHEDAlD0y27F6/rJE14X1UI",
 			"outgoingToken":"vjNL7X8Ea6GFJoa6PBsvK2ECzNK3b8IZ",
 			"minimumContractPayment":"1",
+			"creditCost":"0.5",
+			"maxConcurrency":2,
+			"rateLimit":"1.5",
 			"createdAt":"2000-01-01T00:00:00Z"
 		}
 	}
@@ -0,0 +1,30 @@
+package presenters
+
+import (
+	"time"
+
+	"github.com/smartcontractkit/chainlink/core/chains"
+)
+
+// ChainResource represents a chain of the chain registry JSONAPI resource
+type ChainResource struct {
+	JAID
+	Config    chains.ChainOverrides `json:"config"`
+	CreatedAt time.Time             `json:"createdAt"`
+	UpdatedAt time.Time             `json:"updatedAt"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r ChainResource) GetName() string {
+	return "chain"
+}
+
+// NewChainResource constructs a new ChainResource
+func NewChainResource(chain chains.EVMChain) ChainResource {
+	return ChainResource{
+		JAID:      NewJAID(chain.ID.String()),
+		Config:    chain.Cfg,
+		CreatedAt: chain.CreatedAt,
+		UpdatedAt: chain.UpdatedAt,
+	}
+}
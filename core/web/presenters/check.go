@@ -1,14 +1,43 @@
 package presenters
 
-import "github.com/smartcontractkit/chainlink/core/services/health"
+import (
+	"time"
+
+	"github.com/smartcontractkit/chainlink/core/services/health"
+)
 
 type Check struct {
 	JAID
 	Name   string        `json:"name"`
 	Status health.Status `json:"status"`
 	Output string        `json:"output"`
+	// Causes, Since, Duration and Children are only set in the health tree
+	Causes   []string   `json:"causes,omitempty"`
+	Since    *time.Time `json:"since,omitempty"`
+	Duration string     `json:"durationInStatus,omitempty"`
+	Children []Check    `json:"children,omitempty"`
 }
 
 func (c Check) GetName() string {
 	return "checks"
 }
+
+// NewCheckTree presents the health tree, with how long each service has been
+// in its status as of now
+func NewCheckTree(nodes []health.Node, now time.Time) []Check {
+	checks := make([]Check, 0, len(nodes))
+	for _, n := range nodes {
+		since := n.Since
+		checks = append(checks, Check{
+			JAID:     NewJAID(n.Name),
+			Name:     n.Name,
+			Status:   n.Status,
+			Output:   n.Output,
+			Causes:   n.Causes,
+			Since:    &since,
+			Duration: now.Sub(n.Since).Round(time.Second).String(),
+			Children: NewCheckTree(n.Children, now),
+		})
+	}
+	return checks
+}
@@ -0,0 +1,38 @@
+package presenters
+
+import (
+	"github.com/smartcontractkit/chainlink/core/store/config"
+)
+
+// ConfigValueResource represents the effective value of a configuration
+// variable and where it comes from
+type ConfigValueResource struct {
+	JAID
+	Value    string             `json:"value"`
+	Source   config.ValueSource `json:"source"`
+	Redacted bool               `json:"redacted"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r ConfigValueResource) GetName() string {
+	return "configValues"
+}
+
+// NewConfigValueResource constructs a new ConfigValueResource
+func NewConfigValueResource(value config.Value) ConfigValueResource {
+	return ConfigValueResource{
+		JAID:     NewJAID(value.Name),
+		Value:    value.Value,
+		Source:   value.Source,
+		Redacted: value.Redacted,
+	}
+}
+
+// NewConfigValueResources constructs a list of ConfigValueResources
+func NewConfigValueResources(values []config.Value) []ConfigValueResource {
+	rs := []ConfigValueResource{}
+	for _, value := range values {
+		rs = append(rs, NewConfigValueResource(value))
+	}
+	return rs
+}
@@ -9,9 +9,10 @@ import (
 // CSAKeyResource represents a CSA key JSONAPI resource.
 type CSAKeyResource struct {
 	JAID
-	PubKey    string    `json:"publicKey"`
-	CreatedAt time.Time `json:"createdAt"`
-	UpdatedAt time.Time `json:"updatedAt"`
+	PubKey      string    `json:"publicKey"`
+	Fingerprint string    `json:"fingerprint"`
+	CreatedAt   time.Time `json:"createdAt"`
+	UpdatedAt   time.Time `json:"updatedAt"`
 }
 
 // GetName implements the api2go EntityNamer interface
@@ -21,10 +22,11 @@ func (CSAKeyResource) GetName() string {
 
 func NewCSAKeyResource(key csakey.Key) *CSAKeyResource {
 	r := &CSAKeyResource{
-		JAID:      NewJAIDUint(key.ID),
-		PubKey:    key.PublicKey.String(),
-		CreatedAt: key.CreatedAt,
-		UpdatedAt: key.UpdatedAt,
+		JAID:        NewJAIDUint(key.ID),
+		PubKey:      key.PublicKey.String(),
+		Fingerprint: key.Fingerprint(),
+		CreatedAt:   key.CreatedAt,
+		UpdatedAt:   key.UpdatedAt,
 	}
 
 	return r
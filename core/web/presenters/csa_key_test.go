@@ -32,11 +32,12 @@ func TestCSAKeyResource(t *testing.T) {
 			"id":"1",
 			"attributes":{
 				"publicKey": "%s",
+				"fingerprint": "%s",
 				"createdAt":"2000-01-01T00:00:00Z",
 				"updatedAt":"2000-01-01T00:00:00Z"
 			}
 		}
-	}`, key.PublicKey.String())
+	}`, key.PublicKey.String(), key.Fingerprint())
 
 	assert.JSONEq(t, expected, string(b))
 }
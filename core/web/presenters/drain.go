@@ -0,0 +1,41 @@
+package presenters
+
+import (
+	"time"
+
+	"github.com/smartcontractkit/chainlink/core/services/chainlink"
+)
+
+// DrainResource represents the progress of draining the node before it exits
+type DrainResource struct {
+	JAID
+	Draining     bool       `json:"draining"`
+	Drained      bool       `json:"drained"`
+	StartedAt    *time.Time `json:"startedAt"`
+	Deadline     *time.Time `json:"deadline"`
+	ActiveJobs   int        `json:"activeJobs"`
+	InFlightRuns int64      `json:"inFlightRuns"`
+	PendingTxes  uint32     `json:"pendingTxes"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r DrainResource) GetName() string {
+	return "drains"
+}
+
+// NewDrainResource constructs a new DrainResource
+func NewDrainResource(status chainlink.DrainStatus) DrainResource {
+	r := DrainResource{
+		JAID:         NewJAID("drain"),
+		Draining:     status.Draining,
+		Drained:      status.Drained(),
+		ActiveJobs:   status.ActiveJobs,
+		InFlightRuns: status.InFlightRuns,
+		PendingTxes:  status.PendingTxes,
+	}
+	if status.Draining {
+		r.StartedAt = &status.StartedAt
+		r.Deadline = &status.Deadline
+	}
+	return r
+}
@@ -0,0 +1,35 @@
+package presenters
+
+import (
+	"time"
+
+	"github.com/smartcontractkit/chainlink/core/services/bulletprooftxmanager"
+)
+
+// EthForwarderResource represents an allowlisted forwarder JSONAPI resource
+type EthForwarderResource struct {
+	JAID
+	Address    string    `json:"address"`
+	EVMChainID string    `json:"evmChainID,omitempty"`
+	CreatedAt  time.Time `json:"createdAt"`
+	UpdatedAt  time.Time `json:"updatedAt"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r EthForwarderResource) GetName() string {
+	return "ethForwarders"
+}
+
+// NewEthForwarderResource constructs a new EthForwarderResource
+func NewEthForwarderResource(fwd bulletprooftxmanager.EthForwarder) EthForwarderResource {
+	r := EthForwarderResource{
+		JAID:      NewJAIDInt64(fwd.ID),
+		Address:   fwd.Address.Hex(),
+		CreatedAt: fwd.CreatedAt,
+		UpdatedAt: fwd.UpdatedAt,
+	}
+	if fwd.EVMChainID != nil {
+		r.EVMChainID = fwd.EVMChainID.String()
+	}
+	return r
+}
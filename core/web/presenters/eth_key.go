@@ -1,9 +1,11 @@
 package presenters
 
 import (
+	"strconv"
 	"time"
 
 	"github.com/smartcontractkit/chainlink/core/assets"
+	"github.com/smartcontractkit/chainlink/core/services/bulletprooftxmanager"
 	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
 )
 
@@ -11,14 +13,15 @@ import (
 // representation of the address plus its ETH & LINK balances
 type ETHKeyResource struct {
 	JAID
-	Address     string       `json:"address"`
-	EthBalance  *assets.Eth  `json:"ethBalance"`
-	LinkBalance *assets.Link `json:"linkBalance"`
-	NextNonce   int64        `json:"nextNonce"`
-	IsFunding   bool         `json:"isFunding"`
-	CreatedAt   time.Time    `json:"createdAt"`
-	UpdatedAt   time.Time    `json:"updatedAt"`
-	DeletedAt   *time.Time   `json:"deletedAt"`
+	Address     string        `json:"address"`
+	EthBalance  *assets.Eth   `json:"ethBalance"`
+	LinkBalance *assets.Link  `json:"linkBalance"`
+	NextNonce   int64         `json:"nextNonce"`
+	IsFunding   bool          `json:"isFunding"`
+	Policy      ethkey.Policy `json:"policy"`
+	CreatedAt   time.Time     `json:"createdAt"`
+	UpdatedAt   time.Time     `json:"updatedAt"`
+	DeletedAt   *time.Time    `json:"deletedAt"`
 }
 
 // GetName implements the api2go EntityNamer interface
@@ -44,6 +47,7 @@ func NewETHKeyResource(k ethkey.Key, opts ...NewETHKeyOption) (*ETHKeyResource,
 		LinkBalance: nil,
 		NextNonce:   k.NextNonce,
 		IsFunding:   k.IsFunding,
+		Policy:      k.Policy(),
 		CreatedAt:   k.CreatedAt,
 		UpdatedAt:   k.UpdatedAt,
 	}
@@ -78,3 +82,147 @@ func SetETHKeyLinkBalance(linkBalance *assets.Link) NewETHKeyOption {
 		return nil
 	}
 }
+
+// NonceReconciliationResource represents the report of reconciling the nonces
+// of an ETH key with the chain
+type NonceReconciliationResource struct {
+	JAID
+	Address             string   `json:"address"`
+	ChainNonce          uint64   `json:"chainNonce"`
+	PendingChainNonce   uint64   `json:"pendingChainNonce"`
+	LocalNextNonce      int64    `json:"localNextNonce"`
+	FastForwardedTo     *int64   `json:"fastForwardedTo"`
+	AbandonedEthTxIDs   []string `json:"abandonedEthTxIDs"`
+	RebroadcastEthTxIDs []string `json:"rebroadcastEthTxIDs"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r NonceReconciliationResource) GetName() string {
+	return "nonceReconciliations"
+}
+
+// NewNonceReconciliationResource constructs a new NonceReconciliationResource
+func NewNonceReconciliationResource(report bulletprooftxmanager.NonceReconciliationReport) *NonceReconciliationResource {
+	r := &NonceReconciliationResource{
+		JAID:                NewJAID(report.Address.Hex()),
+		Address:             report.Address.Hex(),
+		ChainNonce:          report.ChainNonce,
+		PendingChainNonce:   report.PendingChainNonce,
+		LocalNextNonce:      report.LocalNextNonce,
+		AbandonedEthTxIDs:   []string{},
+		RebroadcastEthTxIDs: []string{},
+	}
+	if report.FastForwardedTo.Valid {
+		r.FastForwardedTo = &report.FastForwardedTo.Int64
+	}
+	for _, id := range report.AbandonedEthTxIDs {
+		r.AbandonedEthTxIDs = append(r.AbandonedEthTxIDs, strconv.FormatInt(id, 10))
+	}
+	for _, id := range report.RebroadcastEthTxIDs {
+		r.RebroadcastEthTxIDs = append(r.RebroadcastEthTxIDs, strconv.FormatInt(id, 10))
+	}
+
+	return r
+}
+
+// DiagnosisIssueResource is an issue found when diagnosing an ETH key
+type DiagnosisIssueResource struct {
+	Kind        string   `json:"kind"`
+	EthTxIDs    []string `json:"ethTxIDs"`
+	Description string   `json:"description"`
+	Remediation string   `json:"remediation"`
+}
+
+// KeyDiagnosisResource represents the diagnosis of the unconfirmed
+// transactions of an ETH key
+type KeyDiagnosisResource struct {
+	JAID
+	Address             string                   `json:"address"`
+	ChainNonce          uint64                   `json:"chainNonce"`
+	PendingChainNonce   uint64                   `json:"pendingChainNonce"`
+	LocalNextNonce      int64                    `json:"localNextNonce"`
+	BalanceWei          string                   `json:"balanceWei"`
+	GasPriceWei         *string                  `json:"gasPriceWei"`
+	UnconfirmedEthTxIDs []string                 `json:"unconfirmedEthTxIDs"`
+	Healthy             bool                     `json:"healthy"`
+	Issues              []DiagnosisIssueResource `json:"issues"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r KeyDiagnosisResource) GetName() string {
+	return "keyDiagnoses"
+}
+
+// NewKeyDiagnosisResource constructs a new KeyDiagnosisResource
+func NewKeyDiagnosisResource(diagnosis bulletprooftxmanager.KeyDiagnosis) *KeyDiagnosisResource {
+	r := &KeyDiagnosisResource{
+		JAID:                NewJAID(diagnosis.Address.Hex()),
+		Address:             diagnosis.Address.Hex(),
+		ChainNonce:          diagnosis.ChainNonce,
+		PendingChainNonce:   diagnosis.PendingChainNonce,
+		LocalNextNonce:      diagnosis.LocalNextNonce,
+		BalanceWei:          diagnosis.Balance.String(),
+		UnconfirmedEthTxIDs: formatEthTxIDs(diagnosis.UnconfirmedEthTxIDs),
+		Healthy:             diagnosis.Healthy(),
+		Issues:              []DiagnosisIssueResource{},
+	}
+	if diagnosis.GasPrice != nil {
+		gasPrice := diagnosis.GasPrice.String()
+		r.GasPriceWei = &gasPrice
+	}
+	for _, issue := range diagnosis.Issues {
+		r.Issues = append(r.Issues, DiagnosisIssueResource{
+			Kind:        string(issue.Kind),
+			EthTxIDs:    formatEthTxIDs(issue.EthTxIDs),
+			Description: issue.Description,
+			Remediation: issue.Remediation,
+		})
+	}
+
+	return r
+}
+
+func formatEthTxIDs(ids []int64) []string {
+	formatted := []string{}
+	for _, id := range ids {
+		formatted = append(formatted, strconv.FormatInt(id, 10))
+	}
+	return formatted
+}
+
+// KeyRotationResource represents the rotation of an ETH key to a new one
+type KeyRotationResource struct {
+	JAID
+	OldAddress   string    `json:"oldAddress"`
+	NewAddress   string    `json:"newAddress"`
+	SweepEthTxID *string   `json:"sweepEthTxID"`
+	SweepValue   string    `json:"sweepValue"`
+	JobIDs       []string  `json:"jobIDs"`
+	CreatedAt    time.Time `json:"createdAt"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r KeyRotationResource) GetName() string {
+	return "keyRotations"
+}
+
+// NewKeyRotationResource constructs a new KeyRotationResource
+func NewKeyRotationResource(rotation bulletprooftxmanager.KeyRotation) *KeyRotationResource {
+	r := &KeyRotationResource{
+		JAID:       NewJAIDInt64(rotation.ID),
+		OldAddress: rotation.OldAddress.Hex(),
+		NewAddress: rotation.NewAddress.Hex(),
+		SweepValue: rotation.SweepValue.String(),
+		JobIDs:     []string{},
+		CreatedAt:  rotation.CreatedAt,
+	}
+	if rotation.SweepEthTxID.Valid {
+		id := strconv.FormatInt(rotation.SweepEthTxID.Int64, 10)
+		r.SweepEthTxID = &id
+	}
+	for _, id := range rotation.JobIDs {
+		r.JobIDs = append(r.JobIDs, strconv.FormatInt(int64(id), 10))
+	}
+
+	return r
+}
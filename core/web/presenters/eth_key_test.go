@@ -3,11 +3,14 @@ package presenters
 import (
 	"database/sql"
 	"fmt"
+	"math/big"
 	"testing"
 	"time"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/manyminds/api2go/jsonapi"
 	"github.com/smartcontractkit/chainlink/core/assets"
+	"github.com/smartcontractkit/chainlink/core/services/bulletprooftxmanager"
 	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -55,6 +58,7 @@ func TestETHKeyResource(t *testing.T) {
 			  "linkBalance":"1",
 			  "nextNonce":1,
 			  "isFunding":true,
+			  "policy":{"fundingOnly":false,"allowedJobTypes":null,"allowedToAddresses":null},
 			  "createdAt":"2000-01-01T00:00:00Z",
 			  "updatedAt":"2000-01-01T00:00:00Z",
 			  "deletedAt":null
@@ -87,6 +91,7 @@ func TestETHKeyResource(t *testing.T) {
 				"linkBalance":"1",
 				"nextNonce":1,
 				"isFunding":true,
+				"policy":{"fundingOnly":false,"allowedJobTypes":null,"allowedToAddresses":null},
 				"createdAt":"2000-01-01T00:00:00Z",
 				"updatedAt":"2000-01-01T00:00:00Z",
 				"deletedAt":"2000-01-01T00:00:00Z"
@@ -98,3 +103,47 @@ func TestETHKeyResource(t *testing.T) {
 
 	assert.JSONEq(t, expected, string(b))
 }
+
+func TestKeyDiagnosisResource(t *testing.T) {
+	address := common.HexToAddress("0x2aCFF2ec69aa9945Ed84f4F281eCCF6911A3B0eD")
+	diagnosis := bulletprooftxmanager.KeyDiagnosis{
+		Address:             address,
+		ChainNonce:          3,
+		PendingChainNonce:   4,
+		LocalNextNonce:      6,
+		Balance:             big.NewInt(1000),
+		GasPrice:            big.NewInt(20),
+		UnconfirmedEthTxIDs: []int64{7, 8},
+		Issues: []bulletprooftxmanager.DiagnosisIssue{{
+			Kind:        bulletprooftxmanager.DiagnosisUnderpriced,
+			EthTxIDs:    []int64{8},
+			Description: "underpriced",
+			Remediation: "wait",
+		}},
+	}
+
+	r := NewKeyDiagnosisResource(diagnosis)
+	b, err := jsonapi.Marshal(r)
+	require.NoError(t, err)
+
+	expected := fmt.Sprintf(`
+	{
+		"data": {
+			"type":"keyDiagnoses",
+			"id":"%[1]s",
+			"attributes":{
+				"address":"%[1]s",
+				"chainNonce":3,
+				"pendingChainNonce":4,
+				"localNextNonce":6,
+				"balanceWei":"1000",
+				"gasPriceWei":"20",
+				"unconfirmedEthTxIDs":["7","8"],
+				"healthy":false,
+				"issues":[{"kind":"underpriced","ethTxIDs":["8"],"description":"underpriced","remediation":"wait"}]
+			}
+		}
+	}`, address.Hex())
+
+	assert.JSONEq(t, expected, string(b))
+}
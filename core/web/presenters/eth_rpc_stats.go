@@ -0,0 +1,55 @@
+package presenters
+
+import (
+	"time"
+
+	"github.com/smartcontractkit/chainlink/core/services/eth"
+)
+
+// EthRPCStatsResource represents the statistics of the calls of one method to
+// one eth node endpoint
+type EthRPCStatsResource struct {
+	JAID
+	Endpoint         string     `json:"endpoint"`
+	Method           string     `json:"method"`
+	Calls            uint64     `json:"calls"`
+	Errors           uint64     `json:"errors"`
+	RateLimited      uint64     `json:"rateLimited"`
+	ErrorRate        float64    `json:"errorRate"`
+	AverageLatencyMs float64    `json:"averageLatencyMs"`
+	MaxLatencyMs     float64    `json:"maxLatencyMs"`
+	LastErrorAt      *time.Time `json:"lastErrorAt"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r EthRPCStatsResource) GetName() string {
+	return "ethRPCStats"
+}
+
+// NewEthRPCStatsResource constructs a new EthRPCStatsResource
+func NewEthRPCStatsResource(stats eth.RPCStats) EthRPCStatsResource {
+	r := EthRPCStatsResource{
+		JAID:             NewJAID(stats.Endpoint + "/" + stats.Method),
+		Endpoint:         stats.Endpoint,
+		Method:           stats.Method,
+		Calls:            stats.Calls,
+		Errors:           stats.Errors,
+		RateLimited:      stats.RateLimited,
+		AverageLatencyMs: float64(stats.AverageLatency()) / float64(time.Millisecond),
+		MaxLatencyMs:     float64(stats.MaxLatency) / float64(time.Millisecond),
+		LastErrorAt:      stats.LastErrorAt,
+	}
+	if stats.Calls > 0 {
+		r.ErrorRate = float64(stats.Errors) / float64(stats.Calls)
+	}
+	return r
+}
+
+// NewEthRPCStatsResources constructs a slice of EthRPCStatsResources
+func NewEthRPCStatsResources(stats []eth.RPCStats) []EthRPCStatsResource {
+	rs := []EthRPCStatsResource{}
+	for _, s := range stats {
+		rs = append(rs, NewEthRPCStatsResource(s))
+	}
+	return rs
+}
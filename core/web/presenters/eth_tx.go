@@ -2,6 +2,7 @@ package presenters
 
 import (
 	"strconv"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
@@ -22,6 +23,14 @@ type EthTxResource struct {
 	SentAt   string          `json:"sentAt"`
 	To       *common.Address `json:"to"`
 	Value    string          `json:"value"`
+	// ExpiresAt, ExpiresAtBlock and CancelledAt are only set for transactions
+	// with an expiry, or which have been cancelled
+	ExpiresAt      *time.Time `json:"expiresAt,omitempty"`
+	ExpiresAtBlock string     `json:"expiresAtBlock,omitempty"`
+	CancelledAt    *time.Time `json:"cancelledAt,omitempty"`
+	// EVMChainID is only set for transactions on one of the node's
+	// additional chains
+	EVMChainID string `json:"evmChainID,omitempty"`
 }
 
 // GetName implements the api2go EntityNamer interface
@@ -35,7 +44,7 @@ func (EthTxResource) GetName() string {
 // EthTx as the id being used was the EthTxAttempt Hash.
 // This should really use it's proper id
 func NewEthTxResource(tx bulletprooftxmanager.EthTx) EthTxResource {
-	return EthTxResource{
+	r := EthTxResource{
 		Data:     hexutil.Bytes(tx.EncodedPayload),
 		From:     &tx.FromAddress,
 		GasLimit: strconv.FormatUint(tx.GasLimit, 10),
@@ -43,6 +52,15 @@ func NewEthTxResource(tx bulletprooftxmanager.EthTx) EthTxResource {
 		To:       &tx.ToAddress,
 		Value:    tx.Value.String(),
 	}
+	r.ExpiresAt = tx.ExpiresAt.Ptr()
+	if tx.ExpiresAtBlock.Valid {
+		r.ExpiresAtBlock = strconv.FormatInt(tx.ExpiresAtBlock.Int64, 10)
+	}
+	r.CancelledAt = tx.CancelledAt.Ptr()
+	if tx.EVMChainID != nil {
+		r.EVMChainID = tx.EVMChainID.String()
+	}
+	return r
 }
 
 func NewEthTxResourceFromAttempt(txa bulletprooftxmanager.EthTxAttempt) EthTxResource {
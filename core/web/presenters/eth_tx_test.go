@@ -2,6 +2,7 @@ package presenters
 
 import (
 	"testing"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
@@ -11,6 +12,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/utils"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
 )
 
 func TestEthTxResource(t *testing.T) {
@@ -101,4 +103,17 @@ func TestEthTxResource(t *testing.T) {
 	`
 
 	assert.JSONEq(t, expected, string(b))
+
+	cancelledAt := time.Date(2021, 7, 1, 12, 0, 0, 0, time.UTC)
+	tx.ExpiresAtBlock = null.IntFrom(400)
+	tx.CancelledAt = null.TimeFrom(cancelledAt)
+	tx.EVMChainID = utils.NewBigI(56)
+
+	r = NewEthTxResource(tx)
+
+	assert.Nil(t, r.ExpiresAt)
+	assert.Equal(t, "400", r.ExpiresAtBlock)
+	require.NotNil(t, r.CancelledAt)
+	assert.Equal(t, cancelledAt, *r.CancelledAt)
+	assert.Equal(t, "56", r.EVMChainID)
 }
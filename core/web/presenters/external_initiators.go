@@ -16,6 +16,7 @@ type ExternalInitiatorAuthentication struct {
 	Secret         string        `json:"incomingSecret,omitempty"`
 	OutgoingToken  string        `json:"outgoingToken,omitempty"`
 	OutgoingSecret string        `json:"outgoingSecret,omitempty"`
+	SigningSecret  string        `json:"signingSecret,omitempty"`
 }
 
 // NewExternalInitiatorAuthentication creates an instance of ExternalInitiatorAuthentication.
@@ -29,6 +30,7 @@ func NewExternalInitiatorAuthentication(
 		Secret:         eia.Secret,
 		OutgoingToken:  ei.OutgoingToken,
 		OutgoingSecret: ei.OutgoingSecret,
+		SigningSecret:  ei.SigningSecret,
 	}
 	if ei.URL != nil {
 		result.URL = *ei.URL
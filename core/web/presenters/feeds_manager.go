@@ -1,9 +1,13 @@
 package presenters
 
 import (
+	"strconv"
 	"time"
 
+	"github.com/ethereum/go-ethereum/common"
+
 	"github.com/smartcontractkit/chainlink/core/services/feeds"
+	"github.com/smartcontractkit/chainlink/core/store/models"
 	"github.com/smartcontractkit/chainlink/core/utils/crypto"
 	"gopkg.in/guregu/null.v4"
 )
@@ -17,6 +21,7 @@ type FeedsManagerResource struct {
 	JobTypes               []string         `json:"jobTypes"`
 	IsBootstrapPeer        bool             `json:"isBootstrapPeer"`
 	BootstrapPeerMultiaddr null.String      `json:"bootstrapPeerMultiaddr"`
+	CSAKeyID               null.Int         `json:"csaKeyID"`
 	CreatedAt              time.Time        `json:"createdAt"`
 }
 
@@ -35,6 +40,7 @@ func NewFeedsManagerResource(ms feeds.FeedsManager) *FeedsManagerResource {
 		JobTypes:               ms.JobTypes,
 		IsBootstrapPeer:        ms.IsOCRBootstrapPeer,
 		BootstrapPeerMultiaddr: ms.OCRBootstrapPeerMultiaddr,
+		CSAKeyID:               ms.CSAKeyID,
 		CreatedAt:              ms.CreatedAt,
 	}
 }
@@ -49,3 +55,43 @@ func NewFeedsManagerResources(mss []feeds.FeedsManager) []FeedsManagerResource {
 
 	return rs
 }
+
+// ChainConfigResource represents a feeds manager chain config JSONAPI
+// resource.
+type ChainConfigResource struct {
+	JAID
+	FeedsManagerID string             `json:"feedsManagerID"`
+	ChainID        int64              `json:"chainID"`
+	AccountAddress common.Address     `json:"accountAddress"`
+	OCRKeyBundleID *models.Sha256Hash `json:"ocrKeyBundleID"`
+	CreatedAt      time.Time          `json:"createdAt"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r ChainConfigResource) GetName() string {
+	return "chain_configs"
+}
+
+// NewChainConfigResource constructs a new ChainConfigResource.
+func NewChainConfigResource(cfg feeds.ChainConfig) *ChainConfigResource {
+	return &ChainConfigResource{
+		JAID:           NewJAIDInt64(cfg.ID),
+		FeedsManagerID: strconv.FormatInt(cfg.FeedsManagerID, 10),
+		ChainID:        cfg.ChainID,
+		AccountAddress: cfg.AccountAddress,
+		OCRKeyBundleID: cfg.OCRKeyBundleID,
+		CreatedAt:      cfg.CreatedAt,
+	}
+}
+
+// NewChainConfigResources initializes a slice of JSONAPI chain config
+// resources
+func NewChainConfigResources(cfgs []feeds.ChainConfig) []ChainConfigResource {
+	rs := []ChainConfigResource{}
+
+	for _, cfg := range cfgs {
+		rs = append(rs, *NewChainConfigResource(cfg))
+	}
+
+	return rs
+}
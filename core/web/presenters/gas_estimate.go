@@ -0,0 +1,49 @@
+package presenters
+
+import (
+	"math/big"
+	"strconv"
+
+	"github.com/smartcontractkit/chainlink/core/services/gas"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+// GasEstimateResource represents the current gas price estimate of a chain
+type GasEstimateResource struct {
+	JAID
+	EVMChainID    string     `json:"evmChainID"`
+	Mode          string     `json:"mode"`
+	GasPriceWei   *utils.Big `json:"gasPriceWei"`
+	L1GasPriceWei *utils.Big `json:"l1GasPriceWei,omitempty"`
+	// Percentiles are keyed by percentile, e.g. "60"
+	Percentiles map[string]*utils.Big `json:"percentiles,omitempty"`
+	BlockNumber int64                 `json:"blockNumber,omitempty"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r GasEstimateResource) GetName() string {
+	return "gasEstimates"
+}
+
+// NewGasEstimateResource constructs a new GasEstimateResource
+func NewGasEstimateResource(chainID *big.Int, estimate gas.Estimate) GasEstimateResource {
+	r := GasEstimateResource{
+		JAID:        NewJAID(chainID.String()),
+		EVMChainID:  chainID.String(),
+		Mode:        estimate.Mode,
+		BlockNumber: estimate.BlockNumber,
+	}
+	if estimate.GasPrice != nil {
+		r.GasPriceWei = utils.NewBig(estimate.GasPrice)
+	}
+	if estimate.L1GasPrice != nil {
+		r.L1GasPriceWei = utils.NewBig(estimate.L1GasPrice)
+	}
+	if len(estimate.Percentiles) > 0 {
+		r.Percentiles = make(map[string]*utils.Big)
+		for percentile, gasPrice := range estimate.Percentiles {
+			r.Percentiles[strconv.Itoa(percentile)] = utils.NewBig(gasPrice)
+		}
+	}
+	return r
+}
@@ -0,0 +1,54 @@
+package presenters
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/manyminds/api2go/jsonapi"
+	"github.com/smartcontractkit/chainlink/core/services/gas"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGasEstimateResource(t *testing.T) {
+	t.Parallel()
+
+	r := NewGasEstimateResource(big.NewInt(1), gas.Estimate{
+		Mode:        "BlockHistory",
+		GasPrice:    big.NewInt(20000000000),
+		Percentiles: map[int]*big.Int{0: big.NewInt(1000000000), 100: big.NewInt(30000000000)},
+		BlockNumber: 42,
+	})
+
+	b, err := jsonapi.Marshal(r)
+	require.NoError(t, err)
+
+	expected := `
+	{
+		"data": {
+			"type": "gasEstimates",
+			"id": "1",
+			"attributes": {
+				"evmChainID": "1",
+				"mode": "BlockHistory",
+				"gasPriceWei": "20000000000",
+				"percentiles": {
+					"0": "1000000000",
+					"100": "30000000000"
+				},
+				"blockNumber": 42
+			}
+		}
+	}
+	`
+	assert.JSONEq(t, expected, string(b))
+
+	r = NewGasEstimateResource(big.NewInt(10), gas.Estimate{
+		Mode:       "Optimism",
+		GasPrice:   big.NewInt(142),
+		L1GasPrice: big.NewInt(42),
+	})
+	assert.Equal(t, "10", r.EVMChainID)
+	assert.Equal(t, "42", r.L1GasPriceWei.String())
+	assert.Nil(t, r.Percentiles)
+}
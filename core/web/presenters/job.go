@@ -13,6 +13,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/services/pipeline"
 	"github.com/smartcontractkit/chainlink/core/services/signatures/secp256k1"
 	"github.com/smartcontractkit/chainlink/core/store/models"
+	"github.com/smartcontractkit/chainlink/core/utils"
 )
 
 // JobSpecType defines the the the spec type of the job
@@ -36,6 +37,9 @@ const (
 type DirectRequestSpec struct {
 	ContractAddress          ethkey.EIP55Address `json:"contractAddress"`
 	MinIncomingConfirmations clnull.Uint32       `json:"minIncomingConfirmations"`
+	Requesters               []string            `json:"requesters,omitempty"`
+	DeniedRequesters         []string            `json:"deniedRequesters,omitempty"`
+	MinContractPayment       *assets.Link        `json:"minContractPaymentLinkJuels,omitempty"`
 	Initiator                string              `json:"initiator"`
 	CreatedAt                time.Time           `json:"createdAt"`
 	UpdatedAt                time.Time           `json:"updatedAt"`
@@ -47,6 +51,9 @@ func NewDirectRequestSpec(spec *job.DirectRequestSpec) *DirectRequestSpec {
 	return &DirectRequestSpec{
 		ContractAddress:          spec.ContractAddress,
 		MinIncomingConfirmations: spec.MinIncomingConfirmations,
+		Requesters:               spec.Requesters.ToStrings(),
+		DeniedRequesters:         spec.DeniedRequesters.ToStrings(),
+		MinContractPayment:       spec.MinContractPayment,
 		// This is hardcoded to runlog. When we support other intiators, we need
 		// to change this
 		Initiator: "runlog",
@@ -125,8 +132,9 @@ func NewOffChainReportingSpec(spec *job.OffchainReportingOracleSpec) *OffChainRe
 
 // PipelineSpec defines the spec details of the pipeline
 type PipelineSpec struct {
-	ID           int32  `json:"id"`
-	DotDAGSource string `json:"dotDagSource"`
+	ID           int32               `json:"id"`
+	DotDAGSource string              `json:"dotDagSource"`
+	InputSchema  pipeline.VarsSchema `json:"inputSchema,omitempty"`
 }
 
 // NewPipelineSpec generates a new PipelineSpec from a pipeline.Spec
@@ -134,6 +142,7 @@ func NewPipelineSpec(spec *pipeline.Spec) PipelineSpec {
 	return PipelineSpec{
 		ID:           spec.ID,
 		DotDAGSource: spec.DotDagSource,
+		InputSchema:  spec.InputSchema,
 	}
 }
 
@@ -141,65 +150,99 @@ func NewPipelineSpec(spec *pipeline.Spec) PipelineSpec {
 type KeeperSpec struct {
 	ContractAddress ethkey.EIP55Address `json:"contractAddress"`
 	FromAddress     ethkey.EIP55Address `json:"fromAddress"`
-	CreatedAt       time.Time           `json:"createdAt"`
-	UpdatedAt       time.Time           `json:"updatedAt"`
+	// EligibilityStrategy is empty if the job uses KEEPER_ELIGIBILITY_STRATEGY
+	EligibilityStrategy string                  `json:"eligibilityStrategy,omitempty"`
+	Registries          job.KeeperRegistrySpecs `json:"registries,omitempty"`
+	CreatedAt           time.Time               `json:"createdAt"`
+	UpdatedAt           time.Time               `json:"updatedAt"`
 }
 
 // NewKeeperSpec generates a new KeeperSpec from a job.KeeperSpec
 func NewKeeperSpec(spec *job.KeeperSpec) *KeeperSpec {
 	return &KeeperSpec{
-		ContractAddress: spec.ContractAddress,
-		FromAddress:     spec.FromAddress,
-		CreatedAt:       spec.CreatedAt,
-		UpdatedAt:       spec.UpdatedAt,
+		ContractAddress:     spec.ContractAddress,
+		FromAddress:         spec.FromAddress,
+		EligibilityStrategy: spec.EligibilityStrategy,
+		Registries:          spec.Registries,
+		CreatedAt:           spec.CreatedAt,
+		UpdatedAt:           spec.UpdatedAt,
 	}
 }
 
 // WebhookSpec defines the spec details of a Webhook Job
 type WebhookSpec struct {
-	CreatedAt time.Time `json:"createdAt"`
-	UpdatedAt time.Time `json:"updatedAt"`
+	OverlapPolicy string    `json:"overlapPolicy,omitempty"`
+	CreatedAt     time.Time `json:"createdAt"`
+	UpdatedAt     time.Time `json:"updatedAt"`
 }
 
 // NewWebhookSpec generates a new WebhookSpec from a job.WebhookSpec
 func NewWebhookSpec(spec *job.WebhookSpec) *WebhookSpec {
 	return &WebhookSpec{
-		CreatedAt: spec.CreatedAt,
-		UpdatedAt: spec.UpdatedAt,
+		OverlapPolicy: string(spec.OverlapPolicy),
+		CreatedAt:     spec.CreatedAt,
+		UpdatedAt:     spec.UpdatedAt,
 	}
 }
 
 // CronSpec defines the spec details of a Cron Job
 type CronSpec struct {
-	CronSchedule string    `json:"schedule" tom:"schedule"`
-	CreatedAt    time.Time `json:"createdAt"`
-	UpdatedAt    time.Time `json:"updatedAt"`
+	CronSchedule   string     `json:"schedule" tom:"schedule"`
+	RunAt          *time.Time `json:"runAt,omitempty"`
+	Timezone       string     `json:"timezone,omitempty"`
+	OverlapPolicy  string     `json:"overlapPolicy,omitempty"`
+	MaxCatchUpRuns uint32     `json:"maxCatchUpRuns,omitempty"`
+	LastRunAt      *time.Time `json:"lastRunAt,omitempty"`
+	NextRunAt      *time.Time `json:"nextRunAt,omitempty"`
+	CreatedAt      time.Time  `json:"createdAt"`
+	UpdatedAt      time.Time  `json:"updatedAt"`
 }
 
 // NewCronSpec generates a new CronSpec from a job.CronSpec
 func NewCronSpec(spec *job.CronSpec) *CronSpec {
 	return &CronSpec{
-		CronSchedule: spec.CronSchedule,
-		CreatedAt:    spec.CreatedAt,
-		UpdatedAt:    spec.UpdatedAt,
+		CronSchedule:   spec.CronSchedule,
+		RunAt:          spec.RunAt,
+		Timezone:       spec.Timezone,
+		OverlapPolicy:  string(spec.OverlapPolicy),
+		MaxCatchUpRuns: spec.MaxCatchUpRuns,
+		LastRunAt:      spec.LastRunAt,
+		CreatedAt:      spec.CreatedAt,
+		UpdatedAt:      spec.UpdatedAt,
 	}
 }
 
 type VRFSpec struct {
-	CoordinatorAddress ethkey.EIP55Address `json:"coordinatorAddress"`
-	PublicKey          secp256k1.PublicKey `json:"publicKey"`
-	Confirmations      uint32              `json:"confirmations"`
-	CreatedAt          time.Time           `json:"createdAt"`
-	UpdatedAt          time.Time           `json:"updatedAt"`
+	CoordinatorAddress        ethkey.EIP55Address  `json:"coordinatorAddress"`
+	PublicKey                 secp256k1.PublicKey  `json:"publicKey"`
+	Confirmations             uint32               `json:"confirmations"`
+	CoordinatorVersion        uint32               `json:"coordinatorVersion"`
+	FromAddress               *ethkey.EIP55Address `json:"fromAddress,omitempty"`
+	BatchCoordinatorAddress   *ethkey.EIP55Address `json:"batchCoordinatorAddress,omitempty"`
+	BatchFulfillmentEnabled   bool                 `json:"batchFulfillmentEnabled"`
+	BatchFulfillmentGasLimit  uint64               `json:"batchFulfillmentGasLimit,omitempty"`
+	MinSubscriptionBalance    *assets.Link         `json:"minSubscriptionBalanceLinkJuels,omitempty"`
+	MaxGasPrice               *utils.Big           `json:"maxGasPriceWei,omitempty"`
+	FulfillmentDeadlineBlocks uint64               `json:"fulfillmentDeadlineBlocks,omitempty"`
+	CreatedAt                 time.Time            `json:"createdAt"`
+	UpdatedAt                 time.Time            `json:"updatedAt"`
 }
 
 func NewVRFSpec(spec *job.VRFSpec) *VRFSpec {
 	return &VRFSpec{
-		CoordinatorAddress: spec.CoordinatorAddress,
-		PublicKey:          spec.PublicKey,
-		Confirmations:      spec.Confirmations,
-		CreatedAt:          spec.CreatedAt,
-		UpdatedAt:          spec.UpdatedAt,
+		CoordinatorAddress:        spec.CoordinatorAddress,
+		PublicKey:                 spec.PublicKey,
+		Confirmations:             spec.Confirmations,
+		CoordinatorVersion:        spec.CoordinatorVersion,
+		FromAddress:               spec.FromAddress,
+		BatchCoordinatorAddress:   spec.BatchCoordinatorAddress,
+		BatchFulfillmentEnabled:   spec.BatchFulfillmentEnabled,
+		BatchFulfillmentGasLimit:  spec.BatchFulfillmentGasLimit,
+		MinSubscriptionBalance:    spec.MinSubscriptionBalance,
+		MaxGasPrice:               spec.MaxGasPrice,
+		FulfillmentDeadlineBlocks: spec.FulfillmentDeadlineBlocks,
+		CreatedAt:                 spec.CreatedAt,
+		UpdatedAt:                 spec.UpdatedAt,
 	}
 }
 
@@ -207,6 +250,7 @@ func NewVRFSpec(spec *job.VRFSpec) *VRFSpec {
 type JobError struct {
 	ID          int64     `json:"id"`
 	Description string    `json:"description"`
+	Severity    string    `json:"severity"`
 	Occurrences uint      `json:"occurrences"`
 	CreatedAt   time.Time `json:"createdAt"`
 	UpdatedAt   time.Time `json:"updatedAt"`
@@ -216,6 +260,7 @@ func NewJobError(e job.SpecError) JobError {
 	return JobError{
 		ID:          e.ID,
 		Description: e.Description,
+		Severity:    string(e.Severity),
 		Occurrences: e.Occurrences,
 		CreatedAt:   e.CreatedAt,
 		UpdatedAt:   e.UpdatedAt,
@@ -230,6 +275,10 @@ type JobResource struct {
 	SchemaVersion         uint32                 `json:"schemaVersion"`
 	MaxTaskDuration       models.Interval        `json:"maxTaskDuration"`
 	ExternalJobID         uuid.UUID              `json:"externalJobID"`
+	Tags                  []string               `json:"tags"`
+	Labels                map[string]string      `json:"labels"`
+	Paused                bool                   `json:"paused"`
+	QuarantinedAt         *time.Time             `json:"quarantinedAt,omitempty"`
 	DirectRequestSpec     *DirectRequestSpec     `json:"directRequestSpec"`
 	FluxMonitorSpec       *FluxMonitorSpec       `json:"fluxMonitorSpec"`
 	CronSpec              *CronSpec              `json:"cronSpec"`
@@ -239,6 +288,9 @@ type JobResource struct {
 	WebhookSpec           *WebhookSpec           `json:"webhookSpec"`
 	PipelineSpec          PipelineSpec           `json:"pipelineSpec"`
 	Errors                []JobError             `json:"errors"`
+	TemplateID            *int32                 `json:"templateID,omitempty"`
+	TemplateVariables     map[string]string      `json:"templateVariables,omitempty"`
+	StartupState          *job.StartupState      `json:"startupState,omitempty"`
 }
 
 // NewJobResource initializes a new JSONAPI job resource
@@ -251,6 +303,20 @@ func NewJobResource(j job.Job) *JobResource {
 		MaxTaskDuration: j.MaxTaskDuration,
 		PipelineSpec:    NewPipelineSpec(j.PipelineSpec),
 		ExternalJobID:   j.ExternalJobID,
+		Tags:            []string{},
+		Labels:          map[string]string{},
+		Paused:          j.Paused,
+		QuarantinedAt:   j.QuarantinedAt,
+	}
+	if j.Tags != nil {
+		resource.Tags = j.Tags
+	}
+	if j.Labels != nil {
+		resource.Labels = j.Labels
+	}
+	if j.TemplateID != nil {
+		resource.TemplateID = j.TemplateID
+		resource.TemplateVariables = j.TemplateVariables
 	}
 
 	switch j.Type {
@@ -294,3 +360,74 @@ func NewJobResources(js []job.Job) []JobResource {
 func (r JobResource) GetName() string {
 	return "jobs"
 }
+
+// JobCostResource represents the estimated cost of running a job over one UTC
+// day.
+type JobCostResource struct {
+	JAID
+	Day           time.Time `json:"day"`
+	Runs          int64     `json:"runs"`
+	BridgeCredits string    `json:"bridgeCredits"`
+	GasUsed       uint64    `json:"gasUsed"`
+	GasFeeWei     string    `json:"gasFeeWei"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r JobCostResource) GetName() string {
+	return "job_costs"
+}
+
+// NewJobCostResource constructs a new JobCostResource.
+func NewJobCostResource(cost pipeline.DailyCost) *JobCostResource {
+	return &JobCostResource{
+		JAID:          NewJAID(cost.Day.Format("2006-01-02")),
+		Day:           cost.Day,
+		Runs:          cost.Runs,
+		BridgeCredits: cost.BridgeCredits.String(),
+		GasUsed:       cost.GasUsed,
+		GasFeeWei:     cost.GasFee.String(),
+	}
+}
+
+// NewJobCostResources initializes a slice of JSONAPI job cost resources
+func NewJobCostResources(costs []pipeline.DailyCost) []JobCostResource {
+	rs := []JobCostResource{}
+
+	for _, cost := range costs {
+		rs = append(rs, *NewJobCostResource(cost))
+	}
+
+	return rs
+}
+
+// JobTemplateResource represents a job spec template JSONAPI resource.
+type JobTemplateResource struct {
+	JAID
+	Name      string    `json:"name"`
+	TOML      string    `json:"toml"`
+	Variables []string  `json:"variables"`
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r JobTemplateResource) GetName() string {
+	return "job_templates"
+}
+
+// NewJobTemplateResource constructs a new JobTemplateResource.
+func NewJobTemplateResource(t job.Template) *JobTemplateResource {
+	variables, err := t.Variables()
+	if err != nil || variables == nil {
+		variables = []string{}
+	}
+	return &JobTemplateResource{
+		// Uses the name as the id, as templates are addressed by name
+		JAID:      NewJAID(t.Name),
+		Name:      t.Name,
+		TOML:      t.TOML,
+		Variables: variables,
+		CreatedAt: t.CreatedAt,
+		UpdatedAt: t.UpdatedAt,
+	}
+}
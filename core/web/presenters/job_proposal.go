@@ -14,6 +14,7 @@ type JobProposalResource struct {
 	Status         feeds.JobProposalStatus `json:"status"`
 	ExternalJobID  *string                 `json:"external_job_id"`
 	FeedsManagerID string                  `json:"feeds_manager_id"`
+	ChainID        *string                 `json:"chain_id"`
 	CreatedAt      time.Time               `json:"createdAt"`
 }
 
@@ -37,6 +38,11 @@ func NewJobProposalResource(jp feeds.JobProposal) *JobProposalResource {
 		res.ExternalJobID = &uuid
 	}
 
+	if jp.ChainID.Valid {
+		chainID := strconv.FormatInt(jp.ChainID.Int64, 10)
+		res.ChainID = &chainID
+	}
+
 	return res
 }
 
@@ -50,3 +56,43 @@ func NewJobProposalResources(jps []feeds.JobProposal) []JobProposalResource {
 
 	return rs
 }
+
+// JobProposalApprovalResource represents the result of approving a job
+// proposal in a bulk approval.
+type JobProposalApprovalResource struct {
+	JAID
+	Approved bool    `json:"approved"`
+	Error    *string `json:"error"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r JobProposalApprovalResource) GetName() string {
+	return "job_proposal_approvals"
+}
+
+// NewJobProposalApprovalResource constructs a new JobProposalApprovalResource.
+func NewJobProposalApprovalResource(result feeds.JobProposalApprovalResult) *JobProposalApprovalResource {
+	res := &JobProposalApprovalResource{
+		JAID:     NewJAIDInt64(result.ID),
+		Approved: result.Approved,
+	}
+
+	if result.Err != nil {
+		msg := result.Err.Error()
+		res.Error = &msg
+	}
+
+	return res
+}
+
+// NewJobProposalApprovalResources initializes a slice of JSONAPI job proposal
+// approval resources
+func NewJobProposalApprovalResources(results []feeds.JobProposalApprovalResult) []JobProposalApprovalResource {
+	rs := []JobProposalApprovalResource{}
+
+	for _, result := range results {
+		rs = append(rs, *NewJobProposalApprovalResource(result))
+	}
+
+	return rs
+}
@@ -0,0 +1,80 @@
+package presenters
+
+import (
+	"time"
+
+	"github.com/smartcontractkit/chainlink/core/services/job"
+)
+
+// JobSpecErrorResource represents an error of a job, counted each time it
+// occurs
+type JobSpecErrorResource struct {
+	JAID
+	JobID       int32     `json:"jobID"`
+	Description string    `json:"description"`
+	Severity    string    `json:"severity"`
+	Occurrences uint      `json:"occurrences"`
+	CreatedAt   time.Time `json:"createdAt"`
+	UpdatedAt   time.Time `json:"updatedAt"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r JobSpecErrorResource) GetName() string {
+	return "jobSpecErrors"
+}
+
+// NewJobSpecErrorResource constructs a new JobSpecErrorResource
+func NewJobSpecErrorResource(e job.SpecError) JobSpecErrorResource {
+	return JobSpecErrorResource{
+		JAID:        NewJAIDInt64(e.ID),
+		JobID:       e.JobID,
+		Description: e.Description,
+		Severity:    string(e.Severity),
+		Occurrences: e.Occurrences,
+		CreatedAt:   e.CreatedAt,
+		UpdatedAt:   e.UpdatedAt,
+	}
+}
+
+// NewJobSpecErrorResources constructs a slice of JobSpecErrorResources
+func NewJobSpecErrorResources(es []job.SpecError) []JobSpecErrorResource {
+	rs := []JobSpecErrorResource{}
+	for _, e := range es {
+		rs = append(rs, NewJobSpecErrorResource(e))
+	}
+	return rs
+}
+
+// JobSpecErrorSummaryResource aggregates the identical errors of all jobs
+type JobSpecErrorSummaryResource struct {
+	JAID
+	Description string    `json:"description"`
+	Severity    string    `json:"severity"`
+	Occurrences int64     `json:"occurrences"`
+	Jobs        int64     `json:"jobs"`
+	FirstSeenAt time.Time `json:"firstSeenAt"`
+	LastSeenAt  time.Time `json:"lastSeenAt"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r JobSpecErrorSummaryResource) GetName() string {
+	return "jobSpecErrorSummaries"
+}
+
+// NewJobSpecErrorSummaryResources constructs a slice of
+// JobSpecErrorSummaryResources
+func NewJobSpecErrorSummaryResources(summaries []job.SpecErrorSummary) []JobSpecErrorSummaryResource {
+	rs := []JobSpecErrorSummaryResource{}
+	for _, s := range summaries {
+		rs = append(rs, JobSpecErrorSummaryResource{
+			JAID:        NewJAID(s.Description),
+			Description: s.Description,
+			Severity:    string(s.Severity),
+			Occurrences: s.Occurrences,
+			Jobs:        s.Jobs,
+			FirstSeenAt: s.FirstSeenAt,
+			LastSeenAt:  s.LastSeenAt,
+		})
+	}
+	return rs
+}
@@ -82,6 +82,9 @@ func TestJob(t *testing.T) {
 						"type": "directrequest",
 						"maxTaskDuration": "1m0s",
 					    "externalJobID":"0eec7e1d-d0d2-476c-a1a8-72dfb6633f46",
+					    "tags": [],
+					    "labels": {},
+					    "paused": false,
 						"pipelineSpec": {
 							"id": 1,
 							"dotDagSource": "ds1 [type=http method=GET url=\"https://pricesource1.com\""
@@ -140,6 +143,9 @@ func TestJob(t *testing.T) {
 						"type": "fluxmonitor",
 						"maxTaskDuration": "1m0s",
 					    "externalJobID":"0eec7e1d-d0d2-476c-a1a8-72dfb6633f46",
+					    "tags": [],
+					    "labels": {},
+					    "paused": false,
 						"pipelineSpec": {
 							"id": 1,
 							"dotDagSource": "ds1 [type=http method=GET url=\"https://pricesource1.com\""
@@ -207,6 +213,9 @@ func TestJob(t *testing.T) {
 						"type": "offchainreporting",
 						"maxTaskDuration": "1m0s",
 					    "externalJobID":"0eec7e1d-d0d2-476c-a1a8-72dfb6633f46",
+					    "tags": [],
+					    "labels": {},
+					    "paused": false,
 						"pipelineSpec": {
 							"id": 1,
 							"dotDagSource": "ds1 [type=http method=GET url=\"https://pricesource1.com\""
@@ -268,6 +277,9 @@ func TestJob(t *testing.T) {
 						"type": "keeper",
 						"maxTaskDuration": "1m0s",
 					    "externalJobID":"0eec7e1d-d0d2-476c-a1a8-72dfb6633f46",
+					    "tags": [],
+					    "labels": {},
+					    "paused": false,
 						"pipelineSpec": {
 							"id": 1,
 							"dotDagSource": ""
@@ -320,6 +332,9 @@ func TestJob(t *testing.T) {
                         "type": "cron",
                         "maxTaskDuration": "1m0s",
 					    "externalJobID":"0eec7e1d-d0d2-476c-a1a8-72dfb6633f46",
+					    "tags": [],
+					    "labels": {},
+					    "paused": false,
                         "pipelineSpec": {
                             "id": 1,
                             "dotDagSource": ""
@@ -369,6 +384,9 @@ func TestJob(t *testing.T) {
 						"type": "webhook",
 						"maxTaskDuration": "1m0s",
 					    "externalJobID":"0eec7e1d-d0d2-476c-a1a8-72dfb6633f46",
+					    "tags": [],
+					    "labels": {},
+					    "paused": false,
 						"pipelineSpec": {
 							"id": 1,
 							"dotDagSource": ""
@@ -412,6 +430,7 @@ func TestJob(t *testing.T) {
 						ID:          200,
 						JobID:       1,
 						Description: "some error",
+						Severity:    job.SpecErrorSeverityError,
 						Occurrences: 1,
 						CreatedAt:   timestamp,
 						UpdatedAt:   timestamp,
@@ -429,6 +448,9 @@ func TestJob(t *testing.T) {
 						"type": "keeper",
 						"maxTaskDuration": "1m0s",
 					    "externalJobID":"0eec7e1d-d0d2-476c-a1a8-72dfb6633f46",
+					    "tags": [],
+					    "labels": {},
+					    "paused": false,
 						"pipelineSpec": {
 							"id": 1,
 							"dotDagSource": ""
@@ -448,6 +470,7 @@ func TestJob(t *testing.T) {
 						"errors": [{
 							"id": 200,
 							"description": "some error",
+							"severity": "error",
 							"occurrences": 1,
 							"createdAt":"2000-01-01T00:00:00Z",
 							"updatedAt":"2000-01-01T00:00:00Z"
@@ -0,0 +1,50 @@
+package presenters
+
+import (
+	"strconv"
+
+	"github.com/smartcontractkit/chainlink/core/services/keeper"
+	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
+)
+
+// KeeperRegistryResource represents a registry monitored by a keeper job
+type KeeperRegistryResource struct {
+	JAID
+	JobID             int32               `json:"jobID"`
+	ContractAddress   ethkey.EIP55Address `json:"contractAddress"`
+	FromAddress       ethkey.EIP55Address `json:"fromAddress"`
+	KeeperIndex       int32               `json:"keeperIndex"`
+	NumKeepers        int32               `json:"numKeepers"`
+	BlockCountPerTurn int32               `json:"blockCountPerTurn"`
+	CheckGas          int32               `json:"checkGas"`
+	UpkeepCount       int64               `json:"upkeepCount"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r KeeperRegistryResource) GetName() string {
+	return "keeperRegistries"
+}
+
+// NewKeeperRegistryResource constructs a new KeeperRegistryResource
+func NewKeeperRegistryResource(registry keeper.RegistryWithUpkeepCount) KeeperRegistryResource {
+	return KeeperRegistryResource{
+		JAID:              NewJAID(strconv.Itoa(int(registry.ID))),
+		JobID:             registry.JobID,
+		ContractAddress:   registry.ContractAddress,
+		FromAddress:       registry.FromAddress,
+		KeeperIndex:       registry.KeeperIndex,
+		NumKeepers:        registry.NumKeepers,
+		BlockCountPerTurn: registry.BlockCountPerTurn,
+		CheckGas:          registry.CheckGas,
+		UpkeepCount:       registry.UpkeepCount,
+	}
+}
+
+// NewKeeperRegistryResources constructs a slice of KeeperRegistryResources
+func NewKeeperRegistryResources(registries []keeper.RegistryWithUpkeepCount) []KeeperRegistryResource {
+	rs := []KeeperRegistryResource{}
+	for _, r := range registries {
+		rs = append(rs, NewKeeperRegistryResource(r))
+	}
+	return rs
+}
@@ -0,0 +1,57 @@
+package presenters
+
+import (
+	"strconv"
+
+	"github.com/smartcontractkit/chainlink/core/services/keeper"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+// KeeperUpkeepResource represents an upkeep of a keeper job along with the
+// accounting of the performUpkeeps sent by this node
+type KeeperUpkeepResource struct {
+	JAID
+	JobID                    int32      `json:"jobID"`
+	RegistryAddress          string     `json:"registryAddress"`
+	UpkeepID                 int64      `json:"upkeepID"`
+	ExecuteGas               uint64     `json:"executeGas"`
+	GasUsed                  uint64     `json:"gasUsed"`
+	GasSpent                 *utils.Big `json:"gasSpent"`
+	LinkEarned               *utils.Big `json:"linkEarned"`
+	PerformSuccessCount      int64      `json:"performSuccessCount"`
+	PerformFailureCount      int64      `json:"performFailureCount"`
+	LastPerformedBlockNumber *int64     `json:"lastPerformedBlockNumber"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r KeeperUpkeepResource) GetName() string {
+	return "keeperUpkeeps"
+}
+
+// NewKeeperUpkeepResource constructs a new KeeperUpkeepResource
+func NewKeeperUpkeepResource(upkeep keeper.UpkeepWithStat) KeeperUpkeepResource {
+	gasSpent := upkeep.Stat.GasSpent
+	linkEarned := upkeep.Stat.LinkEarned
+	return KeeperUpkeepResource{
+		JAID:                     NewJAID(strconv.Itoa(int(upkeep.ID))),
+		JobID:                    upkeep.Registry.JobID,
+		RegistryAddress:          upkeep.Registry.ContractAddress.Hex(),
+		UpkeepID:                 upkeep.UpkeepID,
+		ExecuteGas:               upkeep.ExecuteGas,
+		GasUsed:                  upkeep.Stat.GasUsed,
+		GasSpent:                 &gasSpent,
+		LinkEarned:               &linkEarned,
+		PerformSuccessCount:      upkeep.Stat.PerformSuccessCount,
+		PerformFailureCount:      upkeep.Stat.PerformFailureCount,
+		LastPerformedBlockNumber: upkeep.Stat.LastPerformedBlockNumber.Ptr(),
+	}
+}
+
+// NewKeeperUpkeepResources constructs a slice of KeeperUpkeepResources
+func NewKeeperUpkeepResources(upkeeps []keeper.UpkeepWithStat) []KeeperUpkeepResource {
+	rs := []KeeperUpkeepResource{}
+	for _, u := range upkeeps {
+		rs = append(rs, NewKeeperUpkeepResource(u))
+	}
+	return rs
+}
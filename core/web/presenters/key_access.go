@@ -0,0 +1,52 @@
+package presenters
+
+import (
+	"time"
+
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/core/services/keystore"
+)
+
+// KeyAccessResource represents an entry of the keystore audit log JSONAPI
+// resource.
+type KeyAccessResource struct {
+	JAID
+	KeyType   string    `json:"keyType"`
+	KeyID     string    `json:"keyID"`
+	Operation string    `json:"operation"`
+	Service   string    `json:"service"`
+	JobID     null.Int  `json:"jobID"`
+	Purpose   string    `json:"purpose"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (KeyAccessResource) GetName() string {
+	return "keyAccesses"
+}
+
+// NewKeyAccessResource constructs a new KeyAccessResource.
+func NewKeyAccessResource(access keystore.KeyAccess) *KeyAccessResource {
+	return &KeyAccessResource{
+		JAID:      NewJAIDInt64(access.ID),
+		KeyType:   string(access.KeyType),
+		KeyID:     access.KeyID,
+		Operation: string(access.Operation),
+		Service:   access.Service,
+		JobID:     access.JobID,
+		Purpose:   access.Purpose,
+		CreatedAt: access.CreatedAt,
+	}
+}
+
+// NewKeyAccessResources initializes a slice of JSONAPI keystore audit log
+// resources
+func NewKeyAccessResources(accesses []keystore.KeyAccess) []KeyAccessResource {
+	rs := []KeyAccessResource{}
+	for _, access := range accesses {
+		rs = append(rs, *NewKeyAccessResource(access))
+	}
+
+	return rs
+}
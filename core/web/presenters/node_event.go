@@ -0,0 +1,49 @@
+package presenters
+
+import (
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/smartcontractkit/chainlink/core/services/events"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+)
+
+// NodeEventResource is streamed to the clients of the node events stream.
+// Only the fields relevant to the event's type are set.
+type NodeEventResource struct {
+	Type        events.Type        `json:"type"`
+	Time        time.Time          `json:"time"`
+	JobID       string             `json:"jobId,omitempty"`
+	RunID       string             `json:"runId,omitempty"`
+	RunState    pipeline.RunStatus `json:"runState,omitempty"`
+	EthTxID     string             `json:"ethTxId,omitempty"`
+	FromAddress *common.Address    `json:"fromAddress,omitempty"`
+	SpecErrorID string             `json:"specErrorId,omitempty"`
+	Severity    string             `json:"severity,omitempty"`
+	Occurrences uint               `json:"occurrences,omitempty"`
+}
+
+func NewNodeEventResource(event events.Event) NodeEventResource {
+	r := NodeEventResource{
+		Type:        event.Type,
+		Time:        event.Time,
+		RunState:    event.RunState,
+		Severity:    event.Severity,
+		Occurrences: event.Occurrences,
+	}
+	if event.JobID != 0 {
+		r.JobID = NewJAIDInt32(event.JobID).ID
+	}
+	if event.RunID != 0 {
+		r.RunID = NewJAIDInt64(event.RunID).ID
+	}
+	if event.EthTxID != 0 {
+		r.EthTxID = NewJAIDInt64(event.EthTxID).ID
+		r.FromAddress = &event.FromAddress
+	}
+	if event.SpecErrorID != 0 {
+		r.SpecErrorID = NewJAIDInt64(event.SpecErrorID).ID
+	}
+	return r
+}
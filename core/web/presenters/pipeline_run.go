@@ -15,13 +15,14 @@ import (
 // Corresponds with models.d.ts PipelineRun
 type PipelineRunResource struct {
 	JAID
-	Outputs      []*string                 `json:"outputs"`
-	Errors       []*string                 `json:"errors"`
-	Inputs       pipeline.JSONSerializable `json:"inputs"`
-	TaskRuns     []PipelineTaskRunResource `json:"taskRuns"`
-	CreatedAt    time.Time                 `json:"createdAt"`
-	FinishedAt   time.Time                 `json:"finishedAt"`
-	PipelineSpec PipelineSpec              `json:"pipelineSpec"`
+	Outputs       []*string                 `json:"outputs"`
+	Errors        []*string                 `json:"errors"`
+	Inputs        pipeline.JSONSerializable `json:"inputs"`
+	TaskRuns      []PipelineTaskRunResource `json:"taskRuns"`
+	CreatedAt     time.Time                 `json:"createdAt"`
+	FinishedAt    time.Time                 `json:"finishedAt"`
+	PipelineSpec  PipelineSpec              `json:"pipelineSpec"`
+	BridgeCredits *string                   `json:"bridgeCredits"`
 }
 
 // GetName implements the api2go EntityNamer interface
@@ -75,15 +76,21 @@ func NewPipelineRunResource(pr pipeline.Run) PipelineRunResource {
 			errors = append(errors, nil)
 		}
 	}
+	var bridgeCredits *string
+	if pr.BridgeCredits.Valid {
+		s := pr.BridgeCredits.Decimal.String()
+		bridgeCredits = &s
+	}
 	return PipelineRunResource{
-		JAID:         NewJAIDInt64(pr.ID),
-		Outputs:      outputs,
-		Errors:       errors,
-		Inputs:       pr.Inputs,
-		TaskRuns:     trs,
-		CreatedAt:    pr.CreatedAt,
-		FinishedAt:   pr.FinishedAt.ValueOrZero(),
-		PipelineSpec: NewPipelineSpec(&pr.PipelineSpec),
+		JAID:          NewJAIDInt64(pr.ID),
+		Outputs:       outputs,
+		Errors:        errors,
+		Inputs:        pr.Inputs,
+		TaskRuns:      trs,
+		CreatedAt:     pr.CreatedAt,
+		FinishedAt:    pr.FinishedAt.ValueOrZero(),
+		PipelineSpec:  NewPipelineSpec(&pr.PipelineSpec),
+		BridgeCredits: bridgeCredits,
 	}
 }
 
@@ -94,7 +101,11 @@ type PipelineTaskRunResource struct {
 	FinishedAt time.Time         `json:"finishedAt"`
 	Output     *string           `json:"output"`
 	Error      *string           `json:"error"`
-	DotID      string            `json:"dotId"`
+	// ErrorCategory and ErrorRetryable classify Error, so that failures can
+	// be grouped
+	ErrorCategory  *string `json:"errorCategory"`
+	ErrorRetryable bool    `json:"errorRetryable"`
+	DotID          string  `json:"dotId"`
 }
 
 // GetName implements the api2go EntityNamer interface
@@ -113,13 +124,19 @@ func NewPipelineTaskRunResource(tr pipeline.TaskRun) PipelineTaskRunResource {
 	if tr.Error.Valid {
 		error = &tr.Error.String
 	}
+	var errorCategory *string
+	if tr.ErrorCategory.Valid {
+		errorCategory = &tr.ErrorCategory.String
+	}
 	return PipelineTaskRunResource{
-		Type:       tr.Type,
-		CreatedAt:  tr.CreatedAt,
-		FinishedAt: tr.FinishedAt.ValueOrZero(),
-		Output:     output,
-		Error:      error,
-		DotID:      tr.GetDotID(),
+		Type:           tr.Type,
+		CreatedAt:      tr.CreatedAt,
+		FinishedAt:     tr.FinishedAt.ValueOrZero(),
+		Output:         output,
+		Error:          error,
+		ErrorCategory:  errorCategory,
+		ErrorRetryable: tr.ErrorRetryable,
+		DotID:          tr.GetDotID(),
 	}
 }
 
@@ -132,3 +149,30 @@ func NewPipelineRunResources(prs []pipeline.Run) []PipelineRunResource {
 
 	return out
 }
+
+// PipelineRunUpdateResource is streamed to the Operator UI as a pipeline run
+// makes progress. TaskRun is set when a task finishes, and is omitted once
+// the run has been saved.
+type PipelineRunUpdateResource struct {
+	RunID      string                   `json:"runId"`
+	JobID      string                   `json:"jobId"`
+	State      pipeline.RunStatus       `json:"state"`
+	TaskRun    *PipelineTaskRunResource `json:"taskRun,omitempty"`
+	FinishedAt *time.Time               `json:"finishedAt"`
+}
+
+func NewPipelineRunUpdateResource(update pipeline.RunUpdate) PipelineRunUpdateResource {
+	r := PipelineRunUpdateResource{
+		RunID: NewJAIDInt64(update.RunID).ID,
+		JobID: NewJAIDInt32(update.JobID).ID,
+		State: update.State,
+	}
+	if update.TaskRun != nil {
+		tr := NewPipelineTaskRunResource(*update.TaskRun)
+		r.TaskRun = &tr
+	}
+	if update.FinishedAt.Valid {
+		r.FinishedAt = &update.FinishedAt.Time
+	}
+	return r
+}
@@ -0,0 +1,60 @@
+package presenters
+
+import (
+	"time"
+
+	httypes "github.com/smartcontractkit/chainlink/core/services/headtracker/types"
+)
+
+// ReorgStatsResource represents the reorgs observed on a chain
+type ReorgStatsResource struct {
+	JAID
+	EVMChainID  string                    `json:"evmChainID"`
+	Count       int64                     `json:"count"`
+	MaxDepth    int64                     `json:"maxDepth"`
+	LastReorgAt *time.Time                `json:"lastReorgAt"`
+	Depths      []ReorgDepthCountResource `json:"depths"`
+}
+
+// ReorgDepthCountResource is the number of reorgs of a given depth
+type ReorgDepthCountResource struct {
+	Depth      int64     `json:"depth"`
+	Count      int64     `json:"count"`
+	LastSeenAt time.Time `json:"lastSeenAt"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r ReorgStatsResource) GetName() string {
+	return "reorgStats"
+}
+
+// NewReorgStatsResource constructs a new ReorgStatsResource
+func NewReorgStatsResource(stats httypes.ReorgStats) ReorgStatsResource {
+	r := ReorgStatsResource{
+		JAID:       NewJAID(stats.EVMChainID.String()),
+		EVMChainID: stats.EVMChainID.String(),
+		Count:      stats.Count,
+		MaxDepth:   stats.MaxDepth,
+		Depths:     []ReorgDepthCountResource{},
+	}
+	if !stats.LastReorgAt.IsZero() {
+		r.LastReorgAt = &stats.LastReorgAt
+	}
+	for _, d := range stats.Depths {
+		r.Depths = append(r.Depths, ReorgDepthCountResource{
+			Depth:      d.Depth,
+			Count:      d.Count,
+			LastSeenAt: d.LastSeenAt,
+		})
+	}
+	return r
+}
+
+// NewReorgStatsResources constructs a slice of ReorgStatsResources
+func NewReorgStatsResources(stats []httypes.ReorgStats) []ReorgStatsResource {
+	rs := []ReorgStatsResource{}
+	for _, s := range stats {
+		rs = append(rs, NewReorgStatsResource(s))
+	}
+	return rs
+}
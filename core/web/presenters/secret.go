@@ -0,0 +1,41 @@
+package presenters
+
+import (
+	"time"
+
+	"github.com/smartcontractkit/chainlink/core/services/keystore"
+)
+
+// SecretResource represents a secret JSONAPI resource. Secret values are
+// never returned.
+type SecretResource struct {
+	JAID
+	Name      string    `json:"name"`
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (SecretResource) GetName() string {
+	return "secrets"
+}
+
+// NewSecretResource constructs a new SecretResource, using the name as the id
+func NewSecretResource(secret keystore.Secret) *SecretResource {
+	return &SecretResource{
+		JAID:      NewJAID(secret.Name),
+		Name:      secret.Name,
+		CreatedAt: secret.CreatedAt,
+		UpdatedAt: secret.UpdatedAt,
+	}
+}
+
+// NewSecretResources constructs SecretResources
+func NewSecretResources(secrets []keystore.Secret) []SecretResource {
+	rs := []SecretResource{}
+	for _, secret := range secrets {
+		rs = append(rs, *NewSecretResource(secret))
+	}
+
+	return rs
+}
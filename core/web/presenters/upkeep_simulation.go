@@ -0,0 +1,55 @@
+package presenters
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/smartcontractkit/chainlink/core/services/keeper"
+)
+
+// UpkeepSimulationResource represents the latest performUpkeep simulation of
+// an upkeep
+type UpkeepSimulationResource struct {
+	JAID
+	JobID              int32      `json:"jobID"`
+	RegistryAddress    string     `json:"registryAddress"`
+	UpkeepID           int64      `json:"upkeepID"`
+	BlockNumber        int64      `json:"blockNumber"`
+	PerformGasEstimate *int64     `json:"performGasEstimate"`
+	RevertReason       *string    `json:"revertReason"`
+	ConsecutiveReverts int32      `json:"consecutiveReverts"`
+	Parked             bool       `json:"parked"`
+	ParkedUntil        *time.Time `json:"parkedUntil"`
+	SimulatedAt        time.Time  `json:"simulatedAt"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r UpkeepSimulationResource) GetName() string {
+	return "upkeepSimulations"
+}
+
+// NewUpkeepSimulationResource constructs a new UpkeepSimulationResource
+func NewUpkeepSimulationResource(sim keeper.UpkeepSimulation) UpkeepSimulationResource {
+	return UpkeepSimulationResource{
+		JAID:               NewJAID(strconv.Itoa(int(sim.UpkeepRegistrationID))),
+		JobID:              sim.UpkeepRegistration.Registry.JobID,
+		RegistryAddress:    sim.UpkeepRegistration.Registry.ContractAddress.Hex(),
+		UpkeepID:           sim.UpkeepRegistration.UpkeepID,
+		BlockNumber:        sim.BlockNumber,
+		PerformGasEstimate: sim.PerformGasEstimate.Ptr(),
+		RevertReason:       sim.RevertReason.Ptr(),
+		ConsecutiveReverts: sim.ConsecutiveReverts,
+		Parked:             sim.Parked(time.Now()),
+		ParkedUntil:        sim.ParkedUntil,
+		SimulatedAt:        sim.SimulatedAt,
+	}
+}
+
+// NewUpkeepSimulationResources constructs a slice of UpkeepSimulationResources
+func NewUpkeepSimulationResources(sims []keeper.UpkeepSimulation) []UpkeepSimulationResource {
+	rs := []UpkeepSimulationResource{}
+	for _, s := range sims {
+		rs = append(rs, NewUpkeepSimulationResource(s))
+	}
+	return rs
+}
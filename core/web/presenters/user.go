@@ -9,8 +9,10 @@ import (
 // UserResource represents a User JSONAPI resource.
 type UserResource struct {
 	JAID
-	Email     string    `json:"email"`
-	CreatedAt time.Time `json:"createdAt"`
+	Email       string    `json:"email"`
+	TOTPEnabled bool      `json:"totpEnabled"`
+	Admin       bool      `json:"admin"`
+	CreatedAt   time.Time `json:"createdAt"`
 }
 
 // GetName implements the api2go EntityNamer interface
@@ -23,8 +25,72 @@ func (r UserResource) GetName() string {
 // A User does not have an ID primary key, so we must use the email
 func NewUserResource(u models.User) *UserResource {
 	return &UserResource{
-		JAID:      NewJAID(u.Email),
-		Email:     u.Email,
-		CreatedAt: u.CreatedAt,
+		JAID:        NewJAID(u.Email),
+		Email:       u.Email,
+		TOTPEnabled: u.TOTPEnabled,
+		Admin:       u.Admin,
+		CreatedAt:   u.CreatedAt,
+	}
+}
+
+// NewUserResources initializes a slice of JSONAPI user resources
+func NewUserResources(users []models.User) []UserResource {
+	rs := []UserResource{}
+	for _, u := range users {
+		rs = append(rs, *NewUserResource(u))
+	}
+
+	return rs
+}
+
+// SessionResource represents a session of the current user
+type SessionResource struct {
+	JAID
+	LastUsed  time.Time `json:"lastUsed"`
+	CreatedAt time.Time `json:"createdAt"`
+	// Current is whether the session is the one of the request
+	Current bool `json:"current"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r SessionResource) GetName() string {
+	return "sessions"
+}
+
+// NewSessionResources initializes a slice of JSONAPI session resources,
+// flagging the one with the currentID
+func NewSessionResources(sessions []models.Session, currentID string) []SessionResource {
+	rs := []SessionResource{}
+	for _, s := range sessions {
+		rs = append(rs, SessionResource{
+			JAID:      NewJAID(s.ID),
+			LastUsed:  s.LastUsed,
+			CreatedAt: s.CreatedAt,
+			Current:   s.ID == currentID,
+		})
+	}
+
+	return rs
+}
+
+// TOTPSecretResource is a new TOTP secret of the current user, to be added
+// to an authenticator app before enabling TOTP
+type TOTPSecretResource struct {
+	JAID
+	Secret string `json:"secret"`
+	URI    string `json:"uri"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r TOTPSecretResource) GetName() string {
+	return "totpSecrets"
+}
+
+// NewTOTPSecretResource constructs a new TOTPSecretResource
+func NewTOTPSecretResource(u models.User, uri string) *TOTPSecretResource {
+	return &TOTPSecretResource{
+		JAID:   NewJAID(u.Email),
+		Secret: u.TOTPSecret,
+		URI:    uri,
 	}
 }
@@ -32,6 +32,8 @@ func TestUserResource(t *testing.T) {
 		   "id": "notreal@fakeemail.ch",
 		   "attributes": {
 			  "email": "notreal@fakeemail.ch",
+			  "totpEnabled": false,
+			  "admin": false,
 			  "createdAt": "2000-01-01T00:00:00Z"
 		   }
 		}
@@ -0,0 +1,57 @@
+package presenters
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/smartcontractkit/chainlink/core/services/vrf"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+// VRFDeferredFulfillmentResource represents a request of a VRF v2 job whose
+// fulfillment is waiting for gas prices to fall
+type VRFDeferredFulfillmentResource struct {
+	JAID
+	JobID               int32      `json:"jobID"`
+	RequestID           string     `json:"requestID"`
+	SubID               uint64     `json:"subID"`
+	RequestTxHash       string     `json:"requestTxHash"`
+	RequestBlockNumber  uint64     `json:"requestBlockNumber"`
+	DeadlineBlockNumber uint64     `json:"deadlineBlockNumber"`
+	GasPrice            *utils.Big `json:"gasPrice"`
+	Attempts            int64      `json:"attempts"`
+	CreatedAt           time.Time  `json:"createdAt"`
+	UpdatedAt           time.Time  `json:"updatedAt"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r VRFDeferredFulfillmentResource) GetName() string {
+	return "vrfDeferredFulfillments"
+}
+
+// NewVRFDeferredFulfillmentResource constructs a new VRFDeferredFulfillmentResource
+func NewVRFDeferredFulfillmentResource(d vrf.DeferredFulfillment) VRFDeferredFulfillmentResource {
+	gasPrice := d.GasPrice
+	return VRFDeferredFulfillmentResource{
+		JAID:                NewJAID(strconv.FormatInt(d.ID, 10)),
+		JobID:               d.JobID,
+		RequestID:           d.RequestID.Hex(),
+		SubID:               d.SubID,
+		RequestTxHash:       d.RequestTxHash.Hex(),
+		RequestBlockNumber:  d.RequestBlockNumber,
+		DeadlineBlockNumber: d.DeadlineBlockNumber,
+		GasPrice:            &gasPrice,
+		Attempts:            d.Attempts,
+		CreatedAt:           d.CreatedAt,
+		UpdatedAt:           d.UpdatedAt,
+	}
+}
+
+// NewVRFDeferredFulfillmentResources constructs a slice of VRFDeferredFulfillmentResources
+func NewVRFDeferredFulfillmentResources(deferred []vrf.DeferredFulfillment) []VRFDeferredFulfillmentResource {
+	rs := []VRFDeferredFulfillmentResource{}
+	for _, d := range deferred {
+		rs = append(rs, NewVRFDeferredFulfillmentResource(d))
+	}
+	return rs
+}
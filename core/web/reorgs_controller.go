@@ -0,0 +1,36 @@
+package web
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/smartcontractkit/chainlink/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/core/services/headtracker"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+// ReorgsController exposes the reorg statistics collected by the head tracker
+type ReorgsController struct {
+	App chainlink.Application
+}
+
+// Index lists the reorg statistics of every chain with reorgs, optionally
+// only of the reorgs since the RFC3339 time of the `since` query param
+// Example:
+// "GET <application>/reorgs?since=2021-09-01T00:00:00Z"
+func (rc *ReorgsController) Index(c *gin.Context) {
+	since, err := queryTime(c, "since")
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	stats, err := headtracker.NewORM(rc.App.GetStore().DB).ReorgStats(c.Request.Context(), since.Time)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewReorgStatsResources(stats), "reorgStats")
+}
@@ -7,6 +7,7 @@ import (
 	"io"
 	"io/ioutil"
 	"math"
+	"net"
 	"net/http"
 	"net/http/pprof"
 	"net/url"
@@ -24,6 +25,7 @@ import (
 	"github.com/gin-gonic/contrib/sessions"
 	"github.com/gin-gonic/gin"
 	"github.com/gobuffalo/packr"
+	gsessions "github.com/gorilla/sessions"
 	"github.com/smartcontractkit/chainlink/core/logger"
 	"github.com/smartcontractkit/chainlink/core/services/chainlink"
 	"github.com/smartcontractkit/chainlink/core/store/config"
@@ -83,12 +85,17 @@ func Router(app chainlink.Application) *gin.Engine {
 	if err != nil {
 		logger.Panic(err)
 	}
-	sessionStore := sessions.NewCookieStore(secret)
+	trustedProxies, err := config.TrustedProxies()
+	if err != nil {
+		logger.Panic(err)
+	}
+	sessionStore := newSameSiteCookieStore(sessions.NewCookieStore(secret), sameSite(config.SessionCookieSameSite()))
 	sessionStore.Options(config.SessionOptions())
 	cors := uiCorsHandler(config)
 
 	prometheus.Use(engine)
 	engine.Use(
+		forwardedClientIP(trustedProxies),
 		limits.RequestSizeLimiter(config.DefaultHTTPLimit()),
 		loggerFunc(),
 		gin.Recovery(),
@@ -108,10 +115,11 @@ func Router(app chainlink.Application) *gin.Engine {
 		explorerStatus(app),
 	)
 
+	guard := newAuthGuard(config)
 	metricRoutes(app, api)
 	healthRoutes(app, api)
-	sessionRoutes(app, api)
-	v2Routes(app, api)
+	sessionRoutes(app, api, guard)
+	v2Routes(app, api, guard)
 
 	guiAssetRoutes(app.NewBox(), engine, config)
 
@@ -130,12 +138,17 @@ func rateLimiter(period time.Duration, limit int64) gin.HandlerFunc {
 // secureOptions configure security options for the secure middleware, mostly
 // for TLS redirection
 func secureOptions(cfg orm.ConfigReader) secure.Options {
-	return secure.Options{
+	options := secure.Options{
 		FrameDeny:     true,
 		IsDevelopment: cfg.Dev(),
 		SSLRedirect:   cfg.TLSRedirect(),
 		SSLHost:       cfg.TLSHost(),
 	}
+	// Requests forwarded by a proxy terminating TLS are not redirected
+	if proxies, err := cfg.TrustedProxies(); err == nil && len(proxies) > 0 {
+		options.SSLProxyHeaders = map[string]string{"X-Forwarded-Proto": "https"}
+	}
+	return options
 }
 
 // secureMiddleware adds a TLS handler and redirector, to button up security
@@ -190,15 +203,15 @@ func pprofHandler(h http.HandlerFunc) gin.HandlerFunc {
 	}
 }
 
-func sessionRoutes(app chainlink.Application, r *gin.RouterGroup) {
+func sessionRoutes(app chainlink.Application, r *gin.RouterGroup, guard *authGuard) {
 	config := app.GetStore().Config
 	unauth := r.Group("/", rateLimiter(
 		config.UnAuthenticatedRateLimitPeriod().Duration(),
 		config.UnAuthenticatedRateLimit(),
 	))
 	sc := SessionsController{app}
-	unauth.POST("/sessions", sc.Create)
-	auth := r.Group("/", RequireAuth(app.GetStore(), AuthenticateBySession))
+	unauth.POST("/sessions", guard.protect(authMethodSession, sessionAuthKeys), sc.Create)
+	auth := r.Group("/", RequireAuth(app.GetStore(), AuthenticateBySession), auditLog(app.GetStore()))
 	auth.DELETE("/sessions", sc.Destroy)
 }
 
@@ -208,7 +221,7 @@ func healthRoutes(app chainlink.Application, r *gin.RouterGroup) {
 	r.GET("/health", hc.Health)
 }
 
-func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
+func v2Routes(app chainlink.Application, r *gin.RouterGroup, guard *authGuard) {
 	unauthedv2 := r.Group("/v2")
 
 	jr := JobRunsController{app}
@@ -221,14 +234,25 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 	jsec := JobSpecErrorsController{app}
 	prc := PipelineRunsController{app}
 	psec := PipelineJobSpecErrorsController{app}
-	unauthedv2.PATCH("/resume/:runID", prc.Resume)
+	unauthedv2.PATCH("/resume/:runID", prc.ResumeBridgeTask)
+	unauthedv2.PATCH("/pipeline/runs/:runID/resume", prc.ResumeBridgeTask)
 
-	authv2 := r.Group("/v2", RequireAuth(app.GetStore(), AuthenticateByToken, AuthenticateBySession))
+	authv2 := r.Group("/v2", guard.protect(authMethodToken, tokenAuthKeys), RequireAuth(app.GetStore(), AuthenticateByToken, AuthenticateBySession), requireTOTP(), auditLog(app.GetStore()))
 	{
 		uc := UserController{app}
 		authv2.PATCH("/user/password", uc.UpdatePassword)
 		authv2.POST("/user/token", uc.NewAPIToken)
 		authv2.POST("/user/token/delete", uc.DeleteAPIToken)
+		authv2.GET("/user/sessions", uc.Sessions)
+		authv2.DELETE("/user/sessions/:id", uc.RevokeSession)
+		authv2.POST("/user/totp", uc.CreateTOTPSecret)
+		authv2.POST("/user/totp/enable", uc.EnableTOTP)
+		authv2.DELETE("/user/totp", uc.DisableTOTP)
+
+		usc := UsersController{app}
+		authv2.GET("/users", usc.Index)
+		authv2.POST("/users", requireAdmin(), usc.Create)
+		authv2.DELETE("/users/:email", requireAdmin(), usc.Delete)
 
 		eia := ExternalInitiatorsController{app}
 		authv2.GET("/external_initiators", paginatedRequest(eia.Index))
@@ -256,16 +280,20 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 		authv2.DELETE("/bridge_types/:BridgeName", bt.Destroy)
 
 		ts := TransfersController{app}
-		authv2.POST("/transfers", ts.Create)
+		authv2.POST("/transfers", requireAdmin(), ts.Create)
 
 		cc := ConfigController{app}
 		authv2.GET("/config", cc.Show)
+		authv2.GET("/config/v2", cc.ShowV2)
 		authv2.PATCH("/config", cc.Patch)
 
 		feedsMgrCtlr := FeedsManagerController{app}
 		authv2.GET("/feeds_managers", feedsMgrCtlr.List)
 		authv2.POST("/feeds_managers", feedsMgrCtlr.Create)
 		authv2.GET("/feeds_managers/:id", feedsMgrCtlr.Show)
+		authv2.GET("/feeds_managers/:id/chain_configs", feedsMgrCtlr.ListChainConfigs)
+		authv2.POST("/feeds_managers/:id/chain_configs", feedsMgrCtlr.CreateChainConfig)
+		authv2.DELETE("/feeds_managers/:id/chain_configs/:chainConfigID", feedsMgrCtlr.DeleteChainConfig)
 
 		tas := TxAttemptsController{app}
 		authv2.GET("/tx_attempts", paginatedRequest(tas.Index))
@@ -273,6 +301,7 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 		txs := TransactionsController{app}
 		authv2.GET("/transactions", paginatedRequest(txs.Index))
 		authv2.GET("/transactions/:TxHash", txs.Show)
+		authv2.POST("/transactions/:TxHash/cancel", txs.Cancel)
 
 		bdc := BulkDeletesController{app}
 		authv2.DELETE("/bulk_delete_runs", bdc.Delete)
@@ -280,60 +309,156 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 		rc := ReplayController{app}
 		authv2.POST("/replay_from_block/:number", rc.ReplayFromBlock)
 
+		dc := DrainController{app}
+		authv2.GET("/drain", dc.Show)
+		authv2.POST("/drain", requireAdmin(), dc.Create)
+
+		ksc := KeyStoreController{app}
+		authv2.PATCH("/keys/password", requireAdmin(), ksc.ChangePassword)
+		authv2.GET("/keys/audit", paginatedRequest(ksc.AuditLog))
+
+		alc := AuditLogController{app}
+		authv2.GET("/audit_log", paginatedRequest(alc.Index))
+
 		ekc := ETHKeysController{app}
 		authv2.GET("/keys/eth", ekc.Index)
 		authv2.POST("/keys/eth", ekc.Create)
 		authv2.DELETE("/keys/eth/:keyID", ekc.Delete)
-		authv2.POST("/keys/eth/import", ekc.Import)
-		authv2.POST("/keys/eth/export/:address", ekc.Export)
+		authv2.POST("/keys/eth/import", requireAdmin(), ekc.Import)
+		authv2.POST("/keys/eth/export/:address", requireAdmin(), ekc.Export)
+		authv2.POST("/keys/eth/reconcile_nonce/:address", ekc.ReconcileNonce)
+		authv2.PATCH("/keys/eth/policy/:address", ekc.UpdatePolicy)
+		authv2.GET("/keys/eth/diagnose/:address", ekc.Diagnose)
+		authv2.POST("/keys/eth/rotate/:address", requireAdmin(), ekc.Rotate)
+
+		efc := EthForwardersController{app}
+		authv2.GET("/forwarders", efc.Index)
+		authv2.POST("/forwarders", efc.Create)
+		authv2.DELETE("/forwarders/:fwdID", efc.Delete)
+
+		chc := ChainsController{app}
+		authv2.GET("/chains/evm", chc.Index)
+		authv2.POST("/chains/evm", chc.Create)
+		authv2.PATCH("/chains/evm/:ID", chc.Update)
+		authv2.DELETE("/chains/evm/:ID", chc.Delete)
+
+		ac := AlertsController{app}
+		authv2.GET("/alerts", paginatedRequest(ac.Index))
+		arc := AlertRulesController{app}
+		authv2.GET("/alerts/rules", arc.Index)
+		authv2.POST("/alerts/rules", arc.Create)
+		authv2.DELETE("/alerts/rules/:ID", arc.Delete)
+		acc := AlertChannelsController{app}
+		authv2.GET("/alerts/channels", acc.Index)
+		authv2.POST("/alerts/channels", acc.Create)
+		authv2.DELETE("/alerts/channels/:ID", acc.Delete)
+		asc := AlertSilencesController{app}
+		authv2.GET("/alerts/silences", asc.Index)
+		authv2.POST("/alerts/silences", asc.Create)
+		authv2.DELETE("/alerts/silences/:ID", asc.Delete)
+
+		gec := GasEstimatesController{app}
+		authv2.GET("/gas_estimates", gec.Index)
+
+		roc := ReorgsController{app}
+		authv2.GET("/reorgs", roc.Index)
+
+		ersc := EthRPCStatsController{app}
+		authv2.GET("/eth/rpc_stats", ersc.Index)
+
+		krc := KeeperRegistriesController{app}
+		authv2.GET("/keeper/registries", krc.Index)
+
+		kuc := KeeperUpkeepsController{app}
+		authv2.GET("/keeper/upkeeps", kuc.Index)
+
+		upsc := UpkeepSimulationsController{app}
+		authv2.GET("/keeper/upkeep_simulations", upsc.Index)
+
+		vdfc := VRFDeferredFulfillmentsController{app}
+		authv2.GET("/vrf/deferred_fulfillments", vdfc.Index)
 
 		ocrkc := OCRKeysController{app}
 		authv2.GET("/keys/ocr", ocrkc.Index)
 		authv2.POST("/keys/ocr", ocrkc.Create)
 		authv2.DELETE("/keys/ocr/:keyID", ocrkc.Delete)
-		authv2.POST("/keys/ocr/import", ocrkc.Import)
-		authv2.POST("/keys/ocr/export/:ID", ocrkc.Export)
+		authv2.POST("/keys/ocr/import", requireAdmin(), ocrkc.Import)
+		authv2.POST("/keys/ocr/export/:ID", requireAdmin(), ocrkc.Export)
 
 		p2pkc := P2PKeysController{app}
 		authv2.GET("/keys/p2p", p2pkc.Index)
 		authv2.POST("/keys/p2p", p2pkc.Create)
 		authv2.DELETE("/keys/p2p/:keyID", p2pkc.Delete)
-		authv2.POST("/keys/p2p/import", p2pkc.Import)
-		authv2.POST("/keys/p2p/export/:ID", p2pkc.Export)
+		authv2.POST("/keys/p2p/import", requireAdmin(), p2pkc.Import)
+		authv2.POST("/keys/p2p/export/:ID", requireAdmin(), p2pkc.Export)
 
 		csakc := CSAKeysController{app}
 		authv2.GET("/keys/csa", csakc.Index)
 		authv2.POST("/keys/csa", csakc.Create)
+		authv2.POST("/keys/csa/import", requireAdmin(), csakc.Import)
+		authv2.POST("/keys/csa/export/:ID", requireAdmin(), csakc.Export)
+
+		sc := SecretsController{app}
+		authv2.GET("/secrets", sc.Index)
+		authv2.POST("/secrets", sc.Create)
+		authv2.DELETE("/secrets/:name", sc.Delete)
 
 		vrfkc := VRFKeysController{app}
 		authv2.GET("/keys/vrf", vrfkc.Index)
 		authv2.POST("/keys/vrf", vrfkc.Create)
 		authv2.DELETE("/keys/vrf/:keyID", vrfkc.Delete)
-		authv2.POST("/keys/vrf/import", vrfkc.Import)
-		authv2.POST("/keys/vrf/export/:keyID", vrfkc.Export)
+		authv2.POST("/keys/vrf/import", requireAdmin(), vrfkc.Import)
+		authv2.POST("/keys/vrf/export/:keyID", requireAdmin(), vrfkc.Export)
 
 		jc := JobsController{app}
 		authv2.GET("/jobs", paginatedRequest(jc.Index))
 		authv2.GET("/jobs/:ID", jc.Show)
 		authv2.POST("/jobs", jc.Create)
 		authv2.DELETE("/jobs/:ID", jc.Delete)
+		authv2.GET("/jobs/:ID/costs", jc.Costs)
+		authv2.PATCH("/jobs/:ID/labels", jc.UpdateLabels)
+		authv2.POST("/jobs/:ID/reactivate", jc.Reactivate)
+		authv2.POST("/bulk_create_jobs", jc.BulkCreate)
+		authv2.POST("/bulk_delete_jobs", jc.BulkDelete)
+		authv2.POST("/bulk_pause_jobs", jc.BulkPause)
+		authv2.POST("/bulk_resume_jobs", jc.BulkResume)
+
+		jtc := JobTemplatesController{app}
+		authv2.GET("/job_templates", paginatedRequest(jtc.Index))
+		authv2.POST("/job_templates", jtc.Create)
+		authv2.GET("/job_templates/:Name", jtc.Show)
+		authv2.DELETE("/job_templates/:Name", jtc.Destroy)
 
 		jpc := JobProposalsController{app}
 		authv2.GET("/job_proposals", jpc.Index)
 		authv2.GET("/job_proposals/:id", jpc.Show)
 		authv2.POST("/job_proposals/:id/approve", jpc.Approve)
 		authv2.POST("/job_proposals/:id/reject", jpc.Reject)
+		authv2.POST("/job_proposals/:id/archive", jpc.Archive)
 		authv2.PATCH("/job_proposals/:id/spec", jpc.UpdateSpec)
+		authv2.POST("/bulk_approve_job_proposals", jpc.BulkApprove)
 
 		mc := MigrateController{app}
 		authv2.POST("/migrate/:ID", mc.Migrate)
 
+		ec := ExportsController{app}
+		authv2.GET("/exports/:dataset", ec.Show)
+
 		// PipelineRunsController
 		authv2.GET("/pipeline/runs", paginatedRequest(prc.Index))
+		authv2.POST("/pipeline/preview", prc.Preview)
 		authv2.GET("/jobs/:ID/runs", paginatedRequest(prc.Index))
 		authv2.GET("/jobs/:ID/runs/:runID", prc.Show)
+		authv2.GET("/jobs/:ID/runs/stream", prc.Stream)
+		authv2.GET("/jobs/:ID/runs/:runID/stream", prc.Stream)
+
+		evc := EventsController{app}
+		authv2.GET("/events/stream", evc.Stream)
 
 		// PipelineJobSpecErrorsController
+		authv2.GET("/pipeline/job_spec_errors", paginatedRequest(psec.Index))
+		authv2.GET("/pipeline/job_spec_error_summaries", psec.Summaries)
+		authv2.POST("/pipeline/bulk_dismiss_job_spec_errors", psec.BulkDismiss)
 		authv2.DELETE("/pipeline/job_spec_errors/:ID", psec.Destroy)
 
 		lgc := LogController{app}
@@ -342,7 +467,7 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 	}
 
 	ping := PingController{app}
-	userOrEI := r.Group("/v2", RequireAuth(app.GetStore(),
+	userOrEI := r.Group("/v2", guard.protect(authMethodToken, tokenAuthKeys), RequireAuth(app.GetStore(),
 		AuthenticateExternalInitiator,
 		AuthenticateByToken,
 		AuthenticateBySession,
@@ -475,11 +600,103 @@ func uiCorsHandler(config orm.ConfigReader) gin.HandlerFunc {
 	if config.AllowOrigins() == "*" {
 		c.AllowAllOrigins = true
 	} else if allowOrigins := strings.Split(config.AllowOrigins(), ","); len(allowOrigins) > 0 {
+		for i, origin := range allowOrigins {
+			allowOrigins[i] = strings.TrimSpace(origin)
+		}
 		c.AllowOrigins = allowOrigins
+		// e.g. https://*.example.com for UIs deployed per environment
+		c.AllowWildcard = true
 	}
 	return cors.New(c)
 }
 
+// forwardedClientIP replaces the remote address of requests relayed by a
+// trusted proxy with the address of the client they were forwarded for, so
+// that rate limiting and request logs see the client rather than the proxy.
+// The X-Forwarded-For header is read from the right, skipping the addresses
+// of trusted proxies, as anything to their left may be forged by the client.
+func forwardedClientIP(trustedProxies []*net.IPNet) gin.HandlerFunc {
+	isTrusted := func(ip net.IP) bool {
+		for _, proxy := range trustedProxies {
+			if proxy.Contains(ip) {
+				return true
+			}
+		}
+		return false
+	}
+	return func(c *gin.Context) {
+		if len(trustedProxies) == 0 {
+			return
+		}
+		host, port, err := net.SplitHostPort(c.Request.RemoteAddr)
+		if err != nil || !isTrusted(net.ParseIP(host)) {
+			return
+		}
+
+		var forwardedFor []string
+		for _, header := range c.Request.Header.Values("X-Forwarded-For") {
+			forwardedFor = append(forwardedFor, strings.Split(header, ",")...)
+		}
+		if len(forwardedFor) == 0 {
+			if realIP := c.GetHeader("X-Real-IP"); realIP != "" {
+				forwardedFor = []string{realIP}
+			}
+		}
+
+		var clientIP net.IP
+		for i := len(forwardedFor) - 1; i >= 0; i-- {
+			ip := net.ParseIP(strings.TrimSpace(forwardedFor[i]))
+			if ip == nil {
+				// A malformed header is ignored rather than partially trusted
+				return
+			}
+			clientIP = ip
+			if !isTrusted(ip) {
+				break
+			}
+		}
+		if clientIP != nil {
+			c.Request.RemoteAddr = net.JoinHostPort(clientIP.String(), port)
+		}
+	}
+}
+
+// sameSiteCookieStore sets the SameSite attribute of the session cookie,
+// which the options of the sessions package do not support
+type sameSiteCookieStore struct {
+	sessions.CookieStore
+	sameSite http.SameSite
+}
+
+func newSameSiteCookieStore(store sessions.CookieStore, sameSite http.SameSite) *sameSiteCookieStore {
+	return &sameSiteCookieStore{CookieStore: store, sameSite: sameSite}
+}
+
+// Get returns the session cached for the request, creating it with New
+func (s *sameSiteCookieStore) Get(r *http.Request, name string) (*gsessions.Session, error) {
+	return gsessions.GetRegistry(r).Get(s, name)
+}
+
+// New returns the session of the request with the SameSite attribute set
+func (s *sameSiteCookieStore) New(r *http.Request, name string) (*gsessions.Session, error) {
+	session, err := s.CookieStore.New(r, name)
+	if session != nil && session.Options != nil {
+		session.Options.SameSite = s.sameSite
+	}
+	return session, err
+}
+
+func sameSite(value string) http.SameSite {
+	switch value {
+	case "strict":
+		return http.SameSiteStrictMode
+	case "none":
+		return http.SameSiteNoneMode
+	default:
+		return http.SameSiteLaxMode
+	}
+}
+
 func readBody(reader io.Reader) string {
 	buf := new(bytes.Buffer)
 	_, err := buf.ReadFrom(reader)
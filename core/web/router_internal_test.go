@@ -0,0 +1,73 @@
+package web
+
+import (
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/contrib/sessions"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestForwardedClientIP(t *testing.T) {
+	t.Parallel()
+
+	_, proxies, err := net.ParseCIDR("10.0.0.0/8")
+	require.NoError(t, err)
+	trusted := []*net.IPNet{proxies}
+
+	tests := []struct {
+		name         string
+		proxies      []*net.IPNet
+		remoteAddr   string
+		headers      map[string][]string
+		expectedAddr string
+	}{
+		{"no trusted proxies", nil, "10.0.0.1:1234", map[string][]string{"X-Forwarded-For": {"1.2.3.4"}}, "10.0.0.1:1234"},
+		{"untrusted peer", trusted, "5.6.7.8:1234", map[string][]string{"X-Forwarded-For": {"1.2.3.4"}}, "5.6.7.8:1234"},
+		{"trusted proxy", trusted, "10.0.0.1:1234", map[string][]string{"X-Forwarded-For": {"1.2.3.4"}}, "1.2.3.4:1234"},
+		{"chain of trusted proxies", trusted, "10.0.0.1:1234", map[string][]string{"X-Forwarded-For": {"1.2.3.4, 10.0.0.2"}}, "1.2.3.4:1234"},
+		{"forged by the client", trusted, "10.0.0.1:1234", map[string][]string{"X-Forwarded-For": {"9.9.9.9, 1.2.3.4"}}, "1.2.3.4:1234"},
+		{"several headers", trusted, "10.0.0.1:1234", map[string][]string{"X-Forwarded-For": {"9.9.9.9", "1.2.3.4"}}, "1.2.3.4:1234"},
+		{"only trusted proxies", trusted, "10.0.0.1:1234", map[string][]string{"X-Forwarded-For": {"10.0.0.3, 10.0.0.2"}}, "10.0.0.3:1234"},
+		{"malformed header", trusted, "10.0.0.1:1234", map[string][]string{"X-Forwarded-For": {"1.2.3.4, bogus"}}, "10.0.0.1:1234"},
+		{"real ip", trusted, "10.0.0.1:1234", map[string][]string{"X-Real-Ip": {"1.2.3.4"}}, "1.2.3.4:1234"},
+		{"ipv6", trusted, "10.0.0.1:1234", map[string][]string{"X-Forwarded-For": {"2001:db8::1"}}, "[2001:db8::1]:1234"},
+	}
+
+	for _, tt := range tests {
+		test := tt
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			c, _ := gin.CreateTestContext(httptest.NewRecorder())
+			c.Request = httptest.NewRequest(http.MethodGet, "/", nil)
+			c.Request.RemoteAddr = test.remoteAddr
+			for name, values := range test.headers {
+				c.Request.Header[name] = values
+			}
+
+			forwardedClientIP(test.proxies)(c)
+			assert.Equal(t, test.expectedAddr, c.Request.RemoteAddr)
+		})
+	}
+}
+
+func TestSameSiteCookieStore(t *testing.T) {
+	t.Parallel()
+
+	store := newSameSiteCookieStore(sessions.NewCookieStore([]byte("secret")), sameSite("none"))
+	store.Options(sessions.Options{Secure: true, HttpOnly: true})
+
+	session, err := store.Get(httptest.NewRequest(http.MethodGet, "/", nil), SessionName)
+	require.NoError(t, err)
+	assert.Equal(t, http.SameSiteNoneMode, session.Options.SameSite)
+	assert.True(t, session.Options.Secure)
+
+	recorder := httptest.NewRecorder()
+	require.NoError(t, session.Save(httptest.NewRequest(http.MethodGet, "/", nil), recorder))
+	assert.Contains(t, recorder.Header().Get("Set-Cookie"), "SameSite=None")
+}
@@ -4,11 +4,13 @@ import (
 	"bytes"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 
 	"github.com/smartcontractkit/chainlink/core/auth"
 	"github.com/smartcontractkit/chainlink/core/internal/cltest"
 	"github.com/smartcontractkit/chainlink/core/store/models"
+	"github.com/smartcontractkit/chainlink/core/store/orm"
 	"github.com/smartcontractkit/chainlink/core/web"
 
 	"github.com/stretchr/testify/assert"
@@ -215,3 +217,56 @@ func TestRouter_GinHelmetHeaders(t *testing.T) {
 			"wrong header for helmet's %s handler", tt.HelmetName)
 	}
 }
+
+func TestRouter_TOTPRequiredOnMutatingRoutes(t *testing.T) {
+	ethClient, _, assertMocksCalled := cltest.NewEthMocksWithStartupAssertions(t)
+	defer assertMocksCalled()
+	app, cleanup := cltest.NewApplicationWithKey(t,
+		ethClient,
+	)
+	defer cleanup()
+	require.NoError(t, app.Start())
+
+	sessionID := app.MustSeedNewSession()
+	user, err := app.Store.FindUserByEmail(cltest.APIEmail)
+	require.NoError(t, err)
+	user.TOTPSecret, err = auth.NewTOTPSecret()
+	require.NoError(t, err)
+	user.TOTPEnabled = true
+	require.NoError(t, app.Store.SaveUser(&user))
+
+	// Routes which authenticate their callers otherwise
+	unauthenticated := map[string]bool{
+		"PATCH /v2/runs/:RunID":                 true,
+		"POST /v2/service_agreements":           true,
+		"PATCH /v2/resume/:runID":               true,
+		"PATCH /v2/pipeline/runs/:runID/resume": true,
+	}
+
+	router := web.Router(app)
+	var checked int
+	for _, route := range router.Routes() {
+		if route.Method == http.MethodGet || route.Method == http.MethodHead || !strings.HasPrefix(route.Path, "/v2/") {
+			continue
+		}
+		if unauthenticated[route.Method+" "+route.Path] {
+			continue
+		}
+		checked++
+
+		segments := strings.Split(route.Path, "/")
+		for i, segment := range segments {
+			if strings.HasPrefix(segment, ":") {
+				segments[i] = "1"
+			}
+		}
+		request := httptest.NewRequest(route.Method, strings.Join(segments, "/"), bytes.NewBufferString("{}"))
+		request.AddCookie(cltest.MustGenerateSessionCookie(sessionID))
+		recorder := httptest.NewRecorder()
+		router.ServeHTTP(recorder, request)
+
+		assert.Equal(t, http.StatusUnauthorized, recorder.Code, "%s %s", route.Method, route.Path)
+		assert.Contains(t, recorder.Body.String(), orm.ErrTOTPRequired.Error(), "%s %s", route.Method, route.Path)
+	}
+	assert.NotZero(t, checked)
+}
@@ -0,0 +1,76 @@
+package web
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/smartcontractkit/chainlink/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/core/services/keystore"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+// SecretsController manages the secrets referenced by `${secret.NAME}` in
+// job specs
+type SecretsController struct {
+	App chainlink.Application
+}
+
+// SecretRequest sets the value of a secret
+type SecretRequest struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// Index lists the secrets, without their values
+// Example:
+// "GET <application>/secrets"
+func (sc *SecretsController) Index(c *gin.Context) {
+	secrets, err := sc.App.GetKeyStore().Secrets().List()
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	jsonAPIResponse(c, presenters.NewSecretResources(secrets), "secrets")
+}
+
+// Create sets a secret, replacing the value of any existing secret with the
+// same name
+// Example:
+// "POST <application>/secrets"
+func (sc *SecretsController) Create(c *gin.Context) {
+	request := SecretRequest{}
+	if err := c.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	secret, err := sc.App.GetKeyStore().Secrets().Set(request.Name, request.Value)
+	if err != nil {
+		if errors.Is(err, keystore.ErrInvalidSecretName) {
+			jsonAPIError(c, http.StatusBadRequest, err)
+			return
+		}
+
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	jsonAPIResponse(c, presenters.NewSecretResource(secret), "secrets")
+}
+
+// Delete removes a secret
+// Example:
+// "DELETE <application>/secrets/:name"
+func (sc *SecretsController) Delete(c *gin.Context) {
+	err := sc.App.GetKeyStore().Secrets().Delete(c.Param("name"))
+	if err != nil {
+		if errors.Is(err, keystore.ErrSecretNotFound) {
+			jsonAPIError(c, http.StatusNotFound, err)
+			return
+		}
+
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	jsonAPIResponseWithStatus(c, nil, "secrets", http.StatusNoContent)
+}
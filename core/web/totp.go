@@ -0,0 +1,45 @@
+package web
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/smartcontractkit/chainlink/core/auth"
+	"github.com/smartcontractkit/chainlink/core/store/orm"
+)
+
+// TOTPHeader is the header name of the TOTP code required for the mutating
+// operations of users who enabled TOTP
+const TOTPHeader = "X-Chainlink-TOTP"
+
+// requireTOTP rejects every request other than a GET by users who enabled
+// TOTP unless it comes with a valid code in the TOTPHeader. It must come after
+// the authentication of the routes.
+func requireTOTP() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if c.Request.Method == http.MethodGet || c.Request.Method == http.MethodHead {
+			c.Next()
+			return
+		}
+		user, ok := authenticatedUser(c)
+		if !ok || !user.TOTPEnabled {
+			c.Next()
+			return
+		}
+
+		code := c.GetHeader(TOTPHeader)
+		if code == "" {
+			c.Abort()
+			jsonAPIError(c, http.StatusUnauthorized, orm.ErrTOTPRequired)
+			return
+		}
+		if !auth.ValidateTOTP(user.TOTPSecret, code, time.Now()) {
+			c.Abort()
+			jsonAPIError(c, http.StatusUnauthorized, orm.ErrInvalidTOTP)
+			return
+		}
+		c.Next()
+	}
+}
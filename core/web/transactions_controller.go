@@ -3,6 +3,7 @@ package web
 import (
 	"net/http"
 
+	"github.com/smartcontractkit/chainlink/core/services/bulletprooftxmanager"
 	"github.com/smartcontractkit/chainlink/core/services/chainlink"
 	"github.com/smartcontractkit/chainlink/core/store/orm"
 	"github.com/smartcontractkit/chainlink/core/web/presenters"
@@ -46,3 +47,37 @@ func (tc *TransactionsController) Show(c *gin.Context) {
 
 	jsonAPIResponse(c, presenters.NewEthTxResourceFromAttempt(*ethTxAttempt), "transaction")
 }
+
+// Cancel cancels the Ethereum transaction with the given attempt hash.
+// Unstarted transactions are cancelled immediately, broadcast ones are
+// replaced with a zero-value send to self on the next head.
+// Example:
+//
+//	"<application>/transactions/:TxHash/cancel"
+func (tc *TransactionsController) Cancel(c *gin.Context) {
+	hash := common.HexToHash(c.Param("TxHash"))
+
+	store := tc.App.GetStore()
+	ethTxAttempt, err := store.FindEthTxAttempt(hash)
+	if errors.Cause(err) == orm.ErrorNotFound {
+		jsonAPIError(c, http.StatusNotFound, errors.New("Transaction not found"))
+		return
+	}
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	etx, err := bulletprooftxmanager.CancelEthTx(store.DB, ethTxAttempt.EthTxID)
+	if errors.Is(err, bulletprooftxmanager.ErrCannotCancelEthTx) {
+		jsonAPIError(c, http.StatusConflict, err)
+		return
+	}
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	ethTxAttempt.EthTx = etx
+	jsonAPIResponse(c, presenters.NewEthTxResourceFromAttempt(*ethTxAttempt), "transaction")
+}
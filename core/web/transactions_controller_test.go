@@ -128,3 +128,44 @@ func TestTransactionsController_Show_NotFound(t *testing.T) {
 	t.Cleanup(cleanup)
 	cltest.AssertServerResponse(t, resp, http.StatusNotFound)
 }
+
+func TestTransactionsController_Cancel(t *testing.T) {
+	t.Parallel()
+
+	app, cleanup := cltest.NewApplicationWithKey(t)
+	t.Cleanup(cleanup)
+
+	require.NoError(t, app.Start())
+	db := app.GetStore().DB
+	client := app.NewHTTPClient()
+	_, from := cltest.MustAddRandomKeyToKeystore(t, app.KeyStore.Eth(), 0)
+
+	t.Run("expires unconfirmed transactions", func(t *testing.T) {
+		tx := cltest.MustInsertUnconfirmedEthTxWithBroadcastAttempt(t, db, 1, from)
+		require.Len(t, tx.EthTxAttempts, 1)
+
+		resp, cleanup := client.Post("/v2/transactions/"+tx.EthTxAttempts[0].Hash.Hex()+"/cancel", nil)
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+		ptx := presenters.EthTxResource{}
+		require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &ptx))
+		assert.Equal(t, string(bulletprooftxmanager.EthTxUnconfirmed), ptx.State)
+		assert.NotNil(t, ptx.ExpiresAt)
+	})
+
+	t.Run("rejects confirmed transactions", func(t *testing.T) {
+		tx := cltest.MustInsertConfirmedEthTxWithAttempt(t, db, 2, 1, from)
+		require.Len(t, tx.EthTxAttempts, 1)
+
+		resp, cleanup := client.Post("/v2/transactions/"+tx.EthTxAttempts[0].Hash.Hex()+"/cancel", nil)
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, resp, http.StatusConflict)
+	})
+
+	t.Run("returns 404 for unknown transactions", func(t *testing.T) {
+		resp, cleanup := client.Post("/v2/transactions/"+utils.NewHash().Hex()+"/cancel", nil)
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, resp, http.StatusNotFound)
+	})
+}
@@ -0,0 +1,42 @@
+package web
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/smartcontractkit/chainlink/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/core/services/keeper"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+// UpkeepSimulationsController exposes the performUpkeep simulations of keeper
+// jobs
+type UpkeepSimulationsController struct {
+	App chainlink.Application
+}
+
+// Index lists the latest simulation of every simulated upkeep, optionally only
+// of the parked upkeeps
+// Example:
+// "GET <application>/keeper/upkeep_simulations?parked=true"
+func (usc *UpkeepSimulationsController) Index(c *gin.Context) {
+	var parkedOnly bool
+	if c.Query("parked") != "" {
+		var err error
+		parkedOnly, err = strconv.ParseBool(c.Query("parked"))
+		if err != nil {
+			jsonAPIError(c, http.StatusUnprocessableEntity, err)
+			return
+		}
+	}
+
+	sims, err := keeper.FindUpkeepSimulations(c.Request.Context(), usc.App.GetStore().DB, parkedOnly)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewUpkeepSimulationResources(sims), "upkeepSimulations")
+}
@@ -4,9 +4,12 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"time"
 
+	"github.com/smartcontractkit/chainlink/core/auth"
 	"github.com/smartcontractkit/chainlink/core/services/chainlink"
 	"github.com/smartcontractkit/chainlink/core/store/models"
+	"github.com/smartcontractkit/chainlink/core/store/orm"
 	"github.com/smartcontractkit/chainlink/core/utils"
 	"github.com/smartcontractkit/chainlink/core/web/presenters"
 
@@ -14,7 +17,7 @@ import (
 	"github.com/gin-gonic/gin"
 )
 
-// UserController manages the current Session's User User.
+// UserController manages the current Session's User.
 type UserController struct {
 	App chainlink.Application
 }
@@ -34,15 +37,19 @@ func (c *UserController) UpdatePassword(ctx *gin.Context) {
 		return
 	}
 
-	user, err := c.App.GetStore().FindUser()
+	user, err := c.currentUser(ctx)
 	if err != nil {
-		jsonAPIError(ctx, http.StatusInternalServerError, fmt.Errorf("failed to obtain current user record: %+v", err))
+		jsonAPIError(ctx, http.StatusInternalServerError, err)
 		return
 	}
 	if !utils.CheckPasswordHash(request.OldPassword, user.HashedPassword) {
 		jsonAPIError(ctx, http.StatusConflict, errors.New("old password does not match"))
 		return
 	}
+	if err := models.ValidatePasswordComplexity(user.Email, request.NewPassword); err != nil {
+		jsonAPIError(ctx, http.StatusUnprocessableEntity, err)
+		return
+	}
 	if err := c.updateUserPassword(ctx, &user, request.NewPassword); err != nil {
 		jsonAPIError(ctx, http.StatusInternalServerError, err)
 		return
@@ -59,9 +66,9 @@ func (c *UserController) NewAPIToken(ctx *gin.Context) {
 		return
 	}
 
-	user, err := c.App.GetStore().FindUser()
+	user, err := c.currentUser(ctx)
 	if err != nil {
-		jsonAPIError(ctx, http.StatusInternalServerError, fmt.Errorf("failed to obtain current user record: %+v", err))
+		jsonAPIError(ctx, http.StatusInternalServerError, err)
 		return
 	}
 	if !utils.CheckPasswordHash(request.Password, user.HashedPassword) {
@@ -89,9 +96,9 @@ func (c *UserController) DeleteAPIToken(ctx *gin.Context) {
 		return
 	}
 
-	user, err := c.App.GetStore().FindUser()
+	user, err := c.currentUser(ctx)
 	if err != nil {
-		jsonAPIError(ctx, http.StatusInternalServerError, fmt.Errorf("failed to obtain current user record: %+v", err))
+		jsonAPIError(ctx, http.StatusInternalServerError, err)
 		return
 	}
 	if !utils.CheckPasswordHash(request.Password, user.HashedPassword) {
@@ -111,6 +118,153 @@ func (c *UserController) DeleteAPIToken(ctx *gin.Context) {
 	}
 }
 
+// Sessions lists the sessions of the current user, e.g. to find ones left
+// logged in elsewhere.
+func (c *UserController) Sessions(ctx *gin.Context) {
+	user, err := c.currentUser(ctx)
+	if err != nil {
+		jsonAPIError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+	sessions, err := c.App.GetStore().UserSessions(user.Email)
+	if err != nil {
+		jsonAPIError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+	// Users authenticated by token have no current session
+	currentID, _ := c.getCurrentSessionID(ctx)
+
+	jsonAPIResponse(ctx, presenters.NewSessionResources(sessions, currentID), "sessions")
+}
+
+// RevokeSession logs the current user out of one of their sessions.
+// Example:
+// "DELETE <application>/user/sessions/:id"
+func (c *UserController) RevokeSession(ctx *gin.Context) {
+	defer c.App.WakeSessionReaper()
+
+	user, err := c.currentUser(ctx)
+	if err != nil {
+		jsonAPIError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+	err = c.App.GetStore().RevokeUserSession(user.Email, ctx.Param("id"))
+	if errors.Is(err, orm.ErrorNotFound) {
+		jsonAPIError(ctx, http.StatusNotFound, errors.New("session not found"))
+		return
+	} else if err != nil {
+		jsonAPIError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponseWithStatus(ctx, nil, "sessions", http.StatusNoContent)
+}
+
+// CreateTOTPSecret generates a new TOTP secret for the current user, which
+// is only required once enabled with a code generated from it. It fails if
+// TOTP is already enabled, to not lock the user out.
+func (c *UserController) CreateTOTPSecret(ctx *gin.Context) {
+	var request models.ChangeAuthTokenRequest
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(ctx, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	user, err := c.currentUser(ctx)
+	if err != nil {
+		jsonAPIError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+	if !utils.CheckPasswordHash(request.Password, user.HashedPassword) {
+		jsonAPIError(ctx, http.StatusUnauthorized, errors.New("incorrect password"))
+		return
+	}
+	if user.TOTPEnabled {
+		jsonAPIError(ctx, http.StatusConflict, errors.New("TOTP is already enabled, disable it first"))
+		return
+	}
+	if user.TOTPSecret, err = auth.NewTOTPSecret(); err != nil {
+		jsonAPIError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+	if err := c.App.GetStore().SaveUser(&user); err != nil {
+		jsonAPIError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+
+	resource := presenters.NewTOTPSecretResource(user, auth.TOTPURI(user.TOTPSecret, user.Email))
+	jsonAPIResponseWithStatus(ctx, resource, "totpSecret", http.StatusCreated)
+}
+
+// EnableTOTPRequest confirms the current user can generate the codes of their
+// TOTP secret
+type EnableTOTPRequest struct {
+	Code string `json:"code"`
+}
+
+// EnableTOTP requires TOTP codes to log in as the current user and for their
+// destructive operations.
+func (c *UserController) EnableTOTP(ctx *gin.Context) {
+	var request EnableTOTPRequest
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(ctx, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	user, err := c.currentUser(ctx)
+	if err != nil {
+		jsonAPIError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+	if user.TOTPSecret == "" {
+		jsonAPIError(ctx, http.StatusConflict, errors.New("no TOTP secret, create one first"))
+		return
+	}
+	if !auth.ValidateTOTP(user.TOTPSecret, request.Code, time.Now()) {
+		jsonAPIError(ctx, http.StatusUnauthorized, orm.ErrInvalidTOTP)
+		return
+	}
+	user.TOTPEnabled = true
+	if err := c.App.GetStore().SaveUser(&user); err != nil {
+		jsonAPIError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponse(ctx, presenters.NewUserResource(user), "user")
+}
+
+// DisableTOTP stops requiring TOTP codes for the current user. Being a
+// destructive operation, it requires a TOTP code itself.
+func (c *UserController) DisableTOTP(ctx *gin.Context) {
+	user, err := c.currentUser(ctx)
+	if err != nil {
+		jsonAPIError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+	user.TOTPEnabled = false
+	user.TOTPSecret = ""
+	if err := c.App.GetStore().SaveUser(&user); err != nil {
+		jsonAPIError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponse(ctx, presenters.NewUserResource(user), "user")
+}
+
+// currentUser reloads the authenticated user, so that changes are saved over
+// its latest state
+func (c *UserController) currentUser(ctx *gin.Context) (models.User, error) {
+	authUser, ok := authenticatedUser(ctx)
+	if !ok {
+		return models.User{}, errors.New("failed to obtain current user from context")
+	}
+	user, err := c.App.GetStore().FindUserByEmail(authUser.Email)
+	if err != nil {
+		return models.User{}, fmt.Errorf("failed to obtain current user record: %+v", err)
+	}
+	return user, nil
+}
+
 func (c *UserController) getCurrentSessionID(ctx *gin.Context) (string, error) {
 	session := sessions.Default(ctx)
 	sessionID, ok := session.Get(SessionIDKey).(string)
@@ -6,10 +6,14 @@ import (
 	"fmt"
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/smartcontractkit/chainlink/core/auth"
 	"github.com/smartcontractkit/chainlink/core/internal/cltest"
 	"github.com/smartcontractkit/chainlink/core/store/models"
+	"github.com/smartcontractkit/chainlink/core/store/orm"
+	"github.com/smartcontractkit/chainlink/core/web"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -41,6 +45,12 @@ func TestUserController_UpdatePassword(t *testing.T) {
 			wantErrCount:   1,
 			wantErrMessage: "old password does not match",
 		},
+		{
+			name:           "Weak new password",
+			reqBody:        fmt.Sprintf(`{"newPassword": "password", "oldPassword": "%v"}`, cltest.Password),
+			wantStatusCode: http.StatusUnprocessableEntity,
+			wantErrCount:   1,
+		},
 		{
 			name:           "Success",
 			reqBody:        fmt.Sprintf(`{"newPassword": "%v", "oldPassword": "%v"}`, cltest.Password, cltest.Password),
@@ -157,3 +167,106 @@ func TestUserController_DeleteAPIKey_unauthorized(t *testing.T) {
 
 	assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
 }
+
+func TestUserController_Sessions(t *testing.T) {
+	t.Parallel()
+
+	ethClient, _, assertMocksCalled := cltest.NewEthMocksWithStartupAssertions(t)
+	defer assertMocksCalled()
+	app, cleanup := cltest.NewApplicationWithKey(t,
+		ethClient,
+	)
+	defer cleanup()
+	require.NoError(t, app.Start())
+
+	otherSessionID := app.MustSeedNewSession()
+	client := app.NewHTTPClient()
+
+	resp, cleanup := client.Get("/v2/user/sessions")
+	defer cleanup()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	var sessions []presenters.SessionResource
+	require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &sessions))
+	require.Len(t, sessions, 2)
+	for _, session := range sessions {
+		assert.Equal(t, session.ID != otherSessionID, session.Current)
+	}
+
+	resp, cleanup = client.Delete("/v2/user/sessions/" + otherSessionID)
+	defer cleanup()
+	require.Equal(t, http.StatusNoContent, resp.StatusCode)
+
+	resp, cleanup = client.Delete("/v2/user/sessions/" + otherSessionID)
+	defer cleanup()
+	require.Equal(t, http.StatusNotFound, resp.StatusCode)
+
+	sessionsLeft, err := app.Store.UserSessions(cltest.APIEmail)
+	require.NoError(t, err)
+	require.Len(t, sessionsLeft, 1)
+	assert.NotEqual(t, otherSessionID, sessionsLeft[0].ID)
+}
+
+func TestUserController_TOTP(t *testing.T) {
+	t.Parallel()
+
+	ethClient, _, assertMocksCalled := cltest.NewEthMocksWithStartupAssertions(t)
+	defer assertMocksCalled()
+	app, cleanup := cltest.NewApplicationWithKey(t,
+		ethClient,
+	)
+	defer cleanup()
+	require.NoError(t, app.Start())
+
+	sessionID := app.MustSeedNewSession()
+	client := app.NewHTTPClient()
+
+	req, err := json.Marshal(models.ChangeAuthTokenRequest{Password: cltest.Password})
+	require.NoError(t, err)
+	resp, cleanup := client.Post("/v2/user/totp", bytes.NewBuffer(req))
+	defer cleanup()
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+	var secret presenters.TOTPSecretResource
+	require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &secret))
+	require.NotEmpty(t, secret.Secret)
+	assert.Contains(t, secret.URI, "otpauth://totp/")
+
+	resp, cleanup = client.Post("/v2/user/totp/enable", bytes.NewBufferString(`{"code": "abcdef"}`))
+	defer cleanup()
+	require.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+
+	code, err := auth.TOTPCode(secret.Secret, time.Now())
+	require.NoError(t, err)
+	resp, cleanup = client.Post("/v2/user/totp/enable", bytes.NewBufferString(fmt.Sprintf(`{"code": "%s"}`, code)))
+	defer cleanup()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	var user presenters.UserResource
+	require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &user))
+	assert.True(t, user.TOTPEnabled)
+
+	_, err = app.Store.CreateSession(models.SessionRequest{Email: cltest.APIEmail, Password: cltest.Password})
+	require.Equal(t, orm.ErrTOTPRequired, err)
+
+	// Destructive operations require a code from now on
+	resp, cleanup = client.Delete("/v2/user/totp")
+	defer cleanup()
+	require.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+
+	// As does account management
+	resp, cleanup = client.Post("/v2/users", bytes.NewBufferString(fmt.Sprintf(`{"email": "new@chainlink.test", "password": "%s"}`, cltest.Password)))
+	defer cleanup()
+	require.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+
+	request, err := http.NewRequest("DELETE", app.Config.ClientNodeURL()+"/v2/user/totp", nil)
+	require.NoError(t, err)
+	request.AddCookie(cltest.MustGenerateSessionCookie(sessionID))
+	request.Header.Set(web.TOTPHeader, code)
+	response, err := http.DefaultClient.Do(request)
+	require.NoError(t, err)
+	defer response.Body.Close()
+	require.Equal(t, http.StatusOK, response.StatusCode)
+
+	found, err := app.Store.FindUserByEmail(cltest.APIEmail)
+	require.NoError(t, err)
+	assert.False(t, found.TOTPEnabled)
+	assert.Empty(t, found.TOTPSecret)
+}
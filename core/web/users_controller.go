@@ -0,0 +1,94 @@
+package web
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/smartcontractkit/chainlink/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/core/store/models"
+	"github.com/smartcontractkit/chainlink/core/store/orm"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+// UsersController manages the API users of the node
+type UsersController struct {
+	App chainlink.Application
+}
+
+// CreateUserRequest adds an API user
+type CreateUserRequest struct {
+	Email    string `json:"email"`
+	Password string `json:"password"`
+	Admin    bool   `json:"admin"`
+}
+
+// Index lists the API users
+// Example:
+// "GET <application>/users"
+func (uc *UsersController) Index(c *gin.Context) {
+	users, err := uc.App.GetStore().Users()
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	jsonAPIResponse(c, presenters.NewUserResources(users), "users")
+}
+
+// Create adds an API user, whose password must meet the password policy.
+// Only admins may add users.
+// Example:
+// "POST <application>/users"
+func (uc *UsersController) Create(c *gin.Context) {
+	request := CreateUserRequest{}
+	if err := c.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	if err := models.ValidatePasswordComplexity(request.Email, request.Password); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	user, err := models.NewUser(request.Email, request.Password)
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	user.Admin = request.Admin
+
+	if err := uc.App.GetStore().CreateUser(&user); err != nil {
+		if errors.Is(err, orm.ErrUserExists) {
+			jsonAPIError(c, http.StatusConflict, err)
+			return
+		}
+
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	jsonAPIResponseWithStatus(c, presenters.NewUserResource(user), "users", http.StatusCreated)
+}
+
+// Delete removes an API user and logs them out. Only admins may remove
+// users, and they cannot remove themselves, so that there is always one left.
+// Example:
+// "DELETE <application>/users/:email"
+func (uc *UsersController) Delete(c *gin.Context) {
+	email := c.Param("email")
+	if user, ok := authenticatedUser(c); ok && strings.EqualFold(user.Email, email) {
+		jsonAPIError(c, http.StatusConflict, errors.New("cannot delete the current user"))
+		return
+	}
+
+	err := uc.App.GetStore().DeleteUserByEmail(email)
+	if err != nil {
+		if errors.Is(err, orm.ErrorNotFound) {
+			jsonAPIError(c, http.StatusNotFound, errors.New("user not found"))
+			return
+		}
+
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	jsonAPIResponseWithStatus(c, nil, "users", http.StatusNoContent)
+}
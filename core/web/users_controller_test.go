@@ -0,0 +1,163 @@
+package web_test
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/store/models"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUsersController_Create(t *testing.T) {
+	t.Parallel()
+
+	ethClient, _, assertMocksCalled := cltest.NewEthMocksWithStartupAssertions(t)
+	defer assertMocksCalled()
+	app, cleanup := cltest.NewApplicationWithKey(t,
+		ethClient,
+	)
+	defer cleanup()
+	require.NoError(t, app.Start())
+	client := app.NewHTTPClient()
+
+	testCases := []struct {
+		name           string
+		reqBody        string
+		wantStatusCode int
+	}{
+		{
+			name:           "Invalid request",
+			reqBody:        "",
+			wantStatusCode: http.StatusUnprocessableEntity,
+		},
+		{
+			name:           "Weak password",
+			reqBody:        `{"email": "weak@chainlink.test", "password": "password"}`,
+			wantStatusCode: http.StatusUnprocessableEntity,
+		},
+		{
+			name:           "Invalid email",
+			reqBody:        fmt.Sprintf(`{"email": "invalid", "password": "%s"}`, cltest.Password),
+			wantStatusCode: http.StatusUnprocessableEntity,
+		},
+		{
+			name:           "Existing user",
+			reqBody:        fmt.Sprintf(`{"email": "%s", "password": "%s"}`, cltest.APIEmail, cltest.Password),
+			wantStatusCode: http.StatusConflict,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			resp, cleanup := client.Post("/v2/users", bytes.NewBufferString(tc.reqBody))
+			defer cleanup()
+			cltest.AssertServerResponse(t, resp, tc.wantStatusCode)
+		})
+	}
+
+	resp, cleanup := client.Post("/v2/users", bytes.NewBufferString(fmt.Sprintf(`{"email": "new@chainlink.test", "password": "%s"}`, cltest.Password)))
+	defer cleanup()
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+
+	resp, cleanup = client.Get("/v2/users")
+	defer cleanup()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	var users []presenters.UserResource
+	require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &users))
+	require.Len(t, users, 2)
+	assert.Equal(t, "new@chainlink.test", users[1].Email)
+	assert.True(t, users[0].Admin)
+	assert.False(t, users[1].Admin)
+}
+
+func TestUsersController_RequiresAdmin(t *testing.T) {
+	t.Parallel()
+
+	ethClient, _, assertMocksCalled := cltest.NewEthMocksWithStartupAssertions(t)
+	defer assertMocksCalled()
+	app, cleanup := cltest.NewApplicationWithKey(t,
+		ethClient,
+	)
+	defer cleanup()
+	require.NoError(t, app.Start())
+
+	nonAdmin := cltest.MustNewUser(t, "nonadmin@chainlink.test", cltest.Password)
+	require.NoError(t, app.Store.CreateUser(&nonAdmin))
+	sessionID, err := app.Store.CreateSession(models.SessionRequest{Email: nonAdmin.Email, Password: cltest.Password})
+	require.NoError(t, err)
+
+	do := func(method, path string, body string) *http.Response {
+		request, err := http.NewRequest(method, app.Config.ClientNodeURL()+path, bytes.NewBufferString(body))
+		require.NoError(t, err)
+		request.AddCookie(cltest.MustGenerateSessionCookie(sessionID))
+		response, err := http.DefaultClient.Do(request)
+		require.NoError(t, err)
+		t.Cleanup(func() { response.Body.Close() })
+		return response
+	}
+
+	resp := do("POST", "/v2/users", fmt.Sprintf(`{"email": "new@chainlink.test", "password": "%s", "admin": true}`, cltest.Password))
+	assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+	_, err = app.Store.FindUserByEmail("new@chainlink.test")
+	require.Error(t, err)
+
+	resp = do("DELETE", "/v2/users/"+cltest.APIEmail, "")
+	assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+	_, err = app.Store.FindUserByEmail(cltest.APIEmail)
+	require.NoError(t, err)
+
+	resp = do("GET", "/v2/users", "")
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	// As are the routes which move funds or expose keys
+	for _, route := range []struct{ method, path string }{
+		{"POST", "/v2/transfers"},
+		{"POST", "/v2/drain"},
+		{"PATCH", "/v2/keys/password"},
+		{"POST", "/v2/keys/eth/import"},
+		{"POST", "/v2/keys/eth/export/0x0000000000000000000000000000000000000000"},
+		{"POST", "/v2/keys/eth/rotate/0x0000000000000000000000000000000000000000"},
+		{"POST", "/v2/keys/vrf/export/1"},
+	} {
+		resp = do(route.method, route.path, "{}")
+		assert.Equal(t, http.StatusForbidden, resp.StatusCode, "%s %s", route.method, route.path)
+	}
+}
+
+func TestUsersController_Delete(t *testing.T) {
+	t.Parallel()
+
+	ethClient, _, assertMocksCalled := cltest.NewEthMocksWithStartupAssertions(t)
+	defer assertMocksCalled()
+	app, cleanup := cltest.NewApplicationWithKey(t,
+		ethClient,
+	)
+	defer cleanup()
+	require.NoError(t, app.Start())
+	client := app.NewHTTPClient()
+
+	other := cltest.MustNewUser(t, "other@chainlink.test", cltest.Password)
+	require.NoError(t, app.Store.CreateUser(&other))
+
+	resp, cleanup := client.Delete("/v2/users/" + cltest.APIEmail)
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusConflict)
+
+	resp, cleanup = client.Delete("/v2/users/" + other.Email)
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusNoContent)
+
+	resp, cleanup = client.Delete("/v2/users/" + other.Email)
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusNotFound)
+
+	_, err := app.Store.FindUserByEmail(other.Email)
+	require.Error(t, err)
+}
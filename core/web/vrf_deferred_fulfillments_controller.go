@@ -0,0 +1,44 @@
+package web
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/core/services/vrf"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+// VRFDeferredFulfillmentsController exposes the requests of VRF v2 jobs whose
+// fulfillments are waiting for gas prices to fall
+type VRFDeferredFulfillmentsController struct {
+	App chainlink.Application
+}
+
+// Index lists the deferred fulfillments of every VRF job, optionally only
+// those of the job with the `jobID` query param
+// Example:
+// "GET <application>/vrf/deferred_fulfillments?jobID=1"
+func (vdfc *VRFDeferredFulfillmentsController) Index(c *gin.Context) {
+	var jobID *int32
+	if param := c.Query("jobID"); param != "" {
+		id, err := strconv.ParseInt(param, 10, 32)
+		if err != nil || id <= 0 {
+			jsonAPIError(c, http.StatusUnprocessableEntity, errors.New("jobID must be a positive integer"))
+			return
+		}
+		id32 := int32(id)
+		jobID = &id32
+	}
+
+	deferred, err := vrf.FindDeferredFulfillments(c.Request.Context(), vdfc.App.GetStore().DB, jobID)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewVRFDeferredFulfillmentResources(deferred), "vrfDeferredFulfillments")
+}
@@ -1,11 +1,16 @@
 package web
 
 import (
+	"fmt"
 	"io/ioutil"
 	"net/http"
 	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
 
 	"github.com/smartcontractkit/chainlink/core/services/signatures/secp256k1"
+	"github.com/smartcontractkit/chainlink/core/services/vrf"
 
 	"github.com/gin-gonic/gin"
 	"github.com/smartcontractkit/chainlink/core/logger"
@@ -47,12 +52,14 @@ func (vrfkc *VRFKeysController) Create(c *gin.Context) {
 	jsonAPIResponse(c, presenters.NewVRFKeyResource(*encKey), "vrfKey")
 }
 
-// Delete a VRF key
+// Delete a VRF key. Keys which are still registered with the coordinator of
+// one of their jobs are only deleted with force=true.
 // Example:
 // "DELETE <application>/keys/vrf/:keyID"
 // "DELETE <application>/keys/vrf/:keyID?hard=true"
+// "DELETE <application>/keys/vrf/:keyID?force=true"
 func (vrfkc *VRFKeysController) Delete(c *gin.Context) {
-	var hardDelete bool
+	var hardDelete, force bool
 	var err error
 	if c.Query("hard") != "" {
 		hardDelete, err = strconv.ParseBool(c.Query("hard"))
@@ -61,6 +68,13 @@ func (vrfkc *VRFKeysController) Delete(c *gin.Context) {
 			return
 		}
 	}
+	if c.Query("force") != "" {
+		force, err = strconv.ParseBool(c.Query("force"))
+		if err != nil {
+			jsonAPIError(c, http.StatusUnprocessableEntity, err)
+			return
+		}
+	}
 	pk, err := secp256k1.NewPublicKeyFromHex(c.Param("keyID"))
 	if err != nil {
 		jsonAPIError(c, http.StatusUnprocessableEntity, err)
@@ -71,6 +85,21 @@ func (vrfkc *VRFKeysController) Delete(c *gin.Context) {
 		jsonAPIError(c, http.StatusNotFound, err)
 		return
 	}
+	if !force {
+		registrations, err := vrf.FindKeyRegistrations(c.Request.Context(), vrfkc.App.GetStore().DB, vrfkc.App.GetEthClient(), pk)
+		if err != nil {
+			jsonAPIError(c, http.StatusInternalServerError, errors.Wrap(err, "unable to check the coordinators of the key, pass force=true to delete it anyway"))
+			return
+		}
+		if len(registrations) > 0 {
+			coordinators := make([]string, len(registrations))
+			for i, r := range registrations {
+				coordinators[i] = fmt.Sprintf("%s (job %d)", r.CoordinatorAddress, r.JobID)
+			}
+			jsonAPIError(c, http.StatusConflict, errors.Errorf("key is still registered with coordinator %s, deregister it or pass force=true to delete it anyway", strings.Join(coordinators, ", ")))
+			return
+		}
+	}
 	if hardDelete {
 		err = vrfkc.App.GetKeyStore().VRF().Delete(pk)
 	} else {
@@ -117,7 +146,12 @@ func (vrfkc *VRFKeysController) Export(c *gin.Context) {
 	}
 	// New password to re-encrypt the export with
 	newPassword := c.Query("newpassword")
-	bytes, err := vrfkc.App.GetKeyStore().VRF().Export(pk, newPassword)
+	scryptParams, err := exportScryptParams(c, vrfkc.App.GetConfig())
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	bytes, err := vrfkc.App.GetKeyStore().VRF().Export(keyStoreContext(c, "export"), pk, newPassword, scryptParams)
 	if err != nil {
 		jsonAPIError(c, http.StatusInternalServerError, err)
 		return
@@ -0,0 +1,116 @@
+package web
+
+import (
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
+)
+
+const (
+	websocketPingPeriod   = 30 * time.Second
+	websocketPongWait     = 2 * websocketPingPeriod
+	websocketWriteTimeout = 10 * time.Second
+)
+
+var websocketUpgrader = websocket.Upgrader{
+	ReadBufferSize:  1024,
+	WriteBufferSize: 1024,
+}
+
+// websocketStream sends JSON messages to a client over a websocket. It pings
+// the client in the background and notices when it goes away.
+type websocketStream struct {
+	conn     *websocket.Conn
+	chClosed chan struct{}
+	chStop   chan struct{}
+	wgDone   sync.WaitGroup
+}
+
+// newWebsocketStream upgrades the request to a websocket. If it fails, the
+// upgrader has already replied with an error.
+func newWebsocketStream(c *gin.Context) (*websocketStream, error) {
+	conn, err := websocketUpgrader.Upgrade(c.Writer, c.Request, nil)
+	if err != nil {
+		return nil, err
+	}
+	s := &websocketStream{
+		conn:     conn,
+		chClosed: make(chan struct{}),
+		chStop:   make(chan struct{}),
+	}
+
+	// the client never sends anything, but reading handles control messages
+	// and notices when it goes away. The deadline replaces the one set by the
+	// server for reading the request.
+	resetReadDeadline := func(string) error {
+		return conn.SetReadDeadline(time.Now().Add(websocketPongWait))
+	}
+	if err = resetReadDeadline(""); err != nil {
+		_ = conn.Close()
+		return nil, err
+	}
+	conn.SetPongHandler(resetReadDeadline)
+
+	s.wgDone.Add(2)
+	go s.readLoop()
+	go s.pingLoop()
+	return s, nil
+}
+
+func (s *websocketStream) readLoop() {
+	defer s.wgDone.Done()
+	defer close(s.chClosed)
+	for {
+		if _, _, err := s.conn.NextReader(); err != nil {
+			return
+		}
+	}
+}
+
+func (s *websocketStream) pingLoop() {
+	defer s.wgDone.Done()
+	ping := time.NewTicker(websocketPingPeriod)
+	defer ping.Stop()
+	for {
+		select {
+		case <-ping.C:
+			// WriteControl may be called concurrently with Send
+			if err := s.conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(websocketWriteTimeout)); err != nil {
+				return
+			}
+		case <-s.chClosed:
+			return
+		case <-s.chStop:
+			return
+		}
+	}
+}
+
+// Closed returns a channel which is closed once the client has gone away
+func (s *websocketStream) Closed() <-chan struct{} {
+	return s.chClosed
+}
+
+// Send sends the message as JSON
+func (s *websocketStream) Send(message interface{}) error {
+	if err := s.conn.SetWriteDeadline(time.Now().Add(websocketWriteTimeout)); err != nil {
+		return err
+	}
+	return s.conn.WriteJSON(message)
+}
+
+// Finish tells the client that the stream is over, for the reason given
+func (s *websocketStream) Finish(reason string) error {
+	message := websocket.FormatCloseMessage(websocket.CloseNormalClosure, reason)
+	return s.conn.WriteControl(websocket.CloseMessage, message, time.Now().Add(websocketWriteTimeout))
+}
+
+// Close stops pinging the client and closes the connection
+func (s *websocketStream) Close() error {
+	close(s.chStop)
+	err := s.conn.Close()
+	s.wgDone.Wait()
+	return err
+}
@@ -0,0 +1,68 @@
+package web
+
+import (
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestWebsocketStream serves a websocket stream with the handler and dials it
+func newTestWebsocketStream(t *testing.T, handle func(*websocketStream)) *websocket.Conn {
+	t.Helper()
+	router := gin.New()
+	router.GET("/stream", func(c *gin.Context) {
+		stream, err := newWebsocketStream(c)
+		if !assert.NoError(t, err) {
+			return
+		}
+		defer stream.Close()
+		handle(stream)
+	})
+	server := httptest.NewServer(router)
+	t.Cleanup(server.Close)
+
+	conn, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(server.URL, "http")+"/stream", nil)
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+	return conn
+}
+
+func TestWebsocketStream_SendAndFinish(t *testing.T) {
+	t.Parallel()
+
+	conn := newTestWebsocketStream(t, func(stream *websocketStream) {
+		assert.NoError(t, stream.Send(map[string]int{"id": 1}))
+		assert.NoError(t, stream.Finish("done"))
+	})
+
+	var message map[string]int
+	require.NoError(t, conn.ReadJSON(&message))
+	assert.Equal(t, map[string]int{"id": 1}, message)
+
+	_, _, err := conn.ReadMessage()
+	require.True(t, websocket.IsCloseError(err, websocket.CloseNormalClosure), err)
+	assert.Contains(t, err.Error(), "done")
+}
+
+func TestWebsocketStream_Closed(t *testing.T) {
+	t.Parallel()
+
+	closed := make(chan struct{})
+	conn := newTestWebsocketStream(t, func(stream *websocketStream) {
+		<-stream.Closed()
+		close(closed)
+	})
+	require.NoError(t, conn.Close())
+
+	select {
+	case <-closed:
+	case <-time.After(5 * time.Second):
+		t.Fatal("the stream did not notice the client going away")
+	}
+}
@@ -471,6 +471,12 @@ declare module 'core/store/models' {
 
   export type PipelineTaskOutput = string | null
   export type PipelineTaskError = string | null
+  export type PipelineTaskErrorCategory =
+    | 'network'
+    | 'parse'
+    | 'validation'
+    | 'timeout'
+    | 'unknown'
 
   interface BaseJobSpecV2 {
     name: string | null
@@ -683,6 +689,8 @@ declare module 'core/store/models' {
 export interface PipelineTaskRun {
   createdAt: time.Time
   error: PipelineTaskError
+  errorCategory: PipelineTaskErrorCategory | null
+  errorRetryable: boolean
   finishedAt: nullable.Time
   output: PipelineTaskOutput
   dotId: string
@@ -105,5 +105,8 @@ declare module 'core/store/presenters' {
     sentAt?: string
     to?: Pointer<common.Address>
     value?: string
+    expiresAt?: time.Time
+    expiresAtBlock?: string
+    cancelledAt?: time.Time
   }
 }
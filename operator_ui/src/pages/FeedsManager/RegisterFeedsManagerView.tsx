@@ -20,10 +20,18 @@ const jobTypes = [
     label: 'Flux Monitor',
     value: 'fluxmonitor',
   },
+  {
+    label: 'Keeper',
+    value: 'keeper',
+  },
   {
     label: 'OCR',
     value: 'offchainreporting',
   },
+  {
+    label: 'Webhook',
+    value: 'webhook',
+  },
 ]
 
 type FormValues = {
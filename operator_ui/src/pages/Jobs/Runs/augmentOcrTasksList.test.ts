@@ -21,6 +21,8 @@ describe('augmentOcrTasksList', () => {
           error:
             'majority of fetchers in median failed: error making http request: reason; error making http request: reason: bad input for task',
           dotId: 'answer',
+          errorCategory: 'validation',
+          errorRetryable: false,
           createdAt: '2020-11-24T11:38:36.100272Z',
           finishedAt: '2020-11-24T11:39:26.19516Z',
           status: 'errored',
@@ -30,6 +32,8 @@ describe('augmentOcrTasksList', () => {
           output: null,
           error: 'error making http request: reason',
           dotId: 'multiplyLast',
+          errorCategory: 'network',
+          errorRetryable: true,
           createdAt: '2020-11-24T11:38:36.100272Z',
           finishedAt: '2020-11-24T11:39:26.171678Z',
           status: 'not_run',
@@ -39,6 +43,8 @@ describe('augmentOcrTasksList', () => {
           output: null,
           error: 'error making http request: reason',
           dotId: 'multiplyOpen',
+          errorCategory: 'network',
+          errorRetryable: true,
           createdAt: '2020-11-24T11:38:36.100272Z',
           finishedAt: '2020-11-24T11:39:26.176633Z',
           status: 'not_run',
@@ -48,6 +54,8 @@ describe('augmentOcrTasksList', () => {
           output: null,
           error: 'error making http request: reason',
           dotId: 'parseLast',
+          errorCategory: 'network',
+          errorRetryable: true,
           createdAt: '2020-11-24T11:38:36.100272Z',
           finishedAt: '2020-11-24T11:39:26.154488Z',
           status: 'not_run',
@@ -57,6 +65,8 @@ describe('augmentOcrTasksList', () => {
           output: null,
           error: 'error making http request: reason',
           dotId: 'parseOpen',
+          errorCategory: 'network',
+          errorRetryable: true,
           createdAt: '2020-11-24T11:38:36.100272Z',
           finishedAt: '2020-11-24T11:39:26.15558Z',
           status: 'not_run',
@@ -66,6 +76,8 @@ describe('augmentOcrTasksList', () => {
           output: null,
           error: 'error making http request: reason',
           dotId: 'fetch',
+          errorCategory: 'network',
+          errorRetryable: true,
           createdAt: '2020-11-24T11:38:36.100272Z',
           finishedAt: '2020-11-24T11:39:26.12949Z',
           status: 'errored',
@@ -75,6 +87,8 @@ describe('augmentOcrTasksList', () => {
           output: null,
           error: 'error making http request: reason',
           dotId: 'fetch2',
+          errorCategory: 'network',
+          errorRetryable: true,
           createdAt: '2020-11-24T11:38:36.100272Z',
           finishedAt: '2020-11-24T11:39:26.127941Z',
           status: 'errored',
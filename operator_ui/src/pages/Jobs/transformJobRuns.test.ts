@@ -35,6 +35,8 @@ describe('transformPipelineJobRun', () => {
           createdAt: '2020-11-19T14:01:24.989522Z',
           error:
             'error making http request: Post "http://localhost:8001": dial tcp 127.0.0.1:8001: connect: connection refused',
+          errorCategory: 'network',
+          errorRetryable: true,
           finishedAt: '2020-11-19T14:01:25.015681Z',
           output: null,
           status: 'not_run',
@@ -45,6 +47,8 @@ describe('transformPipelineJobRun', () => {
           createdAt: '2020-11-19T14:01:24.989522Z',
           error:
             'error making http request: Post "http://localhost:8001": dial tcp 127.0.0.1:8001: connect: connection refused',
+          errorCategory: 'network',
+          errorRetryable: true,
           finishedAt: '2020-11-19T14:01:25.005568Z',
           output: null,
           status: 'not_run',
@@ -55,6 +59,8 @@ describe('transformPipelineJobRun', () => {
           createdAt: '2020-11-19T14:01:24.989522Z',
           error:
             'error making http request: Post "http://localhost:8001": dial tcp 127.0.0.1:8001: connect: connection refused',
+          errorCategory: 'network',
+          errorRetryable: true,
           finishedAt: '2020-11-19T14:01:24.997068Z',
           output: null,
           status: 'errored',
@@ -18,6 +18,8 @@ export function jobRunV2(
       {
         createdAt: '2020-11-19T14:01:24.989522Z',
         error: `error making http request: Post "http://localhost:8001": dial tcp 127.0.0.1:8001: connect: connection refused`,
+        errorCategory: 'network',
+        errorRetryable: true,
         finishedAt: '2020-11-19T14:01:25.015681Z',
         output: null,
         dotId: 'multiply',
@@ -27,6 +29,8 @@ export function jobRunV2(
       {
         createdAt: '2020-11-19T14:01:24.989522Z',
         error: `error making http request: Post "http://localhost:8001": dial tcp 127.0.0.1:8001: connect: connection refused`,
+        errorCategory: 'network',
+        errorRetryable: true,
         finishedAt: '2020-11-19T14:01:25.005568Z',
         output: null,
         dotId: 'parse',
@@ -35,6 +39,8 @@ export function jobRunV2(
       {
         createdAt: '2020-11-19T14:01:24.989522Z',
         error: `error making http request: Post "http://localhost:8001": dial tcp 127.0.0.1:8001: connect: connection refused`,
+        errorCategory: 'network',
+        errorRetryable: true,
         finishedAt: '2020-11-19T14:01:24.997068Z',
         output: null,
         dotId: 'fetch',